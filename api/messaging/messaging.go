@@ -0,0 +1,124 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+// Package messaging publishes the contracts of the NATS messages the project
+// service answers and sends, as JSON schemas under schemas/. Consumers can
+// verify their requests, or the messages they receive, against the schemas of
+// the version of this module they pin.
+package messaging
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sync"
+
+	fgaconstants "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/constants"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// schemaBaseURL is the URL the schemas are compiled at, against which their
+// relative references, such as lookup.json#/$defs/uid, resolve.
+const schemaBaseURL = "file:///schemas/"
+
+//go:embed schemas/*.json
+var schemaFiles embed.FS
+
+// Contract is the message contract of a NATS subject, naming its schemas.
+type Contract struct {
+	// Subject is the NATS subject, without tenant or subject prefix.
+	Subject string
+	// Message is the schema of the messages on Subject: the requests of a
+	// lookup subject, or the messages the service publishes.
+	Message string
+	// Reply is the schema of the replies of a lookup subject. It is empty for
+	// the subjects the service publishes on.
+	Reply string
+}
+
+// Contracts are the contracts of the subjects the project service answers
+// and publishes on.
+var Contracts = []Contract{
+	{Subject: constants.ProjectGetNameSubject, Message: "get_name.request.json", Reply: "get_name.reply.json"},
+	{Subject: constants.ProjectSlugToUIDSubject, Message: "slug_to_uid.request.json", Reply: "slug_to_uid.reply.json"},
+	{Subject: constants.IndexProjectSubject, Message: "index.project.json"},
+	{Subject: fgaconstants.GenericUpdateAccessSubject, Message: "fga.update_access.json"},
+	{Subject: fgaconstants.GenericDeleteAccessSubject, Message: "fga.delete_access.json"},
+	{Subject: fgaconstants.GenericMemberPutSubject, Message: "fga.member.json"},
+	{Subject: fgaconstants.GenericMemberRemoveSubject, Message: "fga.member.json"},
+}
+
+var (
+	compileOnce sync.Once
+	compiled    map[string]*jsonschema.Schema
+	compileErr  error
+)
+
+// Schema returns the JSON schema name, such as get_name.reply.json.
+func Schema(name string) ([]byte, error) {
+	return schemaFiles.ReadFile(path.Join("schemas", name))
+}
+
+// Validate validates data, the body of a message, against the schema name. A
+// body that is not a JSON object is plain text, the way the lookup subjects
+// tell plain-text requests from JSON ones, and is validated as a JSON string.
+func Validate(name string, data []byte) error {
+	compileOnce.Do(func() {
+		compiled, compileErr = compileSchemas()
+	})
+	if compileErr != nil {
+		return compileErr
+	}
+	schema, ok := compiled[name]
+	if !ok {
+		return fmt.Errorf("unknown schema %s", name)
+	}
+
+	var instance any = string(data)
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		var err error
+		instance, err = jsonschema.UnmarshalJSON(bytes.NewReader(data))
+		if err != nil {
+			return fmt.Errorf("decoding message: %w", err)
+		}
+	}
+	return schema.Validate(instance)
+}
+
+// compileSchemas compiles all schemas, keyed by name.
+func compileSchemas() (map[string]*jsonschema.Schema, error) {
+	names, err := fs.Glob(schemaFiles, "schemas/*.json")
+	if err != nil {
+		return nil, err
+	}
+
+	compiler := jsonschema.NewCompiler()
+	for _, name := range names {
+		data, err := schemaFiles.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("decoding schema %s: %w", name, err)
+		}
+		if err := compiler.AddResource(schemaBaseURL+path.Base(name), doc); err != nil {
+			return nil, fmt.Errorf("adding schema %s: %w", name, err)
+		}
+	}
+
+	schemas := make(map[string]*jsonschema.Schema, len(names))
+	for _, name := range names {
+		name = path.Base(name)
+		schema, err := compiler.Compile(schemaBaseURL + name)
+		if err != nil {
+			return nil, fmt.Errorf("compiling schema %s: %w", name, err)
+		}
+		schemas[name] = schema
+	}
+	return schemas, nil
+}
@@ -0,0 +1,95 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package messaging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testUID = "01234567-89ab-cdef-0123-456789abcdef"
+
+func TestContractsNameSchemas(t *testing.T) {
+	for _, contract := range Contracts {
+		for _, name := range []string{contract.Message, contract.Reply} {
+			if name == "" {
+				continue
+			}
+			_, err := Schema(name)
+			require.NoError(t, err, contract.Subject)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		message string
+		valid   bool
+	}{
+		{"plain-text get_name request", "get_name.request.json", testUID, true},
+		{"plain-text get_name request that is not a UID", "get_name.request.json", "my-project", false},
+		{"JSON get_name request", "get_name.request.json", `{"uid":"` + testUID + `","include_previous_names":true}`, true},
+		{"versioned get_name request", "get_name.request.json", `{"version":"v1","request_id":"r1","payload":{"uid":"` + testUID + `"}}`, true},
+		{"versioned get_name request of unknown version", "get_name.request.json", `{"version":"v2","payload":{"uid":"` + testUID + `"}}`, false},
+		{"plain-text get_name reply", "get_name.reply.json", "My Project", true},
+		{"empty get_name reply", "get_name.reply.json", "", true},
+		{"get_name reply with previous names", "get_name.reply.json", `{"name":"My Project","previous_names":[{"name":"Old","renamed_at":"2024-05-01T12:00:00Z"}]}`, true},
+		{"versioned get_name reply", "get_name.reply.json", `{"version":"v1","payload":{"name":"My Project"}}`, true},
+		{"versioned get_name error reply", "get_name.reply.json", `{"version":"v1","error":{"code":"not_found","message":"project not found"}}`, true},
+		{"versioned get_name reply of unknown error code", "get_name.reply.json", `{"version":"v1","error":{"code":"gone","message":"project gone"}}`, false},
+		{"versioned get_name reply with payload and error", "get_name.reply.json", `{"version":"v1","payload":{"name":"My Project"},"error":{"code":"internal","message":"failed"}}`, false},
+		{"plain-text slug_to_uid request", "slug_to_uid.request.json", "my-project", true},
+		{"versioned slug_to_uid request", "slug_to_uid.request.json", `{"version":"v1","payload":{"slug":"my-project"}}`, true},
+		{"versioned slug_to_uid request without slug", "slug_to_uid.request.json", `{"version":"v1","payload":{}}`, false},
+		{"plain-text slug_to_uid reply", "slug_to_uid.reply.json", testUID, true},
+		{"empty slug_to_uid reply", "slug_to_uid.reply.json", "", true},
+		{"slug_to_uid reply that is not a UID", "slug_to_uid.reply.json", "my-project", false},
+		{"versioned slug_to_uid reply", "slug_to_uid.reply.json", `{"version":"v1","request_id":"r1","payload":{"uid":"` + testUID + `"}}`, true},
+		{
+			"created project indexer message", "index.project.json",
+			`{"action":"created","headers":{},"data":{"uid":"` + testUID + `","slug":"my-project","name":"My Project","public":true,"parent_uid":""},` +
+				`"indexing_config":{"object_id":"{{ uid }}","access_check_object":"project:{{ uid }}","access_check_relation":"viewer","history_check_object":"project:{{ uid }}","history_check_relation":"writer"}}`,
+			true,
+		},
+		{
+			"created project indexer message without indexing config", "index.project.json",
+			`{"action":"created","headers":{},"data":{"uid":"` + testUID + `","slug":"my-project","name":"My Project","public":true,"parent_uid":""}}`,
+			false,
+		},
+		{"deleted project indexer message", "index.project.json", `{"action":"deleted","headers":{},"data":"` + testUID + `"}`, true},
+		{"deleted project indexer message with project data", "index.project.json", `{"action":"deleted","headers":{},"data":{"uid":"` + testUID + `"}}`, false},
+		{
+			"update_access message", "fga.update_access.json",
+			`{"object_type":"project","operation":"update_access","data":{"uid":"` + testUID + `","public":false,"relations":{"writer":["alice"]},"references":{},"exclude_relations":null}}`,
+			true,
+		},
+		{
+			"update_access message without references", "fga.update_access.json",
+			`{"object_type":"project","operation":"update_access","data":{"uid":"` + testUID + `","public":false,"relations":{}}}`,
+			false,
+		},
+		{"delete_access message", "fga.delete_access.json", `{"object_type":"project","operation":"delete_access","data":{"uid":"` + testUID + `"}}`, true},
+		{"member_put message", "fga.member.json", `{"object_type":"project","operation":"member_put","data":{"uid":"` + testUID + `","username":"alice","relations":["writer"]}}`, true},
+		{"member message without relations", "fga.member.json", `{"object_type":"project","operation":"member_remove","data":{"uid":"` + testUID + `","username":"alice","relations":[]}}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.schema, []byte(tt.message))
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateUnknownSchema(t *testing.T) {
+	assert.Error(t, Validate("unknown.json", []byte("{}")))
+}
@@ -0,0 +1,77 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package design
+
+import (
+	//nolint:staticcheck // ST1001: the recommended way of using the goa GSL package is with the . import
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("project-service", func() {
+	Method("get-project-associations", func() {
+		Description("Get the committees, mailing lists and meeting series associated with a project.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			ProjectUIDAttribute()
+			Required("uid")
+		})
+
+		Result(ProjectAssociations)
+
+		Error("NotFound", NotFoundError, "Project not found")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			GET("/projects/{uid}/associations")
+			Params(func() {
+				Param("version:v")
+				Param("uid")
+			})
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("update-project-associations", func() {
+		Description("Replace the committees, mailing lists and meeting series associated with a project. Each newly added reference must exist in the service that owns it.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			ProjectUIDAttribute()
+			ProjectAssociationsAttributes()
+			Required("uid")
+		})
+
+		Result(ProjectAssociations)
+
+		Error("BadRequest", BadRequestError, "Bad request, or a referenced resource does not exist")
+		Error("NotFound", NotFoundError, "Project not found")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			PUT("/projects/{uid}/associations")
+			Params(func() {
+				Param("version:v")
+				Param("uid")
+			})
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+})
@@ -0,0 +1,93 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package design
+
+import (
+	//nolint:staticcheck // ST1001: the recommended way of using the goa GSL package is with the . import
+	. "goa.design/goa/v3/dsl"
+)
+
+// UploadProjectCharterPayload is the multipart payload for charter upload.
+var UploadProjectCharterPayload = Type("UploadProjectCharterPayload", func() {
+	Description("Multipart/form-data payload for uploading a project charter.")
+	Attribute("file", Bytes, "PDF charter file contents", func() {
+		Example([]byte("..."))
+	})
+	Attribute("file_name", String, "Original file name including extension", func() {
+		Example("charter.pdf")
+	})
+	Required("file")
+})
+
+var _ = Service("project-service", func() {
+	Method("upload-project-charter", func() {
+		Description("Upload a new version of a project's charter (multipart/form-data). The PDF is stored in S3, added to the charter's version history, and the project's charter_url is set to it.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			XSyncAttribute()
+			VersionAttribute()
+			ProjectUIDAttribute()
+			Extend(UploadProjectCharterPayload)
+			Required("uid")
+		})
+
+		Result(ProjectCharter)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("NotFound", NotFoundError, "Project not found")
+		Error("Conflict", ConflictError, "Concurrent charter uploads")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			PUT("/projects/{uid}/charter")
+			Params(func() {
+				Param("version:v")
+				Param("uid")
+			})
+			Header("bearer_token:Authorization")
+			Header("x_sync:X-Sync")
+			MultipartRequest()
+			Response(StatusOK)
+		})
+	})
+
+	Method("get-project-charter", func() {
+		Description("Get a project's charter and its version history.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			ProjectUIDAttribute()
+			Required("uid")
+		})
+
+		Result(ProjectCharter)
+
+		Error("NotFound", NotFoundError, "Project or charter not found")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			GET("/projects/{uid}/charter")
+			Params(func() {
+				Param("version:v")
+				Param("uid")
+			})
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+})
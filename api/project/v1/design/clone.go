@@ -0,0 +1,56 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package design
+
+import (
+	//nolint:staticcheck // ST1001: the recommended way of using the goa GSL package is with the . import
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("project-service", func() {
+	Method("clone-project", func() {
+		Description("Create a new project with a copy of an existing project's base and settings. The copy gets a new UID, the given slug and new timestamps; its legacy ID and announcement date are cleared. Fields set in the body override the copied values.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			XSyncAttribute()
+			VersionAttribute()
+			ProjectUIDAttribute()
+			ProjectSlugAttribute()
+			ProjectNameAttribute()
+			ProjectDescriptionAttribute()
+			ProjectPublicAttribute()
+			ProjectParentUIDAttribute()
+			ProjectStageAttribute()
+			ProjectCategoryAttribute()
+			ProjectMissionStatementAttribute()
+			Required("uid", "slug")
+		})
+
+		Result(ProjectFull)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("NotFound", NotFoundError, "Project not found")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("Conflict", ConflictError, "Conflict")
+		Error("UnprocessableEntity", UnprocessableEntityError, "Unprocessable entity")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			POST("/projects/{uid}/clone")
+			Params(func() {
+				Param("version:v")
+				Param("uid")
+			})
+			Header("bearer_token:Authorization")
+			Header("x_sync:X-Sync")
+			Response(StatusCreated)
+		})
+	})
+})
@@ -0,0 +1,45 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package design
+
+import (
+	//nolint:staticcheck // ST1001: the recommended way of using the goa GSL package is with the . import
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("project-service", func() {
+	Method("list-project-deletions", func() {
+		Description("List the tombstones of deleted projects, oldest first, so that downstream caches can tell a deleted project from one that never existed. Tombstones are kept for the retention of the deletions bucket. Only LF staff and trusted service principals may list them.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			Attribute("since", String, "Only list projects deleted at or after this time", func() {
+				Format(FormatDateTime)
+				Example("2024-01-01T00:00:00Z")
+			})
+		})
+
+		Result(ProjectDeletionList)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			GET("/projects/deletions")
+			Params(func() {
+				Param("version:v")
+				Param("since")
+			})
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+})
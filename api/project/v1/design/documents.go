@@ -30,7 +30,9 @@ var _ = Service("project-service", func() {
 	Method("upload-project-document", func() {
 		Description("Upload a new document for a project (multipart/form-data).")
 
-		Security(JWTAuth)
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
 
 		Payload(func() {
 			BearerTokenAttribute()
@@ -46,6 +48,7 @@ var _ = Service("project-service", func() {
 		Error("BadRequest", BadRequestError, "Bad request")
 		Error("NotFound", NotFoundError, "Project not found")
 		Error("Conflict", ConflictError, "Document name already exists")
+		Error("Forbidden", ForbiddenError, "Forbidden")
 		Error("InternalServerError", InternalServerError, "Internal server error")
 		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
 
@@ -59,18 +62,15 @@ var _ = Service("project-service", func() {
 			Header("x_sync:X-Sync")
 			MultipartRequest()
 			Response(StatusCreated)
-			Response("BadRequest", StatusBadRequest)
-			Response("NotFound", StatusNotFound)
-			Response("Conflict", StatusConflict)
-			Response("InternalServerError", StatusInternalServerError)
-			Response("ServiceUnavailable", StatusServiceUnavailable)
 		})
 	})
 
 	Method("get-project-document", func() {
 		Description("Get project document metadata.")
 
-		Security(JWTAuth)
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
 
 		Payload(func() {
 			BearerTokenAttribute()
@@ -87,6 +87,7 @@ var _ = Service("project-service", func() {
 		})
 
 		Error("NotFound", NotFoundError, "Resource not found")
+		Error("Forbidden", ForbiddenError, "Forbidden")
 		Error("InternalServerError", InternalServerError, "Internal server error")
 		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
 
@@ -102,16 +103,15 @@ var _ = Service("project-service", func() {
 				Body("document")
 				Header("etag:ETag")
 			})
-			Response("NotFound", StatusNotFound)
-			Response("InternalServerError", StatusInternalServerError)
-			Response("ServiceUnavailable", StatusServiceUnavailable)
 		})
 	})
 
 	Method("download-project-document", func() {
 		Description("Download the binary file of a project document.")
 
-		Security(JWTAuth)
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
 
 		Payload(func() {
 			BearerTokenAttribute()
@@ -122,6 +122,7 @@ var _ = Service("project-service", func() {
 		})
 
 		Error("NotFound", NotFoundError, "Resource not found")
+		Error("Forbidden", ForbiddenError, "Forbidden")
 		Error("InternalServerError", InternalServerError, "Internal server error")
 		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
 
@@ -135,21 +136,21 @@ var _ = Service("project-service", func() {
 			Header("bearer_token:Authorization")
 			SkipResponseBodyEncodeDecode()
 			Response(StatusOK)
-			Response("NotFound", StatusNotFound)
-			Response("InternalServerError", StatusInternalServerError)
-			Response("ServiceUnavailable", StatusServiceUnavailable)
 		})
 	})
 
 	Method("delete-project-document", func() {
 		Description("Delete a project document.")
 
-		Security(JWTAuth)
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
 
 		Payload(func() {
 			BearerTokenAttribute()
 			XSyncAttribute()
 			IfMatchAttribute()
+			ForceUpdateAttribute()
 			VersionAttribute()
 			ProjectUIDAttribute()
 			ResourceUIDAttribute("document_uid", "Document UID")
@@ -159,6 +160,7 @@ var _ = Service("project-service", func() {
 		Error("BadRequest", BadRequestError, "Bad request")
 		Error("NotFound", NotFoundError, "Resource not found")
 		Error("Conflict", ConflictError, "Revision mismatch")
+		Error("Forbidden", ForbiddenError, "Forbidden")
 		Error("InternalServerError", InternalServerError, "Internal server error")
 		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
 
@@ -172,12 +174,8 @@ var _ = Service("project-service", func() {
 			Header("bearer_token:Authorization")
 			Header("x_sync:X-Sync")
 			Header("if_match:If-Match")
+			Header("force_update:X-Force-Update")
 			Response(StatusNoContent)
-			Response("BadRequest", StatusBadRequest)
-			Response("NotFound", StatusNotFound)
-			Response("Conflict", StatusConflict)
-			Response("InternalServerError", StatusInternalServerError)
-			Response("ServiceUnavailable", StatusServiceUnavailable)
 		})
 	})
 })
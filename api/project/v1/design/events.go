@@ -0,0 +1,49 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package design
+
+import (
+	//nolint:staticcheck // ST1001: the recommended way of using the goa GSL package is with the . import
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("project-service", func() {
+	Method("list-project-events", func() {
+		Description("List the lifecycle events of a project, oldest first, reconstructed from the revisions of its base and settings still retained in the project store, so that a new downstream consumer can backfill its state. Only project.created, project.updated, project.deleted and project.settings.updated events are reconstructed, without their actor.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			ProjectUIDAttribute()
+			Attribute("since", String, "Only list events that occurred at or after this time", func() {
+				Format(FormatDateTime)
+				Example("2024-01-01T00:00:00Z")
+			})
+			Required("uid")
+		})
+
+		Result(ProjectEventList)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("NotFound", NotFoundError, "Project has no retained history")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			GET("/projects/{uid}/events")
+			Params(func() {
+				Param("version:v")
+				Param("uid")
+				Param("since")
+			})
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+})
@@ -12,7 +12,9 @@ var _ = Service("project-service", func() {
 	Method("create-project-folder", func() {
 		Description("Create a new folder for a project.")
 
-		Security(JWTAuth)
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
 
 		Payload(func() {
 			BearerTokenAttribute()
@@ -28,6 +30,7 @@ var _ = Service("project-service", func() {
 		Error("BadRequest", BadRequestError, "Bad request")
 		Error("NotFound", NotFoundError, "Project not found")
 		Error("Conflict", ConflictError, "Folder name already exists")
+		Error("Forbidden", ForbiddenError, "Forbidden")
 		Error("InternalServerError", InternalServerError, "Internal server error")
 		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
 
@@ -40,18 +43,15 @@ var _ = Service("project-service", func() {
 			Header("bearer_token:Authorization")
 			Header("x_sync:X-Sync")
 			Response(StatusCreated)
-			Response("BadRequest", StatusBadRequest)
-			Response("NotFound", StatusNotFound)
-			Response("Conflict", StatusConflict)
-			Response("InternalServerError", StatusInternalServerError)
-			Response("ServiceUnavailable", StatusServiceUnavailable)
 		})
 	})
 
 	Method("get-project-folder", func() {
 		Description("Get a single project folder.")
 
-		Security(JWTAuth)
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
 
 		Payload(func() {
 			BearerTokenAttribute()
@@ -68,6 +68,7 @@ var _ = Service("project-service", func() {
 		})
 
 		Error("NotFound", NotFoundError, "Resource not found")
+		Error("Forbidden", ForbiddenError, "Forbidden")
 		Error("InternalServerError", InternalServerError, "Internal server error")
 		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
 
@@ -83,21 +84,21 @@ var _ = Service("project-service", func() {
 				Body("folder")
 				Header("etag:ETag")
 			})
-			Response("NotFound", StatusNotFound)
-			Response("InternalServerError", StatusInternalServerError)
-			Response("ServiceUnavailable", StatusServiceUnavailable)
 		})
 	})
 
 	Method("delete-project-folder", func() {
 		Description("Delete a project folder. The folder must be empty.")
 
-		Security(JWTAuth)
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
 
 		Payload(func() {
 			BearerTokenAttribute()
 			XSyncAttribute()
 			IfMatchAttribute()
+			ForceUpdateAttribute()
 			VersionAttribute()
 			ProjectUIDAttribute()
 			ResourceUIDAttribute("folder_uid", "Folder UID")
@@ -107,6 +108,7 @@ var _ = Service("project-service", func() {
 		Error("BadRequest", BadRequestError, "Bad request")
 		Error("NotFound", NotFoundError, "Resource not found")
 		Error("Conflict", ConflictError, "Folder not empty or revision mismatch")
+		Error("Forbidden", ForbiddenError, "Forbidden")
 		Error("InternalServerError", InternalServerError, "Internal server error")
 		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
 
@@ -120,12 +122,8 @@ var _ = Service("project-service", func() {
 			Header("bearer_token:Authorization")
 			Header("x_sync:X-Sync")
 			Header("if_match:If-Match")
+			Header("force_update:X-Force-Update")
 			Response(StatusNoContent)
-			Response("BadRequest", StatusBadRequest)
-			Response("NotFound", StatusNotFound)
-			Response("Conflict", StatusConflict)
-			Response("InternalServerError", StatusInternalServerError)
-			Response("ServiceUnavailable", StatusServiceUnavailable)
 		})
 	})
 })
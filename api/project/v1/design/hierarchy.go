@@ -0,0 +1,147 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package design
+
+import (
+	//nolint:staticcheck // ST1001: the recommended way of using the goa GSL package is with the . import
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("project-service", func() {
+	Method("get-project-children", func() {
+		Description("List the direct children of a project one page at a time. Children the principal cannot view are left out.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			ProjectUIDAttribute()
+			ProjectPageAttributes()
+			Required("uid")
+		})
+
+		Result(ProjectPage)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("NotFound", NotFoundError, "Project not found")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			GET("/projects/{uid}/children")
+			Params(func() {
+				Param("version:v")
+				Param("uid")
+				ProjectPageParams()
+			})
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("get-project-descendants", func() {
+		Description("List every project under a project, at any depth, one page at a time. Descendants the principal cannot view are left out.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			ProjectUIDAttribute()
+			ProjectPageAttributes()
+			Required("uid")
+		})
+
+		Result(ProjectPage)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("NotFound", NotFoundError, "Project not found")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			GET("/projects/{uid}/descendants")
+			Params(func() {
+				Param("version:v")
+				Param("uid")
+				ProjectPageParams()
+			})
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("get-project-legal-lineage", func() {
+		Description("Get the chain of legal parents of a project for compliance reporting: its legal parent, that project's legal parent, and so on up to a project without one.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			ProjectUIDAttribute()
+			Required("uid")
+		})
+
+		Result(ProjectLegalLineage)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("NotFound", NotFoundError, "Project not found")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			GET("/projects/{uid}/legal-lineage")
+			Params(func() {
+				Param("version:v")
+				Param("uid")
+			})
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+})
+
+// ProjectPageAttributes are the sort, order and pagination attributes of a
+// paginated list of projects.
+func ProjectPageAttributes() {
+	Attribute("sort", String, "Field the projects are sorted by; ties are broken by UID", func() {
+		Enum("name", "created_at", "stage")
+		Default("name")
+		Example("name")
+	})
+	Attribute("order", String, "Sort order", func() {
+		Enum("asc", "desc")
+		Default("asc")
+		Example("asc")
+	})
+	Attribute("page_size", Int, "Maximum number of projects to return", func() {
+		Minimum(1)
+		Maximum(100)
+		Default(50)
+		Example(50)
+	})
+	Attribute("page_token", String, "The next_page_token of the previous page; it must be used with the same sort and order", func() {
+		MaxLength(1024)
+		Example("eyJzIjoibmFtZSIsIm8iOiJhc2MiLCJrIjoia3ViZXJuZXRlcyIsInUiOiI3Y2FkNWE4ZCJ9")
+	})
+}
+
+// ProjectPageParams maps the ProjectPageAttributes to query parameters.
+func ProjectPageParams() {
+	Param("sort")
+	Param("order")
+	Param("page_size")
+	Param("page_token")
+}
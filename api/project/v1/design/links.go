@@ -12,7 +12,9 @@ var _ = Service("project-service", func() {
 	Method("create-project-link", func() {
 		Description("Create a new link for a project.")
 
-		Security(JWTAuth)
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
 
 		Payload(func() {
 			BearerTokenAttribute()
@@ -33,6 +35,7 @@ var _ = Service("project-service", func() {
 
 		Error("BadRequest", BadRequestError, "Bad request")
 		Error("NotFound", NotFoundError, "Project not found")
+		Error("Forbidden", ForbiddenError, "Forbidden")
 		Error("InternalServerError", InternalServerError, "Internal server error")
 		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
 
@@ -45,17 +48,15 @@ var _ = Service("project-service", func() {
 			Header("bearer_token:Authorization")
 			Header("x_sync:X-Sync")
 			Response(StatusCreated)
-			Response("BadRequest", StatusBadRequest)
-			Response("NotFound", StatusNotFound)
-			Response("InternalServerError", StatusInternalServerError)
-			Response("ServiceUnavailable", StatusServiceUnavailable)
 		})
 	})
 
 	Method("get-project-link", func() {
 		Description("Get a single project link.")
 
-		Security(JWTAuth)
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
 
 		Payload(func() {
 			BearerTokenAttribute()
@@ -72,6 +73,7 @@ var _ = Service("project-service", func() {
 		})
 
 		Error("NotFound", NotFoundError, "Resource not found")
+		Error("Forbidden", ForbiddenError, "Forbidden")
 		Error("InternalServerError", InternalServerError, "Internal server error")
 		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
 
@@ -87,21 +89,21 @@ var _ = Service("project-service", func() {
 				Body("link")
 				Header("etag:ETag")
 			})
-			Response("NotFound", StatusNotFound)
-			Response("InternalServerError", StatusInternalServerError)
-			Response("ServiceUnavailable", StatusServiceUnavailable)
 		})
 	})
 
 	Method("delete-project-link", func() {
 		Description("Delete a project link.")
 
-		Security(JWTAuth)
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
 
 		Payload(func() {
 			BearerTokenAttribute()
 			XSyncAttribute()
 			IfMatchAttribute()
+			ForceUpdateAttribute()
 			VersionAttribute()
 			ProjectUIDAttribute()
 			ResourceUIDAttribute("link_uid", "Link UID")
@@ -111,6 +113,7 @@ var _ = Service("project-service", func() {
 		Error("BadRequest", BadRequestError, "Bad request")
 		Error("NotFound", NotFoundError, "Resource not found")
 		Error("Conflict", ConflictError, "Revision mismatch")
+		Error("Forbidden", ForbiddenError, "Forbidden")
 		Error("InternalServerError", InternalServerError, "Internal server error")
 		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
 
@@ -124,12 +127,8 @@ var _ = Service("project-service", func() {
 			Header("bearer_token:Authorization")
 			Header("x_sync:X-Sync")
 			Header("if_match:If-Match")
+			Header("force_update:X-Force-Update")
 			Response(StatusNoContent)
-			Response("BadRequest", StatusBadRequest)
-			Response("NotFound", StatusNotFound)
-			Response("Conflict", StatusConflict)
-			Response("InternalServerError", StatusInternalServerError)
-			Response("ServiceUnavailable", StatusServiceUnavailable)
 		})
 	})
 })
@@ -0,0 +1,60 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package design
+
+import (
+	//nolint:staticcheck // ST1001: the recommended way of using the goa GSL package is with the . import
+	. "goa.design/goa/v3/dsl"
+)
+
+// UploadProjectLogoPayload is the multipart payload for logo upload.
+var UploadProjectLogoPayload = Type("UploadProjectLogoPayload", func() {
+	Description("Multipart/form-data payload for uploading a project logo.")
+	Attribute("file", Bytes, "SVG or PNG logo file contents", func() {
+		Example([]byte("..."))
+	})
+	Attribute("content_type", String, "MIME type of the file; SVG logos must be sent as image/svg+xml", func() {
+		Example("image/svg+xml")
+	})
+	Required("file")
+})
+
+var _ = Service("project-service", func() {
+	Method("upload-project-logo", func() {
+		Description("Upload a project logo (multipart/form-data). The SVG or PNG file is stored in S3 and the project's logo_url is set to it; SVG logos are also converted to PNG.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			XSyncAttribute()
+			VersionAttribute()
+			ProjectUIDAttribute()
+			Extend(UploadProjectLogoPayload)
+			Required("uid")
+		})
+
+		Result(ProjectBase)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("NotFound", NotFoundError, "Project not found")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			POST("/projects/{uid}/logo")
+			Params(func() {
+				Param("version:v")
+				Param("uid")
+			})
+			Header("bearer_token:Authorization")
+			Header("x_sync:X-Sync")
+			MultipartRequest()
+			Response(StatusOK)
+		})
+	})
+})
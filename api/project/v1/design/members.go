@@ -0,0 +1,168 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package design
+
+import (
+	//nolint:staticcheck // ST1001: the recommended way of using the goa GSL package is with the . import
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("project-service", func() {
+	ProjectMemberMethods("writer", "writers")
+	ProjectMemberMethods("auditor", "auditors")
+
+	Method("join-project", func() {
+		Description("Join a project that has autojoin enabled. The requesting user is added to the project's member role configured for autojoin, the auditors by default. Joining a project the user already holds that role in changes nothing.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			XSyncAttribute()
+			VersionAttribute()
+			ProjectUIDAttribute()
+			Required("uid")
+		})
+
+		Result(ProjectJoinResult)
+
+		Error("BadRequest", BadRequestError, "Bad request, or the requesting principal is not a registered user")
+		Error("Forbidden", ForbiddenError, "Forbidden, or autojoin is disabled for the project")
+		Error("NotFound", NotFoundError, "Project not found")
+		Error("Conflict", ConflictError, "Too many concurrent updates")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			POST("/projects/{uid}/join")
+			Params(func() {
+				Param("version:v")
+				Param("uid")
+			})
+			Header("bearer_token:Authorization")
+			Header("x_sync:X-Sync")
+			Response(StatusOK)
+		})
+	})
+
+	Method("bulk-update-project-members", func() {
+		Description("Add users to and remove users from the writers and auditors of several projects, for LF staff on-boarding or off-boarding a user across projects. Each project is updated on its own and reported with its outcome; a project that fails does not stop the others.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			XSyncAttribute()
+			VersionAttribute()
+			Attribute("operations", ArrayOf(ProjectMembersOperation), "The changes to apply, at most one per project", func() {
+				MinLength(1)
+				MaxLength(100)
+			})
+			Required("operations")
+		})
+
+		Result(BulkProjectMembersResult)
+
+		Error("BadRequest", BadRequestError, "Bad request, or a user to add is not a registered user")
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			POST("/projects/settings/bulk-update")
+			Params(func() {
+				Param("version:v")
+			})
+			Header("bearer_token:Authorization")
+			Header("x_sync:X-Sync")
+			Response(StatusOK)
+		})
+	})
+})
+
+// ProjectMemberMethods defines the methods that add a user to and remove a user
+// from one of the user lists in a project's settings, such as the writers.
+func ProjectMemberMethods(role, list string) {
+	Method("add-project-"+role, func() {
+		Description("Add a user to the project's " + list + ". Only that user is changed, so concurrent additions do not overwrite each other. Adding a user who is already one of the " + list + " changes nothing. With If-Match, the user is only added if the settings are still at that revision.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			XSyncAttribute()
+			IfMatchAttribute()
+			VersionAttribute()
+			ProjectUIDAttribute()
+			ProjectMemberUsernameAttribute()
+			Required("uid", "username")
+		})
+
+		Result(ProjectSettings)
+
+		Error("BadRequest", BadRequestError, "Bad request, or no user has this username")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("NotFound", NotFoundError, "Project not found")
+		Error("Conflict", ConflictError, "Too many concurrent updates, or revision mismatch")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			POST("/projects/{uid}/" + list + "/{username}")
+			Params(func() {
+				Param("version:v")
+				Param("uid")
+				Param("username")
+			})
+			Header("bearer_token:Authorization")
+			Header("x_sync:X-Sync")
+			Header("if_match:If-Match")
+			Response(StatusOK)
+		})
+	})
+
+	Method("remove-project-"+role, func() {
+		Description("Remove a user from the project's " + list + ". Only that user is changed, so concurrent removals do not overwrite each other. With If-Match, the user is only removed if the settings are still at that revision.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			XSyncAttribute()
+			IfMatchAttribute()
+			VersionAttribute()
+			ProjectUIDAttribute()
+			ProjectMemberUsernameAttribute()
+			Required("uid", "username")
+		})
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("NotFound", NotFoundError, "Project not found, or the user is not one of the "+list)
+		Error("Conflict", ConflictError, "Too many concurrent updates, or revision mismatch")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			DELETE("/projects/{uid}/" + list + "/{username}")
+			Params(func() {
+				Param("version:v")
+				Param("uid")
+				Param("username")
+			})
+			Header("bearer_token:Authorization")
+			Header("x_sync:X-Sync")
+			Header("if_match:If-Match")
+			Response(StatusNoContent)
+		})
+	})
+}
@@ -0,0 +1,57 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package design
+
+import (
+	//nolint:staticcheck // ST1001: the recommended way of using the goa GSL package is with the . import
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("project-service", func() {
+	Method("merge-project", func() {
+		Description("Merge a project into another one, such as a duplicate created by an import. The children of the project are moved under the target, its writers and auditors are added to the target's, its slug redirects to the target, and the project is deleted. Returns the target project.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			XSyncAttribute()
+			IfMatchAttribute()
+			ForceUpdateAttribute()
+			VersionAttribute()
+			ProjectUIDAttribute()
+			Attribute("target_uid", String, "UID of the project to merge the project into", func() {
+				Example("4b1f3e9c-2d7a-4c1b-9e8f-0a1b2c3d4e5f")
+				Format(FormatUUID)
+			})
+			Required("uid", "target_uid")
+		})
+
+		Result(ProjectFull)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("NotFound", NotFoundError, "Project not found")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("Conflict", ConflictError, "Revision mismatch")
+		Error("UnprocessableEntity", UnprocessableEntityError, "Unprocessable entity")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			POST("/projects/{uid}/merge-into/{target_uid}")
+			Params(func() {
+				Param("version:v")
+				Param("uid")
+				Param("target_uid")
+			})
+			Header("bearer_token:Authorization")
+			Header("x_sync:X-Sync")
+			Header("if_match:If-Match")
+			Header("force_update:X-Force-Update")
+			Response(StatusOK)
+		})
+	})
+})
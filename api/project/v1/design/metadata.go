@@ -0,0 +1,62 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package design
+
+import (
+	//nolint:staticcheck // ST1001: the recommended way of using the goa GSL package is with the . import
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("project-service", func() {
+	Method("get-project-enums", func() {
+		Description("Get the values currently allowed for the enumerated project fields, stage and category. Admins change them without a release of the service.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+		})
+
+		Result(ProjectEnums)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			GET("/metadata/enums")
+			Params(func() {
+				Param("version:v")
+			})
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("update-project-enums", func() {
+		Description("Replace the values allowed for the enumerated project fields, for LF staff. Projects that already have a value no longer allowed keep it until the field is changed.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+			ProjectEnumsAttributes()
+			Required("stages", "categories")
+		})
+		Result(ProjectEnums)
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			PUT("/admin/metadata/enums")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+})
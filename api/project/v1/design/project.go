@@ -12,11 +12,39 @@ import (
 // JWTAuth is the DSL JWT security type for authentication.
 var JWTAuth = JWTSecurity("jwt", func() {
 	Description("Heimdall authorization")
+	Scope(ScopeRead, "Read projects and their settings, documents, links and folders")
+	Scope(ScopeWrite, "Create, update and delete projects and their settings, documents, links and folders")
 })
 
+// Scopes required of machine-to-machine clients. Tokens issued to users are
+// not limited by scope.
+const (
+	ScopeRead  = "projects:read"
+	ScopeWrite = "projects:write"
+)
+
 var _ = API("lfx-v2-project-service", func() {
 	Title("LFX V2 - Project Service")
 	Description("Create, manage, update, and delete LFX project resources")
+
+	// Every error is answered as a problem details document (RFC 7807), with
+	// the same status code whichever method returns it.
+	Error("BadRequest", BadRequestError, "Bad request")
+	Error("Forbidden", ForbiddenError, "Forbidden")
+	Error("NotFound", NotFoundError, "Resource not found")
+	Error("Conflict", ConflictError, "Conflict")
+	Error("UnprocessableEntity", UnprocessableEntityError, "Unprocessable entity")
+	Error("InternalServerError", InternalServerError, "Internal server error")
+	Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+	HTTP(func() {
+		Response("BadRequest", StatusBadRequest, ProblemResponse)
+		Response("Forbidden", StatusForbidden, ProblemResponse)
+		Response("NotFound", StatusNotFound, ProblemResponse)
+		Response("Conflict", StatusConflict, ProblemResponse)
+		Response("UnprocessableEntity", StatusUnprocessableEntity, ProblemResponse)
+		Response("InternalServerError", StatusInternalServerError, ProblemResponse)
+		Response("ServiceUnavailable", StatusServiceUnavailable, ProblemResponse)
+	})
 })
 
 var _ = Service("project-service", func() {
@@ -24,50 +52,67 @@ var _ = Service("project-service", func() {
 
 	// TODO: delete this endpoint once the query service is implemented
 	Method("get-projects", func() {
-		Description("Get all projects.")
+		Description("Get all projects. With v=2, the settings of each project are nested under settings instead of merged into the project.")
 
-		Security(JWTAuth)
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
 
 		Payload(func() {
 			BearerTokenAttribute()
-			VersionAttribute()
+			VersionAttribute("1", "2")
+			Attribute("tag", ArrayOf(String), "Only return projects that have all of these tags", func() {
+				Example([]string{"cncf"})
+			})
+			FieldsAttribute()
+			Attribute("base_only", Boolean, "Only return the base fields of each project, without reading the settings", func() {
+				Default(false)
+				Example(false)
+			})
 		})
 
 		Result(func() {
 			Attribute("projects", ArrayOf(ProjectFull), "Resources found", func() {})
-			Attribute("cache_control", String, "Cache control header", func() {
-				Example("public, max-age=300")
-			})
+			CacheControlAttribute()
 			Required("projects")
 		})
 
 		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Forbidden", ForbiddenError, "Forbidden")
 		Error("InternalServerError", InternalServerError, "Internal server error")
 		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
 
 		HTTP(func() {
 			GET("/projects")
 			Param("version:v")
+			Param("tag")
+			Param("fields")
+			Param("base_only")
 			Header("bearer_token:Authorization")
 			Response(StatusOK, func() {
 				Header("cache_control:Cache-Control")
 			})
-			Response("BadRequest", StatusBadRequest)
-			Response("InternalServerError", StatusInternalServerError)
-			Response("ServiceUnavailable", StatusServiceUnavailable)
 		})
 	})
 
 	Method("create-project", func() {
 		Description("Create a new project.")
 
-		Security(JWTAuth)
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
 
 		Payload(func() {
 			BearerTokenAttribute()
 			XSyncAttribute()
 			VersionAttribute()
-			ProjectSlugAttribute()
+			AllowDuplicatesAttribute()
+			ProjectTemplateAttribute()
+			Attribute("slug", String, "Project slug, a short slugified name of the project; generated from the name when unset", func() {
+				Example("project-slug")
+				Format(FormatRegexp)
+				Pattern(`^[a-z][a-z0-9_\-]*[a-z0-9]$`)
+			})
 			ProjectDescriptionAttribute()
 			ProjectNameAttribute()
 			ProjectPublicAttribute()
@@ -88,8 +133,15 @@ var _ = Service("project-service", func() {
 			ProjectLogoURLAttribute()
 			ProjectRepositoryURLAttribute()
 			ProjectWebsiteURLAttribute()
+			ProjectLinksAttribute()
+			ProjectAnnotationsAttribute()
+			ProjectNameI18nAttribute()
+			ProjectDescriptionI18nAttribute()
+			ProjectLegacyIDAttribute()
+			ProjectTagsAttribute()
 			ProjectAnnouncementDateAttribute()
 			ProjectMissionStatementAttribute()
+			ProjectOverviewHTMLAttribute()
 			ProjectWritersAttribute()
 			ProjectMeetingCoordinatorsAttribute()
 			ProjectAuditorsAttribute()
@@ -98,48 +150,62 @@ var _ = Service("project-service", func() {
 			ProjectOpportunityOwnerAttribute()
 
 			// TODO: figure out what the required attributes are for projects
-			// Same requirements apply to PUT endpoints.
-			Required("slug", "description", "name", "parent_uid")
+			// Same requirements apply to PUT endpoints, except for the slug,
+			// which is generated from the name on create.
+			Required("description", "name", "parent_uid")
 		})
 
 		Result(ProjectFull)
 
 		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Forbidden", ForbiddenError, "Forbidden")
 		Error("Conflict", ConflictError, "Conflict")
+		Error("UnprocessableEntity", UnprocessableEntityError, "Unprocessable entity")
 		Error("InternalServerError", InternalServerError, "Internal server error")
 		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
 
 		HTTP(func() {
 			POST("/projects")
 			Param("version:v")
+			Param("allow_duplicates")
+			Param("template")
 			Header("bearer_token:Authorization")
 			Header("x_sync:X-Sync")
 			Response(StatusCreated)
-			Response("BadRequest", StatusBadRequest)
-			Response("Conflict", StatusConflict)
-			Response("InternalServerError", StatusInternalServerError)
-			Response("ServiceUnavailable", StatusServiceUnavailable)
 		})
 	})
 
 	Method("get-one-project-base", func() {
-		Description("Get a single project's base information.")
+		Description("Get a single project's base information. With expand=settings, the project's settings are merged into it, as in get-projects, and their ETag is returned in X-Settings-ETag.")
 
-		Security(JWTAuth)
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
 
 		Payload(func() {
 			BearerTokenAttribute()
 			VersionAttribute()
 			ProjectUIDAttribute()
+			FieldsAttribute()
+			Attribute("expand", String, "Related resources to include in the project", func() {
+				Enum("settings")
+				Example("settings")
+			})
 		})
 
 		Result(func() {
-			Attribute("project", ProjectBase)
+			Attribute("project", ProjectFull, "The project; only the base fields unless expand=settings")
 			EtagAttribute()
+			CacheControlAttribute()
+			Attribute("settings_etag", String, "X-Settings-ETag header value: the ETag of the settings, with expand=settings", func() {
+				Example("45")
+			})
 			Required("project")
 		})
 
+		Error("BadRequest", BadRequestError, "Bad request")
 		Error("NotFound", NotFoundError, "Resource not found")
+		Error("Forbidden", ForbiddenError, "Forbidden")
 		Error("InternalServerError", InternalServerError, "Internal server error")
 		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
 
@@ -147,21 +213,58 @@ var _ = Service("project-service", func() {
 			GET("/projects/{uid}")
 			Param("version:v")
 			Param("uid")
+			Param("fields")
+			Param("expand")
 			Header("bearer_token:Authorization")
 			Response(StatusOK, func() {
 				Body("project")
 				Header("etag:ETag")
+				Header("cache_control:Cache-Control")
+				Header("settings_etag:X-Settings-ETag")
 			})
-			Response("NotFound", StatusNotFound)
-			Response("InternalServerError", StatusInternalServerError)
-			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("get-project-uid-from-legacy-id", func() {
+		Description("Resolve a project's LFX v1 ID to its v2 UID.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			ProjectLegacyIDAttribute()
+			Required("legacy_id")
+		})
+
+		Result(func() {
+			ProjectUIDAttribute()
+			ProjectLegacyIDAttribute()
+			Required("uid", "legacy_id")
+		})
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("NotFound", NotFoundError, "Resource not found")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			GET("/projects/legacy/{legacy_id}")
+			Param("version:v")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
 		})
 	})
 
 	Method("get-one-project-settings", func() {
 		Description("Get a single project's settings.")
 
-		Security(JWTAuth)
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
 
 		Payload(func() {
 			BearerTokenAttribute()
@@ -172,10 +275,12 @@ var _ = Service("project-service", func() {
 		Result(func() {
 			Attribute("project_settings", ProjectSettings)
 			EtagAttribute()
+			CacheControlAttribute()
 			Required("project_settings")
 		})
 
 		Error("NotFound", NotFoundError, "Resource not found")
+		Error("Forbidden", ForbiddenError, "Forbidden")
 		Error("InternalServerError", InternalServerError, "Internal server error")
 		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
 
@@ -187,22 +292,24 @@ var _ = Service("project-service", func() {
 			Response(StatusOK, func() {
 				Body("project_settings")
 				Header("etag:ETag")
+				Header("cache_control:Cache-Control")
 			})
-			Response("NotFound", StatusNotFound)
-			Response("InternalServerError", StatusInternalServerError)
-			Response("ServiceUnavailable", StatusServiceUnavailable)
 		})
 	})
 
 	Method("update-project-base", func() {
 		Description("Update an existing project's base information.")
 
-		Security(JWTAuth)
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
 
 		Payload(func() {
 			BearerTokenAttribute()
 			XSyncAttribute()
 			IfMatchAttribute()
+			ForceUpdateAttribute()
+			AdminOverrideAttribute()
 			VersionAttribute()
 			ProjectUIDAttribute()
 			ProjectSlugAttribute()
@@ -226,14 +333,22 @@ var _ = Service("project-service", func() {
 			ProjectLogoURLAttribute()
 			ProjectRepositoryURLAttribute()
 			ProjectWebsiteURLAttribute()
+			ProjectLinksAttribute()
+			ProjectAnnotationsAttribute()
+			ProjectNameI18nAttribute()
+			ProjectDescriptionI18nAttribute()
+			ProjectLegacyIDAttribute()
+			ProjectTagsAttribute()
 			Required("slug", "description", "name", "parent_uid")
 		})
 
 		Result(ProjectBase)
 
 		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Forbidden", ForbiddenError, "Forbidden")
 		Error("NotFound", NotFoundError, "Resource not found")
 		Error("Conflict", ConflictError, "Conflict")
+		Error("UnprocessableEntity", UnprocessableEntityError, "Unprocessable entity")
 		Error("InternalServerError", InternalServerError, "Internal server error")
 		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
 
@@ -246,27 +361,29 @@ var _ = Service("project-service", func() {
 			Header("bearer_token:Authorization")
 			Header("x_sync:X-Sync")
 			Header("if_match:If-Match")
+			Header("force_update:X-Force-Update")
+			Header("admin_override:X-Admin-Override")
 			Response(StatusOK)
-			Response("BadRequest", StatusBadRequest)
-			Response("NotFound", StatusNotFound)
-			Response("Conflict", StatusConflict)
-			Response("InternalServerError", StatusInternalServerError)
-			Response("ServiceUnavailable", StatusServiceUnavailable)
 		})
 	})
 
 	Method("update-project-settings", func() {
-		Description("Update an existing project's settings.")
+		Description("Update an existing project's settings. With X-Merge, an update whose If-Match revision is no longer current is merged with the changes made since that revision when they change other fields or other users of the role lists.")
 
-		Security(JWTAuth)
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
 
 		Payload(func() {
 			BearerTokenAttribute()
 			XSyncAttribute()
 			IfMatchAttribute()
+			ForceUpdateAttribute()
+			MergeAttribute()
 			VersionAttribute()
 			ProjectUIDAttribute()
 			ProjectMissionStatementAttribute()
+			ProjectOverviewHTMLAttribute()
 			ProjectAnnouncementDateAttribute()
 			ProjectWritersAttribute()
 			ProjectMeetingCoordinatorsAttribute()
@@ -279,8 +396,10 @@ var _ = Service("project-service", func() {
 		Result(ProjectSettings)
 
 		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Forbidden", ForbiddenError, "Forbidden")
 		Error("NotFound", NotFoundError, "Resource not found")
-		Error("Conflict", ConflictError, "Revision mismatch")
+		Error("Conflict", ConflictError, "Revision mismatch, or conflicting changes when merging")
+		Error("UnprocessableEntity", UnprocessableEntityError, "Unprocessable entity")
 		Error("InternalServerError", InternalServerError, "Internal server error")
 		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
 
@@ -293,30 +412,31 @@ var _ = Service("project-service", func() {
 			Header("bearer_token:Authorization")
 			Header("x_sync:X-Sync")
 			Header("if_match:If-Match")
+			Header("force_update:X-Force-Update")
+			Header("merge:X-Merge")
 			Response(StatusOK)
-			Response("BadRequest", StatusBadRequest)
-			Response("NotFound", StatusNotFound)
-			Response("Conflict", StatusConflict)
-			Response("InternalServerError", StatusInternalServerError)
-			Response("ServiceUnavailable", StatusServiceUnavailable)
 		})
 	})
 
 	Method("delete-project", func() {
 		Description("Delete an existing project.")
 
-		Security(JWTAuth)
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
 
 		Payload(func() {
 			BearerTokenAttribute()
 			XSyncAttribute()
 			IfMatchAttribute()
+			ForceUpdateAttribute()
 			VersionAttribute()
 			ProjectUIDAttribute()
 		})
 
 		Error("NotFound", NotFoundError, "Resource not found")
 		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Forbidden", ForbiddenError, "Forbidden")
 		Error("Conflict", ConflictError, "Revision mismatch")
 		Error("InternalServerError", InternalServerError, "Internal server error")
 		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
@@ -330,12 +450,59 @@ var _ = Service("project-service", func() {
 			Header("bearer_token:Authorization")
 			Header("x_sync:X-Sync")
 			Header("if_match:If-Match")
+			Header("force_update:X-Force-Update")
 			Response(StatusNoContent)
-			Response("NotFound", StatusNotFound)
-			Response("BadRequest", StatusBadRequest)
-			Response("Conflict", StatusConflict)
-			Response("InternalServerError", StatusInternalServerError)
-			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("bulk-delete-projects", func() {
+		Description("Delete several projects at once, such as the test data of a load, for LF staff. The whole set is checked before anything is deleted: a project with children outside the set, or whose funding model is not Crowdfunding only, blocks the deletion. With cascade, the descendants of the projects are deleted too; with force, the funding model is not checked; with dry_run, nothing is deleted. Projects are deleted children first and reported in that order.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			XSyncAttribute()
+			VersionAttribute()
+			Attribute("uids", ArrayOf(String, func() {
+				Format(FormatUUID)
+			}), "UIDs of the projects to delete", func() {
+				MinLength(1)
+				MaxLength(100)
+				Example([]string{"7cad5a8d-19d0-41a4-81a6-043453daf9ee"})
+			})
+			Attribute("dry_run", Boolean, "Report what would be deleted without deleting anything", func() {
+				Default(false)
+			})
+			Attribute("cascade", Boolean, "Also delete every project under the given projects", func() {
+				Default(false)
+			})
+			Attribute("force", Boolean, "Delete projects whose funding model is not Crowdfunding only", func() {
+				Default(false)
+			})
+			Required("uids")
+		})
+
+		Result(BulkProjectDeletionResult)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			DELETE("/projects/bulk")
+			Params(func() {
+				Param("version:v")
+				Param("dry_run")
+				Param("cascade")
+				Param("force")
+			})
+			Header("bearer_token:Authorization")
+			Header("x_sync:X-Sync")
+			Response(StatusOK)
 		})
 	})
 
@@ -351,7 +518,6 @@ var _ = Service("project-service", func() {
 			Response(StatusOK, func() {
 				ContentType("text/plain")
 			})
-			Response("ServiceUnavailable", StatusServiceUnavailable)
 		})
 	})
 
@@ -369,6 +535,272 @@ var _ = Service("project-service", func() {
 		})
 	})
 
+	Method("healthz", func() {
+		Description("Report the health of each dependency of the service.")
+		Meta("swagger:generate", "false")
+		Result(HealthReport)
+		Error("Unhealthy", HealthReport, "One or more dependencies are unhealthy")
+		HTTP(func() {
+			GET("/healthz")
+			Response(StatusOK)
+			Response("Unhealthy", StatusServiceUnavailable)
+		})
+	})
+
+	Method("reconcile-outbox", func() {
+		Description("Publish every pending outbox message now, ignoring retry backoff, for LF staff.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+		})
+		Result(OutboxReconcileResult)
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			POST("/admin/outbox/reconcile")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("resync-projects", func() {
+		Description("Re-publish indexer and update_access messages for every project matching the filter, for LF staff.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+			Attribute("uid_prefix", String, "Only resync projects whose UID starts with this prefix", func() {
+				Example("7cad5a8d")
+			})
+			Attribute("updated_since", String, "Only resync projects whose base or settings were updated at or after this time", func() {
+				Format(FormatDateTime)
+				Example("2025-01-01T00:00:00Z")
+			})
+			ProjectStageAttribute()
+			Attribute("dry_run", Boolean, "Report the matching projects without publishing anything", func() {
+				Default(false)
+			})
+		})
+		Result(ResyncProjectsResult)
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			POST("/admin/resync")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("list-dead-letters", func() {
+		Description("List the NATS events whose handler failed and that are waiting to be replayed, for LF staff.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+		})
+		Result(DeadLetterList)
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			GET("/admin/dead-letters")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("replay-dead-letter", func() {
+		Description("Hand a dead-lettered NATS event back to its handler, removing it if the handler succeeds, for LF staff.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+			Attribute("id", String, "Dead letter ID", func() {
+				Format(FormatUUID)
+				Example("6f3b1c2a-9d4e-4f5a-8b7c-1d2e3f4a5b6c")
+			})
+			Required("id")
+		})
+		Result(ReplayDeadLetterResult)
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("NotFound", NotFoundError, "Dead letter not found")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			POST("/admin/dead-letters/{id}/replay")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("rebuild-slug-mappings", func() {
+		Description("Rebuild the slug to UID mappings from the project documents, reporting the mappings no project holds, for LF staff.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+			Attribute("remove_dangling", Boolean, "Remove the mappings of slugs that no project holds", func() {
+				Default(false)
+			})
+		})
+		Result(SlugRebuildResult)
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			POST("/admin/slug-rebuild")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("get-integrity-report", func() {
+		Description("Get the inconsistencies found in the project store by the last integrity scan, scanning the store when it was not scanned yet, for LF staff.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+		})
+		Result(IntegrityReport)
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			GET("/admin/integrity-report")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("inspect-project-kv", func() {
+		Description("Get the entries stored for a project's base and settings in the NATS KV buckets as they are, with their current revision and retained history, for LF staff debugging stale documents.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+			ProjectUIDAttribute()
+			Required("uid")
+		})
+		Result(ProjectKVInspection)
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("NotFound", NotFoundError, "Project not found")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			GET("/admin/projects/{uid}/kv")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("create-backup", func() {
+		Description("Save every entry of the projects and project-settings stores, including slug mappings, to a new backup archive, for LF staff.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+		})
+		Result(BackupResult)
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			POST("/admin/backups")
+			Header("bearer_token:Authorization")
+			Response(StatusCreated)
+		})
+	})
+
+	Method("list-backups", func() {
+		Description("List the stored backup archives, for LF staff.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+		})
+		Result(BackupList)
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			GET("/admin/backups")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("restore-backup", func() {
+		Description("Write the entries of a backup archive back to the project stores, for every project or only the given ones, for LF staff.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+			BackupNameAttribute()
+			Attribute("project_uids", ArrayOf(String), "Only restore these projects and the slug mappings pointing at them", func() {
+				Example([]string{"7cad5a8d-19d0-41a4-81a6-043453daf9ee"})
+			})
+			Attribute("dry_run", Boolean, "Report what would be restored without writing anything", func() {
+				Default(false)
+			})
+			Required("name")
+		})
+		Result(RestoreBackupResult)
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("NotFound", NotFoundError, "Backup not found")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			POST("/admin/backups/{name}/restore")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("list-pending-announcements", func() {
+		Description("List the projects whose announcement date is set and whose announcement has not been published yet, for LF staff.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+		})
+		Result(PendingAnnouncementList)
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			GET("/admin/announcements")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
 	// Serve the file gen/http/openapi3.json for requests sent to /openapi.json.
 	Files("/_projects/openapi.json", "gen/http/openapi.json", func() {
 		Meta("swagger:generate", "false")
@@ -0,0 +1,107 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package design
+
+import (
+	//nolint:staticcheck // ST1001: the recommended way of using the goa GSL package is with the . import
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("project-service", func() {
+	Method("search-projects", func() {
+		Description("Search projects by name, slug and description, best match first. The search index is used when it is configured and available; otherwise every project is scanned. Projects the principal cannot view are left out, so fewer than limit projects may be returned.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			Attribute("q", String, "Words to search for", func() {
+				MinLength(1)
+				MaxLength(200)
+				Example("kubernetes")
+			})
+			Attribute("limit", Int, "Maximum number of projects to return", func() {
+				Minimum(1)
+				Maximum(100)
+				Default(20)
+				Example(20)
+			})
+			Required("q")
+		})
+
+		Result(func() {
+			Attribute("projects", ArrayOf(ProjectBase), "Matching projects, best match first")
+			Attribute("source", String, "Whether the results come from the search index or a scan of every project", func() {
+				Enum("index", "store")
+				Example("index")
+			})
+			Required("projects", "source")
+		})
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			GET("/projects/search")
+			Params(func() {
+				Param("version:v")
+				Param("q")
+				Param("limit")
+			})
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("autocomplete-projects", func() {
+		Description("Suggest projects whose name, slug, or a word of them, starts with a prefix, for typeahead. Exact matches come first, then names and slugs starting with the prefix, then words starting with it. Projects the principal cannot view are left out.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			Attribute("q", String, "Prefix typed by the user", func() {
+				MinLength(1)
+				MaxLength(100)
+				Example("kube")
+			})
+			Attribute("limit", Int, "Maximum number of projects to return", func() {
+				Minimum(1)
+				Maximum(50)
+				Default(10)
+				Example(10)
+			})
+			Required("q")
+		})
+
+		Result(func() {
+			Attribute("projects", ArrayOf(ProjectSuggestion), "Matching projects, best match first")
+			Required("projects")
+		})
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			GET("/projects/autocomplete")
+			Params(func() {
+				Param("version:v")
+				Param("q")
+				Param("limit")
+			})
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+})
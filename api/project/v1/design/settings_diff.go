@@ -0,0 +1,54 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package design
+
+import (
+	//nolint:staticcheck // ST1001: the recommended way of using the goa GSL package is with the . import
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("project-service", func() {
+	Method("get-project-settings-diff", func() {
+		Description("Get the field-by-field difference between two revisions of a project's settings, such as the writers added and removed. Revisions are the settings ETags; only revisions still retained in the settings history are available.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			ProjectUIDAttribute()
+			Attribute("from", UInt64, "The earlier settings revision", func() {
+				Minimum(1)
+				Example(3)
+			})
+			Attribute("to", UInt64, "The later settings revision", func() {
+				Minimum(1)
+				Example(7)
+			})
+			Required("uid", "from", "to")
+		})
+
+		Result(ProjectSettingsDiff)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("NotFound", NotFoundError, "Project or settings revision not found")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			GET("/projects/{uid}/settings/diff")
+			Params(func() {
+				Param("version:v")
+				Param("uid")
+				Param("from")
+				Param("to")
+			})
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+})
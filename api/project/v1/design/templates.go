@@ -0,0 +1,127 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package design
+
+import (
+	//nolint:staticcheck // ST1001: the recommended way of using the goa GSL package is with the . import
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("project-service", func() {
+	Method("create-project-template", func() {
+		Description("Define a template that projects can be created from with POST /projects?template=<name>, for LF staff.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+			ProjectTemplateNameAttribute()
+			ProjectTemplateFieldsAttributes()
+			Required("name")
+		})
+		Result(ProjectTemplate)
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("Conflict", ConflictError, "Template already exists")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			POST("/admin/project-templates")
+			Header("bearer_token:Authorization")
+			Response(StatusCreated)
+		})
+	})
+
+	Method("list-project-templates", func() {
+		Description("List the project templates, sorted by name, for LF staff.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+		})
+		Result(ProjectTemplateList)
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			GET("/admin/project-templates")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("get-project-template", func() {
+		Description("Get a project template, for LF staff.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+			ProjectTemplateNameAttribute()
+			Required("name")
+		})
+		Result(ProjectTemplate)
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("NotFound", NotFoundError, "Template not found")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			GET("/admin/project-templates/{name}")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("update-project-template", func() {
+		Description("Replace the defaults of a project template, for LF staff. Projects already created from it are not changed.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+			ProjectTemplateNameAttribute()
+			ProjectTemplateFieldsAttributes()
+			Required("name")
+		})
+		Result(ProjectTemplate)
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("NotFound", NotFoundError, "Template not found")
+		Error("Conflict", ConflictError, "Template changed concurrently")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			PUT("/admin/project-templates/{name}")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("delete-project-template", func() {
+		Description("Remove a project template, for LF staff. Projects already created from it are not changed.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+			ProjectTemplateNameAttribute()
+			Required("name")
+		})
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("NotFound", NotFoundError, "Template not found")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			DELETE("/admin/project-templates/{name}")
+			Header("bearer_token:Authorization")
+			Response(StatusNoContent)
+		})
+	})
+})
@@ -0,0 +1,72 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package design
+
+import (
+	//nolint:staticcheck // ST1001: the recommended way of using the goa GSL package is with the . import
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("project-service", func() {
+	Method("transfer-project", func() {
+		Description("Transfer a project to new owners, such as when it moves to another foundation. The project's writers are replaced by the given writers in a single settings update and, when parent_uid is set, the project is moved under that parent. The transfer is audited and a project.transferred event names the previous writers. Returns the transferred project.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			XSyncAttribute()
+			Attribute("if_match", String, "If-Match header value: the ETag of the project's settings, whose writers are replaced", func() {
+				Example("123")
+			})
+			ForceUpdateAttribute()
+			VersionAttribute()
+			ProjectUIDAttribute()
+			Attribute("writers", ArrayOf(UserInfo), "The new writers of the project, which replace the current ones", func() {
+				MinLength(1)
+				Example([]map[string]interface{}{
+					{
+						"name":     "Alice Johnson",
+						"email":    "alice.johnson@example.com",
+						"username": "alicejohnson789",
+					},
+				})
+			})
+			Attribute("parent_uid", String, "UID of the project to move the project under, such as the new foundation; the parent is kept when unset", func() {
+				Example("4b1f3e9c-2d7a-4c1b-9e8f-0a1b2c3d4e5f")
+				Format(FormatUUID)
+			})
+			Attribute("reason", String, "Why the project is transferred, recorded in the audit log and the event", func() {
+				Example("Project moved to the CNCF")
+				MaxLength(1000)
+			})
+			Required("uid", "writers")
+		})
+
+		Result(ProjectFull)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("NotFound", NotFoundError, "Project not found")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("Conflict", ConflictError, "Revision mismatch")
+		Error("UnprocessableEntity", UnprocessableEntityError, "Unprocessable entity")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			POST("/projects/{uid}/transfer")
+			Params(func() {
+				Param("version:v")
+				Param("uid")
+			})
+			Header("bearer_token:Authorization")
+			Header("x_sync:X-Sync")
+			Header("if_match:If-Match")
+			Header("force_update:X-Force-Update")
+			Response(StatusOK)
+		})
+	})
+})
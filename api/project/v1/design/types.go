@@ -4,6 +4,8 @@
 package design
 
 import (
+	"strconv"
+
 	//nolint:staticcheck // ST1001: the recommended way of using the goa GSL package is with the . import
 	. "goa.design/goa/v3/dsl"
 )
@@ -23,6 +25,13 @@ func EtagAttribute() {
 	})
 }
 
+// CacheControlAttribute is a reusable Cache-Control header attribute (for responses).
+func CacheControlAttribute() {
+	Attribute("cache_control", String, "Cache-Control header value: public responses about public projects may be cached, others are private and not stored", func() {
+		Example("public, max-age=300, stale-while-revalidate=60")
+	})
+}
+
 // XSyncAttribute is a reusable X-Sync header attribute.
 func XSyncAttribute() {
 	Attribute("x_sync", Boolean, "X-Sync header value for performing operations synchronously", func() {
@@ -30,18 +39,70 @@ func XSyncAttribute() {
 	})
 }
 
+// AdminOverrideAttribute is a reusable X-Admin-Override header attribute.
+func AdminOverrideAttribute() {
+	Attribute("admin_override", Boolean, "X-Admin-Override header value for changing the legal fields of a project whose legal entity is dissolved; only honored for LF staff and trusted service principals", func() {
+		Example(false)
+	})
+}
+
+// ForceUpdateAttribute is a reusable X-Force-Update header attribute.
+func ForceUpdateAttribute() {
+	Attribute("force_update", Boolean, "X-Force-Update header value for applying the request to the current revision without If-Match; only honored for trusted service principals, and audited", func() {
+		Example(false)
+	})
+}
+
+// MergeAttribute is a reusable X-Merge header attribute for settings updates.
+func MergeAttribute() {
+	Attribute("merge", Boolean, "X-Merge header value for merging the update with the settings changes made since the If-Match revision, instead of failing it with a revision mismatch; only changes to the same fields conflict", func() {
+		Example(true)
+	})
+}
+
+// AllowDuplicatesAttribute is a reusable attribute to skip the duplicate project check.
+func AllowDuplicatesAttribute() {
+	Attribute("allow_duplicates", Boolean, "Create the project even if it likely duplicates an existing project", func() {
+		Default(false)
+		Example(false)
+	})
+}
+
+// ProjectTemplateAttribute is a reusable attribute to create a project from a template.
+func ProjectTemplateAttribute() {
+	Attribute("template", String, "Name of the template whose defaults fill in the fields the request leaves empty", func() {
+		Example("cncf-sandbox")
+	})
+}
+
+// FieldsAttribute is a reusable attribute to select the fields of the projects
+// in a response.
+func FieldsAttribute() {
+	Attribute("fields", String, "Comma-separated names of the project fields to return, such as uid,name,slug,logo_url; all fields are returned when omitted", func() {
+		Example("uid,name,slug,logo_url")
+	})
+}
+
 // IfMatchAttribute is a reusable If-Match header attribute (for conditional requests).
 func IfMatchAttribute() {
-	Attribute("if_match", String, "If-Match header value for conditional requests", func() {
+	Attribute("if_match", String, "If-Match header value for conditional requests: the ETag of the resource, which may be sent quoted or as a weak ETag such as W/\"123\"", func() {
 		Example("123")
 	})
 }
 
-// VersionAttribute is a reusable version attribute.
-func VersionAttribute() {
+// VersionAttribute is a reusable version attribute. The versions an endpoint
+// supports default to "1"; each one keeps the response shape it was released with.
+func VersionAttribute(versions ...string) {
+	if len(versions) == 0 {
+		versions = []string{"1"}
+	}
+	values := make([]any, 0, len(versions))
+	for _, version := range versions {
+		values = append(values, version)
+	}
 	Attribute("version", String, "Version of the API", func() {
-		Enum("1")
-		Example("1")
+		Enum(values...)
+		Example(versions[0])
 	})
 }
 
@@ -51,6 +112,8 @@ var ProjectFull = Type("ProjectFull", func() {
 
 	ProjectBaseAttributes()
 	ProjectSettingsAttributes()
+	ProjectComputedAttributes()
+	Attribute("settings", ProjectSettings, "The project settings, with their own timestamps; only in version 2 responses, which leave out the settings fields above")
 })
 
 // ProjectBase is the DSL type for a project base.
@@ -66,6 +129,8 @@ func ProjectBaseAttributes() {
 	ProjectSlugAttribute()
 	ProjectDescriptionAttribute()
 	ProjectNameAttribute()
+	ProjectNameI18nAttribute()
+	ProjectDescriptionI18nAttribute()
 	ProjectPublicAttribute()
 	ProjectIsFoundationAttribute()
 	ProjectParentUIDAttribute()
@@ -78,16 +143,43 @@ func ProjectBaseAttributes() {
 	ProjectLegalEntityNameAttribute()
 	ProjectLegalParentUIDAttribute()
 	ProjectEntityDissolutionDateAttribute()
+	ProjectEntityStatusAttribute()
 	ProjectEntityFormationDocumentURLAttribute()
 	ProjectAutojoinEnabledAttribute()
 	ProjectFormationDateAttribute()
 	ProjectLogoURLAttribute()
+	ProjectPNGLogoURLAttribute()
 	ProjectRepositoryURLAttribute()
 	ProjectWebsiteURLAttribute()
+	ProjectLinksAttribute()
+	ProjectAnnotationsAttribute()
+	ProjectLegacyIDAttribute()
+	ProjectTagsAttribute()
 	ProjectCreatedAtAttribute()
 	ProjectUpdatedAtAttribute()
 }
 
+// ProjectComputedAttributes is the DSL attributes derived from a project, its
+// settings and its place in the hierarchy, set in full project responses.
+func ProjectComputedAttributes() {
+	Attribute("slug_path", String, "The slugs of the project's ancestors, from the topmost one, and of the project, joined with \"/\"; read-only", func() {
+		Example("foo-foundation/project-slug")
+	})
+	Attribute("depth", Int, "The level of the project in the hierarchy, a project without a parent being level 1; read-only", func() {
+		Example(2)
+	})
+	Attribute("child_count", Int, "The number of direct children of the project; read-only", func() {
+		Example(3)
+	})
+	Attribute("has_settings", Boolean, "Whether the project has settings stored; read-only", func() {
+		Example(true)
+	})
+	Attribute("display_status", String, "The status to show the project with, computed from its stage, entity_dissolution_date, formation_date and announcement_date; read-only", func() {
+		Enum("active", "forming", "on_hold", "upcoming", "prospect", "archived", "dissolved")
+		Example("active")
+	})
+}
+
 // ProjectSettings is the DSL type for a project settings.
 var ProjectSettings = Type("ProjectSettings", func() {
 	Description("A representation of LF Project settings.")
@@ -99,6 +191,7 @@ var ProjectSettings = Type("ProjectSettings", func() {
 func ProjectSettingsAttributes() {
 	ProjectUIDAttribute()
 	ProjectMissionStatementAttribute()
+	ProjectOverviewHTMLAttribute()
 	ProjectAnnouncementDateAttribute()
 	ProjectWritersAttribute()
 	ProjectMeetingCoordinatorsAttribute()
@@ -123,6 +216,16 @@ func ProjectUIDAttribute() {
 	})
 }
 
+// ProjectMemberUsernameAttribute is the DSL attribute for the username of a
+// user added to or removed from a project's settings.
+func ProjectMemberUsernameAttribute() {
+	Attribute("username", String, "The LFID username of the user", func() {
+		Example("jdoe")
+		MinLength(1)
+		MaxLength(100)
+	})
+}
+
 // ProjectSlugAttribute is the DSL attribute for a project slug.
 func ProjectSlugAttribute() {
 	Attribute("slug", String, "Project slug, a short slugified name of the project", func() {
@@ -147,50 +250,53 @@ func ProjectDescriptionAttribute() {
 	})
 }
 
+// ProjectNameI18nAttribute is the DSL attribute for the localized names of a
+// project.
+func ProjectNameI18nAttribute() {
+	Attribute("name_i18n", MapOf(String, String), "The name of the project in other languages, keyed by BCP 47 language tag, for localized project listings", func() {
+		Example(map[string]string{"zh": "Foo 基金会", "ja": "Foo 財団"})
+		MaxLength(50)
+		localeKey()
+		Elem(func() {
+			MinLength(1)
+			MaxLength(255)
+		})
+	})
+}
+
+// ProjectDescriptionI18nAttribute is the DSL attribute for the localized
+// descriptions of a project.
+func ProjectDescriptionI18nAttribute() {
+	Attribute("description_i18n", MapOf(String, String), "The description of the project in other languages, keyed by BCP 47 language tag; each one has the length limit of the description", func() {
+		Example(map[string]string{"zh": "Foo 项目是一个关于 bar 的项目"})
+		MaxLength(50)
+		localeKey()
+		Elem(func() {
+			MinLength(1)
+		})
+	})
+}
+
+// localeKey constrains the keys of a localized field map to the shape of a
+// BCP 47 language tag, such as "zh", "pt-BR" or "zh-Hant-TW".
+func localeKey() {
+	Key(func() {
+		Pattern(`^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$`)
+		MaxLength(35)
+	})
+}
+
 // ProjectStageAttribute is the DSL attribute for a project stage.
 func ProjectStageAttribute() {
-	Attribute("stage", String, "The stage of the project", func() {
+	Attribute("stage", String, "The stage of the project, one of the stages listed by GET /metadata/enums", func() {
 		Example("Formation - Exploratory")
-		Enum(
-			"Formation - Exploratory",
-			"Formation - Engaged",
-			"Active",
-			"Archived",
-			"Formation - On Hold",
-			"Formation - Disengaged",
-			"Formation - Confidential",
-			"Prospect",
-		)
 	})
 }
 
 // ProjectCategoryAttribute is the DSL attribute for a project category.
 func ProjectCategoryAttribute() {
-	Attribute("category", String, "The category of the project", func() {
+	Attribute("category", String, "The category of the project, one of the categories listed by GET /metadata/enums", func() {
 		Example("Active")
-		Enum(
-			"Active",
-			"Adopted",
-			"Archived",
-			"At-Large",
-			"Early Adoption",
-			"Emeritus",
-			"Graduated",
-			"Growth",
-			"Idle",
-			"Impact",
-			"Incubating",
-			"Kanister",
-			"Mature",
-			"Pre-LFESS",
-			"Sandbox",
-			"SIG",
-			"Standards",
-			"TAC",
-			"Working Group",
-			"TAG",
-			"NONE",
-		)
 	})
 }
 
@@ -225,6 +331,14 @@ func ProjectLogoURLAttribute() {
 	})
 }
 
+// ProjectPNGLogoURLAttribute is the DSL attribute for the PNG rendering of a project's SVG logo.
+func ProjectPNGLogoURLAttribute() {
+	Attribute("png_logo_url", String, "The URL of a PNG rendering of the project logo, set by the service when the logo is an SVG", func() {
+		Example("https://example.com/logo.png")
+		Format(FormatURI)
+	})
+}
+
 // ProjectWebsiteURLAttribute is the DSL attribute for a project website URL.
 func ProjectWebsiteURLAttribute() {
 	Attribute("website_url", String, "The URL of the project website", func() {
@@ -241,6 +355,45 @@ func ProjectRepositoryURLAttribute() {
 	})
 }
 
+// ProjectLinks is the DSL type for the social links and contact details of a project.
+var ProjectLinks = Type("ProjectLinks", func() {
+	Description("The social links and contact details of a project. Links are absolute http or https URLs.")
+
+	Attribute("twitter", String, "The URL of the project's X (Twitter) profile", func() {
+		Example("https://x.com/example")
+		Format(FormatURI)
+	})
+	Attribute("linkedin", String, "The URL of the project's LinkedIn page", func() {
+		Example("https://www.linkedin.com/company/example")
+		Format(FormatURI)
+	})
+	Attribute("youtube", String, "The URL of the project's YouTube channel", func() {
+		Example("https://www.youtube.com/@example")
+		Format(FormatURI)
+	})
+	Attribute("slack", String, "The invite URL of the project's Slack workspace", func() {
+		Example("https://slack.example.org/")
+		Format(FormatURI)
+	})
+	Attribute("discord", String, "The invite URL of the project's Discord server", func() {
+		Example("https://discord.gg/example")
+		Format(FormatURI)
+	})
+	Attribute("mailing_list", String, "The URL of the project's mailing list", func() {
+		Example("https://lists.example.org/g/main")
+		Format(FormatURI)
+	})
+	Attribute("contact_email", String, "The email address to contact the project at", func() {
+		Example("info@example.org")
+		Format(FormatEmail)
+	})
+})
+
+// ProjectLinksAttribute is the DSL attribute for the links of a project.
+func ProjectLinksAttribute() {
+	Attribute("links", ProjectLinks, "The social links and contact details of the project")
+}
+
 // InviteInfo is the DSL type for pending invite metadata on a non-LFID user.
 var InviteInfo = Type("InviteInfo", func() {
 	Description("Pending invite details for a user who does not yet have an LFID.")
@@ -397,7 +550,7 @@ func ProjectLegalEntityNameAttribute() {
 
 // ProjectLegalParentUIDAttribute is the DSL attribute for a project legal parent UID.
 func ProjectLegalParentUIDAttribute() {
-	Attribute("legal_parent_uid", String, "The UID of the legal parent entity, should be empty if there is none", func() {
+	Attribute("legal_parent_uid", String, "The UID of the legal parent entity, should be empty if there is none; it must be a project whose legal entity type is Incorporated Entity or Series LLC", func() {
 		Example("7cad5a8d-19d0-41a4-81a6-043453daf9ee")
 		Format(FormatUUID)
 	})
@@ -430,6 +583,41 @@ func ProjectFundingModelAttribute() {
 	})
 }
 
+// ProjectAnnotationsAttribute is the DSL attribute for project annotations.
+func ProjectAnnotationsAttribute() {
+	Attribute("annotations", MapOf(String, String), "Platform-specific metadata attached to the project, such as a v1 Salesforce ID or a billing code. Keys are lowercase alphanumerics, '.', '_', '-' and '/', starting and ending with an alphanumeric.", func() {
+		Example(map[string]string{"salesforce.v1/id": "a0941000002wBz9AAE"})
+		MaxLength(32)
+		Key(func() {
+			Pattern(`^[a-z0-9]([a-z0-9._/-]*[a-z0-9])?$`)
+			MaxLength(63)
+		})
+		Elem(func() {
+			MaxLength(1024)
+		})
+	})
+}
+
+// ProjectLegacyIDAttribute is the DSL attribute for a project's LFX v1 ID.
+func ProjectLegacyIDAttribute() {
+	Attribute("legacy_id", String, "The ID of the project in LFX v1 (a Salesforce record ID), used to resolve v1 references to the v2 UID", func() {
+		Example("a0941000002wBz9AAE")
+		Pattern(`^[A-Za-z0-9]+$`)
+		MaxLength(64)
+	})
+}
+
+// ProjectTagsAttribute is the DSL attribute for project tags.
+func ProjectTagsAttribute() {
+	Attribute("tags", ArrayOf(String, func() {
+		Pattern(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+		MaxLength(63)
+	}), "Free-form labels for grouping projects outside of stage and category, such as a program or a risk classification. Tags are lowercase alphanumerics and '-', starting and ending with an alphanumeric.", func() {
+		Example([]string{"cncf", "security-critical"})
+		MaxLength(20)
+	})
+}
+
 // ProjectCharterURLAttribute is the DSL attribute for a project charter URL.
 func ProjectCharterURLAttribute() {
 	Attribute("charter_url", String, "The URL of the project charter document", func() {
@@ -446,6 +634,14 @@ func ProjectEntityDissolutionDateAttribute() {
 	})
 }
 
+// ProjectEntityStatusAttribute is the DSL attribute for the computed status of a project's legal entity.
+func ProjectEntityStatusAttribute() {
+	Attribute("entity_status", String, "The status of the project's legal entity, computed from entity_dissolution_date: dissolved once the date is in the past. The legal fields of a dissolved project can only be changed with the X-Admin-Override header.", func() {
+		Enum("active", "dissolved")
+		Example("active")
+	})
+}
+
 // ProjectEntityFormationDocumentURLAttribute is the DSL attribute for a project entity formation document URL.
 func ProjectEntityFormationDocumentURLAttribute() {
 	Attribute("entity_formation_document_url", String, "The URL of the project entity formation document", func() {
@@ -502,63 +698,166 @@ func ProjectMissionStatementAttribute() {
 	})
 }
 
+// ProjectOverviewHTMLMaxLength caps, in characters, the overview of a project.
+const ProjectOverviewHTMLMaxLength = 20000
+
+// ProjectOverviewHTMLAttribute is the DSL attribute for a project overview.
+func ProjectOverviewHTMLAttribute() {
+	Attribute("overview_html", String, "Curated HTML content shown on the project page. It is sanitized when stored: only basic formatting, lists, tables and http, https or mailto links are kept.", func() {
+		MaxLength(ProjectOverviewHTMLMaxLength)
+		Example("<p>The project builds <strong>open source</strong> tooling. <a href=\"https://example.org/docs\">Read the docs</a>.</p>")
+	})
+}
+
 //
 // Error types
 //
 
+// ProblemContentType is the media type of error responses (RFC 7807).
+const ProblemContentType = "application/problem+json"
+
+// ProblemTypePrefix is the prefix of the URIs identifying problem types.
+const ProblemTypePrefix = "urn:lfx:project-service:problem:"
+
+// ProblemResponse is the DSL for the HTTP response of an error, which is a
+// problem details document.
+func ProblemResponse() {
+	ContentType(ProblemContentType)
+}
+
+// ProblemAttributes are the DSL attributes every error type shares: the
+// members of a problem details document (RFC 7807), and the code and message
+// of earlier versions of the API.
+func ProblemAttributes(status int, problemType, title, detail string) {
+	Attribute("type", String, "URI identifying the problem type; the same for every occurrence of the problem", func() {
+		Example(ProblemTypePrefix + problemType)
+	})
+	Attribute("title", String, "Short summary of the problem type", func() {
+		Example(title)
+	})
+	Attribute("status", Int, "HTTP status code", func() {
+		Example(status)
+	})
+	Attribute("detail", String, "Explanation of this occurrence of the problem", func() {
+		Example(detail)
+	})
+	Attribute("instance", String, "Path of the request that failed", func() {
+		Example("/projects/7cad5a8d-19d0-41a4-81a6-043453daf9ee")
+	})
+	Attribute("request_id", String, "ID of the request that failed, as in the X-REQUEST-ID response header", func() {
+		Example("5bd8d4b4-8c1a-4c3f-9d57-3f7f3b8f3e16")
+	})
+	Attribute("field_errors", ArrayOf(FieldError), "Fields of the request that caused the problem")
+	Attribute("code", String, "HTTP status code; deprecated, use status", func() {
+		Example(strconv.Itoa(status))
+	})
+	Attribute("message", String, "Error message; deprecated, use detail", func() {
+		Example(detail)
+	})
+	// The problem members are not required so that clients still accept the
+	// errors of servers that only answer with code and message.
+	Required("code", "message")
+}
+
 // BadRequestError is the DSL type for a bad request error.
 var BadRequestError = Type("BadRequestError", func() {
-	Attribute("code", String, "HTTP status code", func() {
-		Example("400")
+	ProblemAttributes(400, "validation-failed", "Validation failed", "The request was invalid.")
+	Attribute("fields", ArrayOf(FieldError), "Fields that failed validation; deprecated, use field_errors")
+})
+
+// FieldError is the DSL type for a single field that failed validation.
+var FieldError = Type("FieldError", func() {
+	Attribute("path", String, "Name of the field in the request body", func() {
+		Example("entity_dissolution_date")
 	})
-	Attribute("message", String, "Error message", func() {
-		Example("The request was invalid.")
+	Attribute("code", String, "Machine-readable reason the field failed validation", func() {
+		Enum("required", "invalid", "too_large", "not_found", "forbidden")
+		Example("required")
 	})
-	Required("code", "message")
+	Attribute("message", String, "Human-readable description of the failure", func() {
+		Example("entity dissolution date is required when project stage is 'Archived'")
+	})
+	Required("path", "code", "message")
 })
 
-// NotFoundError is the DSL type for a not found error.
-var NotFoundError = Type("NotFoundError", func() {
-	Attribute("code", String, "HTTP status code", func() {
-		Example("404")
+// ForbiddenError is the DSL type for a forbidden error.
+var ForbiddenError = Type("ForbiddenError", func() {
+	ProblemAttributes(403, "forbidden", "Forbidden", "The principal is not allowed to perform this operation.")
+	Attribute("fields", ArrayOf(FieldError), "Fields the principal is not allowed to change; deprecated, use field_errors")
+	Attribute("contacts", ArrayOf(ProjectContact), "People to contact about joining the project, when autojoin is disabled")
+})
+
+// ProjectContact is the DSL type for a person to contact about a project.
+var ProjectContact = Type("ProjectContact", func() {
+	Attribute("role", String, "The role of the contact in the project", func() {
+		Enum("executive_director", "program_manager")
+		Example("executive_director")
 	})
-	Attribute("message", String, "Error message", func() {
-		Example("The resource was not found.")
+	Attribute("name", String, "The name of the contact", func() {
+		Example("John Doe")
 	})
-	Required("code", "message")
+	Attribute("email", String, "The email address of the contact", func() {
+		Example("jdoe@example.com")
+	})
+	Required("role", "name", "email")
+})
+
+// NotFoundError is the DSL type for a not found error.
+var NotFoundError = Type("NotFoundError", func() {
+	ProblemAttributes(404, "project-not-found", "Project not found", "The resource was not found.")
 })
 
 // ConflictError is the DSL type for a conflict error.
 var ConflictError = Type("ConflictError", func() {
-	Attribute("code", String, "HTTP status code", func() {
-		Example("409")
+	ProblemAttributes(409, "project-slug-exists", "Project slug exists", "The resource already exists.")
+	Attribute("duplicates", ArrayOf(DuplicateProject), "Existing projects the new project likely duplicates, when it was rejected as a duplicate")
+	Attribute("conflicts", ArrayOf(SettingsMergeConflict), "Settings fields that both the request and a concurrent update changed, when a merged settings update was rejected")
+})
+
+// SettingsMergeConflict is the DSL type for a settings field that a merged
+// update and a concurrent update both changed.
+var SettingsMergeConflict = Type("SettingsMergeConflict", func() {
+	Attribute("field", String, "Settings field that both updates changed", func() {
+		Example("mission_statement")
 	})
-	Attribute("message", String, "Error message", func() {
-		Example("The resource already exists.")
+	Attribute("users", ArrayOf(String), "Usernames, or emails of users without one, that both updates changed differently, when the field is a list of users", func() {
+		Example([]string{"jdoe"})
 	})
-	Required("code", "message")
+	Required("field")
 })
 
-// InternalServerError is the DSL type for an internal server error.
-var InternalServerError = Type("InternalServerError", func() {
-	Attribute("code", String, "HTTP status code", func() {
-		Example("500")
+// DuplicateProject is the DSL type for an existing project a new project likely duplicates.
+var DuplicateProject = Type("DuplicateProject", func() {
+	Attribute("uid", String, "UID of the existing project", func() {
+		Example("7cad5a8d-19d0-41a4-81a6-043453daf9ee")
 	})
-	Attribute("message", String, "Error message", func() {
-		Example("An internal server error occurred.")
+	Attribute("slug", String, "Slug of the existing project", func() {
+		Example("cncf")
 	})
-	Required("code", "message")
+	Attribute("name", String, "Name of the existing project", func() {
+		Example("Cloud Native Computing Foundation")
+	})
+	Attribute("reason", String, "Why the existing project was matched", func() {
+		Enum("similar_name", "website_url", "repository_url")
+		Example("similar_name")
+	})
+	Required("uid", "slug", "name", "reason")
+})
+
+// UnprocessableEntityError is the DSL type for an unprocessable entity error.
+var UnprocessableEntityError = Type("UnprocessableEntityError", func() {
+	ProblemAttributes(422, "field-too-long", "Field too long", "The request cannot be applied to the current state of the resource.")
+	Attribute("fields", ArrayOf(FieldError), "Fields that exceed their limits; deprecated, use field_errors")
+})
+
+// InternalServerError is the DSL type for an internal server error.
+var InternalServerError = Type("InternalServerError", func() {
+	ProblemAttributes(500, "internal-error", "Internal error", "An internal server error occurred.")
 })
 
 // ServiceUnavailableError is the DSL type for a service unavailable error.
 var ServiceUnavailableError = Type("ServiceUnavailableError", func() {
-	Attribute("code", String, "HTTP status code", func() {
-		Example("503")
-	})
-	Attribute("message", String, "Error message", func() {
-		Example("The service is unavailable.")
-	})
-	Required("code", "message")
+	ProblemAttributes(503, "service-unavailable", "Service unavailable", "The service is unavailable.")
 })
 
 //
@@ -664,3 +963,922 @@ var ProjectDocument = Type("ProjectDocument", func() {
 	ResourceTimestampAttribute("created_at")
 	ResourceTimestampAttribute("updated_at")
 })
+
+// ProjectCharterVersion is the DSL type for one uploaded version of a project charter.
+var ProjectCharterVersion = Type("ProjectCharterVersion", func() {
+	Description("One uploaded version of a project charter.")
+	Attribute("version", Int, "Version number, starting at 1", func() {
+		Example(2)
+	})
+	Attribute("url", String, "Public URL of this version of the charter", func() {
+		Example("https://example.com/7cad5a8d-19d0-41a4-81a6-043453daf9ee/6f1c3a52-8d3e-4b8f-9a57-1f0c8f1f2c3d.pdf")
+		Format(FormatURI)
+	})
+	Attribute("file_name", String, "Original uploaded file name", func() {
+		Example("charter.pdf")
+	})
+	Attribute("file_size", Int64, "File size in bytes", func() {
+		Example(int64(204800))
+	})
+	Attribute("sha256", String, "SHA-256 checksum of the file, hex encoded", func() {
+		Example("9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08")
+	})
+	ResourceCreatedByAttribute("uploaded_by_username")
+	ResourceTimestampAttribute("uploaded_at")
+	Required("version", "url", "file_size", "sha256", "uploaded_at")
+})
+
+// ProjectCharter is the DSL type for a project's charter and its version history.
+var ProjectCharter = Type("ProjectCharter", func() {
+	Description("A project's charter document and its version history, oldest first.")
+	ResourceUIDAttribute("project_uid", "Project UID this charter belongs to")
+	Attribute("current", ProjectCharterVersion, "The latest version, which the project's charter_url points at")
+	Attribute("versions", ArrayOf(ProjectCharterVersion), "Every uploaded version, oldest first")
+	Required("project_uid", "current", "versions")
+})
+
+//
+// ProjectAssociations types
+//
+
+// ProjectAssociationsAttributes is the DSL attributes for references to resources
+// owned by other LFX services.
+func ProjectAssociationsAttributes() {
+	Attribute("committee_uids", ArrayOf(String), "UIDs of the committees associated with the project", func() {
+		MaxLength(100)
+		Example([]string{"061a110a-7c38-4cd3-bfcf-fc8511a37f35"})
+	})
+	Attribute("mailing_list_ids", ArrayOf(String), "IDs of the mailing lists associated with the project", func() {
+		MaxLength(100)
+		Example([]string{"119563"})
+	})
+	Attribute("meeting_series_ids", ArrayOf(String), "IDs of the meeting series associated with the project", func() {
+		MaxLength(100)
+		Example([]string{"8f4e2d1a-9b3c-4a5e-8d7f-6c1b2a3e4f5d"})
+	})
+}
+
+// ProjectAssociations is the DSL type for a project's associations.
+var ProjectAssociations = Type("ProjectAssociations", func() {
+	Description("References to committees, mailing lists and meeting series, owned by other LFX services, that are associated with a project.")
+	ResourceUIDAttribute("project_uid", "Project UID these associations belong to")
+	ProjectAssociationsAttributes()
+	ResourceTimestampAttribute("updated_at")
+	Required("project_uid", "committee_uids", "mailing_list_ids", "meeting_series_ids")
+})
+
+//
+// ProjectSettingsDiff types
+//
+
+// ProjectSettingsChange is the DSL type for the change to one settings field.
+var ProjectSettingsChange = Type("ProjectSettingsChange", func() {
+	Description("The change to one settings field between two revisions. Text fields report their old and new values; user fields report the users added and removed.")
+	Attribute("field", String, "The settings field that changed", func() {
+		Enum("mission_statement", "announcement_date", "auditors", "writers", "meeting_coordinators", "executive_director", "program_manager", "opportunity_owner")
+		Example("writers")
+	})
+	Attribute("from", String, "The old value of a text field", func() {
+		Example("Old mission statement")
+	})
+	Attribute("to", String, "The new value of a text field", func() {
+		Example("New mission statement")
+	})
+	Attribute("added", ArrayOf(UserInfo), "Users added to a user field")
+	Attribute("removed", ArrayOf(UserInfo), "Users removed from a user field")
+	Required("field")
+})
+
+// ProjectSettingsDiff is the DSL type for the difference between two revisions
+// of a project's settings.
+var ProjectSettingsDiff = Type("ProjectSettingsDiff", func() {
+	Description("The field-by-field difference between two revisions of a project's settings.")
+	ResourceUIDAttribute("project_uid", "Project UID these settings belong to")
+	Attribute("from_revision", UInt64, "The earlier settings revision", func() {
+		Example(3)
+	})
+	Attribute("to_revision", UInt64, "The later settings revision", func() {
+		Example(7)
+	})
+	Attribute("changes", ArrayOf(ProjectSettingsChange), "The fields that changed, in settings order")
+	Required("project_uid", "from_revision", "to_revision", "changes")
+})
+
+//
+// Visibility impact types
+//
+
+// ProjectPage is the DSL type for a page of a paginated list of projects.
+var ProjectPage = Type("ProjectPage", func() {
+	Attribute("projects", ArrayOf(ProjectBase), "The projects of the page, in the requested order")
+	Attribute("next_page_token", String, "Token of the next page; absent on the last page", func() {
+		Example("eyJzIjoibmFtZSIsIm8iOiJhc2MiLCJrIjoia3ViZXJuZXRlcyIsInUiOiI3Y2FkNWE4ZCJ9")
+	})
+	Required("projects")
+})
+
+// ProjectVisibilityImpact is the DSL type for the preview of a project visibility change.
+var ProjectVisibilityImpact = Type("ProjectVisibilityImpact", func() {
+	Description("What flipping a project's public flag would affect.")
+	ResourceUIDAttribute("project_uid", "Project UID")
+	Attribute("public", Boolean, "Whether the project is currently public", func() {
+		Example(true)
+	})
+	Attribute("target_public", Boolean, "The visibility the report is for, the opposite of public", func() {
+		Example(false)
+	})
+	Attribute("descendants", ArrayOf(VisibilityImpactProject), "The projects under the project, ordered by slug; they keep their own public flag")
+	Attribute("mismatched_descendants", Int, "The number of descendants whose visibility would differ from the project's after the change", func() {
+		Example(2)
+	})
+	Attribute("external_references", ArrayOf(ExternalReference), "The project's URLs that point outside the service")
+	Attribute("subscribers", VisibilitySubscribers, "What follows the project, when associations or webhooks are enabled")
+	Required("project_uid", "public", "target_public", "descendants", "mismatched_descendants", "external_references")
+})
+
+// VisibilityImpactProject is the DSL type for a descendant in a visibility impact report.
+var VisibilityImpactProject = Type("VisibilityImpactProject", func() {
+	ResourceUIDAttribute("uid", "Project UID")
+	ProjectSlugAttribute()
+	ProjectNameAttribute()
+	ResourceUIDAttribute("parent_uid", "Parent project UID")
+	Attribute("public", Boolean, "Whether the project is public", func() {
+		Example(true)
+	})
+	Required("uid", "slug", "name", "parent_uid", "public")
+})
+
+// ProjectLegalLineage is the DSL type for the chain of legal parents of a project.
+var ProjectLegalLineage = Type("ProjectLegalLineage", func() {
+	Description("The chain of legal parents of a project.")
+	ResourceUIDAttribute("project_uid", "Project UID")
+	Attribute("legal_parents", ArrayOf(LegalLineageProject), "The legal parent of the project first, then its legal parent, and so on")
+	Attribute("complete", Boolean, "Whether the chain ends at a project without a legal parent; it is incomplete when a legal parent no longer exists or the chain loops", func() {
+		Example(true)
+	})
+	Required("project_uid", "legal_parents", "complete")
+})
+
+// LegalLineageProject is the DSL type for a legal parent in a legal lineage.
+var LegalLineageProject = Type("LegalLineageProject", func() {
+	ResourceUIDAttribute("uid", "Project UID")
+	ProjectSlugAttribute()
+	ProjectNameAttribute()
+	ProjectLegalEntityTypeAttribute()
+	ProjectLegalEntityNameAttribute()
+	Required("uid", "slug", "name")
+})
+
+// ExternalReference is the DSL type for a project URL field.
+var ExternalReference = Type("ExternalReference", func() {
+	Attribute("field", String, "The project field holding the URL", func() {
+		Enum("website_url", "repository_url", "charter_url", "logo_url", "entity_formation_document_url")
+		Example("website_url")
+	})
+	Attribute("url", String, "The URL", func() {
+		Example("https://example.org")
+	})
+	Required("field", "url")
+})
+
+// VisibilitySubscribers is the DSL type for the counts of what follows a project.
+var VisibilitySubscribers = Type("VisibilitySubscribers", func() {
+	Attribute("committees", Int, "Associated committees, when associations are enabled", func() {
+		Example(3)
+	})
+	Attribute("mailing_lists", Int, "Associated mailing lists, when associations are enabled", func() {
+		Example(2)
+	})
+	Attribute("meeting_series", Int, "Associated meeting series, when associations are enabled", func() {
+		Example(1)
+	})
+	Attribute("webhooks", Int, "Webhooks that receive the project's update events, when webhooks are enabled", func() {
+		Example(1)
+	})
+})
+
+//
+// UserProject types
+//
+
+// UserProject is the DSL type for a project in which a user holds a role.
+var UserProject = Type("UserProject", func() {
+	Description("A project in which a user is a writer, auditor or meeting coordinator.")
+	ResourceUIDAttribute("project_uid", "Project UID")
+	ProjectSlugAttribute()
+	ProjectNameAttribute()
+	Attribute("roles", ArrayOf(String, func() {
+		Enum("writer", "auditor", "meeting_coordinator")
+	}), "The roles the user holds in the project", func() {
+		Example([]string{"writer", "meeting_coordinator"})
+	})
+	Required("project_uid", "slug", "name", "roles")
+})
+
+// ProjectSuggestion is the DSL type for a project offered by autocomplete.
+var ProjectSuggestion = Type("ProjectSuggestion", func() {
+	Description("A project offered for a typeahead prefix.")
+	ResourceUIDAttribute("uid", "Project UID")
+	ProjectNameAttribute()
+	ProjectSlugAttribute()
+	ProjectLogoURLAttribute()
+	Required("uid", "name", "slug")
+})
+
+// UserAccessRemoval is the DSL type for the outcome of removing a user from one project.
+var UserAccessRemoval = Type("UserAccessRemoval", func() {
+	Description("The outcome of removing a user from the member roles of one project.")
+	ResourceUIDAttribute("project_uid", "Project UID")
+	ProjectSlugAttribute()
+	ProjectNameAttribute()
+	Attribute("roles", ArrayOf(String, func() {
+		Enum("writer", "auditor", "meeting_coordinator")
+	}), "The roles the user held in the project", func() {
+		Example([]string{"writer", "meeting_coordinator"})
+	})
+	Attribute("status", String, "Whether the user was removed, no longer held a role, the project was not found, or the removal failed", func() {
+		Enum("updated", "unchanged", "not_found", "failed")
+		Example("updated")
+	})
+	Attribute("error", String, "Why the removal failed", func() {
+		Example("too many concurrent updates")
+	})
+	Required("project_uid", "slug", "name", "roles", "status")
+})
+
+// UserAccessRemovalResult is the DSL type for the result of removing a user from all projects.
+var UserAccessRemovalResult = Type("UserAccessRemovalResult", func() {
+	Description("The outcome of removing a user from every project, one entry per project in which the user held a role, ordered by slug.")
+	ProjectMemberUsernameAttribute()
+	Attribute("projects", ArrayOf(UserAccessRemoval), "Outcome for each project")
+	Attribute("removed", Int, "Projects the user was removed from", func() {
+		Example(3)
+	})
+	Attribute("failed", Int, "Projects that were not found or failed", func() {
+		Example(0)
+	})
+	Required("username", "projects", "removed", "failed")
+})
+
+// UserMembership is the DSL type for a role a user holds in a project, in a data export.
+var UserMembership = Type("UserMembership", func() {
+	Description("A member or contact role a user holds in a project, with the user information stored with it.")
+	ResourceUIDAttribute("project_uid", "Project UID")
+	ProjectSlugAttribute()
+	ProjectNameAttribute()
+	Attribute("role", String, "The role the user holds in the project", func() {
+		Enum("writer", "auditor", "meeting_coordinator", "executive_director", "program_manager", "opportunity_owner")
+		Example("writer")
+	})
+	Attribute("user", UserInfo, "The user information stored with the role")
+	Attribute("added_at", String, "When the user was given the role, as far back as the retained history of the project goes", func() {
+		Example("2024-05-01T12:00:00Z")
+		Format(FormatDateTime)
+	})
+	Required("project_uid", "slug", "name", "role", "user")
+})
+
+// UserDataExport is the DSL type for everything stored about a user.
+var UserDataExport = Type("UserDataExport", func() {
+	Description("Everything the project service stores about a user, for data-subject access requests.")
+	ProjectMemberUsernameAttribute()
+	Attribute("generated_at", String, "When the export was generated", func() {
+		Example("2024-05-01T12:00:00Z")
+		Format(FormatDateTime)
+	})
+	Attribute("memberships", ArrayOf(UserMembership), "The roles the user holds, ordered by project slug")
+	Required("username", "generated_at", "memberships")
+})
+
+// ProjectJoinResult is the DSL type for the result of joining a project.
+var ProjectJoinResult = Type("ProjectJoinResult", func() {
+	Description("The project member role a user was given by joining a project.")
+	ResourceUIDAttribute("project_uid", "Project UID")
+	ProjectMemberUsernameAttribute()
+	Attribute("role", String, "The role the user holds in the project", func() {
+		Enum("writer", "auditor", "meeting_coordinator")
+		Example("auditor")
+	})
+	Required("project_uid", "username", "role")
+})
+
+// ProjectMembersOperation is the DSL type for the member changes of one project in a bulk update.
+var ProjectMembersOperation = Type("ProjectMembersOperation", func() {
+	Description("Users to add to and remove from the writers and auditors of one project.")
+	ResourceUIDAttribute("uid", "Project UID")
+	Attribute("writers_add", ArrayOf(String), "Usernames to add to the writers", func() {
+		Example([]string{"jdoe"})
+	})
+	Attribute("writers_remove", ArrayOf(String), "Usernames to remove from the writers", func() {
+		Example([]string{})
+	})
+	Attribute("auditors_add", ArrayOf(String), "Usernames to add to the auditors", func() {
+		Example([]string{})
+	})
+	Attribute("auditors_remove", ArrayOf(String), "Usernames to remove from the auditors", func() {
+		Example([]string{"jdoe"})
+	})
+	Required("uid")
+})
+
+// ProjectMembersOperationResult is the DSL type for the outcome of one project in a bulk member update.
+var ProjectMembersOperationResult = Type("ProjectMembersOperationResult", func() {
+	Description("The outcome of the member changes of one project.")
+	ResourceUIDAttribute("uid", "Project UID")
+	Attribute("status", String, "Whether the project was updated, already matched the changes, was not found, or failed", func() {
+		Enum("updated", "unchanged", "not_found", "failed")
+		Example("updated")
+	})
+	Attribute("error", String, "Why the changes failed", func() {
+		Example("too many concurrent updates")
+	})
+	Required("uid", "status")
+})
+
+// BulkProjectMembersResult is the DSL type for the result of a bulk member update.
+var BulkProjectMembersResult = Type("BulkProjectMembersResult", func() {
+	Description("The outcome of a bulk member update, one result per operation in request order.")
+	Attribute("results", ArrayOf(ProjectMembersOperationResult), "Outcome of each operation")
+	Attribute("updated", Int, "Projects updated", func() {
+		Example(12)
+	})
+	Attribute("failed", Int, "Projects that were not found or failed", func() {
+		Example(0)
+	})
+	Required("results", "updated", "failed")
+})
+
+// ProjectDeletionOutcome is the DSL type for the outcome of one project in a bulk deletion.
+var ProjectDeletionOutcome = Type("ProjectDeletionOutcome", func() {
+	Description("The outcome of the deletion of one project.")
+	ResourceUIDAttribute("uid", "Project UID")
+	Attribute("slug", String, "Project slug, unless the project was not found", func() {
+		Example("project-slug")
+	})
+	Attribute("requested", Boolean, "Whether the project was given in the request, rather than deleted as a descendant of one", func() {
+		Example(true)
+	})
+	Attribute("status", String, "Whether the project was deleted, would be deleted by a dry run, was not found, blocked the deletion, was skipped because the deletion was blocked or stopped, or failed", func() {
+		Enum("deleted", "would_delete", "not_found", "blocked", "skipped", "failed")
+		Example("deleted")
+	})
+	Attribute("error", String, "Why the project blocked the deletion or failed", func() {
+		Example("project has children that are not deleted")
+	})
+	Required("uid", "requested", "status")
+})
+
+// BulkProjectDeletionResult is the DSL type for the result of a bulk deletion.
+var BulkProjectDeletionResult = Type("BulkProjectDeletionResult", func() {
+	Description("The outcome of a bulk deletion, one result per project in deletion order, children first; projects that were not found come last.")
+	Attribute("results", ArrayOf(ProjectDeletionOutcome), "Outcome of each project")
+	Attribute("deleted", Int, "Projects deleted, or that would be deleted by a dry run", func() {
+		Example(12)
+	})
+	Attribute("failed", Int, "Projects that were not found, blocked the deletion or failed", func() {
+		Example(0)
+	})
+	Attribute("dry_run", Boolean, "Whether nothing was deleted because of dry_run", func() {
+		Example(false)
+	})
+	Required("results", "deleted", "failed", "dry_run")
+})
+
+//
+// Health types
+//
+
+// DependencyHealth is the DSL type for the health of a single service dependency.
+var DependencyHealth = Type("DependencyHealth", func() {
+	Description("The health of a single dependency of the service.")
+	Attribute("name", String, "Dependency name", func() {
+		Example("kv:projects")
+	})
+	Attribute("status", String, "Dependency status", func() {
+		Enum("ok", "error")
+		Example("ok")
+	})
+	Attribute("latency_ms", Float64, "Time taken by the check in milliseconds", func() {
+		Example(1.25)
+	})
+	Attribute("error", String, "Reason the check failed", func() {
+		Example("nats: timeout")
+	})
+	Required("name", "status", "latency_ms")
+})
+
+// HealthReport is the DSL type for the structured health report.
+var HealthReport = Type("HealthReport", func() {
+	Description("Structured health report covering every dependency of the service.")
+	Attribute("status", String, "Overall service status", func() {
+		Enum("ok", "error")
+		Example("ok")
+	})
+	Attribute("checks", ArrayOf(DependencyHealth), "Per-dependency check results")
+	Required("status", "checks")
+})
+
+// OutboxReconcileResult is the DSL type for the result of an outbox reconciliation pass.
+var OutboxReconcileResult = Type("OutboxReconcileResult", func() {
+	Description("Counts of outbox messages handled by a reconciliation pass.")
+	Attribute("published", Int, "Messages published and removed from the outbox", func() {
+		Example(3)
+	})
+	Attribute("failed", Int, "Messages that failed again and were rescheduled", func() {
+		Example(0)
+	})
+	Attribute("pending", Int, "Messages still waiting in the outbox", func() {
+		Example(0)
+	})
+	Required("published", "failed", "pending")
+})
+
+// ResyncProjectsResult is the DSL type for the result of a project resync.
+var ResyncProjectsResult = Type("ResyncProjectsResult", func() {
+	Description("Projects re-published to the indexer and FGA sync by a resync.")
+	Attribute("project_uids", ArrayOf(String), "UIDs of the projects that matched the filter", func() {
+		Example([]string{"7cad5a8d-19d0-41a4-81a6-043453daf9ee"})
+	})
+	Attribute("published", Int, "Projects whose messages were all published", func() {
+		Example(1)
+	})
+	Attribute("failed", Int, "Projects with at least one message that failed to publish", func() {
+		Example(0)
+	})
+	Attribute("missing_settings", ArrayOf(String), "UIDs of the matching projects skipped because their settings are missing", func() {
+		Example([]string{})
+	})
+	Required("project_uids", "published", "failed", "missing_settings")
+})
+
+// SlugRebuildResult is the DSL type for the outcome of a slug mapping rebuild.
+var SlugRebuildResult = Type("SlugRebuildResult", func() {
+	Description("Slug to UID mappings rebuilt from the project documents.")
+	Attribute("projects", Int, "Projects scanned", func() {
+		Example(120)
+	})
+	Attribute("created_mappings", ArrayOf(String), "Slugs whose missing mapping was created", func() {
+		Example([]string{"cncf"})
+	})
+	Attribute("updated_mappings", ArrayOf(String), "Slugs whose mapping pointed at a missing project, or at one with another slug, and now points at the project with the slug", func() {
+		Example([]string{})
+	})
+	Attribute("conflicting_slugs", ArrayOf(String), "Slugs held by more than one project, whose mappings were left as they are", func() {
+		Example([]string{})
+	})
+	Attribute("dangling_mappings", ArrayOf(String), "Slugs whose mapping points at a missing project, or at one that has since changed its slug, and that no project holds", func() {
+		Example([]string{"old-slug"})
+	})
+	Attribute("removed_mappings", Int, "Dangling mappings removed", func() {
+		Example(0)
+	})
+	Attribute("failed", Int, "Mappings that could not be written or removed", func() {
+		Example(0)
+	})
+	Required("projects", "created_mappings", "updated_mappings", "conflicting_slugs", "dangling_mappings", "removed_mappings", "failed")
+})
+
+// KVEntry is the DSL type for one revision of a key of a KV bucket.
+var KVEntry = Type("KVEntry", func() {
+	Description("One revision of a key of a NATS KV bucket, as stored.")
+	Attribute("revision", UInt64, "Revision of the entry in the bucket", func() {
+		Example(42)
+	})
+	Attribute("created", String, "When the entry was written", func() {
+		Format(FormatDateTime)
+		Example("2025-01-01T00:00:00Z")
+	})
+	Attribute("operation", String, "Whether the entry wrote a value, or deleted or purged the key", func() {
+		Enum("put", "delete", "purge")
+		Example("put")
+	})
+	Attribute("value", Any, "The document written, decompressed but otherwise as stored; a string when it is not valid JSON")
+	Required("revision", "created", "operation")
+})
+
+// KVKeyInspection is the DSL type for the entries of one key of a KV bucket.
+var KVKeyInspection = Type("KVKeyInspection", func() {
+	Description("The current entry and the retained history of one key of a NATS KV bucket.")
+	Attribute("bucket", String, "Name of the bucket", func() {
+		Example("project-settings")
+	})
+	Attribute("key", String, "The key", func() {
+		Example("7cad5a8d-19d0-41a4-81a6-043453daf9ee")
+	})
+	Attribute("current", KVEntry, "The latest entry of the key, which may be a delete marker; absent when the key has no entry")
+	Attribute("history", ArrayOf(KVEntry), "The retained entries of the key, oldest first, the current one included")
+	Required("bucket", "key", "history")
+})
+
+// ProjectKVInspection is the DSL type for what is stored for a project.
+var ProjectKVInspection = Type("ProjectKVInspection", func() {
+	Description("The entries stored for a project's base and settings in the NATS KV buckets.")
+	ResourceUIDAttribute("project_uid", "Project UID")
+	Attribute("base", KVKeyInspection, "The project base in the projects bucket")
+	Attribute("settings", KVKeyInspection, "The project settings in the project-settings bucket")
+	Required("project_uid", "base", "settings")
+})
+
+// IntegrityReport is the DSL type for the report of the last integrity scan.
+var IntegrityReport = Type("IntegrityReport", func() {
+	Description("Inconsistencies found in the project store by the last integrity scan.")
+	Attribute("scanned_at", String, "When the scan started", func() {
+		Format(FormatDateTime)
+		Example("2025-01-01T00:00:00Z")
+	})
+	Attribute("found", Int, "Number of inconsistencies found", func() {
+		Example(1)
+	})
+	Attribute("dangling_slug_mappings", ArrayOf(String), "Slugs whose mapping points at a missing project, or at one that has since changed its slug", func() {
+		Example([]string{})
+	})
+	Attribute("missing_slug_mappings", ArrayOf(String), "UIDs of projects without a slug mapping", func() {
+		Example([]string{})
+	})
+	Attribute("dangling_legacy_id_mappings", ArrayOf(String), "Legacy IDs whose mapping points at a missing project, or at one that has since changed its legacy ID", func() {
+		Example([]string{})
+	})
+	Attribute("missing_legacy_id_mappings", ArrayOf(String), "UIDs of projects whose legacy ID is not mapped", func() {
+		Example([]string{})
+	})
+	Attribute("orphaned_settings", ArrayOf(String), "UIDs of project settings without a project", func() {
+		Example([]string{})
+	})
+	Attribute("orphaned_children", ArrayOf(String), "UIDs of projects whose parent project is missing", func() {
+		Example([]string{"7cad5a8d-19d0-41a4-81a6-043453daf9ee"})
+	})
+	Attribute("invalid_entries", ArrayOf(String), "Entries that could not be decoded, as the bucket name and key joined with a slash", func() {
+		Example([]string{})
+	})
+	Required("scanned_at", "found", "dangling_slug_mappings", "missing_slug_mappings", "dangling_legacy_id_mappings",
+		"missing_legacy_id_mappings", "orphaned_settings", "orphaned_children", "invalid_entries")
+})
+
+// DeadLetter is the DSL type for a NATS event whose handler failed.
+var DeadLetter = Type("DeadLetter", func() {
+	Description("A NATS event whose handler failed, kept until it is replayed.")
+	Attribute("id", String, "Dead letter ID", func() {
+		Format(FormatUUID)
+		Example("6f3b1c2a-9d4e-4f5a-8b7c-1d2e3f4a5b6c")
+	})
+	Attribute("subject", String, "Subject the event was received on", func() {
+		Example("lfx.projects-api.project_settings.updated")
+	})
+	Attribute("payload", String, "Original message body", func() {
+		Example(`{"project_uid":"7cad5a8d-19d0-41a4-81a6-043453daf9ee"}`)
+	})
+	Attribute("error", String, "Error returned by the last failed attempt", func() {
+		Example("context deadline exceeded")
+	})
+	Attribute("attempts", Int, "Failed attempts: the original delivery and each failed replay", func() {
+		Example(1)
+	})
+	Attribute("failed_at", String, "When the event first failed", func() {
+		Format(FormatDateTime)
+		Example("2025-01-01T00:00:00Z")
+	})
+	Attribute("last_failed_at", String, "When the event last failed", func() {
+		Format(FormatDateTime)
+		Example("2025-01-01T00:00:00Z")
+	})
+	Required("id", "subject", "payload", "error", "attempts", "failed_at", "last_failed_at")
+})
+
+// DeadLetterList is the DSL type for the list of dead-lettered events.
+var DeadLetterList = Type("DeadLetterList", func() {
+	Description("Dead-lettered NATS events, oldest first.")
+	Attribute("dead_letters", ArrayOf(DeadLetter), "Dead-lettered events")
+	Required("dead_letters")
+})
+
+// ReplayDeadLetterResult is the DSL type for the result of a dead letter replay.
+var ReplayDeadLetterResult = Type("ReplayDeadLetterResult", func() {
+	Description("Outcome of replaying a dead-lettered NATS event.")
+	Attribute("replayed", Boolean, "Whether the handler succeeded and the dead letter was removed", func() {
+		Example(true)
+	})
+	Attribute("dead_letter", DeadLetter, "The replayed event, with the new error and attempt count if the replay failed")
+	Required("replayed", "dead_letter")
+})
+
+//
+// Backup types
+//
+
+// BackupResult is the DSL type for the outcome of a backup of the project stores.
+var BackupResult = Type("BackupResult", func() {
+	Description("Backup archive of the projects and project-settings stores.")
+	BackupNameAttribute()
+	Attribute("entries", Int, "Entries saved, including slug and legacy ID mappings", func() {
+		Example(360)
+	})
+	Attribute("projects", Int, "Projects saved", func() {
+		Example(120)
+	})
+	Required("name", "entries", "projects")
+})
+
+// BackupNameAttribute is the DSL attribute for the name of a backup archive.
+func BackupNameAttribute() {
+	Attribute("name", String, "Backup name", func() {
+		Example("20250101T000000Z.ndjson")
+	})
+}
+
+// Backup is the DSL type for a stored backup archive.
+var Backup = Type("Backup", func() {
+	Description("A stored backup archive.")
+	BackupNameAttribute()
+	Attribute("size", Int64, "Archive size in bytes", func() {
+		Example(524288)
+	})
+	Attribute("created_at", String, "When the archive was stored", func() {
+		Format(FormatDateTime)
+		Example("2025-01-01T00:00:00Z")
+	})
+	Required("name", "size", "created_at")
+})
+
+// BackupList is the DSL type for the list of stored backup archives.
+var BackupList = Type("BackupList", func() {
+	Description("Stored backup archives, newest first.")
+	Attribute("backups", ArrayOf(Backup), "Backup archives")
+	Required("backups")
+})
+
+// RestoreBackupResult is the DSL type for the outcome of a restore from a backup.
+var RestoreBackupResult = Type("RestoreBackupResult", func() {
+	Description("Entries of a backup archive written back to the project stores.")
+	Attribute("backup", String, "Backup name", func() {
+		Example("20250101T000000Z.ndjson")
+	})
+	Attribute("project_uids", ArrayOf(String), "Projects restored, or that would be in a dry run", func() {
+		Example([]string{"7cad5a8d-19d0-41a4-81a6-043453daf9ee"})
+	})
+	Attribute("entries", Int, "Entries written, or that would be in a dry run", func() {
+		Example(3)
+	})
+	Attribute("failed", Int, "Entries that could not be written", func() {
+		Example(0)
+	})
+	Required("backup", "project_uids", "entries", "failed")
+})
+
+//
+// Announcement types
+//
+
+// PendingAnnouncement is the DSL type for a project announcement that has not been published.
+var PendingAnnouncement = Type("PendingAnnouncement", func() {
+	Description("A project whose announcement has not been published yet.")
+	ResourceUIDAttribute("project_uid", "Project UID")
+	ProjectSlugAttribute()
+	ProjectNameAttribute()
+	Attribute("announcement_date", String, "Date the project is announced", func() {
+		Format(FormatDate)
+		Example("2025-06-01")
+	})
+	Attribute("due", Boolean, "Whether the date is reached, so that the announcement is published on the next run of the scheduler", func() {
+		Example(false)
+	})
+	Required("project_uid", "slug", "name", "announcement_date", "due")
+})
+
+// PendingAnnouncementList is the DSL type for the list of pending project announcements.
+var PendingAnnouncementList = Type("PendingAnnouncementList", func() {
+	Description("Pending project announcements, earliest date first.")
+	Attribute("announcements", ArrayOf(PendingAnnouncement), "Pending announcements")
+	Required("announcements")
+})
+
+//
+// Webhook types
+//
+
+// WebhookIDAttribute is the DSL attribute for a webhook ID.
+func WebhookIDAttribute() {
+	Attribute("id", String, "Webhook ID", func() {
+		Format(FormatUUID)
+		Example("0b1f9a3e-5c2d-4e7f-9a8b-6c5d4e3f2a1b")
+	})
+}
+
+// WebhookEventsAttribute is the DSL attribute for the events a webhook receives.
+func WebhookEventsAttribute() {
+	Attribute("events", ArrayOf(String, func() {
+		Enum("project.created", "project.updated", "project.deleted", "project.settings.updated", "project.announced", "project.legal_changed", "project.merged", "project.transferred")
+	}), "Events sent to the webhook; every event when empty", func() {
+		Example([]string{"project.created", "project.deleted"})
+	})
+}
+
+// Webhook is the DSL type for a registered webhook.
+var Webhook = Type("Webhook", func() {
+	Description("An HTTPS endpoint that receives signed POSTs for project lifecycle events.")
+	WebhookIDAttribute()
+	Attribute("url", String, "HTTPS URL the events are POSTed to", func() {
+		Example("https://hooks.example.org/lfx/projects")
+	})
+	Attribute("project_uid", String, "Project the webhook is limited to; empty for every project", func() {
+		Example("7cad5a8d-19d0-41a4-81a6-043453daf9ee")
+	})
+	WebhookEventsAttribute()
+	Attribute("description", String, "What the webhook is for", func() {
+		Example("Sync project changes to the foundation CRM")
+	})
+	Attribute("secret", String, "Key of the HMAC-SHA256 signature in the X-LFX-Signature-256 header, only returned when the webhook is created", func() {
+		Example("3f9c2b7a1e4d6c8b0a5f7e9d1c3b5a7f9e1d3c5b7a9f1e3d5c7b9a1f3e5d7c9b")
+	})
+	ResourceCreatedByAttribute("created_by")
+	ResourceTimestampAttribute("created_at")
+	Required("id", "url", "events", "created_at")
+})
+
+// WebhookList is the DSL type for the list of registered webhooks.
+var WebhookList = Type("WebhookList", func() {
+	Description("Registered webhooks, oldest first.")
+	Attribute("webhooks", ArrayOf(Webhook), "Registered webhooks")
+	Required("webhooks")
+})
+
+// WebhookDelivery is the DSL type for an event sent to a webhook.
+var WebhookDelivery = Type("WebhookDelivery", func() {
+	Description("A project lifecycle event sent, or still being retried, to a webhook.")
+	Attribute("id", String, "Delivery ID, the ID of the event and the X-LFX-Delivery header value", func() {
+		Format(FormatUUID)
+		Example("6f3b1c2a-9d4e-4f5a-8b7c-1d2e3f4a5b6c")
+	})
+	Attribute("event", String, "Event type", func() {
+		Example("project.updated")
+	})
+	Attribute("project_uid", String, "Project the event is about", func() {
+		Example("7cad5a8d-19d0-41a4-81a6-043453daf9ee")
+	})
+	Attribute("status", String, "Delivery status", func() {
+		Enum("pending", "delivered", "failed")
+		Example("delivered")
+	})
+	Attribute("attempts", Int, "Requests made so far", func() {
+		Example(1)
+	})
+	Attribute("response_status", Int, "HTTP status of the last response; absent if no response was received", func() {
+		Example(204)
+	})
+	Attribute("last_error", String, "Why the last attempt failed", func() {
+		Example("webhook endpoint returned 503 Service Unavailable")
+	})
+	ResourceTimestampAttribute("created_at")
+	Attribute("last_attempt_at", String, "When the last request was made", func() {
+		Format(FormatDateTime)
+		Example("2025-01-01T00:00:00Z")
+	})
+	Attribute("next_attempt_at", String, "When a pending delivery is next retried", func() {
+		Format(FormatDateTime)
+		Example("2025-01-01T00:01:00Z")
+	})
+	Attribute("delivered_at", String, "When the endpoint accepted the event", func() {
+		Format(FormatDateTime)
+		Example("2025-01-01T00:00:00Z")
+	})
+	Required("id", "event", "project_uid", "status", "attempts", "created_at")
+})
+
+// WebhookDeliveryList is the DSL type for the deliveries of a webhook.
+var WebhookDeliveryList = Type("WebhookDeliveryList", func() {
+	Description("Events sent to a webhook, newest first.")
+	Attribute("deliveries", ArrayOf(WebhookDelivery), "Webhook deliveries")
+	Required("deliveries")
+})
+
+//
+// ProjectTemplate types
+//
+
+// ProjectTemplateNameAttribute is the DSL attribute for the name of a project template.
+func ProjectTemplateNameAttribute() {
+	Attribute("name", String, "Name of the template, lowercase alphanumerics separated by '-'", func() {
+		Pattern(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+		MaxLength(63)
+		Example("cncf-sandbox")
+	})
+}
+
+// ProjectTemplateFieldsAttributes are the DSL attributes for the project
+// defaults set by a template.
+func ProjectTemplateFieldsAttributes() {
+	Attribute("description", String, "What the template is for", func() {
+		MaxLength(500)
+		Example("CNCF sandbox projects")
+	})
+	ProjectStageAttribute()
+	ProjectCategoryAttribute()
+	ProjectFundingModelAttribute()
+	ProjectMissionStatementAttribute()
+	ProjectAuditorsAttribute()
+}
+
+// ProjectEnums is the DSL type for the values allowed for the enumerated project fields.
+var ProjectEnums = Type("ProjectEnums", func() {
+	Description("The values allowed for the enumerated project fields.")
+	ProjectEnumsAttributes()
+	Attribute("updated_by", String, "The principal that last changed the allowed values; unset for the defaults", func() {
+		Example("admin")
+	})
+	Attribute("updated_at", String, "When the allowed values were last changed; unset for the defaults", func() {
+		Format(FormatDateTime)
+		Example("2024-01-01T00:00:00Z")
+	})
+	Required("stages", "categories")
+})
+
+// ProjectEnumsAttributes are the lists of allowed values of ProjectEnums.
+func ProjectEnumsAttributes() {
+	Attribute("stages", ArrayOf(String), "The stages a project may have", func() {
+		MinLength(1)
+		Example([]string{"Formation - Exploratory", "Active", "Archived"})
+	})
+	Attribute("categories", ArrayOf(String), "The categories a project may have", func() {
+		MinLength(1)
+		Example([]string{"Sandbox", "Incubating", "Graduated"})
+	})
+}
+
+// ProjectTemplate is the DSL type for a project template.
+var ProjectTemplate = Type("ProjectTemplate", func() {
+	Description("Defaults of the projects created from a template. Fields set when creating a project take precedence.")
+	ProjectTemplateNameAttribute()
+	ProjectTemplateFieldsAttributes()
+	ResourceCreatedByAttribute("created_by")
+	ResourceTimestampAttribute("created_at")
+	ResourceTimestampAttribute("updated_at")
+	Required("name", "created_at", "updated_at")
+})
+
+// ProjectTemplateList is the DSL type for the list of project templates.
+var ProjectTemplateList = Type("ProjectTemplateList", func() {
+	Description("Project templates, sorted by name.")
+	Attribute("templates", ArrayOf(ProjectTemplate), "Project templates")
+	Required("templates")
+})
+
+//
+// Project deletion types
+//
+
+// ProjectDeletion is the DSL type for the tombstone of a deleted project.
+var ProjectDeletion = Type("ProjectDeletion", func() {
+	Description("Tombstone of a deleted project.")
+	ProjectUIDAttribute()
+	ProjectSlugAttribute()
+	ResourceTimestampAttribute("deleted_at")
+	Attribute("deleted_by", String, "Username of the principal who deleted the project", func() {
+		Example("johndoe")
+	})
+	Attribute("merged_into", String, "UID of the project the project was merged into, when it was deleted by a merge", func() {
+		Example("4b1f3e9c-2d7a-4c1b-9e8f-0a1b2c3d4e5f")
+		Format(FormatUUID)
+	})
+	Required("uid", "slug", "deleted_at")
+})
+
+// ProjectDeletionList is the DSL type for the list of project tombstones.
+var ProjectDeletionList = Type("ProjectDeletionList", func() {
+	Description("Tombstones of deleted projects, oldest first.")
+	Attribute("deletions", ArrayOf(ProjectDeletion), "Tombstones of deleted projects")
+	Required("deletions")
+})
+
+//
+// Project event types
+//
+
+// ProjectEvent is the DSL type for a project lifecycle event reconstructed
+// from the project's history.
+var ProjectEvent = Type("ProjectEvent", func() {
+	Description("A project lifecycle event reconstructed from a stored revision of the project's base or settings. The payload fields set depend on the type, as in the lifecycle events published on NATS.")
+	Attribute("id", String, "Event ID, the same each time the event is reconstructed from the same revision", func() {
+		Example("3f2b8c1e-5d4a-5b6c-8d7e-9f0a1b2c3d4e")
+		Format(FormatUUID)
+	})
+	Attribute("type", String, "Event type", func() {
+		Enum("project.created", "project.updated", "project.deleted", "project.settings.updated")
+		Example("project.updated")
+	})
+	ResourceTimestampAttribute("occurred_at")
+	ResourceUIDAttribute("project_uid", "UID of the project")
+	Attribute("revision", UInt64, "Revision of the stored project base, or of the settings for project.settings.updated, that the event stands for", func() {
+		Example(7)
+	})
+	Attribute("project", ProjectBase, "The project as written, or as it was before project.deleted")
+	Attribute("previous_project", ProjectBase, "The project before project.updated, when that revision is still retained")
+	Attribute("settings", ProjectSettings, "The settings as written")
+	Attribute("previous_settings", ProjectSettings, "The settings before project.settings.updated, when that revision is still retained")
+	Required("id", "type", "occurred_at", "project_uid", "revision")
+})
+
+// ProjectEventList is the DSL type for the lifecycle events of a project.
+var ProjectEventList = Type("ProjectEventList", func() {
+	Description("Lifecycle events of a project, oldest first.")
+	Attribute("events", ArrayOf(ProjectEvent), "Lifecycle events of the project")
+	Required("events")
+})
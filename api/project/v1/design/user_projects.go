@@ -0,0 +1,112 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package design
+
+import (
+	//nolint:staticcheck // ST1001: the recommended way of using the goa GSL package is with the . import
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("project-service", func() {
+	Method("get-user-projects", func() {
+		Description("List the projects in which a user is a writer, auditor or meeting coordinator. Users may only list their own projects, unless they are LF staff.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			ProjectMemberUsernameAttribute()
+			Required("username")
+		})
+
+		Result(func() {
+			Attribute("projects", ArrayOf(UserProject), "Projects in which the user holds a role, ordered by slug")
+			Required("projects")
+		})
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			GET("/users/{username}/projects")
+			Params(func() {
+				Param("version:v")
+				Param("username")
+			})
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("export-user-data", func() {
+		Description("Export everything the service stores about a user, for data-subject access requests: each writer, auditor, meeting coordinator and contact role the user holds, with the name, email and avatar stored with it and when the user was given the role. Users may only export their own data, unless they are LF staff.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			VersionAttribute()
+			ProjectMemberUsernameAttribute()
+			Required("username")
+		})
+
+		Result(UserDataExport)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Forbidden", ForbiddenError, "Forbidden")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			GET("/users/{username}/data-export")
+			Params(func() {
+				Param("version:v")
+				Param("username")
+			})
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("remove-user-access", func() {
+		Description("Remove a user from the writers, auditors and meeting coordinators of every project, for employee departures and erasure requests. Only LF staff may remove a user's access. Each project is updated on its own and reported with its outcome; a project that fails does not stop the others.")
+
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+			XSyncAttribute()
+			VersionAttribute()
+			ProjectMemberUsernameAttribute()
+			Required("username")
+		})
+
+		Result(UserAccessRemovalResult)
+
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+
+		HTTP(func() {
+			DELETE("/users/{username}/access")
+			Params(func() {
+				Param("version:v")
+				Param("username")
+			})
+			Header("bearer_token:Authorization")
+			Header("x_sync:X-Sync")
+			Response(StatusOK)
+		})
+	})
+})
@@ -0,0 +1,134 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package design
+
+import (
+	//nolint:staticcheck // ST1001: the recommended way of using the goa GSL package is with the . import
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("project-service", func() {
+	Method("create-webhook", func() {
+		Description("Register an HTTPS endpoint that receives signed POSTs for project lifecycle events, for LF staff. The signing secret is only returned by this call.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+			Attribute("url", String, "HTTPS URL the events are POSTed to", func() {
+				Format(FormatURI)
+				Example("https://hooks.example.org/lfx/projects")
+			})
+			Attribute("project_uid", String, "Only send events for this project; every project when omitted", func() {
+				Format(FormatUUID)
+				Example("7cad5a8d-19d0-41a4-81a6-043453daf9ee")
+			})
+			WebhookEventsAttribute()
+			Attribute("description", String, "What the webhook is for", func() {
+				MaxLength(500)
+				Example("Sync project changes to the foundation CRM")
+			})
+			Required("url")
+		})
+		Result(Webhook)
+		Error("BadRequest", BadRequestError, "Bad request")
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			POST("/admin/webhooks")
+			Header("bearer_token:Authorization")
+			Response(StatusCreated)
+		})
+	})
+
+	Method("list-webhooks", func() {
+		Description("List the registered webhooks, without their signing secrets.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+		})
+		Result(WebhookList)
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			GET("/admin/webhooks")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("get-webhook", func() {
+		Description("Get a registered webhook, without its signing secret.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+			WebhookIDAttribute()
+			Required("id")
+		})
+		Result(Webhook)
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("NotFound", NotFoundError, "Webhook not found")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			GET("/admin/webhooks/{id}")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+
+	Method("delete-webhook", func() {
+		Description("Remove a webhook along with its delivery history. Pending retries are dropped.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeWrite)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+			WebhookIDAttribute()
+			Required("id")
+		})
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("NotFound", NotFoundError, "Webhook not found")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			DELETE("/admin/webhooks/{id}")
+			Header("bearer_token:Authorization")
+			Response(StatusNoContent)
+		})
+	})
+
+	Method("list-webhook-deliveries", func() {
+		Description("List the events sent, or still being retried, to a webhook, newest first.")
+		Meta("swagger:generate", "false")
+		Security(JWTAuth, func() {
+			Scope(ScopeRead)
+		})
+		Payload(func() {
+			BearerTokenAttribute()
+			WebhookIDAttribute()
+			Required("id")
+		})
+		Result(WebhookDeliveryList)
+		Error("Forbidden", ForbiddenError, "Forbidden, the principal is not LF staff")
+		Error("NotFound", NotFoundError, "Webhook not found")
+		Error("InternalServerError", InternalServerError, "Internal server error")
+		Error("ServiceUnavailable", ServiceUnavailableError, "Service unavailable")
+		HTTP(func() {
+			GET("/admin/webhooks/{id}/deliveries")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+		})
+	})
+})
@@ -25,13 +25,13 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() []string {
 	return []string{
-		"project-service (get-projects|create-project|get-one-project-base|get-one-project-settings|update-project-base|update-project-settings|delete-project|readyz|livez|create-project-link|get-project-link|delete-project-link|create-project-folder|get-project-folder|delete-project-folder|upload-project-document|get-project-document|download-project-document|delete-project-document)",
+		"project-service (clone-project|upload-project-logo|merge-project|transfer-project|create-project-link|get-project-link|delete-project-link|create-project-folder|get-project-folder|delete-project-folder|upload-project-document|get-project-document|download-project-document|delete-project-document|upload-project-charter|get-project-charter|get-project-associations|update-project-associations|get-project-settings-diff|get-project-children|get-project-descendants|get-project-legal-lineage|search-projects|autocomplete-projects|add-project-writer|remove-project-writer|add-project-auditor|remove-project-auditor|join-project|bulk-update-project-members|get-projects|create-project|get-one-project-base|get-project-uid-from-legacy-id|get-one-project-settings|update-project-base|update-project-settings|delete-project|bulk-delete-projects|readyz|livez|healthz|reconcile-outbox|resync-projects|list-dead-letters|replay-dead-letter|rebuild-slug-mappings|get-integrity-report|inspect-project-kv|create-backup|list-backups|restore-backup|list-pending-announcements|get-project-enums|update-project-enums|create-project-template|list-project-templates|get-project-template|update-project-template|delete-project-template|list-project-deletions|list-project-events|get-user-projects|export-user-data|remove-user-access|get-project-visibility-impact|create-webhook|list-webhooks|get-webhook|delete-webhook|list-webhook-deliveries)",
 	}
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + " " + "project-service get-projects --version \"1\" --bearer-token \"eyJhbGci...\"" + "\n" +
+	return os.Args[0] + " " + "project-service clone-project --body '{\n      \"category\": \"Active\",\n      \"description\": \"project foo is a project about bar\",\n      \"mission_statement\": \"The mission of the project is to build a sustainable ecosystem around open source projects to accelerate technology development and industry adoption.\",\n      \"name\": \"Foo Foundation\",\n      \"parent_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"public\": true,\n      \"slug\": \"project-slug\",\n      \"stage\": \"Formation - Exploratory\"\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true" + "\n" +
 		""
 }
 
@@ -43,57 +43,44 @@ func ParseEndpoint(
 	enc func(*http.Request) goahttp.Encoder,
 	dec func(*http.Response) goahttp.Decoder,
 	restore bool,
+	projectServiceUploadProjectLogoEncoderFn projectservicec.ProjectServiceUploadProjectLogoEncoderFunc,
 	projectServiceUploadProjectDocumentEncoderFn projectservicec.ProjectServiceUploadProjectDocumentEncoderFunc,
+	projectServiceUploadProjectCharterEncoderFn projectservicec.ProjectServiceUploadProjectCharterEncoderFunc,
 ) (goa.Endpoint, any, error) {
 	var (
 		projectServiceFlags = flag.NewFlagSet("project-service", flag.ContinueOnError)
 
-		projectServiceGetProjectsFlags           = flag.NewFlagSet("get-projects", flag.ExitOnError)
-		projectServiceGetProjectsVersionFlag     = projectServiceGetProjectsFlags.String("version", "", "")
-		projectServiceGetProjectsBearerTokenFlag = projectServiceGetProjectsFlags.String("bearer-token", "", "")
-
-		projectServiceCreateProjectFlags           = flag.NewFlagSet("create-project", flag.ExitOnError)
-		projectServiceCreateProjectBodyFlag        = projectServiceCreateProjectFlags.String("body", "REQUIRED", "")
-		projectServiceCreateProjectVersionFlag     = projectServiceCreateProjectFlags.String("version", "", "")
-		projectServiceCreateProjectBearerTokenFlag = projectServiceCreateProjectFlags.String("bearer-token", "", "")
-		projectServiceCreateProjectXSyncFlag       = projectServiceCreateProjectFlags.String("x-sync", "", "")
-
-		projectServiceGetOneProjectBaseFlags           = flag.NewFlagSet("get-one-project-base", flag.ExitOnError)
-		projectServiceGetOneProjectBaseUIDFlag         = projectServiceGetOneProjectBaseFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
-		projectServiceGetOneProjectBaseVersionFlag     = projectServiceGetOneProjectBaseFlags.String("version", "", "")
-		projectServiceGetOneProjectBaseBearerTokenFlag = projectServiceGetOneProjectBaseFlags.String("bearer-token", "", "")
-
-		projectServiceGetOneProjectSettingsFlags           = flag.NewFlagSet("get-one-project-settings", flag.ExitOnError)
-		projectServiceGetOneProjectSettingsUIDFlag         = projectServiceGetOneProjectSettingsFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
-		projectServiceGetOneProjectSettingsVersionFlag     = projectServiceGetOneProjectSettingsFlags.String("version", "", "")
-		projectServiceGetOneProjectSettingsBearerTokenFlag = projectServiceGetOneProjectSettingsFlags.String("bearer-token", "", "")
-
-		projectServiceUpdateProjectBaseFlags           = flag.NewFlagSet("update-project-base", flag.ExitOnError)
-		projectServiceUpdateProjectBaseBodyFlag        = projectServiceUpdateProjectBaseFlags.String("body", "REQUIRED", "")
-		projectServiceUpdateProjectBaseUIDFlag         = projectServiceUpdateProjectBaseFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
-		projectServiceUpdateProjectBaseVersionFlag     = projectServiceUpdateProjectBaseFlags.String("version", "", "")
-		projectServiceUpdateProjectBaseBearerTokenFlag = projectServiceUpdateProjectBaseFlags.String("bearer-token", "", "")
-		projectServiceUpdateProjectBaseXSyncFlag       = projectServiceUpdateProjectBaseFlags.String("x-sync", "", "")
-		projectServiceUpdateProjectBaseIfMatchFlag     = projectServiceUpdateProjectBaseFlags.String("if-match", "", "")
-
-		projectServiceUpdateProjectSettingsFlags           = flag.NewFlagSet("update-project-settings", flag.ExitOnError)
-		projectServiceUpdateProjectSettingsBodyFlag        = projectServiceUpdateProjectSettingsFlags.String("body", "REQUIRED", "")
-		projectServiceUpdateProjectSettingsUIDFlag         = projectServiceUpdateProjectSettingsFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
-		projectServiceUpdateProjectSettingsVersionFlag     = projectServiceUpdateProjectSettingsFlags.String("version", "", "")
-		projectServiceUpdateProjectSettingsBearerTokenFlag = projectServiceUpdateProjectSettingsFlags.String("bearer-token", "", "")
-		projectServiceUpdateProjectSettingsXSyncFlag       = projectServiceUpdateProjectSettingsFlags.String("x-sync", "", "")
-		projectServiceUpdateProjectSettingsIfMatchFlag     = projectServiceUpdateProjectSettingsFlags.String("if-match", "", "")
-
-		projectServiceDeleteProjectFlags           = flag.NewFlagSet("delete-project", flag.ExitOnError)
-		projectServiceDeleteProjectUIDFlag         = projectServiceDeleteProjectFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
-		projectServiceDeleteProjectVersionFlag     = projectServiceDeleteProjectFlags.String("version", "", "")
-		projectServiceDeleteProjectBearerTokenFlag = projectServiceDeleteProjectFlags.String("bearer-token", "", "")
-		projectServiceDeleteProjectXSyncFlag       = projectServiceDeleteProjectFlags.String("x-sync", "", "")
-		projectServiceDeleteProjectIfMatchFlag     = projectServiceDeleteProjectFlags.String("if-match", "", "")
-
-		projectServiceReadyzFlags = flag.NewFlagSet("readyz", flag.ExitOnError)
-
-		projectServiceLivezFlags = flag.NewFlagSet("livez", flag.ExitOnError)
+		projectServiceCloneProjectFlags           = flag.NewFlagSet("clone-project", flag.ExitOnError)
+		projectServiceCloneProjectBodyFlag        = projectServiceCloneProjectFlags.String("body", "REQUIRED", "")
+		projectServiceCloneProjectUIDFlag         = projectServiceCloneProjectFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceCloneProjectVersionFlag     = projectServiceCloneProjectFlags.String("version", "", "")
+		projectServiceCloneProjectBearerTokenFlag = projectServiceCloneProjectFlags.String("bearer-token", "", "")
+		projectServiceCloneProjectXSyncFlag       = projectServiceCloneProjectFlags.String("x-sync", "", "")
+
+		projectServiceUploadProjectLogoFlags           = flag.NewFlagSet("upload-project-logo", flag.ExitOnError)
+		projectServiceUploadProjectLogoBodyFlag        = projectServiceUploadProjectLogoFlags.String("body", "REQUIRED", "")
+		projectServiceUploadProjectLogoUIDFlag         = projectServiceUploadProjectLogoFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceUploadProjectLogoVersionFlag     = projectServiceUploadProjectLogoFlags.String("version", "", "")
+		projectServiceUploadProjectLogoBearerTokenFlag = projectServiceUploadProjectLogoFlags.String("bearer-token", "", "")
+		projectServiceUploadProjectLogoXSyncFlag       = projectServiceUploadProjectLogoFlags.String("x-sync", "", "")
+
+		projectServiceMergeProjectFlags           = flag.NewFlagSet("merge-project", flag.ExitOnError)
+		projectServiceMergeProjectUIDFlag         = projectServiceMergeProjectFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceMergeProjectTargetUIDFlag   = projectServiceMergeProjectFlags.String("target-uid", "REQUIRED", "UID of the project to merge the project into")
+		projectServiceMergeProjectVersionFlag     = projectServiceMergeProjectFlags.String("version", "", "")
+		projectServiceMergeProjectBearerTokenFlag = projectServiceMergeProjectFlags.String("bearer-token", "", "")
+		projectServiceMergeProjectXSyncFlag       = projectServiceMergeProjectFlags.String("x-sync", "", "")
+		projectServiceMergeProjectIfMatchFlag     = projectServiceMergeProjectFlags.String("if-match", "", "")
+		projectServiceMergeProjectForceUpdateFlag = projectServiceMergeProjectFlags.String("force-update", "", "")
+
+		projectServiceTransferProjectFlags           = flag.NewFlagSet("transfer-project", flag.ExitOnError)
+		projectServiceTransferProjectBodyFlag        = projectServiceTransferProjectFlags.String("body", "REQUIRED", "")
+		projectServiceTransferProjectUIDFlag         = projectServiceTransferProjectFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceTransferProjectVersionFlag     = projectServiceTransferProjectFlags.String("version", "", "")
+		projectServiceTransferProjectBearerTokenFlag = projectServiceTransferProjectFlags.String("bearer-token", "", "")
+		projectServiceTransferProjectXSyncFlag       = projectServiceTransferProjectFlags.String("x-sync", "", "")
+		projectServiceTransferProjectIfMatchFlag     = projectServiceTransferProjectFlags.String("if-match", "", "")
+		projectServiceTransferProjectForceUpdateFlag = projectServiceTransferProjectFlags.String("force-update", "", "")
 
 		projectServiceCreateProjectLinkFlags           = flag.NewFlagSet("create-project-link", flag.ExitOnError)
 		projectServiceCreateProjectLinkBodyFlag        = projectServiceCreateProjectLinkFlags.String("body", "REQUIRED", "")
@@ -115,6 +102,7 @@ func ParseEndpoint(
 		projectServiceDeleteProjectLinkBearerTokenFlag = projectServiceDeleteProjectLinkFlags.String("bearer-token", "", "")
 		projectServiceDeleteProjectLinkXSyncFlag       = projectServiceDeleteProjectLinkFlags.String("x-sync", "", "")
 		projectServiceDeleteProjectLinkIfMatchFlag     = projectServiceDeleteProjectLinkFlags.String("if-match", "", "")
+		projectServiceDeleteProjectLinkForceUpdateFlag = projectServiceDeleteProjectLinkFlags.String("force-update", "", "")
 
 		projectServiceCreateProjectFolderFlags           = flag.NewFlagSet("create-project-folder", flag.ExitOnError)
 		projectServiceCreateProjectFolderBodyFlag        = projectServiceCreateProjectFolderFlags.String("body", "REQUIRED", "")
@@ -136,6 +124,7 @@ func ParseEndpoint(
 		projectServiceDeleteProjectFolderBearerTokenFlag = projectServiceDeleteProjectFolderFlags.String("bearer-token", "", "")
 		projectServiceDeleteProjectFolderXSyncFlag       = projectServiceDeleteProjectFolderFlags.String("x-sync", "", "")
 		projectServiceDeleteProjectFolderIfMatchFlag     = projectServiceDeleteProjectFolderFlags.String("if-match", "", "")
+		projectServiceDeleteProjectFolderForceUpdateFlag = projectServiceDeleteProjectFolderFlags.String("force-update", "", "")
 
 		projectServiceUploadProjectDocumentFlags           = flag.NewFlagSet("upload-project-document", flag.ExitOnError)
 		projectServiceUploadProjectDocumentBodyFlag        = projectServiceUploadProjectDocumentFlags.String("body", "REQUIRED", "")
@@ -163,17 +152,315 @@ func ParseEndpoint(
 		projectServiceDeleteProjectDocumentBearerTokenFlag = projectServiceDeleteProjectDocumentFlags.String("bearer-token", "", "")
 		projectServiceDeleteProjectDocumentXSyncFlag       = projectServiceDeleteProjectDocumentFlags.String("x-sync", "", "")
 		projectServiceDeleteProjectDocumentIfMatchFlag     = projectServiceDeleteProjectDocumentFlags.String("if-match", "", "")
+		projectServiceDeleteProjectDocumentForceUpdateFlag = projectServiceDeleteProjectDocumentFlags.String("force-update", "", "")
+
+		projectServiceUploadProjectCharterFlags           = flag.NewFlagSet("upload-project-charter", flag.ExitOnError)
+		projectServiceUploadProjectCharterBodyFlag        = projectServiceUploadProjectCharterFlags.String("body", "REQUIRED", "")
+		projectServiceUploadProjectCharterUIDFlag         = projectServiceUploadProjectCharterFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceUploadProjectCharterVersionFlag     = projectServiceUploadProjectCharterFlags.String("version", "", "")
+		projectServiceUploadProjectCharterBearerTokenFlag = projectServiceUploadProjectCharterFlags.String("bearer-token", "", "")
+		projectServiceUploadProjectCharterXSyncFlag       = projectServiceUploadProjectCharterFlags.String("x-sync", "", "")
+
+		projectServiceGetProjectCharterFlags           = flag.NewFlagSet("get-project-charter", flag.ExitOnError)
+		projectServiceGetProjectCharterUIDFlag         = projectServiceGetProjectCharterFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceGetProjectCharterVersionFlag     = projectServiceGetProjectCharterFlags.String("version", "", "")
+		projectServiceGetProjectCharterBearerTokenFlag = projectServiceGetProjectCharterFlags.String("bearer-token", "", "")
+
+		projectServiceGetProjectAssociationsFlags           = flag.NewFlagSet("get-project-associations", flag.ExitOnError)
+		projectServiceGetProjectAssociationsUIDFlag         = projectServiceGetProjectAssociationsFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceGetProjectAssociationsVersionFlag     = projectServiceGetProjectAssociationsFlags.String("version", "", "")
+		projectServiceGetProjectAssociationsBearerTokenFlag = projectServiceGetProjectAssociationsFlags.String("bearer-token", "", "")
+
+		projectServiceUpdateProjectAssociationsFlags           = flag.NewFlagSet("update-project-associations", flag.ExitOnError)
+		projectServiceUpdateProjectAssociationsBodyFlag        = projectServiceUpdateProjectAssociationsFlags.String("body", "REQUIRED", "")
+		projectServiceUpdateProjectAssociationsUIDFlag         = projectServiceUpdateProjectAssociationsFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceUpdateProjectAssociationsVersionFlag     = projectServiceUpdateProjectAssociationsFlags.String("version", "", "")
+		projectServiceUpdateProjectAssociationsBearerTokenFlag = projectServiceUpdateProjectAssociationsFlags.String("bearer-token", "", "")
+
+		projectServiceGetProjectSettingsDiffFlags           = flag.NewFlagSet("get-project-settings-diff", flag.ExitOnError)
+		projectServiceGetProjectSettingsDiffUIDFlag         = projectServiceGetProjectSettingsDiffFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceGetProjectSettingsDiffVersionFlag     = projectServiceGetProjectSettingsDiffFlags.String("version", "", "")
+		projectServiceGetProjectSettingsDiffFromFlag        = projectServiceGetProjectSettingsDiffFlags.String("from", "REQUIRED", "")
+		projectServiceGetProjectSettingsDiffToFlag          = projectServiceGetProjectSettingsDiffFlags.String("to", "REQUIRED", "")
+		projectServiceGetProjectSettingsDiffBearerTokenFlag = projectServiceGetProjectSettingsDiffFlags.String("bearer-token", "", "")
+
+		projectServiceGetProjectChildrenFlags           = flag.NewFlagSet("get-project-children", flag.ExitOnError)
+		projectServiceGetProjectChildrenUIDFlag         = projectServiceGetProjectChildrenFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceGetProjectChildrenVersionFlag     = projectServiceGetProjectChildrenFlags.String("version", "", "")
+		projectServiceGetProjectChildrenSortFlag        = projectServiceGetProjectChildrenFlags.String("sort", "name", "")
+		projectServiceGetProjectChildrenOrderFlag       = projectServiceGetProjectChildrenFlags.String("order", "asc", "")
+		projectServiceGetProjectChildrenPageSizeFlag    = projectServiceGetProjectChildrenFlags.String("page-size", "50", "")
+		projectServiceGetProjectChildrenPageTokenFlag   = projectServiceGetProjectChildrenFlags.String("page-token", "", "")
+		projectServiceGetProjectChildrenBearerTokenFlag = projectServiceGetProjectChildrenFlags.String("bearer-token", "", "")
+
+		projectServiceGetProjectDescendantsFlags           = flag.NewFlagSet("get-project-descendants", flag.ExitOnError)
+		projectServiceGetProjectDescendantsUIDFlag         = projectServiceGetProjectDescendantsFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceGetProjectDescendantsVersionFlag     = projectServiceGetProjectDescendantsFlags.String("version", "", "")
+		projectServiceGetProjectDescendantsSortFlag        = projectServiceGetProjectDescendantsFlags.String("sort", "name", "")
+		projectServiceGetProjectDescendantsOrderFlag       = projectServiceGetProjectDescendantsFlags.String("order", "asc", "")
+		projectServiceGetProjectDescendantsPageSizeFlag    = projectServiceGetProjectDescendantsFlags.String("page-size", "50", "")
+		projectServiceGetProjectDescendantsPageTokenFlag   = projectServiceGetProjectDescendantsFlags.String("page-token", "", "")
+		projectServiceGetProjectDescendantsBearerTokenFlag = projectServiceGetProjectDescendantsFlags.String("bearer-token", "", "")
+
+		projectServiceGetProjectLegalLineageFlags           = flag.NewFlagSet("get-project-legal-lineage", flag.ExitOnError)
+		projectServiceGetProjectLegalLineageUIDFlag         = projectServiceGetProjectLegalLineageFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceGetProjectLegalLineageVersionFlag     = projectServiceGetProjectLegalLineageFlags.String("version", "", "")
+		projectServiceGetProjectLegalLineageBearerTokenFlag = projectServiceGetProjectLegalLineageFlags.String("bearer-token", "", "")
+
+		projectServiceSearchProjectsFlags           = flag.NewFlagSet("search-projects", flag.ExitOnError)
+		projectServiceSearchProjectsVersionFlag     = projectServiceSearchProjectsFlags.String("version", "", "")
+		projectServiceSearchProjectsQFlag           = projectServiceSearchProjectsFlags.String("q", "REQUIRED", "")
+		projectServiceSearchProjectsLimitFlag       = projectServiceSearchProjectsFlags.String("limit", "20", "")
+		projectServiceSearchProjectsBearerTokenFlag = projectServiceSearchProjectsFlags.String("bearer-token", "", "")
+
+		projectServiceAutocompleteProjectsFlags           = flag.NewFlagSet("autocomplete-projects", flag.ExitOnError)
+		projectServiceAutocompleteProjectsVersionFlag     = projectServiceAutocompleteProjectsFlags.String("version", "", "")
+		projectServiceAutocompleteProjectsQFlag           = projectServiceAutocompleteProjectsFlags.String("q", "REQUIRED", "")
+		projectServiceAutocompleteProjectsLimitFlag       = projectServiceAutocompleteProjectsFlags.String("limit", "10", "")
+		projectServiceAutocompleteProjectsBearerTokenFlag = projectServiceAutocompleteProjectsFlags.String("bearer-token", "", "")
+
+		projectServiceAddProjectWriterFlags           = flag.NewFlagSet("add-project-writer", flag.ExitOnError)
+		projectServiceAddProjectWriterUIDFlag         = projectServiceAddProjectWriterFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceAddProjectWriterUsernameFlag    = projectServiceAddProjectWriterFlags.String("username", "REQUIRED", "The LFID username of the user")
+		projectServiceAddProjectWriterVersionFlag     = projectServiceAddProjectWriterFlags.String("version", "", "")
+		projectServiceAddProjectWriterBearerTokenFlag = projectServiceAddProjectWriterFlags.String("bearer-token", "", "")
+		projectServiceAddProjectWriterXSyncFlag       = projectServiceAddProjectWriterFlags.String("x-sync", "", "")
+		projectServiceAddProjectWriterIfMatchFlag     = projectServiceAddProjectWriterFlags.String("if-match", "", "")
+
+		projectServiceRemoveProjectWriterFlags           = flag.NewFlagSet("remove-project-writer", flag.ExitOnError)
+		projectServiceRemoveProjectWriterUIDFlag         = projectServiceRemoveProjectWriterFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceRemoveProjectWriterUsernameFlag    = projectServiceRemoveProjectWriterFlags.String("username", "REQUIRED", "The LFID username of the user")
+		projectServiceRemoveProjectWriterVersionFlag     = projectServiceRemoveProjectWriterFlags.String("version", "", "")
+		projectServiceRemoveProjectWriterBearerTokenFlag = projectServiceRemoveProjectWriterFlags.String("bearer-token", "", "")
+		projectServiceRemoveProjectWriterXSyncFlag       = projectServiceRemoveProjectWriterFlags.String("x-sync", "", "")
+		projectServiceRemoveProjectWriterIfMatchFlag     = projectServiceRemoveProjectWriterFlags.String("if-match", "", "")
+
+		projectServiceAddProjectAuditorFlags           = flag.NewFlagSet("add-project-auditor", flag.ExitOnError)
+		projectServiceAddProjectAuditorUIDFlag         = projectServiceAddProjectAuditorFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceAddProjectAuditorUsernameFlag    = projectServiceAddProjectAuditorFlags.String("username", "REQUIRED", "The LFID username of the user")
+		projectServiceAddProjectAuditorVersionFlag     = projectServiceAddProjectAuditorFlags.String("version", "", "")
+		projectServiceAddProjectAuditorBearerTokenFlag = projectServiceAddProjectAuditorFlags.String("bearer-token", "", "")
+		projectServiceAddProjectAuditorXSyncFlag       = projectServiceAddProjectAuditorFlags.String("x-sync", "", "")
+		projectServiceAddProjectAuditorIfMatchFlag     = projectServiceAddProjectAuditorFlags.String("if-match", "", "")
+
+		projectServiceRemoveProjectAuditorFlags           = flag.NewFlagSet("remove-project-auditor", flag.ExitOnError)
+		projectServiceRemoveProjectAuditorUIDFlag         = projectServiceRemoveProjectAuditorFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceRemoveProjectAuditorUsernameFlag    = projectServiceRemoveProjectAuditorFlags.String("username", "REQUIRED", "The LFID username of the user")
+		projectServiceRemoveProjectAuditorVersionFlag     = projectServiceRemoveProjectAuditorFlags.String("version", "", "")
+		projectServiceRemoveProjectAuditorBearerTokenFlag = projectServiceRemoveProjectAuditorFlags.String("bearer-token", "", "")
+		projectServiceRemoveProjectAuditorXSyncFlag       = projectServiceRemoveProjectAuditorFlags.String("x-sync", "", "")
+		projectServiceRemoveProjectAuditorIfMatchFlag     = projectServiceRemoveProjectAuditorFlags.String("if-match", "", "")
+
+		projectServiceJoinProjectFlags           = flag.NewFlagSet("join-project", flag.ExitOnError)
+		projectServiceJoinProjectUIDFlag         = projectServiceJoinProjectFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceJoinProjectVersionFlag     = projectServiceJoinProjectFlags.String("version", "", "")
+		projectServiceJoinProjectBearerTokenFlag = projectServiceJoinProjectFlags.String("bearer-token", "", "")
+		projectServiceJoinProjectXSyncFlag       = projectServiceJoinProjectFlags.String("x-sync", "", "")
+
+		projectServiceBulkUpdateProjectMembersFlags           = flag.NewFlagSet("bulk-update-project-members", flag.ExitOnError)
+		projectServiceBulkUpdateProjectMembersBodyFlag        = projectServiceBulkUpdateProjectMembersFlags.String("body", "REQUIRED", "")
+		projectServiceBulkUpdateProjectMembersVersionFlag     = projectServiceBulkUpdateProjectMembersFlags.String("version", "", "")
+		projectServiceBulkUpdateProjectMembersBearerTokenFlag = projectServiceBulkUpdateProjectMembersFlags.String("bearer-token", "", "")
+		projectServiceBulkUpdateProjectMembersXSyncFlag       = projectServiceBulkUpdateProjectMembersFlags.String("x-sync", "", "")
+
+		projectServiceGetProjectsFlags           = flag.NewFlagSet("get-projects", flag.ExitOnError)
+		projectServiceGetProjectsVersionFlag     = projectServiceGetProjectsFlags.String("version", "", "")
+		projectServiceGetProjectsTagFlag         = projectServiceGetProjectsFlags.String("tag", "", "")
+		projectServiceGetProjectsFieldsFlag      = projectServiceGetProjectsFlags.String("fields", "", "")
+		projectServiceGetProjectsBaseOnlyFlag    = projectServiceGetProjectsFlags.String("base-only", "", "")
+		projectServiceGetProjectsBearerTokenFlag = projectServiceGetProjectsFlags.String("bearer-token", "", "")
+
+		projectServiceCreateProjectFlags               = flag.NewFlagSet("create-project", flag.ExitOnError)
+		projectServiceCreateProjectBodyFlag            = projectServiceCreateProjectFlags.String("body", "REQUIRED", "")
+		projectServiceCreateProjectVersionFlag         = projectServiceCreateProjectFlags.String("version", "", "")
+		projectServiceCreateProjectAllowDuplicatesFlag = projectServiceCreateProjectFlags.String("allow-duplicates", "", "")
+		projectServiceCreateProjectTemplateFlag        = projectServiceCreateProjectFlags.String("template", "", "")
+		projectServiceCreateProjectBearerTokenFlag     = projectServiceCreateProjectFlags.String("bearer-token", "", "")
+		projectServiceCreateProjectXSyncFlag           = projectServiceCreateProjectFlags.String("x-sync", "", "")
+
+		projectServiceGetOneProjectBaseFlags           = flag.NewFlagSet("get-one-project-base", flag.ExitOnError)
+		projectServiceGetOneProjectBaseUIDFlag         = projectServiceGetOneProjectBaseFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceGetOneProjectBaseVersionFlag     = projectServiceGetOneProjectBaseFlags.String("version", "", "")
+		projectServiceGetOneProjectBaseFieldsFlag      = projectServiceGetOneProjectBaseFlags.String("fields", "", "")
+		projectServiceGetOneProjectBaseExpandFlag      = projectServiceGetOneProjectBaseFlags.String("expand", "", "")
+		projectServiceGetOneProjectBaseBearerTokenFlag = projectServiceGetOneProjectBaseFlags.String("bearer-token", "", "")
+
+		projectServiceGetProjectUIDFromLegacyIDFlags           = flag.NewFlagSet("get-project-uid-from-legacy-id", flag.ExitOnError)
+		projectServiceGetProjectUIDFromLegacyIDLegacyIDFlag    = projectServiceGetProjectUIDFromLegacyIDFlags.String("legacy-id", "REQUIRED", "The ID of the project in LFX v1 (a Salesforce record ID), used to resolve v1 references to the v2 UID")
+		projectServiceGetProjectUIDFromLegacyIDVersionFlag     = projectServiceGetProjectUIDFromLegacyIDFlags.String("version", "", "")
+		projectServiceGetProjectUIDFromLegacyIDBearerTokenFlag = projectServiceGetProjectUIDFromLegacyIDFlags.String("bearer-token", "", "")
+
+		projectServiceGetOneProjectSettingsFlags           = flag.NewFlagSet("get-one-project-settings", flag.ExitOnError)
+		projectServiceGetOneProjectSettingsUIDFlag         = projectServiceGetOneProjectSettingsFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceGetOneProjectSettingsVersionFlag     = projectServiceGetOneProjectSettingsFlags.String("version", "", "")
+		projectServiceGetOneProjectSettingsBearerTokenFlag = projectServiceGetOneProjectSettingsFlags.String("bearer-token", "", "")
+
+		projectServiceUpdateProjectBaseFlags             = flag.NewFlagSet("update-project-base", flag.ExitOnError)
+		projectServiceUpdateProjectBaseBodyFlag          = projectServiceUpdateProjectBaseFlags.String("body", "REQUIRED", "")
+		projectServiceUpdateProjectBaseUIDFlag           = projectServiceUpdateProjectBaseFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceUpdateProjectBaseVersionFlag       = projectServiceUpdateProjectBaseFlags.String("version", "", "")
+		projectServiceUpdateProjectBaseBearerTokenFlag   = projectServiceUpdateProjectBaseFlags.String("bearer-token", "", "")
+		projectServiceUpdateProjectBaseXSyncFlag         = projectServiceUpdateProjectBaseFlags.String("x-sync", "", "")
+		projectServiceUpdateProjectBaseIfMatchFlag       = projectServiceUpdateProjectBaseFlags.String("if-match", "", "")
+		projectServiceUpdateProjectBaseForceUpdateFlag   = projectServiceUpdateProjectBaseFlags.String("force-update", "", "")
+		projectServiceUpdateProjectBaseAdminOverrideFlag = projectServiceUpdateProjectBaseFlags.String("admin-override", "", "")
+
+		projectServiceUpdateProjectSettingsFlags           = flag.NewFlagSet("update-project-settings", flag.ExitOnError)
+		projectServiceUpdateProjectSettingsBodyFlag        = projectServiceUpdateProjectSettingsFlags.String("body", "REQUIRED", "")
+		projectServiceUpdateProjectSettingsUIDFlag         = projectServiceUpdateProjectSettingsFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceUpdateProjectSettingsVersionFlag     = projectServiceUpdateProjectSettingsFlags.String("version", "", "")
+		projectServiceUpdateProjectSettingsBearerTokenFlag = projectServiceUpdateProjectSettingsFlags.String("bearer-token", "", "")
+		projectServiceUpdateProjectSettingsXSyncFlag       = projectServiceUpdateProjectSettingsFlags.String("x-sync", "", "")
+		projectServiceUpdateProjectSettingsIfMatchFlag     = projectServiceUpdateProjectSettingsFlags.String("if-match", "", "")
+		projectServiceUpdateProjectSettingsForceUpdateFlag = projectServiceUpdateProjectSettingsFlags.String("force-update", "", "")
+		projectServiceUpdateProjectSettingsMergeFlag       = projectServiceUpdateProjectSettingsFlags.String("merge", "", "")
+
+		projectServiceDeleteProjectFlags           = flag.NewFlagSet("delete-project", flag.ExitOnError)
+		projectServiceDeleteProjectUIDFlag         = projectServiceDeleteProjectFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceDeleteProjectVersionFlag     = projectServiceDeleteProjectFlags.String("version", "", "")
+		projectServiceDeleteProjectBearerTokenFlag = projectServiceDeleteProjectFlags.String("bearer-token", "", "")
+		projectServiceDeleteProjectXSyncFlag       = projectServiceDeleteProjectFlags.String("x-sync", "", "")
+		projectServiceDeleteProjectIfMatchFlag     = projectServiceDeleteProjectFlags.String("if-match", "", "")
+		projectServiceDeleteProjectForceUpdateFlag = projectServiceDeleteProjectFlags.String("force-update", "", "")
+
+		projectServiceBulkDeleteProjectsFlags           = flag.NewFlagSet("bulk-delete-projects", flag.ExitOnError)
+		projectServiceBulkDeleteProjectsBodyFlag        = projectServiceBulkDeleteProjectsFlags.String("body", "REQUIRED", "")
+		projectServiceBulkDeleteProjectsVersionFlag     = projectServiceBulkDeleteProjectsFlags.String("version", "", "")
+		projectServiceBulkDeleteProjectsDryRunFlag      = projectServiceBulkDeleteProjectsFlags.String("dry-run", "", "")
+		projectServiceBulkDeleteProjectsCascadeFlag     = projectServiceBulkDeleteProjectsFlags.String("cascade", "", "")
+		projectServiceBulkDeleteProjectsForceFlag       = projectServiceBulkDeleteProjectsFlags.String("force", "", "")
+		projectServiceBulkDeleteProjectsBearerTokenFlag = projectServiceBulkDeleteProjectsFlags.String("bearer-token", "", "")
+		projectServiceBulkDeleteProjectsXSyncFlag       = projectServiceBulkDeleteProjectsFlags.String("x-sync", "", "")
+
+		projectServiceReadyzFlags = flag.NewFlagSet("readyz", flag.ExitOnError)
+
+		projectServiceLivezFlags = flag.NewFlagSet("livez", flag.ExitOnError)
+
+		projectServiceHealthzFlags = flag.NewFlagSet("healthz", flag.ExitOnError)
+
+		projectServiceReconcileOutboxFlags           = flag.NewFlagSet("reconcile-outbox", flag.ExitOnError)
+		projectServiceReconcileOutboxBearerTokenFlag = projectServiceReconcileOutboxFlags.String("bearer-token", "", "")
+
+		projectServiceResyncProjectsFlags           = flag.NewFlagSet("resync-projects", flag.ExitOnError)
+		projectServiceResyncProjectsBodyFlag        = projectServiceResyncProjectsFlags.String("body", "REQUIRED", "")
+		projectServiceResyncProjectsBearerTokenFlag = projectServiceResyncProjectsFlags.String("bearer-token", "", "")
+
+		projectServiceListDeadLettersFlags           = flag.NewFlagSet("list-dead-letters", flag.ExitOnError)
+		projectServiceListDeadLettersBearerTokenFlag = projectServiceListDeadLettersFlags.String("bearer-token", "", "")
+
+		projectServiceReplayDeadLetterFlags           = flag.NewFlagSet("replay-dead-letter", flag.ExitOnError)
+		projectServiceReplayDeadLetterIDFlag          = projectServiceReplayDeadLetterFlags.String("id", "REQUIRED", "Dead letter ID")
+		projectServiceReplayDeadLetterBearerTokenFlag = projectServiceReplayDeadLetterFlags.String("bearer-token", "", "")
+
+		projectServiceRebuildSlugMappingsFlags           = flag.NewFlagSet("rebuild-slug-mappings", flag.ExitOnError)
+		projectServiceRebuildSlugMappingsBodyFlag        = projectServiceRebuildSlugMappingsFlags.String("body", "REQUIRED", "")
+		projectServiceRebuildSlugMappingsBearerTokenFlag = projectServiceRebuildSlugMappingsFlags.String("bearer-token", "", "")
+
+		projectServiceGetIntegrityReportFlags           = flag.NewFlagSet("get-integrity-report", flag.ExitOnError)
+		projectServiceGetIntegrityReportBearerTokenFlag = projectServiceGetIntegrityReportFlags.String("bearer-token", "", "")
+
+		projectServiceInspectProjectKvFlags           = flag.NewFlagSet("inspect-project-kv", flag.ExitOnError)
+		projectServiceInspectProjectKvUIDFlag         = projectServiceInspectProjectKvFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceInspectProjectKvBearerTokenFlag = projectServiceInspectProjectKvFlags.String("bearer-token", "", "")
+
+		projectServiceCreateBackupFlags           = flag.NewFlagSet("create-backup", flag.ExitOnError)
+		projectServiceCreateBackupBearerTokenFlag = projectServiceCreateBackupFlags.String("bearer-token", "", "")
+
+		projectServiceListBackupsFlags           = flag.NewFlagSet("list-backups", flag.ExitOnError)
+		projectServiceListBackupsBearerTokenFlag = projectServiceListBackupsFlags.String("bearer-token", "", "")
+
+		projectServiceRestoreBackupFlags           = flag.NewFlagSet("restore-backup", flag.ExitOnError)
+		projectServiceRestoreBackupBodyFlag        = projectServiceRestoreBackupFlags.String("body", "REQUIRED", "")
+		projectServiceRestoreBackupNameFlag        = projectServiceRestoreBackupFlags.String("name", "REQUIRED", "Backup name")
+		projectServiceRestoreBackupBearerTokenFlag = projectServiceRestoreBackupFlags.String("bearer-token", "", "")
+
+		projectServiceListPendingAnnouncementsFlags           = flag.NewFlagSet("list-pending-announcements", flag.ExitOnError)
+		projectServiceListPendingAnnouncementsBearerTokenFlag = projectServiceListPendingAnnouncementsFlags.String("bearer-token", "", "")
+
+		projectServiceGetProjectEnumsFlags           = flag.NewFlagSet("get-project-enums", flag.ExitOnError)
+		projectServiceGetProjectEnumsVersionFlag     = projectServiceGetProjectEnumsFlags.String("version", "", "")
+		projectServiceGetProjectEnumsBearerTokenFlag = projectServiceGetProjectEnumsFlags.String("bearer-token", "", "")
+
+		projectServiceUpdateProjectEnumsFlags           = flag.NewFlagSet("update-project-enums", flag.ExitOnError)
+		projectServiceUpdateProjectEnumsBodyFlag        = projectServiceUpdateProjectEnumsFlags.String("body", "REQUIRED", "")
+		projectServiceUpdateProjectEnumsBearerTokenFlag = projectServiceUpdateProjectEnumsFlags.String("bearer-token", "", "")
+
+		projectServiceCreateProjectTemplateFlags           = flag.NewFlagSet("create-project-template", flag.ExitOnError)
+		projectServiceCreateProjectTemplateBodyFlag        = projectServiceCreateProjectTemplateFlags.String("body", "REQUIRED", "")
+		projectServiceCreateProjectTemplateBearerTokenFlag = projectServiceCreateProjectTemplateFlags.String("bearer-token", "", "")
+
+		projectServiceListProjectTemplatesFlags           = flag.NewFlagSet("list-project-templates", flag.ExitOnError)
+		projectServiceListProjectTemplatesBearerTokenFlag = projectServiceListProjectTemplatesFlags.String("bearer-token", "", "")
+
+		projectServiceGetProjectTemplateFlags           = flag.NewFlagSet("get-project-template", flag.ExitOnError)
+		projectServiceGetProjectTemplateNameFlag        = projectServiceGetProjectTemplateFlags.String("name", "REQUIRED", "Name of the template, lowercase alphanumerics separated by '-'")
+		projectServiceGetProjectTemplateBearerTokenFlag = projectServiceGetProjectTemplateFlags.String("bearer-token", "", "")
+
+		projectServiceUpdateProjectTemplateFlags           = flag.NewFlagSet("update-project-template", flag.ExitOnError)
+		projectServiceUpdateProjectTemplateBodyFlag        = projectServiceUpdateProjectTemplateFlags.String("body", "REQUIRED", "")
+		projectServiceUpdateProjectTemplateNameFlag        = projectServiceUpdateProjectTemplateFlags.String("name", "REQUIRED", "Name of the template, lowercase alphanumerics separated by '-'")
+		projectServiceUpdateProjectTemplateBearerTokenFlag = projectServiceUpdateProjectTemplateFlags.String("bearer-token", "", "")
+
+		projectServiceDeleteProjectTemplateFlags           = flag.NewFlagSet("delete-project-template", flag.ExitOnError)
+		projectServiceDeleteProjectTemplateNameFlag        = projectServiceDeleteProjectTemplateFlags.String("name", "REQUIRED", "Name of the template, lowercase alphanumerics separated by '-'")
+		projectServiceDeleteProjectTemplateBearerTokenFlag = projectServiceDeleteProjectTemplateFlags.String("bearer-token", "", "")
+
+		projectServiceListProjectDeletionsFlags           = flag.NewFlagSet("list-project-deletions", flag.ExitOnError)
+		projectServiceListProjectDeletionsVersionFlag     = projectServiceListProjectDeletionsFlags.String("version", "", "")
+		projectServiceListProjectDeletionsSinceFlag       = projectServiceListProjectDeletionsFlags.String("since", "", "")
+		projectServiceListProjectDeletionsBearerTokenFlag = projectServiceListProjectDeletionsFlags.String("bearer-token", "", "")
+
+		projectServiceListProjectEventsFlags           = flag.NewFlagSet("list-project-events", flag.ExitOnError)
+		projectServiceListProjectEventsUIDFlag         = projectServiceListProjectEventsFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceListProjectEventsVersionFlag     = projectServiceListProjectEventsFlags.String("version", "", "")
+		projectServiceListProjectEventsSinceFlag       = projectServiceListProjectEventsFlags.String("since", "", "")
+		projectServiceListProjectEventsBearerTokenFlag = projectServiceListProjectEventsFlags.String("bearer-token", "", "")
+
+		projectServiceGetUserProjectsFlags           = flag.NewFlagSet("get-user-projects", flag.ExitOnError)
+		projectServiceGetUserProjectsUsernameFlag    = projectServiceGetUserProjectsFlags.String("username", "REQUIRED", "The LFID username of the user")
+		projectServiceGetUserProjectsVersionFlag     = projectServiceGetUserProjectsFlags.String("version", "", "")
+		projectServiceGetUserProjectsBearerTokenFlag = projectServiceGetUserProjectsFlags.String("bearer-token", "", "")
+
+		projectServiceExportUserDataFlags           = flag.NewFlagSet("export-user-data", flag.ExitOnError)
+		projectServiceExportUserDataUsernameFlag    = projectServiceExportUserDataFlags.String("username", "REQUIRED", "The LFID username of the user")
+		projectServiceExportUserDataVersionFlag     = projectServiceExportUserDataFlags.String("version", "", "")
+		projectServiceExportUserDataBearerTokenFlag = projectServiceExportUserDataFlags.String("bearer-token", "", "")
+
+		projectServiceRemoveUserAccessFlags           = flag.NewFlagSet("remove-user-access", flag.ExitOnError)
+		projectServiceRemoveUserAccessUsernameFlag    = projectServiceRemoveUserAccessFlags.String("username", "REQUIRED", "The LFID username of the user")
+		projectServiceRemoveUserAccessVersionFlag     = projectServiceRemoveUserAccessFlags.String("version", "", "")
+		projectServiceRemoveUserAccessBearerTokenFlag = projectServiceRemoveUserAccessFlags.String("bearer-token", "", "")
+		projectServiceRemoveUserAccessXSyncFlag       = projectServiceRemoveUserAccessFlags.String("x-sync", "", "")
+
+		projectServiceGetProjectVisibilityImpactFlags           = flag.NewFlagSet("get-project-visibility-impact", flag.ExitOnError)
+		projectServiceGetProjectVisibilityImpactUIDFlag         = projectServiceGetProjectVisibilityImpactFlags.String("uid", "REQUIRED", "Project UID -- v2 uid, not related to v1 id directly")
+		projectServiceGetProjectVisibilityImpactVersionFlag     = projectServiceGetProjectVisibilityImpactFlags.String("version", "", "")
+		projectServiceGetProjectVisibilityImpactBearerTokenFlag = projectServiceGetProjectVisibilityImpactFlags.String("bearer-token", "", "")
+
+		projectServiceCreateWebhookFlags           = flag.NewFlagSet("create-webhook", flag.ExitOnError)
+		projectServiceCreateWebhookBodyFlag        = projectServiceCreateWebhookFlags.String("body", "REQUIRED", "")
+		projectServiceCreateWebhookBearerTokenFlag = projectServiceCreateWebhookFlags.String("bearer-token", "", "")
+
+		projectServiceListWebhooksFlags           = flag.NewFlagSet("list-webhooks", flag.ExitOnError)
+		projectServiceListWebhooksBearerTokenFlag = projectServiceListWebhooksFlags.String("bearer-token", "", "")
+
+		projectServiceGetWebhookFlags           = flag.NewFlagSet("get-webhook", flag.ExitOnError)
+		projectServiceGetWebhookIDFlag          = projectServiceGetWebhookFlags.String("id", "REQUIRED", "Webhook ID")
+		projectServiceGetWebhookBearerTokenFlag = projectServiceGetWebhookFlags.String("bearer-token", "", "")
+
+		projectServiceDeleteWebhookFlags           = flag.NewFlagSet("delete-webhook", flag.ExitOnError)
+		projectServiceDeleteWebhookIDFlag          = projectServiceDeleteWebhookFlags.String("id", "REQUIRED", "Webhook ID")
+		projectServiceDeleteWebhookBearerTokenFlag = projectServiceDeleteWebhookFlags.String("bearer-token", "", "")
+
+		projectServiceListWebhookDeliveriesFlags           = flag.NewFlagSet("list-webhook-deliveries", flag.ExitOnError)
+		projectServiceListWebhookDeliveriesIDFlag          = projectServiceListWebhookDeliveriesFlags.String("id", "REQUIRED", "Webhook ID")
+		projectServiceListWebhookDeliveriesBearerTokenFlag = projectServiceListWebhookDeliveriesFlags.String("bearer-token", "", "")
 	)
 	projectServiceFlags.Usage = projectServiceUsage
-	projectServiceGetProjectsFlags.Usage = projectServiceGetProjectsUsage
-	projectServiceCreateProjectFlags.Usage = projectServiceCreateProjectUsage
-	projectServiceGetOneProjectBaseFlags.Usage = projectServiceGetOneProjectBaseUsage
-	projectServiceGetOneProjectSettingsFlags.Usage = projectServiceGetOneProjectSettingsUsage
-	projectServiceUpdateProjectBaseFlags.Usage = projectServiceUpdateProjectBaseUsage
-	projectServiceUpdateProjectSettingsFlags.Usage = projectServiceUpdateProjectSettingsUsage
-	projectServiceDeleteProjectFlags.Usage = projectServiceDeleteProjectUsage
-	projectServiceReadyzFlags.Usage = projectServiceReadyzUsage
-	projectServiceLivezFlags.Usage = projectServiceLivezUsage
+	projectServiceCloneProjectFlags.Usage = projectServiceCloneProjectUsage
+	projectServiceUploadProjectLogoFlags.Usage = projectServiceUploadProjectLogoUsage
+	projectServiceMergeProjectFlags.Usage = projectServiceMergeProjectUsage
+	projectServiceTransferProjectFlags.Usage = projectServiceTransferProjectUsage
 	projectServiceCreateProjectLinkFlags.Usage = projectServiceCreateProjectLinkUsage
 	projectServiceGetProjectLinkFlags.Usage = projectServiceGetProjectLinkUsage
 	projectServiceDeleteProjectLinkFlags.Usage = projectServiceDeleteProjectLinkUsage
@@ -184,6 +471,63 @@ func ParseEndpoint(
 	projectServiceGetProjectDocumentFlags.Usage = projectServiceGetProjectDocumentUsage
 	projectServiceDownloadProjectDocumentFlags.Usage = projectServiceDownloadProjectDocumentUsage
 	projectServiceDeleteProjectDocumentFlags.Usage = projectServiceDeleteProjectDocumentUsage
+	projectServiceUploadProjectCharterFlags.Usage = projectServiceUploadProjectCharterUsage
+	projectServiceGetProjectCharterFlags.Usage = projectServiceGetProjectCharterUsage
+	projectServiceGetProjectAssociationsFlags.Usage = projectServiceGetProjectAssociationsUsage
+	projectServiceUpdateProjectAssociationsFlags.Usage = projectServiceUpdateProjectAssociationsUsage
+	projectServiceGetProjectSettingsDiffFlags.Usage = projectServiceGetProjectSettingsDiffUsage
+	projectServiceGetProjectChildrenFlags.Usage = projectServiceGetProjectChildrenUsage
+	projectServiceGetProjectDescendantsFlags.Usage = projectServiceGetProjectDescendantsUsage
+	projectServiceGetProjectLegalLineageFlags.Usage = projectServiceGetProjectLegalLineageUsage
+	projectServiceSearchProjectsFlags.Usage = projectServiceSearchProjectsUsage
+	projectServiceAutocompleteProjectsFlags.Usage = projectServiceAutocompleteProjectsUsage
+	projectServiceAddProjectWriterFlags.Usage = projectServiceAddProjectWriterUsage
+	projectServiceRemoveProjectWriterFlags.Usage = projectServiceRemoveProjectWriterUsage
+	projectServiceAddProjectAuditorFlags.Usage = projectServiceAddProjectAuditorUsage
+	projectServiceRemoveProjectAuditorFlags.Usage = projectServiceRemoveProjectAuditorUsage
+	projectServiceJoinProjectFlags.Usage = projectServiceJoinProjectUsage
+	projectServiceBulkUpdateProjectMembersFlags.Usage = projectServiceBulkUpdateProjectMembersUsage
+	projectServiceGetProjectsFlags.Usage = projectServiceGetProjectsUsage
+	projectServiceCreateProjectFlags.Usage = projectServiceCreateProjectUsage
+	projectServiceGetOneProjectBaseFlags.Usage = projectServiceGetOneProjectBaseUsage
+	projectServiceGetProjectUIDFromLegacyIDFlags.Usage = projectServiceGetProjectUIDFromLegacyIDUsage
+	projectServiceGetOneProjectSettingsFlags.Usage = projectServiceGetOneProjectSettingsUsage
+	projectServiceUpdateProjectBaseFlags.Usage = projectServiceUpdateProjectBaseUsage
+	projectServiceUpdateProjectSettingsFlags.Usage = projectServiceUpdateProjectSettingsUsage
+	projectServiceDeleteProjectFlags.Usage = projectServiceDeleteProjectUsage
+	projectServiceBulkDeleteProjectsFlags.Usage = projectServiceBulkDeleteProjectsUsage
+	projectServiceReadyzFlags.Usage = projectServiceReadyzUsage
+	projectServiceLivezFlags.Usage = projectServiceLivezUsage
+	projectServiceHealthzFlags.Usage = projectServiceHealthzUsage
+	projectServiceReconcileOutboxFlags.Usage = projectServiceReconcileOutboxUsage
+	projectServiceResyncProjectsFlags.Usage = projectServiceResyncProjectsUsage
+	projectServiceListDeadLettersFlags.Usage = projectServiceListDeadLettersUsage
+	projectServiceReplayDeadLetterFlags.Usage = projectServiceReplayDeadLetterUsage
+	projectServiceRebuildSlugMappingsFlags.Usage = projectServiceRebuildSlugMappingsUsage
+	projectServiceGetIntegrityReportFlags.Usage = projectServiceGetIntegrityReportUsage
+	projectServiceInspectProjectKvFlags.Usage = projectServiceInspectProjectKvUsage
+	projectServiceCreateBackupFlags.Usage = projectServiceCreateBackupUsage
+	projectServiceListBackupsFlags.Usage = projectServiceListBackupsUsage
+	projectServiceRestoreBackupFlags.Usage = projectServiceRestoreBackupUsage
+	projectServiceListPendingAnnouncementsFlags.Usage = projectServiceListPendingAnnouncementsUsage
+	projectServiceGetProjectEnumsFlags.Usage = projectServiceGetProjectEnumsUsage
+	projectServiceUpdateProjectEnumsFlags.Usage = projectServiceUpdateProjectEnumsUsage
+	projectServiceCreateProjectTemplateFlags.Usage = projectServiceCreateProjectTemplateUsage
+	projectServiceListProjectTemplatesFlags.Usage = projectServiceListProjectTemplatesUsage
+	projectServiceGetProjectTemplateFlags.Usage = projectServiceGetProjectTemplateUsage
+	projectServiceUpdateProjectTemplateFlags.Usage = projectServiceUpdateProjectTemplateUsage
+	projectServiceDeleteProjectTemplateFlags.Usage = projectServiceDeleteProjectTemplateUsage
+	projectServiceListProjectDeletionsFlags.Usage = projectServiceListProjectDeletionsUsage
+	projectServiceListProjectEventsFlags.Usage = projectServiceListProjectEventsUsage
+	projectServiceGetUserProjectsFlags.Usage = projectServiceGetUserProjectsUsage
+	projectServiceExportUserDataFlags.Usage = projectServiceExportUserDataUsage
+	projectServiceRemoveUserAccessFlags.Usage = projectServiceRemoveUserAccessUsage
+	projectServiceGetProjectVisibilityImpactFlags.Usage = projectServiceGetProjectVisibilityImpactUsage
+	projectServiceCreateWebhookFlags.Usage = projectServiceCreateWebhookUsage
+	projectServiceListWebhooksFlags.Usage = projectServiceListWebhooksUsage
+	projectServiceGetWebhookFlags.Usage = projectServiceGetWebhookUsage
+	projectServiceDeleteWebhookFlags.Usage = projectServiceDeleteWebhookUsage
+	projectServiceListWebhookDeliveriesFlags.Usage = projectServiceListWebhookDeliveriesUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -219,6 +563,96 @@ func ParseEndpoint(
 		switch svcn {
 		case "project-service":
 			switch epn {
+			case "clone-project":
+				epf = projectServiceCloneProjectFlags
+
+			case "upload-project-logo":
+				epf = projectServiceUploadProjectLogoFlags
+
+			case "merge-project":
+				epf = projectServiceMergeProjectFlags
+
+			case "transfer-project":
+				epf = projectServiceTransferProjectFlags
+
+			case "create-project-link":
+				epf = projectServiceCreateProjectLinkFlags
+
+			case "get-project-link":
+				epf = projectServiceGetProjectLinkFlags
+
+			case "delete-project-link":
+				epf = projectServiceDeleteProjectLinkFlags
+
+			case "create-project-folder":
+				epf = projectServiceCreateProjectFolderFlags
+
+			case "get-project-folder":
+				epf = projectServiceGetProjectFolderFlags
+
+			case "delete-project-folder":
+				epf = projectServiceDeleteProjectFolderFlags
+
+			case "upload-project-document":
+				epf = projectServiceUploadProjectDocumentFlags
+
+			case "get-project-document":
+				epf = projectServiceGetProjectDocumentFlags
+
+			case "download-project-document":
+				epf = projectServiceDownloadProjectDocumentFlags
+
+			case "delete-project-document":
+				epf = projectServiceDeleteProjectDocumentFlags
+
+			case "upload-project-charter":
+				epf = projectServiceUploadProjectCharterFlags
+
+			case "get-project-charter":
+				epf = projectServiceGetProjectCharterFlags
+
+			case "get-project-associations":
+				epf = projectServiceGetProjectAssociationsFlags
+
+			case "update-project-associations":
+				epf = projectServiceUpdateProjectAssociationsFlags
+
+			case "get-project-settings-diff":
+				epf = projectServiceGetProjectSettingsDiffFlags
+
+			case "get-project-children":
+				epf = projectServiceGetProjectChildrenFlags
+
+			case "get-project-descendants":
+				epf = projectServiceGetProjectDescendantsFlags
+
+			case "get-project-legal-lineage":
+				epf = projectServiceGetProjectLegalLineageFlags
+
+			case "search-projects":
+				epf = projectServiceSearchProjectsFlags
+
+			case "autocomplete-projects":
+				epf = projectServiceAutocompleteProjectsFlags
+
+			case "add-project-writer":
+				epf = projectServiceAddProjectWriterFlags
+
+			case "remove-project-writer":
+				epf = projectServiceRemoveProjectWriterFlags
+
+			case "add-project-auditor":
+				epf = projectServiceAddProjectAuditorFlags
+
+			case "remove-project-auditor":
+				epf = projectServiceRemoveProjectAuditorFlags
+
+			case "join-project":
+				epf = projectServiceJoinProjectFlags
+
+			case "bulk-update-project-members":
+				epf = projectServiceBulkUpdateProjectMembersFlags
+
 			case "get-projects":
 				epf = projectServiceGetProjectsFlags
 
@@ -228,6 +662,9 @@ func ParseEndpoint(
 			case "get-one-project-base":
 				epf = projectServiceGetOneProjectBaseFlags
 
+			case "get-project-uid-from-legacy-id":
+				epf = projectServiceGetProjectUIDFromLegacyIDFlags
+
 			case "get-one-project-settings":
 				epf = projectServiceGetOneProjectSettingsFlags
 
@@ -240,41 +677,104 @@ func ParseEndpoint(
 			case "delete-project":
 				epf = projectServiceDeleteProjectFlags
 
+			case "bulk-delete-projects":
+				epf = projectServiceBulkDeleteProjectsFlags
+
 			case "readyz":
 				epf = projectServiceReadyzFlags
 
 			case "livez":
 				epf = projectServiceLivezFlags
 
-			case "create-project-link":
-				epf = projectServiceCreateProjectLinkFlags
+			case "healthz":
+				epf = projectServiceHealthzFlags
 
-			case "get-project-link":
-				epf = projectServiceGetProjectLinkFlags
+			case "reconcile-outbox":
+				epf = projectServiceReconcileOutboxFlags
 
-			case "delete-project-link":
-				epf = projectServiceDeleteProjectLinkFlags
+			case "resync-projects":
+				epf = projectServiceResyncProjectsFlags
 
-			case "create-project-folder":
-				epf = projectServiceCreateProjectFolderFlags
+			case "list-dead-letters":
+				epf = projectServiceListDeadLettersFlags
 
-			case "get-project-folder":
-				epf = projectServiceGetProjectFolderFlags
+			case "replay-dead-letter":
+				epf = projectServiceReplayDeadLetterFlags
 
-			case "delete-project-folder":
-				epf = projectServiceDeleteProjectFolderFlags
+			case "rebuild-slug-mappings":
+				epf = projectServiceRebuildSlugMappingsFlags
 
-			case "upload-project-document":
-				epf = projectServiceUploadProjectDocumentFlags
+			case "get-integrity-report":
+				epf = projectServiceGetIntegrityReportFlags
 
-			case "get-project-document":
-				epf = projectServiceGetProjectDocumentFlags
+			case "inspect-project-kv":
+				epf = projectServiceInspectProjectKvFlags
 
-			case "download-project-document":
-				epf = projectServiceDownloadProjectDocumentFlags
+			case "create-backup":
+				epf = projectServiceCreateBackupFlags
 
-			case "delete-project-document":
-				epf = projectServiceDeleteProjectDocumentFlags
+			case "list-backups":
+				epf = projectServiceListBackupsFlags
+
+			case "restore-backup":
+				epf = projectServiceRestoreBackupFlags
+
+			case "list-pending-announcements":
+				epf = projectServiceListPendingAnnouncementsFlags
+
+			case "get-project-enums":
+				epf = projectServiceGetProjectEnumsFlags
+
+			case "update-project-enums":
+				epf = projectServiceUpdateProjectEnumsFlags
+
+			case "create-project-template":
+				epf = projectServiceCreateProjectTemplateFlags
+
+			case "list-project-templates":
+				epf = projectServiceListProjectTemplatesFlags
+
+			case "get-project-template":
+				epf = projectServiceGetProjectTemplateFlags
+
+			case "update-project-template":
+				epf = projectServiceUpdateProjectTemplateFlags
+
+			case "delete-project-template":
+				epf = projectServiceDeleteProjectTemplateFlags
+
+			case "list-project-deletions":
+				epf = projectServiceListProjectDeletionsFlags
+
+			case "list-project-events":
+				epf = projectServiceListProjectEventsFlags
+
+			case "get-user-projects":
+				epf = projectServiceGetUserProjectsFlags
+
+			case "export-user-data":
+				epf = projectServiceExportUserDataFlags
+
+			case "remove-user-access":
+				epf = projectServiceRemoveUserAccessFlags
+
+			case "get-project-visibility-impact":
+				epf = projectServiceGetProjectVisibilityImpactFlags
+
+			case "create-webhook":
+				epf = projectServiceCreateWebhookFlags
+
+			case "list-webhooks":
+				epf = projectServiceListWebhooksFlags
+
+			case "get-webhook":
+				epf = projectServiceGetWebhookFlags
+
+			case "delete-webhook":
+				epf = projectServiceDeleteWebhookFlags
+
+			case "list-webhook-deliveries":
+				epf = projectServiceListWebhookDeliveriesFlags
 
 			}
 
@@ -301,31 +801,18 @@ func ParseEndpoint(
 		case "project-service":
 			c := projectservicec.NewClient(scheme, host, doer, enc, dec, restore)
 			switch epn {
-			case "get-projects":
-				endpoint = c.GetProjects()
-				data, err = projectservicec.BuildGetProjectsPayload(*projectServiceGetProjectsVersionFlag, *projectServiceGetProjectsBearerTokenFlag)
-			case "create-project":
-				endpoint = c.CreateProject()
-				data, err = projectservicec.BuildCreateProjectPayload(*projectServiceCreateProjectBodyFlag, *projectServiceCreateProjectVersionFlag, *projectServiceCreateProjectBearerTokenFlag, *projectServiceCreateProjectXSyncFlag)
-			case "get-one-project-base":
-				endpoint = c.GetOneProjectBase()
-				data, err = projectservicec.BuildGetOneProjectBasePayload(*projectServiceGetOneProjectBaseUIDFlag, *projectServiceGetOneProjectBaseVersionFlag, *projectServiceGetOneProjectBaseBearerTokenFlag)
-			case "get-one-project-settings":
-				endpoint = c.GetOneProjectSettings()
-				data, err = projectservicec.BuildGetOneProjectSettingsPayload(*projectServiceGetOneProjectSettingsUIDFlag, *projectServiceGetOneProjectSettingsVersionFlag, *projectServiceGetOneProjectSettingsBearerTokenFlag)
-			case "update-project-base":
-				endpoint = c.UpdateProjectBase()
-				data, err = projectservicec.BuildUpdateProjectBasePayload(*projectServiceUpdateProjectBaseBodyFlag, *projectServiceUpdateProjectBaseUIDFlag, *projectServiceUpdateProjectBaseVersionFlag, *projectServiceUpdateProjectBaseBearerTokenFlag, *projectServiceUpdateProjectBaseXSyncFlag, *projectServiceUpdateProjectBaseIfMatchFlag)
-			case "update-project-settings":
-				endpoint = c.UpdateProjectSettings()
-				data, err = projectservicec.BuildUpdateProjectSettingsPayload(*projectServiceUpdateProjectSettingsBodyFlag, *projectServiceUpdateProjectSettingsUIDFlag, *projectServiceUpdateProjectSettingsVersionFlag, *projectServiceUpdateProjectSettingsBearerTokenFlag, *projectServiceUpdateProjectSettingsXSyncFlag, *projectServiceUpdateProjectSettingsIfMatchFlag)
-			case "delete-project":
-				endpoint = c.DeleteProject()
-				data, err = projectservicec.BuildDeleteProjectPayload(*projectServiceDeleteProjectUIDFlag, *projectServiceDeleteProjectVersionFlag, *projectServiceDeleteProjectBearerTokenFlag, *projectServiceDeleteProjectXSyncFlag, *projectServiceDeleteProjectIfMatchFlag)
-			case "readyz":
-				endpoint = c.Readyz()
-			case "livez":
-				endpoint = c.Livez()
+			case "clone-project":
+				endpoint = c.CloneProject()
+				data, err = projectservicec.BuildCloneProjectPayload(*projectServiceCloneProjectBodyFlag, *projectServiceCloneProjectUIDFlag, *projectServiceCloneProjectVersionFlag, *projectServiceCloneProjectBearerTokenFlag, *projectServiceCloneProjectXSyncFlag)
+			case "upload-project-logo":
+				endpoint = c.UploadProjectLogo(projectServiceUploadProjectLogoEncoderFn)
+				data, err = projectservicec.BuildUploadProjectLogoPayload(*projectServiceUploadProjectLogoBodyFlag, *projectServiceUploadProjectLogoUIDFlag, *projectServiceUploadProjectLogoVersionFlag, *projectServiceUploadProjectLogoBearerTokenFlag, *projectServiceUploadProjectLogoXSyncFlag)
+			case "merge-project":
+				endpoint = c.MergeProject()
+				data, err = projectservicec.BuildMergeProjectPayload(*projectServiceMergeProjectUIDFlag, *projectServiceMergeProjectTargetUIDFlag, *projectServiceMergeProjectVersionFlag, *projectServiceMergeProjectBearerTokenFlag, *projectServiceMergeProjectXSyncFlag, *projectServiceMergeProjectIfMatchFlag, *projectServiceMergeProjectForceUpdateFlag)
+			case "transfer-project":
+				endpoint = c.TransferProject()
+				data, err = projectservicec.BuildTransferProjectPayload(*projectServiceTransferProjectBodyFlag, *projectServiceTransferProjectUIDFlag, *projectServiceTransferProjectVersionFlag, *projectServiceTransferProjectBearerTokenFlag, *projectServiceTransferProjectXSyncFlag, *projectServiceTransferProjectIfMatchFlag, *projectServiceTransferProjectForceUpdateFlag)
 			case "create-project-link":
 				endpoint = c.CreateProjectLink()
 				data, err = projectservicec.BuildCreateProjectLinkPayload(*projectServiceCreateProjectLinkBodyFlag, *projectServiceCreateProjectLinkUIDFlag, *projectServiceCreateProjectLinkVersionFlag, *projectServiceCreateProjectLinkBearerTokenFlag, *projectServiceCreateProjectLinkXSyncFlag)
@@ -334,7 +821,7 @@ func ParseEndpoint(
 				data, err = projectservicec.BuildGetProjectLinkPayload(*projectServiceGetProjectLinkUIDFlag, *projectServiceGetProjectLinkLinkUIDFlag, *projectServiceGetProjectLinkVersionFlag, *projectServiceGetProjectLinkBearerTokenFlag)
 			case "delete-project-link":
 				endpoint = c.DeleteProjectLink()
-				data, err = projectservicec.BuildDeleteProjectLinkPayload(*projectServiceDeleteProjectLinkUIDFlag, *projectServiceDeleteProjectLinkLinkUIDFlag, *projectServiceDeleteProjectLinkVersionFlag, *projectServiceDeleteProjectLinkBearerTokenFlag, *projectServiceDeleteProjectLinkXSyncFlag, *projectServiceDeleteProjectLinkIfMatchFlag)
+				data, err = projectservicec.BuildDeleteProjectLinkPayload(*projectServiceDeleteProjectLinkUIDFlag, *projectServiceDeleteProjectLinkLinkUIDFlag, *projectServiceDeleteProjectLinkVersionFlag, *projectServiceDeleteProjectLinkBearerTokenFlag, *projectServiceDeleteProjectLinkXSyncFlag, *projectServiceDeleteProjectLinkIfMatchFlag, *projectServiceDeleteProjectLinkForceUpdateFlag)
 			case "create-project-folder":
 				endpoint = c.CreateProjectFolder()
 				data, err = projectservicec.BuildCreateProjectFolderPayload(*projectServiceCreateProjectFolderBodyFlag, *projectServiceCreateProjectFolderUIDFlag, *projectServiceCreateProjectFolderVersionFlag, *projectServiceCreateProjectFolderBearerTokenFlag, *projectServiceCreateProjectFolderXSyncFlag)
@@ -343,7 +830,7 @@ func ParseEndpoint(
 				data, err = projectservicec.BuildGetProjectFolderPayload(*projectServiceGetProjectFolderUIDFlag, *projectServiceGetProjectFolderFolderUIDFlag, *projectServiceGetProjectFolderVersionFlag, *projectServiceGetProjectFolderBearerTokenFlag)
 			case "delete-project-folder":
 				endpoint = c.DeleteProjectFolder()
-				data, err = projectservicec.BuildDeleteProjectFolderPayload(*projectServiceDeleteProjectFolderUIDFlag, *projectServiceDeleteProjectFolderFolderUIDFlag, *projectServiceDeleteProjectFolderVersionFlag, *projectServiceDeleteProjectFolderBearerTokenFlag, *projectServiceDeleteProjectFolderXSyncFlag, *projectServiceDeleteProjectFolderIfMatchFlag)
+				data, err = projectservicec.BuildDeleteProjectFolderPayload(*projectServiceDeleteProjectFolderUIDFlag, *projectServiceDeleteProjectFolderFolderUIDFlag, *projectServiceDeleteProjectFolderVersionFlag, *projectServiceDeleteProjectFolderBearerTokenFlag, *projectServiceDeleteProjectFolderXSyncFlag, *projectServiceDeleteProjectFolderIfMatchFlag, *projectServiceDeleteProjectFolderForceUpdateFlag)
 			case "upload-project-document":
 				endpoint = c.UploadProjectDocument(projectServiceUploadProjectDocumentEncoderFn)
 				data, err = projectservicec.BuildUploadProjectDocumentPayload(*projectServiceUploadProjectDocumentBodyFlag, *projectServiceUploadProjectDocumentUIDFlag, *projectServiceUploadProjectDocumentVersionFlag, *projectServiceUploadProjectDocumentBearerTokenFlag, *projectServiceUploadProjectDocumentXSyncFlag)
@@ -355,7 +842,175 @@ func ParseEndpoint(
 				data, err = projectservicec.BuildDownloadProjectDocumentPayload(*projectServiceDownloadProjectDocumentUIDFlag, *projectServiceDownloadProjectDocumentDocumentUIDFlag, *projectServiceDownloadProjectDocumentVersionFlag, *projectServiceDownloadProjectDocumentBearerTokenFlag)
 			case "delete-project-document":
 				endpoint = c.DeleteProjectDocument()
-				data, err = projectservicec.BuildDeleteProjectDocumentPayload(*projectServiceDeleteProjectDocumentUIDFlag, *projectServiceDeleteProjectDocumentDocumentUIDFlag, *projectServiceDeleteProjectDocumentVersionFlag, *projectServiceDeleteProjectDocumentBearerTokenFlag, *projectServiceDeleteProjectDocumentXSyncFlag, *projectServiceDeleteProjectDocumentIfMatchFlag)
+				data, err = projectservicec.BuildDeleteProjectDocumentPayload(*projectServiceDeleteProjectDocumentUIDFlag, *projectServiceDeleteProjectDocumentDocumentUIDFlag, *projectServiceDeleteProjectDocumentVersionFlag, *projectServiceDeleteProjectDocumentBearerTokenFlag, *projectServiceDeleteProjectDocumentXSyncFlag, *projectServiceDeleteProjectDocumentIfMatchFlag, *projectServiceDeleteProjectDocumentForceUpdateFlag)
+			case "upload-project-charter":
+				endpoint = c.UploadProjectCharter(projectServiceUploadProjectCharterEncoderFn)
+				data, err = projectservicec.BuildUploadProjectCharterPayload(*projectServiceUploadProjectCharterBodyFlag, *projectServiceUploadProjectCharterUIDFlag, *projectServiceUploadProjectCharterVersionFlag, *projectServiceUploadProjectCharterBearerTokenFlag, *projectServiceUploadProjectCharterXSyncFlag)
+			case "get-project-charter":
+				endpoint = c.GetProjectCharter()
+				data, err = projectservicec.BuildGetProjectCharterPayload(*projectServiceGetProjectCharterUIDFlag, *projectServiceGetProjectCharterVersionFlag, *projectServiceGetProjectCharterBearerTokenFlag)
+			case "get-project-associations":
+				endpoint = c.GetProjectAssociations()
+				data, err = projectservicec.BuildGetProjectAssociationsPayload(*projectServiceGetProjectAssociationsUIDFlag, *projectServiceGetProjectAssociationsVersionFlag, *projectServiceGetProjectAssociationsBearerTokenFlag)
+			case "update-project-associations":
+				endpoint = c.UpdateProjectAssociations()
+				data, err = projectservicec.BuildUpdateProjectAssociationsPayload(*projectServiceUpdateProjectAssociationsBodyFlag, *projectServiceUpdateProjectAssociationsUIDFlag, *projectServiceUpdateProjectAssociationsVersionFlag, *projectServiceUpdateProjectAssociationsBearerTokenFlag)
+			case "get-project-settings-diff":
+				endpoint = c.GetProjectSettingsDiff()
+				data, err = projectservicec.BuildGetProjectSettingsDiffPayload(*projectServiceGetProjectSettingsDiffUIDFlag, *projectServiceGetProjectSettingsDiffVersionFlag, *projectServiceGetProjectSettingsDiffFromFlag, *projectServiceGetProjectSettingsDiffToFlag, *projectServiceGetProjectSettingsDiffBearerTokenFlag)
+			case "get-project-children":
+				endpoint = c.GetProjectChildren()
+				data, err = projectservicec.BuildGetProjectChildrenPayload(*projectServiceGetProjectChildrenUIDFlag, *projectServiceGetProjectChildrenVersionFlag, *projectServiceGetProjectChildrenSortFlag, *projectServiceGetProjectChildrenOrderFlag, *projectServiceGetProjectChildrenPageSizeFlag, *projectServiceGetProjectChildrenPageTokenFlag, *projectServiceGetProjectChildrenBearerTokenFlag)
+			case "get-project-descendants":
+				endpoint = c.GetProjectDescendants()
+				data, err = projectservicec.BuildGetProjectDescendantsPayload(*projectServiceGetProjectDescendantsUIDFlag, *projectServiceGetProjectDescendantsVersionFlag, *projectServiceGetProjectDescendantsSortFlag, *projectServiceGetProjectDescendantsOrderFlag, *projectServiceGetProjectDescendantsPageSizeFlag, *projectServiceGetProjectDescendantsPageTokenFlag, *projectServiceGetProjectDescendantsBearerTokenFlag)
+			case "get-project-legal-lineage":
+				endpoint = c.GetProjectLegalLineage()
+				data, err = projectservicec.BuildGetProjectLegalLineagePayload(*projectServiceGetProjectLegalLineageUIDFlag, *projectServiceGetProjectLegalLineageVersionFlag, *projectServiceGetProjectLegalLineageBearerTokenFlag)
+			case "search-projects":
+				endpoint = c.SearchProjects()
+				data, err = projectservicec.BuildSearchProjectsPayload(*projectServiceSearchProjectsVersionFlag, *projectServiceSearchProjectsQFlag, *projectServiceSearchProjectsLimitFlag, *projectServiceSearchProjectsBearerTokenFlag)
+			case "autocomplete-projects":
+				endpoint = c.AutocompleteProjects()
+				data, err = projectservicec.BuildAutocompleteProjectsPayload(*projectServiceAutocompleteProjectsVersionFlag, *projectServiceAutocompleteProjectsQFlag, *projectServiceAutocompleteProjectsLimitFlag, *projectServiceAutocompleteProjectsBearerTokenFlag)
+			case "add-project-writer":
+				endpoint = c.AddProjectWriter()
+				data, err = projectservicec.BuildAddProjectWriterPayload(*projectServiceAddProjectWriterUIDFlag, *projectServiceAddProjectWriterUsernameFlag, *projectServiceAddProjectWriterVersionFlag, *projectServiceAddProjectWriterBearerTokenFlag, *projectServiceAddProjectWriterXSyncFlag, *projectServiceAddProjectWriterIfMatchFlag)
+			case "remove-project-writer":
+				endpoint = c.RemoveProjectWriter()
+				data, err = projectservicec.BuildRemoveProjectWriterPayload(*projectServiceRemoveProjectWriterUIDFlag, *projectServiceRemoveProjectWriterUsernameFlag, *projectServiceRemoveProjectWriterVersionFlag, *projectServiceRemoveProjectWriterBearerTokenFlag, *projectServiceRemoveProjectWriterXSyncFlag, *projectServiceRemoveProjectWriterIfMatchFlag)
+			case "add-project-auditor":
+				endpoint = c.AddProjectAuditor()
+				data, err = projectservicec.BuildAddProjectAuditorPayload(*projectServiceAddProjectAuditorUIDFlag, *projectServiceAddProjectAuditorUsernameFlag, *projectServiceAddProjectAuditorVersionFlag, *projectServiceAddProjectAuditorBearerTokenFlag, *projectServiceAddProjectAuditorXSyncFlag, *projectServiceAddProjectAuditorIfMatchFlag)
+			case "remove-project-auditor":
+				endpoint = c.RemoveProjectAuditor()
+				data, err = projectservicec.BuildRemoveProjectAuditorPayload(*projectServiceRemoveProjectAuditorUIDFlag, *projectServiceRemoveProjectAuditorUsernameFlag, *projectServiceRemoveProjectAuditorVersionFlag, *projectServiceRemoveProjectAuditorBearerTokenFlag, *projectServiceRemoveProjectAuditorXSyncFlag, *projectServiceRemoveProjectAuditorIfMatchFlag)
+			case "join-project":
+				endpoint = c.JoinProject()
+				data, err = projectservicec.BuildJoinProjectPayload(*projectServiceJoinProjectUIDFlag, *projectServiceJoinProjectVersionFlag, *projectServiceJoinProjectBearerTokenFlag, *projectServiceJoinProjectXSyncFlag)
+			case "bulk-update-project-members":
+				endpoint = c.BulkUpdateProjectMembers()
+				data, err = projectservicec.BuildBulkUpdateProjectMembersPayload(*projectServiceBulkUpdateProjectMembersBodyFlag, *projectServiceBulkUpdateProjectMembersVersionFlag, *projectServiceBulkUpdateProjectMembersBearerTokenFlag, *projectServiceBulkUpdateProjectMembersXSyncFlag)
+			case "get-projects":
+				endpoint = c.GetProjects()
+				data, err = projectservicec.BuildGetProjectsPayload(*projectServiceGetProjectsVersionFlag, *projectServiceGetProjectsTagFlag, *projectServiceGetProjectsFieldsFlag, *projectServiceGetProjectsBaseOnlyFlag, *projectServiceGetProjectsBearerTokenFlag)
+			case "create-project":
+				endpoint = c.CreateProject()
+				data, err = projectservicec.BuildCreateProjectPayload(*projectServiceCreateProjectBodyFlag, *projectServiceCreateProjectVersionFlag, *projectServiceCreateProjectAllowDuplicatesFlag, *projectServiceCreateProjectTemplateFlag, *projectServiceCreateProjectBearerTokenFlag, *projectServiceCreateProjectXSyncFlag)
+			case "get-one-project-base":
+				endpoint = c.GetOneProjectBase()
+				data, err = projectservicec.BuildGetOneProjectBasePayload(*projectServiceGetOneProjectBaseUIDFlag, *projectServiceGetOneProjectBaseVersionFlag, *projectServiceGetOneProjectBaseFieldsFlag, *projectServiceGetOneProjectBaseExpandFlag, *projectServiceGetOneProjectBaseBearerTokenFlag)
+			case "get-project-uid-from-legacy-id":
+				endpoint = c.GetProjectUIDFromLegacyID()
+				data, err = projectservicec.BuildGetProjectUIDFromLegacyIDPayload(*projectServiceGetProjectUIDFromLegacyIDLegacyIDFlag, *projectServiceGetProjectUIDFromLegacyIDVersionFlag, *projectServiceGetProjectUIDFromLegacyIDBearerTokenFlag)
+			case "get-one-project-settings":
+				endpoint = c.GetOneProjectSettings()
+				data, err = projectservicec.BuildGetOneProjectSettingsPayload(*projectServiceGetOneProjectSettingsUIDFlag, *projectServiceGetOneProjectSettingsVersionFlag, *projectServiceGetOneProjectSettingsBearerTokenFlag)
+			case "update-project-base":
+				endpoint = c.UpdateProjectBase()
+				data, err = projectservicec.BuildUpdateProjectBasePayload(*projectServiceUpdateProjectBaseBodyFlag, *projectServiceUpdateProjectBaseUIDFlag, *projectServiceUpdateProjectBaseVersionFlag, *projectServiceUpdateProjectBaseBearerTokenFlag, *projectServiceUpdateProjectBaseXSyncFlag, *projectServiceUpdateProjectBaseIfMatchFlag, *projectServiceUpdateProjectBaseForceUpdateFlag, *projectServiceUpdateProjectBaseAdminOverrideFlag)
+			case "update-project-settings":
+				endpoint = c.UpdateProjectSettings()
+				data, err = projectservicec.BuildUpdateProjectSettingsPayload(*projectServiceUpdateProjectSettingsBodyFlag, *projectServiceUpdateProjectSettingsUIDFlag, *projectServiceUpdateProjectSettingsVersionFlag, *projectServiceUpdateProjectSettingsBearerTokenFlag, *projectServiceUpdateProjectSettingsXSyncFlag, *projectServiceUpdateProjectSettingsIfMatchFlag, *projectServiceUpdateProjectSettingsForceUpdateFlag, *projectServiceUpdateProjectSettingsMergeFlag)
+			case "delete-project":
+				endpoint = c.DeleteProject()
+				data, err = projectservicec.BuildDeleteProjectPayload(*projectServiceDeleteProjectUIDFlag, *projectServiceDeleteProjectVersionFlag, *projectServiceDeleteProjectBearerTokenFlag, *projectServiceDeleteProjectXSyncFlag, *projectServiceDeleteProjectIfMatchFlag, *projectServiceDeleteProjectForceUpdateFlag)
+			case "bulk-delete-projects":
+				endpoint = c.BulkDeleteProjects()
+				data, err = projectservicec.BuildBulkDeleteProjectsPayload(*projectServiceBulkDeleteProjectsBodyFlag, *projectServiceBulkDeleteProjectsVersionFlag, *projectServiceBulkDeleteProjectsDryRunFlag, *projectServiceBulkDeleteProjectsCascadeFlag, *projectServiceBulkDeleteProjectsForceFlag, *projectServiceBulkDeleteProjectsBearerTokenFlag, *projectServiceBulkDeleteProjectsXSyncFlag)
+			case "readyz":
+				endpoint = c.Readyz()
+			case "livez":
+				endpoint = c.Livez()
+			case "healthz":
+				endpoint = c.Healthz()
+			case "reconcile-outbox":
+				endpoint = c.ReconcileOutbox()
+				data, err = projectservicec.BuildReconcileOutboxPayload(*projectServiceReconcileOutboxBearerTokenFlag)
+			case "resync-projects":
+				endpoint = c.ResyncProjects()
+				data, err = projectservicec.BuildResyncProjectsPayload(*projectServiceResyncProjectsBodyFlag, *projectServiceResyncProjectsBearerTokenFlag)
+			case "list-dead-letters":
+				endpoint = c.ListDeadLetters()
+				data, err = projectservicec.BuildListDeadLettersPayload(*projectServiceListDeadLettersBearerTokenFlag)
+			case "replay-dead-letter":
+				endpoint = c.ReplayDeadLetter()
+				data, err = projectservicec.BuildReplayDeadLetterPayload(*projectServiceReplayDeadLetterIDFlag, *projectServiceReplayDeadLetterBearerTokenFlag)
+			case "rebuild-slug-mappings":
+				endpoint = c.RebuildSlugMappings()
+				data, err = projectservicec.BuildRebuildSlugMappingsPayload(*projectServiceRebuildSlugMappingsBodyFlag, *projectServiceRebuildSlugMappingsBearerTokenFlag)
+			case "get-integrity-report":
+				endpoint = c.GetIntegrityReport()
+				data, err = projectservicec.BuildGetIntegrityReportPayload(*projectServiceGetIntegrityReportBearerTokenFlag)
+			case "inspect-project-kv":
+				endpoint = c.InspectProjectKv()
+				data, err = projectservicec.BuildInspectProjectKvPayload(*projectServiceInspectProjectKvUIDFlag, *projectServiceInspectProjectKvBearerTokenFlag)
+			case "create-backup":
+				endpoint = c.CreateBackup()
+				data, err = projectservicec.BuildCreateBackupPayload(*projectServiceCreateBackupBearerTokenFlag)
+			case "list-backups":
+				endpoint = c.ListBackups()
+				data, err = projectservicec.BuildListBackupsPayload(*projectServiceListBackupsBearerTokenFlag)
+			case "restore-backup":
+				endpoint = c.RestoreBackup()
+				data, err = projectservicec.BuildRestoreBackupPayload(*projectServiceRestoreBackupBodyFlag, *projectServiceRestoreBackupNameFlag, *projectServiceRestoreBackupBearerTokenFlag)
+			case "list-pending-announcements":
+				endpoint = c.ListPendingAnnouncements()
+				data, err = projectservicec.BuildListPendingAnnouncementsPayload(*projectServiceListPendingAnnouncementsBearerTokenFlag)
+			case "get-project-enums":
+				endpoint = c.GetProjectEnums()
+				data, err = projectservicec.BuildGetProjectEnumsPayload(*projectServiceGetProjectEnumsVersionFlag, *projectServiceGetProjectEnumsBearerTokenFlag)
+			case "update-project-enums":
+				endpoint = c.UpdateProjectEnums()
+				data, err = projectservicec.BuildUpdateProjectEnumsPayload(*projectServiceUpdateProjectEnumsBodyFlag, *projectServiceUpdateProjectEnumsBearerTokenFlag)
+			case "create-project-template":
+				endpoint = c.CreateProjectTemplate()
+				data, err = projectservicec.BuildCreateProjectTemplatePayload(*projectServiceCreateProjectTemplateBodyFlag, *projectServiceCreateProjectTemplateBearerTokenFlag)
+			case "list-project-templates":
+				endpoint = c.ListProjectTemplates()
+				data, err = projectservicec.BuildListProjectTemplatesPayload(*projectServiceListProjectTemplatesBearerTokenFlag)
+			case "get-project-template":
+				endpoint = c.GetProjectTemplate()
+				data, err = projectservicec.BuildGetProjectTemplatePayload(*projectServiceGetProjectTemplateNameFlag, *projectServiceGetProjectTemplateBearerTokenFlag)
+			case "update-project-template":
+				endpoint = c.UpdateProjectTemplate()
+				data, err = projectservicec.BuildUpdateProjectTemplatePayload(*projectServiceUpdateProjectTemplateBodyFlag, *projectServiceUpdateProjectTemplateNameFlag, *projectServiceUpdateProjectTemplateBearerTokenFlag)
+			case "delete-project-template":
+				endpoint = c.DeleteProjectTemplate()
+				data, err = projectservicec.BuildDeleteProjectTemplatePayload(*projectServiceDeleteProjectTemplateNameFlag, *projectServiceDeleteProjectTemplateBearerTokenFlag)
+			case "list-project-deletions":
+				endpoint = c.ListProjectDeletions()
+				data, err = projectservicec.BuildListProjectDeletionsPayload(*projectServiceListProjectDeletionsVersionFlag, *projectServiceListProjectDeletionsSinceFlag, *projectServiceListProjectDeletionsBearerTokenFlag)
+			case "list-project-events":
+				endpoint = c.ListProjectEvents()
+				data, err = projectservicec.BuildListProjectEventsPayload(*projectServiceListProjectEventsUIDFlag, *projectServiceListProjectEventsVersionFlag, *projectServiceListProjectEventsSinceFlag, *projectServiceListProjectEventsBearerTokenFlag)
+			case "get-user-projects":
+				endpoint = c.GetUserProjects()
+				data, err = projectservicec.BuildGetUserProjectsPayload(*projectServiceGetUserProjectsUsernameFlag, *projectServiceGetUserProjectsVersionFlag, *projectServiceGetUserProjectsBearerTokenFlag)
+			case "export-user-data":
+				endpoint = c.ExportUserData()
+				data, err = projectservicec.BuildExportUserDataPayload(*projectServiceExportUserDataUsernameFlag, *projectServiceExportUserDataVersionFlag, *projectServiceExportUserDataBearerTokenFlag)
+			case "remove-user-access":
+				endpoint = c.RemoveUserAccess()
+				data, err = projectservicec.BuildRemoveUserAccessPayload(*projectServiceRemoveUserAccessUsernameFlag, *projectServiceRemoveUserAccessVersionFlag, *projectServiceRemoveUserAccessBearerTokenFlag, *projectServiceRemoveUserAccessXSyncFlag)
+			case "get-project-visibility-impact":
+				endpoint = c.GetProjectVisibilityImpact()
+				data, err = projectservicec.BuildGetProjectVisibilityImpactPayload(*projectServiceGetProjectVisibilityImpactUIDFlag, *projectServiceGetProjectVisibilityImpactVersionFlag, *projectServiceGetProjectVisibilityImpactBearerTokenFlag)
+			case "create-webhook":
+				endpoint = c.CreateWebhook()
+				data, err = projectservicec.BuildCreateWebhookPayload(*projectServiceCreateWebhookBodyFlag, *projectServiceCreateWebhookBearerTokenFlag)
+			case "list-webhooks":
+				endpoint = c.ListWebhooks()
+				data, err = projectservicec.BuildListWebhooksPayload(*projectServiceListWebhooksBearerTokenFlag)
+			case "get-webhook":
+				endpoint = c.GetWebhook()
+				data, err = projectservicec.BuildGetWebhookPayload(*projectServiceGetWebhookIDFlag, *projectServiceGetWebhookBearerTokenFlag)
+			case "delete-webhook":
+				endpoint = c.DeleteWebhook()
+				data, err = projectservicec.BuildDeleteWebhookPayload(*projectServiceDeleteWebhookIDFlag, *projectServiceDeleteWebhookBearerTokenFlag)
+			case "list-webhook-deliveries":
+				endpoint = c.ListWebhookDeliveries()
+				data, err = projectservicec.BuildListWebhookDeliveriesPayload(*projectServiceListWebhookDeliveriesIDFlag, *projectServiceListWebhookDeliveriesBearerTokenFlag)
 			}
 		}
 	}
@@ -363,494 +1018,1750 @@ func ParseEndpoint(
 		return nil, nil, err
 	}
 
-	return endpoint, data, nil
+	return endpoint, data, nil
+}
+
+// projectServiceUsage displays the usage of the project-service command and
+// its subcommands.
+func projectServiceUsage() {
+	fmt.Fprintln(os.Stderr, `The project service provides LFX Project resources.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] project-service COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    clone-project: Create a new project with a copy of an existing project's base and settings. The copy gets a new UID, the given slug and new timestamps; its legacy ID and announcement date are cleared. Fields set in the body override the copied values.`)
+	fmt.Fprintln(os.Stderr, `    upload-project-logo: Upload a project logo (multipart/form-data). The SVG or PNG file is stored in S3 and the project's logo_url is set to it; SVG logos are also converted to PNG.`)
+	fmt.Fprintln(os.Stderr, `    merge-project: Merge a project into another one, such as a duplicate created by an import. The children of the project are moved under the target, its writers and auditors are added to the target's, its slug redirects to the target, and the project is deleted. Returns the target project.`)
+	fmt.Fprintln(os.Stderr, `    transfer-project: Transfer a project to new owners, such as when it moves to another foundation. The project's writers are replaced by the given writers in a single settings update and, when parent_uid is set, the project is moved under that parent. The transfer is audited and a project.transferred event names the previous writers. Returns the transferred project.`)
+	fmt.Fprintln(os.Stderr, `    create-project-link: Create a new link for a project.`)
+	fmt.Fprintln(os.Stderr, `    get-project-link: Get a single project link.`)
+	fmt.Fprintln(os.Stderr, `    delete-project-link: Delete a project link.`)
+	fmt.Fprintln(os.Stderr, `    create-project-folder: Create a new folder for a project.`)
+	fmt.Fprintln(os.Stderr, `    get-project-folder: Get a single project folder.`)
+	fmt.Fprintln(os.Stderr, `    delete-project-folder: Delete a project folder. The folder must be empty.`)
+	fmt.Fprintln(os.Stderr, `    upload-project-document: Upload a new document for a project (multipart/form-data).`)
+	fmt.Fprintln(os.Stderr, `    get-project-document: Get project document metadata.`)
+	fmt.Fprintln(os.Stderr, `    download-project-document: Download the binary file of a project document.`)
+	fmt.Fprintln(os.Stderr, `    delete-project-document: Delete a project document.`)
+	fmt.Fprintln(os.Stderr, `    upload-project-charter: Upload a new version of a project's charter (multipart/form-data). The PDF is stored in S3, added to the charter's version history, and the project's charter_url is set to it.`)
+	fmt.Fprintln(os.Stderr, `    get-project-charter: Get a project's charter and its version history.`)
+	fmt.Fprintln(os.Stderr, `    get-project-associations: Get the committees, mailing lists and meeting series associated with a project.`)
+	fmt.Fprintln(os.Stderr, `    update-project-associations: Replace the committees, mailing lists and meeting series associated with a project. Each newly added reference must exist in the service that owns it.`)
+	fmt.Fprintln(os.Stderr, `    get-project-settings-diff: Get the field-by-field difference between two revisions of a project's settings, such as the writers added and removed. Revisions are the settings ETags; only revisions still retained in the settings history are available.`)
+	fmt.Fprintln(os.Stderr, `    get-project-children: List the direct children of a project one page at a time. Children the principal cannot view are left out.`)
+	fmt.Fprintln(os.Stderr, `    get-project-descendants: List every project under a project, at any depth, one page at a time. Descendants the principal cannot view are left out.`)
+	fmt.Fprintln(os.Stderr, `    get-project-legal-lineage: Get the chain of legal parents of a project for compliance reporting: its legal parent, that project's legal parent, and so on up to a project without one.`)
+	fmt.Fprintln(os.Stderr, `    search-projects: Search projects by name, slug and description, best match first. The search index is used when it is configured and available; otherwise every project is scanned. Projects the principal cannot view are left out, so fewer than limit projects may be returned.`)
+	fmt.Fprintln(os.Stderr, `    autocomplete-projects: Suggest projects whose name, slug, or a word of them, starts with a prefix, for typeahead. Exact matches come first, then names and slugs starting with the prefix, then words starting with it. Projects the principal cannot view are left out.`)
+	fmt.Fprintln(os.Stderr, `    add-project-writer: Add a user to the project's writers. Only that user is changed, so concurrent additions do not overwrite each other. Adding a user who is already one of the writers changes nothing. With If-Match, the user is only added if the settings are still at that revision.`)
+	fmt.Fprintln(os.Stderr, `    remove-project-writer: Remove a user from the project's writers. Only that user is changed, so concurrent removals do not overwrite each other. With If-Match, the user is only removed if the settings are still at that revision.`)
+	fmt.Fprintln(os.Stderr, `    add-project-auditor: Add a user to the project's auditors. Only that user is changed, so concurrent additions do not overwrite each other. Adding a user who is already one of the auditors changes nothing. With If-Match, the user is only added if the settings are still at that revision.`)
+	fmt.Fprintln(os.Stderr, `    remove-project-auditor: Remove a user from the project's auditors. Only that user is changed, so concurrent removals do not overwrite each other. With If-Match, the user is only removed if the settings are still at that revision.`)
+	fmt.Fprintln(os.Stderr, `    join-project: Join a project that has autojoin enabled. The requesting user is added to the project's member role configured for autojoin, the auditors by default. Joining a project the user already holds that role in changes nothing.`)
+	fmt.Fprintln(os.Stderr, `    bulk-update-project-members: Add users to and remove users from the writers and auditors of several projects, for LF staff on-boarding or off-boarding a user across projects. Each project is updated on its own and reported with its outcome; a project that fails does not stop the others.`)
+	fmt.Fprintln(os.Stderr, `    get-projects: Get all projects. With v=2, the settings of each project are nested under settings instead of merged into the project.`)
+	fmt.Fprintln(os.Stderr, `    create-project: Create a new project.`)
+	fmt.Fprintln(os.Stderr, `    get-one-project-base: Get a single project's base information. With expand=settings, the project's settings are merged into it, as in get-projects, and their ETag is returned in X-Settings-ETag.`)
+	fmt.Fprintln(os.Stderr, `    get-project-uid-from-legacy-id: Resolve a project's LFX v1 ID to its v2 UID.`)
+	fmt.Fprintln(os.Stderr, `    get-one-project-settings: Get a single project's settings.`)
+	fmt.Fprintln(os.Stderr, `    update-project-base: Update an existing project's base information.`)
+	fmt.Fprintln(os.Stderr, `    update-project-settings: Update an existing project's settings. With X-Merge, an update whose If-Match revision is no longer current is merged with the changes made since that revision when they change other fields or other users of the role lists.`)
+	fmt.Fprintln(os.Stderr, `    delete-project: Delete an existing project.`)
+	fmt.Fprintln(os.Stderr, `    bulk-delete-projects: Delete several projects at once, such as the test data of a load, for LF staff. The whole set is checked before anything is deleted: a project with children outside the set, or whose funding model is not Crowdfunding only, blocks the deletion. With cascade, the descendants of the projects are deleted too; with force, the funding model is not checked; with dry_run, nothing is deleted. Projects are deleted children first and reported in that order.`)
+	fmt.Fprintln(os.Stderr, `    readyz: Check if the service is able to take inbound requests.`)
+	fmt.Fprintln(os.Stderr, `    livez: Check if the service is alive.`)
+	fmt.Fprintln(os.Stderr, `    healthz: Report the health of each dependency of the service.`)
+	fmt.Fprintln(os.Stderr, `    reconcile-outbox: Publish every pending outbox message now, ignoring retry backoff, for LF staff.`)
+	fmt.Fprintln(os.Stderr, `    resync-projects: Re-publish indexer and update_access messages for every project matching the filter, for LF staff.`)
+	fmt.Fprintln(os.Stderr, `    list-dead-letters: List the NATS events whose handler failed and that are waiting to be replayed, for LF staff.`)
+	fmt.Fprintln(os.Stderr, `    replay-dead-letter: Hand a dead-lettered NATS event back to its handler, removing it if the handler succeeds, for LF staff.`)
+	fmt.Fprintln(os.Stderr, `    rebuild-slug-mappings: Rebuild the slug to UID mappings from the project documents, reporting the mappings no project holds, for LF staff.`)
+	fmt.Fprintln(os.Stderr, `    get-integrity-report: Get the inconsistencies found in the project store by the last integrity scan, scanning the store when it was not scanned yet, for LF staff.`)
+	fmt.Fprintln(os.Stderr, `    inspect-project-kv: Get the entries stored for a project's base and settings in the NATS KV buckets as they are, with their current revision and retained history, for LF staff debugging stale documents.`)
+	fmt.Fprintln(os.Stderr, `    create-backup: Save every entry of the projects and project-settings stores, including slug mappings, to a new backup archive, for LF staff.`)
+	fmt.Fprintln(os.Stderr, `    list-backups: List the stored backup archives, for LF staff.`)
+	fmt.Fprintln(os.Stderr, `    restore-backup: Write the entries of a backup archive back to the project stores, for every project or only the given ones, for LF staff.`)
+	fmt.Fprintln(os.Stderr, `    list-pending-announcements: List the projects whose announcement date is set and whose announcement has not been published yet, for LF staff.`)
+	fmt.Fprintln(os.Stderr, `    get-project-enums: Get the values currently allowed for the enumerated project fields, stage and category. Admins change them without a release of the service.`)
+	fmt.Fprintln(os.Stderr, `    update-project-enums: Replace the values allowed for the enumerated project fields, for LF staff. Projects that already have a value no longer allowed keep it until the field is changed.`)
+	fmt.Fprintln(os.Stderr, `    create-project-template: Define a template that projects can be created from with POST /projects?template=<name>, for LF staff.`)
+	fmt.Fprintln(os.Stderr, `    list-project-templates: List the project templates, sorted by name, for LF staff.`)
+	fmt.Fprintln(os.Stderr, `    get-project-template: Get a project template, for LF staff.`)
+	fmt.Fprintln(os.Stderr, `    update-project-template: Replace the defaults of a project template, for LF staff. Projects already created from it are not changed.`)
+	fmt.Fprintln(os.Stderr, `    delete-project-template: Remove a project template, for LF staff. Projects already created from it are not changed.`)
+	fmt.Fprintln(os.Stderr, `    list-project-deletions: List the tombstones of deleted projects, oldest first, so that downstream caches can tell a deleted project from one that never existed. Tombstones are kept for the retention of the deletions bucket. Only LF staff and trusted service principals may list them.`)
+	fmt.Fprintln(os.Stderr, `    list-project-events: List the lifecycle events of a project, oldest first, reconstructed from the revisions of its base and settings still retained in the project store, so that a new downstream consumer can backfill its state. Only project.created, project.updated, project.deleted and project.settings.updated events are reconstructed, without their actor.`)
+	fmt.Fprintln(os.Stderr, `    get-user-projects: List the projects in which a user is a writer, auditor or meeting coordinator. Users may only list their own projects, unless they are LF staff.`)
+	fmt.Fprintln(os.Stderr, `    export-user-data: Export everything the service stores about a user, for data-subject access requests: each writer, auditor, meeting coordinator and contact role the user holds, with the name, email and avatar stored with it and when the user was given the role. Users may only export their own data, unless they are LF staff.`)
+	fmt.Fprintln(os.Stderr, `    remove-user-access: Remove a user from the writers, auditors and meeting coordinators of every project, for employee departures and erasure requests. Only LF staff may remove a user's access. Each project is updated on its own and reported with its outcome; a project that fails does not stop the others.`)
+	fmt.Fprintln(os.Stderr, `    get-project-visibility-impact: Preview what flipping a project's public flag would affect: its descendants, its URLs pointing outside the service, and the associated resources and webhooks that follow it. The project is not changed.`)
+	fmt.Fprintln(os.Stderr, `    create-webhook: Register an HTTPS endpoint that receives signed POSTs for project lifecycle events, for LF staff. The signing secret is only returned by this call.`)
+	fmt.Fprintln(os.Stderr, `    list-webhooks: List the registered webhooks, without their signing secrets.`)
+	fmt.Fprintln(os.Stderr, `    get-webhook: Get a registered webhook, without its signing secret.`)
+	fmt.Fprintln(os.Stderr, `    delete-webhook: Remove a webhook along with its delivery history. Pending retries are dropped.`)
+	fmt.Fprintln(os.Stderr, `    list-webhook-deliveries: List the events sent, or still being retried, to a webhook, newest first.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s project-service COMMAND --help\n", os.Args[0])
+}
+func projectServiceCloneProjectUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service clone-project", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Create a new project with a copy of an existing project's base and settings. The copy gets a new UID, the given slug and new timestamps; its legacy ID and announcement date are cleared. Fields set in the body override the copied values.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service clone-project --body '{\n      \"category\": \"Active\",\n      \"description\": \"project foo is a project about bar\",\n      \"mission_statement\": \"The mission of the project is to build a sustainable ecosystem around open source projects to accelerate technology development and industry adoption.\",\n      \"name\": \"Foo Foundation\",\n      \"parent_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"public\": true,\n      \"slug\": \"project-slug\",\n      \"stage\": \"Formation - Exploratory\"\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
+}
+
+func projectServiceUploadProjectLogoUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service upload-project-logo", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Upload a project logo (multipart/form-data). The SVG or PNG file is stored in S3 and the project's logo_url is set to it; SVG logos are also converted to PNG.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service upload-project-logo --body '{\n      \"content_type\": \"image/svg+xml\",\n      \"file\": \"Li4u\"\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
+}
+
+func projectServiceMergeProjectUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service merge-project", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -target-uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -force-update BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Merge a project into another one, such as a duplicate created by an import. The children of the project are moved under the target, its writers and auditors are added to the target's, its slug redirects to the target, and the project is deleted. Returns the target project.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -target-uid STRING: UID of the project to merge the project into`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -force-update BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service merge-project --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --target-uid \"4b1f3e9c-2d7a-4c1b-9e8f-0a1b2c3d4e5f\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true --if-match \"123\" --force-update false")
+}
+
+func projectServiceTransferProjectUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service transfer-project", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -force-update BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Transfer a project to new owners, such as when it moves to another foundation. The project's writers are replaced by the given writers in a single settings update and, when parent_uid is set, the project is moved under that parent. The transfer is audited and a project.transferred event names the previous writers. Returns the transferred project.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -force-update BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service transfer-project --body '{\n      \"parent_uid\": \"4b1f3e9c-2d7a-4c1b-9e8f-0a1b2c3d4e5f\",\n      \"reason\": \"Project moved to the CNCF\",\n      \"writers\": [\n         {\n            \"email\": \"alice.johnson@example.com\",\n            \"name\": \"Alice Johnson\",\n            \"username\": \"alicejohnson789\"\n         }\n      ]\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true --if-match \"123\" --force-update false")
+}
+
+func projectServiceCreateProjectLinkUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service create-project-link", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Create a new link for a project.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service create-project-link --body '{\n      \"description\": \"A description of the resource\",\n      \"folder_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"name\": \"My Resource\",\n      \"url\": \"https://example.com\"\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
+}
+
+func projectServiceGetProjectLinkUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-project-link", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -link-uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get a single project link.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -link-uid STRING: Link UID`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-project-link --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --link-uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceDeleteProjectLinkUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service delete-project-link", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -link-uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -force-update BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Delete a project link.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -link-uid STRING: Link UID`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -force-update BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service delete-project-link --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --link-uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true --if-match \"123\" --force-update false")
+}
+
+func projectServiceCreateProjectFolderUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service create-project-folder", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Create a new folder for a project.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service create-project-folder --body '{\n      \"name\": \"My Resource\"\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
+}
+
+func projectServiceGetProjectFolderUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-project-folder", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -folder-uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get a single project folder.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -folder-uid STRING: Folder UID`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-project-folder --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --folder-uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceDeleteProjectFolderUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service delete-project-folder", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -folder-uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -force-update BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Delete a project folder. The folder must be empty.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -folder-uid STRING: Folder UID`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -force-update BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service delete-project-folder --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --folder-uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true --if-match \"123\" --force-update false")
+}
+
+func projectServiceUploadProjectDocumentUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service upload-project-document", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Upload a new document for a project (multipart/form-data).`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service upload-project-document --body '{\n      \"content_type\": \"application/pdf\",\n      \"description\": \"A description of the resource\",\n      \"file\": \"Li4u\",\n      \"file_name\": \"report.pdf\",\n      \"folder_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"name\": \"My Resource\"\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
+}
+
+func projectServiceGetProjectDocumentUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-project-document", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -document-uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get project document metadata.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -document-uid STRING: Document UID`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-project-document --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --document-uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceDownloadProjectDocumentUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service download-project-document", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -document-uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Download the binary file of a project document.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -document-uid STRING: Document UID`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service download-project-document --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --document-uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceDeleteProjectDocumentUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service delete-project-document", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -document-uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -force-update BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Delete a project document.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -document-uid STRING: Document UID`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -force-update BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service delete-project-document --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --document-uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true --if-match \"123\" --force-update false")
+}
+
+func projectServiceUploadProjectCharterUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service upload-project-charter", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Upload a new version of a project's charter (multipart/form-data). The PDF is stored in S3, added to the charter's version history, and the project's charter_url is set to it.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service upload-project-charter --body '{\n      \"file\": \"Li4u\",\n      \"file_name\": \"charter.pdf\"\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
+}
+
+func projectServiceGetProjectCharterUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-project-charter", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get a project's charter and its version history.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-project-charter --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceGetProjectAssociationsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-project-associations", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the committees, mailing lists and meeting series associated with a project.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-project-associations --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceUpdateProjectAssociationsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service update-project-associations", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Replace the committees, mailing lists and meeting series associated with a project. Each newly added reference must exist in the service that owns it.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service update-project-associations --body '{\n      \"committee_uids\": [\n         \"061a110a-7c38-4cd3-bfcf-fc8511a37f35\"\n      ],\n      \"mailing_list_ids\": [\n         \"119563\"\n      ],\n      \"meeting_series_ids\": [\n         \"8f4e2d1a-9b3c-4a5e-8d7f-6c1b2a3e4f5d\"\n      ]\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceGetProjectSettingsDiffUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-project-settings-diff", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -from UINT64")
+	fmt.Fprint(os.Stderr, " -to UINT64")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the field-by-field difference between two revisions of a project's settings, such as the writers added and removed. Revisions are the settings ETags; only revisions still retained in the settings history are available.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -from UINT64: `)
+	fmt.Fprintln(os.Stderr, `    -to UINT64: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-project-settings-diff --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --from 3 --to 7 --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceGetProjectChildrenUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-project-children", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -sort STRING")
+	fmt.Fprint(os.Stderr, " -order STRING")
+	fmt.Fprint(os.Stderr, " -page-size INT")
+	fmt.Fprint(os.Stderr, " -page-token STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the direct children of a project one page at a time. Children the principal cannot view are left out.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -sort STRING: `)
+	fmt.Fprintln(os.Stderr, `    -order STRING: `)
+	fmt.Fprintln(os.Stderr, `    -page-size INT: `)
+	fmt.Fprintln(os.Stderr, `    -page-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-project-children --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --sort \"name\" --order \"asc\" --page-size 50 --page-token \"eyJzIjoibmFtZSIsIm8iOiJhc2MiLCJrIjoia3ViZXJuZXRlcyIsInUiOiI3Y2FkNWE4ZCJ9\" --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceGetProjectDescendantsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-project-descendants", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -sort STRING")
+	fmt.Fprint(os.Stderr, " -order STRING")
+	fmt.Fprint(os.Stderr, " -page-size INT")
+	fmt.Fprint(os.Stderr, " -page-token STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List every project under a project, at any depth, one page at a time. Descendants the principal cannot view are left out.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -sort STRING: `)
+	fmt.Fprintln(os.Stderr, `    -order STRING: `)
+	fmt.Fprintln(os.Stderr, `    -page-size INT: `)
+	fmt.Fprintln(os.Stderr, `    -page-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-project-descendants --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --sort \"name\" --order \"asc\" --page-size 50 --page-token \"eyJzIjoibmFtZSIsIm8iOiJhc2MiLCJrIjoia3ViZXJuZXRlcyIsInUiOiI3Y2FkNWE4ZCJ9\" --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceGetProjectLegalLineageUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-project-legal-lineage", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the chain of legal parents of a project for compliance reporting: its legal parent, that project's legal parent, and so on up to a project without one.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-project-legal-lineage --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceSearchProjectsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service search-projects", os.Args[0])
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -q STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Search projects by name, slug and description, best match first. The search index is used when it is configured and available; otherwise every project is scanned. Projects the principal cannot view are left out, so fewer than limit projects may be returned.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -q STRING: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service search-projects --version \"1\" --q \"kubernetes\" --limit 20 --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceAutocompleteProjectsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service autocomplete-projects", os.Args[0])
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -q STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Suggest projects whose name, slug, or a word of them, starts with a prefix, for typeahead. Exact matches come first, then names and slugs starting with the prefix, then words starting with it. Projects the principal cannot view are left out.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -q STRING: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service autocomplete-projects --version \"1\" --q \"kube\" --limit 10 --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceAddProjectWriterUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service add-project-writer", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -username STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Add a user to the project's writers. Only that user is changed, so concurrent additions do not overwrite each other. Adding a user who is already one of the writers changes nothing. With If-Match, the user is only added if the settings are still at that revision.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -username STRING: The LFID username of the user`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service add-project-writer --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --username \"jdoe\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true --if-match \"123\"")
+}
+
+func projectServiceRemoveProjectWriterUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service remove-project-writer", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -username STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Remove a user from the project's writers. Only that user is changed, so concurrent removals do not overwrite each other. With If-Match, the user is only removed if the settings are still at that revision.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -username STRING: The LFID username of the user`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service remove-project-writer --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --username \"jdoe\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true --if-match \"123\"")
+}
+
+func projectServiceAddProjectAuditorUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service add-project-auditor", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -username STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Add a user to the project's auditors. Only that user is changed, so concurrent additions do not overwrite each other. Adding a user who is already one of the auditors changes nothing. With If-Match, the user is only added if the settings are still at that revision.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -username STRING: The LFID username of the user`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service add-project-auditor --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --username \"jdoe\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true --if-match \"123\"")
+}
+
+func projectServiceRemoveProjectAuditorUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service remove-project-auditor", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -username STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Remove a user from the project's auditors. Only that user is changed, so concurrent removals do not overwrite each other. With If-Match, the user is only removed if the settings are still at that revision.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -username STRING: The LFID username of the user`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service remove-project-auditor --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --username \"jdoe\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true --if-match \"123\"")
+}
+
+func projectServiceJoinProjectUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service join-project", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Join a project that has autojoin enabled. The requesting user is added to the project's member role configured for autojoin, the auditors by default. Joining a project the user already holds that role in changes nothing.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service join-project --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
+}
+
+func projectServiceBulkUpdateProjectMembersUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service bulk-update-project-members", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Add users to and remove users from the writers and auditors of several projects, for LF staff on-boarding or off-boarding a user across projects. Each project is updated on its own and reported with its outcome; a project that fails does not stop the others.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service bulk-update-project-members --body '{\n      \"operations\": [\n         {\n            \"auditors_add\": [],\n            \"auditors_remove\": [\n               \"jdoe\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n            \"writers_add\": [\n               \"jdoe\"\n            ],\n            \"writers_remove\": []\n         }\n      ]\n   }' --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
+}
+
+func projectServiceGetProjectsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-projects", os.Args[0])
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -tag JSON")
+	fmt.Fprint(os.Stderr, " -fields STRING")
+	fmt.Fprint(os.Stderr, " -base-only BOOL")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get all projects. With v=2, the settings of each project are nested under settings instead of merged into the project.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -tag JSON: `)
+	fmt.Fprintln(os.Stderr, `    -fields STRING: `)
+	fmt.Fprintln(os.Stderr, `    -base-only BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-projects --version \"1\" --tag '[\n      \"cncf\"\n   ]' --fields \"uid,name,slug,logo_url\" --base-only false --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceCreateProjectUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service create-project", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -allow-duplicates BOOL")
+	fmt.Fprint(os.Stderr, " -template STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Create a new project.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -allow-duplicates BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -template STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service create-project --body '{\n      \"annotations\": {\n         \"salesforce.v1/id\": \"a0941000002wBz9AAE\"\n      },\n      \"announcement_date\": \"2021-01-01\",\n      \"auditors\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"autojoin_enabled\": false,\n      \"category\": \"Active\",\n      \"charter_url\": \"https://example.com/charter.pdf\",\n      \"description\": \"project foo is a project about bar\",\n      \"description_i18n\": {\n         \"zh\": \"Foo 项目是一个关于 bar 的项目\"\n      },\n      \"entity_dissolution_date\": \"2021-12-31\",\n      \"entity_formation_document_url\": \"https://example.com/formation.pdf\",\n      \"executive_director\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"formation_date\": \"2021-01-01\",\n      \"funding\": \"Funded\",\n      \"funding_model\": [\n         \"Crowdfunding\"\n      ],\n      \"is_foundation\": false,\n      \"legacy_id\": \"a0941000002wBz9AAE\",\n      \"legal_entity_name\": \"Example Foundation LLC\",\n      \"legal_entity_type\": \"Subproject\",\n      \"legal_parent_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"links\": {\n         \"contact_email\": \"info@example.org\",\n         \"discord\": \"https://discord.gg/example\",\n         \"linkedin\": \"https://www.linkedin.com/company/example\",\n         \"mailing_list\": \"https://lists.example.org/g/main\",\n         \"slack\": \"https://slack.example.org/\",\n         \"twitter\": \"https://x.com/example\",\n         \"youtube\": \"https://www.youtube.com/@example\"\n      },\n      \"logo_url\": \"https://example.com/logo.png\",\n      \"meeting_coordinators\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"mission_statement\": \"The mission of the project is to build a sustainable ecosystem around open source projects to accelerate technology development and industry adoption.\",\n      \"name\": \"Foo Foundation\",\n      \"name_i18n\": {\n         \"ja\": \"Foo 財団\",\n         \"zh\": \"Foo 基金会\"\n      },\n      \"opportunity_owner\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"overview_html\": \"\\u003cp\\u003eThe project builds \\u003cstrong\\u003eopen source\\u003c/strong\\u003e tooling. \\u003ca href=\\\"https://example.org/docs\\\"\\u003eRead the docs\\u003c/a\\u003e.\\u003c/p\\u003e\",\n      \"parent_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"program_manager\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"public\": true,\n      \"repository_url\": \"https://example.com/project\",\n      \"slug\": \"project-slug\",\n      \"stage\": \"Formation - Exploratory\",\n      \"tags\": [\n         \"cncf\",\n         \"security-critical\"\n      ],\n      \"website_url\": \"https://example.com\",\n      \"writers\": [\n         {\n            \"avatar\": \"https://example.com/avatar3.jpg\",\n            \"email\": \"alice.johnson@example.com\",\n            \"name\": \"Alice Johnson\",\n            \"username\": \"alicejohnson789\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar4.jpg\",\n            \"email\": \"bob.wilson@example.com\",\n            \"name\": \"Bob Wilson\",\n            \"username\": \"bobwilson101\"\n         }\n      ]\n   }' --version \"1\" --allow-duplicates false --template \"cncf-sandbox\" --bearer-token \"eyJhbGci...\" --x-sync true")
+}
+
+func projectServiceGetOneProjectBaseUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-one-project-base", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -fields STRING")
+	fmt.Fprint(os.Stderr, " -expand STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get a single project's base information. With expand=settings, the project's settings are merged into it, as in get-projects, and their ETag is returned in X-Settings-ETag.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -fields STRING: `)
+	fmt.Fprintln(os.Stderr, `    -expand STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-one-project-base --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --fields \"uid,name,slug,logo_url\" --expand \"settings\" --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceGetProjectUIDFromLegacyIDUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-project-uid-from-legacy-id", os.Args[0])
+	fmt.Fprint(os.Stderr, " -legacy-id STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Resolve a project's LFX v1 ID to its v2 UID.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -legacy-id STRING: The ID of the project in LFX v1 (a Salesforce record ID), used to resolve v1 references to the v2 UID`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-project-uid-from-legacy-id --legacy-id \"a0941000002wBz9AAE\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceGetOneProjectSettingsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-one-project-settings", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get a single project's settings.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-one-project-settings --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceUpdateProjectBaseUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service update-project-base", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -force-update BOOL")
+	fmt.Fprint(os.Stderr, " -admin-override BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Update an existing project's base information.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -force-update BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -admin-override BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service update-project-base --body '{\n      \"annotations\": {\n         \"salesforce.v1/id\": \"a0941000002wBz9AAE\"\n      },\n      \"autojoin_enabled\": false,\n      \"category\": \"Active\",\n      \"charter_url\": \"https://example.com/charter.pdf\",\n      \"description\": \"project foo is a project about bar\",\n      \"description_i18n\": {\n         \"zh\": \"Foo 项目是一个关于 bar 的项目\"\n      },\n      \"entity_dissolution_date\": \"2021-12-31\",\n      \"entity_formation_document_url\": \"https://example.com/formation.pdf\",\n      \"formation_date\": \"2021-01-01\",\n      \"funding\": \"Funded\",\n      \"funding_model\": [\n         \"Crowdfunding\"\n      ],\n      \"is_foundation\": false,\n      \"legacy_id\": \"a0941000002wBz9AAE\",\n      \"legal_entity_name\": \"Example Foundation LLC\",\n      \"legal_entity_type\": \"Subproject\",\n      \"legal_parent_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"links\": {\n         \"contact_email\": \"info@example.org\",\n         \"discord\": \"https://discord.gg/example\",\n         \"linkedin\": \"https://www.linkedin.com/company/example\",\n         \"mailing_list\": \"https://lists.example.org/g/main\",\n         \"slack\": \"https://slack.example.org/\",\n         \"twitter\": \"https://x.com/example\",\n         \"youtube\": \"https://www.youtube.com/@example\"\n      },\n      \"logo_url\": \"https://example.com/logo.png\",\n      \"name\": \"Foo Foundation\",\n      \"name_i18n\": {\n         \"ja\": \"Foo 財団\",\n         \"zh\": \"Foo 基金会\"\n      },\n      \"parent_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"public\": true,\n      \"repository_url\": \"https://example.com/project\",\n      \"slug\": \"project-slug\",\n      \"stage\": \"Formation - Exploratory\",\n      \"tags\": [\n         \"cncf\",\n         \"security-critical\"\n      ],\n      \"website_url\": \"https://example.com\"\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true --if-match \"123\" --force-update false --admin-override false")
+}
+
+func projectServiceUpdateProjectSettingsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service update-project-settings", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -force-update BOOL")
+	fmt.Fprint(os.Stderr, " -merge BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Update an existing project's settings. With X-Merge, an update whose If-Match revision is no longer current is merged with the changes made since that revision when they change other fields or other users of the role lists.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -force-update BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -merge BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service update-project-settings --body '{\n      \"announcement_date\": \"2021-01-01\",\n      \"auditors\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"executive_director\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"meeting_coordinators\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"mission_statement\": \"The mission of the project is to build a sustainable ecosystem around open source projects to accelerate technology development and industry adoption.\",\n      \"opportunity_owner\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"overview_html\": \"\\u003cp\\u003eThe project builds \\u003cstrong\\u003eopen source\\u003c/strong\\u003e tooling. \\u003ca href=\\\"https://example.org/docs\\\"\\u003eRead the docs\\u003c/a\\u003e.\\u003c/p\\u003e\",\n      \"program_manager\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"writers\": [\n         {\n            \"avatar\": \"https://example.com/avatar3.jpg\",\n            \"email\": \"alice.johnson@example.com\",\n            \"name\": \"Alice Johnson\",\n            \"username\": \"alicejohnson789\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar4.jpg\",\n            \"email\": \"bob.wilson@example.com\",\n            \"name\": \"Bob Wilson\",\n            \"username\": \"bobwilson101\"\n         }\n      ]\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true --if-match \"123\" --force-update false --merge true")
+}
+
+func projectServiceDeleteProjectUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service delete-project", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprint(os.Stderr, " -force-update BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Delete an existing project.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -force-update BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service delete-project --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true --if-match \"123\" --force-update false")
+}
+
+func projectServiceBulkDeleteProjectsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service bulk-delete-projects", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -dry-run BOOL")
+	fmt.Fprint(os.Stderr, " -cascade BOOL")
+	fmt.Fprint(os.Stderr, " -force BOOL")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Delete several projects at once, such as the test data of a load, for LF staff. The whole set is checked before anything is deleted: a project with children outside the set, or whose funding model is not Crowdfunding only, blocks the deletion. With cascade, the descendants of the projects are deleted too; with force, the funding model is not checked; with dry_run, nothing is deleted. Projects are deleted children first and reported in that order.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -dry-run BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -cascade BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -force BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service bulk-delete-projects --body '{\n      \"uids\": [\n         \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n      ]\n   }' --version \"1\" --dry-run true --cascade true --force true --bearer-token \"eyJhbGci...\" --x-sync true")
+}
+
+func projectServiceReadyzUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service readyz", os.Args[0])
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Check if the service is able to take inbound requests.`)
+
+	// Flags list
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service readyz")
+}
+
+func projectServiceLivezUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service livez", os.Args[0])
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Check if the service is alive.`)
+
+	// Flags list
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service livez")
+}
+
+func projectServiceHealthzUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service healthz", os.Args[0])
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Report the health of each dependency of the service.`)
+
+	// Flags list
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service healthz")
+}
+
+func projectServiceReconcileOutboxUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service reconcile-outbox", os.Args[0])
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Publish every pending outbox message now, ignoring retry backoff, for LF staff.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service reconcile-outbox --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceResyncProjectsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service resync-projects", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Re-publish indexer and update_access messages for every project matching the filter, for LF staff.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service resync-projects --body '{\n      \"dry_run\": true,\n      \"stage\": \"Formation - Exploratory\",\n      \"uid_prefix\": \"7cad5a8d\",\n      \"updated_since\": \"2025-01-01T00:00:00Z\"\n   }' --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceListDeadLettersUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service list-dead-letters", os.Args[0])
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the NATS events whose handler failed and that are waiting to be replayed, for LF staff.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service list-dead-letters --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceReplayDeadLetterUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service replay-dead-letter", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Hand a dead-lettered NATS event back to its handler, removing it if the handler succeeds, for LF staff.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Dead letter ID`)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service replay-dead-letter --id \"6f3b1c2a-9d4e-4f5a-8b7c-1d2e3f4a5b6c\" --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceRebuildSlugMappingsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service rebuild-slug-mappings", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Rebuild the slug to UID mappings from the project documents, reporting the mappings no project holds, for LF staff.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service rebuild-slug-mappings --body '{\n      \"remove_dangling\": true\n   }' --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceGetIntegrityReportUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-integrity-report", os.Args[0])
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the inconsistencies found in the project store by the last integrity scan, scanning the store when it was not scanned yet, for LF staff.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-integrity-report --bearer-token \"eyJhbGci...\"")
 }
 
-// projectServiceUsage displays the usage of the project-service command and
-// its subcommands.
-func projectServiceUsage() {
-	fmt.Fprintln(os.Stderr, `The project service provides LFX Project resources.`)
-	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] project-service COMMAND [flags]\n\n", os.Args[0])
-	fmt.Fprintln(os.Stderr, "COMMAND:")
-	fmt.Fprintln(os.Stderr, `    get-projects: Get all projects.`)
-	fmt.Fprintln(os.Stderr, `    create-project: Create a new project.`)
-	fmt.Fprintln(os.Stderr, `    get-one-project-base: Get a single project's base information.`)
-	fmt.Fprintln(os.Stderr, `    get-one-project-settings: Get a single project's settings.`)
-	fmt.Fprintln(os.Stderr, `    update-project-base: Update an existing project's base information.`)
-	fmt.Fprintln(os.Stderr, `    update-project-settings: Update an existing project's settings.`)
-	fmt.Fprintln(os.Stderr, `    delete-project: Delete an existing project.`)
-	fmt.Fprintln(os.Stderr, `    readyz: Check if the service is able to take inbound requests.`)
-	fmt.Fprintln(os.Stderr, `    livez: Check if the service is alive.`)
-	fmt.Fprintln(os.Stderr, `    create-project-link: Create a new link for a project.`)
-	fmt.Fprintln(os.Stderr, `    get-project-link: Get a single project link.`)
-	fmt.Fprintln(os.Stderr, `    delete-project-link: Delete a project link.`)
-	fmt.Fprintln(os.Stderr, `    create-project-folder: Create a new folder for a project.`)
-	fmt.Fprintln(os.Stderr, `    get-project-folder: Get a single project folder.`)
-	fmt.Fprintln(os.Stderr, `    delete-project-folder: Delete a project folder. The folder must be empty.`)
-	fmt.Fprintln(os.Stderr, `    upload-project-document: Upload a new document for a project (multipart/form-data).`)
-	fmt.Fprintln(os.Stderr, `    get-project-document: Get project document metadata.`)
-	fmt.Fprintln(os.Stderr, `    download-project-document: Download the binary file of a project document.`)
-	fmt.Fprintln(os.Stderr, `    delete-project-document: Delete a project document.`)
+func projectServiceInspectProjectKvUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service inspect-project-kv", os.Args[0])
+	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, "Additional help:")
-	fmt.Fprintf(os.Stderr, "    %s project-service COMMAND --help\n", os.Args[0])
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the entries stored for a project's base and settings in the NATS KV buckets as they are, with their current revision and retained history, for LF staff debugging stale documents.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service inspect-project-kv --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --bearer-token \"eyJhbGci...\"")
 }
-func projectServiceGetProjectsUsage() {
+
+func projectServiceCreateBackupUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-projects", os.Args[0])
-	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service create-backup", os.Args[0])
 	fmt.Fprint(os.Stderr, " -bearer-token STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Get all projects.`)
+	fmt.Fprintln(os.Stderr, `Save every entry of the projects and project-settings stores, including slug mappings, to a new backup archive, for LF staff.`)
 
 	// Flags list
-	fmt.Fprintln(os.Stderr, `    -version STRING: `)
 	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-projects --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service create-backup --bearer-token \"eyJhbGci...\"")
 }
 
-func projectServiceCreateProjectUsage() {
+func projectServiceListBackupsUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] project-service create-project", os.Args[0])
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service list-backups", os.Args[0])
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the stored backup archives, for LF staff.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service list-backups --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceRestoreBackupUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service restore-backup", os.Args[0])
 	fmt.Fprint(os.Stderr, " -body JSON")
-	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -name STRING")
 	fmt.Fprint(os.Stderr, " -bearer-token STRING")
-	fmt.Fprint(os.Stderr, " -x-sync BOOL")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Create a new project.`)
+	fmt.Fprintln(os.Stderr, `Write the entries of a backup archive back to the project stores, for every project or only the given ones, for LF staff.`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -body JSON: `)
-	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -name STRING: Backup name`)
 	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
-	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service create-project --body '{\n      \"announcement_date\": \"2021-01-01\",\n      \"auditors\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"autojoin_enabled\": false,\n      \"category\": \"Active\",\n      \"charter_url\": \"https://example.com/charter.pdf\",\n      \"description\": \"project foo is a project about bar\",\n      \"entity_dissolution_date\": \"2021-12-31\",\n      \"entity_formation_document_url\": \"https://example.com/formation.pdf\",\n      \"executive_director\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"formation_date\": \"2021-01-01\",\n      \"funding\": \"Funded\",\n      \"funding_model\": [\n         \"Crowdfunding\"\n      ],\n      \"is_foundation\": false,\n      \"legal_entity_name\": \"Example Foundation LLC\",\n      \"legal_entity_type\": \"Subproject\",\n      \"legal_parent_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"logo_url\": \"https://example.com/logo.png\",\n      \"meeting_coordinators\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"mission_statement\": \"The mission of the project is to build a sustainable ecosystem around open source projects to accelerate technology development and industry adoption.\",\n      \"name\": \"Foo Foundation\",\n      \"opportunity_owner\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"parent_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"program_manager\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"public\": true,\n      \"repository_url\": \"https://example.com/project\",\n      \"slug\": \"project-slug\",\n      \"stage\": \"Formation - Exploratory\",\n      \"website_url\": \"https://example.com\",\n      \"writers\": [\n         {\n            \"avatar\": \"https://example.com/avatar3.jpg\",\n            \"email\": \"alice.johnson@example.com\",\n            \"name\": \"Alice Johnson\",\n            \"username\": \"alicejohnson789\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar4.jpg\",\n            \"email\": \"bob.wilson@example.com\",\n            \"name\": \"Bob Wilson\",\n            \"username\": \"bobwilson101\"\n         }\n      ]\n   }' --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service restore-backup --body '{\n      \"dry_run\": true,\n      \"project_uids\": [\n         \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n      ]\n   }' --name \"20250101T000000Z.ndjson\" --bearer-token \"eyJhbGci...\"")
 }
 
-func projectServiceGetOneProjectBaseUsage() {
+func projectServiceListPendingAnnouncementsUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-one-project-base", os.Args[0])
-	fmt.Fprint(os.Stderr, " -uid STRING")
-	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service list-pending-announcements", os.Args[0])
 	fmt.Fprint(os.Stderr, " -bearer-token STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Get a single project's base information.`)
+	fmt.Fprintln(os.Stderr, `List the projects whose announcement date is set and whose announcement has not been published yet, for LF staff.`)
 
 	// Flags list
-	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
-	fmt.Fprintln(os.Stderr, `    -version STRING: `)
 	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-one-project-base --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service list-pending-announcements --bearer-token \"eyJhbGci...\"")
 }
 
-func projectServiceGetOneProjectSettingsUsage() {
+func projectServiceGetProjectEnumsUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-one-project-settings", os.Args[0])
-	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-project-enums", os.Args[0])
 	fmt.Fprint(os.Stderr, " -version STRING")
 	fmt.Fprint(os.Stderr, " -bearer-token STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Get a single project's settings.`)
+	fmt.Fprintln(os.Stderr, `Get the values currently allowed for the enumerated project fields, stage and category. Admins change them without a release of the service.`)
 
 	// Flags list
-	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
 	fmt.Fprintln(os.Stderr, `    -version STRING: `)
 	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-one-project-settings --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-project-enums --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
-func projectServiceUpdateProjectBaseUsage() {
+func projectServiceUpdateProjectEnumsUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] project-service update-project-base", os.Args[0])
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service update-project-enums", os.Args[0])
 	fmt.Fprint(os.Stderr, " -body JSON")
-	fmt.Fprint(os.Stderr, " -uid STRING")
-	fmt.Fprint(os.Stderr, " -version STRING")
 	fmt.Fprint(os.Stderr, " -bearer-token STRING")
-	fmt.Fprint(os.Stderr, " -x-sync BOOL")
-	fmt.Fprint(os.Stderr, " -if-match STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Update an existing project's base information.`)
+	fmt.Fprintln(os.Stderr, `Replace the values allowed for the enumerated project fields, for LF staff. Projects that already have a value no longer allowed keep it until the field is changed.`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -body JSON: `)
-	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
-	fmt.Fprintln(os.Stderr, `    -version STRING: `)
 	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
-	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
-	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service update-project-base --body '{\n      \"autojoin_enabled\": false,\n      \"category\": \"Active\",\n      \"charter_url\": \"https://example.com/charter.pdf\",\n      \"description\": \"project foo is a project about bar\",\n      \"entity_dissolution_date\": \"2021-12-31\",\n      \"entity_formation_document_url\": \"https://example.com/formation.pdf\",\n      \"formation_date\": \"2021-01-01\",\n      \"funding\": \"Funded\",\n      \"funding_model\": [\n         \"Crowdfunding\"\n      ],\n      \"is_foundation\": false,\n      \"legal_entity_name\": \"Example Foundation LLC\",\n      \"legal_entity_type\": \"Subproject\",\n      \"legal_parent_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"logo_url\": \"https://example.com/logo.png\",\n      \"name\": \"Foo Foundation\",\n      \"parent_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"public\": true,\n      \"repository_url\": \"https://example.com/project\",\n      \"slug\": \"project-slug\",\n      \"stage\": \"Formation - Exploratory\",\n      \"website_url\": \"https://example.com\"\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true --if-match \"123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service update-project-enums --body '{\n      \"categories\": [\n         \"Sandbox\",\n         \"Incubating\",\n         \"Graduated\"\n      ],\n      \"stages\": [\n         \"Formation - Exploratory\",\n         \"Active\",\n         \"Archived\"\n      ]\n   }' --bearer-token \"eyJhbGci...\"")
 }
 
-func projectServiceUpdateProjectSettingsUsage() {
+func projectServiceCreateProjectTemplateUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] project-service update-project-settings", os.Args[0])
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service create-project-template", os.Args[0])
 	fmt.Fprint(os.Stderr, " -body JSON")
-	fmt.Fprint(os.Stderr, " -uid STRING")
-	fmt.Fprint(os.Stderr, " -version STRING")
 	fmt.Fprint(os.Stderr, " -bearer-token STRING")
-	fmt.Fprint(os.Stderr, " -x-sync BOOL")
-	fmt.Fprint(os.Stderr, " -if-match STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Update an existing project's settings.`)
+	fmt.Fprintln(os.Stderr, `Define a template that projects can be created from with POST /projects?template=<name>, for LF staff.`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -body JSON: `)
-	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
-	fmt.Fprintln(os.Stderr, `    -version STRING: `)
 	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
-	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
-	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service update-project-settings --body '{\n      \"announcement_date\": \"2021-01-01\",\n      \"auditors\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"executive_director\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"meeting_coordinators\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"mission_statement\": \"The mission of the project is to build a sustainable ecosystem around open source projects to accelerate technology development and industry adoption.\",\n      \"opportunity_owner\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"program_manager\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"writers\": [\n         {\n            \"avatar\": \"https://example.com/avatar3.jpg\",\n            \"email\": \"alice.johnson@example.com\",\n            \"name\": \"Alice Johnson\",\n            \"username\": \"alicejohnson789\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar4.jpg\",\n            \"email\": \"bob.wilson@example.com\",\n            \"name\": \"Bob Wilson\",\n            \"username\": \"bobwilson101\"\n         }\n      ]\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true --if-match \"123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service create-project-template --body '{\n      \"auditors\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"category\": \"Active\",\n      \"description\": \"CNCF sandbox projects\",\n      \"funding_model\": [\n         \"Crowdfunding\"\n      ],\n      \"mission_statement\": \"The mission of the project is to build a sustainable ecosystem around open source projects to accelerate technology development and industry adoption.\",\n      \"name\": \"cncf-sandbox\",\n      \"stage\": \"Formation - Exploratory\"\n   }' --bearer-token \"eyJhbGci...\"")
 }
 
-func projectServiceDeleteProjectUsage() {
+func projectServiceListProjectTemplatesUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] project-service delete-project", os.Args[0])
-	fmt.Fprint(os.Stderr, " -uid STRING")
-	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service list-project-templates", os.Args[0])
 	fmt.Fprint(os.Stderr, " -bearer-token STRING")
-	fmt.Fprint(os.Stderr, " -x-sync BOOL")
-	fmt.Fprint(os.Stderr, " -if-match STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Delete an existing project.`)
+	fmt.Fprintln(os.Stderr, `List the project templates, sorted by name, for LF staff.`)
 
 	// Flags list
-	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
-	fmt.Fprintln(os.Stderr, `    -version STRING: `)
 	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
-	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
-	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service delete-project --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true --if-match \"123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service list-project-templates --bearer-token \"eyJhbGci...\"")
 }
 
-func projectServiceReadyzUsage() {
+func projectServiceGetProjectTemplateUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] project-service readyz", os.Args[0])
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-project-template", os.Args[0])
+	fmt.Fprint(os.Stderr, " -name STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Check if the service is able to take inbound requests.`)
+	fmt.Fprintln(os.Stderr, `Get a project template, for LF staff.`)
 
 	// Flags list
+	fmt.Fprintln(os.Stderr, `    -name STRING: Name of the template, lowercase alphanumerics separated by '-'`)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service readyz")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-project-template --name \"cncf-sandbox\" --bearer-token \"eyJhbGci...\"")
 }
 
-func projectServiceLivezUsage() {
+func projectServiceUpdateProjectTemplateUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] project-service livez", os.Args[0])
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service update-project-template", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -name STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Check if the service is alive.`)
+	fmt.Fprintln(os.Stderr, `Replace the defaults of a project template, for LF staff. Projects already created from it are not changed.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -name STRING: Name of the template, lowercase alphanumerics separated by '-'`)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service update-project-template --body '{\n      \"auditors\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"category\": \"Active\",\n      \"description\": \"CNCF sandbox projects\",\n      \"funding_model\": [\n         \"Crowdfunding\"\n      ],\n      \"mission_statement\": \"The mission of the project is to build a sustainable ecosystem around open source projects to accelerate technology development and industry adoption.\",\n      \"stage\": \"Formation - Exploratory\"\n   }' --name \"cncf-sandbox\" --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceDeleteProjectTemplateUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service delete-project-template", os.Args[0])
+	fmt.Fprint(os.Stderr, " -name STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Remove a project template, for LF staff. Projects already created from it are not changed.`)
 
 	// Flags list
+	fmt.Fprintln(os.Stderr, `    -name STRING: Name of the template, lowercase alphanumerics separated by '-'`)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service livez")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service delete-project-template --name \"cncf-sandbox\" --bearer-token \"eyJhbGci...\"")
 }
 
-func projectServiceCreateProjectLinkUsage() {
+func projectServiceListProjectDeletionsUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] project-service create-project-link", os.Args[0])
-	fmt.Fprint(os.Stderr, " -body JSON")
-	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service list-project-deletions", os.Args[0])
 	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -since STRING")
 	fmt.Fprint(os.Stderr, " -bearer-token STRING")
-	fmt.Fprint(os.Stderr, " -x-sync BOOL")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Create a new link for a project.`)
+	fmt.Fprintln(os.Stderr, `List the tombstones of deleted projects, oldest first, so that downstream caches can tell a deleted project from one that never existed. Tombstones are kept for the retention of the deletions bucket. Only LF staff and trusted service principals may list them.`)
 
 	// Flags list
-	fmt.Fprintln(os.Stderr, `    -body JSON: `)
-	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
 	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -since STRING: `)
 	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
-	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service create-project-link --body '{\n      \"description\": \"A description of the resource\",\n      \"folder_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"name\": \"My Resource\",\n      \"url\": \"https://example.com\"\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service list-project-deletions --version \"1\" --since \"2024-01-01T00:00:00Z\" --bearer-token \"eyJhbGci...\"")
 }
 
-func projectServiceGetProjectLinkUsage() {
+func projectServiceListProjectEventsUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-project-link", os.Args[0])
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service list-project-events", os.Args[0])
 	fmt.Fprint(os.Stderr, " -uid STRING")
-	fmt.Fprint(os.Stderr, " -link-uid STRING")
 	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -since STRING")
 	fmt.Fprint(os.Stderr, " -bearer-token STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Get a single project link.`)
+	fmt.Fprintln(os.Stderr, `List the lifecycle events of a project, oldest first, reconstructed from the revisions of its base and settings still retained in the project store, so that a new downstream consumer can backfill its state. Only project.created, project.updated, project.deleted and project.settings.updated events are reconstructed, without their actor.`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
-	fmt.Fprintln(os.Stderr, `    -link-uid STRING: Link UID`)
 	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -since STRING: `)
 	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-project-link --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --link-uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service list-project-events --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --since \"2024-01-01T00:00:00Z\" --bearer-token \"eyJhbGci...\"")
 }
 
-func projectServiceDeleteProjectLinkUsage() {
+func projectServiceGetUserProjectsUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] project-service delete-project-link", os.Args[0])
-	fmt.Fprint(os.Stderr, " -uid STRING")
-	fmt.Fprint(os.Stderr, " -link-uid STRING")
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-user-projects", os.Args[0])
+	fmt.Fprint(os.Stderr, " -username STRING")
 	fmt.Fprint(os.Stderr, " -version STRING")
 	fmt.Fprint(os.Stderr, " -bearer-token STRING")
-	fmt.Fprint(os.Stderr, " -x-sync BOOL")
-	fmt.Fprint(os.Stderr, " -if-match STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Delete a project link.`)
+	fmt.Fprintln(os.Stderr, `List the projects in which a user is a writer, auditor or meeting coordinator. Users may only list their own projects, unless they are LF staff.`)
 
 	// Flags list
-	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
-	fmt.Fprintln(os.Stderr, `    -link-uid STRING: Link UID`)
+	fmt.Fprintln(os.Stderr, `    -username STRING: The LFID username of the user`)
 	fmt.Fprintln(os.Stderr, `    -version STRING: `)
 	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
-	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
-	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service delete-project-link --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --link-uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true --if-match \"123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-user-projects --username \"jdoe\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
-func projectServiceCreateProjectFolderUsage() {
+func projectServiceExportUserDataUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] project-service create-project-folder", os.Args[0])
-	fmt.Fprint(os.Stderr, " -body JSON")
-	fmt.Fprint(os.Stderr, " -uid STRING")
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service export-user-data", os.Args[0])
+	fmt.Fprint(os.Stderr, " -username STRING")
 	fmt.Fprint(os.Stderr, " -version STRING")
 	fmt.Fprint(os.Stderr, " -bearer-token STRING")
-	fmt.Fprint(os.Stderr, " -x-sync BOOL")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Create a new folder for a project.`)
+	fmt.Fprintln(os.Stderr, `Export everything the service stores about a user, for data-subject access requests: each writer, auditor, meeting coordinator and contact role the user holds, with the name, email and avatar stored with it and when the user was given the role. Users may only export their own data, unless they are LF staff.`)
 
 	// Flags list
-	fmt.Fprintln(os.Stderr, `    -body JSON: `)
-	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
+	fmt.Fprintln(os.Stderr, `    -username STRING: The LFID username of the user`)
 	fmt.Fprintln(os.Stderr, `    -version STRING: `)
 	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
-	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service create-project-folder --body '{\n      \"name\": \"My Resource\"\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service export-user-data --username \"jdoe\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
-func projectServiceGetProjectFolderUsage() {
+func projectServiceRemoveUserAccessUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-project-folder", os.Args[0])
-	fmt.Fprint(os.Stderr, " -uid STRING")
-	fmt.Fprint(os.Stderr, " -folder-uid STRING")
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service remove-user-access", os.Args[0])
+	fmt.Fprint(os.Stderr, " -username STRING")
 	fmt.Fprint(os.Stderr, " -version STRING")
 	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprint(os.Stderr, " -x-sync BOOL")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Get a single project folder.`)
+	fmt.Fprintln(os.Stderr, `Remove a user from the writers, auditors and meeting coordinators of every project, for employee departures and erasure requests. Only LF staff may remove a user's access. Each project is updated on its own and reported with its outcome; a project that fails does not stop the others.`)
 
 	// Flags list
-	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
-	fmt.Fprintln(os.Stderr, `    -folder-uid STRING: Folder UID`)
+	fmt.Fprintln(os.Stderr, `    -username STRING: The LFID username of the user`)
 	fmt.Fprintln(os.Stderr, `    -version STRING: `)
 	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-project-folder --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --folder-uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service remove-user-access --username \"jdoe\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
 }
 
-func projectServiceDeleteProjectFolderUsage() {
+func projectServiceGetProjectVisibilityImpactUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] project-service delete-project-folder", os.Args[0])
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-project-visibility-impact", os.Args[0])
 	fmt.Fprint(os.Stderr, " -uid STRING")
-	fmt.Fprint(os.Stderr, " -folder-uid STRING")
 	fmt.Fprint(os.Stderr, " -version STRING")
 	fmt.Fprint(os.Stderr, " -bearer-token STRING")
-	fmt.Fprint(os.Stderr, " -x-sync BOOL")
-	fmt.Fprint(os.Stderr, " -if-match STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Delete a project folder. The folder must be empty.`)
+	fmt.Fprintln(os.Stderr, `Preview what flipping a project's public flag would affect: its descendants, its URLs pointing outside the service, and the associated resources and webhooks that follow it. The project is not changed.`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
-	fmt.Fprintln(os.Stderr, `    -folder-uid STRING: Folder UID`)
 	fmt.Fprintln(os.Stderr, `    -version STRING: `)
 	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
-	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
-	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service delete-project-folder --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --folder-uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true --if-match \"123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-project-visibility-impact --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\"")
 }
 
-func projectServiceUploadProjectDocumentUsage() {
+func projectServiceCreateWebhookUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] project-service upload-project-document", os.Args[0])
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service create-webhook", os.Args[0])
 	fmt.Fprint(os.Stderr, " -body JSON")
-	fmt.Fprint(os.Stderr, " -uid STRING")
-	fmt.Fprint(os.Stderr, " -version STRING")
 	fmt.Fprint(os.Stderr, " -bearer-token STRING")
-	fmt.Fprint(os.Stderr, " -x-sync BOOL")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Upload a new document for a project (multipart/form-data).`)
+	fmt.Fprintln(os.Stderr, `Register an HTTPS endpoint that receives signed POSTs for project lifecycle events, for LF staff. The signing secret is only returned by this call.`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -body JSON: `)
-	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
-	fmt.Fprintln(os.Stderr, `    -version STRING: `)
 	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
-	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service upload-project-document --body '{\n      \"content_type\": \"application/pdf\",\n      \"description\": \"A description of the resource\",\n      \"file\": \"Li4u\",\n      \"file_name\": \"report.pdf\",\n      \"folder_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"name\": \"My Resource\"\n   }' --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service create-webhook --body '{\n      \"description\": \"Sync project changes to the foundation CRM\",\n      \"events\": [\n         \"project.created\",\n         \"project.deleted\"\n      ],\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"url\": \"https://hooks.example.org/lfx/projects\"\n   }' --bearer-token \"eyJhbGci...\"")
 }
 
-func projectServiceGetProjectDocumentUsage() {
+func projectServiceListWebhooksUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-project-document", os.Args[0])
-	fmt.Fprint(os.Stderr, " -uid STRING")
-	fmt.Fprint(os.Stderr, " -document-uid STRING")
-	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service list-webhooks", os.Args[0])
 	fmt.Fprint(os.Stderr, " -bearer-token STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Get project document metadata.`)
+	fmt.Fprintln(os.Stderr, `List the registered webhooks, without their signing secrets.`)
 
 	// Flags list
-	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
-	fmt.Fprintln(os.Stderr, `    -document-uid STRING: Document UID`)
-	fmt.Fprintln(os.Stderr, `    -version STRING: `)
 	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-project-document --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --document-uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service list-webhooks --bearer-token \"eyJhbGci...\"")
 }
 
-func projectServiceDownloadProjectDocumentUsage() {
+func projectServiceGetWebhookUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] project-service download-project-document", os.Args[0])
-	fmt.Fprint(os.Stderr, " -uid STRING")
-	fmt.Fprint(os.Stderr, " -document-uid STRING")
-	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service get-webhook", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
 	fmt.Fprint(os.Stderr, " -bearer-token STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Download the binary file of a project document.`)
+	fmt.Fprintln(os.Stderr, `Get a registered webhook, without its signing secret.`)
 
 	// Flags list
-	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
-	fmt.Fprintln(os.Stderr, `    -document-uid STRING: Document UID`)
-	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: Webhook ID`)
 	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service download-project-document --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --document-uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service get-webhook --id \"0b1f9a3e-5c2d-4e7f-9a8b-6c5d4e3f2a1b\" --bearer-token \"eyJhbGci...\"")
 }
 
-func projectServiceDeleteProjectDocumentUsage() {
+func projectServiceDeleteWebhookUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] project-service delete-project-document", os.Args[0])
-	fmt.Fprint(os.Stderr, " -uid STRING")
-	fmt.Fprint(os.Stderr, " -document-uid STRING")
-	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service delete-webhook", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
 	fmt.Fprint(os.Stderr, " -bearer-token STRING")
-	fmt.Fprint(os.Stderr, " -x-sync BOOL")
-	fmt.Fprint(os.Stderr, " -if-match STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Delete a project document.`)
+	fmt.Fprintln(os.Stderr, `Remove a webhook along with its delivery history. Pending retries are dropped.`)
 
 	// Flags list
-	fmt.Fprintln(os.Stderr, `    -uid STRING: Project UID -- v2 uid, not related to v1 id directly`)
-	fmt.Fprintln(os.Stderr, `    -document-uid STRING: Document UID`)
-	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: Webhook ID`)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service delete-webhook --id \"0b1f9a3e-5c2d-4e7f-9a8b-6c5d4e3f2a1b\" --bearer-token \"eyJhbGci...\"")
+}
+
+func projectServiceListWebhookDeliveriesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] project-service list-webhook-deliveries", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the events sent, or still being retried, to a webhook, newest first.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Webhook ID`)
 	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
-	fmt.Fprintln(os.Stderr, `    -x-sync BOOL: `)
-	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service delete-project-document --uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --document-uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --version \"1\" --bearer-token \"eyJhbGci...\" --x-sync true --if-match \"123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "project-service list-webhook-deliveries --id \"0b1f9a3e-5c2d-4e7f-9a8b-6c5d4e3f2a1b\" --bearer-token \"eyJhbGci...\"")
 }
@@ -19,14 +19,34 @@ import (
 	goa "goa.design/goa/v3/pkg"
 )
 
-// BuildGetProjectsPayload builds the payload for the project-service
-// get-projects endpoint from CLI flags.
-func BuildGetProjectsPayload(projectServiceGetProjectsVersion string, projectServiceGetProjectsBearerToken string) (*projectservice.GetProjectsPayload, error) {
+// BuildCloneProjectPayload builds the payload for the project-service
+// clone-project endpoint from CLI flags.
+func BuildCloneProjectPayload(projectServiceCloneProjectBody string, projectServiceCloneProjectUID string, projectServiceCloneProjectVersion string, projectServiceCloneProjectBearerToken string, projectServiceCloneProjectXSync string) (*projectservice.CloneProjectPayload, error) {
 	var err error
+	var body CloneProjectRequestBody
+	{
+		err = json.Unmarshal([]byte(projectServiceCloneProjectBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"category\": \"Active\",\n      \"description\": \"project foo is a project about bar\",\n      \"mission_statement\": \"The mission of the project is to build a sustainable ecosystem around open source projects to accelerate technology development and industry adoption.\",\n      \"name\": \"Foo Foundation\",\n      \"parent_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"public\": true,\n      \"slug\": \"project-slug\",\n      \"stage\": \"Formation - Exploratory\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.slug", body.Slug, goa.FormatRegexp))
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", body.Slug, "^[a-z][a-z0-9_\\-]*[a-z0-9]$"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var uid string
+	{
+		uid = projectServiceCloneProjectUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
 	var version *string
 	{
-		if projectServiceGetProjectsVersion != "" {
-			version = &projectServiceGetProjectsVersion
+		if projectServiceCloneProjectVersion != "" {
+			version = &projectServiceCloneProjectVersion
 			if !(*version == "1") {
 				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
 			}
@@ -37,125 +57,68 @@ func BuildGetProjectsPayload(projectServiceGetProjectsVersion string, projectSer
 	}
 	var bearerToken *string
 	{
-		if projectServiceGetProjectsBearerToken != "" {
-			bearerToken = &projectServiceGetProjectsBearerToken
+		if projectServiceCloneProjectBearerToken != "" {
+			bearerToken = &projectServiceCloneProjectBearerToken
 		}
 	}
-	v := &projectservice.GetProjectsPayload{}
+	var xSync *bool
+	{
+		if projectServiceCloneProjectXSync != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceCloneProjectXSync)
+			xSync = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	v := &projectservice.CloneProjectPayload{
+		Slug:             body.Slug,
+		Name:             body.Name,
+		Description:      body.Description,
+		Public:           body.Public,
+		ParentUID:        body.ParentUID,
+		Stage:            body.Stage,
+		Category:         body.Category,
+		MissionStatement: body.MissionStatement,
+	}
+	v.UID = uid
 	v.Version = version
 	v.BearerToken = bearerToken
+	v.XSync = xSync
 
 	return v, nil
 }
 
-// BuildCreateProjectPayload builds the payload for the project-service
-// create-project endpoint from CLI flags.
-func BuildCreateProjectPayload(projectServiceCreateProjectBody string, projectServiceCreateProjectVersion string, projectServiceCreateProjectBearerToken string, projectServiceCreateProjectXSync string) (*projectservice.CreateProjectPayload, error) {
+// BuildUploadProjectLogoPayload builds the payload for the project-service
+// upload-project-logo endpoint from CLI flags.
+func BuildUploadProjectLogoPayload(projectServiceUploadProjectLogoBody string, projectServiceUploadProjectLogoUID string, projectServiceUploadProjectLogoVersion string, projectServiceUploadProjectLogoBearerToken string, projectServiceUploadProjectLogoXSync string) (*projectservice.UploadProjectLogoPayload, error) {
 	var err error
-	var body CreateProjectRequestBody
+	var body UploadProjectLogoRequestBody
 	{
-		err = json.Unmarshal([]byte(projectServiceCreateProjectBody), &body)
+		err = json.Unmarshal([]byte(projectServiceUploadProjectLogoBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"announcement_date\": \"2021-01-01\",\n      \"auditors\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"autojoin_enabled\": false,\n      \"category\": \"Active\",\n      \"charter_url\": \"https://example.com/charter.pdf\",\n      \"description\": \"project foo is a project about bar\",\n      \"entity_dissolution_date\": \"2021-12-31\",\n      \"entity_formation_document_url\": \"https://example.com/formation.pdf\",\n      \"executive_director\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"formation_date\": \"2021-01-01\",\n      \"funding\": \"Funded\",\n      \"funding_model\": [\n         \"Crowdfunding\"\n      ],\n      \"is_foundation\": false,\n      \"legal_entity_name\": \"Example Foundation LLC\",\n      \"legal_entity_type\": \"Subproject\",\n      \"legal_parent_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"logo_url\": \"https://example.com/logo.png\",\n      \"meeting_coordinators\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"mission_statement\": \"The mission of the project is to build a sustainable ecosystem around open source projects to accelerate technology development and industry adoption.\",\n      \"name\": \"Foo Foundation\",\n      \"opportunity_owner\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"parent_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"program_manager\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"public\": true,\n      \"repository_url\": \"https://example.com/project\",\n      \"slug\": \"project-slug\",\n      \"stage\": \"Formation - Exploratory\",\n      \"website_url\": \"https://example.com\",\n      \"writers\": [\n         {\n            \"avatar\": \"https://example.com/avatar3.jpg\",\n            \"email\": \"alice.johnson@example.com\",\n            \"name\": \"Alice Johnson\",\n            \"username\": \"alicejohnson789\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar4.jpg\",\n            \"email\": \"bob.wilson@example.com\",\n            \"name\": \"Bob Wilson\",\n            \"username\": \"bobwilson101\"\n         }\n      ]\n   }'")
-		}
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.slug", body.Slug, goa.FormatRegexp))
-		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", body.Slug, "^[a-z][a-z0-9_\\-]*[a-z0-9]$"))
-		if body.Stage != nil {
-			if !(*body.Stage == "Formation - Exploratory" || *body.Stage == "Formation - Engaged" || *body.Stage == "Active" || *body.Stage == "Archived" || *body.Stage == "Formation - On Hold" || *body.Stage == "Formation - Disengaged" || *body.Stage == "Formation - Confidential" || *body.Stage == "Prospect") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.stage", *body.Stage, []any{"Formation - Exploratory", "Formation - Engaged", "Active", "Archived", "Formation - On Hold", "Formation - Disengaged", "Formation - Confidential", "Prospect"}))
-			}
-		}
-		if body.Category != nil {
-			if !(*body.Category == "Active" || *body.Category == "Adopted" || *body.Category == "Archived" || *body.Category == "At-Large" || *body.Category == "Early Adoption" || *body.Category == "Emeritus" || *body.Category == "Graduated" || *body.Category == "Growth" || *body.Category == "Idle" || *body.Category == "Impact" || *body.Category == "Incubating" || *body.Category == "Kanister" || *body.Category == "Mature" || *body.Category == "Pre-LFESS" || *body.Category == "Sandbox" || *body.Category == "SIG" || *body.Category == "Standards" || *body.Category == "TAC" || *body.Category == "Working Group" || *body.Category == "TAG" || *body.Category == "NONE") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.category", *body.Category, []any{"Active", "Adopted", "Archived", "At-Large", "Early Adoption", "Emeritus", "Graduated", "Growth", "Idle", "Impact", "Incubating", "Kanister", "Mature", "Pre-LFESS", "Sandbox", "SIG", "Standards", "TAC", "Working Group", "TAG", "NONE"}))
-			}
-		}
-		if body.Funding != nil {
-			if !(*body.Funding == "Funded" || *body.Funding == "Unfunded" || *body.Funding == "Supported by Parent Project") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.funding", *body.Funding, []any{"Funded", "Unfunded", "Supported by Parent Project"}))
-			}
-		}
-		for _, e := range body.FundingModel {
-			if !(e == "Crowdfunding" || e == "Membership" || e == "Alternate Funding") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.funding_model[*]", e, []any{"Crowdfunding", "Membership", "Alternate Funding"}))
-			}
-		}
-		if body.CharterURL != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.charter_url", *body.CharterURL, goa.FormatURI))
-		}
-		if body.LegalEntityType != nil {
-			if !(*body.LegalEntityType == "Subproject" || *body.LegalEntityType == "Incorporated Entity" || *body.LegalEntityType == "Series LLC" || *body.LegalEntityType == "Unofficial Subproject" || *body.LegalEntityType == "Internal Allocation" || *body.LegalEntityType == "None") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.legal_entity_type", *body.LegalEntityType, []any{"Subproject", "Incorporated Entity", "Series LLC", "Unofficial Subproject", "Internal Allocation", "None"}))
-			}
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"content_type\": \"image/svg+xml\",\n      \"file\": \"Li4u\"\n   }'")
 		}
-		if body.LegalParentUID != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.legal_parent_uid", *body.LegalParentUID, goa.FormatUUID))
-		}
-		if body.EntityDissolutionDate != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.entity_dissolution_date", *body.EntityDissolutionDate, goa.FormatDate))
-		}
-		if body.EntityFormationDocumentURL != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.entity_formation_document_url", *body.EntityFormationDocumentURL, goa.FormatURI))
-		}
-		if body.FormationDate != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.formation_date", *body.FormationDate, goa.FormatDate))
-		}
-		if body.LogoURL != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.logo_url", *body.LogoURL, goa.FormatURI))
-		}
-		if body.RepositoryURL != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.repository_url", *body.RepositoryURL, goa.FormatURI))
-		}
-		if body.WebsiteURL != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.website_url", *body.WebsiteURL, goa.FormatURI))
-		}
-		if body.AnnouncementDate != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.announcement_date", *body.AnnouncementDate, goa.FormatDate))
-		}
-		for _, e := range body.Writers {
-			if e != nil {
-				if err2 := ValidateUserInfoRequestBody(e); err2 != nil {
-					err = goa.MergeErrors(err, err2)
-				}
-			}
-		}
-		for _, e := range body.MeetingCoordinators {
-			if e != nil {
-				if err2 := ValidateUserInfoRequestBody(e); err2 != nil {
-					err = goa.MergeErrors(err, err2)
-				}
-			}
-		}
-		for _, e := range body.Auditors {
-			if e != nil {
-				if err2 := ValidateUserInfoRequestBody(e); err2 != nil {
-					err = goa.MergeErrors(err, err2)
-				}
-			}
-		}
-		if body.ExecutiveDirector != nil {
-			if err2 := ValidateUserInfoRequestBody(body.ExecutiveDirector); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
-		if body.ProgramManager != nil {
-			if err2 := ValidateUserInfoRequestBody(body.ProgramManager); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
+		if body.File == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("file", "body"))
 		}
-		if body.OpportunityOwner != nil {
-			if err2 := ValidateUserInfoRequestBody(body.OpportunityOwner); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
+		if err != nil {
+			return nil, err
 		}
+	}
+	var uid string
+	{
+		uid = projectServiceUploadProjectLogoUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
 		if err != nil {
 			return nil, err
 		}
 	}
 	var version *string
 	{
-		if projectServiceCreateProjectVersion != "" {
-			version = &projectServiceCreateProjectVersion
+		if projectServiceUploadProjectLogoVersion != "" {
+			version = &projectServiceUploadProjectLogoVersion
 			if !(*version == "1") {
 				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
 			}
@@ -166,78 +129,26 @@ func BuildCreateProjectPayload(projectServiceCreateProjectBody string, projectSe
 	}
 	var bearerToken *string
 	{
-		if projectServiceCreateProjectBearerToken != "" {
-			bearerToken = &projectServiceCreateProjectBearerToken
+		if projectServiceUploadProjectLogoBearerToken != "" {
+			bearerToken = &projectServiceUploadProjectLogoBearerToken
 		}
 	}
 	var xSync *bool
 	{
-		if projectServiceCreateProjectXSync != "" {
+		if projectServiceUploadProjectLogoXSync != "" {
 			var val bool
-			val, err = strconv.ParseBool(projectServiceCreateProjectXSync)
+			val, err = strconv.ParseBool(projectServiceUploadProjectLogoXSync)
 			xSync = &val
 			if err != nil {
 				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
 			}
 		}
 	}
-	v := &projectservice.CreateProjectPayload{
-		Slug:                       body.Slug,
-		Description:                body.Description,
-		Name:                       body.Name,
-		Public:                     body.Public,
-		IsFoundation:               body.IsFoundation,
-		ParentUID:                  body.ParentUID,
-		Stage:                      body.Stage,
-		Category:                   body.Category,
-		Funding:                    body.Funding,
-		CharterURL:                 body.CharterURL,
-		LegalEntityType:            body.LegalEntityType,
-		LegalEntityName:            body.LegalEntityName,
-		LegalParentUID:             body.LegalParentUID,
-		EntityDissolutionDate:      body.EntityDissolutionDate,
-		EntityFormationDocumentURL: body.EntityFormationDocumentURL,
-		AutojoinEnabled:            body.AutojoinEnabled,
-		FormationDate:              body.FormationDate,
-		LogoURL:                    body.LogoURL,
-		RepositoryURL:              body.RepositoryURL,
-		WebsiteURL:                 body.WebsiteURL,
-		AnnouncementDate:           body.AnnouncementDate,
-		MissionStatement:           body.MissionStatement,
-	}
-	if body.FundingModel != nil {
-		v.FundingModel = make([]string, len(body.FundingModel))
-		for i, val := range body.FundingModel {
-			v.FundingModel[i] = val
-		}
-	}
-	if body.Writers != nil {
-		v.Writers = make([]*projectservice.UserInfo, len(body.Writers))
-		for i, val := range body.Writers {
-			v.Writers[i] = marshalUserInfoRequestBodyToProjectserviceUserInfo(val)
-		}
-	}
-	if body.MeetingCoordinators != nil {
-		v.MeetingCoordinators = make([]*projectservice.UserInfo, len(body.MeetingCoordinators))
-		for i, val := range body.MeetingCoordinators {
-			v.MeetingCoordinators[i] = marshalUserInfoRequestBodyToProjectserviceUserInfo(val)
-		}
-	}
-	if body.Auditors != nil {
-		v.Auditors = make([]*projectservice.UserInfo, len(body.Auditors))
-		for i, val := range body.Auditors {
-			v.Auditors[i] = marshalUserInfoRequestBodyToProjectserviceUserInfo(val)
-		}
-	}
-	if body.ExecutiveDirector != nil {
-		v.ExecutiveDirector = marshalUserInfoRequestBodyToProjectserviceUserInfo(body.ExecutiveDirector)
-	}
-	if body.ProgramManager != nil {
-		v.ProgramManager = marshalUserInfoRequestBodyToProjectserviceUserInfo(body.ProgramManager)
-	}
-	if body.OpportunityOwner != nil {
-		v.OpportunityOwner = marshalUserInfoRequestBodyToProjectserviceUserInfo(body.OpportunityOwner)
+	v := &projectservice.UploadProjectLogoPayload{
+		File:        body.File,
+		ContentType: body.ContentType,
 	}
+	v.UID = uid
 	v.Version = version
 	v.BearerToken = bearerToken
 	v.XSync = xSync
@@ -245,22 +156,30 @@ func BuildCreateProjectPayload(projectServiceCreateProjectBody string, projectSe
 	return v, nil
 }
 
-// BuildGetOneProjectBasePayload builds the payload for the project-service
-// get-one-project-base endpoint from CLI flags.
-func BuildGetOneProjectBasePayload(projectServiceGetOneProjectBaseUID string, projectServiceGetOneProjectBaseVersion string, projectServiceGetOneProjectBaseBearerToken string) (*projectservice.GetOneProjectBasePayload, error) {
+// BuildMergeProjectPayload builds the payload for the project-service
+// merge-project endpoint from CLI flags.
+func BuildMergeProjectPayload(projectServiceMergeProjectUID string, projectServiceMergeProjectTargetUID string, projectServiceMergeProjectVersion string, projectServiceMergeProjectBearerToken string, projectServiceMergeProjectXSync string, projectServiceMergeProjectIfMatch string, projectServiceMergeProjectForceUpdate string) (*projectservice.MergeProjectPayload, error) {
 	var err error
 	var uid string
 	{
-		uid = projectServiceGetOneProjectBaseUID
+		uid = projectServiceMergeProjectUID
 		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
 		if err != nil {
 			return nil, err
 		}
 	}
+	var targetUID string
+	{
+		targetUID = projectServiceMergeProjectTargetUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("target_uid", targetUID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
 	var version *string
 	{
-		if projectServiceGetOneProjectBaseVersion != "" {
-			version = &projectServiceGetOneProjectBaseVersion
+		if projectServiceMergeProjectVersion != "" {
+			version = &projectServiceMergeProjectVersion
 			if !(*version == "1") {
 				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
 			}
@@ -271,25 +190,88 @@ func BuildGetOneProjectBasePayload(projectServiceGetOneProjectBaseUID string, pr
 	}
 	var bearerToken *string
 	{
-		if projectServiceGetOneProjectBaseBearerToken != "" {
-			bearerToken = &projectServiceGetOneProjectBaseBearerToken
+		if projectServiceMergeProjectBearerToken != "" {
+			bearerToken = &projectServiceMergeProjectBearerToken
 		}
 	}
-	v := &projectservice.GetOneProjectBasePayload{}
-	v.UID = &uid
+	var xSync *bool
+	{
+		if projectServiceMergeProjectXSync != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceMergeProjectXSync)
+			xSync = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	var ifMatch *string
+	{
+		if projectServiceMergeProjectIfMatch != "" {
+			ifMatch = &projectServiceMergeProjectIfMatch
+		}
+	}
+	var forceUpdate *bool
+	{
+		if projectServiceMergeProjectForceUpdate != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceMergeProjectForceUpdate)
+			forceUpdate = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for forceUpdate, must be BOOL")
+			}
+		}
+	}
+	v := &projectservice.MergeProjectPayload{}
+	v.UID = uid
+	v.TargetUID = targetUID
 	v.Version = version
 	v.BearerToken = bearerToken
+	v.XSync = xSync
+	v.IfMatch = ifMatch
+	v.ForceUpdate = forceUpdate
 
 	return v, nil
 }
 
-// BuildGetOneProjectSettingsPayload builds the payload for the project-service
-// get-one-project-settings endpoint from CLI flags.
-func BuildGetOneProjectSettingsPayload(projectServiceGetOneProjectSettingsUID string, projectServiceGetOneProjectSettingsVersion string, projectServiceGetOneProjectSettingsBearerToken string) (*projectservice.GetOneProjectSettingsPayload, error) {
+// BuildTransferProjectPayload builds the payload for the project-service
+// transfer-project endpoint from CLI flags.
+func BuildTransferProjectPayload(projectServiceTransferProjectBody string, projectServiceTransferProjectUID string, projectServiceTransferProjectVersion string, projectServiceTransferProjectBearerToken string, projectServiceTransferProjectXSync string, projectServiceTransferProjectIfMatch string, projectServiceTransferProjectForceUpdate string) (*projectservice.TransferProjectPayload, error) {
 	var err error
+	var body TransferProjectRequestBody
+	{
+		err = json.Unmarshal([]byte(projectServiceTransferProjectBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"parent_uid\": \"4b1f3e9c-2d7a-4c1b-9e8f-0a1b2c3d4e5f\",\n      \"reason\": \"Project moved to the CNCF\",\n      \"writers\": [\n         {\n            \"email\": \"alice.johnson@example.com\",\n            \"name\": \"Alice Johnson\",\n            \"username\": \"alicejohnson789\"\n         }\n      ]\n   }'")
+		}
+		if body.Writers == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("writers", "body"))
+		}
+		if len(body.Writers) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.writers", body.Writers, len(body.Writers), 1, true))
+		}
+		for _, e := range body.Writers {
+			if e != nil {
+				if err2 := ValidateUserInfoRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if body.ParentUID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.parent_uid", *body.ParentUID, goa.FormatUUID))
+		}
+		if body.Reason != nil {
+			if utf8.RuneCountInString(*body.Reason) > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.reason", *body.Reason, utf8.RuneCountInString(*body.Reason), 1000, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
 	var uid string
 	{
-		uid = projectServiceGetOneProjectSettingsUID
+		uid = projectServiceTransferProjectUID
 		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
 		if err != nil {
 			return nil, err
@@ -297,8 +279,8 @@ func BuildGetOneProjectSettingsPayload(projectServiceGetOneProjectSettingsUID st
 	}
 	var version *string
 	{
-		if projectServiceGetOneProjectSettingsVersion != "" {
-			version = &projectServiceGetOneProjectSettingsVersion
+		if projectServiceTransferProjectVersion != "" {
+			version = &projectServiceTransferProjectVersion
 			if !(*version == "1") {
 				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
 			}
@@ -309,78 +291,76 @@ func BuildGetOneProjectSettingsPayload(projectServiceGetOneProjectSettingsUID st
 	}
 	var bearerToken *string
 	{
-		if projectServiceGetOneProjectSettingsBearerToken != "" {
-			bearerToken = &projectServiceGetOneProjectSettingsBearerToken
+		if projectServiceTransferProjectBearerToken != "" {
+			bearerToken = &projectServiceTransferProjectBearerToken
 		}
 	}
-	v := &projectservice.GetOneProjectSettingsPayload{}
-	v.UID = &uid
+	var xSync *bool
+	{
+		if projectServiceTransferProjectXSync != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceTransferProjectXSync)
+			xSync = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	var ifMatch *string
+	{
+		if projectServiceTransferProjectIfMatch != "" {
+			ifMatch = &projectServiceTransferProjectIfMatch
+		}
+	}
+	var forceUpdate *bool
+	{
+		if projectServiceTransferProjectForceUpdate != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceTransferProjectForceUpdate)
+			forceUpdate = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for forceUpdate, must be BOOL")
+			}
+		}
+	}
+	v := &projectservice.TransferProjectPayload{
+		ParentUID: body.ParentUID,
+		Reason:    body.Reason,
+	}
+	if body.Writers != nil {
+		v.Writers = make([]*projectservice.UserInfo, len(body.Writers))
+		for i, val := range body.Writers {
+			v.Writers[i] = marshalUserInfoRequestBodyToProjectserviceUserInfo(val)
+		}
+	} else {
+		v.Writers = []*projectservice.UserInfo{}
+	}
+	v.UID = uid
 	v.Version = version
 	v.BearerToken = bearerToken
+	v.XSync = xSync
+	v.IfMatch = ifMatch
+	v.ForceUpdate = forceUpdate
 
 	return v, nil
 }
 
-// BuildUpdateProjectBasePayload builds the payload for the project-service
-// update-project-base endpoint from CLI flags.
-func BuildUpdateProjectBasePayload(projectServiceUpdateProjectBaseBody string, projectServiceUpdateProjectBaseUID string, projectServiceUpdateProjectBaseVersion string, projectServiceUpdateProjectBaseBearerToken string, projectServiceUpdateProjectBaseXSync string, projectServiceUpdateProjectBaseIfMatch string) (*projectservice.UpdateProjectBasePayload, error) {
+// BuildCreateProjectLinkPayload builds the payload for the project-service
+// create-project-link endpoint from CLI flags.
+func BuildCreateProjectLinkPayload(projectServiceCreateProjectLinkBody string, projectServiceCreateProjectLinkUID string, projectServiceCreateProjectLinkVersion string, projectServiceCreateProjectLinkBearerToken string, projectServiceCreateProjectLinkXSync string) (*projectservice.CreateProjectLinkPayload, error) {
 	var err error
-	var body UpdateProjectBaseRequestBody
+	var body CreateProjectLinkRequestBody
 	{
-		err = json.Unmarshal([]byte(projectServiceUpdateProjectBaseBody), &body)
+		err = json.Unmarshal([]byte(projectServiceCreateProjectLinkBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"autojoin_enabled\": false,\n      \"category\": \"Active\",\n      \"charter_url\": \"https://example.com/charter.pdf\",\n      \"description\": \"project foo is a project about bar\",\n      \"entity_dissolution_date\": \"2021-12-31\",\n      \"entity_formation_document_url\": \"https://example.com/formation.pdf\",\n      \"formation_date\": \"2021-01-01\",\n      \"funding\": \"Funded\",\n      \"funding_model\": [\n         \"Crowdfunding\"\n      ],\n      \"is_foundation\": false,\n      \"legal_entity_name\": \"Example Foundation LLC\",\n      \"legal_entity_type\": \"Subproject\",\n      \"legal_parent_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"logo_url\": \"https://example.com/logo.png\",\n      \"name\": \"Foo Foundation\",\n      \"parent_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"public\": true,\n      \"repository_url\": \"https://example.com/project\",\n      \"slug\": \"project-slug\",\n      \"stage\": \"Formation - Exploratory\",\n      \"website_url\": \"https://example.com\"\n   }'")
-		}
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.slug", body.Slug, goa.FormatRegexp))
-		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", body.Slug, "^[a-z][a-z0-9_\\-]*[a-z0-9]$"))
-		if body.Stage != nil {
-			if !(*body.Stage == "Formation - Exploratory" || *body.Stage == "Formation - Engaged" || *body.Stage == "Active" || *body.Stage == "Archived" || *body.Stage == "Formation - On Hold" || *body.Stage == "Formation - Disengaged" || *body.Stage == "Formation - Confidential" || *body.Stage == "Prospect") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.stage", *body.Stage, []any{"Formation - Exploratory", "Formation - Engaged", "Active", "Archived", "Formation - On Hold", "Formation - Disengaged", "Formation - Confidential", "Prospect"}))
-			}
-		}
-		if body.Category != nil {
-			if !(*body.Category == "Active" || *body.Category == "Adopted" || *body.Category == "Archived" || *body.Category == "At-Large" || *body.Category == "Early Adoption" || *body.Category == "Emeritus" || *body.Category == "Graduated" || *body.Category == "Growth" || *body.Category == "Idle" || *body.Category == "Impact" || *body.Category == "Incubating" || *body.Category == "Kanister" || *body.Category == "Mature" || *body.Category == "Pre-LFESS" || *body.Category == "Sandbox" || *body.Category == "SIG" || *body.Category == "Standards" || *body.Category == "TAC" || *body.Category == "Working Group" || *body.Category == "TAG" || *body.Category == "NONE") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.category", *body.Category, []any{"Active", "Adopted", "Archived", "At-Large", "Early Adoption", "Emeritus", "Graduated", "Growth", "Idle", "Impact", "Incubating", "Kanister", "Mature", "Pre-LFESS", "Sandbox", "SIG", "Standards", "TAC", "Working Group", "TAG", "NONE"}))
-			}
-		}
-		if body.Funding != nil {
-			if !(*body.Funding == "Funded" || *body.Funding == "Unfunded" || *body.Funding == "Supported by Parent Project") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.funding", *body.Funding, []any{"Funded", "Unfunded", "Supported by Parent Project"}))
-			}
-		}
-		for _, e := range body.FundingModel {
-			if !(e == "Crowdfunding" || e == "Membership" || e == "Alternate Funding") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.funding_model[*]", e, []any{"Crowdfunding", "Membership", "Alternate Funding"}))
-			}
-		}
-		if body.CharterURL != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.charter_url", *body.CharterURL, goa.FormatURI))
-		}
-		if body.LegalEntityType != nil {
-			if !(*body.LegalEntityType == "Subproject" || *body.LegalEntityType == "Incorporated Entity" || *body.LegalEntityType == "Series LLC" || *body.LegalEntityType == "Unofficial Subproject" || *body.LegalEntityType == "Internal Allocation" || *body.LegalEntityType == "None") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.legal_entity_type", *body.LegalEntityType, []any{"Subproject", "Incorporated Entity", "Series LLC", "Unofficial Subproject", "Internal Allocation", "None"}))
-			}
-		}
-		if body.LegalParentUID != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.legal_parent_uid", *body.LegalParentUID, goa.FormatUUID))
-		}
-		if body.EntityDissolutionDate != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.entity_dissolution_date", *body.EntityDissolutionDate, goa.FormatDate))
-		}
-		if body.EntityFormationDocumentURL != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.entity_formation_document_url", *body.EntityFormationDocumentURL, goa.FormatURI))
-		}
-		if body.FormationDate != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.formation_date", *body.FormationDate, goa.FormatDate))
-		}
-		if body.LogoURL != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.logo_url", *body.LogoURL, goa.FormatURI))
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"description\": \"A description of the resource\",\n      \"folder_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"name\": \"My Resource\",\n      \"url\": \"https://example.com\"\n   }'")
 		}
-		if body.RepositoryURL != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.repository_url", *body.RepositoryURL, goa.FormatURI))
+		if utf8.RuneCountInString(body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
 		}
-		if body.WebsiteURL != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.website_url", *body.WebsiteURL, goa.FormatURI))
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.url", body.URL, goa.FormatURI))
+		if body.FolderUID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.folder_uid", *body.FolderUID, goa.FormatUUID))
 		}
 		if err != nil {
 			return nil, err
@@ -388,7 +368,7 @@ func BuildUpdateProjectBasePayload(projectServiceUpdateProjectBaseBody string, p
 	}
 	var uid string
 	{
-		uid = projectServiceUpdateProjectBaseUID
+		uid = projectServiceCreateProjectLinkUID
 		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
 		if err != nil {
 			return nil, err
@@ -396,8 +376,8 @@ func BuildUpdateProjectBasePayload(projectServiceUpdateProjectBaseBody string, p
 	}
 	var version *string
 	{
-		if projectServiceUpdateProjectBaseVersion != "" {
-			version = &projectServiceUpdateProjectBaseVersion
+		if projectServiceCreateProjectLinkVersion != "" {
+			version = &projectServiceCreateProjectLinkVersion
 			if !(*version == "1") {
 				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
 			}
@@ -408,87 +388,106 @@ func BuildUpdateProjectBasePayload(projectServiceUpdateProjectBaseBody string, p
 	}
 	var bearerToken *string
 	{
-		if projectServiceUpdateProjectBaseBearerToken != "" {
-			bearerToken = &projectServiceUpdateProjectBaseBearerToken
+		if projectServiceCreateProjectLinkBearerToken != "" {
+			bearerToken = &projectServiceCreateProjectLinkBearerToken
 		}
 	}
 	var xSync *bool
 	{
-		if projectServiceUpdateProjectBaseXSync != "" {
+		if projectServiceCreateProjectLinkXSync != "" {
 			var val bool
-			val, err = strconv.ParseBool(projectServiceUpdateProjectBaseXSync)
+			val, err = strconv.ParseBool(projectServiceCreateProjectLinkXSync)
 			xSync = &val
 			if err != nil {
 				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
 			}
 		}
 	}
-	var ifMatch *string
+	v := &projectservice.CreateProjectLinkPayload{
+		Name:        body.Name,
+		URL:         body.URL,
+		Description: body.Description,
+		FolderUID:   body.FolderUID,
+	}
+	v.UID = uid
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+
+	return v, nil
+}
+
+// BuildGetProjectLinkPayload builds the payload for the project-service
+// get-project-link endpoint from CLI flags.
+func BuildGetProjectLinkPayload(projectServiceGetProjectLinkUID string, projectServiceGetProjectLinkLinkUID string, projectServiceGetProjectLinkVersion string, projectServiceGetProjectLinkBearerToken string) (*projectservice.GetProjectLinkPayload, error) {
+	var err error
+	var uid string
 	{
-		if projectServiceUpdateProjectBaseIfMatch != "" {
-			ifMatch = &projectServiceUpdateProjectBaseIfMatch
+		uid = projectServiceGetProjectLinkUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
 		}
 	}
-	v := &projectservice.UpdateProjectBasePayload{
-		Slug:                       body.Slug,
-		Description:                body.Description,
-		Name:                       body.Name,
-		Public:                     body.Public,
-		IsFoundation:               body.IsFoundation,
-		ParentUID:                  body.ParentUID,
-		Stage:                      body.Stage,
-		Category:                   body.Category,
-		Funding:                    body.Funding,
-		CharterURL:                 body.CharterURL,
-		LegalEntityType:            body.LegalEntityType,
-		LegalEntityName:            body.LegalEntityName,
-		LegalParentUID:             body.LegalParentUID,
-		EntityDissolutionDate:      body.EntityDissolutionDate,
-		EntityFormationDocumentURL: body.EntityFormationDocumentURL,
-		AutojoinEnabled:            body.AutojoinEnabled,
-		FormationDate:              body.FormationDate,
-		LogoURL:                    body.LogoURL,
-		RepositoryURL:              body.RepositoryURL,
-		WebsiteURL:                 body.WebsiteURL,
+	var linkUID string
+	{
+		linkUID = projectServiceGetProjectLinkLinkUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("link_uid", linkUID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
 	}
-	if body.FundingModel != nil {
-		v.FundingModel = make([]string, len(body.FundingModel))
-		for i, val := range body.FundingModel {
-			v.FundingModel[i] = val
+	var version *string
+	{
+		if projectServiceGetProjectLinkVersion != "" {
+			version = &projectServiceGetProjectLinkVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
-	v.UID = &uid
+	var bearerToken *string
+	{
+		if projectServiceGetProjectLinkBearerToken != "" {
+			bearerToken = &projectServiceGetProjectLinkBearerToken
+		}
+	}
+	v := &projectservice.GetProjectLinkPayload{}
+	v.UID = uid
+	v.LinkUID = linkUID
 	v.Version = version
 	v.BearerToken = bearerToken
-	v.XSync = xSync
-	v.IfMatch = ifMatch
 
 	return v, nil
 }
 
-// BuildUpdateProjectSettingsPayload builds the payload for the project-service
-// update-project-settings endpoint from CLI flags.
-func BuildUpdateProjectSettingsPayload(projectServiceUpdateProjectSettingsBody string, projectServiceUpdateProjectSettingsUID string, projectServiceUpdateProjectSettingsVersion string, projectServiceUpdateProjectSettingsBearerToken string, projectServiceUpdateProjectSettingsXSync string, projectServiceUpdateProjectSettingsIfMatch string) (*projectservice.UpdateProjectSettingsPayload, error) {
+// BuildDeleteProjectLinkPayload builds the payload for the project-service
+// delete-project-link endpoint from CLI flags.
+func BuildDeleteProjectLinkPayload(projectServiceDeleteProjectLinkUID string, projectServiceDeleteProjectLinkLinkUID string, projectServiceDeleteProjectLinkVersion string, projectServiceDeleteProjectLinkBearerToken string, projectServiceDeleteProjectLinkXSync string, projectServiceDeleteProjectLinkIfMatch string, projectServiceDeleteProjectLinkForceUpdate string) (*projectservice.DeleteProjectLinkPayload, error) {
 	var err error
-	var body UpdateProjectSettingsRequestBody
+	var uid string
 	{
-		err = json.Unmarshal([]byte(projectServiceUpdateProjectSettingsBody), &body)
+		uid = projectServiceDeleteProjectLinkUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"announcement_date\": \"2021-01-01\",\n      \"auditors\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"executive_director\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"meeting_coordinators\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"mission_statement\": \"The mission of the project is to build a sustainable ecosystem around open source projects to accelerate technology development and industry adoption.\",\n      \"opportunity_owner\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"program_manager\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"writers\": [\n         {\n            \"avatar\": \"https://example.com/avatar3.jpg\",\n            \"email\": \"alice.johnson@example.com\",\n            \"name\": \"Alice Johnson\",\n            \"username\": \"alicejohnson789\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar4.jpg\",\n            \"email\": \"bob.wilson@example.com\",\n            \"name\": \"Bob Wilson\",\n            \"username\": \"bobwilson101\"\n         }\n      ]\n   }'")
+			return nil, err
 		}
 	}
-	var uid string
+	var linkUID string
 	{
-		uid = projectServiceUpdateProjectSettingsUID
-		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		linkUID = projectServiceDeleteProjectLinkLinkUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("link_uid", linkUID, goa.FormatUUID))
 		if err != nil {
 			return nil, err
 		}
 	}
 	var version *string
 	{
-		if projectServiceUpdateProjectSettingsVersion != "" {
-			version = &projectServiceUpdateProjectSettingsVersion
+		if projectServiceDeleteProjectLinkVersion != "" {
+			version = &projectServiceDeleteProjectLinkVersion
 			if !(*version == "1") {
 				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
 			}
@@ -499,15 +498,15 @@ func BuildUpdateProjectSettingsPayload(projectServiceUpdateProjectSettingsBody s
 	}
 	var bearerToken *string
 	{
-		if projectServiceUpdateProjectSettingsBearerToken != "" {
-			bearerToken = &projectServiceUpdateProjectSettingsBearerToken
+		if projectServiceDeleteProjectLinkBearerToken != "" {
+			bearerToken = &projectServiceDeleteProjectLinkBearerToken
 		}
 	}
 	var xSync *bool
 	{
-		if projectServiceUpdateProjectSettingsXSync != "" {
+		if projectServiceDeleteProjectLinkXSync != "" {
 			var val bool
-			val, err = strconv.ParseBool(projectServiceUpdateProjectSettingsXSync)
+			val, err = strconv.ParseBool(projectServiceDeleteProjectLinkXSync)
 			xSync = &val
 			if err != nil {
 				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
@@ -516,66 +515,2825 @@ func BuildUpdateProjectSettingsPayload(projectServiceUpdateProjectSettingsBody s
 	}
 	var ifMatch *string
 	{
-		if projectServiceUpdateProjectSettingsIfMatch != "" {
-			ifMatch = &projectServiceUpdateProjectSettingsIfMatch
+		if projectServiceDeleteProjectLinkIfMatch != "" {
+			ifMatch = &projectServiceDeleteProjectLinkIfMatch
 		}
 	}
-	v := &projectservice.UpdateProjectSettingsPayload{
-		MissionStatement: body.MissionStatement,
-		AnnouncementDate: body.AnnouncementDate,
-	}
-	if body.Writers != nil {
-		v.Writers = make([]*projectservice.UserInfo, len(body.Writers))
-		for i, val := range body.Writers {
-			v.Writers[i] = marshalUserInfoRequestBodyToProjectserviceUserInfo(val)
+	var forceUpdate *bool
+	{
+		if projectServiceDeleteProjectLinkForceUpdate != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceDeleteProjectLinkForceUpdate)
+			forceUpdate = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for forceUpdate, must be BOOL")
+			}
 		}
 	}
-	if body.MeetingCoordinators != nil {
-		v.MeetingCoordinators = make([]*projectservice.UserInfo, len(body.MeetingCoordinators))
-		for i, val := range body.MeetingCoordinators {
-			v.MeetingCoordinators[i] = marshalUserInfoRequestBodyToProjectserviceUserInfo(val)
+	v := &projectservice.DeleteProjectLinkPayload{}
+	v.UID = uid
+	v.LinkUID = linkUID
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+	v.IfMatch = ifMatch
+	v.ForceUpdate = forceUpdate
+
+	return v, nil
+}
+
+// BuildCreateProjectFolderPayload builds the payload for the project-service
+// create-project-folder endpoint from CLI flags.
+func BuildCreateProjectFolderPayload(projectServiceCreateProjectFolderBody string, projectServiceCreateProjectFolderUID string, projectServiceCreateProjectFolderVersion string, projectServiceCreateProjectFolderBearerToken string, projectServiceCreateProjectFolderXSync string) (*projectservice.CreateProjectFolderPayload, error) {
+	var err error
+	var body CreateProjectFolderRequestBody
+	{
+		err = json.Unmarshal([]byte(projectServiceCreateProjectFolderBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"name\": \"My Resource\"\n   }'")
 		}
-	}
-	if body.Auditors != nil {
-		v.Auditors = make([]*projectservice.UserInfo, len(body.Auditors))
+		if utf8.RuneCountInString(body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var uid string
+	{
+		uid = projectServiceCreateProjectFolderUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceCreateProjectFolderVersion != "" {
+			version = &projectServiceCreateProjectFolderVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceCreateProjectFolderBearerToken != "" {
+			bearerToken = &projectServiceCreateProjectFolderBearerToken
+		}
+	}
+	var xSync *bool
+	{
+		if projectServiceCreateProjectFolderXSync != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceCreateProjectFolderXSync)
+			xSync = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	v := &projectservice.CreateProjectFolderPayload{
+		Name: body.Name,
+	}
+	v.UID = uid
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+
+	return v, nil
+}
+
+// BuildGetProjectFolderPayload builds the payload for the project-service
+// get-project-folder endpoint from CLI flags.
+func BuildGetProjectFolderPayload(projectServiceGetProjectFolderUID string, projectServiceGetProjectFolderFolderUID string, projectServiceGetProjectFolderVersion string, projectServiceGetProjectFolderBearerToken string) (*projectservice.GetProjectFolderPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceGetProjectFolderUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var folderUID string
+	{
+		folderUID = projectServiceGetProjectFolderFolderUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("folder_uid", folderUID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceGetProjectFolderVersion != "" {
+			version = &projectServiceGetProjectFolderVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceGetProjectFolderBearerToken != "" {
+			bearerToken = &projectServiceGetProjectFolderBearerToken
+		}
+	}
+	v := &projectservice.GetProjectFolderPayload{}
+	v.UID = uid
+	v.FolderUID = folderUID
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildDeleteProjectFolderPayload builds the payload for the project-service
+// delete-project-folder endpoint from CLI flags.
+func BuildDeleteProjectFolderPayload(projectServiceDeleteProjectFolderUID string, projectServiceDeleteProjectFolderFolderUID string, projectServiceDeleteProjectFolderVersion string, projectServiceDeleteProjectFolderBearerToken string, projectServiceDeleteProjectFolderXSync string, projectServiceDeleteProjectFolderIfMatch string, projectServiceDeleteProjectFolderForceUpdate string) (*projectservice.DeleteProjectFolderPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceDeleteProjectFolderUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var folderUID string
+	{
+		folderUID = projectServiceDeleteProjectFolderFolderUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("folder_uid", folderUID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceDeleteProjectFolderVersion != "" {
+			version = &projectServiceDeleteProjectFolderVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceDeleteProjectFolderBearerToken != "" {
+			bearerToken = &projectServiceDeleteProjectFolderBearerToken
+		}
+	}
+	var xSync *bool
+	{
+		if projectServiceDeleteProjectFolderXSync != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceDeleteProjectFolderXSync)
+			xSync = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	var ifMatch *string
+	{
+		if projectServiceDeleteProjectFolderIfMatch != "" {
+			ifMatch = &projectServiceDeleteProjectFolderIfMatch
+		}
+	}
+	var forceUpdate *bool
+	{
+		if projectServiceDeleteProjectFolderForceUpdate != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceDeleteProjectFolderForceUpdate)
+			forceUpdate = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for forceUpdate, must be BOOL")
+			}
+		}
+	}
+	v := &projectservice.DeleteProjectFolderPayload{}
+	v.UID = uid
+	v.FolderUID = folderUID
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+	v.IfMatch = ifMatch
+	v.ForceUpdate = forceUpdate
+
+	return v, nil
+}
+
+// BuildUploadProjectDocumentPayload builds the payload for the project-service
+// upload-project-document endpoint from CLI flags.
+func BuildUploadProjectDocumentPayload(projectServiceUploadProjectDocumentBody string, projectServiceUploadProjectDocumentUID string, projectServiceUploadProjectDocumentVersion string, projectServiceUploadProjectDocumentBearerToken string, projectServiceUploadProjectDocumentXSync string) (*projectservice.UploadProjectDocumentPayload, error) {
+	var err error
+	var body UploadProjectDocumentRequestBody
+	{
+		err = json.Unmarshal([]byte(projectServiceUploadProjectDocumentBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"content_type\": \"application/pdf\",\n      \"description\": \"A description of the resource\",\n      \"file\": \"Li4u\",\n      \"file_name\": \"report.pdf\",\n      \"folder_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"name\": \"My Resource\"\n   }'")
+		}
+		if body.File == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("file", "body"))
+		}
+		if utf8.RuneCountInString(body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+		}
+		if body.FolderUID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.folder_uid", *body.FolderUID, goa.FormatUUID))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var uid string
+	{
+		uid = projectServiceUploadProjectDocumentUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceUploadProjectDocumentVersion != "" {
+			version = &projectServiceUploadProjectDocumentVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceUploadProjectDocumentBearerToken != "" {
+			bearerToken = &projectServiceUploadProjectDocumentBearerToken
+		}
+	}
+	var xSync *bool
+	{
+		if projectServiceUploadProjectDocumentXSync != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceUploadProjectDocumentXSync)
+			xSync = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	v := &projectservice.UploadProjectDocumentPayload{
+		Name:        body.Name,
+		Description: body.Description,
+		FolderUID:   body.FolderUID,
+		File:        body.File,
+		FileName:    body.FileName,
+		ContentType: body.ContentType,
+	}
+	v.UID = uid
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+
+	return v, nil
+}
+
+// BuildGetProjectDocumentPayload builds the payload for the project-service
+// get-project-document endpoint from CLI flags.
+func BuildGetProjectDocumentPayload(projectServiceGetProjectDocumentUID string, projectServiceGetProjectDocumentDocumentUID string, projectServiceGetProjectDocumentVersion string, projectServiceGetProjectDocumentBearerToken string) (*projectservice.GetProjectDocumentPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceGetProjectDocumentUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var documentUID string
+	{
+		documentUID = projectServiceGetProjectDocumentDocumentUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("document_uid", documentUID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceGetProjectDocumentVersion != "" {
+			version = &projectServiceGetProjectDocumentVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceGetProjectDocumentBearerToken != "" {
+			bearerToken = &projectServiceGetProjectDocumentBearerToken
+		}
+	}
+	v := &projectservice.GetProjectDocumentPayload{}
+	v.UID = uid
+	v.DocumentUID = documentUID
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildDownloadProjectDocumentPayload builds the payload for the
+// project-service download-project-document endpoint from CLI flags.
+func BuildDownloadProjectDocumentPayload(projectServiceDownloadProjectDocumentUID string, projectServiceDownloadProjectDocumentDocumentUID string, projectServiceDownloadProjectDocumentVersion string, projectServiceDownloadProjectDocumentBearerToken string) (*projectservice.DownloadProjectDocumentPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceDownloadProjectDocumentUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var documentUID string
+	{
+		documentUID = projectServiceDownloadProjectDocumentDocumentUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("document_uid", documentUID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceDownloadProjectDocumentVersion != "" {
+			version = &projectServiceDownloadProjectDocumentVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceDownloadProjectDocumentBearerToken != "" {
+			bearerToken = &projectServiceDownloadProjectDocumentBearerToken
+		}
+	}
+	v := &projectservice.DownloadProjectDocumentPayload{}
+	v.UID = uid
+	v.DocumentUID = documentUID
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildDeleteProjectDocumentPayload builds the payload for the project-service
+// delete-project-document endpoint from CLI flags.
+func BuildDeleteProjectDocumentPayload(projectServiceDeleteProjectDocumentUID string, projectServiceDeleteProjectDocumentDocumentUID string, projectServiceDeleteProjectDocumentVersion string, projectServiceDeleteProjectDocumentBearerToken string, projectServiceDeleteProjectDocumentXSync string, projectServiceDeleteProjectDocumentIfMatch string, projectServiceDeleteProjectDocumentForceUpdate string) (*projectservice.DeleteProjectDocumentPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceDeleteProjectDocumentUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var documentUID string
+	{
+		documentUID = projectServiceDeleteProjectDocumentDocumentUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("document_uid", documentUID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceDeleteProjectDocumentVersion != "" {
+			version = &projectServiceDeleteProjectDocumentVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceDeleteProjectDocumentBearerToken != "" {
+			bearerToken = &projectServiceDeleteProjectDocumentBearerToken
+		}
+	}
+	var xSync *bool
+	{
+		if projectServiceDeleteProjectDocumentXSync != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceDeleteProjectDocumentXSync)
+			xSync = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	var ifMatch *string
+	{
+		if projectServiceDeleteProjectDocumentIfMatch != "" {
+			ifMatch = &projectServiceDeleteProjectDocumentIfMatch
+		}
+	}
+	var forceUpdate *bool
+	{
+		if projectServiceDeleteProjectDocumentForceUpdate != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceDeleteProjectDocumentForceUpdate)
+			forceUpdate = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for forceUpdate, must be BOOL")
+			}
+		}
+	}
+	v := &projectservice.DeleteProjectDocumentPayload{}
+	v.UID = uid
+	v.DocumentUID = documentUID
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+	v.IfMatch = ifMatch
+	v.ForceUpdate = forceUpdate
+
+	return v, nil
+}
+
+// BuildUploadProjectCharterPayload builds the payload for the project-service
+// upload-project-charter endpoint from CLI flags.
+func BuildUploadProjectCharterPayload(projectServiceUploadProjectCharterBody string, projectServiceUploadProjectCharterUID string, projectServiceUploadProjectCharterVersion string, projectServiceUploadProjectCharterBearerToken string, projectServiceUploadProjectCharterXSync string) (*projectservice.UploadProjectCharterPayload, error) {
+	var err error
+	var body UploadProjectCharterRequestBody
+	{
+		err = json.Unmarshal([]byte(projectServiceUploadProjectCharterBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"file\": \"Li4u\",\n      \"file_name\": \"charter.pdf\"\n   }'")
+		}
+		if body.File == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("file", "body"))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var uid string
+	{
+		uid = projectServiceUploadProjectCharterUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceUploadProjectCharterVersion != "" {
+			version = &projectServiceUploadProjectCharterVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceUploadProjectCharterBearerToken != "" {
+			bearerToken = &projectServiceUploadProjectCharterBearerToken
+		}
+	}
+	var xSync *bool
+	{
+		if projectServiceUploadProjectCharterXSync != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceUploadProjectCharterXSync)
+			xSync = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	v := &projectservice.UploadProjectCharterPayload{
+		File:     body.File,
+		FileName: body.FileName,
+	}
+	v.UID = uid
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+
+	return v, nil
+}
+
+// BuildGetProjectCharterPayload builds the payload for the project-service
+// get-project-charter endpoint from CLI flags.
+func BuildGetProjectCharterPayload(projectServiceGetProjectCharterUID string, projectServiceGetProjectCharterVersion string, projectServiceGetProjectCharterBearerToken string) (*projectservice.GetProjectCharterPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceGetProjectCharterUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceGetProjectCharterVersion != "" {
+			version = &projectServiceGetProjectCharterVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceGetProjectCharterBearerToken != "" {
+			bearerToken = &projectServiceGetProjectCharterBearerToken
+		}
+	}
+	v := &projectservice.GetProjectCharterPayload{}
+	v.UID = uid
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildGetProjectAssociationsPayload builds the payload for the
+// project-service get-project-associations endpoint from CLI flags.
+func BuildGetProjectAssociationsPayload(projectServiceGetProjectAssociationsUID string, projectServiceGetProjectAssociationsVersion string, projectServiceGetProjectAssociationsBearerToken string) (*projectservice.GetProjectAssociationsPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceGetProjectAssociationsUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceGetProjectAssociationsVersion != "" {
+			version = &projectServiceGetProjectAssociationsVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceGetProjectAssociationsBearerToken != "" {
+			bearerToken = &projectServiceGetProjectAssociationsBearerToken
+		}
+	}
+	v := &projectservice.GetProjectAssociationsPayload{}
+	v.UID = uid
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildUpdateProjectAssociationsPayload builds the payload for the
+// project-service update-project-associations endpoint from CLI flags.
+func BuildUpdateProjectAssociationsPayload(projectServiceUpdateProjectAssociationsBody string, projectServiceUpdateProjectAssociationsUID string, projectServiceUpdateProjectAssociationsVersion string, projectServiceUpdateProjectAssociationsBearerToken string) (*projectservice.UpdateProjectAssociationsPayload, error) {
+	var err error
+	var body UpdateProjectAssociationsRequestBody
+	{
+		err = json.Unmarshal([]byte(projectServiceUpdateProjectAssociationsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"committee_uids\": [\n         \"061a110a-7c38-4cd3-bfcf-fc8511a37f35\"\n      ],\n      \"mailing_list_ids\": [\n         \"119563\"\n      ],\n      \"meeting_series_ids\": [\n         \"8f4e2d1a-9b3c-4a5e-8d7f-6c1b2a3e4f5d\"\n      ]\n   }'")
+		}
+		if len(body.CommitteeUids) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.committee_uids", body.CommitteeUids, len(body.CommitteeUids), 100, false))
+		}
+		if len(body.MailingListIds) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.mailing_list_ids", body.MailingListIds, len(body.MailingListIds), 100, false))
+		}
+		if len(body.MeetingSeriesIds) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.meeting_series_ids", body.MeetingSeriesIds, len(body.MeetingSeriesIds), 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var uid string
+	{
+		uid = projectServiceUpdateProjectAssociationsUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceUpdateProjectAssociationsVersion != "" {
+			version = &projectServiceUpdateProjectAssociationsVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceUpdateProjectAssociationsBearerToken != "" {
+			bearerToken = &projectServiceUpdateProjectAssociationsBearerToken
+		}
+	}
+	v := &projectservice.UpdateProjectAssociationsPayload{}
+	if body.CommitteeUids != nil {
+		v.CommitteeUids = make([]string, len(body.CommitteeUids))
+		for i, val := range body.CommitteeUids {
+			v.CommitteeUids[i] = val
+		}
+	}
+	if body.MailingListIds != nil {
+		v.MailingListIds = make([]string, len(body.MailingListIds))
+		for i, val := range body.MailingListIds {
+			v.MailingListIds[i] = val
+		}
+	}
+	if body.MeetingSeriesIds != nil {
+		v.MeetingSeriesIds = make([]string, len(body.MeetingSeriesIds))
+		for i, val := range body.MeetingSeriesIds {
+			v.MeetingSeriesIds[i] = val
+		}
+	}
+	v.UID = uid
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildGetProjectSettingsDiffPayload builds the payload for the
+// project-service get-project-settings-diff endpoint from CLI flags.
+func BuildGetProjectSettingsDiffPayload(projectServiceGetProjectSettingsDiffUID string, projectServiceGetProjectSettingsDiffVersion string, projectServiceGetProjectSettingsDiffFrom string, projectServiceGetProjectSettingsDiffTo string, projectServiceGetProjectSettingsDiffBearerToken string) (*projectservice.GetProjectSettingsDiffPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceGetProjectSettingsDiffUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceGetProjectSettingsDiffVersion != "" {
+			version = &projectServiceGetProjectSettingsDiffVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var from uint64
+	{
+		from, err = strconv.ParseUint(projectServiceGetProjectSettingsDiffFrom, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for from, must be UINT64")
+		}
+		if from < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("from", from, 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var to uint64
+	{
+		to, err = strconv.ParseUint(projectServiceGetProjectSettingsDiffTo, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for to, must be UINT64")
+		}
+		if to < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("to", to, 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceGetProjectSettingsDiffBearerToken != "" {
+			bearerToken = &projectServiceGetProjectSettingsDiffBearerToken
+		}
+	}
+	v := &projectservice.GetProjectSettingsDiffPayload{}
+	v.UID = uid
+	v.Version = version
+	v.From = from
+	v.To = to
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildGetProjectChildrenPayload builds the payload for the project-service
+// get-project-children endpoint from CLI flags.
+func BuildGetProjectChildrenPayload(projectServiceGetProjectChildrenUID string, projectServiceGetProjectChildrenVersion string, projectServiceGetProjectChildrenSort string, projectServiceGetProjectChildrenOrder string, projectServiceGetProjectChildrenPageSize string, projectServiceGetProjectChildrenPageToken string, projectServiceGetProjectChildrenBearerToken string) (*projectservice.GetProjectChildrenPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceGetProjectChildrenUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceGetProjectChildrenVersion != "" {
+			version = &projectServiceGetProjectChildrenVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var sort string
+	{
+		if projectServiceGetProjectChildrenSort != "" {
+			sort = projectServiceGetProjectChildrenSort
+			if !(sort == "name" || sort == "created_at" || sort == "stage") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort", sort, []any{"name", "created_at", "stage"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var order string
+	{
+		if projectServiceGetProjectChildrenOrder != "" {
+			order = projectServiceGetProjectChildrenOrder
+			if !(order == "asc" || order == "desc") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("order", order, []any{"asc", "desc"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var pageSize int
+	{
+		if projectServiceGetProjectChildrenPageSize != "" {
+			var v int64
+			v, err = strconv.ParseInt(projectServiceGetProjectChildrenPageSize, 10, strconv.IntSize)
+			pageSize = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for pageSize, must be INT")
+			}
+			if pageSize < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 1, true))
+			}
+			if pageSize > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var pageToken *string
+	{
+		if projectServiceGetProjectChildrenPageToken != "" {
+			pageToken = &projectServiceGetProjectChildrenPageToken
+			if utf8.RuneCountInString(*pageToken) > 1024 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("page_token", *pageToken, utf8.RuneCountInString(*pageToken), 1024, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceGetProjectChildrenBearerToken != "" {
+			bearerToken = &projectServiceGetProjectChildrenBearerToken
+		}
+	}
+	v := &projectservice.GetProjectChildrenPayload{}
+	v.UID = uid
+	v.Version = version
+	v.Sort = sort
+	v.Order = order
+	v.PageSize = pageSize
+	v.PageToken = pageToken
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildGetProjectDescendantsPayload builds the payload for the project-service
+// get-project-descendants endpoint from CLI flags.
+func BuildGetProjectDescendantsPayload(projectServiceGetProjectDescendantsUID string, projectServiceGetProjectDescendantsVersion string, projectServiceGetProjectDescendantsSort string, projectServiceGetProjectDescendantsOrder string, projectServiceGetProjectDescendantsPageSize string, projectServiceGetProjectDescendantsPageToken string, projectServiceGetProjectDescendantsBearerToken string) (*projectservice.GetProjectDescendantsPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceGetProjectDescendantsUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceGetProjectDescendantsVersion != "" {
+			version = &projectServiceGetProjectDescendantsVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var sort string
+	{
+		if projectServiceGetProjectDescendantsSort != "" {
+			sort = projectServiceGetProjectDescendantsSort
+			if !(sort == "name" || sort == "created_at" || sort == "stage") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort", sort, []any{"name", "created_at", "stage"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var order string
+	{
+		if projectServiceGetProjectDescendantsOrder != "" {
+			order = projectServiceGetProjectDescendantsOrder
+			if !(order == "asc" || order == "desc") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("order", order, []any{"asc", "desc"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var pageSize int
+	{
+		if projectServiceGetProjectDescendantsPageSize != "" {
+			var v int64
+			v, err = strconv.ParseInt(projectServiceGetProjectDescendantsPageSize, 10, strconv.IntSize)
+			pageSize = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for pageSize, must be INT")
+			}
+			if pageSize < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 1, true))
+			}
+			if pageSize > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var pageToken *string
+	{
+		if projectServiceGetProjectDescendantsPageToken != "" {
+			pageToken = &projectServiceGetProjectDescendantsPageToken
+			if utf8.RuneCountInString(*pageToken) > 1024 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("page_token", *pageToken, utf8.RuneCountInString(*pageToken), 1024, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceGetProjectDescendantsBearerToken != "" {
+			bearerToken = &projectServiceGetProjectDescendantsBearerToken
+		}
+	}
+	v := &projectservice.GetProjectDescendantsPayload{}
+	v.UID = uid
+	v.Version = version
+	v.Sort = sort
+	v.Order = order
+	v.PageSize = pageSize
+	v.PageToken = pageToken
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildGetProjectLegalLineagePayload builds the payload for the
+// project-service get-project-legal-lineage endpoint from CLI flags.
+func BuildGetProjectLegalLineagePayload(projectServiceGetProjectLegalLineageUID string, projectServiceGetProjectLegalLineageVersion string, projectServiceGetProjectLegalLineageBearerToken string) (*projectservice.GetProjectLegalLineagePayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceGetProjectLegalLineageUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceGetProjectLegalLineageVersion != "" {
+			version = &projectServiceGetProjectLegalLineageVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceGetProjectLegalLineageBearerToken != "" {
+			bearerToken = &projectServiceGetProjectLegalLineageBearerToken
+		}
+	}
+	v := &projectservice.GetProjectLegalLineagePayload{}
+	v.UID = uid
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildSearchProjectsPayload builds the payload for the project-service
+// search-projects endpoint from CLI flags.
+func BuildSearchProjectsPayload(projectServiceSearchProjectsVersion string, projectServiceSearchProjectsQ string, projectServiceSearchProjectsLimit string, projectServiceSearchProjectsBearerToken string) (*projectservice.SearchProjectsPayload, error) {
+	var err error
+	var version *string
+	{
+		if projectServiceSearchProjectsVersion != "" {
+			version = &projectServiceSearchProjectsVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var q string
+	{
+		q = projectServiceSearchProjectsQ
+		if utf8.RuneCountInString(q) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("q", q, utf8.RuneCountInString(q), 1, true))
+		}
+		if utf8.RuneCountInString(q) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("q", q, utf8.RuneCountInString(q), 200, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var limit int
+	{
+		if projectServiceSearchProjectsLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(projectServiceSearchProjectsLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceSearchProjectsBearerToken != "" {
+			bearerToken = &projectServiceSearchProjectsBearerToken
+		}
+	}
+	v := &projectservice.SearchProjectsPayload{}
+	v.Version = version
+	v.Q = q
+	v.Limit = limit
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildAutocompleteProjectsPayload builds the payload for the project-service
+// autocomplete-projects endpoint from CLI flags.
+func BuildAutocompleteProjectsPayload(projectServiceAutocompleteProjectsVersion string, projectServiceAutocompleteProjectsQ string, projectServiceAutocompleteProjectsLimit string, projectServiceAutocompleteProjectsBearerToken string) (*projectservice.AutocompleteProjectsPayload, error) {
+	var err error
+	var version *string
+	{
+		if projectServiceAutocompleteProjectsVersion != "" {
+			version = &projectServiceAutocompleteProjectsVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var q string
+	{
+		q = projectServiceAutocompleteProjectsQ
+		if utf8.RuneCountInString(q) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("q", q, utf8.RuneCountInString(q), 1, true))
+		}
+		if utf8.RuneCountInString(q) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("q", q, utf8.RuneCountInString(q), 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var limit int
+	{
+		if projectServiceAutocompleteProjectsLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(projectServiceAutocompleteProjectsLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 50 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 50, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceAutocompleteProjectsBearerToken != "" {
+			bearerToken = &projectServiceAutocompleteProjectsBearerToken
+		}
+	}
+	v := &projectservice.AutocompleteProjectsPayload{}
+	v.Version = version
+	v.Q = q
+	v.Limit = limit
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildAddProjectWriterPayload builds the payload for the project-service
+// add-project-writer endpoint from CLI flags.
+func BuildAddProjectWriterPayload(projectServiceAddProjectWriterUID string, projectServiceAddProjectWriterUsername string, projectServiceAddProjectWriterVersion string, projectServiceAddProjectWriterBearerToken string, projectServiceAddProjectWriterXSync string, projectServiceAddProjectWriterIfMatch string) (*projectservice.AddProjectWriterPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceAddProjectWriterUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var username string
+	{
+		username = projectServiceAddProjectWriterUsername
+		if utf8.RuneCountInString(username) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 1, true))
+		}
+		if utf8.RuneCountInString(username) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceAddProjectWriterVersion != "" {
+			version = &projectServiceAddProjectWriterVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceAddProjectWriterBearerToken != "" {
+			bearerToken = &projectServiceAddProjectWriterBearerToken
+		}
+	}
+	var xSync *bool
+	{
+		if projectServiceAddProjectWriterXSync != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceAddProjectWriterXSync)
+			xSync = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	var ifMatch *string
+	{
+		if projectServiceAddProjectWriterIfMatch != "" {
+			ifMatch = &projectServiceAddProjectWriterIfMatch
+		}
+	}
+	v := &projectservice.AddProjectWriterPayload{}
+	v.UID = uid
+	v.Username = username
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+	v.IfMatch = ifMatch
+
+	return v, nil
+}
+
+// BuildRemoveProjectWriterPayload builds the payload for the project-service
+// remove-project-writer endpoint from CLI flags.
+func BuildRemoveProjectWriterPayload(projectServiceRemoveProjectWriterUID string, projectServiceRemoveProjectWriterUsername string, projectServiceRemoveProjectWriterVersion string, projectServiceRemoveProjectWriterBearerToken string, projectServiceRemoveProjectWriterXSync string, projectServiceRemoveProjectWriterIfMatch string) (*projectservice.RemoveProjectWriterPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceRemoveProjectWriterUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var username string
+	{
+		username = projectServiceRemoveProjectWriterUsername
+		if utf8.RuneCountInString(username) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 1, true))
+		}
+		if utf8.RuneCountInString(username) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceRemoveProjectWriterVersion != "" {
+			version = &projectServiceRemoveProjectWriterVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceRemoveProjectWriterBearerToken != "" {
+			bearerToken = &projectServiceRemoveProjectWriterBearerToken
+		}
+	}
+	var xSync *bool
+	{
+		if projectServiceRemoveProjectWriterXSync != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceRemoveProjectWriterXSync)
+			xSync = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	var ifMatch *string
+	{
+		if projectServiceRemoveProjectWriterIfMatch != "" {
+			ifMatch = &projectServiceRemoveProjectWriterIfMatch
+		}
+	}
+	v := &projectservice.RemoveProjectWriterPayload{}
+	v.UID = uid
+	v.Username = username
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+	v.IfMatch = ifMatch
+
+	return v, nil
+}
+
+// BuildAddProjectAuditorPayload builds the payload for the project-service
+// add-project-auditor endpoint from CLI flags.
+func BuildAddProjectAuditorPayload(projectServiceAddProjectAuditorUID string, projectServiceAddProjectAuditorUsername string, projectServiceAddProjectAuditorVersion string, projectServiceAddProjectAuditorBearerToken string, projectServiceAddProjectAuditorXSync string, projectServiceAddProjectAuditorIfMatch string) (*projectservice.AddProjectAuditorPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceAddProjectAuditorUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var username string
+	{
+		username = projectServiceAddProjectAuditorUsername
+		if utf8.RuneCountInString(username) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 1, true))
+		}
+		if utf8.RuneCountInString(username) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceAddProjectAuditorVersion != "" {
+			version = &projectServiceAddProjectAuditorVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceAddProjectAuditorBearerToken != "" {
+			bearerToken = &projectServiceAddProjectAuditorBearerToken
+		}
+	}
+	var xSync *bool
+	{
+		if projectServiceAddProjectAuditorXSync != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceAddProjectAuditorXSync)
+			xSync = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	var ifMatch *string
+	{
+		if projectServiceAddProjectAuditorIfMatch != "" {
+			ifMatch = &projectServiceAddProjectAuditorIfMatch
+		}
+	}
+	v := &projectservice.AddProjectAuditorPayload{}
+	v.UID = uid
+	v.Username = username
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+	v.IfMatch = ifMatch
+
+	return v, nil
+}
+
+// BuildRemoveProjectAuditorPayload builds the payload for the project-service
+// remove-project-auditor endpoint from CLI flags.
+func BuildRemoveProjectAuditorPayload(projectServiceRemoveProjectAuditorUID string, projectServiceRemoveProjectAuditorUsername string, projectServiceRemoveProjectAuditorVersion string, projectServiceRemoveProjectAuditorBearerToken string, projectServiceRemoveProjectAuditorXSync string, projectServiceRemoveProjectAuditorIfMatch string) (*projectservice.RemoveProjectAuditorPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceRemoveProjectAuditorUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var username string
+	{
+		username = projectServiceRemoveProjectAuditorUsername
+		if utf8.RuneCountInString(username) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 1, true))
+		}
+		if utf8.RuneCountInString(username) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceRemoveProjectAuditorVersion != "" {
+			version = &projectServiceRemoveProjectAuditorVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceRemoveProjectAuditorBearerToken != "" {
+			bearerToken = &projectServiceRemoveProjectAuditorBearerToken
+		}
+	}
+	var xSync *bool
+	{
+		if projectServiceRemoveProjectAuditorXSync != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceRemoveProjectAuditorXSync)
+			xSync = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	var ifMatch *string
+	{
+		if projectServiceRemoveProjectAuditorIfMatch != "" {
+			ifMatch = &projectServiceRemoveProjectAuditorIfMatch
+		}
+	}
+	v := &projectservice.RemoveProjectAuditorPayload{}
+	v.UID = uid
+	v.Username = username
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+	v.IfMatch = ifMatch
+
+	return v, nil
+}
+
+// BuildJoinProjectPayload builds the payload for the project-service
+// join-project endpoint from CLI flags.
+func BuildJoinProjectPayload(projectServiceJoinProjectUID string, projectServiceJoinProjectVersion string, projectServiceJoinProjectBearerToken string, projectServiceJoinProjectXSync string) (*projectservice.JoinProjectPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceJoinProjectUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceJoinProjectVersion != "" {
+			version = &projectServiceJoinProjectVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceJoinProjectBearerToken != "" {
+			bearerToken = &projectServiceJoinProjectBearerToken
+		}
+	}
+	var xSync *bool
+	{
+		if projectServiceJoinProjectXSync != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceJoinProjectXSync)
+			xSync = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	v := &projectservice.JoinProjectPayload{}
+	v.UID = uid
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+
+	return v, nil
+}
+
+// BuildBulkUpdateProjectMembersPayload builds the payload for the
+// project-service bulk-update-project-members endpoint from CLI flags.
+func BuildBulkUpdateProjectMembersPayload(projectServiceBulkUpdateProjectMembersBody string, projectServiceBulkUpdateProjectMembersVersion string, projectServiceBulkUpdateProjectMembersBearerToken string, projectServiceBulkUpdateProjectMembersXSync string) (*projectservice.BulkUpdateProjectMembersPayload, error) {
+	var err error
+	var body BulkUpdateProjectMembersRequestBody
+	{
+		err = json.Unmarshal([]byte(projectServiceBulkUpdateProjectMembersBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"operations\": [\n         {\n            \"auditors_add\": [],\n            \"auditors_remove\": [\n               \"jdoe\"\n            ],\n            \"uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n            \"writers_add\": [\n               \"jdoe\"\n            ],\n            \"writers_remove\": []\n         }\n      ]\n   }'")
+		}
+		if body.Operations == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("operations", "body"))
+		}
+		if len(body.Operations) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.operations", body.Operations, len(body.Operations), 1, true))
+		}
+		if len(body.Operations) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.operations", body.Operations, len(body.Operations), 100, false))
+		}
+		for _, e := range body.Operations {
+			if e != nil {
+				if err2 := ValidateProjectMembersOperationRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceBulkUpdateProjectMembersVersion != "" {
+			version = &projectServiceBulkUpdateProjectMembersVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceBulkUpdateProjectMembersBearerToken != "" {
+			bearerToken = &projectServiceBulkUpdateProjectMembersBearerToken
+		}
+	}
+	var xSync *bool
+	{
+		if projectServiceBulkUpdateProjectMembersXSync != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceBulkUpdateProjectMembersXSync)
+			xSync = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	v := &projectservice.BulkUpdateProjectMembersPayload{}
+	if body.Operations != nil {
+		v.Operations = make([]*projectservice.ProjectMembersOperation, len(body.Operations))
+		for i, val := range body.Operations {
+			v.Operations[i] = marshalProjectMembersOperationRequestBodyToProjectserviceProjectMembersOperation(val)
+		}
+	} else {
+		v.Operations = []*projectservice.ProjectMembersOperation{}
+	}
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+
+	return v, nil
+}
+
+// BuildGetProjectsPayload builds the payload for the project-service
+// get-projects endpoint from CLI flags.
+func BuildGetProjectsPayload(projectServiceGetProjectsVersion string, projectServiceGetProjectsTag string, projectServiceGetProjectsFields string, projectServiceGetProjectsBaseOnly string, projectServiceGetProjectsBearerToken string) (*projectservice.GetProjectsPayload, error) {
+	var err error
+	var version *string
+	{
+		if projectServiceGetProjectsVersion != "" {
+			version = &projectServiceGetProjectsVersion
+			if !(*version == "1" || *version == "2") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1", "2"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var tag []string
+	{
+		if projectServiceGetProjectsTag != "" {
+			err = json.Unmarshal([]byte(projectServiceGetProjectsTag), &tag)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for tag, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"cncf\"\n   ]'")
+			}
+		}
+	}
+	var fields *string
+	{
+		if projectServiceGetProjectsFields != "" {
+			fields = &projectServiceGetProjectsFields
+		}
+	}
+	var baseOnly bool
+	{
+		if projectServiceGetProjectsBaseOnly != "" {
+			baseOnly, err = strconv.ParseBool(projectServiceGetProjectsBaseOnly)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for baseOnly, must be BOOL")
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceGetProjectsBearerToken != "" {
+			bearerToken = &projectServiceGetProjectsBearerToken
+		}
+	}
+	v := &projectservice.GetProjectsPayload{}
+	v.Version = version
+	v.Tag = tag
+	v.Fields = fields
+	v.BaseOnly = baseOnly
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildCreateProjectPayload builds the payload for the project-service
+// create-project endpoint from CLI flags.
+func BuildCreateProjectPayload(projectServiceCreateProjectBody string, projectServiceCreateProjectVersion string, projectServiceCreateProjectAllowDuplicates string, projectServiceCreateProjectTemplate string, projectServiceCreateProjectBearerToken string, projectServiceCreateProjectXSync string) (*projectservice.CreateProjectPayload, error) {
+	var err error
+	var body CreateProjectRequestBody
+	{
+		err = json.Unmarshal([]byte(projectServiceCreateProjectBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"annotations\": {\n         \"salesforce.v1/id\": \"a0941000002wBz9AAE\"\n      },\n      \"announcement_date\": \"2021-01-01\",\n      \"auditors\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"autojoin_enabled\": false,\n      \"category\": \"Active\",\n      \"charter_url\": \"https://example.com/charter.pdf\",\n      \"description\": \"project foo is a project about bar\",\n      \"description_i18n\": {\n         \"zh\": \"Foo 项目是一个关于 bar 的项目\"\n      },\n      \"entity_dissolution_date\": \"2021-12-31\",\n      \"entity_formation_document_url\": \"https://example.com/formation.pdf\",\n      \"executive_director\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"formation_date\": \"2021-01-01\",\n      \"funding\": \"Funded\",\n      \"funding_model\": [\n         \"Crowdfunding\"\n      ],\n      \"is_foundation\": false,\n      \"legacy_id\": \"a0941000002wBz9AAE\",\n      \"legal_entity_name\": \"Example Foundation LLC\",\n      \"legal_entity_type\": \"Subproject\",\n      \"legal_parent_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"links\": {\n         \"contact_email\": \"info@example.org\",\n         \"discord\": \"https://discord.gg/example\",\n         \"linkedin\": \"https://www.linkedin.com/company/example\",\n         \"mailing_list\": \"https://lists.example.org/g/main\",\n         \"slack\": \"https://slack.example.org/\",\n         \"twitter\": \"https://x.com/example\",\n         \"youtube\": \"https://www.youtube.com/@example\"\n      },\n      \"logo_url\": \"https://example.com/logo.png\",\n      \"meeting_coordinators\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"mission_statement\": \"The mission of the project is to build a sustainable ecosystem around open source projects to accelerate technology development and industry adoption.\",\n      \"name\": \"Foo Foundation\",\n      \"name_i18n\": {\n         \"ja\": \"Foo 財団\",\n         \"zh\": \"Foo 基金会\"\n      },\n      \"opportunity_owner\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"overview_html\": \"\\u003cp\\u003eThe project builds \\u003cstrong\\u003eopen source\\u003c/strong\\u003e tooling. \\u003ca href=\\\"https://example.org/docs\\\"\\u003eRead the docs\\u003c/a\\u003e.\\u003c/p\\u003e\",\n      \"parent_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"program_manager\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"public\": true,\n      \"repository_url\": \"https://example.com/project\",\n      \"slug\": \"project-slug\",\n      \"stage\": \"Formation - Exploratory\",\n      \"tags\": [\n         \"cncf\",\n         \"security-critical\"\n      ],\n      \"website_url\": \"https://example.com\",\n      \"writers\": [\n         {\n            \"avatar\": \"https://example.com/avatar3.jpg\",\n            \"email\": \"alice.johnson@example.com\",\n            \"name\": \"Alice Johnson\",\n            \"username\": \"alicejohnson789\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar4.jpg\",\n            \"email\": \"bob.wilson@example.com\",\n            \"name\": \"Bob Wilson\",\n            \"username\": \"bobwilson101\"\n         }\n      ]\n   }'")
+		}
+		if body.Slug != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.slug", *body.Slug, goa.FormatRegexp))
+		}
+		if body.Slug != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", *body.Slug, "^[a-z][a-z0-9_\\-]*[a-z0-9]$"))
+		}
+		if body.Funding != nil {
+			if !(*body.Funding == "Funded" || *body.Funding == "Unfunded" || *body.Funding == "Supported by Parent Project") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.funding", *body.Funding, []any{"Funded", "Unfunded", "Supported by Parent Project"}))
+			}
+		}
+		for _, e := range body.FundingModel {
+			if !(e == "Crowdfunding" || e == "Membership" || e == "Alternate Funding") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.funding_model[*]", e, []any{"Crowdfunding", "Membership", "Alternate Funding"}))
+			}
+		}
+		if body.CharterURL != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.charter_url", *body.CharterURL, goa.FormatURI))
+		}
+		if body.LegalEntityType != nil {
+			if !(*body.LegalEntityType == "Subproject" || *body.LegalEntityType == "Incorporated Entity" || *body.LegalEntityType == "Series LLC" || *body.LegalEntityType == "Unofficial Subproject" || *body.LegalEntityType == "Internal Allocation" || *body.LegalEntityType == "None") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.legal_entity_type", *body.LegalEntityType, []any{"Subproject", "Incorporated Entity", "Series LLC", "Unofficial Subproject", "Internal Allocation", "None"}))
+			}
+		}
+		if body.LegalParentUID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.legal_parent_uid", *body.LegalParentUID, goa.FormatUUID))
+		}
+		if body.EntityDissolutionDate != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.entity_dissolution_date", *body.EntityDissolutionDate, goa.FormatDate))
+		}
+		if body.EntityFormationDocumentURL != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.entity_formation_document_url", *body.EntityFormationDocumentURL, goa.FormatURI))
+		}
+		if body.FormationDate != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.formation_date", *body.FormationDate, goa.FormatDate))
+		}
+		if body.LogoURL != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.logo_url", *body.LogoURL, goa.FormatURI))
+		}
+		if body.RepositoryURL != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.repository_url", *body.RepositoryURL, goa.FormatURI))
+		}
+		if body.WebsiteURL != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.website_url", *body.WebsiteURL, goa.FormatURI))
+		}
+		if body.Links != nil {
+			if err2 := ValidateProjectLinksRequestBody(body.Links); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if len(body.Annotations) > 32 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.annotations", body.Annotations, len(body.Annotations), 32, false))
+		}
+		for k, v := range body.Annotations {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.annotations.key", k, "^[a-z0-9]([a-z0-9._/-]*[a-z0-9])?$"))
+			if utf8.RuneCountInString(k) > 63 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.annotations.key", k, utf8.RuneCountInString(k), 63, false))
+			}
+			if utf8.RuneCountInString(v) > 1024 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.annotations[key]", v, utf8.RuneCountInString(v), 1024, false))
+			}
+		}
+		if len(body.NameI18n) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name_i18n", body.NameI18n, len(body.NameI18n), 50, false))
+		}
+		for k, v := range body.NameI18n {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.name_i18n.key", k, "^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$"))
+			if utf8.RuneCountInString(k) > 35 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.name_i18n.key", k, utf8.RuneCountInString(k), 35, false))
+			}
+			if utf8.RuneCountInString(v) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.name_i18n[key]", v, utf8.RuneCountInString(v), 1, true))
+			}
+			if utf8.RuneCountInString(v) > 255 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.name_i18n[key]", v, utf8.RuneCountInString(v), 255, false))
+			}
+		}
+		if len(body.DescriptionI18n) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.description_i18n", body.DescriptionI18n, len(body.DescriptionI18n), 50, false))
+		}
+		for k, v := range body.DescriptionI18n {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.description_i18n.key", k, "^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$"))
+			if utf8.RuneCountInString(k) > 35 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.description_i18n.key", k, utf8.RuneCountInString(k), 35, false))
+			}
+			if utf8.RuneCountInString(v) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.description_i18n[key]", v, utf8.RuneCountInString(v), 1, true))
+			}
+		}
+		if body.LegacyID != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.legacy_id", *body.LegacyID, "^[A-Za-z0-9]+$"))
+		}
+		if body.LegacyID != nil {
+			if utf8.RuneCountInString(*body.LegacyID) > 64 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.legacy_id", *body.LegacyID, utf8.RuneCountInString(*body.LegacyID), 64, false))
+			}
+		}
+		if len(body.Tags) > 20 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags", body.Tags, len(body.Tags), 20, false))
+		}
+		for _, e := range body.Tags {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.tags[*]", e, "^[a-z0-9]([a-z0-9-]*[a-z0-9])?$"))
+			if utf8.RuneCountInString(e) > 63 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags[*]", e, utf8.RuneCountInString(e), 63, false))
+			}
+		}
+		if body.AnnouncementDate != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.announcement_date", *body.AnnouncementDate, goa.FormatDate))
+		}
+		if body.OverviewHTML != nil {
+			if utf8.RuneCountInString(*body.OverviewHTML) > 20000 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.overview_html", *body.OverviewHTML, utf8.RuneCountInString(*body.OverviewHTML), 20000, false))
+			}
+		}
+		for _, e := range body.Writers {
+			if e != nil {
+				if err2 := ValidateUserInfoRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		for _, e := range body.MeetingCoordinators {
+			if e != nil {
+				if err2 := ValidateUserInfoRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		for _, e := range body.Auditors {
+			if e != nil {
+				if err2 := ValidateUserInfoRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if body.ExecutiveDirector != nil {
+			if err2 := ValidateUserInfoRequestBody(body.ExecutiveDirector); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if body.ProgramManager != nil {
+			if err2 := ValidateUserInfoRequestBody(body.ProgramManager); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if body.OpportunityOwner != nil {
+			if err2 := ValidateUserInfoRequestBody(body.OpportunityOwner); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceCreateProjectVersion != "" {
+			version = &projectServiceCreateProjectVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var allowDuplicates bool
+	{
+		if projectServiceCreateProjectAllowDuplicates != "" {
+			allowDuplicates, err = strconv.ParseBool(projectServiceCreateProjectAllowDuplicates)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for allowDuplicates, must be BOOL")
+			}
+		}
+	}
+	var template *string
+	{
+		if projectServiceCreateProjectTemplate != "" {
+			template = &projectServiceCreateProjectTemplate
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceCreateProjectBearerToken != "" {
+			bearerToken = &projectServiceCreateProjectBearerToken
+		}
+	}
+	var xSync *bool
+	{
+		if projectServiceCreateProjectXSync != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceCreateProjectXSync)
+			xSync = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	v := &projectservice.CreateProjectPayload{
+		Slug:                       body.Slug,
+		Description:                body.Description,
+		Name:                       body.Name,
+		Public:                     body.Public,
+		IsFoundation:               body.IsFoundation,
+		ParentUID:                  body.ParentUID,
+		Stage:                      body.Stage,
+		Category:                   body.Category,
+		Funding:                    body.Funding,
+		CharterURL:                 body.CharterURL,
+		LegalEntityType:            body.LegalEntityType,
+		LegalEntityName:            body.LegalEntityName,
+		LegalParentUID:             body.LegalParentUID,
+		EntityDissolutionDate:      body.EntityDissolutionDate,
+		EntityFormationDocumentURL: body.EntityFormationDocumentURL,
+		AutojoinEnabled:            body.AutojoinEnabled,
+		FormationDate:              body.FormationDate,
+		LogoURL:                    body.LogoURL,
+		RepositoryURL:              body.RepositoryURL,
+		WebsiteURL:                 body.WebsiteURL,
+		LegacyID:                   body.LegacyID,
+		AnnouncementDate:           body.AnnouncementDate,
+		MissionStatement:           body.MissionStatement,
+		OverviewHTML:               body.OverviewHTML,
+	}
+	if body.FundingModel != nil {
+		v.FundingModel = make([]string, len(body.FundingModel))
+		for i, val := range body.FundingModel {
+			v.FundingModel[i] = val
+		}
+	}
+	if body.Links != nil {
+		v.Links = marshalProjectLinksRequestBodyToProjectserviceProjectLinks(body.Links)
+	}
+	if body.Annotations != nil {
+		v.Annotations = make(map[string]string, len(body.Annotations))
+		for key, val := range body.Annotations {
+			tk := key
+			tv := val
+			v.Annotations[tk] = tv
+		}
+	}
+	if body.NameI18n != nil {
+		v.NameI18n = make(map[string]string, len(body.NameI18n))
+		for key, val := range body.NameI18n {
+			tk := key
+			tv := val
+			v.NameI18n[tk] = tv
+		}
+	}
+	if body.DescriptionI18n != nil {
+		v.DescriptionI18n = make(map[string]string, len(body.DescriptionI18n))
+		for key, val := range body.DescriptionI18n {
+			tk := key
+			tv := val
+			v.DescriptionI18n[tk] = tv
+		}
+	}
+	if body.Tags != nil {
+		v.Tags = make([]string, len(body.Tags))
+		for i, val := range body.Tags {
+			v.Tags[i] = val
+		}
+	}
+	if body.Writers != nil {
+		v.Writers = make([]*projectservice.UserInfo, len(body.Writers))
+		for i, val := range body.Writers {
+			v.Writers[i] = marshalUserInfoRequestBodyToProjectserviceUserInfo(val)
+		}
+	}
+	if body.MeetingCoordinators != nil {
+		v.MeetingCoordinators = make([]*projectservice.UserInfo, len(body.MeetingCoordinators))
+		for i, val := range body.MeetingCoordinators {
+			v.MeetingCoordinators[i] = marshalUserInfoRequestBodyToProjectserviceUserInfo(val)
+		}
+	}
+	if body.Auditors != nil {
+		v.Auditors = make([]*projectservice.UserInfo, len(body.Auditors))
+		for i, val := range body.Auditors {
+			v.Auditors[i] = marshalUserInfoRequestBodyToProjectserviceUserInfo(val)
+		}
+	}
+	if body.ExecutiveDirector != nil {
+		v.ExecutiveDirector = marshalUserInfoRequestBodyToProjectserviceUserInfo(body.ExecutiveDirector)
+	}
+	if body.ProgramManager != nil {
+		v.ProgramManager = marshalUserInfoRequestBodyToProjectserviceUserInfo(body.ProgramManager)
+	}
+	if body.OpportunityOwner != nil {
+		v.OpportunityOwner = marshalUserInfoRequestBodyToProjectserviceUserInfo(body.OpportunityOwner)
+	}
+	v.Version = version
+	v.AllowDuplicates = allowDuplicates
+	v.Template = template
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+
+	return v, nil
+}
+
+// BuildGetOneProjectBasePayload builds the payload for the project-service
+// get-one-project-base endpoint from CLI flags.
+func BuildGetOneProjectBasePayload(projectServiceGetOneProjectBaseUID string, projectServiceGetOneProjectBaseVersion string, projectServiceGetOneProjectBaseFields string, projectServiceGetOneProjectBaseExpand string, projectServiceGetOneProjectBaseBearerToken string) (*projectservice.GetOneProjectBasePayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceGetOneProjectBaseUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceGetOneProjectBaseVersion != "" {
+			version = &projectServiceGetOneProjectBaseVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var fields *string
+	{
+		if projectServiceGetOneProjectBaseFields != "" {
+			fields = &projectServiceGetOneProjectBaseFields
+		}
+	}
+	var expand *string
+	{
+		if projectServiceGetOneProjectBaseExpand != "" {
+			expand = &projectServiceGetOneProjectBaseExpand
+			if !(*expand == "settings") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("expand", *expand, []any{"settings"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceGetOneProjectBaseBearerToken != "" {
+			bearerToken = &projectServiceGetOneProjectBaseBearerToken
+		}
+	}
+	v := &projectservice.GetOneProjectBasePayload{}
+	v.UID = &uid
+	v.Version = version
+	v.Fields = fields
+	v.Expand = expand
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildGetProjectUIDFromLegacyIDPayload builds the payload for the
+// project-service get-project-uid-from-legacy-id endpoint from CLI flags.
+func BuildGetProjectUIDFromLegacyIDPayload(projectServiceGetProjectUIDFromLegacyIDLegacyID string, projectServiceGetProjectUIDFromLegacyIDVersion string, projectServiceGetProjectUIDFromLegacyIDBearerToken string) (*projectservice.GetProjectUIDFromLegacyIDPayload, error) {
+	var err error
+	var legacyID string
+	{
+		legacyID = projectServiceGetProjectUIDFromLegacyIDLegacyID
+		err = goa.MergeErrors(err, goa.ValidatePattern("legacy_id", legacyID, "^[A-Za-z0-9]+$"))
+		if utf8.RuneCountInString(legacyID) > 64 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("legacy_id", legacyID, utf8.RuneCountInString(legacyID), 64, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceGetProjectUIDFromLegacyIDVersion != "" {
+			version = &projectServiceGetProjectUIDFromLegacyIDVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceGetProjectUIDFromLegacyIDBearerToken != "" {
+			bearerToken = &projectServiceGetProjectUIDFromLegacyIDBearerToken
+		}
+	}
+	v := &projectservice.GetProjectUIDFromLegacyIDPayload{}
+	v.LegacyID = legacyID
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildGetOneProjectSettingsPayload builds the payload for the project-service
+// get-one-project-settings endpoint from CLI flags.
+func BuildGetOneProjectSettingsPayload(projectServiceGetOneProjectSettingsUID string, projectServiceGetOneProjectSettingsVersion string, projectServiceGetOneProjectSettingsBearerToken string) (*projectservice.GetOneProjectSettingsPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceGetOneProjectSettingsUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceGetOneProjectSettingsVersion != "" {
+			version = &projectServiceGetOneProjectSettingsVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceGetOneProjectSettingsBearerToken != "" {
+			bearerToken = &projectServiceGetOneProjectSettingsBearerToken
+		}
+	}
+	v := &projectservice.GetOneProjectSettingsPayload{}
+	v.UID = &uid
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildUpdateProjectBasePayload builds the payload for the project-service
+// update-project-base endpoint from CLI flags.
+func BuildUpdateProjectBasePayload(projectServiceUpdateProjectBaseBody string, projectServiceUpdateProjectBaseUID string, projectServiceUpdateProjectBaseVersion string, projectServiceUpdateProjectBaseBearerToken string, projectServiceUpdateProjectBaseXSync string, projectServiceUpdateProjectBaseIfMatch string, projectServiceUpdateProjectBaseForceUpdate string, projectServiceUpdateProjectBaseAdminOverride string) (*projectservice.UpdateProjectBasePayload, error) {
+	var err error
+	var body UpdateProjectBaseRequestBody
+	{
+		err = json.Unmarshal([]byte(projectServiceUpdateProjectBaseBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"annotations\": {\n         \"salesforce.v1/id\": \"a0941000002wBz9AAE\"\n      },\n      \"autojoin_enabled\": false,\n      \"category\": \"Active\",\n      \"charter_url\": \"https://example.com/charter.pdf\",\n      \"description\": \"project foo is a project about bar\",\n      \"description_i18n\": {\n         \"zh\": \"Foo 项目是一个关于 bar 的项目\"\n      },\n      \"entity_dissolution_date\": \"2021-12-31\",\n      \"entity_formation_document_url\": \"https://example.com/formation.pdf\",\n      \"formation_date\": \"2021-01-01\",\n      \"funding\": \"Funded\",\n      \"funding_model\": [\n         \"Crowdfunding\"\n      ],\n      \"is_foundation\": false,\n      \"legacy_id\": \"a0941000002wBz9AAE\",\n      \"legal_entity_name\": \"Example Foundation LLC\",\n      \"legal_entity_type\": \"Subproject\",\n      \"legal_parent_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"links\": {\n         \"contact_email\": \"info@example.org\",\n         \"discord\": \"https://discord.gg/example\",\n         \"linkedin\": \"https://www.linkedin.com/company/example\",\n         \"mailing_list\": \"https://lists.example.org/g/main\",\n         \"slack\": \"https://slack.example.org/\",\n         \"twitter\": \"https://x.com/example\",\n         \"youtube\": \"https://www.youtube.com/@example\"\n      },\n      \"logo_url\": \"https://example.com/logo.png\",\n      \"name\": \"Foo Foundation\",\n      \"name_i18n\": {\n         \"ja\": \"Foo 財団\",\n         \"zh\": \"Foo 基金会\"\n      },\n      \"parent_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"public\": true,\n      \"repository_url\": \"https://example.com/project\",\n      \"slug\": \"project-slug\",\n      \"stage\": \"Formation - Exploratory\",\n      \"tags\": [\n         \"cncf\",\n         \"security-critical\"\n      ],\n      \"website_url\": \"https://example.com\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.slug", body.Slug, goa.FormatRegexp))
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", body.Slug, "^[a-z][a-z0-9_\\-]*[a-z0-9]$"))
+		if body.Funding != nil {
+			if !(*body.Funding == "Funded" || *body.Funding == "Unfunded" || *body.Funding == "Supported by Parent Project") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.funding", *body.Funding, []any{"Funded", "Unfunded", "Supported by Parent Project"}))
+			}
+		}
+		for _, e := range body.FundingModel {
+			if !(e == "Crowdfunding" || e == "Membership" || e == "Alternate Funding") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.funding_model[*]", e, []any{"Crowdfunding", "Membership", "Alternate Funding"}))
+			}
+		}
+		if body.CharterURL != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.charter_url", *body.CharterURL, goa.FormatURI))
+		}
+		if body.LegalEntityType != nil {
+			if !(*body.LegalEntityType == "Subproject" || *body.LegalEntityType == "Incorporated Entity" || *body.LegalEntityType == "Series LLC" || *body.LegalEntityType == "Unofficial Subproject" || *body.LegalEntityType == "Internal Allocation" || *body.LegalEntityType == "None") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.legal_entity_type", *body.LegalEntityType, []any{"Subproject", "Incorporated Entity", "Series LLC", "Unofficial Subproject", "Internal Allocation", "None"}))
+			}
+		}
+		if body.LegalParentUID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.legal_parent_uid", *body.LegalParentUID, goa.FormatUUID))
+		}
+		if body.EntityDissolutionDate != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.entity_dissolution_date", *body.EntityDissolutionDate, goa.FormatDate))
+		}
+		if body.EntityFormationDocumentURL != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.entity_formation_document_url", *body.EntityFormationDocumentURL, goa.FormatURI))
+		}
+		if body.FormationDate != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.formation_date", *body.FormationDate, goa.FormatDate))
+		}
+		if body.LogoURL != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.logo_url", *body.LogoURL, goa.FormatURI))
+		}
+		if body.RepositoryURL != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.repository_url", *body.RepositoryURL, goa.FormatURI))
+		}
+		if body.WebsiteURL != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.website_url", *body.WebsiteURL, goa.FormatURI))
+		}
+		if body.Links != nil {
+			if err2 := ValidateProjectLinksRequestBody(body.Links); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if len(body.Annotations) > 32 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.annotations", body.Annotations, len(body.Annotations), 32, false))
+		}
+		for k, v := range body.Annotations {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.annotations.key", k, "^[a-z0-9]([a-z0-9._/-]*[a-z0-9])?$"))
+			if utf8.RuneCountInString(k) > 63 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.annotations.key", k, utf8.RuneCountInString(k), 63, false))
+			}
+			if utf8.RuneCountInString(v) > 1024 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.annotations[key]", v, utf8.RuneCountInString(v), 1024, false))
+			}
+		}
+		if len(body.NameI18n) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name_i18n", body.NameI18n, len(body.NameI18n), 50, false))
+		}
+		for k, v := range body.NameI18n {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.name_i18n.key", k, "^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$"))
+			if utf8.RuneCountInString(k) > 35 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.name_i18n.key", k, utf8.RuneCountInString(k), 35, false))
+			}
+			if utf8.RuneCountInString(v) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.name_i18n[key]", v, utf8.RuneCountInString(v), 1, true))
+			}
+			if utf8.RuneCountInString(v) > 255 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.name_i18n[key]", v, utf8.RuneCountInString(v), 255, false))
+			}
+		}
+		if len(body.DescriptionI18n) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.description_i18n", body.DescriptionI18n, len(body.DescriptionI18n), 50, false))
+		}
+		for k, v := range body.DescriptionI18n {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.description_i18n.key", k, "^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$"))
+			if utf8.RuneCountInString(k) > 35 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.description_i18n.key", k, utf8.RuneCountInString(k), 35, false))
+			}
+			if utf8.RuneCountInString(v) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.description_i18n[key]", v, utf8.RuneCountInString(v), 1, true))
+			}
+		}
+		if body.LegacyID != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.legacy_id", *body.LegacyID, "^[A-Za-z0-9]+$"))
+		}
+		if body.LegacyID != nil {
+			if utf8.RuneCountInString(*body.LegacyID) > 64 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.legacy_id", *body.LegacyID, utf8.RuneCountInString(*body.LegacyID), 64, false))
+			}
+		}
+		if len(body.Tags) > 20 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags", body.Tags, len(body.Tags), 20, false))
+		}
+		for _, e := range body.Tags {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.tags[*]", e, "^[a-z0-9]([a-z0-9-]*[a-z0-9])?$"))
+			if utf8.RuneCountInString(e) > 63 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags[*]", e, utf8.RuneCountInString(e), 63, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var uid string
+	{
+		uid = projectServiceUpdateProjectBaseUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceUpdateProjectBaseVersion != "" {
+			version = &projectServiceUpdateProjectBaseVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceUpdateProjectBaseBearerToken != "" {
+			bearerToken = &projectServiceUpdateProjectBaseBearerToken
+		}
+	}
+	var xSync *bool
+	{
+		if projectServiceUpdateProjectBaseXSync != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceUpdateProjectBaseXSync)
+			xSync = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	var ifMatch *string
+	{
+		if projectServiceUpdateProjectBaseIfMatch != "" {
+			ifMatch = &projectServiceUpdateProjectBaseIfMatch
+		}
+	}
+	var forceUpdate *bool
+	{
+		if projectServiceUpdateProjectBaseForceUpdate != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceUpdateProjectBaseForceUpdate)
+			forceUpdate = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for forceUpdate, must be BOOL")
+			}
+		}
+	}
+	var adminOverride *bool
+	{
+		if projectServiceUpdateProjectBaseAdminOverride != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceUpdateProjectBaseAdminOverride)
+			adminOverride = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for adminOverride, must be BOOL")
+			}
+		}
+	}
+	v := &projectservice.UpdateProjectBasePayload{
+		Slug:                       body.Slug,
+		Description:                body.Description,
+		Name:                       body.Name,
+		Public:                     body.Public,
+		IsFoundation:               body.IsFoundation,
+		ParentUID:                  body.ParentUID,
+		Stage:                      body.Stage,
+		Category:                   body.Category,
+		Funding:                    body.Funding,
+		CharterURL:                 body.CharterURL,
+		LegalEntityType:            body.LegalEntityType,
+		LegalEntityName:            body.LegalEntityName,
+		LegalParentUID:             body.LegalParentUID,
+		EntityDissolutionDate:      body.EntityDissolutionDate,
+		EntityFormationDocumentURL: body.EntityFormationDocumentURL,
+		AutojoinEnabled:            body.AutojoinEnabled,
+		FormationDate:              body.FormationDate,
+		LogoURL:                    body.LogoURL,
+		RepositoryURL:              body.RepositoryURL,
+		WebsiteURL:                 body.WebsiteURL,
+		LegacyID:                   body.LegacyID,
+	}
+	if body.FundingModel != nil {
+		v.FundingModel = make([]string, len(body.FundingModel))
+		for i, val := range body.FundingModel {
+			v.FundingModel[i] = val
+		}
+	}
+	if body.Links != nil {
+		v.Links = marshalProjectLinksRequestBodyToProjectserviceProjectLinks(body.Links)
+	}
+	if body.Annotations != nil {
+		v.Annotations = make(map[string]string, len(body.Annotations))
+		for key, val := range body.Annotations {
+			tk := key
+			tv := val
+			v.Annotations[tk] = tv
+		}
+	}
+	if body.NameI18n != nil {
+		v.NameI18n = make(map[string]string, len(body.NameI18n))
+		for key, val := range body.NameI18n {
+			tk := key
+			tv := val
+			v.NameI18n[tk] = tv
+		}
+	}
+	if body.DescriptionI18n != nil {
+		v.DescriptionI18n = make(map[string]string, len(body.DescriptionI18n))
+		for key, val := range body.DescriptionI18n {
+			tk := key
+			tv := val
+			v.DescriptionI18n[tk] = tv
+		}
+	}
+	if body.Tags != nil {
+		v.Tags = make([]string, len(body.Tags))
+		for i, val := range body.Tags {
+			v.Tags[i] = val
+		}
+	}
+	v.UID = &uid
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+	v.IfMatch = ifMatch
+	v.ForceUpdate = forceUpdate
+	v.AdminOverride = adminOverride
+
+	return v, nil
+}
+
+// BuildUpdateProjectSettingsPayload builds the payload for the project-service
+// update-project-settings endpoint from CLI flags.
+func BuildUpdateProjectSettingsPayload(projectServiceUpdateProjectSettingsBody string, projectServiceUpdateProjectSettingsUID string, projectServiceUpdateProjectSettingsVersion string, projectServiceUpdateProjectSettingsBearerToken string, projectServiceUpdateProjectSettingsXSync string, projectServiceUpdateProjectSettingsIfMatch string, projectServiceUpdateProjectSettingsForceUpdate string, projectServiceUpdateProjectSettingsMerge string) (*projectservice.UpdateProjectSettingsPayload, error) {
+	var err error
+	var body UpdateProjectSettingsRequestBody
+	{
+		err = json.Unmarshal([]byte(projectServiceUpdateProjectSettingsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"announcement_date\": \"2021-01-01\",\n      \"auditors\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"executive_director\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"meeting_coordinators\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"mission_statement\": \"The mission of the project is to build a sustainable ecosystem around open source projects to accelerate technology development and industry adoption.\",\n      \"opportunity_owner\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"overview_html\": \"\\u003cp\\u003eThe project builds \\u003cstrong\\u003eopen source\\u003c/strong\\u003e tooling. \\u003ca href=\\\"https://example.org/docs\\\"\\u003eRead the docs\\u003c/a\\u003e.\\u003c/p\\u003e\",\n      \"program_manager\": {\n         \"avatar\": \"https://example.com/avatar.jpg\",\n         \"email\": \"jane.smith@example.com\",\n         \"name\": \"Jane Smith\",\n         \"username\": \"janesmith456\"\n      },\n      \"writers\": [\n         {\n            \"avatar\": \"https://example.com/avatar3.jpg\",\n            \"email\": \"alice.johnson@example.com\",\n            \"name\": \"Alice Johnson\",\n            \"username\": \"alicejohnson789\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar4.jpg\",\n            \"email\": \"bob.wilson@example.com\",\n            \"name\": \"Bob Wilson\",\n            \"username\": \"bobwilson101\"\n         }\n      ]\n   }'")
+		}
+	}
+	var uid string
+	{
+		uid = projectServiceUpdateProjectSettingsUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceUpdateProjectSettingsVersion != "" {
+			version = &projectServiceUpdateProjectSettingsVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceUpdateProjectSettingsBearerToken != "" {
+			bearerToken = &projectServiceUpdateProjectSettingsBearerToken
+		}
+	}
+	var xSync *bool
+	{
+		if projectServiceUpdateProjectSettingsXSync != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceUpdateProjectSettingsXSync)
+			xSync = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	var ifMatch *string
+	{
+		if projectServiceUpdateProjectSettingsIfMatch != "" {
+			ifMatch = &projectServiceUpdateProjectSettingsIfMatch
+		}
+	}
+	var forceUpdate *bool
+	{
+		if projectServiceUpdateProjectSettingsForceUpdate != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceUpdateProjectSettingsForceUpdate)
+			forceUpdate = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for forceUpdate, must be BOOL")
+			}
+		}
+	}
+	var merge *bool
+	{
+		if projectServiceUpdateProjectSettingsMerge != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceUpdateProjectSettingsMerge)
+			merge = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for merge, must be BOOL")
+			}
+		}
+	}
+	v := &projectservice.UpdateProjectSettingsPayload{
+		MissionStatement: body.MissionStatement,
+		OverviewHTML:     body.OverviewHTML,
+		AnnouncementDate: body.AnnouncementDate,
+	}
+	if body.Writers != nil {
+		v.Writers = make([]*projectservice.UserInfo, len(body.Writers))
+		for i, val := range body.Writers {
+			v.Writers[i] = marshalUserInfoRequestBodyToProjectserviceUserInfo(val)
+		}
+	}
+	if body.MeetingCoordinators != nil {
+		v.MeetingCoordinators = make([]*projectservice.UserInfo, len(body.MeetingCoordinators))
+		for i, val := range body.MeetingCoordinators {
+			v.MeetingCoordinators[i] = marshalUserInfoRequestBodyToProjectserviceUserInfo(val)
+		}
+	}
+	if body.Auditors != nil {
+		v.Auditors = make([]*projectservice.UserInfo, len(body.Auditors))
 		for i, val := range body.Auditors {
 			v.Auditors[i] = marshalUserInfoRequestBodyToProjectserviceUserInfo(val)
 		}
 	}
-	if body.ExecutiveDirector != nil {
-		v.ExecutiveDirector = marshalUserInfoRequestBodyToProjectserviceUserInfo(body.ExecutiveDirector)
+	if body.ExecutiveDirector != nil {
+		v.ExecutiveDirector = marshalUserInfoRequestBodyToProjectserviceUserInfo(body.ExecutiveDirector)
+	}
+	if body.ProgramManager != nil {
+		v.ProgramManager = marshalUserInfoRequestBodyToProjectserviceUserInfo(body.ProgramManager)
+	}
+	if body.OpportunityOwner != nil {
+		v.OpportunityOwner = marshalUserInfoRequestBodyToProjectserviceUserInfo(body.OpportunityOwner)
+	}
+	v.UID = &uid
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+	v.IfMatch = ifMatch
+	v.ForceUpdate = forceUpdate
+	v.Merge = merge
+
+	return v, nil
+}
+
+// BuildDeleteProjectPayload builds the payload for the project-service
+// delete-project endpoint from CLI flags.
+func BuildDeleteProjectPayload(projectServiceDeleteProjectUID string, projectServiceDeleteProjectVersion string, projectServiceDeleteProjectBearerToken string, projectServiceDeleteProjectXSync string, projectServiceDeleteProjectIfMatch string, projectServiceDeleteProjectForceUpdate string) (*projectservice.DeleteProjectPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceDeleteProjectUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceDeleteProjectVersion != "" {
+			version = &projectServiceDeleteProjectVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceDeleteProjectBearerToken != "" {
+			bearerToken = &projectServiceDeleteProjectBearerToken
+		}
+	}
+	var xSync *bool
+	{
+		if projectServiceDeleteProjectXSync != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceDeleteProjectXSync)
+			xSync = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	var ifMatch *string
+	{
+		if projectServiceDeleteProjectIfMatch != "" {
+			ifMatch = &projectServiceDeleteProjectIfMatch
+		}
+	}
+	var forceUpdate *bool
+	{
+		if projectServiceDeleteProjectForceUpdate != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceDeleteProjectForceUpdate)
+			forceUpdate = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for forceUpdate, must be BOOL")
+			}
+		}
+	}
+	v := &projectservice.DeleteProjectPayload{}
+	v.UID = &uid
+	v.Version = version
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+	v.IfMatch = ifMatch
+	v.ForceUpdate = forceUpdate
+
+	return v, nil
+}
+
+// BuildBulkDeleteProjectsPayload builds the payload for the project-service
+// bulk-delete-projects endpoint from CLI flags.
+func BuildBulkDeleteProjectsPayload(projectServiceBulkDeleteProjectsBody string, projectServiceBulkDeleteProjectsVersion string, projectServiceBulkDeleteProjectsDryRun string, projectServiceBulkDeleteProjectsCascade string, projectServiceBulkDeleteProjectsForce string, projectServiceBulkDeleteProjectsBearerToken string, projectServiceBulkDeleteProjectsXSync string) (*projectservice.BulkDeleteProjectsPayload, error) {
+	var err error
+	var body BulkDeleteProjectsRequestBody
+	{
+		err = json.Unmarshal([]byte(projectServiceBulkDeleteProjectsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"uids\": [\n         \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n      ]\n   }'")
+		}
+		if body.Uids == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("uids", "body"))
+		}
+		if len(body.Uids) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.uids", body.Uids, len(body.Uids), 1, true))
+		}
+		if len(body.Uids) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.uids", body.Uids, len(body.Uids), 100, false))
+		}
+		for _, e := range body.Uids {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.uids[*]", e, goa.FormatUUID))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var version *string
+	{
+		if projectServiceBulkDeleteProjectsVersion != "" {
+			version = &projectServiceBulkDeleteProjectsVersion
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var dryRun bool
+	{
+		if projectServiceBulkDeleteProjectsDryRun != "" {
+			dryRun, err = strconv.ParseBool(projectServiceBulkDeleteProjectsDryRun)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for dryRun, must be BOOL")
+			}
+		}
+	}
+	var cascade bool
+	{
+		if projectServiceBulkDeleteProjectsCascade != "" {
+			cascade, err = strconv.ParseBool(projectServiceBulkDeleteProjectsCascade)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for cascade, must be BOOL")
+			}
+		}
+	}
+	var force bool
+	{
+		if projectServiceBulkDeleteProjectsForce != "" {
+			force, err = strconv.ParseBool(projectServiceBulkDeleteProjectsForce)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for force, must be BOOL")
+			}
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceBulkDeleteProjectsBearerToken != "" {
+			bearerToken = &projectServiceBulkDeleteProjectsBearerToken
+		}
+	}
+	var xSync *bool
+	{
+		if projectServiceBulkDeleteProjectsXSync != "" {
+			var val bool
+			val, err = strconv.ParseBool(projectServiceBulkDeleteProjectsXSync)
+			xSync = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+			}
+		}
+	}
+	v := &projectservice.BulkDeleteProjectsPayload{}
+	if body.Uids != nil {
+		v.Uids = make([]string, len(body.Uids))
+		for i, val := range body.Uids {
+			v.Uids[i] = val
+		}
+	} else {
+		v.Uids = []string{}
+	}
+	v.Version = version
+	v.DryRun = dryRun
+	v.Cascade = cascade
+	v.Force = force
+	v.BearerToken = bearerToken
+	v.XSync = xSync
+
+	return v, nil
+}
+
+// BuildReconcileOutboxPayload builds the payload for the project-service
+// reconcile-outbox endpoint from CLI flags.
+func BuildReconcileOutboxPayload(projectServiceReconcileOutboxBearerToken string) (*projectservice.ReconcileOutboxPayload, error) {
+	var bearerToken *string
+	{
+		if projectServiceReconcileOutboxBearerToken != "" {
+			bearerToken = &projectServiceReconcileOutboxBearerToken
+		}
+	}
+	v := &projectservice.ReconcileOutboxPayload{}
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildResyncProjectsPayload builds the payload for the project-service
+// resync-projects endpoint from CLI flags.
+func BuildResyncProjectsPayload(projectServiceResyncProjectsBody string, projectServiceResyncProjectsBearerToken string) (*projectservice.ResyncProjectsPayload, error) {
+	var err error
+	var body ResyncProjectsRequestBody
+	{
+		err = json.Unmarshal([]byte(projectServiceResyncProjectsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"dry_run\": true,\n      \"stage\": \"Formation - Exploratory\",\n      \"uid_prefix\": \"7cad5a8d\",\n      \"updated_since\": \"2025-01-01T00:00:00Z\"\n   }'")
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceResyncProjectsBearerToken != "" {
+			bearerToken = &projectServiceResyncProjectsBearerToken
+		}
+	}
+	v := &projectservice.ResyncProjectsPayload{
+		UIDPrefix:    body.UIDPrefix,
+		UpdatedSince: body.UpdatedSince,
+		Stage:        body.Stage,
+		DryRun:       body.DryRun,
+	}
+	{
+		var zero bool
+		if v.DryRun == zero {
+			v.DryRun = false
+		}
+	}
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildListDeadLettersPayload builds the payload for the project-service
+// list-dead-letters endpoint from CLI flags.
+func BuildListDeadLettersPayload(projectServiceListDeadLettersBearerToken string) (*projectservice.ListDeadLettersPayload, error) {
+	var bearerToken *string
+	{
+		if projectServiceListDeadLettersBearerToken != "" {
+			bearerToken = &projectServiceListDeadLettersBearerToken
+		}
+	}
+	v := &projectservice.ListDeadLettersPayload{}
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildReplayDeadLetterPayload builds the payload for the project-service
+// replay-dead-letter endpoint from CLI flags.
+func BuildReplayDeadLetterPayload(projectServiceReplayDeadLetterID string, projectServiceReplayDeadLetterBearerToken string) (*projectservice.ReplayDeadLetterPayload, error) {
+	var err error
+	var id string
+	{
+		id = projectServiceReplayDeadLetterID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceReplayDeadLetterBearerToken != "" {
+			bearerToken = &projectServiceReplayDeadLetterBearerToken
+		}
+	}
+	v := &projectservice.ReplayDeadLetterPayload{}
+	v.ID = id
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildRebuildSlugMappingsPayload builds the payload for the project-service
+// rebuild-slug-mappings endpoint from CLI flags.
+func BuildRebuildSlugMappingsPayload(projectServiceRebuildSlugMappingsBody string, projectServiceRebuildSlugMappingsBearerToken string) (*projectservice.RebuildSlugMappingsPayload, error) {
+	var err error
+	var body RebuildSlugMappingsRequestBody
+	{
+		err = json.Unmarshal([]byte(projectServiceRebuildSlugMappingsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"remove_dangling\": true\n   }'")
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceRebuildSlugMappingsBearerToken != "" {
+			bearerToken = &projectServiceRebuildSlugMappingsBearerToken
+		}
+	}
+	v := &projectservice.RebuildSlugMappingsPayload{
+		RemoveDangling: body.RemoveDangling,
+	}
+	{
+		var zero bool
+		if v.RemoveDangling == zero {
+			v.RemoveDangling = false
+		}
+	}
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildGetIntegrityReportPayload builds the payload for the project-service
+// get-integrity-report endpoint from CLI flags.
+func BuildGetIntegrityReportPayload(projectServiceGetIntegrityReportBearerToken string) (*projectservice.GetIntegrityReportPayload, error) {
+	var bearerToken *string
+	{
+		if projectServiceGetIntegrityReportBearerToken != "" {
+			bearerToken = &projectServiceGetIntegrityReportBearerToken
+		}
+	}
+	v := &projectservice.GetIntegrityReportPayload{}
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildInspectProjectKvPayload builds the payload for the project-service
+// inspect-project-kv endpoint from CLI flags.
+func BuildInspectProjectKvPayload(projectServiceInspectProjectKvUID string, projectServiceInspectProjectKvBearerToken string) (*projectservice.InspectProjectKvPayload, error) {
+	var err error
+	var uid string
+	{
+		uid = projectServiceInspectProjectKvUID
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken *string
+	{
+		if projectServiceInspectProjectKvBearerToken != "" {
+			bearerToken = &projectServiceInspectProjectKvBearerToken
+		}
+	}
+	v := &projectservice.InspectProjectKvPayload{}
+	v.UID = uid
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildCreateBackupPayload builds the payload for the project-service
+// create-backup endpoint from CLI flags.
+func BuildCreateBackupPayload(projectServiceCreateBackupBearerToken string) (*projectservice.CreateBackupPayload, error) {
+	var bearerToken *string
+	{
+		if projectServiceCreateBackupBearerToken != "" {
+			bearerToken = &projectServiceCreateBackupBearerToken
+		}
+	}
+	v := &projectservice.CreateBackupPayload{}
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildListBackupsPayload builds the payload for the project-service
+// list-backups endpoint from CLI flags.
+func BuildListBackupsPayload(projectServiceListBackupsBearerToken string) (*projectservice.ListBackupsPayload, error) {
+	var bearerToken *string
+	{
+		if projectServiceListBackupsBearerToken != "" {
+			bearerToken = &projectServiceListBackupsBearerToken
+		}
+	}
+	v := &projectservice.ListBackupsPayload{}
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildRestoreBackupPayload builds the payload for the project-service
+// restore-backup endpoint from CLI flags.
+func BuildRestoreBackupPayload(projectServiceRestoreBackupBody string, projectServiceRestoreBackupName string, projectServiceRestoreBackupBearerToken string) (*projectservice.RestoreBackupPayload, error) {
+	var err error
+	var body RestoreBackupRequestBody
+	{
+		err = json.Unmarshal([]byte(projectServiceRestoreBackupBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"dry_run\": true,\n      \"project_uids\": [\n         \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\"\n      ]\n   }'")
+		}
+	}
+	var name string
+	{
+		name = projectServiceRestoreBackupName
+	}
+	var bearerToken *string
+	{
+		if projectServiceRestoreBackupBearerToken != "" {
+			bearerToken = &projectServiceRestoreBackupBearerToken
+		}
+	}
+	v := &projectservice.RestoreBackupPayload{
+		DryRun: body.DryRun,
 	}
-	if body.ProgramManager != nil {
-		v.ProgramManager = marshalUserInfoRequestBodyToProjectserviceUserInfo(body.ProgramManager)
+	if body.ProjectUids != nil {
+		v.ProjectUids = make([]string, len(body.ProjectUids))
+		for i, val := range body.ProjectUids {
+			v.ProjectUids[i] = val
+		}
 	}
-	if body.OpportunityOwner != nil {
-		v.OpportunityOwner = marshalUserInfoRequestBodyToProjectserviceUserInfo(body.OpportunityOwner)
+	{
+		var zero bool
+		if v.DryRun == zero {
+			v.DryRun = false
+		}
 	}
-	v.UID = &uid
-	v.Version = version
+	v.Name = name
 	v.BearerToken = bearerToken
-	v.XSync = xSync
-	v.IfMatch = ifMatch
 
 	return v, nil
 }
 
-// BuildDeleteProjectPayload builds the payload for the project-service
-// delete-project endpoint from CLI flags.
-func BuildDeleteProjectPayload(projectServiceDeleteProjectUID string, projectServiceDeleteProjectVersion string, projectServiceDeleteProjectBearerToken string, projectServiceDeleteProjectXSync string, projectServiceDeleteProjectIfMatch string) (*projectservice.DeleteProjectPayload, error) {
-	var err error
-	var uid string
+// BuildListPendingAnnouncementsPayload builds the payload for the
+// project-service list-pending-announcements endpoint from CLI flags.
+func BuildListPendingAnnouncementsPayload(projectServiceListPendingAnnouncementsBearerToken string) (*projectservice.ListPendingAnnouncementsPayload, error) {
+	var bearerToken *string
 	{
-		uid = projectServiceDeleteProjectUID
-		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
-		if err != nil {
-			return nil, err
+		if projectServiceListPendingAnnouncementsBearerToken != "" {
+			bearerToken = &projectServiceListPendingAnnouncementsBearerToken
 		}
 	}
+	v := &projectservice.ListPendingAnnouncementsPayload{}
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildGetProjectEnumsPayload builds the payload for the project-service
+// get-project-enums endpoint from CLI flags.
+func BuildGetProjectEnumsPayload(projectServiceGetProjectEnumsVersion string, projectServiceGetProjectEnumsBearerToken string) (*projectservice.GetProjectEnumsPayload, error) {
+	var err error
 	var version *string
 	{
-		if projectServiceDeleteProjectVersion != "" {
-			version = &projectServiceDeleteProjectVersion
+		if projectServiceGetProjectEnumsVersion != "" {
+			version = &projectServiceGetProjectEnumsVersion
 			if !(*version == "1") {
 				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
 			}
@@ -586,180 +3344,287 @@ func BuildDeleteProjectPayload(projectServiceDeleteProjectUID string, projectSer
 	}
 	var bearerToken *string
 	{
-		if projectServiceDeleteProjectBearerToken != "" {
-			bearerToken = &projectServiceDeleteProjectBearerToken
+		if projectServiceGetProjectEnumsBearerToken != "" {
+			bearerToken = &projectServiceGetProjectEnumsBearerToken
 		}
 	}
-	var xSync *bool
+	v := &projectservice.GetProjectEnumsPayload{}
+	v.Version = version
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildUpdateProjectEnumsPayload builds the payload for the project-service
+// update-project-enums endpoint from CLI flags.
+func BuildUpdateProjectEnumsPayload(projectServiceUpdateProjectEnumsBody string, projectServiceUpdateProjectEnumsBearerToken string) (*projectservice.UpdateProjectEnumsPayload, error) {
+	var err error
+	var body UpdateProjectEnumsRequestBody
 	{
-		if projectServiceDeleteProjectXSync != "" {
-			var val bool
-			val, err = strconv.ParseBool(projectServiceDeleteProjectXSync)
-			xSync = &val
-			if err != nil {
-				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
-			}
+		err = json.Unmarshal([]byte(projectServiceUpdateProjectEnumsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"categories\": [\n         \"Sandbox\",\n         \"Incubating\",\n         \"Graduated\"\n      ],\n      \"stages\": [\n         \"Formation - Exploratory\",\n         \"Active\",\n         \"Archived\"\n      ]\n   }'")
+		}
+		if body.Stages == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("stages", "body"))
+		}
+		if body.Categories == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("categories", "body"))
+		}
+		if len(body.Stages) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.stages", body.Stages, len(body.Stages), 1, true))
+		}
+		if len(body.Categories) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.categories", body.Categories, len(body.Categories), 1, true))
+		}
+		if err != nil {
+			return nil, err
 		}
 	}
-	var ifMatch *string
+	var bearerToken *string
 	{
-		if projectServiceDeleteProjectIfMatch != "" {
-			ifMatch = &projectServiceDeleteProjectIfMatch
+		if projectServiceUpdateProjectEnumsBearerToken != "" {
+			bearerToken = &projectServiceUpdateProjectEnumsBearerToken
 		}
 	}
-	v := &projectservice.DeleteProjectPayload{}
-	v.UID = &uid
-	v.Version = version
+	v := &projectservice.UpdateProjectEnumsPayload{}
+	if body.Stages != nil {
+		v.Stages = make([]string, len(body.Stages))
+		for i, val := range body.Stages {
+			v.Stages[i] = val
+		}
+	} else {
+		v.Stages = []string{}
+	}
+	if body.Categories != nil {
+		v.Categories = make([]string, len(body.Categories))
+		for i, val := range body.Categories {
+			v.Categories[i] = val
+		}
+	} else {
+		v.Categories = []string{}
+	}
 	v.BearerToken = bearerToken
-	v.XSync = xSync
-	v.IfMatch = ifMatch
 
 	return v, nil
 }
 
-// BuildCreateProjectLinkPayload builds the payload for the project-service
-// create-project-link endpoint from CLI flags.
-func BuildCreateProjectLinkPayload(projectServiceCreateProjectLinkBody string, projectServiceCreateProjectLinkUID string, projectServiceCreateProjectLinkVersion string, projectServiceCreateProjectLinkBearerToken string, projectServiceCreateProjectLinkXSync string) (*projectservice.CreateProjectLinkPayload, error) {
+// BuildCreateProjectTemplatePayload builds the payload for the project-service
+// create-project-template endpoint from CLI flags.
+func BuildCreateProjectTemplatePayload(projectServiceCreateProjectTemplateBody string, projectServiceCreateProjectTemplateBearerToken string) (*projectservice.CreateProjectTemplatePayload, error) {
 	var err error
-	var body CreateProjectLinkRequestBody
+	var body CreateProjectTemplateRequestBody
 	{
-		err = json.Unmarshal([]byte(projectServiceCreateProjectLinkBody), &body)
+		err = json.Unmarshal([]byte(projectServiceCreateProjectTemplateBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"description\": \"A description of the resource\",\n      \"folder_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"name\": \"My Resource\",\n      \"url\": \"https://example.com\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"auditors\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"category\": \"Active\",\n      \"description\": \"CNCF sandbox projects\",\n      \"funding_model\": [\n         \"Crowdfunding\"\n      ],\n      \"mission_statement\": \"The mission of the project is to build a sustainable ecosystem around open source projects to accelerate technology development and industry adoption.\",\n      \"name\": \"cncf-sandbox\",\n      \"stage\": \"Formation - Exploratory\"\n   }'")
 		}
-		if utf8.RuneCountInString(body.Name) < 1 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.name", body.Name, "^[a-z0-9]+(-[a-z0-9]+)*$"))
+		if utf8.RuneCountInString(body.Name) > 63 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 63, false))
 		}
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.url", body.URL, goa.FormatURI))
-		if body.FolderUID != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.folder_uid", *body.FolderUID, goa.FormatUUID))
+		if body.Description != nil {
+			if utf8.RuneCountInString(*body.Description) > 500 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.description", *body.Description, utf8.RuneCountInString(*body.Description), 500, false))
+			}
+		}
+		for _, e := range body.FundingModel {
+			if !(e == "Crowdfunding" || e == "Membership" || e == "Alternate Funding") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.funding_model[*]", e, []any{"Crowdfunding", "Membership", "Alternate Funding"}))
+			}
+		}
+		for _, e := range body.Auditors {
+			if e != nil {
+				if err2 := ValidateUserInfoRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
 		}
 		if err != nil {
 			return nil, err
 		}
 	}
-	var uid string
+	var bearerToken *string
 	{
-		uid = projectServiceCreateProjectLinkUID
-		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
-		if err != nil {
-			return nil, err
+		if projectServiceCreateProjectTemplateBearerToken != "" {
+			bearerToken = &projectServiceCreateProjectTemplateBearerToken
 		}
 	}
-	var version *string
-	{
-		if projectServiceCreateProjectLinkVersion != "" {
-			version = &projectServiceCreateProjectLinkVersion
-			if !(*version == "1") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
-			}
-			if err != nil {
-				return nil, err
-			}
+	v := &projectservice.CreateProjectTemplatePayload{
+		Name:             body.Name,
+		Description:      body.Description,
+		Stage:            body.Stage,
+		Category:         body.Category,
+		MissionStatement: body.MissionStatement,
+	}
+	if body.FundingModel != nil {
+		v.FundingModel = make([]string, len(body.FundingModel))
+		for i, val := range body.FundingModel {
+			v.FundingModel[i] = val
+		}
+	}
+	if body.Auditors != nil {
+		v.Auditors = make([]*projectservice.UserInfo, len(body.Auditors))
+		for i, val := range body.Auditors {
+			v.Auditors[i] = marshalUserInfoRequestBodyToProjectserviceUserInfo(val)
 		}
 	}
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildListProjectTemplatesPayload builds the payload for the project-service
+// list-project-templates endpoint from CLI flags.
+func BuildListProjectTemplatesPayload(projectServiceListProjectTemplatesBearerToken string) (*projectservice.ListProjectTemplatesPayload, error) {
 	var bearerToken *string
 	{
-		if projectServiceCreateProjectLinkBearerToken != "" {
-			bearerToken = &projectServiceCreateProjectLinkBearerToken
+		if projectServiceListProjectTemplatesBearerToken != "" {
+			bearerToken = &projectServiceListProjectTemplatesBearerToken
 		}
 	}
-	var xSync *bool
+	v := &projectservice.ListProjectTemplatesPayload{}
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildGetProjectTemplatePayload builds the payload for the project-service
+// get-project-template endpoint from CLI flags.
+func BuildGetProjectTemplatePayload(projectServiceGetProjectTemplateName string, projectServiceGetProjectTemplateBearerToken string) (*projectservice.GetProjectTemplatePayload, error) {
+	var err error
+	var name string
 	{
-		if projectServiceCreateProjectLinkXSync != "" {
-			var val bool
-			val, err = strconv.ParseBool(projectServiceCreateProjectLinkXSync)
-			xSync = &val
-			if err != nil {
-				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
-			}
+		name = projectServiceGetProjectTemplateName
+		err = goa.MergeErrors(err, goa.ValidatePattern("name", name, "^[a-z0-9]+(-[a-z0-9]+)*$"))
+		if utf8.RuneCountInString(name) > 63 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("name", name, utf8.RuneCountInString(name), 63, false))
+		}
+		if err != nil {
+			return nil, err
 		}
 	}
-	v := &projectservice.CreateProjectLinkPayload{
-		Name:        body.Name,
-		URL:         body.URL,
-		Description: body.Description,
-		FolderUID:   body.FolderUID,
+	var bearerToken *string
+	{
+		if projectServiceGetProjectTemplateBearerToken != "" {
+			bearerToken = &projectServiceGetProjectTemplateBearerToken
+		}
 	}
-	v.UID = uid
-	v.Version = version
+	v := &projectservice.GetProjectTemplatePayload{}
+	v.Name = name
 	v.BearerToken = bearerToken
-	v.XSync = xSync
 
 	return v, nil
 }
 
-// BuildGetProjectLinkPayload builds the payload for the project-service
-// get-project-link endpoint from CLI flags.
-func BuildGetProjectLinkPayload(projectServiceGetProjectLinkUID string, projectServiceGetProjectLinkLinkUID string, projectServiceGetProjectLinkVersion string, projectServiceGetProjectLinkBearerToken string) (*projectservice.GetProjectLinkPayload, error) {
+// BuildUpdateProjectTemplatePayload builds the payload for the project-service
+// update-project-template endpoint from CLI flags.
+func BuildUpdateProjectTemplatePayload(projectServiceUpdateProjectTemplateBody string, projectServiceUpdateProjectTemplateName string, projectServiceUpdateProjectTemplateBearerToken string) (*projectservice.UpdateProjectTemplatePayload, error) {
 	var err error
-	var uid string
+	var body UpdateProjectTemplateRequestBody
 	{
-		uid = projectServiceGetProjectLinkUID
-		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		err = json.Unmarshal([]byte(projectServiceUpdateProjectTemplateBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"auditors\": [\n         {\n            \"avatar\": \"https://example.com/avatar1.jpg\",\n            \"email\": \"john.doe@example.com\",\n            \"name\": \"John Doe\",\n            \"username\": \"johndoe123\"\n         },\n         {\n            \"avatar\": \"https://example.com/avatar2.jpg\",\n            \"email\": \"jane.smith@example.com\",\n            \"name\": \"Jane Smith\",\n            \"username\": \"janesmith456\"\n         }\n      ],\n      \"category\": \"Active\",\n      \"description\": \"CNCF sandbox projects\",\n      \"funding_model\": [\n         \"Crowdfunding\"\n      ],\n      \"mission_statement\": \"The mission of the project is to build a sustainable ecosystem around open source projects to accelerate technology development and industry adoption.\",\n      \"stage\": \"Formation - Exploratory\"\n   }'")
+		}
+		if body.Description != nil {
+			if utf8.RuneCountInString(*body.Description) > 500 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.description", *body.Description, utf8.RuneCountInString(*body.Description), 500, false))
+			}
+		}
+		for _, e := range body.FundingModel {
+			if !(e == "Crowdfunding" || e == "Membership" || e == "Alternate Funding") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.funding_model[*]", e, []any{"Crowdfunding", "Membership", "Alternate Funding"}))
+			}
+		}
+		for _, e := range body.Auditors {
+			if e != nil {
+				if err2 := ValidateUserInfoRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
 	}
-	var linkUID string
+	var name string
 	{
-		linkUID = projectServiceGetProjectLinkLinkUID
-		err = goa.MergeErrors(err, goa.ValidateFormat("link_uid", linkUID, goa.FormatUUID))
+		name = projectServiceUpdateProjectTemplateName
+		err = goa.MergeErrors(err, goa.ValidatePattern("name", name, "^[a-z0-9]+(-[a-z0-9]+)*$"))
+		if utf8.RuneCountInString(name) > 63 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("name", name, utf8.RuneCountInString(name), 63, false))
+		}
 		if err != nil {
 			return nil, err
 		}
 	}
-	var version *string
+	var bearerToken *string
 	{
-		if projectServiceGetProjectLinkVersion != "" {
-			version = &projectServiceGetProjectLinkVersion
-			if !(*version == "1") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
-			}
-			if err != nil {
-				return nil, err
-			}
+		if projectServiceUpdateProjectTemplateBearerToken != "" {
+			bearerToken = &projectServiceUpdateProjectTemplateBearerToken
 		}
 	}
-	var bearerToken *string
-	{
-		if projectServiceGetProjectLinkBearerToken != "" {
-			bearerToken = &projectServiceGetProjectLinkBearerToken
+	v := &projectservice.UpdateProjectTemplatePayload{
+		Description:      body.Description,
+		Stage:            body.Stage,
+		Category:         body.Category,
+		MissionStatement: body.MissionStatement,
+	}
+	if body.FundingModel != nil {
+		v.FundingModel = make([]string, len(body.FundingModel))
+		for i, val := range body.FundingModel {
+			v.FundingModel[i] = val
 		}
 	}
-	v := &projectservice.GetProjectLinkPayload{}
-	v.UID = uid
-	v.LinkUID = linkUID
-	v.Version = version
+	if body.Auditors != nil {
+		v.Auditors = make([]*projectservice.UserInfo, len(body.Auditors))
+		for i, val := range body.Auditors {
+			v.Auditors[i] = marshalUserInfoRequestBodyToProjectserviceUserInfo(val)
+		}
+	}
+	v.Name = name
 	v.BearerToken = bearerToken
 
 	return v, nil
 }
 
-// BuildDeleteProjectLinkPayload builds the payload for the project-service
-// delete-project-link endpoint from CLI flags.
-func BuildDeleteProjectLinkPayload(projectServiceDeleteProjectLinkUID string, projectServiceDeleteProjectLinkLinkUID string, projectServiceDeleteProjectLinkVersion string, projectServiceDeleteProjectLinkBearerToken string, projectServiceDeleteProjectLinkXSync string, projectServiceDeleteProjectLinkIfMatch string) (*projectservice.DeleteProjectLinkPayload, error) {
+// BuildDeleteProjectTemplatePayload builds the payload for the project-service
+// delete-project-template endpoint from CLI flags.
+func BuildDeleteProjectTemplatePayload(projectServiceDeleteProjectTemplateName string, projectServiceDeleteProjectTemplateBearerToken string) (*projectservice.DeleteProjectTemplatePayload, error) {
 	var err error
-	var uid string
+	var name string
 	{
-		uid = projectServiceDeleteProjectLinkUID
-		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		name = projectServiceDeleteProjectTemplateName
+		err = goa.MergeErrors(err, goa.ValidatePattern("name", name, "^[a-z0-9]+(-[a-z0-9]+)*$"))
+		if utf8.RuneCountInString(name) > 63 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("name", name, utf8.RuneCountInString(name), 63, false))
+		}
 		if err != nil {
 			return nil, err
 		}
 	}
-	var linkUID string
+	var bearerToken *string
 	{
-		linkUID = projectServiceDeleteProjectLinkLinkUID
-		err = goa.MergeErrors(err, goa.ValidateFormat("link_uid", linkUID, goa.FormatUUID))
-		if err != nil {
-			return nil, err
+		if projectServiceDeleteProjectTemplateBearerToken != "" {
+			bearerToken = &projectServiceDeleteProjectTemplateBearerToken
 		}
 	}
+	v := &projectservice.DeleteProjectTemplatePayload{}
+	v.Name = name
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildListProjectDeletionsPayload builds the payload for the project-service
+// list-project-deletions endpoint from CLI flags.
+func BuildListProjectDeletionsPayload(projectServiceListProjectDeletionsVersion string, projectServiceListProjectDeletionsSince string, projectServiceListProjectDeletionsBearerToken string) (*projectservice.ListProjectDeletionsPayload, error) {
+	var err error
 	var version *string
 	{
-		if projectServiceDeleteProjectLinkVersion != "" {
-			version = &projectServiceDeleteProjectLinkVersion
+		if projectServiceListProjectDeletionsVersion != "" {
+			version = &projectServiceListProjectDeletionsVersion
 			if !(*version == "1") {
 				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
 			}
@@ -768,60 +3633,37 @@ func BuildDeleteProjectLinkPayload(projectServiceDeleteProjectLinkUID string, pr
 			}
 		}
 	}
-	var bearerToken *string
-	{
-		if projectServiceDeleteProjectLinkBearerToken != "" {
-			bearerToken = &projectServiceDeleteProjectLinkBearerToken
-		}
-	}
-	var xSync *bool
+	var since *string
 	{
-		if projectServiceDeleteProjectLinkXSync != "" {
-			var val bool
-			val, err = strconv.ParseBool(projectServiceDeleteProjectLinkXSync)
-			xSync = &val
+		if projectServiceListProjectDeletionsSince != "" {
+			since = &projectServiceListProjectDeletionsSince
+			err = goa.MergeErrors(err, goa.ValidateFormat("since", *since, goa.FormatDateTime))
 			if err != nil {
-				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+				return nil, err
 			}
 		}
 	}
-	var ifMatch *string
+	var bearerToken *string
 	{
-		if projectServiceDeleteProjectLinkIfMatch != "" {
-			ifMatch = &projectServiceDeleteProjectLinkIfMatch
+		if projectServiceListProjectDeletionsBearerToken != "" {
+			bearerToken = &projectServiceListProjectDeletionsBearerToken
 		}
 	}
-	v := &projectservice.DeleteProjectLinkPayload{}
-	v.UID = uid
-	v.LinkUID = linkUID
+	v := &projectservice.ListProjectDeletionsPayload{}
 	v.Version = version
+	v.Since = since
 	v.BearerToken = bearerToken
-	v.XSync = xSync
-	v.IfMatch = ifMatch
 
 	return v, nil
 }
 
-// BuildCreateProjectFolderPayload builds the payload for the project-service
-// create-project-folder endpoint from CLI flags.
-func BuildCreateProjectFolderPayload(projectServiceCreateProjectFolderBody string, projectServiceCreateProjectFolderUID string, projectServiceCreateProjectFolderVersion string, projectServiceCreateProjectFolderBearerToken string, projectServiceCreateProjectFolderXSync string) (*projectservice.CreateProjectFolderPayload, error) {
+// BuildListProjectEventsPayload builds the payload for the project-service
+// list-project-events endpoint from CLI flags.
+func BuildListProjectEventsPayload(projectServiceListProjectEventsUID string, projectServiceListProjectEventsVersion string, projectServiceListProjectEventsSince string, projectServiceListProjectEventsBearerToken string) (*projectservice.ListProjectEventsPayload, error) {
 	var err error
-	var body CreateProjectFolderRequestBody
-	{
-		err = json.Unmarshal([]byte(projectServiceCreateProjectFolderBody), &body)
-		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"name\": \"My Resource\"\n   }'")
-		}
-		if utf8.RuneCountInString(body.Name) < 1 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
-		}
-		if err != nil {
-			return nil, err
-		}
-	}
 	var uid string
 	{
-		uid = projectServiceCreateProjectFolderUID
+		uid = projectServiceListProjectEventsUID
 		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
 		if err != nil {
 			return nil, err
@@ -829,8 +3671,8 @@ func BuildCreateProjectFolderPayload(projectServiceCreateProjectFolderBody strin
 	}
 	var version *string
 	{
-		if projectServiceCreateProjectFolderVersion != "" {
-			version = &projectServiceCreateProjectFolderVersion
+		if projectServiceListProjectEventsVersion != "" {
+			version = &projectServiceListProjectEventsVersion
 			if !(*version == "1") {
 				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
 			}
@@ -839,58 +3681,52 @@ func BuildCreateProjectFolderPayload(projectServiceCreateProjectFolderBody strin
 			}
 		}
 	}
-	var bearerToken *string
-	{
-		if projectServiceCreateProjectFolderBearerToken != "" {
-			bearerToken = &projectServiceCreateProjectFolderBearerToken
-		}
-	}
-	var xSync *bool
+	var since *string
 	{
-		if projectServiceCreateProjectFolderXSync != "" {
-			var val bool
-			val, err = strconv.ParseBool(projectServiceCreateProjectFolderXSync)
-			xSync = &val
+		if projectServiceListProjectEventsSince != "" {
+			since = &projectServiceListProjectEventsSince
+			err = goa.MergeErrors(err, goa.ValidateFormat("since", *since, goa.FormatDateTime))
 			if err != nil {
-				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
+				return nil, err
 			}
 		}
 	}
-	v := &projectservice.CreateProjectFolderPayload{
-		Name: body.Name,
+	var bearerToken *string
+	{
+		if projectServiceListProjectEventsBearerToken != "" {
+			bearerToken = &projectServiceListProjectEventsBearerToken
+		}
 	}
+	v := &projectservice.ListProjectEventsPayload{}
 	v.UID = uid
 	v.Version = version
+	v.Since = since
 	v.BearerToken = bearerToken
-	v.XSync = xSync
 
 	return v, nil
 }
 
-// BuildGetProjectFolderPayload builds the payload for the project-service
-// get-project-folder endpoint from CLI flags.
-func BuildGetProjectFolderPayload(projectServiceGetProjectFolderUID string, projectServiceGetProjectFolderFolderUID string, projectServiceGetProjectFolderVersion string, projectServiceGetProjectFolderBearerToken string) (*projectservice.GetProjectFolderPayload, error) {
+// BuildGetUserProjectsPayload builds the payload for the project-service
+// get-user-projects endpoint from CLI flags.
+func BuildGetUserProjectsPayload(projectServiceGetUserProjectsUsername string, projectServiceGetUserProjectsVersion string, projectServiceGetUserProjectsBearerToken string) (*projectservice.GetUserProjectsPayload, error) {
 	var err error
-	var uid string
+	var username string
 	{
-		uid = projectServiceGetProjectFolderUID
-		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
-		if err != nil {
-			return nil, err
+		username = projectServiceGetUserProjectsUsername
+		if utf8.RuneCountInString(username) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 1, true))
+		}
+		if utf8.RuneCountInString(username) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 100, false))
 		}
-	}
-	var folderUID string
-	{
-		folderUID = projectServiceGetProjectFolderFolderUID
-		err = goa.MergeErrors(err, goa.ValidateFormat("folder_uid", folderUID, goa.FormatUUID))
 		if err != nil {
 			return nil, err
 		}
 	}
 	var version *string
 	{
-		if projectServiceGetProjectFolderVersion != "" {
-			version = &projectServiceGetProjectFolderVersion
+		if projectServiceGetUserProjectsVersion != "" {
+			version = &projectServiceGetUserProjectsVersion
 			if !(*version == "1") {
 				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
 			}
@@ -901,43 +3737,39 @@ func BuildGetProjectFolderPayload(projectServiceGetProjectFolderUID string, proj
 	}
 	var bearerToken *string
 	{
-		if projectServiceGetProjectFolderBearerToken != "" {
-			bearerToken = &projectServiceGetProjectFolderBearerToken
+		if projectServiceGetUserProjectsBearerToken != "" {
+			bearerToken = &projectServiceGetUserProjectsBearerToken
 		}
 	}
-	v := &projectservice.GetProjectFolderPayload{}
-	v.UID = uid
-	v.FolderUID = folderUID
+	v := &projectservice.GetUserProjectsPayload{}
+	v.Username = username
 	v.Version = version
 	v.BearerToken = bearerToken
 
 	return v, nil
 }
 
-// BuildDeleteProjectFolderPayload builds the payload for the project-service
-// delete-project-folder endpoint from CLI flags.
-func BuildDeleteProjectFolderPayload(projectServiceDeleteProjectFolderUID string, projectServiceDeleteProjectFolderFolderUID string, projectServiceDeleteProjectFolderVersion string, projectServiceDeleteProjectFolderBearerToken string, projectServiceDeleteProjectFolderXSync string, projectServiceDeleteProjectFolderIfMatch string) (*projectservice.DeleteProjectFolderPayload, error) {
+// BuildExportUserDataPayload builds the payload for the project-service
+// export-user-data endpoint from CLI flags.
+func BuildExportUserDataPayload(projectServiceExportUserDataUsername string, projectServiceExportUserDataVersion string, projectServiceExportUserDataBearerToken string) (*projectservice.ExportUserDataPayload, error) {
 	var err error
-	var uid string
+	var username string
 	{
-		uid = projectServiceDeleteProjectFolderUID
-		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
-		if err != nil {
-			return nil, err
+		username = projectServiceExportUserDataUsername
+		if utf8.RuneCountInString(username) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 1, true))
+		}
+		if utf8.RuneCountInString(username) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 100, false))
 		}
-	}
-	var folderUID string
-	{
-		folderUID = projectServiceDeleteProjectFolderFolderUID
-		err = goa.MergeErrors(err, goa.ValidateFormat("folder_uid", folderUID, goa.FormatUUID))
 		if err != nil {
 			return nil, err
 		}
 	}
 	var version *string
 	{
-		if projectServiceDeleteProjectFolderVersion != "" {
-			version = &projectServiceDeleteProjectFolderVersion
+		if projectServiceExportUserDataVersion != "" {
+			version = &projectServiceExportUserDataVersion
 			if !(*version == "1") {
 				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
 			}
@@ -948,73 +3780,39 @@ func BuildDeleteProjectFolderPayload(projectServiceDeleteProjectFolderUID string
 	}
 	var bearerToken *string
 	{
-		if projectServiceDeleteProjectFolderBearerToken != "" {
-			bearerToken = &projectServiceDeleteProjectFolderBearerToken
-		}
-	}
-	var xSync *bool
-	{
-		if projectServiceDeleteProjectFolderXSync != "" {
-			var val bool
-			val, err = strconv.ParseBool(projectServiceDeleteProjectFolderXSync)
-			xSync = &val
-			if err != nil {
-				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
-			}
-		}
-	}
-	var ifMatch *string
-	{
-		if projectServiceDeleteProjectFolderIfMatch != "" {
-			ifMatch = &projectServiceDeleteProjectFolderIfMatch
+		if projectServiceExportUserDataBearerToken != "" {
+			bearerToken = &projectServiceExportUserDataBearerToken
 		}
 	}
-	v := &projectservice.DeleteProjectFolderPayload{}
-	v.UID = uid
-	v.FolderUID = folderUID
+	v := &projectservice.ExportUserDataPayload{}
+	v.Username = username
 	v.Version = version
 	v.BearerToken = bearerToken
-	v.XSync = xSync
-	v.IfMatch = ifMatch
 
 	return v, nil
 }
 
-// BuildUploadProjectDocumentPayload builds the payload for the project-service
-// upload-project-document endpoint from CLI flags.
-func BuildUploadProjectDocumentPayload(projectServiceUploadProjectDocumentBody string, projectServiceUploadProjectDocumentUID string, projectServiceUploadProjectDocumentVersion string, projectServiceUploadProjectDocumentBearerToken string, projectServiceUploadProjectDocumentXSync string) (*projectservice.UploadProjectDocumentPayload, error) {
+// BuildRemoveUserAccessPayload builds the payload for the project-service
+// remove-user-access endpoint from CLI flags.
+func BuildRemoveUserAccessPayload(projectServiceRemoveUserAccessUsername string, projectServiceRemoveUserAccessVersion string, projectServiceRemoveUserAccessBearerToken string, projectServiceRemoveUserAccessXSync string) (*projectservice.RemoveUserAccessPayload, error) {
 	var err error
-	var body UploadProjectDocumentRequestBody
+	var username string
 	{
-		err = json.Unmarshal([]byte(projectServiceUploadProjectDocumentBody), &body)
-		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"content_type\": \"application/pdf\",\n      \"description\": \"A description of the resource\",\n      \"file\": \"Li4u\",\n      \"file_name\": \"report.pdf\",\n      \"folder_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"name\": \"My Resource\"\n   }'")
-		}
-		if body.File == nil {
-			err = goa.MergeErrors(err, goa.MissingFieldError("file", "body"))
-		}
-		if utf8.RuneCountInString(body.Name) < 1 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
-		}
-		if body.FolderUID != nil {
-			err = goa.MergeErrors(err, goa.ValidateFormat("body.folder_uid", *body.FolderUID, goa.FormatUUID))
+		username = projectServiceRemoveUserAccessUsername
+		if utf8.RuneCountInString(username) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 1, true))
 		}
-		if err != nil {
-			return nil, err
+		if utf8.RuneCountInString(username) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 100, false))
 		}
-	}
-	var uid string
-	{
-		uid = projectServiceUploadProjectDocumentUID
-		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
 		if err != nil {
 			return nil, err
 		}
 	}
 	var version *string
 	{
-		if projectServiceUploadProjectDocumentVersion != "" {
-			version = &projectServiceUploadProjectDocumentVersion
+		if projectServiceRemoveUserAccessVersion != "" {
+			version = &projectServiceRemoveUserAccessVersion
 			if !(*version == "1") {
 				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
 			}
@@ -1025,30 +3823,23 @@ func BuildUploadProjectDocumentPayload(projectServiceUploadProjectDocumentBody s
 	}
 	var bearerToken *string
 	{
-		if projectServiceUploadProjectDocumentBearerToken != "" {
-			bearerToken = &projectServiceUploadProjectDocumentBearerToken
+		if projectServiceRemoveUserAccessBearerToken != "" {
+			bearerToken = &projectServiceRemoveUserAccessBearerToken
 		}
 	}
 	var xSync *bool
 	{
-		if projectServiceUploadProjectDocumentXSync != "" {
+		if projectServiceRemoveUserAccessXSync != "" {
 			var val bool
-			val, err = strconv.ParseBool(projectServiceUploadProjectDocumentXSync)
+			val, err = strconv.ParseBool(projectServiceRemoveUserAccessXSync)
 			xSync = &val
 			if err != nil {
 				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
 			}
 		}
 	}
-	v := &projectservice.UploadProjectDocumentPayload{
-		Name:        body.Name,
-		Description: body.Description,
-		FolderUID:   body.FolderUID,
-		File:        body.File,
-		FileName:    body.FileName,
-		ContentType: body.ContentType,
-	}
-	v.UID = uid
+	v := &projectservice.RemoveUserAccessPayload{}
+	v.Username = username
 	v.Version = version
 	v.BearerToken = bearerToken
 	v.XSync = xSync
@@ -1056,30 +3847,22 @@ func BuildUploadProjectDocumentPayload(projectServiceUploadProjectDocumentBody s
 	return v, nil
 }
 
-// BuildGetProjectDocumentPayload builds the payload for the project-service
-// get-project-document endpoint from CLI flags.
-func BuildGetProjectDocumentPayload(projectServiceGetProjectDocumentUID string, projectServiceGetProjectDocumentDocumentUID string, projectServiceGetProjectDocumentVersion string, projectServiceGetProjectDocumentBearerToken string) (*projectservice.GetProjectDocumentPayload, error) {
+// BuildGetProjectVisibilityImpactPayload builds the payload for the
+// project-service get-project-visibility-impact endpoint from CLI flags.
+func BuildGetProjectVisibilityImpactPayload(projectServiceGetProjectVisibilityImpactUID string, projectServiceGetProjectVisibilityImpactVersion string, projectServiceGetProjectVisibilityImpactBearerToken string) (*projectservice.GetProjectVisibilityImpactPayload, error) {
 	var err error
 	var uid string
 	{
-		uid = projectServiceGetProjectDocumentUID
+		uid = projectServiceGetProjectVisibilityImpactUID
 		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
 		if err != nil {
 			return nil, err
 		}
 	}
-	var documentUID string
-	{
-		documentUID = projectServiceGetProjectDocumentDocumentUID
-		err = goa.MergeErrors(err, goa.ValidateFormat("document_uid", documentUID, goa.FormatUUID))
-		if err != nil {
-			return nil, err
-		}
-	}
 	var version *string
 	{
-		if projectServiceGetProjectDocumentVersion != "" {
-			version = &projectServiceGetProjectDocumentVersion
+		if projectServiceGetProjectVisibilityImpactVersion != "" {
+			version = &projectServiceGetProjectVisibilityImpactVersion
 			if !(*version == "1") {
 				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
 			}
@@ -1090,128 +3873,154 @@ func BuildGetProjectDocumentPayload(projectServiceGetProjectDocumentUID string,
 	}
 	var bearerToken *string
 	{
-		if projectServiceGetProjectDocumentBearerToken != "" {
-			bearerToken = &projectServiceGetProjectDocumentBearerToken
+		if projectServiceGetProjectVisibilityImpactBearerToken != "" {
+			bearerToken = &projectServiceGetProjectVisibilityImpactBearerToken
 		}
 	}
-	v := &projectservice.GetProjectDocumentPayload{}
+	v := &projectservice.GetProjectVisibilityImpactPayload{}
 	v.UID = uid
-	v.DocumentUID = documentUID
 	v.Version = version
 	v.BearerToken = bearerToken
 
 	return v, nil
 }
 
-// BuildDownloadProjectDocumentPayload builds the payload for the
-// project-service download-project-document endpoint from CLI flags.
-func BuildDownloadProjectDocumentPayload(projectServiceDownloadProjectDocumentUID string, projectServiceDownloadProjectDocumentDocumentUID string, projectServiceDownloadProjectDocumentVersion string, projectServiceDownloadProjectDocumentBearerToken string) (*projectservice.DownloadProjectDocumentPayload, error) {
+// BuildCreateWebhookPayload builds the payload for the project-service
+// create-webhook endpoint from CLI flags.
+func BuildCreateWebhookPayload(projectServiceCreateWebhookBody string, projectServiceCreateWebhookBearerToken string) (*projectservice.CreateWebhookPayload, error) {
 	var err error
-	var uid string
+	var body CreateWebhookRequestBody
 	{
-		uid = projectServiceDownloadProjectDocumentUID
-		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		err = json.Unmarshal([]byte(projectServiceCreateWebhookBody), &body)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"description\": \"Sync project changes to the foundation CRM\",\n      \"events\": [\n         \"project.created\",\n         \"project.deleted\"\n      ],\n      \"project_uid\": \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\",\n      \"url\": \"https://hooks.example.org/lfx/projects\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.url", body.URL, goa.FormatURI))
+		if body.ProjectUID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.project_uid", *body.ProjectUID, goa.FormatUUID))
+		}
+		for _, e := range body.Events {
+			if !(e == "project.created" || e == "project.updated" || e == "project.deleted" || e == "project.settings.updated" || e == "project.announced" || e == "project.legal_changed" || e == "project.merged" || e == "project.transferred") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.events[*]", e, []any{"project.created", "project.updated", "project.deleted", "project.settings.updated", "project.announced", "project.legal_changed", "project.merged", "project.transferred"}))
+			}
+		}
+		if body.Description != nil {
+			if utf8.RuneCountInString(*body.Description) > 500 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.description", *body.Description, utf8.RuneCountInString(*body.Description), 500, false))
+			}
 		}
-	}
-	var documentUID string
-	{
-		documentUID = projectServiceDownloadProjectDocumentDocumentUID
-		err = goa.MergeErrors(err, goa.ValidateFormat("document_uid", documentUID, goa.FormatUUID))
 		if err != nil {
 			return nil, err
 		}
 	}
-	var version *string
+	var bearerToken *string
 	{
-		if projectServiceDownloadProjectDocumentVersion != "" {
-			version = &projectServiceDownloadProjectDocumentVersion
-			if !(*version == "1") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
-			}
-			if err != nil {
-				return nil, err
-			}
+		if projectServiceCreateWebhookBearerToken != "" {
+			bearerToken = &projectServiceCreateWebhookBearerToken
 		}
 	}
+	v := &projectservice.CreateWebhookPayload{
+		URL:         body.URL,
+		ProjectUID:  body.ProjectUID,
+		Description: body.Description,
+	}
+	if body.Events != nil {
+		v.Events = make([]string, len(body.Events))
+		for i, val := range body.Events {
+			v.Events[i] = val
+		}
+	}
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildListWebhooksPayload builds the payload for the project-service
+// list-webhooks endpoint from CLI flags.
+func BuildListWebhooksPayload(projectServiceListWebhooksBearerToken string) (*projectservice.ListWebhooksPayload, error) {
 	var bearerToken *string
 	{
-		if projectServiceDownloadProjectDocumentBearerToken != "" {
-			bearerToken = &projectServiceDownloadProjectDocumentBearerToken
+		if projectServiceListWebhooksBearerToken != "" {
+			bearerToken = &projectServiceListWebhooksBearerToken
 		}
 	}
-	v := &projectservice.DownloadProjectDocumentPayload{}
-	v.UID = uid
-	v.DocumentUID = documentUID
-	v.Version = version
+	v := &projectservice.ListWebhooksPayload{}
 	v.BearerToken = bearerToken
 
 	return v, nil
 }
 
-// BuildDeleteProjectDocumentPayload builds the payload for the project-service
-// delete-project-document endpoint from CLI flags.
-func BuildDeleteProjectDocumentPayload(projectServiceDeleteProjectDocumentUID string, projectServiceDeleteProjectDocumentDocumentUID string, projectServiceDeleteProjectDocumentVersion string, projectServiceDeleteProjectDocumentBearerToken string, projectServiceDeleteProjectDocumentXSync string, projectServiceDeleteProjectDocumentIfMatch string) (*projectservice.DeleteProjectDocumentPayload, error) {
+// BuildGetWebhookPayload builds the payload for the project-service
+// get-webhook endpoint from CLI flags.
+func BuildGetWebhookPayload(projectServiceGetWebhookID string, projectServiceGetWebhookBearerToken string) (*projectservice.GetWebhookPayload, error) {
 	var err error
-	var uid string
+	var id string
 	{
-		uid = projectServiceDeleteProjectDocumentUID
-		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		id = projectServiceGetWebhookID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
 		if err != nil {
 			return nil, err
 		}
 	}
-	var documentUID string
+	var bearerToken *string
 	{
-		documentUID = projectServiceDeleteProjectDocumentDocumentUID
-		err = goa.MergeErrors(err, goa.ValidateFormat("document_uid", documentUID, goa.FormatUUID))
-		if err != nil {
-			return nil, err
+		if projectServiceGetWebhookBearerToken != "" {
+			bearerToken = &projectServiceGetWebhookBearerToken
 		}
 	}
-	var version *string
+	v := &projectservice.GetWebhookPayload{}
+	v.ID = id
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildDeleteWebhookPayload builds the payload for the project-service
+// delete-webhook endpoint from CLI flags.
+func BuildDeleteWebhookPayload(projectServiceDeleteWebhookID string, projectServiceDeleteWebhookBearerToken string) (*projectservice.DeleteWebhookPayload, error) {
+	var err error
+	var id string
 	{
-		if projectServiceDeleteProjectDocumentVersion != "" {
-			version = &projectServiceDeleteProjectDocumentVersion
-			if !(*version == "1") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
-			}
-			if err != nil {
-				return nil, err
-			}
+		id = projectServiceDeleteWebhookID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
 		}
 	}
 	var bearerToken *string
 	{
-		if projectServiceDeleteProjectDocumentBearerToken != "" {
-			bearerToken = &projectServiceDeleteProjectDocumentBearerToken
+		if projectServiceDeleteWebhookBearerToken != "" {
+			bearerToken = &projectServiceDeleteWebhookBearerToken
 		}
 	}
-	var xSync *bool
+	v := &projectservice.DeleteWebhookPayload{}
+	v.ID = id
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildListWebhookDeliveriesPayload builds the payload for the project-service
+// list-webhook-deliveries endpoint from CLI flags.
+func BuildListWebhookDeliveriesPayload(projectServiceListWebhookDeliveriesID string, projectServiceListWebhookDeliveriesBearerToken string) (*projectservice.ListWebhookDeliveriesPayload, error) {
+	var err error
+	var id string
 	{
-		if projectServiceDeleteProjectDocumentXSync != "" {
-			var val bool
-			val, err = strconv.ParseBool(projectServiceDeleteProjectDocumentXSync)
-			xSync = &val
-			if err != nil {
-				return nil, fmt.Errorf("invalid value for xSync, must be BOOL")
-			}
+		id = projectServiceListWebhookDeliveriesID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
 		}
 	}
-	var ifMatch *string
+	var bearerToken *string
 	{
-		if projectServiceDeleteProjectDocumentIfMatch != "" {
-			ifMatch = &projectServiceDeleteProjectDocumentIfMatch
+		if projectServiceListWebhookDeliveriesBearerToken != "" {
+			bearerToken = &projectServiceListWebhookDeliveriesBearerToken
 		}
 	}
-	v := &projectservice.DeleteProjectDocumentPayload{}
-	v.UID = uid
-	v.DocumentUID = documentUID
-	v.Version = version
+	v := &projectservice.ListWebhookDeliveriesPayload{}
+	v.ID = id
 	v.BearerToken = bearerToken
-	v.XSync = xSync
-	v.IfMatch = ifMatch
 
 	return v, nil
 }
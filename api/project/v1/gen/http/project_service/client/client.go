@@ -21,39 +21,21 @@ import (
 
 // Client lists the project-service service endpoint HTTP clients.
 type Client struct {
-	// GetProjects Doer is the HTTP client used to make requests to the
-	// get-projects endpoint.
-	GetProjectsDoer goahttp.Doer
-
-	// CreateProject Doer is the HTTP client used to make requests to the
-	// create-project endpoint.
-	CreateProjectDoer goahttp.Doer
-
-	// GetOneProjectBase Doer is the HTTP client used to make requests to the
-	// get-one-project-base endpoint.
-	GetOneProjectBaseDoer goahttp.Doer
-
-	// GetOneProjectSettings Doer is the HTTP client used to make requests to the
-	// get-one-project-settings endpoint.
-	GetOneProjectSettingsDoer goahttp.Doer
-
-	// UpdateProjectBase Doer is the HTTP client used to make requests to the
-	// update-project-base endpoint.
-	UpdateProjectBaseDoer goahttp.Doer
-
-	// UpdateProjectSettings Doer is the HTTP client used to make requests to the
-	// update-project-settings endpoint.
-	UpdateProjectSettingsDoer goahttp.Doer
+	// CloneProject Doer is the HTTP client used to make requests to the
+	// clone-project endpoint.
+	CloneProjectDoer goahttp.Doer
 
-	// DeleteProject Doer is the HTTP client used to make requests to the
-	// delete-project endpoint.
-	DeleteProjectDoer goahttp.Doer
+	// UploadProjectLogo Doer is the HTTP client used to make requests to the
+	// upload-project-logo endpoint.
+	UploadProjectLogoDoer goahttp.Doer
 
-	// Readyz Doer is the HTTP client used to make requests to the readyz endpoint.
-	ReadyzDoer goahttp.Doer
+	// MergeProject Doer is the HTTP client used to make requests to the
+	// merge-project endpoint.
+	MergeProjectDoer goahttp.Doer
 
-	// Livez Doer is the HTTP client used to make requests to the livez endpoint.
-	LivezDoer goahttp.Doer
+	// TransferProject Doer is the HTTP client used to make requests to the
+	// transfer-project endpoint.
+	TransferProjectDoer goahttp.Doer
 
 	// CreateProjectLink Doer is the HTTP client used to make requests to the
 	// create-project-link endpoint.
@@ -95,6 +77,232 @@ type Client struct {
 	// delete-project-document endpoint.
 	DeleteProjectDocumentDoer goahttp.Doer
 
+	// UploadProjectCharter Doer is the HTTP client used to make requests to the
+	// upload-project-charter endpoint.
+	UploadProjectCharterDoer goahttp.Doer
+
+	// GetProjectCharter Doer is the HTTP client used to make requests to the
+	// get-project-charter endpoint.
+	GetProjectCharterDoer goahttp.Doer
+
+	// GetProjectAssociations Doer is the HTTP client used to make requests to the
+	// get-project-associations endpoint.
+	GetProjectAssociationsDoer goahttp.Doer
+
+	// UpdateProjectAssociations Doer is the HTTP client used to make requests to
+	// the update-project-associations endpoint.
+	UpdateProjectAssociationsDoer goahttp.Doer
+
+	// GetProjectSettingsDiff Doer is the HTTP client used to make requests to the
+	// get-project-settings-diff endpoint.
+	GetProjectSettingsDiffDoer goahttp.Doer
+
+	// GetProjectChildren Doer is the HTTP client used to make requests to the
+	// get-project-children endpoint.
+	GetProjectChildrenDoer goahttp.Doer
+
+	// GetProjectDescendants Doer is the HTTP client used to make requests to the
+	// get-project-descendants endpoint.
+	GetProjectDescendantsDoer goahttp.Doer
+
+	// GetProjectLegalLineage Doer is the HTTP client used to make requests to the
+	// get-project-legal-lineage endpoint.
+	GetProjectLegalLineageDoer goahttp.Doer
+
+	// SearchProjects Doer is the HTTP client used to make requests to the
+	// search-projects endpoint.
+	SearchProjectsDoer goahttp.Doer
+
+	// AutocompleteProjects Doer is the HTTP client used to make requests to the
+	// autocomplete-projects endpoint.
+	AutocompleteProjectsDoer goahttp.Doer
+
+	// AddProjectWriter Doer is the HTTP client used to make requests to the
+	// add-project-writer endpoint.
+	AddProjectWriterDoer goahttp.Doer
+
+	// RemoveProjectWriter Doer is the HTTP client used to make requests to the
+	// remove-project-writer endpoint.
+	RemoveProjectWriterDoer goahttp.Doer
+
+	// AddProjectAuditor Doer is the HTTP client used to make requests to the
+	// add-project-auditor endpoint.
+	AddProjectAuditorDoer goahttp.Doer
+
+	// RemoveProjectAuditor Doer is the HTTP client used to make requests to the
+	// remove-project-auditor endpoint.
+	RemoveProjectAuditorDoer goahttp.Doer
+
+	// JoinProject Doer is the HTTP client used to make requests to the
+	// join-project endpoint.
+	JoinProjectDoer goahttp.Doer
+
+	// BulkUpdateProjectMembers Doer is the HTTP client used to make requests to
+	// the bulk-update-project-members endpoint.
+	BulkUpdateProjectMembersDoer goahttp.Doer
+
+	// GetProjects Doer is the HTTP client used to make requests to the
+	// get-projects endpoint.
+	GetProjectsDoer goahttp.Doer
+
+	// CreateProject Doer is the HTTP client used to make requests to the
+	// create-project endpoint.
+	CreateProjectDoer goahttp.Doer
+
+	// GetOneProjectBase Doer is the HTTP client used to make requests to the
+	// get-one-project-base endpoint.
+	GetOneProjectBaseDoer goahttp.Doer
+
+	// GetProjectUIDFromLegacyID Doer is the HTTP client used to make requests to
+	// the get-project-uid-from-legacy-id endpoint.
+	GetProjectUIDFromLegacyIDDoer goahttp.Doer
+
+	// GetOneProjectSettings Doer is the HTTP client used to make requests to the
+	// get-one-project-settings endpoint.
+	GetOneProjectSettingsDoer goahttp.Doer
+
+	// UpdateProjectBase Doer is the HTTP client used to make requests to the
+	// update-project-base endpoint.
+	UpdateProjectBaseDoer goahttp.Doer
+
+	// UpdateProjectSettings Doer is the HTTP client used to make requests to the
+	// update-project-settings endpoint.
+	UpdateProjectSettingsDoer goahttp.Doer
+
+	// DeleteProject Doer is the HTTP client used to make requests to the
+	// delete-project endpoint.
+	DeleteProjectDoer goahttp.Doer
+
+	// BulkDeleteProjects Doer is the HTTP client used to make requests to the
+	// bulk-delete-projects endpoint.
+	BulkDeleteProjectsDoer goahttp.Doer
+
+	// Readyz Doer is the HTTP client used to make requests to the readyz endpoint.
+	ReadyzDoer goahttp.Doer
+
+	// Livez Doer is the HTTP client used to make requests to the livez endpoint.
+	LivezDoer goahttp.Doer
+
+	// Healthz Doer is the HTTP client used to make requests to the healthz
+	// endpoint.
+	HealthzDoer goahttp.Doer
+
+	// ReconcileOutbox Doer is the HTTP client used to make requests to the
+	// reconcile-outbox endpoint.
+	ReconcileOutboxDoer goahttp.Doer
+
+	// ResyncProjects Doer is the HTTP client used to make requests to the
+	// resync-projects endpoint.
+	ResyncProjectsDoer goahttp.Doer
+
+	// ListDeadLetters Doer is the HTTP client used to make requests to the
+	// list-dead-letters endpoint.
+	ListDeadLettersDoer goahttp.Doer
+
+	// ReplayDeadLetter Doer is the HTTP client used to make requests to the
+	// replay-dead-letter endpoint.
+	ReplayDeadLetterDoer goahttp.Doer
+
+	// RebuildSlugMappings Doer is the HTTP client used to make requests to the
+	// rebuild-slug-mappings endpoint.
+	RebuildSlugMappingsDoer goahttp.Doer
+
+	// GetIntegrityReport Doer is the HTTP client used to make requests to the
+	// get-integrity-report endpoint.
+	GetIntegrityReportDoer goahttp.Doer
+
+	// InspectProjectKv Doer is the HTTP client used to make requests to the
+	// inspect-project-kv endpoint.
+	InspectProjectKvDoer goahttp.Doer
+
+	// CreateBackup Doer is the HTTP client used to make requests to the
+	// create-backup endpoint.
+	CreateBackupDoer goahttp.Doer
+
+	// ListBackups Doer is the HTTP client used to make requests to the
+	// list-backups endpoint.
+	ListBackupsDoer goahttp.Doer
+
+	// RestoreBackup Doer is the HTTP client used to make requests to the
+	// restore-backup endpoint.
+	RestoreBackupDoer goahttp.Doer
+
+	// ListPendingAnnouncements Doer is the HTTP client used to make requests to
+	// the list-pending-announcements endpoint.
+	ListPendingAnnouncementsDoer goahttp.Doer
+
+	// GetProjectEnums Doer is the HTTP client used to make requests to the
+	// get-project-enums endpoint.
+	GetProjectEnumsDoer goahttp.Doer
+
+	// UpdateProjectEnums Doer is the HTTP client used to make requests to the
+	// update-project-enums endpoint.
+	UpdateProjectEnumsDoer goahttp.Doer
+
+	// CreateProjectTemplate Doer is the HTTP client used to make requests to the
+	// create-project-template endpoint.
+	CreateProjectTemplateDoer goahttp.Doer
+
+	// ListProjectTemplates Doer is the HTTP client used to make requests to the
+	// list-project-templates endpoint.
+	ListProjectTemplatesDoer goahttp.Doer
+
+	// GetProjectTemplate Doer is the HTTP client used to make requests to the
+	// get-project-template endpoint.
+	GetProjectTemplateDoer goahttp.Doer
+
+	// UpdateProjectTemplate Doer is the HTTP client used to make requests to the
+	// update-project-template endpoint.
+	UpdateProjectTemplateDoer goahttp.Doer
+
+	// DeleteProjectTemplate Doer is the HTTP client used to make requests to the
+	// delete-project-template endpoint.
+	DeleteProjectTemplateDoer goahttp.Doer
+
+	// ListProjectDeletions Doer is the HTTP client used to make requests to the
+	// list-project-deletions endpoint.
+	ListProjectDeletionsDoer goahttp.Doer
+
+	// ListProjectEvents Doer is the HTTP client used to make requests to the
+	// list-project-events endpoint.
+	ListProjectEventsDoer goahttp.Doer
+
+	// GetUserProjects Doer is the HTTP client used to make requests to the
+	// get-user-projects endpoint.
+	GetUserProjectsDoer goahttp.Doer
+
+	// ExportUserData Doer is the HTTP client used to make requests to the
+	// export-user-data endpoint.
+	ExportUserDataDoer goahttp.Doer
+
+	// RemoveUserAccess Doer is the HTTP client used to make requests to the
+	// remove-user-access endpoint.
+	RemoveUserAccessDoer goahttp.Doer
+
+	// GetProjectVisibilityImpact Doer is the HTTP client used to make requests to
+	// the get-project-visibility-impact endpoint.
+	GetProjectVisibilityImpactDoer goahttp.Doer
+
+	// CreateWebhook Doer is the HTTP client used to make requests to the
+	// create-webhook endpoint.
+	CreateWebhookDoer goahttp.Doer
+
+	// ListWebhooks Doer is the HTTP client used to make requests to the
+	// list-webhooks endpoint.
+	ListWebhooksDoer goahttp.Doer
+
+	// GetWebhook Doer is the HTTP client used to make requests to the get-webhook
+	// endpoint.
+	GetWebhookDoer goahttp.Doer
+
+	// DeleteWebhook Doer is the HTTP client used to make requests to the
+	// delete-webhook endpoint.
+	DeleteWebhookDoer goahttp.Doer
+
+	// ListWebhookDeliveries Doer is the HTTP client used to make requests to the
+	// list-webhook-deliveries endpoint.
+	ListWebhookDeliveriesDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -105,11 +313,20 @@ type Client struct {
 	decoder func(*http.Response) goahttp.Decoder
 }
 
+// ProjectServiceUploadProjectLogoEncoderFunc is the type to encode multipart
+// request for the "project-service" service "upload-project-logo" endpoint.
+type ProjectServiceUploadProjectLogoEncoderFunc func(*multipart.Writer, *projectservice.UploadProjectLogoPayload) error
+
 // ProjectServiceUploadProjectDocumentEncoderFunc is the type to encode
 // multipart request for the "project-service" service
 // "upload-project-document" endpoint.
 type ProjectServiceUploadProjectDocumentEncoderFunc func(*multipart.Writer, *projectservice.UploadProjectDocumentPayload) error
 
+// ProjectServiceUploadProjectCharterEncoderFunc is the type to encode
+// multipart request for the "project-service" service "upload-project-charter"
+// endpoint.
+type ProjectServiceUploadProjectCharterEncoderFunc func(*multipart.Writer, *projectservice.UploadProjectCharterPayload) error
+
 // NewClient instantiates HTTP clients for all the project-service service
 // servers.
 func NewClient(
@@ -121,114 +338,94 @@ func NewClient(
 	restoreBody bool,
 ) *Client {
 	return &Client{
-		GetProjectsDoer:             doer,
-		CreateProjectDoer:           doer,
-		GetOneProjectBaseDoer:       doer,
-		GetOneProjectSettingsDoer:   doer,
-		UpdateProjectBaseDoer:       doer,
-		UpdateProjectSettingsDoer:   doer,
-		DeleteProjectDoer:           doer,
-		ReadyzDoer:                  doer,
-		LivezDoer:                   doer,
-		CreateProjectLinkDoer:       doer,
-		GetProjectLinkDoer:          doer,
-		DeleteProjectLinkDoer:       doer,
-		CreateProjectFolderDoer:     doer,
-		GetProjectFolderDoer:        doer,
-		DeleteProjectFolderDoer:     doer,
-		UploadProjectDocumentDoer:   doer,
-		GetProjectDocumentDoer:      doer,
-		DownloadProjectDocumentDoer: doer,
-		DeleteProjectDocumentDoer:   doer,
-		RestoreResponseBody:         restoreBody,
-		scheme:                      scheme,
-		host:                        host,
-		decoder:                     dec,
-		encoder:                     enc,
-	}
-}
-
-// GetProjects returns an endpoint that makes HTTP requests to the
-// project-service service get-projects server.
-func (c *Client) GetProjects() goa.Endpoint {
-	var (
-		encodeRequest  = EncodeGetProjectsRequest(c.encoder)
-		decodeResponse = DecodeGetProjectsResponse(c.decoder, c.RestoreResponseBody)
-	)
-	return func(ctx context.Context, v any) (any, error) {
-		req, err := c.BuildGetProjectsRequest(ctx, v)
-		if err != nil {
-			return nil, err
-		}
-		err = encodeRequest(req, v)
-		if err != nil {
-			return nil, err
-		}
-		resp, err := c.GetProjectsDoer.Do(req)
-		if err != nil {
-			return nil, goahttp.ErrRequestError("project-service", "get-projects", err)
-		}
-		return decodeResponse(resp)
-	}
-}
-
-// CreateProject returns an endpoint that makes HTTP requests to the
-// project-service service create-project server.
-func (c *Client) CreateProject() goa.Endpoint {
-	var (
-		encodeRequest  = EncodeCreateProjectRequest(c.encoder)
-		decodeResponse = DecodeCreateProjectResponse(c.decoder, c.RestoreResponseBody)
-	)
-	return func(ctx context.Context, v any) (any, error) {
-		req, err := c.BuildCreateProjectRequest(ctx, v)
-		if err != nil {
-			return nil, err
-		}
-		err = encodeRequest(req, v)
-		if err != nil {
-			return nil, err
-		}
-		resp, err := c.CreateProjectDoer.Do(req)
-		if err != nil {
-			return nil, goahttp.ErrRequestError("project-service", "create-project", err)
-		}
-		return decodeResponse(resp)
-	}
-}
-
-// GetOneProjectBase returns an endpoint that makes HTTP requests to the
-// project-service service get-one-project-base server.
-func (c *Client) GetOneProjectBase() goa.Endpoint {
-	var (
-		encodeRequest  = EncodeGetOneProjectBaseRequest(c.encoder)
-		decodeResponse = DecodeGetOneProjectBaseResponse(c.decoder, c.RestoreResponseBody)
-	)
-	return func(ctx context.Context, v any) (any, error) {
-		req, err := c.BuildGetOneProjectBaseRequest(ctx, v)
-		if err != nil {
-			return nil, err
-		}
-		err = encodeRequest(req, v)
-		if err != nil {
-			return nil, err
-		}
-		resp, err := c.GetOneProjectBaseDoer.Do(req)
-		if err != nil {
-			return nil, goahttp.ErrRequestError("project-service", "get-one-project-base", err)
-		}
-		return decodeResponse(resp)
+		CloneProjectDoer:               doer,
+		UploadProjectLogoDoer:          doer,
+		MergeProjectDoer:               doer,
+		TransferProjectDoer:            doer,
+		CreateProjectLinkDoer:          doer,
+		GetProjectLinkDoer:             doer,
+		DeleteProjectLinkDoer:          doer,
+		CreateProjectFolderDoer:        doer,
+		GetProjectFolderDoer:           doer,
+		DeleteProjectFolderDoer:        doer,
+		UploadProjectDocumentDoer:      doer,
+		GetProjectDocumentDoer:         doer,
+		DownloadProjectDocumentDoer:    doer,
+		DeleteProjectDocumentDoer:      doer,
+		UploadProjectCharterDoer:       doer,
+		GetProjectCharterDoer:          doer,
+		GetProjectAssociationsDoer:     doer,
+		UpdateProjectAssociationsDoer:  doer,
+		GetProjectSettingsDiffDoer:     doer,
+		GetProjectChildrenDoer:         doer,
+		GetProjectDescendantsDoer:      doer,
+		GetProjectLegalLineageDoer:     doer,
+		SearchProjectsDoer:             doer,
+		AutocompleteProjectsDoer:       doer,
+		AddProjectWriterDoer:           doer,
+		RemoveProjectWriterDoer:        doer,
+		AddProjectAuditorDoer:          doer,
+		RemoveProjectAuditorDoer:       doer,
+		JoinProjectDoer:                doer,
+		BulkUpdateProjectMembersDoer:   doer,
+		GetProjectsDoer:                doer,
+		CreateProjectDoer:              doer,
+		GetOneProjectBaseDoer:          doer,
+		GetProjectUIDFromLegacyIDDoer:  doer,
+		GetOneProjectSettingsDoer:      doer,
+		UpdateProjectBaseDoer:          doer,
+		UpdateProjectSettingsDoer:      doer,
+		DeleteProjectDoer:              doer,
+		BulkDeleteProjectsDoer:         doer,
+		ReadyzDoer:                     doer,
+		LivezDoer:                      doer,
+		HealthzDoer:                    doer,
+		ReconcileOutboxDoer:            doer,
+		ResyncProjectsDoer:             doer,
+		ListDeadLettersDoer:            doer,
+		ReplayDeadLetterDoer:           doer,
+		RebuildSlugMappingsDoer:        doer,
+		GetIntegrityReportDoer:         doer,
+		InspectProjectKvDoer:           doer,
+		CreateBackupDoer:               doer,
+		ListBackupsDoer:                doer,
+		RestoreBackupDoer:              doer,
+		ListPendingAnnouncementsDoer:   doer,
+		GetProjectEnumsDoer:            doer,
+		UpdateProjectEnumsDoer:         doer,
+		CreateProjectTemplateDoer:      doer,
+		ListProjectTemplatesDoer:       doer,
+		GetProjectTemplateDoer:         doer,
+		UpdateProjectTemplateDoer:      doer,
+		DeleteProjectTemplateDoer:      doer,
+		ListProjectDeletionsDoer:       doer,
+		ListProjectEventsDoer:          doer,
+		GetUserProjectsDoer:            doer,
+		ExportUserDataDoer:             doer,
+		RemoveUserAccessDoer:           doer,
+		GetProjectVisibilityImpactDoer: doer,
+		CreateWebhookDoer:              doer,
+		ListWebhooksDoer:               doer,
+		GetWebhookDoer:                 doer,
+		DeleteWebhookDoer:              doer,
+		ListWebhookDeliveriesDoer:      doer,
+		RestoreResponseBody:            restoreBody,
+		scheme:                         scheme,
+		host:                           host,
+		decoder:                        dec,
+		encoder:                        enc,
 	}
 }
 
-// GetOneProjectSettings returns an endpoint that makes HTTP requests to the
-// project-service service get-one-project-settings server.
-func (c *Client) GetOneProjectSettings() goa.Endpoint {
+// CloneProject returns an endpoint that makes HTTP requests to the
+// project-service service clone-project server.
+func (c *Client) CloneProject() goa.Endpoint {
 	var (
-		encodeRequest  = EncodeGetOneProjectSettingsRequest(c.encoder)
-		decodeResponse = DecodeGetOneProjectSettingsResponse(c.decoder, c.RestoreResponseBody)
+		encodeRequest  = EncodeCloneProjectRequest(c.encoder)
+		decodeResponse = DecodeCloneProjectResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v any) (any, error) {
-		req, err := c.BuildGetOneProjectSettingsRequest(ctx, v)
+		req, err := c.BuildCloneProjectRequest(ctx, v)
 		if err != nil {
 			return nil, err
 		}
@@ -236,23 +433,23 @@ func (c *Client) GetOneProjectSettings() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
-		resp, err := c.GetOneProjectSettingsDoer.Do(req)
+		resp, err := c.CloneProjectDoer.Do(req)
 		if err != nil {
-			return nil, goahttp.ErrRequestError("project-service", "get-one-project-settings", err)
+			return nil, goahttp.ErrRequestError("project-service", "clone-project", err)
 		}
 		return decodeResponse(resp)
 	}
 }
 
-// UpdateProjectBase returns an endpoint that makes HTTP requests to the
-// project-service service update-project-base server.
-func (c *Client) UpdateProjectBase() goa.Endpoint {
+// UploadProjectLogo returns an endpoint that makes HTTP requests to the
+// project-service service upload-project-logo server.
+func (c *Client) UploadProjectLogo(projectServiceUploadProjectLogoEncoderFn ProjectServiceUploadProjectLogoEncoderFunc) goa.Endpoint {
 	var (
-		encodeRequest  = EncodeUpdateProjectBaseRequest(c.encoder)
-		decodeResponse = DecodeUpdateProjectBaseResponse(c.decoder, c.RestoreResponseBody)
+		encodeRequest  = EncodeUploadProjectLogoRequest(NewProjectServiceUploadProjectLogoEncoder(projectServiceUploadProjectLogoEncoderFn))
+		decodeResponse = DecodeUploadProjectLogoResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v any) (any, error) {
-		req, err := c.BuildUpdateProjectBaseRequest(ctx, v)
+		req, err := c.BuildUploadProjectLogoRequest(ctx, v)
 		if err != nil {
 			return nil, err
 		}
@@ -260,23 +457,23 @@ func (c *Client) UpdateProjectBase() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
-		resp, err := c.UpdateProjectBaseDoer.Do(req)
+		resp, err := c.UploadProjectLogoDoer.Do(req)
 		if err != nil {
-			return nil, goahttp.ErrRequestError("project-service", "update-project-base", err)
+			return nil, goahttp.ErrRequestError("project-service", "upload-project-logo", err)
 		}
 		return decodeResponse(resp)
 	}
 }
 
-// UpdateProjectSettings returns an endpoint that makes HTTP requests to the
-// project-service service update-project-settings server.
-func (c *Client) UpdateProjectSettings() goa.Endpoint {
+// MergeProject returns an endpoint that makes HTTP requests to the
+// project-service service merge-project server.
+func (c *Client) MergeProject() goa.Endpoint {
 	var (
-		encodeRequest  = EncodeUpdateProjectSettingsRequest(c.encoder)
-		decodeResponse = DecodeUpdateProjectSettingsResponse(c.decoder, c.RestoreResponseBody)
+		encodeRequest  = EncodeMergeProjectRequest(c.encoder)
+		decodeResponse = DecodeMergeProjectResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v any) (any, error) {
-		req, err := c.BuildUpdateProjectSettingsRequest(ctx, v)
+		req, err := c.BuildMergeProjectRequest(ctx, v)
 		if err != nil {
 			return nil, err
 		}
@@ -284,23 +481,23 @@ func (c *Client) UpdateProjectSettings() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
-		resp, err := c.UpdateProjectSettingsDoer.Do(req)
+		resp, err := c.MergeProjectDoer.Do(req)
 		if err != nil {
-			return nil, goahttp.ErrRequestError("project-service", "update-project-settings", err)
+			return nil, goahttp.ErrRequestError("project-service", "merge-project", err)
 		}
 		return decodeResponse(resp)
 	}
 }
 
-// DeleteProject returns an endpoint that makes HTTP requests to the
-// project-service service delete-project server.
-func (c *Client) DeleteProject() goa.Endpoint {
+// TransferProject returns an endpoint that makes HTTP requests to the
+// project-service service transfer-project server.
+func (c *Client) TransferProject() goa.Endpoint {
 	var (
-		encodeRequest  = EncodeDeleteProjectRequest(c.encoder)
-		decodeResponse = DecodeDeleteProjectResponse(c.decoder, c.RestoreResponseBody)
+		encodeRequest  = EncodeTransferProjectRequest(c.encoder)
+		decodeResponse = DecodeTransferProjectResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v any) (any, error) {
-		req, err := c.BuildDeleteProjectRequest(ctx, v)
+		req, err := c.BuildTransferProjectRequest(ctx, v)
 		if err != nil {
 			return nil, err
 		}
@@ -308,47 +505,9 @@ func (c *Client) DeleteProject() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
-		resp, err := c.DeleteProjectDoer.Do(req)
-		if err != nil {
-			return nil, goahttp.ErrRequestError("project-service", "delete-project", err)
-		}
-		return decodeResponse(resp)
-	}
-}
-
-// Readyz returns an endpoint that makes HTTP requests to the project-service
-// service readyz server.
-func (c *Client) Readyz() goa.Endpoint {
-	var (
-		decodeResponse = DecodeReadyzResponse(c.decoder, c.RestoreResponseBody)
-	)
-	return func(ctx context.Context, v any) (any, error) {
-		req, err := c.BuildReadyzRequest(ctx, v)
-		if err != nil {
-			return nil, err
-		}
-		resp, err := c.ReadyzDoer.Do(req)
-		if err != nil {
-			return nil, goahttp.ErrRequestError("project-service", "readyz", err)
-		}
-		return decodeResponse(resp)
-	}
-}
-
-// Livez returns an endpoint that makes HTTP requests to the project-service
-// service livez server.
-func (c *Client) Livez() goa.Endpoint {
-	var (
-		decodeResponse = DecodeLivezResponse(c.decoder, c.RestoreResponseBody)
-	)
-	return func(ctx context.Context, v any) (any, error) {
-		req, err := c.BuildLivezRequest(ctx, v)
-		if err != nil {
-			return nil, err
-		}
-		resp, err := c.LivezDoer.Do(req)
+		resp, err := c.TransferProjectDoer.Do(req)
 		if err != nil {
-			return nil, goahttp.ErrRequestError("project-service", "livez", err)
+			return nil, goahttp.ErrRequestError("project-service", "transfer-project", err)
 		}
 		return decodeResponse(resp)
 	}
@@ -598,3 +757,1356 @@ func (c *Client) DeleteProjectDocument() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// UploadProjectCharter returns an endpoint that makes HTTP requests to the
+// project-service service upload-project-charter server.
+func (c *Client) UploadProjectCharter(projectServiceUploadProjectCharterEncoderFn ProjectServiceUploadProjectCharterEncoderFunc) goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUploadProjectCharterRequest(NewProjectServiceUploadProjectCharterEncoder(projectServiceUploadProjectCharterEncoderFn))
+		decodeResponse = DecodeUploadProjectCharterResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUploadProjectCharterRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UploadProjectCharterDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "upload-project-charter", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetProjectCharter returns an endpoint that makes HTTP requests to the
+// project-service service get-project-charter server.
+func (c *Client) GetProjectCharter() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetProjectCharterRequest(c.encoder)
+		decodeResponse = DecodeGetProjectCharterResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetProjectCharterRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetProjectCharterDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "get-project-charter", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetProjectAssociations returns an endpoint that makes HTTP requests to the
+// project-service service get-project-associations server.
+func (c *Client) GetProjectAssociations() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetProjectAssociationsRequest(c.encoder)
+		decodeResponse = DecodeGetProjectAssociationsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetProjectAssociationsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetProjectAssociationsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "get-project-associations", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateProjectAssociations returns an endpoint that makes HTTP requests to
+// the project-service service update-project-associations server.
+func (c *Client) UpdateProjectAssociations() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateProjectAssociationsRequest(c.encoder)
+		decodeResponse = DecodeUpdateProjectAssociationsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateProjectAssociationsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateProjectAssociationsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "update-project-associations", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetProjectSettingsDiff returns an endpoint that makes HTTP requests to the
+// project-service service get-project-settings-diff server.
+func (c *Client) GetProjectSettingsDiff() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetProjectSettingsDiffRequest(c.encoder)
+		decodeResponse = DecodeGetProjectSettingsDiffResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetProjectSettingsDiffRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetProjectSettingsDiffDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "get-project-settings-diff", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetProjectChildren returns an endpoint that makes HTTP requests to the
+// project-service service get-project-children server.
+func (c *Client) GetProjectChildren() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetProjectChildrenRequest(c.encoder)
+		decodeResponse = DecodeGetProjectChildrenResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetProjectChildrenRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetProjectChildrenDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "get-project-children", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetProjectDescendants returns an endpoint that makes HTTP requests to the
+// project-service service get-project-descendants server.
+func (c *Client) GetProjectDescendants() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetProjectDescendantsRequest(c.encoder)
+		decodeResponse = DecodeGetProjectDescendantsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetProjectDescendantsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetProjectDescendantsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "get-project-descendants", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetProjectLegalLineage returns an endpoint that makes HTTP requests to the
+// project-service service get-project-legal-lineage server.
+func (c *Client) GetProjectLegalLineage() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetProjectLegalLineageRequest(c.encoder)
+		decodeResponse = DecodeGetProjectLegalLineageResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetProjectLegalLineageRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetProjectLegalLineageDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "get-project-legal-lineage", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// SearchProjects returns an endpoint that makes HTTP requests to the
+// project-service service search-projects server.
+func (c *Client) SearchProjects() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSearchProjectsRequest(c.encoder)
+		decodeResponse = DecodeSearchProjectsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSearchProjectsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SearchProjectsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "search-projects", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// AutocompleteProjects returns an endpoint that makes HTTP requests to the
+// project-service service autocomplete-projects server.
+func (c *Client) AutocompleteProjects() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeAutocompleteProjectsRequest(c.encoder)
+		decodeResponse = DecodeAutocompleteProjectsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildAutocompleteProjectsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.AutocompleteProjectsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "autocomplete-projects", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// AddProjectWriter returns an endpoint that makes HTTP requests to the
+// project-service service add-project-writer server.
+func (c *Client) AddProjectWriter() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeAddProjectWriterRequest(c.encoder)
+		decodeResponse = DecodeAddProjectWriterResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildAddProjectWriterRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.AddProjectWriterDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "add-project-writer", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RemoveProjectWriter returns an endpoint that makes HTTP requests to the
+// project-service service remove-project-writer server.
+func (c *Client) RemoveProjectWriter() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRemoveProjectWriterRequest(c.encoder)
+		decodeResponse = DecodeRemoveProjectWriterResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRemoveProjectWriterRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RemoveProjectWriterDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "remove-project-writer", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// AddProjectAuditor returns an endpoint that makes HTTP requests to the
+// project-service service add-project-auditor server.
+func (c *Client) AddProjectAuditor() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeAddProjectAuditorRequest(c.encoder)
+		decodeResponse = DecodeAddProjectAuditorResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildAddProjectAuditorRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.AddProjectAuditorDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "add-project-auditor", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RemoveProjectAuditor returns an endpoint that makes HTTP requests to the
+// project-service service remove-project-auditor server.
+func (c *Client) RemoveProjectAuditor() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRemoveProjectAuditorRequest(c.encoder)
+		decodeResponse = DecodeRemoveProjectAuditorResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRemoveProjectAuditorRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RemoveProjectAuditorDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "remove-project-auditor", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// JoinProject returns an endpoint that makes HTTP requests to the
+// project-service service join-project server.
+func (c *Client) JoinProject() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeJoinProjectRequest(c.encoder)
+		decodeResponse = DecodeJoinProjectResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildJoinProjectRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.JoinProjectDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "join-project", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// BulkUpdateProjectMembers returns an endpoint that makes HTTP requests to the
+// project-service service bulk-update-project-members server.
+func (c *Client) BulkUpdateProjectMembers() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeBulkUpdateProjectMembersRequest(c.encoder)
+		decodeResponse = DecodeBulkUpdateProjectMembersResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildBulkUpdateProjectMembersRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.BulkUpdateProjectMembersDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "bulk-update-project-members", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetProjects returns an endpoint that makes HTTP requests to the
+// project-service service get-projects server.
+func (c *Client) GetProjects() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetProjectsRequest(c.encoder)
+		decodeResponse = DecodeGetProjectsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetProjectsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetProjectsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "get-projects", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateProject returns an endpoint that makes HTTP requests to the
+// project-service service create-project server.
+func (c *Client) CreateProject() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateProjectRequest(c.encoder)
+		decodeResponse = DecodeCreateProjectResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateProjectRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateProjectDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "create-project", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetOneProjectBase returns an endpoint that makes HTTP requests to the
+// project-service service get-one-project-base server.
+func (c *Client) GetOneProjectBase() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetOneProjectBaseRequest(c.encoder)
+		decodeResponse = DecodeGetOneProjectBaseResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetOneProjectBaseRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetOneProjectBaseDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "get-one-project-base", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetProjectUIDFromLegacyID returns an endpoint that makes HTTP requests to
+// the project-service service get-project-uid-from-legacy-id server.
+func (c *Client) GetProjectUIDFromLegacyID() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetProjectUIDFromLegacyIDRequest(c.encoder)
+		decodeResponse = DecodeGetProjectUIDFromLegacyIDResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetProjectUIDFromLegacyIDRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetProjectUIDFromLegacyIDDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "get-project-uid-from-legacy-id", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetOneProjectSettings returns an endpoint that makes HTTP requests to the
+// project-service service get-one-project-settings server.
+func (c *Client) GetOneProjectSettings() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetOneProjectSettingsRequest(c.encoder)
+		decodeResponse = DecodeGetOneProjectSettingsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetOneProjectSettingsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetOneProjectSettingsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "get-one-project-settings", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateProjectBase returns an endpoint that makes HTTP requests to the
+// project-service service update-project-base server.
+func (c *Client) UpdateProjectBase() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateProjectBaseRequest(c.encoder)
+		decodeResponse = DecodeUpdateProjectBaseResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateProjectBaseRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateProjectBaseDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "update-project-base", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateProjectSettings returns an endpoint that makes HTTP requests to the
+// project-service service update-project-settings server.
+func (c *Client) UpdateProjectSettings() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateProjectSettingsRequest(c.encoder)
+		decodeResponse = DecodeUpdateProjectSettingsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateProjectSettingsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateProjectSettingsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "update-project-settings", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteProject returns an endpoint that makes HTTP requests to the
+// project-service service delete-project server.
+func (c *Client) DeleteProject() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteProjectRequest(c.encoder)
+		decodeResponse = DecodeDeleteProjectResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteProjectRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteProjectDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "delete-project", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// BulkDeleteProjects returns an endpoint that makes HTTP requests to the
+// project-service service bulk-delete-projects server.
+func (c *Client) BulkDeleteProjects() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeBulkDeleteProjectsRequest(c.encoder)
+		decodeResponse = DecodeBulkDeleteProjectsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildBulkDeleteProjectsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.BulkDeleteProjectsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "bulk-delete-projects", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Readyz returns an endpoint that makes HTTP requests to the project-service
+// service readyz server.
+func (c *Client) Readyz() goa.Endpoint {
+	var (
+		decodeResponse = DecodeReadyzResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildReadyzRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ReadyzDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "readyz", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Livez returns an endpoint that makes HTTP requests to the project-service
+// service livez server.
+func (c *Client) Livez() goa.Endpoint {
+	var (
+		decodeResponse = DecodeLivezResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildLivezRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.LivezDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "livez", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Healthz returns an endpoint that makes HTTP requests to the project-service
+// service healthz server.
+func (c *Client) Healthz() goa.Endpoint {
+	var (
+		decodeResponse = DecodeHealthzResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildHealthzRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.HealthzDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "healthz", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ReconcileOutbox returns an endpoint that makes HTTP requests to the
+// project-service service reconcile-outbox server.
+func (c *Client) ReconcileOutbox() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeReconcileOutboxRequest(c.encoder)
+		decodeResponse = DecodeReconcileOutboxResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildReconcileOutboxRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ReconcileOutboxDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "reconcile-outbox", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ResyncProjects returns an endpoint that makes HTTP requests to the
+// project-service service resync-projects server.
+func (c *Client) ResyncProjects() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeResyncProjectsRequest(c.encoder)
+		decodeResponse = DecodeResyncProjectsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildResyncProjectsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ResyncProjectsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "resync-projects", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListDeadLetters returns an endpoint that makes HTTP requests to the
+// project-service service list-dead-letters server.
+func (c *Client) ListDeadLetters() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListDeadLettersRequest(c.encoder)
+		decodeResponse = DecodeListDeadLettersResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListDeadLettersRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListDeadLettersDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "list-dead-letters", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ReplayDeadLetter returns an endpoint that makes HTTP requests to the
+// project-service service replay-dead-letter server.
+func (c *Client) ReplayDeadLetter() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeReplayDeadLetterRequest(c.encoder)
+		decodeResponse = DecodeReplayDeadLetterResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildReplayDeadLetterRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ReplayDeadLetterDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "replay-dead-letter", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RebuildSlugMappings returns an endpoint that makes HTTP requests to the
+// project-service service rebuild-slug-mappings server.
+func (c *Client) RebuildSlugMappings() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRebuildSlugMappingsRequest(c.encoder)
+		decodeResponse = DecodeRebuildSlugMappingsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRebuildSlugMappingsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RebuildSlugMappingsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "rebuild-slug-mappings", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetIntegrityReport returns an endpoint that makes HTTP requests to the
+// project-service service get-integrity-report server.
+func (c *Client) GetIntegrityReport() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetIntegrityReportRequest(c.encoder)
+		decodeResponse = DecodeGetIntegrityReportResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetIntegrityReportRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetIntegrityReportDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "get-integrity-report", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// InspectProjectKv returns an endpoint that makes HTTP requests to the
+// project-service service inspect-project-kv server.
+func (c *Client) InspectProjectKv() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeInspectProjectKvRequest(c.encoder)
+		decodeResponse = DecodeInspectProjectKvResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildInspectProjectKvRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.InspectProjectKvDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "inspect-project-kv", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateBackup returns an endpoint that makes HTTP requests to the
+// project-service service create-backup server.
+func (c *Client) CreateBackup() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateBackupRequest(c.encoder)
+		decodeResponse = DecodeCreateBackupResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateBackupRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateBackupDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "create-backup", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListBackups returns an endpoint that makes HTTP requests to the
+// project-service service list-backups server.
+func (c *Client) ListBackups() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListBackupsRequest(c.encoder)
+		decodeResponse = DecodeListBackupsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListBackupsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListBackupsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "list-backups", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RestoreBackup returns an endpoint that makes HTTP requests to the
+// project-service service restore-backup server.
+func (c *Client) RestoreBackup() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRestoreBackupRequest(c.encoder)
+		decodeResponse = DecodeRestoreBackupResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRestoreBackupRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RestoreBackupDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "restore-backup", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListPendingAnnouncements returns an endpoint that makes HTTP requests to the
+// project-service service list-pending-announcements server.
+func (c *Client) ListPendingAnnouncements() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListPendingAnnouncementsRequest(c.encoder)
+		decodeResponse = DecodeListPendingAnnouncementsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListPendingAnnouncementsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListPendingAnnouncementsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "list-pending-announcements", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetProjectEnums returns an endpoint that makes HTTP requests to the
+// project-service service get-project-enums server.
+func (c *Client) GetProjectEnums() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetProjectEnumsRequest(c.encoder)
+		decodeResponse = DecodeGetProjectEnumsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetProjectEnumsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetProjectEnumsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "get-project-enums", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateProjectEnums returns an endpoint that makes HTTP requests to the
+// project-service service update-project-enums server.
+func (c *Client) UpdateProjectEnums() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateProjectEnumsRequest(c.encoder)
+		decodeResponse = DecodeUpdateProjectEnumsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateProjectEnumsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateProjectEnumsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "update-project-enums", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateProjectTemplate returns an endpoint that makes HTTP requests to the
+// project-service service create-project-template server.
+func (c *Client) CreateProjectTemplate() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateProjectTemplateRequest(c.encoder)
+		decodeResponse = DecodeCreateProjectTemplateResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateProjectTemplateRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateProjectTemplateDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "create-project-template", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListProjectTemplates returns an endpoint that makes HTTP requests to the
+// project-service service list-project-templates server.
+func (c *Client) ListProjectTemplates() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListProjectTemplatesRequest(c.encoder)
+		decodeResponse = DecodeListProjectTemplatesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListProjectTemplatesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListProjectTemplatesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "list-project-templates", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetProjectTemplate returns an endpoint that makes HTTP requests to the
+// project-service service get-project-template server.
+func (c *Client) GetProjectTemplate() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetProjectTemplateRequest(c.encoder)
+		decodeResponse = DecodeGetProjectTemplateResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetProjectTemplateRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetProjectTemplateDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "get-project-template", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateProjectTemplate returns an endpoint that makes HTTP requests to the
+// project-service service update-project-template server.
+func (c *Client) UpdateProjectTemplate() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateProjectTemplateRequest(c.encoder)
+		decodeResponse = DecodeUpdateProjectTemplateResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateProjectTemplateRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateProjectTemplateDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "update-project-template", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteProjectTemplate returns an endpoint that makes HTTP requests to the
+// project-service service delete-project-template server.
+func (c *Client) DeleteProjectTemplate() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteProjectTemplateRequest(c.encoder)
+		decodeResponse = DecodeDeleteProjectTemplateResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteProjectTemplateRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteProjectTemplateDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "delete-project-template", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListProjectDeletions returns an endpoint that makes HTTP requests to the
+// project-service service list-project-deletions server.
+func (c *Client) ListProjectDeletions() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListProjectDeletionsRequest(c.encoder)
+		decodeResponse = DecodeListProjectDeletionsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListProjectDeletionsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListProjectDeletionsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "list-project-deletions", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListProjectEvents returns an endpoint that makes HTTP requests to the
+// project-service service list-project-events server.
+func (c *Client) ListProjectEvents() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListProjectEventsRequest(c.encoder)
+		decodeResponse = DecodeListProjectEventsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListProjectEventsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListProjectEventsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "list-project-events", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetUserProjects returns an endpoint that makes HTTP requests to the
+// project-service service get-user-projects server.
+func (c *Client) GetUserProjects() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetUserProjectsRequest(c.encoder)
+		decodeResponse = DecodeGetUserProjectsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetUserProjectsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetUserProjectsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "get-user-projects", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ExportUserData returns an endpoint that makes HTTP requests to the
+// project-service service export-user-data server.
+func (c *Client) ExportUserData() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeExportUserDataRequest(c.encoder)
+		decodeResponse = DecodeExportUserDataResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildExportUserDataRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ExportUserDataDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "export-user-data", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RemoveUserAccess returns an endpoint that makes HTTP requests to the
+// project-service service remove-user-access server.
+func (c *Client) RemoveUserAccess() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRemoveUserAccessRequest(c.encoder)
+		decodeResponse = DecodeRemoveUserAccessResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRemoveUserAccessRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RemoveUserAccessDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "remove-user-access", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetProjectVisibilityImpact returns an endpoint that makes HTTP requests to
+// the project-service service get-project-visibility-impact server.
+func (c *Client) GetProjectVisibilityImpact() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetProjectVisibilityImpactRequest(c.encoder)
+		decodeResponse = DecodeGetProjectVisibilityImpactResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetProjectVisibilityImpactRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetProjectVisibilityImpactDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "get-project-visibility-impact", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateWebhook returns an endpoint that makes HTTP requests to the
+// project-service service create-webhook server.
+func (c *Client) CreateWebhook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateWebhookRequest(c.encoder)
+		decodeResponse = DecodeCreateWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "create-webhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListWebhooks returns an endpoint that makes HTTP requests to the
+// project-service service list-webhooks server.
+func (c *Client) ListWebhooks() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListWebhooksRequest(c.encoder)
+		decodeResponse = DecodeListWebhooksResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListWebhooksRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListWebhooksDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "list-webhooks", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetWebhook returns an endpoint that makes HTTP requests to the
+// project-service service get-webhook server.
+func (c *Client) GetWebhook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetWebhookRequest(c.encoder)
+		decodeResponse = DecodeGetWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "get-webhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteWebhook returns an endpoint that makes HTTP requests to the
+// project-service service delete-webhook server.
+func (c *Client) DeleteWebhook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteWebhookRequest(c.encoder)
+		decodeResponse = DecodeDeleteWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "delete-webhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListWebhookDeliveries returns an endpoint that makes HTTP requests to the
+// project-service service list-webhook-deliveries server.
+func (c *Client) ListWebhookDeliveries() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListWebhookDeliveriesRequest(c.encoder)
+		decodeResponse = DecodeListWebhookDeliveriesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListWebhookDeliveriesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListWebhookDeliveriesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("project-service", "list-webhook-deliveries", err)
+		}
+		return decodeResponse(resp)
+	}
+}
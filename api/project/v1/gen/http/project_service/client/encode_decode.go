@@ -12,6 +12,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
@@ -23,13 +24,23 @@ import (
 	goahttp "goa.design/goa/v3/http"
 )
 
-// BuildGetProjectsRequest instantiates a HTTP request object with method and
-// path set to call the "project-service" service "get-projects" endpoint
-func (c *Client) BuildGetProjectsRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProjectsProjectServicePath()}
-	req, err := http.NewRequest("GET", u.String(), nil)
+// BuildCloneProjectRequest instantiates a HTTP request object with method and
+// path set to call the "project-service" service "clone-project" endpoint
+func (c *Client) BuildCloneProjectRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*projectservice.CloneProjectPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "clone-project", "*projectservice.CloneProjectPayload", v)
+		}
+		uid = p.UID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CloneProjectProjectServicePath(uid)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("project-service", "get-projects", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("project-service", "clone-project", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -38,13 +49,13 @@ func (c *Client) BuildGetProjectsRequest(ctx context.Context, v any) (*http.Requ
 	return req, nil
 }
 
-// EncodeGetProjectsRequest returns an encoder for requests sent to the
-// project-service get-projects server.
-func EncodeGetProjectsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeCloneProjectRequest returns an encoder for requests sent to the
+// project-service clone-project server.
+func EncodeCloneProjectRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*projectservice.GetProjectsPayload)
+		p, ok := v.(*projectservice.CloneProjectPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("project-service", "get-projects", "*projectservice.GetProjectsPayload", v)
+			return goahttp.ErrInvalidType("project-service", "clone-project", "*projectservice.CloneProjectPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -54,24 +65,37 @@ func EncodeGetProjectsRequest(encoder func(*http.Request) goahttp.Encoder) func(
 				req.Header.Set("Authorization", head)
 			}
 		}
+		if p.XSync != nil {
+			head := *p.XSync
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Sync", headStr)
+		}
 		values := req.URL.Query()
 		if p.Version != nil {
 			values.Add("v", *p.Version)
 		}
 		req.URL.RawQuery = values.Encode()
+		body := NewCloneProjectRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("project-service", "clone-project", err)
+		}
 		return nil
 	}
 }
 
-// DecodeGetProjectsResponse returns a decoder for responses returned by the
-// project-service get-projects endpoint. restoreBody controls whether the
+// DecodeCloneProjectResponse returns a decoder for responses returned by the
+// project-service clone-project endpoint. restoreBody controls whether the
 // response body should be restored after having been read.
-// DecodeGetProjectsResponse may return the following errors:
+// DecodeCloneProjectResponse may return the following errors:
 //   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "UnprocessableEntity" (type *projectservice.UnprocessableEntityError): http.StatusUnprocessableEntity
 //   - error: internal error
-func DecodeGetProjectsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeCloneProjectResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -86,84 +110,144 @@ func DecodeGetProjectsResponse(decoder func(*http.Response) goahttp.Decoder, res
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusOK:
+		case http.StatusCreated:
 			var (
-				body GetProjectsResponseBody
+				body CloneProjectResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-projects", err)
+				return nil, goahttp.ErrDecodingError("project-service", "clone-project", err)
 			}
-			err = ValidateGetProjectsResponseBody(&body)
+			err = ValidateCloneProjectResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-projects", err)
+				return nil, goahttp.ErrValidationError("project-service", "clone-project", err)
 			}
+			res := NewCloneProjectProjectFullCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
 			var (
-				cacheControl *string
+				body CloneProjectBadRequestResponseBody
+				err  error
 			)
-			cacheControlRaw := resp.Header.Get("Cache-Control")
-			if cacheControlRaw != "" {
-				cacheControl = &cacheControlRaw
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "clone-project", err)
 			}
-			res := NewGetProjectsResultOK(&body, cacheControl)
-			return res, nil
-		case http.StatusBadRequest:
+			err = ValidateCloneProjectBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "clone-project", err)
+			}
+			return nil, NewCloneProjectBadRequest(&body)
+		case http.StatusConflict:
 			var (
-				body GetProjectsBadRequestResponseBody
+				body CloneProjectConflictResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-projects", err)
+				return nil, goahttp.ErrDecodingError("project-service", "clone-project", err)
 			}
-			err = ValidateGetProjectsBadRequestResponseBody(&body)
+			err = ValidateCloneProjectConflictResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-projects", err)
+				return nil, goahttp.ErrValidationError("project-service", "clone-project", err)
 			}
-			return nil, NewGetProjectsBadRequest(&body)
+			return nil, NewCloneProjectConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body CloneProjectForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "clone-project", err)
+			}
+			err = ValidateCloneProjectForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "clone-project", err)
+			}
+			return nil, NewCloneProjectForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetProjectsInternalServerErrorResponseBody
+				body CloneProjectInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-projects", err)
+				return nil, goahttp.ErrDecodingError("project-service", "clone-project", err)
 			}
-			err = ValidateGetProjectsInternalServerErrorResponseBody(&body)
+			err = ValidateCloneProjectInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-projects", err)
+				return nil, goahttp.ErrValidationError("project-service", "clone-project", err)
 			}
-			return nil, NewGetProjectsInternalServerError(&body)
+			return nil, NewCloneProjectInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body CloneProjectNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "clone-project", err)
+			}
+			err = ValidateCloneProjectNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "clone-project", err)
+			}
+			return nil, NewCloneProjectNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body GetProjectsServiceUnavailableResponseBody
+				body CloneProjectServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-projects", err)
+				return nil, goahttp.ErrDecodingError("project-service", "clone-project", err)
 			}
-			err = ValidateGetProjectsServiceUnavailableResponseBody(&body)
+			err = ValidateCloneProjectServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-projects", err)
+				return nil, goahttp.ErrValidationError("project-service", "clone-project", err)
 			}
-			return nil, NewGetProjectsServiceUnavailable(&body)
+			return nil, NewCloneProjectServiceUnavailable(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body CloneProjectUnprocessableEntityResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "clone-project", err)
+			}
+			err = ValidateCloneProjectUnprocessableEntityResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "clone-project", err)
+			}
+			return nil, NewCloneProjectUnprocessableEntity(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("project-service", "get-projects", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("project-service", "clone-project", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildCreateProjectRequest instantiates a HTTP request object with method and
-// path set to call the "project-service" service "create-project" endpoint
-func (c *Client) BuildCreateProjectRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateProjectProjectServicePath()}
+// BuildUploadProjectLogoRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "upload-project-logo"
+// endpoint
+func (c *Client) BuildUploadProjectLogoRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*projectservice.UploadProjectLogoPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "upload-project-logo", "*projectservice.UploadProjectLogoPayload", v)
+		}
+		uid = p.UID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UploadProjectLogoProjectServicePath(uid)}
 	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("project-service", "create-project", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("project-service", "upload-project-logo", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -172,13 +256,13 @@ func (c *Client) BuildCreateProjectRequest(ctx context.Context, v any) (*http.Re
 	return req, nil
 }
 
-// EncodeCreateProjectRequest returns an encoder for requests sent to the
-// project-service create-project server.
-func EncodeCreateProjectRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeUploadProjectLogoRequest returns an encoder for requests sent to the
+// project-service upload-project-logo server.
+func EncodeUploadProjectLogoRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*projectservice.CreateProjectPayload)
+		p, ok := v.(*projectservice.UploadProjectLogoPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("project-service", "create-project", "*projectservice.CreateProjectPayload", v)
+			return goahttp.ErrInvalidType("project-service", "upload-project-logo", "*projectservice.UploadProjectLogoPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -198,24 +282,43 @@ func EncodeCreateProjectRequest(encoder func(*http.Request) goahttp.Encoder) fun
 			values.Add("v", *p.Version)
 		}
 		req.URL.RawQuery = values.Encode()
-		body := NewCreateProjectRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("project-service", "create-project", err)
+		if err := encoder(req).Encode(p); err != nil {
+			return goahttp.ErrEncodingError("project-service", "upload-project-logo", err)
 		}
 		return nil
 	}
 }
 
-// DecodeCreateProjectResponse returns a decoder for responses returned by the
-// project-service create-project endpoint. restoreBody controls whether the
-// response body should be restored after having been read.
-// DecodeCreateProjectResponse may return the following errors:
+// NewProjectServiceUploadProjectLogoEncoder returns an encoder to encode the
+// multipart request for the "project-service" service "upload-project-logo"
+// endpoint.
+func NewProjectServiceUploadProjectLogoEncoder(encoderFn ProjectServiceUploadProjectLogoEncoderFunc) func(r *http.Request) goahttp.Encoder {
+	return func(r *http.Request) goahttp.Encoder {
+		body := &bytes.Buffer{}
+		mw := multipart.NewWriter(body)
+		return goahttp.EncodingFunc(func(v any) error {
+			p := v.(*projectservice.UploadProjectLogoPayload)
+			if err := encoderFn(mw, p); err != nil {
+				return err
+			}
+			r.Body = io.NopCloser(body)
+			r.Header.Set("Content-Type", mw.FormDataContentType())
+			return mw.Close()
+		})
+	}
+}
+
+// DecodeUploadProjectLogoResponse returns a decoder for responses returned by
+// the project-service upload-project-logo endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeUploadProjectLogoResponse may return the following errors:
 //   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
-//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeCreateProjectResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeUploadProjectLogoResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -230,104 +333,117 @@ func DecodeCreateProjectResponse(decoder func(*http.Response) goahttp.Decoder, r
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusCreated:
+		case http.StatusOK:
 			var (
-				body CreateProjectResponseBody
+				body UploadProjectLogoResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "create-project", err)
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-logo", err)
 			}
-			err = ValidateCreateProjectResponseBody(&body)
+			err = ValidateUploadProjectLogoResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "create-project", err)
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-logo", err)
 			}
-			res := NewCreateProjectProjectFullCreated(&body)
+			res := NewUploadProjectLogoProjectBaseOK(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body CreateProjectBadRequestResponseBody
+				body UploadProjectLogoBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "create-project", err)
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-logo", err)
 			}
-			err = ValidateCreateProjectBadRequestResponseBody(&body)
+			err = ValidateUploadProjectLogoBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "create-project", err)
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-logo", err)
 			}
-			return nil, NewCreateProjectBadRequest(&body)
-		case http.StatusConflict:
+			return nil, NewUploadProjectLogoBadRequest(&body)
+		case http.StatusForbidden:
 			var (
-				body CreateProjectConflictResponseBody
+				body UploadProjectLogoForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "create-project", err)
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-logo", err)
 			}
-			err = ValidateCreateProjectConflictResponseBody(&body)
+			err = ValidateUploadProjectLogoForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "create-project", err)
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-logo", err)
 			}
-			return nil, NewCreateProjectConflict(&body)
+			return nil, NewUploadProjectLogoForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body CreateProjectInternalServerErrorResponseBody
+				body UploadProjectLogoInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "create-project", err)
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-logo", err)
 			}
-			err = ValidateCreateProjectInternalServerErrorResponseBody(&body)
+			err = ValidateUploadProjectLogoInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "create-project", err)
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-logo", err)
 			}
-			return nil, NewCreateProjectInternalServerError(&body)
+			return nil, NewUploadProjectLogoInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body UploadProjectLogoNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-logo", err)
+			}
+			err = ValidateUploadProjectLogoNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-logo", err)
+			}
+			return nil, NewUploadProjectLogoNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body CreateProjectServiceUnavailableResponseBody
+				body UploadProjectLogoServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "create-project", err)
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-logo", err)
 			}
-			err = ValidateCreateProjectServiceUnavailableResponseBody(&body)
+			err = ValidateUploadProjectLogoServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "create-project", err)
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-logo", err)
 			}
-			return nil, NewCreateProjectServiceUnavailable(&body)
+			return nil, NewUploadProjectLogoServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("project-service", "create-project", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("project-service", "upload-project-logo", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetOneProjectBaseRequest instantiates a HTTP request object with method
-// and path set to call the "project-service" service "get-one-project-base"
-// endpoint
-func (c *Client) BuildGetOneProjectBaseRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildMergeProjectRequest instantiates a HTTP request object with method and
+// path set to call the "project-service" service "merge-project" endpoint
+func (c *Client) BuildMergeProjectRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		uid string
+		uid       string
+		targetUID string
 	)
 	{
-		p, ok := v.(*projectservice.GetOneProjectBasePayload)
+		p, ok := v.(*projectservice.MergeProjectPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("project-service", "get-one-project-base", "*projectservice.GetOneProjectBasePayload", v)
-		}
-		if p.UID != nil {
-			uid = *p.UID
+			return nil, goahttp.ErrInvalidType("project-service", "merge-project", "*projectservice.MergeProjectPayload", v)
 		}
+		uid = p.UID
+		targetUID = p.TargetUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetOneProjectBaseProjectServicePath(uid)}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: MergeProjectProjectServicePath(uid, targetUID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("project-service", "get-one-project-base", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("project-service", "merge-project", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -336,13 +452,13 @@ func (c *Client) BuildGetOneProjectBaseRequest(ctx context.Context, v any) (*htt
 	return req, nil
 }
 
-// EncodeGetOneProjectBaseRequest returns an encoder for requests sent to the
-// project-service get-one-project-base server.
-func EncodeGetOneProjectBaseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeMergeProjectRequest returns an encoder for requests sent to the
+// project-service merge-project server.
+func EncodeMergeProjectRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*projectservice.GetOneProjectBasePayload)
+		p, ok := v.(*projectservice.MergeProjectPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("project-service", "get-one-project-base", "*projectservice.GetOneProjectBasePayload", v)
+			return goahttp.ErrInvalidType("project-service", "merge-project", "*projectservice.MergeProjectPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -352,6 +468,20 @@ func EncodeGetOneProjectBaseRequest(encoder func(*http.Request) goahttp.Encoder)
 				req.Header.Set("Authorization", head)
 			}
 		}
+		if p.XSync != nil {
+			head := *p.XSync
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Sync", headStr)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.ForceUpdate != nil {
+			head := *p.ForceUpdate
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Force-Update", headStr)
+		}
 		values := req.URL.Query()
 		if p.Version != nil {
 			values.Add("v", *p.Version)
@@ -361,15 +491,19 @@ func EncodeGetOneProjectBaseRequest(encoder func(*http.Request) goahttp.Encoder)
 	}
 }
 
-// DecodeGetOneProjectBaseResponse returns a decoder for responses returned by
-// the project-service get-one-project-base endpoint. restoreBody controls
-// whether the response body should be restored after having been read.
-// DecodeGetOneProjectBaseResponse may return the following errors:
+// DecodeMergeProjectResponse returns a decoder for responses returned by the
+// project-service merge-project endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeMergeProjectResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "UnprocessableEntity" (type *projectservice.UnprocessableEntityError): http.StatusUnprocessableEntity
 //   - error: internal error
-func DecodeGetOneProjectBaseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeMergeProjectResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -386,95 +520,142 @@ func DecodeGetOneProjectBaseResponse(decoder func(*http.Response) goahttp.Decode
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetOneProjectBaseResponseBody
+				body MergeProjectResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-one-project-base", err)
+				return nil, goahttp.ErrDecodingError("project-service", "merge-project", err)
 			}
-			err = ValidateGetOneProjectBaseResponseBody(&body)
+			err = ValidateMergeProjectResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-one-project-base", err)
+				return nil, goahttp.ErrValidationError("project-service", "merge-project", err)
 			}
+			res := NewMergeProjectProjectFullOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
 			var (
-				etag *string
+				body MergeProjectBadRequestResponseBody
+				err  error
 			)
-			etagRaw := resp.Header.Get("Etag")
-			if etagRaw != "" {
-				etag = &etagRaw
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "merge-project", err)
 			}
-			res := NewGetOneProjectBaseResultOK(&body, etag)
-			return res, nil
-		case http.StatusInternalServerError:
+			err = ValidateMergeProjectBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "merge-project", err)
+			}
+			return nil, NewMergeProjectBadRequest(&body)
+		case http.StatusConflict:
 			var (
-				body GetOneProjectBaseInternalServerErrorResponseBody
+				body MergeProjectConflictResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-one-project-base", err)
+				return nil, goahttp.ErrDecodingError("project-service", "merge-project", err)
 			}
-			err = ValidateGetOneProjectBaseInternalServerErrorResponseBody(&body)
+			err = ValidateMergeProjectConflictResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-one-project-base", err)
+				return nil, goahttp.ErrValidationError("project-service", "merge-project", err)
 			}
-			return nil, NewGetOneProjectBaseInternalServerError(&body)
-		case http.StatusNotFound:
+			return nil, NewMergeProjectConflict(&body)
+		case http.StatusForbidden:
 			var (
-				body GetOneProjectBaseNotFoundResponseBody
+				body MergeProjectForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-one-project-base", err)
+				return nil, goahttp.ErrDecodingError("project-service", "merge-project", err)
 			}
-			err = ValidateGetOneProjectBaseNotFoundResponseBody(&body)
+			err = ValidateMergeProjectForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-one-project-base", err)
+				return nil, goahttp.ErrValidationError("project-service", "merge-project", err)
 			}
-			return nil, NewGetOneProjectBaseNotFound(&body)
-		case http.StatusServiceUnavailable:
+			return nil, NewMergeProjectForbidden(&body)
+		case http.StatusInternalServerError:
 			var (
-				body GetOneProjectBaseServiceUnavailableResponseBody
+				body MergeProjectInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-one-project-base", err)
+				return nil, goahttp.ErrDecodingError("project-service", "merge-project", err)
 			}
-			err = ValidateGetOneProjectBaseServiceUnavailableResponseBody(&body)
+			err = ValidateMergeProjectInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-one-project-base", err)
+				return nil, goahttp.ErrValidationError("project-service", "merge-project", err)
 			}
-			return nil, NewGetOneProjectBaseServiceUnavailable(&body)
-		default:
-			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("project-service", "get-one-project-base", resp.StatusCode, string(body))
+			return nil, NewMergeProjectInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body MergeProjectNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "merge-project", err)
+			}
+			err = ValidateMergeProjectNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "merge-project", err)
+			}
+			return nil, NewMergeProjectNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body MergeProjectServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "merge-project", err)
+			}
+			err = ValidateMergeProjectServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "merge-project", err)
+			}
+			return nil, NewMergeProjectServiceUnavailable(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body MergeProjectUnprocessableEntityResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "merge-project", err)
+			}
+			err = ValidateMergeProjectUnprocessableEntityResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "merge-project", err)
+			}
+			return nil, NewMergeProjectUnprocessableEntity(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "merge-project", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetOneProjectSettingsRequest instantiates a HTTP request object with
-// method and path set to call the "project-service" service
-// "get-one-project-settings" endpoint
-func (c *Client) BuildGetOneProjectSettingsRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildTransferProjectRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "transfer-project"
+// endpoint
+func (c *Client) BuildTransferProjectRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		uid string
 	)
 	{
-		p, ok := v.(*projectservice.GetOneProjectSettingsPayload)
+		p, ok := v.(*projectservice.TransferProjectPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("project-service", "get-one-project-settings", "*projectservice.GetOneProjectSettingsPayload", v)
-		}
-		if p.UID != nil {
-			uid = *p.UID
+			return nil, goahttp.ErrInvalidType("project-service", "transfer-project", "*projectservice.TransferProjectPayload", v)
 		}
+		uid = p.UID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetOneProjectSettingsProjectServicePath(uid)}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: TransferProjectProjectServicePath(uid)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("project-service", "get-one-project-settings", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("project-service", "transfer-project", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -483,13 +664,13 @@ func (c *Client) BuildGetOneProjectSettingsRequest(ctx context.Context, v any) (
 	return req, nil
 }
 
-// EncodeGetOneProjectSettingsRequest returns an encoder for requests sent to
-// the project-service get-one-project-settings server.
-func EncodeGetOneProjectSettingsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeTransferProjectRequest returns an encoder for requests sent to the
+// project-service transfer-project server.
+func EncodeTransferProjectRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*projectservice.GetOneProjectSettingsPayload)
+		p, ok := v.(*projectservice.TransferProjectPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("project-service", "get-one-project-settings", "*projectservice.GetOneProjectSettingsPayload", v)
+			return goahttp.ErrInvalidType("project-service", "transfer-project", "*projectservice.TransferProjectPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -499,24 +680,46 @@ func EncodeGetOneProjectSettingsRequest(encoder func(*http.Request) goahttp.Enco
 				req.Header.Set("Authorization", head)
 			}
 		}
+		if p.XSync != nil {
+			head := *p.XSync
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Sync", headStr)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.ForceUpdate != nil {
+			head := *p.ForceUpdate
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Force-Update", headStr)
+		}
 		values := req.URL.Query()
 		if p.Version != nil {
 			values.Add("v", *p.Version)
 		}
 		req.URL.RawQuery = values.Encode()
+		body := NewTransferProjectRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("project-service", "transfer-project", err)
+		}
 		return nil
 	}
 }
 
-// DecodeGetOneProjectSettingsResponse returns a decoder for responses returned
-// by the project-service get-one-project-settings endpoint. restoreBody
-// controls whether the response body should be restored after having been read.
-// DecodeGetOneProjectSettingsResponse may return the following errors:
+// DecodeTransferProjectResponse returns a decoder for responses returned by
+// the project-service transfer-project endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeTransferProjectResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "UnprocessableEntity" (type *projectservice.UnprocessableEntityError): http.StatusUnprocessableEntity
 //   - error: internal error
-func DecodeGetOneProjectSettingsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeTransferProjectResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -533,95 +736,142 @@ func DecodeGetOneProjectSettingsResponse(decoder func(*http.Response) goahttp.De
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetOneProjectSettingsResponseBody
+				body TransferProjectResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-one-project-settings", err)
+				return nil, goahttp.ErrDecodingError("project-service", "transfer-project", err)
 			}
-			err = ValidateGetOneProjectSettingsResponseBody(&body)
+			err = ValidateTransferProjectResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-one-project-settings", err)
+				return nil, goahttp.ErrValidationError("project-service", "transfer-project", err)
 			}
+			res := NewTransferProjectProjectFullOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
 			var (
-				etag *string
+				body TransferProjectBadRequestResponseBody
+				err  error
 			)
-			etagRaw := resp.Header.Get("Etag")
-			if etagRaw != "" {
-				etag = &etagRaw
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "transfer-project", err)
 			}
-			res := NewGetOneProjectSettingsResultOK(&body, etag)
-			return res, nil
+			err = ValidateTransferProjectBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "transfer-project", err)
+			}
+			return nil, NewTransferProjectBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body TransferProjectConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "transfer-project", err)
+			}
+			err = ValidateTransferProjectConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "transfer-project", err)
+			}
+			return nil, NewTransferProjectConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body TransferProjectForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "transfer-project", err)
+			}
+			err = ValidateTransferProjectForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "transfer-project", err)
+			}
+			return nil, NewTransferProjectForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetOneProjectSettingsInternalServerErrorResponseBody
+				body TransferProjectInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-one-project-settings", err)
+				return nil, goahttp.ErrDecodingError("project-service", "transfer-project", err)
 			}
-			err = ValidateGetOneProjectSettingsInternalServerErrorResponseBody(&body)
+			err = ValidateTransferProjectInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-one-project-settings", err)
+				return nil, goahttp.ErrValidationError("project-service", "transfer-project", err)
 			}
-			return nil, NewGetOneProjectSettingsInternalServerError(&body)
+			return nil, NewTransferProjectInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body GetOneProjectSettingsNotFoundResponseBody
+				body TransferProjectNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-one-project-settings", err)
+				return nil, goahttp.ErrDecodingError("project-service", "transfer-project", err)
 			}
-			err = ValidateGetOneProjectSettingsNotFoundResponseBody(&body)
+			err = ValidateTransferProjectNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-one-project-settings", err)
+				return nil, goahttp.ErrValidationError("project-service", "transfer-project", err)
 			}
-			return nil, NewGetOneProjectSettingsNotFound(&body)
+			return nil, NewTransferProjectNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body GetOneProjectSettingsServiceUnavailableResponseBody
+				body TransferProjectServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-one-project-settings", err)
+				return nil, goahttp.ErrDecodingError("project-service", "transfer-project", err)
 			}
-			err = ValidateGetOneProjectSettingsServiceUnavailableResponseBody(&body)
+			err = ValidateTransferProjectServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-one-project-settings", err)
+				return nil, goahttp.ErrValidationError("project-service", "transfer-project", err)
 			}
-			return nil, NewGetOneProjectSettingsServiceUnavailable(&body)
+			return nil, NewTransferProjectServiceUnavailable(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body TransferProjectUnprocessableEntityResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "transfer-project", err)
+			}
+			err = ValidateTransferProjectUnprocessableEntityResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "transfer-project", err)
+			}
+			return nil, NewTransferProjectUnprocessableEntity(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("project-service", "get-one-project-settings", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("project-service", "transfer-project", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildUpdateProjectBaseRequest instantiates a HTTP request object with method
-// and path set to call the "project-service" service "update-project-base"
+// BuildCreateProjectLinkRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "create-project-link"
 // endpoint
-func (c *Client) BuildUpdateProjectBaseRequest(ctx context.Context, v any) (*http.Request, error) {
+func (c *Client) BuildCreateProjectLinkRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		uid string
 	)
 	{
-		p, ok := v.(*projectservice.UpdateProjectBasePayload)
+		p, ok := v.(*projectservice.CreateProjectLinkPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("project-service", "update-project-base", "*projectservice.UpdateProjectBasePayload", v)
-		}
-		if p.UID != nil {
-			uid = *p.UID
+			return nil, goahttp.ErrInvalidType("project-service", "create-project-link", "*projectservice.CreateProjectLinkPayload", v)
 		}
+		uid = p.UID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateProjectBaseProjectServicePath(uid)}
-	req, err := http.NewRequest("PUT", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateProjectLinkProjectServicePath(uid)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("project-service", "update-project-base", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("project-service", "create-project-link", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -630,13 +880,13 @@ func (c *Client) BuildUpdateProjectBaseRequest(ctx context.Context, v any) (*htt
 	return req, nil
 }
 
-// EncodeUpdateProjectBaseRequest returns an encoder for requests sent to the
-// project-service update-project-base server.
-func EncodeUpdateProjectBaseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeCreateProjectLinkRequest returns an encoder for requests sent to the
+// project-service create-project-link server.
+func EncodeCreateProjectLinkRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*projectservice.UpdateProjectBasePayload)
+		p, ok := v.(*projectservice.CreateProjectLinkPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("project-service", "update-project-base", "*projectservice.UpdateProjectBasePayload", v)
+			return goahttp.ErrInvalidType("project-service", "create-project-link", "*projectservice.CreateProjectLinkPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -651,34 +901,30 @@ func EncodeUpdateProjectBaseRequest(encoder func(*http.Request) goahttp.Encoder)
 			headStr := strconv.FormatBool(head)
 			req.Header.Set("X-Sync", headStr)
 		}
-		if p.IfMatch != nil {
-			head := *p.IfMatch
-			req.Header.Set("If-Match", head)
-		}
 		values := req.URL.Query()
 		if p.Version != nil {
 			values.Add("v", *p.Version)
 		}
 		req.URL.RawQuery = values.Encode()
-		body := NewUpdateProjectBaseRequestBody(p)
+		body := NewCreateProjectLinkRequestBody(p)
 		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("project-service", "update-project-base", err)
+			return goahttp.ErrEncodingError("project-service", "create-project-link", err)
 		}
 		return nil
 	}
 }
 
-// DecodeUpdateProjectBaseResponse returns a decoder for responses returned by
-// the project-service update-project-base endpoint. restoreBody controls
+// DecodeCreateProjectLinkResponse returns a decoder for responses returned by
+// the project-service create-project-link endpoint. restoreBody controls
 // whether the response body should be restored after having been read.
-// DecodeUpdateProjectBaseResponse may return the following errors:
+// DecodeCreateProjectLinkResponse may return the following errors:
 //   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
-//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeUpdateProjectBaseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeCreateProjectLinkResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -693,118 +939,118 @@ func DecodeUpdateProjectBaseResponse(decoder func(*http.Response) goahttp.Decode
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusOK:
+		case http.StatusCreated:
 			var (
-				body UpdateProjectBaseResponseBody
+				body CreateProjectLinkResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "update-project-base", err)
+				return nil, goahttp.ErrDecodingError("project-service", "create-project-link", err)
 			}
-			err = ValidateUpdateProjectBaseResponseBody(&body)
+			err = ValidateCreateProjectLinkResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "update-project-base", err)
+				return nil, goahttp.ErrValidationError("project-service", "create-project-link", err)
 			}
-			res := NewUpdateProjectBaseProjectBaseOK(&body)
+			res := NewCreateProjectLinkProjectLinkCreated(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body UpdateProjectBaseBadRequestResponseBody
+				body CreateProjectLinkBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "update-project-base", err)
+				return nil, goahttp.ErrDecodingError("project-service", "create-project-link", err)
 			}
-			err = ValidateUpdateProjectBaseBadRequestResponseBody(&body)
+			err = ValidateCreateProjectLinkBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "update-project-base", err)
+				return nil, goahttp.ErrValidationError("project-service", "create-project-link", err)
 			}
-			return nil, NewUpdateProjectBaseBadRequest(&body)
-		case http.StatusConflict:
+			return nil, NewCreateProjectLinkBadRequest(&body)
+		case http.StatusForbidden:
 			var (
-				body UpdateProjectBaseConflictResponseBody
+				body CreateProjectLinkForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "update-project-base", err)
+				return nil, goahttp.ErrDecodingError("project-service", "create-project-link", err)
 			}
-			err = ValidateUpdateProjectBaseConflictResponseBody(&body)
+			err = ValidateCreateProjectLinkForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "update-project-base", err)
+				return nil, goahttp.ErrValidationError("project-service", "create-project-link", err)
 			}
-			return nil, NewUpdateProjectBaseConflict(&body)
+			return nil, NewCreateProjectLinkForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body UpdateProjectBaseInternalServerErrorResponseBody
+				body CreateProjectLinkInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "update-project-base", err)
+				return nil, goahttp.ErrDecodingError("project-service", "create-project-link", err)
 			}
-			err = ValidateUpdateProjectBaseInternalServerErrorResponseBody(&body)
+			err = ValidateCreateProjectLinkInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "update-project-base", err)
+				return nil, goahttp.ErrValidationError("project-service", "create-project-link", err)
 			}
-			return nil, NewUpdateProjectBaseInternalServerError(&body)
+			return nil, NewCreateProjectLinkInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body UpdateProjectBaseNotFoundResponseBody
+				body CreateProjectLinkNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "update-project-base", err)
+				return nil, goahttp.ErrDecodingError("project-service", "create-project-link", err)
 			}
-			err = ValidateUpdateProjectBaseNotFoundResponseBody(&body)
+			err = ValidateCreateProjectLinkNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "update-project-base", err)
+				return nil, goahttp.ErrValidationError("project-service", "create-project-link", err)
 			}
-			return nil, NewUpdateProjectBaseNotFound(&body)
+			return nil, NewCreateProjectLinkNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body UpdateProjectBaseServiceUnavailableResponseBody
+				body CreateProjectLinkServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "update-project-base", err)
+				return nil, goahttp.ErrDecodingError("project-service", "create-project-link", err)
 			}
-			err = ValidateUpdateProjectBaseServiceUnavailableResponseBody(&body)
+			err = ValidateCreateProjectLinkServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "update-project-base", err)
+				return nil, goahttp.ErrValidationError("project-service", "create-project-link", err)
 			}
-			return nil, NewUpdateProjectBaseServiceUnavailable(&body)
+			return nil, NewCreateProjectLinkServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("project-service", "update-project-base", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("project-service", "create-project-link", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildUpdateProjectSettingsRequest instantiates a HTTP request object with
-// method and path set to call the "project-service" service
-// "update-project-settings" endpoint
-func (c *Client) BuildUpdateProjectSettingsRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildGetProjectLinkRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "get-project-link"
+// endpoint
+func (c *Client) BuildGetProjectLinkRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		uid string
+		uid     string
+		linkUID string
 	)
 	{
-		p, ok := v.(*projectservice.UpdateProjectSettingsPayload)
+		p, ok := v.(*projectservice.GetProjectLinkPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("project-service", "update-project-settings", "*projectservice.UpdateProjectSettingsPayload", v)
-		}
-		if p.UID != nil {
-			uid = *p.UID
+			return nil, goahttp.ErrInvalidType("project-service", "get-project-link", "*projectservice.GetProjectLinkPayload", v)
 		}
+		uid = p.UID
+		linkUID = p.LinkUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateProjectSettingsProjectServicePath(uid)}
-	req, err := http.NewRequest("PUT", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProjectLinkProjectServicePath(uid, linkUID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("project-service", "update-project-settings", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("project-service", "get-project-link", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -813,13 +1059,13 @@ func (c *Client) BuildUpdateProjectSettingsRequest(ctx context.Context, v any) (
 	return req, nil
 }
 
-// EncodeUpdateProjectSettingsRequest returns an encoder for requests sent to
-// the project-service update-project-settings server.
-func EncodeUpdateProjectSettingsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeGetProjectLinkRequest returns an encoder for requests sent to the
+// project-service get-project-link server.
+func EncodeGetProjectLinkRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*projectservice.UpdateProjectSettingsPayload)
+		p, ok := v.(*projectservice.GetProjectLinkPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("project-service", "update-project-settings", "*projectservice.UpdateProjectSettingsPayload", v)
+			return goahttp.ErrInvalidType("project-service", "get-project-link", "*projectservice.GetProjectLinkPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -829,39 +1075,25 @@ func EncodeUpdateProjectSettingsRequest(encoder func(*http.Request) goahttp.Enco
 				req.Header.Set("Authorization", head)
 			}
 		}
-		if p.XSync != nil {
-			head := *p.XSync
-			headStr := strconv.FormatBool(head)
-			req.Header.Set("X-Sync", headStr)
-		}
-		if p.IfMatch != nil {
-			head := *p.IfMatch
-			req.Header.Set("If-Match", head)
-		}
 		values := req.URL.Query()
 		if p.Version != nil {
 			values.Add("v", *p.Version)
 		}
 		req.URL.RawQuery = values.Encode()
-		body := NewUpdateProjectSettingsRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("project-service", "update-project-settings", err)
-		}
 		return nil
 	}
 }
 
-// DecodeUpdateProjectSettingsResponse returns a decoder for responses returned
-// by the project-service update-project-settings endpoint. restoreBody
-// controls whether the response body should be restored after having been read.
-// DecodeUpdateProjectSettingsResponse may return the following errors:
-//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
-//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+// DecodeGetProjectLinkResponse returns a decoder for responses returned by the
+// project-service get-project-link endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetProjectLinkResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeUpdateProjectSettingsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetProjectLinkResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -878,115 +1110,109 @@ func DecodeUpdateProjectSettingsResponse(decoder func(*http.Response) goahttp.De
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body UpdateProjectSettingsResponseBody
+				body GetProjectLinkResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "update-project-settings", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-link", err)
 			}
-			err = ValidateUpdateProjectSettingsResponseBody(&body)
+			err = ValidateGetProjectLinkResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "update-project-settings", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-link", err)
 			}
-			res := NewUpdateProjectSettingsProjectSettingsOK(&body)
-			return res, nil
-		case http.StatusBadRequest:
 			var (
-				body UpdateProjectSettingsBadRequestResponseBody
-				err  error
+				etag *string
 			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "update-project-settings", err)
-			}
-			err = ValidateUpdateProjectSettingsBadRequestResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "update-project-settings", err)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw != "" {
+				etag = &etagRaw
 			}
-			return nil, NewUpdateProjectSettingsBadRequest(&body)
-		case http.StatusConflict:
+			res := NewGetProjectLinkResultOK(&body, etag)
+			return res, nil
+		case http.StatusForbidden:
 			var (
-				body UpdateProjectSettingsConflictResponseBody
+				body GetProjectLinkForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "update-project-settings", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-link", err)
 			}
-			err = ValidateUpdateProjectSettingsConflictResponseBody(&body)
+			err = ValidateGetProjectLinkForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "update-project-settings", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-link", err)
 			}
-			return nil, NewUpdateProjectSettingsConflict(&body)
+			return nil, NewGetProjectLinkForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body UpdateProjectSettingsInternalServerErrorResponseBody
+				body GetProjectLinkInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "update-project-settings", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-link", err)
 			}
-			err = ValidateUpdateProjectSettingsInternalServerErrorResponseBody(&body)
+			err = ValidateGetProjectLinkInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "update-project-settings", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-link", err)
 			}
-			return nil, NewUpdateProjectSettingsInternalServerError(&body)
+			return nil, NewGetProjectLinkInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body UpdateProjectSettingsNotFoundResponseBody
+				body GetProjectLinkNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "update-project-settings", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-link", err)
 			}
-			err = ValidateUpdateProjectSettingsNotFoundResponseBody(&body)
+			err = ValidateGetProjectLinkNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "update-project-settings", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-link", err)
 			}
-			return nil, NewUpdateProjectSettingsNotFound(&body)
+			return nil, NewGetProjectLinkNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body UpdateProjectSettingsServiceUnavailableResponseBody
+				body GetProjectLinkServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "update-project-settings", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-link", err)
 			}
-			err = ValidateUpdateProjectSettingsServiceUnavailableResponseBody(&body)
+			err = ValidateGetProjectLinkServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "update-project-settings", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-link", err)
 			}
-			return nil, NewUpdateProjectSettingsServiceUnavailable(&body)
+			return nil, NewGetProjectLinkServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("project-service", "update-project-settings", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("project-service", "get-project-link", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildDeleteProjectRequest instantiates a HTTP request object with method and
-// path set to call the "project-service" service "delete-project" endpoint
-func (c *Client) BuildDeleteProjectRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildDeleteProjectLinkRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "delete-project-link"
+// endpoint
+func (c *Client) BuildDeleteProjectLinkRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		uid string
+		uid     string
+		linkUID string
 	)
 	{
-		p, ok := v.(*projectservice.DeleteProjectPayload)
+		p, ok := v.(*projectservice.DeleteProjectLinkPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("project-service", "delete-project", "*projectservice.DeleteProjectPayload", v)
-		}
-		if p.UID != nil {
-			uid = *p.UID
+			return nil, goahttp.ErrInvalidType("project-service", "delete-project-link", "*projectservice.DeleteProjectLinkPayload", v)
 		}
+		uid = p.UID
+		linkUID = p.LinkUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteProjectProjectServicePath(uid)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteProjectLinkProjectServicePath(uid, linkUID)}
 	req, err := http.NewRequest("DELETE", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("project-service", "delete-project", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("project-service", "delete-project-link", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -995,13 +1221,13 @@ func (c *Client) BuildDeleteProjectRequest(ctx context.Context, v any) (*http.Re
 	return req, nil
 }
 
-// EncodeDeleteProjectRequest returns an encoder for requests sent to the
-// project-service delete-project server.
-func EncodeDeleteProjectRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeDeleteProjectLinkRequest returns an encoder for requests sent to the
+// project-service delete-project-link server.
+func EncodeDeleteProjectLinkRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*projectservice.DeleteProjectPayload)
+		p, ok := v.(*projectservice.DeleteProjectLinkPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("project-service", "delete-project", "*projectservice.DeleteProjectPayload", v)
+			return goahttp.ErrInvalidType("project-service", "delete-project-link", "*projectservice.DeleteProjectLinkPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -1020,6 +1246,11 @@ func EncodeDeleteProjectRequest(encoder func(*http.Request) goahttp.Encoder) fun
 			head := *p.IfMatch
 			req.Header.Set("If-Match", head)
 		}
+		if p.ForceUpdate != nil {
+			head := *p.ForceUpdate
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Force-Update", headStr)
+		}
 		values := req.URL.Query()
 		if p.Version != nil {
 			values.Add("v", *p.Version)
@@ -1029,17 +1260,18 @@ func EncodeDeleteProjectRequest(encoder func(*http.Request) goahttp.Encoder) fun
 	}
 }
 
-// DecodeDeleteProjectResponse returns a decoder for responses returned by the
-// project-service delete-project endpoint. restoreBody controls whether the
-// response body should be restored after having been read.
-// DecodeDeleteProjectResponse may return the following errors:
+// DecodeDeleteProjectLinkResponse returns a decoder for responses returned by
+// the project-service delete-project-link endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeDeleteProjectLinkResponse may return the following errors:
 //   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
 //   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeDeleteProjectResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeDeleteProjectLinkResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1058,88 +1290,113 @@ func DecodeDeleteProjectResponse(decoder func(*http.Response) goahttp.Decoder, r
 			return nil, nil
 		case http.StatusBadRequest:
 			var (
-				body DeleteProjectBadRequestResponseBody
+				body DeleteProjectLinkBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project", err)
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-link", err)
 			}
-			err = ValidateDeleteProjectBadRequestResponseBody(&body)
+			err = ValidateDeleteProjectLinkBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project", err)
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-link", err)
 			}
-			return nil, NewDeleteProjectBadRequest(&body)
+			return nil, NewDeleteProjectLinkBadRequest(&body)
 		case http.StatusConflict:
 			var (
-				body DeleteProjectConflictResponseBody
+				body DeleteProjectLinkConflictResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project", err)
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-link", err)
 			}
-			err = ValidateDeleteProjectConflictResponseBody(&body)
+			err = ValidateDeleteProjectLinkConflictResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project", err)
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-link", err)
 			}
-			return nil, NewDeleteProjectConflict(&body)
+			return nil, NewDeleteProjectLinkConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body DeleteProjectLinkForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-link", err)
+			}
+			err = ValidateDeleteProjectLinkForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-link", err)
+			}
+			return nil, NewDeleteProjectLinkForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body DeleteProjectInternalServerErrorResponseBody
+				body DeleteProjectLinkInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project", err)
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-link", err)
 			}
-			err = ValidateDeleteProjectInternalServerErrorResponseBody(&body)
+			err = ValidateDeleteProjectLinkInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project", err)
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-link", err)
 			}
-			return nil, NewDeleteProjectInternalServerError(&body)
+			return nil, NewDeleteProjectLinkInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body DeleteProjectNotFoundResponseBody
+				body DeleteProjectLinkNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project", err)
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-link", err)
 			}
-			err = ValidateDeleteProjectNotFoundResponseBody(&body)
+			err = ValidateDeleteProjectLinkNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project", err)
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-link", err)
 			}
-			return nil, NewDeleteProjectNotFound(&body)
+			return nil, NewDeleteProjectLinkNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body DeleteProjectServiceUnavailableResponseBody
+				body DeleteProjectLinkServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project", err)
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-link", err)
 			}
-			err = ValidateDeleteProjectServiceUnavailableResponseBody(&body)
+			err = ValidateDeleteProjectLinkServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project", err)
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-link", err)
 			}
-			return nil, NewDeleteProjectServiceUnavailable(&body)
+			return nil, NewDeleteProjectLinkServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("project-service", "delete-project", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("project-service", "delete-project-link", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildReadyzRequest instantiates a HTTP request object with method and path
-// set to call the "project-service" service "readyz" endpoint
-func (c *Client) BuildReadyzRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ReadyzProjectServicePath()}
-	req, err := http.NewRequest("GET", u.String(), nil)
+// BuildCreateProjectFolderRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "create-project-folder" endpoint
+func (c *Client) BuildCreateProjectFolderRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*projectservice.CreateProjectFolderPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "create-project-folder", "*projectservice.CreateProjectFolderPayload", v)
+		}
+		uid = p.UID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateProjectFolderProjectServicePath(uid)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("project-service", "readyz", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("project-service", "create-project-folder", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1148,141 +1405,13 @@ func (c *Client) BuildReadyzRequest(ctx context.Context, v any) (*http.Request,
 	return req, nil
 }
 
-// DecodeReadyzResponse returns a decoder for responses returned by the
-// project-service readyz endpoint. restoreBody controls whether the response
-// body should be restored after having been read.
-// DecodeReadyzResponse may return the following errors:
-//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
-//   - error: internal error
-func DecodeReadyzResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
-	return func(resp *http.Response) (any, error) {
-		if restoreBody {
-			b, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return nil, err
-			}
-			resp.Body = io.NopCloser(bytes.NewBuffer(b))
-			defer func() {
-				resp.Body = io.NopCloser(bytes.NewBuffer(b))
-			}()
-		} else {
-			defer resp.Body.Close()
-		}
-		switch resp.StatusCode {
-		case http.StatusOK:
-			var (
-				body []byte
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "readyz", err)
-			}
-			return body, nil
-		case http.StatusServiceUnavailable:
-			var (
-				body ReadyzServiceUnavailableResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "readyz", err)
-			}
-			err = ValidateReadyzServiceUnavailableResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "readyz", err)
-			}
-			return nil, NewReadyzServiceUnavailable(&body)
-		default:
-			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("project-service", "readyz", resp.StatusCode, string(body))
-		}
-	}
-}
-
-// BuildLivezRequest instantiates a HTTP request object with method and path
-// set to call the "project-service" service "livez" endpoint
-func (c *Client) BuildLivezRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: LivezProjectServicePath()}
-	req, err := http.NewRequest("GET", u.String(), nil)
-	if err != nil {
-		return nil, goahttp.ErrInvalidURL("project-service", "livez", u.String(), err)
-	}
-	if ctx != nil {
-		req = req.WithContext(ctx)
-	}
-
-	return req, nil
-}
-
-// DecodeLivezResponse returns a decoder for responses returned by the
-// project-service livez endpoint. restoreBody controls whether the response
-// body should be restored after having been read.
-func DecodeLivezResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
-	return func(resp *http.Response) (any, error) {
-		if restoreBody {
-			b, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return nil, err
-			}
-			resp.Body = io.NopCloser(bytes.NewBuffer(b))
-			defer func() {
-				resp.Body = io.NopCloser(bytes.NewBuffer(b))
-			}()
-		} else {
-			defer resp.Body.Close()
-		}
-		switch resp.StatusCode {
-		case http.StatusOK:
-			var (
-				body []byte
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "livez", err)
-			}
-			return body, nil
-		default:
-			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("project-service", "livez", resp.StatusCode, string(body))
-		}
-	}
-}
-
-// BuildCreateProjectLinkRequest instantiates a HTTP request object with method
-// and path set to call the "project-service" service "create-project-link"
-// endpoint
-func (c *Client) BuildCreateProjectLinkRequest(ctx context.Context, v any) (*http.Request, error) {
-	var (
-		uid string
-	)
-	{
-		p, ok := v.(*projectservice.CreateProjectLinkPayload)
-		if !ok {
-			return nil, goahttp.ErrInvalidType("project-service", "create-project-link", "*projectservice.CreateProjectLinkPayload", v)
-		}
-		uid = p.UID
-	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateProjectLinkProjectServicePath(uid)}
-	req, err := http.NewRequest("POST", u.String(), nil)
-	if err != nil {
-		return nil, goahttp.ErrInvalidURL("project-service", "create-project-link", u.String(), err)
-	}
-	if ctx != nil {
-		req = req.WithContext(ctx)
-	}
-
-	return req, nil
-}
-
-// EncodeCreateProjectLinkRequest returns an encoder for requests sent to the
-// project-service create-project-link server.
-func EncodeCreateProjectLinkRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeCreateProjectFolderRequest returns an encoder for requests sent to the
+// project-service create-project-folder server.
+func EncodeCreateProjectFolderRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*projectservice.CreateProjectLinkPayload)
+		p, ok := v.(*projectservice.CreateProjectFolderPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("project-service", "create-project-link", "*projectservice.CreateProjectLinkPayload", v)
+			return goahttp.ErrInvalidType("project-service", "create-project-folder", "*projectservice.CreateProjectFolderPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -1302,24 +1431,26 @@ func EncodeCreateProjectLinkRequest(encoder func(*http.Request) goahttp.Encoder)
 			values.Add("v", *p.Version)
 		}
 		req.URL.RawQuery = values.Encode()
-		body := NewCreateProjectLinkRequestBody(p)
+		body := NewCreateProjectFolderRequestBody(p)
 		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("project-service", "create-project-link", err)
+			return goahttp.ErrEncodingError("project-service", "create-project-folder", err)
 		}
 		return nil
 	}
 }
 
-// DecodeCreateProjectLinkResponse returns a decoder for responses returned by
-// the project-service create-project-link endpoint. restoreBody controls
+// DecodeCreateProjectFolderResponse returns a decoder for responses returned
+// by the project-service create-project-folder endpoint. restoreBody controls
 // whether the response body should be restored after having been read.
-// DecodeCreateProjectLinkResponse may return the following errors:
+// DecodeCreateProjectFolderResponse may return the following errors:
 //   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeCreateProjectLinkResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeCreateProjectFolderResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1336,102 +1467,130 @@ func DecodeCreateProjectLinkResponse(decoder func(*http.Response) goahttp.Decode
 		switch resp.StatusCode {
 		case http.StatusCreated:
 			var (
-				body CreateProjectLinkResponseBody
+				body CreateProjectFolderResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "create-project-link", err)
+				return nil, goahttp.ErrDecodingError("project-service", "create-project-folder", err)
 			}
-			err = ValidateCreateProjectLinkResponseBody(&body)
+			err = ValidateCreateProjectFolderResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "create-project-link", err)
+				return nil, goahttp.ErrValidationError("project-service", "create-project-folder", err)
 			}
-			res := NewCreateProjectLinkProjectLinkCreated(&body)
+			res := NewCreateProjectFolderProjectFolderCreated(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body CreateProjectLinkBadRequestResponseBody
+				body CreateProjectFolderBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "create-project-link", err)
+				return nil, goahttp.ErrDecodingError("project-service", "create-project-folder", err)
 			}
-			err = ValidateCreateProjectLinkBadRequestResponseBody(&body)
+			err = ValidateCreateProjectFolderBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "create-project-link", err)
+				return nil, goahttp.ErrValidationError("project-service", "create-project-folder", err)
 			}
-			return nil, NewCreateProjectLinkBadRequest(&body)
+			return nil, NewCreateProjectFolderBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body CreateProjectFolderConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-project-folder", err)
+			}
+			err = ValidateCreateProjectFolderConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-project-folder", err)
+			}
+			return nil, NewCreateProjectFolderConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateProjectFolderForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-project-folder", err)
+			}
+			err = ValidateCreateProjectFolderForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-project-folder", err)
+			}
+			return nil, NewCreateProjectFolderForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body CreateProjectLinkInternalServerErrorResponseBody
+				body CreateProjectFolderInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "create-project-link", err)
+				return nil, goahttp.ErrDecodingError("project-service", "create-project-folder", err)
 			}
-			err = ValidateCreateProjectLinkInternalServerErrorResponseBody(&body)
+			err = ValidateCreateProjectFolderInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "create-project-link", err)
+				return nil, goahttp.ErrValidationError("project-service", "create-project-folder", err)
 			}
-			return nil, NewCreateProjectLinkInternalServerError(&body)
+			return nil, NewCreateProjectFolderInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body CreateProjectLinkNotFoundResponseBody
+				body CreateProjectFolderNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "create-project-link", err)
+				return nil, goahttp.ErrDecodingError("project-service", "create-project-folder", err)
 			}
-			err = ValidateCreateProjectLinkNotFoundResponseBody(&body)
+			err = ValidateCreateProjectFolderNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "create-project-link", err)
+				return nil, goahttp.ErrValidationError("project-service", "create-project-folder", err)
 			}
-			return nil, NewCreateProjectLinkNotFound(&body)
+			return nil, NewCreateProjectFolderNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body CreateProjectLinkServiceUnavailableResponseBody
+				body CreateProjectFolderServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "create-project-link", err)
+				return nil, goahttp.ErrDecodingError("project-service", "create-project-folder", err)
 			}
-			err = ValidateCreateProjectLinkServiceUnavailableResponseBody(&body)
+			err = ValidateCreateProjectFolderServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "create-project-link", err)
+				return nil, goahttp.ErrValidationError("project-service", "create-project-folder", err)
 			}
-			return nil, NewCreateProjectLinkServiceUnavailable(&body)
+			return nil, NewCreateProjectFolderServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("project-service", "create-project-link", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("project-service", "create-project-folder", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetProjectLinkRequest instantiates a HTTP request object with method
-// and path set to call the "project-service" service "get-project-link"
+// BuildGetProjectFolderRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "get-project-folder"
 // endpoint
-func (c *Client) BuildGetProjectLinkRequest(ctx context.Context, v any) (*http.Request, error) {
+func (c *Client) BuildGetProjectFolderRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		uid     string
-		linkUID string
+		uid       string
+		folderUID string
 	)
 	{
-		p, ok := v.(*projectservice.GetProjectLinkPayload)
+		p, ok := v.(*projectservice.GetProjectFolderPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("project-service", "get-project-link", "*projectservice.GetProjectLinkPayload", v)
+			return nil, goahttp.ErrInvalidType("project-service", "get-project-folder", "*projectservice.GetProjectFolderPayload", v)
 		}
 		uid = p.UID
-		linkUID = p.LinkUID
+		folderUID = p.FolderUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProjectLinkProjectServicePath(uid, linkUID)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProjectFolderProjectServicePath(uid, folderUID)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("project-service", "get-project-link", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("project-service", "get-project-folder", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1440,13 +1599,13 @@ func (c *Client) BuildGetProjectLinkRequest(ctx context.Context, v any) (*http.R
 	return req, nil
 }
 
-// EncodeGetProjectLinkRequest returns an encoder for requests sent to the
-// project-service get-project-link server.
-func EncodeGetProjectLinkRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeGetProjectFolderRequest returns an encoder for requests sent to the
+// project-service get-project-folder server.
+func EncodeGetProjectFolderRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*projectservice.GetProjectLinkPayload)
+		p, ok := v.(*projectservice.GetProjectFolderPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("project-service", "get-project-link", "*projectservice.GetProjectLinkPayload", v)
+			return goahttp.ErrInvalidType("project-service", "get-project-folder", "*projectservice.GetProjectFolderPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -1465,15 +1624,16 @@ func EncodeGetProjectLinkRequest(encoder func(*http.Request) goahttp.Encoder) fu
 	}
 }
 
-// DecodeGetProjectLinkResponse returns a decoder for responses returned by the
-// project-service get-project-link endpoint. restoreBody controls whether the
-// response body should be restored after having been read.
-// DecodeGetProjectLinkResponse may return the following errors:
+// DecodeGetProjectFolderResponse returns a decoder for responses returned by
+// the project-service get-project-folder endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeGetProjectFolderResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeGetProjectLinkResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetProjectFolderResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1490,16 +1650,16 @@ func DecodeGetProjectLinkResponse(decoder func(*http.Response) goahttp.Decoder,
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetProjectLinkResponseBody
+				body GetProjectFolderResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-project-link", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-folder", err)
 			}
-			err = ValidateGetProjectLinkResponseBody(&body)
+			err = ValidateGetProjectFolderResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-project-link", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-folder", err)
 			}
 			var (
 				etag *string
@@ -1508,77 +1668,91 @@ func DecodeGetProjectLinkResponse(decoder func(*http.Response) goahttp.Decoder,
 			if etagRaw != "" {
 				etag = &etagRaw
 			}
-			res := NewGetProjectLinkResultOK(&body, etag)
+			res := NewGetProjectFolderResultOK(&body, etag)
 			return res, nil
+		case http.StatusForbidden:
+			var (
+				body GetProjectFolderForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-folder", err)
+			}
+			err = ValidateGetProjectFolderForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-folder", err)
+			}
+			return nil, NewGetProjectFolderForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetProjectLinkInternalServerErrorResponseBody
+				body GetProjectFolderInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-project-link", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-folder", err)
 			}
-			err = ValidateGetProjectLinkInternalServerErrorResponseBody(&body)
+			err = ValidateGetProjectFolderInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-project-link", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-folder", err)
 			}
-			return nil, NewGetProjectLinkInternalServerError(&body)
+			return nil, NewGetProjectFolderInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body GetProjectLinkNotFoundResponseBody
+				body GetProjectFolderNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-project-link", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-folder", err)
 			}
-			err = ValidateGetProjectLinkNotFoundResponseBody(&body)
+			err = ValidateGetProjectFolderNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-project-link", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-folder", err)
 			}
-			return nil, NewGetProjectLinkNotFound(&body)
+			return nil, NewGetProjectFolderNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body GetProjectLinkServiceUnavailableResponseBody
+				body GetProjectFolderServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-project-link", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-folder", err)
 			}
-			err = ValidateGetProjectLinkServiceUnavailableResponseBody(&body)
+			err = ValidateGetProjectFolderServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-project-link", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-folder", err)
 			}
-			return nil, NewGetProjectLinkServiceUnavailable(&body)
+			return nil, NewGetProjectFolderServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("project-service", "get-project-link", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("project-service", "get-project-folder", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildDeleteProjectLinkRequest instantiates a HTTP request object with method
-// and path set to call the "project-service" service "delete-project-link"
-// endpoint
-func (c *Client) BuildDeleteProjectLinkRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildDeleteProjectFolderRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "delete-project-folder" endpoint
+func (c *Client) BuildDeleteProjectFolderRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		uid     string
-		linkUID string
+		uid       string
+		folderUID string
 	)
 	{
-		p, ok := v.(*projectservice.DeleteProjectLinkPayload)
+		p, ok := v.(*projectservice.DeleteProjectFolderPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("project-service", "delete-project-link", "*projectservice.DeleteProjectLinkPayload", v)
+			return nil, goahttp.ErrInvalidType("project-service", "delete-project-folder", "*projectservice.DeleteProjectFolderPayload", v)
 		}
 		uid = p.UID
-		linkUID = p.LinkUID
+		folderUID = p.FolderUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteProjectLinkProjectServicePath(uid, linkUID)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteProjectFolderProjectServicePath(uid, folderUID)}
 	req, err := http.NewRequest("DELETE", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("project-service", "delete-project-link", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("project-service", "delete-project-folder", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1587,13 +1761,13 @@ func (c *Client) BuildDeleteProjectLinkRequest(ctx context.Context, v any) (*htt
 	return req, nil
 }
 
-// EncodeDeleteProjectLinkRequest returns an encoder for requests sent to the
-// project-service delete-project-link server.
-func EncodeDeleteProjectLinkRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeDeleteProjectFolderRequest returns an encoder for requests sent to the
+// project-service delete-project-folder server.
+func EncodeDeleteProjectFolderRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*projectservice.DeleteProjectLinkPayload)
+		p, ok := v.(*projectservice.DeleteProjectFolderPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("project-service", "delete-project-link", "*projectservice.DeleteProjectLinkPayload", v)
+			return goahttp.ErrInvalidType("project-service", "delete-project-folder", "*projectservice.DeleteProjectFolderPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -1612,6 +1786,11 @@ func EncodeDeleteProjectLinkRequest(encoder func(*http.Request) goahttp.Encoder)
 			head := *p.IfMatch
 			req.Header.Set("If-Match", head)
 		}
+		if p.ForceUpdate != nil {
+			head := *p.ForceUpdate
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Force-Update", headStr)
+		}
 		values := req.URL.Query()
 		if p.Version != nil {
 			values.Add("v", *p.Version)
@@ -1621,17 +1800,18 @@ func EncodeDeleteProjectLinkRequest(encoder func(*http.Request) goahttp.Encoder)
 	}
 }
 
-// DecodeDeleteProjectLinkResponse returns a decoder for responses returned by
-// the project-service delete-project-link endpoint. restoreBody controls
+// DecodeDeleteProjectFolderResponse returns a decoder for responses returned
+// by the project-service delete-project-folder endpoint. restoreBody controls
 // whether the response body should be restored after having been read.
-// DecodeDeleteProjectLinkResponse may return the following errors:
+// DecodeDeleteProjectFolderResponse may return the following errors:
 //   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
 //   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeDeleteProjectLinkResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeDeleteProjectFolderResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1650,99 +1830,113 @@ func DecodeDeleteProjectLinkResponse(decoder func(*http.Response) goahttp.Decode
 			return nil, nil
 		case http.StatusBadRequest:
 			var (
-				body DeleteProjectLinkBadRequestResponseBody
+				body DeleteProjectFolderBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project-link", err)
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-folder", err)
 			}
-			err = ValidateDeleteProjectLinkBadRequestResponseBody(&body)
+			err = ValidateDeleteProjectFolderBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project-link", err)
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-folder", err)
 			}
-			return nil, NewDeleteProjectLinkBadRequest(&body)
+			return nil, NewDeleteProjectFolderBadRequest(&body)
 		case http.StatusConflict:
 			var (
-				body DeleteProjectLinkConflictResponseBody
+				body DeleteProjectFolderConflictResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project-link", err)
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-folder", err)
 			}
-			err = ValidateDeleteProjectLinkConflictResponseBody(&body)
+			err = ValidateDeleteProjectFolderConflictResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project-link", err)
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-folder", err)
 			}
-			return nil, NewDeleteProjectLinkConflict(&body)
+			return nil, NewDeleteProjectFolderConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body DeleteProjectFolderForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-folder", err)
+			}
+			err = ValidateDeleteProjectFolderForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-folder", err)
+			}
+			return nil, NewDeleteProjectFolderForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body DeleteProjectLinkInternalServerErrorResponseBody
+				body DeleteProjectFolderInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project-link", err)
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-folder", err)
 			}
-			err = ValidateDeleteProjectLinkInternalServerErrorResponseBody(&body)
+			err = ValidateDeleteProjectFolderInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project-link", err)
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-folder", err)
 			}
-			return nil, NewDeleteProjectLinkInternalServerError(&body)
+			return nil, NewDeleteProjectFolderInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body DeleteProjectLinkNotFoundResponseBody
+				body DeleteProjectFolderNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project-link", err)
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-folder", err)
 			}
-			err = ValidateDeleteProjectLinkNotFoundResponseBody(&body)
+			err = ValidateDeleteProjectFolderNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project-link", err)
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-folder", err)
 			}
-			return nil, NewDeleteProjectLinkNotFound(&body)
+			return nil, NewDeleteProjectFolderNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body DeleteProjectLinkServiceUnavailableResponseBody
+				body DeleteProjectFolderServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project-link", err)
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-folder", err)
 			}
-			err = ValidateDeleteProjectLinkServiceUnavailableResponseBody(&body)
+			err = ValidateDeleteProjectFolderServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project-link", err)
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-folder", err)
 			}
-			return nil, NewDeleteProjectLinkServiceUnavailable(&body)
+			return nil, NewDeleteProjectFolderServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("project-service", "delete-project-link", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("project-service", "delete-project-folder", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildCreateProjectFolderRequest instantiates a HTTP request object with
+// BuildUploadProjectDocumentRequest instantiates a HTTP request object with
 // method and path set to call the "project-service" service
-// "create-project-folder" endpoint
-func (c *Client) BuildCreateProjectFolderRequest(ctx context.Context, v any) (*http.Request, error) {
+// "upload-project-document" endpoint
+func (c *Client) BuildUploadProjectDocumentRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		uid string
 	)
 	{
-		p, ok := v.(*projectservice.CreateProjectFolderPayload)
+		p, ok := v.(*projectservice.UploadProjectDocumentPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("project-service", "create-project-folder", "*projectservice.CreateProjectFolderPayload", v)
+			return nil, goahttp.ErrInvalidType("project-service", "upload-project-document", "*projectservice.UploadProjectDocumentPayload", v)
 		}
 		uid = p.UID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateProjectFolderProjectServicePath(uid)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UploadProjectDocumentProjectServicePath(uid)}
 	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("project-service", "create-project-folder", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("project-service", "upload-project-document", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1751,13 +1945,13 @@ func (c *Client) BuildCreateProjectFolderRequest(ctx context.Context, v any) (*h
 	return req, nil
 }
 
-// EncodeCreateProjectFolderRequest returns an encoder for requests sent to the
-// project-service create-project-folder server.
-func EncodeCreateProjectFolderRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeUploadProjectDocumentRequest returns an encoder for requests sent to
+// the project-service upload-project-document server.
+func EncodeUploadProjectDocumentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*projectservice.CreateProjectFolderPayload)
+		p, ok := v.(*projectservice.UploadProjectDocumentPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("project-service", "create-project-folder", "*projectservice.CreateProjectFolderPayload", v)
+			return goahttp.ErrInvalidType("project-service", "upload-project-document", "*projectservice.UploadProjectDocumentPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -1777,25 +1971,44 @@ func EncodeCreateProjectFolderRequest(encoder func(*http.Request) goahttp.Encode
 			values.Add("v", *p.Version)
 		}
 		req.URL.RawQuery = values.Encode()
-		body := NewCreateProjectFolderRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("project-service", "create-project-folder", err)
+		if err := encoder(req).Encode(p); err != nil {
+			return goahttp.ErrEncodingError("project-service", "upload-project-document", err)
 		}
 		return nil
 	}
 }
 
-// DecodeCreateProjectFolderResponse returns a decoder for responses returned
-// by the project-service create-project-folder endpoint. restoreBody controls
-// whether the response body should be restored after having been read.
-// DecodeCreateProjectFolderResponse may return the following errors:
+// NewProjectServiceUploadProjectDocumentEncoder returns an encoder to encode
+// the multipart request for the "project-service" service
+// "upload-project-document" endpoint.
+func NewProjectServiceUploadProjectDocumentEncoder(encoderFn ProjectServiceUploadProjectDocumentEncoderFunc) func(r *http.Request) goahttp.Encoder {
+	return func(r *http.Request) goahttp.Encoder {
+		body := &bytes.Buffer{}
+		mw := multipart.NewWriter(body)
+		return goahttp.EncodingFunc(func(v any) error {
+			p := v.(*projectservice.UploadProjectDocumentPayload)
+			if err := encoderFn(mw, p); err != nil {
+				return err
+			}
+			r.Body = io.NopCloser(body)
+			r.Header.Set("Content-Type", mw.FormDataContentType())
+			return mw.Close()
+		})
+	}
+}
+
+// DecodeUploadProjectDocumentResponse returns a decoder for responses returned
+// by the project-service upload-project-document endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeUploadProjectDocumentResponse may return the following errors:
 //   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
 //   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeCreateProjectFolderResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeUploadProjectDocumentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1812,116 +2025,130 @@ func DecodeCreateProjectFolderResponse(decoder func(*http.Response) goahttp.Deco
 		switch resp.StatusCode {
 		case http.StatusCreated:
 			var (
-				body CreateProjectFolderResponseBody
+				body UploadProjectDocumentResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "create-project-folder", err)
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-document", err)
 			}
-			err = ValidateCreateProjectFolderResponseBody(&body)
+			err = ValidateUploadProjectDocumentResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "create-project-folder", err)
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-document", err)
 			}
-			res := NewCreateProjectFolderProjectFolderCreated(&body)
+			res := NewUploadProjectDocumentProjectDocumentCreated(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body CreateProjectFolderBadRequestResponseBody
+				body UploadProjectDocumentBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "create-project-folder", err)
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-document", err)
 			}
-			err = ValidateCreateProjectFolderBadRequestResponseBody(&body)
+			err = ValidateUploadProjectDocumentBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "create-project-folder", err)
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-document", err)
 			}
-			return nil, NewCreateProjectFolderBadRequest(&body)
+			return nil, NewUploadProjectDocumentBadRequest(&body)
 		case http.StatusConflict:
 			var (
-				body CreateProjectFolderConflictResponseBody
+				body UploadProjectDocumentConflictResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "create-project-folder", err)
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-document", err)
 			}
-			err = ValidateCreateProjectFolderConflictResponseBody(&body)
+			err = ValidateUploadProjectDocumentConflictResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "create-project-folder", err)
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-document", err)
 			}
-			return nil, NewCreateProjectFolderConflict(&body)
-		case http.StatusInternalServerError:
+			return nil, NewUploadProjectDocumentConflict(&body)
+		case http.StatusForbidden:
 			var (
-				body CreateProjectFolderInternalServerErrorResponseBody
+				body UploadProjectDocumentForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "create-project-folder", err)
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-document", err)
 			}
-			err = ValidateCreateProjectFolderInternalServerErrorResponseBody(&body)
+			err = ValidateUploadProjectDocumentForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "create-project-folder", err)
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-document", err)
 			}
-			return nil, NewCreateProjectFolderInternalServerError(&body)
-		case http.StatusNotFound:
+			return nil, NewUploadProjectDocumentForbidden(&body)
+		case http.StatusInternalServerError:
 			var (
-				body CreateProjectFolderNotFoundResponseBody
+				body UploadProjectDocumentInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "create-project-folder", err)
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-document", err)
 			}
-			err = ValidateCreateProjectFolderNotFoundResponseBody(&body)
+			err = ValidateUploadProjectDocumentInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "create-project-folder", err)
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-document", err)
 			}
-			return nil, NewCreateProjectFolderNotFound(&body)
-		case http.StatusServiceUnavailable:
+			return nil, NewUploadProjectDocumentInternalServerError(&body)
+		case http.StatusNotFound:
 			var (
-				body CreateProjectFolderServiceUnavailableResponseBody
+				body UploadProjectDocumentNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "create-project-folder", err)
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-document", err)
 			}
-			err = ValidateCreateProjectFolderServiceUnavailableResponseBody(&body)
+			err = ValidateUploadProjectDocumentNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "create-project-folder", err)
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-document", err)
 			}
-			return nil, NewCreateProjectFolderServiceUnavailable(&body)
+			return nil, NewUploadProjectDocumentNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body UploadProjectDocumentServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-document", err)
+			}
+			err = ValidateUploadProjectDocumentServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-document", err)
+			}
+			return nil, NewUploadProjectDocumentServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("project-service", "create-project-folder", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("project-service", "upload-project-document", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetProjectFolderRequest instantiates a HTTP request object with method
-// and path set to call the "project-service" service "get-project-folder"
-// endpoint
-func (c *Client) BuildGetProjectFolderRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildGetProjectDocumentRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "get-project-document" endpoint
+func (c *Client) BuildGetProjectDocumentRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		uid       string
-		folderUID string
+		uid         string
+		documentUID string
 	)
 	{
-		p, ok := v.(*projectservice.GetProjectFolderPayload)
+		p, ok := v.(*projectservice.GetProjectDocumentPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("project-service", "get-project-folder", "*projectservice.GetProjectFolderPayload", v)
+			return nil, goahttp.ErrInvalidType("project-service", "get-project-document", "*projectservice.GetProjectDocumentPayload", v)
 		}
 		uid = p.UID
-		folderUID = p.FolderUID
+		documentUID = p.DocumentUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProjectFolderProjectServicePath(uid, folderUID)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProjectDocumentProjectServicePath(uid, documentUID)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("project-service", "get-project-folder", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("project-service", "get-project-document", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1930,13 +2157,13 @@ func (c *Client) BuildGetProjectFolderRequest(ctx context.Context, v any) (*http
 	return req, nil
 }
 
-// EncodeGetProjectFolderRequest returns an encoder for requests sent to the
-// project-service get-project-folder server.
-func EncodeGetProjectFolderRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeGetProjectDocumentRequest returns an encoder for requests sent to the
+// project-service get-project-document server.
+func EncodeGetProjectDocumentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*projectservice.GetProjectFolderPayload)
+		p, ok := v.(*projectservice.GetProjectDocumentPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("project-service", "get-project-folder", "*projectservice.GetProjectFolderPayload", v)
+			return goahttp.ErrInvalidType("project-service", "get-project-document", "*projectservice.GetProjectDocumentPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -1955,15 +2182,16 @@ func EncodeGetProjectFolderRequest(encoder func(*http.Request) goahttp.Encoder)
 	}
 }
 
-// DecodeGetProjectFolderResponse returns a decoder for responses returned by
-// the project-service get-project-folder endpoint. restoreBody controls
+// DecodeGetProjectDocumentResponse returns a decoder for responses returned by
+// the project-service get-project-document endpoint. restoreBody controls
 // whether the response body should be restored after having been read.
-// DecodeGetProjectFolderResponse may return the following errors:
+// DecodeGetProjectDocumentResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeGetProjectFolderResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetProjectDocumentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1980,16 +2208,16 @@ func DecodeGetProjectFolderResponse(decoder func(*http.Response) goahttp.Decoder
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetProjectFolderResponseBody
+				body GetProjectDocumentResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-project-folder", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-document", err)
 			}
-			err = ValidateGetProjectFolderResponseBody(&body)
+			err = ValidateGetProjectDocumentResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-project-folder", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-document", err)
 			}
 			var (
 				etag *string
@@ -1998,77 +2226,91 @@ func DecodeGetProjectFolderResponse(decoder func(*http.Response) goahttp.Decoder
 			if etagRaw != "" {
 				etag = &etagRaw
 			}
-			res := NewGetProjectFolderResultOK(&body, etag)
+			res := NewGetProjectDocumentResultOK(&body, etag)
 			return res, nil
+		case http.StatusForbidden:
+			var (
+				body GetProjectDocumentForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-document", err)
+			}
+			err = ValidateGetProjectDocumentForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-document", err)
+			}
+			return nil, NewGetProjectDocumentForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetProjectFolderInternalServerErrorResponseBody
+				body GetProjectDocumentInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-project-folder", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-document", err)
 			}
-			err = ValidateGetProjectFolderInternalServerErrorResponseBody(&body)
+			err = ValidateGetProjectDocumentInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-project-folder", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-document", err)
 			}
-			return nil, NewGetProjectFolderInternalServerError(&body)
+			return nil, NewGetProjectDocumentInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body GetProjectFolderNotFoundResponseBody
+				body GetProjectDocumentNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-project-folder", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-document", err)
 			}
-			err = ValidateGetProjectFolderNotFoundResponseBody(&body)
+			err = ValidateGetProjectDocumentNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-project-folder", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-document", err)
 			}
-			return nil, NewGetProjectFolderNotFound(&body)
+			return nil, NewGetProjectDocumentNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body GetProjectFolderServiceUnavailableResponseBody
+				body GetProjectDocumentServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-project-folder", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-document", err)
 			}
-			err = ValidateGetProjectFolderServiceUnavailableResponseBody(&body)
+			err = ValidateGetProjectDocumentServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-project-folder", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-document", err)
 			}
-			return nil, NewGetProjectFolderServiceUnavailable(&body)
+			return nil, NewGetProjectDocumentServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("project-service", "get-project-folder", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("project-service", "get-project-document", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildDeleteProjectFolderRequest instantiates a HTTP request object with
+// BuildDownloadProjectDocumentRequest instantiates a HTTP request object with
 // method and path set to call the "project-service" service
-// "delete-project-folder" endpoint
-func (c *Client) BuildDeleteProjectFolderRequest(ctx context.Context, v any) (*http.Request, error) {
+// "download-project-document" endpoint
+func (c *Client) BuildDownloadProjectDocumentRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		uid       string
-		folderUID string
+		uid         string
+		documentUID string
 	)
 	{
-		p, ok := v.(*projectservice.DeleteProjectFolderPayload)
+		p, ok := v.(*projectservice.DownloadProjectDocumentPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("project-service", "delete-project-folder", "*projectservice.DeleteProjectFolderPayload", v)
+			return nil, goahttp.ErrInvalidType("project-service", "download-project-document", "*projectservice.DownloadProjectDocumentPayload", v)
 		}
 		uid = p.UID
-		folderUID = p.FolderUID
+		documentUID = p.DocumentUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteProjectFolderProjectServicePath(uid, folderUID)}
-	req, err := http.NewRequest("DELETE", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DownloadProjectDocumentProjectServicePath(uid, documentUID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("project-service", "delete-project-folder", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("project-service", "download-project-document", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2077,13 +2319,13 @@ func (c *Client) BuildDeleteProjectFolderRequest(ctx context.Context, v any) (*h
 	return req, nil
 }
 
-// EncodeDeleteProjectFolderRequest returns an encoder for requests sent to the
-// project-service delete-project-folder server.
-func EncodeDeleteProjectFolderRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeDownloadProjectDocumentRequest returns an encoder for requests sent to
+// the project-service download-project-document server.
+func EncodeDownloadProjectDocumentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*projectservice.DeleteProjectFolderPayload)
+		p, ok := v.(*projectservice.DownloadProjectDocumentPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("project-service", "delete-project-folder", "*projectservice.DeleteProjectFolderPayload", v)
+			return goahttp.ErrInvalidType("project-service", "download-project-document", "*projectservice.DownloadProjectDocumentPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -2093,15 +2335,6 @@ func EncodeDeleteProjectFolderRequest(encoder func(*http.Request) goahttp.Encode
 				req.Header.Set("Authorization", head)
 			}
 		}
-		if p.XSync != nil {
-			head := *p.XSync
-			headStr := strconv.FormatBool(head)
-			req.Header.Set("X-Sync", headStr)
-		}
-		if p.IfMatch != nil {
-			head := *p.IfMatch
-			req.Header.Set("If-Match", head)
-		}
 		values := req.URL.Query()
 		if p.Version != nil {
 			values.Add("v", *p.Version)
@@ -2111,17 +2344,17 @@ func EncodeDeleteProjectFolderRequest(encoder func(*http.Request) goahttp.Encode
 	}
 }
 
-// DecodeDeleteProjectFolderResponse returns a decoder for responses returned
-// by the project-service delete-project-folder endpoint. restoreBody controls
-// whether the response body should be restored after having been read.
-// DecodeDeleteProjectFolderResponse may return the following errors:
-//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
-//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+// DecodeDownloadProjectDocumentResponse returns a decoder for responses
+// returned by the project-service download-project-document endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeDownloadProjectDocumentResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeDeleteProjectFolderResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeDownloadProjectDocumentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2132,107 +2365,93 @@ func DecodeDeleteProjectFolderResponse(decoder func(*http.Response) goahttp.Deco
 			defer func() {
 				resp.Body = io.NopCloser(bytes.NewBuffer(b))
 			}()
-		} else {
-			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusNoContent:
+		case http.StatusOK:
 			return nil, nil
-		case http.StatusBadRequest:
-			var (
-				body DeleteProjectFolderBadRequestResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project-folder", err)
-			}
-			err = ValidateDeleteProjectFolderBadRequestResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project-folder", err)
-			}
-			return nil, NewDeleteProjectFolderBadRequest(&body)
-		case http.StatusConflict:
+		case http.StatusForbidden:
 			var (
-				body DeleteProjectFolderConflictResponseBody
+				body DownloadProjectDocumentForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project-folder", err)
+				return nil, goahttp.ErrDecodingError("project-service", "download-project-document", err)
 			}
-			err = ValidateDeleteProjectFolderConflictResponseBody(&body)
+			err = ValidateDownloadProjectDocumentForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project-folder", err)
+				return nil, goahttp.ErrValidationError("project-service", "download-project-document", err)
 			}
-			return nil, NewDeleteProjectFolderConflict(&body)
+			return nil, NewDownloadProjectDocumentForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body DeleteProjectFolderInternalServerErrorResponseBody
+				body DownloadProjectDocumentInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project-folder", err)
+				return nil, goahttp.ErrDecodingError("project-service", "download-project-document", err)
 			}
-			err = ValidateDeleteProjectFolderInternalServerErrorResponseBody(&body)
+			err = ValidateDownloadProjectDocumentInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project-folder", err)
+				return nil, goahttp.ErrValidationError("project-service", "download-project-document", err)
 			}
-			return nil, NewDeleteProjectFolderInternalServerError(&body)
+			return nil, NewDownloadProjectDocumentInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body DeleteProjectFolderNotFoundResponseBody
+				body DownloadProjectDocumentNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project-folder", err)
+				return nil, goahttp.ErrDecodingError("project-service", "download-project-document", err)
 			}
-			err = ValidateDeleteProjectFolderNotFoundResponseBody(&body)
+			err = ValidateDownloadProjectDocumentNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project-folder", err)
+				return nil, goahttp.ErrValidationError("project-service", "download-project-document", err)
 			}
-			return nil, NewDeleteProjectFolderNotFound(&body)
+			return nil, NewDownloadProjectDocumentNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body DeleteProjectFolderServiceUnavailableResponseBody
+				body DownloadProjectDocumentServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project-folder", err)
+				return nil, goahttp.ErrDecodingError("project-service", "download-project-document", err)
 			}
-			err = ValidateDeleteProjectFolderServiceUnavailableResponseBody(&body)
+			err = ValidateDownloadProjectDocumentServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project-folder", err)
+				return nil, goahttp.ErrValidationError("project-service", "download-project-document", err)
 			}
-			return nil, NewDeleteProjectFolderServiceUnavailable(&body)
+			return nil, NewDownloadProjectDocumentServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("project-service", "delete-project-folder", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("project-service", "download-project-document", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildUploadProjectDocumentRequest instantiates a HTTP request object with
+// BuildDeleteProjectDocumentRequest instantiates a HTTP request object with
 // method and path set to call the "project-service" service
-// "upload-project-document" endpoint
-func (c *Client) BuildUploadProjectDocumentRequest(ctx context.Context, v any) (*http.Request, error) {
+// "delete-project-document" endpoint
+func (c *Client) BuildDeleteProjectDocumentRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		uid string
+		uid         string
+		documentUID string
 	)
 	{
-		p, ok := v.(*projectservice.UploadProjectDocumentPayload)
+		p, ok := v.(*projectservice.DeleteProjectDocumentPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("project-service", "upload-project-document", "*projectservice.UploadProjectDocumentPayload", v)
+			return nil, goahttp.ErrInvalidType("project-service", "delete-project-document", "*projectservice.DeleteProjectDocumentPayload", v)
 		}
 		uid = p.UID
+		documentUID = p.DocumentUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UploadProjectDocumentProjectServicePath(uid)}
-	req, err := http.NewRequest("POST", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteProjectDocumentProjectServicePath(uid, documentUID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("project-service", "upload-project-document", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("project-service", "delete-project-document", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2241,13 +2460,13 @@ func (c *Client) BuildUploadProjectDocumentRequest(ctx context.Context, v any) (
 	return req, nil
 }
 
-// EncodeUploadProjectDocumentRequest returns an encoder for requests sent to
-// the project-service upload-project-document server.
-func EncodeUploadProjectDocumentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
-	return func(req *http.Request, v any) error {
-		p, ok := v.(*projectservice.UploadProjectDocumentPayload)
+// EncodeDeleteProjectDocumentRequest returns an encoder for requests sent to
+// the project-service delete-project-document server.
+func EncodeDeleteProjectDocumentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.DeleteProjectDocumentPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("project-service", "upload-project-document", "*projectservice.UploadProjectDocumentPayload", v)
+			return goahttp.ErrInvalidType("project-service", "delete-project-document", "*projectservice.DeleteProjectDocumentPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -2262,48 +2481,36 @@ func EncodeUploadProjectDocumentRequest(encoder func(*http.Request) goahttp.Enco
 			headStr := strconv.FormatBool(head)
 			req.Header.Set("X-Sync", headStr)
 		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.ForceUpdate != nil {
+			head := *p.ForceUpdate
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Force-Update", headStr)
+		}
 		values := req.URL.Query()
 		if p.Version != nil {
 			values.Add("v", *p.Version)
 		}
 		req.URL.RawQuery = values.Encode()
-		if err := encoder(req).Encode(p); err != nil {
-			return goahttp.ErrEncodingError("project-service", "upload-project-document", err)
-		}
 		return nil
 	}
 }
 
-// NewProjectServiceUploadProjectDocumentEncoder returns an encoder to encode
-// the multipart request for the "project-service" service
-// "upload-project-document" endpoint.
-func NewProjectServiceUploadProjectDocumentEncoder(encoderFn ProjectServiceUploadProjectDocumentEncoderFunc) func(r *http.Request) goahttp.Encoder {
-	return func(r *http.Request) goahttp.Encoder {
-		body := &bytes.Buffer{}
-		mw := multipart.NewWriter(body)
-		return goahttp.EncodingFunc(func(v any) error {
-			p := v.(*projectservice.UploadProjectDocumentPayload)
-			if err := encoderFn(mw, p); err != nil {
-				return err
-			}
-			r.Body = io.NopCloser(body)
-			r.Header.Set("Content-Type", mw.FormDataContentType())
-			return mw.Close()
-		})
-	}
-}
-
-// DecodeUploadProjectDocumentResponse returns a decoder for responses returned
-// by the project-service upload-project-document endpoint. restoreBody
+// DecodeDeleteProjectDocumentResponse returns a decoder for responses returned
+// by the project-service delete-project-document endpoint. restoreBody
 // controls whether the response body should be restored after having been read.
-// DecodeUploadProjectDocumentResponse may return the following errors:
+// DecodeDeleteProjectDocumentResponse may return the following errors:
 //   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
 //   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeUploadProjectDocumentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeDeleteProjectDocumentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2318,118 +2525,117 @@ func DecodeUploadProjectDocumentResponse(decoder func(*http.Response) goahttp.De
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusCreated:
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
 			var (
-				body UploadProjectDocumentResponseBody
+				body DeleteProjectDocumentBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "upload-project-document", err)
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-document", err)
 			}
-			err = ValidateUploadProjectDocumentResponseBody(&body)
+			err = ValidateDeleteProjectDocumentBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "upload-project-document", err)
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-document", err)
 			}
-			res := NewUploadProjectDocumentProjectDocumentCreated(&body)
-			return res, nil
-		case http.StatusBadRequest:
+			return nil, NewDeleteProjectDocumentBadRequest(&body)
+		case http.StatusConflict:
 			var (
-				body UploadProjectDocumentBadRequestResponseBody
+				body DeleteProjectDocumentConflictResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "upload-project-document", err)
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-document", err)
 			}
-			err = ValidateUploadProjectDocumentBadRequestResponseBody(&body)
+			err = ValidateDeleteProjectDocumentConflictResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "upload-project-document", err)
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-document", err)
 			}
-			return nil, NewUploadProjectDocumentBadRequest(&body)
-		case http.StatusConflict:
+			return nil, NewDeleteProjectDocumentConflict(&body)
+		case http.StatusForbidden:
 			var (
-				body UploadProjectDocumentConflictResponseBody
+				body DeleteProjectDocumentForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "upload-project-document", err)
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-document", err)
 			}
-			err = ValidateUploadProjectDocumentConflictResponseBody(&body)
+			err = ValidateDeleteProjectDocumentForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "upload-project-document", err)
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-document", err)
 			}
-			return nil, NewUploadProjectDocumentConflict(&body)
+			return nil, NewDeleteProjectDocumentForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body UploadProjectDocumentInternalServerErrorResponseBody
+				body DeleteProjectDocumentInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "upload-project-document", err)
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-document", err)
 			}
-			err = ValidateUploadProjectDocumentInternalServerErrorResponseBody(&body)
+			err = ValidateDeleteProjectDocumentInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "upload-project-document", err)
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-document", err)
 			}
-			return nil, NewUploadProjectDocumentInternalServerError(&body)
+			return nil, NewDeleteProjectDocumentInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body UploadProjectDocumentNotFoundResponseBody
+				body DeleteProjectDocumentNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "upload-project-document", err)
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-document", err)
 			}
-			err = ValidateUploadProjectDocumentNotFoundResponseBody(&body)
+			err = ValidateDeleteProjectDocumentNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "upload-project-document", err)
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-document", err)
 			}
-			return nil, NewUploadProjectDocumentNotFound(&body)
+			return nil, NewDeleteProjectDocumentNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body UploadProjectDocumentServiceUnavailableResponseBody
+				body DeleteProjectDocumentServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "upload-project-document", err)
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-document", err)
 			}
-			err = ValidateUploadProjectDocumentServiceUnavailableResponseBody(&body)
+			err = ValidateDeleteProjectDocumentServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "upload-project-document", err)
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-document", err)
 			}
-			return nil, NewUploadProjectDocumentServiceUnavailable(&body)
+			return nil, NewDeleteProjectDocumentServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("project-service", "upload-project-document", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("project-service", "delete-project-document", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetProjectDocumentRequest instantiates a HTTP request object with
+// BuildUploadProjectCharterRequest instantiates a HTTP request object with
 // method and path set to call the "project-service" service
-// "get-project-document" endpoint
-func (c *Client) BuildGetProjectDocumentRequest(ctx context.Context, v any) (*http.Request, error) {
+// "upload-project-charter" endpoint
+func (c *Client) BuildUploadProjectCharterRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		uid         string
-		documentUID string
+		uid string
 	)
 	{
-		p, ok := v.(*projectservice.GetProjectDocumentPayload)
+		p, ok := v.(*projectservice.UploadProjectCharterPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("project-service", "get-project-document", "*projectservice.GetProjectDocumentPayload", v)
+			return nil, goahttp.ErrInvalidType("project-service", "upload-project-charter", "*projectservice.UploadProjectCharterPayload", v)
 		}
 		uid = p.UID
-		documentUID = p.DocumentUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProjectDocumentProjectServicePath(uid, documentUID)}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UploadProjectCharterProjectServicePath(uid)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("project-service", "get-project-document", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("project-service", "upload-project-charter", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2438,13 +2644,13 @@ func (c *Client) BuildGetProjectDocumentRequest(ctx context.Context, v any) (*ht
 	return req, nil
 }
 
-// EncodeGetProjectDocumentRequest returns an encoder for requests sent to the
-// project-service get-project-document server.
-func EncodeGetProjectDocumentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeUploadProjectCharterRequest returns an encoder for requests sent to
+// the project-service upload-project-charter server.
+func EncodeUploadProjectCharterRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*projectservice.GetProjectDocumentPayload)
+		p, ok := v.(*projectservice.UploadProjectCharterPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("project-service", "get-project-document", "*projectservice.GetProjectDocumentPayload", v)
+			return goahttp.ErrInvalidType("project-service", "upload-project-charter", "*projectservice.UploadProjectCharterPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -2454,24 +2660,54 @@ func EncodeGetProjectDocumentRequest(encoder func(*http.Request) goahttp.Encoder
 				req.Header.Set("Authorization", head)
 			}
 		}
+		if p.XSync != nil {
+			head := *p.XSync
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Sync", headStr)
+		}
 		values := req.URL.Query()
 		if p.Version != nil {
 			values.Add("v", *p.Version)
 		}
 		req.URL.RawQuery = values.Encode()
+		if err := encoder(req).Encode(p); err != nil {
+			return goahttp.ErrEncodingError("project-service", "upload-project-charter", err)
+		}
 		return nil
 	}
 }
 
-// DecodeGetProjectDocumentResponse returns a decoder for responses returned by
-// the project-service get-project-document endpoint. restoreBody controls
+// NewProjectServiceUploadProjectCharterEncoder returns an encoder to encode
+// the multipart request for the "project-service" service
+// "upload-project-charter" endpoint.
+func NewProjectServiceUploadProjectCharterEncoder(encoderFn ProjectServiceUploadProjectCharterEncoderFunc) func(r *http.Request) goahttp.Encoder {
+	return func(r *http.Request) goahttp.Encoder {
+		body := &bytes.Buffer{}
+		mw := multipart.NewWriter(body)
+		return goahttp.EncodingFunc(func(v any) error {
+			p := v.(*projectservice.UploadProjectCharterPayload)
+			if err := encoderFn(mw, p); err != nil {
+				return err
+			}
+			r.Body = io.NopCloser(body)
+			r.Header.Set("Content-Type", mw.FormDataContentType())
+			return mw.Close()
+		})
+	}
+}
+
+// DecodeUploadProjectCharterResponse returns a decoder for responses returned
+// by the project-service upload-project-charter endpoint. restoreBody controls
 // whether the response body should be restored after having been read.
-// DecodeGetProjectDocumentResponse may return the following errors:
+// DecodeUploadProjectCharterResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeGetProjectDocumentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeUploadProjectCharterResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2488,95 +2724,128 @@ func DecodeGetProjectDocumentResponse(decoder func(*http.Response) goahttp.Decod
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetProjectDocumentResponseBody
+				body UploadProjectCharterResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-project-document", err)
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-charter", err)
 			}
-			err = ValidateGetProjectDocumentResponseBody(&body)
+			err = ValidateUploadProjectCharterResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-project-document", err)
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-charter", err)
 			}
+			res := NewUploadProjectCharterProjectCharterOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
 			var (
-				etag *string
+				body UploadProjectCharterBadRequestResponseBody
+				err  error
 			)
-			etagRaw := resp.Header.Get("Etag")
-			if etagRaw != "" {
-				etag = &etagRaw
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-charter", err)
 			}
-			res := NewGetProjectDocumentResultOK(&body, etag)
-			return res, nil
+			err = ValidateUploadProjectCharterBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-charter", err)
+			}
+			return nil, NewUploadProjectCharterBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body UploadProjectCharterConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-charter", err)
+			}
+			err = ValidateUploadProjectCharterConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-charter", err)
+			}
+			return nil, NewUploadProjectCharterConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body UploadProjectCharterForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-charter", err)
+			}
+			err = ValidateUploadProjectCharterForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-charter", err)
+			}
+			return nil, NewUploadProjectCharterForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetProjectDocumentInternalServerErrorResponseBody
+				body UploadProjectCharterInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-project-document", err)
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-charter", err)
 			}
-			err = ValidateGetProjectDocumentInternalServerErrorResponseBody(&body)
+			err = ValidateUploadProjectCharterInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-project-document", err)
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-charter", err)
 			}
-			return nil, NewGetProjectDocumentInternalServerError(&body)
+			return nil, NewUploadProjectCharterInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body GetProjectDocumentNotFoundResponseBody
+				body UploadProjectCharterNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-project-document", err)
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-charter", err)
 			}
-			err = ValidateGetProjectDocumentNotFoundResponseBody(&body)
+			err = ValidateUploadProjectCharterNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-project-document", err)
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-charter", err)
 			}
-			return nil, NewGetProjectDocumentNotFound(&body)
+			return nil, NewUploadProjectCharterNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body GetProjectDocumentServiceUnavailableResponseBody
+				body UploadProjectCharterServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "get-project-document", err)
+				return nil, goahttp.ErrDecodingError("project-service", "upload-project-charter", err)
 			}
-			err = ValidateGetProjectDocumentServiceUnavailableResponseBody(&body)
+			err = ValidateUploadProjectCharterServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "get-project-document", err)
+				return nil, goahttp.ErrValidationError("project-service", "upload-project-charter", err)
 			}
-			return nil, NewGetProjectDocumentServiceUnavailable(&body)
+			return nil, NewUploadProjectCharterServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("project-service", "get-project-document", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("project-service", "upload-project-charter", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildDownloadProjectDocumentRequest instantiates a HTTP request object with
-// method and path set to call the "project-service" service
-// "download-project-document" endpoint
-func (c *Client) BuildDownloadProjectDocumentRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildGetProjectCharterRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "get-project-charter"
+// endpoint
+func (c *Client) BuildGetProjectCharterRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		uid         string
-		documentUID string
+		uid string
 	)
 	{
-		p, ok := v.(*projectservice.DownloadProjectDocumentPayload)
+		p, ok := v.(*projectservice.GetProjectCharterPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("project-service", "download-project-document", "*projectservice.DownloadProjectDocumentPayload", v)
+			return nil, goahttp.ErrInvalidType("project-service", "get-project-charter", "*projectservice.GetProjectCharterPayload", v)
 		}
 		uid = p.UID
-		documentUID = p.DocumentUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DownloadProjectDocumentProjectServicePath(uid, documentUID)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProjectCharterProjectServicePath(uid)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("project-service", "download-project-document", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("project-service", "get-project-charter", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2585,13 +2854,13 @@ func (c *Client) BuildDownloadProjectDocumentRequest(ctx context.Context, v any)
 	return req, nil
 }
 
-// EncodeDownloadProjectDocumentRequest returns an encoder for requests sent to
-// the project-service download-project-document server.
-func EncodeDownloadProjectDocumentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeGetProjectCharterRequest returns an encoder for requests sent to the
+// project-service get-project-charter server.
+func EncodeGetProjectCharterRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*projectservice.DownloadProjectDocumentPayload)
+		p, ok := v.(*projectservice.GetProjectCharterPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("project-service", "download-project-document", "*projectservice.DownloadProjectDocumentPayload", v)
+			return goahttp.ErrInvalidType("project-service", "get-project-charter", "*projectservice.GetProjectCharterPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -2610,16 +2879,16 @@ func EncodeDownloadProjectDocumentRequest(encoder func(*http.Request) goahttp.En
 	}
 }
 
-// DecodeDownloadProjectDocumentResponse returns a decoder for responses
-// returned by the project-service download-project-document endpoint.
-// restoreBody controls whether the response body should be restored after
-// having been read.
-// DecodeDownloadProjectDocumentResponse may return the following errors:
+// DecodeGetProjectCharterResponse returns a decoder for responses returned by
+// the project-service get-project-charter endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeGetProjectCharterResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeDownloadProjectDocumentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetProjectCharterResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2630,79 +2899,106 @@ func DecodeDownloadProjectDocumentResponse(decoder func(*http.Response) goahttp.
 			defer func() {
 				resp.Body = io.NopCloser(bytes.NewBuffer(b))
 			}()
+		} else {
+			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
 		case http.StatusOK:
-			return nil, nil
-		case http.StatusInternalServerError:
 			var (
-				body DownloadProjectDocumentInternalServerErrorResponseBody
+				body GetProjectCharterResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "download-project-document", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-charter", err)
 			}
-			err = ValidateDownloadProjectDocumentInternalServerErrorResponseBody(&body)
+			err = ValidateGetProjectCharterResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "download-project-document", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-charter", err)
 			}
-			return nil, NewDownloadProjectDocumentInternalServerError(&body)
-		case http.StatusNotFound:
+			res := NewGetProjectCharterProjectCharterOK(&body)
+			return res, nil
+		case http.StatusForbidden:
 			var (
-				body DownloadProjectDocumentNotFoundResponseBody
+				body GetProjectCharterForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "download-project-document", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-charter", err)
 			}
-			err = ValidateDownloadProjectDocumentNotFoundResponseBody(&body)
+			err = ValidateGetProjectCharterForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "download-project-document", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-charter", err)
 			}
-			return nil, NewDownloadProjectDocumentNotFound(&body)
-		case http.StatusServiceUnavailable:
+			return nil, NewGetProjectCharterForbidden(&body)
+		case http.StatusInternalServerError:
 			var (
-				body DownloadProjectDocumentServiceUnavailableResponseBody
+				body GetProjectCharterInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "download-project-document", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-charter", err)
 			}
-			err = ValidateDownloadProjectDocumentServiceUnavailableResponseBody(&body)
+			err = ValidateGetProjectCharterInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "download-project-document", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-charter", err)
 			}
-			return nil, NewDownloadProjectDocumentServiceUnavailable(&body)
-		default:
+			return nil, NewGetProjectCharterInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetProjectCharterNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-charter", err)
+			}
+			err = ValidateGetProjectCharterNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-charter", err)
+			}
+			return nil, NewGetProjectCharterNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetProjectCharterServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-charter", err)
+			}
+			err = ValidateGetProjectCharterServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-charter", err)
+			}
+			return nil, NewGetProjectCharterServiceUnavailable(&body)
+		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("project-service", "download-project-document", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("project-service", "get-project-charter", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildDeleteProjectDocumentRequest instantiates a HTTP request object with
+// BuildGetProjectAssociationsRequest instantiates a HTTP request object with
 // method and path set to call the "project-service" service
-// "delete-project-document" endpoint
-func (c *Client) BuildDeleteProjectDocumentRequest(ctx context.Context, v any) (*http.Request, error) {
+// "get-project-associations" endpoint
+func (c *Client) BuildGetProjectAssociationsRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		uid         string
-		documentUID string
+		uid string
 	)
 	{
-		p, ok := v.(*projectservice.DeleteProjectDocumentPayload)
+		p, ok := v.(*projectservice.GetProjectAssociationsPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("project-service", "delete-project-document", "*projectservice.DeleteProjectDocumentPayload", v)
+			return nil, goahttp.ErrInvalidType("project-service", "get-project-associations", "*projectservice.GetProjectAssociationsPayload", v)
 		}
 		uid = p.UID
-		documentUID = p.DocumentUID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteProjectDocumentProjectServicePath(uid, documentUID)}
-	req, err := http.NewRequest("DELETE", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProjectAssociationsProjectServicePath(uid)}
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("project-service", "delete-project-document", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("project-service", "get-project-associations", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2711,13 +3007,13 @@ func (c *Client) BuildDeleteProjectDocumentRequest(ctx context.Context, v any) (
 	return req, nil
 }
 
-// EncodeDeleteProjectDocumentRequest returns an encoder for requests sent to
-// the project-service delete-project-document server.
-func EncodeDeleteProjectDocumentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeGetProjectAssociationsRequest returns an encoder for requests sent to
+// the project-service get-project-associations server.
+func EncodeGetProjectAssociationsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*projectservice.DeleteProjectDocumentPayload)
+		p, ok := v.(*projectservice.GetProjectAssociationsPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("project-service", "delete-project-document", "*projectservice.DeleteProjectDocumentPayload", v)
+			return goahttp.ErrInvalidType("project-service", "get-project-associations", "*projectservice.GetProjectAssociationsPayload", v)
 		}
 		if p.BearerToken != nil {
 			head := *p.BearerToken
@@ -2727,15 +3023,6 @@ func EncodeDeleteProjectDocumentRequest(encoder func(*http.Request) goahttp.Enco
 				req.Header.Set("Authorization", head)
 			}
 		}
-		if p.XSync != nil {
-			head := *p.XSync
-			headStr := strconv.FormatBool(head)
-			req.Header.Set("X-Sync", headStr)
-		}
-		if p.IfMatch != nil {
-			head := *p.IfMatch
-			req.Header.Set("If-Match", head)
-		}
 		values := req.URL.Query()
 		if p.Version != nil {
 			values.Add("v", *p.Version)
@@ -2745,17 +3032,17 @@ func EncodeDeleteProjectDocumentRequest(encoder func(*http.Request) goahttp.Enco
 	}
 }
 
-// DecodeDeleteProjectDocumentResponse returns a decoder for responses returned
-// by the project-service delete-project-document endpoint. restoreBody
-// controls whether the response body should be restored after having been read.
-// DecodeDeleteProjectDocumentResponse may return the following errors:
-//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
-//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+// DecodeGetProjectAssociationsResponse returns a decoder for responses
+// returned by the project-service get-project-associations endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeGetProjectAssociationsResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
 //   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
 //   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - error: internal error
-func DecodeDeleteProjectDocumentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetProjectAssociationsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2770,250 +3057,9216 @@ func DecodeDeleteProjectDocumentResponse(decoder func(*http.Response) goahttp.De
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusNoContent:
-			return nil, nil
-		case http.StatusBadRequest:
+		case http.StatusOK:
 			var (
-				body DeleteProjectDocumentBadRequestResponseBody
+				body GetProjectAssociationsResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project-document", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-associations", err)
 			}
-			err = ValidateDeleteProjectDocumentBadRequestResponseBody(&body)
+			err = ValidateGetProjectAssociationsResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project-document", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-associations", err)
 			}
-			return nil, NewDeleteProjectDocumentBadRequest(&body)
-		case http.StatusConflict:
+			res := NewGetProjectAssociationsProjectAssociationsOK(&body)
+			return res, nil
+		case http.StatusForbidden:
 			var (
-				body DeleteProjectDocumentConflictResponseBody
+				body GetProjectAssociationsForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project-document", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-associations", err)
 			}
-			err = ValidateDeleteProjectDocumentConflictResponseBody(&body)
+			err = ValidateGetProjectAssociationsForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project-document", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-associations", err)
 			}
-			return nil, NewDeleteProjectDocumentConflict(&body)
+			return nil, NewGetProjectAssociationsForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body DeleteProjectDocumentInternalServerErrorResponseBody
+				body GetProjectAssociationsInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project-document", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-associations", err)
 			}
-			err = ValidateDeleteProjectDocumentInternalServerErrorResponseBody(&body)
+			err = ValidateGetProjectAssociationsInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project-document", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-associations", err)
 			}
-			return nil, NewDeleteProjectDocumentInternalServerError(&body)
+			return nil, NewGetProjectAssociationsInternalServerError(&body)
 		case http.StatusNotFound:
 			var (
-				body DeleteProjectDocumentNotFoundResponseBody
+				body GetProjectAssociationsNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project-document", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-associations", err)
 			}
-			err = ValidateDeleteProjectDocumentNotFoundResponseBody(&body)
+			err = ValidateGetProjectAssociationsNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project-document", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-associations", err)
 			}
-			return nil, NewDeleteProjectDocumentNotFound(&body)
+			return nil, NewGetProjectAssociationsNotFound(&body)
 		case http.StatusServiceUnavailable:
 			var (
-				body DeleteProjectDocumentServiceUnavailableResponseBody
+				body GetProjectAssociationsServiceUnavailableResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("project-service", "delete-project-document", err)
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-associations", err)
 			}
-			err = ValidateDeleteProjectDocumentServiceUnavailableResponseBody(&body)
+			err = ValidateGetProjectAssociationsServiceUnavailableResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("project-service", "delete-project-document", err)
+				return nil, goahttp.ErrValidationError("project-service", "get-project-associations", err)
 			}
-			return nil, NewDeleteProjectDocumentServiceUnavailable(&body)
+			return nil, NewGetProjectAssociationsServiceUnavailable(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("project-service", "delete-project-document", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("project-service", "get-project-associations", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// unmarshalProjectFullResponseBodyToProjectserviceProjectFull builds a value
-// of type *projectservice.ProjectFull from a value of type
-// *ProjectFullResponseBody.
-func unmarshalProjectFullResponseBodyToProjectserviceProjectFull(v *ProjectFullResponseBody) *projectservice.ProjectFull {
-	res := &projectservice.ProjectFull{
-		UID:                        v.UID,
-		Slug:                       v.Slug,
-		Description:                v.Description,
-		Name:                       v.Name,
-		Public:                     v.Public,
-		IsFoundation:               v.IsFoundation,
-		ParentUID:                  v.ParentUID,
-		Stage:                      v.Stage,
-		Category:                   v.Category,
-		Funding:                    v.Funding,
-		CharterURL:                 v.CharterURL,
-		LegalEntityType:            v.LegalEntityType,
-		LegalEntityName:            v.LegalEntityName,
-		LegalParentUID:             v.LegalParentUID,
-		EntityDissolutionDate:      v.EntityDissolutionDate,
-		EntityFormationDocumentURL: v.EntityFormationDocumentURL,
-		AutojoinEnabled:            v.AutojoinEnabled,
-		FormationDate:              v.FormationDate,
-		LogoURL:                    v.LogoURL,
-		RepositoryURL:              v.RepositoryURL,
-		WebsiteURL:                 v.WebsiteURL,
-		CreatedAt:                  v.CreatedAt,
-		UpdatedAt:                  v.UpdatedAt,
-		MissionStatement:           v.MissionStatement,
-		AnnouncementDate:           v.AnnouncementDate,
-	}
-	if v.FundingModel != nil {
-		res.FundingModel = make([]string, len(v.FundingModel))
-		for i, val := range v.FundingModel {
-			res.FundingModel[i] = val
-		}
-	}
-	if v.Writers != nil {
-		res.Writers = make([]*projectservice.UserInfo, len(v.Writers))
-		for i, val := range v.Writers {
-			res.Writers[i] = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(val)
-		}
-	}
-	if v.MeetingCoordinators != nil {
-		res.MeetingCoordinators = make([]*projectservice.UserInfo, len(v.MeetingCoordinators))
-		for i, val := range v.MeetingCoordinators {
-			res.MeetingCoordinators[i] = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(val)
-		}
-	}
-	if v.Auditors != nil {
-		res.Auditors = make([]*projectservice.UserInfo, len(v.Auditors))
-		for i, val := range v.Auditors {
-			res.Auditors[i] = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(val)
+// BuildUpdateProjectAssociationsRequest instantiates a HTTP request object
+// with method and path set to call the "project-service" service
+// "update-project-associations" endpoint
+func (c *Client) BuildUpdateProjectAssociationsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*projectservice.UpdateProjectAssociationsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "update-project-associations", "*projectservice.UpdateProjectAssociationsPayload", v)
 		}
+		uid = p.UID
 	}
-	if v.ExecutiveDirector != nil {
-		res.ExecutiveDirector = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(v.ExecutiveDirector)
-	}
-	if v.ProgramManager != nil {
-		res.ProgramManager = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(v.ProgramManager)
-	}
-	if v.OpportunityOwner != nil {
-		res.OpportunityOwner = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(v.OpportunityOwner)
-	}
-
-	return res
-}
-
-// unmarshalUserInfoResponseBodyToProjectserviceUserInfo builds a value of type
-// *projectservice.UserInfo from a value of type *UserInfoResponseBody.
-func unmarshalUserInfoResponseBodyToProjectserviceUserInfo(v *UserInfoResponseBody) *projectservice.UserInfo {
-	if v == nil {
-		return nil
-	}
-	res := &projectservice.UserInfo{
-		Name:     v.Name,
-		Email:    v.Email,
-		Username: v.Username,
-		Avatar:   v.Avatar,
-	}
-	if v.Invite != nil {
-		res.Invite = unmarshalInviteInfoResponseBodyToProjectserviceInviteInfo(v.Invite)
-	}
-
-	return res
-}
-
-// unmarshalInviteInfoResponseBodyToProjectserviceInviteInfo builds a value of
-// type *projectservice.InviteInfo from a value of type *InviteInfoResponseBody.
-func unmarshalInviteInfoResponseBodyToProjectserviceInviteInfo(v *InviteInfoResponseBody) *projectservice.InviteInfo {
-	if v == nil {
-		return nil
-	}
-	res := &projectservice.InviteInfo{
-		UID:       v.UID,
-		Email:     v.Email,
-		ExpiresAt: v.ExpiresAt,
-	}
-
-	return res
-}
-
-// marshalProjectserviceUserInfoToUserInfoRequestBody builds a value of type
-// *UserInfoRequestBody from a value of type *projectservice.UserInfo.
-func marshalProjectserviceUserInfoToUserInfoRequestBody(v *projectservice.UserInfo) *UserInfoRequestBody {
-	if v == nil {
-		return nil
-	}
-	res := &UserInfoRequestBody{
-		Name:     v.Name,
-		Email:    v.Email,
-		Username: v.Username,
-		Avatar:   v.Avatar,
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateProjectAssociationsProjectServicePath(uid)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "update-project-associations", u.String(), err)
 	}
-	if v.Invite != nil {
-		res.Invite = marshalProjectserviceInviteInfoToInviteInfoRequestBody(v.Invite)
+	if ctx != nil {
+		req = req.WithContext(ctx)
 	}
 
-	return res
+	return req, nil
 }
 
-// marshalProjectserviceInviteInfoToInviteInfoRequestBody builds a value of
-// type *InviteInfoRequestBody from a value of type *projectservice.InviteInfo.
-func marshalProjectserviceInviteInfoToInviteInfoRequestBody(v *projectservice.InviteInfo) *InviteInfoRequestBody {
-	if v == nil {
+// EncodeUpdateProjectAssociationsRequest returns an encoder for requests sent
+// to the project-service update-project-associations server.
+func EncodeUpdateProjectAssociationsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.UpdateProjectAssociationsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "update-project-associations", "*projectservice.UpdateProjectAssociationsPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		body := NewUpdateProjectAssociationsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("project-service", "update-project-associations", err)
+		}
 		return nil
 	}
-	res := &InviteInfoRequestBody{
-		UID:       v.UID,
-		Email:     v.Email,
-		ExpiresAt: v.ExpiresAt,
-	}
-
-	return res
 }
 
-// marshalUserInfoRequestBodyToProjectserviceUserInfo builds a value of type
-// *projectservice.UserInfo from a value of type *UserInfoRequestBody.
-func marshalUserInfoRequestBodyToProjectserviceUserInfo(v *UserInfoRequestBody) *projectservice.UserInfo {
-	if v == nil {
-		return nil
-	}
-	res := &projectservice.UserInfo{
-		Name:     v.Name,
-		Email:    v.Email,
-		Username: v.Username,
-		Avatar:   v.Avatar,
-	}
-	if v.Invite != nil {
-		res.Invite = marshalInviteInfoRequestBodyToProjectserviceInviteInfo(v.Invite)
+// DecodeUpdateProjectAssociationsResponse returns a decoder for responses
+// returned by the project-service update-project-associations endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeUpdateProjectAssociationsResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeUpdateProjectAssociationsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateProjectAssociationsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-associations", err)
+			}
+			err = ValidateUpdateProjectAssociationsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-associations", err)
+			}
+			res := NewUpdateProjectAssociationsProjectAssociationsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body UpdateProjectAssociationsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-associations", err)
+			}
+			err = ValidateUpdateProjectAssociationsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-associations", err)
+			}
+			return nil, NewUpdateProjectAssociationsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateProjectAssociationsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-associations", err)
+			}
+			err = ValidateUpdateProjectAssociationsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-associations", err)
+			}
+			return nil, NewUpdateProjectAssociationsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpdateProjectAssociationsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-associations", err)
+			}
+			err = ValidateUpdateProjectAssociationsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-associations", err)
+			}
+			return nil, NewUpdateProjectAssociationsInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateProjectAssociationsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-associations", err)
+			}
+			err = ValidateUpdateProjectAssociationsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-associations", err)
+			}
+			return nil, NewUpdateProjectAssociationsNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body UpdateProjectAssociationsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-associations", err)
+			}
+			err = ValidateUpdateProjectAssociationsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-associations", err)
+			}
+			return nil, NewUpdateProjectAssociationsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "update-project-associations", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetProjectSettingsDiffRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "get-project-settings-diff" endpoint
+func (c *Client) BuildGetProjectSettingsDiffRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*projectservice.GetProjectSettingsDiffPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "get-project-settings-diff", "*projectservice.GetProjectSettingsDiffPayload", v)
+		}
+		uid = p.UID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProjectSettingsDiffProjectServicePath(uid)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "get-project-settings-diff", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetProjectSettingsDiffRequest returns an encoder for requests sent to
+// the project-service get-project-settings-diff server.
+func EncodeGetProjectSettingsDiffRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.GetProjectSettingsDiffPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "get-project-settings-diff", "*projectservice.GetProjectSettingsDiffPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		values.Add("from", fmt.Sprintf("%v", p.From))
+		values.Add("to", fmt.Sprintf("%v", p.To))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetProjectSettingsDiffResponse returns a decoder for responses
+// returned by the project-service get-project-settings-diff endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeGetProjectSettingsDiffResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetProjectSettingsDiffResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetProjectSettingsDiffResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-settings-diff", err)
+			}
+			err = ValidateGetProjectSettingsDiffResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-settings-diff", err)
+			}
+			res := NewGetProjectSettingsDiffProjectSettingsDiffOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetProjectSettingsDiffBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-settings-diff", err)
+			}
+			err = ValidateGetProjectSettingsDiffBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-settings-diff", err)
+			}
+			return nil, NewGetProjectSettingsDiffBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetProjectSettingsDiffForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-settings-diff", err)
+			}
+			err = ValidateGetProjectSettingsDiffForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-settings-diff", err)
+			}
+			return nil, NewGetProjectSettingsDiffForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetProjectSettingsDiffInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-settings-diff", err)
+			}
+			err = ValidateGetProjectSettingsDiffInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-settings-diff", err)
+			}
+			return nil, NewGetProjectSettingsDiffInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetProjectSettingsDiffNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-settings-diff", err)
+			}
+			err = ValidateGetProjectSettingsDiffNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-settings-diff", err)
+			}
+			return nil, NewGetProjectSettingsDiffNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetProjectSettingsDiffServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-settings-diff", err)
+			}
+			err = ValidateGetProjectSettingsDiffServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-settings-diff", err)
+			}
+			return nil, NewGetProjectSettingsDiffServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "get-project-settings-diff", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetProjectChildrenRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "get-project-children" endpoint
+func (c *Client) BuildGetProjectChildrenRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*projectservice.GetProjectChildrenPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "get-project-children", "*projectservice.GetProjectChildrenPayload", v)
+		}
+		uid = p.UID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProjectChildrenProjectServicePath(uid)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "get-project-children", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetProjectChildrenRequest returns an encoder for requests sent to the
+// project-service get-project-children server.
+func EncodeGetProjectChildrenRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.GetProjectChildrenPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "get-project-children", "*projectservice.GetProjectChildrenPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		values.Add("sort", p.Sort)
+		values.Add("order", p.Order)
+		values.Add("page_size", fmt.Sprintf("%v", p.PageSize))
+		if p.PageToken != nil {
+			values.Add("page_token", *p.PageToken)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetProjectChildrenResponse returns a decoder for responses returned by
+// the project-service get-project-children endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeGetProjectChildrenResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetProjectChildrenResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetProjectChildrenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-children", err)
+			}
+			err = ValidateGetProjectChildrenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-children", err)
+			}
+			res := NewGetProjectChildrenProjectPageOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetProjectChildrenBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-children", err)
+			}
+			err = ValidateGetProjectChildrenBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-children", err)
+			}
+			return nil, NewGetProjectChildrenBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetProjectChildrenForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-children", err)
+			}
+			err = ValidateGetProjectChildrenForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-children", err)
+			}
+			return nil, NewGetProjectChildrenForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetProjectChildrenInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-children", err)
+			}
+			err = ValidateGetProjectChildrenInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-children", err)
+			}
+			return nil, NewGetProjectChildrenInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetProjectChildrenNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-children", err)
+			}
+			err = ValidateGetProjectChildrenNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-children", err)
+			}
+			return nil, NewGetProjectChildrenNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetProjectChildrenServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-children", err)
+			}
+			err = ValidateGetProjectChildrenServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-children", err)
+			}
+			return nil, NewGetProjectChildrenServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "get-project-children", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetProjectDescendantsRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "get-project-descendants" endpoint
+func (c *Client) BuildGetProjectDescendantsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*projectservice.GetProjectDescendantsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "get-project-descendants", "*projectservice.GetProjectDescendantsPayload", v)
+		}
+		uid = p.UID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProjectDescendantsProjectServicePath(uid)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "get-project-descendants", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetProjectDescendantsRequest returns an encoder for requests sent to
+// the project-service get-project-descendants server.
+func EncodeGetProjectDescendantsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.GetProjectDescendantsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "get-project-descendants", "*projectservice.GetProjectDescendantsPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		values.Add("sort", p.Sort)
+		values.Add("order", p.Order)
+		values.Add("page_size", fmt.Sprintf("%v", p.PageSize))
+		if p.PageToken != nil {
+			values.Add("page_token", *p.PageToken)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetProjectDescendantsResponse returns a decoder for responses returned
+// by the project-service get-project-descendants endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeGetProjectDescendantsResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetProjectDescendantsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetProjectDescendantsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-descendants", err)
+			}
+			err = ValidateGetProjectDescendantsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-descendants", err)
+			}
+			res := NewGetProjectDescendantsProjectPageOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetProjectDescendantsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-descendants", err)
+			}
+			err = ValidateGetProjectDescendantsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-descendants", err)
+			}
+			return nil, NewGetProjectDescendantsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetProjectDescendantsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-descendants", err)
+			}
+			err = ValidateGetProjectDescendantsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-descendants", err)
+			}
+			return nil, NewGetProjectDescendantsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetProjectDescendantsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-descendants", err)
+			}
+			err = ValidateGetProjectDescendantsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-descendants", err)
+			}
+			return nil, NewGetProjectDescendantsInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetProjectDescendantsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-descendants", err)
+			}
+			err = ValidateGetProjectDescendantsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-descendants", err)
+			}
+			return nil, NewGetProjectDescendantsNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetProjectDescendantsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-descendants", err)
+			}
+			err = ValidateGetProjectDescendantsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-descendants", err)
+			}
+			return nil, NewGetProjectDescendantsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "get-project-descendants", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetProjectLegalLineageRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "get-project-legal-lineage" endpoint
+func (c *Client) BuildGetProjectLegalLineageRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*projectservice.GetProjectLegalLineagePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "get-project-legal-lineage", "*projectservice.GetProjectLegalLineagePayload", v)
+		}
+		uid = p.UID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProjectLegalLineageProjectServicePath(uid)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "get-project-legal-lineage", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetProjectLegalLineageRequest returns an encoder for requests sent to
+// the project-service get-project-legal-lineage server.
+func EncodeGetProjectLegalLineageRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.GetProjectLegalLineagePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "get-project-legal-lineage", "*projectservice.GetProjectLegalLineagePayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetProjectLegalLineageResponse returns a decoder for responses
+// returned by the project-service get-project-legal-lineage endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeGetProjectLegalLineageResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetProjectLegalLineageResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetProjectLegalLineageResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-legal-lineage", err)
+			}
+			err = ValidateGetProjectLegalLineageResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-legal-lineage", err)
+			}
+			res := NewGetProjectLegalLineageProjectLegalLineageOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetProjectLegalLineageBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-legal-lineage", err)
+			}
+			err = ValidateGetProjectLegalLineageBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-legal-lineage", err)
+			}
+			return nil, NewGetProjectLegalLineageBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetProjectLegalLineageForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-legal-lineage", err)
+			}
+			err = ValidateGetProjectLegalLineageForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-legal-lineage", err)
+			}
+			return nil, NewGetProjectLegalLineageForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetProjectLegalLineageInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-legal-lineage", err)
+			}
+			err = ValidateGetProjectLegalLineageInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-legal-lineage", err)
+			}
+			return nil, NewGetProjectLegalLineageInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetProjectLegalLineageNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-legal-lineage", err)
+			}
+			err = ValidateGetProjectLegalLineageNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-legal-lineage", err)
+			}
+			return nil, NewGetProjectLegalLineageNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetProjectLegalLineageServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-legal-lineage", err)
+			}
+			err = ValidateGetProjectLegalLineageServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-legal-lineage", err)
+			}
+			return nil, NewGetProjectLegalLineageServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "get-project-legal-lineage", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSearchProjectsRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "search-projects" endpoint
+func (c *Client) BuildSearchProjectsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SearchProjectsProjectServicePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "search-projects", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSearchProjectsRequest returns an encoder for requests sent to the
+// project-service search-projects server.
+func EncodeSearchProjectsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.SearchProjectsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "search-projects", "*projectservice.SearchProjectsPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		values.Add("q", p.Q)
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeSearchProjectsResponse returns a decoder for responses returned by the
+// project-service search-projects endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeSearchProjectsResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeSearchProjectsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SearchProjectsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "search-projects", err)
+			}
+			err = ValidateSearchProjectsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "search-projects", err)
+			}
+			res := NewSearchProjectsResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body SearchProjectsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "search-projects", err)
+			}
+			err = ValidateSearchProjectsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "search-projects", err)
+			}
+			return nil, NewSearchProjectsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body SearchProjectsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "search-projects", err)
+			}
+			err = ValidateSearchProjectsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "search-projects", err)
+			}
+			return nil, NewSearchProjectsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body SearchProjectsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "search-projects", err)
+			}
+			err = ValidateSearchProjectsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "search-projects", err)
+			}
+			return nil, NewSearchProjectsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body SearchProjectsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "search-projects", err)
+			}
+			err = ValidateSearchProjectsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "search-projects", err)
+			}
+			return nil, NewSearchProjectsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "search-projects", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildAutocompleteProjectsRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "autocomplete-projects" endpoint
+func (c *Client) BuildAutocompleteProjectsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AutocompleteProjectsProjectServicePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "autocomplete-projects", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeAutocompleteProjectsRequest returns an encoder for requests sent to
+// the project-service autocomplete-projects server.
+func EncodeAutocompleteProjectsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.AutocompleteProjectsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "autocomplete-projects", "*projectservice.AutocompleteProjectsPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		values.Add("q", p.Q)
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeAutocompleteProjectsResponse returns a decoder for responses returned
+// by the project-service autocomplete-projects endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeAutocompleteProjectsResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeAutocompleteProjectsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body AutocompleteProjectsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "autocomplete-projects", err)
+			}
+			err = ValidateAutocompleteProjectsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "autocomplete-projects", err)
+			}
+			res := NewAutocompleteProjectsResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body AutocompleteProjectsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "autocomplete-projects", err)
+			}
+			err = ValidateAutocompleteProjectsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "autocomplete-projects", err)
+			}
+			return nil, NewAutocompleteProjectsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body AutocompleteProjectsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "autocomplete-projects", err)
+			}
+			err = ValidateAutocompleteProjectsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "autocomplete-projects", err)
+			}
+			return nil, NewAutocompleteProjectsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body AutocompleteProjectsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "autocomplete-projects", err)
+			}
+			err = ValidateAutocompleteProjectsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "autocomplete-projects", err)
+			}
+			return nil, NewAutocompleteProjectsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body AutocompleteProjectsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "autocomplete-projects", err)
+			}
+			err = ValidateAutocompleteProjectsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "autocomplete-projects", err)
+			}
+			return nil, NewAutocompleteProjectsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "autocomplete-projects", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildAddProjectWriterRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "add-project-writer"
+// endpoint
+func (c *Client) BuildAddProjectWriterRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid      string
+		username string
+	)
+	{
+		p, ok := v.(*projectservice.AddProjectWriterPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "add-project-writer", "*projectservice.AddProjectWriterPayload", v)
+		}
+		uid = p.UID
+		username = p.Username
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AddProjectWriterProjectServicePath(uid, username)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "add-project-writer", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeAddProjectWriterRequest returns an encoder for requests sent to the
+// project-service add-project-writer server.
+func EncodeAddProjectWriterRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.AddProjectWriterPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "add-project-writer", "*projectservice.AddProjectWriterPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		if p.XSync != nil {
+			head := *p.XSync
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Sync", headStr)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeAddProjectWriterResponse returns a decoder for responses returned by
+// the project-service add-project-writer endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeAddProjectWriterResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeAddProjectWriterResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body AddProjectWriterResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "add-project-writer", err)
+			}
+			err = ValidateAddProjectWriterResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "add-project-writer", err)
+			}
+			res := NewAddProjectWriterProjectSettingsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body AddProjectWriterBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "add-project-writer", err)
+			}
+			err = ValidateAddProjectWriterBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "add-project-writer", err)
+			}
+			return nil, NewAddProjectWriterBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body AddProjectWriterConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "add-project-writer", err)
+			}
+			err = ValidateAddProjectWriterConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "add-project-writer", err)
+			}
+			return nil, NewAddProjectWriterConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body AddProjectWriterForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "add-project-writer", err)
+			}
+			err = ValidateAddProjectWriterForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "add-project-writer", err)
+			}
+			return nil, NewAddProjectWriterForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body AddProjectWriterInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "add-project-writer", err)
+			}
+			err = ValidateAddProjectWriterInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "add-project-writer", err)
+			}
+			return nil, NewAddProjectWriterInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body AddProjectWriterNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "add-project-writer", err)
+			}
+			err = ValidateAddProjectWriterNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "add-project-writer", err)
+			}
+			return nil, NewAddProjectWriterNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body AddProjectWriterServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "add-project-writer", err)
+			}
+			err = ValidateAddProjectWriterServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "add-project-writer", err)
+			}
+			return nil, NewAddProjectWriterServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "add-project-writer", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRemoveProjectWriterRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "remove-project-writer" endpoint
+func (c *Client) BuildRemoveProjectWriterRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid      string
+		username string
+	)
+	{
+		p, ok := v.(*projectservice.RemoveProjectWriterPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "remove-project-writer", "*projectservice.RemoveProjectWriterPayload", v)
+		}
+		uid = p.UID
+		username = p.Username
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RemoveProjectWriterProjectServicePath(uid, username)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "remove-project-writer", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRemoveProjectWriterRequest returns an encoder for requests sent to the
+// project-service remove-project-writer server.
+func EncodeRemoveProjectWriterRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.RemoveProjectWriterPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "remove-project-writer", "*projectservice.RemoveProjectWriterPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		if p.XSync != nil {
+			head := *p.XSync
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Sync", headStr)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeRemoveProjectWriterResponse returns a decoder for responses returned
+// by the project-service remove-project-writer endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeRemoveProjectWriterResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeRemoveProjectWriterResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body RemoveProjectWriterBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "remove-project-writer", err)
+			}
+			err = ValidateRemoveProjectWriterBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "remove-project-writer", err)
+			}
+			return nil, NewRemoveProjectWriterBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body RemoveProjectWriterConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "remove-project-writer", err)
+			}
+			err = ValidateRemoveProjectWriterConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "remove-project-writer", err)
+			}
+			return nil, NewRemoveProjectWriterConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body RemoveProjectWriterForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "remove-project-writer", err)
+			}
+			err = ValidateRemoveProjectWriterForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "remove-project-writer", err)
+			}
+			return nil, NewRemoveProjectWriterForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RemoveProjectWriterInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "remove-project-writer", err)
+			}
+			err = ValidateRemoveProjectWriterInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "remove-project-writer", err)
+			}
+			return nil, NewRemoveProjectWriterInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body RemoveProjectWriterNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "remove-project-writer", err)
+			}
+			err = ValidateRemoveProjectWriterNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "remove-project-writer", err)
+			}
+			return nil, NewRemoveProjectWriterNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body RemoveProjectWriterServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "remove-project-writer", err)
+			}
+			err = ValidateRemoveProjectWriterServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "remove-project-writer", err)
+			}
+			return nil, NewRemoveProjectWriterServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "remove-project-writer", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildAddProjectAuditorRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "add-project-auditor"
+// endpoint
+func (c *Client) BuildAddProjectAuditorRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid      string
+		username string
+	)
+	{
+		p, ok := v.(*projectservice.AddProjectAuditorPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "add-project-auditor", "*projectservice.AddProjectAuditorPayload", v)
+		}
+		uid = p.UID
+		username = p.Username
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AddProjectAuditorProjectServicePath(uid, username)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "add-project-auditor", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeAddProjectAuditorRequest returns an encoder for requests sent to the
+// project-service add-project-auditor server.
+func EncodeAddProjectAuditorRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.AddProjectAuditorPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "add-project-auditor", "*projectservice.AddProjectAuditorPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		if p.XSync != nil {
+			head := *p.XSync
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Sync", headStr)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeAddProjectAuditorResponse returns a decoder for responses returned by
+// the project-service add-project-auditor endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeAddProjectAuditorResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeAddProjectAuditorResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body AddProjectAuditorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "add-project-auditor", err)
+			}
+			err = ValidateAddProjectAuditorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "add-project-auditor", err)
+			}
+			res := NewAddProjectAuditorProjectSettingsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body AddProjectAuditorBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "add-project-auditor", err)
+			}
+			err = ValidateAddProjectAuditorBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "add-project-auditor", err)
+			}
+			return nil, NewAddProjectAuditorBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body AddProjectAuditorConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "add-project-auditor", err)
+			}
+			err = ValidateAddProjectAuditorConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "add-project-auditor", err)
+			}
+			return nil, NewAddProjectAuditorConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body AddProjectAuditorForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "add-project-auditor", err)
+			}
+			err = ValidateAddProjectAuditorForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "add-project-auditor", err)
+			}
+			return nil, NewAddProjectAuditorForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body AddProjectAuditorInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "add-project-auditor", err)
+			}
+			err = ValidateAddProjectAuditorInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "add-project-auditor", err)
+			}
+			return nil, NewAddProjectAuditorInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body AddProjectAuditorNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "add-project-auditor", err)
+			}
+			err = ValidateAddProjectAuditorNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "add-project-auditor", err)
+			}
+			return nil, NewAddProjectAuditorNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body AddProjectAuditorServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "add-project-auditor", err)
+			}
+			err = ValidateAddProjectAuditorServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "add-project-auditor", err)
+			}
+			return nil, NewAddProjectAuditorServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "add-project-auditor", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRemoveProjectAuditorRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "remove-project-auditor" endpoint
+func (c *Client) BuildRemoveProjectAuditorRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid      string
+		username string
+	)
+	{
+		p, ok := v.(*projectservice.RemoveProjectAuditorPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "remove-project-auditor", "*projectservice.RemoveProjectAuditorPayload", v)
+		}
+		uid = p.UID
+		username = p.Username
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RemoveProjectAuditorProjectServicePath(uid, username)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "remove-project-auditor", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRemoveProjectAuditorRequest returns an encoder for requests sent to
+// the project-service remove-project-auditor server.
+func EncodeRemoveProjectAuditorRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.RemoveProjectAuditorPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "remove-project-auditor", "*projectservice.RemoveProjectAuditorPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		if p.XSync != nil {
+			head := *p.XSync
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Sync", headStr)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeRemoveProjectAuditorResponse returns a decoder for responses returned
+// by the project-service remove-project-auditor endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeRemoveProjectAuditorResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeRemoveProjectAuditorResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body RemoveProjectAuditorBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "remove-project-auditor", err)
+			}
+			err = ValidateRemoveProjectAuditorBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "remove-project-auditor", err)
+			}
+			return nil, NewRemoveProjectAuditorBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body RemoveProjectAuditorConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "remove-project-auditor", err)
+			}
+			err = ValidateRemoveProjectAuditorConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "remove-project-auditor", err)
+			}
+			return nil, NewRemoveProjectAuditorConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body RemoveProjectAuditorForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "remove-project-auditor", err)
+			}
+			err = ValidateRemoveProjectAuditorForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "remove-project-auditor", err)
+			}
+			return nil, NewRemoveProjectAuditorForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RemoveProjectAuditorInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "remove-project-auditor", err)
+			}
+			err = ValidateRemoveProjectAuditorInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "remove-project-auditor", err)
+			}
+			return nil, NewRemoveProjectAuditorInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body RemoveProjectAuditorNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "remove-project-auditor", err)
+			}
+			err = ValidateRemoveProjectAuditorNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "remove-project-auditor", err)
+			}
+			return nil, NewRemoveProjectAuditorNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body RemoveProjectAuditorServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "remove-project-auditor", err)
+			}
+			err = ValidateRemoveProjectAuditorServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "remove-project-auditor", err)
+			}
+			return nil, NewRemoveProjectAuditorServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "remove-project-auditor", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildJoinProjectRequest instantiates a HTTP request object with method and
+// path set to call the "project-service" service "join-project" endpoint
+func (c *Client) BuildJoinProjectRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*projectservice.JoinProjectPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "join-project", "*projectservice.JoinProjectPayload", v)
+		}
+		uid = p.UID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: JoinProjectProjectServicePath(uid)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "join-project", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeJoinProjectRequest returns an encoder for requests sent to the
+// project-service join-project server.
+func EncodeJoinProjectRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.JoinProjectPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "join-project", "*projectservice.JoinProjectPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		if p.XSync != nil {
+			head := *p.XSync
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Sync", headStr)
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeJoinProjectResponse returns a decoder for responses returned by the
+// project-service join-project endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeJoinProjectResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeJoinProjectResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body JoinProjectResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "join-project", err)
+			}
+			err = ValidateJoinProjectResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "join-project", err)
+			}
+			res := NewJoinProjectProjectJoinResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body JoinProjectBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "join-project", err)
+			}
+			err = ValidateJoinProjectBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "join-project", err)
+			}
+			return nil, NewJoinProjectBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body JoinProjectConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "join-project", err)
+			}
+			err = ValidateJoinProjectConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "join-project", err)
+			}
+			return nil, NewJoinProjectConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body JoinProjectForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "join-project", err)
+			}
+			err = ValidateJoinProjectForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "join-project", err)
+			}
+			return nil, NewJoinProjectForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body JoinProjectInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "join-project", err)
+			}
+			err = ValidateJoinProjectInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "join-project", err)
+			}
+			return nil, NewJoinProjectInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body JoinProjectNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "join-project", err)
+			}
+			err = ValidateJoinProjectNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "join-project", err)
+			}
+			return nil, NewJoinProjectNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body JoinProjectServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "join-project", err)
+			}
+			err = ValidateJoinProjectServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "join-project", err)
+			}
+			return nil, NewJoinProjectServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "join-project", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildBulkUpdateProjectMembersRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "bulk-update-project-members" endpoint
+func (c *Client) BuildBulkUpdateProjectMembersRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BulkUpdateProjectMembersProjectServicePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "bulk-update-project-members", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeBulkUpdateProjectMembersRequest returns an encoder for requests sent
+// to the project-service bulk-update-project-members server.
+func EncodeBulkUpdateProjectMembersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.BulkUpdateProjectMembersPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "bulk-update-project-members", "*projectservice.BulkUpdateProjectMembersPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		if p.XSync != nil {
+			head := *p.XSync
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Sync", headStr)
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		body := NewBulkUpdateProjectMembersRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("project-service", "bulk-update-project-members", err)
+		}
+		return nil
+	}
+}
+
+// DecodeBulkUpdateProjectMembersResponse returns a decoder for responses
+// returned by the project-service bulk-update-project-members endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeBulkUpdateProjectMembersResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeBulkUpdateProjectMembersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body BulkUpdateProjectMembersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "bulk-update-project-members", err)
+			}
+			err = ValidateBulkUpdateProjectMembersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "bulk-update-project-members", err)
+			}
+			res := NewBulkUpdateProjectMembersBulkProjectMembersResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body BulkUpdateProjectMembersBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "bulk-update-project-members", err)
+			}
+			err = ValidateBulkUpdateProjectMembersBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "bulk-update-project-members", err)
+			}
+			return nil, NewBulkUpdateProjectMembersBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body BulkUpdateProjectMembersForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "bulk-update-project-members", err)
+			}
+			err = ValidateBulkUpdateProjectMembersForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "bulk-update-project-members", err)
+			}
+			return nil, NewBulkUpdateProjectMembersForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body BulkUpdateProjectMembersInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "bulk-update-project-members", err)
+			}
+			err = ValidateBulkUpdateProjectMembersInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "bulk-update-project-members", err)
+			}
+			return nil, NewBulkUpdateProjectMembersInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body BulkUpdateProjectMembersServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "bulk-update-project-members", err)
+			}
+			err = ValidateBulkUpdateProjectMembersServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "bulk-update-project-members", err)
+			}
+			return nil, NewBulkUpdateProjectMembersServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "bulk-update-project-members", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetProjectsRequest instantiates a HTTP request object with method and
+// path set to call the "project-service" service "get-projects" endpoint
+func (c *Client) BuildGetProjectsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProjectsProjectServicePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "get-projects", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetProjectsRequest returns an encoder for requests sent to the
+// project-service get-projects server.
+func EncodeGetProjectsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.GetProjectsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "get-projects", "*projectservice.GetProjectsPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		for _, value := range p.Tag {
+			values.Add("tag", value)
+		}
+		if p.Fields != nil {
+			values.Add("fields", *p.Fields)
+		}
+		values.Add("base_only", fmt.Sprintf("%v", p.BaseOnly))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetProjectsResponse returns a decoder for responses returned by the
+// project-service get-projects endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetProjectsResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetProjectsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetProjectsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-projects", err)
+			}
+			err = ValidateGetProjectsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-projects", err)
+			}
+			var (
+				cacheControl *string
+			)
+			cacheControlRaw := resp.Header.Get("Cache-Control")
+			if cacheControlRaw != "" {
+				cacheControl = &cacheControlRaw
+			}
+			res := NewGetProjectsResultOK(&body, cacheControl)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetProjectsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-projects", err)
+			}
+			err = ValidateGetProjectsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-projects", err)
+			}
+			return nil, NewGetProjectsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetProjectsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-projects", err)
+			}
+			err = ValidateGetProjectsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-projects", err)
+			}
+			return nil, NewGetProjectsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetProjectsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-projects", err)
+			}
+			err = ValidateGetProjectsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-projects", err)
+			}
+			return nil, NewGetProjectsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetProjectsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-projects", err)
+			}
+			err = ValidateGetProjectsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-projects", err)
+			}
+			return nil, NewGetProjectsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "get-projects", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateProjectRequest instantiates a HTTP request object with method and
+// path set to call the "project-service" service "create-project" endpoint
+func (c *Client) BuildCreateProjectRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateProjectProjectServicePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "create-project", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateProjectRequest returns an encoder for requests sent to the
+// project-service create-project server.
+func EncodeCreateProjectRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.CreateProjectPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "create-project", "*projectservice.CreateProjectPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		if p.XSync != nil {
+			head := *p.XSync
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Sync", headStr)
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		values.Add("allow_duplicates", fmt.Sprintf("%v", p.AllowDuplicates))
+		if p.Template != nil {
+			values.Add("template", *p.Template)
+		}
+		req.URL.RawQuery = values.Encode()
+		body := NewCreateProjectRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("project-service", "create-project", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateProjectResponse returns a decoder for responses returned by the
+// project-service create-project endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeCreateProjectResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "UnprocessableEntity" (type *projectservice.UnprocessableEntityError): http.StatusUnprocessableEntity
+//   - error: internal error
+func DecodeCreateProjectResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateProjectResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-project", err)
+			}
+			err = ValidateCreateProjectResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-project", err)
+			}
+			res := NewCreateProjectProjectFullCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CreateProjectBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-project", err)
+			}
+			err = ValidateCreateProjectBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-project", err)
+			}
+			return nil, NewCreateProjectBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body CreateProjectConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-project", err)
+			}
+			err = ValidateCreateProjectConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-project", err)
+			}
+			return nil, NewCreateProjectConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateProjectForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-project", err)
+			}
+			err = ValidateCreateProjectForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-project", err)
+			}
+			return nil, NewCreateProjectForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateProjectInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-project", err)
+			}
+			err = ValidateCreateProjectInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-project", err)
+			}
+			return nil, NewCreateProjectInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body CreateProjectServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-project", err)
+			}
+			err = ValidateCreateProjectServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-project", err)
+			}
+			return nil, NewCreateProjectServiceUnavailable(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body CreateProjectUnprocessableEntityResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-project", err)
+			}
+			err = ValidateCreateProjectUnprocessableEntityResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-project", err)
+			}
+			return nil, NewCreateProjectUnprocessableEntity(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "create-project", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetOneProjectBaseRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "get-one-project-base"
+// endpoint
+func (c *Client) BuildGetOneProjectBaseRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*projectservice.GetOneProjectBasePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "get-one-project-base", "*projectservice.GetOneProjectBasePayload", v)
+		}
+		if p.UID != nil {
+			uid = *p.UID
+		}
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetOneProjectBaseProjectServicePath(uid)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "get-one-project-base", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetOneProjectBaseRequest returns an encoder for requests sent to the
+// project-service get-one-project-base server.
+func EncodeGetOneProjectBaseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.GetOneProjectBasePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "get-one-project-base", "*projectservice.GetOneProjectBasePayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		if p.Fields != nil {
+			values.Add("fields", *p.Fields)
+		}
+		if p.Expand != nil {
+			values.Add("expand", *p.Expand)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetOneProjectBaseResponse returns a decoder for responses returned by
+// the project-service get-one-project-base endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeGetOneProjectBaseResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetOneProjectBaseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetOneProjectBaseResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-one-project-base", err)
+			}
+			err = ValidateGetOneProjectBaseResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-one-project-base", err)
+			}
+			var (
+				etag         *string
+				cacheControl *string
+				settingsEtag *string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw != "" {
+				etag = &etagRaw
+			}
+			cacheControlRaw := resp.Header.Get("Cache-Control")
+			if cacheControlRaw != "" {
+				cacheControl = &cacheControlRaw
+			}
+			settingsEtagRaw := resp.Header.Get("X-Settings-Etag")
+			if settingsEtagRaw != "" {
+				settingsEtag = &settingsEtagRaw
+			}
+			res := NewGetOneProjectBaseResultOK(&body, etag, cacheControl, settingsEtag)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetOneProjectBaseBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-one-project-base", err)
+			}
+			err = ValidateGetOneProjectBaseBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-one-project-base", err)
+			}
+			return nil, NewGetOneProjectBaseBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetOneProjectBaseForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-one-project-base", err)
+			}
+			err = ValidateGetOneProjectBaseForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-one-project-base", err)
+			}
+			return nil, NewGetOneProjectBaseForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetOneProjectBaseInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-one-project-base", err)
+			}
+			err = ValidateGetOneProjectBaseInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-one-project-base", err)
+			}
+			return nil, NewGetOneProjectBaseInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetOneProjectBaseNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-one-project-base", err)
+			}
+			err = ValidateGetOneProjectBaseNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-one-project-base", err)
+			}
+			return nil, NewGetOneProjectBaseNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetOneProjectBaseServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-one-project-base", err)
+			}
+			err = ValidateGetOneProjectBaseServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-one-project-base", err)
+			}
+			return nil, NewGetOneProjectBaseServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "get-one-project-base", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetProjectUIDFromLegacyIDRequest instantiates a HTTP request object
+// with method and path set to call the "project-service" service
+// "get-project-uid-from-legacy-id" endpoint
+func (c *Client) BuildGetProjectUIDFromLegacyIDRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		legacyID string
+	)
+	{
+		p, ok := v.(*projectservice.GetProjectUIDFromLegacyIDPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "get-project-uid-from-legacy-id", "*projectservice.GetProjectUIDFromLegacyIDPayload", v)
+		}
+		legacyID = p.LegacyID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProjectUIDFromLegacyIDProjectServicePath(legacyID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "get-project-uid-from-legacy-id", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetProjectUIDFromLegacyIDRequest returns an encoder for requests sent
+// to the project-service get-project-uid-from-legacy-id server.
+func EncodeGetProjectUIDFromLegacyIDRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.GetProjectUIDFromLegacyIDPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "get-project-uid-from-legacy-id", "*projectservice.GetProjectUIDFromLegacyIDPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetProjectUIDFromLegacyIDResponse returns a decoder for responses
+// returned by the project-service get-project-uid-from-legacy-id endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeGetProjectUIDFromLegacyIDResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetProjectUIDFromLegacyIDResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetProjectUIDFromLegacyIDResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-uid-from-legacy-id", err)
+			}
+			err = ValidateGetProjectUIDFromLegacyIDResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-uid-from-legacy-id", err)
+			}
+			res := NewGetProjectUIDFromLegacyIDResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetProjectUIDFromLegacyIDBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-uid-from-legacy-id", err)
+			}
+			err = ValidateGetProjectUIDFromLegacyIDBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-uid-from-legacy-id", err)
+			}
+			return nil, NewGetProjectUIDFromLegacyIDBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetProjectUIDFromLegacyIDForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-uid-from-legacy-id", err)
+			}
+			err = ValidateGetProjectUIDFromLegacyIDForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-uid-from-legacy-id", err)
+			}
+			return nil, NewGetProjectUIDFromLegacyIDForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetProjectUIDFromLegacyIDInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-uid-from-legacy-id", err)
+			}
+			err = ValidateGetProjectUIDFromLegacyIDInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-uid-from-legacy-id", err)
+			}
+			return nil, NewGetProjectUIDFromLegacyIDInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetProjectUIDFromLegacyIDNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-uid-from-legacy-id", err)
+			}
+			err = ValidateGetProjectUIDFromLegacyIDNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-uid-from-legacy-id", err)
+			}
+			return nil, NewGetProjectUIDFromLegacyIDNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetProjectUIDFromLegacyIDServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-uid-from-legacy-id", err)
+			}
+			err = ValidateGetProjectUIDFromLegacyIDServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-uid-from-legacy-id", err)
+			}
+			return nil, NewGetProjectUIDFromLegacyIDServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "get-project-uid-from-legacy-id", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetOneProjectSettingsRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "get-one-project-settings" endpoint
+func (c *Client) BuildGetOneProjectSettingsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*projectservice.GetOneProjectSettingsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "get-one-project-settings", "*projectservice.GetOneProjectSettingsPayload", v)
+		}
+		if p.UID != nil {
+			uid = *p.UID
+		}
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetOneProjectSettingsProjectServicePath(uid)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "get-one-project-settings", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetOneProjectSettingsRequest returns an encoder for requests sent to
+// the project-service get-one-project-settings server.
+func EncodeGetOneProjectSettingsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.GetOneProjectSettingsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "get-one-project-settings", "*projectservice.GetOneProjectSettingsPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetOneProjectSettingsResponse returns a decoder for responses returned
+// by the project-service get-one-project-settings endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeGetOneProjectSettingsResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetOneProjectSettingsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetOneProjectSettingsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-one-project-settings", err)
+			}
+			err = ValidateGetOneProjectSettingsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-one-project-settings", err)
+			}
+			var (
+				etag         *string
+				cacheControl *string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw != "" {
+				etag = &etagRaw
+			}
+			cacheControlRaw := resp.Header.Get("Cache-Control")
+			if cacheControlRaw != "" {
+				cacheControl = &cacheControlRaw
+			}
+			res := NewGetOneProjectSettingsResultOK(&body, etag, cacheControl)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body GetOneProjectSettingsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-one-project-settings", err)
+			}
+			err = ValidateGetOneProjectSettingsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-one-project-settings", err)
+			}
+			return nil, NewGetOneProjectSettingsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetOneProjectSettingsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-one-project-settings", err)
+			}
+			err = ValidateGetOneProjectSettingsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-one-project-settings", err)
+			}
+			return nil, NewGetOneProjectSettingsInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetOneProjectSettingsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-one-project-settings", err)
+			}
+			err = ValidateGetOneProjectSettingsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-one-project-settings", err)
+			}
+			return nil, NewGetOneProjectSettingsNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetOneProjectSettingsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-one-project-settings", err)
+			}
+			err = ValidateGetOneProjectSettingsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-one-project-settings", err)
+			}
+			return nil, NewGetOneProjectSettingsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "get-one-project-settings", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateProjectBaseRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "update-project-base"
+// endpoint
+func (c *Client) BuildUpdateProjectBaseRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*projectservice.UpdateProjectBasePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "update-project-base", "*projectservice.UpdateProjectBasePayload", v)
+		}
+		if p.UID != nil {
+			uid = *p.UID
+		}
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateProjectBaseProjectServicePath(uid)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "update-project-base", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateProjectBaseRequest returns an encoder for requests sent to the
+// project-service update-project-base server.
+func EncodeUpdateProjectBaseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.UpdateProjectBasePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "update-project-base", "*projectservice.UpdateProjectBasePayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		if p.XSync != nil {
+			head := *p.XSync
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Sync", headStr)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.ForceUpdate != nil {
+			head := *p.ForceUpdate
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Force-Update", headStr)
+		}
+		if p.AdminOverride != nil {
+			head := *p.AdminOverride
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Admin-Override", headStr)
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		body := NewUpdateProjectBaseRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("project-service", "update-project-base", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateProjectBaseResponse returns a decoder for responses returned by
+// the project-service update-project-base endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeUpdateProjectBaseResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "UnprocessableEntity" (type *projectservice.UnprocessableEntityError): http.StatusUnprocessableEntity
+//   - error: internal error
+func DecodeUpdateProjectBaseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateProjectBaseResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-base", err)
+			}
+			err = ValidateUpdateProjectBaseResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-base", err)
+			}
+			res := NewUpdateProjectBaseProjectBaseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body UpdateProjectBaseBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-base", err)
+			}
+			err = ValidateUpdateProjectBaseBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-base", err)
+			}
+			return nil, NewUpdateProjectBaseBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body UpdateProjectBaseConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-base", err)
+			}
+			err = ValidateUpdateProjectBaseConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-base", err)
+			}
+			return nil, NewUpdateProjectBaseConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateProjectBaseForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-base", err)
+			}
+			err = ValidateUpdateProjectBaseForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-base", err)
+			}
+			return nil, NewUpdateProjectBaseForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpdateProjectBaseInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-base", err)
+			}
+			err = ValidateUpdateProjectBaseInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-base", err)
+			}
+			return nil, NewUpdateProjectBaseInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateProjectBaseNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-base", err)
+			}
+			err = ValidateUpdateProjectBaseNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-base", err)
+			}
+			return nil, NewUpdateProjectBaseNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body UpdateProjectBaseServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-base", err)
+			}
+			err = ValidateUpdateProjectBaseServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-base", err)
+			}
+			return nil, NewUpdateProjectBaseServiceUnavailable(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body UpdateProjectBaseUnprocessableEntityResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-base", err)
+			}
+			err = ValidateUpdateProjectBaseUnprocessableEntityResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-base", err)
+			}
+			return nil, NewUpdateProjectBaseUnprocessableEntity(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "update-project-base", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateProjectSettingsRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "update-project-settings" endpoint
+func (c *Client) BuildUpdateProjectSettingsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*projectservice.UpdateProjectSettingsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "update-project-settings", "*projectservice.UpdateProjectSettingsPayload", v)
+		}
+		if p.UID != nil {
+			uid = *p.UID
+		}
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateProjectSettingsProjectServicePath(uid)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "update-project-settings", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateProjectSettingsRequest returns an encoder for requests sent to
+// the project-service update-project-settings server.
+func EncodeUpdateProjectSettingsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.UpdateProjectSettingsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "update-project-settings", "*projectservice.UpdateProjectSettingsPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		if p.XSync != nil {
+			head := *p.XSync
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Sync", headStr)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.ForceUpdate != nil {
+			head := *p.ForceUpdate
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Force-Update", headStr)
+		}
+		if p.Merge != nil {
+			head := *p.Merge
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Merge", headStr)
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		body := NewUpdateProjectSettingsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("project-service", "update-project-settings", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateProjectSettingsResponse returns a decoder for responses returned
+// by the project-service update-project-settings endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeUpdateProjectSettingsResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "UnprocessableEntity" (type *projectservice.UnprocessableEntityError): http.StatusUnprocessableEntity
+//   - error: internal error
+func DecodeUpdateProjectSettingsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateProjectSettingsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-settings", err)
+			}
+			err = ValidateUpdateProjectSettingsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-settings", err)
+			}
+			res := NewUpdateProjectSettingsProjectSettingsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body UpdateProjectSettingsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-settings", err)
+			}
+			err = ValidateUpdateProjectSettingsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-settings", err)
+			}
+			return nil, NewUpdateProjectSettingsBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body UpdateProjectSettingsConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-settings", err)
+			}
+			err = ValidateUpdateProjectSettingsConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-settings", err)
+			}
+			return nil, NewUpdateProjectSettingsConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateProjectSettingsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-settings", err)
+			}
+			err = ValidateUpdateProjectSettingsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-settings", err)
+			}
+			return nil, NewUpdateProjectSettingsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpdateProjectSettingsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-settings", err)
+			}
+			err = ValidateUpdateProjectSettingsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-settings", err)
+			}
+			return nil, NewUpdateProjectSettingsInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateProjectSettingsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-settings", err)
+			}
+			err = ValidateUpdateProjectSettingsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-settings", err)
+			}
+			return nil, NewUpdateProjectSettingsNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body UpdateProjectSettingsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-settings", err)
+			}
+			err = ValidateUpdateProjectSettingsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-settings", err)
+			}
+			return nil, NewUpdateProjectSettingsServiceUnavailable(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body UpdateProjectSettingsUnprocessableEntityResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-settings", err)
+			}
+			err = ValidateUpdateProjectSettingsUnprocessableEntityResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-settings", err)
+			}
+			return nil, NewUpdateProjectSettingsUnprocessableEntity(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "update-project-settings", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteProjectRequest instantiates a HTTP request object with method and
+// path set to call the "project-service" service "delete-project" endpoint
+func (c *Client) BuildDeleteProjectRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*projectservice.DeleteProjectPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "delete-project", "*projectservice.DeleteProjectPayload", v)
+		}
+		if p.UID != nil {
+			uid = *p.UID
+		}
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteProjectProjectServicePath(uid)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "delete-project", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteProjectRequest returns an encoder for requests sent to the
+// project-service delete-project server.
+func EncodeDeleteProjectRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.DeleteProjectPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "delete-project", "*projectservice.DeleteProjectPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		if p.XSync != nil {
+			head := *p.XSync
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Sync", headStr)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		if p.ForceUpdate != nil {
+			head := *p.ForceUpdate
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Force-Update", headStr)
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeDeleteProjectResponse returns a decoder for responses returned by the
+// project-service delete-project endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeDeleteProjectResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeDeleteProjectResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body DeleteProjectBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project", err)
+			}
+			err = ValidateDeleteProjectBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "delete-project", err)
+			}
+			return nil, NewDeleteProjectBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body DeleteProjectConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project", err)
+			}
+			err = ValidateDeleteProjectConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "delete-project", err)
+			}
+			return nil, NewDeleteProjectConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body DeleteProjectForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project", err)
+			}
+			err = ValidateDeleteProjectForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "delete-project", err)
+			}
+			return nil, NewDeleteProjectForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteProjectInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project", err)
+			}
+			err = ValidateDeleteProjectInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "delete-project", err)
+			}
+			return nil, NewDeleteProjectInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteProjectNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project", err)
+			}
+			err = ValidateDeleteProjectNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "delete-project", err)
+			}
+			return nil, NewDeleteProjectNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body DeleteProjectServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project", err)
+			}
+			err = ValidateDeleteProjectServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "delete-project", err)
+			}
+			return nil, NewDeleteProjectServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "delete-project", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildBulkDeleteProjectsRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "bulk-delete-projects" endpoint
+func (c *Client) BuildBulkDeleteProjectsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BulkDeleteProjectsProjectServicePath()}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "bulk-delete-projects", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeBulkDeleteProjectsRequest returns an encoder for requests sent to the
+// project-service bulk-delete-projects server.
+func EncodeBulkDeleteProjectsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.BulkDeleteProjectsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "bulk-delete-projects", "*projectservice.BulkDeleteProjectsPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		if p.XSync != nil {
+			head := *p.XSync
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Sync", headStr)
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		values.Add("dry_run", fmt.Sprintf("%v", p.DryRun))
+		values.Add("cascade", fmt.Sprintf("%v", p.Cascade))
+		values.Add("force", fmt.Sprintf("%v", p.Force))
+		req.URL.RawQuery = values.Encode()
+		body := NewBulkDeleteProjectsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("project-service", "bulk-delete-projects", err)
+		}
+		return nil
+	}
+}
+
+// DecodeBulkDeleteProjectsResponse returns a decoder for responses returned by
+// the project-service bulk-delete-projects endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeBulkDeleteProjectsResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeBulkDeleteProjectsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body BulkDeleteProjectsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "bulk-delete-projects", err)
+			}
+			err = ValidateBulkDeleteProjectsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "bulk-delete-projects", err)
+			}
+			res := NewBulkDeleteProjectsBulkProjectDeletionResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body BulkDeleteProjectsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "bulk-delete-projects", err)
+			}
+			err = ValidateBulkDeleteProjectsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "bulk-delete-projects", err)
+			}
+			return nil, NewBulkDeleteProjectsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body BulkDeleteProjectsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "bulk-delete-projects", err)
+			}
+			err = ValidateBulkDeleteProjectsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "bulk-delete-projects", err)
+			}
+			return nil, NewBulkDeleteProjectsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body BulkDeleteProjectsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "bulk-delete-projects", err)
+			}
+			err = ValidateBulkDeleteProjectsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "bulk-delete-projects", err)
+			}
+			return nil, NewBulkDeleteProjectsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body BulkDeleteProjectsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "bulk-delete-projects", err)
+			}
+			err = ValidateBulkDeleteProjectsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "bulk-delete-projects", err)
+			}
+			return nil, NewBulkDeleteProjectsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "bulk-delete-projects", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildReadyzRequest instantiates a HTTP request object with method and path
+// set to call the "project-service" service "readyz" endpoint
+func (c *Client) BuildReadyzRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ReadyzProjectServicePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "readyz", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeReadyzResponse returns a decoder for responses returned by the
+// project-service readyz endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeReadyzResponse may return the following errors:
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeReadyzResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body []byte
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "readyz", err)
+			}
+			return body, nil
+		case http.StatusServiceUnavailable:
+			var (
+				body ReadyzServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "readyz", err)
+			}
+			err = ValidateReadyzServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "readyz", err)
+			}
+			return nil, NewReadyzServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "readyz", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildLivezRequest instantiates a HTTP request object with method and path
+// set to call the "project-service" service "livez" endpoint
+func (c *Client) BuildLivezRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: LivezProjectServicePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "livez", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeLivezResponse returns a decoder for responses returned by the
+// project-service livez endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeLivezResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body []byte
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "livez", err)
+			}
+			return body, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "livez", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildHealthzRequest instantiates a HTTP request object with method and path
+// set to call the "project-service" service "healthz" endpoint
+func (c *Client) BuildHealthzRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: HealthzProjectServicePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "healthz", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeHealthzResponse returns a decoder for responses returned by the
+// project-service healthz endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeHealthzResponse may return the following errors:
+//   - "Unhealthy" (type *projectservice.HealthReport): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeHealthzResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body HealthzResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "healthz", err)
+			}
+			err = ValidateHealthzResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "healthz", err)
+			}
+			res := NewHealthzHealthReportOK(&body)
+			return res, nil
+		case http.StatusServiceUnavailable:
+			var (
+				body HealthzUnhealthyResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "healthz", err)
+			}
+			err = ValidateHealthzUnhealthyResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "healthz", err)
+			}
+			return nil, NewHealthzUnhealthy(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "healthz", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildReconcileOutboxRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "reconcile-outbox"
+// endpoint
+func (c *Client) BuildReconcileOutboxRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ReconcileOutboxProjectServicePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "reconcile-outbox", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeReconcileOutboxRequest returns an encoder for requests sent to the
+// project-service reconcile-outbox server.
+func EncodeReconcileOutboxRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.ReconcileOutboxPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "reconcile-outbox", "*projectservice.ReconcileOutboxPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeReconcileOutboxResponse returns a decoder for responses returned by
+// the project-service reconcile-outbox endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeReconcileOutboxResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeReconcileOutboxResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ReconcileOutboxResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "reconcile-outbox", err)
+			}
+			err = ValidateReconcileOutboxResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "reconcile-outbox", err)
+			}
+			res := NewReconcileOutboxOutboxReconcileResultOK(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body ReconcileOutboxForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "reconcile-outbox", err)
+			}
+			err = ValidateReconcileOutboxForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "reconcile-outbox", err)
+			}
+			return nil, NewReconcileOutboxForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ReconcileOutboxInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "reconcile-outbox", err)
+			}
+			err = ValidateReconcileOutboxInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "reconcile-outbox", err)
+			}
+			return nil, NewReconcileOutboxInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ReconcileOutboxServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "reconcile-outbox", err)
+			}
+			err = ValidateReconcileOutboxServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "reconcile-outbox", err)
+			}
+			return nil, NewReconcileOutboxServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "reconcile-outbox", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildResyncProjectsRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "resync-projects" endpoint
+func (c *Client) BuildResyncProjectsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ResyncProjectsProjectServicePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "resync-projects", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeResyncProjectsRequest returns an encoder for requests sent to the
+// project-service resync-projects server.
+func EncodeResyncProjectsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.ResyncProjectsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "resync-projects", "*projectservice.ResyncProjectsPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewResyncProjectsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("project-service", "resync-projects", err)
+		}
+		return nil
+	}
+}
+
+// DecodeResyncProjectsResponse returns a decoder for responses returned by the
+// project-service resync-projects endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeResyncProjectsResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeResyncProjectsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ResyncProjectsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "resync-projects", err)
+			}
+			err = ValidateResyncProjectsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "resync-projects", err)
+			}
+			res := NewResyncProjectsResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ResyncProjectsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "resync-projects", err)
+			}
+			err = ValidateResyncProjectsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "resync-projects", err)
+			}
+			return nil, NewResyncProjectsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ResyncProjectsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "resync-projects", err)
+			}
+			err = ValidateResyncProjectsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "resync-projects", err)
+			}
+			return nil, NewResyncProjectsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ResyncProjectsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "resync-projects", err)
+			}
+			err = ValidateResyncProjectsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "resync-projects", err)
+			}
+			return nil, NewResyncProjectsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ResyncProjectsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "resync-projects", err)
+			}
+			err = ValidateResyncProjectsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "resync-projects", err)
+			}
+			return nil, NewResyncProjectsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "resync-projects", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListDeadLettersRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "list-dead-letters"
+// endpoint
+func (c *Client) BuildListDeadLettersRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListDeadLettersProjectServicePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "list-dead-letters", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListDeadLettersRequest returns an encoder for requests sent to the
+// project-service list-dead-letters server.
+func EncodeListDeadLettersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.ListDeadLettersPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "list-dead-letters", "*projectservice.ListDeadLettersPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListDeadLettersResponse returns a decoder for responses returned by
+// the project-service list-dead-letters endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeListDeadLettersResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListDeadLettersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListDeadLettersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-dead-letters", err)
+			}
+			err = ValidateListDeadLettersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-dead-letters", err)
+			}
+			res := NewListDeadLettersDeadLetterListOK(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body ListDeadLettersForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-dead-letters", err)
+			}
+			err = ValidateListDeadLettersForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-dead-letters", err)
+			}
+			return nil, NewListDeadLettersForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListDeadLettersInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-dead-letters", err)
+			}
+			err = ValidateListDeadLettersInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-dead-letters", err)
+			}
+			return nil, NewListDeadLettersInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListDeadLettersServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-dead-letters", err)
+			}
+			err = ValidateListDeadLettersServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-dead-letters", err)
+			}
+			return nil, NewListDeadLettersServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "list-dead-letters", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildReplayDeadLetterRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "replay-dead-letter"
+// endpoint
+func (c *Client) BuildReplayDeadLetterRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*projectservice.ReplayDeadLetterPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "replay-dead-letter", "*projectservice.ReplayDeadLetterPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ReplayDeadLetterProjectServicePath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "replay-dead-letter", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeReplayDeadLetterRequest returns an encoder for requests sent to the
+// project-service replay-dead-letter server.
+func EncodeReplayDeadLetterRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.ReplayDeadLetterPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "replay-dead-letter", "*projectservice.ReplayDeadLetterPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeReplayDeadLetterResponse returns a decoder for responses returned by
+// the project-service replay-dead-letter endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeReplayDeadLetterResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeReplayDeadLetterResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ReplayDeadLetterResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "replay-dead-letter", err)
+			}
+			err = ValidateReplayDeadLetterResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "replay-dead-letter", err)
+			}
+			res := NewReplayDeadLetterResultOK(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body ReplayDeadLetterForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "replay-dead-letter", err)
+			}
+			err = ValidateReplayDeadLetterForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "replay-dead-letter", err)
+			}
+			return nil, NewReplayDeadLetterForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ReplayDeadLetterInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "replay-dead-letter", err)
+			}
+			err = ValidateReplayDeadLetterInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "replay-dead-letter", err)
+			}
+			return nil, NewReplayDeadLetterInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ReplayDeadLetterNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "replay-dead-letter", err)
+			}
+			err = ValidateReplayDeadLetterNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "replay-dead-letter", err)
+			}
+			return nil, NewReplayDeadLetterNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ReplayDeadLetterServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "replay-dead-letter", err)
+			}
+			err = ValidateReplayDeadLetterServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "replay-dead-letter", err)
+			}
+			return nil, NewReplayDeadLetterServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "replay-dead-letter", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRebuildSlugMappingsRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "rebuild-slug-mappings" endpoint
+func (c *Client) BuildRebuildSlugMappingsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RebuildSlugMappingsProjectServicePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "rebuild-slug-mappings", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRebuildSlugMappingsRequest returns an encoder for requests sent to the
+// project-service rebuild-slug-mappings server.
+func EncodeRebuildSlugMappingsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.RebuildSlugMappingsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "rebuild-slug-mappings", "*projectservice.RebuildSlugMappingsPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewRebuildSlugMappingsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("project-service", "rebuild-slug-mappings", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRebuildSlugMappingsResponse returns a decoder for responses returned
+// by the project-service rebuild-slug-mappings endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeRebuildSlugMappingsResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeRebuildSlugMappingsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RebuildSlugMappingsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "rebuild-slug-mappings", err)
+			}
+			err = ValidateRebuildSlugMappingsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "rebuild-slug-mappings", err)
+			}
+			res := NewRebuildSlugMappingsSlugRebuildResultOK(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body RebuildSlugMappingsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "rebuild-slug-mappings", err)
+			}
+			err = ValidateRebuildSlugMappingsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "rebuild-slug-mappings", err)
+			}
+			return nil, NewRebuildSlugMappingsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RebuildSlugMappingsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "rebuild-slug-mappings", err)
+			}
+			err = ValidateRebuildSlugMappingsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "rebuild-slug-mappings", err)
+			}
+			return nil, NewRebuildSlugMappingsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body RebuildSlugMappingsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "rebuild-slug-mappings", err)
+			}
+			err = ValidateRebuildSlugMappingsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "rebuild-slug-mappings", err)
+			}
+			return nil, NewRebuildSlugMappingsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "rebuild-slug-mappings", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetIntegrityReportRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "get-integrity-report" endpoint
+func (c *Client) BuildGetIntegrityReportRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetIntegrityReportProjectServicePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "get-integrity-report", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetIntegrityReportRequest returns an encoder for requests sent to the
+// project-service get-integrity-report server.
+func EncodeGetIntegrityReportRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.GetIntegrityReportPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "get-integrity-report", "*projectservice.GetIntegrityReportPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetIntegrityReportResponse returns a decoder for responses returned by
+// the project-service get-integrity-report endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeGetIntegrityReportResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetIntegrityReportResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetIntegrityReportResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-integrity-report", err)
+			}
+			err = ValidateGetIntegrityReportResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-integrity-report", err)
+			}
+			res := NewGetIntegrityReportIntegrityReportOK(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body GetIntegrityReportForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-integrity-report", err)
+			}
+			err = ValidateGetIntegrityReportForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-integrity-report", err)
+			}
+			return nil, NewGetIntegrityReportForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetIntegrityReportInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-integrity-report", err)
+			}
+			err = ValidateGetIntegrityReportInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-integrity-report", err)
+			}
+			return nil, NewGetIntegrityReportInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetIntegrityReportServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-integrity-report", err)
+			}
+			err = ValidateGetIntegrityReportServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-integrity-report", err)
+			}
+			return nil, NewGetIntegrityReportServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "get-integrity-report", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildInspectProjectKvRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "inspect-project-kv"
+// endpoint
+func (c *Client) BuildInspectProjectKvRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*projectservice.InspectProjectKvPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "inspect-project-kv", "*projectservice.InspectProjectKvPayload", v)
+		}
+		uid = p.UID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: InspectProjectKvProjectServicePath(uid)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "inspect-project-kv", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeInspectProjectKvRequest returns an encoder for requests sent to the
+// project-service inspect-project-kv server.
+func EncodeInspectProjectKvRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.InspectProjectKvPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "inspect-project-kv", "*projectservice.InspectProjectKvPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeInspectProjectKvResponse returns a decoder for responses returned by
+// the project-service inspect-project-kv endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeInspectProjectKvResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeInspectProjectKvResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body InspectProjectKvResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "inspect-project-kv", err)
+			}
+			err = ValidateInspectProjectKvResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "inspect-project-kv", err)
+			}
+			res := NewInspectProjectKvProjectKVInspectionOK(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body InspectProjectKvForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "inspect-project-kv", err)
+			}
+			err = ValidateInspectProjectKvForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "inspect-project-kv", err)
+			}
+			return nil, NewInspectProjectKvForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body InspectProjectKvInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "inspect-project-kv", err)
+			}
+			err = ValidateInspectProjectKvInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "inspect-project-kv", err)
+			}
+			return nil, NewInspectProjectKvInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body InspectProjectKvNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "inspect-project-kv", err)
+			}
+			err = ValidateInspectProjectKvNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "inspect-project-kv", err)
+			}
+			return nil, NewInspectProjectKvNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body InspectProjectKvServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "inspect-project-kv", err)
+			}
+			err = ValidateInspectProjectKvServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "inspect-project-kv", err)
+			}
+			return nil, NewInspectProjectKvServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "inspect-project-kv", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateBackupRequest instantiates a HTTP request object with method and
+// path set to call the "project-service" service "create-backup" endpoint
+func (c *Client) BuildCreateBackupRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateBackupProjectServicePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "create-backup", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateBackupRequest returns an encoder for requests sent to the
+// project-service create-backup server.
+func EncodeCreateBackupRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.CreateBackupPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "create-backup", "*projectservice.CreateBackupPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeCreateBackupResponse returns a decoder for responses returned by the
+// project-service create-backup endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeCreateBackupResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeCreateBackupResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateBackupResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-backup", err)
+			}
+			err = ValidateCreateBackupResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-backup", err)
+			}
+			res := NewCreateBackupBackupResultCreated(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body CreateBackupForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-backup", err)
+			}
+			err = ValidateCreateBackupForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-backup", err)
+			}
+			return nil, NewCreateBackupForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateBackupInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-backup", err)
+			}
+			err = ValidateCreateBackupInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-backup", err)
+			}
+			return nil, NewCreateBackupInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body CreateBackupServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-backup", err)
+			}
+			err = ValidateCreateBackupServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-backup", err)
+			}
+			return nil, NewCreateBackupServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "create-backup", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListBackupsRequest instantiates a HTTP request object with method and
+// path set to call the "project-service" service "list-backups" endpoint
+func (c *Client) BuildListBackupsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListBackupsProjectServicePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "list-backups", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListBackupsRequest returns an encoder for requests sent to the
+// project-service list-backups server.
+func EncodeListBackupsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.ListBackupsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "list-backups", "*projectservice.ListBackupsPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListBackupsResponse returns a decoder for responses returned by the
+// project-service list-backups endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListBackupsResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListBackupsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListBackupsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-backups", err)
+			}
+			err = ValidateListBackupsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-backups", err)
+			}
+			res := NewListBackupsBackupListOK(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body ListBackupsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-backups", err)
+			}
+			err = ValidateListBackupsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-backups", err)
+			}
+			return nil, NewListBackupsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListBackupsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-backups", err)
+			}
+			err = ValidateListBackupsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-backups", err)
+			}
+			return nil, NewListBackupsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListBackupsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-backups", err)
+			}
+			err = ValidateListBackupsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-backups", err)
+			}
+			return nil, NewListBackupsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "list-backups", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRestoreBackupRequest instantiates a HTTP request object with method and
+// path set to call the "project-service" service "restore-backup" endpoint
+func (c *Client) BuildRestoreBackupRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		name string
+	)
+	{
+		p, ok := v.(*projectservice.RestoreBackupPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "restore-backup", "*projectservice.RestoreBackupPayload", v)
+		}
+		name = p.Name
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RestoreBackupProjectServicePath(name)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "restore-backup", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRestoreBackupRequest returns an encoder for requests sent to the
+// project-service restore-backup server.
+func EncodeRestoreBackupRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.RestoreBackupPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "restore-backup", "*projectservice.RestoreBackupPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewRestoreBackupRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("project-service", "restore-backup", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRestoreBackupResponse returns a decoder for responses returned by the
+// project-service restore-backup endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeRestoreBackupResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeRestoreBackupResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RestoreBackupResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "restore-backup", err)
+			}
+			err = ValidateRestoreBackupResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "restore-backup", err)
+			}
+			res := NewRestoreBackupResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body RestoreBackupBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "restore-backup", err)
+			}
+			err = ValidateRestoreBackupBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "restore-backup", err)
+			}
+			return nil, NewRestoreBackupBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body RestoreBackupForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "restore-backup", err)
+			}
+			err = ValidateRestoreBackupForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "restore-backup", err)
+			}
+			return nil, NewRestoreBackupForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RestoreBackupInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "restore-backup", err)
+			}
+			err = ValidateRestoreBackupInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "restore-backup", err)
+			}
+			return nil, NewRestoreBackupInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body RestoreBackupNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "restore-backup", err)
+			}
+			err = ValidateRestoreBackupNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "restore-backup", err)
+			}
+			return nil, NewRestoreBackupNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body RestoreBackupServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "restore-backup", err)
+			}
+			err = ValidateRestoreBackupServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "restore-backup", err)
+			}
+			return nil, NewRestoreBackupServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "restore-backup", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListPendingAnnouncementsRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "list-pending-announcements" endpoint
+func (c *Client) BuildListPendingAnnouncementsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListPendingAnnouncementsProjectServicePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "list-pending-announcements", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListPendingAnnouncementsRequest returns an encoder for requests sent
+// to the project-service list-pending-announcements server.
+func EncodeListPendingAnnouncementsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.ListPendingAnnouncementsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "list-pending-announcements", "*projectservice.ListPendingAnnouncementsPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListPendingAnnouncementsResponse returns a decoder for responses
+// returned by the project-service list-pending-announcements endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeListPendingAnnouncementsResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListPendingAnnouncementsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListPendingAnnouncementsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-pending-announcements", err)
+			}
+			err = ValidateListPendingAnnouncementsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-pending-announcements", err)
+			}
+			res := NewListPendingAnnouncementsPendingAnnouncementListOK(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body ListPendingAnnouncementsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-pending-announcements", err)
+			}
+			err = ValidateListPendingAnnouncementsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-pending-announcements", err)
+			}
+			return nil, NewListPendingAnnouncementsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListPendingAnnouncementsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-pending-announcements", err)
+			}
+			err = ValidateListPendingAnnouncementsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-pending-announcements", err)
+			}
+			return nil, NewListPendingAnnouncementsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListPendingAnnouncementsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-pending-announcements", err)
+			}
+			err = ValidateListPendingAnnouncementsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-pending-announcements", err)
+			}
+			return nil, NewListPendingAnnouncementsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "list-pending-announcements", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetProjectEnumsRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "get-project-enums"
+// endpoint
+func (c *Client) BuildGetProjectEnumsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProjectEnumsProjectServicePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "get-project-enums", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetProjectEnumsRequest returns an encoder for requests sent to the
+// project-service get-project-enums server.
+func EncodeGetProjectEnumsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.GetProjectEnumsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "get-project-enums", "*projectservice.GetProjectEnumsPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetProjectEnumsResponse returns a decoder for responses returned by
+// the project-service get-project-enums endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeGetProjectEnumsResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetProjectEnumsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetProjectEnumsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-enums", err)
+			}
+			err = ValidateGetProjectEnumsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-enums", err)
+			}
+			res := NewGetProjectEnumsProjectEnumsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetProjectEnumsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-enums", err)
+			}
+			err = ValidateGetProjectEnumsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-enums", err)
+			}
+			return nil, NewGetProjectEnumsBadRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetProjectEnumsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-enums", err)
+			}
+			err = ValidateGetProjectEnumsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-enums", err)
+			}
+			return nil, NewGetProjectEnumsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetProjectEnumsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-enums", err)
+			}
+			err = ValidateGetProjectEnumsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-enums", err)
+			}
+			return nil, NewGetProjectEnumsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "get-project-enums", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateProjectEnumsRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "update-project-enums" endpoint
+func (c *Client) BuildUpdateProjectEnumsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateProjectEnumsProjectServicePath()}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "update-project-enums", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateProjectEnumsRequest returns an encoder for requests sent to the
+// project-service update-project-enums server.
+func EncodeUpdateProjectEnumsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.UpdateProjectEnumsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "update-project-enums", "*projectservice.UpdateProjectEnumsPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewUpdateProjectEnumsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("project-service", "update-project-enums", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateProjectEnumsResponse returns a decoder for responses returned by
+// the project-service update-project-enums endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeUpdateProjectEnumsResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeUpdateProjectEnumsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateProjectEnumsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-enums", err)
+			}
+			err = ValidateUpdateProjectEnumsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-enums", err)
+			}
+			res := NewUpdateProjectEnumsProjectEnumsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body UpdateProjectEnumsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-enums", err)
+			}
+			err = ValidateUpdateProjectEnumsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-enums", err)
+			}
+			return nil, NewUpdateProjectEnumsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateProjectEnumsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-enums", err)
+			}
+			err = ValidateUpdateProjectEnumsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-enums", err)
+			}
+			return nil, NewUpdateProjectEnumsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpdateProjectEnumsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-enums", err)
+			}
+			err = ValidateUpdateProjectEnumsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-enums", err)
+			}
+			return nil, NewUpdateProjectEnumsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body UpdateProjectEnumsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-enums", err)
+			}
+			err = ValidateUpdateProjectEnumsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-enums", err)
+			}
+			return nil, NewUpdateProjectEnumsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "update-project-enums", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateProjectTemplateRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "create-project-template" endpoint
+func (c *Client) BuildCreateProjectTemplateRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateProjectTemplateProjectServicePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "create-project-template", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateProjectTemplateRequest returns an encoder for requests sent to
+// the project-service create-project-template server.
+func EncodeCreateProjectTemplateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.CreateProjectTemplatePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "create-project-template", "*projectservice.CreateProjectTemplatePayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewCreateProjectTemplateRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("project-service", "create-project-template", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateProjectTemplateResponse returns a decoder for responses returned
+// by the project-service create-project-template endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeCreateProjectTemplateResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeCreateProjectTemplateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateProjectTemplateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-project-template", err)
+			}
+			err = ValidateCreateProjectTemplateResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-project-template", err)
+			}
+			res := NewCreateProjectTemplateProjectTemplateCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CreateProjectTemplateBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-project-template", err)
+			}
+			err = ValidateCreateProjectTemplateBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-project-template", err)
+			}
+			return nil, NewCreateProjectTemplateBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body CreateProjectTemplateConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-project-template", err)
+			}
+			err = ValidateCreateProjectTemplateConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-project-template", err)
+			}
+			return nil, NewCreateProjectTemplateConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateProjectTemplateForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-project-template", err)
+			}
+			err = ValidateCreateProjectTemplateForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-project-template", err)
+			}
+			return nil, NewCreateProjectTemplateForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateProjectTemplateInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-project-template", err)
+			}
+			err = ValidateCreateProjectTemplateInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-project-template", err)
+			}
+			return nil, NewCreateProjectTemplateInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body CreateProjectTemplateServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-project-template", err)
+			}
+			err = ValidateCreateProjectTemplateServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-project-template", err)
+			}
+			return nil, NewCreateProjectTemplateServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "create-project-template", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListProjectTemplatesRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "list-project-templates" endpoint
+func (c *Client) BuildListProjectTemplatesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListProjectTemplatesProjectServicePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "list-project-templates", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListProjectTemplatesRequest returns an encoder for requests sent to
+// the project-service list-project-templates server.
+func EncodeListProjectTemplatesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.ListProjectTemplatesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "list-project-templates", "*projectservice.ListProjectTemplatesPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListProjectTemplatesResponse returns a decoder for responses returned
+// by the project-service list-project-templates endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeListProjectTemplatesResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListProjectTemplatesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListProjectTemplatesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-project-templates", err)
+			}
+			err = ValidateListProjectTemplatesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-project-templates", err)
+			}
+			res := NewListProjectTemplatesProjectTemplateListOK(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body ListProjectTemplatesForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-project-templates", err)
+			}
+			err = ValidateListProjectTemplatesForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-project-templates", err)
+			}
+			return nil, NewListProjectTemplatesForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListProjectTemplatesInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-project-templates", err)
+			}
+			err = ValidateListProjectTemplatesInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-project-templates", err)
+			}
+			return nil, NewListProjectTemplatesInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListProjectTemplatesServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-project-templates", err)
+			}
+			err = ValidateListProjectTemplatesServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-project-templates", err)
+			}
+			return nil, NewListProjectTemplatesServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "list-project-templates", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetProjectTemplateRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "get-project-template" endpoint
+func (c *Client) BuildGetProjectTemplateRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		name string
+	)
+	{
+		p, ok := v.(*projectservice.GetProjectTemplatePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "get-project-template", "*projectservice.GetProjectTemplatePayload", v)
+		}
+		name = p.Name
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProjectTemplateProjectServicePath(name)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "get-project-template", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetProjectTemplateRequest returns an encoder for requests sent to the
+// project-service get-project-template server.
+func EncodeGetProjectTemplateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.GetProjectTemplatePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "get-project-template", "*projectservice.GetProjectTemplatePayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetProjectTemplateResponse returns a decoder for responses returned by
+// the project-service get-project-template endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeGetProjectTemplateResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetProjectTemplateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetProjectTemplateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-template", err)
+			}
+			err = ValidateGetProjectTemplateResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-template", err)
+			}
+			res := NewGetProjectTemplateProjectTemplateOK(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body GetProjectTemplateForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-template", err)
+			}
+			err = ValidateGetProjectTemplateForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-template", err)
+			}
+			return nil, NewGetProjectTemplateForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetProjectTemplateInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-template", err)
+			}
+			err = ValidateGetProjectTemplateInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-template", err)
+			}
+			return nil, NewGetProjectTemplateInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetProjectTemplateNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-template", err)
+			}
+			err = ValidateGetProjectTemplateNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-template", err)
+			}
+			return nil, NewGetProjectTemplateNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetProjectTemplateServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-template", err)
+			}
+			err = ValidateGetProjectTemplateServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-template", err)
+			}
+			return nil, NewGetProjectTemplateServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "get-project-template", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateProjectTemplateRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "update-project-template" endpoint
+func (c *Client) BuildUpdateProjectTemplateRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		name string
+	)
+	{
+		p, ok := v.(*projectservice.UpdateProjectTemplatePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "update-project-template", "*projectservice.UpdateProjectTemplatePayload", v)
+		}
+		name = p.Name
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateProjectTemplateProjectServicePath(name)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "update-project-template", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateProjectTemplateRequest returns an encoder for requests sent to
+// the project-service update-project-template server.
+func EncodeUpdateProjectTemplateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.UpdateProjectTemplatePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "update-project-template", "*projectservice.UpdateProjectTemplatePayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewUpdateProjectTemplateRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("project-service", "update-project-template", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateProjectTemplateResponse returns a decoder for responses returned
+// by the project-service update-project-template endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeUpdateProjectTemplateResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *projectservice.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeUpdateProjectTemplateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateProjectTemplateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-template", err)
+			}
+			err = ValidateUpdateProjectTemplateResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-template", err)
+			}
+			res := NewUpdateProjectTemplateProjectTemplateOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body UpdateProjectTemplateBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-template", err)
+			}
+			err = ValidateUpdateProjectTemplateBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-template", err)
+			}
+			return nil, NewUpdateProjectTemplateBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body UpdateProjectTemplateConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-template", err)
+			}
+			err = ValidateUpdateProjectTemplateConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-template", err)
+			}
+			return nil, NewUpdateProjectTemplateConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateProjectTemplateForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-template", err)
+			}
+			err = ValidateUpdateProjectTemplateForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-template", err)
+			}
+			return nil, NewUpdateProjectTemplateForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpdateProjectTemplateInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-template", err)
+			}
+			err = ValidateUpdateProjectTemplateInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-template", err)
+			}
+			return nil, NewUpdateProjectTemplateInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateProjectTemplateNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-template", err)
+			}
+			err = ValidateUpdateProjectTemplateNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-template", err)
+			}
+			return nil, NewUpdateProjectTemplateNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body UpdateProjectTemplateServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "update-project-template", err)
+			}
+			err = ValidateUpdateProjectTemplateServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "update-project-template", err)
+			}
+			return nil, NewUpdateProjectTemplateServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "update-project-template", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteProjectTemplateRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "delete-project-template" endpoint
+func (c *Client) BuildDeleteProjectTemplateRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		name string
+	)
+	{
+		p, ok := v.(*projectservice.DeleteProjectTemplatePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "delete-project-template", "*projectservice.DeleteProjectTemplatePayload", v)
+		}
+		name = p.Name
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteProjectTemplateProjectServicePath(name)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "delete-project-template", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteProjectTemplateRequest returns an encoder for requests sent to
+// the project-service delete-project-template server.
+func EncodeDeleteProjectTemplateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.DeleteProjectTemplatePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "delete-project-template", "*projectservice.DeleteProjectTemplatePayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteProjectTemplateResponse returns a decoder for responses returned
+// by the project-service delete-project-template endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeDeleteProjectTemplateResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeDeleteProjectTemplateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusForbidden:
+			var (
+				body DeleteProjectTemplateForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-template", err)
+			}
+			err = ValidateDeleteProjectTemplateForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-template", err)
+			}
+			return nil, NewDeleteProjectTemplateForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteProjectTemplateInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-template", err)
+			}
+			err = ValidateDeleteProjectTemplateInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-template", err)
+			}
+			return nil, NewDeleteProjectTemplateInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteProjectTemplateNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-template", err)
+			}
+			err = ValidateDeleteProjectTemplateNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-template", err)
+			}
+			return nil, NewDeleteProjectTemplateNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body DeleteProjectTemplateServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "delete-project-template", err)
+			}
+			err = ValidateDeleteProjectTemplateServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "delete-project-template", err)
+			}
+			return nil, NewDeleteProjectTemplateServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "delete-project-template", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListProjectDeletionsRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "list-project-deletions" endpoint
+func (c *Client) BuildListProjectDeletionsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListProjectDeletionsProjectServicePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "list-project-deletions", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListProjectDeletionsRequest returns an encoder for requests sent to
+// the project-service list-project-deletions server.
+func EncodeListProjectDeletionsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.ListProjectDeletionsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "list-project-deletions", "*projectservice.ListProjectDeletionsPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		if p.Since != nil {
+			values.Add("since", *p.Since)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListProjectDeletionsResponse returns a decoder for responses returned
+// by the project-service list-project-deletions endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeListProjectDeletionsResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListProjectDeletionsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListProjectDeletionsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-project-deletions", err)
+			}
+			err = ValidateListProjectDeletionsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-project-deletions", err)
+			}
+			res := NewListProjectDeletionsProjectDeletionListOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListProjectDeletionsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-project-deletions", err)
+			}
+			err = ValidateListProjectDeletionsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-project-deletions", err)
+			}
+			return nil, NewListProjectDeletionsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ListProjectDeletionsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-project-deletions", err)
+			}
+			err = ValidateListProjectDeletionsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-project-deletions", err)
+			}
+			return nil, NewListProjectDeletionsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListProjectDeletionsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-project-deletions", err)
+			}
+			err = ValidateListProjectDeletionsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-project-deletions", err)
+			}
+			return nil, NewListProjectDeletionsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListProjectDeletionsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-project-deletions", err)
+			}
+			err = ValidateListProjectDeletionsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-project-deletions", err)
+			}
+			return nil, NewListProjectDeletionsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "list-project-deletions", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListProjectEventsRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "list-project-events"
+// endpoint
+func (c *Client) BuildListProjectEventsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*projectservice.ListProjectEventsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "list-project-events", "*projectservice.ListProjectEventsPayload", v)
+		}
+		uid = p.UID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListProjectEventsProjectServicePath(uid)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "list-project-events", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListProjectEventsRequest returns an encoder for requests sent to the
+// project-service list-project-events server.
+func EncodeListProjectEventsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.ListProjectEventsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "list-project-events", "*projectservice.ListProjectEventsPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		if p.Since != nil {
+			values.Add("since", *p.Since)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListProjectEventsResponse returns a decoder for responses returned by
+// the project-service list-project-events endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeListProjectEventsResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListProjectEventsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListProjectEventsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-project-events", err)
+			}
+			err = ValidateListProjectEventsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-project-events", err)
+			}
+			res := NewListProjectEventsProjectEventListOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListProjectEventsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-project-events", err)
+			}
+			err = ValidateListProjectEventsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-project-events", err)
+			}
+			return nil, NewListProjectEventsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ListProjectEventsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-project-events", err)
+			}
+			err = ValidateListProjectEventsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-project-events", err)
+			}
+			return nil, NewListProjectEventsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListProjectEventsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-project-events", err)
+			}
+			err = ValidateListProjectEventsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-project-events", err)
+			}
+			return nil, NewListProjectEventsInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ListProjectEventsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-project-events", err)
+			}
+			err = ValidateListProjectEventsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-project-events", err)
+			}
+			return nil, NewListProjectEventsNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListProjectEventsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-project-events", err)
+			}
+			err = ValidateListProjectEventsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-project-events", err)
+			}
+			return nil, NewListProjectEventsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "list-project-events", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetUserProjectsRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "get-user-projects"
+// endpoint
+func (c *Client) BuildGetUserProjectsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		username string
+	)
+	{
+		p, ok := v.(*projectservice.GetUserProjectsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "get-user-projects", "*projectservice.GetUserProjectsPayload", v)
+		}
+		username = p.Username
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetUserProjectsProjectServicePath(username)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "get-user-projects", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetUserProjectsRequest returns an encoder for requests sent to the
+// project-service get-user-projects server.
+func EncodeGetUserProjectsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.GetUserProjectsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "get-user-projects", "*projectservice.GetUserProjectsPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetUserProjectsResponse returns a decoder for responses returned by
+// the project-service get-user-projects endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeGetUserProjectsResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetUserProjectsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetUserProjectsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-user-projects", err)
+			}
+			err = ValidateGetUserProjectsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-user-projects", err)
+			}
+			res := NewGetUserProjectsResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetUserProjectsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-user-projects", err)
+			}
+			err = ValidateGetUserProjectsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-user-projects", err)
+			}
+			return nil, NewGetUserProjectsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetUserProjectsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-user-projects", err)
+			}
+			err = ValidateGetUserProjectsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-user-projects", err)
+			}
+			return nil, NewGetUserProjectsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetUserProjectsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-user-projects", err)
+			}
+			err = ValidateGetUserProjectsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-user-projects", err)
+			}
+			return nil, NewGetUserProjectsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetUserProjectsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-user-projects", err)
+			}
+			err = ValidateGetUserProjectsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-user-projects", err)
+			}
+			return nil, NewGetUserProjectsServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "get-user-projects", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildExportUserDataRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "export-user-data"
+// endpoint
+func (c *Client) BuildExportUserDataRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		username string
+	)
+	{
+		p, ok := v.(*projectservice.ExportUserDataPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "export-user-data", "*projectservice.ExportUserDataPayload", v)
+		}
+		username = p.Username
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ExportUserDataProjectServicePath(username)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "export-user-data", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeExportUserDataRequest returns an encoder for requests sent to the
+// project-service export-user-data server.
+func EncodeExportUserDataRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.ExportUserDataPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "export-user-data", "*projectservice.ExportUserDataPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeExportUserDataResponse returns a decoder for responses returned by the
+// project-service export-user-data endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeExportUserDataResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeExportUserDataResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ExportUserDataResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "export-user-data", err)
+			}
+			err = ValidateExportUserDataResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "export-user-data", err)
+			}
+			res := NewExportUserDataUserDataExportOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ExportUserDataBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "export-user-data", err)
+			}
+			err = ValidateExportUserDataBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "export-user-data", err)
+			}
+			return nil, NewExportUserDataBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ExportUserDataForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "export-user-data", err)
+			}
+			err = ValidateExportUserDataForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "export-user-data", err)
+			}
+			return nil, NewExportUserDataForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ExportUserDataInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "export-user-data", err)
+			}
+			err = ValidateExportUserDataInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "export-user-data", err)
+			}
+			return nil, NewExportUserDataInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ExportUserDataServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "export-user-data", err)
+			}
+			err = ValidateExportUserDataServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "export-user-data", err)
+			}
+			return nil, NewExportUserDataServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "export-user-data", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRemoveUserAccessRequest instantiates a HTTP request object with method
+// and path set to call the "project-service" service "remove-user-access"
+// endpoint
+func (c *Client) BuildRemoveUserAccessRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		username string
+	)
+	{
+		p, ok := v.(*projectservice.RemoveUserAccessPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "remove-user-access", "*projectservice.RemoveUserAccessPayload", v)
+		}
+		username = p.Username
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RemoveUserAccessProjectServicePath(username)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "remove-user-access", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRemoveUserAccessRequest returns an encoder for requests sent to the
+// project-service remove-user-access server.
+func EncodeRemoveUserAccessRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.RemoveUserAccessPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "remove-user-access", "*projectservice.RemoveUserAccessPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		if p.XSync != nil {
+			head := *p.XSync
+			headStr := strconv.FormatBool(head)
+			req.Header.Set("X-Sync", headStr)
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeRemoveUserAccessResponse returns a decoder for responses returned by
+// the project-service remove-user-access endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeRemoveUserAccessResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeRemoveUserAccessResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RemoveUserAccessResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "remove-user-access", err)
+			}
+			err = ValidateRemoveUserAccessResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "remove-user-access", err)
+			}
+			res := NewRemoveUserAccessUserAccessRemovalResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body RemoveUserAccessBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "remove-user-access", err)
+			}
+			err = ValidateRemoveUserAccessBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "remove-user-access", err)
+			}
+			return nil, NewRemoveUserAccessBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body RemoveUserAccessForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "remove-user-access", err)
+			}
+			err = ValidateRemoveUserAccessForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "remove-user-access", err)
+			}
+			return nil, NewRemoveUserAccessForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RemoveUserAccessInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "remove-user-access", err)
+			}
+			err = ValidateRemoveUserAccessInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "remove-user-access", err)
+			}
+			return nil, NewRemoveUserAccessInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body RemoveUserAccessServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "remove-user-access", err)
+			}
+			err = ValidateRemoveUserAccessServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "remove-user-access", err)
+			}
+			return nil, NewRemoveUserAccessServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "remove-user-access", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetProjectVisibilityImpactRequest instantiates a HTTP request object
+// with method and path set to call the "project-service" service
+// "get-project-visibility-impact" endpoint
+func (c *Client) BuildGetProjectVisibilityImpactRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		uid string
+	)
+	{
+		p, ok := v.(*projectservice.GetProjectVisibilityImpactPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "get-project-visibility-impact", "*projectservice.GetProjectVisibilityImpactPayload", v)
+		}
+		uid = p.UID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProjectVisibilityImpactProjectServicePath(uid)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "get-project-visibility-impact", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetProjectVisibilityImpactRequest returns an encoder for requests sent
+// to the project-service get-project-visibility-impact server.
+func EncodeGetProjectVisibilityImpactRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.GetProjectVisibilityImpactPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "get-project-visibility-impact", "*projectservice.GetProjectVisibilityImpactPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("v", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetProjectVisibilityImpactResponse returns a decoder for responses
+// returned by the project-service get-project-visibility-impact endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeGetProjectVisibilityImpactResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetProjectVisibilityImpactResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetProjectVisibilityImpactResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-visibility-impact", err)
+			}
+			err = ValidateGetProjectVisibilityImpactResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-visibility-impact", err)
+			}
+			res := NewGetProjectVisibilityImpactProjectVisibilityImpactOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetProjectVisibilityImpactBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-visibility-impact", err)
+			}
+			err = ValidateGetProjectVisibilityImpactBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-visibility-impact", err)
+			}
+			return nil, NewGetProjectVisibilityImpactBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetProjectVisibilityImpactForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-visibility-impact", err)
+			}
+			err = ValidateGetProjectVisibilityImpactForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-visibility-impact", err)
+			}
+			return nil, NewGetProjectVisibilityImpactForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetProjectVisibilityImpactInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-visibility-impact", err)
+			}
+			err = ValidateGetProjectVisibilityImpactInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-visibility-impact", err)
+			}
+			return nil, NewGetProjectVisibilityImpactInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetProjectVisibilityImpactNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-visibility-impact", err)
+			}
+			err = ValidateGetProjectVisibilityImpactNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-visibility-impact", err)
+			}
+			return nil, NewGetProjectVisibilityImpactNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetProjectVisibilityImpactServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-project-visibility-impact", err)
+			}
+			err = ValidateGetProjectVisibilityImpactServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-project-visibility-impact", err)
+			}
+			return nil, NewGetProjectVisibilityImpactServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "get-project-visibility-impact", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateWebhookRequest instantiates a HTTP request object with method and
+// path set to call the "project-service" service "create-webhook" endpoint
+func (c *Client) BuildCreateWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateWebhookProjectServicePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "create-webhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateWebhookRequest returns an encoder for requests sent to the
+// project-service create-webhook server.
+func EncodeCreateWebhookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.CreateWebhookPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "create-webhook", "*projectservice.CreateWebhookPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewCreateWebhookRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("project-service", "create-webhook", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateWebhookResponse returns a decoder for responses returned by the
+// project-service create-webhook endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeCreateWebhookResponse may return the following errors:
+//   - "BadRequest" (type *projectservice.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeCreateWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateWebhookResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-webhook", err)
+			}
+			err = ValidateCreateWebhookResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-webhook", err)
+			}
+			res := NewCreateWebhookWebhookCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CreateWebhookBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-webhook", err)
+			}
+			err = ValidateCreateWebhookBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-webhook", err)
+			}
+			return nil, NewCreateWebhookBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateWebhookForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-webhook", err)
+			}
+			err = ValidateCreateWebhookForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-webhook", err)
+			}
+			return nil, NewCreateWebhookForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateWebhookInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-webhook", err)
+			}
+			err = ValidateCreateWebhookInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-webhook", err)
+			}
+			return nil, NewCreateWebhookInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body CreateWebhookServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "create-webhook", err)
+			}
+			err = ValidateCreateWebhookServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "create-webhook", err)
+			}
+			return nil, NewCreateWebhookServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "create-webhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListWebhooksRequest instantiates a HTTP request object with method and
+// path set to call the "project-service" service "list-webhooks" endpoint
+func (c *Client) BuildListWebhooksRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListWebhooksProjectServicePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "list-webhooks", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListWebhooksRequest returns an encoder for requests sent to the
+// project-service list-webhooks server.
+func EncodeListWebhooksRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.ListWebhooksPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "list-webhooks", "*projectservice.ListWebhooksPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListWebhooksResponse returns a decoder for responses returned by the
+// project-service list-webhooks endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListWebhooksResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListWebhooksResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListWebhooksResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-webhooks", err)
+			}
+			err = ValidateListWebhooksResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-webhooks", err)
+			}
+			res := NewListWebhooksWebhookListOK(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body ListWebhooksForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-webhooks", err)
+			}
+			err = ValidateListWebhooksForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-webhooks", err)
+			}
+			return nil, NewListWebhooksForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListWebhooksInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-webhooks", err)
+			}
+			err = ValidateListWebhooksInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-webhooks", err)
+			}
+			return nil, NewListWebhooksInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListWebhooksServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-webhooks", err)
+			}
+			err = ValidateListWebhooksServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-webhooks", err)
+			}
+			return nil, NewListWebhooksServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "list-webhooks", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetWebhookRequest instantiates a HTTP request object with method and
+// path set to call the "project-service" service "get-webhook" endpoint
+func (c *Client) BuildGetWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*projectservice.GetWebhookPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "get-webhook", "*projectservice.GetWebhookPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetWebhookProjectServicePath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "get-webhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetWebhookRequest returns an encoder for requests sent to the
+// project-service get-webhook server.
+func EncodeGetWebhookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.GetWebhookPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "get-webhook", "*projectservice.GetWebhookPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetWebhookResponse returns a decoder for responses returned by the
+// project-service get-webhook endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetWebhookResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeGetWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetWebhookResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-webhook", err)
+			}
+			err = ValidateGetWebhookResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-webhook", err)
+			}
+			res := NewGetWebhookWebhookOK(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body GetWebhookForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-webhook", err)
+			}
+			err = ValidateGetWebhookForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-webhook", err)
+			}
+			return nil, NewGetWebhookForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetWebhookInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-webhook", err)
+			}
+			err = ValidateGetWebhookInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-webhook", err)
+			}
+			return nil, NewGetWebhookInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetWebhookNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-webhook", err)
+			}
+			err = ValidateGetWebhookNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-webhook", err)
+			}
+			return nil, NewGetWebhookNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetWebhookServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "get-webhook", err)
+			}
+			err = ValidateGetWebhookServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "get-webhook", err)
+			}
+			return nil, NewGetWebhookServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "get-webhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteWebhookRequest instantiates a HTTP request object with method and
+// path set to call the "project-service" service "delete-webhook" endpoint
+func (c *Client) BuildDeleteWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*projectservice.DeleteWebhookPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "delete-webhook", "*projectservice.DeleteWebhookPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteWebhookProjectServicePath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "delete-webhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteWebhookRequest returns an encoder for requests sent to the
+// project-service delete-webhook server.
+func EncodeDeleteWebhookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.DeleteWebhookPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "delete-webhook", "*projectservice.DeleteWebhookPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteWebhookResponse returns a decoder for responses returned by the
+// project-service delete-webhook endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeDeleteWebhookResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeDeleteWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusForbidden:
+			var (
+				body DeleteWebhookForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "delete-webhook", err)
+			}
+			err = ValidateDeleteWebhookForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "delete-webhook", err)
+			}
+			return nil, NewDeleteWebhookForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteWebhookInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "delete-webhook", err)
+			}
+			err = ValidateDeleteWebhookInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "delete-webhook", err)
+			}
+			return nil, NewDeleteWebhookInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteWebhookNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "delete-webhook", err)
+			}
+			err = ValidateDeleteWebhookNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "delete-webhook", err)
+			}
+			return nil, NewDeleteWebhookNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body DeleteWebhookServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "delete-webhook", err)
+			}
+			err = ValidateDeleteWebhookServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "delete-webhook", err)
+			}
+			return nil, NewDeleteWebhookServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "delete-webhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListWebhookDeliveriesRequest instantiates a HTTP request object with
+// method and path set to call the "project-service" service
+// "list-webhook-deliveries" endpoint
+func (c *Client) BuildListWebhookDeliveriesRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*projectservice.ListWebhookDeliveriesPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("project-service", "list-webhook-deliveries", "*projectservice.ListWebhookDeliveriesPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListWebhookDeliveriesProjectServicePath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("project-service", "list-webhook-deliveries", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListWebhookDeliveriesRequest returns an encoder for requests sent to
+// the project-service list-webhook-deliveries server.
+func EncodeListWebhookDeliveriesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*projectservice.ListWebhookDeliveriesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("project-service", "list-webhook-deliveries", "*projectservice.ListWebhookDeliveriesPayload", v)
+		}
+		if p.BearerToken != nil {
+			head := *p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListWebhookDeliveriesResponse returns a decoder for responses returned
+// by the project-service list-webhook-deliveries endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeListWebhookDeliveriesResponse may return the following errors:
+//   - "Forbidden" (type *projectservice.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *projectservice.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *projectservice.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *projectservice.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - error: internal error
+func DecodeListWebhookDeliveriesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListWebhookDeliveriesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-webhook-deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-webhook-deliveries", err)
+			}
+			res := NewListWebhookDeliveriesWebhookDeliveryListOK(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body ListWebhookDeliveriesForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-webhook-deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-webhook-deliveries", err)
+			}
+			return nil, NewListWebhookDeliveriesForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListWebhookDeliveriesInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-webhook-deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-webhook-deliveries", err)
+			}
+			return nil, NewListWebhookDeliveriesInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ListWebhookDeliveriesNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-webhook-deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-webhook-deliveries", err)
+			}
+			return nil, NewListWebhookDeliveriesNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListWebhookDeliveriesServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("project-service", "list-webhook-deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("project-service", "list-webhook-deliveries", err)
+			}
+			return nil, NewListWebhookDeliveriesServiceUnavailable(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("project-service", "list-webhook-deliveries", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalProjectLinksResponseBodyToProjectserviceProjectLinks builds a value
+// of type *projectservice.ProjectLinks from a value of type
+// *ProjectLinksResponseBody.
+func unmarshalProjectLinksResponseBodyToProjectserviceProjectLinks(v *ProjectLinksResponseBody) *projectservice.ProjectLinks {
+	if v == nil {
+		return nil
+	}
+	res := &projectservice.ProjectLinks{
+		Twitter:      v.Twitter,
+		Linkedin:     v.Linkedin,
+		Youtube:      v.Youtube,
+		Slack:        v.Slack,
+		Discord:      v.Discord,
+		MailingList:  v.MailingList,
+		ContactEmail: v.ContactEmail,
+	}
+
+	return res
+}
+
+// unmarshalUserInfoResponseBodyToProjectserviceUserInfo builds a value of type
+// *projectservice.UserInfo from a value of type *UserInfoResponseBody.
+func unmarshalUserInfoResponseBodyToProjectserviceUserInfo(v *UserInfoResponseBody) *projectservice.UserInfo {
+	if v == nil {
+		return nil
+	}
+	res := &projectservice.UserInfo{
+		Name:     v.Name,
+		Email:    v.Email,
+		Username: v.Username,
+		Avatar:   v.Avatar,
+	}
+	if v.Invite != nil {
+		res.Invite = unmarshalInviteInfoResponseBodyToProjectserviceInviteInfo(v.Invite)
+	}
+
+	return res
+}
+
+// unmarshalInviteInfoResponseBodyToProjectserviceInviteInfo builds a value of
+// type *projectservice.InviteInfo from a value of type *InviteInfoResponseBody.
+func unmarshalInviteInfoResponseBodyToProjectserviceInviteInfo(v *InviteInfoResponseBody) *projectservice.InviteInfo {
+	if v == nil {
+		return nil
+	}
+	res := &projectservice.InviteInfo{
+		UID:       v.UID,
+		Email:     v.Email,
+		ExpiresAt: v.ExpiresAt,
+	}
+
+	return res
+}
+
+// unmarshalProjectSettingsResponseBodyToProjectserviceProjectSettings builds a
+// value of type *projectservice.ProjectSettings from a value of type
+// *ProjectSettingsResponseBody.
+func unmarshalProjectSettingsResponseBodyToProjectserviceProjectSettings(v *ProjectSettingsResponseBody) *projectservice.ProjectSettings {
+	if v == nil {
+		return nil
+	}
+	res := &projectservice.ProjectSettings{
+		UID:              v.UID,
+		MissionStatement: v.MissionStatement,
+		OverviewHTML:     v.OverviewHTML,
+		AnnouncementDate: v.AnnouncementDate,
+		CreatedAt:        v.CreatedAt,
+		UpdatedAt:        v.UpdatedAt,
+	}
+	if v.Writers != nil {
+		res.Writers = make([]*projectservice.UserInfo, len(v.Writers))
+		for i, val := range v.Writers {
+			res.Writers[i] = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(val)
+		}
+	}
+	if v.MeetingCoordinators != nil {
+		res.MeetingCoordinators = make([]*projectservice.UserInfo, len(v.MeetingCoordinators))
+		for i, val := range v.MeetingCoordinators {
+			res.MeetingCoordinators[i] = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(val)
+		}
+	}
+	if v.Auditors != nil {
+		res.Auditors = make([]*projectservice.UserInfo, len(v.Auditors))
+		for i, val := range v.Auditors {
+			res.Auditors[i] = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(val)
+		}
+	}
+	if v.ExecutiveDirector != nil {
+		res.ExecutiveDirector = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(v.ExecutiveDirector)
+	}
+	if v.ProgramManager != nil {
+		res.ProgramManager = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(v.ProgramManager)
+	}
+	if v.OpportunityOwner != nil {
+		res.OpportunityOwner = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(v.OpportunityOwner)
+	}
+
+	return res
+}
+
+// unmarshalFieldErrorResponseBodyToProjectserviceFieldError builds a value of
+// type *projectservice.FieldError from a value of type *FieldErrorResponseBody.
+func unmarshalFieldErrorResponseBodyToProjectserviceFieldError(v *FieldErrorResponseBody) *projectservice.FieldError {
+	if v == nil {
+		return nil
+	}
+	res := &projectservice.FieldError{
+		Path:    *v.Path,
+		Code:    *v.Code,
+		Message: *v.Message,
+	}
+
+	return res
+}
+
+// unmarshalProjectContactResponseBodyToProjectserviceProjectContact builds a
+// value of type *projectservice.ProjectContact from a value of type
+// *ProjectContactResponseBody.
+func unmarshalProjectContactResponseBodyToProjectserviceProjectContact(v *ProjectContactResponseBody) *projectservice.ProjectContact {
+	if v == nil {
+		return nil
+	}
+	res := &projectservice.ProjectContact{
+		Role:  *v.Role,
+		Name:  *v.Name,
+		Email: *v.Email,
+	}
+
+	return res
+}
+
+// unmarshalDuplicateProjectResponseBodyToProjectserviceDuplicateProject builds
+// a value of type *projectservice.DuplicateProject from a value of type
+// *DuplicateProjectResponseBody.
+func unmarshalDuplicateProjectResponseBodyToProjectserviceDuplicateProject(v *DuplicateProjectResponseBody) *projectservice.DuplicateProject {
+	if v == nil {
+		return nil
+	}
+	res := &projectservice.DuplicateProject{
+		UID:    *v.UID,
+		Slug:   *v.Slug,
+		Name:   *v.Name,
+		Reason: *v.Reason,
+	}
+
+	return res
+}
+
+// unmarshalSettingsMergeConflictResponseBodyToProjectserviceSettingsMergeConflict
+// builds a value of type *projectservice.SettingsMergeConflict from a value of
+// type *SettingsMergeConflictResponseBody.
+func unmarshalSettingsMergeConflictResponseBodyToProjectserviceSettingsMergeConflict(v *SettingsMergeConflictResponseBody) *projectservice.SettingsMergeConflict {
+	if v == nil {
+		return nil
+	}
+	res := &projectservice.SettingsMergeConflict{
+		Field: *v.Field,
+	}
+	if v.Users != nil {
+		res.Users = make([]string, len(v.Users))
+		for i, val := range v.Users {
+			res.Users[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalProjectserviceUserInfoToUserInfoRequestBody builds a value of type
+// *UserInfoRequestBody from a value of type *projectservice.UserInfo.
+func marshalProjectserviceUserInfoToUserInfoRequestBody(v *projectservice.UserInfo) *UserInfoRequestBody {
+	res := &UserInfoRequestBody{
+		Name:     v.Name,
+		Email:    v.Email,
+		Username: v.Username,
+		Avatar:   v.Avatar,
+	}
+	if v.Invite != nil {
+		res.Invite = marshalProjectserviceInviteInfoToInviteInfoRequestBody(v.Invite)
+	}
+
+	return res
+}
+
+// marshalProjectserviceInviteInfoToInviteInfoRequestBody builds a value of
+// type *InviteInfoRequestBody from a value of type *projectservice.InviteInfo.
+func marshalProjectserviceInviteInfoToInviteInfoRequestBody(v *projectservice.InviteInfo) *InviteInfoRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &InviteInfoRequestBody{
+		UID:       v.UID,
+		Email:     v.Email,
+		ExpiresAt: v.ExpiresAt,
+	}
+
+	return res
+}
+
+// marshalUserInfoRequestBodyToProjectserviceUserInfo builds a value of type
+// *projectservice.UserInfo from a value of type *UserInfoRequestBody.
+func marshalUserInfoRequestBodyToProjectserviceUserInfo(v *UserInfoRequestBody) *projectservice.UserInfo {
+	res := &projectservice.UserInfo{
+		Name:     v.Name,
+		Email:    v.Email,
+		Username: v.Username,
+		Avatar:   v.Avatar,
+	}
+	if v.Invite != nil {
+		res.Invite = marshalInviteInfoRequestBodyToProjectserviceInviteInfo(v.Invite)
+	}
+
+	return res
+}
+
+// marshalInviteInfoRequestBodyToProjectserviceInviteInfo builds a value of
+// type *projectservice.InviteInfo from a value of type *InviteInfoRequestBody.
+func marshalInviteInfoRequestBodyToProjectserviceInviteInfo(v *InviteInfoRequestBody) *projectservice.InviteInfo {
+	if v == nil {
+		return nil
+	}
+	res := &projectservice.InviteInfo{
+		UID:       v.UID,
+		Email:     v.Email,
+		ExpiresAt: v.ExpiresAt,
+	}
+
+	return res
+}
+
+// unmarshalProjectCharterVersionResponseBodyToProjectserviceProjectCharterVersion
+// builds a value of type *projectservice.ProjectCharterVersion from a value of
+// type *ProjectCharterVersionResponseBody.
+func unmarshalProjectCharterVersionResponseBodyToProjectserviceProjectCharterVersion(v *ProjectCharterVersionResponseBody) *projectservice.ProjectCharterVersion {
+	res := &projectservice.ProjectCharterVersion{
+		Version:            *v.Version,
+		URL:                *v.URL,
+		FileName:           v.FileName,
+		FileSize:           *v.FileSize,
+		Sha256:             *v.Sha256,
+		UploadedByUsername: v.UploadedByUsername,
+		UploadedAt:         *v.UploadedAt,
+	}
+
+	return res
+}
+
+// unmarshalProjectSettingsChangeResponseBodyToProjectserviceProjectSettingsChange
+// builds a value of type *projectservice.ProjectSettingsChange from a value of
+// type *ProjectSettingsChangeResponseBody.
+func unmarshalProjectSettingsChangeResponseBodyToProjectserviceProjectSettingsChange(v *ProjectSettingsChangeResponseBody) *projectservice.ProjectSettingsChange {
+	res := &projectservice.ProjectSettingsChange{
+		Field: *v.Field,
+		From:  v.From,
+		To:    v.To,
+	}
+	if v.Added != nil {
+		res.Added = make([]*projectservice.UserInfo, len(v.Added))
+		for i, val := range v.Added {
+			res.Added[i] = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(val)
+		}
+	}
+	if v.Removed != nil {
+		res.Removed = make([]*projectservice.UserInfo, len(v.Removed))
+		for i, val := range v.Removed {
+			res.Removed[i] = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(val)
+		}
+	}
+
+	return res
+}
+
+// unmarshalProjectBaseResponseBodyToProjectserviceProjectBase builds a value
+// of type *projectservice.ProjectBase from a value of type
+// *ProjectBaseResponseBody.
+func unmarshalProjectBaseResponseBodyToProjectserviceProjectBase(v *ProjectBaseResponseBody) *projectservice.ProjectBase {
+	res := &projectservice.ProjectBase{
+		UID:                        v.UID,
+		Slug:                       v.Slug,
+		Description:                v.Description,
+		Name:                       v.Name,
+		Public:                     v.Public,
+		IsFoundation:               v.IsFoundation,
+		ParentUID:                  v.ParentUID,
+		Stage:                      v.Stage,
+		Category:                   v.Category,
+		Funding:                    v.Funding,
+		CharterURL:                 v.CharterURL,
+		LegalEntityType:            v.LegalEntityType,
+		LegalEntityName:            v.LegalEntityName,
+		LegalParentUID:             v.LegalParentUID,
+		EntityDissolutionDate:      v.EntityDissolutionDate,
+		EntityStatus:               v.EntityStatus,
+		EntityFormationDocumentURL: v.EntityFormationDocumentURL,
+		AutojoinEnabled:            v.AutojoinEnabled,
+		FormationDate:              v.FormationDate,
+		LogoURL:                    v.LogoURL,
+		PngLogoURL:                 v.PngLogoURL,
+		RepositoryURL:              v.RepositoryURL,
+		WebsiteURL:                 v.WebsiteURL,
+		LegacyID:                   v.LegacyID,
+		CreatedAt:                  v.CreatedAt,
+		UpdatedAt:                  v.UpdatedAt,
+	}
+	if v.NameI18n != nil {
+		res.NameI18n = make(map[string]string, len(v.NameI18n))
+		for key, val := range v.NameI18n {
+			tk := key
+			tv := val
+			res.NameI18n[tk] = tv
+		}
+	}
+	if v.DescriptionI18n != nil {
+		res.DescriptionI18n = make(map[string]string, len(v.DescriptionI18n))
+		for key, val := range v.DescriptionI18n {
+			tk := key
+			tv := val
+			res.DescriptionI18n[tk] = tv
+		}
+	}
+	if v.FundingModel != nil {
+		res.FundingModel = make([]string, len(v.FundingModel))
+		for i, val := range v.FundingModel {
+			res.FundingModel[i] = val
+		}
+	}
+	if v.Links != nil {
+		res.Links = unmarshalProjectLinksResponseBodyToProjectserviceProjectLinks(v.Links)
+	}
+	if v.Annotations != nil {
+		res.Annotations = make(map[string]string, len(v.Annotations))
+		for key, val := range v.Annotations {
+			tk := key
+			tv := val
+			res.Annotations[tk] = tv
+		}
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
 	}
 
 	return res
 }
 
-// marshalInviteInfoRequestBodyToProjectserviceInviteInfo builds a value of
-// type *projectservice.InviteInfo from a value of type *InviteInfoRequestBody.
-func marshalInviteInfoRequestBodyToProjectserviceInviteInfo(v *InviteInfoRequestBody) *projectservice.InviteInfo {
+// unmarshalLegalLineageProjectResponseBodyToProjectserviceLegalLineageProject
+// builds a value of type *projectservice.LegalLineageProject from a value of
+// type *LegalLineageProjectResponseBody.
+func unmarshalLegalLineageProjectResponseBodyToProjectserviceLegalLineageProject(v *LegalLineageProjectResponseBody) *projectservice.LegalLineageProject {
+	res := &projectservice.LegalLineageProject{
+		UID:             *v.UID,
+		Slug:            *v.Slug,
+		Name:            *v.Name,
+		LegalEntityType: v.LegalEntityType,
+		LegalEntityName: v.LegalEntityName,
+	}
+
+	return res
+}
+
+// unmarshalProjectSuggestionResponseBodyToProjectserviceProjectSuggestion
+// builds a value of type *projectservice.ProjectSuggestion from a value of
+// type *ProjectSuggestionResponseBody.
+func unmarshalProjectSuggestionResponseBodyToProjectserviceProjectSuggestion(v *ProjectSuggestionResponseBody) *projectservice.ProjectSuggestion {
+	res := &projectservice.ProjectSuggestion{
+		UID:     *v.UID,
+		Name:    *v.Name,
+		Slug:    *v.Slug,
+		LogoURL: v.LogoURL,
+	}
+
+	return res
+}
+
+// marshalProjectserviceProjectMembersOperationToProjectMembersOperationRequestBody
+// builds a value of type *ProjectMembersOperationRequestBody from a value of
+// type *projectservice.ProjectMembersOperation.
+func marshalProjectserviceProjectMembersOperationToProjectMembersOperationRequestBody(v *projectservice.ProjectMembersOperation) *ProjectMembersOperationRequestBody {
+	res := &ProjectMembersOperationRequestBody{
+		UID: v.UID,
+	}
+	if v.WritersAdd != nil {
+		res.WritersAdd = make([]string, len(v.WritersAdd))
+		for i, val := range v.WritersAdd {
+			res.WritersAdd[i] = val
+		}
+	}
+	if v.WritersRemove != nil {
+		res.WritersRemove = make([]string, len(v.WritersRemove))
+		for i, val := range v.WritersRemove {
+			res.WritersRemove[i] = val
+		}
+	}
+	if v.AuditorsAdd != nil {
+		res.AuditorsAdd = make([]string, len(v.AuditorsAdd))
+		for i, val := range v.AuditorsAdd {
+			res.AuditorsAdd[i] = val
+		}
+	}
+	if v.AuditorsRemove != nil {
+		res.AuditorsRemove = make([]string, len(v.AuditorsRemove))
+		for i, val := range v.AuditorsRemove {
+			res.AuditorsRemove[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalProjectMembersOperationRequestBodyToProjectserviceProjectMembersOperation
+// builds a value of type *projectservice.ProjectMembersOperation from a value
+// of type *ProjectMembersOperationRequestBody.
+func marshalProjectMembersOperationRequestBodyToProjectserviceProjectMembersOperation(v *ProjectMembersOperationRequestBody) *projectservice.ProjectMembersOperation {
+	res := &projectservice.ProjectMembersOperation{
+		UID: v.UID,
+	}
+	if v.WritersAdd != nil {
+		res.WritersAdd = make([]string, len(v.WritersAdd))
+		for i, val := range v.WritersAdd {
+			res.WritersAdd[i] = val
+		}
+	}
+	if v.WritersRemove != nil {
+		res.WritersRemove = make([]string, len(v.WritersRemove))
+		for i, val := range v.WritersRemove {
+			res.WritersRemove[i] = val
+		}
+	}
+	if v.AuditorsAdd != nil {
+		res.AuditorsAdd = make([]string, len(v.AuditorsAdd))
+		for i, val := range v.AuditorsAdd {
+			res.AuditorsAdd[i] = val
+		}
+	}
+	if v.AuditorsRemove != nil {
+		res.AuditorsRemove = make([]string, len(v.AuditorsRemove))
+		for i, val := range v.AuditorsRemove {
+			res.AuditorsRemove[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalProjectMembersOperationResultResponseBodyToProjectserviceProjectMembersOperationResult
+// builds a value of type *projectservice.ProjectMembersOperationResult from a
+// value of type *ProjectMembersOperationResultResponseBody.
+func unmarshalProjectMembersOperationResultResponseBodyToProjectserviceProjectMembersOperationResult(v *ProjectMembersOperationResultResponseBody) *projectservice.ProjectMembersOperationResult {
+	res := &projectservice.ProjectMembersOperationResult{
+		UID:    *v.UID,
+		Status: *v.Status,
+		Error:  v.Error,
+	}
+
+	return res
+}
+
+// unmarshalProjectFullResponseBodyToProjectserviceProjectFull builds a value
+// of type *projectservice.ProjectFull from a value of type
+// *ProjectFullResponseBody.
+func unmarshalProjectFullResponseBodyToProjectserviceProjectFull(v *ProjectFullResponseBody) *projectservice.ProjectFull {
+	res := &projectservice.ProjectFull{
+		UID:                        v.UID,
+		Slug:                       v.Slug,
+		Description:                v.Description,
+		Name:                       v.Name,
+		Public:                     v.Public,
+		IsFoundation:               v.IsFoundation,
+		ParentUID:                  v.ParentUID,
+		Stage:                      v.Stage,
+		Category:                   v.Category,
+		Funding:                    v.Funding,
+		CharterURL:                 v.CharterURL,
+		LegalEntityType:            v.LegalEntityType,
+		LegalEntityName:            v.LegalEntityName,
+		LegalParentUID:             v.LegalParentUID,
+		EntityDissolutionDate:      v.EntityDissolutionDate,
+		EntityStatus:               v.EntityStatus,
+		EntityFormationDocumentURL: v.EntityFormationDocumentURL,
+		AutojoinEnabled:            v.AutojoinEnabled,
+		FormationDate:              v.FormationDate,
+		LogoURL:                    v.LogoURL,
+		PngLogoURL:                 v.PngLogoURL,
+		RepositoryURL:              v.RepositoryURL,
+		WebsiteURL:                 v.WebsiteURL,
+		LegacyID:                   v.LegacyID,
+		CreatedAt:                  v.CreatedAt,
+		UpdatedAt:                  v.UpdatedAt,
+		MissionStatement:           v.MissionStatement,
+		OverviewHTML:               v.OverviewHTML,
+		AnnouncementDate:           v.AnnouncementDate,
+		SlugPath:                   v.SlugPath,
+		Depth:                      v.Depth,
+		ChildCount:                 v.ChildCount,
+		HasSettings:                v.HasSettings,
+		DisplayStatus:              v.DisplayStatus,
+	}
+	if v.NameI18n != nil {
+		res.NameI18n = make(map[string]string, len(v.NameI18n))
+		for key, val := range v.NameI18n {
+			tk := key
+			tv := val
+			res.NameI18n[tk] = tv
+		}
+	}
+	if v.DescriptionI18n != nil {
+		res.DescriptionI18n = make(map[string]string, len(v.DescriptionI18n))
+		for key, val := range v.DescriptionI18n {
+			tk := key
+			tv := val
+			res.DescriptionI18n[tk] = tv
+		}
+	}
+	if v.FundingModel != nil {
+		res.FundingModel = make([]string, len(v.FundingModel))
+		for i, val := range v.FundingModel {
+			res.FundingModel[i] = val
+		}
+	}
+	if v.Links != nil {
+		res.Links = unmarshalProjectLinksResponseBodyToProjectserviceProjectLinks(v.Links)
+	}
+	if v.Annotations != nil {
+		res.Annotations = make(map[string]string, len(v.Annotations))
+		for key, val := range v.Annotations {
+			tk := key
+			tv := val
+			res.Annotations[tk] = tv
+		}
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
+	}
+	if v.Writers != nil {
+		res.Writers = make([]*projectservice.UserInfo, len(v.Writers))
+		for i, val := range v.Writers {
+			res.Writers[i] = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(val)
+		}
+	}
+	if v.MeetingCoordinators != nil {
+		res.MeetingCoordinators = make([]*projectservice.UserInfo, len(v.MeetingCoordinators))
+		for i, val := range v.MeetingCoordinators {
+			res.MeetingCoordinators[i] = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(val)
+		}
+	}
+	if v.Auditors != nil {
+		res.Auditors = make([]*projectservice.UserInfo, len(v.Auditors))
+		for i, val := range v.Auditors {
+			res.Auditors[i] = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(val)
+		}
+	}
+	if v.ExecutiveDirector != nil {
+		res.ExecutiveDirector = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(v.ExecutiveDirector)
+	}
+	if v.ProgramManager != nil {
+		res.ProgramManager = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(v.ProgramManager)
+	}
+	if v.OpportunityOwner != nil {
+		res.OpportunityOwner = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(v.OpportunityOwner)
+	}
+	if v.Settings != nil {
+		res.Settings = unmarshalProjectSettingsResponseBodyToProjectserviceProjectSettings(v.Settings)
+	}
+
+	return res
+}
+
+// marshalProjectserviceProjectLinksToProjectLinksRequestBody builds a value of
+// type *ProjectLinksRequestBody from a value of type
+// *projectservice.ProjectLinks.
+func marshalProjectserviceProjectLinksToProjectLinksRequestBody(v *projectservice.ProjectLinks) *ProjectLinksRequestBody {
 	if v == nil {
 		return nil
 	}
-	res := &projectservice.InviteInfo{
-		UID:       v.UID,
-		Email:     v.Email,
-		ExpiresAt: v.ExpiresAt,
+	res := &ProjectLinksRequestBody{
+		Twitter:      v.Twitter,
+		Linkedin:     v.Linkedin,
+		Youtube:      v.Youtube,
+		Slack:        v.Slack,
+		Discord:      v.Discord,
+		MailingList:  v.MailingList,
+		ContactEmail: v.ContactEmail,
+	}
+
+	return res
+}
+
+// marshalProjectLinksRequestBodyToProjectserviceProjectLinks builds a value of
+// type *projectservice.ProjectLinks from a value of type
+// *ProjectLinksRequestBody.
+func marshalProjectLinksRequestBodyToProjectserviceProjectLinks(v *ProjectLinksRequestBody) *projectservice.ProjectLinks {
+	if v == nil {
+		return nil
+	}
+	res := &projectservice.ProjectLinks{
+		Twitter:      v.Twitter,
+		Linkedin:     v.Linkedin,
+		Youtube:      v.Youtube,
+		Slack:        v.Slack,
+		Discord:      v.Discord,
+		MailingList:  v.MailingList,
+		ContactEmail: v.ContactEmail,
+	}
+
+	return res
+}
+
+// unmarshalProjectDeletionOutcomeResponseBodyToProjectserviceProjectDeletionOutcome
+// builds a value of type *projectservice.ProjectDeletionOutcome from a value
+// of type *ProjectDeletionOutcomeResponseBody.
+func unmarshalProjectDeletionOutcomeResponseBodyToProjectserviceProjectDeletionOutcome(v *ProjectDeletionOutcomeResponseBody) *projectservice.ProjectDeletionOutcome {
+	res := &projectservice.ProjectDeletionOutcome{
+		UID:       *v.UID,
+		Slug:      v.Slug,
+		Requested: *v.Requested,
+		Status:    *v.Status,
+		Error:     v.Error,
+	}
+
+	return res
+}
+
+// unmarshalDependencyHealthResponseBodyToProjectserviceDependencyHealth builds
+// a value of type *projectservice.DependencyHealth from a value of type
+// *DependencyHealthResponseBody.
+func unmarshalDependencyHealthResponseBodyToProjectserviceDependencyHealth(v *DependencyHealthResponseBody) *projectservice.DependencyHealth {
+	res := &projectservice.DependencyHealth{
+		Name:      *v.Name,
+		Status:    *v.Status,
+		LatencyMs: *v.LatencyMs,
+		Error:     v.Error,
+	}
+
+	return res
+}
+
+// unmarshalDeadLetterResponseBodyToProjectserviceDeadLetter builds a value of
+// type *projectservice.DeadLetter from a value of type *DeadLetterResponseBody.
+func unmarshalDeadLetterResponseBodyToProjectserviceDeadLetter(v *DeadLetterResponseBody) *projectservice.DeadLetter {
+	res := &projectservice.DeadLetter{
+		ID:           *v.ID,
+		Subject:      *v.Subject,
+		Payload:      *v.Payload,
+		Error:        *v.Error,
+		Attempts:     *v.Attempts,
+		FailedAt:     *v.FailedAt,
+		LastFailedAt: *v.LastFailedAt,
+	}
+
+	return res
+}
+
+// unmarshalKVKeyInspectionResponseBodyToProjectserviceKVKeyInspection builds a
+// value of type *projectservice.KVKeyInspection from a value of type
+// *KVKeyInspectionResponseBody.
+func unmarshalKVKeyInspectionResponseBodyToProjectserviceKVKeyInspection(v *KVKeyInspectionResponseBody) *projectservice.KVKeyInspection {
+	res := &projectservice.KVKeyInspection{
+		Bucket: *v.Bucket,
+		Key:    *v.Key,
+	}
+	if v.Current != nil {
+		res.Current = unmarshalKVEntryResponseBodyToProjectserviceKVEntry(v.Current)
+	}
+	res.History = make([]*projectservice.KVEntry, len(v.History))
+	for i, val := range v.History {
+		res.History[i] = unmarshalKVEntryResponseBodyToProjectserviceKVEntry(val)
+	}
+
+	return res
+}
+
+// unmarshalKVEntryResponseBodyToProjectserviceKVEntry builds a value of type
+// *projectservice.KVEntry from a value of type *KVEntryResponseBody.
+func unmarshalKVEntryResponseBodyToProjectserviceKVEntry(v *KVEntryResponseBody) *projectservice.KVEntry {
+	if v == nil {
+		return nil
+	}
+	res := &projectservice.KVEntry{
+		Revision:  *v.Revision,
+		Created:   *v.Created,
+		Operation: *v.Operation,
+		Value:     v.Value,
+	}
+
+	return res
+}
+
+// unmarshalBackupResponseBodyToProjectserviceBackup builds a value of type
+// *projectservice.Backup from a value of type *BackupResponseBody.
+func unmarshalBackupResponseBodyToProjectserviceBackup(v *BackupResponseBody) *projectservice.Backup {
+	res := &projectservice.Backup{
+		Name:      *v.Name,
+		Size:      *v.Size,
+		CreatedAt: *v.CreatedAt,
+	}
+
+	return res
+}
+
+// unmarshalPendingAnnouncementResponseBodyToProjectservicePendingAnnouncement
+// builds a value of type *projectservice.PendingAnnouncement from a value of
+// type *PendingAnnouncementResponseBody.
+func unmarshalPendingAnnouncementResponseBodyToProjectservicePendingAnnouncement(v *PendingAnnouncementResponseBody) *projectservice.PendingAnnouncement {
+	res := &projectservice.PendingAnnouncement{
+		ProjectUID:       *v.ProjectUID,
+		Slug:             *v.Slug,
+		Name:             *v.Name,
+		AnnouncementDate: *v.AnnouncementDate,
+		Due:              *v.Due,
+	}
+
+	return res
+}
+
+// unmarshalProjectTemplateResponseBodyToProjectserviceProjectTemplate builds a
+// value of type *projectservice.ProjectTemplate from a value of type
+// *ProjectTemplateResponseBody.
+func unmarshalProjectTemplateResponseBodyToProjectserviceProjectTemplate(v *ProjectTemplateResponseBody) *projectservice.ProjectTemplate {
+	res := &projectservice.ProjectTemplate{
+		Name:             *v.Name,
+		Description:      v.Description,
+		Stage:            v.Stage,
+		Category:         v.Category,
+		MissionStatement: v.MissionStatement,
+		CreatedBy:        v.CreatedBy,
+		CreatedAt:        *v.CreatedAt,
+		UpdatedAt:        *v.UpdatedAt,
+	}
+	if v.FundingModel != nil {
+		res.FundingModel = make([]string, len(v.FundingModel))
+		for i, val := range v.FundingModel {
+			res.FundingModel[i] = val
+		}
+	}
+	if v.Auditors != nil {
+		res.Auditors = make([]*projectservice.UserInfo, len(v.Auditors))
+		for i, val := range v.Auditors {
+			res.Auditors[i] = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(val)
+		}
+	}
+
+	return res
+}
+
+// unmarshalProjectDeletionResponseBodyToProjectserviceProjectDeletion builds a
+// value of type *projectservice.ProjectDeletion from a value of type
+// *ProjectDeletionResponseBody.
+func unmarshalProjectDeletionResponseBodyToProjectserviceProjectDeletion(v *ProjectDeletionResponseBody) *projectservice.ProjectDeletion {
+	res := &projectservice.ProjectDeletion{
+		UID:        *v.UID,
+		Slug:       *v.Slug,
+		DeletedAt:  *v.DeletedAt,
+		DeletedBy:  v.DeletedBy,
+		MergedInto: v.MergedInto,
+	}
+
+	return res
+}
+
+// unmarshalProjectEventResponseBodyToProjectserviceProjectEvent builds a value
+// of type *projectservice.ProjectEvent from a value of type
+// *ProjectEventResponseBody.
+func unmarshalProjectEventResponseBodyToProjectserviceProjectEvent(v *ProjectEventResponseBody) *projectservice.ProjectEvent {
+	res := &projectservice.ProjectEvent{
+		ID:         *v.ID,
+		Type:       *v.Type,
+		OccurredAt: *v.OccurredAt,
+		ProjectUID: *v.ProjectUID,
+		Revision:   *v.Revision,
+	}
+	if v.Project != nil {
+		res.Project = unmarshalProjectBaseResponseBodyToProjectserviceProjectBase(v.Project)
+	}
+	if v.PreviousProject != nil {
+		res.PreviousProject = unmarshalProjectBaseResponseBodyToProjectserviceProjectBase(v.PreviousProject)
+	}
+	if v.Settings != nil {
+		res.Settings = unmarshalProjectSettingsResponseBodyToProjectserviceProjectSettings(v.Settings)
+	}
+	if v.PreviousSettings != nil {
+		res.PreviousSettings = unmarshalProjectSettingsResponseBodyToProjectserviceProjectSettings(v.PreviousSettings)
+	}
+
+	return res
+}
+
+// unmarshalUserProjectResponseBodyToProjectserviceUserProject builds a value
+// of type *projectservice.UserProject from a value of type
+// *UserProjectResponseBody.
+func unmarshalUserProjectResponseBodyToProjectserviceUserProject(v *UserProjectResponseBody) *projectservice.UserProject {
+	res := &projectservice.UserProject{
+		ProjectUID: *v.ProjectUID,
+		Slug:       *v.Slug,
+		Name:       *v.Name,
+	}
+	res.Roles = make([]string, len(v.Roles))
+	for i, val := range v.Roles {
+		res.Roles[i] = val
+	}
+
+	return res
+}
+
+// unmarshalUserMembershipResponseBodyToProjectserviceUserMembership builds a
+// value of type *projectservice.UserMembership from a value of type
+// *UserMembershipResponseBody.
+func unmarshalUserMembershipResponseBodyToProjectserviceUserMembership(v *UserMembershipResponseBody) *projectservice.UserMembership {
+	res := &projectservice.UserMembership{
+		ProjectUID: *v.ProjectUID,
+		Slug:       *v.Slug,
+		Name:       *v.Name,
+		Role:       *v.Role,
+		AddedAt:    v.AddedAt,
+	}
+	res.User = unmarshalUserInfoResponseBodyToProjectserviceUserInfo(v.User)
+
+	return res
+}
+
+// unmarshalUserAccessRemovalResponseBodyToProjectserviceUserAccessRemoval
+// builds a value of type *projectservice.UserAccessRemoval from a value of
+// type *UserAccessRemovalResponseBody.
+func unmarshalUserAccessRemovalResponseBodyToProjectserviceUserAccessRemoval(v *UserAccessRemovalResponseBody) *projectservice.UserAccessRemoval {
+	res := &projectservice.UserAccessRemoval{
+		ProjectUID: *v.ProjectUID,
+		Slug:       *v.Slug,
+		Name:       *v.Name,
+		Status:     *v.Status,
+		Error:      v.Error,
+	}
+	res.Roles = make([]string, len(v.Roles))
+	for i, val := range v.Roles {
+		res.Roles[i] = val
+	}
+
+	return res
+}
+
+// unmarshalVisibilityImpactProjectResponseBodyToProjectserviceVisibilityImpactProject
+// builds a value of type *projectservice.VisibilityImpactProject from a value
+// of type *VisibilityImpactProjectResponseBody.
+func unmarshalVisibilityImpactProjectResponseBodyToProjectserviceVisibilityImpactProject(v *VisibilityImpactProjectResponseBody) *projectservice.VisibilityImpactProject {
+	res := &projectservice.VisibilityImpactProject{
+		UID:       *v.UID,
+		Slug:      *v.Slug,
+		Name:      *v.Name,
+		ParentUID: *v.ParentUID,
+		Public:    *v.Public,
+	}
+
+	return res
+}
+
+// unmarshalExternalReferenceResponseBodyToProjectserviceExternalReference
+// builds a value of type *projectservice.ExternalReference from a value of
+// type *ExternalReferenceResponseBody.
+func unmarshalExternalReferenceResponseBodyToProjectserviceExternalReference(v *ExternalReferenceResponseBody) *projectservice.ExternalReference {
+	res := &projectservice.ExternalReference{
+		Field: *v.Field,
+		URL:   *v.URL,
+	}
+
+	return res
+}
+
+// unmarshalVisibilitySubscribersResponseBodyToProjectserviceVisibilitySubscribers
+// builds a value of type *projectservice.VisibilitySubscribers from a value of
+// type *VisibilitySubscribersResponseBody.
+func unmarshalVisibilitySubscribersResponseBodyToProjectserviceVisibilitySubscribers(v *VisibilitySubscribersResponseBody) *projectservice.VisibilitySubscribers {
+	if v == nil {
+		return nil
+	}
+	res := &projectservice.VisibilitySubscribers{
+		Committees:    v.Committees,
+		MailingLists:  v.MailingLists,
+		MeetingSeries: v.MeetingSeries,
+		Webhooks:      v.Webhooks,
+	}
+
+	return res
+}
+
+// unmarshalWebhookResponseBodyToProjectserviceWebhook builds a value of type
+// *projectservice.Webhook from a value of type *WebhookResponseBody.
+func unmarshalWebhookResponseBodyToProjectserviceWebhook(v *WebhookResponseBody) *projectservice.Webhook {
+	res := &projectservice.Webhook{
+		ID:          *v.ID,
+		URL:         *v.URL,
+		ProjectUID:  v.ProjectUID,
+		Description: v.Description,
+		Secret:      v.Secret,
+		CreatedBy:   v.CreatedBy,
+		CreatedAt:   *v.CreatedAt,
+	}
+	res.Events = make([]string, len(v.Events))
+	for i, val := range v.Events {
+		res.Events[i] = val
+	}
+
+	return res
+}
+
+// unmarshalWebhookDeliveryResponseBodyToProjectserviceWebhookDelivery builds a
+// value of type *projectservice.WebhookDelivery from a value of type
+// *WebhookDeliveryResponseBody.
+func unmarshalWebhookDeliveryResponseBodyToProjectserviceWebhookDelivery(v *WebhookDeliveryResponseBody) *projectservice.WebhookDelivery {
+	res := &projectservice.WebhookDelivery{
+		ID:             *v.ID,
+		Event:          *v.Event,
+		ProjectUID:     *v.ProjectUID,
+		Status:         *v.Status,
+		Attempts:       *v.Attempts,
+		ResponseStatus: v.ResponseStatus,
+		LastError:      v.LastError,
+		CreatedAt:      *v.CreatedAt,
+		LastAttemptAt:  v.LastAttemptAt,
+		NextAttemptAt:  v.NextAttemptAt,
+		DeliveredAt:    v.DeliveredAt,
 	}
 
 	return res
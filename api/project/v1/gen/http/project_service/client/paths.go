@@ -13,6 +13,156 @@ import (
 	"fmt"
 )
 
+// CloneProjectProjectServicePath returns the URL path to the project-service service clone-project HTTP endpoint.
+func CloneProjectProjectServicePath(uid string) string {
+	return fmt.Sprintf("/projects/%v/clone", uid)
+}
+
+// UploadProjectLogoProjectServicePath returns the URL path to the project-service service upload-project-logo HTTP endpoint.
+func UploadProjectLogoProjectServicePath(uid string) string {
+	return fmt.Sprintf("/projects/%v/logo", uid)
+}
+
+// MergeProjectProjectServicePath returns the URL path to the project-service service merge-project HTTP endpoint.
+func MergeProjectProjectServicePath(uid string, targetUID string) string {
+	return fmt.Sprintf("/projects/%v/merge-into/%v", uid, targetUID)
+}
+
+// TransferProjectProjectServicePath returns the URL path to the project-service service transfer-project HTTP endpoint.
+func TransferProjectProjectServicePath(uid string) string {
+	return fmt.Sprintf("/projects/%v/transfer", uid)
+}
+
+// CreateProjectLinkProjectServicePath returns the URL path to the project-service service create-project-link HTTP endpoint.
+func CreateProjectLinkProjectServicePath(uid string) string {
+	return fmt.Sprintf("/projects/%v/links", uid)
+}
+
+// GetProjectLinkProjectServicePath returns the URL path to the project-service service get-project-link HTTP endpoint.
+func GetProjectLinkProjectServicePath(uid string, linkUID string) string {
+	return fmt.Sprintf("/projects/%v/links/%v", uid, linkUID)
+}
+
+// DeleteProjectLinkProjectServicePath returns the URL path to the project-service service delete-project-link HTTP endpoint.
+func DeleteProjectLinkProjectServicePath(uid string, linkUID string) string {
+	return fmt.Sprintf("/projects/%v/links/%v", uid, linkUID)
+}
+
+// CreateProjectFolderProjectServicePath returns the URL path to the project-service service create-project-folder HTTP endpoint.
+func CreateProjectFolderProjectServicePath(uid string) string {
+	return fmt.Sprintf("/projects/%v/folders", uid)
+}
+
+// GetProjectFolderProjectServicePath returns the URL path to the project-service service get-project-folder HTTP endpoint.
+func GetProjectFolderProjectServicePath(uid string, folderUID string) string {
+	return fmt.Sprintf("/projects/%v/folders/%v", uid, folderUID)
+}
+
+// DeleteProjectFolderProjectServicePath returns the URL path to the project-service service delete-project-folder HTTP endpoint.
+func DeleteProjectFolderProjectServicePath(uid string, folderUID string) string {
+	return fmt.Sprintf("/projects/%v/folders/%v", uid, folderUID)
+}
+
+// UploadProjectDocumentProjectServicePath returns the URL path to the project-service service upload-project-document HTTP endpoint.
+func UploadProjectDocumentProjectServicePath(uid string) string {
+	return fmt.Sprintf("/projects/%v/documents", uid)
+}
+
+// GetProjectDocumentProjectServicePath returns the URL path to the project-service service get-project-document HTTP endpoint.
+func GetProjectDocumentProjectServicePath(uid string, documentUID string) string {
+	return fmt.Sprintf("/projects/%v/documents/%v", uid, documentUID)
+}
+
+// DownloadProjectDocumentProjectServicePath returns the URL path to the project-service service download-project-document HTTP endpoint.
+func DownloadProjectDocumentProjectServicePath(uid string, documentUID string) string {
+	return fmt.Sprintf("/projects/%v/documents/%v/download", uid, documentUID)
+}
+
+// DeleteProjectDocumentProjectServicePath returns the URL path to the project-service service delete-project-document HTTP endpoint.
+func DeleteProjectDocumentProjectServicePath(uid string, documentUID string) string {
+	return fmt.Sprintf("/projects/%v/documents/%v", uid, documentUID)
+}
+
+// UploadProjectCharterProjectServicePath returns the URL path to the project-service service upload-project-charter HTTP endpoint.
+func UploadProjectCharterProjectServicePath(uid string) string {
+	return fmt.Sprintf("/projects/%v/charter", uid)
+}
+
+// GetProjectCharterProjectServicePath returns the URL path to the project-service service get-project-charter HTTP endpoint.
+func GetProjectCharterProjectServicePath(uid string) string {
+	return fmt.Sprintf("/projects/%v/charter", uid)
+}
+
+// GetProjectAssociationsProjectServicePath returns the URL path to the project-service service get-project-associations HTTP endpoint.
+func GetProjectAssociationsProjectServicePath(uid string) string {
+	return fmt.Sprintf("/projects/%v/associations", uid)
+}
+
+// UpdateProjectAssociationsProjectServicePath returns the URL path to the project-service service update-project-associations HTTP endpoint.
+func UpdateProjectAssociationsProjectServicePath(uid string) string {
+	return fmt.Sprintf("/projects/%v/associations", uid)
+}
+
+// GetProjectSettingsDiffProjectServicePath returns the URL path to the project-service service get-project-settings-diff HTTP endpoint.
+func GetProjectSettingsDiffProjectServicePath(uid string) string {
+	return fmt.Sprintf("/projects/%v/settings/diff", uid)
+}
+
+// GetProjectChildrenProjectServicePath returns the URL path to the project-service service get-project-children HTTP endpoint.
+func GetProjectChildrenProjectServicePath(uid string) string {
+	return fmt.Sprintf("/projects/%v/children", uid)
+}
+
+// GetProjectDescendantsProjectServicePath returns the URL path to the project-service service get-project-descendants HTTP endpoint.
+func GetProjectDescendantsProjectServicePath(uid string) string {
+	return fmt.Sprintf("/projects/%v/descendants", uid)
+}
+
+// GetProjectLegalLineageProjectServicePath returns the URL path to the project-service service get-project-legal-lineage HTTP endpoint.
+func GetProjectLegalLineageProjectServicePath(uid string) string {
+	return fmt.Sprintf("/projects/%v/legal-lineage", uid)
+}
+
+// SearchProjectsProjectServicePath returns the URL path to the project-service service search-projects HTTP endpoint.
+func SearchProjectsProjectServicePath() string {
+	return "/projects/search"
+}
+
+// AutocompleteProjectsProjectServicePath returns the URL path to the project-service service autocomplete-projects HTTP endpoint.
+func AutocompleteProjectsProjectServicePath() string {
+	return "/projects/autocomplete"
+}
+
+// AddProjectWriterProjectServicePath returns the URL path to the project-service service add-project-writer HTTP endpoint.
+func AddProjectWriterProjectServicePath(uid string, username string) string {
+	return fmt.Sprintf("/projects/%v/writers/%v", uid, username)
+}
+
+// RemoveProjectWriterProjectServicePath returns the URL path to the project-service service remove-project-writer HTTP endpoint.
+func RemoveProjectWriterProjectServicePath(uid string, username string) string {
+	return fmt.Sprintf("/projects/%v/writers/%v", uid, username)
+}
+
+// AddProjectAuditorProjectServicePath returns the URL path to the project-service service add-project-auditor HTTP endpoint.
+func AddProjectAuditorProjectServicePath(uid string, username string) string {
+	return fmt.Sprintf("/projects/%v/auditors/%v", uid, username)
+}
+
+// RemoveProjectAuditorProjectServicePath returns the URL path to the project-service service remove-project-auditor HTTP endpoint.
+func RemoveProjectAuditorProjectServicePath(uid string, username string) string {
+	return fmt.Sprintf("/projects/%v/auditors/%v", uid, username)
+}
+
+// JoinProjectProjectServicePath returns the URL path to the project-service service join-project HTTP endpoint.
+func JoinProjectProjectServicePath(uid string) string {
+	return fmt.Sprintf("/projects/%v/join", uid)
+}
+
+// BulkUpdateProjectMembersProjectServicePath returns the URL path to the project-service service bulk-update-project-members HTTP endpoint.
+func BulkUpdateProjectMembersProjectServicePath() string {
+	return "/projects/settings/bulk-update"
+}
+
 // GetProjectsProjectServicePath returns the URL path to the project-service service get-projects HTTP endpoint.
 func GetProjectsProjectServicePath() string {
 	return "/projects"
@@ -28,6 +178,11 @@ func GetOneProjectBaseProjectServicePath(uid string) string {
 	return fmt.Sprintf("/projects/%v", uid)
 }
 
+// GetProjectUIDFromLegacyIDProjectServicePath returns the URL path to the project-service service get-project-uid-from-legacy-id HTTP endpoint.
+func GetProjectUIDFromLegacyIDProjectServicePath(legacyID string) string {
+	return fmt.Sprintf("/projects/legacy/%v", legacyID)
+}
+
 // GetOneProjectSettingsProjectServicePath returns the URL path to the project-service service get-one-project-settings HTTP endpoint.
 func GetOneProjectSettingsProjectServicePath(uid string) string {
 	return fmt.Sprintf("/projects/%v/settings", uid)
@@ -48,6 +203,11 @@ func DeleteProjectProjectServicePath(uid string) string {
 	return fmt.Sprintf("/projects/%v", uid)
 }
 
+// BulkDeleteProjectsProjectServicePath returns the URL path to the project-service service bulk-delete-projects HTTP endpoint.
+func BulkDeleteProjectsProjectServicePath() string {
+	return "/projects/bulk"
+}
+
 // ReadyzProjectServicePath returns the URL path to the project-service service readyz HTTP endpoint.
 func ReadyzProjectServicePath() string {
 	return "/readyz"
@@ -58,52 +218,152 @@ func LivezProjectServicePath() string {
 	return "/livez"
 }
 
-// CreateProjectLinkProjectServicePath returns the URL path to the project-service service create-project-link HTTP endpoint.
-func CreateProjectLinkProjectServicePath(uid string) string {
-	return fmt.Sprintf("/projects/%v/links", uid)
+// HealthzProjectServicePath returns the URL path to the project-service service healthz HTTP endpoint.
+func HealthzProjectServicePath() string {
+	return "/healthz"
 }
 
-// GetProjectLinkProjectServicePath returns the URL path to the project-service service get-project-link HTTP endpoint.
-func GetProjectLinkProjectServicePath(uid string, linkUID string) string {
-	return fmt.Sprintf("/projects/%v/links/%v", uid, linkUID)
+// ReconcileOutboxProjectServicePath returns the URL path to the project-service service reconcile-outbox HTTP endpoint.
+func ReconcileOutboxProjectServicePath() string {
+	return "/admin/outbox/reconcile"
 }
 
-// DeleteProjectLinkProjectServicePath returns the URL path to the project-service service delete-project-link HTTP endpoint.
-func DeleteProjectLinkProjectServicePath(uid string, linkUID string) string {
-	return fmt.Sprintf("/projects/%v/links/%v", uid, linkUID)
+// ResyncProjectsProjectServicePath returns the URL path to the project-service service resync-projects HTTP endpoint.
+func ResyncProjectsProjectServicePath() string {
+	return "/admin/resync"
 }
 
-// CreateProjectFolderProjectServicePath returns the URL path to the project-service service create-project-folder HTTP endpoint.
-func CreateProjectFolderProjectServicePath(uid string) string {
-	return fmt.Sprintf("/projects/%v/folders", uid)
+// ListDeadLettersProjectServicePath returns the URL path to the project-service service list-dead-letters HTTP endpoint.
+func ListDeadLettersProjectServicePath() string {
+	return "/admin/dead-letters"
 }
 
-// GetProjectFolderProjectServicePath returns the URL path to the project-service service get-project-folder HTTP endpoint.
-func GetProjectFolderProjectServicePath(uid string, folderUID string) string {
-	return fmt.Sprintf("/projects/%v/folders/%v", uid, folderUID)
+// ReplayDeadLetterProjectServicePath returns the URL path to the project-service service replay-dead-letter HTTP endpoint.
+func ReplayDeadLetterProjectServicePath(id string) string {
+	return fmt.Sprintf("/admin/dead-letters/%v/replay", id)
 }
 
-// DeleteProjectFolderProjectServicePath returns the URL path to the project-service service delete-project-folder HTTP endpoint.
-func DeleteProjectFolderProjectServicePath(uid string, folderUID string) string {
-	return fmt.Sprintf("/projects/%v/folders/%v", uid, folderUID)
+// RebuildSlugMappingsProjectServicePath returns the URL path to the project-service service rebuild-slug-mappings HTTP endpoint.
+func RebuildSlugMappingsProjectServicePath() string {
+	return "/admin/slug-rebuild"
 }
 
-// UploadProjectDocumentProjectServicePath returns the URL path to the project-service service upload-project-document HTTP endpoint.
-func UploadProjectDocumentProjectServicePath(uid string) string {
-	return fmt.Sprintf("/projects/%v/documents", uid)
+// GetIntegrityReportProjectServicePath returns the URL path to the project-service service get-integrity-report HTTP endpoint.
+func GetIntegrityReportProjectServicePath() string {
+	return "/admin/integrity-report"
 }
 
-// GetProjectDocumentProjectServicePath returns the URL path to the project-service service get-project-document HTTP endpoint.
-func GetProjectDocumentProjectServicePath(uid string, documentUID string) string {
-	return fmt.Sprintf("/projects/%v/documents/%v", uid, documentUID)
+// InspectProjectKvProjectServicePath returns the URL path to the project-service service inspect-project-kv HTTP endpoint.
+func InspectProjectKvProjectServicePath(uid string) string {
+	return fmt.Sprintf("/admin/projects/%v/kv", uid)
 }
 
-// DownloadProjectDocumentProjectServicePath returns the URL path to the project-service service download-project-document HTTP endpoint.
-func DownloadProjectDocumentProjectServicePath(uid string, documentUID string) string {
-	return fmt.Sprintf("/projects/%v/documents/%v/download", uid, documentUID)
+// CreateBackupProjectServicePath returns the URL path to the project-service service create-backup HTTP endpoint.
+func CreateBackupProjectServicePath() string {
+	return "/admin/backups"
 }
 
-// DeleteProjectDocumentProjectServicePath returns the URL path to the project-service service delete-project-document HTTP endpoint.
-func DeleteProjectDocumentProjectServicePath(uid string, documentUID string) string {
-	return fmt.Sprintf("/projects/%v/documents/%v", uid, documentUID)
+// ListBackupsProjectServicePath returns the URL path to the project-service service list-backups HTTP endpoint.
+func ListBackupsProjectServicePath() string {
+	return "/admin/backups"
+}
+
+// RestoreBackupProjectServicePath returns the URL path to the project-service service restore-backup HTTP endpoint.
+func RestoreBackupProjectServicePath(name string) string {
+	return fmt.Sprintf("/admin/backups/%v/restore", name)
+}
+
+// ListPendingAnnouncementsProjectServicePath returns the URL path to the project-service service list-pending-announcements HTTP endpoint.
+func ListPendingAnnouncementsProjectServicePath() string {
+	return "/admin/announcements"
+}
+
+// GetProjectEnumsProjectServicePath returns the URL path to the project-service service get-project-enums HTTP endpoint.
+func GetProjectEnumsProjectServicePath() string {
+	return "/metadata/enums"
+}
+
+// UpdateProjectEnumsProjectServicePath returns the URL path to the project-service service update-project-enums HTTP endpoint.
+func UpdateProjectEnumsProjectServicePath() string {
+	return "/admin/metadata/enums"
+}
+
+// CreateProjectTemplateProjectServicePath returns the URL path to the project-service service create-project-template HTTP endpoint.
+func CreateProjectTemplateProjectServicePath() string {
+	return "/admin/project-templates"
+}
+
+// ListProjectTemplatesProjectServicePath returns the URL path to the project-service service list-project-templates HTTP endpoint.
+func ListProjectTemplatesProjectServicePath() string {
+	return "/admin/project-templates"
+}
+
+// GetProjectTemplateProjectServicePath returns the URL path to the project-service service get-project-template HTTP endpoint.
+func GetProjectTemplateProjectServicePath(name string) string {
+	return fmt.Sprintf("/admin/project-templates/%v", name)
+}
+
+// UpdateProjectTemplateProjectServicePath returns the URL path to the project-service service update-project-template HTTP endpoint.
+func UpdateProjectTemplateProjectServicePath(name string) string {
+	return fmt.Sprintf("/admin/project-templates/%v", name)
+}
+
+// DeleteProjectTemplateProjectServicePath returns the URL path to the project-service service delete-project-template HTTP endpoint.
+func DeleteProjectTemplateProjectServicePath(name string) string {
+	return fmt.Sprintf("/admin/project-templates/%v", name)
+}
+
+// ListProjectDeletionsProjectServicePath returns the URL path to the project-service service list-project-deletions HTTP endpoint.
+func ListProjectDeletionsProjectServicePath() string {
+	return "/projects/deletions"
+}
+
+// ListProjectEventsProjectServicePath returns the URL path to the project-service service list-project-events HTTP endpoint.
+func ListProjectEventsProjectServicePath(uid string) string {
+	return fmt.Sprintf("/projects/%v/events", uid)
+}
+
+// GetUserProjectsProjectServicePath returns the URL path to the project-service service get-user-projects HTTP endpoint.
+func GetUserProjectsProjectServicePath(username string) string {
+	return fmt.Sprintf("/users/%v/projects", username)
+}
+
+// ExportUserDataProjectServicePath returns the URL path to the project-service service export-user-data HTTP endpoint.
+func ExportUserDataProjectServicePath(username string) string {
+	return fmt.Sprintf("/users/%v/data-export", username)
+}
+
+// RemoveUserAccessProjectServicePath returns the URL path to the project-service service remove-user-access HTTP endpoint.
+func RemoveUserAccessProjectServicePath(username string) string {
+	return fmt.Sprintf("/users/%v/access", username)
+}
+
+// GetProjectVisibilityImpactProjectServicePath returns the URL path to the project-service service get-project-visibility-impact HTTP endpoint.
+func GetProjectVisibilityImpactProjectServicePath(uid string) string {
+	return fmt.Sprintf("/projects/%v/visibility-impact", uid)
+}
+
+// CreateWebhookProjectServicePath returns the URL path to the project-service service create-webhook HTTP endpoint.
+func CreateWebhookProjectServicePath() string {
+	return "/admin/webhooks"
+}
+
+// ListWebhooksProjectServicePath returns the URL path to the project-service service list-webhooks HTTP endpoint.
+func ListWebhooksProjectServicePath() string {
+	return "/admin/webhooks"
+}
+
+// GetWebhookProjectServicePath returns the URL path to the project-service service get-webhook HTTP endpoint.
+func GetWebhookProjectServicePath(id string) string {
+	return fmt.Sprintf("/admin/webhooks/%v", id)
+}
+
+// DeleteWebhookProjectServicePath returns the URL path to the project-service service delete-webhook HTTP endpoint.
+func DeleteWebhookProjectServicePath(id string) string {
+	return fmt.Sprintf("/admin/webhooks/%v", id)
+}
+
+// ListWebhookDeliveriesProjectServicePath returns the URL path to the project-service service list-webhook-deliveries HTTP endpoint.
+func ListWebhookDeliveriesProjectServicePath(id string) string {
+	return fmt.Sprintf("/admin/webhooks/%v/deliveries", id)
 }
@@ -16,36 +16,59 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	projectservice "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
 )
 
-// EncodeGetProjectsResponse returns an encoder for responses returned by the
-// project-service get-projects endpoint.
-func EncodeGetProjectsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeCloneProjectResponse returns an encoder for responses returned by the
+// project-service clone-project endpoint.
+func EncodeCloneProjectResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*projectservice.GetProjectsResult)
+		res, _ := v.(*projectservice.ProjectFull)
 		enc := encoder(ctx, w)
-		body := NewGetProjectsResponseBody(res)
-		if res.CacheControl != nil {
-			w.Header().Set("Cache-Control", *res.CacheControl)
-		}
-		w.WriteHeader(http.StatusOK)
+		body := NewCloneProjectResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetProjectsRequest returns a decoder for requests sent to the
-// project-service get-projects endpoint.
-func DecodeGetProjectsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetProjectsPayload, error) {
-	return func(r *http.Request) (*projectservice.GetProjectsPayload, error) {
+// DecodeCloneProjectRequest returns a decoder for requests sent to the
+// project-service clone-project endpoint.
+func DecodeCloneProjectRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.CloneProjectPayload, error) {
+	return func(r *http.Request) (*projectservice.CloneProjectPayload, error) {
+		var (
+			body CloneProjectRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCloneProjectRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
 		var (
+			uid         string
 			version     *string
 			bearerToken *string
-			err         error
+			xSync       *bool
+
+			params = mux.Vars(r)
 		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -59,10 +82,20 @@ func DecodeGetProjectsRequest(mux goahttp.Muxer, decoder func(*http.Request) goa
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
+		{
+			xSyncRaw := r.Header.Get("X-Sync")
+			if xSyncRaw != "" {
+				v, err2 := strconv.ParseBool(xSyncRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+				}
+				xSync = &v
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewGetProjectsPayload(version, bearerToken)
+		payload := NewCloneProjectPayload(&body, uid, version, bearerToken, xSync)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -75,9 +108,9 @@ func DecodeGetProjectsRequest(mux goahttp.Muxer, decoder func(*http.Request) goa
 	}
 }
 
-// EncodeGetProjectsError returns an encoder for errors returned by the
-// get-projects project-service endpoint.
-func EncodeGetProjectsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeCloneProjectError returns an encoder for errors returned by the
+// clone-project project-service endpoint.
+func EncodeCloneProjectError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -88,116 +121,131 @@ func EncodeGetProjectsError(encoder func(context.Context, http.ResponseWriter) g
 		case "BadRequest":
 			var res *projectservice.BadRequestError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetProjectsBadRequestResponseBody(res)
+				body = NewCloneProjectBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
+		case "Conflict":
+			var res *projectservice.ConflictError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCloneProjectConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCloneProjectForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
 		case "InternalServerError":
 			var res *projectservice.InternalServerError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetProjectsInternalServerErrorResponseBody(res)
+				body = NewCloneProjectInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCloneProjectNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
 		case "ServiceUnavailable":
 			var res *projectservice.ServiceUnavailableError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetProjectsServiceUnavailableResponseBody(res)
+				body = NewCloneProjectServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
 			return enc.Encode(body)
+		case "UnprocessableEntity":
+			var res *projectservice.UnprocessableEntityError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCloneProjectUnprocessableEntityResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
 	}
 }
 
-// EncodeCreateProjectResponse returns an encoder for responses returned by the
-// project-service create-project endpoint.
-func EncodeCreateProjectResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeUploadProjectLogoResponse returns an encoder for responses returned by
+// the project-service upload-project-logo endpoint.
+func EncodeUploadProjectLogoResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*projectservice.ProjectFull)
+		res, _ := v.(*projectservice.ProjectBase)
 		enc := encoder(ctx, w)
-		body := NewCreateProjectResponseBody(res)
-		w.WriteHeader(http.StatusCreated)
+		body := NewUploadProjectLogoResponseBody(res)
+		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeCreateProjectRequest returns a decoder for requests sent to the
-// project-service create-project endpoint.
-func DecodeCreateProjectRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.CreateProjectPayload, error) {
-	return func(r *http.Request) (*projectservice.CreateProjectPayload, error) {
-		var (
-			body CreateProjectRequestBody
-			err  error
-		)
-		err = decoder(r).Decode(&body)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return nil, goa.MissingPayloadError()
-			}
+// DecodeUploadProjectLogoRequest returns a decoder for requests sent to the
+// project-service upload-project-logo endpoint.
+func DecodeUploadProjectLogoRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.UploadProjectLogoPayload, error) {
+	return func(r *http.Request) (*projectservice.UploadProjectLogoPayload, error) {
+		var payload *projectservice.UploadProjectLogoPayload
+		if err := decoder(r).Decode(&payload); err != nil {
 			var gerr *goa.ServiceError
 			if errors.As(err, &gerr) {
 				return nil, gerr
 			}
 			return nil, goa.DecodePayloadError(err.Error())
 		}
-		err = ValidateCreateProjectRequestBody(&body)
-		if err != nil {
-			return nil, err
-		}
-
-		var (
-			version     *string
-			bearerToken *string
-			xSync       *bool
-		)
-		versionRaw := r.URL.Query().Get("v")
-		if versionRaw != "" {
-			version = &versionRaw
-		}
-		if version != nil {
-			if !(*version == "1") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
-			}
-		}
-		bearerTokenRaw := r.Header.Get("Authorization")
-		if bearerTokenRaw != "" {
-			bearerToken = &bearerTokenRaw
-		}
-		{
-			xSyncRaw := r.Header.Get("X-Sync")
-			if xSyncRaw != "" {
-				v, err2 := strconv.ParseBool(xSyncRaw)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
-				}
-				xSync = &v
-			}
-		}
-		if err != nil {
-			return nil, err
-		}
-		payload := NewCreateProjectPayload(&body, version, bearerToken, xSync)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -210,9 +258,70 @@ func DecodeCreateProjectRequest(mux goahttp.Muxer, decoder func(*http.Request) g
 	}
 }
 
-// EncodeCreateProjectError returns an encoder for errors returned by the
-// create-project project-service endpoint.
-func EncodeCreateProjectError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// NewProjectServiceUploadProjectLogoDecoder returns a decoder to decode the
+// multipart request for the "project-service" service "upload-project-logo"
+// endpoint.
+func NewProjectServiceUploadProjectLogoDecoder(mux goahttp.Muxer, projectServiceUploadProjectLogoDecoderFn ProjectServiceUploadProjectLogoDecoderFunc) func(r *http.Request) goahttp.Decoder {
+	return func(r *http.Request) goahttp.Decoder {
+		return goahttp.EncodingFunc(func(v any) error {
+			mr, merr := r.MultipartReader()
+			if merr != nil {
+				return merr
+			}
+			p := v.(**projectservice.UploadProjectLogoPayload)
+			if err := projectServiceUploadProjectLogoDecoderFn(mr, p); err != nil {
+				return err
+			}
+
+			var (
+				uid         string
+				version     *string
+				bearerToken *string
+				xSync       *bool
+				err         error
+
+				params = mux.Vars(r)
+			)
+			uid = params["uid"]
+			err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+			versionRaw := r.URL.Query().Get("v")
+			if versionRaw != "" {
+				version = &versionRaw
+			}
+			if version != nil {
+				if !(*version == "1") {
+					err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+				}
+			}
+			bearerTokenRaw := r.Header.Get("Authorization")
+			if bearerTokenRaw != "" {
+				bearerToken = &bearerTokenRaw
+			}
+			{
+				xSyncRaw := r.Header.Get("X-Sync")
+				if xSyncRaw != "" {
+					v, err2 := strconv.ParseBool(xSyncRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+					}
+					xSync = &v
+				}
+			}
+			if err != nil {
+				return err
+			}
+			(*p).UID = uid
+			(*p).Version = version
+			(*p).BearerToken = bearerToken
+			(*p).XSync = xSync
+			return nil
+		})
+	}
+}
+
+// EncodeUploadProjectLogoError returns an encoder for errors returned by the
+// upload-project-logo project-service endpoint.
+func EncodeUploadProjectLogoError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -223,51 +332,69 @@ func EncodeCreateProjectError(encoder func(context.Context, http.ResponseWriter)
 		case "BadRequest":
 			var res *projectservice.BadRequestError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateProjectBadRequestResponseBody(res)
+				body = NewUploadProjectLogoBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
-		case "Conflict":
-			var res *projectservice.ConflictError
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateProjectConflictResponseBody(res)
+				body = NewUploadProjectLogoForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusConflict)
+			w.WriteHeader(http.StatusForbidden)
 			return enc.Encode(body)
 		case "InternalServerError":
 			var res *projectservice.InternalServerError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateProjectInternalServerErrorResponseBody(res)
+				body = NewUploadProjectLogoInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUploadProjectLogoNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
 		case "ServiceUnavailable":
 			var res *projectservice.ServiceUnavailableError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateProjectServiceUnavailableResponseBody(res)
+				body = NewUploadProjectLogoServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -278,35 +405,38 @@ func EncodeCreateProjectError(encoder func(context.Context, http.ResponseWriter)
 	}
 }
 
-// EncodeGetOneProjectBaseResponse returns an encoder for responses returned by
-// the project-service get-one-project-base endpoint.
-func EncodeGetOneProjectBaseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeMergeProjectResponse returns an encoder for responses returned by the
+// project-service merge-project endpoint.
+func EncodeMergeProjectResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*projectservice.GetOneProjectBaseResult)
+		res, _ := v.(*projectservice.ProjectFull)
 		enc := encoder(ctx, w)
-		body := NewGetOneProjectBaseResponseBody(res)
-		if res.Etag != nil {
-			w.Header().Set("Etag", *res.Etag)
-		}
+		body := NewMergeProjectResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetOneProjectBaseRequest returns a decoder for requests sent to the
-// project-service get-one-project-base endpoint.
-func DecodeGetOneProjectBaseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetOneProjectBasePayload, error) {
-	return func(r *http.Request) (*projectservice.GetOneProjectBasePayload, error) {
+// DecodeMergeProjectRequest returns a decoder for requests sent to the
+// project-service merge-project endpoint.
+func DecodeMergeProjectRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.MergeProjectPayload, error) {
+	return func(r *http.Request) (*projectservice.MergeProjectPayload, error) {
 		var (
 			uid         string
+			targetUID   string
 			version     *string
 			bearerToken *string
+			xSync       *bool
+			ifMatch     *string
+			forceUpdate *bool
 			err         error
 
 			params = mux.Vars(r)
 		)
 		uid = params["uid"]
 		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		targetUID = params["target_uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("target_uid", targetUID, goa.FormatUUID))
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -320,15 +450,39 @@ func DecodeGetOneProjectBaseRequest(mux goahttp.Muxer, decoder func(*http.Reques
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
-		if err != nil {
-			return nil, err
-		}
-		payload := NewGetOneProjectBasePayload(uid, version, bearerToken)
-		if payload.BearerToken != nil {
-			if strings.Contains(*payload.BearerToken, " ") {
-				// Remove authorization scheme prefix (e.g. "Bearer")
-				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
-				payload.BearerToken = &cred
+		{
+			xSyncRaw := r.Header.Get("X-Sync")
+			if xSyncRaw != "" {
+				v, err2 := strconv.ParseBool(xSyncRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+				}
+				xSync = &v
+			}
+		}
+		ifMatchRaw := r.Header.Get("If-Match")
+		if ifMatchRaw != "" {
+			ifMatch = &ifMatchRaw
+		}
+		{
+			forceUpdateRaw := r.Header.Get("X-Force-Update")
+			if forceUpdateRaw != "" {
+				v, err2 := strconv.ParseBool(forceUpdateRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("force_update", forceUpdateRaw, "boolean"))
+				}
+				forceUpdate = &v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewMergeProjectPayload(uid, targetUID, version, bearerToken, xSync, ifMatch, forceUpdate)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
 			}
 		}
 
@@ -336,9 +490,9 @@ func DecodeGetOneProjectBaseRequest(mux goahttp.Muxer, decoder func(*http.Reques
 	}
 }
 
-// EncodeGetOneProjectBaseError returns an encoder for errors returned by the
-// get-one-project-base project-service endpoint.
-func EncodeGetOneProjectBaseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeMergeProjectError returns an encoder for errors returned by the
+// merge-project project-service endpoint.
+func EncodeMergeProjectError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -346,128 +500,58 @@ func EncodeGetOneProjectBaseError(encoder func(context.Context, http.ResponseWri
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "InternalServerError":
-			var res *projectservice.InternalServerError
+		case "BadRequest":
+			var res *projectservice.BadRequestError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetOneProjectBaseInternalServerErrorResponseBody(res)
+				body = NewMergeProjectBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusInternalServerError)
+			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
-		case "NotFound":
-			var res *projectservice.NotFoundError
+		case "Conflict":
+			var res *projectservice.ConflictError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetOneProjectBaseNotFoundResponseBody(res)
+				body = NewMergeProjectConflictResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
+			w.WriteHeader(http.StatusConflict)
 			return enc.Encode(body)
-		case "ServiceUnavailable":
-			var res *projectservice.ServiceUnavailableError
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetOneProjectBaseServiceUnavailableResponseBody(res)
+				body = NewMergeProjectForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusServiceUnavailable)
+			w.WriteHeader(http.StatusForbidden)
 			return enc.Encode(body)
-		default:
-			return encodeError(ctx, w, v)
-		}
-	}
-}
-
-// EncodeGetOneProjectSettingsResponse returns an encoder for responses
-// returned by the project-service get-one-project-settings endpoint.
-func EncodeGetOneProjectSettingsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
-	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*projectservice.GetOneProjectSettingsResult)
-		enc := encoder(ctx, w)
-		body := NewGetOneProjectSettingsResponseBody(res)
-		if res.Etag != nil {
-			w.Header().Set("Etag", *res.Etag)
-		}
-		w.WriteHeader(http.StatusOK)
-		return enc.Encode(body)
-	}
-}
-
-// DecodeGetOneProjectSettingsRequest returns a decoder for requests sent to
-// the project-service get-one-project-settings endpoint.
-func DecodeGetOneProjectSettingsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetOneProjectSettingsPayload, error) {
-	return func(r *http.Request) (*projectservice.GetOneProjectSettingsPayload, error) {
-		var (
-			uid         string
-			version     *string
-			bearerToken *string
-			err         error
-
-			params = mux.Vars(r)
-		)
-		uid = params["uid"]
-		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
-		versionRaw := r.URL.Query().Get("v")
-		if versionRaw != "" {
-			version = &versionRaw
-		}
-		if version != nil {
-			if !(*version == "1") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
-			}
-		}
-		bearerTokenRaw := r.Header.Get("Authorization")
-		if bearerTokenRaw != "" {
-			bearerToken = &bearerTokenRaw
-		}
-		if err != nil {
-			return nil, err
-		}
-		payload := NewGetOneProjectSettingsPayload(uid, version, bearerToken)
-		if payload.BearerToken != nil {
-			if strings.Contains(*payload.BearerToken, " ") {
-				// Remove authorization scheme prefix (e.g. "Bearer")
-				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
-				payload.BearerToken = &cred
-			}
-		}
-
-		return payload, nil
-	}
-}
-
-// EncodeGetOneProjectSettingsError returns an encoder for errors returned by
-// the get-one-project-settings project-service endpoint.
-func EncodeGetOneProjectSettingsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
-	encodeError := goahttp.ErrorEncoder(encoder, formatter)
-	return func(ctx context.Context, w http.ResponseWriter, v error) error {
-		var en goa.GoaErrorNamer
-		if !errors.As(v, &en) {
-			return encodeError(ctx, w, v)
-		}
-		switch en.GoaErrorName() {
 		case "InternalServerError":
 			var res *projectservice.InternalServerError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetOneProjectSettingsInternalServerErrorResponseBody(res)
+				body = NewMergeProjectInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -475,12 +559,13 @@ func EncodeGetOneProjectSettingsError(encoder func(context.Context, http.Respons
 		case "NotFound":
 			var res *projectservice.NotFoundError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetOneProjectSettingsNotFoundResponseBody(res)
+				body = NewMergeProjectNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -488,40 +573,55 @@ func EncodeGetOneProjectSettingsError(encoder func(context.Context, http.Respons
 		case "ServiceUnavailable":
 			var res *projectservice.ServiceUnavailableError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetOneProjectSettingsServiceUnavailableResponseBody(res)
+				body = NewMergeProjectServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
 			return enc.Encode(body)
+		case "UnprocessableEntity":
+			var res *projectservice.UnprocessableEntityError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewMergeProjectUnprocessableEntityResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
 	}
 }
 
-// EncodeUpdateProjectBaseResponse returns an encoder for responses returned by
-// the project-service update-project-base endpoint.
-func EncodeUpdateProjectBaseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeTransferProjectResponse returns an encoder for responses returned by
+// the project-service transfer-project endpoint.
+func EncodeTransferProjectResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*projectservice.ProjectBase)
+		res, _ := v.(*projectservice.ProjectFull)
 		enc := encoder(ctx, w)
-		body := NewUpdateProjectBaseResponseBody(res)
+		body := NewTransferProjectResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeUpdateProjectBaseRequest returns a decoder for requests sent to the
-// project-service update-project-base endpoint.
-func DecodeUpdateProjectBaseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.UpdateProjectBasePayload, error) {
-	return func(r *http.Request) (*projectservice.UpdateProjectBasePayload, error) {
+// DecodeTransferProjectRequest returns a decoder for requests sent to the
+// project-service transfer-project endpoint.
+func DecodeTransferProjectRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.TransferProjectPayload, error) {
+	return func(r *http.Request) (*projectservice.TransferProjectPayload, error) {
 		var (
-			body UpdateProjectBaseRequestBody
+			body TransferProjectRequestBody
 			err  error
 		)
 		err = decoder(r).Decode(&body)
@@ -535,7 +635,7 @@ func DecodeUpdateProjectBaseRequest(mux goahttp.Muxer, decoder func(*http.Reques
 			}
 			return nil, goa.DecodePayloadError(err.Error())
 		}
-		err = ValidateUpdateProjectBaseRequestBody(&body)
+		err = ValidateTransferProjectRequestBody(&body)
 		if err != nil {
 			return nil, err
 		}
@@ -546,6 +646,7 @@ func DecodeUpdateProjectBaseRequest(mux goahttp.Muxer, decoder func(*http.Reques
 			bearerToken *string
 			xSync       *bool
 			ifMatch     *string
+			forceUpdate *bool
 
 			params = mux.Vars(r)
 		)
@@ -578,10 +679,20 @@ func DecodeUpdateProjectBaseRequest(mux goahttp.Muxer, decoder func(*http.Reques
 		if ifMatchRaw != "" {
 			ifMatch = &ifMatchRaw
 		}
+		{
+			forceUpdateRaw := r.Header.Get("X-Force-Update")
+			if forceUpdateRaw != "" {
+				v, err2 := strconv.ParseBool(forceUpdateRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("force_update", forceUpdateRaw, "boolean"))
+				}
+				forceUpdate = &v
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewUpdateProjectBasePayload(&body, uid, version, bearerToken, xSync, ifMatch)
+		payload := NewTransferProjectPayload(&body, uid, version, bearerToken, xSync, ifMatch, forceUpdate)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -594,9 +705,9 @@ func DecodeUpdateProjectBaseRequest(mux goahttp.Muxer, decoder func(*http.Reques
 	}
 }
 
-// EncodeUpdateProjectBaseError returns an encoder for errors returned by the
-// update-project-base project-service endpoint.
-func EncodeUpdateProjectBaseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeTransferProjectError returns an encoder for errors returned by the
+// transfer-project project-service endpoint.
+func EncodeTransferProjectError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -607,12 +718,13 @@ func EncodeUpdateProjectBaseError(encoder func(context.Context, http.ResponseWri
 		case "BadRequest":
 			var res *projectservice.BadRequestError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateProjectBaseBadRequestResponseBody(res)
+				body = NewTransferProjectBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -620,25 +732,41 @@ func EncodeUpdateProjectBaseError(encoder func(context.Context, http.ResponseWri
 		case "Conflict":
 			var res *projectservice.ConflictError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateProjectBaseConflictResponseBody(res)
+				body = NewTransferProjectConflictResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
 			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewTransferProjectForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
 		case "InternalServerError":
 			var res *projectservice.InternalServerError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateProjectBaseInternalServerErrorResponseBody(res)
+				body = NewTransferProjectInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -646,12 +774,13 @@ func EncodeUpdateProjectBaseError(encoder func(context.Context, http.ResponseWri
 		case "NotFound":
 			var res *projectservice.NotFoundError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateProjectBaseNotFoundResponseBody(res)
+				body = NewTransferProjectNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -659,40 +788,55 @@ func EncodeUpdateProjectBaseError(encoder func(context.Context, http.ResponseWri
 		case "ServiceUnavailable":
 			var res *projectservice.ServiceUnavailableError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateProjectBaseServiceUnavailableResponseBody(res)
+				body = NewTransferProjectServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
 			return enc.Encode(body)
+		case "UnprocessableEntity":
+			var res *projectservice.UnprocessableEntityError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewTransferProjectUnprocessableEntityResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
 	}
 }
 
-// EncodeUpdateProjectSettingsResponse returns an encoder for responses
-// returned by the project-service update-project-settings endpoint.
-func EncodeUpdateProjectSettingsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeCreateProjectLinkResponse returns an encoder for responses returned by
+// the project-service create-project-link endpoint.
+func EncodeCreateProjectLinkResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*projectservice.ProjectSettings)
+		res, _ := v.(*projectservice.ProjectLink)
 		enc := encoder(ctx, w)
-		body := NewUpdateProjectSettingsResponseBody(res)
-		w.WriteHeader(http.StatusOK)
+		body := NewCreateProjectLinkResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeUpdateProjectSettingsRequest returns a decoder for requests sent to
-// the project-service update-project-settings endpoint.
-func DecodeUpdateProjectSettingsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.UpdateProjectSettingsPayload, error) {
-	return func(r *http.Request) (*projectservice.UpdateProjectSettingsPayload, error) {
+// DecodeCreateProjectLinkRequest returns a decoder for requests sent to the
+// project-service create-project-link endpoint.
+func DecodeCreateProjectLinkRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.CreateProjectLinkPayload, error) {
+	return func(r *http.Request) (*projectservice.CreateProjectLinkPayload, error) {
 		var (
-			body UpdateProjectSettingsRequestBody
+			body CreateProjectLinkRequestBody
 			err  error
 		)
 		err = decoder(r).Decode(&body)
@@ -706,7 +850,7 @@ func DecodeUpdateProjectSettingsRequest(mux goahttp.Muxer, decoder func(*http.Re
 			}
 			return nil, goa.DecodePayloadError(err.Error())
 		}
-		err = ValidateUpdateProjectSettingsRequestBody(&body)
+		err = ValidateCreateProjectLinkRequestBody(&body)
 		if err != nil {
 			return nil, err
 		}
@@ -716,7 +860,6 @@ func DecodeUpdateProjectSettingsRequest(mux goahttp.Muxer, decoder func(*http.Re
 			version     *string
 			bearerToken *string
 			xSync       *bool
-			ifMatch     *string
 
 			params = mux.Vars(r)
 		)
@@ -745,14 +888,10 @@ func DecodeUpdateProjectSettingsRequest(mux goahttp.Muxer, decoder func(*http.Re
 				xSync = &v
 			}
 		}
-		ifMatchRaw := r.Header.Get("If-Match")
-		if ifMatchRaw != "" {
-			ifMatch = &ifMatchRaw
-		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewUpdateProjectSettingsPayload(&body, uid, version, bearerToken, xSync, ifMatch)
+		payload := NewCreateProjectLinkPayload(&body, uid, version, bearerToken, xSync)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -765,9 +904,9 @@ func DecodeUpdateProjectSettingsRequest(mux goahttp.Muxer, decoder func(*http.Re
 	}
 }
 
-// EncodeUpdateProjectSettingsError returns an encoder for errors returned by
-// the update-project-settings project-service endpoint.
-func EncodeUpdateProjectSettingsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeCreateProjectLinkError returns an encoder for errors returned by the
+// create-project-link project-service endpoint.
+func EncodeCreateProjectLinkError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -778,38 +917,41 @@ func EncodeUpdateProjectSettingsError(encoder func(context.Context, http.Respons
 		case "BadRequest":
 			var res *projectservice.BadRequestError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateProjectSettingsBadRequestResponseBody(res)
+				body = NewCreateProjectLinkBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
-		case "Conflict":
-			var res *projectservice.ConflictError
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateProjectSettingsConflictResponseBody(res)
+				body = NewCreateProjectLinkForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusConflict)
+			w.WriteHeader(http.StatusForbidden)
 			return enc.Encode(body)
 		case "InternalServerError":
 			var res *projectservice.InternalServerError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateProjectSettingsInternalServerErrorResponseBody(res)
+				body = NewCreateProjectLinkInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -817,12 +959,13 @@ func EncodeUpdateProjectSettingsError(encoder func(context.Context, http.Respons
 		case "NotFound":
 			var res *projectservice.NotFoundError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateProjectSettingsNotFoundResponseBody(res)
+				body = NewCreateProjectLinkNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -830,12 +973,13 @@ func EncodeUpdateProjectSettingsError(encoder func(context.Context, http.Respons
 		case "ServiceUnavailable":
 			var res *projectservice.ServiceUnavailableError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewUpdateProjectSettingsServiceUnavailableResponseBody(res)
+				body = NewCreateProjectLinkServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -846,31 +990,38 @@ func EncodeUpdateProjectSettingsError(encoder func(context.Context, http.Respons
 	}
 }
 
-// EncodeDeleteProjectResponse returns an encoder for responses returned by the
-// project-service delete-project endpoint.
-func EncodeDeleteProjectResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeGetProjectLinkResponse returns an encoder for responses returned by
+// the project-service get-project-link endpoint.
+func EncodeGetProjectLinkResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		w.WriteHeader(http.StatusNoContent)
-		return nil
+		res, _ := v.(*projectservice.GetProjectLinkResult)
+		enc := encoder(ctx, w)
+		body := NewGetProjectLinkResponseBody(res)
+		if res.Etag != nil {
+			w.Header().Set("Etag", *res.Etag)
+		}
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
 	}
 }
 
-// DecodeDeleteProjectRequest returns a decoder for requests sent to the
-// project-service delete-project endpoint.
-func DecodeDeleteProjectRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.DeleteProjectPayload, error) {
-	return func(r *http.Request) (*projectservice.DeleteProjectPayload, error) {
+// DecodeGetProjectLinkRequest returns a decoder for requests sent to the
+// project-service get-project-link endpoint.
+func DecodeGetProjectLinkRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetProjectLinkPayload, error) {
+	return func(r *http.Request) (*projectservice.GetProjectLinkPayload, error) {
 		var (
 			uid         string
+			linkUID     string
 			version     *string
 			bearerToken *string
-			xSync       *bool
-			ifMatch     *string
 			err         error
 
 			params = mux.Vars(r)
 		)
 		uid = params["uid"]
 		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		linkUID = params["link_uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("link_uid", linkUID, goa.FormatUUID))
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -884,24 +1035,10 @@ func DecodeDeleteProjectRequest(mux goahttp.Muxer, decoder func(*http.Request) g
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
-		{
-			xSyncRaw := r.Header.Get("X-Sync")
-			if xSyncRaw != "" {
-				v, err2 := strconv.ParseBool(xSyncRaw)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
-				}
-				xSync = &v
-			}
-		}
-		ifMatchRaw := r.Header.Get("If-Match")
-		if ifMatchRaw != "" {
-			ifMatch = &ifMatchRaw
-		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewDeleteProjectPayload(uid, version, bearerToken, xSync, ifMatch)
+		payload := NewGetProjectLinkPayload(uid, linkUID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -914,9 +1051,9 @@ func DecodeDeleteProjectRequest(mux goahttp.Muxer, decoder func(*http.Request) g
 	}
 }
 
-// EncodeDeleteProjectError returns an encoder for errors returned by the
-// delete-project project-service endpoint.
-func EncodeDeleteProjectError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetProjectLinkError returns an encoder for errors returned by the
+// get-project-link project-service endpoint.
+func EncodeGetProjectLinkError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -924,41 +1061,30 @@ func EncodeDeleteProjectError(encoder func(context.Context, http.ResponseWriter)
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "BadRequest":
-			var res *projectservice.BadRequestError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewDeleteProjectBadRequestResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusBadRequest)
-			return enc.Encode(body)
-		case "Conflict":
-			var res *projectservice.ConflictError
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteProjectConflictResponseBody(res)
+				body = NewGetProjectLinkForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusConflict)
+			w.WriteHeader(http.StatusForbidden)
 			return enc.Encode(body)
 		case "InternalServerError":
 			var res *projectservice.InternalServerError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteProjectInternalServerErrorResponseBody(res)
+				body = NewGetProjectLinkInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -966,12 +1092,13 @@ func EncodeDeleteProjectError(encoder func(context.Context, http.ResponseWriter)
 		case "NotFound":
 			var res *projectservice.NotFoundError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteProjectNotFoundResponseBody(res)
+				body = NewGetProjectLinkNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -979,54 +1106,13 @@ func EncodeDeleteProjectError(encoder func(context.Context, http.ResponseWriter)
 		case "ServiceUnavailable":
 			var res *projectservice.ServiceUnavailableError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteProjectServiceUnavailableResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusServiceUnavailable)
-			return enc.Encode(body)
-		default:
-			return encodeError(ctx, w, v)
-		}
-	}
-}
-
-// EncodeReadyzResponse returns an encoder for responses returned by the
-// project-service readyz endpoint.
-func EncodeReadyzResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
-	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.([]byte)
-		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "text/plain")
-		enc := encoder(ctx, w)
-		body := res
-		w.WriteHeader(http.StatusOK)
-		return enc.Encode(body)
-	}
-}
-
-// EncodeReadyzError returns an encoder for errors returned by the readyz
-// project-service endpoint.
-func EncodeReadyzError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
-	encodeError := goahttp.ErrorEncoder(encoder, formatter)
-	return func(ctx context.Context, w http.ResponseWriter, v error) error {
-		var en goa.GoaErrorNamer
-		if !errors.As(v, &en) {
-			return encodeError(ctx, w, v)
-		}
-		switch en.GoaErrorName() {
-		case "ServiceUnavailable":
-			var res *projectservice.ServiceUnavailableError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewReadyzServiceUnavailableResponseBody(res)
+				body = NewGetProjectLinkServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1037,65 +1123,35 @@ func EncodeReadyzError(encoder func(context.Context, http.ResponseWriter) goahtt
 	}
 }
 
-// EncodeLivezResponse returns an encoder for responses returned by the
-// project-service livez endpoint.
-func EncodeLivezResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
-	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.([]byte)
-		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "text/plain")
-		enc := encoder(ctx, w)
-		body := res
-		w.WriteHeader(http.StatusOK)
-		return enc.Encode(body)
-	}
-}
-
-// EncodeCreateProjectLinkResponse returns an encoder for responses returned by
-// the project-service create-project-link endpoint.
-func EncodeCreateProjectLinkResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeDeleteProjectLinkResponse returns an encoder for responses returned by
+// the project-service delete-project-link endpoint.
+func EncodeDeleteProjectLinkResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*projectservice.ProjectLink)
-		enc := encoder(ctx, w)
-		body := NewCreateProjectLinkResponseBody(res)
-		w.WriteHeader(http.StatusCreated)
-		return enc.Encode(body)
+		w.WriteHeader(http.StatusNoContent)
+		return nil
 	}
 }
 
-// DecodeCreateProjectLinkRequest returns a decoder for requests sent to the
-// project-service create-project-link endpoint.
-func DecodeCreateProjectLinkRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.CreateProjectLinkPayload, error) {
-	return func(r *http.Request) (*projectservice.CreateProjectLinkPayload, error) {
-		var (
-			body CreateProjectLinkRequestBody
-			err  error
-		)
-		err = decoder(r).Decode(&body)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return nil, goa.MissingPayloadError()
-			}
-			var gerr *goa.ServiceError
-			if errors.As(err, &gerr) {
-				return nil, gerr
-			}
-			return nil, goa.DecodePayloadError(err.Error())
-		}
-		err = ValidateCreateProjectLinkRequestBody(&body)
-		if err != nil {
-			return nil, err
-		}
-
+// DecodeDeleteProjectLinkRequest returns a decoder for requests sent to the
+// project-service delete-project-link endpoint.
+func DecodeDeleteProjectLinkRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.DeleteProjectLinkPayload, error) {
+	return func(r *http.Request) (*projectservice.DeleteProjectLinkPayload, error) {
 		var (
 			uid         string
+			linkUID     string
 			version     *string
 			bearerToken *string
 			xSync       *bool
+			ifMatch     *string
+			forceUpdate *bool
+			err         error
 
 			params = mux.Vars(r)
 		)
 		uid = params["uid"]
 		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		linkUID = params["link_uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("link_uid", linkUID, goa.FormatUUID))
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -1119,10 +1175,24 @@ func DecodeCreateProjectLinkRequest(mux goahttp.Muxer, decoder func(*http.Reques
 				xSync = &v
 			}
 		}
+		ifMatchRaw := r.Header.Get("If-Match")
+		if ifMatchRaw != "" {
+			ifMatch = &ifMatchRaw
+		}
+		{
+			forceUpdateRaw := r.Header.Get("X-Force-Update")
+			if forceUpdateRaw != "" {
+				v, err2 := strconv.ParseBool(forceUpdateRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("force_update", forceUpdateRaw, "boolean"))
+				}
+				forceUpdate = &v
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewCreateProjectLinkPayload(&body, uid, version, bearerToken, xSync)
+		payload := NewDeleteProjectLinkPayload(uid, linkUID, version, bearerToken, xSync, ifMatch, forceUpdate)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1135,9 +1205,9 @@ func DecodeCreateProjectLinkRequest(mux goahttp.Muxer, decoder func(*http.Reques
 	}
 }
 
-// EncodeCreateProjectLinkError returns an encoder for errors returned by the
-// create-project-link project-service endpoint.
-func EncodeCreateProjectLinkError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeDeleteProjectLinkError returns an encoder for errors returned by the
+// delete-project-link project-service endpoint.
+func EncodeDeleteProjectLinkError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1148,25 +1218,55 @@ func EncodeCreateProjectLinkError(encoder func(context.Context, http.ResponseWri
 		case "BadRequest":
 			var res *projectservice.BadRequestError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateProjectLinkBadRequestResponseBody(res)
+				body = NewDeleteProjectLinkBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
+		case "Conflict":
+			var res *projectservice.ConflictError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteProjectLinkConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteProjectLinkForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
 		case "InternalServerError":
 			var res *projectservice.InternalServerError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateProjectLinkInternalServerErrorResponseBody(res)
+				body = NewDeleteProjectLinkInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1174,12 +1274,13 @@ func EncodeCreateProjectLinkError(encoder func(context.Context, http.ResponseWri
 		case "NotFound":
 			var res *projectservice.NotFoundError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateProjectLinkNotFoundResponseBody(res)
+				body = NewDeleteProjectLinkNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1187,12 +1288,13 @@ func EncodeCreateProjectLinkError(encoder func(context.Context, http.ResponseWri
 		case "ServiceUnavailable":
 			var res *projectservice.ServiceUnavailableError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateProjectLinkServiceUnavailableResponseBody(res)
+				body = NewDeleteProjectLinkServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1203,38 +1305,52 @@ func EncodeCreateProjectLinkError(encoder func(context.Context, http.ResponseWri
 	}
 }
 
-// EncodeGetProjectLinkResponse returns an encoder for responses returned by
-// the project-service get-project-link endpoint.
-func EncodeGetProjectLinkResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeCreateProjectFolderResponse returns an encoder for responses returned
+// by the project-service create-project-folder endpoint.
+func EncodeCreateProjectFolderResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*projectservice.GetProjectLinkResult)
+		res, _ := v.(*projectservice.ProjectFolder)
 		enc := encoder(ctx, w)
-		body := NewGetProjectLinkResponseBody(res)
-		if res.Etag != nil {
-			w.Header().Set("Etag", *res.Etag)
-		}
-		w.WriteHeader(http.StatusOK)
+		body := NewCreateProjectFolderResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetProjectLinkRequest returns a decoder for requests sent to the
-// project-service get-project-link endpoint.
-func DecodeGetProjectLinkRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetProjectLinkPayload, error) {
-	return func(r *http.Request) (*projectservice.GetProjectLinkPayload, error) {
+// DecodeCreateProjectFolderRequest returns a decoder for requests sent to the
+// project-service create-project-folder endpoint.
+func DecodeCreateProjectFolderRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.CreateProjectFolderPayload, error) {
+	return func(r *http.Request) (*projectservice.CreateProjectFolderPayload, error) {
+		var (
+			body CreateProjectFolderRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateProjectFolderRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
 		var (
 			uid         string
-			linkUID     string
 			version     *string
 			bearerToken *string
-			err         error
+			xSync       *bool
 
 			params = mux.Vars(r)
 		)
 		uid = params["uid"]
 		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
-		linkUID = params["link_uid"]
-		err = goa.MergeErrors(err, goa.ValidateFormat("link_uid", linkUID, goa.FormatUUID))
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -1248,10 +1364,20 @@ func DecodeGetProjectLinkRequest(mux goahttp.Muxer, decoder func(*http.Request)
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
+		{
+			xSyncRaw := r.Header.Get("X-Sync")
+			if xSyncRaw != "" {
+				v, err2 := strconv.ParseBool(xSyncRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+				}
+				xSync = &v
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewGetProjectLinkPayload(uid, linkUID, version, bearerToken)
+		payload := NewCreateProjectFolderPayload(&body, uid, version, bearerToken, xSync)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1264,9 +1390,9 @@ func DecodeGetProjectLinkRequest(mux goahttp.Muxer, decoder func(*http.Request)
 	}
 }
 
-// EncodeGetProjectLinkError returns an encoder for errors returned by the
-// get-project-link project-service endpoint.
-func EncodeGetProjectLinkError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeCreateProjectFolderError returns an encoder for errors returned by the
+// create-project-folder project-service endpoint.
+func EncodeCreateProjectFolderError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1274,15 +1400,58 @@ func EncodeGetProjectLinkError(encoder func(context.Context, http.ResponseWriter
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "InternalServerError":
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateProjectFolderBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *projectservice.ConflictError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateProjectFolderConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateProjectFolderForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
 			var res *projectservice.InternalServerError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetProjectLinkInternalServerErrorResponseBody(res)
+				body = NewCreateProjectFolderInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1290,12 +1459,13 @@ func EncodeGetProjectLinkError(encoder func(context.Context, http.ResponseWriter
 		case "NotFound":
 			var res *projectservice.NotFoundError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetProjectLinkNotFoundResponseBody(res)
+				body = NewCreateProjectFolderNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1303,12 +1473,13 @@ func EncodeGetProjectLinkError(encoder func(context.Context, http.ResponseWriter
 		case "ServiceUnavailable":
 			var res *projectservice.ServiceUnavailableError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetProjectLinkServiceUnavailableResponseBody(res)
+				body = NewCreateProjectFolderServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1319,34 +1490,38 @@ func EncodeGetProjectLinkError(encoder func(context.Context, http.ResponseWriter
 	}
 }
 
-// EncodeDeleteProjectLinkResponse returns an encoder for responses returned by
-// the project-service delete-project-link endpoint.
-func EncodeDeleteProjectLinkResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeGetProjectFolderResponse returns an encoder for responses returned by
+// the project-service get-project-folder endpoint.
+func EncodeGetProjectFolderResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		w.WriteHeader(http.StatusNoContent)
-		return nil
+		res, _ := v.(*projectservice.GetProjectFolderResult)
+		enc := encoder(ctx, w)
+		body := NewGetProjectFolderResponseBody(res)
+		if res.Etag != nil {
+			w.Header().Set("Etag", *res.Etag)
+		}
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
 	}
 }
 
-// DecodeDeleteProjectLinkRequest returns a decoder for requests sent to the
-// project-service delete-project-link endpoint.
-func DecodeDeleteProjectLinkRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.DeleteProjectLinkPayload, error) {
-	return func(r *http.Request) (*projectservice.DeleteProjectLinkPayload, error) {
+// DecodeGetProjectFolderRequest returns a decoder for requests sent to the
+// project-service get-project-folder endpoint.
+func DecodeGetProjectFolderRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetProjectFolderPayload, error) {
+	return func(r *http.Request) (*projectservice.GetProjectFolderPayload, error) {
 		var (
 			uid         string
-			linkUID     string
+			folderUID   string
 			version     *string
 			bearerToken *string
-			xSync       *bool
-			ifMatch     *string
 			err         error
 
 			params = mux.Vars(r)
 		)
 		uid = params["uid"]
 		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
-		linkUID = params["link_uid"]
-		err = goa.MergeErrors(err, goa.ValidateFormat("link_uid", linkUID, goa.FormatUUID))
+		folderUID = params["folder_uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("folder_uid", folderUID, goa.FormatUUID))
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -1360,24 +1535,10 @@ func DecodeDeleteProjectLinkRequest(mux goahttp.Muxer, decoder func(*http.Reques
 		if bearerTokenRaw != "" {
 			bearerToken = &bearerTokenRaw
 		}
-		{
-			xSyncRaw := r.Header.Get("X-Sync")
-			if xSyncRaw != "" {
-				v, err2 := strconv.ParseBool(xSyncRaw)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
-				}
-				xSync = &v
-			}
-		}
-		ifMatchRaw := r.Header.Get("If-Match")
-		if ifMatchRaw != "" {
-			ifMatch = &ifMatchRaw
-		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewDeleteProjectLinkPayload(uid, linkUID, version, bearerToken, xSync, ifMatch)
+		payload := NewGetProjectFolderPayload(uid, folderUID, version, bearerToken)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1390,9 +1551,9 @@ func DecodeDeleteProjectLinkRequest(mux goahttp.Muxer, decoder func(*http.Reques
 	}
 }
 
-// EncodeDeleteProjectLinkError returns an encoder for errors returned by the
-// delete-project-link project-service endpoint.
-func EncodeDeleteProjectLinkError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetProjectFolderError returns an encoder for errors returned by the
+// get-project-folder project-service endpoint.
+func EncodeGetProjectFolderError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1400,41 +1561,30 @@ func EncodeDeleteProjectLinkError(encoder func(context.Context, http.ResponseWri
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "BadRequest":
-			var res *projectservice.BadRequestError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewDeleteProjectLinkBadRequestResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusBadRequest)
-			return enc.Encode(body)
-		case "Conflict":
-			var res *projectservice.ConflictError
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteProjectLinkConflictResponseBody(res)
+				body = NewGetProjectFolderForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusConflict)
+			w.WriteHeader(http.StatusForbidden)
 			return enc.Encode(body)
 		case "InternalServerError":
 			var res *projectservice.InternalServerError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteProjectLinkInternalServerErrorResponseBody(res)
+				body = NewGetProjectFolderInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1442,12 +1592,13 @@ func EncodeDeleteProjectLinkError(encoder func(context.Context, http.ResponseWri
 		case "NotFound":
 			var res *projectservice.NotFoundError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteProjectLinkNotFoundResponseBody(res)
+				body = NewGetProjectFolderNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1455,12 +1606,13 @@ func EncodeDeleteProjectLinkError(encoder func(context.Context, http.ResponseWri
 		case "ServiceUnavailable":
 			var res *projectservice.ServiceUnavailableError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewDeleteProjectLinkServiceUnavailableResponseBody(res)
+				body = NewGetProjectFolderServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1471,52 +1623,35 @@ func EncodeDeleteProjectLinkError(encoder func(context.Context, http.ResponseWri
 	}
 }
 
-// EncodeCreateProjectFolderResponse returns an encoder for responses returned
-// by the project-service create-project-folder endpoint.
-func EncodeCreateProjectFolderResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeDeleteProjectFolderResponse returns an encoder for responses returned
+// by the project-service delete-project-folder endpoint.
+func EncodeDeleteProjectFolderResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*projectservice.ProjectFolder)
-		enc := encoder(ctx, w)
-		body := NewCreateProjectFolderResponseBody(res)
-		w.WriteHeader(http.StatusCreated)
-		return enc.Encode(body)
+		w.WriteHeader(http.StatusNoContent)
+		return nil
 	}
 }
 
-// DecodeCreateProjectFolderRequest returns a decoder for requests sent to the
-// project-service create-project-folder endpoint.
-func DecodeCreateProjectFolderRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.CreateProjectFolderPayload, error) {
-	return func(r *http.Request) (*projectservice.CreateProjectFolderPayload, error) {
-		var (
-			body CreateProjectFolderRequestBody
-			err  error
-		)
-		err = decoder(r).Decode(&body)
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return nil, goa.MissingPayloadError()
-			}
-			var gerr *goa.ServiceError
-			if errors.As(err, &gerr) {
-				return nil, gerr
-			}
-			return nil, goa.DecodePayloadError(err.Error())
-		}
-		err = ValidateCreateProjectFolderRequestBody(&body)
-		if err != nil {
-			return nil, err
-		}
-
+// DecodeDeleteProjectFolderRequest returns a decoder for requests sent to the
+// project-service delete-project-folder endpoint.
+func DecodeDeleteProjectFolderRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.DeleteProjectFolderPayload, error) {
+	return func(r *http.Request) (*projectservice.DeleteProjectFolderPayload, error) {
 		var (
 			uid         string
+			folderUID   string
 			version     *string
 			bearerToken *string
 			xSync       *bool
+			ifMatch     *string
+			forceUpdate *bool
+			err         error
 
 			params = mux.Vars(r)
 		)
 		uid = params["uid"]
 		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		folderUID = params["folder_uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("folder_uid", folderUID, goa.FormatUUID))
 		versionRaw := r.URL.Query().Get("v")
 		if versionRaw != "" {
 			version = &versionRaw
@@ -1540,10 +1675,24 @@ func DecodeCreateProjectFolderRequest(mux goahttp.Muxer, decoder func(*http.Requ
 				xSync = &v
 			}
 		}
+		ifMatchRaw := r.Header.Get("If-Match")
+		if ifMatchRaw != "" {
+			ifMatch = &ifMatchRaw
+		}
+		{
+			forceUpdateRaw := r.Header.Get("X-Force-Update")
+			if forceUpdateRaw != "" {
+				v, err2 := strconv.ParseBool(forceUpdateRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("force_update", forceUpdateRaw, "boolean"))
+				}
+				forceUpdate = &v
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewCreateProjectFolderPayload(&body, uid, version, bearerToken, xSync)
+		payload := NewDeleteProjectFolderPayload(uid, folderUID, version, bearerToken, xSync, ifMatch, forceUpdate)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -1556,9 +1705,9 @@ func DecodeCreateProjectFolderRequest(mux goahttp.Muxer, decoder func(*http.Requ
 	}
 }
 
-// EncodeCreateProjectFolderError returns an encoder for errors returned by the
-// create-project-folder project-service endpoint.
-func EncodeCreateProjectFolderError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeDeleteProjectFolderError returns an encoder for errors returned by the
+// delete-project-folder project-service endpoint.
+func EncodeDeleteProjectFolderError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1569,12 +1718,13 @@ func EncodeCreateProjectFolderError(encoder func(context.Context, http.ResponseW
 		case "BadRequest":
 			var res *projectservice.BadRequestError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateProjectFolderBadRequestResponseBody(res)
+				body = NewDeleteProjectFolderBadRequestResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
@@ -1582,25 +1732,41 @@ func EncodeCreateProjectFolderError(encoder func(context.Context, http.ResponseW
 		case "Conflict":
 			var res *projectservice.ConflictError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateProjectFolderConflictResponseBody(res)
+				body = NewDeleteProjectFolderConflictResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
 			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteProjectFolderForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
 		case "InternalServerError":
 			var res *projectservice.InternalServerError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateProjectFolderInternalServerErrorResponseBody(res)
+				body = NewDeleteProjectFolderInternalServerErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -1608,12 +1774,13 @@ func EncodeCreateProjectFolderError(encoder func(context.Context, http.ResponseW
 		case "NotFound":
 			var res *projectservice.NotFoundError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateProjectFolderNotFoundResponseBody(res)
+				body = NewDeleteProjectFolderNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
@@ -1621,12 +1788,13 @@ func EncodeCreateProjectFolderError(encoder func(context.Context, http.ResponseW
 		case "ServiceUnavailable":
 			var res *projectservice.ServiceUnavailableError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewCreateProjectFolderServiceUnavailableResponseBody(res)
+				body = NewDeleteProjectFolderServiceUnavailableResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
@@ -1637,297 +1805,29 @@ func EncodeCreateProjectFolderError(encoder func(context.Context, http.ResponseW
 	}
 }
 
-// EncodeGetProjectFolderResponse returns an encoder for responses returned by
-// the project-service get-project-folder endpoint.
-func EncodeGetProjectFolderResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeUploadProjectDocumentResponse returns an encoder for responses
+// returned by the project-service upload-project-document endpoint.
+func EncodeUploadProjectDocumentResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*projectservice.GetProjectFolderResult)
+		res, _ := v.(*projectservice.ProjectDocument)
 		enc := encoder(ctx, w)
-		body := NewGetProjectFolderResponseBody(res)
-		if res.Etag != nil {
-			w.Header().Set("Etag", *res.Etag)
-		}
-		w.WriteHeader(http.StatusOK)
+		body := NewUploadProjectDocumentResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetProjectFolderRequest returns a decoder for requests sent to the
-// project-service get-project-folder endpoint.
-func DecodeGetProjectFolderRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetProjectFolderPayload, error) {
-	return func(r *http.Request) (*projectservice.GetProjectFolderPayload, error) {
-		var (
-			uid         string
-			folderUID   string
-			version     *string
-			bearerToken *string
-			err         error
-
-			params = mux.Vars(r)
-		)
-		uid = params["uid"]
-		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
-		folderUID = params["folder_uid"]
-		err = goa.MergeErrors(err, goa.ValidateFormat("folder_uid", folderUID, goa.FormatUUID))
-		versionRaw := r.URL.Query().Get("v")
-		if versionRaw != "" {
-			version = &versionRaw
-		}
-		if version != nil {
-			if !(*version == "1") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+// DecodeUploadProjectDocumentRequest returns a decoder for requests sent to
+// the project-service upload-project-document endpoint.
+func DecodeUploadProjectDocumentRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.UploadProjectDocumentPayload, error) {
+	return func(r *http.Request) (*projectservice.UploadProjectDocumentPayload, error) {
+		var payload *projectservice.UploadProjectDocumentPayload
+		if err := decoder(r).Decode(&payload); err != nil {
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
 			}
-		}
-		bearerTokenRaw := r.Header.Get("Authorization")
-		if bearerTokenRaw != "" {
-			bearerToken = &bearerTokenRaw
-		}
-		if err != nil {
-			return nil, err
-		}
-		payload := NewGetProjectFolderPayload(uid, folderUID, version, bearerToken)
-		if payload.BearerToken != nil {
-			if strings.Contains(*payload.BearerToken, " ") {
-				// Remove authorization scheme prefix (e.g. "Bearer")
-				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
-				payload.BearerToken = &cred
-			}
-		}
-
-		return payload, nil
-	}
-}
-
-// EncodeGetProjectFolderError returns an encoder for errors returned by the
-// get-project-folder project-service endpoint.
-func EncodeGetProjectFolderError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
-	encodeError := goahttp.ErrorEncoder(encoder, formatter)
-	return func(ctx context.Context, w http.ResponseWriter, v error) error {
-		var en goa.GoaErrorNamer
-		if !errors.As(v, &en) {
-			return encodeError(ctx, w, v)
-		}
-		switch en.GoaErrorName() {
-		case "InternalServerError":
-			var res *projectservice.InternalServerError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewGetProjectFolderInternalServerErrorResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusInternalServerError)
-			return enc.Encode(body)
-		case "NotFound":
-			var res *projectservice.NotFoundError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewGetProjectFolderNotFoundResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
-			return enc.Encode(body)
-		case "ServiceUnavailable":
-			var res *projectservice.ServiceUnavailableError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewGetProjectFolderServiceUnavailableResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusServiceUnavailable)
-			return enc.Encode(body)
-		default:
-			return encodeError(ctx, w, v)
-		}
-	}
-}
-
-// EncodeDeleteProjectFolderResponse returns an encoder for responses returned
-// by the project-service delete-project-folder endpoint.
-func EncodeDeleteProjectFolderResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
-	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		w.WriteHeader(http.StatusNoContent)
-		return nil
-	}
-}
-
-// DecodeDeleteProjectFolderRequest returns a decoder for requests sent to the
-// project-service delete-project-folder endpoint.
-func DecodeDeleteProjectFolderRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.DeleteProjectFolderPayload, error) {
-	return func(r *http.Request) (*projectservice.DeleteProjectFolderPayload, error) {
-		var (
-			uid         string
-			folderUID   string
-			version     *string
-			bearerToken *string
-			xSync       *bool
-			ifMatch     *string
-			err         error
-
-			params = mux.Vars(r)
-		)
-		uid = params["uid"]
-		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
-		folderUID = params["folder_uid"]
-		err = goa.MergeErrors(err, goa.ValidateFormat("folder_uid", folderUID, goa.FormatUUID))
-		versionRaw := r.URL.Query().Get("v")
-		if versionRaw != "" {
-			version = &versionRaw
-		}
-		if version != nil {
-			if !(*version == "1") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
-			}
-		}
-		bearerTokenRaw := r.Header.Get("Authorization")
-		if bearerTokenRaw != "" {
-			bearerToken = &bearerTokenRaw
-		}
-		{
-			xSyncRaw := r.Header.Get("X-Sync")
-			if xSyncRaw != "" {
-				v, err2 := strconv.ParseBool(xSyncRaw)
-				if err2 != nil {
-					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
-				}
-				xSync = &v
-			}
-		}
-		ifMatchRaw := r.Header.Get("If-Match")
-		if ifMatchRaw != "" {
-			ifMatch = &ifMatchRaw
-		}
-		if err != nil {
-			return nil, err
-		}
-		payload := NewDeleteProjectFolderPayload(uid, folderUID, version, bearerToken, xSync, ifMatch)
-		if payload.BearerToken != nil {
-			if strings.Contains(*payload.BearerToken, " ") {
-				// Remove authorization scheme prefix (e.g. "Bearer")
-				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
-				payload.BearerToken = &cred
-			}
-		}
-
-		return payload, nil
-	}
-}
-
-// EncodeDeleteProjectFolderError returns an encoder for errors returned by the
-// delete-project-folder project-service endpoint.
-func EncodeDeleteProjectFolderError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
-	encodeError := goahttp.ErrorEncoder(encoder, formatter)
-	return func(ctx context.Context, w http.ResponseWriter, v error) error {
-		var en goa.GoaErrorNamer
-		if !errors.As(v, &en) {
-			return encodeError(ctx, w, v)
-		}
-		switch en.GoaErrorName() {
-		case "BadRequest":
-			var res *projectservice.BadRequestError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewDeleteProjectFolderBadRequestResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusBadRequest)
-			return enc.Encode(body)
-		case "Conflict":
-			var res *projectservice.ConflictError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewDeleteProjectFolderConflictResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusConflict)
-			return enc.Encode(body)
-		case "InternalServerError":
-			var res *projectservice.InternalServerError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewDeleteProjectFolderInternalServerErrorResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusInternalServerError)
-			return enc.Encode(body)
-		case "NotFound":
-			var res *projectservice.NotFoundError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewDeleteProjectFolderNotFoundResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
-			return enc.Encode(body)
-		case "ServiceUnavailable":
-			var res *projectservice.ServiceUnavailableError
-			errors.As(v, &res)
-			enc := encoder(ctx, w)
-			var body any
-			if formatter != nil {
-				body = formatter(ctx, res)
-			} else {
-				body = NewDeleteProjectFolderServiceUnavailableResponseBody(res)
-			}
-			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusServiceUnavailable)
-			return enc.Encode(body)
-		default:
-			return encodeError(ctx, w, v)
-		}
-	}
-}
-
-// EncodeUploadProjectDocumentResponse returns an encoder for responses
-// returned by the project-service upload-project-document endpoint.
-func EncodeUploadProjectDocumentResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
-	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*projectservice.ProjectDocument)
-		enc := encoder(ctx, w)
-		body := NewUploadProjectDocumentResponseBody(res)
-		w.WriteHeader(http.StatusCreated)
-		return enc.Encode(body)
-	}
-}
-
-// DecodeUploadProjectDocumentRequest returns a decoder for requests sent to
-// the project-service upload-project-document endpoint.
-func DecodeUploadProjectDocumentRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.UploadProjectDocumentPayload, error) {
-	return func(r *http.Request) (*projectservice.UploadProjectDocumentPayload, error) {
-		var payload *projectservice.UploadProjectDocumentPayload
-		if err := decoder(r).Decode(&payload); err != nil {
-			var gerr *goa.ServiceError
-			if errors.As(err, &gerr) {
-				return nil, gerr
-			}
-			return nil, goa.DecodePayloadError(err.Error())
+			return nil, goa.DecodePayloadError(err.Error())
 		}
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
@@ -2015,6 +1915,7 @@ func EncodeUploadProjectDocumentError(encoder func(context.Context, http.Respons
 		case "BadRequest":
 			var res *projectservice.BadRequestError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
@@ -2028,6 +1929,7 @@ func EncodeUploadProjectDocumentError(encoder func(context.Context, http.Respons
 		case "Conflict":
 			var res *projectservice.ConflictError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
@@ -2038,9 +1940,24 @@ func EncodeUploadProjectDocumentError(encoder func(context.Context, http.Respons
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
 			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUploadProjectDocumentForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
 		case "InternalServerError":
 			var res *projectservice.InternalServerError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
@@ -2054,6 +1971,7 @@ func EncodeUploadProjectDocumentError(encoder func(context.Context, http.Respons
 		case "NotFound":
 			var res *projectservice.NotFoundError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
@@ -2067,6 +1985,7 @@ func EncodeUploadProjectDocumentError(encoder func(context.Context, http.Respons
 		case "ServiceUnavailable":
 			var res *projectservice.ServiceUnavailableError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
@@ -2154,22 +2073,38 @@ func EncodeGetProjectDocumentError(encoder func(context.Context, http.ResponseWr
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "InternalServerError":
-			var res *projectservice.InternalServerError
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewGetProjectDocumentInternalServerErrorResponseBody(res)
+				body = NewGetProjectDocumentForbiddenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusInternalServerError)
+			w.WriteHeader(http.StatusForbidden)
 			return enc.Encode(body)
-		case "NotFound":
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectDocumentInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
 			var res *projectservice.NotFoundError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
@@ -2183,6 +2118,7 @@ func EncodeGetProjectDocumentError(encoder func(context.Context, http.ResponseWr
 		case "ServiceUnavailable":
 			var res *projectservice.ServiceUnavailableError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
@@ -2263,9 +2199,24 @@ func EncodeDownloadProjectDocumentError(encoder func(context.Context, http.Respo
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDownloadProjectDocumentForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
 		case "InternalServerError":
 			var res *projectservice.InternalServerError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
@@ -2279,6 +2230,7 @@ func EncodeDownloadProjectDocumentError(encoder func(context.Context, http.Respo
 		case "NotFound":
 			var res *projectservice.NotFoundError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
@@ -2292,6 +2244,7 @@ func EncodeDownloadProjectDocumentError(encoder func(context.Context, http.Respo
 		case "ServiceUnavailable":
 			var res *projectservice.ServiceUnavailableError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
@@ -2328,6 +2281,7 @@ func DecodeDeleteProjectDocumentRequest(mux goahttp.Muxer, decoder func(*http.Re
 			bearerToken *string
 			xSync       *bool
 			ifMatch     *string
+			forceUpdate *bool
 			err         error
 
 			params = mux.Vars(r)
@@ -2363,10 +2317,20 @@ func DecodeDeleteProjectDocumentRequest(mux goahttp.Muxer, decoder func(*http.Re
 		if ifMatchRaw != "" {
 			ifMatch = &ifMatchRaw
 		}
+		{
+			forceUpdateRaw := r.Header.Get("X-Force-Update")
+			if forceUpdateRaw != "" {
+				v, err2 := strconv.ParseBool(forceUpdateRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("force_update", forceUpdateRaw, "boolean"))
+				}
+				forceUpdate = &v
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewDeleteProjectDocumentPayload(uid, documentUID, version, bearerToken, xSync, ifMatch)
+		payload := NewDeleteProjectDocumentPayload(uid, documentUID, version, bearerToken, xSync, ifMatch, forceUpdate)
 		if payload.BearerToken != nil {
 			if strings.Contains(*payload.BearerToken, " ") {
 				// Remove authorization scheme prefix (e.g. "Bearer")
@@ -2392,6 +2356,7 @@ func EncodeDeleteProjectDocumentError(encoder func(context.Context, http.Respons
 		case "BadRequest":
 			var res *projectservice.BadRequestError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
@@ -2405,6 +2370,7 @@ func EncodeDeleteProjectDocumentError(encoder func(context.Context, http.Respons
 		case "Conflict":
 			var res *projectservice.ConflictError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
@@ -2415,9 +2381,24 @@ func EncodeDeleteProjectDocumentError(encoder func(context.Context, http.Respons
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
 			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteProjectDocumentForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
 		case "InternalServerError":
 			var res *projectservice.InternalServerError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
@@ -2431,6 +2412,7 @@ func EncodeDeleteProjectDocumentError(encoder func(context.Context, http.Respons
 		case "NotFound":
 			var res *projectservice.NotFoundError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
@@ -2444,6 +2426,7 @@ func EncodeDeleteProjectDocumentError(encoder func(context.Context, http.Respons
 		case "ServiceUnavailable":
 			var res *projectservice.ServiceUnavailableError
 			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
 			enc := encoder(ctx, w)
 			var body any
 			if formatter != nil {
@@ -2460,137 +2443,8652 @@ func EncodeDeleteProjectDocumentError(encoder func(context.Context, http.Respons
 	}
 }
 
-// marshalProjectserviceProjectFullToProjectFullResponseBody builds a value of
-// type *ProjectFullResponseBody from a value of type
-// *projectservice.ProjectFull.
-func marshalProjectserviceProjectFullToProjectFullResponseBody(v *projectservice.ProjectFull) *ProjectFullResponseBody {
-	res := &ProjectFullResponseBody{
-		UID:                        v.UID,
-		Slug:                       v.Slug,
-		Description:                v.Description,
-		Name:                       v.Name,
-		Public:                     v.Public,
-		IsFoundation:               v.IsFoundation,
-		ParentUID:                  v.ParentUID,
-		Stage:                      v.Stage,
-		Category:                   v.Category,
-		Funding:                    v.Funding,
-		CharterURL:                 v.CharterURL,
-		LegalEntityType:            v.LegalEntityType,
-		LegalEntityName:            v.LegalEntityName,
-		LegalParentUID:             v.LegalParentUID,
-		EntityDissolutionDate:      v.EntityDissolutionDate,
-		EntityFormationDocumentURL: v.EntityFormationDocumentURL,
-		AutojoinEnabled:            v.AutojoinEnabled,
-		FormationDate:              v.FormationDate,
-		LogoURL:                    v.LogoURL,
-		RepositoryURL:              v.RepositoryURL,
-		WebsiteURL:                 v.WebsiteURL,
-		CreatedAt:                  v.CreatedAt,
-		UpdatedAt:                  v.UpdatedAt,
-		MissionStatement:           v.MissionStatement,
-		AnnouncementDate:           v.AnnouncementDate,
-	}
-	if v.FundingModel != nil {
-		res.FundingModel = make([]string, len(v.FundingModel))
-		for i, val := range v.FundingModel {
-			res.FundingModel[i] = val
-		}
-	}
-	if v.Writers != nil {
-		res.Writers = make([]*UserInfoResponseBody, len(v.Writers))
-		for i, val := range v.Writers {
-			res.Writers[i] = marshalProjectserviceUserInfoToUserInfoResponseBody(val)
-		}
+// EncodeUploadProjectCharterResponse returns an encoder for responses returned
+// by the project-service upload-project-charter endpoint.
+func EncodeUploadProjectCharterResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectCharter)
+		enc := encoder(ctx, w)
+		body := NewUploadProjectCharterResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
 	}
-	if v.MeetingCoordinators != nil {
-		res.MeetingCoordinators = make([]*UserInfoResponseBody, len(v.MeetingCoordinators))
-		for i, val := range v.MeetingCoordinators {
-			res.MeetingCoordinators[i] = marshalProjectserviceUserInfoToUserInfoResponseBody(val)
+}
+
+// DecodeUploadProjectCharterRequest returns a decoder for requests sent to the
+// project-service upload-project-charter endpoint.
+func DecodeUploadProjectCharterRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.UploadProjectCharterPayload, error) {
+	return func(r *http.Request) (*projectservice.UploadProjectCharterPayload, error) {
+		var payload *projectservice.UploadProjectCharterPayload
+		if err := decoder(r).Decode(&payload); err != nil {
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
 		}
-	}
-	if v.Auditors != nil {
-		res.Auditors = make([]*UserInfoResponseBody, len(v.Auditors))
-		for i, val := range v.Auditors {
-			res.Auditors[i] = marshalProjectserviceUserInfoToUserInfoResponseBody(val)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
 		}
-	}
-	if v.ExecutiveDirector != nil {
-		res.ExecutiveDirector = marshalProjectserviceUserInfoToUserInfoResponseBody(v.ExecutiveDirector)
-	}
-	if v.ProgramManager != nil {
-		res.ProgramManager = marshalProjectserviceUserInfoToUserInfoResponseBody(v.ProgramManager)
-	}
-	if v.OpportunityOwner != nil {
-		res.OpportunityOwner = marshalProjectserviceUserInfoToUserInfoResponseBody(v.OpportunityOwner)
-	}
 
-	return res
-}
-
-// marshalProjectserviceUserInfoToUserInfoResponseBody builds a value of type
-// *UserInfoResponseBody from a value of type *projectservice.UserInfo.
-func marshalProjectserviceUserInfoToUserInfoResponseBody(v *projectservice.UserInfo) *UserInfoResponseBody {
-	if v == nil {
-		return nil
-	}
-	res := &UserInfoResponseBody{
-		Name:     v.Name,
-		Email:    v.Email,
-		Username: v.Username,
-		Avatar:   v.Avatar,
-	}
-	if v.Invite != nil {
-		res.Invite = marshalProjectserviceInviteInfoToInviteInfoResponseBody(v.Invite)
+		return payload, nil
 	}
-
-	return res
 }
 
-// marshalProjectserviceInviteInfoToInviteInfoResponseBody builds a value of
-// type *InviteInfoResponseBody from a value of type *projectservice.InviteInfo.
-func marshalProjectserviceInviteInfoToInviteInfoResponseBody(v *projectservice.InviteInfo) *InviteInfoResponseBody {
-	if v == nil {
-		return nil
-	}
-	res := &InviteInfoResponseBody{
-		UID:       v.UID,
-		Email:     v.Email,
-		ExpiresAt: v.ExpiresAt,
-	}
+// NewProjectServiceUploadProjectCharterDecoder returns a decoder to decode the
+// multipart request for the "project-service" service "upload-project-charter"
+// endpoint.
+func NewProjectServiceUploadProjectCharterDecoder(mux goahttp.Muxer, projectServiceUploadProjectCharterDecoderFn ProjectServiceUploadProjectCharterDecoderFunc) func(r *http.Request) goahttp.Decoder {
+	return func(r *http.Request) goahttp.Decoder {
+		return goahttp.EncodingFunc(func(v any) error {
+			mr, merr := r.MultipartReader()
+			if merr != nil {
+				return merr
+			}
+			p := v.(**projectservice.UploadProjectCharterPayload)
+			if err := projectServiceUploadProjectCharterDecoderFn(mr, p); err != nil {
+				return err
+			}
 
-	return res
-}
+			var (
+				uid         string
+				version     *string
+				bearerToken *string
+				xSync       *bool
+				err         error
 
-// unmarshalUserInfoRequestBodyToProjectserviceUserInfo builds a value of type
-// *projectservice.UserInfo from a value of type *UserInfoRequestBody.
-func unmarshalUserInfoRequestBodyToProjectserviceUserInfo(v *UserInfoRequestBody) *projectservice.UserInfo {
-	if v == nil {
-		return nil
-	}
-	res := &projectservice.UserInfo{
-		Name:     v.Name,
-		Email:    v.Email,
-		Username: v.Username,
-		Avatar:   v.Avatar,
-	}
-	if v.Invite != nil {
-		res.Invite = unmarshalInviteInfoRequestBodyToProjectserviceInviteInfo(v.Invite)
+				params = mux.Vars(r)
+			)
+			uid = params["uid"]
+			err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+			versionRaw := r.URL.Query().Get("v")
+			if versionRaw != "" {
+				version = &versionRaw
+			}
+			if version != nil {
+				if !(*version == "1") {
+					err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+				}
+			}
+			bearerTokenRaw := r.Header.Get("Authorization")
+			if bearerTokenRaw != "" {
+				bearerToken = &bearerTokenRaw
+			}
+			{
+				xSyncRaw := r.Header.Get("X-Sync")
+				if xSyncRaw != "" {
+					v, err2 := strconv.ParseBool(xSyncRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+					}
+					xSync = &v
+				}
+			}
+			if err != nil {
+				return err
+			}
+			(*p).UID = uid
+			(*p).Version = version
+			(*p).BearerToken = bearerToken
+			(*p).XSync = xSync
+			return nil
+		})
 	}
-
-	return res
 }
 
-// unmarshalInviteInfoRequestBodyToProjectserviceInviteInfo builds a value of
-// type *projectservice.InviteInfo from a value of type *InviteInfoRequestBody.
-func unmarshalInviteInfoRequestBodyToProjectserviceInviteInfo(v *InviteInfoRequestBody) *projectservice.InviteInfo {
-	if v == nil {
-		return nil
-	}
-	res := &projectservice.InviteInfo{
-		UID:       v.UID,
-		Email:     v.Email,
-		ExpiresAt: v.ExpiresAt,
+// EncodeUploadProjectCharterError returns an encoder for errors returned by
+// the upload-project-charter project-service endpoint.
+func EncodeUploadProjectCharterError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUploadProjectCharterBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *projectservice.ConflictError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUploadProjectCharterConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUploadProjectCharterForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUploadProjectCharterInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUploadProjectCharterNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUploadProjectCharterServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetProjectCharterResponse returns an encoder for responses returned by
+// the project-service get-project-charter endpoint.
+func EncodeGetProjectCharterResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectCharter)
+		enc := encoder(ctx, w)
+		body := NewGetProjectCharterResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetProjectCharterRequest returns a decoder for requests sent to the
+// project-service get-project-charter endpoint.
+func DecodeGetProjectCharterRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetProjectCharterPayload, error) {
+	return func(r *http.Request) (*projectservice.GetProjectCharterPayload, error) {
+		var (
+			uid         string
+			version     *string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetProjectCharterPayload(uid, version, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetProjectCharterError returns an encoder for errors returned by the
+// get-project-charter project-service endpoint.
+func EncodeGetProjectCharterError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectCharterForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectCharterInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectCharterNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectCharterServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetProjectAssociationsResponse returns an encoder for responses
+// returned by the project-service get-project-associations endpoint.
+func EncodeGetProjectAssociationsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectAssociations)
+		enc := encoder(ctx, w)
+		body := NewGetProjectAssociationsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetProjectAssociationsRequest returns a decoder for requests sent to
+// the project-service get-project-associations endpoint.
+func DecodeGetProjectAssociationsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetProjectAssociationsPayload, error) {
+	return func(r *http.Request) (*projectservice.GetProjectAssociationsPayload, error) {
+		var (
+			uid         string
+			version     *string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetProjectAssociationsPayload(uid, version, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetProjectAssociationsError returns an encoder for errors returned by
+// the get-project-associations project-service endpoint.
+func EncodeGetProjectAssociationsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectAssociationsForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectAssociationsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectAssociationsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectAssociationsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUpdateProjectAssociationsResponse returns an encoder for responses
+// returned by the project-service update-project-associations endpoint.
+func EncodeUpdateProjectAssociationsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectAssociations)
+		enc := encoder(ctx, w)
+		body := NewUpdateProjectAssociationsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpdateProjectAssociationsRequest returns a decoder for requests sent
+// to the project-service update-project-associations endpoint.
+func DecodeUpdateProjectAssociationsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.UpdateProjectAssociationsPayload, error) {
+	return func(r *http.Request) (*projectservice.UpdateProjectAssociationsPayload, error) {
+		var (
+			body UpdateProjectAssociationsRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateProjectAssociationsRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			uid         string
+			version     *string
+			bearerToken *string
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewUpdateProjectAssociationsPayload(&body, uid, version, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateProjectAssociationsError returns an encoder for errors returned
+// by the update-project-associations project-service endpoint.
+func EncodeUpdateProjectAssociationsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectAssociationsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectAssociationsForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectAssociationsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectAssociationsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectAssociationsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetProjectSettingsDiffResponse returns an encoder for responses
+// returned by the project-service get-project-settings-diff endpoint.
+func EncodeGetProjectSettingsDiffResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectSettingsDiff)
+		enc := encoder(ctx, w)
+		body := NewGetProjectSettingsDiffResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetProjectSettingsDiffRequest returns a decoder for requests sent to
+// the project-service get-project-settings-diff endpoint.
+func DecodeGetProjectSettingsDiffRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetProjectSettingsDiffPayload, error) {
+	return func(r *http.Request) (*projectservice.GetProjectSettingsDiffPayload, error) {
+		var (
+			uid         string
+			version     *string
+			from        uint64
+			to          uint64
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		qp := r.URL.Query()
+		versionRaw := qp.Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		{
+			fromRaw := qp.Get("from")
+			if fromRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("from", "query string"))
+			}
+			v, err2 := strconv.ParseUint(fromRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("from", fromRaw, "unsigned integer"))
+			}
+			from = v
+		}
+		if from < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("from", from, 1, true))
+		}
+		{
+			toRaw := qp.Get("to")
+			if toRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("to", "query string"))
+			}
+			v, err2 := strconv.ParseUint(toRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("to", toRaw, "unsigned integer"))
+			}
+			to = v
+		}
+		if to < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("to", to, 1, true))
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetProjectSettingsDiffPayload(uid, version, from, to, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetProjectSettingsDiffError returns an encoder for errors returned by
+// the get-project-settings-diff project-service endpoint.
+func EncodeGetProjectSettingsDiffError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectSettingsDiffBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectSettingsDiffForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectSettingsDiffInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectSettingsDiffNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectSettingsDiffServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetProjectChildrenResponse returns an encoder for responses returned
+// by the project-service get-project-children endpoint.
+func EncodeGetProjectChildrenResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectPage)
+		enc := encoder(ctx, w)
+		body := NewGetProjectChildrenResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetProjectChildrenRequest returns a decoder for requests sent to the
+// project-service get-project-children endpoint.
+func DecodeGetProjectChildrenRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetProjectChildrenPayload, error) {
+	return func(r *http.Request) (*projectservice.GetProjectChildrenPayload, error) {
+		var (
+			uid         string
+			version     *string
+			sort        string
+			order       string
+			pageSize    int
+			pageToken   *string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		qp := r.URL.Query()
+		versionRaw := qp.Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		sortRaw := qp.Get("sort")
+		if sortRaw != "" {
+			sort = sortRaw
+		} else {
+			sort = "name"
+		}
+		if !(sort == "name" || sort == "created_at" || sort == "stage") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort", sort, []any{"name", "created_at", "stage"}))
+		}
+		orderRaw := qp.Get("order")
+		if orderRaw != "" {
+			order = orderRaw
+		} else {
+			order = "asc"
+		}
+		if !(order == "asc" || order == "desc") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("order", order, []any{"asc", "desc"}))
+		}
+		{
+			pageSizeRaw := qp.Get("page_size")
+			if pageSizeRaw == "" {
+				pageSize = 50
+			} else {
+				v, err2 := strconv.ParseInt(pageSizeRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("page_size", pageSizeRaw, "integer"))
+				}
+				pageSize = int(v)
+			}
+		}
+		if pageSize < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 1, true))
+		}
+		if pageSize > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 100, false))
+		}
+		pageTokenRaw := qp.Get("page_token")
+		if pageTokenRaw != "" {
+			pageToken = &pageTokenRaw
+		}
+		if pageToken != nil {
+			if utf8.RuneCountInString(*pageToken) > 1024 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("page_token", *pageToken, utf8.RuneCountInString(*pageToken), 1024, false))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetProjectChildrenPayload(uid, version, sort, order, pageSize, pageToken, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetProjectChildrenError returns an encoder for errors returned by the
+// get-project-children project-service endpoint.
+func EncodeGetProjectChildrenError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectChildrenBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectChildrenForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectChildrenInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectChildrenNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectChildrenServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetProjectDescendantsResponse returns an encoder for responses
+// returned by the project-service get-project-descendants endpoint.
+func EncodeGetProjectDescendantsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectPage)
+		enc := encoder(ctx, w)
+		body := NewGetProjectDescendantsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetProjectDescendantsRequest returns a decoder for requests sent to
+// the project-service get-project-descendants endpoint.
+func DecodeGetProjectDescendantsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetProjectDescendantsPayload, error) {
+	return func(r *http.Request) (*projectservice.GetProjectDescendantsPayload, error) {
+		var (
+			uid         string
+			version     *string
+			sort        string
+			order       string
+			pageSize    int
+			pageToken   *string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		qp := r.URL.Query()
+		versionRaw := qp.Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		sortRaw := qp.Get("sort")
+		if sortRaw != "" {
+			sort = sortRaw
+		} else {
+			sort = "name"
+		}
+		if !(sort == "name" || sort == "created_at" || sort == "stage") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort", sort, []any{"name", "created_at", "stage"}))
+		}
+		orderRaw := qp.Get("order")
+		if orderRaw != "" {
+			order = orderRaw
+		} else {
+			order = "asc"
+		}
+		if !(order == "asc" || order == "desc") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("order", order, []any{"asc", "desc"}))
+		}
+		{
+			pageSizeRaw := qp.Get("page_size")
+			if pageSizeRaw == "" {
+				pageSize = 50
+			} else {
+				v, err2 := strconv.ParseInt(pageSizeRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("page_size", pageSizeRaw, "integer"))
+				}
+				pageSize = int(v)
+			}
+		}
+		if pageSize < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 1, true))
+		}
+		if pageSize > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 100, false))
+		}
+		pageTokenRaw := qp.Get("page_token")
+		if pageTokenRaw != "" {
+			pageToken = &pageTokenRaw
+		}
+		if pageToken != nil {
+			if utf8.RuneCountInString(*pageToken) > 1024 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("page_token", *pageToken, utf8.RuneCountInString(*pageToken), 1024, false))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetProjectDescendantsPayload(uid, version, sort, order, pageSize, pageToken, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetProjectDescendantsError returns an encoder for errors returned by
+// the get-project-descendants project-service endpoint.
+func EncodeGetProjectDescendantsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectDescendantsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectDescendantsForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectDescendantsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectDescendantsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectDescendantsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetProjectLegalLineageResponse returns an encoder for responses
+// returned by the project-service get-project-legal-lineage endpoint.
+func EncodeGetProjectLegalLineageResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectLegalLineage)
+		enc := encoder(ctx, w)
+		body := NewGetProjectLegalLineageResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetProjectLegalLineageRequest returns a decoder for requests sent to
+// the project-service get-project-legal-lineage endpoint.
+func DecodeGetProjectLegalLineageRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetProjectLegalLineagePayload, error) {
+	return func(r *http.Request) (*projectservice.GetProjectLegalLineagePayload, error) {
+		var (
+			uid         string
+			version     *string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetProjectLegalLineagePayload(uid, version, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetProjectLegalLineageError returns an encoder for errors returned by
+// the get-project-legal-lineage project-service endpoint.
+func EncodeGetProjectLegalLineageError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectLegalLineageBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectLegalLineageForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectLegalLineageInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectLegalLineageNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectLegalLineageServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeSearchProjectsResponse returns an encoder for responses returned by
+// the project-service search-projects endpoint.
+func EncodeSearchProjectsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.SearchProjectsResult)
+		enc := encoder(ctx, w)
+		body := NewSearchProjectsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSearchProjectsRequest returns a decoder for requests sent to the
+// project-service search-projects endpoint.
+func DecodeSearchProjectsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.SearchProjectsPayload, error) {
+	return func(r *http.Request) (*projectservice.SearchProjectsPayload, error) {
+		var (
+			version     *string
+			q           string
+			limit       int
+			bearerToken *string
+			err         error
+		)
+		qp := r.URL.Query()
+		versionRaw := qp.Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		q = qp.Get("q")
+		if q == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("q", "query string"))
+		}
+		if utf8.RuneCountInString(q) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("q", q, utf8.RuneCountInString(q), 1, true))
+		}
+		if utf8.RuneCountInString(q) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("q", q, utf8.RuneCountInString(q), 200, false))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 20
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewSearchProjectsPayload(version, q, limit, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeSearchProjectsError returns an encoder for errors returned by the
+// search-projects project-service endpoint.
+func EncodeSearchProjectsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSearchProjectsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSearchProjectsForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSearchProjectsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSearchProjectsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeAutocompleteProjectsResponse returns an encoder for responses returned
+// by the project-service autocomplete-projects endpoint.
+func EncodeAutocompleteProjectsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.AutocompleteProjectsResult)
+		enc := encoder(ctx, w)
+		body := NewAutocompleteProjectsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeAutocompleteProjectsRequest returns a decoder for requests sent to the
+// project-service autocomplete-projects endpoint.
+func DecodeAutocompleteProjectsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.AutocompleteProjectsPayload, error) {
+	return func(r *http.Request) (*projectservice.AutocompleteProjectsPayload, error) {
+		var (
+			version     *string
+			q           string
+			limit       int
+			bearerToken *string
+			err         error
+		)
+		qp := r.URL.Query()
+		versionRaw := qp.Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		q = qp.Get("q")
+		if q == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("q", "query string"))
+		}
+		if utf8.RuneCountInString(q) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("q", q, utf8.RuneCountInString(q), 1, true))
+		}
+		if utf8.RuneCountInString(q) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("q", q, utf8.RuneCountInString(q), 100, false))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 10
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 50 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 50, false))
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewAutocompleteProjectsPayload(version, q, limit, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeAutocompleteProjectsError returns an encoder for errors returned by
+// the autocomplete-projects project-service endpoint.
+func EncodeAutocompleteProjectsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAutocompleteProjectsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAutocompleteProjectsForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAutocompleteProjectsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAutocompleteProjectsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeAddProjectWriterResponse returns an encoder for responses returned by
+// the project-service add-project-writer endpoint.
+func EncodeAddProjectWriterResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectSettings)
+		enc := encoder(ctx, w)
+		body := NewAddProjectWriterResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeAddProjectWriterRequest returns a decoder for requests sent to the
+// project-service add-project-writer endpoint.
+func DecodeAddProjectWriterRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.AddProjectWriterPayload, error) {
+	return func(r *http.Request) (*projectservice.AddProjectWriterPayload, error) {
+		var (
+			uid         string
+			username    string
+			version     *string
+			bearerToken *string
+			xSync       *bool
+			ifMatch     *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		username = params["username"]
+		if utf8.RuneCountInString(username) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 1, true))
+		}
+		if utf8.RuneCountInString(username) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 100, false))
+		}
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		{
+			xSyncRaw := r.Header.Get("X-Sync")
+			if xSyncRaw != "" {
+				v, err2 := strconv.ParseBool(xSyncRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+				}
+				xSync = &v
+			}
+		}
+		ifMatchRaw := r.Header.Get("If-Match")
+		if ifMatchRaw != "" {
+			ifMatch = &ifMatchRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewAddProjectWriterPayload(uid, username, version, bearerToken, xSync, ifMatch)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeAddProjectWriterError returns an encoder for errors returned by the
+// add-project-writer project-service endpoint.
+func EncodeAddProjectWriterError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddProjectWriterBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *projectservice.ConflictError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddProjectWriterConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddProjectWriterForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddProjectWriterInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddProjectWriterNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddProjectWriterServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRemoveProjectWriterResponse returns an encoder for responses returned
+// by the project-service remove-project-writer endpoint.
+func EncodeRemoveProjectWriterResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeRemoveProjectWriterRequest returns a decoder for requests sent to the
+// project-service remove-project-writer endpoint.
+func DecodeRemoveProjectWriterRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.RemoveProjectWriterPayload, error) {
+	return func(r *http.Request) (*projectservice.RemoveProjectWriterPayload, error) {
+		var (
+			uid         string
+			username    string
+			version     *string
+			bearerToken *string
+			xSync       *bool
+			ifMatch     *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		username = params["username"]
+		if utf8.RuneCountInString(username) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 1, true))
+		}
+		if utf8.RuneCountInString(username) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 100, false))
+		}
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		{
+			xSyncRaw := r.Header.Get("X-Sync")
+			if xSyncRaw != "" {
+				v, err2 := strconv.ParseBool(xSyncRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+				}
+				xSync = &v
+			}
+		}
+		ifMatchRaw := r.Header.Get("If-Match")
+		if ifMatchRaw != "" {
+			ifMatch = &ifMatchRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRemoveProjectWriterPayload(uid, username, version, bearerToken, xSync, ifMatch)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeRemoveProjectWriterError returns an encoder for errors returned by the
+// remove-project-writer project-service endpoint.
+func EncodeRemoveProjectWriterError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRemoveProjectWriterBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *projectservice.ConflictError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRemoveProjectWriterConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRemoveProjectWriterForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRemoveProjectWriterInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRemoveProjectWriterNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRemoveProjectWriterServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeAddProjectAuditorResponse returns an encoder for responses returned by
+// the project-service add-project-auditor endpoint.
+func EncodeAddProjectAuditorResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectSettings)
+		enc := encoder(ctx, w)
+		body := NewAddProjectAuditorResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeAddProjectAuditorRequest returns a decoder for requests sent to the
+// project-service add-project-auditor endpoint.
+func DecodeAddProjectAuditorRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.AddProjectAuditorPayload, error) {
+	return func(r *http.Request) (*projectservice.AddProjectAuditorPayload, error) {
+		var (
+			uid         string
+			username    string
+			version     *string
+			bearerToken *string
+			xSync       *bool
+			ifMatch     *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		username = params["username"]
+		if utf8.RuneCountInString(username) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 1, true))
+		}
+		if utf8.RuneCountInString(username) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 100, false))
+		}
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		{
+			xSyncRaw := r.Header.Get("X-Sync")
+			if xSyncRaw != "" {
+				v, err2 := strconv.ParseBool(xSyncRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+				}
+				xSync = &v
+			}
+		}
+		ifMatchRaw := r.Header.Get("If-Match")
+		if ifMatchRaw != "" {
+			ifMatch = &ifMatchRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewAddProjectAuditorPayload(uid, username, version, bearerToken, xSync, ifMatch)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeAddProjectAuditorError returns an encoder for errors returned by the
+// add-project-auditor project-service endpoint.
+func EncodeAddProjectAuditorError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddProjectAuditorBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *projectservice.ConflictError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddProjectAuditorConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddProjectAuditorForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddProjectAuditorInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddProjectAuditorNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddProjectAuditorServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRemoveProjectAuditorResponse returns an encoder for responses returned
+// by the project-service remove-project-auditor endpoint.
+func EncodeRemoveProjectAuditorResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeRemoveProjectAuditorRequest returns a decoder for requests sent to the
+// project-service remove-project-auditor endpoint.
+func DecodeRemoveProjectAuditorRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.RemoveProjectAuditorPayload, error) {
+	return func(r *http.Request) (*projectservice.RemoveProjectAuditorPayload, error) {
+		var (
+			uid         string
+			username    string
+			version     *string
+			bearerToken *string
+			xSync       *bool
+			ifMatch     *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		username = params["username"]
+		if utf8.RuneCountInString(username) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 1, true))
+		}
+		if utf8.RuneCountInString(username) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 100, false))
+		}
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		{
+			xSyncRaw := r.Header.Get("X-Sync")
+			if xSyncRaw != "" {
+				v, err2 := strconv.ParseBool(xSyncRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+				}
+				xSync = &v
+			}
+		}
+		ifMatchRaw := r.Header.Get("If-Match")
+		if ifMatchRaw != "" {
+			ifMatch = &ifMatchRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRemoveProjectAuditorPayload(uid, username, version, bearerToken, xSync, ifMatch)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeRemoveProjectAuditorError returns an encoder for errors returned by
+// the remove-project-auditor project-service endpoint.
+func EncodeRemoveProjectAuditorError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRemoveProjectAuditorBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *projectservice.ConflictError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRemoveProjectAuditorConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRemoveProjectAuditorForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRemoveProjectAuditorInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRemoveProjectAuditorNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRemoveProjectAuditorServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeJoinProjectResponse returns an encoder for responses returned by the
+// project-service join-project endpoint.
+func EncodeJoinProjectResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectJoinResult)
+		enc := encoder(ctx, w)
+		body := NewJoinProjectResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeJoinProjectRequest returns a decoder for requests sent to the
+// project-service join-project endpoint.
+func DecodeJoinProjectRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.JoinProjectPayload, error) {
+	return func(r *http.Request) (*projectservice.JoinProjectPayload, error) {
+		var (
+			uid         string
+			version     *string
+			bearerToken *string
+			xSync       *bool
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		{
+			xSyncRaw := r.Header.Get("X-Sync")
+			if xSyncRaw != "" {
+				v, err2 := strconv.ParseBool(xSyncRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+				}
+				xSync = &v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewJoinProjectPayload(uid, version, bearerToken, xSync)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeJoinProjectError returns an encoder for errors returned by the
+// join-project project-service endpoint.
+func EncodeJoinProjectError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewJoinProjectBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *projectservice.ConflictError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewJoinProjectConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewJoinProjectForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewJoinProjectInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewJoinProjectNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewJoinProjectServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeBulkUpdateProjectMembersResponse returns an encoder for responses
+// returned by the project-service bulk-update-project-members endpoint.
+func EncodeBulkUpdateProjectMembersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.BulkProjectMembersResult)
+		enc := encoder(ctx, w)
+		body := NewBulkUpdateProjectMembersResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeBulkUpdateProjectMembersRequest returns a decoder for requests sent to
+// the project-service bulk-update-project-members endpoint.
+func DecodeBulkUpdateProjectMembersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.BulkUpdateProjectMembersPayload, error) {
+	return func(r *http.Request) (*projectservice.BulkUpdateProjectMembersPayload, error) {
+		var (
+			body BulkUpdateProjectMembersRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateBulkUpdateProjectMembersRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			version     *string
+			bearerToken *string
+			xSync       *bool
+		)
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		{
+			xSyncRaw := r.Header.Get("X-Sync")
+			if xSyncRaw != "" {
+				v, err2 := strconv.ParseBool(xSyncRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+				}
+				xSync = &v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewBulkUpdateProjectMembersPayload(&body, version, bearerToken, xSync)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeBulkUpdateProjectMembersError returns an encoder for errors returned
+// by the bulk-update-project-members project-service endpoint.
+func EncodeBulkUpdateProjectMembersError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewBulkUpdateProjectMembersBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewBulkUpdateProjectMembersForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewBulkUpdateProjectMembersInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewBulkUpdateProjectMembersServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetProjectsResponse returns an encoder for responses returned by the
+// project-service get-projects endpoint.
+func EncodeGetProjectsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.GetProjectsResult)
+		enc := encoder(ctx, w)
+		body := NewGetProjectsResponseBody(res)
+		if res.CacheControl != nil {
+			w.Header().Set("Cache-Control", *res.CacheControl)
+		}
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetProjectsRequest returns a decoder for requests sent to the
+// project-service get-projects endpoint.
+func DecodeGetProjectsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetProjectsPayload, error) {
+	return func(r *http.Request) (*projectservice.GetProjectsPayload, error) {
+		var (
+			version     *string
+			tag         []string
+			fields      *string
+			baseOnly    bool
+			bearerToken *string
+			err         error
+		)
+		qp := r.URL.Query()
+		versionRaw := qp.Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1" || *version == "2") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1", "2"}))
+			}
+		}
+		tag = qp["tag"]
+		fieldsRaw := qp.Get("fields")
+		if fieldsRaw != "" {
+			fields = &fieldsRaw
+		}
+		{
+			baseOnlyRaw := qp.Get("base_only")
+			if baseOnlyRaw != "" {
+				v, err2 := strconv.ParseBool(baseOnlyRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("base_only", baseOnlyRaw, "boolean"))
+				}
+				baseOnly = v
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetProjectsPayload(version, tag, fields, baseOnly, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetProjectsError returns an encoder for errors returned by the
+// get-projects project-service endpoint.
+func EncodeGetProjectsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectsForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCreateProjectResponse returns an encoder for responses returned by the
+// project-service create-project endpoint.
+func EncodeCreateProjectResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectFull)
+		enc := encoder(ctx, w)
+		body := NewCreateProjectResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateProjectRequest returns a decoder for requests sent to the
+// project-service create-project endpoint.
+func DecodeCreateProjectRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.CreateProjectPayload, error) {
+	return func(r *http.Request) (*projectservice.CreateProjectPayload, error) {
+		var (
+			body CreateProjectRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateProjectRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			version         *string
+			allowDuplicates bool
+			template        *string
+			bearerToken     *string
+			xSync           *bool
+		)
+		qp := r.URL.Query()
+		versionRaw := qp.Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		{
+			allowDuplicatesRaw := qp.Get("allow_duplicates")
+			if allowDuplicatesRaw != "" {
+				v, err2 := strconv.ParseBool(allowDuplicatesRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("allow_duplicates", allowDuplicatesRaw, "boolean"))
+				}
+				allowDuplicates = v
+			}
+		}
+		templateRaw := qp.Get("template")
+		if templateRaw != "" {
+			template = &templateRaw
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		{
+			xSyncRaw := r.Header.Get("X-Sync")
+			if xSyncRaw != "" {
+				v, err2 := strconv.ParseBool(xSyncRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+				}
+				xSync = &v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCreateProjectPayload(&body, version, allowDuplicates, template, bearerToken, xSync)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeCreateProjectError returns an encoder for errors returned by the
+// create-project project-service endpoint.
+func EncodeCreateProjectError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateProjectBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *projectservice.ConflictError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateProjectConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateProjectForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateProjectInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateProjectServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "UnprocessableEntity":
+			var res *projectservice.UnprocessableEntityError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateProjectUnprocessableEntityResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetOneProjectBaseResponse returns an encoder for responses returned by
+// the project-service get-one-project-base endpoint.
+func EncodeGetOneProjectBaseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.GetOneProjectBaseResult)
+		enc := encoder(ctx, w)
+		body := NewGetOneProjectBaseResponseBody(res)
+		if res.Etag != nil {
+			w.Header().Set("Etag", *res.Etag)
+		}
+		if res.CacheControl != nil {
+			w.Header().Set("Cache-Control", *res.CacheControl)
+		}
+		if res.SettingsEtag != nil {
+			w.Header().Set("X-Settings-Etag", *res.SettingsEtag)
+		}
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetOneProjectBaseRequest returns a decoder for requests sent to the
+// project-service get-one-project-base endpoint.
+func DecodeGetOneProjectBaseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetOneProjectBasePayload, error) {
+	return func(r *http.Request) (*projectservice.GetOneProjectBasePayload, error) {
+		var (
+			uid         string
+			version     *string
+			fields      *string
+			expand      *string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		qp := r.URL.Query()
+		versionRaw := qp.Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		fieldsRaw := qp.Get("fields")
+		if fieldsRaw != "" {
+			fields = &fieldsRaw
+		}
+		expandRaw := qp.Get("expand")
+		if expandRaw != "" {
+			expand = &expandRaw
+		}
+		if expand != nil {
+			if !(*expand == "settings") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("expand", *expand, []any{"settings"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetOneProjectBasePayload(uid, version, fields, expand, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetOneProjectBaseError returns an encoder for errors returned by the
+// get-one-project-base project-service endpoint.
+func EncodeGetOneProjectBaseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetOneProjectBaseBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetOneProjectBaseForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetOneProjectBaseInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetOneProjectBaseNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetOneProjectBaseServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetProjectUIDFromLegacyIDResponse returns an encoder for responses
+// returned by the project-service get-project-uid-from-legacy-id endpoint.
+func EncodeGetProjectUIDFromLegacyIDResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.GetProjectUIDFromLegacyIDResult)
+		enc := encoder(ctx, w)
+		body := NewGetProjectUIDFromLegacyIDResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetProjectUIDFromLegacyIDRequest returns a decoder for requests sent
+// to the project-service get-project-uid-from-legacy-id endpoint.
+func DecodeGetProjectUIDFromLegacyIDRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetProjectUIDFromLegacyIDPayload, error) {
+	return func(r *http.Request) (*projectservice.GetProjectUIDFromLegacyIDPayload, error) {
+		var (
+			legacyID    string
+			version     *string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		legacyID = params["legacy_id"]
+		err = goa.MergeErrors(err, goa.ValidatePattern("legacy_id", legacyID, "^[A-Za-z0-9]+$"))
+		if utf8.RuneCountInString(legacyID) > 64 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("legacy_id", legacyID, utf8.RuneCountInString(legacyID), 64, false))
+		}
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetProjectUIDFromLegacyIDPayload(legacyID, version, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetProjectUIDFromLegacyIDError returns an encoder for errors returned
+// by the get-project-uid-from-legacy-id project-service endpoint.
+func EncodeGetProjectUIDFromLegacyIDError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectUIDFromLegacyIDBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectUIDFromLegacyIDForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectUIDFromLegacyIDInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectUIDFromLegacyIDNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectUIDFromLegacyIDServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetOneProjectSettingsResponse returns an encoder for responses
+// returned by the project-service get-one-project-settings endpoint.
+func EncodeGetOneProjectSettingsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.GetOneProjectSettingsResult)
+		enc := encoder(ctx, w)
+		body := NewGetOneProjectSettingsResponseBody(res)
+		if res.Etag != nil {
+			w.Header().Set("Etag", *res.Etag)
+		}
+		if res.CacheControl != nil {
+			w.Header().Set("Cache-Control", *res.CacheControl)
+		}
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetOneProjectSettingsRequest returns a decoder for requests sent to
+// the project-service get-one-project-settings endpoint.
+func DecodeGetOneProjectSettingsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetOneProjectSettingsPayload, error) {
+	return func(r *http.Request) (*projectservice.GetOneProjectSettingsPayload, error) {
+		var (
+			uid         string
+			version     *string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetOneProjectSettingsPayload(uid, version, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetOneProjectSettingsError returns an encoder for errors returned by
+// the get-one-project-settings project-service endpoint.
+func EncodeGetOneProjectSettingsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetOneProjectSettingsForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetOneProjectSettingsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetOneProjectSettingsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetOneProjectSettingsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUpdateProjectBaseResponse returns an encoder for responses returned by
+// the project-service update-project-base endpoint.
+func EncodeUpdateProjectBaseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectBase)
+		enc := encoder(ctx, w)
+		body := NewUpdateProjectBaseResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpdateProjectBaseRequest returns a decoder for requests sent to the
+// project-service update-project-base endpoint.
+func DecodeUpdateProjectBaseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.UpdateProjectBasePayload, error) {
+	return func(r *http.Request) (*projectservice.UpdateProjectBasePayload, error) {
+		var (
+			body UpdateProjectBaseRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateProjectBaseRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			uid           string
+			version       *string
+			bearerToken   *string
+			xSync         *bool
+			ifMatch       *string
+			forceUpdate   *bool
+			adminOverride *bool
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		{
+			xSyncRaw := r.Header.Get("X-Sync")
+			if xSyncRaw != "" {
+				v, err2 := strconv.ParseBool(xSyncRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+				}
+				xSync = &v
+			}
+		}
+		ifMatchRaw := r.Header.Get("If-Match")
+		if ifMatchRaw != "" {
+			ifMatch = &ifMatchRaw
+		}
+		{
+			forceUpdateRaw := r.Header.Get("X-Force-Update")
+			if forceUpdateRaw != "" {
+				v, err2 := strconv.ParseBool(forceUpdateRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("force_update", forceUpdateRaw, "boolean"))
+				}
+				forceUpdate = &v
+			}
+		}
+		{
+			adminOverrideRaw := r.Header.Get("X-Admin-Override")
+			if adminOverrideRaw != "" {
+				v, err2 := strconv.ParseBool(adminOverrideRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("admin_override", adminOverrideRaw, "boolean"))
+				}
+				adminOverride = &v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewUpdateProjectBasePayload(&body, uid, version, bearerToken, xSync, ifMatch, forceUpdate, adminOverride)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateProjectBaseError returns an encoder for errors returned by the
+// update-project-base project-service endpoint.
+func EncodeUpdateProjectBaseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectBaseBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *projectservice.ConflictError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectBaseConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectBaseForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectBaseInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectBaseNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectBaseServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "UnprocessableEntity":
+			var res *projectservice.UnprocessableEntityError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectBaseUnprocessableEntityResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUpdateProjectSettingsResponse returns an encoder for responses
+// returned by the project-service update-project-settings endpoint.
+func EncodeUpdateProjectSettingsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectSettings)
+		enc := encoder(ctx, w)
+		body := NewUpdateProjectSettingsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpdateProjectSettingsRequest returns a decoder for requests sent to
+// the project-service update-project-settings endpoint.
+func DecodeUpdateProjectSettingsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.UpdateProjectSettingsPayload, error) {
+	return func(r *http.Request) (*projectservice.UpdateProjectSettingsPayload, error) {
+		var (
+			body UpdateProjectSettingsRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateProjectSettingsRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			uid         string
+			version     *string
+			bearerToken *string
+			xSync       *bool
+			ifMatch     *string
+			forceUpdate *bool
+			merge       *bool
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		{
+			xSyncRaw := r.Header.Get("X-Sync")
+			if xSyncRaw != "" {
+				v, err2 := strconv.ParseBool(xSyncRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+				}
+				xSync = &v
+			}
+		}
+		ifMatchRaw := r.Header.Get("If-Match")
+		if ifMatchRaw != "" {
+			ifMatch = &ifMatchRaw
+		}
+		{
+			forceUpdateRaw := r.Header.Get("X-Force-Update")
+			if forceUpdateRaw != "" {
+				v, err2 := strconv.ParseBool(forceUpdateRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("force_update", forceUpdateRaw, "boolean"))
+				}
+				forceUpdate = &v
+			}
+		}
+		{
+			mergeRaw := r.Header.Get("X-Merge")
+			if mergeRaw != "" {
+				v, err2 := strconv.ParseBool(mergeRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("merge", mergeRaw, "boolean"))
+				}
+				merge = &v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewUpdateProjectSettingsPayload(&body, uid, version, bearerToken, xSync, ifMatch, forceUpdate, merge)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateProjectSettingsError returns an encoder for errors returned by
+// the update-project-settings project-service endpoint.
+func EncodeUpdateProjectSettingsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectSettingsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *projectservice.ConflictError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectSettingsConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectSettingsForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectSettingsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectSettingsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectSettingsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "UnprocessableEntity":
+			var res *projectservice.UnprocessableEntityError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectSettingsUnprocessableEntityResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDeleteProjectResponse returns an encoder for responses returned by the
+// project-service delete-project endpoint.
+func EncodeDeleteProjectResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeDeleteProjectRequest returns a decoder for requests sent to the
+// project-service delete-project endpoint.
+func DecodeDeleteProjectRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.DeleteProjectPayload, error) {
+	return func(r *http.Request) (*projectservice.DeleteProjectPayload, error) {
+		var (
+			uid         string
+			version     *string
+			bearerToken *string
+			xSync       *bool
+			ifMatch     *string
+			forceUpdate *bool
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		{
+			xSyncRaw := r.Header.Get("X-Sync")
+			if xSyncRaw != "" {
+				v, err2 := strconv.ParseBool(xSyncRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+				}
+				xSync = &v
+			}
+		}
+		ifMatchRaw := r.Header.Get("If-Match")
+		if ifMatchRaw != "" {
+			ifMatch = &ifMatchRaw
+		}
+		{
+			forceUpdateRaw := r.Header.Get("X-Force-Update")
+			if forceUpdateRaw != "" {
+				v, err2 := strconv.ParseBool(forceUpdateRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("force_update", forceUpdateRaw, "boolean"))
+				}
+				forceUpdate = &v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteProjectPayload(uid, version, bearerToken, xSync, ifMatch, forceUpdate)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteProjectError returns an encoder for errors returned by the
+// delete-project project-service endpoint.
+func EncodeDeleteProjectError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteProjectBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *projectservice.ConflictError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteProjectConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteProjectForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteProjectInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteProjectNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteProjectServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeBulkDeleteProjectsResponse returns an encoder for responses returned
+// by the project-service bulk-delete-projects endpoint.
+func EncodeBulkDeleteProjectsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.BulkProjectDeletionResult)
+		enc := encoder(ctx, w)
+		body := NewBulkDeleteProjectsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeBulkDeleteProjectsRequest returns a decoder for requests sent to the
+// project-service bulk-delete-projects endpoint.
+func DecodeBulkDeleteProjectsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.BulkDeleteProjectsPayload, error) {
+	return func(r *http.Request) (*projectservice.BulkDeleteProjectsPayload, error) {
+		var (
+			body BulkDeleteProjectsRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateBulkDeleteProjectsRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			version     *string
+			dryRun      bool
+			cascade     bool
+			force       bool
+			bearerToken *string
+			xSync       *bool
+		)
+		qp := r.URL.Query()
+		versionRaw := qp.Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		{
+			dryRunRaw := qp.Get("dry_run")
+			if dryRunRaw != "" {
+				v, err2 := strconv.ParseBool(dryRunRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("dry_run", dryRunRaw, "boolean"))
+				}
+				dryRun = v
+			}
+		}
+		{
+			cascadeRaw := qp.Get("cascade")
+			if cascadeRaw != "" {
+				v, err2 := strconv.ParseBool(cascadeRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("cascade", cascadeRaw, "boolean"))
+				}
+				cascade = v
+			}
+		}
+		{
+			forceRaw := qp.Get("force")
+			if forceRaw != "" {
+				v, err2 := strconv.ParseBool(forceRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("force", forceRaw, "boolean"))
+				}
+				force = v
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		{
+			xSyncRaw := r.Header.Get("X-Sync")
+			if xSyncRaw != "" {
+				v, err2 := strconv.ParseBool(xSyncRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+				}
+				xSync = &v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewBulkDeleteProjectsPayload(&body, version, dryRun, cascade, force, bearerToken, xSync)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeBulkDeleteProjectsError returns an encoder for errors returned by the
+// bulk-delete-projects project-service endpoint.
+func EncodeBulkDeleteProjectsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewBulkDeleteProjectsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewBulkDeleteProjectsForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewBulkDeleteProjectsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewBulkDeleteProjectsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeReadyzResponse returns an encoder for responses returned by the
+// project-service readyz endpoint.
+func EncodeReadyzResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]byte)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "text/plain")
+		enc := encoder(ctx, w)
+		body := res
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// EncodeReadyzError returns an encoder for errors returned by the readyz
+// project-service endpoint.
+func EncodeReadyzError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReadyzServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeLivezResponse returns an encoder for responses returned by the
+// project-service livez endpoint.
+func EncodeLivezResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]byte)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "text/plain")
+		enc := encoder(ctx, w)
+		body := res
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// EncodeHealthzResponse returns an encoder for responses returned by the
+// project-service healthz endpoint.
+func EncodeHealthzResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.HealthReport)
+		enc := encoder(ctx, w)
+		body := NewHealthzResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// EncodeHealthzError returns an encoder for errors returned by the healthz
+// project-service endpoint.
+func EncodeHealthzError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Unhealthy":
+			var res *projectservice.HealthReport
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewHealthzUnhealthyResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeReconcileOutboxResponse returns an encoder for responses returned by
+// the project-service reconcile-outbox endpoint.
+func EncodeReconcileOutboxResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.OutboxReconcileResult)
+		enc := encoder(ctx, w)
+		body := NewReconcileOutboxResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeReconcileOutboxRequest returns a decoder for requests sent to the
+// project-service reconcile-outbox endpoint.
+func DecodeReconcileOutboxRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.ReconcileOutboxPayload, error) {
+	return func(r *http.Request) (*projectservice.ReconcileOutboxPayload, error) {
+		var (
+			bearerToken *string
+		)
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewReconcileOutboxPayload(bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeReconcileOutboxError returns an encoder for errors returned by the
+// reconcile-outbox project-service endpoint.
+func EncodeReconcileOutboxError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReconcileOutboxForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReconcileOutboxInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReconcileOutboxServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeResyncProjectsResponse returns an encoder for responses returned by
+// the project-service resync-projects endpoint.
+func EncodeResyncProjectsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ResyncProjectsResult)
+		enc := encoder(ctx, w)
+		body := NewResyncProjectsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeResyncProjectsRequest returns a decoder for requests sent to the
+// project-service resync-projects endpoint.
+func DecodeResyncProjectsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.ResyncProjectsPayload, error) {
+	return func(r *http.Request) (*projectservice.ResyncProjectsPayload, error) {
+		var (
+			body ResyncProjectsRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateResyncProjectsRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			bearerToken *string
+		)
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewResyncProjectsPayload(&body, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeResyncProjectsError returns an encoder for errors returned by the
+// resync-projects project-service endpoint.
+func EncodeResyncProjectsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewResyncProjectsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewResyncProjectsForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewResyncProjectsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewResyncProjectsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListDeadLettersResponse returns an encoder for responses returned by
+// the project-service list-dead-letters endpoint.
+func EncodeListDeadLettersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.DeadLetterList)
+		enc := encoder(ctx, w)
+		body := NewListDeadLettersResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListDeadLettersRequest returns a decoder for requests sent to the
+// project-service list-dead-letters endpoint.
+func DecodeListDeadLettersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.ListDeadLettersPayload, error) {
+	return func(r *http.Request) (*projectservice.ListDeadLettersPayload, error) {
+		var (
+			bearerToken *string
+		)
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewListDeadLettersPayload(bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListDeadLettersError returns an encoder for errors returned by the
+// list-dead-letters project-service endpoint.
+func EncodeListDeadLettersError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListDeadLettersForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListDeadLettersInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListDeadLettersServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeReplayDeadLetterResponse returns an encoder for responses returned by
+// the project-service replay-dead-letter endpoint.
+func EncodeReplayDeadLetterResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ReplayDeadLetterResult)
+		enc := encoder(ctx, w)
+		body := NewReplayDeadLetterResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeReplayDeadLetterRequest returns a decoder for requests sent to the
+// project-service replay-dead-letter endpoint.
+func DecodeReplayDeadLetterRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.ReplayDeadLetterPayload, error) {
+	return func(r *http.Request) (*projectservice.ReplayDeadLetterPayload, error) {
+		var (
+			id          string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewReplayDeadLetterPayload(id, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeReplayDeadLetterError returns an encoder for errors returned by the
+// replay-dead-letter project-service endpoint.
+func EncodeReplayDeadLetterError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReplayDeadLetterForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReplayDeadLetterInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReplayDeadLetterNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewReplayDeadLetterServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRebuildSlugMappingsResponse returns an encoder for responses returned
+// by the project-service rebuild-slug-mappings endpoint.
+func EncodeRebuildSlugMappingsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.SlugRebuildResult)
+		enc := encoder(ctx, w)
+		body := NewRebuildSlugMappingsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRebuildSlugMappingsRequest returns a decoder for requests sent to the
+// project-service rebuild-slug-mappings endpoint.
+func DecodeRebuildSlugMappingsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.RebuildSlugMappingsPayload, error) {
+	return func(r *http.Request) (*projectservice.RebuildSlugMappingsPayload, error) {
+		var (
+			body RebuildSlugMappingsRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+
+		var (
+			bearerToken *string
+		)
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewRebuildSlugMappingsPayload(&body, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeRebuildSlugMappingsError returns an encoder for errors returned by the
+// rebuild-slug-mappings project-service endpoint.
+func EncodeRebuildSlugMappingsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRebuildSlugMappingsForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRebuildSlugMappingsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRebuildSlugMappingsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetIntegrityReportResponse returns an encoder for responses returned
+// by the project-service get-integrity-report endpoint.
+func EncodeGetIntegrityReportResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.IntegrityReport)
+		enc := encoder(ctx, w)
+		body := NewGetIntegrityReportResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetIntegrityReportRequest returns a decoder for requests sent to the
+// project-service get-integrity-report endpoint.
+func DecodeGetIntegrityReportRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetIntegrityReportPayload, error) {
+	return func(r *http.Request) (*projectservice.GetIntegrityReportPayload, error) {
+		var (
+			bearerToken *string
+		)
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewGetIntegrityReportPayload(bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetIntegrityReportError returns an encoder for errors returned by the
+// get-integrity-report project-service endpoint.
+func EncodeGetIntegrityReportError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetIntegrityReportForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetIntegrityReportInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetIntegrityReportServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeInspectProjectKvResponse returns an encoder for responses returned by
+// the project-service inspect-project-kv endpoint.
+func EncodeInspectProjectKvResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectKVInspection)
+		enc := encoder(ctx, w)
+		body := NewInspectProjectKvResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeInspectProjectKvRequest returns a decoder for requests sent to the
+// project-service inspect-project-kv endpoint.
+func DecodeInspectProjectKvRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.InspectProjectKvPayload, error) {
+	return func(r *http.Request) (*projectservice.InspectProjectKvPayload, error) {
+		var (
+			uid         string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewInspectProjectKvPayload(uid, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeInspectProjectKvError returns an encoder for errors returned by the
+// inspect-project-kv project-service endpoint.
+func EncodeInspectProjectKvError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewInspectProjectKvForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewInspectProjectKvInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewInspectProjectKvNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewInspectProjectKvServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCreateBackupResponse returns an encoder for responses returned by the
+// project-service create-backup endpoint.
+func EncodeCreateBackupResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.BackupResult)
+		enc := encoder(ctx, w)
+		body := NewCreateBackupResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateBackupRequest returns a decoder for requests sent to the
+// project-service create-backup endpoint.
+func DecodeCreateBackupRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.CreateBackupPayload, error) {
+	return func(r *http.Request) (*projectservice.CreateBackupPayload, error) {
+		var (
+			bearerToken *string
+		)
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewCreateBackupPayload(bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeCreateBackupError returns an encoder for errors returned by the
+// create-backup project-service endpoint.
+func EncodeCreateBackupError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateBackupForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateBackupInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateBackupServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListBackupsResponse returns an encoder for responses returned by the
+// project-service list-backups endpoint.
+func EncodeListBackupsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.BackupList)
+		enc := encoder(ctx, w)
+		body := NewListBackupsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListBackupsRequest returns a decoder for requests sent to the
+// project-service list-backups endpoint.
+func DecodeListBackupsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.ListBackupsPayload, error) {
+	return func(r *http.Request) (*projectservice.ListBackupsPayload, error) {
+		var (
+			bearerToken *string
+		)
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewListBackupsPayload(bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListBackupsError returns an encoder for errors returned by the
+// list-backups project-service endpoint.
+func EncodeListBackupsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListBackupsForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListBackupsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListBackupsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRestoreBackupResponse returns an encoder for responses returned by the
+// project-service restore-backup endpoint.
+func EncodeRestoreBackupResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.RestoreBackupResult)
+		enc := encoder(ctx, w)
+		body := NewRestoreBackupResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRestoreBackupRequest returns a decoder for requests sent to the
+// project-service restore-backup endpoint.
+func DecodeRestoreBackupRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.RestoreBackupPayload, error) {
+	return func(r *http.Request) (*projectservice.RestoreBackupPayload, error) {
+		var (
+			body RestoreBackupRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+
+		var (
+			name        string
+			bearerToken *string
+
+			params = mux.Vars(r)
+		)
+		name = params["name"]
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewRestoreBackupPayload(&body, name, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeRestoreBackupError returns an encoder for errors returned by the
+// restore-backup project-service endpoint.
+func EncodeRestoreBackupError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRestoreBackupBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRestoreBackupForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRestoreBackupInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRestoreBackupNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRestoreBackupServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListPendingAnnouncementsResponse returns an encoder for responses
+// returned by the project-service list-pending-announcements endpoint.
+func EncodeListPendingAnnouncementsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.PendingAnnouncementList)
+		enc := encoder(ctx, w)
+		body := NewListPendingAnnouncementsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListPendingAnnouncementsRequest returns a decoder for requests sent to
+// the project-service list-pending-announcements endpoint.
+func DecodeListPendingAnnouncementsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.ListPendingAnnouncementsPayload, error) {
+	return func(r *http.Request) (*projectservice.ListPendingAnnouncementsPayload, error) {
+		var (
+			bearerToken *string
+		)
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewListPendingAnnouncementsPayload(bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListPendingAnnouncementsError returns an encoder for errors returned
+// by the list-pending-announcements project-service endpoint.
+func EncodeListPendingAnnouncementsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListPendingAnnouncementsForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListPendingAnnouncementsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListPendingAnnouncementsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetProjectEnumsResponse returns an encoder for responses returned by
+// the project-service get-project-enums endpoint.
+func EncodeGetProjectEnumsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectEnums)
+		enc := encoder(ctx, w)
+		body := NewGetProjectEnumsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetProjectEnumsRequest returns a decoder for requests sent to the
+// project-service get-project-enums endpoint.
+func DecodeGetProjectEnumsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetProjectEnumsPayload, error) {
+	return func(r *http.Request) (*projectservice.GetProjectEnumsPayload, error) {
+		var (
+			version     *string
+			bearerToken *string
+			err         error
+		)
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetProjectEnumsPayload(version, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetProjectEnumsError returns an encoder for errors returned by the
+// get-project-enums project-service endpoint.
+func EncodeGetProjectEnumsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectEnumsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectEnumsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectEnumsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUpdateProjectEnumsResponse returns an encoder for responses returned
+// by the project-service update-project-enums endpoint.
+func EncodeUpdateProjectEnumsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectEnums)
+		enc := encoder(ctx, w)
+		body := NewUpdateProjectEnumsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpdateProjectEnumsRequest returns a decoder for requests sent to the
+// project-service update-project-enums endpoint.
+func DecodeUpdateProjectEnumsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.UpdateProjectEnumsPayload, error) {
+	return func(r *http.Request) (*projectservice.UpdateProjectEnumsPayload, error) {
+		var (
+			body UpdateProjectEnumsRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateProjectEnumsRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			bearerToken *string
+		)
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewUpdateProjectEnumsPayload(&body, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateProjectEnumsError returns an encoder for errors returned by the
+// update-project-enums project-service endpoint.
+func EncodeUpdateProjectEnumsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectEnumsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectEnumsForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectEnumsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectEnumsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCreateProjectTemplateResponse returns an encoder for responses
+// returned by the project-service create-project-template endpoint.
+func EncodeCreateProjectTemplateResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectTemplate)
+		enc := encoder(ctx, w)
+		body := NewCreateProjectTemplateResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateProjectTemplateRequest returns a decoder for requests sent to
+// the project-service create-project-template endpoint.
+func DecodeCreateProjectTemplateRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.CreateProjectTemplatePayload, error) {
+	return func(r *http.Request) (*projectservice.CreateProjectTemplatePayload, error) {
+		var (
+			body CreateProjectTemplateRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateProjectTemplateRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			bearerToken *string
+		)
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewCreateProjectTemplatePayload(&body, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeCreateProjectTemplateError returns an encoder for errors returned by
+// the create-project-template project-service endpoint.
+func EncodeCreateProjectTemplateError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateProjectTemplateBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *projectservice.ConflictError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateProjectTemplateConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateProjectTemplateForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateProjectTemplateInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateProjectTemplateServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListProjectTemplatesResponse returns an encoder for responses returned
+// by the project-service list-project-templates endpoint.
+func EncodeListProjectTemplatesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectTemplateList)
+		enc := encoder(ctx, w)
+		body := NewListProjectTemplatesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListProjectTemplatesRequest returns a decoder for requests sent to the
+// project-service list-project-templates endpoint.
+func DecodeListProjectTemplatesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.ListProjectTemplatesPayload, error) {
+	return func(r *http.Request) (*projectservice.ListProjectTemplatesPayload, error) {
+		var (
+			bearerToken *string
+		)
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewListProjectTemplatesPayload(bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListProjectTemplatesError returns an encoder for errors returned by
+// the list-project-templates project-service endpoint.
+func EncodeListProjectTemplatesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListProjectTemplatesForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListProjectTemplatesInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListProjectTemplatesServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetProjectTemplateResponse returns an encoder for responses returned
+// by the project-service get-project-template endpoint.
+func EncodeGetProjectTemplateResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectTemplate)
+		enc := encoder(ctx, w)
+		body := NewGetProjectTemplateResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetProjectTemplateRequest returns a decoder for requests sent to the
+// project-service get-project-template endpoint.
+func DecodeGetProjectTemplateRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetProjectTemplatePayload, error) {
+	return func(r *http.Request) (*projectservice.GetProjectTemplatePayload, error) {
+		var (
+			name        string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		name = params["name"]
+		err = goa.MergeErrors(err, goa.ValidatePattern("name", name, "^[a-z0-9]+(-[a-z0-9]+)*$"))
+		if utf8.RuneCountInString(name) > 63 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("name", name, utf8.RuneCountInString(name), 63, false))
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetProjectTemplatePayload(name, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetProjectTemplateError returns an encoder for errors returned by the
+// get-project-template project-service endpoint.
+func EncodeGetProjectTemplateError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectTemplateForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectTemplateInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectTemplateNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectTemplateServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUpdateProjectTemplateResponse returns an encoder for responses
+// returned by the project-service update-project-template endpoint.
+func EncodeUpdateProjectTemplateResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectTemplate)
+		enc := encoder(ctx, w)
+		body := NewUpdateProjectTemplateResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpdateProjectTemplateRequest returns a decoder for requests sent to
+// the project-service update-project-template endpoint.
+func DecodeUpdateProjectTemplateRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.UpdateProjectTemplatePayload, error) {
+	return func(r *http.Request) (*projectservice.UpdateProjectTemplatePayload, error) {
+		var (
+			body UpdateProjectTemplateRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateProjectTemplateRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			name        string
+			bearerToken *string
+
+			params = mux.Vars(r)
+		)
+		name = params["name"]
+		err = goa.MergeErrors(err, goa.ValidatePattern("name", name, "^[a-z0-9]+(-[a-z0-9]+)*$"))
+		if utf8.RuneCountInString(name) > 63 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("name", name, utf8.RuneCountInString(name), 63, false))
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewUpdateProjectTemplatePayload(&body, name, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateProjectTemplateError returns an encoder for errors returned by
+// the update-project-template project-service endpoint.
+func EncodeUpdateProjectTemplateError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectTemplateBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Conflict":
+			var res *projectservice.ConflictError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectTemplateConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectTemplateForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectTemplateInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectTemplateNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateProjectTemplateServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDeleteProjectTemplateResponse returns an encoder for responses
+// returned by the project-service delete-project-template endpoint.
+func EncodeDeleteProjectTemplateResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeDeleteProjectTemplateRequest returns a decoder for requests sent to
+// the project-service delete-project-template endpoint.
+func DecodeDeleteProjectTemplateRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.DeleteProjectTemplatePayload, error) {
+	return func(r *http.Request) (*projectservice.DeleteProjectTemplatePayload, error) {
+		var (
+			name        string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		name = params["name"]
+		err = goa.MergeErrors(err, goa.ValidatePattern("name", name, "^[a-z0-9]+(-[a-z0-9]+)*$"))
+		if utf8.RuneCountInString(name) > 63 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("name", name, utf8.RuneCountInString(name), 63, false))
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteProjectTemplatePayload(name, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteProjectTemplateError returns an encoder for errors returned by
+// the delete-project-template project-service endpoint.
+func EncodeDeleteProjectTemplateError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteProjectTemplateForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteProjectTemplateInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteProjectTemplateNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteProjectTemplateServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListProjectDeletionsResponse returns an encoder for responses returned
+// by the project-service list-project-deletions endpoint.
+func EncodeListProjectDeletionsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectDeletionList)
+		enc := encoder(ctx, w)
+		body := NewListProjectDeletionsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListProjectDeletionsRequest returns a decoder for requests sent to the
+// project-service list-project-deletions endpoint.
+func DecodeListProjectDeletionsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.ListProjectDeletionsPayload, error) {
+	return func(r *http.Request) (*projectservice.ListProjectDeletionsPayload, error) {
+		var (
+			version     *string
+			since       *string
+			bearerToken *string
+			err         error
+		)
+		qp := r.URL.Query()
+		versionRaw := qp.Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		sinceRaw := qp.Get("since")
+		if sinceRaw != "" {
+			since = &sinceRaw
+		}
+		if since != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("since", *since, goa.FormatDateTime))
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListProjectDeletionsPayload(version, since, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListProjectDeletionsError returns an encoder for errors returned by
+// the list-project-deletions project-service endpoint.
+func EncodeListProjectDeletionsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListProjectDeletionsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListProjectDeletionsForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListProjectDeletionsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListProjectDeletionsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListProjectEventsResponse returns an encoder for responses returned by
+// the project-service list-project-events endpoint.
+func EncodeListProjectEventsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectEventList)
+		enc := encoder(ctx, w)
+		body := NewListProjectEventsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListProjectEventsRequest returns a decoder for requests sent to the
+// project-service list-project-events endpoint.
+func DecodeListProjectEventsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.ListProjectEventsPayload, error) {
+	return func(r *http.Request) (*projectservice.ListProjectEventsPayload, error) {
+		var (
+			uid         string
+			version     *string
+			since       *string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		qp := r.URL.Query()
+		versionRaw := qp.Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		sinceRaw := qp.Get("since")
+		if sinceRaw != "" {
+			since = &sinceRaw
+		}
+		if since != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("since", *since, goa.FormatDateTime))
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListProjectEventsPayload(uid, version, since, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListProjectEventsError returns an encoder for errors returned by the
+// list-project-events project-service endpoint.
+func EncodeListProjectEventsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListProjectEventsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListProjectEventsForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListProjectEventsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListProjectEventsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListProjectEventsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetUserProjectsResponse returns an encoder for responses returned by
+// the project-service get-user-projects endpoint.
+func EncodeGetUserProjectsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.GetUserProjectsResult)
+		enc := encoder(ctx, w)
+		body := NewGetUserProjectsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetUserProjectsRequest returns a decoder for requests sent to the
+// project-service get-user-projects endpoint.
+func DecodeGetUserProjectsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetUserProjectsPayload, error) {
+	return func(r *http.Request) (*projectservice.GetUserProjectsPayload, error) {
+		var (
+			username    string
+			version     *string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		username = params["username"]
+		if utf8.RuneCountInString(username) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 1, true))
+		}
+		if utf8.RuneCountInString(username) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 100, false))
+		}
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetUserProjectsPayload(username, version, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetUserProjectsError returns an encoder for errors returned by the
+// get-user-projects project-service endpoint.
+func EncodeGetUserProjectsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetUserProjectsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetUserProjectsForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetUserProjectsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetUserProjectsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeExportUserDataResponse returns an encoder for responses returned by
+// the project-service export-user-data endpoint.
+func EncodeExportUserDataResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.UserDataExport)
+		enc := encoder(ctx, w)
+		body := NewExportUserDataResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeExportUserDataRequest returns a decoder for requests sent to the
+// project-service export-user-data endpoint.
+func DecodeExportUserDataRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.ExportUserDataPayload, error) {
+	return func(r *http.Request) (*projectservice.ExportUserDataPayload, error) {
+		var (
+			username    string
+			version     *string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		username = params["username"]
+		if utf8.RuneCountInString(username) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 1, true))
+		}
+		if utf8.RuneCountInString(username) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 100, false))
+		}
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewExportUserDataPayload(username, version, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeExportUserDataError returns an encoder for errors returned by the
+// export-user-data project-service endpoint.
+func EncodeExportUserDataError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewExportUserDataBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewExportUserDataForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewExportUserDataInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewExportUserDataServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRemoveUserAccessResponse returns an encoder for responses returned by
+// the project-service remove-user-access endpoint.
+func EncodeRemoveUserAccessResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.UserAccessRemovalResult)
+		enc := encoder(ctx, w)
+		body := NewRemoveUserAccessResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRemoveUserAccessRequest returns a decoder for requests sent to the
+// project-service remove-user-access endpoint.
+func DecodeRemoveUserAccessRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.RemoveUserAccessPayload, error) {
+	return func(r *http.Request) (*projectservice.RemoveUserAccessPayload, error) {
+		var (
+			username    string
+			version     *string
+			bearerToken *string
+			xSync       *bool
+			err         error
+
+			params = mux.Vars(r)
+		)
+		username = params["username"]
+		if utf8.RuneCountInString(username) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 1, true))
+		}
+		if utf8.RuneCountInString(username) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 100, false))
+		}
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		{
+			xSyncRaw := r.Header.Get("X-Sync")
+			if xSyncRaw != "" {
+				v, err2 := strconv.ParseBool(xSyncRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("x_sync", xSyncRaw, "boolean"))
+				}
+				xSync = &v
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRemoveUserAccessPayload(username, version, bearerToken, xSync)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeRemoveUserAccessError returns an encoder for errors returned by the
+// remove-user-access project-service endpoint.
+func EncodeRemoveUserAccessError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRemoveUserAccessBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRemoveUserAccessForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRemoveUserAccessInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRemoveUserAccessServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetProjectVisibilityImpactResponse returns an encoder for responses
+// returned by the project-service get-project-visibility-impact endpoint.
+func EncodeGetProjectVisibilityImpactResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.ProjectVisibilityImpact)
+		enc := encoder(ctx, w)
+		body := NewGetProjectVisibilityImpactResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetProjectVisibilityImpactRequest returns a decoder for requests sent
+// to the project-service get-project-visibility-impact endpoint.
+func DecodeGetProjectVisibilityImpactRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetProjectVisibilityImpactPayload, error) {
+	return func(r *http.Request) (*projectservice.GetProjectVisibilityImpactPayload, error) {
+		var (
+			uid         string
+			version     *string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		uid = params["uid"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("uid", uid, goa.FormatUUID))
+		versionRaw := r.URL.Query().Get("v")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		if version != nil {
+			if !(*version == "1") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", *version, []any{"1"}))
+			}
+		}
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetProjectVisibilityImpactPayload(uid, version, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetProjectVisibilityImpactError returns an encoder for errors returned
+// by the get-project-visibility-impact project-service endpoint.
+func EncodeGetProjectVisibilityImpactError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectVisibilityImpactBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectVisibilityImpactForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectVisibilityImpactInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectVisibilityImpactNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetProjectVisibilityImpactServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCreateWebhookResponse returns an encoder for responses returned by the
+// project-service create-webhook endpoint.
+func EncodeCreateWebhookResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.Webhook)
+		enc := encoder(ctx, w)
+		body := NewCreateWebhookResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateWebhookRequest returns a decoder for requests sent to the
+// project-service create-webhook endpoint.
+func DecodeCreateWebhookRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.CreateWebhookPayload, error) {
+	return func(r *http.Request) (*projectservice.CreateWebhookPayload, error) {
+		var (
+			body CreateWebhookRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateWebhookRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			bearerToken *string
+		)
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewCreateWebhookPayload(&body, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeCreateWebhookError returns an encoder for errors returned by the
+// create-webhook project-service endpoint.
+func EncodeCreateWebhookError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *projectservice.BadRequestError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateWebhookBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateWebhookForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateWebhookInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateWebhookServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListWebhooksResponse returns an encoder for responses returned by the
+// project-service list-webhooks endpoint.
+func EncodeListWebhooksResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.WebhookList)
+		enc := encoder(ctx, w)
+		body := NewListWebhooksResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListWebhooksRequest returns a decoder for requests sent to the
+// project-service list-webhooks endpoint.
+func DecodeListWebhooksRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.ListWebhooksPayload, error) {
+	return func(r *http.Request) (*projectservice.ListWebhooksPayload, error) {
+		var (
+			bearerToken *string
+		)
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		payload := NewListWebhooksPayload(bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListWebhooksError returns an encoder for errors returned by the
+// list-webhooks project-service endpoint.
+func EncodeListWebhooksError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListWebhooksForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListWebhooksInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListWebhooksServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetWebhookResponse returns an encoder for responses returned by the
+// project-service get-webhook endpoint.
+func EncodeGetWebhookResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.Webhook)
+		enc := encoder(ctx, w)
+		body := NewGetWebhookResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetWebhookRequest returns a decoder for requests sent to the
+// project-service get-webhook endpoint.
+func DecodeGetWebhookRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.GetWebhookPayload, error) {
+	return func(r *http.Request) (*projectservice.GetWebhookPayload, error) {
+		var (
+			id          string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetWebhookPayload(id, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetWebhookError returns an encoder for errors returned by the
+// get-webhook project-service endpoint.
+func EncodeGetWebhookError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetWebhookForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetWebhookInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetWebhookNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetWebhookServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDeleteWebhookResponse returns an encoder for responses returned by the
+// project-service delete-webhook endpoint.
+func EncodeDeleteWebhookResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeDeleteWebhookRequest returns a decoder for requests sent to the
+// project-service delete-webhook endpoint.
+func DecodeDeleteWebhookRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.DeleteWebhookPayload, error) {
+	return func(r *http.Request) (*projectservice.DeleteWebhookPayload, error) {
+		var (
+			id          string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteWebhookPayload(id, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteWebhookError returns an encoder for errors returned by the
+// delete-webhook project-service endpoint.
+func EncodeDeleteWebhookError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteWebhookForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteWebhookInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteWebhookNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteWebhookServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListWebhookDeliveriesResponse returns an encoder for responses
+// returned by the project-service list-webhook-deliveries endpoint.
+func EncodeListWebhookDeliveriesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*projectservice.WebhookDeliveryList)
+		enc := encoder(ctx, w)
+		body := NewListWebhookDeliveriesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListWebhookDeliveriesRequest returns a decoder for requests sent to
+// the project-service list-webhook-deliveries endpoint.
+func DecodeListWebhookDeliveriesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*projectservice.ListWebhookDeliveriesPayload, error) {
+	return func(r *http.Request) (*projectservice.ListWebhookDeliveriesPayload, error) {
+		var (
+			id          string
+			bearerToken *string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		bearerTokenRaw := r.Header.Get("Authorization")
+		if bearerTokenRaw != "" {
+			bearerToken = &bearerTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListWebhookDeliveriesPayload(id, bearerToken)
+		if payload.BearerToken != nil {
+			if strings.Contains(*payload.BearerToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.BearerToken, " ", 2)[1]
+				payload.BearerToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListWebhookDeliveriesError returns an encoder for errors returned by
+// the list-webhook-deliveries project-service endpoint.
+func EncodeListWebhookDeliveriesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "Forbidden":
+			var res *projectservice.ForbiddenError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListWebhookDeliveriesForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *projectservice.InternalServerError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListWebhookDeliveriesInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "NotFound":
+			var res *projectservice.NotFoundError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListWebhookDeliveriesNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *projectservice.ServiceUnavailableError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/problem+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListWebhookDeliveriesServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// marshalProjectserviceProjectLinksToProjectLinksResponseBody builds a value
+// of type *ProjectLinksResponseBody from a value of type
+// *projectservice.ProjectLinks.
+func marshalProjectserviceProjectLinksToProjectLinksResponseBody(v *projectservice.ProjectLinks) *ProjectLinksResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &ProjectLinksResponseBody{
+		Twitter:      v.Twitter,
+		Linkedin:     v.Linkedin,
+		Youtube:      v.Youtube,
+		Slack:        v.Slack,
+		Discord:      v.Discord,
+		MailingList:  v.MailingList,
+		ContactEmail: v.ContactEmail,
+	}
+
+	return res
+}
+
+// marshalProjectserviceUserInfoToUserInfoResponseBody builds a value of type
+// *UserInfoResponseBody from a value of type *projectservice.UserInfo.
+func marshalProjectserviceUserInfoToUserInfoResponseBody(v *projectservice.UserInfo) *UserInfoResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &UserInfoResponseBody{
+		Name:     v.Name,
+		Email:    v.Email,
+		Username: v.Username,
+		Avatar:   v.Avatar,
+	}
+	if v.Invite != nil {
+		res.Invite = marshalProjectserviceInviteInfoToInviteInfoResponseBody(v.Invite)
+	}
+
+	return res
+}
+
+// marshalProjectserviceInviteInfoToInviteInfoResponseBody builds a value of
+// type *InviteInfoResponseBody from a value of type *projectservice.InviteInfo.
+func marshalProjectserviceInviteInfoToInviteInfoResponseBody(v *projectservice.InviteInfo) *InviteInfoResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &InviteInfoResponseBody{
+		UID:       v.UID,
+		Email:     v.Email,
+		ExpiresAt: v.ExpiresAt,
+	}
+
+	return res
+}
+
+// marshalProjectserviceProjectSettingsToProjectSettingsResponseBody builds a
+// value of type *ProjectSettingsResponseBody from a value of type
+// *projectservice.ProjectSettings.
+func marshalProjectserviceProjectSettingsToProjectSettingsResponseBody(v *projectservice.ProjectSettings) *ProjectSettingsResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &ProjectSettingsResponseBody{
+		UID:              v.UID,
+		MissionStatement: v.MissionStatement,
+		OverviewHTML:     v.OverviewHTML,
+		AnnouncementDate: v.AnnouncementDate,
+		CreatedAt:        v.CreatedAt,
+		UpdatedAt:        v.UpdatedAt,
+	}
+	if v.Writers != nil {
+		res.Writers = make([]*UserInfoResponseBody, len(v.Writers))
+		for i, val := range v.Writers {
+			res.Writers[i] = marshalProjectserviceUserInfoToUserInfoResponseBody(val)
+		}
+	}
+	if v.MeetingCoordinators != nil {
+		res.MeetingCoordinators = make([]*UserInfoResponseBody, len(v.MeetingCoordinators))
+		for i, val := range v.MeetingCoordinators {
+			res.MeetingCoordinators[i] = marshalProjectserviceUserInfoToUserInfoResponseBody(val)
+		}
+	}
+	if v.Auditors != nil {
+		res.Auditors = make([]*UserInfoResponseBody, len(v.Auditors))
+		for i, val := range v.Auditors {
+			res.Auditors[i] = marshalProjectserviceUserInfoToUserInfoResponseBody(val)
+		}
+	}
+	if v.ExecutiveDirector != nil {
+		res.ExecutiveDirector = marshalProjectserviceUserInfoToUserInfoResponseBody(v.ExecutiveDirector)
+	}
+	if v.ProgramManager != nil {
+		res.ProgramManager = marshalProjectserviceUserInfoToUserInfoResponseBody(v.ProgramManager)
+	}
+	if v.OpportunityOwner != nil {
+		res.OpportunityOwner = marshalProjectserviceUserInfoToUserInfoResponseBody(v.OpportunityOwner)
+	}
+
+	return res
+}
+
+// marshalProjectserviceFieldErrorToFieldErrorResponseBody builds a value of
+// type *FieldErrorResponseBody from a value of type *projectservice.FieldError.
+func marshalProjectserviceFieldErrorToFieldErrorResponseBody(v *projectservice.FieldError) *FieldErrorResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &FieldErrorResponseBody{
+		Path:    v.Path,
+		Code:    v.Code,
+		Message: v.Message,
+	}
+
+	return res
+}
+
+// marshalProjectserviceProjectContactToProjectContactResponseBody builds a
+// value of type *ProjectContactResponseBody from a value of type
+// *projectservice.ProjectContact.
+func marshalProjectserviceProjectContactToProjectContactResponseBody(v *projectservice.ProjectContact) *ProjectContactResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &ProjectContactResponseBody{
+		Role:  v.Role,
+		Name:  v.Name,
+		Email: v.Email,
+	}
+
+	return res
+}
+
+// marshalProjectserviceDuplicateProjectToDuplicateProjectResponseBody builds a
+// value of type *DuplicateProjectResponseBody from a value of type
+// *projectservice.DuplicateProject.
+func marshalProjectserviceDuplicateProjectToDuplicateProjectResponseBody(v *projectservice.DuplicateProject) *DuplicateProjectResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &DuplicateProjectResponseBody{
+		UID:    v.UID,
+		Slug:   v.Slug,
+		Name:   v.Name,
+		Reason: v.Reason,
+	}
+
+	return res
+}
+
+// marshalProjectserviceSettingsMergeConflictToSettingsMergeConflictResponseBody
+// builds a value of type *SettingsMergeConflictResponseBody from a value of
+// type *projectservice.SettingsMergeConflict.
+func marshalProjectserviceSettingsMergeConflictToSettingsMergeConflictResponseBody(v *projectservice.SettingsMergeConflict) *SettingsMergeConflictResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &SettingsMergeConflictResponseBody{
+		Field: v.Field,
+	}
+	if v.Users != nil {
+		res.Users = make([]string, len(v.Users))
+		for i, val := range v.Users {
+			res.Users[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalUserInfoRequestBodyToProjectserviceUserInfo builds a value of type
+// *projectservice.UserInfo from a value of type *UserInfoRequestBody.
+func unmarshalUserInfoRequestBodyToProjectserviceUserInfo(v *UserInfoRequestBody) *projectservice.UserInfo {
+	res := &projectservice.UserInfo{
+		Name:     v.Name,
+		Email:    v.Email,
+		Username: v.Username,
+		Avatar:   v.Avatar,
+	}
+	if v.Invite != nil {
+		res.Invite = unmarshalInviteInfoRequestBodyToProjectserviceInviteInfo(v.Invite)
+	}
+
+	return res
+}
+
+// unmarshalInviteInfoRequestBodyToProjectserviceInviteInfo builds a value of
+// type *projectservice.InviteInfo from a value of type *InviteInfoRequestBody.
+func unmarshalInviteInfoRequestBodyToProjectserviceInviteInfo(v *InviteInfoRequestBody) *projectservice.InviteInfo {
+	if v == nil {
+		return nil
+	}
+	res := &projectservice.InviteInfo{
+		UID:       v.UID,
+		Email:     v.Email,
+		ExpiresAt: v.ExpiresAt,
+	}
+
+	return res
+}
+
+// marshalProjectserviceProjectCharterVersionToProjectCharterVersionResponseBody
+// builds a value of type *ProjectCharterVersionResponseBody from a value of
+// type *projectservice.ProjectCharterVersion.
+func marshalProjectserviceProjectCharterVersionToProjectCharterVersionResponseBody(v *projectservice.ProjectCharterVersion) *ProjectCharterVersionResponseBody {
+	res := &ProjectCharterVersionResponseBody{
+		Version:            v.Version,
+		URL:                v.URL,
+		FileName:           v.FileName,
+		FileSize:           v.FileSize,
+		Sha256:             v.Sha256,
+		UploadedByUsername: v.UploadedByUsername,
+		UploadedAt:         v.UploadedAt,
+	}
+
+	return res
+}
+
+// marshalProjectserviceProjectSettingsChangeToProjectSettingsChangeResponseBody
+// builds a value of type *ProjectSettingsChangeResponseBody from a value of
+// type *projectservice.ProjectSettingsChange.
+func marshalProjectserviceProjectSettingsChangeToProjectSettingsChangeResponseBody(v *projectservice.ProjectSettingsChange) *ProjectSettingsChangeResponseBody {
+	res := &ProjectSettingsChangeResponseBody{
+		Field: v.Field,
+		From:  v.From,
+		To:    v.To,
+	}
+	if v.Added != nil {
+		res.Added = make([]*UserInfoResponseBody, len(v.Added))
+		for i, val := range v.Added {
+			res.Added[i] = marshalProjectserviceUserInfoToUserInfoResponseBody(val)
+		}
+	}
+	if v.Removed != nil {
+		res.Removed = make([]*UserInfoResponseBody, len(v.Removed))
+		for i, val := range v.Removed {
+			res.Removed[i] = marshalProjectserviceUserInfoToUserInfoResponseBody(val)
+		}
+	}
+
+	return res
+}
+
+// marshalProjectserviceProjectBaseToProjectBaseResponseBody builds a value of
+// type *ProjectBaseResponseBody from a value of type
+// *projectservice.ProjectBase.
+func marshalProjectserviceProjectBaseToProjectBaseResponseBody(v *projectservice.ProjectBase) *ProjectBaseResponseBody {
+	res := &ProjectBaseResponseBody{
+		UID:                        v.UID,
+		Slug:                       v.Slug,
+		Description:                v.Description,
+		Name:                       v.Name,
+		Public:                     v.Public,
+		IsFoundation:               v.IsFoundation,
+		ParentUID:                  v.ParentUID,
+		Stage:                      v.Stage,
+		Category:                   v.Category,
+		Funding:                    v.Funding,
+		CharterURL:                 v.CharterURL,
+		LegalEntityType:            v.LegalEntityType,
+		LegalEntityName:            v.LegalEntityName,
+		LegalParentUID:             v.LegalParentUID,
+		EntityDissolutionDate:      v.EntityDissolutionDate,
+		EntityStatus:               v.EntityStatus,
+		EntityFormationDocumentURL: v.EntityFormationDocumentURL,
+		AutojoinEnabled:            v.AutojoinEnabled,
+		FormationDate:              v.FormationDate,
+		LogoURL:                    v.LogoURL,
+		PngLogoURL:                 v.PngLogoURL,
+		RepositoryURL:              v.RepositoryURL,
+		WebsiteURL:                 v.WebsiteURL,
+		LegacyID:                   v.LegacyID,
+		CreatedAt:                  v.CreatedAt,
+		UpdatedAt:                  v.UpdatedAt,
+	}
+	if v.NameI18n != nil {
+		res.NameI18n = make(map[string]string, len(v.NameI18n))
+		for key, val := range v.NameI18n {
+			tk := key
+			tv := val
+			res.NameI18n[tk] = tv
+		}
+	}
+	if v.DescriptionI18n != nil {
+		res.DescriptionI18n = make(map[string]string, len(v.DescriptionI18n))
+		for key, val := range v.DescriptionI18n {
+			tk := key
+			tv := val
+			res.DescriptionI18n[tk] = tv
+		}
+	}
+	if v.FundingModel != nil {
+		res.FundingModel = make([]string, len(v.FundingModel))
+		for i, val := range v.FundingModel {
+			res.FundingModel[i] = val
+		}
+	}
+	if v.Links != nil {
+		res.Links = marshalProjectserviceProjectLinksToProjectLinksResponseBody(v.Links)
+	}
+	if v.Annotations != nil {
+		res.Annotations = make(map[string]string, len(v.Annotations))
+		for key, val := range v.Annotations {
+			tk := key
+			tv := val
+			res.Annotations[tk] = tv
+		}
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalProjectserviceLegalLineageProjectToLegalLineageProjectResponseBody
+// builds a value of type *LegalLineageProjectResponseBody from a value of type
+// *projectservice.LegalLineageProject.
+func marshalProjectserviceLegalLineageProjectToLegalLineageProjectResponseBody(v *projectservice.LegalLineageProject) *LegalLineageProjectResponseBody {
+	res := &LegalLineageProjectResponseBody{
+		UID:             v.UID,
+		Slug:            v.Slug,
+		Name:            v.Name,
+		LegalEntityType: v.LegalEntityType,
+		LegalEntityName: v.LegalEntityName,
+	}
+
+	return res
+}
+
+// marshalProjectserviceProjectSuggestionToProjectSuggestionResponseBody builds
+// a value of type *ProjectSuggestionResponseBody from a value of type
+// *projectservice.ProjectSuggestion.
+func marshalProjectserviceProjectSuggestionToProjectSuggestionResponseBody(v *projectservice.ProjectSuggestion) *ProjectSuggestionResponseBody {
+	res := &ProjectSuggestionResponseBody{
+		UID:     v.UID,
+		Name:    v.Name,
+		Slug:    v.Slug,
+		LogoURL: v.LogoURL,
+	}
+
+	return res
+}
+
+// unmarshalProjectMembersOperationRequestBodyToProjectserviceProjectMembersOperation
+// builds a value of type *projectservice.ProjectMembersOperation from a value
+// of type *ProjectMembersOperationRequestBody.
+func unmarshalProjectMembersOperationRequestBodyToProjectserviceProjectMembersOperation(v *ProjectMembersOperationRequestBody) *projectservice.ProjectMembersOperation {
+	res := &projectservice.ProjectMembersOperation{
+		UID: *v.UID,
+	}
+	if v.WritersAdd != nil {
+		res.WritersAdd = make([]string, len(v.WritersAdd))
+		for i, val := range v.WritersAdd {
+			res.WritersAdd[i] = val
+		}
+	}
+	if v.WritersRemove != nil {
+		res.WritersRemove = make([]string, len(v.WritersRemove))
+		for i, val := range v.WritersRemove {
+			res.WritersRemove[i] = val
+		}
+	}
+	if v.AuditorsAdd != nil {
+		res.AuditorsAdd = make([]string, len(v.AuditorsAdd))
+		for i, val := range v.AuditorsAdd {
+			res.AuditorsAdd[i] = val
+		}
+	}
+	if v.AuditorsRemove != nil {
+		res.AuditorsRemove = make([]string, len(v.AuditorsRemove))
+		for i, val := range v.AuditorsRemove {
+			res.AuditorsRemove[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalProjectserviceProjectMembersOperationResultToProjectMembersOperationResultResponseBody
+// builds a value of type *ProjectMembersOperationResultResponseBody from a
+// value of type *projectservice.ProjectMembersOperationResult.
+func marshalProjectserviceProjectMembersOperationResultToProjectMembersOperationResultResponseBody(v *projectservice.ProjectMembersOperationResult) *ProjectMembersOperationResultResponseBody {
+	res := &ProjectMembersOperationResultResponseBody{
+		UID:    v.UID,
+		Status: v.Status,
+		Error:  v.Error,
+	}
+
+	return res
+}
+
+// marshalProjectserviceProjectFullToProjectFullResponseBody builds a value of
+// type *ProjectFullResponseBody from a value of type
+// *projectservice.ProjectFull.
+func marshalProjectserviceProjectFullToProjectFullResponseBody(v *projectservice.ProjectFull) *ProjectFullResponseBody {
+	res := &ProjectFullResponseBody{
+		UID:                        v.UID,
+		Slug:                       v.Slug,
+		Description:                v.Description,
+		Name:                       v.Name,
+		Public:                     v.Public,
+		IsFoundation:               v.IsFoundation,
+		ParentUID:                  v.ParentUID,
+		Stage:                      v.Stage,
+		Category:                   v.Category,
+		Funding:                    v.Funding,
+		CharterURL:                 v.CharterURL,
+		LegalEntityType:            v.LegalEntityType,
+		LegalEntityName:            v.LegalEntityName,
+		LegalParentUID:             v.LegalParentUID,
+		EntityDissolutionDate:      v.EntityDissolutionDate,
+		EntityStatus:               v.EntityStatus,
+		EntityFormationDocumentURL: v.EntityFormationDocumentURL,
+		AutojoinEnabled:            v.AutojoinEnabled,
+		FormationDate:              v.FormationDate,
+		LogoURL:                    v.LogoURL,
+		PngLogoURL:                 v.PngLogoURL,
+		RepositoryURL:              v.RepositoryURL,
+		WebsiteURL:                 v.WebsiteURL,
+		LegacyID:                   v.LegacyID,
+		CreatedAt:                  v.CreatedAt,
+		UpdatedAt:                  v.UpdatedAt,
+		MissionStatement:           v.MissionStatement,
+		OverviewHTML:               v.OverviewHTML,
+		AnnouncementDate:           v.AnnouncementDate,
+		SlugPath:                   v.SlugPath,
+		Depth:                      v.Depth,
+		ChildCount:                 v.ChildCount,
+		HasSettings:                v.HasSettings,
+		DisplayStatus:              v.DisplayStatus,
+	}
+	if v.NameI18n != nil {
+		res.NameI18n = make(map[string]string, len(v.NameI18n))
+		for key, val := range v.NameI18n {
+			tk := key
+			tv := val
+			res.NameI18n[tk] = tv
+		}
+	}
+	if v.DescriptionI18n != nil {
+		res.DescriptionI18n = make(map[string]string, len(v.DescriptionI18n))
+		for key, val := range v.DescriptionI18n {
+			tk := key
+			tv := val
+			res.DescriptionI18n[tk] = tv
+		}
+	}
+	if v.FundingModel != nil {
+		res.FundingModel = make([]string, len(v.FundingModel))
+		for i, val := range v.FundingModel {
+			res.FundingModel[i] = val
+		}
+	}
+	if v.Links != nil {
+		res.Links = marshalProjectserviceProjectLinksToProjectLinksResponseBody(v.Links)
+	}
+	if v.Annotations != nil {
+		res.Annotations = make(map[string]string, len(v.Annotations))
+		for key, val := range v.Annotations {
+			tk := key
+			tv := val
+			res.Annotations[tk] = tv
+		}
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
+	}
+	if v.Writers != nil {
+		res.Writers = make([]*UserInfoResponseBody, len(v.Writers))
+		for i, val := range v.Writers {
+			res.Writers[i] = marshalProjectserviceUserInfoToUserInfoResponseBody(val)
+		}
+	}
+	if v.MeetingCoordinators != nil {
+		res.MeetingCoordinators = make([]*UserInfoResponseBody, len(v.MeetingCoordinators))
+		for i, val := range v.MeetingCoordinators {
+			res.MeetingCoordinators[i] = marshalProjectserviceUserInfoToUserInfoResponseBody(val)
+		}
+	}
+	if v.Auditors != nil {
+		res.Auditors = make([]*UserInfoResponseBody, len(v.Auditors))
+		for i, val := range v.Auditors {
+			res.Auditors[i] = marshalProjectserviceUserInfoToUserInfoResponseBody(val)
+		}
+	}
+	if v.ExecutiveDirector != nil {
+		res.ExecutiveDirector = marshalProjectserviceUserInfoToUserInfoResponseBody(v.ExecutiveDirector)
+	}
+	if v.ProgramManager != nil {
+		res.ProgramManager = marshalProjectserviceUserInfoToUserInfoResponseBody(v.ProgramManager)
+	}
+	if v.OpportunityOwner != nil {
+		res.OpportunityOwner = marshalProjectserviceUserInfoToUserInfoResponseBody(v.OpportunityOwner)
+	}
+	if v.Settings != nil {
+		res.Settings = marshalProjectserviceProjectSettingsToProjectSettingsResponseBody(v.Settings)
+	}
+
+	return res
+}
+
+// unmarshalProjectLinksRequestBodyToProjectserviceProjectLinks builds a value
+// of type *projectservice.ProjectLinks from a value of type
+// *ProjectLinksRequestBody.
+func unmarshalProjectLinksRequestBodyToProjectserviceProjectLinks(v *ProjectLinksRequestBody) *projectservice.ProjectLinks {
+	if v == nil {
+		return nil
+	}
+	res := &projectservice.ProjectLinks{
+		Twitter:      v.Twitter,
+		Linkedin:     v.Linkedin,
+		Youtube:      v.Youtube,
+		Slack:        v.Slack,
+		Discord:      v.Discord,
+		MailingList:  v.MailingList,
+		ContactEmail: v.ContactEmail,
+	}
+
+	return res
+}
+
+// marshalProjectserviceProjectDeletionOutcomeToProjectDeletionOutcomeResponseBody
+// builds a value of type *ProjectDeletionOutcomeResponseBody from a value of
+// type *projectservice.ProjectDeletionOutcome.
+func marshalProjectserviceProjectDeletionOutcomeToProjectDeletionOutcomeResponseBody(v *projectservice.ProjectDeletionOutcome) *ProjectDeletionOutcomeResponseBody {
+	res := &ProjectDeletionOutcomeResponseBody{
+		UID:       v.UID,
+		Slug:      v.Slug,
+		Requested: v.Requested,
+		Status:    v.Status,
+		Error:     v.Error,
+	}
+
+	return res
+}
+
+// marshalProjectserviceDependencyHealthToDependencyHealthResponseBody builds a
+// value of type *DependencyHealthResponseBody from a value of type
+// *projectservice.DependencyHealth.
+func marshalProjectserviceDependencyHealthToDependencyHealthResponseBody(v *projectservice.DependencyHealth) *DependencyHealthResponseBody {
+	res := &DependencyHealthResponseBody{
+		Name:      v.Name,
+		Status:    v.Status,
+		LatencyMs: v.LatencyMs,
+		Error:     v.Error,
+	}
+
+	return res
+}
+
+// marshalProjectserviceDeadLetterToDeadLetterResponseBody builds a value of
+// type *DeadLetterResponseBody from a value of type *projectservice.DeadLetter.
+func marshalProjectserviceDeadLetterToDeadLetterResponseBody(v *projectservice.DeadLetter) *DeadLetterResponseBody {
+	res := &DeadLetterResponseBody{
+		ID:           v.ID,
+		Subject:      v.Subject,
+		Payload:      v.Payload,
+		Error:        v.Error,
+		Attempts:     v.Attempts,
+		FailedAt:     v.FailedAt,
+		LastFailedAt: v.LastFailedAt,
+	}
+
+	return res
+}
+
+// marshalProjectserviceKVKeyInspectionToKVKeyInspectionResponseBody builds a
+// value of type *KVKeyInspectionResponseBody from a value of type
+// *projectservice.KVKeyInspection.
+func marshalProjectserviceKVKeyInspectionToKVKeyInspectionResponseBody(v *projectservice.KVKeyInspection) *KVKeyInspectionResponseBody {
+	res := &KVKeyInspectionResponseBody{
+		Bucket: v.Bucket,
+		Key:    v.Key,
+	}
+	if v.Current != nil {
+		res.Current = marshalProjectserviceKVEntryToKVEntryResponseBody(v.Current)
+	}
+	if v.History != nil {
+		res.History = make([]*KVEntryResponseBody, len(v.History))
+		for i, val := range v.History {
+			res.History[i] = marshalProjectserviceKVEntryToKVEntryResponseBody(val)
+		}
+	} else {
+		res.History = []*KVEntryResponseBody{}
+	}
+
+	return res
+}
+
+// marshalProjectserviceKVEntryToKVEntryResponseBody builds a value of type
+// *KVEntryResponseBody from a value of type *projectservice.KVEntry.
+func marshalProjectserviceKVEntryToKVEntryResponseBody(v *projectservice.KVEntry) *KVEntryResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &KVEntryResponseBody{
+		Revision:  v.Revision,
+		Created:   v.Created,
+		Operation: v.Operation,
+		Value:     v.Value,
+	}
+
+	return res
+}
+
+// marshalProjectserviceBackupToBackupResponseBody builds a value of type
+// *BackupResponseBody from a value of type *projectservice.Backup.
+func marshalProjectserviceBackupToBackupResponseBody(v *projectservice.Backup) *BackupResponseBody {
+	res := &BackupResponseBody{
+		Name:      v.Name,
+		Size:      v.Size,
+		CreatedAt: v.CreatedAt,
+	}
+
+	return res
+}
+
+// marshalProjectservicePendingAnnouncementToPendingAnnouncementResponseBody
+// builds a value of type *PendingAnnouncementResponseBody from a value of type
+// *projectservice.PendingAnnouncement.
+func marshalProjectservicePendingAnnouncementToPendingAnnouncementResponseBody(v *projectservice.PendingAnnouncement) *PendingAnnouncementResponseBody {
+	res := &PendingAnnouncementResponseBody{
+		ProjectUID:       v.ProjectUID,
+		Slug:             v.Slug,
+		Name:             v.Name,
+		AnnouncementDate: v.AnnouncementDate,
+		Due:              v.Due,
+	}
+
+	return res
+}
+
+// marshalProjectserviceProjectTemplateToProjectTemplateResponseBody builds a
+// value of type *ProjectTemplateResponseBody from a value of type
+// *projectservice.ProjectTemplate.
+func marshalProjectserviceProjectTemplateToProjectTemplateResponseBody(v *projectservice.ProjectTemplate) *ProjectTemplateResponseBody {
+	res := &ProjectTemplateResponseBody{
+		Name:             v.Name,
+		Description:      v.Description,
+		Stage:            v.Stage,
+		Category:         v.Category,
+		MissionStatement: v.MissionStatement,
+		CreatedBy:        v.CreatedBy,
+		CreatedAt:        v.CreatedAt,
+		UpdatedAt:        v.UpdatedAt,
+	}
+	if v.FundingModel != nil {
+		res.FundingModel = make([]string, len(v.FundingModel))
+		for i, val := range v.FundingModel {
+			res.FundingModel[i] = val
+		}
+	}
+	if v.Auditors != nil {
+		res.Auditors = make([]*UserInfoResponseBody, len(v.Auditors))
+		for i, val := range v.Auditors {
+			res.Auditors[i] = marshalProjectserviceUserInfoToUserInfoResponseBody(val)
+		}
+	}
+
+	return res
+}
+
+// marshalProjectserviceProjectDeletionToProjectDeletionResponseBody builds a
+// value of type *ProjectDeletionResponseBody from a value of type
+// *projectservice.ProjectDeletion.
+func marshalProjectserviceProjectDeletionToProjectDeletionResponseBody(v *projectservice.ProjectDeletion) *ProjectDeletionResponseBody {
+	res := &ProjectDeletionResponseBody{
+		UID:        v.UID,
+		Slug:       v.Slug,
+		DeletedAt:  v.DeletedAt,
+		DeletedBy:  v.DeletedBy,
+		MergedInto: v.MergedInto,
+	}
+
+	return res
+}
+
+// marshalProjectserviceProjectEventToProjectEventResponseBody builds a value
+// of type *ProjectEventResponseBody from a value of type
+// *projectservice.ProjectEvent.
+func marshalProjectserviceProjectEventToProjectEventResponseBody(v *projectservice.ProjectEvent) *ProjectEventResponseBody {
+	res := &ProjectEventResponseBody{
+		ID:         v.ID,
+		Type:       v.Type,
+		OccurredAt: v.OccurredAt,
+		ProjectUID: v.ProjectUID,
+		Revision:   v.Revision,
+	}
+	if v.Project != nil {
+		res.Project = marshalProjectserviceProjectBaseToProjectBaseResponseBody(v.Project)
+	}
+	if v.PreviousProject != nil {
+		res.PreviousProject = marshalProjectserviceProjectBaseToProjectBaseResponseBody(v.PreviousProject)
+	}
+	if v.Settings != nil {
+		res.Settings = marshalProjectserviceProjectSettingsToProjectSettingsResponseBody(v.Settings)
+	}
+	if v.PreviousSettings != nil {
+		res.PreviousSettings = marshalProjectserviceProjectSettingsToProjectSettingsResponseBody(v.PreviousSettings)
+	}
+
+	return res
+}
+
+// marshalProjectserviceUserProjectToUserProjectResponseBody builds a value of
+// type *UserProjectResponseBody from a value of type
+// *projectservice.UserProject.
+func marshalProjectserviceUserProjectToUserProjectResponseBody(v *projectservice.UserProject) *UserProjectResponseBody {
+	res := &UserProjectResponseBody{
+		ProjectUID: v.ProjectUID,
+		Slug:       v.Slug,
+		Name:       v.Name,
+	}
+	if v.Roles != nil {
+		res.Roles = make([]string, len(v.Roles))
+		for i, val := range v.Roles {
+			res.Roles[i] = val
+		}
+	} else {
+		res.Roles = []string{}
+	}
+
+	return res
+}
+
+// marshalProjectserviceUserMembershipToUserMembershipResponseBody builds a
+// value of type *UserMembershipResponseBody from a value of type
+// *projectservice.UserMembership.
+func marshalProjectserviceUserMembershipToUserMembershipResponseBody(v *projectservice.UserMembership) *UserMembershipResponseBody {
+	res := &UserMembershipResponseBody{
+		ProjectUID: v.ProjectUID,
+		Slug:       v.Slug,
+		Name:       v.Name,
+		Role:       v.Role,
+		AddedAt:    v.AddedAt,
+	}
+	if v.User != nil {
+		res.User = marshalProjectserviceUserInfoToUserInfoResponseBody(v.User)
+	}
+
+	return res
+}
+
+// marshalProjectserviceUserAccessRemovalToUserAccessRemovalResponseBody builds
+// a value of type *UserAccessRemovalResponseBody from a value of type
+// *projectservice.UserAccessRemoval.
+func marshalProjectserviceUserAccessRemovalToUserAccessRemovalResponseBody(v *projectservice.UserAccessRemoval) *UserAccessRemovalResponseBody {
+	res := &UserAccessRemovalResponseBody{
+		ProjectUID: v.ProjectUID,
+		Slug:       v.Slug,
+		Name:       v.Name,
+		Status:     v.Status,
+		Error:      v.Error,
+	}
+	if v.Roles != nil {
+		res.Roles = make([]string, len(v.Roles))
+		for i, val := range v.Roles {
+			res.Roles[i] = val
+		}
+	} else {
+		res.Roles = []string{}
+	}
+
+	return res
+}
+
+// marshalProjectserviceVisibilityImpactProjectToVisibilityImpactProjectResponseBody
+// builds a value of type *VisibilityImpactProjectResponseBody from a value of
+// type *projectservice.VisibilityImpactProject.
+func marshalProjectserviceVisibilityImpactProjectToVisibilityImpactProjectResponseBody(v *projectservice.VisibilityImpactProject) *VisibilityImpactProjectResponseBody {
+	res := &VisibilityImpactProjectResponseBody{
+		UID:       v.UID,
+		Slug:      v.Slug,
+		Name:      v.Name,
+		ParentUID: v.ParentUID,
+		Public:    v.Public,
+	}
+
+	return res
+}
+
+// marshalProjectserviceExternalReferenceToExternalReferenceResponseBody builds
+// a value of type *ExternalReferenceResponseBody from a value of type
+// *projectservice.ExternalReference.
+func marshalProjectserviceExternalReferenceToExternalReferenceResponseBody(v *projectservice.ExternalReference) *ExternalReferenceResponseBody {
+	res := &ExternalReferenceResponseBody{
+		Field: v.Field,
+		URL:   v.URL,
+	}
+
+	return res
+}
+
+// marshalProjectserviceVisibilitySubscribersToVisibilitySubscribersResponseBody
+// builds a value of type *VisibilitySubscribersResponseBody from a value of
+// type *projectservice.VisibilitySubscribers.
+func marshalProjectserviceVisibilitySubscribersToVisibilitySubscribersResponseBody(v *projectservice.VisibilitySubscribers) *VisibilitySubscribersResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &VisibilitySubscribersResponseBody{
+		Committees:    v.Committees,
+		MailingLists:  v.MailingLists,
+		MeetingSeries: v.MeetingSeries,
+		Webhooks:      v.Webhooks,
+	}
+
+	return res
+}
+
+// marshalProjectserviceWebhookToWebhookResponseBody builds a value of type
+// *WebhookResponseBody from a value of type *projectservice.Webhook.
+func marshalProjectserviceWebhookToWebhookResponseBody(v *projectservice.Webhook) *WebhookResponseBody {
+	res := &WebhookResponseBody{
+		ID:          v.ID,
+		URL:         v.URL,
+		ProjectUID:  v.ProjectUID,
+		Description: v.Description,
+		Secret:      v.Secret,
+		CreatedBy:   v.CreatedBy,
+		CreatedAt:   v.CreatedAt,
+	}
+	if v.Events != nil {
+		res.Events = make([]string, len(v.Events))
+		for i, val := range v.Events {
+			res.Events[i] = val
+		}
+	} else {
+		res.Events = []string{}
+	}
+
+	return res
+}
+
+// marshalProjectserviceWebhookDeliveryToWebhookDeliveryResponseBody builds a
+// value of type *WebhookDeliveryResponseBody from a value of type
+// *projectservice.WebhookDelivery.
+func marshalProjectserviceWebhookDeliveryToWebhookDeliveryResponseBody(v *projectservice.WebhookDelivery) *WebhookDeliveryResponseBody {
+	res := &WebhookDeliveryResponseBody{
+		ID:             v.ID,
+		Event:          v.Event,
+		ProjectUID:     v.ProjectUID,
+		Status:         v.Status,
+		Attempts:       v.Attempts,
+		ResponseStatus: v.ResponseStatus,
+		LastError:      v.LastError,
+		CreatedAt:      v.CreatedAt,
+		LastAttemptAt:  v.LastAttemptAt,
+		NextAttemptAt:  v.NextAttemptAt,
+		DeliveredAt:    v.DeliveredAt,
 	}
 
 	return res
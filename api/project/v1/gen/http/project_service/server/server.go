@@ -24,30 +24,82 @@ import (
 
 // Server lists the project-service service endpoint HTTP handlers.
 type Server struct {
-	Mounts                  []*MountPoint
-	GetProjects             http.Handler
-	CreateProject           http.Handler
-	GetOneProjectBase       http.Handler
-	GetOneProjectSettings   http.Handler
-	UpdateProjectBase       http.Handler
-	UpdateProjectSettings   http.Handler
-	DeleteProject           http.Handler
-	Readyz                  http.Handler
-	Livez                   http.Handler
-	CreateProjectLink       http.Handler
-	GetProjectLink          http.Handler
-	DeleteProjectLink       http.Handler
-	CreateProjectFolder     http.Handler
-	GetProjectFolder        http.Handler
-	DeleteProjectFolder     http.Handler
-	UploadProjectDocument   http.Handler
-	GetProjectDocument      http.Handler
-	DownloadProjectDocument http.Handler
-	DeleteProjectDocument   http.Handler
-	GenHTTPOpenapiJSON      http.Handler
-	GenHTTPOpenapiYaml      http.Handler
-	GenHTTPOpenapi3JSON     http.Handler
-	GenHTTPOpenapi3Yaml     http.Handler
+	Mounts                     []*MountPoint
+	CloneProject               http.Handler
+	UploadProjectLogo          http.Handler
+	MergeProject               http.Handler
+	TransferProject            http.Handler
+	CreateProjectLink          http.Handler
+	GetProjectLink             http.Handler
+	DeleteProjectLink          http.Handler
+	CreateProjectFolder        http.Handler
+	GetProjectFolder           http.Handler
+	DeleteProjectFolder        http.Handler
+	UploadProjectDocument      http.Handler
+	GetProjectDocument         http.Handler
+	DownloadProjectDocument    http.Handler
+	DeleteProjectDocument      http.Handler
+	UploadProjectCharter       http.Handler
+	GetProjectCharter          http.Handler
+	GetProjectAssociations     http.Handler
+	UpdateProjectAssociations  http.Handler
+	GetProjectSettingsDiff     http.Handler
+	GetProjectChildren         http.Handler
+	GetProjectDescendants      http.Handler
+	GetProjectLegalLineage     http.Handler
+	SearchProjects             http.Handler
+	AutocompleteProjects       http.Handler
+	AddProjectWriter           http.Handler
+	RemoveProjectWriter        http.Handler
+	AddProjectAuditor          http.Handler
+	RemoveProjectAuditor       http.Handler
+	JoinProject                http.Handler
+	BulkUpdateProjectMembers   http.Handler
+	GetProjects                http.Handler
+	CreateProject              http.Handler
+	GetOneProjectBase          http.Handler
+	GetProjectUIDFromLegacyID  http.Handler
+	GetOneProjectSettings      http.Handler
+	UpdateProjectBase          http.Handler
+	UpdateProjectSettings      http.Handler
+	DeleteProject              http.Handler
+	BulkDeleteProjects         http.Handler
+	Readyz                     http.Handler
+	Livez                      http.Handler
+	Healthz                    http.Handler
+	ReconcileOutbox            http.Handler
+	ResyncProjects             http.Handler
+	ListDeadLetters            http.Handler
+	ReplayDeadLetter           http.Handler
+	RebuildSlugMappings        http.Handler
+	GetIntegrityReport         http.Handler
+	InspectProjectKv           http.Handler
+	CreateBackup               http.Handler
+	ListBackups                http.Handler
+	RestoreBackup              http.Handler
+	ListPendingAnnouncements   http.Handler
+	GetProjectEnums            http.Handler
+	UpdateProjectEnums         http.Handler
+	CreateProjectTemplate      http.Handler
+	ListProjectTemplates       http.Handler
+	GetProjectTemplate         http.Handler
+	UpdateProjectTemplate      http.Handler
+	DeleteProjectTemplate      http.Handler
+	ListProjectDeletions       http.Handler
+	ListProjectEvents          http.Handler
+	GetUserProjects            http.Handler
+	ExportUserData             http.Handler
+	RemoveUserAccess           http.Handler
+	GetProjectVisibilityImpact http.Handler
+	CreateWebhook              http.Handler
+	ListWebhooks               http.Handler
+	GetWebhook                 http.Handler
+	DeleteWebhook              http.Handler
+	ListWebhookDeliveries      http.Handler
+	GenHTTPOpenapiJSON         http.Handler
+	GenHTTPOpenapiYaml         http.Handler
+	GenHTTPOpenapi3JSON        http.Handler
+	GenHTTPOpenapi3Yaml        http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -61,11 +113,20 @@ type MountPoint struct {
 	Pattern string
 }
 
+// ProjectServiceUploadProjectLogoDecoderFunc is the type to decode multipart
+// request for the "project-service" service "upload-project-logo" endpoint.
+type ProjectServiceUploadProjectLogoDecoderFunc func(*multipart.Reader, **projectservice.UploadProjectLogoPayload) error
+
 // ProjectServiceUploadProjectDocumentDecoderFunc is the type to decode
 // multipart request for the "project-service" service
 // "upload-project-document" endpoint.
 type ProjectServiceUploadProjectDocumentDecoderFunc func(*multipart.Reader, **projectservice.UploadProjectDocumentPayload) error
 
+// ProjectServiceUploadProjectCharterDecoderFunc is the type to decode
+// multipart request for the "project-service" service "upload-project-charter"
+// endpoint.
+type ProjectServiceUploadProjectCharterDecoderFunc func(*multipart.Reader, **projectservice.UploadProjectCharterPayload) error
+
 // New instantiates HTTP handlers for all the project-service service endpoints
 // using the provided encoder and decoder. The handlers are mounted on the
 // given mux using the HTTP verb and path defined in the design. errhandler is
@@ -79,7 +140,9 @@ func New(
 	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
 	errhandler func(context.Context, http.ResponseWriter, error),
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
+	projectServiceUploadProjectLogoDecoderFn ProjectServiceUploadProjectLogoDecoderFunc,
 	projectServiceUploadProjectDocumentDecoderFn ProjectServiceUploadProjectDocumentDecoderFunc,
+	projectServiceUploadProjectCharterDecoderFn ProjectServiceUploadProjectCharterDecoderFunc,
 	fileSystemGenHTTPOpenapiJSON http.FileSystem,
 	fileSystemGenHTTPOpenapiYaml http.FileSystem,
 	fileSystemGenHTTPOpenapi3JSON http.FileSystem,
@@ -103,15 +166,10 @@ func New(
 	fileSystemGenHTTPOpenapi3Yaml = appendPrefix(fileSystemGenHTTPOpenapi3Yaml, "/gen/http")
 	return &Server{
 		Mounts: []*MountPoint{
-			{"GetProjects", "GET", "/projects"},
-			{"CreateProject", "POST", "/projects"},
-			{"GetOneProjectBase", "GET", "/projects/{uid}"},
-			{"GetOneProjectSettings", "GET", "/projects/{uid}/settings"},
-			{"UpdateProjectBase", "PUT", "/projects/{uid}"},
-			{"UpdateProjectSettings", "PUT", "/projects/{uid}/settings"},
-			{"DeleteProject", "DELETE", "/projects/{uid}"},
-			{"Readyz", "GET", "/readyz"},
-			{"Livez", "GET", "/livez"},
+			{"CloneProject", "POST", "/projects/{uid}/clone"},
+			{"UploadProjectLogo", "POST", "/projects/{uid}/logo"},
+			{"MergeProject", "POST", "/projects/{uid}/merge-into/{target_uid}"},
+			{"TransferProject", "POST", "/projects/{uid}/transfer"},
 			{"CreateProjectLink", "POST", "/projects/{uid}/links"},
 			{"GetProjectLink", "GET", "/projects/{uid}/links/{link_uid}"},
 			{"DeleteProjectLink", "DELETE", "/projects/{uid}/links/{link_uid}"},
@@ -122,34 +180,143 @@ func New(
 			{"GetProjectDocument", "GET", "/projects/{uid}/documents/{document_uid}"},
 			{"DownloadProjectDocument", "GET", "/projects/{uid}/documents/{document_uid}/download"},
 			{"DeleteProjectDocument", "DELETE", "/projects/{uid}/documents/{document_uid}"},
+			{"UploadProjectCharter", "PUT", "/projects/{uid}/charter"},
+			{"GetProjectCharter", "GET", "/projects/{uid}/charter"},
+			{"GetProjectAssociations", "GET", "/projects/{uid}/associations"},
+			{"UpdateProjectAssociations", "PUT", "/projects/{uid}/associations"},
+			{"GetProjectSettingsDiff", "GET", "/projects/{uid}/settings/diff"},
+			{"GetProjectChildren", "GET", "/projects/{uid}/children"},
+			{"GetProjectDescendants", "GET", "/projects/{uid}/descendants"},
+			{"GetProjectLegalLineage", "GET", "/projects/{uid}/legal-lineage"},
+			{"SearchProjects", "GET", "/projects/search"},
+			{"AutocompleteProjects", "GET", "/projects/autocomplete"},
+			{"AddProjectWriter", "POST", "/projects/{uid}/writers/{username}"},
+			{"RemoveProjectWriter", "DELETE", "/projects/{uid}/writers/{username}"},
+			{"AddProjectAuditor", "POST", "/projects/{uid}/auditors/{username}"},
+			{"RemoveProjectAuditor", "DELETE", "/projects/{uid}/auditors/{username}"},
+			{"JoinProject", "POST", "/projects/{uid}/join"},
+			{"BulkUpdateProjectMembers", "POST", "/projects/settings/bulk-update"},
+			{"GetProjects", "GET", "/projects"},
+			{"CreateProject", "POST", "/projects"},
+			{"GetOneProjectBase", "GET", "/projects/{uid}"},
+			{"GetProjectUIDFromLegacyID", "GET", "/projects/legacy/{legacy_id}"},
+			{"GetOneProjectSettings", "GET", "/projects/{uid}/settings"},
+			{"UpdateProjectBase", "PUT", "/projects/{uid}"},
+			{"UpdateProjectSettings", "PUT", "/projects/{uid}/settings"},
+			{"DeleteProject", "DELETE", "/projects/{uid}"},
+			{"BulkDeleteProjects", "DELETE", "/projects/bulk"},
+			{"Readyz", "GET", "/readyz"},
+			{"Livez", "GET", "/livez"},
+			{"Healthz", "GET", "/healthz"},
+			{"ReconcileOutbox", "POST", "/admin/outbox/reconcile"},
+			{"ResyncProjects", "POST", "/admin/resync"},
+			{"ListDeadLetters", "GET", "/admin/dead-letters"},
+			{"ReplayDeadLetter", "POST", "/admin/dead-letters/{id}/replay"},
+			{"RebuildSlugMappings", "POST", "/admin/slug-rebuild"},
+			{"GetIntegrityReport", "GET", "/admin/integrity-report"},
+			{"InspectProjectKv", "GET", "/admin/projects/{uid}/kv"},
+			{"CreateBackup", "POST", "/admin/backups"},
+			{"ListBackups", "GET", "/admin/backups"},
+			{"RestoreBackup", "POST", "/admin/backups/{name}/restore"},
+			{"ListPendingAnnouncements", "GET", "/admin/announcements"},
+			{"GetProjectEnums", "GET", "/metadata/enums"},
+			{"UpdateProjectEnums", "PUT", "/admin/metadata/enums"},
+			{"CreateProjectTemplate", "POST", "/admin/project-templates"},
+			{"ListProjectTemplates", "GET", "/admin/project-templates"},
+			{"GetProjectTemplate", "GET", "/admin/project-templates/{name}"},
+			{"UpdateProjectTemplate", "PUT", "/admin/project-templates/{name}"},
+			{"DeleteProjectTemplate", "DELETE", "/admin/project-templates/{name}"},
+			{"ListProjectDeletions", "GET", "/projects/deletions"},
+			{"ListProjectEvents", "GET", "/projects/{uid}/events"},
+			{"GetUserProjects", "GET", "/users/{username}/projects"},
+			{"ExportUserData", "GET", "/users/{username}/data-export"},
+			{"RemoveUserAccess", "DELETE", "/users/{username}/access"},
+			{"GetProjectVisibilityImpact", "GET", "/projects/{uid}/visibility-impact"},
+			{"CreateWebhook", "POST", "/admin/webhooks"},
+			{"ListWebhooks", "GET", "/admin/webhooks"},
+			{"GetWebhook", "GET", "/admin/webhooks/{id}"},
+			{"DeleteWebhook", "DELETE", "/admin/webhooks/{id}"},
+			{"ListWebhookDeliveries", "GET", "/admin/webhooks/{id}/deliveries"},
 			{"Serve gen/http/openapi.json", "GET", "/_projects/openapi.json"},
 			{"Serve gen/http/openapi.yaml", "GET", "/_projects/openapi.yaml"},
 			{"Serve gen/http/openapi3.json", "GET", "/_projects/openapi3.json"},
 			{"Serve gen/http/openapi3.yaml", "GET", "/_projects/openapi3.yaml"},
 		},
-		GetProjects:             NewGetProjectsHandler(e.GetProjects, mux, decoder, encoder, errhandler, formatter),
-		CreateProject:           NewCreateProjectHandler(e.CreateProject, mux, decoder, encoder, errhandler, formatter),
-		GetOneProjectBase:       NewGetOneProjectBaseHandler(e.GetOneProjectBase, mux, decoder, encoder, errhandler, formatter),
-		GetOneProjectSettings:   NewGetOneProjectSettingsHandler(e.GetOneProjectSettings, mux, decoder, encoder, errhandler, formatter),
-		UpdateProjectBase:       NewUpdateProjectBaseHandler(e.UpdateProjectBase, mux, decoder, encoder, errhandler, formatter),
-		UpdateProjectSettings:   NewUpdateProjectSettingsHandler(e.UpdateProjectSettings, mux, decoder, encoder, errhandler, formatter),
-		DeleteProject:           NewDeleteProjectHandler(e.DeleteProject, mux, decoder, encoder, errhandler, formatter),
-		Readyz:                  NewReadyzHandler(e.Readyz, mux, decoder, encoder, errhandler, formatter),
-		Livez:                   NewLivezHandler(e.Livez, mux, decoder, encoder, errhandler, formatter),
-		CreateProjectLink:       NewCreateProjectLinkHandler(e.CreateProjectLink, mux, decoder, encoder, errhandler, formatter),
-		GetProjectLink:          NewGetProjectLinkHandler(e.GetProjectLink, mux, decoder, encoder, errhandler, formatter),
-		DeleteProjectLink:       NewDeleteProjectLinkHandler(e.DeleteProjectLink, mux, decoder, encoder, errhandler, formatter),
-		CreateProjectFolder:     NewCreateProjectFolderHandler(e.CreateProjectFolder, mux, decoder, encoder, errhandler, formatter),
-		GetProjectFolder:        NewGetProjectFolderHandler(e.GetProjectFolder, mux, decoder, encoder, errhandler, formatter),
-		DeleteProjectFolder:     NewDeleteProjectFolderHandler(e.DeleteProjectFolder, mux, decoder, encoder, errhandler, formatter),
-		UploadProjectDocument:   NewUploadProjectDocumentHandler(e.UploadProjectDocument, mux, NewProjectServiceUploadProjectDocumentDecoder(mux, projectServiceUploadProjectDocumentDecoderFn), encoder, errhandler, formatter),
-		GetProjectDocument:      NewGetProjectDocumentHandler(e.GetProjectDocument, mux, decoder, encoder, errhandler, formatter),
-		DownloadProjectDocument: NewDownloadProjectDocumentHandler(e.DownloadProjectDocument, mux, decoder, encoder, errhandler, formatter),
-		DeleteProjectDocument:   NewDeleteProjectDocumentHandler(e.DeleteProjectDocument, mux, decoder, encoder, errhandler, formatter),
-		GenHTTPOpenapiJSON:      http.FileServer(fileSystemGenHTTPOpenapiJSON),
-		GenHTTPOpenapiYaml:      http.FileServer(fileSystemGenHTTPOpenapiYaml),
-		GenHTTPOpenapi3JSON:     http.FileServer(fileSystemGenHTTPOpenapi3JSON),
-		GenHTTPOpenapi3Yaml:     http.FileServer(fileSystemGenHTTPOpenapi3Yaml),
+		CloneProject:               NewCloneProjectHandler(e.CloneProject, mux, decoder, encoder, errhandler, formatter),
+		UploadProjectLogo:          NewUploadProjectLogoHandler(e.UploadProjectLogo, mux, NewProjectServiceUploadProjectLogoDecoder(mux, projectServiceUploadProjectLogoDecoderFn), encoder, errhandler, formatter),
+		MergeProject:               NewMergeProjectHandler(e.MergeProject, mux, decoder, encoder, errhandler, formatter),
+		TransferProject:            NewTransferProjectHandler(e.TransferProject, mux, decoder, encoder, errhandler, formatter),
+		CreateProjectLink:          NewCreateProjectLinkHandler(e.CreateProjectLink, mux, decoder, encoder, errhandler, formatter),
+		GetProjectLink:             NewGetProjectLinkHandler(e.GetProjectLink, mux, decoder, encoder, errhandler, formatter),
+		DeleteProjectLink:          NewDeleteProjectLinkHandler(e.DeleteProjectLink, mux, decoder, encoder, errhandler, formatter),
+		CreateProjectFolder:        NewCreateProjectFolderHandler(e.CreateProjectFolder, mux, decoder, encoder, errhandler, formatter),
+		GetProjectFolder:           NewGetProjectFolderHandler(e.GetProjectFolder, mux, decoder, encoder, errhandler, formatter),
+		DeleteProjectFolder:        NewDeleteProjectFolderHandler(e.DeleteProjectFolder, mux, decoder, encoder, errhandler, formatter),
+		UploadProjectDocument:      NewUploadProjectDocumentHandler(e.UploadProjectDocument, mux, NewProjectServiceUploadProjectDocumentDecoder(mux, projectServiceUploadProjectDocumentDecoderFn), encoder, errhandler, formatter),
+		GetProjectDocument:         NewGetProjectDocumentHandler(e.GetProjectDocument, mux, decoder, encoder, errhandler, formatter),
+		DownloadProjectDocument:    NewDownloadProjectDocumentHandler(e.DownloadProjectDocument, mux, decoder, encoder, errhandler, formatter),
+		DeleteProjectDocument:      NewDeleteProjectDocumentHandler(e.DeleteProjectDocument, mux, decoder, encoder, errhandler, formatter),
+		UploadProjectCharter:       NewUploadProjectCharterHandler(e.UploadProjectCharter, mux, NewProjectServiceUploadProjectCharterDecoder(mux, projectServiceUploadProjectCharterDecoderFn), encoder, errhandler, formatter),
+		GetProjectCharter:          NewGetProjectCharterHandler(e.GetProjectCharter, mux, decoder, encoder, errhandler, formatter),
+		GetProjectAssociations:     NewGetProjectAssociationsHandler(e.GetProjectAssociations, mux, decoder, encoder, errhandler, formatter),
+		UpdateProjectAssociations:  NewUpdateProjectAssociationsHandler(e.UpdateProjectAssociations, mux, decoder, encoder, errhandler, formatter),
+		GetProjectSettingsDiff:     NewGetProjectSettingsDiffHandler(e.GetProjectSettingsDiff, mux, decoder, encoder, errhandler, formatter),
+		GetProjectChildren:         NewGetProjectChildrenHandler(e.GetProjectChildren, mux, decoder, encoder, errhandler, formatter),
+		GetProjectDescendants:      NewGetProjectDescendantsHandler(e.GetProjectDescendants, mux, decoder, encoder, errhandler, formatter),
+		GetProjectLegalLineage:     NewGetProjectLegalLineageHandler(e.GetProjectLegalLineage, mux, decoder, encoder, errhandler, formatter),
+		SearchProjects:             NewSearchProjectsHandler(e.SearchProjects, mux, decoder, encoder, errhandler, formatter),
+		AutocompleteProjects:       NewAutocompleteProjectsHandler(e.AutocompleteProjects, mux, decoder, encoder, errhandler, formatter),
+		AddProjectWriter:           NewAddProjectWriterHandler(e.AddProjectWriter, mux, decoder, encoder, errhandler, formatter),
+		RemoveProjectWriter:        NewRemoveProjectWriterHandler(e.RemoveProjectWriter, mux, decoder, encoder, errhandler, formatter),
+		AddProjectAuditor:          NewAddProjectAuditorHandler(e.AddProjectAuditor, mux, decoder, encoder, errhandler, formatter),
+		RemoveProjectAuditor:       NewRemoveProjectAuditorHandler(e.RemoveProjectAuditor, mux, decoder, encoder, errhandler, formatter),
+		JoinProject:                NewJoinProjectHandler(e.JoinProject, mux, decoder, encoder, errhandler, formatter),
+		BulkUpdateProjectMembers:   NewBulkUpdateProjectMembersHandler(e.BulkUpdateProjectMembers, mux, decoder, encoder, errhandler, formatter),
+		GetProjects:                NewGetProjectsHandler(e.GetProjects, mux, decoder, encoder, errhandler, formatter),
+		CreateProject:              NewCreateProjectHandler(e.CreateProject, mux, decoder, encoder, errhandler, formatter),
+		GetOneProjectBase:          NewGetOneProjectBaseHandler(e.GetOneProjectBase, mux, decoder, encoder, errhandler, formatter),
+		GetProjectUIDFromLegacyID:  NewGetProjectUIDFromLegacyIDHandler(e.GetProjectUIDFromLegacyID, mux, decoder, encoder, errhandler, formatter),
+		GetOneProjectSettings:      NewGetOneProjectSettingsHandler(e.GetOneProjectSettings, mux, decoder, encoder, errhandler, formatter),
+		UpdateProjectBase:          NewUpdateProjectBaseHandler(e.UpdateProjectBase, mux, decoder, encoder, errhandler, formatter),
+		UpdateProjectSettings:      NewUpdateProjectSettingsHandler(e.UpdateProjectSettings, mux, decoder, encoder, errhandler, formatter),
+		DeleteProject:              NewDeleteProjectHandler(e.DeleteProject, mux, decoder, encoder, errhandler, formatter),
+		BulkDeleteProjects:         NewBulkDeleteProjectsHandler(e.BulkDeleteProjects, mux, decoder, encoder, errhandler, formatter),
+		Readyz:                     NewReadyzHandler(e.Readyz, mux, decoder, encoder, errhandler, formatter),
+		Livez:                      NewLivezHandler(e.Livez, mux, decoder, encoder, errhandler, formatter),
+		Healthz:                    NewHealthzHandler(e.Healthz, mux, decoder, encoder, errhandler, formatter),
+		ReconcileOutbox:            NewReconcileOutboxHandler(e.ReconcileOutbox, mux, decoder, encoder, errhandler, formatter),
+		ResyncProjects:             NewResyncProjectsHandler(e.ResyncProjects, mux, decoder, encoder, errhandler, formatter),
+		ListDeadLetters:            NewListDeadLettersHandler(e.ListDeadLetters, mux, decoder, encoder, errhandler, formatter),
+		ReplayDeadLetter:           NewReplayDeadLetterHandler(e.ReplayDeadLetter, mux, decoder, encoder, errhandler, formatter),
+		RebuildSlugMappings:        NewRebuildSlugMappingsHandler(e.RebuildSlugMappings, mux, decoder, encoder, errhandler, formatter),
+		GetIntegrityReport:         NewGetIntegrityReportHandler(e.GetIntegrityReport, mux, decoder, encoder, errhandler, formatter),
+		InspectProjectKv:           NewInspectProjectKvHandler(e.InspectProjectKv, mux, decoder, encoder, errhandler, formatter),
+		CreateBackup:               NewCreateBackupHandler(e.CreateBackup, mux, decoder, encoder, errhandler, formatter),
+		ListBackups:                NewListBackupsHandler(e.ListBackups, mux, decoder, encoder, errhandler, formatter),
+		RestoreBackup:              NewRestoreBackupHandler(e.RestoreBackup, mux, decoder, encoder, errhandler, formatter),
+		ListPendingAnnouncements:   NewListPendingAnnouncementsHandler(e.ListPendingAnnouncements, mux, decoder, encoder, errhandler, formatter),
+		GetProjectEnums:            NewGetProjectEnumsHandler(e.GetProjectEnums, mux, decoder, encoder, errhandler, formatter),
+		UpdateProjectEnums:         NewUpdateProjectEnumsHandler(e.UpdateProjectEnums, mux, decoder, encoder, errhandler, formatter),
+		CreateProjectTemplate:      NewCreateProjectTemplateHandler(e.CreateProjectTemplate, mux, decoder, encoder, errhandler, formatter),
+		ListProjectTemplates:       NewListProjectTemplatesHandler(e.ListProjectTemplates, mux, decoder, encoder, errhandler, formatter),
+		GetProjectTemplate:         NewGetProjectTemplateHandler(e.GetProjectTemplate, mux, decoder, encoder, errhandler, formatter),
+		UpdateProjectTemplate:      NewUpdateProjectTemplateHandler(e.UpdateProjectTemplate, mux, decoder, encoder, errhandler, formatter),
+		DeleteProjectTemplate:      NewDeleteProjectTemplateHandler(e.DeleteProjectTemplate, mux, decoder, encoder, errhandler, formatter),
+		ListProjectDeletions:       NewListProjectDeletionsHandler(e.ListProjectDeletions, mux, decoder, encoder, errhandler, formatter),
+		ListProjectEvents:          NewListProjectEventsHandler(e.ListProjectEvents, mux, decoder, encoder, errhandler, formatter),
+		GetUserProjects:            NewGetUserProjectsHandler(e.GetUserProjects, mux, decoder, encoder, errhandler, formatter),
+		ExportUserData:             NewExportUserDataHandler(e.ExportUserData, mux, decoder, encoder, errhandler, formatter),
+		RemoveUserAccess:           NewRemoveUserAccessHandler(e.RemoveUserAccess, mux, decoder, encoder, errhandler, formatter),
+		GetProjectVisibilityImpact: NewGetProjectVisibilityImpactHandler(e.GetProjectVisibilityImpact, mux, decoder, encoder, errhandler, formatter),
+		CreateWebhook:              NewCreateWebhookHandler(e.CreateWebhook, mux, decoder, encoder, errhandler, formatter),
+		ListWebhooks:               NewListWebhooksHandler(e.ListWebhooks, mux, decoder, encoder, errhandler, formatter),
+		GetWebhook:                 NewGetWebhookHandler(e.GetWebhook, mux, decoder, encoder, errhandler, formatter),
+		DeleteWebhook:              NewDeleteWebhookHandler(e.DeleteWebhook, mux, decoder, encoder, errhandler, formatter),
+		ListWebhookDeliveries:      NewListWebhookDeliveriesHandler(e.ListWebhookDeliveries, mux, decoder, encoder, errhandler, formatter),
+		GenHTTPOpenapiJSON:         http.FileServer(fileSystemGenHTTPOpenapiJSON),
+		GenHTTPOpenapiYaml:         http.FileServer(fileSystemGenHTTPOpenapiYaml),
+		GenHTTPOpenapi3JSON:        http.FileServer(fileSystemGenHTTPOpenapi3JSON),
+		GenHTTPOpenapi3Yaml:        http.FileServer(fileSystemGenHTTPOpenapi3Yaml),
 	}
 }
 
@@ -158,15 +325,10 @@ func (s *Server) Service() string { return "project-service" }
 
 // Use wraps the server handlers with the given middleware.
 func (s *Server) Use(m func(http.Handler) http.Handler) {
-	s.GetProjects = m(s.GetProjects)
-	s.CreateProject = m(s.CreateProject)
-	s.GetOneProjectBase = m(s.GetOneProjectBase)
-	s.GetOneProjectSettings = m(s.GetOneProjectSettings)
-	s.UpdateProjectBase = m(s.UpdateProjectBase)
-	s.UpdateProjectSettings = m(s.UpdateProjectSettings)
-	s.DeleteProject = m(s.DeleteProject)
-	s.Readyz = m(s.Readyz)
-	s.Livez = m(s.Livez)
+	s.CloneProject = m(s.CloneProject)
+	s.UploadProjectLogo = m(s.UploadProjectLogo)
+	s.MergeProject = m(s.MergeProject)
+	s.TransferProject = m(s.TransferProject)
 	s.CreateProjectLink = m(s.CreateProjectLink)
 	s.GetProjectLink = m(s.GetProjectLink)
 	s.DeleteProjectLink = m(s.DeleteProjectLink)
@@ -177,6 +339,63 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.GetProjectDocument = m(s.GetProjectDocument)
 	s.DownloadProjectDocument = m(s.DownloadProjectDocument)
 	s.DeleteProjectDocument = m(s.DeleteProjectDocument)
+	s.UploadProjectCharter = m(s.UploadProjectCharter)
+	s.GetProjectCharter = m(s.GetProjectCharter)
+	s.GetProjectAssociations = m(s.GetProjectAssociations)
+	s.UpdateProjectAssociations = m(s.UpdateProjectAssociations)
+	s.GetProjectSettingsDiff = m(s.GetProjectSettingsDiff)
+	s.GetProjectChildren = m(s.GetProjectChildren)
+	s.GetProjectDescendants = m(s.GetProjectDescendants)
+	s.GetProjectLegalLineage = m(s.GetProjectLegalLineage)
+	s.SearchProjects = m(s.SearchProjects)
+	s.AutocompleteProjects = m(s.AutocompleteProjects)
+	s.AddProjectWriter = m(s.AddProjectWriter)
+	s.RemoveProjectWriter = m(s.RemoveProjectWriter)
+	s.AddProjectAuditor = m(s.AddProjectAuditor)
+	s.RemoveProjectAuditor = m(s.RemoveProjectAuditor)
+	s.JoinProject = m(s.JoinProject)
+	s.BulkUpdateProjectMembers = m(s.BulkUpdateProjectMembers)
+	s.GetProjects = m(s.GetProjects)
+	s.CreateProject = m(s.CreateProject)
+	s.GetOneProjectBase = m(s.GetOneProjectBase)
+	s.GetProjectUIDFromLegacyID = m(s.GetProjectUIDFromLegacyID)
+	s.GetOneProjectSettings = m(s.GetOneProjectSettings)
+	s.UpdateProjectBase = m(s.UpdateProjectBase)
+	s.UpdateProjectSettings = m(s.UpdateProjectSettings)
+	s.DeleteProject = m(s.DeleteProject)
+	s.BulkDeleteProjects = m(s.BulkDeleteProjects)
+	s.Readyz = m(s.Readyz)
+	s.Livez = m(s.Livez)
+	s.Healthz = m(s.Healthz)
+	s.ReconcileOutbox = m(s.ReconcileOutbox)
+	s.ResyncProjects = m(s.ResyncProjects)
+	s.ListDeadLetters = m(s.ListDeadLetters)
+	s.ReplayDeadLetter = m(s.ReplayDeadLetter)
+	s.RebuildSlugMappings = m(s.RebuildSlugMappings)
+	s.GetIntegrityReport = m(s.GetIntegrityReport)
+	s.InspectProjectKv = m(s.InspectProjectKv)
+	s.CreateBackup = m(s.CreateBackup)
+	s.ListBackups = m(s.ListBackups)
+	s.RestoreBackup = m(s.RestoreBackup)
+	s.ListPendingAnnouncements = m(s.ListPendingAnnouncements)
+	s.GetProjectEnums = m(s.GetProjectEnums)
+	s.UpdateProjectEnums = m(s.UpdateProjectEnums)
+	s.CreateProjectTemplate = m(s.CreateProjectTemplate)
+	s.ListProjectTemplates = m(s.ListProjectTemplates)
+	s.GetProjectTemplate = m(s.GetProjectTemplate)
+	s.UpdateProjectTemplate = m(s.UpdateProjectTemplate)
+	s.DeleteProjectTemplate = m(s.DeleteProjectTemplate)
+	s.ListProjectDeletions = m(s.ListProjectDeletions)
+	s.ListProjectEvents = m(s.ListProjectEvents)
+	s.GetUserProjects = m(s.GetUserProjects)
+	s.ExportUserData = m(s.ExportUserData)
+	s.RemoveUserAccess = m(s.RemoveUserAccess)
+	s.GetProjectVisibilityImpact = m(s.GetProjectVisibilityImpact)
+	s.CreateWebhook = m(s.CreateWebhook)
+	s.ListWebhooks = m(s.ListWebhooks)
+	s.GetWebhook = m(s.GetWebhook)
+	s.DeleteWebhook = m(s.DeleteWebhook)
+	s.ListWebhookDeliveries = m(s.ListWebhookDeliveries)
 }
 
 // MethodNames returns the methods served.
@@ -184,15 +403,10 @@ func (s *Server) MethodNames() []string { return projectservice.MethodNames[:] }
 
 // Mount configures the mux to serve the project-service endpoints.
 func Mount(mux goahttp.Muxer, h *Server) {
-	MountGetProjectsHandler(mux, h.GetProjects)
-	MountCreateProjectHandler(mux, h.CreateProject)
-	MountGetOneProjectBaseHandler(mux, h.GetOneProjectBase)
-	MountGetOneProjectSettingsHandler(mux, h.GetOneProjectSettings)
-	MountUpdateProjectBaseHandler(mux, h.UpdateProjectBase)
-	MountUpdateProjectSettingsHandler(mux, h.UpdateProjectSettings)
-	MountDeleteProjectHandler(mux, h.DeleteProject)
-	MountReadyzHandler(mux, h.Readyz)
-	MountLivezHandler(mux, h.Livez)
+	MountCloneProjectHandler(mux, h.CloneProject)
+	MountUploadProjectLogoHandler(mux, h.UploadProjectLogo)
+	MountMergeProjectHandler(mux, h.MergeProject)
+	MountTransferProjectHandler(mux, h.TransferProject)
 	MountCreateProjectLinkHandler(mux, h.CreateProjectLink)
 	MountGetProjectLinkHandler(mux, h.GetProjectLink)
 	MountDeleteProjectLinkHandler(mux, h.DeleteProjectLink)
@@ -203,6 +417,63 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountGetProjectDocumentHandler(mux, h.GetProjectDocument)
 	MountDownloadProjectDocumentHandler(mux, h.DownloadProjectDocument)
 	MountDeleteProjectDocumentHandler(mux, h.DeleteProjectDocument)
+	MountUploadProjectCharterHandler(mux, h.UploadProjectCharter)
+	MountGetProjectCharterHandler(mux, h.GetProjectCharter)
+	MountGetProjectAssociationsHandler(mux, h.GetProjectAssociations)
+	MountUpdateProjectAssociationsHandler(mux, h.UpdateProjectAssociations)
+	MountGetProjectSettingsDiffHandler(mux, h.GetProjectSettingsDiff)
+	MountGetProjectChildrenHandler(mux, h.GetProjectChildren)
+	MountGetProjectDescendantsHandler(mux, h.GetProjectDescendants)
+	MountGetProjectLegalLineageHandler(mux, h.GetProjectLegalLineage)
+	MountSearchProjectsHandler(mux, h.SearchProjects)
+	MountAutocompleteProjectsHandler(mux, h.AutocompleteProjects)
+	MountAddProjectWriterHandler(mux, h.AddProjectWriter)
+	MountRemoveProjectWriterHandler(mux, h.RemoveProjectWriter)
+	MountAddProjectAuditorHandler(mux, h.AddProjectAuditor)
+	MountRemoveProjectAuditorHandler(mux, h.RemoveProjectAuditor)
+	MountJoinProjectHandler(mux, h.JoinProject)
+	MountBulkUpdateProjectMembersHandler(mux, h.BulkUpdateProjectMembers)
+	MountGetProjectsHandler(mux, h.GetProjects)
+	MountCreateProjectHandler(mux, h.CreateProject)
+	MountGetOneProjectBaseHandler(mux, h.GetOneProjectBase)
+	MountGetProjectUIDFromLegacyIDHandler(mux, h.GetProjectUIDFromLegacyID)
+	MountGetOneProjectSettingsHandler(mux, h.GetOneProjectSettings)
+	MountUpdateProjectBaseHandler(mux, h.UpdateProjectBase)
+	MountUpdateProjectSettingsHandler(mux, h.UpdateProjectSettings)
+	MountDeleteProjectHandler(mux, h.DeleteProject)
+	MountBulkDeleteProjectsHandler(mux, h.BulkDeleteProjects)
+	MountReadyzHandler(mux, h.Readyz)
+	MountLivezHandler(mux, h.Livez)
+	MountHealthzHandler(mux, h.Healthz)
+	MountReconcileOutboxHandler(mux, h.ReconcileOutbox)
+	MountResyncProjectsHandler(mux, h.ResyncProjects)
+	MountListDeadLettersHandler(mux, h.ListDeadLetters)
+	MountReplayDeadLetterHandler(mux, h.ReplayDeadLetter)
+	MountRebuildSlugMappingsHandler(mux, h.RebuildSlugMappings)
+	MountGetIntegrityReportHandler(mux, h.GetIntegrityReport)
+	MountInspectProjectKvHandler(mux, h.InspectProjectKv)
+	MountCreateBackupHandler(mux, h.CreateBackup)
+	MountListBackupsHandler(mux, h.ListBackups)
+	MountRestoreBackupHandler(mux, h.RestoreBackup)
+	MountListPendingAnnouncementsHandler(mux, h.ListPendingAnnouncements)
+	MountGetProjectEnumsHandler(mux, h.GetProjectEnums)
+	MountUpdateProjectEnumsHandler(mux, h.UpdateProjectEnums)
+	MountCreateProjectTemplateHandler(mux, h.CreateProjectTemplate)
+	MountListProjectTemplatesHandler(mux, h.ListProjectTemplates)
+	MountGetProjectTemplateHandler(mux, h.GetProjectTemplate)
+	MountUpdateProjectTemplateHandler(mux, h.UpdateProjectTemplate)
+	MountDeleteProjectTemplateHandler(mux, h.DeleteProjectTemplate)
+	MountListProjectDeletionsHandler(mux, h.ListProjectDeletions)
+	MountListProjectEventsHandler(mux, h.ListProjectEvents)
+	MountGetUserProjectsHandler(mux, h.GetUserProjects)
+	MountExportUserDataHandler(mux, h.ExportUserData)
+	MountRemoveUserAccessHandler(mux, h.RemoveUserAccess)
+	MountGetProjectVisibilityImpactHandler(mux, h.GetProjectVisibilityImpact)
+	MountCreateWebhookHandler(mux, h.CreateWebhook)
+	MountListWebhooksHandler(mux, h.ListWebhooks)
+	MountGetWebhookHandler(mux, h.GetWebhook)
+	MountDeleteWebhookHandler(mux, h.DeleteWebhook)
+	MountListWebhookDeliveriesHandler(mux, h.ListWebhookDeliveries)
 	MountGenHTTPOpenapiJSON(mux, http.StripPrefix("/_projects", h.GenHTTPOpenapiJSON))
 	MountGenHTTPOpenapiYaml(mux, http.StripPrefix("/_projects", h.GenHTTPOpenapiYaml))
 	MountGenHTTPOpenapi3JSON(mux, http.StripPrefix("/_projects", h.GenHTTPOpenapi3JSON))
@@ -214,21 +485,21 @@ func (s *Server) Mount(mux goahttp.Muxer) {
 	Mount(mux, s)
 }
 
-// MountGetProjectsHandler configures the mux to serve the "project-service"
-// service "get-projects" endpoint.
-func MountGetProjectsHandler(mux goahttp.Muxer, h http.Handler) {
+// MountCloneProjectHandler configures the mux to serve the "project-service"
+// service "clone-project" endpoint.
+func MountCloneProjectHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/projects", f)
+	mux.Handle("POST", "/projects/{uid}/clone", f)
 }
 
-// NewGetProjectsHandler creates a HTTP handler which loads the HTTP request
-// and calls the "project-service" service "get-projects" endpoint.
-func NewGetProjectsHandler(
+// NewCloneProjectHandler creates a HTTP handler which loads the HTTP request
+// and calls the "project-service" service "clone-project" endpoint.
+func NewCloneProjectHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -237,13 +508,13 @@ func NewGetProjectsHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeGetProjectsRequest(mux, decoder)
-		encodeResponse = EncodeGetProjectsResponse(encoder)
-		encodeError    = EncodeGetProjectsError(encoder, formatter)
+		decodeRequest  = DecodeCloneProjectRequest(mux, decoder)
+		encodeResponse = EncodeCloneProjectResponse(encoder)
+		encodeError    = EncodeCloneProjectError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "get-projects")
+		ctx = context.WithValue(ctx, goa.MethodKey, "clone-project")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -267,21 +538,2826 @@ func NewGetProjectsHandler(
 	})
 }
 
-// MountCreateProjectHandler configures the mux to serve the "project-service"
-// service "create-project" endpoint.
-func MountCreateProjectHandler(mux goahttp.Muxer, h http.Handler) {
+// MountUploadProjectLogoHandler configures the mux to serve the
+// "project-service" service "upload-project-logo" endpoint.
+func MountUploadProjectLogoHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("POST", "/projects", f)
+	mux.Handle("POST", "/projects/{uid}/logo", f)
 }
 
-// NewCreateProjectHandler creates a HTTP handler which loads the HTTP request
-// and calls the "project-service" service "create-project" endpoint.
-func NewCreateProjectHandler(
+// NewUploadProjectLogoHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "upload-project-logo"
+// endpoint.
+func NewUploadProjectLogoHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUploadProjectLogoRequest(mux, decoder)
+		encodeResponse = EncodeUploadProjectLogoResponse(encoder)
+		encodeError    = EncodeUploadProjectLogoError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "upload-project-logo")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountMergeProjectHandler configures the mux to serve the "project-service"
+// service "merge-project" endpoint.
+func MountMergeProjectHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/projects/{uid}/merge-into/{target_uid}", f)
+}
+
+// NewMergeProjectHandler creates a HTTP handler which loads the HTTP request
+// and calls the "project-service" service "merge-project" endpoint.
+func NewMergeProjectHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeMergeProjectRequest(mux, decoder)
+		encodeResponse = EncodeMergeProjectResponse(encoder)
+		encodeError    = EncodeMergeProjectError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "merge-project")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountTransferProjectHandler configures the mux to serve the
+// "project-service" service "transfer-project" endpoint.
+func MountTransferProjectHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/projects/{uid}/transfer", f)
+}
+
+// NewTransferProjectHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "transfer-project" endpoint.
+func NewTransferProjectHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeTransferProjectRequest(mux, decoder)
+		encodeResponse = EncodeTransferProjectResponse(encoder)
+		encodeError    = EncodeTransferProjectError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "transfer-project")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCreateProjectLinkHandler configures the mux to serve the
+// "project-service" service "create-project-link" endpoint.
+func MountCreateProjectLinkHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/projects/{uid}/links", f)
+}
+
+// NewCreateProjectLinkHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "create-project-link"
+// endpoint.
+func NewCreateProjectLinkHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateProjectLinkRequest(mux, decoder)
+		encodeResponse = EncodeCreateProjectLinkResponse(encoder)
+		encodeError    = EncodeCreateProjectLinkError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "create-project-link")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetProjectLinkHandler configures the mux to serve the "project-service"
+// service "get-project-link" endpoint.
+func MountGetProjectLinkHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/projects/{uid}/links/{link_uid}", f)
+}
+
+// NewGetProjectLinkHandler creates a HTTP handler which loads the HTTP request
+// and calls the "project-service" service "get-project-link" endpoint.
+func NewGetProjectLinkHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetProjectLinkRequest(mux, decoder)
+		encodeResponse = EncodeGetProjectLinkResponse(encoder)
+		encodeError    = EncodeGetProjectLinkError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-project-link")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDeleteProjectLinkHandler configures the mux to serve the
+// "project-service" service "delete-project-link" endpoint.
+func MountDeleteProjectLinkHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/projects/{uid}/links/{link_uid}", f)
+}
+
+// NewDeleteProjectLinkHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "delete-project-link"
+// endpoint.
+func NewDeleteProjectLinkHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteProjectLinkRequest(mux, decoder)
+		encodeResponse = EncodeDeleteProjectLinkResponse(encoder)
+		encodeError    = EncodeDeleteProjectLinkError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete-project-link")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCreateProjectFolderHandler configures the mux to serve the
+// "project-service" service "create-project-folder" endpoint.
+func MountCreateProjectFolderHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/projects/{uid}/folders", f)
+}
+
+// NewCreateProjectFolderHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "create-project-folder"
+// endpoint.
+func NewCreateProjectFolderHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateProjectFolderRequest(mux, decoder)
+		encodeResponse = EncodeCreateProjectFolderResponse(encoder)
+		encodeError    = EncodeCreateProjectFolderError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "create-project-folder")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetProjectFolderHandler configures the mux to serve the
+// "project-service" service "get-project-folder" endpoint.
+func MountGetProjectFolderHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/projects/{uid}/folders/{folder_uid}", f)
+}
+
+// NewGetProjectFolderHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "get-project-folder"
+// endpoint.
+func NewGetProjectFolderHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetProjectFolderRequest(mux, decoder)
+		encodeResponse = EncodeGetProjectFolderResponse(encoder)
+		encodeError    = EncodeGetProjectFolderError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-project-folder")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDeleteProjectFolderHandler configures the mux to serve the
+// "project-service" service "delete-project-folder" endpoint.
+func MountDeleteProjectFolderHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/projects/{uid}/folders/{folder_uid}", f)
+}
+
+// NewDeleteProjectFolderHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "delete-project-folder"
+// endpoint.
+func NewDeleteProjectFolderHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteProjectFolderRequest(mux, decoder)
+		encodeResponse = EncodeDeleteProjectFolderResponse(encoder)
+		encodeError    = EncodeDeleteProjectFolderError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete-project-folder")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUploadProjectDocumentHandler configures the mux to serve the
+// "project-service" service "upload-project-document" endpoint.
+func MountUploadProjectDocumentHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/projects/{uid}/documents", f)
+}
+
+// NewUploadProjectDocumentHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "upload-project-document"
+// endpoint.
+func NewUploadProjectDocumentHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUploadProjectDocumentRequest(mux, decoder)
+		encodeResponse = EncodeUploadProjectDocumentResponse(encoder)
+		encodeError    = EncodeUploadProjectDocumentError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "upload-project-document")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetProjectDocumentHandler configures the mux to serve the
+// "project-service" service "get-project-document" endpoint.
+func MountGetProjectDocumentHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/projects/{uid}/documents/{document_uid}", f)
+}
+
+// NewGetProjectDocumentHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "get-project-document"
+// endpoint.
+func NewGetProjectDocumentHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetProjectDocumentRequest(mux, decoder)
+		encodeResponse = EncodeGetProjectDocumentResponse(encoder)
+		encodeError    = EncodeGetProjectDocumentError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-project-document")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDownloadProjectDocumentHandler configures the mux to serve the
+// "project-service" service "download-project-document" endpoint.
+func MountDownloadProjectDocumentHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/projects/{uid}/documents/{document_uid}/download", f)
+}
+
+// NewDownloadProjectDocumentHandler creates a HTTP handler which loads the
+// HTTP request and calls the "project-service" service
+// "download-project-document" endpoint.
+func NewDownloadProjectDocumentHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDownloadProjectDocumentRequest(mux, decoder)
+		encodeResponse = EncodeDownloadProjectDocumentResponse(encoder)
+		encodeError    = EncodeDownloadProjectDocumentError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "download-project-document")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		o := res.(*projectservice.DownloadProjectDocumentResponseData)
+		defer o.Body.Close()
+		if wt, ok := o.Body.(io.WriterTo); ok {
+			if err := encodeResponse(ctx, w, res); err != nil {
+				if errhandler != nil {
+					errhandler(ctx, w, err)
+				}
+				return
+			}
+			n, err := wt.WriteTo(w)
+			if err != nil {
+				if n == 0 {
+					if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+						errhandler(ctx, w, err)
+					}
+				} else {
+					http.NewResponseController(w).Flush()
+					panic(http.ErrAbortHandler) // too late to write an error
+				}
+			}
+			return
+		}
+		// handle immediate read error like a returned error
+		buf := bufio.NewReader(o.Body)
+		if _, err := buf.Peek(1); err != nil && err != io.EOF {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if _, err := io.Copy(w, buf); err != nil {
+			http.NewResponseController(w).Flush()
+			panic(http.ErrAbortHandler) // too late to write an error
+		}
+	})
+}
+
+// MountDeleteProjectDocumentHandler configures the mux to serve the
+// "project-service" service "delete-project-document" endpoint.
+func MountDeleteProjectDocumentHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/projects/{uid}/documents/{document_uid}", f)
+}
+
+// NewDeleteProjectDocumentHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "delete-project-document"
+// endpoint.
+func NewDeleteProjectDocumentHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteProjectDocumentRequest(mux, decoder)
+		encodeResponse = EncodeDeleteProjectDocumentResponse(encoder)
+		encodeError    = EncodeDeleteProjectDocumentError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete-project-document")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUploadProjectCharterHandler configures the mux to serve the
+// "project-service" service "upload-project-charter" endpoint.
+func MountUploadProjectCharterHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/projects/{uid}/charter", f)
+}
+
+// NewUploadProjectCharterHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "upload-project-charter"
+// endpoint.
+func NewUploadProjectCharterHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUploadProjectCharterRequest(mux, decoder)
+		encodeResponse = EncodeUploadProjectCharterResponse(encoder)
+		encodeError    = EncodeUploadProjectCharterError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "upload-project-charter")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetProjectCharterHandler configures the mux to serve the
+// "project-service" service "get-project-charter" endpoint.
+func MountGetProjectCharterHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/projects/{uid}/charter", f)
+}
+
+// NewGetProjectCharterHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "get-project-charter"
+// endpoint.
+func NewGetProjectCharterHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetProjectCharterRequest(mux, decoder)
+		encodeResponse = EncodeGetProjectCharterResponse(encoder)
+		encodeError    = EncodeGetProjectCharterError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-project-charter")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetProjectAssociationsHandler configures the mux to serve the
+// "project-service" service "get-project-associations" endpoint.
+func MountGetProjectAssociationsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/projects/{uid}/associations", f)
+}
+
+// NewGetProjectAssociationsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "get-project-associations"
+// endpoint.
+func NewGetProjectAssociationsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetProjectAssociationsRequest(mux, decoder)
+		encodeResponse = EncodeGetProjectAssociationsResponse(encoder)
+		encodeError    = EncodeGetProjectAssociationsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-project-associations")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUpdateProjectAssociationsHandler configures the mux to serve the
+// "project-service" service "update-project-associations" endpoint.
+func MountUpdateProjectAssociationsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/projects/{uid}/associations", f)
+}
+
+// NewUpdateProjectAssociationsHandler creates a HTTP handler which loads the
+// HTTP request and calls the "project-service" service
+// "update-project-associations" endpoint.
+func NewUpdateProjectAssociationsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateProjectAssociationsRequest(mux, decoder)
+		encodeResponse = EncodeUpdateProjectAssociationsResponse(encoder)
+		encodeError    = EncodeUpdateProjectAssociationsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "update-project-associations")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetProjectSettingsDiffHandler configures the mux to serve the
+// "project-service" service "get-project-settings-diff" endpoint.
+func MountGetProjectSettingsDiffHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/projects/{uid}/settings/diff", f)
+}
+
+// NewGetProjectSettingsDiffHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "get-project-settings-diff"
+// endpoint.
+func NewGetProjectSettingsDiffHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetProjectSettingsDiffRequest(mux, decoder)
+		encodeResponse = EncodeGetProjectSettingsDiffResponse(encoder)
+		encodeError    = EncodeGetProjectSettingsDiffError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-project-settings-diff")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetProjectChildrenHandler configures the mux to serve the
+// "project-service" service "get-project-children" endpoint.
+func MountGetProjectChildrenHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/projects/{uid}/children", f)
+}
+
+// NewGetProjectChildrenHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "get-project-children"
+// endpoint.
+func NewGetProjectChildrenHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetProjectChildrenRequest(mux, decoder)
+		encodeResponse = EncodeGetProjectChildrenResponse(encoder)
+		encodeError    = EncodeGetProjectChildrenError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-project-children")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetProjectDescendantsHandler configures the mux to serve the
+// "project-service" service "get-project-descendants" endpoint.
+func MountGetProjectDescendantsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/projects/{uid}/descendants", f)
+}
+
+// NewGetProjectDescendantsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "get-project-descendants"
+// endpoint.
+func NewGetProjectDescendantsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetProjectDescendantsRequest(mux, decoder)
+		encodeResponse = EncodeGetProjectDescendantsResponse(encoder)
+		encodeError    = EncodeGetProjectDescendantsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-project-descendants")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetProjectLegalLineageHandler configures the mux to serve the
+// "project-service" service "get-project-legal-lineage" endpoint.
+func MountGetProjectLegalLineageHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/projects/{uid}/legal-lineage", f)
+}
+
+// NewGetProjectLegalLineageHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "get-project-legal-lineage"
+// endpoint.
+func NewGetProjectLegalLineageHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetProjectLegalLineageRequest(mux, decoder)
+		encodeResponse = EncodeGetProjectLegalLineageResponse(encoder)
+		encodeError    = EncodeGetProjectLegalLineageError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-project-legal-lineage")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountSearchProjectsHandler configures the mux to serve the "project-service"
+// service "search-projects" endpoint.
+func MountSearchProjectsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/projects/search", f)
+}
+
+// NewSearchProjectsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "project-service" service "search-projects" endpoint.
+func NewSearchProjectsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSearchProjectsRequest(mux, decoder)
+		encodeResponse = EncodeSearchProjectsResponse(encoder)
+		encodeError    = EncodeSearchProjectsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "search-projects")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountAutocompleteProjectsHandler configures the mux to serve the
+// "project-service" service "autocomplete-projects" endpoint.
+func MountAutocompleteProjectsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/projects/autocomplete", f)
+}
+
+// NewAutocompleteProjectsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "autocomplete-projects"
+// endpoint.
+func NewAutocompleteProjectsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeAutocompleteProjectsRequest(mux, decoder)
+		encodeResponse = EncodeAutocompleteProjectsResponse(encoder)
+		encodeError    = EncodeAutocompleteProjectsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "autocomplete-projects")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountAddProjectWriterHandler configures the mux to serve the
+// "project-service" service "add-project-writer" endpoint.
+func MountAddProjectWriterHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/projects/{uid}/writers/{username}", f)
+}
+
+// NewAddProjectWriterHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "add-project-writer"
+// endpoint.
+func NewAddProjectWriterHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeAddProjectWriterRequest(mux, decoder)
+		encodeResponse = EncodeAddProjectWriterResponse(encoder)
+		encodeError    = EncodeAddProjectWriterError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "add-project-writer")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRemoveProjectWriterHandler configures the mux to serve the
+// "project-service" service "remove-project-writer" endpoint.
+func MountRemoveProjectWriterHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/projects/{uid}/writers/{username}", f)
+}
+
+// NewRemoveProjectWriterHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "remove-project-writer"
+// endpoint.
+func NewRemoveProjectWriterHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRemoveProjectWriterRequest(mux, decoder)
+		encodeResponse = EncodeRemoveProjectWriterResponse(encoder)
+		encodeError    = EncodeRemoveProjectWriterError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "remove-project-writer")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountAddProjectAuditorHandler configures the mux to serve the
+// "project-service" service "add-project-auditor" endpoint.
+func MountAddProjectAuditorHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/projects/{uid}/auditors/{username}", f)
+}
+
+// NewAddProjectAuditorHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "add-project-auditor"
+// endpoint.
+func NewAddProjectAuditorHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeAddProjectAuditorRequest(mux, decoder)
+		encodeResponse = EncodeAddProjectAuditorResponse(encoder)
+		encodeError    = EncodeAddProjectAuditorError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "add-project-auditor")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRemoveProjectAuditorHandler configures the mux to serve the
+// "project-service" service "remove-project-auditor" endpoint.
+func MountRemoveProjectAuditorHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/projects/{uid}/auditors/{username}", f)
+}
+
+// NewRemoveProjectAuditorHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "remove-project-auditor"
+// endpoint.
+func NewRemoveProjectAuditorHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRemoveProjectAuditorRequest(mux, decoder)
+		encodeResponse = EncodeRemoveProjectAuditorResponse(encoder)
+		encodeError    = EncodeRemoveProjectAuditorError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "remove-project-auditor")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountJoinProjectHandler configures the mux to serve the "project-service"
+// service "join-project" endpoint.
+func MountJoinProjectHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/projects/{uid}/join", f)
+}
+
+// NewJoinProjectHandler creates a HTTP handler which loads the HTTP request
+// and calls the "project-service" service "join-project" endpoint.
+func NewJoinProjectHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeJoinProjectRequest(mux, decoder)
+		encodeResponse = EncodeJoinProjectResponse(encoder)
+		encodeError    = EncodeJoinProjectError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "join-project")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountBulkUpdateProjectMembersHandler configures the mux to serve the
+// "project-service" service "bulk-update-project-members" endpoint.
+func MountBulkUpdateProjectMembersHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/projects/settings/bulk-update", f)
+}
+
+// NewBulkUpdateProjectMembersHandler creates a HTTP handler which loads the
+// HTTP request and calls the "project-service" service
+// "bulk-update-project-members" endpoint.
+func NewBulkUpdateProjectMembersHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeBulkUpdateProjectMembersRequest(mux, decoder)
+		encodeResponse = EncodeBulkUpdateProjectMembersResponse(encoder)
+		encodeError    = EncodeBulkUpdateProjectMembersError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "bulk-update-project-members")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetProjectsHandler configures the mux to serve the "project-service"
+// service "get-projects" endpoint.
+func MountGetProjectsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/projects", f)
+}
+
+// NewGetProjectsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "project-service" service "get-projects" endpoint.
+func NewGetProjectsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetProjectsRequest(mux, decoder)
+		encodeResponse = EncodeGetProjectsResponse(encoder)
+		encodeError    = EncodeGetProjectsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-projects")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCreateProjectHandler configures the mux to serve the "project-service"
+// service "create-project" endpoint.
+func MountCreateProjectHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/projects", f)
+}
+
+// NewCreateProjectHandler creates a HTTP handler which loads the HTTP request
+// and calls the "project-service" service "create-project" endpoint.
+func NewCreateProjectHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateProjectRequest(mux, decoder)
+		encodeResponse = EncodeCreateProjectResponse(encoder)
+		encodeError    = EncodeCreateProjectError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "create-project")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetOneProjectBaseHandler configures the mux to serve the
+// "project-service" service "get-one-project-base" endpoint.
+func MountGetOneProjectBaseHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/projects/{uid}", f)
+}
+
+// NewGetOneProjectBaseHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "get-one-project-base"
+// endpoint.
+func NewGetOneProjectBaseHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetOneProjectBaseRequest(mux, decoder)
+		encodeResponse = EncodeGetOneProjectBaseResponse(encoder)
+		encodeError    = EncodeGetOneProjectBaseError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-one-project-base")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetProjectUIDFromLegacyIDHandler configures the mux to serve the
+// "project-service" service "get-project-uid-from-legacy-id" endpoint.
+func MountGetProjectUIDFromLegacyIDHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/projects/legacy/{legacy_id}", f)
+}
+
+// NewGetProjectUIDFromLegacyIDHandler creates a HTTP handler which loads the
+// HTTP request and calls the "project-service" service
+// "get-project-uid-from-legacy-id" endpoint.
+func NewGetProjectUIDFromLegacyIDHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetProjectUIDFromLegacyIDRequest(mux, decoder)
+		encodeResponse = EncodeGetProjectUIDFromLegacyIDResponse(encoder)
+		encodeError    = EncodeGetProjectUIDFromLegacyIDError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-project-uid-from-legacy-id")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetOneProjectSettingsHandler configures the mux to serve the
+// "project-service" service "get-one-project-settings" endpoint.
+func MountGetOneProjectSettingsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/projects/{uid}/settings", f)
+}
+
+// NewGetOneProjectSettingsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "get-one-project-settings"
+// endpoint.
+func NewGetOneProjectSettingsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetOneProjectSettingsRequest(mux, decoder)
+		encodeResponse = EncodeGetOneProjectSettingsResponse(encoder)
+		encodeError    = EncodeGetOneProjectSettingsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-one-project-settings")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUpdateProjectBaseHandler configures the mux to serve the
+// "project-service" service "update-project-base" endpoint.
+func MountUpdateProjectBaseHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/projects/{uid}", f)
+}
+
+// NewUpdateProjectBaseHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "update-project-base"
+// endpoint.
+func NewUpdateProjectBaseHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateProjectBaseRequest(mux, decoder)
+		encodeResponse = EncodeUpdateProjectBaseResponse(encoder)
+		encodeError    = EncodeUpdateProjectBaseError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "update-project-base")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUpdateProjectSettingsHandler configures the mux to serve the
+// "project-service" service "update-project-settings" endpoint.
+func MountUpdateProjectSettingsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/projects/{uid}/settings", f)
+}
+
+// NewUpdateProjectSettingsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "update-project-settings"
+// endpoint.
+func NewUpdateProjectSettingsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateProjectSettingsRequest(mux, decoder)
+		encodeResponse = EncodeUpdateProjectSettingsResponse(encoder)
+		encodeError    = EncodeUpdateProjectSettingsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "update-project-settings")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDeleteProjectHandler configures the mux to serve the "project-service"
+// service "delete-project" endpoint.
+func MountDeleteProjectHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/projects/{uid}", f)
+}
+
+// NewDeleteProjectHandler creates a HTTP handler which loads the HTTP request
+// and calls the "project-service" service "delete-project" endpoint.
+func NewDeleteProjectHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteProjectRequest(mux, decoder)
+		encodeResponse = EncodeDeleteProjectResponse(encoder)
+		encodeError    = EncodeDeleteProjectError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete-project")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountBulkDeleteProjectsHandler configures the mux to serve the
+// "project-service" service "bulk-delete-projects" endpoint.
+func MountBulkDeleteProjectsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/projects/bulk", f)
+}
+
+// NewBulkDeleteProjectsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "bulk-delete-projects"
+// endpoint.
+func NewBulkDeleteProjectsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeBulkDeleteProjectsRequest(mux, decoder)
+		encodeResponse = EncodeBulkDeleteProjectsResponse(encoder)
+		encodeError    = EncodeBulkDeleteProjectsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "bulk-delete-projects")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountReadyzHandler configures the mux to serve the "project-service" service
+// "readyz" endpoint.
+func MountReadyzHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/readyz", f)
+}
+
+// NewReadyzHandler creates a HTTP handler which loads the HTTP request and
+// calls the "project-service" service "readyz" endpoint.
+func NewReadyzHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		encodeResponse = EncodeReadyzResponse(encoder)
+		encodeError    = EncodeReadyzError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "readyz")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		var err error
+		res, err := endpoint(ctx, nil)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountLivezHandler configures the mux to serve the "project-service" service
+// "livez" endpoint.
+func MountLivezHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/livez", f)
+}
+
+// NewLivezHandler creates a HTTP handler which loads the HTTP request and
+// calls the "project-service" service "livez" endpoint.
+func NewLivezHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		encodeResponse = EncodeLivezResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "livez")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		var err error
+		res, err := endpoint(ctx, nil)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountHealthzHandler configures the mux to serve the "project-service"
+// service "healthz" endpoint.
+func MountHealthzHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/healthz", f)
+}
+
+// NewHealthzHandler creates a HTTP handler which loads the HTTP request and
+// calls the "project-service" service "healthz" endpoint.
+func NewHealthzHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		encodeResponse = EncodeHealthzResponse(encoder)
+		encodeError    = EncodeHealthzError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "healthz")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		var err error
+		res, err := endpoint(ctx, nil)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountReconcileOutboxHandler configures the mux to serve the
+// "project-service" service "reconcile-outbox" endpoint.
+func MountReconcileOutboxHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/admin/outbox/reconcile", f)
+}
+
+// NewReconcileOutboxHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "reconcile-outbox" endpoint.
+func NewReconcileOutboxHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeReconcileOutboxRequest(mux, decoder)
+		encodeResponse = EncodeReconcileOutboxResponse(encoder)
+		encodeError    = EncodeReconcileOutboxError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "reconcile-outbox")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountResyncProjectsHandler configures the mux to serve the "project-service"
+// service "resync-projects" endpoint.
+func MountResyncProjectsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/admin/resync", f)
+}
+
+// NewResyncProjectsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "project-service" service "resync-projects" endpoint.
+func NewResyncProjectsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeResyncProjectsRequest(mux, decoder)
+		encodeResponse = EncodeResyncProjectsResponse(encoder)
+		encodeError    = EncodeResyncProjectsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "resync-projects")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListDeadLettersHandler configures the mux to serve the
+// "project-service" service "list-dead-letters" endpoint.
+func MountListDeadLettersHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/admin/dead-letters", f)
+}
+
+// NewListDeadLettersHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "list-dead-letters" endpoint.
+func NewListDeadLettersHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListDeadLettersRequest(mux, decoder)
+		encodeResponse = EncodeListDeadLettersResponse(encoder)
+		encodeError    = EncodeListDeadLettersError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-dead-letters")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountReplayDeadLetterHandler configures the mux to serve the
+// "project-service" service "replay-dead-letter" endpoint.
+func MountReplayDeadLetterHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/admin/dead-letters/{id}/replay", f)
+}
+
+// NewReplayDeadLetterHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "replay-dead-letter"
+// endpoint.
+func NewReplayDeadLetterHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeReplayDeadLetterRequest(mux, decoder)
+		encodeResponse = EncodeReplayDeadLetterResponse(encoder)
+		encodeError    = EncodeReplayDeadLetterError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "replay-dead-letter")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRebuildSlugMappingsHandler configures the mux to serve the
+// "project-service" service "rebuild-slug-mappings" endpoint.
+func MountRebuildSlugMappingsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/admin/slug-rebuild", f)
+}
+
+// NewRebuildSlugMappingsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "rebuild-slug-mappings"
+// endpoint.
+func NewRebuildSlugMappingsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRebuildSlugMappingsRequest(mux, decoder)
+		encodeResponse = EncodeRebuildSlugMappingsResponse(encoder)
+		encodeError    = EncodeRebuildSlugMappingsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "rebuild-slug-mappings")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetIntegrityReportHandler configures the mux to serve the
+// "project-service" service "get-integrity-report" endpoint.
+func MountGetIntegrityReportHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/admin/integrity-report", f)
+}
+
+// NewGetIntegrityReportHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "get-integrity-report"
+// endpoint.
+func NewGetIntegrityReportHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetIntegrityReportRequest(mux, decoder)
+		encodeResponse = EncodeGetIntegrityReportResponse(encoder)
+		encodeError    = EncodeGetIntegrityReportError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-integrity-report")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountInspectProjectKvHandler configures the mux to serve the
+// "project-service" service "inspect-project-kv" endpoint.
+func MountInspectProjectKvHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/admin/projects/{uid}/kv", f)
+}
+
+// NewInspectProjectKvHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "inspect-project-kv"
+// endpoint.
+func NewInspectProjectKvHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeInspectProjectKvRequest(mux, decoder)
+		encodeResponse = EncodeInspectProjectKvResponse(encoder)
+		encodeError    = EncodeInspectProjectKvError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "inspect-project-kv")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCreateBackupHandler configures the mux to serve the "project-service"
+// service "create-backup" endpoint.
+func MountCreateBackupHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/admin/backups", f)
+}
+
+// NewCreateBackupHandler creates a HTTP handler which loads the HTTP request
+// and calls the "project-service" service "create-backup" endpoint.
+func NewCreateBackupHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateBackupRequest(mux, decoder)
+		encodeResponse = EncodeCreateBackupResponse(encoder)
+		encodeError    = EncodeCreateBackupError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "create-backup")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListBackupsHandler configures the mux to serve the "project-service"
+// service "list-backups" endpoint.
+func MountListBackupsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/admin/backups", f)
+}
+
+// NewListBackupsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "project-service" service "list-backups" endpoint.
+func NewListBackupsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListBackupsRequest(mux, decoder)
+		encodeResponse = EncodeListBackupsResponse(encoder)
+		encodeError    = EncodeListBackupsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-backups")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRestoreBackupHandler configures the mux to serve the "project-service"
+// service "restore-backup" endpoint.
+func MountRestoreBackupHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/admin/backups/{name}/restore", f)
+}
+
+// NewRestoreBackupHandler creates a HTTP handler which loads the HTTP request
+// and calls the "project-service" service "restore-backup" endpoint.
+func NewRestoreBackupHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRestoreBackupRequest(mux, decoder)
+		encodeResponse = EncodeRestoreBackupResponse(encoder)
+		encodeError    = EncodeRestoreBackupError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "restore-backup")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListPendingAnnouncementsHandler configures the mux to serve the
+// "project-service" service "list-pending-announcements" endpoint.
+func MountListPendingAnnouncementsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/admin/announcements", f)
+}
+
+// NewListPendingAnnouncementsHandler creates a HTTP handler which loads the
+// HTTP request and calls the "project-service" service
+// "list-pending-announcements" endpoint.
+func NewListPendingAnnouncementsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListPendingAnnouncementsRequest(mux, decoder)
+		encodeResponse = EncodeListPendingAnnouncementsResponse(encoder)
+		encodeError    = EncodeListPendingAnnouncementsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-pending-announcements")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetProjectEnumsHandler configures the mux to serve the
+// "project-service" service "get-project-enums" endpoint.
+func MountGetProjectEnumsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/metadata/enums", f)
+}
+
+// NewGetProjectEnumsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "get-project-enums" endpoint.
+func NewGetProjectEnumsHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -290,13 +3366,13 @@ func NewCreateProjectHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeCreateProjectRequest(mux, decoder)
-		encodeResponse = EncodeCreateProjectResponse(encoder)
-		encodeError    = EncodeCreateProjectError(encoder, formatter)
+		decodeRequest  = DecodeGetProjectEnumsRequest(mux, decoder)
+		encodeResponse = EncodeGetProjectEnumsResponse(encoder)
+		encodeError    = EncodeGetProjectEnumsError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "create-project")
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-project-enums")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -320,22 +3396,22 @@ func NewCreateProjectHandler(
 	})
 }
 
-// MountGetOneProjectBaseHandler configures the mux to serve the
-// "project-service" service "get-one-project-base" endpoint.
-func MountGetOneProjectBaseHandler(mux goahttp.Muxer, h http.Handler) {
+// MountUpdateProjectEnumsHandler configures the mux to serve the
+// "project-service" service "update-project-enums" endpoint.
+func MountUpdateProjectEnumsHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/projects/{uid}", f)
+	mux.Handle("PUT", "/admin/metadata/enums", f)
 }
 
-// NewGetOneProjectBaseHandler creates a HTTP handler which loads the HTTP
-// request and calls the "project-service" service "get-one-project-base"
+// NewUpdateProjectEnumsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "update-project-enums"
 // endpoint.
-func NewGetOneProjectBaseHandler(
+func NewUpdateProjectEnumsHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -344,13 +3420,13 @@ func NewGetOneProjectBaseHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeGetOneProjectBaseRequest(mux, decoder)
-		encodeResponse = EncodeGetOneProjectBaseResponse(encoder)
-		encodeError    = EncodeGetOneProjectBaseError(encoder, formatter)
+		decodeRequest  = DecodeUpdateProjectEnumsRequest(mux, decoder)
+		encodeResponse = EncodeUpdateProjectEnumsResponse(encoder)
+		encodeError    = EncodeUpdateProjectEnumsError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "get-one-project-base")
+		ctx = context.WithValue(ctx, goa.MethodKey, "update-project-enums")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -374,22 +3450,22 @@ func NewGetOneProjectBaseHandler(
 	})
 }
 
-// MountGetOneProjectSettingsHandler configures the mux to serve the
-// "project-service" service "get-one-project-settings" endpoint.
-func MountGetOneProjectSettingsHandler(mux goahttp.Muxer, h http.Handler) {
+// MountCreateProjectTemplateHandler configures the mux to serve the
+// "project-service" service "create-project-template" endpoint.
+func MountCreateProjectTemplateHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/projects/{uid}/settings", f)
+	mux.Handle("POST", "/admin/project-templates", f)
 }
 
-// NewGetOneProjectSettingsHandler creates a HTTP handler which loads the HTTP
-// request and calls the "project-service" service "get-one-project-settings"
+// NewCreateProjectTemplateHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "create-project-template"
 // endpoint.
-func NewGetOneProjectSettingsHandler(
+func NewCreateProjectTemplateHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -398,13 +3474,13 @@ func NewGetOneProjectSettingsHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeGetOneProjectSettingsRequest(mux, decoder)
-		encodeResponse = EncodeGetOneProjectSettingsResponse(encoder)
-		encodeError    = EncodeGetOneProjectSettingsError(encoder, formatter)
+		decodeRequest  = DecodeCreateProjectTemplateRequest(mux, decoder)
+		encodeResponse = EncodeCreateProjectTemplateResponse(encoder)
+		encodeError    = EncodeCreateProjectTemplateError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "get-one-project-settings")
+		ctx = context.WithValue(ctx, goa.MethodKey, "create-project-template")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -428,22 +3504,22 @@ func NewGetOneProjectSettingsHandler(
 	})
 }
 
-// MountUpdateProjectBaseHandler configures the mux to serve the
-// "project-service" service "update-project-base" endpoint.
-func MountUpdateProjectBaseHandler(mux goahttp.Muxer, h http.Handler) {
+// MountListProjectTemplatesHandler configures the mux to serve the
+// "project-service" service "list-project-templates" endpoint.
+func MountListProjectTemplatesHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("PUT", "/projects/{uid}", f)
+	mux.Handle("GET", "/admin/project-templates", f)
 }
 
-// NewUpdateProjectBaseHandler creates a HTTP handler which loads the HTTP
-// request and calls the "project-service" service "update-project-base"
+// NewListProjectTemplatesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "list-project-templates"
 // endpoint.
-func NewUpdateProjectBaseHandler(
+func NewListProjectTemplatesHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -452,13 +3528,13 @@ func NewUpdateProjectBaseHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeUpdateProjectBaseRequest(mux, decoder)
-		encodeResponse = EncodeUpdateProjectBaseResponse(encoder)
-		encodeError    = EncodeUpdateProjectBaseError(encoder, formatter)
+		decodeRequest  = DecodeListProjectTemplatesRequest(mux, decoder)
+		encodeResponse = EncodeListProjectTemplatesResponse(encoder)
+		encodeError    = EncodeListProjectTemplatesError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "update-project-base")
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-project-templates")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -482,22 +3558,22 @@ func NewUpdateProjectBaseHandler(
 	})
 }
 
-// MountUpdateProjectSettingsHandler configures the mux to serve the
-// "project-service" service "update-project-settings" endpoint.
-func MountUpdateProjectSettingsHandler(mux goahttp.Muxer, h http.Handler) {
+// MountGetProjectTemplateHandler configures the mux to serve the
+// "project-service" service "get-project-template" endpoint.
+func MountGetProjectTemplateHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("PUT", "/projects/{uid}/settings", f)
+	mux.Handle("GET", "/admin/project-templates/{name}", f)
 }
 
-// NewUpdateProjectSettingsHandler creates a HTTP handler which loads the HTTP
-// request and calls the "project-service" service "update-project-settings"
+// NewGetProjectTemplateHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "get-project-template"
 // endpoint.
-func NewUpdateProjectSettingsHandler(
+func NewGetProjectTemplateHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -506,13 +3582,13 @@ func NewUpdateProjectSettingsHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeUpdateProjectSettingsRequest(mux, decoder)
-		encodeResponse = EncodeUpdateProjectSettingsResponse(encoder)
-		encodeError    = EncodeUpdateProjectSettingsError(encoder, formatter)
+		decodeRequest  = DecodeGetProjectTemplateRequest(mux, decoder)
+		encodeResponse = EncodeGetProjectTemplateResponse(encoder)
+		encodeError    = EncodeGetProjectTemplateError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "update-project-settings")
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-project-template")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -536,21 +3612,22 @@ func NewUpdateProjectSettingsHandler(
 	})
 }
 
-// MountDeleteProjectHandler configures the mux to serve the "project-service"
-// service "delete-project" endpoint.
-func MountDeleteProjectHandler(mux goahttp.Muxer, h http.Handler) {
+// MountUpdateProjectTemplateHandler configures the mux to serve the
+// "project-service" service "update-project-template" endpoint.
+func MountUpdateProjectTemplateHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("DELETE", "/projects/{uid}", f)
+	mux.Handle("PUT", "/admin/project-templates/{name}", f)
 }
 
-// NewDeleteProjectHandler creates a HTTP handler which loads the HTTP request
-// and calls the "project-service" service "delete-project" endpoint.
-func NewDeleteProjectHandler(
+// NewUpdateProjectTemplateHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "update-project-template"
+// endpoint.
+func NewUpdateProjectTemplateHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -559,13 +3636,13 @@ func NewDeleteProjectHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeDeleteProjectRequest(mux, decoder)
-		encodeResponse = EncodeDeleteProjectResponse(encoder)
-		encodeError    = EncodeDeleteProjectError(encoder, formatter)
+		decodeRequest  = DecodeUpdateProjectTemplateRequest(mux, decoder)
+		encodeResponse = EncodeUpdateProjectTemplateResponse(encoder)
+		encodeError    = EncodeUpdateProjectTemplateError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "delete-project")
+		ctx = context.WithValue(ctx, goa.MethodKey, "update-project-template")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -589,21 +3666,22 @@ func NewDeleteProjectHandler(
 	})
 }
 
-// MountReadyzHandler configures the mux to serve the "project-service" service
-// "readyz" endpoint.
-func MountReadyzHandler(mux goahttp.Muxer, h http.Handler) {
+// MountDeleteProjectTemplateHandler configures the mux to serve the
+// "project-service" service "delete-project-template" endpoint.
+func MountDeleteProjectTemplateHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/readyz", f)
+	mux.Handle("DELETE", "/admin/project-templates/{name}", f)
 }
 
-// NewReadyzHandler creates a HTTP handler which loads the HTTP request and
-// calls the "project-service" service "readyz" endpoint.
-func NewReadyzHandler(
+// NewDeleteProjectTemplateHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "delete-project-template"
+// endpoint.
+func NewDeleteProjectTemplateHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -612,15 +3690,22 @@ func NewReadyzHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		encodeResponse = EncodeReadyzResponse(encoder)
-		encodeError    = EncodeReadyzError(encoder, formatter)
+		decodeRequest  = DecodeDeleteProjectTemplateRequest(mux, decoder)
+		encodeResponse = EncodeDeleteProjectTemplateResponse(encoder)
+		encodeError    = EncodeDeleteProjectTemplateError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "readyz")
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete-project-template")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
-		var err error
-		res, err := endpoint(ctx, nil)
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
 		if err != nil {
 			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
 				errhandler(ctx, w, err)
@@ -635,21 +3720,22 @@ func NewReadyzHandler(
 	})
 }
 
-// MountLivezHandler configures the mux to serve the "project-service" service
-// "livez" endpoint.
-func MountLivezHandler(mux goahttp.Muxer, h http.Handler) {
+// MountListProjectDeletionsHandler configures the mux to serve the
+// "project-service" service "list-project-deletions" endpoint.
+func MountListProjectDeletionsHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/livez", f)
+	mux.Handle("GET", "/projects/deletions", f)
 }
 
-// NewLivezHandler creates a HTTP handler which loads the HTTP request and
-// calls the "project-service" service "livez" endpoint.
-func NewLivezHandler(
+// NewListProjectDeletionsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "list-project-deletions"
+// endpoint.
+func NewListProjectDeletionsHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -658,15 +3744,22 @@ func NewLivezHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		encodeResponse = EncodeLivezResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		decodeRequest  = DecodeListProjectDeletionsRequest(mux, decoder)
+		encodeResponse = EncodeListProjectDeletionsResponse(encoder)
+		encodeError    = EncodeListProjectDeletionsError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "livez")
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-project-deletions")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
-		var err error
-		res, err := endpoint(ctx, nil)
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
 		if err != nil {
 			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
 				errhandler(ctx, w, err)
@@ -681,22 +3774,22 @@ func NewLivezHandler(
 	})
 }
 
-// MountCreateProjectLinkHandler configures the mux to serve the
-// "project-service" service "create-project-link" endpoint.
-func MountCreateProjectLinkHandler(mux goahttp.Muxer, h http.Handler) {
+// MountListProjectEventsHandler configures the mux to serve the
+// "project-service" service "list-project-events" endpoint.
+func MountListProjectEventsHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("POST", "/projects/{uid}/links", f)
+	mux.Handle("GET", "/projects/{uid}/events", f)
 }
 
-// NewCreateProjectLinkHandler creates a HTTP handler which loads the HTTP
-// request and calls the "project-service" service "create-project-link"
+// NewListProjectEventsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "list-project-events"
 // endpoint.
-func NewCreateProjectLinkHandler(
+func NewListProjectEventsHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -705,13 +3798,13 @@ func NewCreateProjectLinkHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeCreateProjectLinkRequest(mux, decoder)
-		encodeResponse = EncodeCreateProjectLinkResponse(encoder)
-		encodeError    = EncodeCreateProjectLinkError(encoder, formatter)
+		decodeRequest  = DecodeListProjectEventsRequest(mux, decoder)
+		encodeResponse = EncodeListProjectEventsResponse(encoder)
+		encodeError    = EncodeListProjectEventsError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "create-project-link")
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-project-events")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -735,21 +3828,21 @@ func NewCreateProjectLinkHandler(
 	})
 }
 
-// MountGetProjectLinkHandler configures the mux to serve the "project-service"
-// service "get-project-link" endpoint.
-func MountGetProjectLinkHandler(mux goahttp.Muxer, h http.Handler) {
+// MountGetUserProjectsHandler configures the mux to serve the
+// "project-service" service "get-user-projects" endpoint.
+func MountGetUserProjectsHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/projects/{uid}/links/{link_uid}", f)
+	mux.Handle("GET", "/users/{username}/projects", f)
 }
 
-// NewGetProjectLinkHandler creates a HTTP handler which loads the HTTP request
-// and calls the "project-service" service "get-project-link" endpoint.
-func NewGetProjectLinkHandler(
+// NewGetUserProjectsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "get-user-projects" endpoint.
+func NewGetUserProjectsHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -758,13 +3851,13 @@ func NewGetProjectLinkHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeGetProjectLinkRequest(mux, decoder)
-		encodeResponse = EncodeGetProjectLinkResponse(encoder)
-		encodeError    = EncodeGetProjectLinkError(encoder, formatter)
+		decodeRequest  = DecodeGetUserProjectsRequest(mux, decoder)
+		encodeResponse = EncodeGetUserProjectsResponse(encoder)
+		encodeError    = EncodeGetUserProjectsError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "get-project-link")
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-user-projects")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -788,22 +3881,21 @@ func NewGetProjectLinkHandler(
 	})
 }
 
-// MountDeleteProjectLinkHandler configures the mux to serve the
-// "project-service" service "delete-project-link" endpoint.
-func MountDeleteProjectLinkHandler(mux goahttp.Muxer, h http.Handler) {
+// MountExportUserDataHandler configures the mux to serve the "project-service"
+// service "export-user-data" endpoint.
+func MountExportUserDataHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("DELETE", "/projects/{uid}/links/{link_uid}", f)
+	mux.Handle("GET", "/users/{username}/data-export", f)
 }
 
-// NewDeleteProjectLinkHandler creates a HTTP handler which loads the HTTP
-// request and calls the "project-service" service "delete-project-link"
-// endpoint.
-func NewDeleteProjectLinkHandler(
+// NewExportUserDataHandler creates a HTTP handler which loads the HTTP request
+// and calls the "project-service" service "export-user-data" endpoint.
+func NewExportUserDataHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -812,13 +3904,13 @@ func NewDeleteProjectLinkHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeDeleteProjectLinkRequest(mux, decoder)
-		encodeResponse = EncodeDeleteProjectLinkResponse(encoder)
-		encodeError    = EncodeDeleteProjectLinkError(encoder, formatter)
+		decodeRequest  = DecodeExportUserDataRequest(mux, decoder)
+		encodeResponse = EncodeExportUserDataResponse(encoder)
+		encodeError    = EncodeExportUserDataError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "delete-project-link")
+		ctx = context.WithValue(ctx, goa.MethodKey, "export-user-data")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -842,22 +3934,22 @@ func NewDeleteProjectLinkHandler(
 	})
 }
 
-// MountCreateProjectFolderHandler configures the mux to serve the
-// "project-service" service "create-project-folder" endpoint.
-func MountCreateProjectFolderHandler(mux goahttp.Muxer, h http.Handler) {
+// MountRemoveUserAccessHandler configures the mux to serve the
+// "project-service" service "remove-user-access" endpoint.
+func MountRemoveUserAccessHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("POST", "/projects/{uid}/folders", f)
+	mux.Handle("DELETE", "/users/{username}/access", f)
 }
 
-// NewCreateProjectFolderHandler creates a HTTP handler which loads the HTTP
-// request and calls the "project-service" service "create-project-folder"
+// NewRemoveUserAccessHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "remove-user-access"
 // endpoint.
-func NewCreateProjectFolderHandler(
+func NewRemoveUserAccessHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -866,13 +3958,13 @@ func NewCreateProjectFolderHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeCreateProjectFolderRequest(mux, decoder)
-		encodeResponse = EncodeCreateProjectFolderResponse(encoder)
-		encodeError    = EncodeCreateProjectFolderError(encoder, formatter)
+		decodeRequest  = DecodeRemoveUserAccessRequest(mux, decoder)
+		encodeResponse = EncodeRemoveUserAccessResponse(encoder)
+		encodeError    = EncodeRemoveUserAccessError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "create-project-folder")
+		ctx = context.WithValue(ctx, goa.MethodKey, "remove-user-access")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -896,22 +3988,22 @@ func NewCreateProjectFolderHandler(
 	})
 }
 
-// MountGetProjectFolderHandler configures the mux to serve the
-// "project-service" service "get-project-folder" endpoint.
-func MountGetProjectFolderHandler(mux goahttp.Muxer, h http.Handler) {
+// MountGetProjectVisibilityImpactHandler configures the mux to serve the
+// "project-service" service "get-project-visibility-impact" endpoint.
+func MountGetProjectVisibilityImpactHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/projects/{uid}/folders/{folder_uid}", f)
+	mux.Handle("GET", "/projects/{uid}/visibility-impact", f)
 }
 
-// NewGetProjectFolderHandler creates a HTTP handler which loads the HTTP
-// request and calls the "project-service" service "get-project-folder"
-// endpoint.
-func NewGetProjectFolderHandler(
+// NewGetProjectVisibilityImpactHandler creates a HTTP handler which loads the
+// HTTP request and calls the "project-service" service
+// "get-project-visibility-impact" endpoint.
+func NewGetProjectVisibilityImpactHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -920,13 +4012,13 @@ func NewGetProjectFolderHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeGetProjectFolderRequest(mux, decoder)
-		encodeResponse = EncodeGetProjectFolderResponse(encoder)
-		encodeError    = EncodeGetProjectFolderError(encoder, formatter)
+		decodeRequest  = DecodeGetProjectVisibilityImpactRequest(mux, decoder)
+		encodeResponse = EncodeGetProjectVisibilityImpactResponse(encoder)
+		encodeError    = EncodeGetProjectVisibilityImpactError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "get-project-folder")
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-project-visibility-impact")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -950,22 +4042,21 @@ func NewGetProjectFolderHandler(
 	})
 }
 
-// MountDeleteProjectFolderHandler configures the mux to serve the
-// "project-service" service "delete-project-folder" endpoint.
-func MountDeleteProjectFolderHandler(mux goahttp.Muxer, h http.Handler) {
+// MountCreateWebhookHandler configures the mux to serve the "project-service"
+// service "create-webhook" endpoint.
+func MountCreateWebhookHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("DELETE", "/projects/{uid}/folders/{folder_uid}", f)
+	mux.Handle("POST", "/admin/webhooks", f)
 }
 
-// NewDeleteProjectFolderHandler creates a HTTP handler which loads the HTTP
-// request and calls the "project-service" service "delete-project-folder"
-// endpoint.
-func NewDeleteProjectFolderHandler(
+// NewCreateWebhookHandler creates a HTTP handler which loads the HTTP request
+// and calls the "project-service" service "create-webhook" endpoint.
+func NewCreateWebhookHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -974,13 +4065,13 @@ func NewDeleteProjectFolderHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeDeleteProjectFolderRequest(mux, decoder)
-		encodeResponse = EncodeDeleteProjectFolderResponse(encoder)
-		encodeError    = EncodeDeleteProjectFolderError(encoder, formatter)
+		decodeRequest  = DecodeCreateWebhookRequest(mux, decoder)
+		encodeResponse = EncodeCreateWebhookResponse(encoder)
+		encodeError    = EncodeCreateWebhookError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "delete-project-folder")
+		ctx = context.WithValue(ctx, goa.MethodKey, "create-webhook")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1004,22 +4095,21 @@ func NewDeleteProjectFolderHandler(
 	})
 }
 
-// MountUploadProjectDocumentHandler configures the mux to serve the
-// "project-service" service "upload-project-document" endpoint.
-func MountUploadProjectDocumentHandler(mux goahttp.Muxer, h http.Handler) {
+// MountListWebhooksHandler configures the mux to serve the "project-service"
+// service "list-webhooks" endpoint.
+func MountListWebhooksHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("POST", "/projects/{uid}/documents", f)
+	mux.Handle("GET", "/admin/webhooks", f)
 }
 
-// NewUploadProjectDocumentHandler creates a HTTP handler which loads the HTTP
-// request and calls the "project-service" service "upload-project-document"
-// endpoint.
-func NewUploadProjectDocumentHandler(
+// NewListWebhooksHandler creates a HTTP handler which loads the HTTP request
+// and calls the "project-service" service "list-webhooks" endpoint.
+func NewListWebhooksHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1028,13 +4118,13 @@ func NewUploadProjectDocumentHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeUploadProjectDocumentRequest(mux, decoder)
-		encodeResponse = EncodeUploadProjectDocumentResponse(encoder)
-		encodeError    = EncodeUploadProjectDocumentError(encoder, formatter)
+		decodeRequest  = DecodeListWebhooksRequest(mux, decoder)
+		encodeResponse = EncodeListWebhooksResponse(encoder)
+		encodeError    = EncodeListWebhooksError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "upload-project-document")
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-webhooks")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1058,22 +4148,21 @@ func NewUploadProjectDocumentHandler(
 	})
 }
 
-// MountGetProjectDocumentHandler configures the mux to serve the
-// "project-service" service "get-project-document" endpoint.
-func MountGetProjectDocumentHandler(mux goahttp.Muxer, h http.Handler) {
+// MountGetWebhookHandler configures the mux to serve the "project-service"
+// service "get-webhook" endpoint.
+func MountGetWebhookHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/projects/{uid}/documents/{document_uid}", f)
+	mux.Handle("GET", "/admin/webhooks/{id}", f)
 }
 
-// NewGetProjectDocumentHandler creates a HTTP handler which loads the HTTP
-// request and calls the "project-service" service "get-project-document"
-// endpoint.
-func NewGetProjectDocumentHandler(
+// NewGetWebhookHandler creates a HTTP handler which loads the HTTP request and
+// calls the "project-service" service "get-webhook" endpoint.
+func NewGetWebhookHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1082,13 +4171,13 @@ func NewGetProjectDocumentHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeGetProjectDocumentRequest(mux, decoder)
-		encodeResponse = EncodeGetProjectDocumentResponse(encoder)
-		encodeError    = EncodeGetProjectDocumentError(encoder, formatter)
+		decodeRequest  = DecodeGetWebhookRequest(mux, decoder)
+		encodeResponse = EncodeGetWebhookResponse(encoder)
+		encodeError    = EncodeGetWebhookError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "get-project-document")
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-webhook")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1112,22 +4201,21 @@ func NewGetProjectDocumentHandler(
 	})
 }
 
-// MountDownloadProjectDocumentHandler configures the mux to serve the
-// "project-service" service "download-project-document" endpoint.
-func MountDownloadProjectDocumentHandler(mux goahttp.Muxer, h http.Handler) {
+// MountDeleteWebhookHandler configures the mux to serve the "project-service"
+// service "delete-webhook" endpoint.
+func MountDeleteWebhookHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/projects/{uid}/documents/{document_uid}/download", f)
+	mux.Handle("DELETE", "/admin/webhooks/{id}", f)
 }
 
-// NewDownloadProjectDocumentHandler creates a HTTP handler which loads the
-// HTTP request and calls the "project-service" service
-// "download-project-document" endpoint.
-func NewDownloadProjectDocumentHandler(
+// NewDeleteWebhookHandler creates a HTTP handler which loads the HTTP request
+// and calls the "project-service" service "delete-webhook" endpoint.
+func NewDeleteWebhookHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1136,13 +4224,13 @@ func NewDownloadProjectDocumentHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeDownloadProjectDocumentRequest(mux, decoder)
-		encodeResponse = EncodeDownloadProjectDocumentResponse(encoder)
-		encodeError    = EncodeDownloadProjectDocumentError(encoder, formatter)
+		decodeRequest  = DecodeDeleteWebhookRequest(mux, decoder)
+		encodeResponse = EncodeDeleteWebhookResponse(encoder)
+		encodeError    = EncodeDeleteWebhookError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "download-project-document")
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete-webhook")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -1158,65 +4246,30 @@ func NewDownloadProjectDocumentHandler(
 			}
 			return
 		}
-		o := res.(*projectservice.DownloadProjectDocumentResponseData)
-		defer o.Body.Close()
-		if wt, ok := o.Body.(io.WriterTo); ok {
-			if err := encodeResponse(ctx, w, res); err != nil {
-				if errhandler != nil {
-					errhandler(ctx, w, err)
-				}
-				return
-			}
-			n, err := wt.WriteTo(w)
-			if err != nil {
-				if n == 0 {
-					if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
-						errhandler(ctx, w, err)
-					}
-				} else {
-					http.NewResponseController(w).Flush()
-					panic(http.ErrAbortHandler) // too late to write an error
-				}
-			}
-			return
-		}
-		// handle immediate read error like a returned error
-		buf := bufio.NewReader(o.Body)
-		if _, err := buf.Peek(1); err != nil && err != io.EOF {
-			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
-				errhandler(ctx, w, err)
-			}
-			return
-		}
 		if err := encodeResponse(ctx, w, res); err != nil {
 			if errhandler != nil {
 				errhandler(ctx, w, err)
 			}
-			return
-		}
-		if _, err := io.Copy(w, buf); err != nil {
-			http.NewResponseController(w).Flush()
-			panic(http.ErrAbortHandler) // too late to write an error
 		}
 	})
 }
 
-// MountDeleteProjectDocumentHandler configures the mux to serve the
-// "project-service" service "delete-project-document" endpoint.
-func MountDeleteProjectDocumentHandler(mux goahttp.Muxer, h http.Handler) {
+// MountListWebhookDeliveriesHandler configures the mux to serve the
+// "project-service" service "list-webhook-deliveries" endpoint.
+func MountListWebhookDeliveriesHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("DELETE", "/projects/{uid}/documents/{document_uid}", f)
+	mux.Handle("GET", "/admin/webhooks/{id}/deliveries", f)
 }
 
-// NewDeleteProjectDocumentHandler creates a HTTP handler which loads the HTTP
-// request and calls the "project-service" service "delete-project-document"
+// NewListWebhookDeliveriesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "project-service" service "list-webhook-deliveries"
 // endpoint.
-func NewDeleteProjectDocumentHandler(
+func NewListWebhookDeliveriesHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -1225,13 +4278,13 @@ func NewDeleteProjectDocumentHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeDeleteProjectDocumentRequest(mux, decoder)
-		encodeResponse = EncodeDeleteProjectDocumentResponse(encoder)
-		encodeError    = EncodeDeleteProjectDocumentError(encoder, formatter)
+		decodeRequest  = DecodeListWebhookDeliveriesRequest(mux, decoder)
+		encodeResponse = EncodeListWebhookDeliveriesResponse(encoder)
+		encodeError    = EncodeListWebhookDeliveriesError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "delete-project-document")
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-webhook-deliveries")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "project-service")
 		payload, err := decodeRequest(r)
 		if err != nil {
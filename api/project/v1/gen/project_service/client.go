@@ -18,188 +18,232 @@ import (
 
 // Client is the "project-service" service client.
 type Client struct {
-	GetProjectsEndpoint             goa.Endpoint
-	CreateProjectEndpoint           goa.Endpoint
-	GetOneProjectBaseEndpoint       goa.Endpoint
-	GetOneProjectSettingsEndpoint   goa.Endpoint
-	UpdateProjectBaseEndpoint       goa.Endpoint
-	UpdateProjectSettingsEndpoint   goa.Endpoint
-	DeleteProjectEndpoint           goa.Endpoint
-	ReadyzEndpoint                  goa.Endpoint
-	LivezEndpoint                   goa.Endpoint
-	CreateProjectLinkEndpoint       goa.Endpoint
-	GetProjectLinkEndpoint          goa.Endpoint
-	DeleteProjectLinkEndpoint       goa.Endpoint
-	CreateProjectFolderEndpoint     goa.Endpoint
-	GetProjectFolderEndpoint        goa.Endpoint
-	DeleteProjectFolderEndpoint     goa.Endpoint
-	UploadProjectDocumentEndpoint   goa.Endpoint
-	GetProjectDocumentEndpoint      goa.Endpoint
-	DownloadProjectDocumentEndpoint goa.Endpoint
-	DeleteProjectDocumentEndpoint   goa.Endpoint
+	CloneProjectEndpoint               goa.Endpoint
+	UploadProjectLogoEndpoint          goa.Endpoint
+	MergeProjectEndpoint               goa.Endpoint
+	TransferProjectEndpoint            goa.Endpoint
+	CreateProjectLinkEndpoint          goa.Endpoint
+	GetProjectLinkEndpoint             goa.Endpoint
+	DeleteProjectLinkEndpoint          goa.Endpoint
+	CreateProjectFolderEndpoint        goa.Endpoint
+	GetProjectFolderEndpoint           goa.Endpoint
+	DeleteProjectFolderEndpoint        goa.Endpoint
+	UploadProjectDocumentEndpoint      goa.Endpoint
+	GetProjectDocumentEndpoint         goa.Endpoint
+	DownloadProjectDocumentEndpoint    goa.Endpoint
+	DeleteProjectDocumentEndpoint      goa.Endpoint
+	UploadProjectCharterEndpoint       goa.Endpoint
+	GetProjectCharterEndpoint          goa.Endpoint
+	GetProjectAssociationsEndpoint     goa.Endpoint
+	UpdateProjectAssociationsEndpoint  goa.Endpoint
+	GetProjectSettingsDiffEndpoint     goa.Endpoint
+	GetProjectChildrenEndpoint         goa.Endpoint
+	GetProjectDescendantsEndpoint      goa.Endpoint
+	GetProjectLegalLineageEndpoint     goa.Endpoint
+	SearchProjectsEndpoint             goa.Endpoint
+	AutocompleteProjectsEndpoint       goa.Endpoint
+	AddProjectWriterEndpoint           goa.Endpoint
+	RemoveProjectWriterEndpoint        goa.Endpoint
+	AddProjectAuditorEndpoint          goa.Endpoint
+	RemoveProjectAuditorEndpoint       goa.Endpoint
+	JoinProjectEndpoint                goa.Endpoint
+	BulkUpdateProjectMembersEndpoint   goa.Endpoint
+	GetProjectsEndpoint                goa.Endpoint
+	CreateProjectEndpoint              goa.Endpoint
+	GetOneProjectBaseEndpoint          goa.Endpoint
+	GetProjectUIDFromLegacyIDEndpoint  goa.Endpoint
+	GetOneProjectSettingsEndpoint      goa.Endpoint
+	UpdateProjectBaseEndpoint          goa.Endpoint
+	UpdateProjectSettingsEndpoint      goa.Endpoint
+	DeleteProjectEndpoint              goa.Endpoint
+	BulkDeleteProjectsEndpoint         goa.Endpoint
+	ReadyzEndpoint                     goa.Endpoint
+	LivezEndpoint                      goa.Endpoint
+	HealthzEndpoint                    goa.Endpoint
+	ReconcileOutboxEndpoint            goa.Endpoint
+	ResyncProjectsEndpoint             goa.Endpoint
+	ListDeadLettersEndpoint            goa.Endpoint
+	ReplayDeadLetterEndpoint           goa.Endpoint
+	RebuildSlugMappingsEndpoint        goa.Endpoint
+	GetIntegrityReportEndpoint         goa.Endpoint
+	InspectProjectKvEndpoint           goa.Endpoint
+	CreateBackupEndpoint               goa.Endpoint
+	ListBackupsEndpoint                goa.Endpoint
+	RestoreBackupEndpoint              goa.Endpoint
+	ListPendingAnnouncementsEndpoint   goa.Endpoint
+	GetProjectEnumsEndpoint            goa.Endpoint
+	UpdateProjectEnumsEndpoint         goa.Endpoint
+	CreateProjectTemplateEndpoint      goa.Endpoint
+	ListProjectTemplatesEndpoint       goa.Endpoint
+	GetProjectTemplateEndpoint         goa.Endpoint
+	UpdateProjectTemplateEndpoint      goa.Endpoint
+	DeleteProjectTemplateEndpoint      goa.Endpoint
+	ListProjectDeletionsEndpoint       goa.Endpoint
+	ListProjectEventsEndpoint          goa.Endpoint
+	GetUserProjectsEndpoint            goa.Endpoint
+	ExportUserDataEndpoint             goa.Endpoint
+	RemoveUserAccessEndpoint           goa.Endpoint
+	GetProjectVisibilityImpactEndpoint goa.Endpoint
+	CreateWebhookEndpoint              goa.Endpoint
+	ListWebhooksEndpoint               goa.Endpoint
+	GetWebhookEndpoint                 goa.Endpoint
+	DeleteWebhookEndpoint              goa.Endpoint
+	ListWebhookDeliveriesEndpoint      goa.Endpoint
 }
 
 // NewClient initializes a "project-service" service client given the endpoints.
-func NewClient(getProjects, createProject, getOneProjectBase, getOneProjectSettings, updateProjectBase, updateProjectSettings, deleteProject, readyz, livez, createProjectLink, getProjectLink, deleteProjectLink, createProjectFolder, getProjectFolder, deleteProjectFolder, uploadProjectDocument, getProjectDocument, downloadProjectDocument, deleteProjectDocument goa.Endpoint) *Client {
+func NewClient(cloneProject, uploadProjectLogo, mergeProject, transferProject, createProjectLink, getProjectLink, deleteProjectLink, createProjectFolder, getProjectFolder, deleteProjectFolder, uploadProjectDocument, getProjectDocument, downloadProjectDocument, deleteProjectDocument, uploadProjectCharter, getProjectCharter, getProjectAssociations, updateProjectAssociations, getProjectSettingsDiff, getProjectChildren, getProjectDescendants, getProjectLegalLineage, searchProjects, autocompleteProjects, addProjectWriter, removeProjectWriter, addProjectAuditor, removeProjectAuditor, joinProject, bulkUpdateProjectMembers, getProjects, createProject, getOneProjectBase, getProjectUIDFromLegacyID, getOneProjectSettings, updateProjectBase, updateProjectSettings, deleteProject, bulkDeleteProjects, readyz, livez, healthz, reconcileOutbox, resyncProjects, listDeadLetters, replayDeadLetter, rebuildSlugMappings, getIntegrityReport, inspectProjectKv, createBackup, listBackups, restoreBackup, listPendingAnnouncements, getProjectEnums, updateProjectEnums, createProjectTemplate, listProjectTemplates, getProjectTemplate, updateProjectTemplate, deleteProjectTemplate, listProjectDeletions, listProjectEvents, getUserProjects, exportUserData, removeUserAccess, getProjectVisibilityImpact, createWebhook, listWebhooks, getWebhook, deleteWebhook, listWebhookDeliveries goa.Endpoint) *Client {
 	return &Client{
-		GetProjectsEndpoint:             getProjects,
-		CreateProjectEndpoint:           createProject,
-		GetOneProjectBaseEndpoint:       getOneProjectBase,
-		GetOneProjectSettingsEndpoint:   getOneProjectSettings,
-		UpdateProjectBaseEndpoint:       updateProjectBase,
-		UpdateProjectSettingsEndpoint:   updateProjectSettings,
-		DeleteProjectEndpoint:           deleteProject,
-		ReadyzEndpoint:                  readyz,
-		LivezEndpoint:                   livez,
-		CreateProjectLinkEndpoint:       createProjectLink,
-		GetProjectLinkEndpoint:          getProjectLink,
-		DeleteProjectLinkEndpoint:       deleteProjectLink,
-		CreateProjectFolderEndpoint:     createProjectFolder,
-		GetProjectFolderEndpoint:        getProjectFolder,
-		DeleteProjectFolderEndpoint:     deleteProjectFolder,
-		UploadProjectDocumentEndpoint:   uploadProjectDocument,
-		GetProjectDocumentEndpoint:      getProjectDocument,
-		DownloadProjectDocumentEndpoint: downloadProjectDocument,
-		DeleteProjectDocumentEndpoint:   deleteProjectDocument,
+		CloneProjectEndpoint:               cloneProject,
+		UploadProjectLogoEndpoint:          uploadProjectLogo,
+		MergeProjectEndpoint:               mergeProject,
+		TransferProjectEndpoint:            transferProject,
+		CreateProjectLinkEndpoint:          createProjectLink,
+		GetProjectLinkEndpoint:             getProjectLink,
+		DeleteProjectLinkEndpoint:          deleteProjectLink,
+		CreateProjectFolderEndpoint:        createProjectFolder,
+		GetProjectFolderEndpoint:           getProjectFolder,
+		DeleteProjectFolderEndpoint:        deleteProjectFolder,
+		UploadProjectDocumentEndpoint:      uploadProjectDocument,
+		GetProjectDocumentEndpoint:         getProjectDocument,
+		DownloadProjectDocumentEndpoint:    downloadProjectDocument,
+		DeleteProjectDocumentEndpoint:      deleteProjectDocument,
+		UploadProjectCharterEndpoint:       uploadProjectCharter,
+		GetProjectCharterEndpoint:          getProjectCharter,
+		GetProjectAssociationsEndpoint:     getProjectAssociations,
+		UpdateProjectAssociationsEndpoint:  updateProjectAssociations,
+		GetProjectSettingsDiffEndpoint:     getProjectSettingsDiff,
+		GetProjectChildrenEndpoint:         getProjectChildren,
+		GetProjectDescendantsEndpoint:      getProjectDescendants,
+		GetProjectLegalLineageEndpoint:     getProjectLegalLineage,
+		SearchProjectsEndpoint:             searchProjects,
+		AutocompleteProjectsEndpoint:       autocompleteProjects,
+		AddProjectWriterEndpoint:           addProjectWriter,
+		RemoveProjectWriterEndpoint:        removeProjectWriter,
+		AddProjectAuditorEndpoint:          addProjectAuditor,
+		RemoveProjectAuditorEndpoint:       removeProjectAuditor,
+		JoinProjectEndpoint:                joinProject,
+		BulkUpdateProjectMembersEndpoint:   bulkUpdateProjectMembers,
+		GetProjectsEndpoint:                getProjects,
+		CreateProjectEndpoint:              createProject,
+		GetOneProjectBaseEndpoint:          getOneProjectBase,
+		GetProjectUIDFromLegacyIDEndpoint:  getProjectUIDFromLegacyID,
+		GetOneProjectSettingsEndpoint:      getOneProjectSettings,
+		UpdateProjectBaseEndpoint:          updateProjectBase,
+		UpdateProjectSettingsEndpoint:      updateProjectSettings,
+		DeleteProjectEndpoint:              deleteProject,
+		BulkDeleteProjectsEndpoint:         bulkDeleteProjects,
+		ReadyzEndpoint:                     readyz,
+		LivezEndpoint:                      livez,
+		HealthzEndpoint:                    healthz,
+		ReconcileOutboxEndpoint:            reconcileOutbox,
+		ResyncProjectsEndpoint:             resyncProjects,
+		ListDeadLettersEndpoint:            listDeadLetters,
+		ReplayDeadLetterEndpoint:           replayDeadLetter,
+		RebuildSlugMappingsEndpoint:        rebuildSlugMappings,
+		GetIntegrityReportEndpoint:         getIntegrityReport,
+		InspectProjectKvEndpoint:           inspectProjectKv,
+		CreateBackupEndpoint:               createBackup,
+		ListBackupsEndpoint:                listBackups,
+		RestoreBackupEndpoint:              restoreBackup,
+		ListPendingAnnouncementsEndpoint:   listPendingAnnouncements,
+		GetProjectEnumsEndpoint:            getProjectEnums,
+		UpdateProjectEnumsEndpoint:         updateProjectEnums,
+		CreateProjectTemplateEndpoint:      createProjectTemplate,
+		ListProjectTemplatesEndpoint:       listProjectTemplates,
+		GetProjectTemplateEndpoint:         getProjectTemplate,
+		UpdateProjectTemplateEndpoint:      updateProjectTemplate,
+		DeleteProjectTemplateEndpoint:      deleteProjectTemplate,
+		ListProjectDeletionsEndpoint:       listProjectDeletions,
+		ListProjectEventsEndpoint:          listProjectEvents,
+		GetUserProjectsEndpoint:            getUserProjects,
+		ExportUserDataEndpoint:             exportUserData,
+		RemoveUserAccessEndpoint:           removeUserAccess,
+		GetProjectVisibilityImpactEndpoint: getProjectVisibilityImpact,
+		CreateWebhookEndpoint:              createWebhook,
+		ListWebhooksEndpoint:               listWebhooks,
+		GetWebhookEndpoint:                 getWebhook,
+		DeleteWebhookEndpoint:              deleteWebhook,
+		ListWebhookDeliveriesEndpoint:      listWebhookDeliveries,
 	}
 }
 
-// GetProjects calls the "get-projects" endpoint of the "project-service"
-// service.
-// GetProjects may return the following errors:
-//   - "BadRequest" (type *BadRequestError): Bad request
-//   - "InternalServerError" (type *InternalServerError): Internal server error
-//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
-//   - error: internal error
-func (c *Client) GetProjects(ctx context.Context, p *GetProjectsPayload) (res *GetProjectsResult, err error) {
-	var ires any
-	ires, err = c.GetProjectsEndpoint(ctx, p)
-	if err != nil {
-		return
-	}
-	return ires.(*GetProjectsResult), nil
-}
-
-// CreateProject calls the "create-project" endpoint of the "project-service"
+// CloneProject calls the "clone-project" endpoint of the "project-service"
 // service.
-// CreateProject may return the following errors:
+// CloneProject may return the following errors:
 //   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Project not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
 //   - "Conflict" (type *ConflictError): Conflict
+//   - "UnprocessableEntity" (type *UnprocessableEntityError): Unprocessable entity
 //   - "InternalServerError" (type *InternalServerError): Internal server error
 //   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
 //   - error: internal error
-func (c *Client) CreateProject(ctx context.Context, p *CreateProjectPayload) (res *ProjectFull, err error) {
+func (c *Client) CloneProject(ctx context.Context, p *CloneProjectPayload) (res *ProjectFull, err error) {
 	var ires any
-	ires, err = c.CreateProjectEndpoint(ctx, p)
+	ires, err = c.CloneProjectEndpoint(ctx, p)
 	if err != nil {
 		return
 	}
 	return ires.(*ProjectFull), nil
 }
 
-// GetOneProjectBase calls the "get-one-project-base" endpoint of the
-// "project-service" service.
-// GetOneProjectBase may return the following errors:
-//   - "NotFound" (type *NotFoundError): Resource not found
-//   - "InternalServerError" (type *InternalServerError): Internal server error
-//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
-//   - error: internal error
-func (c *Client) GetOneProjectBase(ctx context.Context, p *GetOneProjectBasePayload) (res *GetOneProjectBaseResult, err error) {
-	var ires any
-	ires, err = c.GetOneProjectBaseEndpoint(ctx, p)
-	if err != nil {
-		return
-	}
-	return ires.(*GetOneProjectBaseResult), nil
-}
-
-// GetOneProjectSettings calls the "get-one-project-settings" endpoint of the
-// "project-service" service.
-// GetOneProjectSettings may return the following errors:
-//   - "NotFound" (type *NotFoundError): Resource not found
-//   - "InternalServerError" (type *InternalServerError): Internal server error
-//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
-//   - error: internal error
-func (c *Client) GetOneProjectSettings(ctx context.Context, p *GetOneProjectSettingsPayload) (res *GetOneProjectSettingsResult, err error) {
-	var ires any
-	ires, err = c.GetOneProjectSettingsEndpoint(ctx, p)
-	if err != nil {
-		return
-	}
-	return ires.(*GetOneProjectSettingsResult), nil
-}
-
-// UpdateProjectBase calls the "update-project-base" endpoint of the
+// UploadProjectLogo calls the "upload-project-logo" endpoint of the
 // "project-service" service.
-// UpdateProjectBase may return the following errors:
+// UploadProjectLogo may return the following errors:
 //   - "BadRequest" (type *BadRequestError): Bad request
-//   - "NotFound" (type *NotFoundError): Resource not found
-//   - "Conflict" (type *ConflictError): Conflict
+//   - "NotFound" (type *NotFoundError): Project not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
 //   - "InternalServerError" (type *InternalServerError): Internal server error
 //   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
 //   - error: internal error
-func (c *Client) UpdateProjectBase(ctx context.Context, p *UpdateProjectBasePayload) (res *ProjectBase, err error) {
+func (c *Client) UploadProjectLogo(ctx context.Context, p *UploadProjectLogoPayload) (res *ProjectBase, err error) {
 	var ires any
-	ires, err = c.UpdateProjectBaseEndpoint(ctx, p)
+	ires, err = c.UploadProjectLogoEndpoint(ctx, p)
 	if err != nil {
 		return
 	}
 	return ires.(*ProjectBase), nil
 }
 
-// UpdateProjectSettings calls the "update-project-settings" endpoint of the
-// "project-service" service.
-// UpdateProjectSettings may return the following errors:
+// MergeProject calls the "merge-project" endpoint of the "project-service"
+// service.
+// MergeProject may return the following errors:
 //   - "BadRequest" (type *BadRequestError): Bad request
-//   - "NotFound" (type *NotFoundError): Resource not found
+//   - "NotFound" (type *NotFoundError): Project not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
 //   - "Conflict" (type *ConflictError): Revision mismatch
+//   - "UnprocessableEntity" (type *UnprocessableEntityError): Unprocessable entity
 //   - "InternalServerError" (type *InternalServerError): Internal server error
 //   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
 //   - error: internal error
-func (c *Client) UpdateProjectSettings(ctx context.Context, p *UpdateProjectSettingsPayload) (res *ProjectSettings, err error) {
+func (c *Client) MergeProject(ctx context.Context, p *MergeProjectPayload) (res *ProjectFull, err error) {
 	var ires any
-	ires, err = c.UpdateProjectSettingsEndpoint(ctx, p)
+	ires, err = c.MergeProjectEndpoint(ctx, p)
 	if err != nil {
 		return
 	}
-	return ires.(*ProjectSettings), nil
+	return ires.(*ProjectFull), nil
 }
 
-// DeleteProject calls the "delete-project" endpoint of the "project-service"
-// service.
-// DeleteProject may return the following errors:
-//   - "NotFound" (type *NotFoundError): Resource not found
+// TransferProject calls the "transfer-project" endpoint of the
+// "project-service" service.
+// TransferProject may return the following errors:
 //   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Project not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
 //   - "Conflict" (type *ConflictError): Revision mismatch
+//   - "UnprocessableEntity" (type *UnprocessableEntityError): Unprocessable entity
 //   - "InternalServerError" (type *InternalServerError): Internal server error
 //   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
 //   - error: internal error
-func (c *Client) DeleteProject(ctx context.Context, p *DeleteProjectPayload) (err error) {
-	_, err = c.DeleteProjectEndpoint(ctx, p)
-	return
-}
-
-// Readyz calls the "readyz" endpoint of the "project-service" service.
-// Readyz may return the following errors:
-//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service is unavailable
-//   - error: internal error
-func (c *Client) Readyz(ctx context.Context) (res []byte, err error) {
-	var ires any
-	ires, err = c.ReadyzEndpoint(ctx, nil)
-	if err != nil {
-		return
-	}
-	return ires.([]byte), nil
-}
-
-// Livez calls the "livez" endpoint of the "project-service" service.
-func (c *Client) Livez(ctx context.Context) (res []byte, err error) {
+func (c *Client) TransferProject(ctx context.Context, p *TransferProjectPayload) (res *ProjectFull, err error) {
 	var ires any
-	ires, err = c.LivezEndpoint(ctx, nil)
+	ires, err = c.TransferProjectEndpoint(ctx, p)
 	if err != nil {
 		return
 	}
-	return ires.([]byte), nil
+	return ires.(*ProjectFull), nil
 }
 
 // CreateProjectLink calls the "create-project-link" endpoint of the
@@ -207,6 +251,7 @@ func (c *Client) Livez(ctx context.Context) (res []byte, err error) {
 // CreateProjectLink may return the following errors:
 //   - "BadRequest" (type *BadRequestError): Bad request
 //   - "NotFound" (type *NotFoundError): Project not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
 //   - "InternalServerError" (type *InternalServerError): Internal server error
 //   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
 //   - error: internal error
@@ -223,6 +268,7 @@ func (c *Client) CreateProjectLink(ctx context.Context, p *CreateProjectLinkPayl
 // "project-service" service.
 // GetProjectLink may return the following errors:
 //   - "NotFound" (type *NotFoundError): Resource not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
 //   - "InternalServerError" (type *InternalServerError): Internal server error
 //   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
 //   - error: internal error
@@ -241,6 +287,7 @@ func (c *Client) GetProjectLink(ctx context.Context, p *GetProjectLinkPayload) (
 //   - "BadRequest" (type *BadRequestError): Bad request
 //   - "NotFound" (type *NotFoundError): Resource not found
 //   - "Conflict" (type *ConflictError): Revision mismatch
+//   - "Forbidden" (type *ForbiddenError): Forbidden
 //   - "InternalServerError" (type *InternalServerError): Internal server error
 //   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
 //   - error: internal error
@@ -255,6 +302,7 @@ func (c *Client) DeleteProjectLink(ctx context.Context, p *DeleteProjectLinkPayl
 //   - "BadRequest" (type *BadRequestError): Bad request
 //   - "NotFound" (type *NotFoundError): Project not found
 //   - "Conflict" (type *ConflictError): Folder name already exists
+//   - "Forbidden" (type *ForbiddenError): Forbidden
 //   - "InternalServerError" (type *InternalServerError): Internal server error
 //   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
 //   - error: internal error
@@ -271,6 +319,7 @@ func (c *Client) CreateProjectFolder(ctx context.Context, p *CreateProjectFolder
 // "project-service" service.
 // GetProjectFolder may return the following errors:
 //   - "NotFound" (type *NotFoundError): Resource not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
 //   - "InternalServerError" (type *InternalServerError): Internal server error
 //   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
 //   - error: internal error
@@ -289,6 +338,7 @@ func (c *Client) GetProjectFolder(ctx context.Context, p *GetProjectFolderPayloa
 //   - "BadRequest" (type *BadRequestError): Bad request
 //   - "NotFound" (type *NotFoundError): Resource not found
 //   - "Conflict" (type *ConflictError): Folder not empty or revision mismatch
+//   - "Forbidden" (type *ForbiddenError): Forbidden
 //   - "InternalServerError" (type *InternalServerError): Internal server error
 //   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
 //   - error: internal error
@@ -303,6 +353,7 @@ func (c *Client) DeleteProjectFolder(ctx context.Context, p *DeleteProjectFolder
 //   - "BadRequest" (type *BadRequestError): Bad request
 //   - "NotFound" (type *NotFoundError): Project not found
 //   - "Conflict" (type *ConflictError): Document name already exists
+//   - "Forbidden" (type *ForbiddenError): Forbidden
 //   - "InternalServerError" (type *InternalServerError): Internal server error
 //   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
 //   - error: internal error
@@ -319,6 +370,7 @@ func (c *Client) UploadProjectDocument(ctx context.Context, p *UploadProjectDocu
 // "project-service" service.
 // GetProjectDocument may return the following errors:
 //   - "NotFound" (type *NotFoundError): Resource not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
 //   - "InternalServerError" (type *InternalServerError): Internal server error
 //   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
 //   - error: internal error
@@ -335,6 +387,7 @@ func (c *Client) GetProjectDocument(ctx context.Context, p *GetProjectDocumentPa
 // the "project-service" service.
 // DownloadProjectDocument may return the following errors:
 //   - "NotFound" (type *NotFoundError): Resource not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
 //   - "InternalServerError" (type *InternalServerError): Internal server error
 //   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
 //   - error: internal error
@@ -354,6 +407,7 @@ func (c *Client) DownloadProjectDocument(ctx context.Context, p *DownloadProject
 //   - "BadRequest" (type *BadRequestError): Bad request
 //   - "NotFound" (type *NotFoundError): Resource not found
 //   - "Conflict" (type *ConflictError): Revision mismatch
+//   - "Forbidden" (type *ForbiddenError): Forbidden
 //   - "InternalServerError" (type *InternalServerError): Internal server error
 //   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
 //   - error: internal error
@@ -361,3 +415,961 @@ func (c *Client) DeleteProjectDocument(ctx context.Context, p *DeleteProjectDocu
 	_, err = c.DeleteProjectDocumentEndpoint(ctx, p)
 	return
 }
+
+// UploadProjectCharter calls the "upload-project-charter" endpoint of the
+// "project-service" service.
+// UploadProjectCharter may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Project not found
+//   - "Conflict" (type *ConflictError): Concurrent charter uploads
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) UploadProjectCharter(ctx context.Context, p *UploadProjectCharterPayload) (res *ProjectCharter, err error) {
+	var ires any
+	ires, err = c.UploadProjectCharterEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectCharter), nil
+}
+
+// GetProjectCharter calls the "get-project-charter" endpoint of the
+// "project-service" service.
+// GetProjectCharter may return the following errors:
+//   - "NotFound" (type *NotFoundError): Project or charter not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetProjectCharter(ctx context.Context, p *GetProjectCharterPayload) (res *ProjectCharter, err error) {
+	var ires any
+	ires, err = c.GetProjectCharterEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectCharter), nil
+}
+
+// GetProjectAssociations calls the "get-project-associations" endpoint of the
+// "project-service" service.
+// GetProjectAssociations may return the following errors:
+//   - "NotFound" (type *NotFoundError): Project not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetProjectAssociations(ctx context.Context, p *GetProjectAssociationsPayload) (res *ProjectAssociations, err error) {
+	var ires any
+	ires, err = c.GetProjectAssociationsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectAssociations), nil
+}
+
+// UpdateProjectAssociations calls the "update-project-associations" endpoint
+// of the "project-service" service.
+// UpdateProjectAssociations may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request, or a referenced resource does not exist
+//   - "NotFound" (type *NotFoundError): Project not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) UpdateProjectAssociations(ctx context.Context, p *UpdateProjectAssociationsPayload) (res *ProjectAssociations, err error) {
+	var ires any
+	ires, err = c.UpdateProjectAssociationsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectAssociations), nil
+}
+
+// GetProjectSettingsDiff calls the "get-project-settings-diff" endpoint of the
+// "project-service" service.
+// GetProjectSettingsDiff may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Project or settings revision not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetProjectSettingsDiff(ctx context.Context, p *GetProjectSettingsDiffPayload) (res *ProjectSettingsDiff, err error) {
+	var ires any
+	ires, err = c.GetProjectSettingsDiffEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectSettingsDiff), nil
+}
+
+// GetProjectChildren calls the "get-project-children" endpoint of the
+// "project-service" service.
+// GetProjectChildren may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Project not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetProjectChildren(ctx context.Context, p *GetProjectChildrenPayload) (res *ProjectPage, err error) {
+	var ires any
+	ires, err = c.GetProjectChildrenEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectPage), nil
+}
+
+// GetProjectDescendants calls the "get-project-descendants" endpoint of the
+// "project-service" service.
+// GetProjectDescendants may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Project not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetProjectDescendants(ctx context.Context, p *GetProjectDescendantsPayload) (res *ProjectPage, err error) {
+	var ires any
+	ires, err = c.GetProjectDescendantsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectPage), nil
+}
+
+// GetProjectLegalLineage calls the "get-project-legal-lineage" endpoint of the
+// "project-service" service.
+// GetProjectLegalLineage may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Project not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetProjectLegalLineage(ctx context.Context, p *GetProjectLegalLineagePayload) (res *ProjectLegalLineage, err error) {
+	var ires any
+	ires, err = c.GetProjectLegalLineageEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectLegalLineage), nil
+}
+
+// SearchProjects calls the "search-projects" endpoint of the "project-service"
+// service.
+// SearchProjects may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) SearchProjects(ctx context.Context, p *SearchProjectsPayload) (res *SearchProjectsResult, err error) {
+	var ires any
+	ires, err = c.SearchProjectsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SearchProjectsResult), nil
+}
+
+// AutocompleteProjects calls the "autocomplete-projects" endpoint of the
+// "project-service" service.
+// AutocompleteProjects may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) AutocompleteProjects(ctx context.Context, p *AutocompleteProjectsPayload) (res *AutocompleteProjectsResult, err error) {
+	var ires any
+	ires, err = c.AutocompleteProjectsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*AutocompleteProjectsResult), nil
+}
+
+// AddProjectWriter calls the "add-project-writer" endpoint of the
+// "project-service" service.
+// AddProjectWriter may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request, or no user has this username
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Project not found
+//   - "Conflict" (type *ConflictError): Too many concurrent updates, or revision mismatch
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) AddProjectWriter(ctx context.Context, p *AddProjectWriterPayload) (res *ProjectSettings, err error) {
+	var ires any
+	ires, err = c.AddProjectWriterEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectSettings), nil
+}
+
+// RemoveProjectWriter calls the "remove-project-writer" endpoint of the
+// "project-service" service.
+// RemoveProjectWriter may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Project not found, or the user is not one of the writers
+//   - "Conflict" (type *ConflictError): Too many concurrent updates, or revision mismatch
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) RemoveProjectWriter(ctx context.Context, p *RemoveProjectWriterPayload) (err error) {
+	_, err = c.RemoveProjectWriterEndpoint(ctx, p)
+	return
+}
+
+// AddProjectAuditor calls the "add-project-auditor" endpoint of the
+// "project-service" service.
+// AddProjectAuditor may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request, or no user has this username
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Project not found
+//   - "Conflict" (type *ConflictError): Too many concurrent updates, or revision mismatch
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) AddProjectAuditor(ctx context.Context, p *AddProjectAuditorPayload) (res *ProjectSettings, err error) {
+	var ires any
+	ires, err = c.AddProjectAuditorEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectSettings), nil
+}
+
+// RemoveProjectAuditor calls the "remove-project-auditor" endpoint of the
+// "project-service" service.
+// RemoveProjectAuditor may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Project not found, or the user is not one of the auditors
+//   - "Conflict" (type *ConflictError): Too many concurrent updates, or revision mismatch
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) RemoveProjectAuditor(ctx context.Context, p *RemoveProjectAuditorPayload) (err error) {
+	_, err = c.RemoveProjectAuditorEndpoint(ctx, p)
+	return
+}
+
+// JoinProject calls the "join-project" endpoint of the "project-service"
+// service.
+// JoinProject may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request, or the requesting principal is not a registered user
+//   - "Forbidden" (type *ForbiddenError): Forbidden, or autojoin is disabled for the project
+//   - "NotFound" (type *NotFoundError): Project not found
+//   - "Conflict" (type *ConflictError): Too many concurrent updates
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) JoinProject(ctx context.Context, p *JoinProjectPayload) (res *ProjectJoinResult, err error) {
+	var ires any
+	ires, err = c.JoinProjectEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectJoinResult), nil
+}
+
+// BulkUpdateProjectMembers calls the "bulk-update-project-members" endpoint of
+// the "project-service" service.
+// BulkUpdateProjectMembers may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request, or a user to add is not a registered user
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) BulkUpdateProjectMembers(ctx context.Context, p *BulkUpdateProjectMembersPayload) (res *BulkProjectMembersResult, err error) {
+	var ires any
+	ires, err = c.BulkUpdateProjectMembersEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*BulkProjectMembersResult), nil
+}
+
+// GetProjects calls the "get-projects" endpoint of the "project-service"
+// service.
+// GetProjects may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetProjects(ctx context.Context, p *GetProjectsPayload) (res *GetProjectsResult, err error) {
+	var ires any
+	ires, err = c.GetProjectsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetProjectsResult), nil
+}
+
+// CreateProject calls the "create-project" endpoint of the "project-service"
+// service.
+// CreateProject may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "UnprocessableEntity" (type *UnprocessableEntityError): Unprocessable entity
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) CreateProject(ctx context.Context, p *CreateProjectPayload) (res *ProjectFull, err error) {
+	var ires any
+	ires, err = c.CreateProjectEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectFull), nil
+}
+
+// GetOneProjectBase calls the "get-one-project-base" endpoint of the
+// "project-service" service.
+// GetOneProjectBase may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Resource not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetOneProjectBase(ctx context.Context, p *GetOneProjectBasePayload) (res *GetOneProjectBaseResult, err error) {
+	var ires any
+	ires, err = c.GetOneProjectBaseEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetOneProjectBaseResult), nil
+}
+
+// GetProjectUIDFromLegacyID calls the "get-project-uid-from-legacy-id"
+// endpoint of the "project-service" service.
+// GetProjectUIDFromLegacyID may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Resource not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetProjectUIDFromLegacyID(ctx context.Context, p *GetProjectUIDFromLegacyIDPayload) (res *GetProjectUIDFromLegacyIDResult, err error) {
+	var ires any
+	ires, err = c.GetProjectUIDFromLegacyIDEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetProjectUIDFromLegacyIDResult), nil
+}
+
+// GetOneProjectSettings calls the "get-one-project-settings" endpoint of the
+// "project-service" service.
+// GetOneProjectSettings may return the following errors:
+//   - "NotFound" (type *NotFoundError): Resource not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetOneProjectSettings(ctx context.Context, p *GetOneProjectSettingsPayload) (res *GetOneProjectSettingsResult, err error) {
+	var ires any
+	ires, err = c.GetOneProjectSettingsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetOneProjectSettingsResult), nil
+}
+
+// UpdateProjectBase calls the "update-project-base" endpoint of the
+// "project-service" service.
+// UpdateProjectBase may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Resource not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "UnprocessableEntity" (type *UnprocessableEntityError): Unprocessable entity
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) UpdateProjectBase(ctx context.Context, p *UpdateProjectBasePayload) (res *ProjectBase, err error) {
+	var ires any
+	ires, err = c.UpdateProjectBaseEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectBase), nil
+}
+
+// UpdateProjectSettings calls the "update-project-settings" endpoint of the
+// "project-service" service.
+// UpdateProjectSettings may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Resource not found
+//   - "Conflict" (type *ConflictError): Revision mismatch, or conflicting changes when merging
+//   - "UnprocessableEntity" (type *UnprocessableEntityError): Unprocessable entity
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) UpdateProjectSettings(ctx context.Context, p *UpdateProjectSettingsPayload) (res *ProjectSettings, err error) {
+	var ires any
+	ires, err = c.UpdateProjectSettingsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectSettings), nil
+}
+
+// DeleteProject calls the "delete-project" endpoint of the "project-service"
+// service.
+// DeleteProject may return the following errors:
+//   - "NotFound" (type *NotFoundError): Resource not found
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "Conflict" (type *ConflictError): Revision mismatch
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) DeleteProject(ctx context.Context, p *DeleteProjectPayload) (err error) {
+	_, err = c.DeleteProjectEndpoint(ctx, p)
+	return
+}
+
+// BulkDeleteProjects calls the "bulk-delete-projects" endpoint of the
+// "project-service" service.
+// BulkDeleteProjects may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) BulkDeleteProjects(ctx context.Context, p *BulkDeleteProjectsPayload) (res *BulkProjectDeletionResult, err error) {
+	var ires any
+	ires, err = c.BulkDeleteProjectsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*BulkProjectDeletionResult), nil
+}
+
+// Readyz calls the "readyz" endpoint of the "project-service" service.
+// Readyz may return the following errors:
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service is unavailable
+//   - error: internal error
+func (c *Client) Readyz(ctx context.Context) (res []byte, err error) {
+	var ires any
+	ires, err = c.ReadyzEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.([]byte), nil
+}
+
+// Livez calls the "livez" endpoint of the "project-service" service.
+func (c *Client) Livez(ctx context.Context) (res []byte, err error) {
+	var ires any
+	ires, err = c.LivezEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.([]byte), nil
+}
+
+// Healthz calls the "healthz" endpoint of the "project-service" service.
+// Healthz may return the following errors:
+//   - "Unhealthy" (type *HealthReport): One or more dependencies are unhealthy
+//   - error: internal error
+func (c *Client) Healthz(ctx context.Context) (res *HealthReport, err error) {
+	var ires any
+	ires, err = c.HealthzEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(*HealthReport), nil
+}
+
+// ReconcileOutbox calls the "reconcile-outbox" endpoint of the
+// "project-service" service.
+// ReconcileOutbox may return the following errors:
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ReconcileOutbox(ctx context.Context, p *ReconcileOutboxPayload) (res *OutboxReconcileResult, err error) {
+	var ires any
+	ires, err = c.ReconcileOutboxEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*OutboxReconcileResult), nil
+}
+
+// ResyncProjects calls the "resync-projects" endpoint of the "project-service"
+// service.
+// ResyncProjects may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ResyncProjects(ctx context.Context, p *ResyncProjectsPayload) (res *ResyncProjectsResult, err error) {
+	var ires any
+	ires, err = c.ResyncProjectsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ResyncProjectsResult), nil
+}
+
+// ListDeadLetters calls the "list-dead-letters" endpoint of the
+// "project-service" service.
+// ListDeadLetters may return the following errors:
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListDeadLetters(ctx context.Context, p *ListDeadLettersPayload) (res *DeadLetterList, err error) {
+	var ires any
+	ires, err = c.ListDeadLettersEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*DeadLetterList), nil
+}
+
+// ReplayDeadLetter calls the "replay-dead-letter" endpoint of the
+// "project-service" service.
+// ReplayDeadLetter may return the following errors:
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "NotFound" (type *NotFoundError): Dead letter not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ReplayDeadLetter(ctx context.Context, p *ReplayDeadLetterPayload) (res *ReplayDeadLetterResult, err error) {
+	var ires any
+	ires, err = c.ReplayDeadLetterEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ReplayDeadLetterResult), nil
+}
+
+// RebuildSlugMappings calls the "rebuild-slug-mappings" endpoint of the
+// "project-service" service.
+// RebuildSlugMappings may return the following errors:
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) RebuildSlugMappings(ctx context.Context, p *RebuildSlugMappingsPayload) (res *SlugRebuildResult, err error) {
+	var ires any
+	ires, err = c.RebuildSlugMappingsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SlugRebuildResult), nil
+}
+
+// GetIntegrityReport calls the "get-integrity-report" endpoint of the
+// "project-service" service.
+// GetIntegrityReport may return the following errors:
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetIntegrityReport(ctx context.Context, p *GetIntegrityReportPayload) (res *IntegrityReport, err error) {
+	var ires any
+	ires, err = c.GetIntegrityReportEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*IntegrityReport), nil
+}
+
+// InspectProjectKv calls the "inspect-project-kv" endpoint of the
+// "project-service" service.
+// InspectProjectKv may return the following errors:
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "NotFound" (type *NotFoundError): Project not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) InspectProjectKv(ctx context.Context, p *InspectProjectKvPayload) (res *ProjectKVInspection, err error) {
+	var ires any
+	ires, err = c.InspectProjectKvEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectKVInspection), nil
+}
+
+// CreateBackup calls the "create-backup" endpoint of the "project-service"
+// service.
+// CreateBackup may return the following errors:
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) CreateBackup(ctx context.Context, p *CreateBackupPayload) (res *BackupResult, err error) {
+	var ires any
+	ires, err = c.CreateBackupEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*BackupResult), nil
+}
+
+// ListBackups calls the "list-backups" endpoint of the "project-service"
+// service.
+// ListBackups may return the following errors:
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListBackups(ctx context.Context, p *ListBackupsPayload) (res *BackupList, err error) {
+	var ires any
+	ires, err = c.ListBackupsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*BackupList), nil
+}
+
+// RestoreBackup calls the "restore-backup" endpoint of the "project-service"
+// service.
+// RestoreBackup may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "NotFound" (type *NotFoundError): Backup not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) RestoreBackup(ctx context.Context, p *RestoreBackupPayload) (res *RestoreBackupResult, err error) {
+	var ires any
+	ires, err = c.RestoreBackupEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*RestoreBackupResult), nil
+}
+
+// ListPendingAnnouncements calls the "list-pending-announcements" endpoint of
+// the "project-service" service.
+// ListPendingAnnouncements may return the following errors:
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListPendingAnnouncements(ctx context.Context, p *ListPendingAnnouncementsPayload) (res *PendingAnnouncementList, err error) {
+	var ires any
+	ires, err = c.ListPendingAnnouncementsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*PendingAnnouncementList), nil
+}
+
+// GetProjectEnums calls the "get-project-enums" endpoint of the
+// "project-service" service.
+// GetProjectEnums may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetProjectEnums(ctx context.Context, p *GetProjectEnumsPayload) (res *ProjectEnums, err error) {
+	var ires any
+	ires, err = c.GetProjectEnumsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectEnums), nil
+}
+
+// UpdateProjectEnums calls the "update-project-enums" endpoint of the
+// "project-service" service.
+// UpdateProjectEnums may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) UpdateProjectEnums(ctx context.Context, p *UpdateProjectEnumsPayload) (res *ProjectEnums, err error) {
+	var ires any
+	ires, err = c.UpdateProjectEnumsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectEnums), nil
+}
+
+// CreateProjectTemplate calls the "create-project-template" endpoint of the
+// "project-service" service.
+// CreateProjectTemplate may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "Conflict" (type *ConflictError): Template already exists
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) CreateProjectTemplate(ctx context.Context, p *CreateProjectTemplatePayload) (res *ProjectTemplate, err error) {
+	var ires any
+	ires, err = c.CreateProjectTemplateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectTemplate), nil
+}
+
+// ListProjectTemplates calls the "list-project-templates" endpoint of the
+// "project-service" service.
+// ListProjectTemplates may return the following errors:
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListProjectTemplates(ctx context.Context, p *ListProjectTemplatesPayload) (res *ProjectTemplateList, err error) {
+	var ires any
+	ires, err = c.ListProjectTemplatesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectTemplateList), nil
+}
+
+// GetProjectTemplate calls the "get-project-template" endpoint of the
+// "project-service" service.
+// GetProjectTemplate may return the following errors:
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "NotFound" (type *NotFoundError): Template not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetProjectTemplate(ctx context.Context, p *GetProjectTemplatePayload) (res *ProjectTemplate, err error) {
+	var ires any
+	ires, err = c.GetProjectTemplateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectTemplate), nil
+}
+
+// UpdateProjectTemplate calls the "update-project-template" endpoint of the
+// "project-service" service.
+// UpdateProjectTemplate may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "NotFound" (type *NotFoundError): Template not found
+//   - "Conflict" (type *ConflictError): Template changed concurrently
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) UpdateProjectTemplate(ctx context.Context, p *UpdateProjectTemplatePayload) (res *ProjectTemplate, err error) {
+	var ires any
+	ires, err = c.UpdateProjectTemplateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectTemplate), nil
+}
+
+// DeleteProjectTemplate calls the "delete-project-template" endpoint of the
+// "project-service" service.
+// DeleteProjectTemplate may return the following errors:
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "NotFound" (type *NotFoundError): Template not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) DeleteProjectTemplate(ctx context.Context, p *DeleteProjectTemplatePayload) (err error) {
+	_, err = c.DeleteProjectTemplateEndpoint(ctx, p)
+	return
+}
+
+// ListProjectDeletions calls the "list-project-deletions" endpoint of the
+// "project-service" service.
+// ListProjectDeletions may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListProjectDeletions(ctx context.Context, p *ListProjectDeletionsPayload) (res *ProjectDeletionList, err error) {
+	var ires any
+	ires, err = c.ListProjectDeletionsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectDeletionList), nil
+}
+
+// ListProjectEvents calls the "list-project-events" endpoint of the
+// "project-service" service.
+// ListProjectEvents may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Project has no retained history
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListProjectEvents(ctx context.Context, p *ListProjectEventsPayload) (res *ProjectEventList, err error) {
+	var ires any
+	ires, err = c.ListProjectEventsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectEventList), nil
+}
+
+// GetUserProjects calls the "get-user-projects" endpoint of the
+// "project-service" service.
+// GetUserProjects may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetUserProjects(ctx context.Context, p *GetUserProjectsPayload) (res *GetUserProjectsResult, err error) {
+	var ires any
+	ires, err = c.GetUserProjectsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetUserProjectsResult), nil
+}
+
+// ExportUserData calls the "export-user-data" endpoint of the
+// "project-service" service.
+// ExportUserData may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ExportUserData(ctx context.Context, p *ExportUserDataPayload) (res *UserDataExport, err error) {
+	var ires any
+	ires, err = c.ExportUserDataEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*UserDataExport), nil
+}
+
+// RemoveUserAccess calls the "remove-user-access" endpoint of the
+// "project-service" service.
+// RemoveUserAccess may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) RemoveUserAccess(ctx context.Context, p *RemoveUserAccessPayload) (res *UserAccessRemovalResult, err error) {
+	var ires any
+	ires, err = c.RemoveUserAccessEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*UserAccessRemovalResult), nil
+}
+
+// GetProjectVisibilityImpact calls the "get-project-visibility-impact"
+// endpoint of the "project-service" service.
+// GetProjectVisibilityImpact may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "NotFound" (type *NotFoundError): Project not found
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetProjectVisibilityImpact(ctx context.Context, p *GetProjectVisibilityImpactPayload) (res *ProjectVisibilityImpact, err error) {
+	var ires any
+	ires, err = c.GetProjectVisibilityImpactEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ProjectVisibilityImpact), nil
+}
+
+// CreateWebhook calls the "create-webhook" endpoint of the "project-service"
+// service.
+// CreateWebhook may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) CreateWebhook(ctx context.Context, p *CreateWebhookPayload) (res *Webhook, err error) {
+	var ires any
+	ires, err = c.CreateWebhookEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Webhook), nil
+}
+
+// ListWebhooks calls the "list-webhooks" endpoint of the "project-service"
+// service.
+// ListWebhooks may return the following errors:
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListWebhooks(ctx context.Context, p *ListWebhooksPayload) (res *WebhookList, err error) {
+	var ires any
+	ires, err = c.ListWebhooksEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WebhookList), nil
+}
+
+// GetWebhook calls the "get-webhook" endpoint of the "project-service" service.
+// GetWebhook may return the following errors:
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "NotFound" (type *NotFoundError): Webhook not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetWebhook(ctx context.Context, p *GetWebhookPayload) (res *Webhook, err error) {
+	var ires any
+	ires, err = c.GetWebhookEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Webhook), nil
+}
+
+// DeleteWebhook calls the "delete-webhook" endpoint of the "project-service"
+// service.
+// DeleteWebhook may return the following errors:
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "NotFound" (type *NotFoundError): Webhook not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) DeleteWebhook(ctx context.Context, p *DeleteWebhookPayload) (err error) {
+	_, err = c.DeleteWebhookEndpoint(ctx, p)
+	return
+}
+
+// ListWebhookDeliveries calls the "list-webhook-deliveries" endpoint of the
+// "project-service" service.
+// ListWebhookDeliveries may return the following errors:
+//   - "Forbidden" (type *ForbiddenError): Forbidden, the principal is not LF staff
+//   - "NotFound" (type *NotFoundError): Webhook not found
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListWebhookDeliveries(ctx context.Context, p *ListWebhookDeliveriesPayload) (res *WebhookDeliveryList, err error) {
+	var ires any
+	ires, err = c.ListWebhookDeliveriesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WebhookDeliveryList), nil
+}
@@ -19,25 +19,77 @@ import (
 
 // Endpoints wraps the "project-service" service endpoints.
 type Endpoints struct {
-	GetProjects             goa.Endpoint
-	CreateProject           goa.Endpoint
-	GetOneProjectBase       goa.Endpoint
-	GetOneProjectSettings   goa.Endpoint
-	UpdateProjectBase       goa.Endpoint
-	UpdateProjectSettings   goa.Endpoint
-	DeleteProject           goa.Endpoint
-	Readyz                  goa.Endpoint
-	Livez                   goa.Endpoint
-	CreateProjectLink       goa.Endpoint
-	GetProjectLink          goa.Endpoint
-	DeleteProjectLink       goa.Endpoint
-	CreateProjectFolder     goa.Endpoint
-	GetProjectFolder        goa.Endpoint
-	DeleteProjectFolder     goa.Endpoint
-	UploadProjectDocument   goa.Endpoint
-	GetProjectDocument      goa.Endpoint
-	DownloadProjectDocument goa.Endpoint
-	DeleteProjectDocument   goa.Endpoint
+	CloneProject               goa.Endpoint
+	UploadProjectLogo          goa.Endpoint
+	MergeProject               goa.Endpoint
+	TransferProject            goa.Endpoint
+	CreateProjectLink          goa.Endpoint
+	GetProjectLink             goa.Endpoint
+	DeleteProjectLink          goa.Endpoint
+	CreateProjectFolder        goa.Endpoint
+	GetProjectFolder           goa.Endpoint
+	DeleteProjectFolder        goa.Endpoint
+	UploadProjectDocument      goa.Endpoint
+	GetProjectDocument         goa.Endpoint
+	DownloadProjectDocument    goa.Endpoint
+	DeleteProjectDocument      goa.Endpoint
+	UploadProjectCharter       goa.Endpoint
+	GetProjectCharter          goa.Endpoint
+	GetProjectAssociations     goa.Endpoint
+	UpdateProjectAssociations  goa.Endpoint
+	GetProjectSettingsDiff     goa.Endpoint
+	GetProjectChildren         goa.Endpoint
+	GetProjectDescendants      goa.Endpoint
+	GetProjectLegalLineage     goa.Endpoint
+	SearchProjects             goa.Endpoint
+	AutocompleteProjects       goa.Endpoint
+	AddProjectWriter           goa.Endpoint
+	RemoveProjectWriter        goa.Endpoint
+	AddProjectAuditor          goa.Endpoint
+	RemoveProjectAuditor       goa.Endpoint
+	JoinProject                goa.Endpoint
+	BulkUpdateProjectMembers   goa.Endpoint
+	GetProjects                goa.Endpoint
+	CreateProject              goa.Endpoint
+	GetOneProjectBase          goa.Endpoint
+	GetProjectUIDFromLegacyID  goa.Endpoint
+	GetOneProjectSettings      goa.Endpoint
+	UpdateProjectBase          goa.Endpoint
+	UpdateProjectSettings      goa.Endpoint
+	DeleteProject              goa.Endpoint
+	BulkDeleteProjects         goa.Endpoint
+	Readyz                     goa.Endpoint
+	Livez                      goa.Endpoint
+	Healthz                    goa.Endpoint
+	ReconcileOutbox            goa.Endpoint
+	ResyncProjects             goa.Endpoint
+	ListDeadLetters            goa.Endpoint
+	ReplayDeadLetter           goa.Endpoint
+	RebuildSlugMappings        goa.Endpoint
+	GetIntegrityReport         goa.Endpoint
+	InspectProjectKv           goa.Endpoint
+	CreateBackup               goa.Endpoint
+	ListBackups                goa.Endpoint
+	RestoreBackup              goa.Endpoint
+	ListPendingAnnouncements   goa.Endpoint
+	GetProjectEnums            goa.Endpoint
+	UpdateProjectEnums         goa.Endpoint
+	CreateProjectTemplate      goa.Endpoint
+	ListProjectTemplates       goa.Endpoint
+	GetProjectTemplate         goa.Endpoint
+	UpdateProjectTemplate      goa.Endpoint
+	DeleteProjectTemplate      goa.Endpoint
+	ListProjectDeletions       goa.Endpoint
+	ListProjectEvents          goa.Endpoint
+	GetUserProjects            goa.Endpoint
+	ExportUserData             goa.Endpoint
+	RemoveUserAccess           goa.Endpoint
+	GetProjectVisibilityImpact goa.Endpoint
+	CreateWebhook              goa.Endpoint
+	ListWebhooks               goa.Endpoint
+	GetWebhook                 goa.Endpoint
+	DeleteWebhook              goa.Endpoint
+	ListWebhookDeliveries      goa.Endpoint
 }
 
 // DownloadProjectDocumentResponseData holds both the result and the HTTP
@@ -53,40 +105,87 @@ func NewEndpoints(s Service) *Endpoints {
 	// Casting service to Auther interface
 	a := s.(Auther)
 	return &Endpoints{
-		GetProjects:             NewGetProjectsEndpoint(s, a.JWTAuth),
-		CreateProject:           NewCreateProjectEndpoint(s, a.JWTAuth),
-		GetOneProjectBase:       NewGetOneProjectBaseEndpoint(s, a.JWTAuth),
-		GetOneProjectSettings:   NewGetOneProjectSettingsEndpoint(s, a.JWTAuth),
-		UpdateProjectBase:       NewUpdateProjectBaseEndpoint(s, a.JWTAuth),
-		UpdateProjectSettings:   NewUpdateProjectSettingsEndpoint(s, a.JWTAuth),
-		DeleteProject:           NewDeleteProjectEndpoint(s, a.JWTAuth),
-		Readyz:                  NewReadyzEndpoint(s),
-		Livez:                   NewLivezEndpoint(s),
-		CreateProjectLink:       NewCreateProjectLinkEndpoint(s, a.JWTAuth),
-		GetProjectLink:          NewGetProjectLinkEndpoint(s, a.JWTAuth),
-		DeleteProjectLink:       NewDeleteProjectLinkEndpoint(s, a.JWTAuth),
-		CreateProjectFolder:     NewCreateProjectFolderEndpoint(s, a.JWTAuth),
-		GetProjectFolder:        NewGetProjectFolderEndpoint(s, a.JWTAuth),
-		DeleteProjectFolder:     NewDeleteProjectFolderEndpoint(s, a.JWTAuth),
-		UploadProjectDocument:   NewUploadProjectDocumentEndpoint(s, a.JWTAuth),
-		GetProjectDocument:      NewGetProjectDocumentEndpoint(s, a.JWTAuth),
-		DownloadProjectDocument: NewDownloadProjectDocumentEndpoint(s, a.JWTAuth),
-		DeleteProjectDocument:   NewDeleteProjectDocumentEndpoint(s, a.JWTAuth),
+		CloneProject:               NewCloneProjectEndpoint(s, a.JWTAuth),
+		UploadProjectLogo:          NewUploadProjectLogoEndpoint(s, a.JWTAuth),
+		MergeProject:               NewMergeProjectEndpoint(s, a.JWTAuth),
+		TransferProject:            NewTransferProjectEndpoint(s, a.JWTAuth),
+		CreateProjectLink:          NewCreateProjectLinkEndpoint(s, a.JWTAuth),
+		GetProjectLink:             NewGetProjectLinkEndpoint(s, a.JWTAuth),
+		DeleteProjectLink:          NewDeleteProjectLinkEndpoint(s, a.JWTAuth),
+		CreateProjectFolder:        NewCreateProjectFolderEndpoint(s, a.JWTAuth),
+		GetProjectFolder:           NewGetProjectFolderEndpoint(s, a.JWTAuth),
+		DeleteProjectFolder:        NewDeleteProjectFolderEndpoint(s, a.JWTAuth),
+		UploadProjectDocument:      NewUploadProjectDocumentEndpoint(s, a.JWTAuth),
+		GetProjectDocument:         NewGetProjectDocumentEndpoint(s, a.JWTAuth),
+		DownloadProjectDocument:    NewDownloadProjectDocumentEndpoint(s, a.JWTAuth),
+		DeleteProjectDocument:      NewDeleteProjectDocumentEndpoint(s, a.JWTAuth),
+		UploadProjectCharter:       NewUploadProjectCharterEndpoint(s, a.JWTAuth),
+		GetProjectCharter:          NewGetProjectCharterEndpoint(s, a.JWTAuth),
+		GetProjectAssociations:     NewGetProjectAssociationsEndpoint(s, a.JWTAuth),
+		UpdateProjectAssociations:  NewUpdateProjectAssociationsEndpoint(s, a.JWTAuth),
+		GetProjectSettingsDiff:     NewGetProjectSettingsDiffEndpoint(s, a.JWTAuth),
+		GetProjectChildren:         NewGetProjectChildrenEndpoint(s, a.JWTAuth),
+		GetProjectDescendants:      NewGetProjectDescendantsEndpoint(s, a.JWTAuth),
+		GetProjectLegalLineage:     NewGetProjectLegalLineageEndpoint(s, a.JWTAuth),
+		SearchProjects:             NewSearchProjectsEndpoint(s, a.JWTAuth),
+		AutocompleteProjects:       NewAutocompleteProjectsEndpoint(s, a.JWTAuth),
+		AddProjectWriter:           NewAddProjectWriterEndpoint(s, a.JWTAuth),
+		RemoveProjectWriter:        NewRemoveProjectWriterEndpoint(s, a.JWTAuth),
+		AddProjectAuditor:          NewAddProjectAuditorEndpoint(s, a.JWTAuth),
+		RemoveProjectAuditor:       NewRemoveProjectAuditorEndpoint(s, a.JWTAuth),
+		JoinProject:                NewJoinProjectEndpoint(s, a.JWTAuth),
+		BulkUpdateProjectMembers:   NewBulkUpdateProjectMembersEndpoint(s, a.JWTAuth),
+		GetProjects:                NewGetProjectsEndpoint(s, a.JWTAuth),
+		CreateProject:              NewCreateProjectEndpoint(s, a.JWTAuth),
+		GetOneProjectBase:          NewGetOneProjectBaseEndpoint(s, a.JWTAuth),
+		GetProjectUIDFromLegacyID:  NewGetProjectUIDFromLegacyIDEndpoint(s, a.JWTAuth),
+		GetOneProjectSettings:      NewGetOneProjectSettingsEndpoint(s, a.JWTAuth),
+		UpdateProjectBase:          NewUpdateProjectBaseEndpoint(s, a.JWTAuth),
+		UpdateProjectSettings:      NewUpdateProjectSettingsEndpoint(s, a.JWTAuth),
+		DeleteProject:              NewDeleteProjectEndpoint(s, a.JWTAuth),
+		BulkDeleteProjects:         NewBulkDeleteProjectsEndpoint(s, a.JWTAuth),
+		Readyz:                     NewReadyzEndpoint(s),
+		Livez:                      NewLivezEndpoint(s),
+		Healthz:                    NewHealthzEndpoint(s),
+		ReconcileOutbox:            NewReconcileOutboxEndpoint(s, a.JWTAuth),
+		ResyncProjects:             NewResyncProjectsEndpoint(s, a.JWTAuth),
+		ListDeadLetters:            NewListDeadLettersEndpoint(s, a.JWTAuth),
+		ReplayDeadLetter:           NewReplayDeadLetterEndpoint(s, a.JWTAuth),
+		RebuildSlugMappings:        NewRebuildSlugMappingsEndpoint(s, a.JWTAuth),
+		GetIntegrityReport:         NewGetIntegrityReportEndpoint(s, a.JWTAuth),
+		InspectProjectKv:           NewInspectProjectKvEndpoint(s, a.JWTAuth),
+		CreateBackup:               NewCreateBackupEndpoint(s, a.JWTAuth),
+		ListBackups:                NewListBackupsEndpoint(s, a.JWTAuth),
+		RestoreBackup:              NewRestoreBackupEndpoint(s, a.JWTAuth),
+		ListPendingAnnouncements:   NewListPendingAnnouncementsEndpoint(s, a.JWTAuth),
+		GetProjectEnums:            NewGetProjectEnumsEndpoint(s, a.JWTAuth),
+		UpdateProjectEnums:         NewUpdateProjectEnumsEndpoint(s, a.JWTAuth),
+		CreateProjectTemplate:      NewCreateProjectTemplateEndpoint(s, a.JWTAuth),
+		ListProjectTemplates:       NewListProjectTemplatesEndpoint(s, a.JWTAuth),
+		GetProjectTemplate:         NewGetProjectTemplateEndpoint(s, a.JWTAuth),
+		UpdateProjectTemplate:      NewUpdateProjectTemplateEndpoint(s, a.JWTAuth),
+		DeleteProjectTemplate:      NewDeleteProjectTemplateEndpoint(s, a.JWTAuth),
+		ListProjectDeletions:       NewListProjectDeletionsEndpoint(s, a.JWTAuth),
+		ListProjectEvents:          NewListProjectEventsEndpoint(s, a.JWTAuth),
+		GetUserProjects:            NewGetUserProjectsEndpoint(s, a.JWTAuth),
+		ExportUserData:             NewExportUserDataEndpoint(s, a.JWTAuth),
+		RemoveUserAccess:           NewRemoveUserAccessEndpoint(s, a.JWTAuth),
+		GetProjectVisibilityImpact: NewGetProjectVisibilityImpactEndpoint(s, a.JWTAuth),
+		CreateWebhook:              NewCreateWebhookEndpoint(s, a.JWTAuth),
+		ListWebhooks:               NewListWebhooksEndpoint(s, a.JWTAuth),
+		GetWebhook:                 NewGetWebhookEndpoint(s, a.JWTAuth),
+		DeleteWebhook:              NewDeleteWebhookEndpoint(s, a.JWTAuth),
+		ListWebhookDeliveries:      NewListWebhookDeliveriesEndpoint(s, a.JWTAuth),
 	}
 }
 
 // Use applies the given middleware to all the "project-service" service
 // endpoints.
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
-	e.GetProjects = m(e.GetProjects)
-	e.CreateProject = m(e.CreateProject)
-	e.GetOneProjectBase = m(e.GetOneProjectBase)
-	e.GetOneProjectSettings = m(e.GetOneProjectSettings)
-	e.UpdateProjectBase = m(e.UpdateProjectBase)
-	e.UpdateProjectSettings = m(e.UpdateProjectSettings)
-	e.DeleteProject = m(e.DeleteProject)
-	e.Readyz = m(e.Readyz)
-	e.Livez = m(e.Livez)
+	e.CloneProject = m(e.CloneProject)
+	e.UploadProjectLogo = m(e.UploadProjectLogo)
+	e.MergeProject = m(e.MergeProject)
+	e.TransferProject = m(e.TransferProject)
 	e.CreateProjectLink = m(e.CreateProjectLink)
 	e.GetProjectLink = m(e.GetProjectLink)
 	e.DeleteProjectLink = m(e.DeleteProjectLink)
@@ -97,18 +196,75 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.GetProjectDocument = m(e.GetProjectDocument)
 	e.DownloadProjectDocument = m(e.DownloadProjectDocument)
 	e.DeleteProjectDocument = m(e.DeleteProjectDocument)
+	e.UploadProjectCharter = m(e.UploadProjectCharter)
+	e.GetProjectCharter = m(e.GetProjectCharter)
+	e.GetProjectAssociations = m(e.GetProjectAssociations)
+	e.UpdateProjectAssociations = m(e.UpdateProjectAssociations)
+	e.GetProjectSettingsDiff = m(e.GetProjectSettingsDiff)
+	e.GetProjectChildren = m(e.GetProjectChildren)
+	e.GetProjectDescendants = m(e.GetProjectDescendants)
+	e.GetProjectLegalLineage = m(e.GetProjectLegalLineage)
+	e.SearchProjects = m(e.SearchProjects)
+	e.AutocompleteProjects = m(e.AutocompleteProjects)
+	e.AddProjectWriter = m(e.AddProjectWriter)
+	e.RemoveProjectWriter = m(e.RemoveProjectWriter)
+	e.AddProjectAuditor = m(e.AddProjectAuditor)
+	e.RemoveProjectAuditor = m(e.RemoveProjectAuditor)
+	e.JoinProject = m(e.JoinProject)
+	e.BulkUpdateProjectMembers = m(e.BulkUpdateProjectMembers)
+	e.GetProjects = m(e.GetProjects)
+	e.CreateProject = m(e.CreateProject)
+	e.GetOneProjectBase = m(e.GetOneProjectBase)
+	e.GetProjectUIDFromLegacyID = m(e.GetProjectUIDFromLegacyID)
+	e.GetOneProjectSettings = m(e.GetOneProjectSettings)
+	e.UpdateProjectBase = m(e.UpdateProjectBase)
+	e.UpdateProjectSettings = m(e.UpdateProjectSettings)
+	e.DeleteProject = m(e.DeleteProject)
+	e.BulkDeleteProjects = m(e.BulkDeleteProjects)
+	e.Readyz = m(e.Readyz)
+	e.Livez = m(e.Livez)
+	e.Healthz = m(e.Healthz)
+	e.ReconcileOutbox = m(e.ReconcileOutbox)
+	e.ResyncProjects = m(e.ResyncProjects)
+	e.ListDeadLetters = m(e.ListDeadLetters)
+	e.ReplayDeadLetter = m(e.ReplayDeadLetter)
+	e.RebuildSlugMappings = m(e.RebuildSlugMappings)
+	e.GetIntegrityReport = m(e.GetIntegrityReport)
+	e.InspectProjectKv = m(e.InspectProjectKv)
+	e.CreateBackup = m(e.CreateBackup)
+	e.ListBackups = m(e.ListBackups)
+	e.RestoreBackup = m(e.RestoreBackup)
+	e.ListPendingAnnouncements = m(e.ListPendingAnnouncements)
+	e.GetProjectEnums = m(e.GetProjectEnums)
+	e.UpdateProjectEnums = m(e.UpdateProjectEnums)
+	e.CreateProjectTemplate = m(e.CreateProjectTemplate)
+	e.ListProjectTemplates = m(e.ListProjectTemplates)
+	e.GetProjectTemplate = m(e.GetProjectTemplate)
+	e.UpdateProjectTemplate = m(e.UpdateProjectTemplate)
+	e.DeleteProjectTemplate = m(e.DeleteProjectTemplate)
+	e.ListProjectDeletions = m(e.ListProjectDeletions)
+	e.ListProjectEvents = m(e.ListProjectEvents)
+	e.GetUserProjects = m(e.GetUserProjects)
+	e.ExportUserData = m(e.ExportUserData)
+	e.RemoveUserAccess = m(e.RemoveUserAccess)
+	e.GetProjectVisibilityImpact = m(e.GetProjectVisibilityImpact)
+	e.CreateWebhook = m(e.CreateWebhook)
+	e.ListWebhooks = m(e.ListWebhooks)
+	e.GetWebhook = m(e.GetWebhook)
+	e.DeleteWebhook = m(e.DeleteWebhook)
+	e.ListWebhookDeliveries = m(e.ListWebhookDeliveries)
 }
 
-// NewGetProjectsEndpoint returns an endpoint function that calls the method
-// "get-projects" of service "project-service".
-func NewGetProjectsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+// NewCloneProjectEndpoint returns an endpoint function that calls the method
+// "clone-project" of service "project-service".
+func NewCloneProjectEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		p := req.(*GetProjectsPayload)
+		p := req.(*CloneProjectPayload)
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
 		}
 		var token string
 		if p.BearerToken != nil {
@@ -118,20 +274,20 @@ func NewGetProjectsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpo
 		if err != nil {
 			return nil, err
 		}
-		return s.GetProjects(ctx, p)
+		return s.CloneProject(ctx, p)
 	}
 }
 
-// NewCreateProjectEndpoint returns an endpoint function that calls the method
-// "create-project" of service "project-service".
-func NewCreateProjectEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+// NewUploadProjectLogoEndpoint returns an endpoint function that calls the
+// method "upload-project-logo" of service "project-service".
+func NewUploadProjectLogoEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		p := req.(*CreateProjectPayload)
+		p := req.(*UploadProjectLogoPayload)
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
 		}
 		var token string
 		if p.BearerToken != nil {
@@ -141,20 +297,20 @@ func NewCreateProjectEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.End
 		if err != nil {
 			return nil, err
 		}
-		return s.CreateProject(ctx, p)
+		return s.UploadProjectLogo(ctx, p)
 	}
 }
 
-// NewGetOneProjectBaseEndpoint returns an endpoint function that calls the
-// method "get-one-project-base" of service "project-service".
-func NewGetOneProjectBaseEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+// NewMergeProjectEndpoint returns an endpoint function that calls the method
+// "merge-project" of service "project-service".
+func NewMergeProjectEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		p := req.(*GetOneProjectBasePayload)
+		p := req.(*MergeProjectPayload)
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
 		}
 		var token string
 		if p.BearerToken != nil {
@@ -164,20 +320,20 @@ func NewGetOneProjectBaseEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa
 		if err != nil {
 			return nil, err
 		}
-		return s.GetOneProjectBase(ctx, p)
+		return s.MergeProject(ctx, p)
 	}
 }
 
-// NewGetOneProjectSettingsEndpoint returns an endpoint function that calls the
-// method "get-one-project-settings" of service "project-service".
-func NewGetOneProjectSettingsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+// NewTransferProjectEndpoint returns an endpoint function that calls the
+// method "transfer-project" of service "project-service".
+func NewTransferProjectEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		p := req.(*GetOneProjectSettingsPayload)
+		p := req.(*TransferProjectPayload)
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
 		}
 		var token string
 		if p.BearerToken != nil {
@@ -187,20 +343,20 @@ func NewGetOneProjectSettingsEndpoint(s Service, authJWTFn security.AuthJWTFunc)
 		if err != nil {
 			return nil, err
 		}
-		return s.GetOneProjectSettings(ctx, p)
+		return s.TransferProject(ctx, p)
 	}
 }
 
-// NewUpdateProjectBaseEndpoint returns an endpoint function that calls the
-// method "update-project-base" of service "project-service".
-func NewUpdateProjectBaseEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+// NewCreateProjectLinkEndpoint returns an endpoint function that calls the
+// method "create-project-link" of service "project-service".
+func NewCreateProjectLinkEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		p := req.(*UpdateProjectBasePayload)
+		p := req.(*CreateProjectLinkPayload)
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
 		}
 		var token string
 		if p.BearerToken != nil {
@@ -210,20 +366,20 @@ func NewUpdateProjectBaseEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa
 		if err != nil {
 			return nil, err
 		}
-		return s.UpdateProjectBase(ctx, p)
+		return s.CreateProjectLink(ctx, p)
 	}
 }
 
-// NewUpdateProjectSettingsEndpoint returns an endpoint function that calls the
-// method "update-project-settings" of service "project-service".
-func NewUpdateProjectSettingsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+// NewGetProjectLinkEndpoint returns an endpoint function that calls the method
+// "get-project-link" of service "project-service".
+func NewGetProjectLinkEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		p := req.(*UpdateProjectSettingsPayload)
+		p := req.(*GetProjectLinkPayload)
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
 		}
 		var token string
 		if p.BearerToken != nil {
@@ -233,20 +389,20 @@ func NewUpdateProjectSettingsEndpoint(s Service, authJWTFn security.AuthJWTFunc)
 		if err != nil {
 			return nil, err
 		}
-		return s.UpdateProjectSettings(ctx, p)
+		return s.GetProjectLink(ctx, p)
 	}
 }
 
-// NewDeleteProjectEndpoint returns an endpoint function that calls the method
-// "delete-project" of service "project-service".
-func NewDeleteProjectEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+// NewDeleteProjectLinkEndpoint returns an endpoint function that calls the
+// method "delete-project-link" of service "project-service".
+func NewDeleteProjectLinkEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		p := req.(*DeleteProjectPayload)
+		p := req.(*DeleteProjectLinkPayload)
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
 		}
 		var token string
 		if p.BearerToken != nil {
@@ -256,36 +412,66 @@ func NewDeleteProjectEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.End
 		if err != nil {
 			return nil, err
 		}
-		return nil, s.DeleteProject(ctx, p)
+		return nil, s.DeleteProjectLink(ctx, p)
 	}
 }
 
-// NewReadyzEndpoint returns an endpoint function that calls the method
-// "readyz" of service "project-service".
-func NewReadyzEndpoint(s Service) goa.Endpoint {
+// NewCreateProjectFolderEndpoint returns an endpoint function that calls the
+// method "create-project-folder" of service "project-service".
+func NewCreateProjectFolderEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		return s.Readyz(ctx)
+		p := req.(*CreateProjectFolderPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CreateProjectFolder(ctx, p)
 	}
 }
 
-// NewLivezEndpoint returns an endpoint function that calls the method "livez"
-// of service "project-service".
-func NewLivezEndpoint(s Service) goa.Endpoint {
+// NewGetProjectFolderEndpoint returns an endpoint function that calls the
+// method "get-project-folder" of service "project-service".
+func NewGetProjectFolderEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		return s.Livez(ctx)
+		p := req.(*GetProjectFolderPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetProjectFolder(ctx, p)
 	}
 }
 
-// NewCreateProjectLinkEndpoint returns an endpoint function that calls the
-// method "create-project-link" of service "project-service".
-func NewCreateProjectLinkEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+// NewDeleteProjectFolderEndpoint returns an endpoint function that calls the
+// method "delete-project-folder" of service "project-service".
+func NewDeleteProjectFolderEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		p := req.(*CreateProjectLinkPayload)
+		p := req.(*DeleteProjectFolderPayload)
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
 		}
 		var token string
 		if p.BearerToken != nil {
@@ -295,20 +481,20 @@ func NewCreateProjectLinkEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa
 		if err != nil {
 			return nil, err
 		}
-		return s.CreateProjectLink(ctx, p)
+		return nil, s.DeleteProjectFolder(ctx, p)
 	}
 }
 
-// NewGetProjectLinkEndpoint returns an endpoint function that calls the method
-// "get-project-link" of service "project-service".
-func NewGetProjectLinkEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+// NewUploadProjectDocumentEndpoint returns an endpoint function that calls the
+// method "upload-project-document" of service "project-service".
+func NewUploadProjectDocumentEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		p := req.(*GetProjectLinkPayload)
+		p := req.(*UploadProjectDocumentPayload)
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
 		}
 		var token string
 		if p.BearerToken != nil {
@@ -318,20 +504,20 @@ func NewGetProjectLinkEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.En
 		if err != nil {
 			return nil, err
 		}
-		return s.GetProjectLink(ctx, p)
+		return s.UploadProjectDocument(ctx, p)
 	}
 }
 
-// NewDeleteProjectLinkEndpoint returns an endpoint function that calls the
-// method "delete-project-link" of service "project-service".
-func NewDeleteProjectLinkEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+// NewGetProjectDocumentEndpoint returns an endpoint function that calls the
+// method "get-project-document" of service "project-service".
+func NewGetProjectDocumentEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		p := req.(*DeleteProjectLinkPayload)
+		p := req.(*GetProjectDocumentPayload)
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
 		}
 		var token string
 		if p.BearerToken != nil {
@@ -341,20 +527,20 @@ func NewDeleteProjectLinkEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa
 		if err != nil {
 			return nil, err
 		}
-		return nil, s.DeleteProjectLink(ctx, p)
+		return s.GetProjectDocument(ctx, p)
 	}
 }
 
-// NewCreateProjectFolderEndpoint returns an endpoint function that calls the
-// method "create-project-folder" of service "project-service".
-func NewCreateProjectFolderEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+// NewDownloadProjectDocumentEndpoint returns an endpoint function that calls
+// the method "download-project-document" of service "project-service".
+func NewDownloadProjectDocumentEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		p := req.(*CreateProjectFolderPayload)
+		p := req.(*DownloadProjectDocumentPayload)
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
 		}
 		var token string
 		if p.BearerToken != nil {
@@ -364,20 +550,24 @@ func NewCreateProjectFolderEndpoint(s Service, authJWTFn security.AuthJWTFunc) g
 		if err != nil {
 			return nil, err
 		}
-		return s.CreateProjectFolder(ctx, p)
+		body, err := s.DownloadProjectDocument(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return &DownloadProjectDocumentResponseData{Body: body}, nil
 	}
 }
 
-// NewGetProjectFolderEndpoint returns an endpoint function that calls the
-// method "get-project-folder" of service "project-service".
-func NewGetProjectFolderEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+// NewDeleteProjectDocumentEndpoint returns an endpoint function that calls the
+// method "delete-project-document" of service "project-service".
+func NewDeleteProjectDocumentEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		p := req.(*GetProjectFolderPayload)
+		p := req.(*DeleteProjectDocumentPayload)
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
 		}
 		var token string
 		if p.BearerToken != nil {
@@ -387,20 +577,20 @@ func NewGetProjectFolderEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.
 		if err != nil {
 			return nil, err
 		}
-		return s.GetProjectFolder(ctx, p)
+		return nil, s.DeleteProjectDocument(ctx, p)
 	}
 }
 
-// NewDeleteProjectFolderEndpoint returns an endpoint function that calls the
-// method "delete-project-folder" of service "project-service".
-func NewDeleteProjectFolderEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+// NewUploadProjectCharterEndpoint returns an endpoint function that calls the
+// method "upload-project-charter" of service "project-service".
+func NewUploadProjectCharterEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		p := req.(*DeleteProjectFolderPayload)
+		p := req.(*UploadProjectCharterPayload)
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
 		}
 		var token string
 		if p.BearerToken != nil {
@@ -410,20 +600,20 @@ func NewDeleteProjectFolderEndpoint(s Service, authJWTFn security.AuthJWTFunc) g
 		if err != nil {
 			return nil, err
 		}
-		return nil, s.DeleteProjectFolder(ctx, p)
+		return s.UploadProjectCharter(ctx, p)
 	}
 }
 
-// NewUploadProjectDocumentEndpoint returns an endpoint function that calls the
-// method "upload-project-document" of service "project-service".
-func NewUploadProjectDocumentEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+// NewGetProjectCharterEndpoint returns an endpoint function that calls the
+// method "get-project-charter" of service "project-service".
+func NewGetProjectCharterEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		p := req.(*UploadProjectDocumentPayload)
+		p := req.(*GetProjectCharterPayload)
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
 		}
 		var token string
 		if p.BearerToken != nil {
@@ -433,20 +623,20 @@ func NewUploadProjectDocumentEndpoint(s Service, authJWTFn security.AuthJWTFunc)
 		if err != nil {
 			return nil, err
 		}
-		return s.UploadProjectDocument(ctx, p)
+		return s.GetProjectCharter(ctx, p)
 	}
 }
 
-// NewGetProjectDocumentEndpoint returns an endpoint function that calls the
-// method "get-project-document" of service "project-service".
-func NewGetProjectDocumentEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+// NewGetProjectAssociationsEndpoint returns an endpoint function that calls
+// the method "get-project-associations" of service "project-service".
+func NewGetProjectAssociationsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		p := req.(*GetProjectDocumentPayload)
+		p := req.(*GetProjectAssociationsPayload)
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
 		}
 		var token string
 		if p.BearerToken != nil {
@@ -456,20 +646,20 @@ func NewGetProjectDocumentEndpoint(s Service, authJWTFn security.AuthJWTFunc) go
 		if err != nil {
 			return nil, err
 		}
-		return s.GetProjectDocument(ctx, p)
+		return s.GetProjectAssociations(ctx, p)
 	}
 }
 
-// NewDownloadProjectDocumentEndpoint returns an endpoint function that calls
-// the method "download-project-document" of service "project-service".
-func NewDownloadProjectDocumentEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+// NewUpdateProjectAssociationsEndpoint returns an endpoint function that calls
+// the method "update-project-associations" of service "project-service".
+func NewUpdateProjectAssociationsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		p := req.(*DownloadProjectDocumentPayload)
+		p := req.(*UpdateProjectAssociationsPayload)
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
 		}
 		var token string
 		if p.BearerToken != nil {
@@ -479,24 +669,43 @@ func NewDownloadProjectDocumentEndpoint(s Service, authJWTFn security.AuthJWTFun
 		if err != nil {
 			return nil, err
 		}
-		body, err := s.DownloadProjectDocument(ctx, p)
+		return s.UpdateProjectAssociations(ctx, p)
+	}
+}
+
+// NewGetProjectSettingsDiffEndpoint returns an endpoint function that calls
+// the method "get-project-settings-diff" of service "project-service".
+func NewGetProjectSettingsDiffEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetProjectSettingsDiffPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
 		if err != nil {
 			return nil, err
 		}
-		return &DownloadProjectDocumentResponseData{Body: body}, nil
+		return s.GetProjectSettingsDiff(ctx, p)
 	}
 }
 
-// NewDeleteProjectDocumentEndpoint returns an endpoint function that calls the
-// method "delete-project-document" of service "project-service".
-func NewDeleteProjectDocumentEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+// NewGetProjectChildrenEndpoint returns an endpoint function that calls the
+// method "get-project-children" of service "project-service".
+func NewGetProjectChildrenEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		p := req.(*DeleteProjectDocumentPayload)
+		p := req.(*GetProjectChildrenPayload)
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
 		}
 		var token string
 		if p.BearerToken != nil {
@@ -506,6 +715,1135 @@ func NewDeleteProjectDocumentEndpoint(s Service, authJWTFn security.AuthJWTFunc)
 		if err != nil {
 			return nil, err
 		}
-		return nil, s.DeleteProjectDocument(ctx, p)
+		return s.GetProjectChildren(ctx, p)
+	}
+}
+
+// NewGetProjectDescendantsEndpoint returns an endpoint function that calls the
+// method "get-project-descendants" of service "project-service".
+func NewGetProjectDescendantsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetProjectDescendantsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetProjectDescendants(ctx, p)
+	}
+}
+
+// NewGetProjectLegalLineageEndpoint returns an endpoint function that calls
+// the method "get-project-legal-lineage" of service "project-service".
+func NewGetProjectLegalLineageEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetProjectLegalLineagePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetProjectLegalLineage(ctx, p)
+	}
+}
+
+// NewSearchProjectsEndpoint returns an endpoint function that calls the method
+// "search-projects" of service "project-service".
+func NewSearchProjectsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SearchProjectsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.SearchProjects(ctx, p)
+	}
+}
+
+// NewAutocompleteProjectsEndpoint returns an endpoint function that calls the
+// method "autocomplete-projects" of service "project-service".
+func NewAutocompleteProjectsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AutocompleteProjectsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.AutocompleteProjects(ctx, p)
+	}
+}
+
+// NewAddProjectWriterEndpoint returns an endpoint function that calls the
+// method "add-project-writer" of service "project-service".
+func NewAddProjectWriterEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AddProjectWriterPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.AddProjectWriter(ctx, p)
+	}
+}
+
+// NewRemoveProjectWriterEndpoint returns an endpoint function that calls the
+// method "remove-project-writer" of service "project-service".
+func NewRemoveProjectWriterEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RemoveProjectWriterPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.RemoveProjectWriter(ctx, p)
+	}
+}
+
+// NewAddProjectAuditorEndpoint returns an endpoint function that calls the
+// method "add-project-auditor" of service "project-service".
+func NewAddProjectAuditorEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AddProjectAuditorPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.AddProjectAuditor(ctx, p)
+	}
+}
+
+// NewRemoveProjectAuditorEndpoint returns an endpoint function that calls the
+// method "remove-project-auditor" of service "project-service".
+func NewRemoveProjectAuditorEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RemoveProjectAuditorPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.RemoveProjectAuditor(ctx, p)
+	}
+}
+
+// NewJoinProjectEndpoint returns an endpoint function that calls the method
+// "join-project" of service "project-service".
+func NewJoinProjectEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*JoinProjectPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.JoinProject(ctx, p)
+	}
+}
+
+// NewBulkUpdateProjectMembersEndpoint returns an endpoint function that calls
+// the method "bulk-update-project-members" of service "project-service".
+func NewBulkUpdateProjectMembersEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*BulkUpdateProjectMembersPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.BulkUpdateProjectMembers(ctx, p)
+	}
+}
+
+// NewGetProjectsEndpoint returns an endpoint function that calls the method
+// "get-projects" of service "project-service".
+func NewGetProjectsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetProjectsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetProjects(ctx, p)
+	}
+}
+
+// NewCreateProjectEndpoint returns an endpoint function that calls the method
+// "create-project" of service "project-service".
+func NewCreateProjectEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateProjectPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CreateProject(ctx, p)
+	}
+}
+
+// NewGetOneProjectBaseEndpoint returns an endpoint function that calls the
+// method "get-one-project-base" of service "project-service".
+func NewGetOneProjectBaseEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetOneProjectBasePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetOneProjectBase(ctx, p)
+	}
+}
+
+// NewGetProjectUIDFromLegacyIDEndpoint returns an endpoint function that calls
+// the method "get-project-uid-from-legacy-id" of service "project-service".
+func NewGetProjectUIDFromLegacyIDEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetProjectUIDFromLegacyIDPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetProjectUIDFromLegacyID(ctx, p)
+	}
+}
+
+// NewGetOneProjectSettingsEndpoint returns an endpoint function that calls the
+// method "get-one-project-settings" of service "project-service".
+func NewGetOneProjectSettingsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetOneProjectSettingsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetOneProjectSettings(ctx, p)
+	}
+}
+
+// NewUpdateProjectBaseEndpoint returns an endpoint function that calls the
+// method "update-project-base" of service "project-service".
+func NewUpdateProjectBaseEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateProjectBasePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.UpdateProjectBase(ctx, p)
+	}
+}
+
+// NewUpdateProjectSettingsEndpoint returns an endpoint function that calls the
+// method "update-project-settings" of service "project-service".
+func NewUpdateProjectSettingsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateProjectSettingsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.UpdateProjectSettings(ctx, p)
+	}
+}
+
+// NewDeleteProjectEndpoint returns an endpoint function that calls the method
+// "delete-project" of service "project-service".
+func NewDeleteProjectEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteProjectPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.DeleteProject(ctx, p)
+	}
+}
+
+// NewBulkDeleteProjectsEndpoint returns an endpoint function that calls the
+// method "bulk-delete-projects" of service "project-service".
+func NewBulkDeleteProjectsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*BulkDeleteProjectsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.BulkDeleteProjects(ctx, p)
+	}
+}
+
+// NewReadyzEndpoint returns an endpoint function that calls the method
+// "readyz" of service "project-service".
+func NewReadyzEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.Readyz(ctx)
+	}
+}
+
+// NewLivezEndpoint returns an endpoint function that calls the method "livez"
+// of service "project-service".
+func NewLivezEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.Livez(ctx)
+	}
+}
+
+// NewHealthzEndpoint returns an endpoint function that calls the method
+// "healthz" of service "project-service".
+func NewHealthzEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.Healthz(ctx)
+	}
+}
+
+// NewReconcileOutboxEndpoint returns an endpoint function that calls the
+// method "reconcile-outbox" of service "project-service".
+func NewReconcileOutboxEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ReconcileOutboxPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ReconcileOutbox(ctx, p)
+	}
+}
+
+// NewResyncProjectsEndpoint returns an endpoint function that calls the method
+// "resync-projects" of service "project-service".
+func NewResyncProjectsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ResyncProjectsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ResyncProjects(ctx, p)
+	}
+}
+
+// NewListDeadLettersEndpoint returns an endpoint function that calls the
+// method "list-dead-letters" of service "project-service".
+func NewListDeadLettersEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListDeadLettersPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListDeadLetters(ctx, p)
+	}
+}
+
+// NewReplayDeadLetterEndpoint returns an endpoint function that calls the
+// method "replay-dead-letter" of service "project-service".
+func NewReplayDeadLetterEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ReplayDeadLetterPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ReplayDeadLetter(ctx, p)
+	}
+}
+
+// NewRebuildSlugMappingsEndpoint returns an endpoint function that calls the
+// method "rebuild-slug-mappings" of service "project-service".
+func NewRebuildSlugMappingsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RebuildSlugMappingsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.RebuildSlugMappings(ctx, p)
+	}
+}
+
+// NewGetIntegrityReportEndpoint returns an endpoint function that calls the
+// method "get-integrity-report" of service "project-service".
+func NewGetIntegrityReportEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetIntegrityReportPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetIntegrityReport(ctx, p)
+	}
+}
+
+// NewInspectProjectKvEndpoint returns an endpoint function that calls the
+// method "inspect-project-kv" of service "project-service".
+func NewInspectProjectKvEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*InspectProjectKvPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.InspectProjectKv(ctx, p)
+	}
+}
+
+// NewCreateBackupEndpoint returns an endpoint function that calls the method
+// "create-backup" of service "project-service".
+func NewCreateBackupEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateBackupPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CreateBackup(ctx, p)
+	}
+}
+
+// NewListBackupsEndpoint returns an endpoint function that calls the method
+// "list-backups" of service "project-service".
+func NewListBackupsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListBackupsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListBackups(ctx, p)
+	}
+}
+
+// NewRestoreBackupEndpoint returns an endpoint function that calls the method
+// "restore-backup" of service "project-service".
+func NewRestoreBackupEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RestoreBackupPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.RestoreBackup(ctx, p)
+	}
+}
+
+// NewListPendingAnnouncementsEndpoint returns an endpoint function that calls
+// the method "list-pending-announcements" of service "project-service".
+func NewListPendingAnnouncementsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListPendingAnnouncementsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListPendingAnnouncements(ctx, p)
+	}
+}
+
+// NewGetProjectEnumsEndpoint returns an endpoint function that calls the
+// method "get-project-enums" of service "project-service".
+func NewGetProjectEnumsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetProjectEnumsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetProjectEnums(ctx, p)
+	}
+}
+
+// NewUpdateProjectEnumsEndpoint returns an endpoint function that calls the
+// method "update-project-enums" of service "project-service".
+func NewUpdateProjectEnumsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateProjectEnumsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.UpdateProjectEnums(ctx, p)
+	}
+}
+
+// NewCreateProjectTemplateEndpoint returns an endpoint function that calls the
+// method "create-project-template" of service "project-service".
+func NewCreateProjectTemplateEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateProjectTemplatePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CreateProjectTemplate(ctx, p)
+	}
+}
+
+// NewListProjectTemplatesEndpoint returns an endpoint function that calls the
+// method "list-project-templates" of service "project-service".
+func NewListProjectTemplatesEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListProjectTemplatesPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListProjectTemplates(ctx, p)
+	}
+}
+
+// NewGetProjectTemplateEndpoint returns an endpoint function that calls the
+// method "get-project-template" of service "project-service".
+func NewGetProjectTemplateEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetProjectTemplatePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetProjectTemplate(ctx, p)
+	}
+}
+
+// NewUpdateProjectTemplateEndpoint returns an endpoint function that calls the
+// method "update-project-template" of service "project-service".
+func NewUpdateProjectTemplateEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateProjectTemplatePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.UpdateProjectTemplate(ctx, p)
+	}
+}
+
+// NewDeleteProjectTemplateEndpoint returns an endpoint function that calls the
+// method "delete-project-template" of service "project-service".
+func NewDeleteProjectTemplateEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteProjectTemplatePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.DeleteProjectTemplate(ctx, p)
+	}
+}
+
+// NewListProjectDeletionsEndpoint returns an endpoint function that calls the
+// method "list-project-deletions" of service "project-service".
+func NewListProjectDeletionsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListProjectDeletionsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListProjectDeletions(ctx, p)
+	}
+}
+
+// NewListProjectEventsEndpoint returns an endpoint function that calls the
+// method "list-project-events" of service "project-service".
+func NewListProjectEventsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListProjectEventsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListProjectEvents(ctx, p)
+	}
+}
+
+// NewGetUserProjectsEndpoint returns an endpoint function that calls the
+// method "get-user-projects" of service "project-service".
+func NewGetUserProjectsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetUserProjectsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetUserProjects(ctx, p)
+	}
+}
+
+// NewExportUserDataEndpoint returns an endpoint function that calls the method
+// "export-user-data" of service "project-service".
+func NewExportUserDataEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ExportUserDataPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ExportUserData(ctx, p)
+	}
+}
+
+// NewRemoveUserAccessEndpoint returns an endpoint function that calls the
+// method "remove-user-access" of service "project-service".
+func NewRemoveUserAccessEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RemoveUserAccessPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.RemoveUserAccess(ctx, p)
+	}
+}
+
+// NewGetProjectVisibilityImpactEndpoint returns an endpoint function that
+// calls the method "get-project-visibility-impact" of service
+// "project-service".
+func NewGetProjectVisibilityImpactEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetProjectVisibilityImpactPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetProjectVisibilityImpact(ctx, p)
+	}
+}
+
+// NewCreateWebhookEndpoint returns an endpoint function that calls the method
+// "create-webhook" of service "project-service".
+func NewCreateWebhookEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateWebhookPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CreateWebhook(ctx, p)
+	}
+}
+
+// NewListWebhooksEndpoint returns an endpoint function that calls the method
+// "list-webhooks" of service "project-service".
+func NewListWebhooksEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListWebhooksPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListWebhooks(ctx, p)
+	}
+}
+
+// NewGetWebhookEndpoint returns an endpoint function that calls the method
+// "get-webhook" of service "project-service".
+func NewGetWebhookEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetWebhookPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetWebhook(ctx, p)
+	}
+}
+
+// NewDeleteWebhookEndpoint returns an endpoint function that calls the method
+// "delete-webhook" of service "project-service".
+func NewDeleteWebhookEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteWebhookPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:write"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.DeleteWebhook(ctx, p)
+	}
+}
+
+// NewListWebhookDeliveriesEndpoint returns an endpoint function that calls the
+// method "list-webhook-deliveries" of service "project-service".
+func NewListWebhookDeliveriesEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListWebhookDeliveriesPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"projects:read", "projects:write"},
+			RequiredScopes: []string{"projects:read"},
+		}
+		var token string
+		if p.BearerToken != nil {
+			token = *p.BearerToken
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListWebhookDeliveries(ctx, p)
 	}
 }
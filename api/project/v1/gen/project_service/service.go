@@ -18,24 +18,26 @@ import (
 
 // The project service provides LFX Project resources.
 type Service interface {
-	// Get all projects.
-	GetProjects(context.Context, *GetProjectsPayload) (res *GetProjectsResult, err error)
-	// Create a new project.
-	CreateProject(context.Context, *CreateProjectPayload) (res *ProjectFull, err error)
-	// Get a single project's base information.
-	GetOneProjectBase(context.Context, *GetOneProjectBasePayload) (res *GetOneProjectBaseResult, err error)
-	// Get a single project's settings.
-	GetOneProjectSettings(context.Context, *GetOneProjectSettingsPayload) (res *GetOneProjectSettingsResult, err error)
-	// Update an existing project's base information.
-	UpdateProjectBase(context.Context, *UpdateProjectBasePayload) (res *ProjectBase, err error)
-	// Update an existing project's settings.
-	UpdateProjectSettings(context.Context, *UpdateProjectSettingsPayload) (res *ProjectSettings, err error)
-	// Delete an existing project.
-	DeleteProject(context.Context, *DeleteProjectPayload) (err error)
-	// Check if the service is able to take inbound requests.
-	Readyz(context.Context) (res []byte, err error)
-	// Check if the service is alive.
-	Livez(context.Context) (res []byte, err error)
+	// Create a new project with a copy of an existing project's base and settings.
+	// The copy gets a new UID, the given slug and new timestamps; its legacy ID
+	// and announcement date are cleared. Fields set in the body override the
+	// copied values.
+	CloneProject(context.Context, *CloneProjectPayload) (res *ProjectFull, err error)
+	// Upload a project logo (multipart/form-data). The SVG or PNG file is stored
+	// in S3 and the project's logo_url is set to it; SVG logos are also converted
+	// to PNG.
+	UploadProjectLogo(context.Context, *UploadProjectLogoPayload) (res *ProjectBase, err error)
+	// Merge a project into another one, such as a duplicate created by an import.
+	// The children of the project are moved under the target, its writers and
+	// auditors are added to the target's, its slug redirects to the target, and
+	// the project is deleted. Returns the target project.
+	MergeProject(context.Context, *MergeProjectPayload) (res *ProjectFull, err error)
+	// Transfer a project to new owners, such as when it moves to another
+	// foundation. The project's writers are replaced by the given writers in a
+	// single settings update and, when parent_uid is set, the project is moved
+	// under that parent. The transfer is audited and a project.transferred event
+	// names the previous writers. Returns the transferred project.
+	TransferProject(context.Context, *TransferProjectPayload) (res *ProjectFull, err error)
 	// Create a new link for a project.
 	CreateProjectLink(context.Context, *CreateProjectLinkPayload) (res *ProjectLink, err error)
 	// Get a single project link.
@@ -60,6 +62,199 @@ type Service interface {
 	DownloadProjectDocument(context.Context, *DownloadProjectDocumentPayload) (body io.ReadCloser, err error)
 	// Delete a project document.
 	DeleteProjectDocument(context.Context, *DeleteProjectDocumentPayload) (err error)
+	// Upload a new version of a project's charter (multipart/form-data). The PDF
+	// is stored in S3, added to the charter's version history, and the project's
+	// charter_url is set to it.
+	UploadProjectCharter(context.Context, *UploadProjectCharterPayload) (res *ProjectCharter, err error)
+	// Get a project's charter and its version history.
+	GetProjectCharter(context.Context, *GetProjectCharterPayload) (res *ProjectCharter, err error)
+	// Get the committees, mailing lists and meeting series associated with a
+	// project.
+	GetProjectAssociations(context.Context, *GetProjectAssociationsPayload) (res *ProjectAssociations, err error)
+	// Replace the committees, mailing lists and meeting series associated with a
+	// project. Each newly added reference must exist in the service that owns it.
+	UpdateProjectAssociations(context.Context, *UpdateProjectAssociationsPayload) (res *ProjectAssociations, err error)
+	// Get the field-by-field difference between two revisions of a project's
+	// settings, such as the writers added and removed. Revisions are the settings
+	// ETags; only revisions still retained in the settings history are available.
+	GetProjectSettingsDiff(context.Context, *GetProjectSettingsDiffPayload) (res *ProjectSettingsDiff, err error)
+	// List the direct children of a project one page at a time. Children the
+	// principal cannot view are left out.
+	GetProjectChildren(context.Context, *GetProjectChildrenPayload) (res *ProjectPage, err error)
+	// List every project under a project, at any depth, one page at a time.
+	// Descendants the principal cannot view are left out.
+	GetProjectDescendants(context.Context, *GetProjectDescendantsPayload) (res *ProjectPage, err error)
+	// Get the chain of legal parents of a project for compliance reporting: its
+	// legal parent, that project's legal parent, and so on up to a project without
+	// one.
+	GetProjectLegalLineage(context.Context, *GetProjectLegalLineagePayload) (res *ProjectLegalLineage, err error)
+	// Search projects by name, slug and description, best match first. The search
+	// index is used when it is configured and available; otherwise every project
+	// is scanned. Projects the principal cannot view are left out, so fewer than
+	// limit projects may be returned.
+	SearchProjects(context.Context, *SearchProjectsPayload) (res *SearchProjectsResult, err error)
+	// Suggest projects whose name, slug, or a word of them, starts with a prefix,
+	// for typeahead. Exact matches come first, then names and slugs starting with
+	// the prefix, then words starting with it. Projects the principal cannot view
+	// are left out.
+	AutocompleteProjects(context.Context, *AutocompleteProjectsPayload) (res *AutocompleteProjectsResult, err error)
+	// Add a user to the project's writers. Only that user is changed, so
+	// concurrent additions do not overwrite each other. Adding a user who is
+	// already one of the writers changes nothing. With If-Match, the user is only
+	// added if the settings are still at that revision.
+	AddProjectWriter(context.Context, *AddProjectWriterPayload) (res *ProjectSettings, err error)
+	// Remove a user from the project's writers. Only that user is changed, so
+	// concurrent removals do not overwrite each other. With If-Match, the user is
+	// only removed if the settings are still at that revision.
+	RemoveProjectWriter(context.Context, *RemoveProjectWriterPayload) (err error)
+	// Add a user to the project's auditors. Only that user is changed, so
+	// concurrent additions do not overwrite each other. Adding a user who is
+	// already one of the auditors changes nothing. With If-Match, the user is only
+	// added if the settings are still at that revision.
+	AddProjectAuditor(context.Context, *AddProjectAuditorPayload) (res *ProjectSettings, err error)
+	// Remove a user from the project's auditors. Only that user is changed, so
+	// concurrent removals do not overwrite each other. With If-Match, the user is
+	// only removed if the settings are still at that revision.
+	RemoveProjectAuditor(context.Context, *RemoveProjectAuditorPayload) (err error)
+	// Join a project that has autojoin enabled. The requesting user is added to
+	// the project's member role configured for autojoin, the auditors by default.
+	// Joining a project the user already holds that role in changes nothing.
+	JoinProject(context.Context, *JoinProjectPayload) (res *ProjectJoinResult, err error)
+	// Add users to and remove users from the writers and auditors of several
+	// projects, for LF staff on-boarding or off-boarding a user across projects.
+	// Each project is updated on its own and reported with its outcome; a project
+	// that fails does not stop the others.
+	BulkUpdateProjectMembers(context.Context, *BulkUpdateProjectMembersPayload) (res *BulkProjectMembersResult, err error)
+	// Get all projects. With v=2, the settings of each project are nested under
+	// settings instead of merged into the project.
+	GetProjects(context.Context, *GetProjectsPayload) (res *GetProjectsResult, err error)
+	// Create a new project.
+	CreateProject(context.Context, *CreateProjectPayload) (res *ProjectFull, err error)
+	// Get a single project's base information. With expand=settings, the project's
+	// settings are merged into it, as in get-projects, and their ETag is returned
+	// in X-Settings-ETag.
+	GetOneProjectBase(context.Context, *GetOneProjectBasePayload) (res *GetOneProjectBaseResult, err error)
+	// Resolve a project's LFX v1 ID to its v2 UID.
+	GetProjectUIDFromLegacyID(context.Context, *GetProjectUIDFromLegacyIDPayload) (res *GetProjectUIDFromLegacyIDResult, err error)
+	// Get a single project's settings.
+	GetOneProjectSettings(context.Context, *GetOneProjectSettingsPayload) (res *GetOneProjectSettingsResult, err error)
+	// Update an existing project's base information.
+	UpdateProjectBase(context.Context, *UpdateProjectBasePayload) (res *ProjectBase, err error)
+	// Update an existing project's settings. With X-Merge, an update whose
+	// If-Match revision is no longer current is merged with the changes made since
+	// that revision when they change other fields or other users of the role lists.
+	UpdateProjectSettings(context.Context, *UpdateProjectSettingsPayload) (res *ProjectSettings, err error)
+	// Delete an existing project.
+	DeleteProject(context.Context, *DeleteProjectPayload) (err error)
+	// Delete several projects at once, such as the test data of a load, for LF
+	// staff. The whole set is checked before anything is deleted: a project with
+	// children outside the set, or whose funding model is not Crowdfunding only,
+	// blocks the deletion. With cascade, the descendants of the projects are
+	// deleted too; with force, the funding model is not checked; with dry_run,
+	// nothing is deleted. Projects are deleted children first and reported in that
+	// order.
+	BulkDeleteProjects(context.Context, *BulkDeleteProjectsPayload) (res *BulkProjectDeletionResult, err error)
+	// Check if the service is able to take inbound requests.
+	Readyz(context.Context) (res []byte, err error)
+	// Check if the service is alive.
+	Livez(context.Context) (res []byte, err error)
+	// Report the health of each dependency of the service.
+	Healthz(context.Context) (res *HealthReport, err error)
+	// Publish every pending outbox message now, ignoring retry backoff, for LF
+	// staff.
+	ReconcileOutbox(context.Context, *ReconcileOutboxPayload) (res *OutboxReconcileResult, err error)
+	// Re-publish indexer and update_access messages for every project matching the
+	// filter, for LF staff.
+	ResyncProjects(context.Context, *ResyncProjectsPayload) (res *ResyncProjectsResult, err error)
+	// List the NATS events whose handler failed and that are waiting to be
+	// replayed, for LF staff.
+	ListDeadLetters(context.Context, *ListDeadLettersPayload) (res *DeadLetterList, err error)
+	// Hand a dead-lettered NATS event back to its handler, removing it if the
+	// handler succeeds, for LF staff.
+	ReplayDeadLetter(context.Context, *ReplayDeadLetterPayload) (res *ReplayDeadLetterResult, err error)
+	// Rebuild the slug to UID mappings from the project documents, reporting the
+	// mappings no project holds, for LF staff.
+	RebuildSlugMappings(context.Context, *RebuildSlugMappingsPayload) (res *SlugRebuildResult, err error)
+	// Get the inconsistencies found in the project store by the last integrity
+	// scan, scanning the store when it was not scanned yet, for LF staff.
+	GetIntegrityReport(context.Context, *GetIntegrityReportPayload) (res *IntegrityReport, err error)
+	// Get the entries stored for a project's base and settings in the NATS KV
+	// buckets as they are, with their current revision and retained history, for
+	// LF staff debugging stale documents.
+	InspectProjectKv(context.Context, *InspectProjectKvPayload) (res *ProjectKVInspection, err error)
+	// Save every entry of the projects and project-settings stores, including slug
+	// mappings, to a new backup archive, for LF staff.
+	CreateBackup(context.Context, *CreateBackupPayload) (res *BackupResult, err error)
+	// List the stored backup archives, for LF staff.
+	ListBackups(context.Context, *ListBackupsPayload) (res *BackupList, err error)
+	// Write the entries of a backup archive back to the project stores, for every
+	// project or only the given ones, for LF staff.
+	RestoreBackup(context.Context, *RestoreBackupPayload) (res *RestoreBackupResult, err error)
+	// List the projects whose announcement date is set and whose announcement has
+	// not been published yet, for LF staff.
+	ListPendingAnnouncements(context.Context, *ListPendingAnnouncementsPayload) (res *PendingAnnouncementList, err error)
+	// Get the values currently allowed for the enumerated project fields, stage
+	// and category. Admins change them without a release of the service.
+	GetProjectEnums(context.Context, *GetProjectEnumsPayload) (res *ProjectEnums, err error)
+	// Replace the values allowed for the enumerated project fields, for LF staff.
+	// Projects that already have a value no longer allowed keep it until the field
+	// is changed.
+	UpdateProjectEnums(context.Context, *UpdateProjectEnumsPayload) (res *ProjectEnums, err error)
+	// Define a template that projects can be created from with POST
+	// /projects?template=<name>, for LF staff.
+	CreateProjectTemplate(context.Context, *CreateProjectTemplatePayload) (res *ProjectTemplate, err error)
+	// List the project templates, sorted by name, for LF staff.
+	ListProjectTemplates(context.Context, *ListProjectTemplatesPayload) (res *ProjectTemplateList, err error)
+	// Get a project template, for LF staff.
+	GetProjectTemplate(context.Context, *GetProjectTemplatePayload) (res *ProjectTemplate, err error)
+	// Replace the defaults of a project template, for LF staff. Projects already
+	// created from it are not changed.
+	UpdateProjectTemplate(context.Context, *UpdateProjectTemplatePayload) (res *ProjectTemplate, err error)
+	// Remove a project template, for LF staff. Projects already created from it
+	// are not changed.
+	DeleteProjectTemplate(context.Context, *DeleteProjectTemplatePayload) (err error)
+	// List the tombstones of deleted projects, oldest first, so that downstream
+	// caches can tell a deleted project from one that never existed. Tombstones
+	// are kept for the retention of the deletions bucket. Only LF staff and
+	// trusted service principals may list them.
+	ListProjectDeletions(context.Context, *ListProjectDeletionsPayload) (res *ProjectDeletionList, err error)
+	// List the lifecycle events of a project, oldest first, reconstructed from the
+	// revisions of its base and settings still retained in the project store, so
+	// that a new downstream consumer can backfill its state. Only project.created,
+	// project.updated, project.deleted and project.settings.updated events are
+	// reconstructed, without their actor.
+	ListProjectEvents(context.Context, *ListProjectEventsPayload) (res *ProjectEventList, err error)
+	// List the projects in which a user is a writer, auditor or meeting
+	// coordinator. Users may only list their own projects, unless they are LF
+	// staff.
+	GetUserProjects(context.Context, *GetUserProjectsPayload) (res *GetUserProjectsResult, err error)
+	// Export everything the service stores about a user, for data-subject access
+	// requests: each writer, auditor, meeting coordinator and contact role the
+	// user holds, with the name, email and avatar stored with it and when the user
+	// was given the role. Users may only export their own data, unless they are LF
+	// staff.
+	ExportUserData(context.Context, *ExportUserDataPayload) (res *UserDataExport, err error)
+	// Remove a user from the writers, auditors and meeting coordinators of every
+	// project, for employee departures and erasure requests. Only LF staff may
+	// remove a user's access. Each project is updated on its own and reported with
+	// its outcome; a project that fails does not stop the others.
+	RemoveUserAccess(context.Context, *RemoveUserAccessPayload) (res *UserAccessRemovalResult, err error)
+	// Preview what flipping a project's public flag would affect: its descendants,
+	// its URLs pointing outside the service, and the associated resources and
+	// webhooks that follow it. The project is not changed.
+	GetProjectVisibilityImpact(context.Context, *GetProjectVisibilityImpactPayload) (res *ProjectVisibilityImpact, err error)
+	// Register an HTTPS endpoint that receives signed POSTs for project lifecycle
+	// events, for LF staff. The signing secret is only returned by this call.
+	CreateWebhook(context.Context, *CreateWebhookPayload) (res *Webhook, err error)
+	// List the registered webhooks, without their signing secrets.
+	ListWebhooks(context.Context, *ListWebhooksPayload) (res *WebhookList, err error)
+	// Get a registered webhook, without its signing secret.
+	GetWebhook(context.Context, *GetWebhookPayload) (res *Webhook, err error)
+	// Remove a webhook along with its delivery history. Pending retries are
+	// dropped.
+	DeleteWebhook(context.Context, *DeleteWebhookPayload) (err error)
+	// List the events sent, or still being retried, to a webhook, newest first.
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesPayload) (res *WebhookDeliveryList, err error)
 }
 
 // Auther defines the authorization functions to be implemented by the service.
@@ -82,20 +277,235 @@ const ServiceName = "project-service"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [19]string{"get-projects", "create-project", "get-one-project-base", "get-one-project-settings", "update-project-base", "update-project-settings", "delete-project", "readyz", "livez", "create-project-link", "get-project-link", "delete-project-link", "create-project-folder", "get-project-folder", "delete-project-folder", "upload-project-document", "get-project-document", "download-project-document", "delete-project-document"}
+var MethodNames = [71]string{"clone-project", "upload-project-logo", "merge-project", "transfer-project", "create-project-link", "get-project-link", "delete-project-link", "create-project-folder", "get-project-folder", "delete-project-folder", "upload-project-document", "get-project-document", "download-project-document", "delete-project-document", "upload-project-charter", "get-project-charter", "get-project-associations", "update-project-associations", "get-project-settings-diff", "get-project-children", "get-project-descendants", "get-project-legal-lineage", "search-projects", "autocomplete-projects", "add-project-writer", "remove-project-writer", "add-project-auditor", "remove-project-auditor", "join-project", "bulk-update-project-members", "get-projects", "create-project", "get-one-project-base", "get-project-uid-from-legacy-id", "get-one-project-settings", "update-project-base", "update-project-settings", "delete-project", "bulk-delete-projects", "readyz", "livez", "healthz", "reconcile-outbox", "resync-projects", "list-dead-letters", "replay-dead-letter", "rebuild-slug-mappings", "get-integrity-report", "inspect-project-kv", "create-backup", "list-backups", "restore-backup", "list-pending-announcements", "get-project-enums", "update-project-enums", "create-project-template", "list-project-templates", "get-project-template", "update-project-template", "delete-project-template", "list-project-deletions", "list-project-events", "get-user-projects", "export-user-data", "remove-user-access", "get-project-visibility-impact", "create-webhook", "list-webhooks", "get-webhook", "delete-webhook", "list-webhook-deliveries"}
+
+// AddProjectAuditorPayload is the payload type of the project-service service
+// add-project-auditor method.
+type AddProjectAuditorPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// X-Sync header value for performing operations synchronously
+	XSync *bool
+	// If-Match header value for conditional requests: the ETag of the resource,
+	// which may be sent quoted or as a weak ETag such as W/"123"
+	IfMatch *string
+	// Version of the API
+	Version *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+	// The LFID username of the user
+	Username string
+}
+
+// AddProjectWriterPayload is the payload type of the project-service service
+// add-project-writer method.
+type AddProjectWriterPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// X-Sync header value for performing operations synchronously
+	XSync *bool
+	// If-Match header value for conditional requests: the ETag of the resource,
+	// which may be sent quoted or as a weak ETag such as W/"123"
+	IfMatch *string
+	// Version of the API
+	Version *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+	// The LFID username of the user
+	Username string
+}
+
+// AutocompleteProjectsPayload is the payload type of the project-service
+// service autocomplete-projects method.
+type AutocompleteProjectsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// Prefix typed by the user
+	Q string
+	// Maximum number of projects to return
+	Limit int
+}
+
+// AutocompleteProjectsResult is the result type of the project-service service
+// autocomplete-projects method.
+type AutocompleteProjectsResult struct {
+	// Matching projects, best match first
+	Projects []*ProjectSuggestion
+}
+
+// A stored backup archive.
+type Backup struct {
+	// Backup name
+	Name string
+	// Archive size in bytes
+	Size int64
+	// When the archive was stored
+	CreatedAt string
+}
+
+// BackupList is the result type of the project-service service list-backups
+// method.
+type BackupList struct {
+	// Backup archives
+	Backups []*Backup
+}
+
+// BackupResult is the result type of the project-service service create-backup
+// method.
+type BackupResult struct {
+	// Backup name
+	Name string
+	// Entries saved, including slug and legacy ID mappings
+	Entries int
+	// Projects saved
+	Projects int
+}
 
 type BadRequestError struct {
+	// URI identifying the problem type; the same for every occurrence of the
+	// problem
+	Type *string
+	// Short summary of the problem type
+	Title *string
 	// HTTP status code
+	Status *int
+	// Explanation of this occurrence of the problem
+	Detail *string
+	// Path of the request that failed
+	Instance *string
+	// ID of the request that failed, as in the X-REQUEST-ID response header
+	RequestID *string
+	// Fields of the request that caused the problem
+	FieldErrors []*FieldError
+	// HTTP status code; deprecated, use status
 	Code string
-	// Error message
+	// Error message; deprecated, use detail
 	Message string
+	// Fields that failed validation; deprecated, use field_errors
+	Fields []*FieldError
+}
+
+// BulkDeleteProjectsPayload is the payload type of the project-service service
+// bulk-delete-projects method.
+type BulkDeleteProjectsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// X-Sync header value for performing operations synchronously
+	XSync *bool
+	// Version of the API
+	Version *string
+	// UIDs of the projects to delete
+	Uids []string
+	// Report what would be deleted without deleting anything
+	DryRun bool
+	// Also delete every project under the given projects
+	Cascade bool
+	// Delete projects whose funding model is not Crowdfunding only
+	Force bool
+}
+
+// BulkProjectDeletionResult is the result type of the project-service service
+// bulk-delete-projects method.
+type BulkProjectDeletionResult struct {
+	// Outcome of each project
+	Results []*ProjectDeletionOutcome
+	// Projects deleted, or that would be deleted by a dry run
+	Deleted int
+	// Projects that were not found, blocked the deletion or failed
+	Failed int
+	// Whether nothing was deleted because of dry_run
+	DryRun bool
+}
+
+// BulkProjectMembersResult is the result type of the project-service service
+// bulk-update-project-members method.
+type BulkProjectMembersResult struct {
+	// Outcome of each operation
+	Results []*ProjectMembersOperationResult
+	// Projects updated
+	Updated int
+	// Projects that were not found or failed
+	Failed int
+}
+
+// BulkUpdateProjectMembersPayload is the payload type of the project-service
+// service bulk-update-project-members method.
+type BulkUpdateProjectMembersPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// X-Sync header value for performing operations synchronously
+	XSync *bool
+	// Version of the API
+	Version *string
+	// The changes to apply, at most one per project
+	Operations []*ProjectMembersOperation
+}
+
+// CloneProjectPayload is the payload type of the project-service service
+// clone-project method.
+type CloneProjectPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// X-Sync header value for performing operations synchronously
+	XSync *bool
+	// Version of the API
+	Version *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+	// Project slug, a short slugified name of the project
+	Slug string
+	// The pretty name of the project
+	Name *string
+	// A description of the project
+	Description *string
+	// Whether the project is public
+	Public *bool
+	// The UID of the parent project, required and must be a valid UUID
+	ParentUID *string
+	// The stage of the project, one of the stages listed by GET /metadata/enums
+	Stage *string
+	// The category of the project, one of the categories listed by GET
+	// /metadata/enums
+	Category *string
+	// The mission statement of the project
+	MissionStatement *string
 }
 
 type ConflictError struct {
+	// URI identifying the problem type; the same for every occurrence of the
+	// problem
+	Type *string
+	// Short summary of the problem type
+	Title *string
 	// HTTP status code
+	Status *int
+	// Explanation of this occurrence of the problem
+	Detail *string
+	// Path of the request that failed
+	Instance *string
+	// ID of the request that failed, as in the X-REQUEST-ID response header
+	RequestID *string
+	// Fields of the request that caused the problem
+	FieldErrors []*FieldError
+	// HTTP status code; deprecated, use status
 	Code string
-	// Error message
+	// Error message; deprecated, use detail
 	Message string
+	// Existing projects the new project likely duplicates, when it was rejected as
+	// a duplicate
+	Duplicates []*DuplicateProject
+	// Settings fields that both the request and a concurrent update changed, when
+	// a merged settings update was rejected
+	Conflicts []*SettingsMergeConflict
+}
+
+// CreateBackupPayload is the payload type of the project-service service
+// create-backup method.
+type CreateBackupPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
 }
 
 // CreateProjectFolderPayload is the payload type of the project-service
@@ -143,8 +553,14 @@ type CreateProjectPayload struct {
 	XSync *bool
 	// Version of the API
 	Version *string
-	// Project slug, a short slugified name of the project
-	Slug string
+	// Create the project even if it likely duplicates an existing project
+	AllowDuplicates bool
+	// Name of the template whose defaults fill in the fields the request leaves
+	// empty
+	Template *string
+	// Project slug, a short slugified name of the project; generated from the name
+	// when unset
+	Slug *string
 	// A description of the project
 	Description string
 	// The pretty name of the project
@@ -155,9 +571,10 @@ type CreateProjectPayload struct {
 	IsFoundation *bool
 	// The UID of the parent project, required and must be a valid UUID
 	ParentUID string
-	// The stage of the project
+	// The stage of the project, one of the stages listed by GET /metadata/enums
 	Stage *string
-	// The category of the project
+	// The category of the project, one of the categories listed by GET
+	// /metadata/enums
 	Category *string
 	// The funding status of the project
 	Funding *string
@@ -169,7 +586,9 @@ type CreateProjectPayload struct {
 	LegalEntityType *string
 	// The legal entity name of the project
 	LegalEntityName *string
-	// The UID of the legal parent entity, should be empty if there is none
+	// The UID of the legal parent entity, should be empty if there is none; it
+	// must be a project whose legal entity type is Incorporated Entity or Series
+	// LLC
 	LegalParentUID *string
 	// The date the project entity was dissolved. Required when the project stage
 	// is set to "Archived"; creates and updates that result in stage "Archived"
@@ -187,10 +606,33 @@ type CreateProjectPayload struct {
 	RepositoryURL *string
 	// The URL of the project website
 	WebsiteURL *string
+	// The social links and contact details of the project
+	Links *ProjectLinks
+	// Platform-specific metadata attached to the project, such as a v1 Salesforce
+	// ID or a billing code. Keys are lowercase alphanumerics, '.', '_', '-' and
+	// '/', starting and ending with an alphanumeric.
+	Annotations map[string]string
+	// The name of the project in other languages, keyed by BCP 47 language tag,
+	// for localized project listings
+	NameI18n map[string]string
+	// The description of the project in other languages, keyed by BCP 47 language
+	// tag; each one has the length limit of the description
+	DescriptionI18n map[string]string
+	// The ID of the project in LFX v1 (a Salesforce record ID), used to resolve v1
+	// references to the v2 UID
+	LegacyID *string
+	// Free-form labels for grouping projects outside of stage and category, such
+	// as a program or a risk classification. Tags are lowercase alphanumerics and
+	// '-', starting and ending with an alphanumeric.
+	Tags []string
 	// The date the project was announced
 	AnnouncementDate *string
 	// The mission statement of the project
 	MissionStatement *string
+	// Curated HTML content shown on the project page. It is sanitized when stored:
+	// only basic formatting, lists, tables and http, https or mailto links are
+	// kept.
+	OverviewHTML *string
 	// A list of project writers with their profile information
 	Writers []*UserInfo
 	// A list of project meeting coordinators with their profile information
@@ -205,6 +647,68 @@ type CreateProjectPayload struct {
 	OpportunityOwner *UserInfo
 }
 
+// CreateProjectTemplatePayload is the payload type of the project-service
+// service create-project-template method.
+type CreateProjectTemplatePayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Name of the template, lowercase alphanumerics separated by '-'
+	Name string
+	// What the template is for
+	Description *string
+	// The stage of the project, one of the stages listed by GET /metadata/enums
+	Stage *string
+	// The category of the project, one of the categories listed by GET
+	// /metadata/enums
+	Category *string
+	// A list of funding models for the project
+	FundingModel []string
+	// The mission statement of the project
+	MissionStatement *string
+	// A list of project auditors with their profile information
+	Auditors []*UserInfo
+}
+
+// CreateWebhookPayload is the payload type of the project-service service
+// create-webhook method.
+type CreateWebhookPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// HTTPS URL the events are POSTed to
+	URL string
+	// Only send events for this project; every project when omitted
+	ProjectUID *string
+	// Events sent to the webhook; every event when empty
+	Events []string
+	// What the webhook is for
+	Description *string
+}
+
+// A NATS event whose handler failed, kept until it is replayed.
+type DeadLetter struct {
+	// Dead letter ID
+	ID string
+	// Subject the event was received on
+	Subject string
+	// Original message body
+	Payload string
+	// Error returned by the last failed attempt
+	Error string
+	// Failed attempts: the original delivery and each failed replay
+	Attempts int
+	// When the event first failed
+	FailedAt string
+	// When the event last failed
+	LastFailedAt string
+}
+
+// DeadLetterList is the result type of the project-service service
+// list-dead-letters method.
+type DeadLetterList struct {
+	// Dead-lettered events
+	DeadLetters []*DeadLetter
+}
+
 // DeleteProjectDocumentPayload is the payload type of the project-service
 // service delete-project-document method.
 type DeleteProjectDocumentPayload struct {
@@ -212,8 +716,12 @@ type DeleteProjectDocumentPayload struct {
 	BearerToken *string
 	// X-Sync header value for performing operations synchronously
 	XSync *bool
-	// If-Match header value for conditional requests
+	// If-Match header value for conditional requests: the ETag of the resource,
+	// which may be sent quoted or as a weak ETag such as W/"123"
 	IfMatch *string
+	// X-Force-Update header value for applying the request to the current revision
+	// without If-Match; only honored for trusted service principals, and audited
+	ForceUpdate *bool
 	// Version of the API
 	Version *string
 	// Project UID -- v2 uid, not related to v1 id directly
@@ -229,8 +737,12 @@ type DeleteProjectFolderPayload struct {
 	BearerToken *string
 	// X-Sync header value for performing operations synchronously
 	XSync *bool
-	// If-Match header value for conditional requests
+	// If-Match header value for conditional requests: the ETag of the resource,
+	// which may be sent quoted or as a weak ETag such as W/"123"
 	IfMatch *string
+	// X-Force-Update header value for applying the request to the current revision
+	// without If-Match; only honored for trusted service principals, and audited
+	ForceUpdate *bool
 	// Version of the API
 	Version *string
 	// Project UID -- v2 uid, not related to v1 id directly
@@ -246,8 +758,12 @@ type DeleteProjectLinkPayload struct {
 	BearerToken *string
 	// X-Sync header value for performing operations synchronously
 	XSync *bool
-	// If-Match header value for conditional requests
+	// If-Match header value for conditional requests: the ETag of the resource,
+	// which may be sent quoted or as a weak ETag such as W/"123"
 	IfMatch *string
+	// X-Force-Update header value for applying the request to the current revision
+	// without If-Match; only honored for trusted service principals, and audited
+	ForceUpdate *bool
 	// Version of the API
 	Version *string
 	// Project UID -- v2 uid, not related to v1 id directly
@@ -263,14 +779,48 @@ type DeleteProjectPayload struct {
 	BearerToken *string
 	// X-Sync header value for performing operations synchronously
 	XSync *bool
-	// If-Match header value for conditional requests
+	// If-Match header value for conditional requests: the ETag of the resource,
+	// which may be sent quoted or as a weak ETag such as W/"123"
 	IfMatch *string
+	// X-Force-Update header value for applying the request to the current revision
+	// without If-Match; only honored for trusted service principals, and audited
+	ForceUpdate *bool
 	// Version of the API
 	Version *string
 	// Project UID -- v2 uid, not related to v1 id directly
 	UID *string
 }
 
+// DeleteProjectTemplatePayload is the payload type of the project-service
+// service delete-project-template method.
+type DeleteProjectTemplatePayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Name of the template, lowercase alphanumerics separated by '-'
+	Name string
+}
+
+// DeleteWebhookPayload is the payload type of the project-service service
+// delete-webhook method.
+type DeleteWebhookPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Webhook ID
+	ID string
+}
+
+// The health of a single dependency of the service.
+type DependencyHealth struct {
+	// Dependency name
+	Name string
+	// Dependency status
+	Status string
+	// Time taken by the check in milliseconds
+	LatencyMs float64
+	// Reason the check failed
+	Error *string
+}
+
 // DownloadProjectDocumentPayload is the payload type of the project-service
 // service download-project-document method.
 type DownloadProjectDocumentPayload struct {
@@ -284,6 +834,77 @@ type DownloadProjectDocumentPayload struct {
 	DocumentUID string
 }
 
+type DuplicateProject struct {
+	// UID of the existing project
+	UID string
+	// Slug of the existing project
+	Slug string
+	// Name of the existing project
+	Name string
+	// Why the existing project was matched
+	Reason string
+}
+
+// ExportUserDataPayload is the payload type of the project-service service
+// export-user-data method.
+type ExportUserDataPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// The LFID username of the user
+	Username string
+}
+
+type ExternalReference struct {
+	// The project field holding the URL
+	Field string
+	// The URL
+	URL string
+}
+
+type FieldError struct {
+	// Name of the field in the request body
+	Path string
+	// Machine-readable reason the field failed validation
+	Code string
+	// Human-readable description of the failure
+	Message string
+}
+
+type ForbiddenError struct {
+	// URI identifying the problem type; the same for every occurrence of the
+	// problem
+	Type *string
+	// Short summary of the problem type
+	Title *string
+	// HTTP status code
+	Status *int
+	// Explanation of this occurrence of the problem
+	Detail *string
+	// Path of the request that failed
+	Instance *string
+	// ID of the request that failed, as in the X-REQUEST-ID response header
+	RequestID *string
+	// Fields of the request that caused the problem
+	FieldErrors []*FieldError
+	// HTTP status code; deprecated, use status
+	Code string
+	// Error message; deprecated, use detail
+	Message string
+	// Fields the principal is not allowed to change; deprecated, use field_errors
+	Fields []*FieldError
+	// People to contact about joining the project, when autojoin is disabled
+	Contacts []*ProjectContact
+}
+
+// GetIntegrityReportPayload is the payload type of the project-service service
+// get-integrity-report method.
+type GetIntegrityReportPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+}
+
 // GetOneProjectBasePayload is the payload type of the project-service service
 // get-one-project-base method.
 type GetOneProjectBasePayload struct {
@@ -293,14 +914,25 @@ type GetOneProjectBasePayload struct {
 	Version *string
 	// Project UID -- v2 uid, not related to v1 id directly
 	UID *string
+	// Comma-separated names of the project fields to return, such as
+	// uid,name,slug,logo_url; all fields are returned when omitted
+	Fields *string
+	// Related resources to include in the project
+	Expand *string
 }
 
 // GetOneProjectBaseResult is the result type of the project-service service
 // get-one-project-base method.
 type GetOneProjectBaseResult struct {
-	Project *ProjectBase
+	// The project; only the base fields unless expand=settings
+	Project *ProjectFull
 	// ETag header value
 	Etag *string
+	// Cache-Control header value: public responses about public projects may be
+	// cached, others are private and not stored
+	CacheControl *string
+	// X-Settings-ETag header value: the ETag of the settings, with expand=settings
+	SettingsEtag *string
 }
 
 // GetOneProjectSettingsPayload is the payload type of the project-service
@@ -320,6 +952,71 @@ type GetOneProjectSettingsResult struct {
 	ProjectSettings *ProjectSettings
 	// ETag header value
 	Etag *string
+	// Cache-Control header value: public responses about public projects may be
+	// cached, others are private and not stored
+	CacheControl *string
+}
+
+// GetProjectAssociationsPayload is the payload type of the project-service
+// service get-project-associations method.
+type GetProjectAssociationsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+}
+
+// GetProjectCharterPayload is the payload type of the project-service service
+// get-project-charter method.
+type GetProjectCharterPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+}
+
+// GetProjectChildrenPayload is the payload type of the project-service service
+// get-project-children method.
+type GetProjectChildrenPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+	// Field the projects are sorted by; ties are broken by UID
+	Sort string
+	// Sort order
+	Order string
+	// Maximum number of projects to return
+	PageSize int
+	// The next_page_token of the previous page; it must be used with the same sort
+	// and order
+	PageToken *string
+}
+
+// GetProjectDescendantsPayload is the payload type of the project-service
+// service get-project-descendants method.
+type GetProjectDescendantsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+	// Field the projects are sorted by; ties are broken by UID
+	Sort string
+	// Sort order
+	Order string
+	// Maximum number of projects to return
+	PageSize int
+	// The next_page_token of the previous page; it must be used with the same sort
+	// and order
+	PageToken *string
 }
 
 // GetProjectDocumentPayload is the payload type of the project-service service
@@ -343,6 +1040,15 @@ type GetProjectDocumentResult struct {
 	Etag *string
 }
 
+// GetProjectEnumsPayload is the payload type of the project-service service
+// get-project-enums method.
+type GetProjectEnumsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+}
+
 // GetProjectFolderPayload is the payload type of the project-service service
 // get-project-folder method.
 type GetProjectFolderPayload struct {
@@ -364,6 +1070,17 @@ type GetProjectFolderResult struct {
 	Etag *string
 }
 
+// GetProjectLegalLineagePayload is the payload type of the project-service
+// service get-project-legal-lineage method.
+type GetProjectLegalLineagePayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+}
+
 // GetProjectLinkPayload is the payload type of the project-service service
 // get-project-link method.
 type GetProjectLinkPayload struct {
@@ -385,29 +1102,180 @@ type GetProjectLinkResult struct {
 	Etag *string
 }
 
-// GetProjectsPayload is the payload type of the project-service service
-// get-projects method.
-type GetProjectsPayload struct {
+// GetProjectSettingsDiffPayload is the payload type of the project-service
+// service get-project-settings-diff method.
+type GetProjectSettingsDiffPayload struct {
 	// JWT token issued by Heimdall
 	BearerToken *string
 	// Version of the API
 	Version *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+	// The earlier settings revision
+	From uint64
+	// The later settings revision
+	To uint64
 }
 
-// GetProjectsResult is the result type of the project-service service
-// get-projects method.
-type GetProjectsResult struct {
-	// Resources found
-	Projects []*ProjectFull
-	// Cache control header
-	CacheControl *string
+// GetProjectTemplatePayload is the payload type of the project-service service
+// get-project-template method.
+type GetProjectTemplatePayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Name of the template, lowercase alphanumerics separated by '-'
+	Name string
 }
 
-type InternalServerError struct {
-	// HTTP status code
-	Code string
-	// Error message
-	Message string
+// GetProjectUIDFromLegacyIDPayload is the payload type of the project-service
+// service get-project-uid-from-legacy-id method.
+type GetProjectUIDFromLegacyIDPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// The ID of the project in LFX v1 (a Salesforce record ID), used to resolve v1
+	// references to the v2 UID
+	LegacyID string
+}
+
+// GetProjectUIDFromLegacyIDResult is the result type of the project-service
+// service get-project-uid-from-legacy-id method.
+type GetProjectUIDFromLegacyIDResult struct {
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+	// The ID of the project in LFX v1 (a Salesforce record ID), used to resolve v1
+	// references to the v2 UID
+	LegacyID string
+}
+
+// GetProjectVisibilityImpactPayload is the payload type of the project-service
+// service get-project-visibility-impact method.
+type GetProjectVisibilityImpactPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+}
+
+// GetProjectsPayload is the payload type of the project-service service
+// get-projects method.
+type GetProjectsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// Only return projects that have all of these tags
+	Tag []string
+	// Comma-separated names of the project fields to return, such as
+	// uid,name,slug,logo_url; all fields are returned when omitted
+	Fields *string
+	// Only return the base fields of each project, without reading the settings
+	BaseOnly bool
+}
+
+// GetProjectsResult is the result type of the project-service service
+// get-projects method.
+type GetProjectsResult struct {
+	// Resources found
+	Projects []*ProjectFull
+	// Cache-Control header value: public responses about public projects may be
+	// cached, others are private and not stored
+	CacheControl *string
+}
+
+// GetUserProjectsPayload is the payload type of the project-service service
+// get-user-projects method.
+type GetUserProjectsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// The LFID username of the user
+	Username string
+}
+
+// GetUserProjectsResult is the result type of the project-service service
+// get-user-projects method.
+type GetUserProjectsResult struct {
+	// Projects in which the user holds a role, ordered by slug
+	Projects []*UserProject
+}
+
+// GetWebhookPayload is the payload type of the project-service service
+// get-webhook method.
+type GetWebhookPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Webhook ID
+	ID string
+}
+
+// HealthReport is the result type of the project-service service healthz
+// method.
+type HealthReport struct {
+	// Overall service status
+	Status string
+	// Per-dependency check results
+	Checks []*DependencyHealth
+}
+
+// InspectProjectKvPayload is the payload type of the project-service service
+// inspect-project-kv method.
+type InspectProjectKvPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+}
+
+// IntegrityReport is the result type of the project-service service
+// get-integrity-report method.
+type IntegrityReport struct {
+	// When the scan started
+	ScannedAt string
+	// Number of inconsistencies found
+	Found int
+	// Slugs whose mapping points at a missing project, or at one that has since
+	// changed its slug
+	DanglingSlugMappings []string
+	// UIDs of projects without a slug mapping
+	MissingSlugMappings []string
+	// Legacy IDs whose mapping points at a missing project, or at one that has
+	// since changed its legacy ID
+	DanglingLegacyIDMappings []string
+	// UIDs of projects whose legacy ID is not mapped
+	MissingLegacyIDMappings []string
+	// UIDs of project settings without a project
+	OrphanedSettings []string
+	// UIDs of projects whose parent project is missing
+	OrphanedChildren []string
+	// Entries that could not be decoded, as the bucket name and key joined with a
+	// slash
+	InvalidEntries []string
+}
+
+type InternalServerError struct {
+	// URI identifying the problem type; the same for every occurrence of the
+	// problem
+	Type *string
+	// Short summary of the problem type
+	Title *string
+	// HTTP status code
+	Status *int
+	// Explanation of this occurrence of the problem
+	Detail *string
+	// Path of the request that failed
+	Instance *string
+	// ID of the request that failed, as in the X-REQUEST-ID response header
+	RequestID *string
+	// Fields of the request that caused the problem
+	FieldErrors []*FieldError
+	// HTTP status code; deprecated, use status
+	Code string
+	// Error message; deprecated, use detail
+	Message string
 }
 
 // Pending invite details for a user who does not yet have an LFID.
@@ -420,15 +1288,219 @@ type InviteInfo struct {
 	ExpiresAt *string
 }
 
+// JoinProjectPayload is the payload type of the project-service service
+// join-project method.
+type JoinProjectPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// X-Sync header value for performing operations synchronously
+	XSync *bool
+	// Version of the API
+	Version *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+}
+
+// One revision of a key of a NATS KV bucket, as stored.
+type KVEntry struct {
+	// Revision of the entry in the bucket
+	Revision uint64
+	// When the entry was written
+	Created string
+	// Whether the entry wrote a value, or deleted or purged the key
+	Operation string
+	// The document written, decompressed but otherwise as stored; a string when it
+	// is not valid JSON
+	Value any
+}
+
+// The current entry and the retained history of one key of a NATS KV bucket.
+type KVKeyInspection struct {
+	// Name of the bucket
+	Bucket string
+	// The key
+	Key string
+	// The latest entry of the key, which may be a delete marker; absent when the
+	// key has no entry
+	Current *KVEntry
+	// The retained entries of the key, oldest first, the current one included
+	History []*KVEntry
+}
+
+type LegalLineageProject struct {
+	// Project UID
+	UID string
+	// Project slug, a short slugified name of the project
+	Slug string
+	// The pretty name of the project
+	Name string
+	// The legal entity type of the project
+	LegalEntityType *string
+	// The legal entity name of the project
+	LegalEntityName *string
+}
+
+// ListBackupsPayload is the payload type of the project-service service
+// list-backups method.
+type ListBackupsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+}
+
+// ListDeadLettersPayload is the payload type of the project-service service
+// list-dead-letters method.
+type ListDeadLettersPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+}
+
+// ListPendingAnnouncementsPayload is the payload type of the project-service
+// service list-pending-announcements method.
+type ListPendingAnnouncementsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+}
+
+// ListProjectDeletionsPayload is the payload type of the project-service
+// service list-project-deletions method.
+type ListProjectDeletionsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// Only list projects deleted at or after this time
+	Since *string
+}
+
+// ListProjectEventsPayload is the payload type of the project-service service
+// list-project-events method.
+type ListProjectEventsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+	// Only list events that occurred at or after this time
+	Since *string
+}
+
+// ListProjectTemplatesPayload is the payload type of the project-service
+// service list-project-templates method.
+type ListProjectTemplatesPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+}
+
+// ListWebhookDeliveriesPayload is the payload type of the project-service
+// service list-webhook-deliveries method.
+type ListWebhookDeliveriesPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Webhook ID
+	ID string
+}
+
+// ListWebhooksPayload is the payload type of the project-service service
+// list-webhooks method.
+type ListWebhooksPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+}
+
+// MergeProjectPayload is the payload type of the project-service service
+// merge-project method.
+type MergeProjectPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// X-Sync header value for performing operations synchronously
+	XSync *bool
+	// If-Match header value for conditional requests: the ETag of the resource,
+	// which may be sent quoted or as a weak ETag such as W/"123"
+	IfMatch *string
+	// X-Force-Update header value for applying the request to the current revision
+	// without If-Match; only honored for trusted service principals, and audited
+	ForceUpdate *bool
+	// Version of the API
+	Version *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+	// UID of the project to merge the project into
+	TargetUID string
+}
+
 type NotFoundError struct {
+	// URI identifying the problem type; the same for every occurrence of the
+	// problem
+	Type *string
+	// Short summary of the problem type
+	Title *string
 	// HTTP status code
+	Status *int
+	// Explanation of this occurrence of the problem
+	Detail *string
+	// Path of the request that failed
+	Instance *string
+	// ID of the request that failed, as in the X-REQUEST-ID response header
+	RequestID *string
+	// Fields of the request that caused the problem
+	FieldErrors []*FieldError
+	// HTTP status code; deprecated, use status
 	Code string
-	// Error message
+	// Error message; deprecated, use detail
 	Message string
 }
 
+// OutboxReconcileResult is the result type of the project-service service
+// reconcile-outbox method.
+type OutboxReconcileResult struct {
+	// Messages published and removed from the outbox
+	Published int
+	// Messages that failed again and were rescheduled
+	Failed int
+	// Messages still waiting in the outbox
+	Pending int
+}
+
+// A project whose announcement has not been published yet.
+type PendingAnnouncement struct {
+	// Project UID
+	ProjectUID string
+	// Project slug, a short slugified name of the project
+	Slug string
+	// The pretty name of the project
+	Name string
+	// Date the project is announced
+	AnnouncementDate string
+	// Whether the date is reached, so that the announcement is published on the
+	// next run of the scheduler
+	Due bool
+}
+
+// PendingAnnouncementList is the result type of the project-service service
+// list-pending-announcements method.
+type PendingAnnouncementList struct {
+	// Pending announcements
+	Announcements []*PendingAnnouncement
+}
+
+// ProjectAssociations is the result type of the project-service service
+// get-project-associations method.
+type ProjectAssociations struct {
+	// Project UID these associations belong to
+	ProjectUID string
+	// UIDs of the committees associated with the project
+	CommitteeUids []string
+	// IDs of the mailing lists associated with the project
+	MailingListIds []string
+	// IDs of the meeting series associated with the project
+	MeetingSeriesIds []string
+	// RFC3339 timestamp
+	UpdatedAt *string
+}
+
 // ProjectBase is the result type of the project-service service
-// update-project-base method.
+// upload-project-logo method.
 type ProjectBase struct {
 	// Project UID -- v2 uid, not related to v1 id directly
 	UID *string
@@ -438,15 +1510,22 @@ type ProjectBase struct {
 	Description *string
 	// The pretty name of the project
 	Name *string
+	// The name of the project in other languages, keyed by BCP 47 language tag,
+	// for localized project listings
+	NameI18n map[string]string
+	// The description of the project in other languages, keyed by BCP 47 language
+	// tag; each one has the length limit of the description
+	DescriptionI18n map[string]string
 	// Whether the project is public
 	Public *bool
 	// Whether the project is a foundation
 	IsFoundation *bool
 	// The UID of the parent project, required and must be a valid UUID
 	ParentUID *string
-	// The stage of the project
+	// The stage of the project, one of the stages listed by GET /metadata/enums
 	Stage *string
-	// The category of the project
+	// The category of the project, one of the categories listed by GET
+	// /metadata/enums
 	Category *string
 	// The funding status of the project
 	Funding *string
@@ -458,12 +1537,19 @@ type ProjectBase struct {
 	LegalEntityType *string
 	// The legal entity name of the project
 	LegalEntityName *string
-	// The UID of the legal parent entity, should be empty if there is none
+	// The UID of the legal parent entity, should be empty if there is none; it
+	// must be a project whose legal entity type is Incorporated Entity or Series
+	// LLC
 	LegalParentUID *string
 	// The date the project entity was dissolved. Required when the project stage
 	// is set to "Archived"; creates and updates that result in stage "Archived"
 	// are rejected if this field is empty. Not required for any other stage.
 	EntityDissolutionDate *string
+	// The status of the project's legal entity, computed from
+	// entity_dissolution_date: dissolved once the date is in the past. The legal
+	// fields of a dissolved project can only be changed with the X-Admin-Override
+	// header.
+	EntityStatus *string
 	// The URL of the project entity formation document
 	EntityFormationDocumentURL *string
 	// Whether autojoin is enabled for the project
@@ -472,16 +1558,109 @@ type ProjectBase struct {
 	FormationDate *string
 	// The URL of the project logo
 	LogoURL *string
+	// The URL of a PNG rendering of the project logo, set by the service when the
+	// logo is an SVG
+	PngLogoURL *string
 	// The URL of the project repository
 	RepositoryURL *string
 	// The URL of the project website
 	WebsiteURL *string
+	// The social links and contact details of the project
+	Links *ProjectLinks
+	// Platform-specific metadata attached to the project, such as a v1 Salesforce
+	// ID or a billing code. Keys are lowercase alphanumerics, '.', '_', '-' and
+	// '/', starting and ending with an alphanumeric.
+	Annotations map[string]string
+	// The ID of the project in LFX v1 (a Salesforce record ID), used to resolve v1
+	// references to the v2 UID
+	LegacyID *string
+	// Free-form labels for grouping projects outside of stage and category, such
+	// as a program or a risk classification. Tags are lowercase alphanumerics and
+	// '-', starting and ending with an alphanumeric.
+	Tags []string
 	// The date and time the project was created
 	CreatedAt *string
 	// The date and time the project was last updated
 	UpdatedAt *string
 }
 
+// ProjectCharter is the result type of the project-service service
+// upload-project-charter method.
+type ProjectCharter struct {
+	// Project UID this charter belongs to
+	ProjectUID string
+	// The latest version, which the project's charter_url points at
+	Current *ProjectCharterVersion
+	// Every uploaded version, oldest first
+	Versions []*ProjectCharterVersion
+}
+
+// One uploaded version of a project charter.
+type ProjectCharterVersion struct {
+	// Version number, starting at 1
+	Version int
+	// Public URL of this version of the charter
+	URL string
+	// Original uploaded file name
+	FileName *string
+	// File size in bytes
+	FileSize int64
+	// SHA-256 checksum of the file, hex encoded
+	Sha256 string
+	// Username of the principal who created this resource
+	UploadedByUsername *string
+	// RFC3339 timestamp
+	UploadedAt string
+}
+
+type ProjectContact struct {
+	// The role of the contact in the project
+	Role string
+	// The name of the contact
+	Name string
+	// The email address of the contact
+	Email string
+}
+
+// Tombstone of a deleted project.
+type ProjectDeletion struct {
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+	// Project slug, a short slugified name of the project
+	Slug string
+	// RFC3339 timestamp
+	DeletedAt string
+	// Username of the principal who deleted the project
+	DeletedBy *string
+	// UID of the project the project was merged into, when it was deleted by a
+	// merge
+	MergedInto *string
+}
+
+// ProjectDeletionList is the result type of the project-service service
+// list-project-deletions method.
+type ProjectDeletionList struct {
+	// Tombstones of deleted projects
+	Deletions []*ProjectDeletion
+}
+
+// The outcome of the deletion of one project.
+type ProjectDeletionOutcome struct {
+	// Project UID
+	UID string
+	// Project slug, unless the project was not found
+	Slug *string
+	// Whether the project was given in the request, rather than deleted as a
+	// descendant of one
+	Requested bool
+	// Whether the project was deleted, would be deleted by a dry run, was not
+	// found, blocked the deletion, was skipped because the deletion was blocked or
+	// stopped, or failed
+	Status string
+	// Why the project blocked the deletion or failed
+	Error *string
+}
+
 // ProjectDocument is the result type of the project-service service
 // upload-project-document method.
 type ProjectDocument struct {
@@ -509,6 +1688,53 @@ type ProjectDocument struct {
 	UpdatedAt *string
 }
 
+// ProjectEnums is the result type of the project-service service
+// get-project-enums method.
+type ProjectEnums struct {
+	// The stages a project may have
+	Stages []string
+	// The categories a project may have
+	Categories []string
+	// The principal that last changed the allowed values; unset for the defaults
+	UpdatedBy *string
+	// When the allowed values were last changed; unset for the defaults
+	UpdatedAt *string
+}
+
+// A project lifecycle event reconstructed from a stored revision of the
+// project's base or settings. The payload fields set depend on the type, as in
+// the lifecycle events published on NATS.
+type ProjectEvent struct {
+	// Event ID, the same each time the event is reconstructed from the same
+	// revision
+	ID string
+	// Event type
+	Type string
+	// RFC3339 timestamp
+	OccurredAt string
+	// UID of the project
+	ProjectUID string
+	// Revision of the stored project base, or of the settings for
+	// project.settings.updated, that the event stands for
+	Revision uint64
+	// The project as written, or as it was before project.deleted
+	Project *ProjectBase
+	// The project before project.updated, when that revision is still retained
+	PreviousProject *ProjectBase
+	// The settings as written
+	Settings *ProjectSettings
+	// The settings before project.settings.updated, when that revision is still
+	// retained
+	PreviousSettings *ProjectSettings
+}
+
+// ProjectEventList is the result type of the project-service service
+// list-project-events method.
+type ProjectEventList struct {
+	// Lifecycle events of the project
+	Events []*ProjectEvent
+}
+
 // ProjectFolder is the result type of the project-service service
 // create-project-folder method.
 type ProjectFolder struct {
@@ -526,7 +1752,7 @@ type ProjectFolder struct {
 	UpdatedAt *string
 }
 
-// ProjectFull is the result type of the project-service service create-project
+// ProjectFull is the result type of the project-service service clone-project
 // method.
 type ProjectFull struct {
 	// Project UID -- v2 uid, not related to v1 id directly
@@ -537,15 +1763,22 @@ type ProjectFull struct {
 	Description *string
 	// The pretty name of the project
 	Name *string
+	// The name of the project in other languages, keyed by BCP 47 language tag,
+	// for localized project listings
+	NameI18n map[string]string
+	// The description of the project in other languages, keyed by BCP 47 language
+	// tag; each one has the length limit of the description
+	DescriptionI18n map[string]string
 	// Whether the project is public
 	Public *bool
 	// Whether the project is a foundation
 	IsFoundation *bool
 	// The UID of the parent project, required and must be a valid UUID
 	ParentUID *string
-	// The stage of the project
+	// The stage of the project, one of the stages listed by GET /metadata/enums
 	Stage *string
-	// The category of the project
+	// The category of the project, one of the categories listed by GET
+	// /metadata/enums
 	Category *string
 	// The funding status of the project
 	Funding *string
@@ -557,12 +1790,19 @@ type ProjectFull struct {
 	LegalEntityType *string
 	// The legal entity name of the project
 	LegalEntityName *string
-	// The UID of the legal parent entity, should be empty if there is none
+	// The UID of the legal parent entity, should be empty if there is none; it
+	// must be a project whose legal entity type is Incorporated Entity or Series
+	// LLC
 	LegalParentUID *string
 	// The date the project entity was dissolved. Required when the project stage
 	// is set to "Archived"; creates and updates that result in stage "Archived"
 	// are rejected if this field is empty. Not required for any other stage.
 	EntityDissolutionDate *string
+	// The status of the project's legal entity, computed from
+	// entity_dissolution_date: dissolved once the date is in the past. The legal
+	// fields of a dissolved project can only be changed with the X-Admin-Override
+	// header.
+	EntityStatus *string
 	// The URL of the project entity formation document
 	EntityFormationDocumentURL *string
 	// Whether autojoin is enabled for the project
@@ -571,16 +1811,36 @@ type ProjectFull struct {
 	FormationDate *string
 	// The URL of the project logo
 	LogoURL *string
+	// The URL of a PNG rendering of the project logo, set by the service when the
+	// logo is an SVG
+	PngLogoURL *string
 	// The URL of the project repository
 	RepositoryURL *string
 	// The URL of the project website
 	WebsiteURL *string
+	// The social links and contact details of the project
+	Links *ProjectLinks
+	// Platform-specific metadata attached to the project, such as a v1 Salesforce
+	// ID or a billing code. Keys are lowercase alphanumerics, '.', '_', '-' and
+	// '/', starting and ending with an alphanumeric.
+	Annotations map[string]string
+	// The ID of the project in LFX v1 (a Salesforce record ID), used to resolve v1
+	// references to the v2 UID
+	LegacyID *string
+	// Free-form labels for grouping projects outside of stage and category, such
+	// as a program or a risk classification. Tags are lowercase alphanumerics and
+	// '-', starting and ending with an alphanumeric.
+	Tags []string
 	// The date and time the project was created
 	CreatedAt *string
 	// The date and time the project was last updated
 	UpdatedAt *string
 	// The mission statement of the project
 	MissionStatement *string
+	// Curated HTML content shown on the project page. It is sanitized when stored:
+	// only basic formatting, lists, tables and http, https or mailto links are
+	// kept.
+	OverviewHTML *string
 	// The date the project was announced
 	AnnouncementDate *string
 	// A list of project writers with their profile information
@@ -595,6 +1855,56 @@ type ProjectFull struct {
 	ProgramManager *UserInfo
 	// The opportunity owner of the project with their profile information
 	OpportunityOwner *UserInfo
+	// The slugs of the project's ancestors, from the topmost one, and of the
+	// project, joined with "/"; read-only
+	SlugPath *string
+	// The level of the project in the hierarchy, a project without a parent being
+	// level 1; read-only
+	Depth *int
+	// The number of direct children of the project; read-only
+	ChildCount *int
+	// Whether the project has settings stored; read-only
+	HasSettings *bool
+	// The status to show the project with, computed from its stage,
+	// entity_dissolution_date, formation_date and announcement_date; read-only
+	DisplayStatus *string
+	// The project settings, with their own timestamps; only in version 2
+	// responses, which leave out the settings fields above
+	Settings *ProjectSettings
+}
+
+// ProjectJoinResult is the result type of the project-service service
+// join-project method.
+type ProjectJoinResult struct {
+	// Project UID
+	ProjectUID string
+	// The LFID username of the user
+	Username string
+	// The role the user holds in the project
+	Role string
+}
+
+// ProjectKVInspection is the result type of the project-service service
+// inspect-project-kv method.
+type ProjectKVInspection struct {
+	// Project UID
+	ProjectUID string
+	// The project base in the projects bucket
+	Base *KVKeyInspection
+	// The project settings in the project-settings bucket
+	Settings *KVKeyInspection
+}
+
+// ProjectLegalLineage is the result type of the project-service service
+// get-project-legal-lineage method.
+type ProjectLegalLineage struct {
+	// Project UID
+	ProjectUID string
+	// The legal parent of the project first, then its legal parent, and so on
+	LegalParents []*LegalLineageProject
+	// Whether the chain ends at a project without a legal parent; it is incomplete
+	// when a legal parent no longer exists or the chain loops
+	Complete bool
 }
 
 // ProjectLink is the result type of the project-service service
@@ -620,13 +1930,70 @@ type ProjectLink struct {
 	UpdatedAt *string
 }
 
+// The social links and contact details of a project. Links are absolute http
+// or https URLs.
+type ProjectLinks struct {
+	// The URL of the project's X (Twitter) profile
+	Twitter *string
+	// The URL of the project's LinkedIn page
+	Linkedin *string
+	// The URL of the project's YouTube channel
+	Youtube *string
+	// The invite URL of the project's Slack workspace
+	Slack *string
+	// The invite URL of the project's Discord server
+	Discord *string
+	// The URL of the project's mailing list
+	MailingList *string
+	// The email address to contact the project at
+	ContactEmail *string
+}
+
+// Users to add to and remove from the writers and auditors of one project.
+type ProjectMembersOperation struct {
+	// Project UID
+	UID string
+	// Usernames to add to the writers
+	WritersAdd []string
+	// Usernames to remove from the writers
+	WritersRemove []string
+	// Usernames to add to the auditors
+	AuditorsAdd []string
+	// Usernames to remove from the auditors
+	AuditorsRemove []string
+}
+
+// The outcome of the member changes of one project.
+type ProjectMembersOperationResult struct {
+	// Project UID
+	UID string
+	// Whether the project was updated, already matched the changes, was not found,
+	// or failed
+	Status string
+	// Why the changes failed
+	Error *string
+}
+
+// ProjectPage is the result type of the project-service service
+// get-project-children method.
+type ProjectPage struct {
+	// The projects of the page, in the requested order
+	Projects []*ProjectBase
+	// Token of the next page; absent on the last page
+	NextPageToken *string
+}
+
 // ProjectSettings is the result type of the project-service service
-// update-project-settings method.
+// add-project-writer method.
 type ProjectSettings struct {
 	// Project UID -- v2 uid, not related to v1 id directly
 	UID *string
 	// The mission statement of the project
 	MissionStatement *string
+	// Curated HTML content shown on the project page. It is sanitized when stored:
+	// only basic formatting, lists, tables and http, https or mailto links are
+	// kept.
+	OverviewHTML *string
 	// The date the project was announced
 	AnnouncementDate *string
 	// A list of project writers with their profile information
@@ -647,11 +2014,376 @@ type ProjectSettings struct {
 	UpdatedAt *string
 }
 
+// The change to one settings field between two revisions. Text fields report
+// their old and new values; user fields report the users added and removed.
+type ProjectSettingsChange struct {
+	// The settings field that changed
+	Field string
+	// The old value of a text field
+	From *string
+	// The new value of a text field
+	To *string
+	// Users added to a user field
+	Added []*UserInfo
+	// Users removed from a user field
+	Removed []*UserInfo
+}
+
+// ProjectSettingsDiff is the result type of the project-service service
+// get-project-settings-diff method.
+type ProjectSettingsDiff struct {
+	// Project UID these settings belong to
+	ProjectUID string
+	// The earlier settings revision
+	FromRevision uint64
+	// The later settings revision
+	ToRevision uint64
+	// The fields that changed, in settings order
+	Changes []*ProjectSettingsChange
+}
+
+// A project offered for a typeahead prefix.
+type ProjectSuggestion struct {
+	// Project UID
+	UID string
+	// The pretty name of the project
+	Name string
+	// Project slug, a short slugified name of the project
+	Slug string
+	// The URL of the project logo
+	LogoURL *string
+}
+
+// ProjectTemplate is the result type of the project-service service
+// create-project-template method.
+type ProjectTemplate struct {
+	// Name of the template, lowercase alphanumerics separated by '-'
+	Name string
+	// What the template is for
+	Description *string
+	// The stage of the project, one of the stages listed by GET /metadata/enums
+	Stage *string
+	// The category of the project, one of the categories listed by GET
+	// /metadata/enums
+	Category *string
+	// A list of funding models for the project
+	FundingModel []string
+	// The mission statement of the project
+	MissionStatement *string
+	// A list of project auditors with their profile information
+	Auditors []*UserInfo
+	// Username of the principal who created this resource
+	CreatedBy *string
+	// RFC3339 timestamp
+	CreatedAt string
+	// RFC3339 timestamp
+	UpdatedAt string
+}
+
+// ProjectTemplateList is the result type of the project-service service
+// list-project-templates method.
+type ProjectTemplateList struct {
+	// Project templates
+	Templates []*ProjectTemplate
+}
+
+// ProjectVisibilityImpact is the result type of the project-service service
+// get-project-visibility-impact method.
+type ProjectVisibilityImpact struct {
+	// Project UID
+	ProjectUID string
+	// Whether the project is currently public
+	Public bool
+	// The visibility the report is for, the opposite of public
+	TargetPublic bool
+	// The projects under the project, ordered by slug; they keep their own public
+	// flag
+	Descendants []*VisibilityImpactProject
+	// The number of descendants whose visibility would differ from the project's
+	// after the change
+	MismatchedDescendants int
+	// The project's URLs that point outside the service
+	ExternalReferences []*ExternalReference
+	// What follows the project, when associations or webhooks are enabled
+	Subscribers *VisibilitySubscribers
+}
+
+// RebuildSlugMappingsPayload is the payload type of the project-service
+// service rebuild-slug-mappings method.
+type RebuildSlugMappingsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Remove the mappings of slugs that no project holds
+	RemoveDangling bool
+}
+
+// ReconcileOutboxPayload is the payload type of the project-service service
+// reconcile-outbox method.
+type ReconcileOutboxPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+}
+
+// RemoveProjectAuditorPayload is the payload type of the project-service
+// service remove-project-auditor method.
+type RemoveProjectAuditorPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// X-Sync header value for performing operations synchronously
+	XSync *bool
+	// If-Match header value for conditional requests: the ETag of the resource,
+	// which may be sent quoted or as a weak ETag such as W/"123"
+	IfMatch *string
+	// Version of the API
+	Version *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+	// The LFID username of the user
+	Username string
+}
+
+// RemoveProjectWriterPayload is the payload type of the project-service
+// service remove-project-writer method.
+type RemoveProjectWriterPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// X-Sync header value for performing operations synchronously
+	XSync *bool
+	// If-Match header value for conditional requests: the ETag of the resource,
+	// which may be sent quoted or as a weak ETag such as W/"123"
+	IfMatch *string
+	// Version of the API
+	Version *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+	// The LFID username of the user
+	Username string
+}
+
+// RemoveUserAccessPayload is the payload type of the project-service service
+// remove-user-access method.
+type RemoveUserAccessPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// X-Sync header value for performing operations synchronously
+	XSync *bool
+	// Version of the API
+	Version *string
+	// The LFID username of the user
+	Username string
+}
+
+// ReplayDeadLetterPayload is the payload type of the project-service service
+// replay-dead-letter method.
+type ReplayDeadLetterPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Dead letter ID
+	ID string
+}
+
+// ReplayDeadLetterResult is the result type of the project-service service
+// replay-dead-letter method.
+type ReplayDeadLetterResult struct {
+	// Whether the handler succeeded and the dead letter was removed
+	Replayed bool
+	// The replayed event, with the new error and attempt count if the replay failed
+	DeadLetter *DeadLetter
+}
+
+// RestoreBackupPayload is the payload type of the project-service service
+// restore-backup method.
+type RestoreBackupPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Backup name
+	Name string
+	// Only restore these projects and the slug mappings pointing at them
+	ProjectUids []string
+	// Report what would be restored without writing anything
+	DryRun bool
+}
+
+// RestoreBackupResult is the result type of the project-service service
+// restore-backup method.
+type RestoreBackupResult struct {
+	// Backup name
+	Backup string
+	// Projects restored, or that would be in a dry run
+	ProjectUids []string
+	// Entries written, or that would be in a dry run
+	Entries int
+	// Entries that could not be written
+	Failed int
+}
+
+// ResyncProjectsPayload is the payload type of the project-service service
+// resync-projects method.
+type ResyncProjectsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Only resync projects whose UID starts with this prefix
+	UIDPrefix *string
+	// Only resync projects whose base or settings were updated at or after this
+	// time
+	UpdatedSince *string
+	// The stage of the project, one of the stages listed by GET /metadata/enums
+	Stage *string
+	// Report the matching projects without publishing anything
+	DryRun bool
+}
+
+// ResyncProjectsResult is the result type of the project-service service
+// resync-projects method.
+type ResyncProjectsResult struct {
+	// UIDs of the projects that matched the filter
+	ProjectUids []string
+	// Projects whose messages were all published
+	Published int
+	// Projects with at least one message that failed to publish
+	Failed int
+	// UIDs of the matching projects skipped because their settings are missing
+	MissingSettings []string
+}
+
+// SearchProjectsPayload is the payload type of the project-service service
+// search-projects method.
+type SearchProjectsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// Words to search for
+	Q string
+	// Maximum number of projects to return
+	Limit int
+}
+
+// SearchProjectsResult is the result type of the project-service service
+// search-projects method.
+type SearchProjectsResult struct {
+	// Matching projects, best match first
+	Projects []*ProjectBase
+	// Whether the results come from the search index or a scan of every project
+	Source string
+}
+
 type ServiceUnavailableError struct {
+	// URI identifying the problem type; the same for every occurrence of the
+	// problem
+	Type *string
+	// Short summary of the problem type
+	Title *string
+	// HTTP status code
+	Status *int
+	// Explanation of this occurrence of the problem
+	Detail *string
+	// Path of the request that failed
+	Instance *string
+	// ID of the request that failed, as in the X-REQUEST-ID response header
+	RequestID *string
+	// Fields of the request that caused the problem
+	FieldErrors []*FieldError
+	// HTTP status code; deprecated, use status
+	Code string
+	// Error message; deprecated, use detail
+	Message string
+}
+
+type SettingsMergeConflict struct {
+	// Settings field that both updates changed
+	Field string
+	// Usernames, or emails of users without one, that both updates changed
+	// differently, when the field is a list of users
+	Users []string
+}
+
+// SlugRebuildResult is the result type of the project-service service
+// rebuild-slug-mappings method.
+type SlugRebuildResult struct {
+	// Projects scanned
+	Projects int
+	// Slugs whose missing mapping was created
+	CreatedMappings []string
+	// Slugs whose mapping pointed at a missing project, or at one with another
+	// slug, and now points at the project with the slug
+	UpdatedMappings []string
+	// Slugs held by more than one project, whose mappings were left as they are
+	ConflictingSlugs []string
+	// Slugs whose mapping points at a missing project, or at one that has since
+	// changed its slug, and that no project holds
+	DanglingMappings []string
+	// Dangling mappings removed
+	RemovedMappings int
+	// Mappings that could not be written or removed
+	Failed int
+}
+
+// TransferProjectPayload is the payload type of the project-service service
+// transfer-project method.
+type TransferProjectPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// X-Sync header value for performing operations synchronously
+	XSync *bool
+	// If-Match header value: the ETag of the project's settings, whose writers are
+	// replaced
+	IfMatch *string
+	// X-Force-Update header value for applying the request to the current revision
+	// without If-Match; only honored for trusted service principals, and audited
+	ForceUpdate *bool
+	// Version of the API
+	Version *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+	// The new writers of the project, which replace the current ones
+	Writers []*UserInfo
+	// UID of the project to move the project under, such as the new foundation;
+	// the parent is kept when unset
+	ParentUID *string
+	// Why the project is transferred, recorded in the audit log and the event
+	Reason *string
+}
+
+type UnprocessableEntityError struct {
+	// URI identifying the problem type; the same for every occurrence of the
+	// problem
+	Type *string
+	// Short summary of the problem type
+	Title *string
 	// HTTP status code
+	Status *int
+	// Explanation of this occurrence of the problem
+	Detail *string
+	// Path of the request that failed
+	Instance *string
+	// ID of the request that failed, as in the X-REQUEST-ID response header
+	RequestID *string
+	// Fields of the request that caused the problem
+	FieldErrors []*FieldError
+	// HTTP status code; deprecated, use status
 	Code string
-	// Error message
+	// Error message; deprecated, use detail
 	Message string
+	// Fields that exceed their limits; deprecated, use field_errors
+	Fields []*FieldError
+}
+
+// UpdateProjectAssociationsPayload is the payload type of the project-service
+// service update-project-associations method.
+type UpdateProjectAssociationsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Version of the API
+	Version *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+	// UIDs of the committees associated with the project
+	CommitteeUids []string
+	// IDs of the mailing lists associated with the project
+	MailingListIds []string
+	// IDs of the meeting series associated with the project
+	MeetingSeriesIds []string
 }
 
 // UpdateProjectBasePayload is the payload type of the project-service service
@@ -661,8 +2393,16 @@ type UpdateProjectBasePayload struct {
 	BearerToken *string
 	// X-Sync header value for performing operations synchronously
 	XSync *bool
-	// If-Match header value for conditional requests
+	// If-Match header value for conditional requests: the ETag of the resource,
+	// which may be sent quoted or as a weak ETag such as W/"123"
 	IfMatch *string
+	// X-Force-Update header value for applying the request to the current revision
+	// without If-Match; only honored for trusted service principals, and audited
+	ForceUpdate *bool
+	// X-Admin-Override header value for changing the legal fields of a project
+	// whose legal entity is dissolved; only honored for LF staff and trusted
+	// service principals
+	AdminOverride *bool
 	// Version of the API
 	Version *string
 	// Project UID -- v2 uid, not related to v1 id directly
@@ -679,9 +2419,10 @@ type UpdateProjectBasePayload struct {
 	IsFoundation *bool
 	// The UID of the parent project, required and must be a valid UUID
 	ParentUID string
-	// The stage of the project
+	// The stage of the project, one of the stages listed by GET /metadata/enums
 	Stage *string
-	// The category of the project
+	// The category of the project, one of the categories listed by GET
+	// /metadata/enums
 	Category *string
 	// The funding status of the project
 	Funding *string
@@ -693,7 +2434,9 @@ type UpdateProjectBasePayload struct {
 	LegalEntityType *string
 	// The legal entity name of the project
 	LegalEntityName *string
-	// The UID of the legal parent entity, should be empty if there is none
+	// The UID of the legal parent entity, should be empty if there is none; it
+	// must be a project whose legal entity type is Incorporated Entity or Series
+	// LLC
 	LegalParentUID *string
 	// The date the project entity was dissolved. Required when the project stage
 	// is set to "Archived"; creates and updates that result in stage "Archived"
@@ -711,6 +2454,36 @@ type UpdateProjectBasePayload struct {
 	RepositoryURL *string
 	// The URL of the project website
 	WebsiteURL *string
+	// The social links and contact details of the project
+	Links *ProjectLinks
+	// Platform-specific metadata attached to the project, such as a v1 Salesforce
+	// ID or a billing code. Keys are lowercase alphanumerics, '.', '_', '-' and
+	// '/', starting and ending with an alphanumeric.
+	Annotations map[string]string
+	// The name of the project in other languages, keyed by BCP 47 language tag,
+	// for localized project listings
+	NameI18n map[string]string
+	// The description of the project in other languages, keyed by BCP 47 language
+	// tag; each one has the length limit of the description
+	DescriptionI18n map[string]string
+	// The ID of the project in LFX v1 (a Salesforce record ID), used to resolve v1
+	// references to the v2 UID
+	LegacyID *string
+	// Free-form labels for grouping projects outside of stage and category, such
+	// as a program or a risk classification. Tags are lowercase alphanumerics and
+	// '-', starting and ending with an alphanumeric.
+	Tags []string
+}
+
+// UpdateProjectEnumsPayload is the payload type of the project-service service
+// update-project-enums method.
+type UpdateProjectEnumsPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// The stages a project may have
+	Stages []string
+	// The categories a project may have
+	Categories []string
 }
 
 // UpdateProjectSettingsPayload is the payload type of the project-service
@@ -720,14 +2493,26 @@ type UpdateProjectSettingsPayload struct {
 	BearerToken *string
 	// X-Sync header value for performing operations synchronously
 	XSync *bool
-	// If-Match header value for conditional requests
+	// If-Match header value for conditional requests: the ETag of the resource,
+	// which may be sent quoted or as a weak ETag such as W/"123"
 	IfMatch *string
+	// X-Force-Update header value for applying the request to the current revision
+	// without If-Match; only honored for trusted service principals, and audited
+	ForceUpdate *bool
+	// X-Merge header value for merging the update with the settings changes made
+	// since the If-Match revision, instead of failing it with a revision mismatch;
+	// only changes to the same fields conflict
+	Merge *bool
 	// Version of the API
 	Version *string
 	// Project UID -- v2 uid, not related to v1 id directly
 	UID *string
 	// The mission statement of the project
 	MissionStatement *string
+	// Curated HTML content shown on the project page. It is sanitized when stored:
+	// only basic formatting, lists, tables and http, https or mailto links are
+	// kept.
+	OverviewHTML *string
 	// The date the project was announced
 	AnnouncementDate *string
 	// A list of project writers with their profile information
@@ -744,6 +2529,45 @@ type UpdateProjectSettingsPayload struct {
 	OpportunityOwner *UserInfo
 }
 
+// UpdateProjectTemplatePayload is the payload type of the project-service
+// service update-project-template method.
+type UpdateProjectTemplatePayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// Name of the template, lowercase alphanumerics separated by '-'
+	Name string
+	// What the template is for
+	Description *string
+	// The stage of the project, one of the stages listed by GET /metadata/enums
+	Stage *string
+	// The category of the project, one of the categories listed by GET
+	// /metadata/enums
+	Category *string
+	// A list of funding models for the project
+	FundingModel []string
+	// The mission statement of the project
+	MissionStatement *string
+	// A list of project auditors with their profile information
+	Auditors []*UserInfo
+}
+
+// UploadProjectCharterPayload is the payload type of the project-service
+// service upload-project-charter method.
+type UploadProjectCharterPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// X-Sync header value for performing operations synchronously
+	XSync *bool
+	// Version of the API
+	Version *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+	// PDF charter file contents
+	File []byte
+	// Original file name including extension
+	FileName *string
+}
+
 // UploadProjectDocumentPayload is the payload type of the project-service
 // service upload-project-document method.
 type UploadProjectDocumentPayload struct {
@@ -769,6 +2593,64 @@ type UploadProjectDocumentPayload struct {
 	ContentType string
 }
 
+// UploadProjectLogoPayload is the payload type of the project-service service
+// upload-project-logo method.
+type UploadProjectLogoPayload struct {
+	// JWT token issued by Heimdall
+	BearerToken *string
+	// X-Sync header value for performing operations synchronously
+	XSync *bool
+	// Version of the API
+	Version *string
+	// Project UID -- v2 uid, not related to v1 id directly
+	UID string
+	// SVG or PNG logo file contents
+	File []byte
+	// MIME type of the file; SVG logos must be sent as image/svg+xml
+	ContentType *string
+}
+
+// The outcome of removing a user from the member roles of one project.
+type UserAccessRemoval struct {
+	// Project UID
+	ProjectUID string
+	// Project slug, a short slugified name of the project
+	Slug string
+	// The pretty name of the project
+	Name string
+	// The roles the user held in the project
+	Roles []string
+	// Whether the user was removed, no longer held a role, the project was not
+	// found, or the removal failed
+	Status string
+	// Why the removal failed
+	Error *string
+}
+
+// UserAccessRemovalResult is the result type of the project-service service
+// remove-user-access method.
+type UserAccessRemovalResult struct {
+	// The LFID username of the user
+	Username string
+	// Outcome for each project
+	Projects []*UserAccessRemoval
+	// Projects the user was removed from
+	Removed int
+	// Projects that were not found or failed
+	Failed int
+}
+
+// UserDataExport is the result type of the project-service service
+// export-user-data method.
+type UserDataExport struct {
+	// The LFID username of the user
+	Username string
+	// When the export was generated
+	GeneratedAt string
+	// The roles the user holds, ordered by project slug
+	Memberships []*UserMembership
+}
+
 // User information including profile details.
 type UserInfo struct {
 	// The full name of the user
@@ -784,6 +2666,122 @@ type UserInfo struct {
 	Invite *InviteInfo
 }
 
+// A member or contact role a user holds in a project, with the user
+// information stored with it.
+type UserMembership struct {
+	// Project UID
+	ProjectUID string
+	// Project slug, a short slugified name of the project
+	Slug string
+	// The pretty name of the project
+	Name string
+	// The role the user holds in the project
+	Role string
+	// The user information stored with the role
+	User *UserInfo
+	// When the user was given the role, as far back as the retained history of the
+	// project goes
+	AddedAt *string
+}
+
+// A project in which a user is a writer, auditor or meeting coordinator.
+type UserProject struct {
+	// Project UID
+	ProjectUID string
+	// Project slug, a short slugified name of the project
+	Slug string
+	// The pretty name of the project
+	Name string
+	// The roles the user holds in the project
+	Roles []string
+}
+
+type VisibilityImpactProject struct {
+	// Project UID
+	UID string
+	// Project slug, a short slugified name of the project
+	Slug string
+	// The pretty name of the project
+	Name string
+	// Parent project UID
+	ParentUID string
+	// Whether the project is public
+	Public bool
+}
+
+type VisibilitySubscribers struct {
+	// Associated committees, when associations are enabled
+	Committees *int
+	// Associated mailing lists, when associations are enabled
+	MailingLists *int
+	// Associated meeting series, when associations are enabled
+	MeetingSeries *int
+	// Webhooks that receive the project's update events, when webhooks are enabled
+	Webhooks *int
+}
+
+// Webhook is the result type of the project-service service create-webhook
+// method.
+type Webhook struct {
+	// Webhook ID
+	ID string
+	// HTTPS URL the events are POSTed to
+	URL string
+	// Project the webhook is limited to; empty for every project
+	ProjectUID *string
+	// Events sent to the webhook; every event when empty
+	Events []string
+	// What the webhook is for
+	Description *string
+	// Key of the HMAC-SHA256 signature in the X-LFX-Signature-256 header, only
+	// returned when the webhook is created
+	Secret *string
+	// Username of the principal who created this resource
+	CreatedBy *string
+	// RFC3339 timestamp
+	CreatedAt string
+}
+
+// A project lifecycle event sent, or still being retried, to a webhook.
+type WebhookDelivery struct {
+	// Delivery ID, the ID of the event and the X-LFX-Delivery header value
+	ID string
+	// Event type
+	Event string
+	// Project the event is about
+	ProjectUID string
+	// Delivery status
+	Status string
+	// Requests made so far
+	Attempts int
+	// HTTP status of the last response; absent if no response was received
+	ResponseStatus *int
+	// Why the last attempt failed
+	LastError *string
+	// RFC3339 timestamp
+	CreatedAt string
+	// When the last request was made
+	LastAttemptAt *string
+	// When a pending delivery is next retried
+	NextAttemptAt *string
+	// When the endpoint accepted the event
+	DeliveredAt *string
+}
+
+// WebhookDeliveryList is the result type of the project-service service
+// list-webhook-deliveries method.
+type WebhookDeliveryList struct {
+	// Webhook deliveries
+	Deliveries []*WebhookDelivery
+}
+
+// WebhookList is the result type of the project-service service list-webhooks
+// method.
+type WebhookList struct {
+	// Registered webhooks
+	Webhooks []*Webhook
+}
+
 // Error returns an error description.
 func (e *BadRequestError) Error() string {
 	return ""
@@ -818,6 +2816,74 @@ func (e *ConflictError) GoaErrorName() string {
 	return "Conflict"
 }
 
+// Error returns an error description.
+func (e *DuplicateProject) Error() string {
+	return ""
+}
+
+// ErrorName returns "DuplicateProject".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *DuplicateProject) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "DuplicateProject".
+func (e *DuplicateProject) GoaErrorName() string {
+	return "DuplicateProject"
+}
+
+// Error returns an error description.
+func (e *FieldError) Error() string {
+	return ""
+}
+
+// ErrorName returns "FieldError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *FieldError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "FieldError".
+func (e *FieldError) GoaErrorName() string {
+	return "FieldError"
+}
+
+// Error returns an error description.
+func (e *ForbiddenError) Error() string {
+	return ""
+}
+
+// ErrorName returns "ForbiddenError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *ForbiddenError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "ForbiddenError".
+func (e *ForbiddenError) GoaErrorName() string {
+	return "Forbidden"
+}
+
+// Error returns an error description.
+func (e *HealthReport) Error() string {
+	return "Structured health report covering every dependency of the service."
+}
+
+// ErrorName returns "HealthReport".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *HealthReport) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "HealthReport".
+func (e *HealthReport) GoaErrorName() string {
+	return "Unhealthy"
+}
+
 // Error returns an error description.
 func (e *InternalServerError) Error() string {
 	return ""
@@ -852,6 +2918,23 @@ func (e *NotFoundError) GoaErrorName() string {
 	return "NotFound"
 }
 
+// Error returns an error description.
+func (e *ProjectContact) Error() string {
+	return ""
+}
+
+// ErrorName returns "ProjectContact".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *ProjectContact) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "ProjectContact".
+func (e *ProjectContact) GoaErrorName() string {
+	return "ProjectContact"
+}
+
 // Error returns an error description.
 func (e *ServiceUnavailableError) Error() string {
 	return ""
@@ -868,3 +2951,37 @@ func (e *ServiceUnavailableError) ErrorName() string {
 func (e *ServiceUnavailableError) GoaErrorName() string {
 	return "ServiceUnavailable"
 }
+
+// Error returns an error description.
+func (e *SettingsMergeConflict) Error() string {
+	return ""
+}
+
+// ErrorName returns "SettingsMergeConflict".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *SettingsMergeConflict) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "SettingsMergeConflict".
+func (e *SettingsMergeConflict) GoaErrorName() string {
+	return "SettingsMergeConflict"
+}
+
+// Error returns an error description.
+func (e *UnprocessableEntityError) Error() string {
+	return ""
+}
+
+// ErrorName returns "UnprocessableEntityError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *UnprocessableEntityError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "UnprocessableEntityError".
+func (e *UnprocessableEntityError) GoaErrorName() string {
+	return "UnprocessableEntity"
+}
@@ -0,0 +1,224 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+
+	internalnats "github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/nats"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/redact"
+)
+
+// envErrors collects the invalid environment variables found by parseEnv. The
+// parse helpers fall back to the defaults so that parsing goes on, and
+// validateEnv reports every invalid variable at once.
+var envErrors []error
+
+// invalidEnv records that the named environment variable is invalid.
+func invalidEnv(name string, format string, args ...any) {
+	envErrors = append(envErrors, fmt.Errorf("%s: %s", name, fmt.Sprintf(format, args...)))
+}
+
+// validateEnv returns every problem with the configuration: the variables
+// parseEnv found invalid, URLs that cannot be used, values outside their
+// ranges, and settings that may not be combined. The service must not start
+// while any are reported.
+func validateEnv(env environment) []error {
+	problems := slices.Clone(envErrors)
+	invalid := func(name string, format string, args ...any) {
+		problems = append(problems, fmt.Errorf("%s: %s", name, fmt.Sprintf(format, args...)))
+	}
+
+	if err := internalnats.ValidateTenant(env.Tenant); err != nil {
+		invalid("TENANT", "%s", err)
+	}
+	if err := internalnats.ValidateSubjectPrefix(env.SubjectPrefix); err != nil {
+		invalid("NATS_SUBJECT_PREFIX", "%s", err)
+	}
+
+	switch env.ProjectRepository {
+	case projectRepositoryNATS, projectRepositoryMemory:
+	case projectRepositoryPostgres:
+		if env.PostgresURL == "" {
+			invalid("POSTGRES_URL", "required when PROJECT_REPOSITORY is %s", projectRepositoryPostgres)
+		}
+	default:
+		invalid("PROJECT_REPOSITORY", "unknown value %q, expected %s, %s or %s",
+			env.ProjectRepository, projectRepositoryNATS, projectRepositoryPostgres, projectRepositoryMemory)
+	}
+	switch env.ConsistencyCheck {
+	case consistencyCheckOff, consistencyCheckReport, consistencyCheckRepair:
+	default:
+		invalid("CONSISTENCY_CHECK", "unknown value %q, expected %s, %s or %s",
+			env.ConsistencyCheck, consistencyCheckOff, consistencyCheckReport, consistencyCheckRepair)
+	}
+	switch env.KVCompression {
+	case internalnats.CompressionNone, internalnats.CompressionGzip, internalnats.CompressionSnappy:
+	default:
+		invalid("KV_COMPRESSION", "unknown value %q, expected %s or %s",
+			env.KVCompression, internalnats.CompressionGzip, internalnats.CompressionSnappy)
+	}
+	for _, target := range env.FaultInjectionTargets {
+		if target != faultTargetKV && target != faultTargetPublish {
+			invalid("FAULT_INJECTION_TARGETS", "unknown value %q, expected %s or %s", target, faultTargetKV, faultTargetPublish)
+		}
+	}
+
+	// The embedded server replaces NATS_URL, and the nats scheme is the default
+	// of NATS URLs.
+	if !env.EmbeddedNATS && env.ProjectRepository != projectRepositoryMemory {
+		natsURLs := strings.Split(env.NatsURL, ",")
+		for i, natsURL := range natsURLs {
+			if natsURL = strings.TrimSpace(natsURL); !strings.Contains(natsURL, "://") {
+				natsURLs[i] = "nats://" + natsURL
+			}
+		}
+		validateURLsEnv(invalid, "NATS_URL", strings.Join(natsURLs, ","), "nats", "tls", "ws", "wss")
+	}
+	for _, v := range []struct {
+		name    string
+		value   string
+		schemes []string
+	}{
+		{"POSTGRES_URL", env.PostgresURL, []string{"postgres", "postgresql"}},
+		{"JWKS_URL", env.JWTAuth.JWKSURL, []string{"http", "https"}},
+		{"OPENSEARCH_URL", env.OpenSearchURL, []string{"http", "https"}},
+		{"LOGO_BASE_URL", env.LogoBaseURL, []string{"http", "https"}},
+		{"CHARTER_BASE_URL", env.CharterBaseURL, []string{"http", "https"}},
+		{"LFX_SELF_SERVE_BASE_URL", env.LFXSelfServeBaseURL, []string{"http", "https"}},
+	} {
+		if v.value != "" {
+			validateURLsEnv(invalid, v.name, v.value, v.schemes...)
+		}
+	}
+
+	// With credentials, the CORS middleware echoes the request origin, so a
+	// wildcard would let any site make credentialed requests.
+	if env.CORSAllowCredentials && slices.Contains(env.CORSAllowedOrigins, "*") {
+		invalid("CORS_ALLOW_CREDENTIALS", "may not be combined with the %q origin of CORS_ALLOWED_ORIGINS", "*")
+	}
+
+	// Hashes of personal data without a secret key could be reversed by
+	// hashing known emails and names.
+	if (env.LogRedaction == redact.Hash || env.IndexerRedaction == redact.Hash) && !env.RedactHashKeySet {
+		invalid("REDACT_PII_HASH_KEY", "required when LOG_REDACT_PII or INDEXER_REDACT_PII is %s", redact.Hash)
+	}
+
+	if env.NATSPublishRetryBaseDelay > env.NATSPublishRetryMaxDelay {
+		invalid("NATS_PUBLISH_RETRY_BASE_DELAY", "%s is longer than NATS_PUBLISH_RETRY_MAX_DELAY %s",
+			env.NATSPublishRetryBaseDelay, env.NATSPublishRetryMaxDelay)
+	}
+	if env.ProjectUpsertRetryBaseDelay > env.ProjectUpsertRetryMaxDelay {
+		invalid("PROJECT_UPSERT_RETRY_BASE_DELAY", "%s is longer than PROJECT_UPSERT_RETRY_MAX_DELAY %s",
+			env.ProjectUpsertRetryBaseDelay, env.ProjectUpsertRetryMaxDelay)
+	}
+	if env.ReadinessProbeTimeout > env.ReadinessProbeInterval {
+		invalid("READINESS_PROBE_TIMEOUT", "%s is longer than READINESS_PROBE_INTERVAL %s",
+			env.ReadinessProbeTimeout, env.ReadinessProbeInterval)
+	}
+
+	// Settings meant for local development and testing may not be used in
+	// production. An unset LFX_ENVIRONMENT is only taken for production where
+	// the setting is meant for tests alone.
+	production := env.LFXEnvironment == "prod" || env.LFXEnvironment == "production"
+	if production && env.JWTAuth.MockLocalPrincipal != "" {
+		invalid("JWT_AUTH_DISABLED_MOCK_LOCAL_PRINCIPAL", "disables authentication and is not allowed when LFX_ENVIRONMENT is %s", env.LFXEnvironment)
+	}
+	if production && env.SkipEtagValidation {
+		invalid("SKIP_ETAG_VALIDATION", "is not allowed when LFX_ENVIRONMENT is %s", env.LFXEnvironment)
+	}
+	if env.FaultInjection && (production || env.LFXEnvironment == "") {
+		invalid("FAULT_INJECTION_ENABLED", "is not allowed in production, set LFX_ENVIRONMENT to a test environment")
+	}
+
+	return problems
+}
+
+// validateURLsEnv reports the named variable unless each URL of its
+// comma-separated value is absolute, with a host and one of the schemes. The
+// value itself is left out of the report as it may hold credentials.
+func validateURLsEnv(invalid func(string, string, ...any), name, value string, schemes ...string) {
+	for _, raw := range strings.Split(value, ",") {
+		u, err := url.Parse(strings.TrimSpace(raw))
+		if err != nil || u.Host == "" {
+			invalid(name, "must be an absolute URL with a host")
+			return
+		}
+		if !slices.Contains(schemes, u.Scheme) {
+			invalid(name, "has scheme %q, expected one of %s", u.Scheme, strings.Join(schemes, ", "))
+			return
+		}
+	}
+}
+
+// logEffectiveConfig logs the configuration the service runs with, defaults
+// included, leaving out credentials.
+func logEffectiveConfig(env environment) {
+	slog.Info("effective configuration",
+		"lfx_environment", env.LFXEnvironment,
+		"port", env.Port,
+		"nats_url", redactURLs(env.NatsURL),
+		"embedded_nats", env.EmbeddedNATS,
+		"tenant", env.Tenant,
+		"nats_subject_prefix", env.SubjectPrefix,
+		"nats_queue_group", env.QueueGroup,
+		"project_repository", env.ProjectRepository,
+		"postgres_url", redactURLs(env.PostgresURL),
+		"consistency_check", env.ConsistencyCheck,
+		"kv_compression", env.KVCompression,
+		"projects_cache", env.ProjectsCache,
+		"autocomplete_index", env.AutocompleteIndex,
+		"slug_cache_size", env.SlugCacheSize,
+		"jwks_url", env.JWTAuth.JWKSURL,
+		"jwt_issuer", env.JWTAuth.Issuer,
+		"audience", env.JWTAuth.Audience,
+		"m2m_clients", len(env.JWTAuth.M2MClients),
+		"jwt_auth_mocked", env.JWTAuth.MockLocalPrincipal != "",
+		"access_check", env.AccessCheckEnabled,
+		"field_permissions", env.FieldPermissions,
+		"private_lookup_policy", env.PrivateLookupPolicy,
+		"graphql", env.GraphQLEnabled,
+		"skip_etag_validation", env.SkipEtagValidation,
+		"rate_limit_ip_rps", env.RateLimitIPRate,
+		"rate_limit_principal_rps", env.RateLimitPrincipalRate,
+		"rate_limit_trust_forwarded_for", env.TrustForwardedFor,
+		"rate_limit_trusted_proxies", len(env.TrustedProxies),
+		"max_request_body_bytes", env.MaxRequestBodyBytes,
+		"cors_allowed_origins", env.CORSAllowedOrigins,
+		"nats_handler_concurrency", env.NATSHandlerConcurrency,
+		"nats_handler_timeout", env.NATSHandlerTimeout,
+		"nats_publish_max_attempts", env.NATSPublishMaxAttempts,
+		"readiness_probe_interval", env.ReadinessProbeInterval,
+		"logo_uploads", env.LogoS3Bucket != "",
+		"charter_uploads", env.CharterS3Bucket != "",
+		"backups", env.BackupS3Bucket != "",
+		"opensearch_url", env.OpenSearchURL,
+		"emails", env.EmailsEnabled,
+		"invites", env.InvitesEnabled,
+		"log_redaction", env.LogRedaction,
+		"indexer_redaction", env.IndexerRedaction,
+		"fault_injection", env.FaultInjection,
+	)
+}
+
+// redactURLs replaces the passwords in a comma-separated list of URLs.
+func redactURLs(value string) string {
+	if value == "" {
+		return ""
+	}
+	urls := strings.Split(value, ",")
+	for i, raw := range urls {
+		u, err := url.Parse(strings.TrimSpace(raw))
+		if err != nil {
+			urls[i] = "[invalid]"
+			continue
+		}
+		urls[i] = u.Redacted()
+	}
+	return strings.Join(urls, ",")
+}
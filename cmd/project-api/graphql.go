@@ -0,0 +1,261 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/service"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+//go:embed schema.graphql
+var graphQLSchema string
+
+const (
+	// graphQLMaxDepth caps the nesting of a GraphQL query, such as
+	// project → children → children → settings → writers.
+	graphQLMaxDepth = 12
+	// graphQLMaxQueryLength caps the length of a GraphQL query, in bytes.
+	graphQLMaxQueryLength = 8 * 1024
+	// graphQLReadScope is the scope a machine-to-machine client needs to query
+	// GraphQL, the same as for the REST read endpoints.
+	graphQLReadScope = "projects:read"
+)
+
+// graphQLContextKey is the context key of the request's ProjectGraph.
+type graphQLContextKey struct{}
+
+// graphQLRequest is the body of a GraphQL request.
+type graphQLRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// graphQLHandler serves read-only GraphQL queries over the project hierarchy.
+// Each request gets its own service.ProjectGraph, which batches and caches the
+// repository reads and access checks made while resolving the query.
+func graphQLHandler(svc *service.ProjectsService) http.Handler {
+	schema := graphql.MustParseSchema(graphQLSchema, &graphQLResolver{},
+		graphql.MaxDepth(graphQLMaxDepth),
+		graphql.MaxQueryLength(graphQLMaxQueryLength),
+	)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		if !svc.ServiceReady() {
+			writeGraphQLError(w, http.StatusServiceUnavailable, domain.ErrServiceUnavailable)
+			return
+		}
+
+		token := r.Header.Get(constants.AuthorizationHeader)
+		if scheme, credentials, ok := strings.Cut(token, " "); ok && strings.EqualFold(scheme, "Bearer") {
+			token = credentials
+		}
+		claims, err := svc.Auth.ParseClaims(ctx, token, slog.Default())
+		if err != nil {
+			writeGraphQLError(w, http.StatusUnauthorized, errors.New("unauthorized"))
+			return
+		}
+		// Machine-to-machine clients are limited to the scopes they were granted.
+		if claims.Scopes != nil && !slices.Contains(claims.Scopes, graphQLReadScope) {
+			slog.WarnContext(ctx, "machine-to-machine client lacks required scope", "principal", claims.Principal)
+			writeGraphQLError(w, http.StatusForbidden, domain.ErrForbidden)
+			return
+		}
+
+		var req graphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeGraphQLError(w, http.StatusBadRequest, domain.ErrValidationFailed)
+			return
+		}
+
+		ctx = context.WithValue(ctx, constants.PrincipalContextID, claims.Principal)
+		ctx = context.WithValue(ctx, constants.RolesContextID, claims.Roles)
+		ctx = context.WithValue(ctx, graphQLContextKey{}, svc.NewProjectGraph())
+		resp := schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			slog.ErrorContext(ctx, "error encoding GraphQL response", errKey, err)
+		}
+	})
+}
+
+// writeGraphQLError writes a GraphQL response with a single error and no data.
+func writeGraphQLError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"errors": []map[string]string{{"message": err.Error()}},
+	})
+}
+
+// projectGraph returns the ProjectGraph of the GraphQL request in ctx.
+func projectGraph(ctx context.Context) *service.ProjectGraph {
+	return ctx.Value(graphQLContextKey{}).(*service.ProjectGraph)
+}
+
+// graphQLResolver resolves the GraphQL Query type.
+type graphQLResolver struct{}
+
+// Project resolves Query.project.
+func (*graphQLResolver) Project(ctx context.Context, args struct{ UID graphql.ID }) (*projectResolver, error) {
+	return newProjectResolver(projectGraph(ctx).Project(ctx, string(args.UID)))
+}
+
+// ProjectBySlug resolves Query.project_by_slug.
+func (*graphQLResolver) ProjectBySlug(ctx context.Context, args struct{ Slug string }) (*projectResolver, error) {
+	return newProjectResolver(projectGraph(ctx).ProjectBySlug(ctx, args.Slug))
+}
+
+// projectResolver resolves the GraphQL Project type.
+type projectResolver struct {
+	p *models.ProjectBase
+}
+
+func newProjectResolver(p *models.ProjectBase, err error) (*projectResolver, error) {
+	if err != nil || p == nil {
+		return nil, err
+	}
+	return &projectResolver{p: p}, nil
+}
+
+func (r *projectResolver) UID() graphql.ID       { return graphql.ID(r.p.UID) }
+func (r *projectResolver) Slug() string          { return r.p.Slug }
+func (r *projectResolver) Name() string          { return r.p.Name }
+func (r *projectResolver) Description() string   { return r.p.Description }
+func (r *projectResolver) Public() bool          { return r.p.Public }
+func (r *projectResolver) IsFoundation() bool    { return r.p.IsFoundation }
+func (r *projectResolver) Stage() string         { return r.p.Stage }
+func (r *projectResolver) Category() string      { return r.p.Category }
+func (r *projectResolver) LogoURL() string       { return r.p.LogoURL }
+func (r *projectResolver) WebsiteURL() string    { return r.p.WebsiteURL }
+func (r *projectResolver) RepositoryURL() string { return r.p.RepositoryURL }
+
+func (r *projectResolver) Tags() []string {
+	if r.p.ProjectTags == nil {
+		return []string{}
+	}
+	return r.p.ProjectTags
+}
+
+func (r *projectResolver) ParentUID() *graphql.ID {
+	if r.p.ParentUID == "" {
+		return nil
+	}
+	uid := graphql.ID(r.p.ParentUID)
+	return &uid
+}
+
+// Parent resolves Project.parent. A parent the principal may not view is
+// reported as null rather than as an error, as it is not what was asked for.
+func (r *projectResolver) Parent(ctx context.Context) (*projectResolver, error) {
+	if r.p.ParentUID == "" {
+		return nil, nil
+	}
+	parent, err := projectGraph(ctx).Project(ctx, r.p.ParentUID)
+	if errors.Is(err, domain.ErrForbidden) {
+		return nil, nil
+	}
+	return newProjectResolver(parent, err)
+}
+
+// Children resolves Project.children.
+func (r *projectResolver) Children(ctx context.Context) ([]*projectResolver, error) {
+	children, err := projectGraph(ctx).Children(ctx, r.p.UID)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*projectResolver, 0, len(children))
+	for _, child := range children {
+		resolvers = append(resolvers, &projectResolver{p: child})
+	}
+	return resolvers, nil
+}
+
+// Settings resolves Project.settings.
+func (r *projectResolver) Settings(ctx context.Context) (*projectSettingsResolver, error) {
+	settings, err := projectGraph(ctx).Settings(ctx, r.p.UID)
+	if err != nil || settings == nil {
+		return nil, err
+	}
+	return &projectSettingsResolver{s: settings}, nil
+}
+
+// projectSettingsResolver resolves the GraphQL ProjectSettings type.
+type projectSettingsResolver struct {
+	s *models.ProjectSettings
+}
+
+func (r *projectSettingsResolver) MissionStatement() string { return r.s.MissionStatement }
+
+func (r *projectSettingsResolver) AnnouncementDate() *string {
+	if r.s.AnnouncementDate == nil {
+		return nil
+	}
+	date := r.s.AnnouncementDate.Format(time.DateOnly)
+	return &date
+}
+
+func (r *projectSettingsResolver) Writers() []*userResolver  { return userResolvers(r.s.Writers) }
+func (r *projectSettingsResolver) Auditors() []*userResolver { return userResolvers(r.s.Auditors) }
+func (r *projectSettingsResolver) MeetingCoordinators() []*userResolver {
+	return userResolvers(r.s.MeetingCoordinators)
+}
+func (r *projectSettingsResolver) ExecutiveDirector() *userResolver {
+	return newUserResolver(r.s.ExecutiveDirector)
+}
+func (r *projectSettingsResolver) ProgramManager() *userResolver {
+	return newUserResolver(r.s.ProgramManager)
+}
+func (r *projectSettingsResolver) OpportunityOwner() *userResolver {
+	return newUserResolver(r.s.OpportunityOwner)
+}
+
+func (r *projectSettingsResolver) UpdatedAt() *string {
+	if r.s.UpdatedAt == nil {
+		return nil
+	}
+	updatedAt := r.s.UpdatedAt.UTC().Format(time.RFC3339)
+	return &updatedAt
+}
+
+// userResolver resolves the GraphQL User type.
+type userResolver struct {
+	u *models.UserInfo
+}
+
+func newUserResolver(u *models.UserInfo) *userResolver {
+	if u == nil {
+		return nil
+	}
+	return &userResolver{u: u}
+}
+
+func userResolvers(users []models.UserInfo) []*userResolver {
+	resolvers := make([]*userResolver, 0, len(users))
+	for i := range users {
+		resolvers = append(resolvers, &userResolver{u: &users[i]})
+	}
+	return resolvers
+}
+
+func (r *userResolver) Username() string { return r.u.Username }
+func (r *userResolver) Name() string     { return r.u.Name }
+func (r *userResolver) Email() string    { return r.u.Email }
+func (r *userResolver) Avatar() string   { return r.u.Avatar }
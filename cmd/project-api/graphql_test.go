@@ -0,0 +1,104 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/auth"
+)
+
+func TestGraphQLHandler(t *testing.T) {
+	updatedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	root := &models.ProjectBase{UID: "root", Slug: "root", Name: "Root", ProjectTags: []string{"cncf"}}
+	child := &models.ProjectBase{UID: "child", Slug: "child", Name: "Child", ParentUID: "root"}
+
+	tests := []struct {
+		name           string
+		authorization  string
+		claims         *domain.Claims
+		body           string
+		setupMocks     func(*domain.MockProjectRepository)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:          "nested project, children, settings and writers",
+			authorization: "Bearer token",
+			claims:        &domain.Claims{Principal: "alice"},
+			body:          `{"query":"query($uid: ID!) { project(uid: $uid) { slug tags children { slug parent_uid parent { name } settings { mission_statement updated_at writers { username } executive_director { username } } } } }","variables":{"uid":"root"}}`,
+			setupMocks: func(repo *domain.MockProjectRepository) {
+				repo.On("GetProjectBase", mock.Anything, "root").Return(root, nil)
+				repo.On("ListAllProjectsBase", mock.Anything).Return([]*models.ProjectBase{root, child}, nil).Once()
+				repo.On("GetProjectSettings", mock.Anything, "child").Return(&models.ProjectSettings{
+					UID:              "child",
+					MissionStatement: "mission",
+					Writers:          []models.UserInfo{{Username: "bob"}},
+					UpdatedAt:        &updatedAt,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"project":{"slug":"root","tags":["cncf"],"children":[{"slug":"child","parent_uid":"root","parent":{"name":"Root"},"settings":{"mission_statement":"mission","updated_at":"2025-01-01T12:00:00Z","writers":[{"username":"bob"}],"executive_director":null}}]}}}`,
+		},
+		{
+			name:          "unknown slug",
+			authorization: "Bearer token",
+			claims:        &domain.Claims{Principal: "alice"},
+			body:          `{"query":"{ project_by_slug(slug: \"missing\") { uid } }"}`,
+			setupMocks: func(repo *domain.MockProjectRepository) {
+				repo.On("GetProjectUIDFromSlug", mock.Anything, "missing").Return("", domain.ErrProjectNotFound)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":{"project_by_slug":null}}`,
+		},
+		{
+			name:           "machine-to-machine client without the read scope",
+			authorization:  "Bearer token",
+			claims:         &domain.Claims{Principal: "client@clients", Scopes: []string{"projects:write"}},
+			body:           `{"query":"{ project(uid: \"root\") { uid } }"}`,
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"errors":[{"message":"forbidden"}]}`,
+		},
+		{
+			name:           "invalid token",
+			authorization:  "Bearer bad",
+			body:           `{"query":"{ project(uid: \"root\") { uid } }"}`,
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `{"errors":[{"message":"unauthorized"}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api, mockRepo, _ := setupAPI()
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockRepo)
+			}
+			mockAuth := api.service.Auth.(*auth.MockJWTAuth)
+			if tt.claims != nil {
+				mockAuth.On("ParseClaims", mock.Anything, "token", mock.Anything).Return(tt.claims, nil)
+			} else {
+				mockAuth.On("ParseClaims", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("invalid token"))
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(tt.body))
+			req.Header.Set("Authorization", tt.authorization)
+			rec := httptest.NewRecorder()
+			graphQLHandler(api.service).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+		})
+	}
+}
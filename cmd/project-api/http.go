@@ -130,3 +130,111 @@ func uploadDocumentDecoder(mr *multipart.Reader, p **projsvc.UploadProjectDocume
 
 	return nil
 }
+
+// uploadLogoDecoder is the multipart decoder for the upload-project-logo endpoint.
+// It reads the file part, capped at MaxLogoFileSize+1 to detect oversized uploads,
+// and takes the content type from the content_type field or else the file part's header.
+func uploadLogoDecoder(mr *multipart.Reader, p **projsvc.UploadProjectLogoPayload) error {
+	payload := *p
+	if payload == nil {
+		payload = &projsvc.UploadProjectLogoPayload{}
+		*p = payload
+	}
+
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		switch part.FormName() {
+		case "content_type":
+			data, err := io.ReadAll(io.LimitReader(part, maxTextPartSize+1))
+			if err != nil {
+				return err
+			}
+			if int64(len(data)) > maxTextPartSize {
+				slog.Warn("multipart field exceeds max size", "field", "content_type", "max_bytes", maxTextPartSize)
+				return createResponse(http.StatusBadRequest, domain.ErrValidationFailed)
+			}
+			contentType := string(data)
+			if ct, _, err := mime.ParseMediaType(contentType); err == nil {
+				contentType = ct
+			}
+			payload.ContentType = &contentType
+
+		case "file":
+			if payload.ContentType == nil {
+				contentType := part.Header.Get("Content-Type")
+				if ct, _, err := mime.ParseMediaType(contentType); err == nil {
+					contentType = ct
+				}
+				payload.ContentType = &contentType
+			}
+
+			data, err := io.ReadAll(io.LimitReader(part, models.MaxLogoFileSize+1))
+			if err != nil {
+				return err
+			}
+			payload.File = data
+		}
+
+		_ = part.Close()
+	}
+
+	return nil
+}
+
+// uploadCharterDecoder is the multipart decoder for the upload-project-charter endpoint.
+// It reads the file part, capped at MaxCharterFileSize+1 to detect oversized uploads,
+// and takes the file name from the file_name field or else the file part's header.
+func uploadCharterDecoder(mr *multipart.Reader, p **projsvc.UploadProjectCharterPayload) error {
+	payload := *p
+	if payload == nil {
+		payload = &projsvc.UploadProjectCharterPayload{}
+		*p = payload
+	}
+
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		switch part.FormName() {
+		case "file_name":
+			data, err := io.ReadAll(io.LimitReader(part, maxTextPartSize+1))
+			if err != nil {
+				return err
+			}
+			if int64(len(data)) > maxTextPartSize {
+				slog.Warn("multipart field exceeds max size", "field", "file_name", "max_bytes", maxTextPartSize)
+				return createResponse(http.StatusBadRequest, domain.ErrValidationFailed)
+			}
+			fileName := string(data)
+			payload.FileName = &fileName
+
+		case "file":
+			if payload.FileName == nil && part.FileName() != "" {
+				fileName := part.FileName()
+				payload.FileName = &fileName
+			}
+
+			data, err := io.ReadAll(io.LimitReader(part, models.MaxCharterFileSize+1))
+			if err != nil {
+				return err
+			}
+			payload.File = data
+		}
+
+		_ = part.Close()
+	}
+
+	return nil
+}
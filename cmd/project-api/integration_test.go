@@ -0,0 +1,280 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+//go:build integration
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tcnats "github.com/testcontainers/testcontainers-go/modules/nats"
+
+	projectservice "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	internalnats "github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/nats"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/client"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// The integration tests run the service binary against NATS with JetStream in
+// a container and exercise it through its REST API and NATS subjects, with
+// the real key-value buckets behind it. They need Docker:
+//
+//	go test -tags integration ./cmd/project-api/
+
+const (
+	integrationNATSImage    = "nats:2.11.7"
+	integrationReadyTimeout = 30 * time.Second
+	integrationMsgTimeout   = 10 * time.Second
+)
+
+// integrationStack is the running service and the NATS server behind it.
+type integrationStack struct {
+	client *client.Client
+	nc     *nats.Conn
+}
+
+// lockedBuffer collects the output of the service, which is written while
+// the tests read it on failure.
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// startIntegrationStack starts NATS in a container, creates the buckets of
+// the service, and builds and starts the service against it. Both are
+// stopped when the test ends.
+func startIntegrationStack(t *testing.T) *integrationStack {
+	t.Helper()
+	ctx := context.Background()
+
+	container, err := tcnats.Run(ctx, integrationNATSImage)
+	require.NoError(t, err, "starting NATS container")
+	t.Cleanup(func() {
+		if err := container.Terminate(context.Background()); err != nil {
+			t.Logf("terminating NATS container: %v", err)
+		}
+	})
+	natsURL, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	nc, err := nats.Connect(natsURL)
+	require.NoError(t, err)
+	t.Cleanup(nc.Close)
+	js, err := jetstream.New(nc)
+	require.NoError(t, err)
+	require.NoError(t, internalnats.CreateBuckets(ctx, js))
+
+	bin := filepath.Join(t.TempDir(), "project-api")
+	build := exec.Command("go", "build", "-o", bin, ".")
+	out, err := build.CombinedOutput()
+	require.NoError(t, err, "building service: %s", out)
+
+	port := freePort(t)
+	output := &lockedBuffer{}
+	cmd := exec.Command(bin)
+	cmd.Env = append(os.Environ(),
+		"NATS_URL="+natsURL,
+		"PORT="+port,
+		"JWT_AUTH_DISABLED_MOCK_LOCAL_PRINCIPAL=integration-test",
+		"PROJECT_REPOSITORY=",
+		"EMBEDDED_NATS=",
+	)
+	cmd.Stdout = output
+	cmd.Stderr = output
+	require.NoError(t, cmd.Start(), "starting service")
+	t.Cleanup(func() {
+		_ = cmd.Process.Signal(syscall.SIGTERM)
+		done := make(chan struct{})
+		go func() {
+			_ = cmd.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(integrationReadyTimeout):
+			_ = cmd.Process.Kill()
+			<-done
+		}
+		if t.Failed() {
+			t.Logf("service output:\n%s", output.String())
+		}
+	})
+
+	baseURL := "http://127.0.0.1:" + port
+	waitReady(t, baseURL+"/readyz", output)
+
+	c, err := client.New(client.Config{
+		BaseURL: baseURL,
+		Token:   client.StaticToken("integration-test"),
+	})
+	require.NoError(t, err)
+
+	return &integrationStack{client: c, nc: nc}
+}
+
+// freePort returns a loopback port that was free when asked.
+func freePort(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return fmt.Sprint(l.Addr().(*net.TCPAddr).Port)
+}
+
+// waitReady waits until url answers 200, failing the test with the service
+// output if it does not in time.
+func waitReady(t *testing.T, url string, output *lockedBuffer) {
+	t.Helper()
+	deadline := time.Now().Add(integrationReadyTimeout)
+	for time.Now().Before(deadline) {
+		resp, err := http.Get(url)
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return
+			}
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+	t.Fatalf("service not ready at %s after %s; output:\n%s", url, integrationReadyTimeout, output.String())
+}
+
+func TestIntegration(t *testing.T) {
+	stack := startIntegrationStack(t)
+	ctx := context.Background()
+
+	indexed, err := stack.nc.SubscribeSync(constants.IndexProjectSubject)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = indexed.Unsubscribe() })
+
+	slug := fmt.Sprintf("integration-%d", time.Now().UnixNano())
+	created, err := stack.client.CreateProject(ctx, &projectservice.CreateProjectPayload{
+		Name:        "Integration Project",
+		Slug:        &slug,
+		Description: "A project created by the integration tests.",
+		ParentUID:   "",
+		// Only crowdfunded projects may be deleted.
+		FundingModel: []string{"Crowdfunding"},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, created.UID)
+	uid := *created.UID
+
+	t.Run("create is indexed", func(t *testing.T) {
+		msg, err := indexed.NextMsg(integrationMsgTimeout)
+		require.NoError(t, err)
+		assert.Contains(t, string(msg.Data), uid)
+	})
+
+	t.Run("lookup subjects", func(t *testing.T) {
+		reply, err := stack.nc.Request(constants.ProjectSlugToUIDSubject, []byte(slug), integrationMsgTimeout)
+		require.NoError(t, err)
+		assert.Equal(t, uid, string(reply.Data))
+
+		reply, err = stack.nc.Request(constants.ProjectGetNameSubject, []byte(uid), integrationMsgTimeout)
+		require.NoError(t, err)
+		assert.Equal(t, "Integration Project", string(reply.Data))
+
+		reply, err = stack.nc.Request(constants.ProjectGetSlugSubject, []byte(uid), integrationMsgTimeout)
+		require.NoError(t, err)
+		assert.Equal(t, slug, string(reply.Data))
+	})
+
+	t.Run("update with ETag", func(t *testing.T) {
+		_, staleETag, err := stack.client.GetProject(ctx, uid)
+		require.NoError(t, err)
+
+		updated, err := stack.client.ModifyProject(ctx, uid, func(p *projectservice.UpdateProjectBasePayload) error {
+			p.Name = "Renamed Integration Project"
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed Integration Project", *updated.Name)
+
+		// The stale ETag is rejected by the key-value store's revision check.
+		project, _, err := stack.client.GetProject(ctx, uid)
+		require.NoError(t, err)
+		_, err = stack.client.UpdateProject(ctx, &projectservice.UpdateProjectBasePayload{
+			UID:         &uid,
+			Name:        "Stale Name",
+			Slug:        *project.Slug,
+			Description: *project.Description,
+			ParentUID:   "",
+		}, staleETag)
+		assert.ErrorIs(t, err, client.ErrConflict)
+
+		reply, err := stack.nc.Request(constants.ProjectGetNameSubject, []byte(uid), integrationMsgTimeout)
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed Integration Project", string(reply.Data))
+	})
+
+	t.Run("update settings with ETag", func(t *testing.T) {
+		_, staleETag, err := stack.client.GetProjectSettings(ctx, uid)
+		require.NoError(t, err)
+
+		mission := "Integration testing."
+		updated, err := stack.client.UpdateProjectSettings(ctx, &projectservice.UpdateProjectSettingsPayload{
+			UID:              &uid,
+			MissionStatement: &mission,
+		}, staleETag)
+		require.NoError(t, err)
+		assert.Equal(t, mission, *updated.MissionStatement)
+
+		_, err = stack.client.UpdateProjectSettings(ctx, &projectservice.UpdateProjectSettingsPayload{
+			UID:              &uid,
+			MissionStatement: &mission,
+		}, staleETag)
+		assert.ErrorIs(t, err, client.ErrConflict)
+	})
+
+	t.Run("delete with ETag", func(t *testing.T) {
+		_, etag, err := stack.client.GetProject(ctx, uid)
+		require.NoError(t, err)
+
+		_, err = stack.client.ModifyProject(ctx, uid, func(p *projectservice.UpdateProjectBasePayload) error {
+			p.Description = "About to be deleted."
+			return nil
+		})
+		require.NoError(t, err)
+		assert.ErrorIs(t, stack.client.DeleteProject(ctx, uid, etag), client.ErrConflict)
+
+		_, etag, err = stack.client.GetProject(ctx, uid)
+		require.NoError(t, err)
+		require.NoError(t, stack.client.DeleteProject(ctx, uid, etag))
+
+		_, _, err = stack.client.GetProject(ctx, uid)
+		assert.ErrorIs(t, err, client.ErrNotFound)
+
+		reply, err := stack.nc.Request(constants.ProjectSlugToUIDSubject, []byte(slug), integrationMsgTimeout)
+		require.NoError(t, err)
+		assert.NotEqual(t, uid, string(reply.Data))
+	})
+}
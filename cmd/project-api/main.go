@@ -7,12 +7,18 @@ package main
 
 import (
 	"context"
+	"errors"
 	_ "expvar"
 	"flag"
+	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -20,22 +26,32 @@ import (
 	"github.com/go-chi/chi/v5"
 	nats "github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
+	"github.com/nats-io/nats.go/micro"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/codes"
 	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
 	"go.opentelemetry.io/otel/trace"
 	goahttp "goa.design/goa/v3/http"
 
-	inviteapi "github.com/linuxfoundation/lfx-v2-invite-service/pkg/api"
 	genhttp "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/http/project_service/server"
 	genquerysvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/auth"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/logo"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/memory"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/metrics"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/middleware"
 	internalnats "github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/nats"
+	osinfra "github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/opensearch"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/postgres"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/s3"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/webhook"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/service"
 	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/redact"
 	"github.com/linuxfoundation/lfx-v2-project-service/pkg/utils"
 )
 
@@ -53,24 +69,116 @@ const (
 	// request timeout, and lower than the pod or liveness probe's
 	// terminationGracePeriodSeconds.
 	gracefulShutdownSeconds = 25
+	// outboxDispatchInterval is how often pending outbox messages are retried.
+	outboxDispatchInterval = 30 * time.Second
+	// webhookDispatchInterval is how often due webhook deliveries are retried.
+	webhookDispatchInterval = 30 * time.Second
+	// projectRepositoryNATS, projectRepositoryPostgres and projectRepositoryMemory
+	// are the PROJECT_REPOSITORY values selecting where projects and their
+	// settings are stored.
+	projectRepositoryNATS     = "nats"
+	projectRepositoryPostgres = "postgres"
+	projectRepositoryMemory   = "memory"
+	// consistencyCheckOff, consistencyCheckReport and consistencyCheckRepair are the
+	// CONSISTENCY_CHECK values selecting what the startup consistency check does.
+	consistencyCheckOff    = "off"
+	consistencyCheckReport = "report"
+	consistencyCheckRepair = "repair"
+	// faultTargetKV and faultTargetPublish are the FAULT_INJECTION_TARGETS
+	// values selecting what faults are injected into.
+	faultTargetKV      = "kv"
+	faultTargetPublish = "publish"
+	// defaultSlugCacheSize is the number of slug-to-UID mappings cached in memory
+	// when SLUG_CACHE_SIZE is unset.
+	defaultSlugCacheSize = 1000
+	// defaultKVCompressionMinBytes is the size from which project settings
+	// values are compressed when KV_COMPRESSION_MIN_BYTES is unset.
+	defaultKVCompressionMinBytes = internalnats.DefaultCompressionMinBytes
+	// defaultMaxRequestBodyBytes caps request bodies other than document
+	// uploads when MAX_REQUEST_BODY_BYTES is unset.
+	defaultMaxRequestBodyBytes = 1 << 20
+	// defaultMaxTextLength caps the project description and mission statement,
+	// in characters, when MAX_DESCRIPTION_LENGTH or MAX_MISSION_STATEMENT_LENGTH is unset.
+	defaultMaxTextLength = 10000
+	// defaultDuplicateNameSimilarity is the name similarity from which a new
+	// project is considered a duplicate when DUPLICATE_NAME_SIMILARITY is unset.
+	defaultDuplicateNameSimilarity = 0.9
+	// defaultNATSHandlerConcurrency is the number of NATS messages handled at
+	// once when NATS_HANDLER_CONCURRENCY is unset.
+	defaultNATSHandlerConcurrency = 32
+	// defaultNATSHandlerTimeout is how long a NATS message handler may run when
+	// NATS_HANDLER_TIMEOUT is unset.
+	defaultNATSHandlerTimeout = 10 * time.Second
+	// defaultNATSPublishMaxAttempts is how many times an indexer or access
+	// message is sent before giving up when NATS_PUBLISH_MAX_ATTEMPTS is unset.
+	defaultNATSPublishMaxAttempts = 3
+	// defaultNATSPublishRetryBaseDelay and defaultNATSPublishRetryMaxDelay bound
+	// the backoff between publish attempts.
+	defaultNATSPublishRetryBaseDelay = 100 * time.Millisecond
+	defaultNATSPublishRetryMaxDelay  = 2 * time.Second
+	// defaultProjectUpsertMaxDeliver is how many times a project upsert whose
+	// handler fails with a transient error is delivered when
+	// PROJECT_UPSERT_MAX_DELIVER is unset.
+	defaultProjectUpsertMaxDeliver = 5
+	// defaultProjectUpsertRetryBaseDelay and defaultProjectUpsertRetryMaxDelay
+	// bound the backoff between the deliveries of a project upsert.
+	defaultProjectUpsertRetryBaseDelay = time.Second
+	defaultProjectUpsertRetryMaxDelay  = time.Minute
+	// defaultNATSPublishBreakerThreshold is the number of consecutive failed
+	// messages that opens the publish circuit breaker.
+	defaultNATSPublishBreakerThreshold = 5
+	// defaultNATSPublishBreakerCooldown is how long the publish circuit breaker
+	// stays open before a trial message is let through.
+	defaultNATSPublishBreakerCooldown = 30 * time.Second
+	// defaultLogoS3Region is the region of the logo bucket when LOGO_S3_REGION is unset.
+	defaultLogoS3Region = "us-west-2"
+	// defaultCharterS3Region is the region of the charter bucket when CHARTER_S3_REGION is unset.
+	defaultCharterS3Region = "us-west-2"
+	// defaultBackupS3Region is the region of the backup bucket when BACKUP_S3_REGION is unset.
+	defaultBackupS3Region = "us-west-2"
+	// defaultBackupS3Prefix is the key prefix of backup archives when BACKUP_S3_PREFIX is unset.
+	defaultBackupS3Prefix = "project-service/"
+	// defaultUserInfoCacheSize is the number of user profiles cached in memory
+	// when USER_INFO_CACHE_SIZE is unset.
+	defaultUserInfoCacheSize = 1000
+	// defaultUserInfoCacheTTL is how long a cached user profile is used before
+	// it is read again when USER_INFO_CACHE_TTL is unset.
+	defaultUserInfoCacheTTL = time.Hour
+	// defaultReadinessProbeInterval is how often NATS and the projects bucket
+	// are probed for readiness when READINESS_PROBE_INTERVAL is unset.
+	defaultReadinessProbeInterval = 5 * time.Second
+	// defaultReadinessProbeTimeout bounds each readiness probe when
+	// READINESS_PROBE_TIMEOUT is unset.
+	defaultReadinessProbeTimeout = 2 * time.Second
+	// defaultReadinessProbeFailureThreshold is the number of consecutive failed
+	// probes after which the service reports not ready when
+	// READINESS_PROBE_FAILURE_THRESHOLD is unset.
+	defaultReadinessProbeFailureThreshold = 3
 )
 
 func main() {
 	env := parseEnv()
-	flags := parseFlags(env.Port)
+	flags := parseFlags(env.Port, env.EmbeddedNATS)
+	env.EmbeddedNATS = flags.EmbeddedNATS
 
 	log.InitStructureLogConfig()
 
+	// Every invalid setting is reported at once, before anything is started.
+	if problems := validateEnv(env); len(problems) > 0 {
+		report := make([]string, len(problems))
+		for i, problem := range problems {
+			report[i] = problem.Error()
+		}
+		slog.Error("invalid configuration", "problems", report)
+		os.Exit(1)
+	}
+	logEffectiveConfig(env)
+
 	// Set up JWT validator needed by the [ProjectsService.JWTAuth] security handler.
 	// This is initialized before OpenTelemetry so that os.Exit(1) does not
 	// skip the deferred OTel shutdown. NewJWTAuth only stores config; actual
 	// JWKS fetching happens at request time when OTel is active.
-	jwtAuthConfig := auth.JWTAuthConfig{
-		JWKSURL:            os.Getenv("JWKS_URL"),
-		Audience:           os.Getenv("AUDIENCE"),
-		MockLocalPrincipal: os.Getenv("JWT_AUTH_DISABLED_MOCK_LOCAL_PRINCIPAL"),
-	}
-	jwtAuth, err := auth.NewJWTAuth(jwtAuthConfig)
+	jwtAuth, err := auth.NewJWTAuth(env.JWTAuth)
 	if err != nil {
 		slog.With(errKey, err).Error("error setting up JWT authentication")
 		os.Exit(1)
@@ -99,46 +207,89 @@ func main() {
 
 	// Generated service initialization.
 	service := service.NewProjectsService(jwtAuth, service.ServiceConfig{
-		SkipEtagValidation:  env.SkipEtagValidation,
-		LFXSelfServeBaseURL: env.LFXSelfServeBaseURL,
-		EmailsEnabled:       env.EmailsEnabled,
-		InvitesEnabled:      env.InvitesEnabled,
+		SkipEtagValidation:        env.SkipEtagValidation,
+		LFXSelfServeBaseURL:       env.LFXSelfServeBaseURL,
+		EmailsEnabled:             env.EmailsEnabled,
+		InvitesEnabled:            env.InvitesEnabled,
+		MaxHierarchyDepth:         env.MaxHierarchyDepth,
+		MaxChildProjects:          env.MaxChildProjects,
+		TrustedPrincipals:         env.TrustedPrincipals,
+		EnforceFieldPermissions:   env.FieldPermissions,
+		PrivateLookupPolicy:       env.PrivateLookupPolicy,
+		VersionedLookupsOnly:      env.VersionedLookupsOnly,
+		MaxDescriptionLength:      env.MaxDescriptionLength,
+		MaxMissionStatementLength: env.MaxMissionStatementLength,
+		RefreshUserInfo:           env.UserInfoRefresh,
+		DuplicateCheck:            env.DuplicateCheck,
+		DuplicateNameSimilarity:   env.DuplicateNameSimilarity,
+		AutojoinRole:              env.AutojoinRole,
+		AnnouncementPublic:        env.AnnouncementPublic,
+		AnnouncementStage:         env.AnnouncementStage,
+		Tenant:                    env.Tenant,
+		SubjectPrefix:             env.SubjectPrefix,
+		ProjectCachePolicy:        env.ProjectCachePolicy,
+		ProjectListCachePolicy:    env.ProjectListCachePolicy,
 	})
+	service.RegisterHealthCheck("jwks", jwtAuth)
 	svc := NewProjectsAPI(service)
 
 	gracefulCloseWG := sync.WaitGroup{}
 
-	httpServer := setupHTTPServer(flags, svc, &gracefulCloseWG)
+	if env.GraphQLEnabled && !env.AccessCheckEnabled {
+		slog.Warn("GraphQL is enabled without ACCESS_CHECK_ENABLED, so every authenticated principal can read every project")
+	}
+	httpServer := setupHTTPServer(flags, svc, rateLimitConfig(env), corsConfig(env), env.RequestLogger, int64(env.MaxRequestBodyBytes), env.GraphQLEnabled, &gracefulCloseWG)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
+	jwtAuth.StartKeyRefresh(ctx)
+	setupLogoStorage(ctx, env, svc)
+	setupCharterStorage(ctx, env, svc)
+	setupBackupStorage(ctx, env, svc)
+	setupProjectSearch(ctx, env, svc)
 	done := make(chan os.Signal, 1)
 	signal.Notify(done, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 
-	natsConn, err := setupNATS(ctx, env, svc, &gracefulCloseWG, done)
-	if err != nil {
-		slog.With(errKey, err).Error("error setting up NATS")
-		return
+	workers := internalnats.NewWorkerPool(env.NATSHandlerConcurrency, env.NATSHandlerTimeout)
+	var natsConn *nats.Conn
+	if env.ProjectRepository == projectRepositoryMemory {
+		setupMemoryRepository(ctx, svc)
+	} else {
+		if env.EmbeddedNATS {
+			shutdownEmbeddedNATS, err := startEmbeddedNATS(&env)
+			if err != nil {
+				slog.With(errKey, err).Error("error starting embedded NATS server")
+				return
+			}
+			defer shutdownEmbeddedNATS()
+		}
+		natsConn, err = setupNATS(ctx, env, svc, workers, &gracefulCloseWG, done)
+		if err != nil {
+			slog.With(errKey, err).Error("error setting up NATS")
+			return
+		}
 	}
 
 	// This next line blocks until SIGINT or SIGTERM is received.
 	<-done
 
-	gracefulShutdown(httpServer, natsConn, &gracefulCloseWG, cancel)
+	gracefulShutdown(httpServer, natsConn, workers, &gracefulCloseWG, cancel)
 
 }
 
 // flags are the command line flags for the project service.
 type flags struct {
-	Debug bool
-	Port  string
-	Bind  string
+	Debug        bool
+	Port         string
+	Bind         string
+	EmbeddedNATS bool
 }
 
-func parseFlags(defaultPort string) flags {
+func parseFlags(defaultPort string, defaultEmbeddedNATS bool) flags {
 	var debug = flag.Bool("d", false, "enable debug logging")
 	var port = flag.String("p", defaultPort, "listen port")
 	var bind = flag.String("bind", "*", "interface to bind on")
+	var embeddedNATS = flag.Bool("embedded-nats", defaultEmbeddedNATS, "run an in-process NATS server with JetStream, creating the buckets")
 
 	flag.Usage = func() {
 		flag.PrintDefaults()
@@ -156,20 +307,103 @@ func parseFlags(defaultPort string) flags {
 	}
 
 	return flags{
-		Debug: *debug,
-		Port:  *port,
-		Bind:  *bind,
+		Debug:        *debug,
+		Port:         *port,
+		Bind:         *bind,
+		EmbeddedNATS: *embeddedNATS,
 	}
 }
 
 // environment are the environment variables for the project service.
 type environment struct {
-	NatsURL             string
-	Port                string
-	SkipEtagValidation  bool
-	LFXSelfServeBaseURL string
-	EmailsEnabled       bool
-	InvitesEnabled      bool
+	NatsURL                     string
+	EmbeddedNATS                bool
+	EmbeddedNATSStoreDir        string
+	Port                        string
+	SkipEtagValidation          bool
+	LFXSelfServeBaseURL         string
+	EmailsEnabled               bool
+	InvitesEnabled              bool
+	SlugCacheSize               int
+	ProjectsCache               bool
+	AutocompleteIndex           bool
+	KVCompression               string
+	KVCompressionMinBytes       int
+	Tenant                      string
+	SubjectPrefix               string
+	QueueGroup                  string
+	ProjectRepository           string
+	PostgresURL                 string
+	ConsistencyCheck            string
+	MaxHierarchyDepth           int
+	MaxChildProjects            int
+	AccessCheckEnabled          bool
+	GraphQLEnabled              bool
+	TrustedPrincipals           []string
+	FieldPermissions            bool
+	PrivateLookupPolicy         service.PrivateLookupPolicy
+	VersionedLookupsOnly        bool
+	RateLimitIPRate             float64
+	RateLimitIPBurst            int
+	RateLimitPrincipalRate      float64
+	RateLimitPrincipalBurst     int
+	TrustForwardedFor           bool
+	TrustedProxies              []*net.IPNet
+	MaxRequestBodyBytes         int
+	MaxDescriptionLength        int
+	MaxMissionStatementLength   int
+	CORSAllowedOrigins          []string
+	CORSAllowedMethods          []string
+	CORSAllowedHeaders          []string
+	CORSAllowCredentials        bool
+	CORSMaxAge                  time.Duration
+	NATSHandlerConcurrency      int
+	NATSHandlerTimeout          time.Duration
+	NATSListConcurrency         int
+	NATSPublishMaxAttempts      int
+	NATSPublishRetryBaseDelay   time.Duration
+	NATSPublishRetryMaxDelay    time.Duration
+	NATSPublishBreakerThreshold int
+	NATSPublishBreakerCooldown  time.Duration
+	ProjectUpsertMaxDeliver     int
+	ProjectUpsertRetryBaseDelay time.Duration
+	ProjectUpsertRetryMaxDelay  time.Duration
+	LogoS3Bucket                string
+	LogoS3Region                string
+	LogoBaseURL                 string
+	LogoInkscapePath            string
+	CharterS3Bucket             string
+	CharterS3Region             string
+	BackupS3Bucket              string
+	BackupS3Region              string
+	BackupS3Prefix              string
+	OpenSearchURL               string
+	CharterBaseURL              string
+	UserInfoRefresh             bool
+	UserInfoCacheSize           int
+	UserInfoCacheTTL            time.Duration
+	UserInfoRefreshInterval     time.Duration
+	DuplicateCheck              bool
+	DuplicateNameSimilarity     float64
+	AutojoinRole                models.ProjectMemberRole
+	AnnouncementCheckInterval   time.Duration
+	AnnouncementPublic          bool
+	AnnouncementStage           string
+	IntegrityScanInterval       time.Duration
+	ProjectCachePolicy          service.CachePolicy
+	ProjectListCachePolicy      service.CachePolicy
+	LFXEnvironment              string
+	FaultInjection              bool
+	FaultInjectionTargets       []string
+	FaultInjectionConfig        internalnats.FaultConfig
+	RequestLogger               middleware.RequestLoggerConfig
+	LogRedaction                redact.Mode
+	IndexerRedaction            redact.Mode
+	RedactHashKeySet            bool
+	JWTAuth                     auth.JWTAuthConfig
+	ReadinessProbeInterval      time.Duration
+	ReadinessProbeTimeout       time.Duration
+	ReadinessProbeFailures      int
 }
 
 func parseEnv() environment {
@@ -186,17 +420,414 @@ func parseEnv() environment {
 	if skipEtagValidationStr == "true" {
 		skipEtagValidation = true
 	}
+	slugCacheSize := defaultSlugCacheSize
+	if slugCacheSizeStr := os.Getenv("SLUG_CACHE_SIZE"); slugCacheSizeStr != "" {
+		size, err := strconv.Atoi(slugCacheSizeStr)
+		if err != nil {
+			invalidEnv("SLUG_CACHE_SIZE", "%q is not an integer", slugCacheSizeStr)
+		} else {
+			slugCacheSize = size
+		}
+	}
+	projectRepository := os.Getenv("PROJECT_REPOSITORY")
+	if projectRepository == "" {
+		projectRepository = projectRepositoryNATS
+	}
+	consistencyCheck := os.Getenv("CONSISTENCY_CHECK")
+	if consistencyCheck == "" {
+		consistencyCheck = consistencyCheckRepair
+	}
+	privateLookupPolicy := service.PrivateLookupPolicy(os.Getenv("PRIVATE_LOOKUP_POLICY"))
+	switch privateLookupPolicy {
+	case service.PrivateLookupAllow, service.PrivateLookupRedact:
+	case "":
+		privateLookupPolicy = service.PrivateLookupAllow
+	default:
+		invalidEnv("PRIVATE_LOOKUP_POLICY", "unknown value %q, expected %s or %s", privateLookupPolicy, service.PrivateLookupAllow, service.PrivateLookupRedact)
+		privateLookupPolicy = service.PrivateLookupAllow
+	}
+	logoS3Region := os.Getenv("LOGO_S3_REGION")
+	if logoS3Region == "" {
+		logoS3Region = defaultLogoS3Region
+	}
+	logoInkscapePath := os.Getenv("LOGO_INKSCAPE_PATH")
+	if logoInkscapePath == "" {
+		logoInkscapePath = "inkscape"
+	}
+	charterS3Region := os.Getenv("CHARTER_S3_REGION")
+	if charterS3Region == "" {
+		charterS3Region = defaultCharterS3Region
+	}
+	backupS3Region := os.Getenv("BACKUP_S3_REGION")
+	if backupS3Region == "" {
+		backupS3Region = defaultBackupS3Region
+	}
+	backupS3Prefix := os.Getenv("BACKUP_S3_PREFIX")
+	if backupS3Prefix == "" {
+		backupS3Prefix = defaultBackupS3Prefix
+	}
+	duplicateNameSimilarity := defaultDuplicateNameSimilarity
+	if value := os.Getenv("DUPLICATE_NAME_SIMILARITY"); value != "" {
+		similarity, err := strconv.ParseFloat(value, 64)
+		if err != nil || similarity <= 0 || similarity > 1 {
+			invalidEnv("DUPLICATE_NAME_SIMILARITY", "%q is not a number above 0 and at most 1", value)
+		} else {
+			duplicateNameSimilarity = similarity
+		}
+	}
+	autojoinRole := models.ProjectMemberRole(os.Getenv("AUTOJOIN_ROLE"))
+	switch {
+	case autojoinRole == "":
+		autojoinRole = models.ProjectMemberRoleAuditor
+	case !autojoinRole.IsValid():
+		invalidEnv("AUTOJOIN_ROLE", "unknown value %q", autojoinRole)
+		autojoinRole = models.ProjectMemberRoleAuditor
+	}
+	logRedaction, err := redact.ParseMode(os.Getenv("LOG_REDACT_PII"))
+	if err != nil {
+		invalidEnv("LOG_REDACT_PII", "%s", err)
+	}
+	indexerRedaction, err := redact.ParseMode(os.Getenv("INDEXER_REDACT_PII"))
+	if err != nil {
+		invalidEnv("INDEXER_REDACT_PII", "%s", err)
+	}
+	m2mClients, err := auth.ParseM2MClients(os.Getenv("M2M_CLIENTS"))
+	if err != nil {
+		invalidEnv("M2M_CLIENTS", "%s", err)
+	}
 	lfxSelfServeBaseURL := LFXSelfServeBaseURL()
 	return environment{
-		NatsURL:             natsURL,
-		Port:                port,
-		SkipEtagValidation:  skipEtagValidation,
-		LFXSelfServeBaseURL: lfxSelfServeBaseURL,
-		EmailsEnabled:       os.Getenv("EMAILS_ENABLED") == "true",
-		InvitesEnabled:      os.Getenv("INVITES_ENABLED") == "true",
+		NatsURL:                     natsURL,
+		EmbeddedNATS:                os.Getenv("EMBEDDED_NATS") == "true",
+		EmbeddedNATSStoreDir:        os.Getenv("EMBEDDED_NATS_STORE_DIR"),
+		Port:                        port,
+		SkipEtagValidation:          skipEtagValidation,
+		LFXSelfServeBaseURL:         lfxSelfServeBaseURL,
+		EmailsEnabled:               os.Getenv("EMAILS_ENABLED") == "true",
+		InvitesEnabled:              os.Getenv("INVITES_ENABLED") == "true",
+		SlugCacheSize:               slugCacheSize,
+		ProjectsCache:               os.Getenv("PROJECTS_CACHE_ENABLED") == "true",
+		AutocompleteIndex:           os.Getenv("AUTOCOMPLETE_INDEX_ENABLED") == "true",
+		KVCompression:               os.Getenv("KV_COMPRESSION"),
+		KVCompressionMinBytes:       parseLimitEnvOrDefault("KV_COMPRESSION_MIN_BYTES", defaultKVCompressionMinBytes),
+		Tenant:                      os.Getenv("TENANT"),
+		SubjectPrefix:               os.Getenv("NATS_SUBJECT_PREFIX"),
+		QueueGroup:                  os.Getenv("NATS_QUEUE_GROUP"),
+		ProjectRepository:           projectRepository,
+		PostgresURL:                 os.Getenv("POSTGRES_URL"),
+		ConsistencyCheck:            consistencyCheck,
+		MaxHierarchyDepth:           parseLimitEnv("MAX_HIERARCHY_DEPTH"),
+		MaxChildProjects:            parseLimitEnv("MAX_CHILD_PROJECTS"),
+		AccessCheckEnabled:          os.Getenv("ACCESS_CHECK_ENABLED") == "true",
+		GraphQLEnabled:              os.Getenv("GRAPHQL_ENABLED") == "true",
+		TrustedPrincipals:           parseListEnv("TRUSTED_SERVICE_PRINCIPALS"),
+		FieldPermissions:            os.Getenv("FIELD_PERMISSIONS_ENABLED") == "true",
+		PrivateLookupPolicy:         privateLookupPolicy,
+		VersionedLookupsOnly:        os.Getenv("NATS_VERSIONED_LOOKUPS_ONLY") == "true",
+		RateLimitIPRate:             parseRateEnv("RATE_LIMIT_IP_RPS"),
+		RateLimitIPBurst:            parseLimitEnv("RATE_LIMIT_IP_BURST"),
+		RateLimitPrincipalRate:      parseRateEnv("RATE_LIMIT_PRINCIPAL_RPS"),
+		RateLimitPrincipalBurst:     parseLimitEnv("RATE_LIMIT_PRINCIPAL_BURST"),
+		TrustForwardedFor:           os.Getenv("RATE_LIMIT_TRUST_FORWARDED_FOR") == "true",
+		TrustedProxies:              parseNetworksEnv("RATE_LIMIT_TRUSTED_PROXIES"),
+		MaxRequestBodyBytes:         parseLimitEnvOrDefault("MAX_REQUEST_BODY_BYTES", defaultMaxRequestBodyBytes),
+		MaxDescriptionLength:        parseLimitEnvOrDefault("MAX_DESCRIPTION_LENGTH", defaultMaxTextLength),
+		MaxMissionStatementLength:   parseLimitEnvOrDefault("MAX_MISSION_STATEMENT_LENGTH", defaultMaxTextLength),
+		CORSAllowedOrigins:          parseListEnv("CORS_ALLOWED_ORIGINS"),
+		CORSAllowedMethods:          parseListEnv("CORS_ALLOWED_METHODS"),
+		CORSAllowedHeaders:          parseListEnv("CORS_ALLOWED_HEADERS"),
+		CORSAllowCredentials:        os.Getenv("CORS_ALLOW_CREDENTIALS") == "true",
+		CORSMaxAge:                  parseDurationEnv("CORS_MAX_AGE"),
+		NATSHandlerConcurrency:      parseLimitEnvOrDefault("NATS_HANDLER_CONCURRENCY", defaultNATSHandlerConcurrency),
+		NATSHandlerTimeout:          parseDurationEnvOrDefault("NATS_HANDLER_TIMEOUT", defaultNATSHandlerTimeout),
+		NATSListConcurrency:         parseLimitEnvOrDefault("NATS_LIST_CONCURRENCY", internalnats.DefaultListConcurrency),
+		NATSPublishMaxAttempts:      parseLimitEnvOrDefault("NATS_PUBLISH_MAX_ATTEMPTS", defaultNATSPublishMaxAttempts),
+		NATSPublishRetryBaseDelay:   parseDurationEnvOrDefault("NATS_PUBLISH_RETRY_BASE_DELAY", defaultNATSPublishRetryBaseDelay),
+		NATSPublishRetryMaxDelay:    parseDurationEnvOrDefault("NATS_PUBLISH_RETRY_MAX_DELAY", defaultNATSPublishRetryMaxDelay),
+		NATSPublishBreakerThreshold: parseLimitEnvOrDefault("NATS_PUBLISH_BREAKER_THRESHOLD", defaultNATSPublishBreakerThreshold),
+		NATSPublishBreakerCooldown:  parseDurationEnvOrDefault("NATS_PUBLISH_BREAKER_COOLDOWN", defaultNATSPublishBreakerCooldown),
+		ProjectUpsertMaxDeliver:     parseLimitEnvOrDefault("PROJECT_UPSERT_MAX_DELIVER", defaultProjectUpsertMaxDeliver),
+		ProjectUpsertRetryBaseDelay: parseDurationEnvOrDefault("PROJECT_UPSERT_RETRY_BASE_DELAY", defaultProjectUpsertRetryBaseDelay),
+		ProjectUpsertRetryMaxDelay:  parseDurationEnvOrDefault("PROJECT_UPSERT_RETRY_MAX_DELAY", defaultProjectUpsertRetryMaxDelay),
+		LogoS3Bucket:                os.Getenv("LOGO_S3_BUCKET"),
+		LogoS3Region:                logoS3Region,
+		LogoBaseURL:                 os.Getenv("LOGO_BASE_URL"),
+		LogoInkscapePath:            logoInkscapePath,
+		CharterS3Bucket:             os.Getenv("CHARTER_S3_BUCKET"),
+		CharterS3Region:             charterS3Region,
+		BackupS3Bucket:              os.Getenv("BACKUP_S3_BUCKET"),
+		BackupS3Region:              backupS3Region,
+		BackupS3Prefix:              backupS3Prefix,
+		CharterBaseURL:              os.Getenv("CHARTER_BASE_URL"),
+		OpenSearchURL:               os.Getenv("OPENSEARCH_URL"),
+		UserInfoRefresh:             os.Getenv("USER_INFO_REFRESH_ENABLED") == "true",
+		UserInfoCacheSize:           parseLimitEnvOrDefault("USER_INFO_CACHE_SIZE", defaultUserInfoCacheSize),
+		UserInfoCacheTTL:            parseDurationEnvOrDefault("USER_INFO_CACHE_TTL", defaultUserInfoCacheTTL),
+		UserInfoRefreshInterval:     parseDurationEnv("USER_INFO_REFRESH_INTERVAL"),
+		DuplicateCheck:              os.Getenv("DUPLICATE_CHECK_ENABLED") == "true",
+		DuplicateNameSimilarity:     duplicateNameSimilarity,
+		AutojoinRole:                autojoinRole,
+		AnnouncementCheckInterval:   parseDurationEnv("ANNOUNCEMENT_CHECK_INTERVAL"),
+		AnnouncementPublic:          os.Getenv("ANNOUNCEMENT_SET_PUBLIC") == "true",
+		AnnouncementStage:           os.Getenv("ANNOUNCEMENT_STAGE"),
+		IntegrityScanInterval:       parseDurationEnv("INTEGRITY_SCAN_INTERVAL"),
+		ReadinessProbeInterval:      parseDurationEnvOrDefault("READINESS_PROBE_INTERVAL", defaultReadinessProbeInterval),
+		ReadinessProbeTimeout:       parseDurationEnvOrDefault("READINESS_PROBE_TIMEOUT", defaultReadinessProbeTimeout),
+		ReadinessProbeFailures:      parseLimitEnvOrDefault("READINESS_PROBE_FAILURE_THRESHOLD", defaultReadinessProbeFailureThreshold),
+		ProjectCachePolicy: service.CachePolicy{
+			MaxAge:               parseDurationEnv("CACHE_CONTROL_PROJECT_MAX_AGE"),
+			StaleWhileRevalidate: parseDurationEnv("CACHE_CONTROL_PROJECT_STALE_WHILE_REVALIDATE"),
+		},
+		ProjectListCachePolicy: service.CachePolicy{
+			MaxAge:               parseDurationEnv("CACHE_CONTROL_PROJECTS_MAX_AGE"),
+			StaleWhileRevalidate: parseDurationEnv("CACHE_CONTROL_PROJECTS_STALE_WHILE_REVALIDATE"),
+		},
+		LFXEnvironment:        os.Getenv("LFX_ENVIRONMENT"),
+		FaultInjection:        os.Getenv("FAULT_INJECTION_ENABLED") == "true",
+		FaultInjectionTargets: parseListEnv("FAULT_INJECTION_TARGETS"),
+		FaultInjectionConfig: internalnats.FaultConfig{
+			FailurePercent: parsePercentEnv("FAULT_INJECTION_FAILURE_PERCENT"),
+			DelayPercent:   parsePercentEnv("FAULT_INJECTION_DELAY_PERCENT"),
+			Delay:          parseDurationEnv("FAULT_INJECTION_DELAY"),
+		},
+		RequestLogger: middleware.RequestLoggerConfig{
+			BodySamplePercent: parsePercentEnv("REQUEST_LOG_BODY_SAMPLE_PERCENT"),
+			MaxBodyBytes:      parseLimitEnv("REQUEST_LOG_BODY_MAX_BYTES"),
+		},
+		LogRedaction:     logRedaction,
+		IndexerRedaction: indexerRedaction,
+		RedactHashKeySet: os.Getenv("REDACT_PII_HASH_KEY") != "",
+		JWTAuth: auth.JWTAuthConfig{
+			JWKSURL:             os.Getenv("JWKS_URL"),
+			Audience:            os.Getenv("AUDIENCE"),
+			Issuer:              os.Getenv("JWT_ISSUER"),
+			ClockSkew:           parseDurationEnv("JWT_CLOCK_SKEW"),
+			JWKSRefreshInterval: parseDurationEnv("JWKS_REFRESH_INTERVAL"),
+			M2MClients:          m2mClients,
+			MockLocalPrincipal:  os.Getenv("JWT_AUTH_DISABLED_MOCK_LOCAL_PRINCIPAL"),
+		},
+	}
+}
+
+// setupLogoStorage enables logo uploads when LOGO_S3_BUCKET is set. SVG logos,
+// uploaded or set through logo_url, are converted to PNG when inkscape is installed.
+func setupLogoStorage(ctx context.Context, env environment, svc *ProjectsAPI) {
+	if env.LogoS3Bucket == "" {
+		slog.Info("LOGO_S3_BUCKET not set, logo uploads are disabled")
+		return
+	}
+	store, err := s3.NewLogoStore(ctx, env.LogoS3Bucket, env.LogoS3Region, env.LogoBaseURL)
+	if err != nil {
+		slog.With(errKey, err).Error("error setting up logo storage, logo uploads are disabled")
+		return
+	}
+	svc.service.LogoStorage = store
+
+	converter, err := logo.NewInkscapeConverter(env.LogoInkscapePath)
+	if err != nil {
+		slog.With(errKey, err, "path", env.LogoInkscapePath).Warn("inkscape not found, SVG logos will not be converted to PNG")
+		return
+	}
+	svc.service.LogoConverter = converter
+	svc.service.LogoFetcher = logo.NewFetcher(models.MaxLogoFileSize)
+}
+
+// setupCharterStorage enables charter uploads when CHARTER_S3_BUCKET is set. The
+// charter version history also needs the project-charters bucket, which is
+// loaded with the other key-value stores.
+func setupCharterStorage(ctx context.Context, env environment, svc *ProjectsAPI) {
+	if env.CharterS3Bucket == "" {
+		slog.Info("CHARTER_S3_BUCKET not set, charter uploads are disabled")
+		return
+	}
+	store, err := s3.NewCharterStore(ctx, env.CharterS3Bucket, env.CharterS3Region, env.CharterBaseURL)
+	if err != nil {
+		slog.With(errKey, err).Error("error setting up charter storage, charter uploads are disabled")
+		return
+	}
+	svc.service.CharterStorage = store
+}
+
+// setupBackupStorage enables backups of the project stores when BACKUP_S3_BUCKET
+// is set. Backups also need NATS to be the project store.
+func setupBackupStorage(ctx context.Context, env environment, svc *ProjectsAPI) {
+	if env.BackupS3Bucket == "" {
+		slog.Info("BACKUP_S3_BUCKET not set, backups are disabled")
+		return
+	}
+	store, err := s3.NewBackupStore(ctx, env.BackupS3Bucket, env.BackupS3Region, env.BackupS3Prefix)
+	if err != nil {
+		slog.With(errKey, err).Error("error setting up backup storage, backups are disabled")
+		return
+	}
+	svc.service.BackupStorage = store
+}
+
+// setupProjectSearch searches projects in the OpenSearch index when
+// OPENSEARCH_URL is set. Searches scan every project otherwise, and whenever the
+// index cannot be searched.
+func setupProjectSearch(ctx context.Context, env environment, svc *ProjectsAPI) {
+	if env.OpenSearchURL == "" {
+		slog.Info("OPENSEARCH_URL not set, project searches scan the project store")
+		return
+	}
+	client, err := osinfra.NewClient(ctx, osinfra.Config{URL: env.OpenSearchURL})
+	if err != nil {
+		slog.With(errKey, err).Error("error setting up OpenSearch, project searches scan the project store")
+		return
+	}
+	searcher := osinfra.NewProjectSearcher(client)
+	svc.service.ProjectSearcher = searcher
+	svc.service.RegisterHealthCheck("opensearch", searcher)
+}
+
+// parseListEnv reads a comma-separated list from the named environment
+// variable, ignoring empty entries.
+func parseListEnv(name string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(name), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// parseNetworksEnv reads a comma-separated list of CIDR networks or single IPs
+// from the named environment variable. Invalid entries are left out and
+// reported by validateEnv.
+func parseNetworksEnv(name string) []*net.IPNet {
+	var networks []*net.IPNet
+	for _, value := range parseListEnv(name) {
+		cidr := value
+		if ip := net.ParseIP(value); ip != nil {
+			bits := 128
+			if ip.To4() != nil {
+				bits = 32
+			}
+			cidr = value + "/" + strconv.Itoa(bits)
+		}
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			invalidEnv(name, "%q is not a CIDR network or IP", value)
+			continue
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// parseLimitEnv reads a non-negative limit from the named environment
+// variable. An unset or invalid value means no limit (0); invalid values are
+// reported by validateEnv.
+func parseLimitEnv(name string) int {
+	value := os.Getenv(name)
+	if value == "" {
+		return 0
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 0 {
+		invalidEnv(name, "%q is not a non-negative integer", value)
+		return 0
+	}
+	return limit
+}
+
+// parseLimitEnvOrDefault is parseLimitEnv with a default for when the named
+// environment variable is unset; 0 still means no limit.
+func parseLimitEnvOrDefault(name string, defaultLimit int) int {
+	if os.Getenv(name) == "" {
+		return defaultLimit
+	}
+	return parseLimitEnv(name)
+}
+
+// parseRateEnv reads a non-negative number of requests per second from the
+// named environment variable, returning 0 (no limit) when it is unset or invalid.
+// Invalid values are reported by validateEnv.
+func parseRateEnv(name string) float64 {
+	value := os.Getenv(name)
+	if value == "" {
+		return 0
+	}
+	rate, err := strconv.ParseFloat(value, 64)
+	if err != nil || rate < 0 {
+		invalidEnv(name, "%q is not a non-negative number", value)
+		return 0
+	}
+	return rate
+}
+
+// parsePercentEnv reads a percentage, from 0 to 100, from the named environment
+// variable, returning 0 when it is unset or invalid. Invalid values are reported
+// by validateEnv.
+func parsePercentEnv(name string) float64 {
+	value := os.Getenv(name)
+	if value == "" {
+		return 0
+	}
+	percent, err := strconv.ParseFloat(value, 64)
+	if err != nil || percent < 0 || percent > 100 {
+		invalidEnv(name, "%q is not a percentage from 0 to 100", value)
+		return 0
+	}
+	return percent
+}
+
+// rateLimitConfig builds the HTTP rate limits from the environment. Requests
+// are limited per principal by the subject of their token, which the
+// endpoints verify afterwards.
+func rateLimitConfig(env environment) middleware.RateLimitConfig {
+	return middleware.RateLimitConfig{
+		IPRate:            env.RateLimitIPRate,
+		IPBurst:           env.RateLimitIPBurst,
+		PrincipalRate:     env.RateLimitPrincipalRate,
+		PrincipalBurst:    env.RateLimitPrincipalBurst,
+		TrustForwardedFor: env.TrustForwardedFor,
+		TrustedProxies:    env.TrustedProxies,
+		Principal:         middleware.BearerTokenSubject,
+		ExemptPaths:       []string{"/livez", "/readyz", "/healthz", "/metrics"},
+	}
+}
+
+// corsConfig returns the CORS configuration for the browser front-ends that
+// call the API from other domains. CORS is disabled unless CORS_ALLOWED_ORIGINS
+// is set.
+func corsConfig(env environment) middleware.CORSConfig {
+	return middleware.CORSConfig{
+		AllowedOrigins:   env.CORSAllowedOrigins,
+		AllowedMethods:   env.CORSAllowedMethods,
+		AllowedHeaders:   env.CORSAllowedHeaders,
+		AllowCredentials: env.CORSAllowCredentials,
+		MaxAge:           env.CORSMaxAge,
 	}
 }
 
+// parseDurationEnv reads a positive duration, such as "30s", from the named
+// environment variable. It returns 0, meaning the default, when the variable
+// is unset or invalid; invalid values are reported by validateEnv.
+func parseDurationEnv(name string) time.Duration {
+	value := os.Getenv(name)
+	if value == "" {
+		return 0
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		invalidEnv(name, "%q is not a positive duration such as 30s", value)
+		return 0
+	}
+	return duration
+}
+
+// parseDurationEnvOrDefault is parseDurationEnv with defaultDuration used when
+// the variable is unset or invalid.
+func parseDurationEnvOrDefault(name string, defaultDuration time.Duration) time.Duration {
+	if duration := parseDurationEnv(name); duration > 0 {
+		return duration
+	}
+	return defaultDuration
+}
+
 // LFXSelfServeBaseURL derives the LFX Self-Serve base URL from environment variables.
 // LFX_SELF_SERVE_BASE_URL takes precedence; otherwise it falls back to LFX_ENVIRONMENT.
 // When LFX_ENVIRONMENT is unset or unrecognized, prod is assumed (safe default for deployed environments).
@@ -216,9 +847,11 @@ func LFXSelfServeBaseURL() string {
 	}
 }
 
-func setupHTTPServer(flags flags, svc *ProjectsAPI, gracefulCloseWG *sync.WaitGroup) *http.Server {
+func setupHTTPServer(flags flags, svc *ProjectsAPI, rateLimit middleware.RateLimitConfig, cors middleware.CORSConfig, requestLogger middleware.RequestLoggerConfig, maxRequestBodyBytes int64, graphQL bool, gracefulCloseWG *sync.WaitGroup) *http.Server {
 	// Wrap it in the generated endpoints
 	endpoints := genquerysvc.NewEndpoints(svc)
+	endpoints.Use(metrics.EndpointMiddleware)
+	endpoints.Use(problemEndpointMiddleware)
 
 	// Build an HTTP handler
 	mux := goahttp.NewMuxer()
@@ -250,8 +883,10 @@ func setupHTTPServer(flags flags, svc *ProjectsAPI, gracefulCloseWG *sync.WaitGr
 		requestDecoder,
 		customEncoder,
 		nil,
-		nil,
+		problemFormatter,
+		uploadLogoDecoder,
 		uploadDocumentDecoder,
+		uploadCharterDecoder,
 		koDataDir,
 		koDataDir,
 		koDataDir,
@@ -285,25 +920,44 @@ func setupHTTPServer(flags flags, svc *ProjectsAPI, gracefulCloseWG *sync.WaitGr
 		})
 	})
 
+	// Answer every error as a problem details document.
+	mux.Use(problemMiddleware)
+
 	// Mount the handler on the mux
 	genhttp.Mount(mux, genHttpServer)
 
+	// Serve native Prometheus metrics for clusters that scrape directly.
+	mux.Handle(http.MethodGet, "/metrics", metrics.Handler().ServeHTTP)
+
+	// Serve the optional read-only GraphQL view of the project hierarchy.
+	if graphQL {
+		mux.Handle(http.MethodPost, "/graphql", graphQLHandler(svc.service).ServeHTTP)
+	}
+
 	var handler http.Handler = mux
 
 	// Add HTTP middleware
 	// Note: Order matters - RequestIDMiddleware should come first in the chain,
 	// so it should be the last middleware added to the handler since it is executed in reverse order.
-	handler = middleware.RequestLoggerMiddleware()(handler)
-	handler = middleware.RequestIDMiddleware()(handler)
+	handler = middleware.RateLimitMiddleware(rateLimit)(handler)
+	// CORS wraps the rate limiter so that 429 responses are readable by browser
+	// clients, and preflight requests are answered without spending the budget.
+	handler = middleware.CORSMiddleware(cors)(handler)
+	handler = middleware.RequestLoggerMiddleware(requestLogger)(handler)
 	handler = middleware.AuthorizationMiddleware()(handler)
 	// Cap total request body size to bound DoS exposure from unbounded multipart reads.
 	// 1 MB headroom above the file limit covers multipart boundaries and all text fields.
 	handler = middleware.BodyLimitMiddleware(models.MaxDocumentFileSize + 1<<20)(handler)
+	// Other requests, such as JSON project payloads, get a much smaller cap.
+	handler = middleware.RequestBodyLimitMiddleware(maxRequestBodyBytes)(handler)
+	// The request ID is set first, so that the problems of requests rejected by
+	// the body limit carry it too.
+	handler = middleware.RequestIDMiddleware()(handler)
 	// Wrap the handler with OpenTelemetry instrumentation
 	handler = otelhttp.NewHandler(handler, "project-service",
 		otelhttp.WithFilter(func(r *http.Request) bool {
 			p := r.URL.Path
-			return p != "/healthz" && p != "/livez" && p != "/readyz"
+			return p != "/healthz" && p != "/livez" && p != "/readyz" && p != "/metrics"
 		}),
 	)
 
@@ -335,7 +989,7 @@ func setupHTTPServer(flags flags, svc *ProjectsAPI, gracefulCloseWG *sync.WaitGr
 	return httpServer
 }
 
-func setupNATS(ctx context.Context, env environment, svc *ProjectsAPI, gracefulCloseWG *sync.WaitGroup, done chan os.Signal) (*nats.Conn, error) {
+func setupNATS(ctx context.Context, env environment, svc *ProjectsAPI, workers *internalnats.WorkerPool, gracefulCloseWG *sync.WaitGroup, done chan os.Signal) (*nats.Conn, error) {
 	// Create NATS connection.
 	gracefulCloseWG.Add(1)
 	var err error
@@ -378,25 +1032,140 @@ func setupNATS(ctx context.Context, env environment, svc *ProjectsAPI, gracefulC
 		return nil, err
 	}
 
+	// The embedded server starts empty, so the buckets are created on it.
+	if env.EmbeddedNATS {
+		if err := createEmbeddedBuckets(ctx, natsConn); err != nil {
+			return natsConn, err
+		}
+	}
+
 	// Get the key-value stores for the service.
-	repo, err := getKeyValueStores(ctx, natsConn)
+	repo, err := getKeyValueStores(ctx, natsConn, env)
+	if err != nil {
+		return natsConn, err
+	}
+	// Requests and published messages go to the subjects of the tenant, and the
+	// service's own events to its subject prefix.
+	tenantConn := internalnats.NewSubjectPrefixConn(internalnats.NewTenantConn(natsConn, env.Tenant), env.SubjectPrefix)
+	publishConn := tenantConn
+	faults, err := newFaultInjector(env)
 	if err != nil {
+		slog.ErrorContext(ctx, "error setting up fault injection", errKey, err)
 		return natsConn, err
 	}
+	if faults != nil {
+		if faultInjectionTarget(env, faultTargetKV) {
+			repo.InjectFaults(faults)
+		}
+		if faultInjectionTarget(env, faultTargetPublish) {
+			publishConn = internalnats.InjectPublishFaults(tenantConn, faults)
+		}
+	}
 	svc.service.ProjectRepository = repo
 	svc.service.DocumentRepository = repo
 	svc.service.LinkRepository = repo
 	svc.service.FolderRepository = repo
+	if repo.Outbox != nil {
+		svc.service.OutboxRepository = repo
+	}
+	if repo.DeadLetters != nil {
+		svc.service.DeadLetterRepository = repo
+	}
+	if repo.Charters != nil {
+		svc.service.CharterRepository = repo
+	}
+	if repo.Associations != nil {
+		svc.service.AssociationRepository = repo
+		svc.service.AssociationChecker = &internalnats.AssociationCheckerNATS{
+			NatsConn: tenantConn,
+		}
+	}
+	if repo.Webhooks != nil && repo.WebhookDeliveries != nil {
+		svc.service.WebhookRepository = repo
+		svc.service.WebhookSender = webhook.NewSender()
+	}
+	if repo.Announcements != nil {
+		svc.service.AnnouncementRepository = repo
+	}
+	if repo.Templates != nil {
+		svc.service.ProjectTemplateRepository = repo
+	}
+	if repo.Metadata != nil {
+		svc.service.ProjectEnumsRepository = repo
+	}
+	if repo.Deletions != nil {
+		svc.service.ProjectDeletionRepository = repo
+	}
+	if err := setupProjectRepository(ctx, env, svc); err != nil {
+		return natsConn, err
+	}
+	if svc.service.ProjectRepository == repo {
+		// Settings revisions and project events are read from the history of the
+		// project buckets, and slug mappings, backups and the prefix index kept
+		// from the projects bucket, which only hold the projects when NATS is
+		// the project store.
+		svc.service.SettingsHistoryRepository = repo
+		svc.service.ProjectHistoryRepository = repo
+		svc.service.KVInspector = repo
+		svc.service.SlugMappingRepository = repo
+		svc.service.ConsistencyChecker = repo
+		svc.service.BackupRepository = repo
+		svc.service.ProjectStreamer = repo
+		if repo.PrefixIndex != nil {
+			svc.service.ProjectSuggester = repo.PrefixIndex
+		}
+		go checkProjectConsistency(ctx, repo, env.ConsistencyCheck)
+	}
 
-	svc.service.MessageBuilder = &internalnats.MessageBuilder{
-		NatsConn: natsConn,
+	messageBuilder := &internalnats.MessageBuilder{
+		NatsConn: publishConn,
+		Retry: internalnats.RetryPolicy{
+			MaxAttempts: env.NATSPublishMaxAttempts,
+			BaseDelay:   env.NATSPublishRetryBaseDelay,
+			MaxDelay:    env.NATSPublishRetryMaxDelay,
+		},
+		Breaker:          internalnats.NewCircuitBreaker(env.NATSPublishBreakerThreshold, env.NATSPublishBreakerCooldown),
+		IndexerRedaction: env.IndexerRedaction,
 	}
-	svc.service.UserReader = &internalnats.UserReaderNATS{
-		NatsConn: natsConn,
+	svc.service.MessageBuilder = messageBuilder
+	svc.service.UserReader = internalnats.NewCachedUserReader(&internalnats.UserReaderNATS{
+		NatsConn: tenantConn,
+	}, env.UserInfoCacheSize, env.UserInfoCacheTTL)
+	if env.AccessCheckEnabled {
+		svc.service.AccessChecker = &internalnats.AccessCheckerNATS{
+			NatsConn: tenantConn,
+		}
+	}
+
+	registerNATSHealthChecks(svc, natsConn, repo, messageBuilder)
+	// Readiness follows the probes of the connection and the projects bucket, so
+	// that traffic is routed elsewhere while JetStream is degraded.
+	readinessProber := internalnats.NewReadinessProber(natsConn, repo.Projects, env.ReadinessProbeTimeout, env.ReadinessProbeFailures)
+	svc.service.ReadinessChecker = readinessProber
+	go readinessProber.Run(ctx, env.ReadinessProbeInterval)
+
+	if svc.service.OutboxRepository != nil {
+		go svc.service.RunOutboxDispatcher(ctx, outboxDispatchInterval)
+	}
+	if svc.service.WebhookRepository != nil {
+		go svc.service.RunWebhookDispatcher(ctx, webhookDispatchInterval)
+	}
+	if env.UserInfoRefreshInterval > 0 {
+		go svc.service.RunUserInfoRefresher(ctx, env.UserInfoRefreshInterval)
+	}
+	if svc.service.AnnouncementRepository != nil && env.AnnouncementCheckInterval > 0 {
+		go svc.service.RunAnnouncementScheduler(ctx, env.AnnouncementCheckInterval)
+	}
+	if svc.service.ConsistencyChecker != nil && env.IntegrityScanInterval > 0 {
+		go svc.service.RunIntegrityScanner(ctx, env.IntegrityScanInterval)
 	}
 
 	// Create NATS subscriptions for the service.
-	err = createNatsSubcriptions(ctx, svc, natsConn)
+	err = createNatsSubcriptions(ctx, svc, natsConn, workers, queueGroup(env))
+	if err != nil {
+		return natsConn, err
+	}
+	err = createStreamConsumers(ctx, svc, natsConn, workers, env)
 	if err != nil {
 		return natsConn, err
 	}
@@ -404,49 +1173,310 @@ func setupNATS(ctx context.Context, env environment, svc *ProjectsAPI, gracefulC
 	return natsConn, nil
 }
 
+// setupProjectRepository switches the project repository to Postgres when
+// PROJECT_REPOSITORY selects it. Links, folders, documents and the outbox stay in
+// NATS either way.
+func setupProjectRepository(ctx context.Context, env environment, svc *ProjectsAPI) error {
+	switch env.ProjectRepository {
+	case projectRepositoryNATS:
+		return nil
+	case projectRepositoryPostgres:
+	default:
+		return fmt.Errorf("unknown PROJECT_REPOSITORY %q", env.ProjectRepository)
+	}
+
+	if env.PostgresURL == "" {
+		return errors.New("POSTGRES_URL is required when PROJECT_REPOSITORY is postgres")
+	}
+	pgRepo, err := postgres.Connect(ctx, env.PostgresURL)
+	if err != nil {
+		slog.ErrorContext(ctx, "error connecting to Postgres", errKey, err)
+		return err
+	}
+	if err := pgRepo.Migrate(ctx); err != nil {
+		slog.ErrorContext(ctx, "error migrating Postgres schema", errKey, err)
+		pgRepo.Close()
+		return err
+	}
+	slog.InfoContext(ctx, "using Postgres project repository")
+
+	svc.service.ProjectRepository = pgRepo
+	svc.service.ProjectStreamer = pgRepo
+	svc.service.RegisterHealthCheck("postgres", pgRepo)
+	return nil
+}
+
+// startEmbeddedNATS starts an in-process NATS server with JetStream for local
+// development and points env at it. Its streams are stored in
+// EMBEDDED_NATS_STORE_DIR, or in a temporary directory removed on shutdown. The
+// returned function shuts the server down.
+func startEmbeddedNATS(env *environment) (func(), error) {
+	storeDir := env.EmbeddedNATSStoreDir
+	removeStoreDir := false
+	if storeDir == "" {
+		dir, err := os.MkdirTemp("", "project-service-nats-")
+		if err != nil {
+			return nil, err
+		}
+		storeDir, removeStoreDir = dir, true
+	}
+
+	ns, err := internalnats.StartEmbeddedServer(storeDir)
+	if err != nil {
+		if removeStoreDir {
+			_ = os.RemoveAll(storeDir)
+		}
+		return nil, err
+	}
+	env.NatsURL = ns.ClientURL()
+	slog.With("nats_url", env.NatsURL, "store_dir", storeDir).Warn("running embedded NATS server, only meant for local development")
+
+	return func() {
+		ns.Shutdown()
+		ns.WaitForShutdown()
+		if removeStoreDir {
+			if err := os.RemoveAll(storeDir); err != nil {
+				slog.With(errKey, err, "store_dir", storeDir).Warn("error removing embedded NATS store directory")
+			}
+		}
+	}, nil
+}
+
+// createEmbeddedBuckets creates the buckets of the service on the embedded NATS
+// server.
+func createEmbeddedBuckets(ctx context.Context, natsConn *nats.Conn) error {
+	js, err := jetstream.New(natsConn)
+	if err != nil {
+		slog.ErrorContext(ctx, "error creating NATS JetStream client", "nats_url", natsConn.ConnectedUrl(), errKey, err)
+		return err
+	}
+	if err := internalnats.CreateBuckets(ctx, js); err != nil {
+		slog.ErrorContext(ctx, "error creating NATS JetStream buckets", "nats_url", natsConn.ConnectedUrl(), errKey, err)
+		return err
+	}
+	return nil
+}
+
+// setupMemoryRepository stores everything in memory, without connecting to
+// NATS, for local development. Messages to other services are dropped, no user
+// is known, and nothing survives a restart.
+func setupMemoryRepository(ctx context.Context, svc *ProjectsAPI) {
+	slog.WarnContext(ctx, "using in-memory project repository, data is lost on restart")
+
+	repo := memory.NewRepository()
+	svc.service.ProjectRepository = repo
+	svc.service.ProjectStreamer = repo
+	svc.service.DocumentRepository = repo
+	svc.service.LinkRepository = repo
+	svc.service.FolderRepository = repo
+	svc.service.MessageBuilder = memory.MessageBuilder{}
+	svc.service.UserReader = memory.UserReader{}
+}
+
+// newFaultInjector returns the injector of the faults configured by the
+// FAULT_INJECTION_* variables, for resilience testing, or nil unless
+// FAULT_INJECTION_ENABLED is set. It is refused in production, which an unset
+// LFX_ENVIRONMENT is taken for.
+func newFaultInjector(env environment) (*internalnats.FaultInjector, error) {
+	if !env.FaultInjection {
+		return nil, nil
+	}
+	switch env.LFXEnvironment {
+	case "", "prod", "production":
+		return nil, errors.New("fault injection is not allowed in production, set LFX_ENVIRONMENT to a test environment")
+	}
+	for _, target := range env.FaultInjectionTargets {
+		if target != faultTargetKV && target != faultTargetPublish {
+			return nil, fmt.Errorf("invalid FAULT_INJECTION_TARGETS value %q, expected %q or %q", target, faultTargetKV, faultTargetPublish)
+		}
+	}
+
+	slog.Warn("fault injection enabled",
+		"targets", env.FaultInjectionTargets,
+		"failure_percent", env.FaultInjectionConfig.FailurePercent,
+		"delay_percent", env.FaultInjectionConfig.DelayPercent,
+		"delay", env.FaultInjectionConfig.Delay,
+	)
+	return internalnats.NewFaultInjector(env.FaultInjectionConfig), nil
+}
+
+// faultInjectionTarget reports whether faults are injected into target, which
+// they all are when FAULT_INJECTION_TARGETS is unset.
+func faultInjectionTarget(env environment, target string) bool {
+	return len(env.FaultInjectionTargets) == 0 || slices.Contains(env.FaultInjectionTargets, target)
+}
+
+// checkProjectConsistency looks for partial projects left behind by interrupted
+// writes, repairing them when mode is consistencyCheckRepair.
+func checkProjectConsistency(ctx context.Context, repo *internalnats.NatsRepository, mode string) {
+	if mode == consistencyCheckOff {
+		return
+	}
+	if mode != consistencyCheckReport && mode != consistencyCheckRepair {
+		slog.WarnContext(ctx, "unknown CONSISTENCY_CHECK, skipping consistency check", "mode", mode)
+		return
+	}
+
+	report, err := repo.CheckConsistency(ctx, mode == consistencyCheckRepair)
+	if err != nil {
+		slog.ErrorContext(ctx, "error checking project consistency", errKey, err)
+		return
+	}
+	if report.Found() == 0 {
+		slog.InfoContext(ctx, "project consistency check found no issues")
+		return
+	}
+	slog.WarnContext(ctx, "project consistency check found issues",
+		"mode", mode,
+		"dangling_slug_mappings", report.DanglingSlugMappings,
+		"missing_slug_mappings", report.MissingSlugMappings,
+		"dangling_legacy_id_mappings", report.DanglingLegacyIDMappings,
+		"missing_legacy_id_mappings", report.MissingLegacyIDMappings,
+		"orphaned_settings", report.OrphanedSettings,
+		"orphaned_children", report.OrphanedChildren,
+		"invalid_entries", report.InvalidEntries,
+		"repaired", report.Repaired,
+		"failed", report.Failed,
+	)
+}
+
+// registerNATSHealthChecks adds the NATS connection, each KV bucket, and the message
+// builder to the dependency checks reported by the health endpoint.
+func registerNATSHealthChecks(svc *ProjectsAPI, natsConn *nats.Conn, repo *internalnats.NatsRepository, messageBuilder *internalnats.MessageBuilder) {
+	svc.service.RegisterHealthCheck("nats", domain.HealthCheckFunc(func(_ context.Context) error {
+		if status := natsConn.Status(); status != nats.CONNECTED {
+			return fmt.Errorf("connection status is %s", status)
+		}
+		return nil
+	}))
+	for _, bucket := range []struct {
+		name string
+		kv   internalnats.INatsKeyValue
+	}{
+		{constants.KVStoreNameProjects, repo.Projects},
+		{constants.KVStoreNameProjectSettings, repo.ProjectSettings},
+		{constants.KVStoreNameProjectLinks, repo.Links},
+		{constants.KVStoreNameProjectFolders, repo.Folders},
+		{constants.KVStoreNameProjectDocuments, repo.Documents},
+	} {
+		svc.service.RegisterHealthCheck("kv:"+bucket.name, internalnats.KVHealthCheck(bucket.kv))
+	}
+	if repo.Outbox != nil {
+		svc.service.RegisterHealthCheck("kv:"+constants.KVStoreNameProjectOutbox, internalnats.KVHealthCheck(repo.Outbox))
+	}
+	if repo.DeadLetters != nil {
+		svc.service.RegisterHealthCheck("kv:"+constants.KVStoreNameProjectDeadLetters, internalnats.KVHealthCheck(repo.DeadLetters))
+	}
+	if repo.Charters != nil {
+		svc.service.RegisterHealthCheck("kv:"+constants.KVStoreNameProjectCharters, internalnats.KVHealthCheck(repo.Charters))
+	}
+	if repo.Associations != nil {
+		svc.service.RegisterHealthCheck("kv:"+constants.KVStoreNameProjectAssociations, internalnats.KVHealthCheck(repo.Associations))
+	}
+	if repo.Webhooks != nil && repo.WebhookDeliveries != nil {
+		svc.service.RegisterHealthCheck("kv:"+constants.KVStoreNameProjectWebhooks, internalnats.KVHealthCheck(repo.Webhooks))
+		svc.service.RegisterHealthCheck("kv:"+constants.KVStoreNameProjectWebhookDeliveries, internalnats.KVHealthCheck(repo.WebhookDeliveries))
+	}
+	if repo.Announcements != nil {
+		svc.service.RegisterHealthCheck("kv:"+constants.KVStoreNameProjectAnnouncements, internalnats.KVHealthCheck(repo.Announcements))
+	}
+	if repo.Templates != nil {
+		svc.service.RegisterHealthCheck("kv:"+constants.KVStoreNameProjectTemplates, internalnats.KVHealthCheck(repo.Templates))
+	}
+	if repo.Metadata != nil {
+		svc.service.RegisterHealthCheck("kv:"+constants.KVStoreNameProjectMetadata, internalnats.KVHealthCheck(repo.Metadata))
+	}
+	if repo.Deletions != nil {
+		svc.service.RegisterHealthCheck("kv:"+constants.KVStoreNameProjectDeletions, internalnats.KVHealthCheck(repo.Deletions))
+	}
+	svc.service.RegisterHealthCheck("message_builder", messageBuilder)
+}
+
 // getKeyValueStores creates a JetStream client and gets the key-value store for projects.
-func getKeyValueStores(ctx context.Context, natsConn *nats.Conn) (*internalnats.NatsRepository, error) {
-	kvStores := &internalnats.NatsRepository{}
+// The environment selects the optional in-memory caches in front of the projects bucket.
+func getKeyValueStores(ctx context.Context, natsConn *nats.Conn, env environment) (*internalnats.NatsRepository, error) {
+	kvStores := &internalnats.NatsRepository{ListConcurrency: env.NATSListConcurrency}
 
 	js, err := jetstream.New(natsConn)
 	if err != nil {
 		slog.ErrorContext(ctx, "error creating NATS JetStream client", "nats_url", natsConn.ConnectedUrl(), errKey, err)
 		return kvStores, err
 	}
+	// With a tenant, every bucket only holds the tenant's keys.
+	js = internalnats.NewTenantJetStream(js, env.Tenant)
 	projectsKV, err := js.KeyValue(ctx, constants.KVStoreNameProjects)
 	if err != nil {
 		slog.ErrorContext(ctx, "error getting NATS JetStream key-value store", "nats_url", natsConn.ConnectedUrl(), errKey, err, "store", constants.KVStoreNameProjects)
 		return kvStores, err
 	}
-	kvStores.Projects = projectsKV
+	kvStores.Projects = internalnats.InstrumentKeyValue(constants.KVStoreNameProjects, projectsKV)
+
+	// The projects cache is an optimization, so the service starts without it
+	// if the bucket cannot be loaded.
+	if env.ProjectsCache {
+		projectsCache := internalnats.NewCachedKeyValue(kvStores.Projects)
+		if err := projectsCache.Watch(ctx, projectsKV); err != nil {
+			slog.WarnContext(ctx, "error loading projects cache, reading from the bucket", errKey, err, "store", constants.KVStoreNameProjects)
+		} else {
+			kvStores.Projects = projectsCache
+		}
+	}
+
+	// Autocomplete scans the bucket when the prefix index cannot be loaded.
+	if env.AutocompleteIndex {
+		prefixIndex := internalnats.NewProjectPrefixIndex()
+		if err := prefixIndex.Watch(ctx, projectsKV); err != nil {
+			slog.WarnContext(ctx, "error loading project prefix index, autocomplete scans the bucket", errKey, err, "store", constants.KVStoreNameProjects)
+		} else {
+			kvStores.PrefixIndex = prefixIndex
+		}
+	}
+
+	if env.SlugCacheSize > 0 {
+		slugCache := internalnats.NewSlugCache(env.SlugCacheSize)
+		if err := slugCache.Watch(ctx, projectsKV); err != nil {
+			slog.ErrorContext(ctx, "error watching NATS JetStream key-value store", "nats_url", natsConn.ConnectedUrl(), errKey, err, "store", constants.KVStoreNameProjects)
+			return kvStores, err
+		}
+		kvStores.SlugCache = slugCache
+	}
 
 	projectSettingsKV, err := js.KeyValue(ctx, constants.KVStoreNameProjectSettings)
 	if err != nil {
 		slog.ErrorContext(ctx, "error getting NATS JetStream key-value store", "nats_url", natsConn.ConnectedUrl(), errKey, err, "store", constants.KVStoreNameProjectSettings)
 		return kvStores, err
 	}
-	kvStores.ProjectSettings = projectSettingsKV
+	// Compressed settings are always decoded, so KV_COMPRESSION only decides
+	// how new values are written.
+	kvStores.ProjectSettings, err = internalnats.CompressKeyValue(
+		internalnats.InstrumentKeyValue(constants.KVStoreNameProjectSettings, projectSettingsKV),
+		env.KVCompression, env.KVCompressionMinBytes,
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "invalid KV_COMPRESSION", errKey, err, "store", constants.KVStoreNameProjectSettings)
+		return kvStores, err
+	}
 
 	linksKV, err := js.KeyValue(ctx, constants.KVStoreNameProjectLinks)
 	if err != nil {
 		slog.ErrorContext(ctx, "error getting NATS JetStream key-value store", "nats_url", natsConn.ConnectedUrl(), errKey, err, "store", constants.KVStoreNameProjectLinks)
 		return kvStores, err
 	}
-	kvStores.Links = linksKV
+	kvStores.Links = internalnats.InstrumentKeyValue(constants.KVStoreNameProjectLinks, linksKV)
 
 	foldersKV, err := js.KeyValue(ctx, constants.KVStoreNameProjectFolders)
 	if err != nil {
 		slog.ErrorContext(ctx, "error getting NATS JetStream key-value store", "nats_url", natsConn.ConnectedUrl(), errKey, err, "store", constants.KVStoreNameProjectFolders)
 		return kvStores, err
 	}
-	kvStores.Folders = foldersKV
+	kvStores.Folders = internalnats.InstrumentKeyValue(constants.KVStoreNameProjectFolders, foldersKV)
 
 	documentsKV, err := js.KeyValue(ctx, constants.KVStoreNameProjectDocuments)
 	if err != nil {
 		slog.ErrorContext(ctx, "error getting NATS JetStream key-value store", "nats_url", natsConn.ConnectedUrl(), errKey, err, "store", constants.KVStoreNameProjectDocuments)
 		return kvStores, err
 	}
-	kvStores.Documents = documentsKV
+	kvStores.Documents = internalnats.InstrumentKeyValue(constants.KVStoreNameProjectDocuments, documentsKV)
 
 	documentFiles, err := js.ObjectStore(ctx, constants.ObjectStoreNameProjectDocuments)
 	if err != nil {
@@ -455,14 +1485,176 @@ func getKeyValueStores(ctx context.Context, natsConn *nats.Conn) (*internalnats.
 	}
 	kvStores.DocumentFiles = documentFiles
 
+	// The outbox bucket is optional so that the service can roll out before the
+	// bucket is provisioned; without it, messages are published directly.
+	outboxKV, err := js.KeyValue(ctx, constants.KVStoreNameProjectOutbox)
+	switch {
+	case errors.Is(err, jetstream.ErrBucketNotFound):
+		slog.WarnContext(ctx, "NATS JetStream key-value store not found, outbox disabled", "store", constants.KVStoreNameProjectOutbox)
+	case err != nil:
+		slog.ErrorContext(ctx, "error getting NATS JetStream key-value store", "nats_url", natsConn.ConnectedUrl(), errKey, err, "store", constants.KVStoreNameProjectOutbox)
+		return kvStores, err
+	default:
+		kvStores.Outbox = internalnats.InstrumentKeyValue(constants.KVStoreNameProjectOutbox, outboxKV)
+	}
+
+	// The dead-letter bucket is optional as well; without it, events whose
+	// handler fails are only logged.
+	deadLettersKV, err := js.KeyValue(ctx, constants.KVStoreNameProjectDeadLetters)
+	switch {
+	case errors.Is(err, jetstream.ErrBucketNotFound):
+		slog.WarnContext(ctx, "NATS JetStream key-value store not found, dead letters disabled", "store", constants.KVStoreNameProjectDeadLetters)
+	case err != nil:
+		slog.ErrorContext(ctx, "error getting NATS JetStream key-value store", "nats_url", natsConn.ConnectedUrl(), errKey, err, "store", constants.KVStoreNameProjectDeadLetters)
+		return kvStores, err
+	default:
+		kvStores.DeadLetters = internalnats.InstrumentKeyValue(constants.KVStoreNameProjectDeadLetters, deadLettersKV)
+	}
+
+	// The charters bucket is optional; without it, charter uploads are disabled.
+	chartersKV, err := js.KeyValue(ctx, constants.KVStoreNameProjectCharters)
+	switch {
+	case errors.Is(err, jetstream.ErrBucketNotFound):
+		slog.WarnContext(ctx, "NATS JetStream key-value store not found, charters disabled", "store", constants.KVStoreNameProjectCharters)
+	case err != nil:
+		slog.ErrorContext(ctx, "error getting NATS JetStream key-value store", "nats_url", natsConn.ConnectedUrl(), errKey, err, "store", constants.KVStoreNameProjectCharters)
+		return kvStores, err
+	default:
+		kvStores.Charters = internalnats.InstrumentKeyValue(constants.KVStoreNameProjectCharters, chartersKV)
+	}
+
+	// The associations bucket is optional; without it, project associations are disabled.
+	associationsKV, err := js.KeyValue(ctx, constants.KVStoreNameProjectAssociations)
+	switch {
+	case errors.Is(err, jetstream.ErrBucketNotFound):
+		slog.WarnContext(ctx, "NATS JetStream key-value store not found, associations disabled", "store", constants.KVStoreNameProjectAssociations)
+	case err != nil:
+		slog.ErrorContext(ctx, "error getting NATS JetStream key-value store", "nats_url", natsConn.ConnectedUrl(), errKey, err, "store", constants.KVStoreNameProjectAssociations)
+		return kvStores, err
+	default:
+		kvStores.Associations = internalnats.InstrumentKeyValue(constants.KVStoreNameProjectAssociations, associationsKV)
+	}
+
+	// The webhook buckets are optional; webhooks are disabled unless both exist.
+	for _, bucket := range []struct {
+		name string
+		kv   *internalnats.INatsKeyValue
+	}{
+		{constants.KVStoreNameProjectWebhooks, &kvStores.Webhooks},
+		{constants.KVStoreNameProjectWebhookDeliveries, &kvStores.WebhookDeliveries},
+	} {
+		kv, err := js.KeyValue(ctx, bucket.name)
+		switch {
+		case errors.Is(err, jetstream.ErrBucketNotFound):
+			slog.WarnContext(ctx, "NATS JetStream key-value store not found, webhooks disabled", "store", bucket.name)
+		case err != nil:
+			slog.ErrorContext(ctx, "error getting NATS JetStream key-value store", "nats_url", natsConn.ConnectedUrl(), errKey, err, "store", bucket.name)
+			return kvStores, err
+		default:
+			*bucket.kv = internalnats.InstrumentKeyValue(bucket.name, kv)
+		}
+	}
+
+	announcementsKV, err := js.KeyValue(ctx, constants.KVStoreNameProjectAnnouncements)
+	switch {
+	case errors.Is(err, jetstream.ErrBucketNotFound):
+		slog.WarnContext(ctx, "NATS JetStream key-value store not found, announcements disabled", "store", constants.KVStoreNameProjectAnnouncements)
+	case err != nil:
+		slog.ErrorContext(ctx, "error getting NATS JetStream key-value store", "nats_url", natsConn.ConnectedUrl(), errKey, err, "store", constants.KVStoreNameProjectAnnouncements)
+		return kvStores, err
+	default:
+		kvStores.Announcements = internalnats.InstrumentKeyValue(constants.KVStoreNameProjectAnnouncements, announcementsKV)
+	}
+
+	// The templates bucket is optional; without it, project templates are disabled.
+	templatesKV, err := js.KeyValue(ctx, constants.KVStoreNameProjectTemplates)
+	switch {
+	case errors.Is(err, jetstream.ErrBucketNotFound):
+		slog.WarnContext(ctx, "NATS JetStream key-value store not found, project templates disabled", "store", constants.KVStoreNameProjectTemplates)
+	case err != nil:
+		slog.ErrorContext(ctx, "error getting NATS JetStream key-value store", "nats_url", natsConn.ConnectedUrl(), errKey, err, "store", constants.KVStoreNameProjectTemplates)
+		return kvStores, err
+	default:
+		kvStores.Templates = internalnats.InstrumentKeyValue(constants.KVStoreNameProjectTemplates, templatesKV)
+	}
+
+	// The metadata bucket is optional; without it, the default stages and
+	// categories are allowed and cannot be changed.
+	metadataKV, err := js.KeyValue(ctx, constants.KVStoreNameProjectMetadata)
+	switch {
+	case errors.Is(err, jetstream.ErrBucketNotFound):
+		slog.WarnContext(ctx, "NATS JetStream key-value store not found, default project enums used", "store", constants.KVStoreNameProjectMetadata)
+	case err != nil:
+		slog.ErrorContext(ctx, "error getting NATS JetStream key-value store", "nats_url", natsConn.ConnectedUrl(), errKey, err, "store", constants.KVStoreNameProjectMetadata)
+		return kvStores, err
+	default:
+		kvStores.Metadata = internalnats.InstrumentKeyValue(constants.KVStoreNameProjectMetadata, metadataKV)
+	}
+
+	// The deletions bucket is optional; without it, no tombstones are recorded.
+	deletionsKV, err := js.KeyValue(ctx, constants.KVStoreNameProjectDeletions)
+	switch {
+	case errors.Is(err, jetstream.ErrBucketNotFound):
+		slog.WarnContext(ctx, "NATS JetStream key-value store not found, project deletion tombstones disabled", "store", constants.KVStoreNameProjectDeletions)
+	case err != nil:
+		slog.ErrorContext(ctx, "error getting NATS JetStream key-value store", "nats_url", natsConn.ConnectedUrl(), errKey, err, "store", constants.KVStoreNameProjectDeletions)
+		return kvStores, err
+	default:
+		kvStores.Deletions = internalnats.InstrumentKeyValue(constants.KVStoreNameProjectDeletions, deletionsKV)
+	}
+
 	return kvStores, nil
 }
 
-// createNatsSubcriptions creates the NATS subscriptions for the project service.
-func createNatsSubcriptions(ctx context.Context, svc *ProjectsAPI, natsConn *nats.Conn) error {
-	slog.InfoContext(ctx, "subscribing to NATS subjects", "nats_url", natsConn.ConnectedUrl(), "servers", natsConn.Servers())
-	queueName := constants.ProjectsAPIQueue
+// queueGroup returns the queue group the service's replicas share, which
+// defaults to the queue under the service's subject prefix.
+func queueGroup(env environment) string {
+	if env.QueueGroup != "" {
+		return env.QueueGroup
+	}
+	return internalnats.ServiceSubject(env.SubjectPrefix, constants.ProjectsAPIQueue)
+}
+
+// createNatsSubcriptions registers the NATS handlers of the project service
+// as the endpoints of a NATS micro service in queueName, so that operators can
+// discover, ping and monitor them with `nats micro`. Messages are handled on
+// the worker pool rather than on the subscription goroutines.
+func createNatsSubcriptions(ctx context.Context, svc *ProjectsAPI, natsConn *nats.Conn, workers *internalnats.WorkerPool, queueName string) error {
+	slog.InfoContext(ctx, "subscribing to NATS subjects", "nats_url", natsConn.ConnectedUrl(), "servers", natsConn.Servers(), "queue", queueName)
+	// With a tenant, only the tenant's subjects are subscribed to, and with a
+	// subject prefix, the service's own subjects under it. Handlers and metrics
+	// see the subjects without either.
+	tenant := svc.service.Config.Tenant
+	prefix := svc.service.Config.SubjectPrefix
 
+	// Handlers finish on the worker pool, after the micro service measured the
+	// request, so their errors and processing time are reported as stats data.
+	stats := internalnats.NewHandlerStats()
+	metadata := map[string]string{"git_commit": GitCommit, "build_time": BuildTime}
+	if tenant != "" {
+		metadata["tenant"] = tenant
+	}
+	microService, err := micro.AddService(natsConn, micro.Config{
+		Name:         constants.ProjectsAPIServiceName,
+		Version:      internalnats.ServiceVersion(Version),
+		Description:  "LFX project service lookups and event handlers",
+		Metadata:     metadata,
+		QueueGroup:   queueName,
+		StatsHandler: stats.Stats,
+		ErrorHandler: func(_ micro.Service, natsErr *micro.NATSError) {
+			slog.ErrorContext(ctx, "NATS micro service error", errKey, natsErr, "subject", natsErr.Subject)
+		},
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "error creating NATS micro service", errKey, err)
+		return err
+	}
+
+	lookupMetadata := map[string]string{
+		"kind":     "lookup",
+		"request":  "text/plain, or an events.LookupRequest " + events.LookupVersion + " JSON envelope",
+		"response": "text/plain or JSON, or an events.LookupResponse " + events.LookupVersion + " JSON envelope",
+	}
 	for _, subject := range []string{
 		// Get project name subscription
 		constants.ProjectGetNameSubject,
@@ -472,45 +1664,65 @@ func createNatsSubcriptions(ctx context.Context, svc *ProjectsAPI, natsConn *nat
 		constants.ProjectGetLogoSubject,
 		// Get project slug to UID subscription
 		constants.ProjectSlugToUIDSubject,
+		// Get project legacy ID to UID subscription
+		constants.ProjectLegacyToUIDSubject,
 		// Get project parent UID subscription
 		constants.ProjectGetParentUIDSubject,
 		// Get project writers subscription
 		constants.ProjectGetWritersSubject,
+		// Get user projects subscription
+		constants.UserGetProjectsSubject,
 	} {
+		endpoint := internalnats.EndpointName(subject)
 		slog.With("subject", subject, "queue", queueName).Debug("subscribing to NATS subject")
-		_, err := natsConn.QueueSubscribe(subject, queueName, func(msg *nats.Msg) {
-			msgCtx, end := internalnats.ExtractMsgContext(ctx, msg, subject)
-			defer end()
-			natsMsg := &internalnats.NatsMsg{Msg: msg}
-			svc.service.HandleMessage(msgCtx, natsMsg)
-		})
+		err := microService.AddEndpoint(endpoint, micro.HandlerFunc(func(req micro.Request) {
+			workers.Go(ctx, subject, func(ctx context.Context) {
+				start := time.Now()
+				msgCtx, end := internalnats.ExtractRequestContext(ctx, req, subject)
+				defer end()
+				natsMsg := internalnats.NewMicroMsg(msgCtx, natsConn, req)
+				svc.service.HandleMessage(msgCtx, natsMsg)
+				metrics.ObserveNATSHandler(subject, start, msgCtx.Err())
+				stats.Observe(endpoint, start, msgCtx.Err())
+			})
+		}),
+			micro.WithEndpointSubject(internalnats.TenantSubject(tenant, internalnats.ServiceSubject(prefix, subject))),
+			micro.WithEndpointMetadata(lookupMetadata),
+		)
 		if err != nil {
-			slog.ErrorContext(ctx, "error creating NATS queue subscription", errKey, err)
+			slog.ErrorContext(ctx, "error adding NATS micro service endpoint", errKey, err, "subject", subject)
 			return err
 		}
 	}
 
-	type eventHandler struct {
-		subject string
-		handle  func(ctx context.Context, msg domain.Message) error
-	}
-	for _, eh := range []eventHandler{
-		{constants.ProjectSettingsUpdatedSubject, svc.service.HandleProjectSettingsUpdated},
-		{inviteapi.InviteServiceAcceptedSubject, svc.service.HandleInviteAccepted},
-		{constants.ProjectDocumentCreatedSubject, svc.service.HandleProjectDocumentCreated},
-		{constants.ProjectLinkCreatedSubject, svc.service.HandleProjectLinkCreated},
-	} {
-		slog.With("subject", eh.subject, "queue", queueName).Debug("subscribing to NATS subject")
-		_, err := natsConn.QueueSubscribe(eh.subject, queueName, func(msg *nats.Msg) {
-			msgCtx, end := internalnats.ExtractMsgContext(ctx, msg, eh.subject)
-			defer end()
-			natsMsg := &internalnats.NatsMsg{Msg: msg}
-			if handlerErr := eh.handle(msgCtx, natsMsg); handlerErr != nil {
-				slog.WarnContext(msgCtx, "event handler failed", errKey, handlerErr, "subject", eh.subject)
-			}
-		})
+	eventMetadata := map[string]string{"kind": "event"}
+	for subject, handle := range svc.service.EventHandlers() {
+		endpoint := internalnats.EndpointName(subject)
+		slog.With("subject", subject, "queue", queueName).Debug("subscribing to NATS subject")
+		err := microService.AddEndpoint(endpoint, micro.HandlerFunc(func(req micro.Request) {
+			workers.Go(ctx, subject, func(ctx context.Context) {
+				start := time.Now()
+				msgCtx, end := internalnats.ExtractRequestContext(ctx, req, subject)
+				defer end()
+				natsMsg := internalnats.NewMicroMsg(msgCtx, natsConn, req)
+				handlerErr := handle(msgCtx, natsMsg)
+				metrics.ObserveNATSHandler(subject, start, handlerErr)
+				stats.Observe(endpoint, start, handlerErr)
+				if handlerErr != nil {
+					span := trace.SpanFromContext(msgCtx)
+					span.RecordError(handlerErr)
+					span.SetStatus(codes.Error, handlerErr.Error())
+					slog.WarnContext(msgCtx, "event handler failed", errKey, handlerErr, "subject", subject)
+					// The handler context may have expired; recording must not.
+					svc.service.RecordDeadLetter(context.WithoutCancel(msgCtx), natsMsg, handlerErr)
+				}
+			})
+		}),
+			micro.WithEndpointSubject(internalnats.TenantSubject(tenant, internalnats.ServiceSubject(prefix, subject))),
+			micro.WithEndpointMetadata(eventMetadata),
+		)
 		if err != nil {
-			slog.ErrorContext(ctx, "error creating NATS queue subscription", errKey, err)
+			slog.ErrorContext(ctx, "error adding NATS micro service endpoint", errKey, err, "subject", subject)
 			return err
 		}
 	}
@@ -518,7 +1730,53 @@ func createNatsSubcriptions(ctx context.Context, svc *ProjectsAPI, natsConn *nat
 	return nil
 }
 
-func gracefulShutdown(httpServer *http.Server, natsConn *nats.Conn, gracefulCloseWG *sync.WaitGroup, cancel context.CancelFunc) {
+// createStreamConsumers consumes the messages of the service's stream
+// handlers from their JetStream stream with a durable consumer, which the
+// service's replicas share. The project upserts stream is optional, so that
+// the service can roll out before the stream is provisioned; without it,
+// upserts are not ingested.
+func createStreamConsumers(ctx context.Context, svc *ProjectsAPI, natsConn *nats.Conn, workers *internalnats.WorkerPool, env environment) error {
+	js, err := jetstream.New(natsConn)
+	if err != nil {
+		slog.ErrorContext(ctx, "error creating NATS JetStream client", "nats_url", natsConn.ConnectedUrl(), errKey, err)
+		return err
+	}
+
+	_, err = js.Stream(ctx, constants.StreamNameProjectUpserts)
+	switch {
+	case errors.Is(err, jetstream.ErrStreamNotFound):
+		slog.WarnContext(ctx, "NATS JetStream stream not found, project upserts disabled", "stream", constants.StreamNameProjectUpserts)
+		return nil
+	case err != nil:
+		slog.ErrorContext(ctx, "error getting NATS JetStream stream", errKey, err, "stream", constants.StreamNameProjectUpserts)
+		return err
+	}
+
+	subject := constants.ProjectUpsertSubject
+	filterSubject := internalnats.TenantSubject(env.Tenant, internalnats.ServiceSubject(env.SubjectPrefix, subject))
+	// Each tenant and subject prefix consumes its own upserts, under a durable
+	// named after them like the micro service endpoints.
+	durable := internalnats.EndpointName(filterSubject)
+	_, err = internalnats.Consume(ctx, js, workers, internalnats.ConsumerConfig{
+		Stream:        constants.StreamNameProjectUpserts,
+		Durable:       durable,
+		FilterSubject: filterSubject,
+		Subject:       subject,
+		Retry: internalnats.RetryPolicy{
+			MaxAttempts: env.ProjectUpsertMaxDeliver,
+			BaseDelay:   env.ProjectUpsertRetryBaseDelay,
+			MaxDelay:    env.ProjectUpsertRetryMaxDelay,
+		},
+	}, svc.service.StreamHandlers()[subject], svc.service.RecordDeadLetter)
+	if err != nil {
+		slog.ErrorContext(ctx, "error consuming NATS JetStream stream", errKey, err, "stream", constants.StreamNameProjectUpserts, "consumer", durable)
+		return err
+	}
+	slog.InfoContext(ctx, "consuming NATS JetStream stream", "stream", constants.StreamNameProjectUpserts, "consumer", durable, "subject", filterSubject)
+	return nil
+}
+
+func gracefulShutdown(httpServer *http.Server, natsConn *nats.Conn, workers *internalnats.WorkerPool, gracefulCloseWG *sync.WaitGroup, cancel context.CancelFunc) {
 	// Cancel the background context.
 	cancel()
 
@@ -537,7 +1795,7 @@ func gracefulShutdown(httpServer *http.Server, natsConn *nats.Conn, gracefulClos
 
 	// Drain the NATS connection, which will drain all subscriptions, then close the
 	// connection when complete.
-	if !natsConn.IsClosed() && !natsConn.IsDraining() {
+	if natsConn != nil && !natsConn.IsClosed() && !natsConn.IsDraining() {
 		slog.Info("draining NATS connections")
 		if err := natsConn.Drain(); err != nil {
 			slog.With(errKey, err).Error("error draining NATS connection")
@@ -546,6 +1804,10 @@ func gracefulShutdown(httpServer *http.Server, natsConn *nats.Conn, gracefulClos
 		}
 	}
 
+	// Wait for the NATS handlers still running; their context is canceled, so
+	// they return promptly.
+	workers.Wait()
+
 	// Wait for the HTTP graceful shutdown and for the NATS connection to be
 	// closed (see nats.Connect options for the timeout and the handler that
 	// decrements the wait group).
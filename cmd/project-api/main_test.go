@@ -5,6 +5,7 @@ package main
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -71,3 +72,153 @@ func TestLFXSelfServeBaseURL(t *testing.T) {
 		})
 	}
 }
+
+func TestParseLimitEnv(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  int
+	}{
+		{name: "unset", want: 0},
+		{name: "limit", value: "5", want: 5},
+		{name: "not a number", value: "five", want: 0},
+		{name: "negative", value: "-1", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("MAX_HIERARCHY_DEPTH", tt.value)
+			assert.Equal(t, tt.want, parseLimitEnv("MAX_HIERARCHY_DEPTH"))
+		})
+	}
+}
+
+func TestParseDurationEnv(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{name: "unset", value: "", want: 0},
+		{name: "duration", value: "30s", want: 30 * time.Second},
+		{name: "not a duration", value: "30", want: 0},
+		{name: "negative", value: "-1m", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("JWT_CLOCK_SKEW", tt.value)
+			assert.Equal(t, tt.want, parseDurationEnv("JWT_CLOCK_SKEW"))
+		})
+	}
+}
+
+func TestParseRateEnv(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  float64
+	}{
+		{name: "unset", value: "", want: 0},
+		{name: "integer", value: "10", want: 10},
+		{name: "fraction", value: "0.5", want: 0.5},
+		{name: "negative", value: "-1", want: 0},
+		{name: "not a number", value: "ten", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("RATE_LIMIT_IP_RPS", tt.value)
+			assert.Equal(t, tt.want, parseRateEnv("RATE_LIMIT_IP_RPS"))
+		})
+	}
+}
+
+func TestParseLimitEnvOrDefault(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  int
+	}{
+		{name: "unset", value: "", want: 10000},
+		{name: "configured", value: "500", want: 500},
+		{name: "disabled", value: "0", want: 0},
+		{name: "not a number", value: "many", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("MAX_DESCRIPTION_LENGTH", tt.value)
+			assert.Equal(t, tt.want, parseLimitEnvOrDefault("MAX_DESCRIPTION_LENGTH", defaultMaxTextLength))
+		})
+	}
+}
+
+func TestParsePercentEnv(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  float64
+	}{
+		{name: "unset", value: "", want: 0},
+		{name: "integer", value: "25", want: 25},
+		{name: "fraction", value: "0.5", want: 0.5},
+		{name: "all", value: "100", want: 100},
+		{name: "over 100", value: "150", want: 0},
+		{name: "negative", value: "-1", want: 0},
+		{name: "not a number", value: "some", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("FAULT_INJECTION_FAILURE_PERCENT", tt.value)
+			assert.Equal(t, tt.want, parsePercentEnv("FAULT_INJECTION_FAILURE_PERCENT"))
+		})
+	}
+}
+
+func TestNewFaultInjector(t *testing.T) {
+	tests := []struct {
+		name        string
+		env         environment
+		wantFaults  bool
+		wantErr     bool
+		wantKV      bool
+		wantPublish bool
+	}{
+		{name: "disabled", env: environment{LFXEnvironment: "dev"}},
+		{name: "refused in production", env: environment{FaultInjection: true, LFXEnvironment: "prod"}, wantErr: true},
+		{name: "refused without environment", env: environment{FaultInjection: true}, wantErr: true},
+		{
+			name:       "all targets by default",
+			env:        environment{FaultInjection: true, LFXEnvironment: "staging"},
+			wantFaults: true, wantKV: true, wantPublish: true,
+		},
+		{
+			name:       "publishes only",
+			env:        environment{FaultInjection: true, LFXEnvironment: "dev", FaultInjectionTargets: []string{"publish"}},
+			wantFaults: true, wantPublish: true,
+		},
+		{
+			name:    "unknown target",
+			env:     environment{FaultInjection: true, LFXEnvironment: "dev", FaultInjectionTargets: []string{"http"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			faults, err := newFaultInjector(tt.env)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantFaults, faults != nil)
+			if faults != nil {
+				assert.Equal(t, tt.wantKV, faultInjectionTarget(tt.env, faultTargetKV))
+				assert.Equal(t, tt.wantPublish, faultInjectionTarget(tt.env, faultTargetPublish))
+			}
+		})
+	}
+}
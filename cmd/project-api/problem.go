@@ -0,0 +1,235 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"errors"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	goahttp "goa.design/goa/v3/http"
+	"goa.design/goa/v3/pkg"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/middleware"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// problem is a type of problem that errors are answered with.
+type problem struct {
+	err    error
+	status int
+	slug   string
+	title  string
+}
+
+// typeURI returns the URI identifying the problem type.
+func (p problem) typeURI() string {
+	return middleware.ProblemTypePrefix + p.slug
+}
+
+// domainProblems maps domain errors to the problem they are answered with. An
+// error is answered with the first problem it matches, so more specific errors
+// come first.
+var domainProblems = []problem{
+	{domain.ErrServiceUnavailable, http.StatusServiceUnavailable, "service-unavailable", "Service unavailable"},
+	{domain.ErrSettingsMergeConflict, http.StatusConflict, "settings-merge-conflict", "Settings merge conflict"},
+	{domain.ErrRevisionMismatch, http.StatusConflict, "revision-mismatch", "Revision mismatch"},
+	{domain.ErrInvalidParentProject, http.StatusBadRequest, "invalid-parent-project", "Invalid parent project"},
+	{domain.ErrInvalidLegalParent, http.StatusBadRequest, "invalid-legal-parent", "Invalid legal parent"},
+	{domain.ErrCannotDeleteNonCrowdfundingProject, http.StatusBadRequest, "project-not-deletable", "Project cannot be deleted"},
+	{domain.ErrArchivedRequiresDissolutionDate, http.StatusBadRequest, "dissolution-date-required", "Dissolution date required"},
+	{domain.ErrAnnotationsTooLarge, http.StatusBadRequest, "annotations-too-large", "Annotations too large"},
+	{domain.ErrInvalidLocalization, http.StatusBadRequest, "invalid-localization", "Invalid localization"},
+	{domain.ErrProjectHierarchyCycle, http.StatusUnprocessableEntity, "project-hierarchy-cycle", "Project hierarchy cycle"},
+	{domain.ErrInvalidContentType, http.StatusBadRequest, "invalid-content-type", "Content type not allowed"},
+	{domain.ErrFileTooLarge, http.StatusBadRequest, "file-too-large", "File too large"},
+	{domain.ErrInvalidLogo, http.StatusBadRequest, "invalid-logo", "Invalid logo"},
+	{domain.ErrProjectDissolved, http.StatusForbidden, "project-dissolved", "Project dissolved"},
+	{domain.ErrRestrictedField, http.StatusForbidden, "restricted-field", "Restricted field"},
+	{domain.ErrFieldTooLong, http.StatusUnprocessableEntity, "field-too-long", "Field too long"},
+	{domain.ErrPolicyViolation, http.StatusUnprocessableEntity, "policy-violation", "Policy violation"},
+	{domain.ErrAutojoinDisabled, http.StatusForbidden, "autojoin-disabled", "Autojoin disabled"},
+	{domain.ErrProjectDuplicate, http.StatusConflict, "duplicate-project", "Duplicate project"},
+	{domain.ErrForbidden, http.StatusForbidden, "forbidden", "Forbidden"},
+	{domain.ErrProjectNotFound, http.StatusNotFound, "project-not-found", "Project not found"},
+	{domain.ErrDocumentNotFound, http.StatusNotFound, "document-not-found", "Document not found"},
+	{domain.ErrLinkNotFound, http.StatusNotFound, "link-not-found", "Link not found"},
+	{domain.ErrFolderNotFound, http.StatusNotFound, "folder-not-found", "Folder not found"},
+	{domain.ErrDeadLetterNotFound, http.StatusNotFound, "dead-letter-not-found", "Dead letter not found"},
+	{domain.ErrBackupNotFound, http.StatusNotFound, "backup-not-found", "Backup not found"},
+	{domain.ErrWebhookNotFound, http.StatusNotFound, "webhook-not-found", "Webhook not found"},
+	{domain.ErrProjectTemplateNotFound, http.StatusNotFound, "project-template-not-found", "Project template not found"},
+	{domain.ErrCharterNotFound, http.StatusNotFound, "charter-not-found", "Charter not found"},
+	{domain.ErrSettingsRevisionNotFound, http.StatusNotFound, "settings-revision-not-found", "Settings revision not found"},
+	{domain.ErrProjectMemberNotFound, http.StatusNotFound, "project-member-not-found", "Project member not found"},
+	{domain.ErrProjectSlugExists, http.StatusConflict, "project-slug-exists", "Project slug exists"},
+	{domain.ErrProjectLegacyIDExists, http.StatusConflict, "project-legacy-id-exists", "Project legacy ID exists"},
+	{domain.ErrProjectTemplateExists, http.StatusConflict, "project-template-exists", "Project template exists"},
+	{domain.ErrDocumentNameExists, http.StatusConflict, "document-name-exists", "Document name exists"},
+	{domain.ErrFolderNameExists, http.StatusConflict, "folder-name-exists", "Folder name exists"},
+	{domain.ErrFolderNotEmpty, http.StatusConflict, "folder-not-empty", "Folder not empty"},
+	{domain.ErrValidationFailed, http.StatusBadRequest, "validation-failed", "Validation failed"},
+	{domain.ErrInternal, http.StatusInternalServerError, "internal-error", "Internal error"},
+}
+
+// statusProblems are the problems of errors answered with a status that none
+// of domainProblems matches.
+var statusProblems = map[int]problem{
+	http.StatusBadRequest:          {status: http.StatusBadRequest, slug: "validation-failed", title: "Validation failed"},
+	http.StatusForbidden:           {status: http.StatusForbidden, slug: "forbidden", title: "Forbidden"},
+	http.StatusNotFound:            {status: http.StatusNotFound, slug: "not-found", title: "Not found"},
+	http.StatusConflict:            {status: http.StatusConflict, slug: "conflict", title: "Conflict"},
+	http.StatusUnprocessableEntity: {status: http.StatusUnprocessableEntity, slug: "unprocessable-entity", title: "Unprocessable entity"},
+	http.StatusInternalServerError: {status: http.StatusInternalServerError, slug: "internal-error", title: "Internal error"},
+	http.StatusServiceUnavailable:  {status: http.StatusServiceUnavailable, slug: "service-unavailable", title: "Service unavailable"},
+}
+
+// lookupProblem returns the problem err is answered with when its status is status.
+func lookupProblem(status int, err error) problem {
+	for _, p := range domainProblems {
+		if p.status == status && errors.Is(err, p.err) {
+			return p
+		}
+	}
+	if p, ok := statusProblems[status]; ok {
+		return p
+	}
+	text := http.StatusText(status)
+	return problem{status: status, slug: strings.ReplaceAll(strings.ToLower(text), " ", "-"), title: text}
+}
+
+// domainProblem returns the problem a domain error is answered with, and
+// false when err is not a known domain error.
+func domainProblem(err error) (problem, bool) {
+	for _, p := range domainProblems {
+		if errors.Is(err, p.err) {
+			return p, true
+		}
+	}
+	return problem{}, false
+}
+
+// problemRequest is the state of an HTTP request that its problems are built from.
+type problemRequest struct {
+	path string
+	// formatted is set when an error that is not declared in the design was
+	// answered, so that the response is labelled as a problem.
+	formatted bool
+}
+
+type problemRequestKey struct{}
+
+// problemMiddleware records the path of each request as the instance of its
+// problems, and labels the responses of errors that are not declared in the
+// design, which Goa encodes as plain JSON, as problems.
+func problemMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state := &problemRequest{path: r.URL.Path}
+		ctx := context.WithValue(r.Context(), problemRequestKey{}, state)
+		next.ServeHTTP(&problemResponseWriter{ResponseWriter: w, state: state}, r.WithContext(ctx))
+	})
+}
+
+// problemResponseWriter sets the problem content type on formatted error responses.
+type problemResponseWriter struct {
+	http.ResponseWriter
+	state *problemRequest
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (w *problemResponseWriter) WriteHeader(status int) {
+	if w.state.formatted && status >= http.StatusBadRequest {
+		if mediaType, _, err := mime.ParseMediaType(w.Header().Get("Content-Type")); err == nil && mediaType == "application/json" {
+			w.Header().Set("Content-Type", middleware.ProblemContentType)
+		}
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Unwrap returns the wrapped writer for http.ResponseController.
+func (w *problemResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// problemOccurrence returns the instance and request ID of the problems of the
+// request of ctx, nil when unknown.
+func problemOccurrence(ctx context.Context) (instance, requestID *string) {
+	if state, ok := ctx.Value(problemRequestKey{}).(*problemRequest); ok && state.path != "" {
+		instance = &state.path
+	}
+	if id, ok := ctx.Value(constants.RequestIDContextID).(string); ok && id != "" {
+		requestID = &id
+	}
+	return instance, requestID
+}
+
+// problemEndpointMiddleware sets the instance and request ID of the problems
+// returned by endpoints.
+func problemEndpointMiddleware(next goa.Endpoint) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		res, err := next(ctx, req)
+		if err != nil {
+			setProblemOccurrence(ctx, err)
+		}
+		return res, err
+	}
+}
+
+// setProblemOccurrence sets the instance and request ID of err when it is a problem.
+func setProblemOccurrence(ctx context.Context, err error) {
+	instance, requestID := problemOccurrence(ctx)
+	switch e := err.(type) {
+	case *projsvc.BadRequestError:
+		e.Instance, e.RequestID = instance, requestID
+	case *projsvc.ForbiddenError:
+		e.Instance, e.RequestID = instance, requestID
+	case *projsvc.NotFoundError:
+		e.Instance, e.RequestID = instance, requestID
+	case *projsvc.ConflictError:
+		e.Instance, e.RequestID = instance, requestID
+	case *projsvc.UnprocessableEntityError:
+		e.Instance, e.RequestID = instance, requestID
+	case *projsvc.InternalServerError:
+		e.Instance, e.RequestID = instance, requestID
+	case *projsvc.ServiceUnavailableError:
+		e.Instance, e.RequestID = instance, requestID
+	}
+}
+
+// problemFormatter formats the errors that are not declared in the design as
+// problems. The status is the one Goa answers the error with; server errors
+// are not detailed.
+func problemFormatter(ctx context.Context, err error) goahttp.Statuser {
+	status := goahttp.NewErrorResponse(ctx, err).StatusCode()
+	p := lookupProblem(status, err)
+
+	detail := err.Error()
+	var serviceErr *goa.ServiceError
+	if errors.As(err, &serviceErr) {
+		detail = serviceErr.Message
+	}
+	if status >= http.StatusInternalServerError {
+		detail = domain.ErrInternal.Error()
+	}
+
+	if state, ok := ctx.Value(problemRequestKey{}).(*problemRequest); ok {
+		state.formatted = true
+	}
+	instance, requestID := problemOccurrence(ctx)
+	return &middleware.Problem{
+		Type:      p.typeURI(),
+		Title:     p.title,
+		Status:    status,
+		Detail:    detail,
+		Instance:  instance,
+		RequestID: requestID,
+		Code:      strconv.Itoa(status),
+		Message:   detail,
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	goahttp "goa.design/goa/v3/http"
+	"goa.design/goa/v3/pkg"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/middleware"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+func TestHandleError_problems(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedType   string
+		expectedStatus int
+		expectedDetail string
+	}{
+		{
+			name:           "project not found",
+			err:            domain.ErrProjectNotFound,
+			expectedType:   "urn:lfx:project-service:problem:project-not-found",
+			expectedStatus: http.StatusNotFound,
+			expectedDetail: "project not found",
+		},
+		{
+			name:           "wrapped slug exists",
+			err:            fmt.Errorf("create project: %w", domain.ErrProjectSlugExists),
+			expectedType:   "urn:lfx:project-service:problem:project-slug-exists",
+			expectedStatus: http.StatusConflict,
+			expectedDetail: "create project: project slug already exists",
+		},
+		{
+			name:           "policy violation",
+			err:            fmt.Errorf("%w: stage may not be changed", domain.ErrPolicyViolation),
+			expectedType:   "urn:lfx:project-service:problem:policy-violation",
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedDetail: "policy violation: stage may not be changed",
+		},
+		{
+			name:           "wrapped internal error is not detailed",
+			err:            fmt.Errorf("%w: kv get failed", domain.ErrInternal),
+			expectedType:   "urn:lfx:project-service:problem:internal-error",
+			expectedStatus: http.StatusInternalServerError,
+			expectedDetail: "internal error",
+		},
+		{
+			name:           "unknown error",
+			err:            errors.New("connection reset"),
+			expectedType:   "urn:lfx:project-service:problem:internal-error",
+			expectedStatus: http.StatusInternalServerError,
+			expectedDetail: "internal error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var named goa.GoaErrorNamer
+			require.True(t, errors.As(handleError(tt.err), &named))
+			body, err := json.Marshal(named)
+			require.NoError(t, err)
+
+			var response map[string]any
+			require.NoError(t, json.Unmarshal(body, &response))
+			assert.Equal(t, tt.expectedType, response["Type"])
+			assert.EqualValues(t, tt.expectedStatus, response["Status"])
+			assert.Equal(t, tt.expectedDetail, response["Detail"])
+			assert.Equal(t, tt.expectedDetail, response["Message"])
+			assert.NotEmpty(t, response["Title"])
+		})
+	}
+}
+
+func TestHandleError_validationProblem(t *testing.T) {
+	err := handleError(domain.NewFieldValidationError("parent_uid", domain.FieldErrorInvalid, domain.ErrInvalidParentProject))
+
+	badRequest, ok := err.(*projsvc.BadRequestError)
+	require.True(t, ok)
+	require.NotNil(t, badRequest.Type)
+	assert.Equal(t, "urn:lfx:project-service:problem:validation-failed", *badRequest.Type)
+	require.NotNil(t, badRequest.Status)
+	assert.Equal(t, http.StatusBadRequest, *badRequest.Status)
+	assert.Equal(t, []*projsvc.FieldError{
+		{Path: "parent_uid", Code: "invalid", Message: domain.ErrInvalidParentProject.Error()},
+	}, badRequest.FieldErrors)
+	assert.Equal(t, badRequest.FieldErrors, badRequest.Fields)
+}
+
+func TestProblemEndpointMiddleware(t *testing.T) {
+	var state *problemRequest
+	handler := problemMiddleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		state, _ = r.Context().Value(problemRequestKey{}).(*problemRequest)
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/projects/abc", nil))
+	require.NotNil(t, state)
+
+	ctx := context.WithValue(context.Background(), problemRequestKey{}, state)
+	ctx = context.WithValue(ctx, constants.RequestIDContextID, "req-1")
+	endpoint := problemEndpointMiddleware(func(context.Context, any) (any, error) {
+		return nil, handleError(domain.ErrProjectNotFound)
+	})
+
+	_, err := endpoint(ctx, nil)
+
+	notFound, ok := err.(*projsvc.NotFoundError)
+	require.True(t, ok)
+	require.NotNil(t, notFound.Instance)
+	assert.Equal(t, "/projects/abc", *notFound.Instance)
+	require.NotNil(t, notFound.RequestID)
+	assert.Equal(t, "req-1", *notFound.RequestID)
+}
+
+func TestProblemFormatter(t *testing.T) {
+	encode := goahttp.ErrorEncoder(goahttp.ResponseEncoder, problemFormatter)
+	handler := problemMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), constants.RequestIDContextID, "req-1")
+		err := goa.MissingFieldError("name", "body")
+		if r.URL.Path == "/fail" {
+			err = errors.New("database password rejected")
+		}
+		assert.NoError(t, encode(ctx, w, err))
+	}))
+
+	tests := []struct {
+		path           string
+		expectedStatus int
+		expectedType   string
+		expectedDetail string
+	}{
+		{
+			path:           "/projects",
+			expectedStatus: http.StatusBadRequest,
+			expectedType:   "urn:lfx:project-service:problem:validation-failed",
+			expectedDetail: `"name" is missing from body`,
+		},
+		{
+			path:           "/fail",
+			expectedStatus: http.StatusInternalServerError,
+			expectedType:   "urn:lfx:project-service:problem:internal-error",
+			expectedDetail: "internal error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, tt.path, nil))
+
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+			assert.Equal(t, middleware.ProblemContentType, recorder.Header().Get("Content-Type"))
+			var response middleware.Problem
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			assert.Equal(t, tt.expectedType, response.Type)
+			assert.Equal(t, tt.expectedStatus, response.Status)
+			assert.Equal(t, tt.expectedDetail, response.Detail)
+			require.NotNil(t, response.Instance)
+			assert.Equal(t, tt.path, *response.Instance)
+			require.NotNil(t, response.RequestID)
+			assert.Equal(t, "req-1", *response.RequestID)
+		})
+	}
+}
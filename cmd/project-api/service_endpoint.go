@@ -5,53 +5,184 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 	"strconv"
+	"time"
 
 	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/service"
 	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
 	"goa.design/goa/v3/security"
 )
 
-// createResponse creates a response error based on the HTTP status code.
+// createResponse creates the problem details error response of err for the
+// status code.
 func createResponse(code int, err error) error {
+	p := lookupProblem(code, err)
+	problemType, title, status, detail := p.typeURI(), p.title, code, err.Error()
 	switch code {
 	case http.StatusBadRequest:
 		return &projsvc.BadRequestError{
+			Type:    &problemType,
+			Title:   &title,
+			Status:  &status,
+			Detail:  &detail,
 			Code:    strconv.Itoa(code),
-			Message: err.Error(),
+			Message: detail,
+		}
+	case http.StatusForbidden:
+		return &projsvc.ForbiddenError{
+			Type:    &problemType,
+			Title:   &title,
+			Status:  &status,
+			Detail:  &detail,
+			Code:    strconv.Itoa(code),
+			Message: detail,
 		}
 	case http.StatusNotFound:
 		return &projsvc.NotFoundError{
+			Type:    &problemType,
+			Title:   &title,
+			Status:  &status,
+			Detail:  &detail,
 			Code:    strconv.Itoa(code),
-			Message: err.Error(),
+			Message: detail,
 		}
 	case http.StatusConflict:
 		return &projsvc.ConflictError{
+			Type:    &problemType,
+			Title:   &title,
+			Status:  &status,
+			Detail:  &detail,
+			Code:    strconv.Itoa(code),
+			Message: detail,
+		}
+	case http.StatusUnprocessableEntity:
+		return &projsvc.UnprocessableEntityError{
+			Type:    &problemType,
+			Title:   &title,
+			Status:  &status,
+			Detail:  &detail,
 			Code:    strconv.Itoa(code),
-			Message: err.Error(),
+			Message: detail,
 		}
 	case http.StatusInternalServerError:
 		return &projsvc.InternalServerError{
+			Type:    &problemType,
+			Title:   &title,
+			Status:  &status,
+			Detail:  &detail,
 			Code:    strconv.Itoa(code),
-			Message: err.Error(),
+			Message: detail,
 		}
 	case http.StatusServiceUnavailable:
 		return &projsvc.ServiceUnavailableError{
+			Type:    &problemType,
+			Title:   &title,
+			Status:  &status,
+			Detail:  &detail,
 			Code:    strconv.Itoa(code),
-			Message: err.Error(),
+			Message: detail,
 		}
 	default:
 		return nil
 	}
 }
 
+// createValidationResponse creates a response error that lists the fields
+// that failed validation: a forbidden error when the principal may not change
+// them or the project is dissolved, and a bad request error otherwise.
+func createValidationResponse(err *domain.ValidationError) error {
+	fields := make([]*projsvc.FieldError, 0, len(err.Fields))
+	for _, field := range err.Fields {
+		fields = append(fields, &projsvc.FieldError{
+			Path:    field.Path,
+			Code:    field.Code,
+			Message: field.Message,
+		})
+	}
+	message := err.Error()
+	if errors.Is(err, domain.ErrFieldTooLong) {
+		response := createResponse(http.StatusUnprocessableEntity, domain.ErrFieldTooLong).(*projsvc.UnprocessableEntityError)
+		response.Detail, response.Message = &message, message
+		response.FieldErrors, response.Fields = fields, fields
+		return response
+	}
+	if errors.Is(err, domain.ErrProjectDissolved) {
+		response := createResponse(http.StatusForbidden, domain.ErrProjectDissolved).(*projsvc.ForbiddenError)
+		response.Detail, response.Message = &message, message
+		response.FieldErrors, response.Fields = fields, fields
+		return response
+	}
+	if errors.Is(err, domain.ErrRestrictedField) {
+		response := createResponse(http.StatusForbidden, domain.ErrRestrictedField).(*projsvc.ForbiddenError)
+		response.Detail, response.Message = &message, message
+		response.FieldErrors, response.Fields = fields, fields
+		return response
+	}
+	response := createResponse(http.StatusBadRequest, domain.ErrValidationFailed).(*projsvc.BadRequestError)
+	response.Detail, response.Message = &message, message
+	response.FieldErrors, response.Fields = fields, fields
+	return response
+}
+
+// createDuplicateResponse creates a 409 error response listing the existing
+// projects a new project likely duplicates.
+func createDuplicateResponse(err *domain.DuplicateProjectError) error {
+	duplicates := make([]*projsvc.DuplicateProject, 0, len(err.Candidates))
+	for _, candidate := range err.Candidates {
+		duplicates = append(duplicates, &projsvc.DuplicateProject{
+			UID:    candidate.UID,
+			Slug:   candidate.Slug,
+			Name:   candidate.Name,
+			Reason: candidate.Reason,
+		})
+	}
+	response := createResponse(http.StatusConflict, err).(*projsvc.ConflictError)
+	response.Duplicates = duplicates
+	return response
+}
+
+// createSettingsMergeConflictResponse creates a 409 error response listing
+// the settings fields that a merged update and a concurrent update both changed.
+func createSettingsMergeConflictResponse(err *domain.SettingsMergeConflictError) error {
+	conflicts := make([]*projsvc.SettingsMergeConflict, 0, len(err.Conflicts))
+	for _, conflict := range err.Conflicts {
+		conflicts = append(conflicts, &projsvc.SettingsMergeConflict{
+			Field: conflict.Field,
+			Users: conflict.Users,
+		})
+	}
+	response := createResponse(http.StatusConflict, err).(*projsvc.ConflictError)
+	response.Conflicts = conflicts
+	return response
+}
+
+// createAutojoinDisabledResponse creates a 403 error response naming the people
+// to contact about joining a project that does not have autojoin enabled.
+func createAutojoinDisabledResponse(err *domain.AutojoinDisabledError) error {
+	contacts := make([]*projsvc.ProjectContact, 0, len(err.Contacts))
+	for _, contact := range err.Contacts {
+		contacts = append(contacts, &projsvc.ProjectContact{
+			Role:  contact.Role,
+			Name:  contact.Name,
+			Email: contact.Email,
+		})
+	}
+	response := createResponse(http.StatusForbidden, err).(*projsvc.ForbiddenError)
+	response.Contacts = contacts
+	return response
+}
+
 // Readyz checks if the service is able to take inbound requests.
 func (s *ProjectsAPI) Readyz(_ context.Context) ([]byte, error) {
-	if !s.service.ServiceReady() {
-		return nil, createResponse(http.StatusServiceUnavailable, domain.ErrServiceUnavailable)
+	if err := s.service.CheckReadiness(); err != nil {
+		return nil, createResponse(http.StatusServiceUnavailable, err)
 	}
 	return []byte("OK\n"), nil
 }
@@ -65,17 +196,262 @@ func (s *ProjectsAPI) Livez(_ context.Context) ([]byte, error) {
 	return []byte("OK\n"), nil
 }
 
+// Healthz reports the health of each dependency of the service.
+func (s *ProjectsAPI) Healthz(ctx context.Context) (*projsvc.HealthReport, error) {
+	report, healthy := s.service.CheckHealth(ctx)
+	if !healthy {
+		return nil, report
+	}
+	return report, nil
+}
+
+// ReconcileOutbox publishes every pending outbox message, ignoring retry backoff.
+func (s *ProjectsAPI) ReconcileOutbox(ctx context.Context, _ *projsvc.ReconcileOutboxPayload) (*projsvc.OutboxReconcileResult, error) {
+	result, err := s.service.ReconcileOutbox(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &projsvc.OutboxReconcileResult{
+		Published: result.Published,
+		Failed:    result.Failed,
+		Pending:   result.Pending,
+	}, nil
+}
+
+// ResyncProjects re-publishes indexer and update_access messages for the projects matching the payload.
+func (s *ProjectsAPI) ResyncProjects(ctx context.Context, payload *projsvc.ResyncProjectsPayload) (*projsvc.ResyncProjectsResult, error) {
+	filter := service.ResyncFilter{DryRun: payload.DryRun}
+	if payload.UIDPrefix != nil {
+		filter.UIDPrefix = *payload.UIDPrefix
+	}
+	if payload.Stage != nil {
+		filter.Stage = *payload.Stage
+	}
+	if payload.UpdatedSince != nil {
+		// The format is validated by the generated decoder.
+		updatedSince, err := time.Parse(time.RFC3339, *payload.UpdatedSince)
+		if err != nil {
+			return nil, createResponse(http.StatusBadRequest, domain.ErrValidationFailed)
+		}
+		filter.UpdatedSince = &updatedSince
+	}
+
+	result, err := s.service.ResyncProjects(ctx, filter)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &projsvc.ResyncProjectsResult{
+		ProjectUids:     result.ProjectUIDs,
+		Published:       result.Published,
+		Failed:          result.Failed,
+		MissingSettings: result.MissingSettings,
+	}, nil
+}
+
+// RebuildSlugMappings rebuilds the slug to UID mappings from the projects.
+func (s *ProjectsAPI) RebuildSlugMappings(ctx context.Context, payload *projsvc.RebuildSlugMappingsPayload) (*projsvc.SlugRebuildResult, error) {
+	report, err := s.service.RebuildSlugMappings(ctx, payload.RemoveDangling)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &projsvc.SlugRebuildResult{
+		Projects:         report.Projects,
+		CreatedMappings:  report.CreatedMappings,
+		UpdatedMappings:  report.UpdatedMappings,
+		ConflictingSlugs: report.ConflictingSlugs,
+		DanglingMappings: report.DanglingMappings,
+		RemovedMappings:  report.RemovedMappings,
+		Failed:           report.Failed,
+	}, nil
+}
+
+// InspectProjectKv returns the entries stored for a project's base and settings.
+func (s *ProjectsAPI) InspectProjectKv(ctx context.Context, payload *projsvc.InspectProjectKvPayload) (*projsvc.ProjectKVInspection, error) {
+	inspection, err := s.service.InspectProjectKV(ctx, payload.UID)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &projsvc.ProjectKVInspection{
+		ProjectUID: inspection.ProjectUID,
+		Base:       convertKVKeyInspection(inspection.Base),
+		Settings:   convertKVKeyInspection(inspection.Settings),
+	}, nil
+}
+
+// convertKVKeyInspection converts the entries of one KV key to the API type.
+func convertKVKeyInspection(inspection models.KVKeyInspection) *projsvc.KVKeyInspection {
+	result := &projsvc.KVKeyInspection{
+		Bucket:  inspection.Bucket,
+		Key:     inspection.Key,
+		History: make([]*projsvc.KVEntry, len(inspection.History)),
+	}
+	for i, entry := range inspection.History {
+		result.History[i] = convertKVEntry(entry)
+	}
+	if inspection.Current != nil {
+		result.Current = convertKVEntry(*inspection.Current)
+	}
+	return result
+}
+
+// convertKVEntry converts a KV entry to the API type, embedding its value as
+// JSON when it is valid JSON and as a string otherwise.
+func convertKVEntry(entry models.KVEntry) *projsvc.KVEntry {
+	result := &projsvc.KVEntry{
+		Revision:  entry.Revision,
+		Created:   entry.Created.UTC().Format(time.RFC3339Nano),
+		Operation: entry.Operation,
+	}
+	switch {
+	case len(entry.Value) == 0:
+	case json.Valid(entry.Value):
+		result.Value = json.RawMessage(entry.Value)
+	default:
+		result.Value = string(entry.Value)
+	}
+	return result
+}
+
+// GetIntegrityReport returns the inconsistencies found by the last integrity scan.
+func (s *ProjectsAPI) GetIntegrityReport(ctx context.Context, _ *projsvc.GetIntegrityReportPayload) (*projsvc.IntegrityReport, error) {
+	report, err := s.service.GetIntegrityReport(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &projsvc.IntegrityReport{
+		ScannedAt:                report.ScannedAt.UTC().Format(time.RFC3339),
+		Found:                    report.Found(),
+		DanglingSlugMappings:     report.DanglingSlugMappings,
+		MissingSlugMappings:      report.MissingSlugMappings,
+		DanglingLegacyIDMappings: report.DanglingLegacyIDMappings,
+		MissingLegacyIDMappings:  report.MissingLegacyIDMappings,
+		OrphanedSettings:         report.OrphanedSettings,
+		OrphanedChildren:         report.OrphanedChildren,
+		InvalidEntries:           report.InvalidEntries,
+	}, nil
+}
+
+// ListDeadLetters lists the NATS events whose handler failed, oldest first.
+func (s *ProjectsAPI) ListDeadLetters(ctx context.Context, _ *projsvc.ListDeadLettersPayload) (*projsvc.DeadLetterList, error) {
+	deadLetters, err := s.service.ListDeadLetters(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	result := &projsvc.DeadLetterList{DeadLetters: make([]*projsvc.DeadLetter, 0, len(deadLetters))}
+	for _, deadLetter := range deadLetters {
+		result.DeadLetters = append(result.DeadLetters, convertDeadLetter(deadLetter))
+	}
+	return result, nil
+}
+
+// ReplayDeadLetter hands a dead-lettered NATS event back to its handler.
+func (s *ProjectsAPI) ReplayDeadLetter(ctx context.Context, payload *projsvc.ReplayDeadLetterPayload) (*projsvc.ReplayDeadLetterResult, error) {
+	result, err := s.service.ReplayDeadLetter(ctx, payload.ID)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &projsvc.ReplayDeadLetterResult{
+		Replayed:   result.Replayed,
+		DeadLetter: convertDeadLetter(result.DeadLetter),
+	}, nil
+}
+
+// convertDeadLetter converts a dead letter to its API representation.
+func convertDeadLetter(deadLetter *models.DeadLetter) *projsvc.DeadLetter {
+	return &projsvc.DeadLetter{
+		ID:           deadLetter.ID,
+		Subject:      deadLetter.Subject,
+		Payload:      string(deadLetter.Payload),
+		Error:        deadLetter.Error,
+		Attempts:     deadLetter.Attempts,
+		FailedAt:     deadLetter.FailedAt.UTC().Format(time.RFC3339),
+		LastFailedAt: deadLetter.LastFailedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// CreateBackup saves the project stores to a new backup archive.
+func (s *ProjectsAPI) CreateBackup(ctx context.Context, _ *projsvc.CreateBackupPayload) (*projsvc.BackupResult, error) {
+	report, err := s.service.BackupProjects(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &projsvc.BackupResult{
+		Name:     report.Name,
+		Entries:  report.Entries,
+		Projects: report.Projects,
+	}, nil
+}
+
+// ListBackups lists the stored backup archives, newest first.
+func (s *ProjectsAPI) ListBackups(ctx context.Context, _ *projsvc.ListBackupsPayload) (*projsvc.BackupList, error) {
+	backups, err := s.service.ListBackups(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	result := &projsvc.BackupList{Backups: make([]*projsvc.Backup, 0, len(backups))}
+	for _, backup := range backups {
+		result.Backups = append(result.Backups, &projsvc.Backup{
+			Name:      backup.Name,
+			Size:      backup.Size,
+			CreatedAt: backup.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return result, nil
+}
+
+// RestoreBackup writes the entries of a backup archive back to the project stores.
+func (s *ProjectsAPI) RestoreBackup(ctx context.Context, payload *projsvc.RestoreBackupPayload) (*projsvc.RestoreBackupResult, error) {
+	report, err := s.service.RestoreProjects(ctx, payload.Name, payload.ProjectUids, payload.DryRun)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &projsvc.RestoreBackupResult{
+		Backup:      report.Backup,
+		ProjectUids: report.ProjectUIDs,
+		Entries:     report.Entries,
+		Failed:      report.Failed,
+	}, nil
+}
+
+// ListPendingAnnouncements lists the project announcements that have not been published yet.
+func (s *ProjectsAPI) ListPendingAnnouncements(ctx context.Context, _ *projsvc.ListPendingAnnouncementsPayload) (*projsvc.PendingAnnouncementList, error) {
+	pending, err := s.service.ListPendingAnnouncements(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	result := &projsvc.PendingAnnouncementList{Announcements: make([]*projsvc.PendingAnnouncement, 0, len(pending))}
+	for _, announcement := range pending {
+		result.Announcements = append(result.Announcements, &projsvc.PendingAnnouncement{
+			ProjectUID:       announcement.ProjectUID,
+			Slug:             announcement.Slug,
+			Name:             announcement.Name,
+			AnnouncementDate: announcement.AnnouncementDate.Format(time.DateOnly),
+			Due:              announcement.Due,
+		})
+	}
+	return result, nil
+}
+
 // JWTAuth implements Auther interface for the JWT security scheme.
-func (s *ProjectsAPI) JWTAuth(ctx context.Context, bearerToken string, _ *security.JWTScheme) (context.Context, error) {
+func (s *ProjectsAPI) JWTAuth(ctx context.Context, bearerToken string, scheme *security.JWTScheme) (context.Context, error) {
 	if !s.service.ServiceReady() {
 		return nil, createResponse(http.StatusServiceUnavailable, domain.ErrServiceUnavailable)
 	}
 
 	// Parse the Heimdall-authorized principal from the token.
-	principal, err := s.service.Auth.ParsePrincipal(ctx, bearerToken, slog.Default())
+	claims, err := s.service.Auth.ParseClaims(ctx, bearerToken, slog.Default())
 	if err != nil {
 		return ctx, err
 	}
-	// Return a new context containing the principal as a value.
-	return context.WithValue(ctx, constants.PrincipalContextID, principal), nil
+	// Machine-to-machine clients are limited to the scopes they were granted.
+	if claims.Scopes != nil {
+		if err := scheme.Validate(claims.Scopes); err != nil {
+			slog.WarnContext(ctx, "machine-to-machine client lacks required scope", constants.ErrKey, err,
+				"principal", claims.Principal)
+			return ctx, createResponse(http.StatusForbidden, domain.ErrForbidden)
+		}
+	}
+	// Return a new context containing the principal and its roles as values.
+	ctx = context.WithValue(ctx, constants.PrincipalContextID, claims.Principal)
+	return context.WithValue(ctx, constants.RolesContextID, claims.Roles), nil
 }
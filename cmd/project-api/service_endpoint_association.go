@@ -0,0 +1,61 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+// toServiceAssociations converts domain project associations to the API type.
+func toServiceAssociations(a *models.ProjectAssociations) *projsvc.ProjectAssociations {
+	if a == nil {
+		return nil
+	}
+	associations := &projsvc.ProjectAssociations{
+		ProjectUID:       a.ProjectUID,
+		CommitteeUids:    nonNilStrings(a.CommitteeUIDs),
+		MailingListIds:   nonNilStrings(a.MailingListIDs),
+		MeetingSeriesIds: nonNilStrings(a.MeetingSeriesIDs),
+	}
+	if a.UpdatedAt != nil {
+		associations.UpdatedAt = misc.StringPtr(a.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	}
+	return associations
+}
+
+// nonNilStrings returns s, or an empty slice when s is nil, so that the list is
+// encoded as [] rather than null.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+// GetProjectAssociations gets the committees, mailing lists and meeting series associated with a project.
+func (s *ProjectsAPI) GetProjectAssociations(ctx context.Context, payload *projsvc.GetProjectAssociationsPayload) (*projsvc.ProjectAssociations, error) {
+	associations, err := s.service.GetProjectAssociations(ctx, payload.UID)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return toServiceAssociations(associations), nil
+}
+
+// UpdateProjectAssociations replaces the committees, mailing lists and meeting series associated with a project.
+func (s *ProjectsAPI) UpdateProjectAssociations(ctx context.Context, payload *projsvc.UpdateProjectAssociationsPayload) (*projsvc.ProjectAssociations, error) {
+	associations, err := s.service.UpdateProjectAssociations(ctx, &models.ProjectAssociations{
+		ProjectUID:       payload.UID,
+		CommitteeUIDs:    nonNilStrings(payload.CommitteeUids),
+		MailingListIDs:   nonNilStrings(payload.MailingListIds),
+		MeetingSeriesIDs: nonNilStrings(payload.MeetingSeriesIds),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return toServiceAssociations(associations), nil
+}
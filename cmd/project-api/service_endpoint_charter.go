@@ -0,0 +1,75 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+// toServiceCharterVersion converts a domain charter version to the API type.
+func toServiceCharterVersion(v *models.ProjectCharterVersion) *projsvc.ProjectCharterVersion {
+	version := &projsvc.ProjectCharterVersion{
+		Version:    v.Version,
+		URL:        v.URL,
+		FileSize:   v.FileSize,
+		Sha256:     v.SHA256,
+		UploadedAt: v.UploadedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if v.FileName != "" {
+		version.FileName = &v.FileName
+	}
+	if v.UploadedByUsername != "" {
+		version.UploadedByUsername = &v.UploadedByUsername
+	}
+	return version
+}
+
+// toServiceCharter converts a domain charter and its version history to the API type.
+func toServiceCharter(c *models.ProjectCharter) *projsvc.ProjectCharter {
+	if c == nil {
+		return nil
+	}
+	charter := &projsvc.ProjectCharter{
+		ProjectUID: c.ProjectUID,
+		Versions:   make([]*projsvc.ProjectCharterVersion, 0, len(c.Versions)),
+	}
+	for i := range c.Versions {
+		charter.Versions = append(charter.Versions, toServiceCharterVersion(&c.Versions[i]))
+	}
+	if current := c.Current(); current != nil {
+		charter.Current = toServiceCharterVersion(current)
+	}
+	return charter
+}
+
+// UploadProjectCharter handles multipart charter upload.
+func (s *ProjectsAPI) UploadProjectCharter(ctx context.Context, payload *projsvc.UploadProjectCharterPayload) (*projsvc.ProjectCharter, error) {
+	xSync := false
+	if payload.XSync != nil {
+		xSync = *payload.XSync
+	}
+
+	fileName := ""
+	if payload.FileName != nil {
+		fileName = *payload.FileName
+	}
+
+	charter, err := s.service.UploadProjectCharter(ctx, payload.UID, fileName, payload.File, xSync)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return toServiceCharter(charter), nil
+}
+
+// GetProjectCharter gets a project's charter and its version history.
+func (s *ProjectsAPI) GetProjectCharter(ctx context.Context, payload *projsvc.GetProjectCharterPayload) (*projsvc.ProjectCharter, error) {
+	charter, err := s.service.GetProjectCharter(ctx, payload.UID)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return toServiceCharter(charter), nil
+}
@@ -0,0 +1,93 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+// ListProjectDeletions lists the tombstones of deleted projects, oldest first.
+func (s *ProjectsAPI) ListProjectDeletions(ctx context.Context, payload *projsvc.ListProjectDeletionsPayload) (*projsvc.ProjectDeletionList, error) {
+	var since time.Time
+	if payload.Since != nil {
+		var err error
+		since, err = time.Parse(time.RFC3339, *payload.Since)
+		if err != nil {
+			return nil, createResponse(http.StatusBadRequest, domain.ErrValidationFailed)
+		}
+	}
+
+	deletions, err := s.service.ListProjectDeletions(ctx, since)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	result := &projsvc.ProjectDeletionList{Deletions: make([]*projsvc.ProjectDeletion, 0, len(deletions))}
+	for _, deletion := range deletions {
+		result.Deletions = append(result.Deletions, toServiceProjectDeletion(deletion))
+	}
+	return result, nil
+}
+
+// toServiceProjectDeletion converts a project deletion to its API type.
+func toServiceProjectDeletion(deletion *models.ProjectDeletion) *projsvc.ProjectDeletion {
+	result := &projsvc.ProjectDeletion{
+		UID:       deletion.UID,
+		Slug:      deletion.Slug,
+		DeletedAt: deletion.DeletedAt.Format(time.RFC3339),
+	}
+	if deletion.DeletedBy != "" {
+		result.DeletedBy = misc.StringPtr(deletion.DeletedBy)
+	}
+	if deletion.MergedInto != "" {
+		result.MergedInto = misc.StringPtr(deletion.MergedInto)
+	}
+	return result
+}
+
+// BulkDeleteProjects deletes several projects at once, children first.
+func (s *ProjectsAPI) BulkDeleteProjects(ctx context.Context, payload *projsvc.BulkDeleteProjectsPayload) (*projsvc.BulkProjectDeletionResult, error) {
+	xSync := false
+	if payload.XSync != nil {
+		xSync = *payload.XSync
+	}
+
+	results, err := s.service.BulkDeleteProjects(ctx, &models.ProjectBulkDeletion{
+		ProjectUIDs: payload.Uids,
+		DryRun:      payload.DryRun,
+		Cascade:     payload.Cascade,
+		Force:       payload.Force,
+	}, xSync)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	result := &projsvc.BulkProjectDeletionResult{
+		Results: make([]*projsvc.ProjectDeletionOutcome, 0, len(results)),
+		DryRun:  payload.DryRun,
+	}
+	for _, r := range results {
+		outcome := &projsvc.ProjectDeletionOutcome{UID: r.ProjectUID, Requested: r.Requested, Status: r.Status}
+		if r.Slug != "" {
+			outcome.Slug = misc.StringPtr(r.Slug)
+		}
+		if r.Error != "" {
+			outcome.Error = misc.StringPtr(r.Error)
+		}
+		switch r.Status {
+		case models.ProjectDeletionDeleted, models.ProjectDeletionWouldDelete:
+			result.Deleted++
+		case models.ProjectDeletionNotFound, models.ProjectDeletionBlocked, models.ProjectDeletionFailed:
+			result.Failed++
+		}
+		result.Results = append(result.Results, outcome)
+	}
+	return result, nil
+}
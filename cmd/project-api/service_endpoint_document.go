@@ -156,7 +156,7 @@ func (s *ProjectsAPI) DeleteProjectDocument(ctx context.Context, payload *projsv
 		xSync = *payload.XSync
 	}
 
-	if err := s.service.DeleteDocument(ctx, payload.UID, payload.DocumentUID, payload.IfMatch, xSync); err != nil {
+	if err := s.service.DeleteDocument(ctx, payload.UID, payload.DocumentUID, payload.IfMatch, payload.ForceUpdate != nil && *payload.ForceUpdate, xSync); err != nil {
 		return handleError(err)
 	}
 
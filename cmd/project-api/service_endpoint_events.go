@@ -0,0 +1,62 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/service"
+)
+
+// ListProjectEvents lists the lifecycle events of a project reconstructed from
+// its history, oldest first.
+func (s *ProjectsAPI) ListProjectEvents(ctx context.Context, payload *projsvc.ListProjectEventsPayload) (*projsvc.ProjectEventList, error) {
+	var since time.Time
+	if payload.Since != nil {
+		var err error
+		since, err = time.Parse(time.RFC3339, *payload.Since)
+		if err != nil {
+			return nil, createResponse(http.StatusBadRequest, domain.ErrValidationFailed)
+		}
+	}
+
+	history, err := s.service.ListProjectEvents(ctx, payload.UID, since)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	result := &projsvc.ProjectEventList{Events: make([]*projsvc.ProjectEvent, 0, len(history))}
+	for _, event := range history {
+		result.Events = append(result.Events, toServiceProjectEvent(event))
+	}
+	return result, nil
+}
+
+// toServiceProjectEvent converts a reconstructed project event to its API type.
+func toServiceProjectEvent(event *models.ProjectHistoryEvent) *projsvc.ProjectEvent {
+	result := &projsvc.ProjectEvent{
+		ID:         event.ID,
+		Type:       event.Type,
+		OccurredAt: event.OccurredAt.Format(time.RFC3339),
+		ProjectUID: event.ProjectUID,
+		Revision:   event.Revision,
+	}
+	if event.Project != nil {
+		result.Project = service.ConvertToServiceProjectBase(event.Project)
+	}
+	if event.PreviousProject != nil {
+		result.PreviousProject = service.ConvertToServiceProjectBase(event.PreviousProject)
+	}
+	if event.Settings != nil {
+		result.Settings = service.ConvertToServiceProjectSettings(event.Settings)
+	}
+	if event.PreviousSettings != nil {
+		result.PreviousSettings = service.ConvertToServiceProjectSettings(event.PreviousSettings)
+	}
+	return result
+}
@@ -63,7 +63,7 @@ func (s *ProjectsAPI) DeleteProjectFolder(ctx context.Context, payload *projsvc.
 		xSync = *payload.XSync
 	}
 
-	if err := s.service.DeleteFolder(ctx, payload.UID, payload.FolderUID, payload.IfMatch, xSync); err != nil {
+	if err := s.service.DeleteFolder(ctx, payload.UID, payload.FolderUID, payload.IfMatch, payload.ForceUpdate != nil && *payload.ForceUpdate, xSync); err != nil {
 		return handleError(err)
 	}
 
@@ -0,0 +1,64 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/service"
+)
+
+// GetProjectChildren lists a page of the direct children of a project.
+func (s *ProjectsAPI) GetProjectChildren(ctx context.Context, payload *projsvc.GetProjectChildrenPayload) (*projsvc.ProjectPage, error) {
+	page, err := s.service.ListProjectChildren(ctx, payload.UID, toProjectPageRequest(payload.Sort, payload.Order, payload.PageSize, payload.PageToken))
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return toServiceProjectPage(page), nil
+}
+
+// GetProjectDescendants lists a page of the projects under a project.
+func (s *ProjectsAPI) GetProjectDescendants(ctx context.Context, payload *projsvc.GetProjectDescendantsPayload) (*projsvc.ProjectPage, error) {
+	page, err := s.service.ListProjectDescendants(ctx, payload.UID, toProjectPageRequest(payload.Sort, payload.Order, payload.PageSize, payload.PageToken))
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return toServiceProjectPage(page), nil
+}
+
+// GetProjectLegalLineage gets the chain of legal parents of a project.
+func (s *ProjectsAPI) GetProjectLegalLineage(ctx context.Context, payload *projsvc.GetProjectLegalLineagePayload) (*projsvc.ProjectLegalLineage, error) {
+	lineage, err := s.service.GetProjectLegalLineage(ctx, payload.UID)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return service.ConvertToServiceLegalLineage(lineage), nil
+}
+
+// toProjectPageRequest converts the page parameters of a payload to a page request.
+func toProjectPageRequest(sort, order string, pageSize int, pageToken *string) models.ProjectPageRequest {
+	request := models.ProjectPageRequest{
+		Sort:       sort,
+		Descending: order == "desc",
+		PageSize:   pageSize,
+	}
+	if pageToken != nil {
+		request.PageToken = *pageToken
+	}
+	return request
+}
+
+// toServiceProjectPage converts a page of projects to the API type.
+func toServiceProjectPage(page *models.ProjectPage) *projsvc.ProjectPage {
+	result := &projsvc.ProjectPage{Projects: make([]*projsvc.ProjectBase, len(page.Projects))}
+	for i, project := range page.Projects {
+		result.Projects[i] = service.ConvertToServiceProjectBase(project)
+	}
+	if page.NextPageToken != "" {
+		result.NextPageToken = &page.NextPageToken
+	}
+	return result
+}
@@ -68,7 +68,7 @@ func (s *ProjectsAPI) DeleteProjectLink(ctx context.Context, payload *projsvc.De
 		xSync = *payload.XSync
 	}
 
-	if err := s.service.DeleteLink(ctx, payload.UID, payload.LinkUID, payload.IfMatch, xSync); err != nil {
+	if err := s.service.DeleteLink(ctx, payload.UID, payload.LinkUID, payload.IfMatch, payload.ForceUpdate != nil && *payload.ForceUpdate, xSync); err != nil {
 		return handleError(err)
 	}
 
@@ -0,0 +1,29 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+)
+
+// UploadProjectLogo handles multipart logo upload.
+func (s *ProjectsAPI) UploadProjectLogo(ctx context.Context, payload *projsvc.UploadProjectLogoPayload) (*projsvc.ProjectBase, error) {
+	xSync := false
+	if payload.XSync != nil {
+		xSync = *payload.XSync
+	}
+
+	contentType := ""
+	if payload.ContentType != nil {
+		contentType = *payload.ContentType
+	}
+
+	project, err := s.service.UploadProjectLogo(ctx, payload.UID, contentType, payload.File, xSync)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return project, nil
+}
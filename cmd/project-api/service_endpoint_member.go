@@ -0,0 +1,117 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/service"
+)
+
+// addProjectMember adds a user to one of a project's member roles.
+func (s *ProjectsAPI) addProjectMember(ctx context.Context, projectUID string, role models.ProjectMemberRole, username string, ifMatch *string, xSyncHeader *bool) (*projsvc.ProjectSettings, error) {
+	xSync := false
+	if xSyncHeader != nil {
+		xSync = *xSyncHeader
+	}
+
+	settings, err := s.service.AddProjectMember(ctx, projectUID, role, username, ifMatch, xSync)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return service.ConvertToServiceProjectSettings(settings), nil
+}
+
+// removeProjectMember removes a user from one of a project's member roles.
+func (s *ProjectsAPI) removeProjectMember(ctx context.Context, projectUID string, role models.ProjectMemberRole, username string, ifMatch *string, xSyncHeader *bool) error {
+	xSync := false
+	if xSyncHeader != nil {
+		xSync = *xSyncHeader
+	}
+
+	if err := s.service.RemoveProjectMember(ctx, projectUID, role, username, ifMatch, xSync); err != nil {
+		return handleError(err)
+	}
+	return nil
+}
+
+// AddProjectWriter adds a user to a project's writers.
+func (s *ProjectsAPI) AddProjectWriter(ctx context.Context, payload *projsvc.AddProjectWriterPayload) (*projsvc.ProjectSettings, error) {
+	return s.addProjectMember(ctx, payload.UID, models.ProjectMemberRoleWriter, payload.Username, payload.IfMatch, payload.XSync)
+}
+
+// RemoveProjectWriter removes a user from a project's writers.
+func (s *ProjectsAPI) RemoveProjectWriter(ctx context.Context, payload *projsvc.RemoveProjectWriterPayload) error {
+	return s.removeProjectMember(ctx, payload.UID, models.ProjectMemberRoleWriter, payload.Username, payload.IfMatch, payload.XSync)
+}
+
+// AddProjectAuditor adds a user to a project's auditors.
+func (s *ProjectsAPI) AddProjectAuditor(ctx context.Context, payload *projsvc.AddProjectAuditorPayload) (*projsvc.ProjectSettings, error) {
+	return s.addProjectMember(ctx, payload.UID, models.ProjectMemberRoleAuditor, payload.Username, payload.IfMatch, payload.XSync)
+}
+
+// RemoveProjectAuditor removes a user from a project's auditors.
+func (s *ProjectsAPI) RemoveProjectAuditor(ctx context.Context, payload *projsvc.RemoveProjectAuditorPayload) error {
+	return s.removeProjectMember(ctx, payload.UID, models.ProjectMemberRoleAuditor, payload.Username, payload.IfMatch, payload.XSync)
+}
+
+// JoinProject adds the requesting user to a project that has autojoin enabled.
+func (s *ProjectsAPI) JoinProject(ctx context.Context, payload *projsvc.JoinProjectPayload) (*projsvc.ProjectJoinResult, error) {
+	xSync := false
+	if payload.XSync != nil {
+		xSync = *payload.XSync
+	}
+
+	role, username, err := s.service.JoinProject(ctx, payload.UID, xSync)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &projsvc.ProjectJoinResult{
+		ProjectUID: payload.UID,
+		Username:   username,
+		Role:       string(role),
+	}, nil
+}
+
+// BulkUpdateProjectMembers adds users to and removes users from the writers and
+// auditors of several projects.
+func (s *ProjectsAPI) BulkUpdateProjectMembers(ctx context.Context, payload *projsvc.BulkUpdateProjectMembersPayload) (*projsvc.BulkProjectMembersResult, error) {
+	xSync := false
+	if payload.XSync != nil {
+		xSync = *payload.XSync
+	}
+
+	changes := make([]models.ProjectMembersChange, 0, len(payload.Operations))
+	for _, operation := range payload.Operations {
+		changes = append(changes, models.ProjectMembersChange{
+			ProjectUID:     operation.UID,
+			WritersAdd:     operation.WritersAdd,
+			WritersRemove:  operation.WritersRemove,
+			AuditorsAdd:    operation.AuditorsAdd,
+			AuditorsRemove: operation.AuditorsRemove,
+		})
+	}
+	results, err := s.service.BulkUpdateProjectMembers(ctx, changes, xSync)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	result := &projsvc.BulkProjectMembersResult{Results: make([]*projsvc.ProjectMembersOperationResult, 0, len(results))}
+	for _, r := range results {
+		operation := &projsvc.ProjectMembersOperationResult{UID: r.ProjectUID, Status: r.Status}
+		if r.Error != "" {
+			operation.Error = &r.Error
+		}
+		switch r.Status {
+		case models.ProjectMembersChangeUpdated:
+			result.Updated++
+		case models.ProjectMembersChangeNotFound, models.ProjectMembersChangeFailed:
+			result.Failed++
+		}
+		result.Results = append(result.Results, operation)
+	}
+	return result, nil
+}
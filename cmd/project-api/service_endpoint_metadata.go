@@ -0,0 +1,50 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"time"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+// GetProjectEnums gets the values allowed for the enumerated project fields.
+func (s *ProjectsAPI) GetProjectEnums(ctx context.Context, _ *projsvc.GetProjectEnumsPayload) (*projsvc.ProjectEnums, error) {
+	enums, err := s.service.GetProjectEnums(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return toServiceProjectEnums(enums), nil
+}
+
+// UpdateProjectEnums replaces the values allowed for the enumerated project fields.
+func (s *ProjectsAPI) UpdateProjectEnums(ctx context.Context, payload *projsvc.UpdateProjectEnumsPayload) (*projsvc.ProjectEnums, error) {
+	enums, err := s.service.UpdateProjectEnums(ctx, &models.ProjectEnums{
+		Stages:     payload.Stages,
+		Categories: payload.Categories,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return toServiceProjectEnums(enums), nil
+}
+
+// toServiceProjectEnums converts the allowed values of the enumerated project
+// fields to the API type.
+func toServiceProjectEnums(e *models.ProjectEnums) *projsvc.ProjectEnums {
+	enums := &projsvc.ProjectEnums{
+		Stages:     e.Stages,
+		Categories: e.Categories,
+	}
+	if e.UpdatedBy != "" {
+		enums.UpdatedBy = misc.StringPtr(e.UpdatedBy)
+	}
+	if e.UpdatedAt != nil {
+		enums.UpdatedAt = misc.StringPtr(e.UpdatedAt.UTC().Format(time.RFC3339))
+	}
+	return enums
+}
@@ -5,62 +5,56 @@ package main
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
+	"goa.design/goa/v3/pkg"
+
 	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
 )
 
 // handleError converts domain errors to HTTP errors.
 func handleError(err error) error {
-	switch err {
-	case domain.ErrServiceUnavailable:
-		return createResponse(http.StatusServiceUnavailable, domain.ErrServiceUnavailable)
-	case domain.ErrValidationFailed:
-		return createResponse(http.StatusBadRequest, domain.ErrValidationFailed)
-	case domain.ErrRevisionMismatch:
-		return createResponse(http.StatusConflict, domain.ErrRevisionMismatch)
-	case domain.ErrInvalidParentProject:
-		return createResponse(http.StatusBadRequest, domain.ErrInvalidParentProject)
-	case domain.ErrCannotDeleteNonCrowdfundingProject:
-		return createResponse(http.StatusBadRequest, domain.ErrCannotDeleteNonCrowdfundingProject)
-	case domain.ErrArchivedRequiresDissolutionDate:
-		return createResponse(http.StatusBadRequest, domain.ErrArchivedRequiresDissolutionDate)
-	case domain.ErrInvalidContentType, domain.ErrFileTooLarge:
-		return createResponse(http.StatusBadRequest, err)
-	case domain.ErrProjectNotFound:
-		return createResponse(http.StatusNotFound, domain.ErrProjectNotFound)
-	case domain.ErrDocumentNotFound:
-		return createResponse(http.StatusNotFound, domain.ErrDocumentNotFound)
-	case domain.ErrLinkNotFound:
-		return createResponse(http.StatusNotFound, domain.ErrLinkNotFound)
-	case domain.ErrFolderNotFound:
-		return createResponse(http.StatusNotFound, domain.ErrFolderNotFound)
-	case domain.ErrProjectSlugExists:
-		return createResponse(http.StatusConflict, domain.ErrProjectSlugExists)
-	case domain.ErrDocumentNameExists:
-		return createResponse(http.StatusConflict, domain.ErrDocumentNameExists)
-	case domain.ErrFolderNameExists:
-		return createResponse(http.StatusConflict, domain.ErrFolderNameExists)
-	case domain.ErrFolderNotEmpty:
-		return createResponse(http.StatusConflict, domain.ErrFolderNotEmpty)
-	case domain.ErrInternal, domain.ErrUnmarshal:
+	var validationErr *domain.ValidationError
+	if errors.As(err, &validationErr) {
+		return createValidationResponse(validationErr)
+	}
+	var autojoinErr *domain.AutojoinDisabledError
+	if errors.As(err, &autojoinErr) {
+		return createAutojoinDisabledResponse(autojoinErr)
+	}
+	var duplicateErr *domain.DuplicateProjectError
+	if errors.As(err, &duplicateErr) {
+		return createDuplicateResponse(duplicateErr)
+	}
+	var mergeConflictErr *domain.SettingsMergeConflictError
+	if errors.As(err, &mergeConflictErr) {
+		return createSettingsMergeConflictResponse(mergeConflictErr)
+	}
+	var apiErr goa.GoaErrorNamer
+	if errors.As(err, &apiErr) {
+		return err
+	}
+
+	p, ok := domainProblem(err)
+	if !ok {
 		return createResponse(http.StatusInternalServerError, domain.ErrInternal)
 	}
-	return err
+	if p.status >= http.StatusInternalServerError {
+		// The causes of server errors are logged, not returned.
+		return createResponse(p.status, p.err)
+	}
+	return createResponse(p.status, err)
 }
 
 // GetProjects fetches all projects
 func (s *ProjectsAPI) GetProjects(ctx context.Context, payload *projsvc.GetProjectsPayload) (*projsvc.GetProjectsResult, error) {
-	projects, err := s.service.GetProjects(ctx)
+	result, err := s.service.GetProjects(ctx, payload)
 	if err != nil {
 		return nil, handleError(err)
 	}
-
-	return &projsvc.GetProjectsResult{
-		Projects:     projects,
-		CacheControl: nil,
-	}, nil
+	return result, nil
 }
 
 // CreateProject creates a new project.
@@ -72,6 +66,33 @@ func (s *ProjectsAPI) CreateProject(ctx context.Context, payload *projsvc.Create
 	return project, nil
 }
 
+// CloneProject creates a new project with a copy of an existing project's base and settings.
+func (s *ProjectsAPI) CloneProject(ctx context.Context, payload *projsvc.CloneProjectPayload) (*projsvc.ProjectFull, error) {
+	project, err := s.service.CloneProject(ctx, payload)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return project, nil
+}
+
+// MergeProject merges a project into another one and returns the target project.
+func (s *ProjectsAPI) MergeProject(ctx context.Context, payload *projsvc.MergeProjectPayload) (*projsvc.ProjectFull, error) {
+	project, err := s.service.MergeProject(ctx, payload)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return project, nil
+}
+
+// TransferProject transfers a project to new owners and returns the project.
+func (s *ProjectsAPI) TransferProject(ctx context.Context, payload *projsvc.TransferProjectPayload) (*projsvc.ProjectFull, error) {
+	project, err := s.service.TransferProject(ctx, payload)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return project, nil
+}
+
 // GetOneProjectBase gets a single project's base information.
 func (s *ProjectsAPI) GetOneProjectBase(ctx context.Context, payload *projsvc.GetOneProjectBasePayload) (*projsvc.GetOneProjectBaseResult, error) {
 	project, err := s.service.GetOneProjectBase(ctx, payload)
@@ -81,6 +102,15 @@ func (s *ProjectsAPI) GetOneProjectBase(ctx context.Context, payload *projsvc.Ge
 	return project, nil
 }
 
+// GetProjectUIDFromLegacyID resolves a project's LFX v1 ID to its UID.
+func (s *ProjectsAPI) GetProjectUIDFromLegacyID(ctx context.Context, payload *projsvc.GetProjectUIDFromLegacyIDPayload) (*projsvc.GetProjectUIDFromLegacyIDResult, error) {
+	result, err := s.service.GetProjectUIDFromLegacyID(ctx, payload)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return result, nil
+}
+
 // GetOneProjectSettings gets a single project's settings information.
 func (s *ProjectsAPI) GetOneProjectSettings(ctx context.Context, payload *projsvc.GetOneProjectSettingsPayload) (*projsvc.GetOneProjectSettingsResult, error) {
 	projectSettings, err := s.service.GetOneProjectSettings(ctx, payload)
@@ -137,7 +137,7 @@ func TestCreateProject(t *testing.T) {
 		{
 			name: "success",
 			payload: &projsvc.CreateProjectPayload{
-				Slug:        "test-project",
+				Slug:        misc.StringPtr("test-project"),
 				Name:        "Test Project",
 				Description: "Test description",
 				Public:      misc.BoolPtr(true),
@@ -175,13 +175,18 @@ func TestCreateProject(t *testing.T) {
 				// Mock message sending
 				mockMsg.On("SendIndexerMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("types.IndexerMessageEnvelope"), mock.AnythingOfType("bool")).Return(nil).Times(2)
 				mockMsg.On("SendAccessMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("types.GenericFGAMessage"), mock.AnythingOfType("bool")).Return(nil)
+				mockMsg.On("SendProjectEventMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("events.ProjectLifecycleEvent")).Return(nil)
+				// Mock the hierarchy read for the created project's slug path
+				mockRepo.On("ListAllProjectsBase", mock.Anything).Return([]*models.ProjectBase{
+					{UID: "787620d0-d7de-449a-b0bf-9d28b13da818", Slug: "parent-project"},
+				}, nil)
 			},
 			expectedError: false,
 		},
 		{
 			name: "slug already exists",
 			payload: &projsvc.CreateProjectPayload{
-				Slug:        "existing-project",
+				Slug:        misc.StringPtr("existing-project"),
 				Name:        "Test Project",
 				Description: "Test description",
 				Public:      misc.BoolPtr(true),
@@ -211,8 +216,11 @@ func TestCreateProject(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, result)
-				assert.Equal(t, tt.payload.Slug, *result.Slug)
+				assert.Equal(t, *tt.payload.Slug, *result.Slug)
 				assert.Equal(t, tt.payload.Name, *result.Name)
+				assert.Equal(t, "parent-project/"+*tt.payload.Slug, *result.SlugPath)
+				assert.Equal(t, 2, *result.Depth)
+				assert.Equal(t, 0, *result.ChildCount)
 			}
 
 			mockRepo.AssertExpectations(t)
@@ -221,3 +229,44 @@ func TestCreateProject(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleError_validation(t *testing.T) {
+	err := handleError(domain.NewFieldValidationError("entity_dissolution_date", domain.FieldErrorRequired, domain.ErrArchivedRequiresDissolutionDate))
+
+	badRequest, ok := err.(*projsvc.BadRequestError)
+	if assert.True(t, ok) {
+		assert.Equal(t, "400", badRequest.Code)
+		assert.Equal(t, domain.ErrArchivedRequiresDissolutionDate.Error(), badRequest.Message)
+		assert.Equal(t, []*projsvc.FieldError{
+			{Path: "entity_dissolution_date", Code: "required", Message: domain.ErrArchivedRequiresDissolutionDate.Error()},
+		}, badRequest.Fields)
+	}
+}
+
+func TestHandleError_restrictedField(t *testing.T) {
+	validation := &domain.ValidationError{Fields: []domain.FieldError{
+		{Path: "funding_model", Code: domain.FieldErrorForbidden, Message: "funding_model can only be changed by LF staff", Err: domain.ErrRestrictedField},
+	}}
+
+	forbidden, ok := handleError(validation).(*projsvc.ForbiddenError)
+	if assert.True(t, ok) {
+		assert.Equal(t, "403", forbidden.Code)
+		assert.Equal(t, []*projsvc.FieldError{
+			{Path: "funding_model", Code: "forbidden", Message: "funding_model can only be changed by LF staff"},
+		}, forbidden.Fields)
+	}
+}
+
+func TestHandleError_fieldTooLong(t *testing.T) {
+	validation := &domain.ValidationError{Fields: []domain.FieldError{
+		{Path: "description", Code: domain.FieldErrorTooLarge, Message: "description must be at most 10 characters, got 12", Err: domain.ErrFieldTooLong},
+	}}
+
+	unprocessable, ok := handleError(validation).(*projsvc.UnprocessableEntityError)
+	if assert.True(t, ok) {
+		assert.Equal(t, "422", unprocessable.Code)
+		assert.Equal(t, []*projsvc.FieldError{
+			{Path: "description", Code: "too_large", Message: "description must be at most 10 characters, got 12"},
+		}, unprocessable.Fields)
+	}
+}
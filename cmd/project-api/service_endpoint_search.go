@@ -0,0 +1,49 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/service"
+)
+
+// SearchProjects searches projects by name, slug and description.
+func (s *ProjectsAPI) SearchProjects(ctx context.Context, payload *projsvc.SearchProjectsPayload) (*projsvc.SearchProjectsResult, error) {
+	projects, source, err := s.service.SearchProjects(ctx, payload.Q, payload.Limit)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	result := &projsvc.SearchProjectsResult{
+		Projects: make([]*projsvc.ProjectBase, len(projects)),
+		Source:   source,
+	}
+	for i, project := range projects {
+		result.Projects[i] = service.ConvertToServiceProjectBase(project)
+	}
+	return result, nil
+}
+
+// AutocompleteProjects suggests projects for a typeahead prefix.
+func (s *ProjectsAPI) AutocompleteProjects(ctx context.Context, payload *projsvc.AutocompleteProjectsPayload) (*projsvc.AutocompleteProjectsResult, error) {
+	suggestions, err := s.service.AutocompleteProjects(ctx, payload.Q, payload.Limit)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	result := &projsvc.AutocompleteProjectsResult{Projects: make([]*projsvc.ProjectSuggestion, len(suggestions))}
+	for i, suggestion := range suggestions {
+		result.Projects[i] = &projsvc.ProjectSuggestion{
+			UID:  suggestion.UID,
+			Name: suggestion.Name,
+			Slug: suggestion.Slug,
+		}
+		if suggestion.LogoURL != "" {
+			result.Projects[i].LogoURL = &suggestion.LogoURL
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,20 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/service"
+)
+
+// GetProjectSettingsDiff gets the difference between two revisions of a project's settings.
+func (s *ProjectsAPI) GetProjectSettingsDiff(ctx context.Context, payload *projsvc.GetProjectSettingsDiffPayload) (*projsvc.ProjectSettingsDiff, error) {
+	diff, err := s.service.GetProjectSettingsDiff(ctx, payload.UID, payload.From, payload.To)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return service.ConvertToServiceSettingsDiff(diff), nil
+}
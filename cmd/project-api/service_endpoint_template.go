@@ -0,0 +1,138 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"time"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+// CreateProjectTemplate defines a template that projects can be created from.
+func (s *ProjectsAPI) CreateProjectTemplate(ctx context.Context, payload *projsvc.CreateProjectTemplatePayload) (*projsvc.ProjectTemplate, error) {
+	template := toModelProjectTemplate(payload.Name, payload.Description, payload.Stage, payload.Category, payload.FundingModel, payload.MissionStatement, payload.Auditors)
+	created, err := s.service.CreateProjectTemplate(ctx, template)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return toServiceProjectTemplate(created), nil
+}
+
+// ListProjectTemplates lists the project templates, sorted by name.
+func (s *ProjectsAPI) ListProjectTemplates(ctx context.Context, _ *projsvc.ListProjectTemplatesPayload) (*projsvc.ProjectTemplateList, error) {
+	templates, err := s.service.ListProjectTemplates(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	result := &projsvc.ProjectTemplateList{Templates: make([]*projsvc.ProjectTemplate, 0, len(templates))}
+	for _, template := range templates {
+		result.Templates = append(result.Templates, toServiceProjectTemplate(template))
+	}
+	return result, nil
+}
+
+// GetProjectTemplate gets a project template.
+func (s *ProjectsAPI) GetProjectTemplate(ctx context.Context, payload *projsvc.GetProjectTemplatePayload) (*projsvc.ProjectTemplate, error) {
+	template, err := s.service.GetProjectTemplate(ctx, payload.Name)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return toServiceProjectTemplate(template), nil
+}
+
+// UpdateProjectTemplate replaces the defaults of a project template.
+func (s *ProjectsAPI) UpdateProjectTemplate(ctx context.Context, payload *projsvc.UpdateProjectTemplatePayload) (*projsvc.ProjectTemplate, error) {
+	template := toModelProjectTemplate(payload.Name, payload.Description, payload.Stage, payload.Category, payload.FundingModel, payload.MissionStatement, payload.Auditors)
+	updated, err := s.service.UpdateProjectTemplate(ctx, template)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return toServiceProjectTemplate(updated), nil
+}
+
+// DeleteProjectTemplate removes a project template.
+func (s *ProjectsAPI) DeleteProjectTemplate(ctx context.Context, payload *projsvc.DeleteProjectTemplatePayload) error {
+	if err := s.service.DeleteProjectTemplate(ctx, payload.Name); err != nil {
+		return handleError(err)
+	}
+	return nil
+}
+
+// toModelProjectTemplate builds a project template from the fields of a
+// create or update request.
+func toModelProjectTemplate(name string, description, stage, category *string, fundingModel []string, missionStatement *string, auditors []*projsvc.UserInfo) *models.ProjectTemplate {
+	template := &models.ProjectTemplate{
+		Name:         name,
+		FundingModel: fundingModel,
+	}
+	if description != nil {
+		template.Description = *description
+	}
+	if stage != nil {
+		template.Stage = *stage
+	}
+	if category != nil {
+		template.Category = *category
+	}
+	if missionStatement != nil {
+		template.MissionStatement = *missionStatement
+	}
+	for _, auditor := range auditors {
+		if auditor == nil {
+			continue
+		}
+		var user models.UserInfo
+		if auditor.Name != nil {
+			user.Name = *auditor.Name
+		}
+		if auditor.Email != nil {
+			user.Email = *auditor.Email
+		}
+		if auditor.Username != nil {
+			user.Username = *auditor.Username
+		}
+		if auditor.Avatar != nil {
+			user.Avatar = *auditor.Avatar
+		}
+		template.Auditors = append(template.Auditors, user)
+	}
+	return template
+}
+
+// toServiceProjectTemplate converts a project template to its API representation.
+func toServiceProjectTemplate(t *models.ProjectTemplate) *projsvc.ProjectTemplate {
+	template := &projsvc.ProjectTemplate{
+		Name:         t.Name,
+		FundingModel: t.FundingModel,
+		CreatedAt:    t.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:    t.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	if t.Description != "" {
+		template.Description = misc.StringPtr(t.Description)
+	}
+	if t.Stage != "" {
+		template.Stage = misc.StringPtr(t.Stage)
+	}
+	if t.Category != "" {
+		template.Category = misc.StringPtr(t.Category)
+	}
+	if t.MissionStatement != "" {
+		template.MissionStatement = misc.StringPtr(t.MissionStatement)
+	}
+	for _, auditor := range t.Auditors {
+		template.Auditors = append(template.Auditors, &projsvc.UserInfo{
+			Name:     misc.StringPtr(auditor.Name),
+			Email:    misc.StringPtr(auditor.Email),
+			Username: misc.StringPtr(auditor.Username),
+			Avatar:   misc.StringPtr(auditor.Avatar),
+		})
+	}
+	if t.CreatedBy != "" {
+		template.CreatedBy = misc.StringPtr(t.CreatedBy)
+	}
+	return template
+}
@@ -5,17 +5,30 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"goa.design/goa/v3/security"
 
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/auth"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/service"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
 )
 
+// staffContext returns a context whose principal is LF staff, who may use the
+// admin endpoints.
+func staffContext() context.Context {
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "staff-user")
+	return context.WithValue(ctx, constants.RolesContextID, []string{domain.RoleStaff})
+}
+
 func TestReadyz(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -49,6 +62,25 @@ func TestReadyz(t *testing.T) {
 			},
 			expectedError: true,
 		},
+		{
+			name: "probes succeeding",
+			setupMocks: func(projectService *service.ProjectsService) {
+				readiness := &domain.MockReadinessChecker{}
+				readiness.On("Ready").Return(nil)
+				projectService.ReadinessChecker = readiness
+			},
+			expectedError: false,
+			expectedBody:  "OK\n",
+		},
+		{
+			name: "probes failing",
+			setupMocks: func(projectService *service.ProjectsService) {
+				readiness := &domain.MockReadinessChecker{}
+				readiness.On("Ready").Return(errors.New("KV bucket: timeout"))
+				projectService.ReadinessChecker = readiness
+			},
+			expectedError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -77,6 +109,116 @@ func TestLivez(t *testing.T) {
 	assert.Equal(t, "OK\n", string(result))
 }
 
+func TestListDeadLetters(t *testing.T) {
+	api, _, _ := setupAPI()
+	deadLetters := &domain.MockDeadLetterRepository{}
+	api.service.DeadLetterRepository = deadLetters
+	failedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	deadLetters.On("ListDeadLetters", mock.Anything).Return([]*models.DeadLetter{
+		{ID: "dl-1", Subject: "lfx.projects-api.project_settings.updated", Payload: []byte(`{"project_uid":"p-1"}`), Error: "boom", Attempts: 2, FailedAt: failedAt, LastFailedAt: failedAt.Add(time.Hour)},
+	}, nil)
+
+	result, err := api.ListDeadLetters(staffContext(), &projsvc.ListDeadLettersPayload{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*projsvc.DeadLetter{{
+		ID:           "dl-1",
+		Subject:      "lfx.projects-api.project_settings.updated",
+		Payload:      `{"project_uid":"p-1"}`,
+		Error:        "boom",
+		Attempts:     2,
+		FailedAt:     "2025-01-01T12:00:00Z",
+		LastFailedAt: "2025-01-01T13:00:00Z",
+	}}, result.DeadLetters)
+}
+
+func TestReplayDeadLetter_notFound(t *testing.T) {
+	api, _, _ := setupAPI()
+	deadLetters := &domain.MockDeadLetterRepository{}
+	api.service.DeadLetterRepository = deadLetters
+	deadLetters.On("GetDeadLetter", mock.Anything, "dl-1").Return(nil, domain.ErrDeadLetterNotFound)
+
+	_, err := api.ReplayDeadLetter(staffContext(), &projsvc.ReplayDeadLetterPayload{ID: "dl-1"})
+
+	notFound, ok := err.(*projsvc.NotFoundError)
+	if assert.True(t, ok) {
+		assert.Equal(t, "404", notFound.Code)
+	}
+}
+
+func TestReplayDeadLetter_forbidden(t *testing.T) {
+	api, _, _ := setupAPI()
+	api.service.DeadLetterRepository = &domain.MockDeadLetterRepository{}
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user-1")
+
+	_, err := api.ReplayDeadLetter(ctx, &projsvc.ReplayDeadLetterPayload{ID: "dl-1"})
+
+	forbidden, ok := err.(*projsvc.ForbiddenError)
+	if assert.True(t, ok) {
+		assert.Equal(t, "403", forbidden.Code)
+	}
+}
+
+func TestInspectProjectKv(t *testing.T) {
+	api, _, _ := setupAPI()
+	inspector := &domain.MockKVInspector{}
+	api.service.KVInspector = inspector
+	created := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	put := models.KVEntry{Revision: 2, Created: created, Operation: models.KVOperationPut, Value: []byte(`{"uid":"p-1"}`)}
+	corrupt := models.KVEntry{Revision: 3, Created: created, Operation: models.KVOperationPut, Value: []byte("not json")}
+	deleted := models.KVEntry{Revision: 4, Created: created, Operation: models.KVOperationDelete}
+	inspector.On("InspectProjectKV", mock.Anything, "p-1").Return(&models.ProjectKVInspection{
+		ProjectUID: "p-1",
+		Base:       models.KVKeyInspection{Bucket: "projects", Key: "p-1", Current: &corrupt, History: []models.KVEntry{put, corrupt}},
+		Settings:   models.KVKeyInspection{Bucket: "project-settings", Key: "p-1", Current: &deleted, History: []models.KVEntry{deleted}},
+	}, nil)
+
+	result, err := api.InspectProjectKv(staffContext(), &projsvc.InspectProjectKvPayload{UID: "p-1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "p-1", result.ProjectUID)
+	assert.Equal(t, json.RawMessage(`{"uid":"p-1"}`), result.Base.History[0].Value)
+	assert.Equal(t, "not json", result.Base.Current.Value)
+	assert.Equal(t, "2025-01-01T12:00:00Z", result.Base.Current.Created)
+	assert.Equal(t, &projsvc.KVEntry{Revision: 4, Created: "2025-01-01T12:00:00Z", Operation: "delete"}, result.Settings.Current)
+}
+
+func TestListWebhooks_omitsSecret(t *testing.T) {
+	api, _, _ := setupAPI()
+	webhooks := &domain.MockWebhookRepository{}
+	api.service.WebhookRepository = webhooks
+	api.service.WebhookSender = &domain.MockWebhookSender{}
+	createdAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	webhooks.On("ListWebhooks", mock.Anything).Return([]*models.Webhook{
+		{ID: "hook-1", URL: "https://example.org/hook", Secret: "secret", CreatedAt: createdAt},
+	}, nil)
+
+	result, err := api.ListWebhooks(staffContext(), &projsvc.ListWebhooksPayload{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*projsvc.Webhook{{
+		ID:        "hook-1",
+		URL:       "https://example.org/hook",
+		Events:    []string{},
+		CreatedAt: "2025-01-01T12:00:00Z",
+	}}, result.Webhooks)
+}
+
+func TestListWebhookDeliveries_notFound(t *testing.T) {
+	api, _, _ := setupAPI()
+	webhooks := &domain.MockWebhookRepository{}
+	api.service.WebhookRepository = webhooks
+	api.service.WebhookSender = &domain.MockWebhookSender{}
+	webhooks.On("GetWebhook", mock.Anything, "hook-1").Return(nil, domain.ErrWebhookNotFound)
+
+	_, err := api.ListWebhookDeliveries(staffContext(), &projsvc.ListWebhookDeliveriesPayload{ID: "hook-1"})
+
+	notFound, ok := err.(*projsvc.NotFoundError)
+	if assert.True(t, ok) {
+		assert.Equal(t, "404", notFound.Code)
+	}
+}
+
 func TestJWTAuth(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -92,7 +234,7 @@ func TestJWTAuth(t *testing.T) {
 			schema:        &security.JWTScheme{},
 			expectedError: false,
 			setupMocks: func(mockJwtAuth *auth.MockJWTAuth) {
-				mockJwtAuth.On("ParsePrincipal", mock.Anything, mock.Anything, mock.Anything).Return("user1", nil)
+				mockJwtAuth.On("ParseClaims", mock.Anything, mock.Anything, mock.Anything).Return(&domain.Claims{Principal: "user1"}, nil)
 			},
 		},
 		{
@@ -101,7 +243,36 @@ func TestJWTAuth(t *testing.T) {
 			schema:        &security.JWTScheme{},
 			expectedError: true,
 			setupMocks: func(mockJwtAuth *auth.MockJWTAuth) {
-				mockJwtAuth.On("ParsePrincipal", mock.Anything, mock.Anything, mock.Anything).Return("", assert.AnError)
+				mockJwtAuth.On("ParseClaims", mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError)
+			},
+		},
+		{
+			name:          "user token is not limited by scope",
+			bearerToken:   "user.token",
+			schema:        &security.JWTScheme{RequiredScopes: []string{"projects:write"}},
+			expectedError: false,
+			setupMocks: func(mockJwtAuth *auth.MockJWTAuth) {
+				mockJwtAuth.On("ParseClaims", mock.Anything, mock.Anything, mock.Anything).Return(&domain.Claims{Principal: "user1"}, nil)
+			},
+		},
+		{
+			name:          "machine-to-machine token with required scope",
+			bearerToken:   "m2m.token",
+			schema:        &security.JWTScheme{RequiredScopes: []string{"projects:write"}},
+			expectedError: false,
+			setupMocks: func(mockJwtAuth *auth.MockJWTAuth) {
+				mockJwtAuth.On("ParseClaims", mock.Anything, mock.Anything, mock.Anything).Return(
+					&domain.Claims{Principal: "logo-converter", Scopes: []string{"projects:read", "projects:write"}}, nil)
+			},
+		},
+		{
+			name:          "machine-to-machine token without required scope",
+			bearerToken:   "m2m.token",
+			schema:        &security.JWTScheme{RequiredScopes: []string{"projects:write"}},
+			expectedError: true,
+			setupMocks: func(mockJwtAuth *auth.MockJWTAuth) {
+				mockJwtAuth.On("ParseClaims", mock.Anything, mock.Anything, mock.Anything).Return(
+					&domain.Claims{Principal: "logo-converter", Scopes: []string{"projects:read"}}, nil)
 			},
 		},
 	}
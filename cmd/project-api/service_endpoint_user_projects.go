@@ -0,0 +1,87 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/service"
+)
+
+// GetUserProjects lists the projects in which a user is a writer, auditor or meeting coordinator.
+func (s *ProjectsAPI) GetUserProjects(ctx context.Context, payload *projsvc.GetUserProjectsPayload) (*projsvc.GetUserProjectsResult, error) {
+	projects, err := s.service.GetUserProjects(ctx, payload.Username)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	result := &projsvc.GetUserProjectsResult{Projects: make([]*projsvc.UserProject, len(projects))}
+	for i, p := range projects {
+		result.Projects[i] = &projsvc.UserProject{
+			ProjectUID: p.ProjectUID,
+			Slug:       p.Slug,
+			Name:       p.Name,
+			Roles:      memberRoleNames(p.Roles),
+		}
+	}
+	return result, nil
+}
+
+// ExportUserData exports everything the service stores about a user.
+func (s *ProjectsAPI) ExportUserData(ctx context.Context, payload *projsvc.ExportUserDataPayload) (*projsvc.UserDataExport, error) {
+	export, err := s.service.ExportUserData(ctx, payload.Username)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return service.ConvertToServiceUserDataExport(export), nil
+}
+
+// RemoveUserAccess removes a user from the member roles of every project.
+func (s *ProjectsAPI) RemoveUserAccess(ctx context.Context, payload *projsvc.RemoveUserAccessPayload) (*projsvc.UserAccessRemovalResult, error) {
+	xSync := false
+	if payload.XSync != nil {
+		xSync = *payload.XSync
+	}
+
+	removals, err := s.service.RemoveUserAccess(ctx, payload.Username, xSync)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	result := &projsvc.UserAccessRemovalResult{
+		Username: payload.Username,
+		Projects: make([]*projsvc.UserAccessRemoval, 0, len(removals)),
+	}
+	for _, r := range removals {
+		removal := &projsvc.UserAccessRemoval{
+			ProjectUID: r.ProjectUID,
+			Slug:       r.Slug,
+			Name:       r.Name,
+			Roles:      memberRoleNames(r.Roles),
+			Status:     r.Status,
+		}
+		if r.Error != "" {
+			removal.Error = &r.Error
+		}
+		switch r.Status {
+		case models.ProjectMembersChangeUpdated:
+			result.Removed++
+		case models.ProjectMembersChangeNotFound, models.ProjectMembersChangeFailed:
+			result.Failed++
+		}
+		result.Projects = append(result.Projects, removal)
+	}
+	return result, nil
+}
+
+// memberRoleNames converts member roles to their API names.
+func memberRoleNames(roles []models.ProjectMemberRole) []string {
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		names[i] = string(role)
+	}
+	return names
+}
@@ -0,0 +1,20 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/service"
+)
+
+// GetProjectVisibilityImpact previews what flipping a project's public flag would affect.
+func (s *ProjectsAPI) GetProjectVisibilityImpact(ctx context.Context, payload *projsvc.GetProjectVisibilityImpactPayload) (*projsvc.ProjectVisibilityImpact, error) {
+	impact, err := s.service.GetProjectVisibilityImpact(ctx, payload.UID)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return service.ConvertToServiceVisibilityImpact(impact), nil
+}
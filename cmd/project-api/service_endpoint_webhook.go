@@ -0,0 +1,131 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"time"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+// CreateWebhook registers a webhook and returns it with its signing secret.
+func (s *ProjectsAPI) CreateWebhook(ctx context.Context, payload *projsvc.CreateWebhookPayload) (*projsvc.Webhook, error) {
+	webhook := &models.Webhook{
+		URL:    payload.URL,
+		Events: payload.Events,
+	}
+	if payload.ProjectUID != nil {
+		webhook.ProjectUID = *payload.ProjectUID
+	}
+	if payload.Description != nil {
+		webhook.Description = *payload.Description
+	}
+
+	created, err := s.service.CreateWebhook(ctx, webhook)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	result := toServiceWebhook(created)
+	result.Secret = &created.Secret
+	return result, nil
+}
+
+// ListWebhooks lists the registered webhooks, oldest first.
+func (s *ProjectsAPI) ListWebhooks(ctx context.Context, _ *projsvc.ListWebhooksPayload) (*projsvc.WebhookList, error) {
+	webhooks, err := s.service.ListWebhooks(ctx)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	result := &projsvc.WebhookList{Webhooks: make([]*projsvc.Webhook, 0, len(webhooks))}
+	for _, webhook := range webhooks {
+		result.Webhooks = append(result.Webhooks, toServiceWebhook(webhook))
+	}
+	return result, nil
+}
+
+// GetWebhook gets a registered webhook.
+func (s *ProjectsAPI) GetWebhook(ctx context.Context, payload *projsvc.GetWebhookPayload) (*projsvc.Webhook, error) {
+	webhook, err := s.service.GetWebhook(ctx, payload.ID)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return toServiceWebhook(webhook), nil
+}
+
+// DeleteWebhook removes a webhook and its deliveries.
+func (s *ProjectsAPI) DeleteWebhook(ctx context.Context, payload *projsvc.DeleteWebhookPayload) error {
+	if err := s.service.DeleteWebhook(ctx, payload.ID); err != nil {
+		return handleError(err)
+	}
+	return nil
+}
+
+// ListWebhookDeliveries lists the events sent to a webhook, newest first.
+func (s *ProjectsAPI) ListWebhookDeliveries(ctx context.Context, payload *projsvc.ListWebhookDeliveriesPayload) (*projsvc.WebhookDeliveryList, error) {
+	deliveries, err := s.service.ListWebhookDeliveries(ctx, payload.ID)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	result := &projsvc.WebhookDeliveryList{Deliveries: make([]*projsvc.WebhookDelivery, 0, len(deliveries))}
+	for _, delivery := range deliveries {
+		result.Deliveries = append(result.Deliveries, toServiceWebhookDelivery(delivery))
+	}
+	return result, nil
+}
+
+// toServiceWebhook converts a webhook to its API representation. The secret is
+// left out; only CreateWebhook returns it.
+func toServiceWebhook(w *models.Webhook) *projsvc.Webhook {
+	webhook := &projsvc.Webhook{
+		ID:        w.ID,
+		URL:       w.URL,
+		Events:    w.Events,
+		CreatedAt: w.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if webhook.Events == nil {
+		webhook.Events = []string{}
+	}
+	if w.ProjectUID != "" {
+		webhook.ProjectUID = misc.StringPtr(w.ProjectUID)
+	}
+	if w.Description != "" {
+		webhook.Description = misc.StringPtr(w.Description)
+	}
+	if w.CreatedBy != "" {
+		webhook.CreatedBy = misc.StringPtr(w.CreatedBy)
+	}
+	return webhook
+}
+
+// toServiceWebhookDelivery converts a webhook delivery to its API representation.
+func toServiceWebhookDelivery(d *models.WebhookDelivery) *projsvc.WebhookDelivery {
+	delivery := &projsvc.WebhookDelivery{
+		ID:         d.ID,
+		Event:      d.EventType,
+		ProjectUID: d.ProjectUID,
+		Status:     string(d.Status),
+		Attempts:   d.Attempts,
+		CreatedAt:  d.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if d.ResponseStatus != 0 {
+		status := d.ResponseStatus
+		delivery.ResponseStatus = &status
+	}
+	if d.LastError != "" {
+		delivery.LastError = misc.StringPtr(d.LastError)
+	}
+	if d.LastAttemptAt != nil {
+		delivery.LastAttemptAt = misc.StringPtr(d.LastAttemptAt.UTC().Format(time.RFC3339))
+	}
+	if d.Status == models.WebhookDeliveryPending {
+		delivery.NextAttemptAt = misc.StringPtr(d.NextAttemptAt.UTC().Format(time.RFC3339))
+	}
+	if d.DeliveredAt != nil {
+		delivery.DeliveredAt = misc.StringPtr(d.DeliveredAt.UTC().Format(time.RFC3339))
+	}
+	return delivery
+}
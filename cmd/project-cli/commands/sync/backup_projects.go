@@ -0,0 +1,198 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/cmd/project-cli/commands"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	natsinfra "github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/nats"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/s3"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/service"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/env"
+)
+
+// backupFlags are the S3 location flags shared by the backup subcommands.
+type backupFlags struct {
+	bucket *string
+	region *string
+	prefix *string
+}
+
+func addBackupFlags(fs *flag.FlagSet) backupFlags {
+	return backupFlags{
+		bucket: fs.String("s3-bucket", env.Get("BACKUP_S3_BUCKET", ""), "S3 bucket holding the backups"),
+		region: fs.String("s3-region", env.Get("BACKUP_S3_REGION", "us-west-2"), "region of the backup bucket"),
+		prefix: fs.String("s3-prefix", env.Get("BACKUP_S3_PREFIX", "project-service/"), "key prefix of the backups in the bucket"),
+	}
+}
+
+// newBackupService connects to NATS and S3 and returns a service that backs up
+// and restores the project stores. The returned function closes the NATS
+// connection.
+func newBackupService(ctx context.Context, rc commands.RunContext, flags backupFlags) (*service.ProjectsService, func(), error) {
+	if *flags.bucket == "" {
+		return nil, nil, errors.New("set the backup bucket with --s3-bucket")
+	}
+	store, err := s3.NewBackupStore(ctx, *flags.bucket, *flags.region, *flags.prefix)
+	if err != nil {
+		return nil, nil, fmt.Errorf("set up backup storage: %w", err)
+	}
+
+	natsConn, js, err := natsinfra.Connect(ctx, rc.NATSConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	projectsKV, err := js.KeyValue(ctx, constants.KVStoreNameProjects)
+	if err != nil {
+		natsConn.Close()
+		return nil, nil, fmt.Errorf("open bucket %s: %w", constants.KVStoreNameProjects, err)
+	}
+	// Settings are saved decompressed and restored compressed as configured.
+	settingsKV, err := openSettingsBucket(ctx, js, rc.NATSConfig)
+	if err != nil {
+		natsConn.Close()
+		return nil, nil, err
+	}
+
+	svc := &service.ProjectsService{
+		BackupStorage:    store,
+		BackupRepository: natsinfra.NewNatsRepository(projectsKV, settingsKV),
+	}
+	return svc, natsConn.Close, nil
+}
+
+type backupProjectsSubcommand struct{}
+
+func (s *backupProjectsSubcommand) Name() string { return "backup-projects" }
+
+func (s *backupProjectsSubcommand) Help() string {
+	return "save the projects and project-settings buckets to a backup in S3"
+}
+
+func (s *backupProjectsSubcommand) Run(ctx context.Context, rc commands.RunContext) error {
+	fs := flag.NewFlagSet("backup-projects", flag.ContinueOnError)
+	fs.Usage = func() {
+		_, _ = fmt.Fprintf(fs.Output(), "usage: project-cli sync backup-projects [flags]\n\nflags:\n")
+		fs.PrintDefaults()
+	}
+	flags := addBackupFlags(fs)
+	list := fs.Bool("list", env.GetBool("LIST_BACKUPS", false), "list the stored backups instead of creating one")
+	if err := fs.Parse(rc.Args); err != nil {
+		if err == flag.ErrHelp {
+			return nil
+		}
+		return err
+	}
+
+	svc, closeConn, err := newBackupService(ctx, rc, flags)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+	// The CLI connects with the service's NATS credentials and acts as the service.
+	ctx = service.WithServicePrincipal(ctx)
+
+	slog.InfoContext(ctx, "backup-projects configured",
+		"s3_bucket", *flags.bucket,
+		"s3_prefix", *flags.prefix,
+		"nats_url", redactURL(rc.NATSConfig.URL),
+	)
+
+	if *list {
+		backups, err := svc.ListBackups(ctx)
+		if err != nil {
+			return err
+		}
+		for _, backup := range backups {
+			slog.InfoContext(ctx, "backup", "name", backup.Name, "size", backup.Size, "created_at", backup.CreatedAt)
+		}
+		return nil
+	}
+
+	_, err = svc.BackupProjects(ctx)
+	return err
+}
+
+type restoreProjectsSubcommand struct{}
+
+func (s *restoreProjectsSubcommand) Name() string { return "restore-projects" }
+
+func (s *restoreProjectsSubcommand) Help() string {
+	return "write the projects of a backup in S3 back to the project buckets"
+}
+
+func (s *restoreProjectsSubcommand) Run(ctx context.Context, rc commands.RunContext) error {
+	fs := flag.NewFlagSet("restore-projects", flag.ContinueOnError)
+	fs.Usage = func() {
+		_, _ = fmt.Fprintf(fs.Output(), "usage: project-cli sync restore-projects --backup <name> [flags]\n\nflags:\n")
+		fs.PrintDefaults()
+	}
+	flags := addBackupFlags(fs)
+	dryRun := fs.Bool("dry-run", env.GetBool("DRY_RUN", true), "log what would be restored without writing")
+	backup := fs.String("backup", env.Get("BACKUP_NAME", ""), "name of the backup to restore, e.g. 20250101T000000Z.ndjson")
+	projectUIDs := fs.String("project-uids", env.Get("PROJECT_UIDS", ""), "comma-separated UIDs of the projects to restore; every project when empty")
+	if err := fs.Parse(rc.Args); err != nil {
+		if err == flag.ErrHelp {
+			return nil
+		}
+		return err
+	}
+
+	name, err := resolveBackupName(*backup)
+	if err != nil {
+		return err
+	}
+	uids := splitList(*projectUIDs)
+
+	rc.DryRun = *dryRun
+
+	svc, closeConn, err := newBackupService(ctx, rc, flags)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+	// The CLI connects with the service's NATS credentials and acts as the service.
+	ctx = service.WithServicePrincipal(ctx)
+
+	ctx = log.AppendCtx(ctx, slog.Bool("dry_run", *dryRun))
+	slog.InfoContext(ctx, "restore-projects configured",
+		"backup", name,
+		"projects", len(uids),
+		"s3_bucket", *flags.bucket,
+		"nats_url", redactURL(rc.NATSConfig.URL),
+	)
+
+	report, err := svc.RestoreProjects(ctx, name, uids, *dryRun)
+	if err != nil {
+		return err
+	}
+	if *dryRun {
+		slog.InfoContext(ctx, "dry run: projects that would be restored", "project_uids", report.ProjectUIDs)
+		return nil
+	}
+	if report.Failed > 0 {
+		return fmt.Errorf("%d of %d entries failed to restore", report.Failed, report.Failed+report.Entries)
+	}
+	return nil
+}
+
+// resolveBackupName validates the name of the backup to restore.
+func resolveBackupName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	switch {
+	case name == "":
+		return "", errors.New("select the backup to restore with --backup")
+	case strings.Contains(name, "/"):
+		return "", fmt.Errorf("--backup %q must be a backup name, not a path", name)
+	}
+	return name, nil
+}
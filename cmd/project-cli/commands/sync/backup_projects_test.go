@@ -0,0 +1,72 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	natsinfra "github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/nats"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+func TestResolveBackupName(t *testing.T) {
+	name, err := resolveBackupName(" 20250101T000000Z.ndjson ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if name != "20250101T000000Z.ndjson" {
+		t.Fatalf("got %q", name)
+	}
+	if _, err := resolveBackupName(""); err == nil {
+		t.Fatal("expected error without a backup name")
+	}
+	if _, err := resolveBackupName("project-service/20250101T000000Z.ndjson"); err == nil {
+		t.Fatal("expected error for a path")
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	if got := splitList(" project-1, ,project-2 "); !reflect.DeepEqual(got, []string{"project-1", "project-2"}) {
+		t.Fatalf("got %v", got)
+	}
+	if got := splitList(""); got != nil {
+		t.Fatalf("got %v", got)
+	}
+}
+
+func TestOpenSettingsBucket_backupSavesDecompressedSettings(t *testing.T) {
+	ctx := context.Background()
+	js := startJetStream(t)
+	settingsKV, err := openSettingsBucket(ctx, js, natsinfra.Config{KVCompression: natsinfra.CompressionSnappy})
+	if err != nil {
+		t.Fatalf("open settings bucket: %v", err)
+	}
+	value := `{"uid":"project-1","mission_statement":"` + strings.Repeat("open source ", 50) + `"}`
+	if _, err := settingsKV.Put(ctx, "project-1", []byte(value)); err != nil {
+		t.Fatalf("put settings: %v", err)
+	}
+	raw, err := js.KeyValue(ctx, constants.KVStoreNameProjectSettings)
+	if err != nil {
+		t.Fatalf("open raw bucket: %v", err)
+	}
+	if entry, err := raw.Get(ctx, "project-1"); err != nil || json.Valid(entry.Value()) {
+		t.Fatalf("settings were not written compressed: %v", err)
+	}
+	projectsKV, err := js.KeyValue(ctx, constants.KVStoreNameProjects)
+	if err != nil {
+		t.Fatalf("open projects bucket: %v", err)
+	}
+
+	entries, err := natsinfra.NewNatsRepository(projectsKV, settingsKV).ExportBackupEntries(ctx)
+	if err != nil {
+		t.Fatalf("export backup entries: %v", err)
+	}
+	if len(entries) != 1 || string(entries[0].Value) != value {
+		t.Fatalf("got %+v, want the decompressed settings", entries)
+	}
+}
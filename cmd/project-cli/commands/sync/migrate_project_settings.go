@@ -0,0 +1,240 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sync
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/cmd/project-cli/commands"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	natsinfra "github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/nats"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/env"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type migrateProjectSettingsSubcommand struct{}
+
+func (s *migrateProjectSettingsSubcommand) Name() string { return "migrate-project-settings" }
+
+func (s *migrateProjectSettingsSubcommand) Help() string {
+	return "fill in the missing names, emails and avatars of project settings users"
+}
+
+func (s *migrateProjectSettingsSubcommand) Run(ctx context.Context, rc commands.RunContext) error {
+	fs := flag.NewFlagSet("migrate-project-settings", flag.ContinueOnError)
+	fs.Usage = func() {
+		_, _ = fmt.Fprintf(fs.Output(), "usage: project-cli sync migrate-project-settings (--all | --project-uids <uid,...>) [flags]\n\nflags:\n")
+		fs.PrintDefaults()
+	}
+	dryRun := fs.Bool("dry-run", env.GetBool("DRY_RUN", true), "log the projects that would change without writing")
+	all := fs.Bool("all", env.GetBool("ALL_PROJECTS", false), "migrate the settings of every project")
+	projectUIDs := fs.String("project-uids", env.Get("PROJECT_UIDS", ""), "comma-separated UIDs of the projects to migrate")
+	mappingFile := fs.String("mapping-file", env.Get("MAPPING_FILE", ""), "CSV or JSON file mapping usernames to user details")
+	lookupUsers := fs.Bool("lookup-users", env.GetBool("LOOKUP_USERS", true), "read the names and avatars of unmapped users from the auth service")
+	if err := fs.Parse(rc.Args); err != nil {
+		if err == flag.ErrHelp {
+			return nil
+		}
+		return err
+	}
+
+	uids, err := resolveProjectUIDs(*all, *projectUIDs)
+	if err != nil {
+		return err
+	}
+	mapping := map[string]models.UserInfo{}
+	if *mappingFile != "" {
+		if mapping, err = loadUserMappingFile(*mappingFile); err != nil {
+			return err
+		}
+	}
+
+	rc.DryRun = *dryRun
+
+	natsConn, js, err := natsinfra.Connect(ctx, rc.NATSConfig)
+	if err != nil {
+		return err
+	}
+	defer natsConn.Close()
+
+	projectsKV, err := js.KeyValue(ctx, constants.KVStoreNameProjects)
+	if err != nil {
+		return fmt.Errorf("open bucket %s: %w", constants.KVStoreNameProjects, err)
+	}
+	settingsKV, err := openSettingsBucket(ctx, js, rc.NATSConfig)
+	if err != nil {
+		return err
+	}
+	if *all {
+		if uids, err = listProjectSettingsKeys(ctx, settingsKV); err != nil {
+			return err
+		}
+	}
+
+	ctx = log.AppendCtx(ctx, slog.Bool("dry_run", *dryRun))
+	slog.InfoContext(ctx, "migrate-project-settings configured",
+		"projects", len(uids),
+		"mapped_users", len(mapping),
+		"lookup_users", *lookupUsers,
+		"nats_url", redactURL(rc.NATSConfig.URL),
+	)
+
+	runner := &SettingsMigrationRunner{
+		Settings: natsinfra.NewNatsRepository(projectsKV, settingsKV),
+		Mapping:  mapping,
+	}
+	if *lookupUsers {
+		runner.Users = &natsinfra.UserReaderNATS{NatsConn: natsinfra.NewTenantConn(natsConn, rc.NATSConfig.Tenant)}
+	}
+	summary := runner.Run(ctx, uids, *dryRun)
+	if summary.Failed > 0 {
+		return fmt.Errorf("%d of %d projects failed to migrate", summary.Failed, len(uids))
+	}
+	return nil
+}
+
+// resolveProjectUIDs validates the project selection flags and returns the
+// requested UIDs. With --all, the UIDs are listed from the bucket later.
+func resolveProjectUIDs(all bool, projectUIDs string) ([]string, error) {
+	uids := splitList(projectUIDs)
+	switch {
+	case all && len(uids) > 0:
+		return nil, errors.New("use either --all or --project-uids, not both")
+	case !all && len(uids) == 0:
+		return nil, errors.New("select the projects to migrate with --all or --project-uids")
+	}
+	return uids, nil
+}
+
+// splitList returns the non-empty items of a comma-separated list.
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// openSettingsBucket opens the project-settings bucket the way the service
+// does: compressed values are decompressed on read, and values are written
+// compressed as natsConfig sets.
+func openSettingsBucket(ctx context.Context, js jetstream.JetStream, natsConfig natsinfra.Config) (natsinfra.INatsKeyValue, error) {
+	kv, err := js.KeyValue(ctx, constants.KVStoreNameProjectSettings)
+	if err != nil {
+		return nil, fmt.Errorf("open bucket %s: %w", constants.KVStoreNameProjectSettings, err)
+	}
+	settingsKV, err := natsConfig.CompressSettings(kv)
+	if err != nil {
+		return nil, fmt.Errorf("open bucket %s: %w", constants.KVStoreNameProjectSettings, err)
+	}
+	return settingsKV, nil
+}
+
+// listProjectSettingsKeys returns the keys of every project's settings.
+func listProjectSettingsKeys(ctx context.Context, kv natsinfra.INatsKeyValue) ([]string, error) {
+	lister, err := kv.ListKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list keys of bucket %s: %w", constants.KVStoreNameProjectSettings, err)
+	}
+	var uids []string
+	for key := range lister.Keys() {
+		if strings.HasPrefix(key, "lookup/") {
+			continue
+		}
+		uids = append(uids, key)
+	}
+	return uids, nil
+}
+
+// loadUserMappingFile reads a username to user details mapping from a .csv
+// or .json file.
+func loadUserMappingFile(path string) (map[string]models.UserInfo, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open mapping file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var mapping map[string]models.UserInfo
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".csv":
+		mapping, err = parseUserMappingCSV(f)
+	case ".json":
+		mapping, err = parseUserMappingJSON(f)
+	default:
+		return nil, fmt.Errorf("mapping file %s must be a .csv or .json file", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read mapping file %s: %w", path, err)
+	}
+	return mapping, nil
+}
+
+// parseUserMappingCSV reads a CSV file with a header row naming a username
+// column and any of the name, email and avatar columns.
+func parseUserMappingCSV(r io.Reader) (map[string]models.UserInfo, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("missing header row")
+	}
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["username"]; !ok {
+		return nil, errors.New("missing username column")
+	}
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	mapping := make(map[string]models.UserInfo, len(records)-1)
+	for line, record := range records[1:] {
+		username := field(record, "username")
+		if username == "" {
+			return nil, fmt.Errorf("line %d: missing username", line+2)
+		}
+		mapping[username] = models.UserInfo{
+			Username: username,
+			Name:     field(record, "name"),
+			Email:    field(record, "email"),
+			Avatar:   field(record, "avatar"),
+		}
+	}
+	return mapping, nil
+}
+
+// parseUserMappingJSON reads a JSON object whose keys are usernames and whose
+// values are user details in the stored settings format.
+func parseUserMappingJSON(r io.Reader) (map[string]models.UserInfo, error) {
+	mapping := map[string]models.UserInfo{}
+	if err := json.NewDecoder(r).Decode(&mapping); err != nil {
+		return nil, err
+	}
+	for username, user := range mapping {
+		user.Username = username
+		mapping[username] = user
+	}
+	return mapping, nil
+}
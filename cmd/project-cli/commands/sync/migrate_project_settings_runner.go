@@ -0,0 +1,183 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+var (
+	// errSettingsUnchanged aborts the update of settings the migration does not change.
+	errSettingsUnchanged = errors.New("project settings unchanged")
+	// errSettingsDryRun aborts the update of settings that would change in a dry run.
+	errSettingsDryRun = errors.New("dry run")
+)
+
+// projectSettingsUpdater is the part of the project repository the settings
+// migration writes through.
+type projectSettingsUpdater interface {
+	UpdateProjectSettingsWithRetry(ctx context.Context, projectUID string, modify func(*models.ProjectSettings) error) (*models.ProjectSettings, error)
+}
+
+// SettingsMigrationRunner fills in the missing name, email and avatar of the
+// users stored in project settings, from a mapping file first and from the
+// auth service for what the mapping does not cover.
+type SettingsMigrationRunner struct {
+	Settings projectSettingsUpdater
+	// Mapping holds user details by username.
+	Mapping map[string]models.UserInfo
+	// Users reads the names and avatars of unmapped users when set.
+	Users domain.UserReader
+
+	// profiles caches auth service lookups across projects; a nil entry
+	// records a failed lookup.
+	profiles map[string]*domain.UserMetadata
+}
+
+type settingsMigrationSummary struct {
+	Total      int
+	Updated    int
+	Unchanged  int
+	NotFound   int
+	Failed     int
+	Unresolved map[string]bool
+}
+
+// Run migrates the settings of the given projects one at a time and logs a
+// summary. In a dry run, the projects that would change are only logged.
+func (r *SettingsMigrationRunner) Run(ctx context.Context, projectUIDs []string, dryRun bool) settingsMigrationSummary {
+	summary := settingsMigrationSummary{Total: len(projectUIDs), Unresolved: map[string]bool{}}
+	if r.profiles == nil {
+		r.profiles = map[string]*domain.UserMetadata{}
+	}
+
+	for i, uid := range projectUIDs {
+		if err := ctx.Err(); err != nil {
+			slog.ErrorContext(ctx, "migration interrupted", constants.ErrKey, err, "remaining", len(projectUIDs)-i)
+			summary.Failed += len(projectUIDs) - i
+			break
+		}
+		var unresolved []string
+		_, err := r.Settings.UpdateProjectSettingsWithRetry(ctx, uid, func(settings *models.ProjectSettings) error {
+			var changed bool
+			changed, unresolved = r.completeUsers(ctx, settings)
+			switch {
+			case !changed:
+				return errSettingsUnchanged
+			case dryRun:
+				return errSettingsDryRun
+			}
+			return nil
+		})
+		for _, username := range unresolved {
+			summary.Unresolved[username] = true
+		}
+
+		switch {
+		case err == nil || errors.Is(err, errSettingsDryRun):
+			summary.Updated++
+			msg := "migrated project settings users"
+			if dryRun {
+				msg = "dry run: project settings users would be migrated"
+			}
+			slog.InfoContext(ctx, msg, "project_uid", uid, "unresolved_users", unresolved)
+		case errors.Is(err, errSettingsUnchanged):
+			summary.Unchanged++
+		case errors.Is(err, domain.ErrProjectNotFound):
+			summary.NotFound++
+			slog.WarnContext(ctx, "project settings not found", "project_uid", uid)
+		default:
+			summary.Failed++
+			slog.ErrorContext(ctx, "error migrating project settings", constants.ErrKey, err, "project_uid", uid)
+		}
+	}
+
+	unresolved := make([]string, 0, len(summary.Unresolved))
+	for username := range summary.Unresolved {
+		unresolved = append(unresolved, username)
+	}
+	slog.InfoContext(ctx, "migrate-project-settings summary",
+		"total", summary.Total,
+		"updated", summary.Updated,
+		"unchanged", summary.Unchanged,
+		"not_found", summary.NotFound,
+		"failed", summary.Failed,
+		"unresolved_users", unresolved,
+	)
+	return summary
+}
+
+// completeUsers fills in the missing details of every user in settings that
+// has a username, reporting whether anything changed and the usernames that
+// still lack a name.
+func (r *SettingsMigrationRunner) completeUsers(ctx context.Context, settings *models.ProjectSettings) (bool, []string) {
+	changed := false
+	var unresolved []string
+	complete := func(u *models.UserInfo) {
+		if u == nil || u.Username == "" || (u.Name != "" && u.Email != "" && u.Avatar != "") {
+			return
+		}
+		if mapped, ok := r.Mapping[u.Username]; ok {
+			changed = fillUserInfo(u, mapped.Name, mapped.Email, mapped.Avatar) || changed
+		}
+		if u.Name == "" || u.Avatar == "" {
+			if meta := r.lookupUser(ctx, u.Username); meta != nil {
+				changed = fillUserInfo(u, meta.Name, "", meta.Picture) || changed
+			}
+		}
+		if u.Name == "" {
+			unresolved = append(unresolved, u.Username)
+		}
+	}
+
+	for _, users := range [][]models.UserInfo{settings.Writers, settings.Auditors, settings.MeetingCoordinators} {
+		for i := range users {
+			complete(&users[i])
+		}
+	}
+	complete(settings.ExecutiveDirector)
+	complete(settings.ProgramManager)
+	complete(settings.OpportunityOwner)
+	return changed, unresolved
+}
+
+// lookupUser returns the auth service profile of a user, or nil when there is
+// no auth service or the lookup fails.
+func (r *SettingsMigrationRunner) lookupUser(ctx context.Context, username string) *domain.UserMetadata {
+	if r.Users == nil {
+		return nil
+	}
+	if meta, ok := r.profiles[username]; ok {
+		return meta
+	}
+	meta, err := r.Users.UserMetadataByPrincipal(ctx, username)
+	if err != nil {
+		slog.WarnContext(ctx, "user metadata lookup failed", constants.ErrKey, err)
+		meta = nil
+	}
+	r.profiles[username] = meta
+	return meta
+}
+
+// fillUserInfo sets the empty fields of u from the given values and reports
+// whether any was set.
+func fillUserInfo(u *models.UserInfo, name, email, avatar string) bool {
+	changed := false
+	for _, f := range []struct {
+		field *string
+		value string
+	}{{&u.Name, name}, {&u.Email, email}, {&u.Avatar, avatar}} {
+		if *f.field == "" && f.value != "" {
+			*f.field = f.value
+			changed = true
+		}
+	}
+	return changed
+}
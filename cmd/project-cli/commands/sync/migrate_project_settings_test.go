@@ -0,0 +1,156 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sync
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+func TestResolveProjectUIDs(t *testing.T) {
+	uids, err := resolveProjectUIDs(false, " project-1, ,project-2 ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(uids, []string{"project-1", "project-2"}) {
+		t.Fatalf("got %v", uids)
+	}
+	if _, err := resolveProjectUIDs(true, "project-1"); err == nil {
+		t.Fatal("expected error for --all with --project-uids")
+	}
+	if _, err := resolveProjectUIDs(false, ""); err == nil {
+		t.Fatal("expected error without a project selection")
+	}
+}
+
+func TestParseUserMappingCSV(t *testing.T) {
+	mapping, err := parseUserMappingCSV(strings.NewReader("email,Username,name\nalice@example.com,alice,Alice\n,bob,Bob\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]models.UserInfo{
+		"alice": {Username: "alice", Name: "Alice", Email: "alice@example.com"},
+		"bob":   {Username: "bob", Name: "Bob"},
+	}
+	if !reflect.DeepEqual(mapping, want) {
+		t.Fatalf("got %+v", mapping)
+	}
+
+	if _, err := parseUserMappingCSV(strings.NewReader("name,email\nAlice,alice@example.com\n")); err == nil {
+		t.Fatal("expected error without a username column")
+	}
+	if _, err := parseUserMappingCSV(strings.NewReader("username,name\n,Alice\n")); err == nil {
+		t.Fatal("expected error for a row without a username")
+	}
+}
+
+func TestParseUserMappingJSON(t *testing.T) {
+	mapping, err := parseUserMappingJSON(strings.NewReader(`{"alice":{"name":"Alice","email":"alice@example.com"}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]models.UserInfo{"alice": {Username: "alice", Name: "Alice", Email: "alice@example.com"}}
+	if !reflect.DeepEqual(mapping, want) {
+		t.Fatalf("got %+v", mapping)
+	}
+}
+
+// fakeSettingsUpdater applies updates to in-memory settings.
+type fakeSettingsUpdater struct {
+	settings map[string]*models.ProjectSettings
+}
+
+func (f *fakeSettingsUpdater) UpdateProjectSettingsWithRetry(_ context.Context, projectUID string, modify func(*models.ProjectSettings) error) (*models.ProjectSettings, error) {
+	stored, ok := f.settings[projectUID]
+	if !ok {
+		return nil, domain.ErrProjectNotFound
+	}
+	settings := *stored
+	settings.Writers = append([]models.UserInfo(nil), stored.Writers...)
+	if err := modify(&settings); err != nil {
+		return nil, err
+	}
+	f.settings[projectUID] = &settings
+	return &settings, nil
+}
+
+// fakeUserReader returns the profiles it holds and ErrUserNotFound otherwise.
+type fakeUserReader struct {
+	profiles map[string]*domain.UserMetadata
+	lookups  int
+}
+
+func (f *fakeUserReader) UserMetadataByPrincipal(_ context.Context, principal string) (*domain.UserMetadata, error) {
+	f.lookups++
+	if meta, ok := f.profiles[principal]; ok {
+		return meta, nil
+	}
+	return nil, domain.ErrUserNotFound
+}
+
+func (f *fakeUserReader) UsernameByEmail(context.Context, string) (string, error) {
+	return "", domain.ErrUserNotFound
+}
+
+func TestSettingsMigrationRunner(t *testing.T) {
+	newStore := func() *fakeSettingsUpdater {
+		return &fakeSettingsUpdater{settings: map[string]*models.ProjectSettings{
+			"project-1": {UID: "project-1", Writers: []models.UserInfo{{Username: "alice"}, {Username: "bob"}, {Username: "carol"}}},
+			"project-2": {UID: "project-2", Writers: []models.UserInfo{{Username: "dave", Name: "Dave", Email: "dave@example.com", Avatar: "dave.png"}}},
+		}}
+	}
+	newRunner := func(store *fakeSettingsUpdater, users *fakeUserReader) *SettingsMigrationRunner {
+		return &SettingsMigrationRunner{
+			Settings: store,
+			Mapping:  map[string]models.UserInfo{"alice": {Username: "alice", Name: "Alice", Email: "alice@example.com"}},
+			Users:    users,
+		}
+	}
+	users := &fakeUserReader{profiles: map[string]*domain.UserMetadata{
+		"alice": {Name: "Alice Profile", Picture: "alice.png"},
+		"bob":   {Name: "Bob", Picture: "bob.png"},
+	}}
+
+	t.Run("dry run writes nothing", func(t *testing.T) {
+		store := newStore()
+		summary := newRunner(store, users).Run(context.Background(), []string{"project-1", "project-2", "missing"}, true)
+
+		if summary.Updated != 1 || summary.Unchanged != 1 || summary.NotFound != 1 || summary.Failed != 0 {
+			t.Fatalf("got %+v", summary)
+		}
+		if !reflect.DeepEqual(summary.Unresolved, map[string]bool{"carol": true}) {
+			t.Fatalf("got unresolved %v", summary.Unresolved)
+		}
+		if store.settings["project-1"].Writers[0].Name != "" {
+			t.Fatalf("dry run changed settings: %+v", store.settings["project-1"].Writers)
+		}
+	})
+
+	t.Run("mapping takes precedence over the auth service", func(t *testing.T) {
+		store := newStore()
+		users.lookups = 0
+		summary := newRunner(store, users).Run(context.Background(), []string{"project-1", "project-1"}, false)
+
+		if summary.Updated != 1 || summary.Unchanged != 1 || summary.Failed != 0 {
+			t.Fatalf("got %+v", summary)
+		}
+		want := []models.UserInfo{
+			{Username: "alice", Name: "Alice", Email: "alice@example.com", Avatar: "alice.png"},
+			{Username: "bob", Name: "Bob", Avatar: "bob.png"},
+			{Username: "carol"},
+		}
+		if got := store.settings["project-1"].Writers; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %+v", got)
+		}
+		// The second pass finds nothing new, and lookups are cached.
+		if users.lookups != 3 {
+			t.Fatalf("got %d lookups", users.lookups)
+		}
+	})
+}
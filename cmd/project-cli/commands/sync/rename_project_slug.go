@@ -69,7 +69,7 @@ func (s *renameProjectSlugSubcommand) Run(ctx context.Context, rc commands.RunCo
 		"nats_url", redactURL(rc.NATSConfig.URL),
 	)
 
-	runner := NewRenameSlugRunner(osClient, js)
+	runner := NewRenameSlugRunner(osClient, js, rc.NATSConfig)
 	return runner.Run(ctx, RenameSlugOptions{
 		OldSlug:     oldSlug,
 		NewSlug:     newSlug,
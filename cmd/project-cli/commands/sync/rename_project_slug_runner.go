@@ -17,6 +17,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	natsinfra "github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/nats"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
 	"github.com/nats-io/nats.go/jetstream"
 	opensearchgo "github.com/opensearch-project/opensearch-go/v2"
 	"golang.org/x/sync/errgroup"
@@ -70,13 +72,16 @@ type renameSlugSummary struct {
 type RenameSlugRunner struct {
 	openSearch *opensearchgo.Client
 	jetStream  jetstream.JetStream
+	natsConfig natsinfra.Config
 }
 
-// NewRenameSlugRunner creates a RenameSlugRunner backed by the provided clients.
-func NewRenameSlugRunner(openSearch *opensearchgo.Client, jetStream jetstream.JetStream) *RenameSlugRunner {
+// NewRenameSlugRunner creates a RenameSlugRunner backed by the provided
+// clients. The project-settings bucket is compressed as natsConfig sets.
+func NewRenameSlugRunner(openSearch *opensearchgo.Client, jetStream jetstream.JetStream, natsConfig natsinfra.Config) *RenameSlugRunner {
 	return &RenameSlugRunner{
 		openSearch: openSearch,
 		jetStream:  jetStream,
+		natsConfig: natsConfig,
 	}
 }
 
@@ -381,9 +386,9 @@ type bucketStats struct {
 }
 
 func (r *RenameSlugRunner) migrateBucket(ctx context.Context, bucket, oldSlug, newSlug string, dryRun bool, concurrency int) (*bucketStats, error) {
-	kvStore, err := r.jetStream.KeyValue(ctx, bucket)
+	kvStore, err := r.openBucket(ctx, bucket)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open KV bucket %q: %w", bucket, err)
+		return nil, err
 	}
 
 	fields := bucketFieldsFor(bucket)
@@ -398,7 +403,7 @@ func (r *RenameSlugRunner) migrateBucket(ctx context.Context, bucket, oldSlug, n
 
 	var recordKeys []string
 	for key := range keys.Keys() {
-		if strings.HasPrefix(key, "lookup/") || strings.HasPrefix(key, "slug/") {
+		if strings.HasPrefix(key, "lookup/") || strings.HasPrefix(key, "slug/") || strings.HasPrefix(key, "legacy/") {
 			continue
 		}
 		recordKeys = append(recordKeys, key)
@@ -457,7 +462,25 @@ func (r *RenameSlugRunner) migrateBucket(ctx context.Context, bucket, oldSlug, n
 	return stats, nil
 }
 
-func processKVRecord(ctx context.Context, kvStore jetstream.KeyValue, key string, fields []string, oldSlug, newSlug string, dryRun bool) error {
+// openBucket opens a KV bucket, decompressing the values of the
+// project-settings bucket and compressing the ones written back as the
+// service does.
+func (r *RenameSlugRunner) openBucket(ctx context.Context, bucket string) (natsinfra.INatsKeyValue, error) {
+	kvStore, err := r.jetStream.KeyValue(ctx, bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open KV bucket %q: %w", bucket, err)
+	}
+	if bucket != constants.KVStoreNameProjectSettings {
+		return kvStore, nil
+	}
+	compressed, err := r.natsConfig.CompressSettings(kvStore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open KV bucket %q: %w", bucket, err)
+	}
+	return compressed, nil
+}
+
+func processKVRecord(ctx context.Context, kvStore natsinfra.INatsKeyValue, key string, fields []string, oldSlug, newSlug string, dryRun bool) error {
 	entry, err := kvStore.Get(ctx, key)
 	if err != nil {
 		return fmt.Errorf("failed to get entry: %w", err)
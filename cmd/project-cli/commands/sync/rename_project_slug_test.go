@@ -4,9 +4,87 @@
 package sync
 
 import (
+	"context"
+	"encoding/json"
+	"strings"
 	"testing"
+
+	natsinfra "github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/nats"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 )
 
+// startJetStream starts an embedded NATS server with the service's buckets and
+// returns its JetStream context.
+func startJetStream(t *testing.T) jetstream.JetStream {
+	t.Helper()
+	ns, err := natsinfra.StartEmbeddedServer(t.TempDir())
+	if err != nil {
+		t.Fatalf("start NATS: %v", err)
+	}
+	t.Cleanup(ns.Shutdown)
+	nc, err := nats.Connect(ns.ClientURL())
+	if err != nil {
+		t.Fatalf("connect NATS: %v", err)
+	}
+	t.Cleanup(nc.Close)
+	js, err := jetstream.New(nc)
+	if err != nil {
+		t.Fatalf("JetStream: %v", err)
+	}
+	if err := natsinfra.CreateBuckets(context.Background(), js); err != nil {
+		t.Fatalf("create buckets: %v", err)
+	}
+	return js
+}
+
+func TestRenameSlugRunner_migrateBucket_compressedSettings(t *testing.T) {
+	ctx := context.Background()
+	js := startJetStream(t)
+	natsConfig := natsinfra.Config{KVCompression: natsinfra.CompressionGzip}
+	kv, err := js.KeyValue(ctx, constants.KVStoreNameProjectSettings)
+	if err != nil {
+		t.Fatalf("open bucket: %v", err)
+	}
+	settingsKV, err := natsConfig.CompressSettings(kv)
+	if err != nil {
+		t.Fatalf("compress bucket: %v", err)
+	}
+	value := `{"uid":"project-1","project_slug":"old-slug","mission_statement":"` + strings.Repeat("open source ", 50) + `"}`
+	if _, err := settingsKV.Put(ctx, "project-1", []byte(value)); err != nil {
+		t.Fatalf("put settings: %v", err)
+	}
+
+	runner := NewRenameSlugRunner(nil, js, natsConfig)
+	stats, err := runner.migrateBucket(ctx, constants.KVStoreNameProjectSettings, "old-slug", "new-slug", false, 1)
+	if err != nil {
+		t.Fatalf("migrate bucket: %v", err)
+	}
+	if stats.Updated != 1 || stats.Failed != 0 {
+		t.Fatalf("got %+v, want one updated record", stats)
+	}
+
+	raw, err := kv.Get(ctx, "project-1")
+	if err != nil {
+		t.Fatalf("get raw settings: %v", err)
+	}
+	if json.Valid(raw.Value()) {
+		t.Fatal("settings were written back uncompressed")
+	}
+	entry, err := settingsKV.Get(ctx, "project-1")
+	if err != nil {
+		t.Fatalf("get settings: %v", err)
+	}
+	var settings map[string]any
+	if err := json.Unmarshal(entry.Value(), &settings); err != nil {
+		t.Fatalf("decode settings: %v", err)
+	}
+	if settings["project_slug"] != "new-slug" {
+		t.Fatalf("got project_slug %v", settings["project_slug"])
+	}
+}
+
 func TestResolveSlugs_fromFlags(t *testing.T) {
 	old, new, err := resolveSlugs("old-slug", "new-slug", nil)
 	if err != nil {
@@ -0,0 +1,122 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sync
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/cmd/project-cli/commands"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	natsinfra "github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/nats"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/service"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/env"
+)
+
+type resyncProjectsSubcommand struct{}
+
+func (s *resyncProjectsSubcommand) Name() string { return "resync-projects" }
+
+func (s *resyncProjectsSubcommand) Help() string {
+	return "re-publish indexer and FGA sync messages for existing projects"
+}
+
+func (s *resyncProjectsSubcommand) Run(ctx context.Context, rc commands.RunContext) error {
+	fs := flag.NewFlagSet("resync-projects", flag.ContinueOnError)
+	fs.Usage = func() {
+		_, _ = fmt.Fprintf(fs.Output(), "usage: project-cli sync resync-projects [flags]\n\nflags:\n")
+		fs.PrintDefaults()
+	}
+	dryRun := fs.Bool("dry-run", env.GetBool("DRY_RUN", true), "list matching projects without publishing")
+	uidPrefix := fs.String("uid-prefix", env.Get("UID_PREFIX", ""), "only resync projects whose UID starts with this prefix")
+	updatedSince := fs.String("updated-since", env.Get("UPDATED_SINCE", ""), "only resync projects whose base or settings were updated at or after this RFC 3339 time")
+	stage := fs.String("stage", env.Get("STAGE", ""), "only resync projects in this stage, e.g. Active")
+	if err := fs.Parse(rc.Args); err != nil {
+		if err == flag.ErrHelp {
+			return nil
+		}
+		return err
+	}
+
+	filter, err := resolveResyncFilter(*uidPrefix, *updatedSince, *stage, *dryRun)
+	if err != nil {
+		return err
+	}
+
+	rc.DryRun = *dryRun
+
+	natsConn, js, err := natsinfra.Connect(ctx, rc.NATSConfig)
+	if err != nil {
+		return err
+	}
+	// Drain rather than close so that asynchronously published messages are flushed.
+	defer func() { _ = natsConn.Drain() }()
+
+	projectsKV, err := js.KeyValue(ctx, constants.KVStoreNameProjects)
+	if err != nil {
+		return fmt.Errorf("open bucket %s: %w", constants.KVStoreNameProjects, err)
+	}
+	settingsKV, err := openSettingsBucket(ctx, js, rc.NATSConfig)
+	if err != nil {
+		return err
+	}
+
+	ctx = log.AppendCtx(ctx, slog.Bool("dry_run", *dryRun))
+	slog.InfoContext(ctx, "resync-projects configured",
+		"uid_prefix", filter.UIDPrefix,
+		"updated_since", *updatedSince,
+		"stage", filter.Stage,
+		"nats_url", redactURL(rc.NATSConfig.URL),
+	)
+
+	svc := &service.ProjectsService{
+		ProjectRepository: natsinfra.NewNatsRepository(projectsKV, settingsKV),
+		MessageBuilder:    &natsinfra.MessageBuilder{NatsConn: natsinfra.NewTenantConn(natsConn, rc.NATSConfig.Tenant)},
+	}
+	// The CLI connects with the service's NATS credentials and acts as the service.
+	result, err := svc.ResyncProjects(service.WithServicePrincipal(ctx), filter)
+	if err != nil {
+		return err
+	}
+
+	if len(result.MissingSettings) > 0 {
+		slog.WarnContext(ctx, "skipped projects whose settings are missing",
+			"skipped", len(result.MissingSettings),
+			"project_uids", result.MissingSettings,
+		)
+	}
+	if *dryRun {
+		slog.InfoContext(ctx, "dry run: projects that would be resynced",
+			"matched", len(result.ProjectUIDs),
+			"project_uids", result.ProjectUIDs,
+		)
+		return nil
+	}
+	if result.Failed > 0 {
+		return fmt.Errorf("%d of %d projects failed to publish", result.Failed, len(result.ProjectUIDs))
+	}
+	return nil
+}
+
+// resolveResyncFilter validates the filter flags and builds the resync filter.
+func resolveResyncFilter(uidPrefix, updatedSince, stage string, dryRun bool) (service.ResyncFilter, error) {
+	filter := service.ResyncFilter{
+		UIDPrefix: strings.TrimSpace(uidPrefix),
+		Stage:     strings.TrimSpace(stage),
+		DryRun:    dryRun,
+	}
+	if updatedSince = strings.TrimSpace(updatedSince); updatedSince != "" {
+		t, err := time.Parse(time.RFC3339, updatedSince)
+		if err != nil {
+			return filter, fmt.Errorf("--updated-since %q is not an RFC 3339 timestamp", updatedSince)
+		}
+		filter.UpdatedSince = &t
+	}
+	return filter, nil
+}
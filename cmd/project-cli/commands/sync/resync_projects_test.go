@@ -0,0 +1,38 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package sync
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResolveResyncFilter_fromFlags(t *testing.T) {
+	filter, err := resolveResyncFilter(" 7cad ", "2025-01-01T00:00:00Z", "Active", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if filter.UIDPrefix != "7cad" || filter.Stage != "Active" || !filter.DryRun {
+		t.Fatalf("got %+v", filter)
+	}
+	if filter.UpdatedSince == nil || !filter.UpdatedSince.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("got updated since %v", filter.UpdatedSince)
+	}
+}
+
+func TestResolveResyncFilter_noFilters(t *testing.T) {
+	filter, err := resolveResyncFilter("", "", "", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if filter.UIDPrefix != "" || filter.Stage != "" || filter.UpdatedSince != nil || filter.DryRun {
+		t.Fatalf("got %+v", filter)
+	}
+}
+
+func TestResolveResyncFilter_rejectsInvalidUpdatedSince(t *testing.T) {
+	if _, err := resolveResyncFilter("", "2025-01-01", "", true); err == nil {
+		t.Fatal("expected error for a non RFC 3339 timestamp")
+	}
+}
@@ -15,7 +15,11 @@ func (c *command) Help() string {
 
 func (c *command) Subcommands() map[string]commands.Subcommand {
 	return map[string]commands.Subcommand{
-		"rename-project-slug": &renameProjectSlugSubcommand{},
+		"backup-projects":          &backupProjectsSubcommand{},
+		"migrate-project-settings": &migrateProjectSettingsSubcommand{},
+		"rename-project-slug":      &renameProjectSlugSubcommand{},
+		"restore-projects":         &restoreProjectsSubcommand{},
+		"resync-projects":          &resyncProjectsSubcommand{},
 	}
 }
 
@@ -0,0 +1,17 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"context"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+// AssociationChecker checks that a resource owned by another LFX service exists
+// before a project is associated with it.
+type AssociationChecker interface {
+	// AssociationExists reports whether the resource of the given kind exists.
+	AssociationExists(ctx context.Context, kind models.AssociationKind, id string) (bool, error)
+}
@@ -12,7 +12,29 @@ import (
 // This interface allows the service layer to authenticate users without depending
 // on specific authentication implementations (JWT, OAuth, etc.).
 type Authenticator interface {
-	// ParsePrincipal extracts the principal (user identifier) from an authentication token.
-	// Returns the principal string and any error that occurred during parsing.
-	ParsePrincipal(ctx context.Context, token string, logger *slog.Logger) (string, error)
+	// ParseClaims validates an authentication token and returns the caller's claims.
+	ParseClaims(ctx context.Context, token string, logger *slog.Logger) (*Claims, error)
+}
+
+// RoleStaff is the role of Linux Foundation staff, who may change the
+// restricted fields of any project they can write.
+const RoleStaff = "lf-staff"
+
+// Claims are the claims of an authenticated caller.
+type Claims struct {
+	// Principal identifies the caller, e.g. an LFID username.
+	Principal string
+	Email     string
+	// Roles are the platform roles granted to the caller, such as RoleStaff.
+	Roles []string
+	// Scopes are the API scopes granted to a machine-to-machine client. They
+	// are nil for users, whose access is not limited by scope.
+	Scopes []string
+}
+
+// AccessChecker checks a principal's relations on objects in OpenFGA.
+type AccessChecker interface {
+	// CheckAccess reports whether principal has relation on object, where
+	// object is an OpenFGA object such as "project:<uid>".
+	CheckAccess(ctx context.Context, principal, object, relation string) (bool, error)
 }
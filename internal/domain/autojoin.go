@@ -0,0 +1,40 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import "errors"
+
+// Roles of the people reported in [AutojoinDisabledError.Contacts].
+const (
+	ContactRoleExecutiveDirector = "executive_director"
+	ContactRoleProgramManager    = "program_manager"
+)
+
+// ErrAutojoinDisabled is matched by every [AutojoinDisabledError].
+var ErrAutojoinDisabled = errors.New("autojoin is disabled for the project")
+
+// ProjectContact is a person to contact about joining a project.
+type ProjectContact struct {
+	// Role is the contact's role in the project, one of the ContactRole* constants.
+	Role  string
+	Name  string
+	Email string
+}
+
+// AutojoinDisabledError is returned when a user asks to join a project that
+// does not have autojoin enabled.
+type AutojoinDisabledError struct {
+	// Contacts are the project's executive director and program manager, when set.
+	Contacts []ProjectContact
+}
+
+// Error implements the error interface.
+func (e *AutojoinDisabledError) Error() string {
+	return ErrAutojoinDisabled.Error()
+}
+
+// Is reports whether target is ErrAutojoinDisabled.
+func (e *AutojoinDisabledError) Is(target error) bool {
+	return target == ErrAutojoinDisabled
+}
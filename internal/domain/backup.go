@@ -0,0 +1,31 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"context"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+// BackupStorage stores backup archives of the project stores.
+type BackupStorage interface {
+	// PutBackup stores an archive under name.
+	PutBackup(ctx context.Context, name string, data []byte) error
+	// GetBackup returns the archive stored under name, or ErrBackupNotFound.
+	GetBackup(ctx context.Context, name string) ([]byte, error)
+	// ListBackups lists the stored archives, newest first.
+	ListBackups(ctx context.Context) ([]models.BackupInfo, error)
+}
+
+// BackupRepository reads and writes the raw entries of the project stores,
+// including slug and legacy ID mappings, for backups. Only repositories that
+// store projects as key-value entries implement it.
+type BackupRepository interface {
+	// ExportBackupEntries returns every entry of the project stores.
+	ExportBackupEntries(ctx context.Context) ([]models.BackupEntry, error)
+	// RestoreBackupEntry writes an entry back to its store, replacing the
+	// current value of the key.
+	RestoreBackupEntry(ctx context.Context, entry models.BackupEntry) error
+}
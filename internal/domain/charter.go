@@ -0,0 +1,13 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import "context"
+
+// CharterStorage stores project charter files where they are served publicly.
+type CharterStorage interface {
+	// PutCharter stores a charter file under key and returns its public URL.
+	// Keys are unique per version, so stored files are never replaced.
+	PutCharter(ctx context.Context, key string, data []byte) (string, error)
+}
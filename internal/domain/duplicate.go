@@ -0,0 +1,48 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Reasons an existing project is reported in [DuplicateProjectError.Candidates].
+const (
+	// DuplicateReasonSimilarName matches a project whose normalized name is
+	// similar to the new project's.
+	DuplicateReasonSimilarName = "similar_name"
+	// DuplicateReasonWebsiteURL matches a project with the same website URL.
+	DuplicateReasonWebsiteURL = "website_url"
+	// DuplicateReasonRepositoryURL matches a project with the same repository URL.
+	DuplicateReasonRepositoryURL = "repository_url"
+)
+
+// ErrProjectDuplicate is matched by every [DuplicateProjectError].
+var ErrProjectDuplicate = errors.New("project likely duplicates an existing project")
+
+// DuplicateCandidate is an existing project a new project likely duplicates.
+type DuplicateCandidate struct {
+	UID  string
+	Slug string
+	Name string
+	// Reason is why the project was matched, one of the DuplicateReason* constants.
+	Reason string
+}
+
+// DuplicateProjectError is returned when a new project likely duplicates
+// existing projects and the caller did not allow duplicates.
+type DuplicateProjectError struct {
+	Candidates []DuplicateCandidate
+}
+
+// Error describes the number of matched projects.
+func (e *DuplicateProjectError) Error() string {
+	return fmt.Sprintf("%s: %d candidate(s) found", ErrProjectDuplicate, len(e.Candidates))
+}
+
+// Is reports whether target is ErrProjectDuplicate.
+func (e *DuplicateProjectError) Is(target error) bool {
+	return target == ErrProjectDuplicate
+}
@@ -3,7 +3,10 @@
 
 package domain
 
-import "errors"
+import (
+	"context"
+	"errors"
+)
 
 // Domain errors
 var (
@@ -11,8 +14,25 @@ var (
 	ErrProjectNotFound = errors.New("project not found")
 	// ErrInvalidParentProject is returned when a parent project is invalid.
 	ErrInvalidParentProject = errors.New("invalid parent project")
+	// ErrInvalidLegalParent is returned when a legal parent is not an existing
+	// project whose legal entity can be the legal parent of another project.
+	ErrInvalidLegalParent = errors.New("legal parent must be an existing Incorporated Entity or Series LLC project")
+	// ErrInvalidParentUID is returned when a parent project UID is not a valid UUID.
+	ErrInvalidParentUID = errors.New("parent UID must be a valid UUID")
+	// ErrInvalidProjectLink is returned when a project link is not an absolute http or https URL.
+	ErrInvalidProjectLink = errors.New("project link must be an absolute http or https URL")
+	// ErrInvalidEnumValue is returned when a stage or category is not one of the allowed values.
+	ErrInvalidEnumValue = errors.New("value is not one of the allowed values listed by GET /metadata/enums")
+	// ErrInvalidProjectEnums is returned when a list of allowed stages or categories is empty or holds blank or repeated values.
+	ErrInvalidProjectEnums = errors.New("allowed values must be a non-empty list of distinct, non-blank values")
+	// ErrInvalidContactEmail is returned when a project's contact email is not a plain email address.
+	ErrInvalidContactEmail = errors.New("contact email must be a plain email address")
+	// ErrProjectHierarchyCycle is returned when a parent change would make a project its own ancestor.
+	ErrProjectHierarchyCycle = errors.New("parent project would create a cycle in the project hierarchy")
 	// ErrProjectSlugExists is returned when a project slug already exists.
 	ErrProjectSlugExists = errors.New("project slug already exists")
+	// ErrProjectLegacyIDExists is returned when another project already has the same legacy ID.
+	ErrProjectLegacyIDExists = errors.New("project legacy ID already exists")
 	// ErrInternal is returned when an internal error occurs.
 	ErrInternal = errors.New("internal error")
 	// ErrRevisionMismatch is returned when a revision mismatch occurs.
@@ -21,12 +41,24 @@ var (
 	ErrUnmarshal = errors.New("unmarshal error")
 	// ErrServiceUnavailable is returned when a service is unavailable.
 	ErrServiceUnavailable = errors.New("service unavailable")
+	// ErrForbidden is returned when the principal lacks the relation required for an operation.
+	ErrForbidden = errors.New("forbidden")
+	// ErrRestrictedField is returned when a principal other than LF staff changes a restricted project field.
+	ErrRestrictedField = errors.New("field can only be changed by LF staff")
 	// ErrValidationFailed is returned when a validation failed.
 	ErrValidationFailed = errors.New("validation failed")
 	// ErrCannotDeleteNonCrowdfundingProject is returned when attempting to delete a project whose funding model is not exactly ["Crowdfunding"].
 	ErrCannotDeleteNonCrowdfundingProject = errors.New("project can only be deleted if its funding model is Crowdfunding only")
 	// ErrArchivedRequiresDissolutionDate is returned when a project's stage is set to "Archived" without an entity dissolution date.
 	ErrArchivedRequiresDissolutionDate = errors.New("entity dissolution date is required when project stage is 'Archived'")
+	// ErrAnnotationsTooLarge is returned when a project's annotations exceed the total size limit.
+	ErrAnnotationsTooLarge = errors.New("project annotations exceed the maximum total size of 8 KiB")
+	// ErrInvalidLocalization is returned when a localized name or description has a malformed language tag or a blank value.
+	ErrInvalidLocalization = errors.New("localized values must be non-blank and keyed by a well-formed BCP 47 language tag")
+	// ErrFieldTooLong is returned when a free-text field exceeds its configured maximum length.
+	ErrFieldTooLong = errors.New("field exceeds the maximum length")
+	// ErrProjectDissolved is returned when the legal fields of a project whose legal entity is dissolved are changed.
+	ErrProjectDissolved = errors.New("the legal entity of the project is dissolved")
 
 	// ErrDocumentNotFound is returned when a document is not found.
 	ErrDocumentNotFound = errors.New("document not found")
@@ -36,6 +68,23 @@ var (
 	ErrInvalidContentType = errors.New("content type is not allowed")
 	// ErrFileTooLarge is returned when the uploaded file exceeds the maximum allowed size.
 	ErrFileTooLarge = errors.New("file size exceeds maximum allowed size")
+	// ErrInvalidLogo is returned when an uploaded logo cannot be read or its dimensions are out of bounds.
+	ErrInvalidLogo = errors.New("logo must be a readable SVG or PNG image within the allowed dimensions")
+
+	// ErrCharterNotFound is returned when a project has no uploaded charter.
+	ErrCharterNotFound = errors.New("charter not found")
+
+	// ErrProjectEnumsNotFound is returned when no allowed values of the
+	// enumerated project fields were stored, so that the defaults apply.
+	ErrProjectEnumsNotFound = errors.New("project enums not found")
+
+	// ErrSettingsRevisionNotFound is returned when a revision is not a retained
+	// revision of the project's settings.
+	ErrSettingsRevisionNotFound = errors.New("settings revision not found")
+
+	// ErrAssociatedResourceNotFound is returned when a project association refers
+	// to a committee, mailing list or meeting series that does not exist.
+	ErrAssociatedResourceNotFound = errors.New("associated resource not found")
 
 	// ErrLinkNotFound is returned when a link is not found.
 	ErrLinkNotFound = errors.New("link not found")
@@ -47,7 +96,45 @@ var (
 	// ErrFolderNotEmpty is returned when attempting to delete a folder that still contains links or documents.
 	ErrFolderNotEmpty = errors.New("folder cannot be deleted because it contains links or documents; remove all items from the folder first")
 
+	// ErrDeadLetterNotFound is returned when a dead-lettered message is not found.
+	ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+	// ErrBackupNotFound is returned when no backup archive has the given name.
+	ErrBackupNotFound = errors.New("backup not found")
+
+	// ErrWebhookNotFound is returned when a webhook is not found.
+	ErrWebhookNotFound = errors.New("webhook not found")
+	// ErrWebhookDeliveryExists is returned when an event was already recorded for a webhook.
+	ErrWebhookDeliveryExists = errors.New("webhook delivery already exists")
+	// ErrInvalidWebhookURL is returned when a webhook URL is not an absolute https URL.
+	ErrInvalidWebhookURL = errors.New("webhook URL must be an absolute https URL")
+	// ErrInvalidWebhookEvent is returned when a webhook subscribes to an unknown event type.
+	ErrInvalidWebhookEvent = errors.New("unknown webhook event type")
+	// ErrProjectTemplateNotFound is returned when a project template is not found.
+	ErrProjectTemplateNotFound = errors.New("project template not found")
+	// ErrProjectTemplateExists is returned when a project template with the same name already exists.
+	ErrProjectTemplateExists = errors.New("project template already exists")
+
+	// ErrAnnouncementExists is returned when a project announcement was already published.
+	ErrAnnouncementExists = errors.New("project announcement already published")
+
 	// ErrUserNotFound is returned by UserReader when no registered user matches the given lookup criteria.
-	// It is handled internally (treated as a lookup miss) and is never surfaced to the HTTP layer.
+	// It is mostly handled internally (treated as a lookup miss); adding a project member by
+	// username reports it as a field error.
 	ErrUserNotFound = errors.New("user not found")
+
+	// ErrProjectMemberNotFound is returned when removing a user who does not hold the role.
+	ErrProjectMemberNotFound = errors.New("project member not found")
+
+	// ErrInvalidPageToken is returned when a page token is malformed or was issued
+	// for another sort or order.
+	ErrInvalidPageToken = errors.New("page token is invalid or was issued for another sort or order")
 )
+
+// IsRetryable reports whether an operation that failed with err may succeed
+// when tried again, such as after a storage outage or a concurrent write.
+// Other errors, such as validation errors, fail the same way every time.
+func IsRetryable(err error) bool {
+	return errors.Is(err, ErrInternal) || errors.Is(err, ErrServiceUnavailable) ||
+		errors.Is(err, ErrRevisionMismatch) || errors.Is(err, context.DeadlineExceeded)
+}
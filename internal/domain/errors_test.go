@@ -4,7 +4,9 @@
 package domain
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -233,3 +235,13 @@ func TestErrorCategories(t *testing.T) {
 		})
 	}
 }
+
+func TestIsRetryable(t *testing.T) {
+	assert.True(t, IsRetryable(ErrInternal))
+	assert.True(t, IsRetryable(ErrServiceUnavailable))
+	assert.True(t, IsRetryable(fmt.Errorf("updating project: %w", ErrRevisionMismatch)))
+	assert.True(t, IsRetryable(context.DeadlineExceeded))
+	assert.False(t, IsRetryable(ErrProjectSlugExists))
+	assert.False(t, IsRetryable(ErrUnmarshal))
+	assert.False(t, IsRetryable(NewFieldValidationError("slug", FieldErrorRequired, ErrValidationFailed)))
+}
@@ -0,0 +1,28 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import "context"
+
+// HealthChecker reports whether a dependency of the service is reachable.
+type HealthChecker interface {
+	// HealthCheck returns nil when the dependency is healthy, or an error describing why it is not.
+	HealthCheck(ctx context.Context) error
+}
+
+// HealthCheckFunc adapts an ordinary function to the HealthChecker interface.
+type HealthCheckFunc func(ctx context.Context) error
+
+// HealthCheck calls f(ctx).
+func (f HealthCheckFunc) HealthCheck(ctx context.Context) error {
+	return f(ctx)
+}
+
+// ReadinessChecker reports whether the service may receive traffic. Ready must
+// not block: it reports state kept up to date in the background, since it is
+// asked on every readiness request.
+type ReadinessChecker interface {
+	// Ready returns nil when the service may receive traffic, or an error describing why it may not.
+	Ready() error
+}
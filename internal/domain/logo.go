@@ -0,0 +1,22 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import "context"
+
+// LogoStorage stores project logo files where they are served publicly.
+type LogoStorage interface {
+	// PutLogo stores a logo file under name, replacing any previous version, and returns its public URL.
+	PutLogo(ctx context.Context, name, contentType string, data []byte) (string, error)
+}
+
+// LogoConverter renders SVG logos as PNG for clients, such as email, that cannot display SVG.
+type LogoConverter interface {
+	ConvertSVGToPNG(ctx context.Context, svg []byte, width, height int) ([]byte, error)
+}
+
+// LogoFetcher downloads a logo from the URL it was set to.
+type LogoFetcher interface {
+	FetchLogo(ctx context.Context, url string) ([]byte, error)
+}
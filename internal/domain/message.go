@@ -38,3 +38,9 @@ type MessageBuilder interface {
 	SendEmailRequest(ctx context.Context, req emailapi.SendEmailRequest) error
 	SendInviteRequest(ctx context.Context, req inviteapi.SendInviteRequest) (InviteResult, error)
 }
+
+// DegradedPublisher is implemented by message builders that can report NATS
+// as too unreliable to publish on, so that callers defer messages instead.
+type DegradedPublisher interface {
+	PublishDegraded() bool
+}
@@ -5,6 +5,7 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
@@ -39,6 +40,14 @@ func (m *MockProjectRepository) UpdateProjectBase(ctx context.Context, projectBa
 	return args.Error(0)
 }
 
+func (m *MockProjectRepository) UpdateProjectBaseWithRetry(ctx context.Context, projectUID string, modify func(*models.ProjectBase) error) (*models.ProjectBase, error) {
+	args := m.Called(ctx, projectUID, modify)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ProjectBase), args.Error(1)
+}
+
 func (m *MockProjectRepository) ProjectExists(ctx context.Context, projectUID string) (bool, error) {
 	args := m.Called(ctx, projectUID)
 	return args.Bool(0), args.Error(1)
@@ -65,11 +74,24 @@ func (m *MockProjectRepository) UpdateProjectSettings(ctx context.Context, proje
 	return args.Error(0)
 }
 
+func (m *MockProjectRepository) UpdateProjectSettingsWithRetry(ctx context.Context, projectUID string, modify func(*models.ProjectSettings) error) (*models.ProjectSettings, error) {
+	args := m.Called(ctx, projectUID, modify)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ProjectSettings), args.Error(1)
+}
+
 func (m *MockProjectRepository) GetProjectUIDFromSlug(ctx context.Context, projectSlug string) (string, error) {
 	args := m.Called(ctx, projectSlug)
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockProjectRepository) GetProjectUIDFromLegacyID(ctx context.Context, legacyID string) (string, error) {
+	args := m.Called(ctx, legacyID)
+	return args.String(0), args.Error(1)
+}
+
 func (m *MockProjectRepository) ProjectSlugExists(ctx context.Context, projectSlug string) (bool, error) {
 	args := m.Called(ctx, projectSlug)
 	return args.Bool(0), args.Error(1)
@@ -199,6 +221,124 @@ func (m *MockLinkRepository) DeleteLink(ctx context.Context, projectUID, linkUID
 	return args.Error(0)
 }
 
+// MockOutboxRepository implements OutboxRepository for testing.
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepository) EnqueueOutboxMessages(ctx context.Context, messages []*models.OutboxMessage) error {
+	args := m.Called(ctx, messages)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) ListOutboxMessages(ctx context.Context) ([]*models.OutboxMessage, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.OutboxMessage), args.Error(1)
+}
+
+func (m *MockOutboxRepository) UpdateOutboxMessage(ctx context.Context, message *models.OutboxMessage) error {
+	args := m.Called(ctx, message)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) DeleteOutboxMessage(ctx context.Context, message *models.OutboxMessage) error {
+	args := m.Called(ctx, message)
+	return args.Error(0)
+}
+
+// MockDeadLetterRepository implements DeadLetterRepository for testing.
+type MockDeadLetterRepository struct {
+	mock.Mock
+}
+
+func (m *MockDeadLetterRepository) PutDeadLetter(ctx context.Context, deadLetter *models.DeadLetter) error {
+	args := m.Called(ctx, deadLetter)
+	return args.Error(0)
+}
+
+func (m *MockDeadLetterRepository) GetDeadLetter(ctx context.Context, deadLetterID string) (*models.DeadLetter, error) {
+	args := m.Called(ctx, deadLetterID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.DeadLetter), args.Error(1)
+}
+
+func (m *MockDeadLetterRepository) ListDeadLetters(ctx context.Context) ([]*models.DeadLetter, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.DeadLetter), args.Error(1)
+}
+
+func (m *MockDeadLetterRepository) DeleteDeadLetter(ctx context.Context, deadLetterID string) error {
+	args := m.Called(ctx, deadLetterID)
+	return args.Error(0)
+}
+
+// MockWebhookRepository implements WebhookRepository for testing.
+type MockWebhookRepository struct {
+	mock.Mock
+}
+
+func (m *MockWebhookRepository) CreateWebhook(ctx context.Context, webhook *models.Webhook) error {
+	args := m.Called(ctx, webhook)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) GetWebhook(ctx context.Context, webhookID string) (*models.Webhook, error) {
+	args := m.Called(ctx, webhookID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) ListWebhooks(ctx context.Context) ([]*models.Webhook, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) DeleteWebhook(ctx context.Context, webhookID string) error {
+	args := m.Called(ctx, webhookID)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) CreateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) UpdateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) ListWebhookDeliveries(ctx context.Context, webhookID string) ([]*models.WebhookDelivery, error) {
+	args := m.Called(ctx, webhookID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.WebhookDelivery), args.Error(1)
+}
+
+// MockWebhookSender implements WebhookSender for testing.
+type MockWebhookSender struct {
+	mock.Mock
+}
+
+func (m *MockWebhookSender) SendWebhook(ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery) (int, error) {
+	args := m.Called(ctx, webhook, delivery)
+	return args.Int(0), args.Error(1)
+}
+
 // MockFolderRepository implements FolderRepository for testing.
 type MockFolderRepository struct {
 	mock.Mock
@@ -283,6 +423,325 @@ func (m *MockUserReader) UsernameByEmail(ctx context.Context, email string) (str
 	return args.String(0), args.Error(1)
 }
 
+// MockAccessChecker implements AccessChecker for testing.
+type MockAccessChecker struct {
+	mock.Mock
+}
+
+func (m *MockAccessChecker) CheckAccess(ctx context.Context, principal, object, relation string) (bool, error) {
+	args := m.Called(ctx, principal, object, relation)
+	return args.Bool(0), args.Error(1)
+}
+
+// MockLogoStorage implements LogoStorage for testing.
+type MockLogoStorage struct {
+	mock.Mock
+}
+
+func (m *MockLogoStorage) PutLogo(ctx context.Context, name, contentType string, data []byte) (string, error) {
+	args := m.Called(ctx, name, contentType, data)
+	return args.String(0), args.Error(1)
+}
+
+// MockLogoConverter implements LogoConverter for testing.
+type MockLogoConverter struct {
+	mock.Mock
+}
+
+func (m *MockLogoConverter) ConvertSVGToPNG(ctx context.Context, svg []byte, width, height int) ([]byte, error) {
+	args := m.Called(ctx, svg, width, height)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+// MockLogoFetcher implements LogoFetcher for testing.
+type MockLogoFetcher struct {
+	mock.Mock
+}
+
+func (m *MockLogoFetcher) FetchLogo(ctx context.Context, url string) ([]byte, error) {
+	args := m.Called(ctx, url)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+// MockCharterStorage implements CharterStorage for testing.
+type MockCharterStorage struct {
+	mock.Mock
+}
+
+func (m *MockCharterStorage) PutCharter(ctx context.Context, key string, data []byte) (string, error) {
+	args := m.Called(ctx, key, data)
+	return args.String(0), args.Error(1)
+}
+
+// MockCharterRepository implements CharterRepository for testing.
+type MockCharterRepository struct {
+	mock.Mock
+}
+
+func (m *MockCharterRepository) GetProjectCharter(ctx context.Context, projectUID string) (*models.ProjectCharter, error) {
+	args := m.Called(ctx, projectUID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ProjectCharter), args.Error(1)
+}
+
+func (m *MockCharterRepository) AddProjectCharterVersion(ctx context.Context, projectUID string, version models.ProjectCharterVersion) (*models.ProjectCharter, error) {
+	args := m.Called(ctx, projectUID, version)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ProjectCharter), args.Error(1)
+}
+
+// MockAssociationRepository implements AssociationRepository for testing.
+type MockAssociationRepository struct {
+	mock.Mock
+}
+
+func (m *MockAssociationRepository) GetProjectAssociations(ctx context.Context, projectUID string) (*models.ProjectAssociations, error) {
+	args := m.Called(ctx, projectUID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ProjectAssociations), args.Error(1)
+}
+
+func (m *MockAssociationRepository) PutProjectAssociations(ctx context.Context, associations *models.ProjectAssociations) error {
+	args := m.Called(ctx, associations)
+	return args.Error(0)
+}
+
+// MockAssociationChecker implements AssociationChecker for testing.
+type MockAssociationChecker struct {
+	mock.Mock
+}
+
+func (m *MockAssociationChecker) AssociationExists(ctx context.Context, kind models.AssociationKind, id string) (bool, error) {
+	args := m.Called(ctx, kind, id)
+	return args.Bool(0), args.Error(1)
+}
+
+// MockProjectHistoryRepository implements ProjectHistoryRepository for testing.
+type MockProjectHistoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockProjectHistoryRepository) GetProjectHistory(ctx context.Context, projectUID string) ([]models.ProjectRevision, error) {
+	args := m.Called(ctx, projectUID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ProjectRevision), args.Error(1)
+}
+
+// MockKVInspector implements KVInspector for testing.
+type MockKVInspector struct {
+	mock.Mock
+}
+
+func (m *MockKVInspector) InspectProjectKV(ctx context.Context, projectUID string) (*models.ProjectKVInspection, error) {
+	args := m.Called(ctx, projectUID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ProjectKVInspection), args.Error(1)
+}
+
+// MockReadinessChecker implements ReadinessChecker for testing.
+type MockReadinessChecker struct {
+	mock.Mock
+}
+
+func (m *MockReadinessChecker) Ready() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+// MockSettingsHistoryRepository implements SettingsHistoryRepository for testing.
+type MockSettingsHistoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockSettingsHistoryRepository) GetProjectSettingsAtRevision(ctx context.Context, projectUID string, revision uint64) (*models.ProjectSettings, error) {
+	args := m.Called(ctx, projectUID, revision)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ProjectSettings), args.Error(1)
+}
+
+// MockSlugMappingRepository implements SlugMappingRepository for testing.
+type MockSlugMappingRepository struct {
+	mock.Mock
+}
+
+func (m *MockSlugMappingRepository) RebuildSlugMappings(ctx context.Context, removeDangling bool) (*models.SlugRebuildReport, error) {
+	args := m.Called(ctx, removeDangling)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.SlugRebuildReport), args.Error(1)
+}
+
+func (m *MockSlugMappingRepository) PutSlugRedirect(ctx context.Context, slug, projectUID string) error {
+	args := m.Called(ctx, slug, projectUID)
+	return args.Error(0)
+}
+
+// MockConsistencyChecker implements ConsistencyChecker for testing.
+type MockConsistencyChecker struct {
+	mock.Mock
+}
+
+func (m *MockConsistencyChecker) CheckConsistency(ctx context.Context, repair bool) (*models.ConsistencyReport, error) {
+	args := m.Called(ctx, repair)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ConsistencyReport), args.Error(1)
+}
+
+// MockBackupStorage implements BackupStorage for testing.
+type MockBackupStorage struct {
+	mock.Mock
+}
+
+func (m *MockBackupStorage) PutBackup(ctx context.Context, name string, data []byte) error {
+	args := m.Called(ctx, name, data)
+	return args.Error(0)
+}
+
+func (m *MockBackupStorage) GetBackup(ctx context.Context, name string) ([]byte, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockBackupStorage) ListBackups(ctx context.Context) ([]models.BackupInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.BackupInfo), args.Error(1)
+}
+
+// MockBackupRepository implements BackupRepository for testing.
+type MockBackupRepository struct {
+	mock.Mock
+}
+
+func (m *MockBackupRepository) ExportBackupEntries(ctx context.Context) ([]models.BackupEntry, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.BackupEntry), args.Error(1)
+}
+
+func (m *MockBackupRepository) RestoreBackupEntry(ctx context.Context, entry models.BackupEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+// MockAnnouncementRepository implements AnnouncementRepository for testing.
+type MockAnnouncementRepository struct {
+	mock.Mock
+}
+
+func (m *MockAnnouncementRepository) CreateProjectAnnouncement(ctx context.Context, announcement *models.ProjectAnnouncement) error {
+	args := m.Called(ctx, announcement)
+	return args.Error(0)
+}
+
+func (m *MockAnnouncementRepository) ListProjectAnnouncements(ctx context.Context) ([]*models.ProjectAnnouncement, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.ProjectAnnouncement), args.Error(1)
+}
+
+// MockProjectTemplateRepository implements ProjectTemplateRepository for testing.
+type MockProjectTemplateRepository struct {
+	mock.Mock
+}
+
+func (m *MockProjectTemplateRepository) CreateProjectTemplate(ctx context.Context, template *models.ProjectTemplate) error {
+	args := m.Called(ctx, template)
+	return args.Error(0)
+}
+
+func (m *MockProjectTemplateRepository) GetProjectTemplate(ctx context.Context, name string) (*models.ProjectTemplate, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ProjectTemplate), args.Error(1)
+}
+
+func (m *MockProjectTemplateRepository) ListProjectTemplates(ctx context.Context) ([]*models.ProjectTemplate, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.ProjectTemplate), args.Error(1)
+}
+
+func (m *MockProjectTemplateRepository) UpdateProjectTemplate(ctx context.Context, template *models.ProjectTemplate) error {
+	args := m.Called(ctx, template)
+	return args.Error(0)
+}
+
+func (m *MockProjectTemplateRepository) DeleteProjectTemplate(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
+
+// MockProjectEnumsRepository implements ProjectEnumsRepository for testing.
+type MockProjectEnumsRepository struct {
+	mock.Mock
+}
+
+func (m *MockProjectEnumsRepository) GetProjectEnums(ctx context.Context) (*models.ProjectEnums, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ProjectEnums), args.Error(1)
+}
+
+func (m *MockProjectEnumsRepository) PutProjectEnums(ctx context.Context, enums *models.ProjectEnums) error {
+	args := m.Called(ctx, enums)
+	return args.Error(0)
+}
+
+// MockProjectDeletionRepository implements ProjectDeletionRepository for testing.
+type MockProjectDeletionRepository struct {
+	mock.Mock
+}
+
+func (m *MockProjectDeletionRepository) PutProjectDeletion(ctx context.Context, deletion *models.ProjectDeletion) error {
+	args := m.Called(ctx, deletion)
+	return args.Error(0)
+}
+
+func (m *MockProjectDeletionRepository) ListProjectDeletions(ctx context.Context, since time.Time) ([]*models.ProjectDeletion, error) {
+	args := m.Called(ctx, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.ProjectDeletion), args.Error(1)
+}
+
 // MockMessage implements Message for testing
 type MockMessage struct {
 	mock.Mock
@@ -310,3 +769,29 @@ func NewMockMessage(data []byte, subject string) *MockMessage {
 		subject: subject,
 	}
 }
+
+// MockProjectSearcher implements ProjectSearcher for testing.
+type MockProjectSearcher struct {
+	mock.Mock
+}
+
+func (m *MockProjectSearcher) SearchProjects(ctx context.Context, query string, limit int) ([]string, error) {
+	args := m.Called(ctx, query, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// MockProjectSuggester implements ProjectSuggester for testing.
+type MockProjectSuggester struct {
+	mock.Mock
+}
+
+func (m *MockProjectSuggester) SuggestProjects(ctx context.Context, prefix string) ([]models.ProjectSuggestion, error) {
+	args := m.Called(ctx, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ProjectSuggestion), args.Error(1)
+}
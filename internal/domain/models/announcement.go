@@ -0,0 +1,26 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import "time"
+
+// ProjectAnnouncement records that a project's announcement was published for
+// its announcement date, so that it is published only once.
+type ProjectAnnouncement struct {
+	ProjectUID       string    `json:"project_uid"`
+	AnnouncementDate time.Time `json:"announcement_date"`
+	AnnouncedAt      time.Time `json:"announced_at"`
+}
+
+// PendingAnnouncement is a project whose announcement date is set and whose
+// announcement has not been published yet.
+type PendingAnnouncement struct {
+	ProjectUID       string    `json:"project_uid"`
+	Slug             string    `json:"slug"`
+	Name             string    `json:"name"`
+	AnnouncementDate time.Time `json:"announcement_date"`
+	// Due reports whether the announcement date has been reached, so that the
+	// announcement is published on the next run of the scheduler.
+	Due bool `json:"due"`
+}
@@ -0,0 +1,32 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import "time"
+
+// MaxAssociationsPerKind is the maximum number of references of one kind that
+// a project can hold.
+const MaxAssociationsPerKind = 100
+
+// AssociationKind is the kind of resource, owned by another LFX service, that
+// a project association refers to.
+type AssociationKind string
+
+// Association kinds.
+const (
+	AssociationKindCommittee     AssociationKind = "committee"
+	AssociationKindMailingList   AssociationKind = "mailing_list"
+	AssociationKindMeetingSeries AssociationKind = "meeting_series"
+)
+
+// ProjectAssociations holds references to the committees, mailing lists and
+// meeting series associated with a project. The referenced resources are owned
+// by other LFX services; only their identifiers are stored here.
+type ProjectAssociations struct {
+	ProjectUID       string     `json:"project_uid"`
+	CommitteeUIDs    []string   `json:"committee_uids"`
+	MailingListIDs   []string   `json:"mailing_list_ids"`
+	MeetingSeriesIDs []string   `json:"meeting_series_ids"`
+	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+}
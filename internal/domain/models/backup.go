@@ -0,0 +1,51 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import "time"
+
+// BackupEntry is one key of a project store as saved in a backup archive,
+// which holds one JSON-encoded entry per line.
+type BackupEntry struct {
+	// Bucket is the name of the store the entry was read from, such as
+	// "projects" or "project-settings".
+	Bucket string `json:"bucket"`
+	Key    string `json:"key"`
+	// ProjectUID is the project the entry belongs to: the project of a
+	// document, or the one a slug or legacy ID mapping points at. It is empty
+	// for entries that belong to no single project.
+	ProjectUID string `json:"project_uid,omitempty"`
+	// Value is the stored value, base64-encoded in the archive, since slug
+	// and legacy ID mappings are not JSON.
+	Value []byte `json:"value"`
+}
+
+// BackupInfo describes a stored backup archive.
+type BackupInfo struct {
+	Name      string
+	Size      int64
+	CreatedAt time.Time
+}
+
+// BackupReport summarizes a backup of the project stores.
+type BackupReport struct {
+	// Name is the name the archive was stored under.
+	Name string
+	// Entries is the number of entries saved.
+	Entries int
+	// Projects is the number of projects saved.
+	Projects int
+}
+
+// RestoreReport summarizes a restore of the project stores from a backup.
+type RestoreReport struct {
+	// Backup is the name of the archive restored from.
+	Backup string
+	// ProjectUIDs are the projects restored, or that would be in a dry run.
+	ProjectUIDs []string
+	// Entries is the number of entries written, or that would be in a dry run.
+	Entries int
+	// Failed is the number of entries that could not be written.
+	Failed int
+}
@@ -0,0 +1,40 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import "time"
+
+const (
+	// MaxCharterFileSize is the maximum allowed file size for charter uploads (10MB).
+	MaxCharterFileSize = MaxDocumentFileSize
+	// CharterContentType is the only format accepted for charter uploads.
+	CharterContentType = "application/pdf"
+)
+
+// ProjectCharter is the version history of a project's charter document. The
+// files are stored in S3; the project's charter_url points at the latest version.
+type ProjectCharter struct {
+	ProjectUID string                  `json:"project_uid"`
+	Versions   []ProjectCharterVersion `json:"versions"`
+}
+
+// ProjectCharterVersion is one uploaded version of a project charter.
+type ProjectCharterVersion struct {
+	// Version numbers start at 1 and increase with each upload.
+	Version            int       `json:"version"`
+	URL                string    `json:"url"`
+	FileName           string    `json:"file_name"`
+	FileSize           int64     `json:"file_size"`
+	SHA256             string    `json:"sha256"`
+	UploadedByUsername string    `json:"uploaded_by_username,omitempty"`
+	UploadedAt         time.Time `json:"uploaded_at"`
+}
+
+// Current returns the latest version of the charter, or nil if it has none.
+func (c *ProjectCharter) Current() *ProjectCharterVersion {
+	if c == nil || len(c.Versions) == 0 {
+		return nil
+	}
+	return &c.Versions[len(c.Versions)-1]
+}
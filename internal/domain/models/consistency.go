@@ -0,0 +1,46 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import "time"
+
+// ConsistencyReport lists the inconsistencies found between the projects and
+// their slug and legacy ID mappings, settings and parents.
+type ConsistencyReport struct {
+	// DanglingSlugMappings are slugs whose mapping points at a missing project,
+	// or at a project that has since changed its slug.
+	DanglingSlugMappings []string
+	// MissingSlugMappings are the UIDs of projects without a slug mapping.
+	MissingSlugMappings []string
+	// DanglingLegacyIDMappings are legacy IDs whose mapping points at a missing
+	// project, or at a project that has since changed its legacy ID.
+	DanglingLegacyIDMappings []string
+	// MissingLegacyIDMappings are the UIDs of projects whose legacy ID is not mapped.
+	MissingLegacyIDMappings []string
+	// OrphanedSettings are the UIDs of project settings without a project base.
+	OrphanedSettings []string
+	// OrphanedChildren are the UIDs of projects whose parent is missing.
+	OrphanedChildren []string
+	// InvalidEntries are the entries that could not be decoded, as the bucket
+	// name and key joined with "/", such as "projects/<uid>".
+	InvalidEntries []string
+	// Repaired is the number of inconsistencies that were repaired.
+	Repaired int
+	// Failed is the number of inconsistencies that could not be repaired.
+	Failed int
+}
+
+// Found returns the number of inconsistencies found.
+func (r *ConsistencyReport) Found() int {
+	return len(r.DanglingSlugMappings) + len(r.MissingSlugMappings) +
+		len(r.DanglingLegacyIDMappings) + len(r.MissingLegacyIDMappings) +
+		len(r.OrphanedSettings) + len(r.OrphanedChildren) + len(r.InvalidEntries)
+}
+
+// IntegrityReport is the consistency report of a scan by the integrity scanner.
+type IntegrityReport struct {
+	ConsistencyReport
+	// ScannedAt is when the scan started.
+	ScannedAt time.Time
+}
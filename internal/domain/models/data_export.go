@@ -0,0 +1,54 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import "time"
+
+// Project contact roles, each held by at most one user of a project's settings.
+// Unlike the member roles, they grant no relation on the project.
+const (
+	ProjectContactRoleExecutiveDirector = "executive_director"
+	ProjectContactRoleProgramManager    = "program_manager"
+	ProjectContactRoleOpportunityOwner  = "opportunity_owner"
+)
+
+// Contacts returns the users of the contact roles of the settings, by role.
+// Roles without a user are left out.
+func (s *ProjectSettings) Contacts() map[string]*UserInfo {
+	contacts := map[string]*UserInfo{}
+	for role, user := range map[string]*UserInfo{
+		ProjectContactRoleExecutiveDirector: s.ExecutiveDirector,
+		ProjectContactRoleProgramManager:    s.ProgramManager,
+		ProjectContactRoleOpportunityOwner:  s.OpportunityOwner,
+	} {
+		if user != nil {
+			contacts[role] = user
+		}
+	}
+	return contacts
+}
+
+// UserDataExport is everything the service stores about one user, for
+// data-subject access requests.
+type UserDataExport struct {
+	Username    string
+	GeneratedAt time.Time
+	// Memberships are the roles the user holds, ordered by project slug and
+	// then by role in settings order.
+	Memberships []UserMembership
+}
+
+// UserMembership is a member or contact role a user holds in a project, with
+// the user information stored with it.
+type UserMembership struct {
+	ProjectUID string
+	Slug       string
+	Name       string
+	// Role is a ProjectMemberRole or one of the ProjectContactRole* roles.
+	Role string
+	User UserInfo
+	// AddedAt is when the user was given the role, as far back as the retained
+	// history of the project goes; nil when the history is not available.
+	AddedAt *time.Time
+}
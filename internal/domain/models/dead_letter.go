@@ -0,0 +1,23 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import "time"
+
+// DeadLetter is an inbound NATS event whose handler failed. It is kept, with
+// the original payload, until an operator replays it, so that a transient
+// failure does not silently lose the event.
+type DeadLetter struct {
+	ID      string `json:"id"`
+	Subject string `json:"subject"`
+	// Payload is the original message body.
+	Payload []byte `json:"payload"`
+	// Error is the error returned by the last failed attempt.
+	Error string `json:"error"`
+	// Attempts counts the failed attempts: the original delivery and each
+	// failed replay.
+	Attempts     int       `json:"attempts"`
+	FailedAt     time.Time `json:"failed_at"`
+	LastFailedAt time.Time `json:"last_failed_at"`
+}
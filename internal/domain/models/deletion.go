@@ -0,0 +1,54 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import "time"
+
+// ProjectDeletion is the tombstone of a deleted project, kept so that
+// downstream caches can tell a deleted project from one that never existed.
+type ProjectDeletion struct {
+	UID       string    `json:"uid"`
+	Slug      string    `json:"slug"`
+	DeletedAt time.Time `json:"deleted_at"`
+	// DeletedBy is the principal who deleted the project.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// MergedInto is the UID of the project the deleted project was merged
+	// into, if it was deleted by a merge.
+	MergedInto string `json:"merged_into,omitempty"`
+}
+
+// ProjectBulkDeletion is a request to delete several projects at once.
+type ProjectBulkDeletion struct {
+	ProjectUIDs []string
+	// DryRun reports what would be deleted without deleting anything.
+	DryRun bool
+	// Cascade also deletes every project under the requested ones.
+	Cascade bool
+	// Force deletes projects whose funding model is not Crowdfunding only.
+	Force bool
+}
+
+// Outcomes of the deletion of one project of a ProjectBulkDeletion.
+const (
+	ProjectDeletionDeleted     = "deleted"
+	ProjectDeletionWouldDelete = "would_delete"
+	ProjectDeletionNotFound    = "not_found"
+	ProjectDeletionBlocked     = "blocked"
+	ProjectDeletionSkipped     = "skipped"
+	ProjectDeletionFailed      = "failed"
+)
+
+// ProjectDeletionResult is the outcome of the deletion of one project of a
+// ProjectBulkDeletion.
+type ProjectDeletionResult struct {
+	ProjectUID string
+	Slug       string
+	// Requested is false for a project deleted as a descendant of a
+	// requested one.
+	Requested bool
+	// Status is one of the ProjectDeletion* outcomes.
+	Status string
+	// Error describes why the project blocked the deletion or failed.
+	Error string
+}
@@ -0,0 +1,55 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import "time"
+
+// ProjectEnums are the values allowed for the enumerated project fields. They
+// are managed by admins, so that a new stage or category does not need a
+// release of the service.
+type ProjectEnums struct {
+	Stages     []string   `json:"stages"`
+	Categories []string   `json:"categories"`
+	UpdatedBy  string     `json:"updated_by,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+// DefaultProjectEnums returns the values allowed until admins store their own.
+func DefaultProjectEnums() *ProjectEnums {
+	return &ProjectEnums{
+		Stages: []string{
+			"Formation - Exploratory",
+			"Formation - Engaged",
+			"Active",
+			"Archived",
+			"Formation - On Hold",
+			"Formation - Disengaged",
+			"Formation - Confidential",
+			"Prospect",
+		},
+		Categories: []string{
+			"Active",
+			"Adopted",
+			"Archived",
+			"At-Large",
+			"Early Adoption",
+			"Emeritus",
+			"Graduated",
+			"Growth",
+			"Idle",
+			"Impact",
+			"Incubating",
+			"Kanister",
+			"Mature",
+			"Pre-LFESS",
+			"Sandbox",
+			"SIG",
+			"Standards",
+			"TAC",
+			"Working Group",
+			"TAG",
+			"NONE",
+		},
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import (
+	"slices"
+	"strings"
+)
+
+// ProjectHierarchy indexes the slug and parent of every project, and the
+// number of children of each, so that the place of a project in the hierarchy
+// is found without reading its ancestors.
+type ProjectHierarchy struct {
+	nodes    map[string]hierarchyNode
+	children map[string]int
+}
+
+type hierarchyNode struct {
+	slug      string
+	parentUID string
+}
+
+// NewProjectHierarchy returns an empty ProjectHierarchy.
+func NewProjectHierarchy() *ProjectHierarchy {
+	return &ProjectHierarchy{
+		nodes:    make(map[string]hierarchyNode),
+		children: make(map[string]int),
+	}
+}
+
+// Add indexes project. A project must be added at most once.
+func (h *ProjectHierarchy) Add(project *ProjectBase) {
+	h.nodes[project.UID] = hierarchyNode{slug: project.Slug, parentUID: project.ParentUID}
+	if project.ParentUID != "" {
+		h.children[project.ParentUID]++
+	}
+}
+
+// Has reports whether the project projectUID is indexed.
+func (h *ProjectHierarchy) Has(projectUID string) bool {
+	_, ok := h.nodes[projectUID]
+	return ok
+}
+
+// ChildCount returns the number of direct children of the project projectUID.
+func (h *ProjectHierarchy) ChildCount(projectUID string) int {
+	return h.children[projectUID]
+}
+
+// SlugPath returns the slugs of the ancestors of the project with slug and
+// parentUID, from the topmost one, followed by slug and joined with "/", such
+// as "cncf/kubernetes". The project itself need not be indexed. Ancestors that
+// are not indexed end the path, as does a cycle.
+func (h *ProjectHierarchy) SlugPath(slug, parentUID string) string {
+	slugs := []string{slug}
+	for _, node := range h.ancestors(parentUID) {
+		slugs = append(slugs, node.slug)
+	}
+	slices.Reverse(slugs)
+	return strings.Join(slugs, "/")
+}
+
+// Depth returns the level of the project with parentUID in the hierarchy, a
+// root project being level 1, counting the ancestors found as SlugPath does.
+func (h *ProjectHierarchy) Depth(parentUID string) int {
+	return len(h.ancestors(parentUID)) + 1
+}
+
+// ancestors returns the indexed ancestors of a project, starting with its
+// parent parentUID.
+func (h *ProjectHierarchy) ancestors(parentUID string) []hierarchyNode {
+	var ancestors []hierarchyNode
+	seen := make(map[string]bool)
+	for uid := parentUID; uid != "" && !seen[uid]; {
+		node, ok := h.nodes[uid]
+		if !ok {
+			break
+		}
+		seen[uid] = true
+		ancestors = append(ancestors, node)
+		uid = node.parentUID
+	}
+	return ancestors
+}
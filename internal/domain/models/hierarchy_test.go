@@ -0,0 +1,44 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectHierarchy(t *testing.T) {
+	hierarchy := NewProjectHierarchy()
+	for _, project := range []*ProjectBase{
+		{UID: "lf", Slug: "lf"},
+		{UID: "cncf", Slug: "cncf", ParentUID: "lf"},
+		{UID: "k8s", Slug: "kubernetes", ParentUID: "cncf"},
+		{UID: "prom", Slug: "prometheus", ParentUID: "cncf"},
+		{UID: "orphan", Slug: "orphan", ParentUID: "missing"},
+		{UID: "loop-a", Slug: "loop-a", ParentUID: "loop-b"},
+		{UID: "loop-b", Slug: "loop-b", ParentUID: "loop-a"},
+	} {
+		hierarchy.Add(project)
+	}
+
+	assert.True(t, hierarchy.Has("cncf"))
+	assert.False(t, hierarchy.Has("missing"))
+
+	assert.Equal(t, "lf", hierarchy.SlugPath("lf", ""))
+	assert.Equal(t, "lf/cncf/kubernetes", hierarchy.SlugPath("kubernetes", "cncf"))
+	assert.Equal(t, "orphan", hierarchy.SlugPath("orphan", "missing"), "an ancestor that is not indexed ends the path")
+	assert.Equal(t, "loop-a/loop-b/loop-a", hierarchy.SlugPath("loop-a", "loop-b"), "a cycle ends the path")
+	assert.Equal(t, "lf/cncf/new-project", hierarchy.SlugPath("new-project", "cncf"), "the project need not be indexed")
+
+	assert.Equal(t, 1, hierarchy.Depth(""))
+	assert.Equal(t, 3, hierarchy.Depth("cncf"))
+	assert.Equal(t, 1, hierarchy.Depth("missing"))
+	assert.Equal(t, 3, hierarchy.Depth("loop-b"))
+
+	assert.Equal(t, 1, hierarchy.ChildCount("lf"))
+	assert.Equal(t, 2, hierarchy.ChildCount("cncf"))
+	assert.Equal(t, 0, hierarchy.ChildCount("k8s"))
+	assert.Equal(t, 1, hierarchy.ChildCount("missing"))
+}
@@ -0,0 +1,87 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import "time"
+
+// ProjectRevisionKind is what a ProjectRevision is a revision of.
+type ProjectRevisionKind string
+
+const (
+	// ProjectRevisionBase is a revision of the project base.
+	ProjectRevisionBase ProjectRevisionKind = "project"
+	// ProjectRevisionSettings is a revision of the project settings.
+	ProjectRevisionSettings ProjectRevisionKind = "settings"
+)
+
+// ProjectRevision is one stored revision of a project's base or settings, as
+// kept in the history of the project store.
+type ProjectRevision struct {
+	Kind ProjectRevisionKind
+	// Revision is the store revision of the write.
+	Revision uint64
+	// Created is when the revision was written.
+	Created time.Time
+	// Deleted is true for the revision that deleted the base or the settings.
+	Deleted bool
+	// Project is the project base written, for a revision of the base.
+	Project *ProjectBase
+	// Settings are the settings written, for a revision of the settings.
+	Settings *ProjectSettings
+}
+
+// ProjectHistoryEvent is a project lifecycle event reconstructed from a
+// revision of the project's history.
+type ProjectHistoryEvent struct {
+	// ID identifies the event, and is the same every time the event is
+	// reconstructed from the same revision.
+	ID         string
+	Type       string
+	OccurredAt time.Time
+	ProjectUID string
+	// Revision is the store revision of the write the event stands for.
+	Revision         uint64
+	Project          *ProjectBase
+	PreviousProject  *ProjectBase
+	Settings         *ProjectSettings
+	PreviousSettings *ProjectSettings
+}
+
+// KV entry operations.
+const (
+	KVOperationPut    = "put"
+	KVOperationDelete = "delete"
+	KVOperationPurge  = "purge"
+)
+
+// KVEntry is one revision of a key of a KV bucket, as stored.
+type KVEntry struct {
+	Revision uint64
+	Created  time.Time
+	// Operation is one of the KVOperation* operations; only put entries have
+	// a value.
+	Operation string
+	// Value is the document written, decompressed but otherwise as stored.
+	Value []byte
+}
+
+// KVKeyInspection is the current entry and the retained history of one key of
+// a KV bucket.
+type KVKeyInspection struct {
+	Bucket string
+	Key    string
+	// Current is the latest entry of the key, which may be a delete marker, or
+	// nil when the key has no entry.
+	Current *KVEntry
+	// History is the retained entries of the key, oldest first, the current
+	// one included.
+	History []KVEntry
+}
+
+// ProjectKVInspection is what is stored for a project's base and settings.
+type ProjectKVInspection struct {
+	ProjectUID string
+	Base       KVKeyInspection
+	Settings   KVKeyInspection
+}
@@ -0,0 +1,26 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+// ProjectLegalLineage is the chain of legal parents of a project, for
+// compliance reporting.
+type ProjectLegalLineage struct {
+	ProjectUID string `json:"project_uid"`
+	// LegalParents holds the legal parent of the project first, then its legal
+	// parent, and so on.
+	LegalParents []LegalLineageProject `json:"legal_parents"`
+	// Complete reports whether the chain ends at a project without a legal
+	// parent, rather than at a legal parent that no longer exists or one
+	// already in the chain.
+	Complete bool `json:"complete"`
+}
+
+// LegalLineageProject is a legal parent in a ProjectLegalLineage.
+type LegalLineageProject struct {
+	UID             string `json:"uid"`
+	Slug            string `json:"slug"`
+	Name            string `json:"name"`
+	LegalEntityType string `json:"legal_entity_type,omitempty"`
+	LegalEntityName string `json:"legal_entity_name,omitempty"`
+}
@@ -0,0 +1,17 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+const (
+	// MaxLogoFileSize is the maximum allowed file size for logo uploads (2MB).
+	MaxLogoFileSize = 2 * 1024 * 1024
+	// MinLogoDimension and MaxLogoDimension bound the width and height, in
+	// pixels, of an uploaded PNG logo.
+	MinLogoDimension = 32
+	MaxLogoDimension = 4096
+
+	// LogoContentTypeSVG and LogoContentTypePNG are the logo formats accepted for upload.
+	LogoContentTypeSVG = "image/svg+xml"
+	LogoContentTypePNG = "image/png"
+)
@@ -0,0 +1,89 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import "slices"
+
+// ProjectMemberRole is a user list in a project's settings whose members can
+// be added and removed one at a time. Roles are named after the OpenFGA
+// relation they grant on the project.
+type ProjectMemberRole string
+
+// Project member roles.
+const (
+	ProjectMemberRoleWriter             ProjectMemberRole = "writer"
+	ProjectMemberRoleAuditor            ProjectMemberRole = "auditor"
+	ProjectMemberRoleMeetingCoordinator ProjectMemberRole = "meeting_coordinator"
+)
+
+// ProjectMemberRoles lists the project member roles in settings order.
+var ProjectMemberRoles = []ProjectMemberRole{
+	ProjectMemberRoleWriter,
+	ProjectMemberRoleAuditor,
+	ProjectMemberRoleMeetingCoordinator,
+}
+
+// IsValid reports whether r is a known project member role.
+func (r ProjectMemberRole) IsValid() bool {
+	return slices.Contains(ProjectMemberRoles, r)
+}
+
+// Members returns the settings list that holds the users with role, or nil for
+// an unknown role.
+func (s *ProjectSettings) Members(role ProjectMemberRole) *[]UserInfo {
+	switch role {
+	case ProjectMemberRoleWriter:
+		return &s.Writers
+	case ProjectMemberRoleAuditor:
+		return &s.Auditors
+	case ProjectMemberRoleMeetingCoordinator:
+		return &s.MeetingCoordinators
+	}
+	return nil
+}
+
+// UserProject is a project in which a user holds one or more member roles.
+type UserProject struct {
+	ProjectUID string              `json:"project_uid"`
+	Slug       string              `json:"slug"`
+	Name       string              `json:"name"`
+	Roles      []ProjectMemberRole `json:"roles"`
+}
+
+// ProjectMembersChange lists the users to add to and remove from the writers
+// and auditors of one project in a bulk member update.
+type ProjectMembersChange struct {
+	ProjectUID     string
+	WritersAdd     []string
+	WritersRemove  []string
+	AuditorsAdd    []string
+	AuditorsRemove []string
+}
+
+// Outcomes of a ProjectMembersChange.
+const (
+	ProjectMembersChangeUpdated   = "updated"
+	ProjectMembersChangeUnchanged = "unchanged"
+	ProjectMembersChangeNotFound  = "not_found"
+	ProjectMembersChangeFailed    = "failed"
+)
+
+// ProjectMembersChangeResult is the outcome of one ProjectMembersChange.
+type ProjectMembersChangeResult struct {
+	ProjectUID string
+	// Status is one of the ProjectMembersChange* outcomes.
+	Status string
+	// Error describes why the change failed, when Status is ProjectMembersChangeFailed.
+	Error string
+}
+
+// UserAccessRemoval is the outcome of removing a user from the member roles of
+// one project.
+type UserAccessRemoval struct {
+	UserProject
+	// Status is one of the ProjectMembersChange* outcomes.
+	Status string
+	// Error describes why the removal failed, when Status is ProjectMembersChangeFailed.
+	Error string
+}
@@ -0,0 +1,49 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// OutboxMessageKind identifies which message builder method publishes an outbox message.
+type OutboxMessageKind string
+
+// Outbox message kinds.
+const (
+	// OutboxKindIndexer messages are sent with MessageBuilder.SendIndexerMessage.
+	OutboxKindIndexer OutboxMessageKind = "indexer"
+	// OutboxKindAccess messages are sent with MessageBuilder.SendAccessMessage.
+	OutboxKindAccess OutboxMessageKind = "access"
+	// OutboxKindEvent messages are sent with MessageBuilder.SendProjectEventMessage.
+	OutboxKindEvent OutboxMessageKind = "event"
+)
+
+// OutboxMessage is an outbound NATS message recorded after a successful write
+// and kept until it has been published, so that a failed publish can be retried
+// instead of leaving the indexer or FGA sync out of date.
+type OutboxMessage struct {
+	ID         string            `json:"id"`
+	ProjectUID string            `json:"project_uid"`
+	Kind       OutboxMessageKind `json:"kind"`
+	Subject    string            `json:"subject"`
+	Payload    json.RawMessage   `json:"payload"`
+	// Principal is the user the message is sent on behalf of. The caller's
+	// bearer token is intentionally not persisted.
+	Principal     string    `json:"principal,omitempty"`
+	Attempts      int       `json:"attempts"`
+	LastError     string    `json:"last_error,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	// Revision is the revision the message was stored or read at. A message is
+	// only updated or removed at that revision, so that a replica settling it
+	// late does not undo another replica's work.
+	Revision uint64 `json:"-"`
+}
+
+// Due reports whether the message should be attempted at now.
+func (m *OutboxMessage) Due(now time.Time) bool {
+	return !m.NextAttemptAt.After(now)
+}
@@ -5,6 +5,7 @@ package models
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -29,36 +30,155 @@ type UserInfo struct {
 
 // ProjectBase is the key-value store representation of a project base.
 type ProjectBase struct {
-	UID                        string     `json:"uid"`
-	Slug                       string     `json:"slug"`
-	Name                       string     `json:"name"`
-	Description                string     `json:"description"`
-	Public                     bool       `json:"public"`
-	IsFoundation               bool       `json:"is_foundation"`
-	ParentUID                  string     `json:"parent_uid"`
-	Stage                      string     `json:"stage"`
-	Category                   string     `json:"category"`
-	LegalEntityType            string     `json:"legal_entity_type"`
-	LegalEntityName            string     `json:"legal_entity_name"`
-	LegalParentUID             string     `json:"legal_parent_uid"`
-	Funding                    string     `json:"funding"`
-	FundingModel               []string   `json:"funding_model"`
-	EntityDissolutionDate      *time.Time `json:"entity_dissolution_date"`
-	EntityFormationDocumentURL string     `json:"entity_formation_document_url"`
-	FormationDate              *time.Time `json:"formation_date"`
-	AutojoinEnabled            bool       `json:"autojoin_enabled"`
-	CharterURL                 string     `json:"charter_url"`
-	LogoURL                    string     `json:"logo_url"`
-	WebsiteURL                 string     `json:"website_url"`
-	RepositoryURL              string     `json:"repository_url"`
-	CreatedAt                  *time.Time `json:"created_at"`
-	UpdatedAt                  *time.Time `json:"updated_at"`
+	UID                        string            `json:"uid"`
+	Slug                       string            `json:"slug"`
+	Name                       string            `json:"name"`
+	Description                string            `json:"description"`
+	Public                     bool              `json:"public"`
+	IsFoundation               bool              `json:"is_foundation"`
+	ParentUID                  string            `json:"parent_uid"`
+	Stage                      string            `json:"stage"`
+	Category                   string            `json:"category"`
+	LegalEntityType            string            `json:"legal_entity_type"`
+	LegalEntityName            string            `json:"legal_entity_name"`
+	LegalParentUID             string            `json:"legal_parent_uid"`
+	Funding                    string            `json:"funding"`
+	FundingModel               []string          `json:"funding_model"`
+	EntityDissolutionDate      *time.Time        `json:"entity_dissolution_date"`
+	EntityFormationDocumentURL string            `json:"entity_formation_document_url"`
+	FormationDate              *time.Time        `json:"formation_date"`
+	AutojoinEnabled            bool              `json:"autojoin_enabled"`
+	CharterURL                 string            `json:"charter_url"`
+	LogoURL                    string            `json:"logo_url"`
+	PNGLogoURL                 string            `json:"png_logo_url,omitempty"`
+	WebsiteURL                 string            `json:"website_url"`
+	RepositoryURL              string            `json:"repository_url"`
+	Links                      *ProjectLinks     `json:"links,omitempty"`
+	Annotations                map[string]string `json:"annotations,omitempty"`
+	// NameI18n and DescriptionI18n are the name and description of the
+	// project in other languages, keyed by BCP 47 language tag.
+	NameI18n        map[string]string `json:"name_i18n,omitempty"`
+	DescriptionI18n map[string]string `json:"description_i18n,omitempty"`
+	LegacyID        string            `json:"legacy_id,omitempty"`
+	ProjectTags     []string          `json:"tags,omitempty"`
+	// PreviousNames are the names the project had before it was renamed,
+	// oldest first. Entries are only ever appended.
+	PreviousNames []PreviousName `json:"previous_names,omitempty"`
+	// MergedFrom are the projects that were merged into the project, oldest
+	// first. Their slugs redirect to the project.
+	MergedFrom []MergedProject `json:"merged_from,omitempty"`
+	CreatedAt  *time.Time      `json:"created_at"`
+	UpdatedAt  *time.Time      `json:"updated_at"`
+}
+
+// Statuses of a project's legal entity, computed from its dissolution date.
+const (
+	EntityStatusActive    = "active"
+	EntityStatusDissolved = "dissolved"
+)
+
+// Dissolved reports whether the legal entity of the project is dissolved at
+// now, that is whether its dissolution date is a day before now, in UTC.
+func (p *ProjectBase) Dissolved(now time.Time) bool {
+	if p == nil || p.EntityDissolutionDate == nil {
+		return false
+	}
+	return p.EntityDissolutionDate.UTC().Before(now.UTC().Truncate(24 * time.Hour))
+}
+
+// EntityStatus returns the status of the legal entity of the project at now.
+func (p *ProjectBase) EntityStatus(now time.Time) string {
+	if p.Dissolved(now) {
+		return EntityStatusDissolved
+	}
+	return EntityStatusActive
+}
+
+// Display statuses of a project, computed from its stage and dates.
+const (
+	DisplayStatusActive    = "active"
+	DisplayStatusForming   = "forming"
+	DisplayStatusOnHold    = "on_hold"
+	DisplayStatusUpcoming  = "upcoming"
+	DisplayStatusProspect  = "prospect"
+	DisplayStatusArchived  = "archived"
+	DisplayStatusDissolved = "dissolved"
+)
+
+// DisplayStatus returns the status the project is shown with at now. A
+// dissolved legal entity outranks the stage, and an active project whose
+// formation or announcement date is still ahead is upcoming. settings may be
+// nil.
+func DisplayStatus(p *ProjectBase, settings *ProjectSettings, now time.Time) string {
+	if p.Dissolved(now) {
+		return DisplayStatusDissolved
+	}
+	switch p.Stage {
+	case "Archived":
+		return DisplayStatusArchived
+	case "Prospect":
+		return DisplayStatusProspect
+	case "Formation - On Hold", "Formation - Disengaged":
+		return DisplayStatusOnHold
+	}
+	if strings.HasPrefix(p.Stage, "Formation") {
+		return DisplayStatusForming
+	}
+	today := now.UTC().Truncate(24 * time.Hour)
+	if p.FormationDate != nil && p.FormationDate.UTC().After(today) {
+		return DisplayStatusUpcoming
+	}
+	if settings != nil && settings.AnnouncementDate != nil && settings.AnnouncementDate.UTC().After(today) {
+		return DisplayStatusUpcoming
+	}
+	return DisplayStatusActive
+}
+
+// ProjectLinks are the social links and contact details of a project.
+type ProjectLinks struct {
+	Twitter      string `json:"twitter,omitempty"`
+	LinkedIn     string `json:"linkedin,omitempty"`
+	YouTube      string `json:"youtube,omitempty"`
+	Slack        string `json:"slack,omitempty"`
+	Discord      string `json:"discord,omitempty"`
+	MailingList  string `json:"mailing_list,omitempty"`
+	ContactEmail string `json:"contact_email,omitempty"`
+}
+
+// PreviousName is a name a project had until it was renamed.
+type PreviousName struct {
+	Name      string    `json:"name"`
+	RenamedAt time.Time `json:"renamed_at"`
+}
+
+// MergedProject is a project that was merged into another one and deleted.
+type MergedProject struct {
+	UID      string    `json:"uid"`
+	Slug     string    `json:"slug"`
+	MergedAt time.Time `json:"merged_at"`
+}
+
+// HasMergedSlug reports whether slug is the slug of a project merged into p.
+func (p *ProjectBase) HasMergedSlug(slug string) bool {
+	return slices.ContainsFunc(p.MergedFrom, func(m MergedProject) bool { return m.Slug == slug })
+}
+
+// RecordRename carries the names history of previous, the stored version of
+// p, over to p, and appends the name of previous when p has another name.
+func (p *ProjectBase) RecordRename(previous *ProjectBase, at time.Time) {
+	p.PreviousNames = previous.PreviousNames
+	if previous.Name != "" && p.Name != previous.Name {
+		p.PreviousNames = append(slices.Clip(previous.PreviousNames), PreviousName{Name: previous.Name, RenamedAt: at})
+	}
 }
 
 // ProjectSettings is the key-value store representation of a project settings.
 type ProjectSettings struct {
-	UID                 string     `json:"uid"`
-	MissionStatement    string     `json:"mission_statement"`
+	UID              string `json:"uid"`
+	MissionStatement string `json:"mission_statement"`
+	OverviewHTML     string `json:"overview_html,omitempty"`
+	// OverviewText is the text of the sanitized OverviewHTML, indexed for search.
+	OverviewText        string     `json:"overview_text,omitempty"`
 	AnnouncementDate    *time.Time `json:"announcement_date"`
 	Auditors            []UserInfo `json:"auditors"`
 	Writers             []UserInfo `json:"writers"`
@@ -262,6 +382,10 @@ func (p *ProjectSettings) TagsTemplatized() []string {
 		tags = append(tags, "{{ mission_statement }}")
 	}
 
+	if p.OverviewText != "" {
+		tags = append(tags, "{{ overview_text }}")
+	}
+
 	return tags
 }
 
@@ -0,0 +1,52 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import "strings"
+
+// Fields a ProjectPage can be sorted by.
+const (
+	ProjectSortName      = "name"
+	ProjectSortCreatedAt = "created_at"
+	ProjectSortStage     = "stage"
+)
+
+// projectSortTimeLayout formats creation times with a fixed width, so that
+// they sort in time order as strings.
+const projectSortTimeLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+// ProjectPageRequest selects a page of a list of projects.
+type ProjectPageRequest struct {
+	// Sort is the ProjectSort* field the projects are sorted by.
+	Sort string
+	// Descending reverses the order of the projects.
+	Descending bool
+	// PageSize is the maximum number of projects in the page.
+	PageSize int
+	// PageToken is the NextPageToken of the previous page; empty for the first page.
+	PageToken string
+}
+
+// ProjectPage is a page of a list of projects.
+type ProjectPage struct {
+	Projects []*ProjectBase
+	// NextPageToken selects the next page; empty on the last page.
+	NextPageToken string
+}
+
+// ProjectSortKey returns the string project is sorted by for the ProjectSort*
+// field sort. Projects without a creation time sort first.
+func ProjectSortKey(project *ProjectBase, sort string) string {
+	switch sort {
+	case ProjectSortCreatedAt:
+		if project.CreatedAt == nil {
+			return ""
+		}
+		return project.CreatedAt.UTC().Format(projectSortTimeLayout)
+	case ProjectSortStage:
+		return project.Stage
+	default:
+		return strings.ToLower(project.Name)
+	}
+}
@@ -195,3 +195,93 @@ func TestProjectSettingsTags(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectBaseRecordRename(t *testing.T) {
+	first := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	second := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	stored := &ProjectBase{Name: "First Name"}
+	renamed := &ProjectBase{Name: "Second Name"}
+	renamed.RecordRename(stored, first)
+	assert.Equal(t, []PreviousName{{Name: "First Name", RenamedAt: first}}, renamed.PreviousNames)
+
+	unchanged := &ProjectBase{Name: "Second Name"}
+	unchanged.RecordRename(renamed, second)
+	assert.Equal(t, renamed.PreviousNames, unchanged.PreviousNames, "the history is kept when the name is unchanged")
+
+	renamedAgain := &ProjectBase{Name: "Third Name"}
+	renamedAgain.RecordRename(unchanged, second)
+	assert.Equal(t, []PreviousName{
+		{Name: "First Name", RenamedAt: first},
+		{Name: "Second Name", RenamedAt: second},
+	}, renamedAgain.PreviousNames)
+	assert.Len(t, unchanged.PreviousNames, 1, "the stored history is not changed")
+}
+
+func TestProjectBaseEntityStatus(t *testing.T) {
+	now := time.Date(2026, 5, 10, 9, 30, 0, 0, time.UTC)
+	date := func(year int, month time.Month, day int) *time.Time {
+		d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+
+	tests := []struct {
+		name     string
+		project  *ProjectBase
+		expected string
+	}{
+		{name: "no dissolution date", project: &ProjectBase{}, expected: EntityStatusActive},
+		{name: "dissolution date in the future", project: &ProjectBase{EntityDissolutionDate: date(2026, 6, 1)}, expected: EntityStatusActive},
+		{name: "dissolution date today", project: &ProjectBase{EntityDissolutionDate: date(2026, 5, 10)}, expected: EntityStatusActive},
+		{name: "dissolution date in the past", project: &ProjectBase{EntityDissolutionDate: date(2026, 5, 9)}, expected: EntityStatusDissolved},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.project.EntityStatus(now))
+		})
+	}
+}
+
+func TestDisplayStatus(t *testing.T) {
+	now := time.Date(2026, 5, 10, 9, 30, 0, 0, time.UTC)
+	date := func(year int, month time.Month, day int) *time.Time {
+		d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+
+	tests := []struct {
+		name     string
+		project  *ProjectBase
+		settings *ProjectSettings
+		expected string
+	}{
+		{name: "active", project: &ProjectBase{Stage: "Active", FormationDate: date(2020, 1, 1)}, expected: DisplayStatusActive},
+		{name: "no stage", project: &ProjectBase{}, expected: DisplayStatusActive},
+		{name: "formation stage", project: &ProjectBase{Stage: "Formation - Engaged"}, expected: DisplayStatusForming},
+		{name: "on hold", project: &ProjectBase{Stage: "Formation - On Hold"}, expected: DisplayStatusOnHold},
+		{name: "disengaged", project: &ProjectBase{Stage: "Formation - Disengaged"}, expected: DisplayStatusOnHold},
+		{name: "prospect", project: &ProjectBase{Stage: "Prospect"}, expected: DisplayStatusProspect},
+		{name: "archived", project: &ProjectBase{Stage: "Archived", EntityDissolutionDate: date(2026, 6, 1)}, expected: DisplayStatusArchived},
+		{name: "dissolved outranks the stage", project: &ProjectBase{Stage: "Active", EntityDissolutionDate: date(2026, 5, 1)}, expected: DisplayStatusDissolved},
+		{name: "formation date ahead", project: &ProjectBase{Stage: "Active", FormationDate: date(2026, 6, 1)}, expected: DisplayStatusUpcoming},
+		{
+			name:     "announcement date ahead",
+			project:  &ProjectBase{Stage: "Active"},
+			settings: &ProjectSettings{AnnouncementDate: date(2026, 5, 11)},
+			expected: DisplayStatusUpcoming,
+		},
+		{
+			name:     "announcement date today",
+			project:  &ProjectBase{Stage: "Active"},
+			settings: &ProjectSettings{AnnouncementDate: date(2026, 5, 10)},
+			expected: DisplayStatusActive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DisplayStatus(tt.project, tt.settings, now))
+		})
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+// ProjectSettingsDiff is the field-by-field difference between two revisions
+// of a project's settings.
+type ProjectSettingsDiff struct {
+	ProjectUID   string                  `json:"project_uid"`
+	FromRevision uint64                  `json:"from_revision"`
+	ToRevision   uint64                  `json:"to_revision"`
+	Changes      []ProjectSettingsChange `json:"changes"`
+}
+
+// ProjectSettingsChange is the change to one settings field between two
+// revisions. Text fields, such as mission_statement, report their old and new
+// values in From and To; user fields report the users added and removed.
+type ProjectSettingsChange struct {
+	Field   string     `json:"field"`
+	From    string     `json:"from,omitempty"`
+	To      string     `json:"to,omitempty"`
+	Added   []UserInfo `json:"added,omitempty"`
+	Removed []UserInfo `json:"removed,omitempty"`
+}
@@ -0,0 +1,27 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+// SlugRebuildReport summarizes a rebuild of the slug to UID mappings of the
+// projects from the projects themselves.
+type SlugRebuildReport struct {
+	// Projects is the number of projects scanned.
+	Projects int
+	// CreatedMappings are the slugs whose missing mapping was created.
+	CreatedMappings []string
+	// UpdatedMappings are the slugs whose mapping pointed at a missing project,
+	// or at one with another slug, and now points at the project with the slug.
+	UpdatedMappings []string
+	// ConflictingSlugs are the slugs held by more than one project. Their
+	// mappings are left as they are.
+	ConflictingSlugs []string
+	// DanglingMappings are the slugs whose mapping points at a missing
+	// project, or at one that has since changed its slug, and that no project
+	// holds.
+	DanglingMappings []string
+	// RemovedMappings is the number of dangling mappings removed.
+	RemovedMappings int
+	// Failed is the number of mappings that could not be written or removed.
+	Failed int
+}
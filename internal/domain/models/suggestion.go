@@ -0,0 +1,73 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Ranks of a ProjectSuggestion matching a prefix, best first.
+const (
+	// SuggestionRankExact is a name or slug equal to the prefix.
+	SuggestionRankExact = iota
+	// SuggestionRankStart is a name or slug starting with the prefix.
+	SuggestionRankStart
+	// SuggestionRankWord is a word of the name or slug starting with the prefix.
+	SuggestionRankWord
+)
+
+// ProjectSuggestion is the little of a project that typeahead needs.
+type ProjectSuggestion struct {
+	UID     string
+	Name    string
+	Slug    string
+	LogoURL string
+	Public  bool
+}
+
+// NewProjectSuggestion returns the suggestion for project.
+func NewProjectSuggestion(project *ProjectBase) ProjectSuggestion {
+	return ProjectSuggestion{
+		UID:     project.UID,
+		Name:    project.Name,
+		Slug:    project.Slug,
+		LogoURL: project.LogoURL,
+		Public:  project.Public,
+	}
+}
+
+// Tokens returns the lowercased strings a prefix is matched against: the
+// name, the slug, and each of their words.
+func (s ProjectSuggestion) Tokens() []string {
+	name, slug := strings.ToLower(s.Name), strings.ToLower(s.Slug)
+	tokens := []string{name, slug}
+	isSeparator := func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }
+	for _, word := range strings.FieldsFunc(name+" "+slug, isSeparator) {
+		if word != name && word != slug {
+			tokens = append(tokens, word)
+		}
+	}
+	return tokens
+}
+
+// MatchRank reports whether one of the tokens starts with prefix, which must be
+// lowercase, and returns the SuggestionRank* of the best match.
+func (s ProjectSuggestion) MatchRank(prefix string) (int, bool) {
+	name, slug := strings.ToLower(s.Name), strings.ToLower(s.Slug)
+	switch {
+	case prefix == "":
+		return 0, false
+	case name == prefix || slug == prefix:
+		return SuggestionRankExact, true
+	case strings.HasPrefix(name, prefix) || strings.HasPrefix(slug, prefix):
+		return SuggestionRankStart, true
+	}
+	for _, token := range s.Tokens() {
+		if strings.HasPrefix(token, prefix) {
+			return SuggestionRankWord, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,22 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import "time"
+
+// ProjectTemplate holds the defaults of projects created from it, such as
+// the projects of a foundation's sandbox. Fields left empty set nothing.
+type ProjectTemplate struct {
+	// Name identifies the template, e.g. cncf-sandbox.
+	Name             string     `json:"name"`
+	Description      string     `json:"description,omitempty"`
+	Stage            string     `json:"stage,omitempty"`
+	Category         string     `json:"category,omitempty"`
+	FundingModel     []string   `json:"funding_model,omitempty"`
+	MissionStatement string     `json:"mission_statement,omitempty"`
+	Auditors         []UserInfo `json:"auditors,omitempty"`
+	CreatedBy        string     `json:"created_by,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
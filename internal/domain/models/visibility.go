@@ -0,0 +1,50 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+// ProjectVisibilityImpact reports what flipping a project's public flag would
+// affect, so that admins can review a visibility change before applying it.
+type ProjectVisibilityImpact struct {
+	ProjectUID string `json:"project_uid"`
+	// Public is the project's current visibility, and TargetPublic the one the
+	// report is for: the opposite of Public.
+	Public       bool `json:"public"`
+	TargetPublic bool `json:"target_public"`
+	// Descendants are the projects under the project, which keep their own
+	// public flag when the project's visibility changes.
+	Descendants []VisibilityImpactProject `json:"descendants"`
+	// MismatchedDescendants is the number of descendants whose visibility
+	// would differ from the project's after the change.
+	MismatchedDescendants int `json:"mismatched_descendants"`
+	// ExternalReferences are the project's URL fields that point outside the service.
+	ExternalReferences []ExternalReference `json:"external_references"`
+	// Subscribers counts the resources of other services and the webhooks that
+	// follow the project. It is nil when no subscriber source is configured.
+	Subscribers *VisibilitySubscribers `json:"subscribers,omitempty"`
+}
+
+// VisibilityImpactProject is a project whose visibility is reported in a
+// ProjectVisibilityImpact.
+type VisibilityImpactProject struct {
+	UID       string `json:"uid"`
+	Slug      string `json:"slug"`
+	Name      string `json:"name"`
+	ParentUID string `json:"parent_uid"`
+	Public    bool   `json:"public"`
+}
+
+// ExternalReference is a URL field of a project.
+type ExternalReference struct {
+	Field string `json:"field"`
+	URL   string `json:"url"`
+}
+
+// VisibilitySubscribers counts what follows a project. A count is nil when its
+// source is not configured.
+type VisibilitySubscribers struct {
+	Committees    *int `json:"committees,omitempty"`
+	MailingLists  *int `json:"mailing_lists,omitempty"`
+	MeetingSeries *int `json:"meeting_series,omitempty"`
+	Webhooks      *int `json:"webhooks,omitempty"`
+}
@@ -0,0 +1,82 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package models
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+// MaxWebhookDeliveryAttempts is the number of times a webhook delivery is
+// attempted before it is marked failed.
+const MaxWebhookDeliveryAttempts = 8
+
+// Webhook is an HTTPS endpoint registered by an admin that receives the project
+// lifecycle events of one project or, without a ProjectUID, of every project.
+type Webhook struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+	// ProjectUID limits the webhook to one project's events; empty means all projects.
+	ProjectUID string `json:"project_uid,omitempty"`
+	// Events are the lifecycle event types sent to the webhook, such as
+	// project.created; empty means all of them.
+	Events      []string `json:"events,omitempty"`
+	Description string   `json:"description,omitempty"`
+	// Secret is the key that deliveries are signed with. It is only returned
+	// when the webhook is created.
+	Secret    string    `json:"secret"`
+	CreatedBy string    `json:"created_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Matches reports whether the webhook receives an event of eventType for the project.
+func (w *Webhook) Matches(eventType, projectUID string) bool {
+	if w.ProjectUID != "" && w.ProjectUID != projectUID {
+		return false
+	}
+	return len(w.Events) == 0 || slices.Contains(w.Events, eventType)
+}
+
+// WebhookDeliveryStatus is the state of a webhook delivery.
+type WebhookDeliveryStatus string
+
+// Webhook delivery statuses.
+const (
+	// WebhookDeliveryPending deliveries have not succeeded yet and will be retried.
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliveryDelivered deliveries were accepted by the endpoint with a 2xx response.
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	// WebhookDeliveryFailed deliveries failed MaxWebhookDeliveryAttempts times and are not retried.
+	WebhookDeliveryFailed WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is one lifecycle event sent, or to be sent, to one webhook.
+// Its ID is the ID of the event, so that a replayed event is not delivered twice.
+type WebhookDelivery struct {
+	ID         string                `json:"id"`
+	WebhookID  string                `json:"webhook_id"`
+	EventType  string                `json:"event_type"`
+	ProjectUID string                `json:"project_uid"`
+	Payload    json.RawMessage       `json:"payload"`
+	Status     WebhookDeliveryStatus `json:"status"`
+	Attempts   int                   `json:"attempts"`
+	// ResponseStatus is the HTTP status of the last attempt; zero when no
+	// response was received.
+	ResponseStatus int        `json:"response_status,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	LastAttemptAt  *time.Time `json:"last_attempt_at,omitempty"`
+	NextAttemptAt  time.Time  `json:"next_attempt_at"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+	// Revision is the revision the delivery was stored or read at. A delivery
+	// is only updated at that revision, so that two replicas never both claim
+	// it and an attempt recorded late does not undo another replica's work.
+	Revision uint64 `json:"-"`
+}
+
+// Due reports whether the delivery should be attempted at now.
+func (d *WebhookDelivery) Due(now time.Time) bool {
+	return d.Status == WebhookDeliveryPending && !d.NextAttemptAt.After(now)
+}
@@ -0,0 +1,39 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Project hierarchy policies reported in [PolicyViolationError.Policy].
+const (
+	// PolicyMaxHierarchyDepth limits the number of levels in a project hierarchy.
+	PolicyMaxHierarchyDepth = "max_hierarchy_depth"
+	// PolicyMaxChildProjects limits the number of direct children of a project.
+	PolicyMaxChildProjects = "max_child_projects"
+)
+
+// ErrPolicyViolation is matched by every [PolicyViolationError].
+var ErrPolicyViolation = errors.New("policy violation")
+
+// PolicyViolationError is returned when a write would exceed a configured
+// service policy limit.
+type PolicyViolationError struct {
+	// Policy is the name of the violated policy, one of the Policy* constants.
+	Policy string
+	// Limit is the configured limit of the policy.
+	Limit int
+}
+
+// Error describes the violated policy and its limit.
+func (e *PolicyViolationError) Error() string {
+	return fmt.Sprintf("%s: %s limit of %d exceeded", ErrPolicyViolation, e.Policy, e.Limit)
+}
+
+// Is reports whether target is ErrPolicyViolation.
+func (e *PolicyViolationError) Is(target error) bool {
+	return target == ErrPolicyViolation
+}
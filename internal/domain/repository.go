@@ -5,6 +5,7 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
 )
@@ -21,16 +22,25 @@ type ProjectRepository interface {
 	GetProjectBase(ctx context.Context, projectUID string) (*models.ProjectBase, error)
 	GetProjectBaseWithRevision(ctx context.Context, projectUID string) (*models.ProjectBase, uint64, error)
 	UpdateProjectBase(ctx context.Context, projectBase *models.ProjectBase, revision uint64) error
+	// UpdateProjectBaseWithRetry re-reads the project base and applies modify, retrying on
+	// concurrent writes. Meant for server-initiated updates that carry no client revision.
+	UpdateProjectBaseWithRetry(ctx context.Context, projectUID string, modify func(*models.ProjectBase) error) (*models.ProjectBase, error)
 
 	// Project settings operations
 	GetProjectSettings(ctx context.Context, projectUID string) (*models.ProjectSettings, error)
 	GetProjectSettingsWithRevision(ctx context.Context, projectUID string) (*models.ProjectSettings, uint64, error)
 	UpdateProjectSettings(ctx context.Context, projectSettings *models.ProjectSettings, revision uint64) error
+	// UpdateProjectSettingsWithRetry re-reads the project settings and applies modify, retrying
+	// on concurrent writes. Meant for server-initiated updates that carry no client revision.
+	UpdateProjectSettingsWithRetry(ctx context.Context, projectUID string, modify func(*models.ProjectSettings) error) (*models.ProjectSettings, error)
 
 	// Slug operations
 	GetProjectUIDFromSlug(ctx context.Context, projectSlug string) (string, error)
 	ProjectSlugExists(ctx context.Context, projectSlug string) (bool, error)
 
+	// Legacy ID operations
+	GetProjectUIDFromLegacyID(ctx context.Context, legacyID string) (string, error)
+
 	// Bulk operations
 	ListAllProjects(ctx context.Context) ([]*models.ProjectBase, []*models.ProjectSettings, error)
 	ListAllProjectsBase(ctx context.Context) ([]*models.ProjectBase, error)
@@ -66,3 +76,162 @@ type FolderRepository interface {
 	UniqueFolderName(ctx context.Context, folder *models.ProjectFolder) (string, error)
 	DeleteUniqueFolderName(ctx context.Context, uniqueKey string) error
 }
+
+// CharterRepository defines the interface for storing the version history of project charters.
+type CharterRepository interface {
+	GetProjectCharter(ctx context.Context, projectUID string) (*models.ProjectCharter, error)
+	// AddProjectCharterVersion appends version to the project's charter history,
+	// numbering it after the latest version, and returns the updated history.
+	AddProjectCharterVersion(ctx context.Context, projectUID string, version models.ProjectCharterVersion) (*models.ProjectCharter, error)
+}
+
+// SettingsHistoryRepository defines the interface for reading earlier revisions
+// of project settings. Only repositories that keep a history implement it.
+type SettingsHistoryRepository interface {
+	// GetProjectSettingsAtRevision returns the project's settings as stored at
+	// revision, or ErrSettingsRevisionNotFound when that revision is not a
+	// retained revision of the project's settings.
+	GetProjectSettingsAtRevision(ctx context.Context, projectUID string, revision uint64) (*models.ProjectSettings, error)
+}
+
+// ProjectHistoryRepository defines the interface for reading the stored
+// revisions of a project. Only repositories that keep a history implement it.
+type ProjectHistoryRepository interface {
+	// GetProjectHistory returns the retained revisions of the project's base
+	// and settings, oldest first, or ErrProjectNotFound when there are none.
+	GetProjectHistory(ctx context.Context, projectUID string) ([]models.ProjectRevision, error)
+}
+
+// KVInspector defines the interface for reading the entries stored for a
+// project as they are, for operators debugging stale documents. Only
+// repositories that store projects in NATS KV buckets implement it.
+type KVInspector interface {
+	// InspectProjectKV returns the current entry and the retained history of
+	// the project's base and settings keys, or ErrProjectNotFound when neither
+	// key has any.
+	InspectProjectKV(ctx context.Context, projectUID string) (*models.ProjectKVInspection, error)
+}
+
+// ProjectStreamer defines the interface for visiting every project without
+// first loading them all into memory. Only repositories that can read the
+// projects as they go implement it.
+type ProjectStreamer interface {
+	// StreamProjectsBase calls fn with each project base, in no particular
+	// order, from the calling goroutine. It stops at and returns the first
+	// error of the store or of fn.
+	StreamProjectsBase(ctx context.Context, fn func(*models.ProjectBase) error) error
+	// StreamProjectsSettings calls fn with the settings of each project, like
+	// StreamProjectsBase.
+	StreamProjectsSettings(ctx context.Context, fn func(*models.ProjectSettings) error) error
+}
+
+// SlugMappingRepository defines the interface for rebuilding the slug to UID
+// mappings of projects and for redirecting the slugs of merged projects. Only
+// repositories that store the mappings apart from the projects implement it.
+type SlugMappingRepository interface {
+	// RebuildSlugMappings points the mapping of every project's slug at the
+	// project, and reports the mappings no project holds, removing them when
+	// removeDangling is set.
+	RebuildSlugMappings(ctx context.Context, removeDangling bool) (*models.SlugRebuildReport, error)
+	// PutSlugRedirect maps slug, the slug of a project merged into the project
+	// projectUID, to projectUID. A slug mapped to another project fails with
+	// ErrProjectSlugExists.
+	PutSlugRedirect(ctx context.Context, slug, projectUID string) error
+}
+
+// ConsistencyChecker defines the interface for scanning the project store for
+// inconsistencies, such as mappings, settings or children whose project is
+// missing, and entries that cannot be decoded.
+type ConsistencyChecker interface {
+	// CheckConsistency reports the inconsistencies found, repairing those it
+	// can when repair is set.
+	CheckConsistency(ctx context.Context, repair bool) (*models.ConsistencyReport, error)
+}
+
+// AssociationRepository defines the interface for storing a project's references
+// to committees, mailing lists and meeting series.
+type AssociationRepository interface {
+	// GetProjectAssociations returns the project's associations, which are
+	// empty when none were stored.
+	GetProjectAssociations(ctx context.Context, projectUID string) (*models.ProjectAssociations, error)
+	PutProjectAssociations(ctx context.Context, associations *models.ProjectAssociations) error
+}
+
+// OutboxRepository defines the interface for storing outbound messages until they are published.
+type OutboxRepository interface {
+	EnqueueOutboxMessages(ctx context.Context, messages []*models.OutboxMessage) error
+	ListOutboxMessages(ctx context.Context) ([]*models.OutboxMessage, error)
+	// UpdateOutboxMessage and DeleteOutboxMessage only change the message at
+	// its Revision, returning ErrRevisionMismatch when it was changed or
+	// removed since.
+	UpdateOutboxMessage(ctx context.Context, message *models.OutboxMessage) error
+	DeleteOutboxMessage(ctx context.Context, message *models.OutboxMessage) error
+}
+
+// DeadLetterRepository defines the interface for storing failed inbound events until they are replayed.
+type DeadLetterRepository interface {
+	PutDeadLetter(ctx context.Context, deadLetter *models.DeadLetter) error
+	GetDeadLetter(ctx context.Context, deadLetterID string) (*models.DeadLetter, error)
+	ListDeadLetters(ctx context.Context) ([]*models.DeadLetter, error)
+	DeleteDeadLetter(ctx context.Context, deadLetterID string) error
+}
+
+// WebhookRepository defines the interface for storing webhooks and their deliveries.
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook *models.Webhook) error
+	GetWebhook(ctx context.Context, webhookID string) (*models.Webhook, error)
+	ListWebhooks(ctx context.Context) ([]*models.Webhook, error)
+	// DeleteWebhook removes the webhook and its deliveries.
+	DeleteWebhook(ctx context.Context, webhookID string) error
+
+	// CreateWebhookDelivery stores a new delivery, or returns
+	// ErrWebhookDeliveryExists when the event was already recorded for the webhook.
+	CreateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+	// UpdateWebhookDelivery overwrites a delivery at its revision, or returns
+	// ErrRevisionMismatch when it was changed or removed since it was read.
+	UpdateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+	// ListWebhookDeliveries returns the deliveries of one webhook, or of every
+	// webhook when webhookID is empty, newest first.
+	ListWebhookDeliveries(ctx context.Context, webhookID string) ([]*models.WebhookDelivery, error)
+}
+
+// AnnouncementRepository defines the interface for recording the project
+// announcements that were published.
+type AnnouncementRepository interface {
+	// CreateProjectAnnouncement records a published announcement, or returns
+	// ErrAnnouncementExists when it was already recorded for the project and date.
+	CreateProjectAnnouncement(ctx context.Context, announcement *models.ProjectAnnouncement) error
+	ListProjectAnnouncements(ctx context.Context) ([]*models.ProjectAnnouncement, error)
+}
+
+// ProjectTemplateRepository defines the interface for storing the templates
+// that new projects can be created from.
+type ProjectTemplateRepository interface {
+	// CreateProjectTemplate stores a new template, or returns
+	// ErrProjectTemplateExists when a template with the same name exists.
+	CreateProjectTemplate(ctx context.Context, template *models.ProjectTemplate) error
+	GetProjectTemplate(ctx context.Context, name string) (*models.ProjectTemplate, error)
+	ListProjectTemplates(ctx context.Context) ([]*models.ProjectTemplate, error)
+	// UpdateProjectTemplate replaces a stored template, or returns
+	// ErrProjectTemplateNotFound when there is none with its name.
+	UpdateProjectTemplate(ctx context.Context, template *models.ProjectTemplate) error
+	DeleteProjectTemplate(ctx context.Context, name string) error
+}
+
+// ProjectEnumsRepository defines the interface for storing the values allowed
+// for the enumerated project fields.
+type ProjectEnumsRepository interface {
+	// GetProjectEnums returns the stored values, or ErrProjectEnumsNotFound
+	// when none were stored.
+	GetProjectEnums(ctx context.Context) (*models.ProjectEnums, error)
+	PutProjectEnums(ctx context.Context, enums *models.ProjectEnums) error
+}
+
+// ProjectDeletionRepository defines the interface for storing the tombstones
+// of deleted projects. Tombstones expire after the store's retention.
+type ProjectDeletionRepository interface {
+	PutProjectDeletion(ctx context.Context, deletion *models.ProjectDeletion) error
+	// ListProjectDeletions returns the tombstones of the projects deleted at or
+	// after since, oldest first.
+	ListProjectDeletions(ctx context.Context, since time.Time) ([]*models.ProjectDeletion, error)
+}
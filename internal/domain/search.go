@@ -0,0 +1,27 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"context"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+// ProjectSearcher runs full-text project searches against the search index that
+// the indexer keeps up to date from the service's indexer messages.
+type ProjectSearcher interface {
+	// SearchProjects returns the UIDs of at most limit projects matching query,
+	// best match first.
+	SearchProjects(ctx context.Context, query string, limit int) ([]string, error)
+}
+
+// ProjectSuggester finds the projects a typeahead prefix may refer to without
+// reading every project from the store.
+type ProjectSuggester interface {
+	// SuggestProjects returns, in no particular order, the projects that
+	// [models.ProjectSuggestion.MatchRank] matches with the lowercase prefix.
+	// It returns ErrServiceUnavailable when the suggestions are not available.
+	SuggestProjects(ctx context.Context, prefix string) ([]models.ProjectSuggestion, error)
+}
@@ -0,0 +1,39 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrSettingsMergeConflict is matched by every [SettingsMergeConflictError].
+var ErrSettingsMergeConflict = errors.New("settings update conflicts with a concurrent update")
+
+// SettingsMergeConflict is a settings field that a merged update and a
+// concurrent update both changed, to different values.
+type SettingsMergeConflict struct {
+	// Field is the JSON name of the settings field, such as "writers".
+	Field string
+	// Users identifies, by username or else email, the users of a role list
+	// that both updates changed differently. It is empty for other fields.
+	Users []string
+}
+
+// SettingsMergeConflictError is returned when a settings update asked to be
+// merged with the changes made since its If-Match revision, and both changed
+// the same fields.
+type SettingsMergeConflictError struct {
+	Conflicts []SettingsMergeConflict
+}
+
+// Error describes the number of conflicting fields.
+func (e *SettingsMergeConflictError) Error() string {
+	return fmt.Sprintf("%s: %d conflicting field(s)", ErrSettingsMergeConflict, len(e.Conflicts))
+}
+
+// Is reports whether target is ErrSettingsMergeConflict.
+func (e *SettingsMergeConflictError) Is(target error) bool {
+	return target == ErrSettingsMergeConflict
+}
@@ -0,0 +1,82 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import "strings"
+
+// Field error codes reported in [FieldError.Code].
+const (
+	// FieldErrorRequired is reported when a required field is missing or empty.
+	FieldErrorRequired = "required"
+	// FieldErrorInvalid is reported when a field does not have a valid format.
+	FieldErrorInvalid = "invalid"
+	// FieldErrorTooLarge is reported when a field exceeds its size limit.
+	FieldErrorTooLarge = "too_large"
+	// FieldErrorNotFound is reported when a field references a resource that does not exist.
+	FieldErrorNotFound = "not_found"
+	// FieldErrorForbidden is reported when the principal may not change a field.
+	FieldErrorForbidden = "forbidden"
+)
+
+// FieldError describes why a single request field failed validation.
+type FieldError struct {
+	// Path is the name of the field in the request body, e.g. "parent_uid".
+	Path string
+	// Code is a machine-readable reason, one of the FieldError* constants.
+	Code string
+	// Message is a human-readable description of the failure.
+	Message string
+	// Err is the domain error behind the failure, if any.
+	Err error
+}
+
+// ValidationError accumulates the field errors found while validating a
+// request. It matches [ErrValidationFailed] and the Err of each of its fields
+// with errors.Is.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// Add records a field error. The message defaults to the text of err.
+func (e *ValidationError) Add(path, code string, err error) {
+	e.Fields = append(e.Fields, FieldError{Path: path, Code: code, Message: err.Error(), Err: err})
+}
+
+// Err returns e if any field error was recorded, and nil otherwise.
+func (e *ValidationError) Err() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Error joins the messages of the field errors.
+func (e *ValidationError) Error() string {
+	if len(e.Fields) == 0 {
+		return ErrValidationFailed.Error()
+	}
+	messages := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		messages = append(messages, field.Message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap returns ErrValidationFailed followed by the errors behind the fields.
+func (e *ValidationError) Unwrap() []error {
+	errs := []error{ErrValidationFailed}
+	for _, field := range e.Fields {
+		if field.Err != nil {
+			errs = append(errs, field.Err)
+		}
+	}
+	return errs
+}
+
+// NewFieldValidationError returns a validation error for a single field.
+func NewFieldValidationError(path, code string, err error) *ValidationError {
+	e := &ValidationError{}
+	e.Add(path, code, err)
+	return e
+}
@@ -0,0 +1,26 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidationError(t *testing.T) {
+	validation := &ValidationError{}
+	assert.NoError(t, validation.Err())
+	assert.Equal(t, "validation failed", validation.Error())
+
+	validation.Add("entity_dissolution_date", FieldErrorRequired, ErrArchivedRequiresDissolutionDate)
+	validation.Add("annotations", FieldErrorTooLarge, ErrAnnotationsTooLarge)
+
+	err := validation.Err()
+	assert.Equal(t, ErrArchivedRequiresDissolutionDate.Error()+"; "+ErrAnnotationsTooLarge.Error(), err.Error())
+	assert.ErrorIs(t, err, ErrValidationFailed)
+	assert.ErrorIs(t, err, ErrArchivedRequiresDissolutionDate)
+	assert.ErrorIs(t, err, ErrAnnotationsTooLarge)
+	assert.NotErrorIs(t, err, ErrInvalidParentProject)
+}
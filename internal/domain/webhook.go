@@ -0,0 +1,18 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"context"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+// WebhookSender sends a webhook delivery to the webhook's endpoint.
+type WebhookSender interface {
+	// SendWebhook POSTs the delivery's payload, signed with the webhook's
+	// secret, and returns the HTTP status of the response. A response other
+	// than 2xx is returned as an error along with its status.
+	SendWebhook(ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery) (int, error)
+}
@@ -0,0 +1,109 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package auth
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/auth0/go-jwt-middleware/v2/jwks"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// keySet caches the JWKS fetched from one endpoint. The keys are fetched on
+// first use and then kept fresh by [JWTAuth.StartKeyRefresh], so rotated keys
+// are picked up without a restart and requests do not wait on the endpoint.
+type keySet struct {
+	provider *jwks.Provider
+	jwksURL  string
+	// maxAge is how old the keys may get before a request refetches them
+	// itself, which only happens when the background refresh is not keeping up.
+	maxAge time.Duration
+
+	// refreshMu serializes fetches, so that concurrent requests that find the
+	// keys missing or stale fetch them once.
+	refreshMu sync.Mutex
+	mu        sync.RWMutex
+	keys      interface{}
+	fetchedAt time.Time
+}
+
+func newKeySet(provider *jwks.Provider, jwksURL string, maxAge time.Duration) *keySet {
+	return &keySet{provider: provider, jwksURL: jwksURL, maxAge: maxAge}
+}
+
+// KeyFunc returns the cached keys for the JWT validator. Missing or stale keys
+// are fetched first; if that fails, stale keys are still returned so that an
+// unavailable endpoint does not reject every request.
+func (k *keySet) KeyFunc(ctx context.Context) (interface{}, error) {
+	keys, fresh := k.cached()
+	if fresh {
+		return keys, nil
+	}
+	if _, err := k.refresh(ctx, k.maxAge); err != nil {
+		if keys != nil {
+			slog.WarnContext(ctx, "error refreshing JWKS, using stale keys", constants.ErrKey, err,
+				"jwks_url", k.jwksURL)
+			return keys, nil
+		}
+		return nil, err
+	}
+	keys, _ = k.cached()
+	return keys, nil
+}
+
+// cached returns the cached keys, if any, and whether they are younger than maxAge.
+func (k *keySet) cached() (interface{}, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.keys, k.keys != nil && time.Since(k.fetchedAt) < k.maxAge
+}
+
+// refresh refetches the keys unless they were fetched less than minAge ago,
+// and reports whether they were refetched.
+func (k *keySet) refresh(ctx context.Context, minAge time.Duration) (bool, error) {
+	k.refreshMu.Lock()
+	defer k.refreshMu.Unlock()
+
+	k.mu.RLock()
+	recent := k.keys != nil && time.Since(k.fetchedAt) < minAge
+	k.mu.RUnlock()
+	if recent {
+		return false, nil
+	}
+
+	keys, err := k.provider.KeyFunc(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	k.mu.Lock()
+	k.keys = keys
+	k.fetchedAt = time.Now()
+	k.mu.Unlock()
+	return true, nil
+}
+
+// run refetches the keys every interval until ctx is done. A failed refresh
+// keeps the previous keys.
+func (k *keySet) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			refreshCtx, cancel := context.WithTimeout(ctx, jwksClientTimeout)
+			if _, err := k.refresh(refreshCtx, 0); err != nil {
+				slog.WarnContext(ctx, "error refreshing JWKS", constants.ErrKey, err, "jwks_url", k.jwksURL)
+			}
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,327 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/go-jose/go-jose.v2"
+	"gopkg.in/go-jose/go-jose.v2/jwt"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+)
+
+// testJWKS serves the public keys of its signing keys as a JWKS endpoint.
+type testJWKS struct {
+	server  *httptest.Server
+	fetches atomic.Int32
+
+	mu   sync.Mutex
+	keys map[string]*rsa.PrivateKey
+}
+
+func newTestJWKS(t *testing.T) *testJWKS {
+	t.Helper()
+	j := &testJWKS{keys: map[string]*rsa.PrivateKey{}}
+	j.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		j.fetches.Add(1)
+		j.mu.Lock()
+		defer j.mu.Unlock()
+		set := jose.JSONWebKeySet{}
+		for kid, key := range j.keys {
+			set.Keys = append(set.Keys, jose.JSONWebKey{Key: &key.PublicKey, KeyID: kid, Algorithm: string(jose.PS256), Use: "sig"})
+		}
+		_ = json.NewEncoder(w).Encode(set)
+	}))
+	t.Cleanup(j.server.Close)
+	return j
+}
+
+// rotate adds a new signing key.
+func (j *testJWKS) rotate(t *testing.T, kid string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	j.mu.Lock()
+	j.keys[kid] = key
+	j.mu.Unlock()
+}
+
+// sign returns a token for the principal alice signed with the key kid.
+func (j *testJWKS) sign(t *testing.T, kid, issuer, audience string, expiry time.Time) string {
+	t.Helper()
+	return j.signClaims(t, kid, issuer, audience, expiry, map[string]any{"principal": "alice"})
+}
+
+// signClaims returns a token with the custom claims signed with the key kid.
+func (j *testJWKS) signClaims(t *testing.T, kid, issuer, audience string, expiry time.Time, custom map[string]any) string {
+	t.Helper()
+	j.mu.Lock()
+	key := j.keys[kid]
+	j.mu.Unlock()
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.PS256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", kid),
+	)
+	require.NoError(t, err)
+	token, err := jwt.Signed(signer).Claims(jwt.Claims{
+		Issuer:   issuer,
+		Audience: jwt.Audience{audience},
+		Expiry:   jwt.NewNumericDate(expiry),
+	}).Claims(custom).CompactSerialize()
+	require.NoError(t, err)
+	return token
+}
+
+func TestNewJWTAuth_multipleIssuers(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  JWTAuthConfig
+		issuers []string
+		keySets int
+		wantErr bool
+	}{
+		{
+			name:    "issuers share one JWKS URL",
+			config:  JWTAuthConfig{Issuer: "heimdall, https://idp.example.com/", JWKSURL: "http://heimdall/jwks"},
+			issuers: []string{"heimdall", "https://idp.example.com/"},
+			keySets: 1,
+		},
+		{
+			name:    "one JWKS URL per issuer",
+			config:  JWTAuthConfig{Issuer: "heimdall,https://idp.example.com/", JWKSURL: "http://heimdall/jwks,https://idp.example.com/jwks"},
+			issuers: []string{"heimdall", "https://idp.example.com/"},
+			keySets: 2,
+		},
+		{
+			name:    "JWKS URL count does not match issuers",
+			config:  JWTAuthConfig{Issuer: "a,b,c", JWKSURL: "http://a/jwks,http://b/jwks"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth, err := NewJWTAuth(tt.config)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, auth.issuers, len(tt.issuers))
+			for _, issuer := range tt.issuers {
+				assert.Contains(t, auth.issuers, issuer)
+			}
+			assert.Len(t, auth.keySets, tt.keySets)
+		})
+	}
+}
+
+func TestJWTAuth_ParseClaims_signedTokens(t *testing.T) {
+	heimdall := newTestJWKS(t)
+	heimdall.rotate(t, "k1")
+	idp := newTestJWKS(t)
+	idp.rotate(t, "idp1")
+
+	auth, err := NewJWTAuth(JWTAuthConfig{
+		Issuer:    "heimdall,https://idp.example.com/",
+		JWKSURL:   heimdall.server.URL + "," + idp.server.URL,
+		Audience:  "lfx-v2-project-service,lfx-one",
+		ClockSkew: time.Minute,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	logger := slog.Default()
+	valid := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{
+			name:  "first issuer",
+			token: heimdall.sign(t, "k1", "heimdall", "lfx-v2-project-service", valid),
+		},
+		{
+			name:  "second issuer and audience",
+			token: idp.sign(t, "idp1", "https://idp.example.com/", "lfx-one", valid),
+		},
+		{
+			name:  "expired within clock skew",
+			token: heimdall.sign(t, "k1", "heimdall", "lfx-v2-project-service", time.Now().Add(-30*time.Second)),
+		},
+		{
+			name:    "expired beyond clock skew",
+			token:   heimdall.sign(t, "k1", "heimdall", "lfx-v2-project-service", time.Now().Add(-2*time.Minute)),
+			wantErr: true,
+		},
+		{
+			name:    "unknown issuer",
+			token:   heimdall.sign(t, "k1", "other", "lfx-v2-project-service", valid),
+			wantErr: true,
+		},
+		{
+			name:    "signed with the other issuer's key",
+			token:   idp.sign(t, "idp1", "heimdall", "lfx-v2-project-service", valid),
+			wantErr: true,
+		},
+		{
+			name:    "unknown audience",
+			token:   heimdall.sign(t, "k1", "heimdall", "other", valid),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := auth.ParseClaims(ctx, tt.token, logger)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "alice", claims.Principal)
+		})
+	}
+}
+
+func TestJWTAuth_ParseClaims_keyRotation(t *testing.T) {
+	heimdall := newTestJWKS(t)
+	heimdall.rotate(t, "k1")
+
+	auth, err := NewJWTAuth(JWTAuthConfig{JWKSURL: heimdall.server.URL})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	valid := time.Now().Add(time.Hour)
+
+	_, err = auth.ParseClaims(ctx, heimdall.sign(t, "k1", "heimdall", "lfx-v2-project-service", valid), slog.Default())
+	require.NoError(t, err)
+	_, err = auth.ParseClaims(ctx, heimdall.sign(t, "k1", "heimdall", "lfx-v2-project-service", valid), slog.Default())
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), heimdall.fetches.Load(), "keys are cached")
+
+	// A token signed with a newly rotated key makes the keys be refetched, but
+	// only if they were not fetched moments ago.
+	heimdall.rotate(t, "k2")
+	rotated := heimdall.sign(t, "k2", "heimdall", "lfx-v2-project-service", valid)
+	_, err = auth.ParseClaims(ctx, rotated, slog.Default())
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), heimdall.fetches.Load())
+
+	auth.keySets[0].fetchedAt = time.Now().Add(-minKeyRefreshInterval)
+	_, err = auth.ParseClaims(ctx, rotated, slog.Default())
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), heimdall.fetches.Load())
+}
+
+func TestJWTAuth_StartKeyRefresh(t *testing.T) {
+	heimdall := newTestJWKS(t)
+	heimdall.rotate(t, "k1")
+
+	auth, err := NewJWTAuth(JWTAuthConfig{JWKSURL: heimdall.server.URL, JWKSRefreshInterval: 10 * time.Millisecond})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	auth.StartKeyRefresh(ctx)
+
+	assert.Eventually(t, func() bool { return heimdall.fetches.Load() >= 2 }, time.Second, 5*time.Millisecond)
+	require.NoError(t, auth.HealthCheck(ctx))
+}
+
+func TestJWTAuth_HealthCheck_staleKeys(t *testing.T) {
+	heimdall := newTestJWKS(t)
+	heimdall.rotate(t, "k1")
+
+	auth, err := NewJWTAuth(JWTAuthConfig{JWKSURL: heimdall.server.URL})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, auth.HealthCheck(ctx))
+	require.NoError(t, auth.HealthCheck(ctx))
+	assert.Equal(t, int32(1), heimdall.fetches.Load(), "fresh keys are not refetched")
+
+	// Stale keys are refetched; while the endpoint is down the check fails but
+	// requests keep using the stale keys.
+	heimdall.server.Close()
+	auth.keySets[0].fetchedAt = time.Now().Add(-auth.keySets[0].maxAge)
+	assert.Error(t, auth.HealthCheck(ctx))
+	_, err = auth.ParseClaims(ctx, heimdall.sign(t, "k1", "heimdall", "lfx-v2-project-service", time.Now().Add(time.Hour)), slog.Default())
+	assert.NoError(t, err)
+}
+
+func TestJWTAuth_ParseClaims_machineToMachine(t *testing.T) {
+	heimdall := newTestJWKS(t)
+	heimdall.rotate(t, "k1")
+
+	auth, err := NewJWTAuth(JWTAuthConfig{
+		JWKSURL: heimdall.server.URL,
+		M2MClients: map[string]M2MClient{
+			"abc123": {Principal: "logo-converter", Scopes: []string{"projects:read", "projects:write"}},
+		},
+	})
+	require.NoError(t, err)
+
+	m2mToken := func(clientID, scope string) string {
+		return heimdall.signClaims(t, "k1", "heimdall", "lfx-v2-project-service", time.Now().Add(time.Hour), map[string]any{
+			"gty":   "client-credentials",
+			"azp":   clientID,
+			"sub":   clientID + "@clients",
+			"scope": scope,
+		})
+	}
+
+	tests := []struct {
+		name     string
+		token    string
+		expected *domain.Claims
+		wantErr  bool
+	}{
+		{
+			name:     "configured client",
+			token:    m2mToken("abc123", "projects:write projects:admin"),
+			expected: &domain.Claims{Principal: "logo-converter", Scopes: []string{"projects:write"}},
+		},
+		{
+			name:     "configured client without scopes",
+			token:    m2mToken("abc123", ""),
+			expected: &domain.Claims{Principal: "logo-converter", Scopes: []string{}},
+		},
+		{
+			name:    "unknown client",
+			token:   m2mToken("def456", "projects:write"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := auth.ParseClaims(context.Background(), tt.token, slog.Default())
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, claims)
+		})
+	}
+}
@@ -5,10 +5,14 @@ package auth
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -21,23 +25,53 @@ import (
 
 const (
 	// PS256 is the default for Heimdall's JWT finalizer.
-	signatureAlgorithm = validator.PS256
-	defaultIssuer      = "heimdall"
-	defaultAudience    = "lfx-v2-project-service"
-	defaultJWKSURL     = "http://heimdall:4457/.well-known/jwks"
-	jwksClientTimeout  = 10 * time.Second
+	signatureAlgorithm         = validator.PS256
+	defaultIssuer              = "heimdall"
+	defaultAudience            = "lfx-v2-project-service"
+	defaultJWKSURL             = "http://heimdall:4457/.well-known/jwks"
+	defaultJWKSRefreshInterval = 5 * time.Minute
+	defaultClockSkew           = 5 * time.Second
+	jwksClientTimeout          = 10 * time.Second
+	// minKeyRefreshInterval limits how often a token that fails validation
+	// makes the keys of its issuer be refetched, in case they were rotated.
+	minKeyRefreshInterval = 30 * time.Second
 )
 
 // JWTAuthConfig holds the configuration parameters for JWT authentication.
 type JWTAuthConfig struct {
-	// JWKSURL is the URL to the JSON Web Key Set endpoint
+	// JWKSURL is the URL to the JSON Web Key Set endpoint. It may be a
+	// comma-separated list with one URL per issuer, in the order of Issuer.
 	JWKSURL string
-	// Audience is the intended audience for the JWT token
+	// Audience is the intended audience for the JWT token. It may be a
+	// comma-separated list of accepted audiences.
 	Audience string
+	// Issuer is the expected issuer of the JWT token. It may be a
+	// comma-separated list of accepted issuers.
+	Issuer string
+	// ClockSkew is the tolerance allowed when checking the token's time claims.
+	ClockSkew time.Duration
+	// JWKSRefreshInterval is how often the signing keys are refetched in the
+	// background by [JWTAuth.StartKeyRefresh].
+	JWKSRefreshInterval time.Duration
+	// M2MClients maps the client IDs of machine-to-machine clients allowed to
+	// call the API with client-credentials tokens to their service principals.
+	M2MClients map[string]M2MClient
 	// MockLocalPrincipal is used for local development to bypass JWT validation
 	MockLocalPrincipal string
 }
 
+// M2MClient is the service identity of a machine-to-machine client.
+type M2MClient struct {
+	// Principal is the service principal the client acts as.
+	Principal string `json:"principal"`
+	// Scopes are the API scopes the client may be granted. Scopes in its
+	// tokens that are not listed here are ignored.
+	Scopes []string `json:"scopes"`
+}
+
+// grantTypeClientCredentials is the "gty" claim of Auth0 client-credentials tokens.
+const grantTypeClientCredentials = "client-credentials"
+
 var (
 	// Factory for custom JWT claims target.
 	customClaims = func() validator.CustomClaims {
@@ -48,13 +82,25 @@ var (
 // HeimdallClaims contains extra custom claims we want to parse from the JWT
 // token.
 type HeimdallClaims struct {
-	Principal string `json:"principal"`
-	Email     string `json:"email,omitempty"`
+	Principal string   `json:"principal"`
+	Email     string   `json:"email,omitempty"`
+	Roles     []string `json:"roles,omitempty"`
+	// GrantType, AuthorizedParty and Scope are set in machine-to-machine
+	// tokens, which carry no principal.
+	GrantType       string `json:"gty,omitempty"`
+	AuthorizedParty string `json:"azp,omitempty"`
+	Scope           string `json:"scope,omitempty"`
 }
 
 // Validate provides additional middleware validation of any claims defined in
 // HeimdallClaims.
 func (c *HeimdallClaims) Validate(_ context.Context) error {
+	if c.GrantType == grantTypeClientCredentials {
+		if c.AuthorizedParty == "" {
+			return errors.New("client ID must be provided")
+		}
+		return nil
+	}
 	if c.Principal == "" {
 		return errors.New("principal must be provided")
 	}
@@ -62,8 +108,19 @@ func (c *HeimdallClaims) Validate(_ context.Context) error {
 }
 
 type JWTAuth struct {
+	// issuers maps each accepted issuer to the validator of its tokens.
+	issuers map[string]*issuerValidator
+	// keySets are the distinct key sets used by the issuers.
+	keySets         []*keySet
+	config          JWTAuthConfig
+	audiences       []string
+	refreshInterval time.Duration
+}
+
+// issuerValidator validates the tokens of one issuer against its key set.
+type issuerValidator struct {
 	validator *validator.Validator
-	config    JWTAuthConfig
+	keys      *keySet
 }
 
 // Ensure JWTAuth implements domain.Authenticator interface
@@ -71,70 +128,213 @@ var _ domain.Authenticator = (*JWTAuth)(nil)
 
 func NewJWTAuth(config JWTAuthConfig) (*JWTAuth, error) {
 	// Set up defaults if not provided
-	jwksURLStr := config.JWKSURL
-	if jwksURLStr == "" {
-		jwksURLStr = defaultJWKSURL
+	jwksURLs := splitList(config.JWKSURL)
+	if len(jwksURLs) == 0 {
+		jwksURLs = []string{defaultJWKSURL}
 	}
-	audience := config.Audience
-	if audience == "" {
-		audience = defaultAudience
+	audiences := splitList(config.Audience)
+	if len(audiences) == 0 {
+		audiences = []string{defaultAudience}
 	}
-
-	// Set up Heimdall JWKS key provider.
-	jwksURL, err := url.Parse(jwksURLStr)
-	if err != nil {
-		slog.With(constants.ErrKey, err).Error("invalid JWKS_URL")
-		return nil, err
+	issuers := splitList(config.Issuer)
+	if len(issuers) == 0 {
+		issuers = []string{defaultIssuer}
 	}
-	var issuer *url.URL
-	issuer, err = url.Parse(defaultIssuer)
-	if err != nil {
-		// This shouldn't happen; a bare hostname is a valid URL.
-		slog.Error("unexpected URL parsing of default issuer", constants.ErrKey, err)
+	clockSkew := config.ClockSkew
+	if clockSkew <= 0 {
+		clockSkew = defaultClockSkew
+	}
+	refreshInterval := config.JWKSRefreshInterval
+	if refreshInterval <= 0 {
+		refreshInterval = defaultJWKSRefreshInterval
+	}
+	if len(jwksURLs) != 1 && len(jwksURLs) != len(issuers) {
+		err := fmt.Errorf("got %d JWKS URLs for %d issuers, expected one shared URL or one per issuer", len(jwksURLs), len(issuers))
+		slog.With(constants.ErrKey, err).Error("invalid JWKS_URL")
 		return nil, err
 	}
+
 	otelClient := &http.Client{
 		Transport: otelhttp.NewTransport(http.DefaultTransport),
 		Timeout:   jwksClientTimeout,
 	}
-	provider := jwks.NewCachingProvider(issuer, 5*time.Minute, jwks.WithCustomJWKSURI(jwksURL), jwks.WithCustomClient(otelClient))
 
-	// Set up the JWT validator.
-	jwtValidator, err := validator.New(
-		provider.KeyFunc,
-		signatureAlgorithm,
-		issuer.String(),
-		[]string{audience},
-		validator.WithCustomClaims(customClaims),
-		validator.WithAllowedClockSkew(5*time.Second),
-	)
-	if err != nil {
-		slog.With(constants.ErrKey, err).Error("failed to set up the Heimdall JWT validator")
-		return nil, err
+	j := &JWTAuth{
+		issuers:         make(map[string]*issuerValidator, len(issuers)),
+		config:          config,
+		audiences:       audiences,
+		refreshInterval: refreshInterval,
 	}
+	for i, issuerStr := range issuers {
+		jwksURLStr := jwksURLs[0]
+		if len(jwksURLs) > 1 {
+			jwksURLStr = jwksURLs[i]
+		}
 
-	return &JWTAuth{
-		validator: jwtValidator,
-		config:    config,
-	}, nil
+		// Set up the issuer's JWKS key provider.
+		jwksURL, err := url.Parse(jwksURLStr)
+		if err != nil {
+			slog.With(constants.ErrKey, err).Error("invalid JWKS_URL")
+			return nil, err
+		}
+		issuer, err := url.Parse(issuerStr)
+		if err != nil {
+			slog.With(constants.ErrKey, err).Error("invalid JWT issuer", "issuer", issuerStr)
+			return nil, err
+		}
+		keys := j.keySetFor(jwksURLStr)
+		if keys == nil {
+			provider := jwks.NewProvider(issuer, jwks.WithCustomJWKSURI(jwksURL), jwks.WithCustomClient(otelClient))
+			keys = newKeySet(provider, jwksURLStr, 2*refreshInterval)
+			j.keySets = append(j.keySets, keys)
+		}
+
+		// Set up the JWT validator.
+		jwtValidator, err := validator.New(
+			keys.KeyFunc,
+			signatureAlgorithm,
+			issuer.String(),
+			audiences,
+			validator.WithCustomClaims(customClaims),
+			validator.WithAllowedClockSkew(clockSkew),
+		)
+		if err != nil {
+			slog.With(constants.ErrKey, err).Error("failed to set up the JWT validator", "issuer", issuerStr)
+			return nil, err
+		}
+		j.issuers[issuer.String()] = &issuerValidator{validator: jwtValidator, keys: keys}
+	}
+
+	return j, nil
+}
+
+// keySetFor returns the key set already set up for jwksURL, if any.
+func (j *JWTAuth) keySetFor(jwksURL string) *keySet {
+	for _, keys := range j.keySets {
+		if keys.jwksURL == jwksURL {
+			return keys
+		}
+	}
+	return nil
+}
+
+// splitList splits a comma-separated list, ignoring empty entries.
+func splitList(list string) []string {
+	var values []string
+	for _, value := range strings.Split(list, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// StartKeyRefresh refetches the signing keys of every issuer in the
+// background until ctx is done, so that rotated keys are picked up without a
+// restart.
+func (j *JWTAuth) StartKeyRefresh(ctx context.Context) {
+	if j.config.MockLocalPrincipal != "" {
+		return
+	}
+	for _, keys := range j.keySets {
+		go keys.run(ctx, j.refreshInterval)
+	}
+}
+
+// HealthCheck verifies that signing keys can be obtained for every issuer.
+// Keys are only refetched here when the background refresh has let them go
+// stale, so a successful check means the keys in use are recent.
+func (j *JWTAuth) HealthCheck(ctx context.Context) error {
+	if j.config.MockLocalPrincipal != "" {
+		// JWT validation is disabled, so the JWKS endpoint is not a dependency.
+		return nil
+	}
+	if len(j.keySets) == 0 {
+		return errors.New("JWKS provider is not set up")
+	}
+	for _, keys := range j.keySets {
+		if _, fresh := keys.cached(); fresh {
+			continue
+		}
+		if _, err := keys.refresh(ctx, keys.maxAge); err != nil {
+			return fmt.Errorf("fetching JWKS from %s: %w", keys.jwksURL, err)
+		}
+	}
+	return nil
+}
+
+// validatorFor returns the validator for the issuer of token. The issuer is
+// read without verifying the token, only to pick the validator that verifies
+// it; with a single issuer that validator is always used.
+func (j *JWTAuth) validatorFor(token string) (*issuerValidator, error) {
+	if len(j.issuers) == 1 {
+		for _, v := range j.issuers {
+			return v, nil
+		}
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("could not parse the token")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errors.New("could not parse the token")
+	}
+	var claims struct {
+		Issuer string `json:"iss"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, errors.New("could not parse the token")
+	}
+	v, ok := j.issuers[claims.Issuer]
+	if !ok {
+		return nil, errors.New("token issuer is not accepted")
+	}
+	return v, nil
 }
 
 // ParsePrincipal extracts the principal from the JWT claims.
 func (j *JWTAuth) ParsePrincipal(ctx context.Context, token string, logger *slog.Logger) (string, error) {
+	claims, err := j.ParseClaims(ctx, token, logger)
+	if err != nil {
+		return "", err
+	}
+	return claims.Principal, nil
+}
+
+// ParseClaims validates the JWT and returns the caller's claims.
+func (j *JWTAuth) ParseClaims(ctx context.Context, token string, logger *slog.Logger) (*domain.Claims, error) {
 	// To avoid having to use a valid JWT token for local development, we can use the
 	// MockLocalPrincipal configuration parameter.
 	if j.config.MockLocalPrincipal != "" {
 		logger.InfoContext(ctx, "JWT authentication is disabled, returning mock principal",
 			"principal", j.config.MockLocalPrincipal,
 		)
-		return j.config.MockLocalPrincipal, nil
+		return &domain.Claims{Principal: j.config.MockLocalPrincipal}, nil
+	}
+
+	if len(j.issuers) == 0 {
+		return nil, errors.New("JWT validator is not set up")
 	}
 
-	if j.validator == nil {
-		return "", errors.New("JWT validator is not set up")
+	v, err := j.validatorFor(token)
+	if err != nil {
+		logger.WarnContext(ctx, "authorization failed", constants.ErrKey, err)
+		return nil, err
 	}
 
-	parsedJWT, err := j.validator.ValidateToken(ctx, token)
+	parsedJWT, err := v.validator.ValidateToken(ctx, token)
+	if err != nil {
+		// The token may be signed with a key that was rotated in since the
+		// keys were last fetched, so refetch them and try once more.
+		refreshed, refreshErr := v.keys.refresh(ctx, minKeyRefreshInterval)
+		if refreshErr != nil {
+			logger.WarnContext(ctx, "error refreshing JWKS", constants.ErrKey, refreshErr)
+		}
+		if refreshed {
+			parsedJWT, err = v.validator.ValidateToken(ctx, token)
+		}
+	}
 	if err != nil {
 		// Drop tertiary (and deeper) nested errors for security reasons. This is
 		// using colons as an approximation for error nesting, which may not
@@ -143,8 +343,7 @@ func (j *JWTAuth) ParsePrincipal(ctx context.Context, token string, logger *slog
 		// accurate to error boundaries, but could also expose tertiary errors if
 		// errors are not wrapped with Go 1.13 `%w` semantics.
 		logger.WarnContext(ctx, "authorization failed",
-			"default_audience", defaultAudience,
-			"default_issuer", defaultIssuer,
+			"audiences", j.audiences,
 			constants.ErrKey, err,
 		)
 		errString := err.Error()
@@ -158,25 +357,83 @@ func (j *JWTAuth) ParsePrincipal(ctx context.Context, token string, logger *slog
 				errString = errString[:firstColon+secondColon+1]
 			}
 		}
-		return "", errors.New(errString)
+		return nil, errors.New(errString)
 	}
 
 	claims, ok := parsedJWT.(*validator.ValidatedClaims)
 	if !ok {
 		// This should never happen.
-		return "", errors.New("failed to get validated authorization claims")
+		return nil, errors.New("failed to get validated authorization claims")
 	}
 
 	customClaims, ok := claims.CustomClaims.(*HeimdallClaims)
 	if !ok {
 		// This should never happen.
-		return "", errors.New("failed to get custom authorization claims")
+		return nil, errors.New("failed to get custom authorization claims")
+	}
+
+	if customClaims.GrantType == grantTypeClientCredentials {
+		return j.m2mClaims(ctx, customClaims, logger)
 	}
 
 	logger.DebugContext(ctx, "JWT principal parsed",
 		"principal", customClaims.Principal,
 		"email", customClaims.Email,
+		"roles", customClaims.Roles,
+	)
+
+	return &domain.Claims{
+		Principal: customClaims.Principal,
+		Email:     customClaims.Email,
+		Roles:     customClaims.Roles,
+	}, nil
+}
+
+// m2mClaims maps a machine-to-machine token to the service principal of its
+// client, granting the scopes of the token that the client is configured for.
+func (j *JWTAuth) m2mClaims(ctx context.Context, claims *HeimdallClaims, logger *slog.Logger) (*domain.Claims, error) {
+	client, ok := j.config.M2MClients[claims.AuthorizedParty]
+	if !ok {
+		logger.WarnContext(ctx, "authorization failed: unknown machine-to-machine client",
+			"client_id", claims.AuthorizedParty,
+		)
+		return nil, errors.New("client is not authorized")
+	}
+
+	scopes := []string{}
+	for _, scope := range strings.Fields(claims.Scope) {
+		if slices.Contains(client.Scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	logger.DebugContext(ctx, "JWT machine-to-machine client parsed",
+		"client_id", claims.AuthorizedParty,
+		"principal", client.Principal,
+		"scopes", scopes,
 	)
 
-	return customClaims.Principal, nil
+	return &domain.Claims{
+		Principal: client.Principal,
+		Scopes:    scopes,
+	}, nil
+}
+
+// ParseM2MClients parses the machine-to-machine clients configuration, a JSON
+// object mapping client IDs to an [M2MClient], e.g.
+// {"abc123": {"principal": "logo-converter", "scopes": ["projects:write"]}}.
+func ParseM2MClients(config string) (map[string]M2MClient, error) {
+	if strings.TrimSpace(config) == "" {
+		return nil, nil
+	}
+	var clients map[string]M2MClient
+	if err := json.Unmarshal([]byte(config), &clients); err != nil {
+		return nil, fmt.Errorf("parsing machine-to-machine clients: %w", err)
+	}
+	for clientID, client := range clients {
+		if client.Principal == "" {
+			return nil, fmt.Errorf("machine-to-machine client %s has no principal", clientID)
+		}
+	}
+	return clients, nil
 }
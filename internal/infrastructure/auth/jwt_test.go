@@ -9,6 +9,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
 )
 
 func TestHeimdallClaims_Validate(t *testing.T) {
@@ -39,6 +41,14 @@ func TestHeimdallClaims_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "machine-to-machine claims without principal",
+			claims: HeimdallClaims{
+				GrantType:       "client-credentials",
+				AuthorizedParty: "abc123",
+			},
+			wantErr: false,
+		},
 		{
 			name: "invalid claims with empty principal",
 			claims: HeimdallClaims{
@@ -111,7 +121,7 @@ func TestNewJWTAuth(t *testing.T) {
 			} else {
 				assert.NotNil(t, auth)
 				if auth != nil {
-					assert.NotNil(t, auth.validator)
+					assert.NotEmpty(t, auth.issuers)
 					assert.Equal(t, tt.config, auth.config)
 				}
 			}
@@ -153,7 +163,7 @@ func TestJWTAuth_ParsePrincipal_MockMode(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			auth := &JWTAuth{
-				validator: nil, // Mock mode doesn't use validator
+				issuers: nil, // Mock mode doesn't use validators
 				config: JWTAuthConfig{
 					MockLocalPrincipal: tt.mockLocalPrincipal,
 				},
@@ -172,6 +182,19 @@ func TestJWTAuth_ParsePrincipal_MockMode(t *testing.T) {
 	}
 }
 
+func TestJWTAuth_ParseClaims_MockMode(t *testing.T) {
+	auth := &JWTAuth{
+		config: JWTAuthConfig{
+			MockLocalPrincipal: "test-user-123",
+		},
+	}
+
+	claims, err := auth.ParseClaims(context.Background(), "any-token", slog.Default())
+
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.Claims{Principal: "test-user-123"}, claims)
+}
+
 func TestJWTAuth_ParsePrincipal_ValidationErrors(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -183,8 +206,8 @@ func TestJWTAuth_ParsePrincipal_ValidationErrors(t *testing.T) {
 		{
 			name: "nil validator",
 			auth: &JWTAuth{
-				validator: nil,
-				config:    JWTAuthConfig{}, // No mock principal
+				issuers: nil,
+				config:  JWTAuthConfig{}, // No mock principal
 			},
 			token:     "some-token",
 			wantErr:   true,
@@ -245,7 +268,7 @@ func TestJWTAuth_Integration(t *testing.T) {
 	t.Run("end to end mock authentication", func(t *testing.T) {
 		// Create auth instance with mock config
 		auth := &JWTAuth{
-			validator: nil,
+			issuers: nil,
 			config: JWTAuthConfig{
 				MockLocalPrincipal: "integration-test-user",
 			},
@@ -326,3 +349,46 @@ func TestJWTAuth_ConfigurationHandling(t *testing.T) {
 		})
 	}
 }
+
+func TestParseM2MClients(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		expected map[string]M2MClient
+		wantErr  bool
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name:   "clients",
+			config: `{"abc123": {"principal": "logo-converter", "scopes": ["projects:write"]}}`,
+			expected: map[string]M2MClient{
+				"abc123": {Principal: "logo-converter", Scopes: []string{"projects:write"}},
+			},
+		},
+		{
+			name:    "invalid JSON",
+			config:  `abc123=logo-converter`,
+			wantErr: true,
+		},
+		{
+			name:    "client without principal",
+			config:  `{"abc123": {"scopes": ["projects:write"]}}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clients, err := ParseM2MClients(tt.config)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, clients)
+		})
+	}
+}
@@ -16,9 +16,12 @@ type MockJWTAuth struct {
 	mock.Mock
 }
 
-func (m *MockJWTAuth) ParsePrincipal(ctx context.Context, token string, logger *slog.Logger) (string, error) {
+func (m *MockJWTAuth) ParseClaims(ctx context.Context, token string, logger *slog.Logger) (*domain.Claims, error) {
 	args := m.Called(ctx, token, logger)
-	return args.String(0), args.Error(1)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Claims), args.Error(1)
 }
 
 // Ensure MockJWTAuth implements domain.Authenticator interface
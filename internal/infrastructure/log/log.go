@@ -6,11 +6,16 @@ package log
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 	"log/slog"
 	"os"
+	"strings"
 
 	slogotel "github.com/remychantenay/slog-otel"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/redact"
 )
 
 type ctxKey string
@@ -25,6 +30,77 @@ const (
 	info  = "info"
 )
 
+// LevelAudit is the level of audit records. It is above every level LOG_LEVEL
+// can be set to, so that audit records are always written.
+const LevelAudit = slog.LevelError + 4
+
+// auditKey marks audit records so that they can be routed to the audit log.
+const auditKey = "audit"
+
+// Audit writes an audit record of a change, such as a change of a project's
+// legal fields, with args as its attributes.
+func Audit(ctx context.Context, msg string, args ...any) {
+	slog.Log(ctx, LevelAudit, msg, append([]any{auditKey, true}, args...)...)
+}
+
+// replaceLevel names LevelAudit "AUDIT" in the records.
+func replaceLevel(_ []string, a slog.Attr) slog.Attr {
+	if a.Key == slog.LevelKey {
+		if level, ok := a.Value.Any().(slog.Level); ok && level == LevelAudit {
+			a.Value = slog.StringValue("AUDIT")
+		}
+	}
+	return a
+}
+
+// redactAttr returns a ReplaceAttr function that replaces the level of audit
+// records and redacts the personal data of the other attributes with mode: the
+// email, username, name and principal attributes, and the emails found in
+// any other.
+func redactAttr(mode redact.Mode) func([]string, slog.Attr) slog.Attr {
+	return func(groups []string, a slog.Attr) slog.Attr {
+		a = replaceLevel(groups, a)
+		if !mode.Enabled() {
+			return a
+		}
+		if len(groups) == 0 && (a.Key == slog.LevelKey || a.Key == slog.SourceKey || a.Key == slog.TimeKey) {
+			return a
+		}
+
+		switch a.Value.Kind() {
+		case slog.KindString:
+			switch a.Key {
+			case "email":
+				a.Value = slog.StringValue(mode.Email(a.Value.String()))
+			case "username", "name", "principal":
+				a.Value = slog.StringValue(mode.Name(a.Value.String()))
+			default:
+				a.Value = slog.StringValue(mode.Text(a.Value.String()))
+			}
+		case slog.KindAny:
+			switch v := a.Value.Any().(type) {
+			case error:
+				if text := mode.Text(v.Error()); text != v.Error() {
+					a.Value = slog.StringValue(text)
+				}
+			default:
+				// Structs, such as project settings, are redacted through
+				// their JSON encoding, which the handler writes anyway.
+				data, err := json.Marshal(v)
+				if err != nil || !strings.Contains(string(data), "@") && !strings.Contains(string(data), `"email"`) {
+					return a
+				}
+				var value any
+				if err := json.Unmarshal(data, &value); err != nil {
+					return a
+				}
+				a.Value = slog.AnyValue(mode.Value(value))
+			}
+		}
+		return a
+	}
+}
+
 type contextHandler struct {
 	slog.Handler
 }
@@ -60,6 +136,7 @@ func AppendCtx(parent context.Context, attr slog.Attr) context.Context {
 // InitStructureLogConfig sets the structured log behavior
 func InitStructureLogConfig() slog.Handler {
 	logOptions := &slog.HandlerOptions{}
+	redaction := redact.Mask
 	var h slog.Handler
 
 	configurations := map[string]func(){
@@ -86,11 +163,29 @@ func InitStructureLogConfig() slog.Handler {
 			}
 			logOptions.AddSource = addSourceBool
 		},
+		"options-redactPII": func() {
+			value := os.Getenv("LOG_REDACT_PII")
+			if value == "" {
+				return
+			}
+			mode, err := redact.ParseMode(value)
+			if err != nil {
+				slog.Warn("invalid LOG_REDACT_PII, masking personal data", constants.ErrKey, err)
+				return
+			}
+			redaction = mode
+		},
 	}
 
 	for _, f := range configurations {
 		f()
 	}
+	redact.SetHashKey(os.Getenv("REDACT_PII_HASH_KEY"))
+	if redaction == redact.Hash && !redact.HashKeySet() {
+		slog.Warn("LOG_REDACT_PII is hash but REDACT_PII_HASH_KEY is not set, masking personal data")
+		redaction = redact.Mask
+	}
+	logOptions.ReplaceAttr = redactAttr(redaction)
 
 	h = slog.NewJSONHandler(os.Stdout, logOptions)
 	log.SetFlags(log.Llongfile)
@@ -105,6 +200,7 @@ func InitStructureLogConfig() slog.Handler {
 	slog.Info("log config",
 		"logLevel", logOptions.Level,
 		"addSource", logOptions.AddSource,
+		"redactPII", redaction,
 	)
 
 	return logger
@@ -7,13 +7,17 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"os"
+	"reflect"
 	"testing"
 	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/redact"
 )
 
 func TestAppendCtx(t *testing.T) {
@@ -312,3 +316,118 @@ func (h *testSlogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
 func (h *testSlogHandler) WithGroup(name string) slog.Handler {
 	return h
 }
+
+func TestAudit(t *testing.T) {
+	var buf bytes.Buffer
+	previous := slog.Default()
+	defer slog.SetDefault(previous)
+	slog.SetDefault(slog.New(contextHandler{slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		Level:       slog.LevelError,
+		ReplaceAttr: replaceLevel,
+	})}))
+
+	ctx := AppendCtx(context.Background(), slog.String("request_id", "req-1"))
+	Audit(ctx, "project legal fields changed", "project_uid", "project-1")
+
+	var record map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("expected an audit record above the configured level, got %q: %v", buf.String(), err)
+	}
+	if record["level"] != "AUDIT" {
+		t.Errorf("expected level AUDIT, got %v", record["level"])
+	}
+	if record["audit"] != true {
+		t.Errorf("expected audit to be true, got %v", record["audit"])
+	}
+	if record["project_uid"] != "project-1" || record["request_id"] != "req-1" {
+		t.Errorf("expected the record and context attributes, got %v", record)
+	}
+}
+
+func TestRedactAttr(t *testing.T) {
+	type user struct {
+		Name     string `json:"name"`
+		Email    string `json:"email"`
+		Username string `json:"username"`
+	}
+	tests := []struct {
+		name     string
+		mode     redact.Mode
+		attr     slog.Attr
+		expected any
+	}{
+		{
+			name:     "email attribute",
+			mode:     redact.Mask,
+			attr:     slog.String("email", "jane@example.com"),
+			expected: "j***@example.com",
+		},
+		{
+			name:     "username attribute",
+			mode:     redact.Mask,
+			attr:     slog.String("username", "jane"),
+			expected: "j***",
+		},
+		{
+			name:     "name attribute",
+			mode:     redact.Mask,
+			attr:     slog.String("name", "Jane Doe"),
+			expected: "J***",
+		},
+		{
+			name:     "principal attribute",
+			mode:     redact.Mask,
+			attr:     slog.String("principal", "jane"),
+			expected: "j***",
+		},
+		{
+			name:     "email in a string",
+			mode:     redact.Mask,
+			attr:     slog.String("recipient", "Jane <jane@example.com>"),
+			expected: "Jane <j***@example.com>",
+		},
+		{
+			name:     "email in an error",
+			mode:     redact.Mask,
+			attr:     slog.Any("error", errors.New("no user jane@example.com")),
+			expected: "no user j***@example.com",
+		},
+		{
+			name:     "user in a struct",
+			mode:     redact.Mask,
+			attr:     slog.Any("writers", []user{{Name: "Jane Doe", Email: "jane@example.com", Username: "jane"}}),
+			expected: []any{map[string]any{"name": "J***", "email": "j***@example.com", "username": "jane"}},
+		},
+		{
+			name:     "other attributes are kept",
+			mode:     redact.Mask,
+			attr:     slog.Int("count", 3),
+			expected: float64(3),
+		},
+		{
+			name:     "redaction off",
+			mode:     redact.Off,
+			attr:     slog.String("email", "jane@example.com"),
+			expected: "jane@example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: redactAttr(tt.mode)}))
+			logger.Info("user lookup", tt.attr)
+
+			var record map[string]any
+			if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+				t.Fatalf("expected a JSON record, got %q: %v", buf.String(), err)
+			}
+			if !reflect.DeepEqual(record[tt.attr.Key], tt.expected) {
+				t.Errorf("expected %s to be %v, got %v", tt.attr.Key, tt.expected, record[tt.attr.Key])
+			}
+			if record["msg"] != "user lookup" || record["level"] != "INFO" {
+				t.Errorf("expected the built-in attributes to be kept, got %v", record)
+			}
+		})
+	}
+}
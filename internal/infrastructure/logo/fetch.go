@@ -0,0 +1,91 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package logo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+const defaultFetchTimeout = 15 * time.Second
+
+// ErrNonPublicAddress is returned when a logo URL resolves to a loopback,
+// private or otherwise non-public address.
+var ErrNonPublicAddress = errors.New("logo URL does not resolve to a public address")
+
+// Fetcher downloads logos from their URL. Logo URLs are set by project writers,
+// so by default only public addresses are fetched and the size is capped.
+type Fetcher struct {
+	Client *http.Client
+	// MaxSize is the largest logo, in bytes, that is downloaded.
+	MaxSize int64
+}
+
+// NewFetcher returns a Fetcher that downloads logos of up to maxSize bytes
+// from public http and https URLs.
+func NewFetcher(maxSize int64) *Fetcher {
+	dialer := &net.Dialer{Timeout: 5 * time.Second, Control: publicAddressOnly}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &Fetcher{
+		Client:  &http.Client{Transport: transport, Timeout: defaultFetchTimeout},
+		MaxSize: maxSize,
+	}
+}
+
+// FetchLogo downloads the logo at rawURL.
+func (f *Fetcher) FetchLogo(ctx context.Context, rawURL string) ([]byte, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return nil, fmt.Errorf("unsupported logo URL scheme %q", u.Scheme)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := f.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching logo: unexpected status %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, f.MaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > f.MaxSize {
+		return nil, fmt.Errorf("fetching logo: larger than %d bytes", f.MaxSize)
+	}
+	return data, nil
+}
+
+// publicAddressOnly is a net.Dialer Control function that refuses connections
+// to addresses that are not public, so that a logo URL cannot be used to reach
+// the cluster network.
+func publicAddressOnly(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return fmt.Errorf("%w: %s", ErrNonPublicAddress, host)
+	}
+	return nil
+}
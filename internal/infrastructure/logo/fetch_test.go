@@ -0,0 +1,80 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package logo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetcher_FetchLogo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/logo.svg":
+			_, _ = w.Write([]byte("<svg/>"))
+		case "/large.svg":
+			_, _ = w.Write([]byte(strings.Repeat("x", 64)))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	fetcher := &Fetcher{Client: server.Client(), MaxSize: 32}
+
+	tests := []struct {
+		name     string
+		url      string
+		expected string
+		wantErr  bool
+	}{
+		{name: "downloads the logo", url: server.URL + "/logo.svg", expected: "<svg/>"},
+		{name: "too large", url: server.URL + "/large.svg", wantErr: true},
+		{name: "not found", url: server.URL + "/missing.svg", wantErr: true},
+		{name: "unsupported scheme", url: "file:///etc/passwd", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := fetcher.FetchLogo(context.Background(), tt.url)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, string(data))
+		})
+	}
+}
+
+func TestNewFetcher_refusesNonPublicAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("<svg/>"))
+	}))
+	defer server.Close()
+
+	_, err := NewFetcher(1024).FetchLogo(context.Background(), server.URL+"/logo.svg")
+	assert.ErrorIs(t, err, ErrNonPublicAddress)
+}
+
+func TestPublicAddressOnly(t *testing.T) {
+	for address, public := range map[string]bool{
+		"140.82.112.3:443":   true,
+		"[2606:4700::1]:80":  true,
+		"127.0.0.1:80":       false,
+		"10.0.0.5:443":       false,
+		"169.254.169.254:80": false,
+		"[::1]:443":          false,
+		"[fd00::1]:443":      false,
+	} {
+		err := publicAddressOnly("tcp", address, nil)
+		assert.Equal(t, public, err == nil, address)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package logo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// PNGHeight is the height of PNG logos converted from SVG.
+	PNGHeight = 800
+	// MaxPNGWidth caps the width of PNG logos converted from very wide SVGs.
+	MaxPNGWidth = 4096
+
+	defaultConversionTimeout = 30 * time.Second
+)
+
+// PNGSize returns the size of the PNG rendering of a width by height SVG: it
+// is PNGHeight tall, keeping the aspect ratio, unless that would be wider
+// than MaxPNGWidth.
+func PNGSize(width, height int) (int, int) {
+	if width <= 0 || height <= 0 {
+		return 2 * PNGHeight, PNGHeight
+	}
+	pngWidth := PNGHeight * width / height
+	if pngWidth <= MaxPNGWidth {
+		return max(pngWidth, 1), PNGHeight
+	}
+	return MaxPNGWidth, max(MaxPNGWidth*height/width, 1)
+}
+
+// InkscapeConverter renders SVG images as PNG with the inkscape command.
+type InkscapeConverter struct {
+	// Path is the inkscape executable.
+	Path string
+	// Timeout bounds a single conversion.
+	Timeout time.Duration
+}
+
+// NewInkscapeConverter returns a converter that runs the named inkscape
+// executable, looked up in PATH if it has no directory, or an error if it
+// cannot be found.
+func NewInkscapeConverter(name string) (*InkscapeConverter, error) {
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return nil, err
+	}
+	return &InkscapeConverter{Path: path, Timeout: defaultConversionTimeout}, nil
+}
+
+// ConvertSVGToPNG renders an SVG image as a width by height PNG.
+func (c *InkscapeConverter) ConvertSVGToPNG(ctx context.Context, svg []byte, width, height int) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "logo-")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			slog.WarnContext(ctx, "error removing logo conversion files", "dir", dir, "error", err)
+		}
+	}()
+
+	inputPath := filepath.Join(dir, "logo.svg")
+	outputPath := filepath.Join(dir, "logo.png")
+	if err := os.WriteFile(inputPath, svg, 0o600); err != nil {
+		return nil, err
+	}
+
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	cmd := exec.CommandContext(
+		ctx,
+		c.Path,
+		"--export-type=png",
+		fmt.Sprintf("--export-width=%d", width),
+		fmt.Sprintf("--export-height=%d", height),
+		fmt.Sprintf("--export-filename=%s", outputPath),
+		inputPath)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("inkscape: %w: %s", err, output)
+	}
+	slog.DebugContext(ctx, "converted SVG logo to PNG",
+		"width", width,
+		"height", height,
+		"duration", time.Since(start).String())
+
+	return os.ReadFile(outputPath)
+}
@@ -0,0 +1,91 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+// Package logo fetches and reads project logo images and converts SVG logos
+// to PNG for clients, such as email, that cannot display SVG.
+package logo
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidSVG is returned for data that is not an SVG image with a usable size.
+var ErrInvalidSVG = errors.New("invalid SVG image")
+
+// IsSVGURL reports whether rawURL names an SVG file, judging by the extension
+// of its path.
+func IsSVGURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(path.Ext(u.Path), ".svg")
+}
+
+// SVGSize returns the width and height of an SVG image, taken from the viewBox
+// of its root element or, without one, from its width and height attributes.
+func SVGSize(data []byte) (width, height int, err error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return 0, 0, fmt.Errorf("%w: no root element", ErrInvalidSVG)
+		}
+		if err != nil {
+			return 0, 0, fmt.Errorf("%w: %v", ErrInvalidSVG, err)
+		}
+		root, ok := token.(xml.StartElement)
+		if !ok {
+			// Skip the XML declaration, comments and a DOCTYPE.
+			continue
+		}
+		if root.Name.Local != "svg" {
+			return 0, 0, fmt.Errorf("%w: root element is %q", ErrInvalidSVG, root.Name.Local)
+		}
+		return rootSize(root)
+	}
+}
+
+// rootSize reads the size from the attributes of the root svg element.
+func rootSize(root xml.StartElement) (int, int, error) {
+	var viewBox, widthAttr, heightAttr string
+	for _, attr := range root.Attr {
+		switch attr.Name.Local {
+		case "viewBox":
+			viewBox = attr.Value
+		case "width":
+			widthAttr = attr.Value
+		case "height":
+			heightAttr = attr.Value
+		}
+	}
+
+	if values := strings.FieldsFunc(viewBox, func(r rune) bool { return r == ' ' || r == ',' }); len(values) == 4 {
+		width, wErr := strconv.ParseFloat(values[2], 64)
+		height, hErr := strconv.ParseFloat(values[3], 64)
+		if wErr == nil && hErr == nil && width >= 1 && height >= 1 {
+			return int(width), int(height), nil
+		}
+	}
+
+	width, wErr := parseLength(widthAttr)
+	height, hErr := parseLength(heightAttr)
+	if wErr != nil || hErr != nil || width < 1 || height < 1 {
+		return 0, 0, fmt.Errorf("%w: no viewBox or absolute width and height", ErrInvalidSVG)
+	}
+	return int(width), int(height), nil
+}
+
+// parseLength parses an SVG length in user units or pixels, such as "120" or
+// "120px". Relative units such as percentages are not supported.
+func parseLength(value string) (float64, error) {
+	return strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(value), "px"), 64)
+}
@@ -0,0 +1,102 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package logo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSVGSize(t *testing.T) {
+	tests := []struct {
+		name           string
+		svg            string
+		expectedWidth  int
+		expectedHeight int
+		wantErr        bool
+	}{
+		{
+			name:           "size from viewBox",
+			svg:            `<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 300 150" width="100%"></svg>`,
+			expectedWidth:  300,
+			expectedHeight: 150,
+		},
+		{
+			name:           "comma-separated viewBox",
+			svg:            `<svg viewBox="0,0,64.5,32"></svg>`,
+			expectedWidth:  64,
+			expectedHeight: 32,
+		},
+		{
+			name:           "size from width and height",
+			svg:            `<!-- logo --><svg width="120px" height="40"></svg>`,
+			expectedWidth:  120,
+			expectedHeight: 40,
+		},
+		{
+			name:    "relative size",
+			svg:     `<svg width="100%" height="100%"></svg>`,
+			wantErr: true,
+		},
+		{
+			name:    "not an svg document",
+			svg:     `<html><body></body></html>`,
+			wantErr: true,
+		},
+		{
+			name:    "not xml",
+			svg:     "\x89PNG\r\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			width, height, err := SVGSize([]byte(tt.svg))
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidSVG)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedWidth, width)
+			assert.Equal(t, tt.expectedHeight, height)
+		})
+	}
+}
+
+func TestIsSVGURL(t *testing.T) {
+	for rawURL, expected := range map[string]bool{
+		"https://example.org/logo.svg":     true,
+		"https://example.org/LOGO.SVG?v=2": true,
+		"https://example.org/logo.png":     false,
+		"https://example.org/logo.svg.png": false,
+		"https://example.org/svg/logo":     false,
+		"":                                 false,
+	} {
+		assert.Equal(t, expected, IsSVGURL(rawURL), rawURL)
+	}
+}
+
+func TestPNGSize(t *testing.T) {
+	tests := []struct {
+		name           string
+		width, height  int
+		expectedWidth  int
+		expectedHeight int
+	}{
+		{name: "keeps the aspect ratio", width: 300, height: 150, expectedWidth: 1600, expectedHeight: 800},
+		{name: "small square", width: 24, height: 24, expectedWidth: 800, expectedHeight: 800},
+		{name: "caps very wide logos", width: 1000, height: 100, expectedWidth: 4096, expectedHeight: 409},
+		{name: "unknown size", expectedWidth: 1600, expectedHeight: 800},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			width, height := PNGSize(tt.width, tt.height)
+			assert.Equal(t, tt.expectedWidth, width)
+			assert.Equal(t, tt.expectedHeight, height)
+		})
+	}
+}
@@ -0,0 +1,289 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// ─── Links ───────────────────────────────────────────────────────────────────
+
+// GetLink gets a project link, verifying it belongs to the given project.
+func (r *Repository) GetLink(ctx context.Context, projectUID, linkUID string) (*models.ProjectLink, uint64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.getLink(ctx, projectUID, linkUID)
+}
+
+// getLink is GetLink for callers holding the lock.
+func (r *Repository) getLink(ctx context.Context, projectUID, linkUID string) (*models.ProjectLink, uint64, error) {
+	link, revision, err := decodeEntry[models.ProjectLink](ctx, r.links, linkUID, domain.ErrLinkNotFound)
+	if err != nil {
+		return nil, 0, err
+	}
+	if link.ProjectUID != projectUID {
+		return nil, 0, domain.ErrLinkNotFound
+	}
+	return link, revision, nil
+}
+
+// ListLinks lists all links of a project.
+func (r *Repository) ListLinks(ctx context.Context, projectUID string) ([]*models.ProjectLink, error) {
+	links, err := listAll[models.ProjectLink](ctx, r, r.links)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(links, func(link *models.ProjectLink) bool {
+		return link.ProjectUID != projectUID
+	}), nil
+}
+
+// CreateLink stores a new project link.
+func (r *Repository) CreateLink(ctx context.Context, link *models.ProjectLink) error {
+	data, err := encode(ctx, link)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.put(r.links, link.UID, data)
+	return nil
+}
+
+// DeleteLink deletes a project link if it is at the given revision.
+func (r *Repository) DeleteLink(ctx context.Context, projectUID, linkUID string, revision uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, current, err := r.getLink(ctx, projectUID, linkUID)
+	if err != nil {
+		return err
+	}
+	if current != revision {
+		slog.WarnContext(ctx, "revision mismatch deleting link", "link_uid", linkUID)
+		return domain.ErrRevisionMismatch
+	}
+
+	delete(r.links, linkUID)
+	return nil
+}
+
+// ─── Folders ─────────────────────────────────────────────────────────────────
+
+// GetFolder gets a project folder, verifying it belongs to the given project.
+func (r *Repository) GetFolder(ctx context.Context, projectUID, folderUID string) (*models.ProjectFolder, uint64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.getFolder(ctx, projectUID, folderUID)
+}
+
+// getFolder is GetFolder for callers holding the lock.
+func (r *Repository) getFolder(ctx context.Context, projectUID, folderUID string) (*models.ProjectFolder, uint64, error) {
+	folder, revision, err := decodeEntry[models.ProjectFolder](ctx, r.folders, folderUID, domain.ErrFolderNotFound)
+	if err != nil {
+		return nil, 0, err
+	}
+	if folder.ProjectUID != projectUID {
+		return nil, 0, domain.ErrFolderNotFound
+	}
+	return folder, revision, nil
+}
+
+// CreateFolder stores a new project folder. The caller must first reserve the
+// unique name via UniqueFolderName and release it via DeleteUniqueFolderName
+// on failure.
+func (r *Repository) CreateFolder(ctx context.Context, folder *models.ProjectFolder) error {
+	data, err := encode(ctx, folder)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.put(r.folders, folder.UID, data)
+	return nil
+}
+
+// DeleteFolder deletes a project folder if it is at the given revision, and
+// releases its name.
+func (r *Repository) DeleteFolder(ctx context.Context, projectUID, folderUID string, revision uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	folder, current, err := r.getFolder(ctx, projectUID, folderUID)
+	if err != nil {
+		return err
+	}
+	if current != revision {
+		slog.WarnContext(ctx, "revision mismatch deleting folder", "folder_uid", folderUID)
+		return domain.ErrRevisionMismatch
+	}
+
+	delete(r.folders, folderUID)
+	delete(r.names, fmt.Sprintf(constants.KVLookupFolderPrefix, folder.BuildIndexKey(ctx)))
+	return nil
+}
+
+// UniqueFolderName reserves a per-project folder name and returns its lookup
+// key, or ErrFolderNameExists when it is already taken.
+func (r *Repository) UniqueFolderName(ctx context.Context, folder *models.ProjectFolder) (string, error) {
+	uniqueKey := fmt.Sprintf(constants.KVLookupFolderPrefix, folder.BuildIndexKey(ctx))
+	if !r.reserveName(uniqueKey, folder.UID) {
+		return "", domain.ErrFolderNameExists
+	}
+	return uniqueKey, nil
+}
+
+// DeleteUniqueFolderName releases a reserved folder name.
+func (r *Repository) DeleteUniqueFolderName(_ context.Context, uniqueKey string) error {
+	r.releaseName(uniqueKey)
+	return nil
+}
+
+// ─── Documents ────────────────────────────────────────────────────────────────
+
+// GetDocumentMetadata gets document metadata, verifying it belongs to the given project.
+func (r *Repository) GetDocumentMetadata(ctx context.Context, projectUID, documentUID string) (*models.ProjectDocument, uint64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.getDocumentMetadata(ctx, projectUID, documentUID)
+}
+
+// getDocumentMetadata is GetDocumentMetadata for callers holding the lock.
+func (r *Repository) getDocumentMetadata(ctx context.Context, projectUID, documentUID string) (*models.ProjectDocument, uint64, error) {
+	doc, revision, err := decodeEntry[models.ProjectDocument](ctx, r.documents, documentUID, domain.ErrDocumentNotFound)
+	if err != nil {
+		return nil, 0, err
+	}
+	if doc.ProjectUID != projectUID {
+		return nil, 0, domain.ErrDocumentNotFound
+	}
+	return doc, revision, nil
+}
+
+// GetDocumentFile returns the content of a document.
+func (r *Repository) GetDocumentFile(_ context.Context, documentUID string) ([]byte, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	data, ok := r.documentFiles[documentUID]
+	if !ok {
+		return nil, domain.ErrDocumentNotFound
+	}
+	return slices.Clone(data), nil
+}
+
+// ListDocuments lists the metadata of all documents of a project.
+func (r *Repository) ListDocuments(ctx context.Context, projectUID string) ([]*models.ProjectDocument, error) {
+	docs, err := listAll[models.ProjectDocument](ctx, r, r.documents)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(docs, func(doc *models.ProjectDocument) bool {
+		return doc.ProjectUID != projectUID
+	}), nil
+}
+
+// CreateDocumentMetadata stores document metadata. The caller must first
+// reserve the unique name via UniqueDocumentName and release it via
+// DeleteUniqueDocumentName on failure.
+func (r *Repository) CreateDocumentMetadata(ctx context.Context, doc *models.ProjectDocument) error {
+	data, err := encode(ctx, doc)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.put(r.documents, doc.UID, data)
+	return nil
+}
+
+// PutDocumentFile stores the content of a document.
+func (r *Repository) PutDocumentFile(_ context.Context, documentUID string, fileData []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.documentFiles[documentUID] = slices.Clone(fileData)
+	return nil
+}
+
+// DeleteDocumentMetadata deletes document metadata if it is at the given
+// revision, and releases its name.
+func (r *Repository) DeleteDocumentMetadata(ctx context.Context, projectUID, documentUID string, revision uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	doc, current, err := r.getDocumentMetadata(ctx, projectUID, documentUID)
+	if err != nil {
+		return err
+	}
+	if current != revision {
+		slog.WarnContext(ctx, "revision mismatch deleting document", "document_uid", documentUID)
+		return domain.ErrRevisionMismatch
+	}
+
+	delete(r.documents, documentUID)
+	delete(r.names, fmt.Sprintf(constants.KVLookupDocumentPrefix, doc.BuildIndexKey(ctx)))
+	return nil
+}
+
+// DeleteDocumentFile removes the content of a document.
+func (r *Repository) DeleteDocumentFile(_ context.Context, documentUID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.documentFiles, documentUID)
+	return nil
+}
+
+// UniqueDocumentName reserves a per-project document name and returns its
+// lookup key, or ErrDocumentNameExists when it is already taken.
+func (r *Repository) UniqueDocumentName(ctx context.Context, doc *models.ProjectDocument) (string, error) {
+	uniqueKey := fmt.Sprintf(constants.KVLookupDocumentPrefix, doc.BuildIndexKey(ctx))
+	if !r.reserveName(uniqueKey, doc.UID) {
+		return "", domain.ErrDocumentNameExists
+	}
+	return uniqueKey, nil
+}
+
+// DeleteUniqueDocumentName releases a reserved document name.
+func (r *Repository) DeleteUniqueDocumentName(_ context.Context, uniqueKey string) error {
+	r.releaseName(uniqueKey)
+	return nil
+}
+
+// reserveName maps uniqueKey to uid, reporting false when it is already mapped.
+func (r *Repository) reserveName(uniqueKey, uid string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.names[uniqueKey]; ok {
+		return false
+	}
+	r.names[uniqueKey] = uid
+	return true
+}
+
+// releaseName removes the mapping of uniqueKey.
+func (r *Repository) releaseName(uniqueKey string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.names, uniqueKey)
+}
@@ -0,0 +1,62 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package memory
+
+import (
+	"context"
+	"log/slog"
+
+	emailapi "github.com/linuxfoundation/lfx-v2-email-service/pkg/api"
+	inviteapi "github.com/linuxfoundation/lfx-v2-invite-service/pkg/api"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+)
+
+// MessageBuilder is a [domain.MessageBuilder] for running without NATS. It
+// logs and drops the indexer, access and event messages, which nothing would
+// receive, and fails the requests that need a reply.
+type MessageBuilder struct{}
+
+// SendIndexerMessage drops an indexer message.
+func (MessageBuilder) SendIndexerMessage(ctx context.Context, subject string, _ any, _ bool) error {
+	slog.DebugContext(ctx, "dropping indexer message, running without NATS", "subject", subject)
+	return nil
+}
+
+// SendAccessMessage drops an access control message.
+func (MessageBuilder) SendAccessMessage(ctx context.Context, subject string, _ any, _ bool) error {
+	slog.DebugContext(ctx, "dropping access message, running without NATS", "subject", subject)
+	return nil
+}
+
+// SendProjectEventMessage drops a project event.
+func (MessageBuilder) SendProjectEventMessage(ctx context.Context, subject string, _ any) error {
+	slog.DebugContext(ctx, "dropping project event, running without NATS", "subject", subject)
+	return nil
+}
+
+// SendEmailRequest drops an email request.
+func (MessageBuilder) SendEmailRequest(ctx context.Context, _ emailapi.SendEmailRequest) error {
+	slog.DebugContext(ctx, "dropping email request, running without NATS")
+	return nil
+}
+
+// SendInviteRequest fails, since there is no invite service to return an invite UID.
+func (MessageBuilder) SendInviteRequest(_ context.Context, _ inviteapi.SendInviteRequest) (domain.InviteResult, error) {
+	return domain.InviteResult{}, domain.ErrServiceUnavailable
+}
+
+// UserReader is a [domain.UserReader] for running without NATS, which knows
+// no user.
+type UserReader struct{}
+
+// UserMetadataByPrincipal returns ErrUserNotFound.
+func (UserReader) UserMetadataByPrincipal(_ context.Context, _ string) (*domain.UserMetadata, error) {
+	return nil, domain.ErrUserNotFound
+}
+
+// UsernameByEmail returns ErrUserNotFound.
+func (UserReader) UsernameByEmail(_ context.Context, _ string) (string, error) {
+	return "", domain.ErrUserNotFound
+}
@@ -0,0 +1,470 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+// Package memory implements the project repositories in memory, for tests and
+// for running the service locally without NATS. Nothing survives a restart.
+//
+// Values are stored JSON-encoded, like in the NATS KV buckets, so that callers
+// never share a value with the store. Every write takes the next value of a
+// revision counter, which plays the role of the NATS KV revision for ETag and
+// optimistic concurrency checks.
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// maxUpdateAttempts bounds the number of read-modify-write cycles of the
+// retrying updates before giving up with domain.ErrRevisionMismatch.
+const maxUpdateAttempts = 5
+
+// entry is a stored value and the revision it was written at.
+type entry struct {
+	data     []byte
+	revision uint64
+}
+
+// Repository is a [domain.ProjectRepository], [domain.DocumentRepository],
+// [domain.LinkRepository] and [domain.FolderRepository] backed by maps.
+type Repository struct {
+	mu       sync.RWMutex
+	revision uint64
+
+	projects  map[string]entry
+	settings  map[string]entry
+	slugs     map[string]string
+	legacyIDs map[string]string
+
+	links         map[string]entry
+	folders       map[string]entry
+	documents     map[string]entry
+	documentFiles map[string][]byte
+	// names holds the reserved folder and document names, keyed by lookup key.
+	names map[string]string
+}
+
+// NewRepository creates an empty repository.
+func NewRepository() *Repository {
+	return &Repository{
+		projects:      map[string]entry{},
+		settings:      map[string]entry{},
+		slugs:         map[string]string{},
+		legacyIDs:     map[string]string{},
+		links:         map[string]entry{},
+		folders:       map[string]entry{},
+		documents:     map[string]entry{},
+		documentFiles: map[string][]byte{},
+		names:         map[string]string{},
+	}
+}
+
+// put stores value under key in m at the next revision, and returns that
+// revision. The caller must hold the write lock.
+func (r *Repository) put(m map[string]entry, key string, data []byte) uint64 {
+	r.revision++
+	m[key] = entry{data: data, revision: r.revision}
+	return r.revision
+}
+
+// CreateProject creates a project and its settings.
+func (r *Repository) CreateProject(ctx context.Context, projectBase *models.ProjectBase, projectSettings *models.ProjectSettings) error {
+	baseData, err := encode(ctx, projectBase)
+	if err != nil {
+		return err
+	}
+	var settingsData []byte
+	if projectSettings != nil {
+		if settingsData, err = encode(ctx, projectSettings); err != nil {
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.slugs[projectBase.Slug]; ok {
+		slog.WarnContext(ctx, "project slug already exists", "slug", projectBase.Slug)
+		return domain.ErrProjectSlugExists
+	}
+	if projectBase.LegacyID != "" {
+		if _, ok := r.legacyIDs[projectBase.LegacyID]; ok {
+			slog.WarnContext(ctx, "project legacy ID already exists", "legacy_id", projectBase.LegacyID)
+			return domain.ErrProjectLegacyIDExists
+		}
+		r.legacyIDs[projectBase.LegacyID] = projectBase.UID
+	}
+	r.slugs[projectBase.Slug] = projectBase.UID
+	r.put(r.projects, projectBase.UID, baseData)
+	if settingsData != nil {
+		r.put(r.settings, projectSettings.UID, settingsData)
+	}
+
+	return nil
+}
+
+// ProjectExists checks if a project exists.
+func (r *Repository) ProjectExists(_ context.Context, projectUID string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.projects[projectUID]
+	return ok, nil
+}
+
+// DeleteProject deletes a project, its settings and its mappings if the
+// project is at the given revision.
+func (r *Repository) DeleteProject(ctx context.Context, projectUID string, revision uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	projectBase, current, err := decodeEntry[models.ProjectBase](ctx, r.projects, projectUID, domain.ErrProjectNotFound)
+	if err != nil {
+		return err
+	}
+	if current != revision {
+		slog.WarnContext(ctx, "revision mismatch", "project_uid", projectUID)
+		return domain.ErrRevisionMismatch
+	}
+
+	delete(r.projects, projectUID)
+	delete(r.settings, projectUID)
+	r.deleteSlug(projectBase.Slug, projectUID)
+	for _, merged := range projectBase.MergedFrom {
+		r.deleteSlug(merged.Slug, projectUID)
+	}
+	if projectBase.LegacyID != "" && r.legacyIDs[projectBase.LegacyID] == projectUID {
+		delete(r.legacyIDs, projectBase.LegacyID)
+	}
+
+	return nil
+}
+
+// deleteSlug removes the mapping of slug if it points at projectUID.
+func (r *Repository) deleteSlug(slug, projectUID string) {
+	if r.slugs[slug] == projectUID {
+		delete(r.slugs, slug)
+	}
+}
+
+// GetProjectBase gets the project base.
+func (r *Repository) GetProjectBase(ctx context.Context, projectUID string) (*models.ProjectBase, error) {
+	projectBase, _, err := r.GetProjectBaseWithRevision(ctx, projectUID)
+	return projectBase, err
+}
+
+// GetProjectBaseWithRevision gets the project base along with its revision.
+func (r *Repository) GetProjectBaseWithRevision(ctx context.Context, projectUID string) (*models.ProjectBase, uint64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return decodeEntry[models.ProjectBase](ctx, r.projects, projectUID, domain.ErrProjectNotFound)
+}
+
+// UpdateProjectBase updates a project's base information if it is at the
+// given revision, moving its slug and legacy ID mappings when they change.
+func (r *Repository) UpdateProjectBase(ctx context.Context, projectBase *models.ProjectBase, revision uint64) error {
+	data, err := encode(ctx, projectBase)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, current, err := decodeEntry[models.ProjectBase](ctx, r.projects, projectBase.UID, domain.ErrProjectNotFound)
+	if err != nil {
+		return err
+	}
+	if current != revision {
+		slog.WarnContext(ctx, "revision mismatch", "project_uid", projectBase.UID)
+		return domain.ErrRevisionMismatch
+	}
+
+	if existing.Slug != projectBase.Slug {
+		if uid, ok := r.slugs[projectBase.Slug]; ok && uid != projectBase.UID {
+			slog.WarnContext(ctx, "project slug already exists", "slug", projectBase.Slug)
+			return domain.ErrProjectSlugExists
+		}
+	}
+	if existing.LegacyID != projectBase.LegacyID && projectBase.LegacyID != "" {
+		if _, ok := r.legacyIDs[projectBase.LegacyID]; ok {
+			slog.WarnContext(ctx, "project legacy ID already exists", "legacy_id", projectBase.LegacyID)
+			return domain.ErrProjectLegacyIDExists
+		}
+	}
+
+	if existing.Slug != projectBase.Slug {
+		r.deleteSlug(existing.Slug, projectBase.UID)
+		r.slugs[projectBase.Slug] = projectBase.UID
+	}
+	if existing.LegacyID != projectBase.LegacyID {
+		if existing.LegacyID != "" {
+			delete(r.legacyIDs, existing.LegacyID)
+		}
+		if projectBase.LegacyID != "" {
+			r.legacyIDs[projectBase.LegacyID] = projectBase.UID
+		}
+	}
+	r.put(r.projects, projectBase.UID, data)
+
+	return nil
+}
+
+// UpdateProjectBaseWithRetry applies modify to the latest project base and
+// writes it back, retrying on concurrent writes. modify runs without the
+// lock held, and must not change the project UID, slug or legacy ID.
+func (r *Repository) UpdateProjectBaseWithRetry(ctx context.Context, projectUID string, modify func(*models.ProjectBase) error) (*models.ProjectBase, error) {
+	return updateWithRetry(ctx, r, r.projects, projectUID, func(p *models.ProjectBase) error {
+		uid, slug, legacyID := p.UID, p.Slug, p.LegacyID
+		if err := modify(p); err != nil {
+			return err
+		}
+		if p.UID != uid || p.Slug != slug || p.LegacyID != legacyID {
+			slog.ErrorContext(ctx, "project UID, slug or legacy ID changed in retrying update", "project_uid", projectUID)
+			return domain.ErrValidationFailed
+		}
+		return nil
+	})
+}
+
+// GetProjectSettings gets the project settings.
+func (r *Repository) GetProjectSettings(ctx context.Context, projectUID string) (*models.ProjectSettings, error) {
+	projectSettings, _, err := r.GetProjectSettingsWithRevision(ctx, projectUID)
+	return projectSettings, err
+}
+
+// GetProjectSettingsWithRevision gets the project settings along with their revision.
+func (r *Repository) GetProjectSettingsWithRevision(ctx context.Context, projectUID string) (*models.ProjectSettings, uint64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return decodeEntry[models.ProjectSettings](ctx, r.settings, projectUID, domain.ErrProjectNotFound)
+}
+
+// UpdateProjectSettings updates a project's settings if they are at the given revision.
+func (r *Repository) UpdateProjectSettings(ctx context.Context, projectSettings *models.ProjectSettings, revision uint64) error {
+	data, err := encode(ctx, projectSettings)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.settings[projectSettings.UID]
+	if !ok {
+		return domain.ErrProjectNotFound
+	}
+	if current.revision != revision {
+		slog.WarnContext(ctx, "revision mismatch", "project_uid", projectSettings.UID)
+		return domain.ErrRevisionMismatch
+	}
+	r.put(r.settings, projectSettings.UID, data)
+
+	return nil
+}
+
+// UpdateProjectSettingsWithRetry applies modify to the latest project settings
+// and writes them back, retrying on concurrent writes.
+func (r *Repository) UpdateProjectSettingsWithRetry(ctx context.Context, projectUID string, modify func(*models.ProjectSettings) error) (*models.ProjectSettings, error) {
+	return updateWithRetry(ctx, r, r.settings, projectUID, func(p *models.ProjectSettings) error {
+		uid := p.UID
+		if err := modify(p); err != nil {
+			return err
+		}
+		if p.UID != uid {
+			slog.ErrorContext(ctx, "project settings UID changed in retrying update", "project_uid", projectUID)
+			return domain.ErrValidationFailed
+		}
+		return nil
+	})
+}
+
+// GetProjectUIDFromSlug gets the project UID from the project slug.
+func (r *Repository) GetProjectUIDFromSlug(_ context.Context, projectSlug string) (string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	projectUID, ok := r.slugs[projectSlug]
+	if !ok {
+		return "", domain.ErrProjectNotFound
+	}
+	return projectUID, nil
+}
+
+// ProjectSlugExists checks if a project slug is mapped to a project.
+func (r *Repository) ProjectSlugExists(_ context.Context, projectSlug string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.slugs[projectSlug]
+	return ok, nil
+}
+
+// GetProjectUIDFromLegacyID gets the project UID from the project's LFX v1 ID.
+func (r *Repository) GetProjectUIDFromLegacyID(_ context.Context, legacyID string) (string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	projectUID, ok := r.legacyIDs[legacyID]
+	if !ok {
+		return "", domain.ErrProjectNotFound
+	}
+	return projectUID, nil
+}
+
+// ListAllProjects lists all projects and their settings.
+func (r *Repository) ListAllProjects(ctx context.Context) ([]*models.ProjectBase, []*models.ProjectSettings, error) {
+	projectsBase, err := r.ListAllProjectsBase(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projectsSettings, err := r.ListAllProjectsSettings(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return projectsBase, projectsSettings, nil
+}
+
+// ListAllProjectsBase lists all project base data.
+func (r *Repository) ListAllProjectsBase(ctx context.Context) ([]*models.ProjectBase, error) {
+	return listAll[models.ProjectBase](ctx, r, r.projects)
+}
+
+// ListAllProjectsSettings lists all project settings data.
+func (r *Repository) ListAllProjectsSettings(ctx context.Context) ([]*models.ProjectSettings, error) {
+	return listAll[models.ProjectSettings](ctx, r, r.settings)
+}
+
+// StreamProjectsBase calls fn with each project base, from a snapshot taken
+// when it is called.
+func (r *Repository) StreamProjectsBase(ctx context.Context, fn func(*models.ProjectBase) error) error {
+	return streamAll(ctx, r, r.projects, fn)
+}
+
+// StreamProjectsSettings calls fn with the settings of each project, from a
+// snapshot taken when it is called.
+func (r *Repository) StreamProjectsSettings(ctx context.Context, fn func(*models.ProjectSettings) error) error {
+	return streamAll(ctx, r, r.settings, fn)
+}
+
+// updateWithRetry performs an optimistic-concurrency read-modify-write on the
+// value stored under key in m, like the NATS repository does on a KV bucket:
+// modify runs on a decoded copy without the lock held, and the write fails
+// over to a new attempt when the value was written in between.
+func updateWithRetry[T any](ctx context.Context, r *Repository, m map[string]entry, key string, modify func(*T) error) (*T, error) {
+	for attempt := 1; attempt <= maxUpdateAttempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		r.mu.RLock()
+		value, revision, err := decodeEntry[T](ctx, m, key, domain.ErrProjectNotFound)
+		r.mu.RUnlock()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := modify(value); err != nil {
+			return nil, err
+		}
+
+		data, err := encode(ctx, value)
+		if err != nil {
+			return nil, err
+		}
+
+		r.mu.Lock()
+		current, ok := m[key]
+		switch {
+		case !ok:
+			r.mu.Unlock()
+			return nil, domain.ErrProjectNotFound
+		case current.revision != revision:
+			r.mu.Unlock()
+			slog.DebugContext(ctx, "revision mismatch, retrying update", "key", key, "attempt", attempt)
+			continue
+		}
+		r.put(m, key, data)
+		r.mu.Unlock()
+
+		return value, nil
+	}
+
+	slog.WarnContext(ctx, "giving up update after repeated revision mismatches", "key", key, "attempts", maxUpdateAttempts)
+	return nil, domain.ErrRevisionMismatch
+}
+
+// encode returns the JSON encoding of value.
+func encode(ctx context.Context, value any) ([]byte, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		slog.ErrorContext(ctx, "error marshalling value into JSON", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+	return data, nil
+}
+
+// decodeEntry decodes the value stored under key in m, returning notFound when
+// there is none. The caller must hold the lock.
+func decodeEntry[T any](ctx context.Context, m map[string]entry, key string, notFound error) (*T, uint64, error) {
+	e, ok := m[key]
+	if !ok {
+		return nil, 0, notFound
+	}
+
+	value := new(T)
+	if err := json.Unmarshal(e.data, value); err != nil {
+		slog.ErrorContext(ctx, "error unmarshalling value", constants.ErrKey, err, "key", key)
+		return nil, 0, domain.ErrUnmarshal
+	}
+
+	return value, e.revision, nil
+}
+
+// listAll decodes every value stored in m.
+func listAll[T any](ctx context.Context, r *Repository, m map[string]entry) ([]*T, error) {
+	values := []*T{}
+	err := streamAll(ctx, r, m, func(value *T) error {
+		values = append(values, value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// streamAll calls fn with each value stored in m, in no particular order. The
+// values are taken under the lock, but fn runs without it, so that fn may call
+// the repository.
+func streamAll[T any](ctx context.Context, r *Repository, m map[string]entry, fn func(*T) error) error {
+	r.mu.RLock()
+	snapshot := make([][]byte, 0, len(m))
+	for _, e := range m {
+		snapshot = append(snapshot, e.data)
+	}
+	r.mu.RUnlock()
+
+	for _, data := range snapshot {
+		value := new(T)
+		if err := json.Unmarshal(data, value); err != nil {
+			slog.ErrorContext(ctx, "error unmarshalling value", constants.ErrKey, err)
+			return domain.ErrUnmarshal
+		}
+		if err := fn(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,334 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+var (
+	_ domain.ProjectRepository  = (*Repository)(nil)
+	_ domain.ProjectStreamer    = (*Repository)(nil)
+	_ domain.DocumentRepository = (*Repository)(nil)
+	_ domain.LinkRepository     = (*Repository)(nil)
+	_ domain.FolderRepository   = (*Repository)(nil)
+	_ domain.MessageBuilder     = MessageBuilder{}
+	_ domain.UserReader         = UserReader{}
+)
+
+const (
+	testUID      = "7cad5a8d-19d0-41a4-81a6-043453daf9ee"
+	otherUID     = "9493eae5-cd73-4c4a-b28f-3b8ec5280f6c"
+	testLegacyID = "a0941000002wBz9AAE"
+)
+
+// newTestRepository returns a repository holding the project testUID, with
+// slug "cncf" and legacy ID testLegacyID.
+func newTestRepository(t *testing.T) *Repository {
+	t.Helper()
+	r := NewRepository()
+	err := r.CreateProject(context.Background(),
+		&models.ProjectBase{UID: testUID, Slug: "cncf", Name: "CNCF", LegacyID: testLegacyID},
+		&models.ProjectSettings{UID: testUID, MissionStatement: "Make cloud native computing ubiquitous"},
+	)
+	require.NoError(t, err)
+	return r
+}
+
+func TestCreateProject(t *testing.T) {
+	ctx := context.Background()
+	r := newTestRepository(t)
+
+	projectBase, revision, err := r.GetProjectBaseWithRevision(ctx, testUID)
+	require.NoError(t, err)
+	assert.Equal(t, "CNCF", projectBase.Name)
+	assert.NotZero(t, revision)
+
+	projectSettings, err := r.GetProjectSettings(ctx, testUID)
+	require.NoError(t, err)
+	assert.Equal(t, "Make cloud native computing ubiquitous", projectSettings.MissionStatement)
+
+	uid, err := r.GetProjectUIDFromSlug(ctx, "cncf")
+	require.NoError(t, err)
+	assert.Equal(t, testUID, uid)
+
+	uid, err = r.GetProjectUIDFromLegacyID(ctx, testLegacyID)
+	require.NoError(t, err)
+	assert.Equal(t, testUID, uid)
+
+	// Changing the returned value does not change the stored one.
+	projectBase.Name = "Changed"
+	projectBase, err = r.GetProjectBase(ctx, testUID)
+	require.NoError(t, err)
+	assert.Equal(t, "CNCF", projectBase.Name)
+}
+
+func TestCreateProjectConflicts(t *testing.T) {
+	tests := []struct {
+		name        string
+		projectBase *models.ProjectBase
+		wantErr     error
+	}{
+		{
+			name:        "taken slug",
+			projectBase: &models.ProjectBase{UID: otherUID, Slug: "cncf"},
+			wantErr:     domain.ErrProjectSlugExists,
+		},
+		{
+			name:        "taken legacy ID",
+			projectBase: &models.ProjectBase{UID: otherUID, Slug: "other", LegacyID: testLegacyID},
+			wantErr:     domain.ErrProjectLegacyIDExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := newTestRepository(t)
+
+			err := r.CreateProject(ctx, tt.projectBase, nil)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			exists, err := r.ProjectExists(ctx, otherUID)
+			require.NoError(t, err)
+			assert.False(t, exists)
+		})
+	}
+}
+
+func TestUpdateProjectBase(t *testing.T) {
+	tests := []struct {
+		name     string
+		slug     string
+		legacyID string
+		stale    bool
+		wantErr  error
+	}{
+		{
+			name:     "renamed slug and legacy ID",
+			slug:     "cloud-native",
+			legacyID: "a0941000002wBz9AAF",
+		},
+		{
+			name:     "slug of another project",
+			slug:     "other",
+			legacyID: testLegacyID,
+			wantErr:  domain.ErrProjectSlugExists,
+		},
+		{
+			name:     "stale revision",
+			slug:     "cloud-native",
+			legacyID: testLegacyID,
+			stale:    true,
+			wantErr:  domain.ErrRevisionMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := newTestRepository(t)
+			require.NoError(t, r.CreateProject(ctx, &models.ProjectBase{UID: otherUID, Slug: "other"}, nil))
+
+			projectBase, revision, err := r.GetProjectBaseWithRevision(ctx, testUID)
+			require.NoError(t, err)
+			if tt.stale {
+				revision--
+			}
+			projectBase.Slug = tt.slug
+			projectBase.LegacyID = tt.legacyID
+
+			err = r.UpdateProjectBase(ctx, projectBase, revision)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				uid, err := r.GetProjectUIDFromSlug(ctx, "cncf")
+				require.NoError(t, err)
+				assert.Equal(t, testUID, uid)
+				return
+			}
+			require.NoError(t, err)
+			uid, err := r.GetProjectUIDFromSlug(ctx, tt.slug)
+			require.NoError(t, err)
+			assert.Equal(t, testUID, uid)
+			_, err = r.GetProjectUIDFromSlug(ctx, "cncf")
+			assert.ErrorIs(t, err, domain.ErrProjectNotFound)
+			uid, err = r.GetProjectUIDFromLegacyID(ctx, tt.legacyID)
+			require.NoError(t, err)
+			assert.Equal(t, testUID, uid)
+			_, newRevision, err := r.GetProjectBaseWithRevision(ctx, testUID)
+			require.NoError(t, err)
+			assert.Greater(t, newRevision, revision)
+		})
+	}
+}
+
+func TestUpdateProjectBaseWithRetry(t *testing.T) {
+	ctx := context.Background()
+	r := newTestRepository(t)
+
+	attempts := 0
+	projectBase, err := r.UpdateProjectBaseWithRetry(ctx, testUID, func(p *models.ProjectBase) error {
+		attempts++
+		if attempts == 1 {
+			// A concurrent write makes the first attempt fail over to a second one.
+			_, err := r.UpdateProjectSettingsWithRetry(ctx, testUID, func(*models.ProjectSettings) error { return nil })
+			require.NoError(t, err)
+			concurrent, revision, err := r.GetProjectBaseWithRevision(ctx, testUID)
+			require.NoError(t, err)
+			concurrent.Description = "Concurrent"
+			require.NoError(t, r.UpdateProjectBase(ctx, concurrent, revision))
+		}
+		p.Name = "Cloud Native Computing Foundation"
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, "Cloud Native Computing Foundation", projectBase.Name)
+	assert.Equal(t, "Concurrent", projectBase.Description)
+
+	_, err = r.UpdateProjectBaseWithRetry(ctx, testUID, func(p *models.ProjectBase) error {
+		p.Slug = "changed"
+		return nil
+	})
+	assert.ErrorIs(t, err, domain.ErrValidationFailed)
+
+	_, err = r.UpdateProjectBaseWithRetry(ctx, otherUID, func(*models.ProjectBase) error { return nil })
+	assert.ErrorIs(t, err, domain.ErrProjectNotFound)
+}
+
+func TestUpdateProjectSettings(t *testing.T) {
+	ctx := context.Background()
+	r := newTestRepository(t)
+
+	projectSettings, revision, err := r.GetProjectSettingsWithRevision(ctx, testUID)
+	require.NoError(t, err)
+	projectSettings.MissionStatement = "Changed"
+
+	require.NoError(t, r.UpdateProjectSettings(ctx, projectSettings, revision))
+	assert.ErrorIs(t, r.UpdateProjectSettings(ctx, projectSettings, revision), domain.ErrRevisionMismatch)
+	assert.ErrorIs(t, r.UpdateProjectSettings(ctx, &models.ProjectSettings{UID: otherUID}, 1), domain.ErrProjectNotFound)
+}
+
+func TestDeleteProject(t *testing.T) {
+	ctx := context.Background()
+	r := newTestRepository(t)
+
+	_, revision, err := r.GetProjectBaseWithRevision(ctx, testUID)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, r.DeleteProject(ctx, testUID, revision+1), domain.ErrRevisionMismatch)
+	require.NoError(t, r.DeleteProject(ctx, testUID, revision))
+	assert.ErrorIs(t, r.DeleteProject(ctx, testUID, revision), domain.ErrProjectNotFound)
+
+	exists, err := r.ProjectExists(ctx, testUID)
+	require.NoError(t, err)
+	assert.False(t, exists)
+	exists, err = r.ProjectSlugExists(ctx, "cncf")
+	require.NoError(t, err)
+	assert.False(t, exists)
+	_, err = r.GetProjectUIDFromLegacyID(ctx, testLegacyID)
+	assert.ErrorIs(t, err, domain.ErrProjectNotFound)
+	_, err = r.GetProjectSettings(ctx, testUID)
+	assert.ErrorIs(t, err, domain.ErrProjectNotFound)
+
+	// The slug and legacy ID can be reused.
+	require.NoError(t, r.CreateProject(ctx, &models.ProjectBase{UID: otherUID, Slug: "cncf", LegacyID: testLegacyID}, nil))
+}
+
+func TestListAllProjects(t *testing.T) {
+	ctx := context.Background()
+	r := newTestRepository(t)
+	require.NoError(t, r.CreateProject(ctx, &models.ProjectBase{UID: otherUID, Slug: "other"}, &models.ProjectSettings{UID: otherUID}))
+
+	projectsBase, projectsSettings, err := r.ListAllProjects(ctx)
+
+	require.NoError(t, err)
+	assert.Len(t, projectsBase, 2)
+	assert.Len(t, projectsSettings, 2)
+}
+
+func TestLinks(t *testing.T) {
+	ctx := context.Background()
+	r := NewRepository()
+	require.NoError(t, r.CreateLink(ctx, &models.ProjectLink{UID: "link-1", ProjectUID: testUID, Name: "Website"}))
+	require.NoError(t, r.CreateLink(ctx, &models.ProjectLink{UID: "link-2", ProjectUID: otherUID, Name: "Website"}))
+
+	links, err := r.ListLinks(ctx, testUID)
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.Equal(t, "link-1", links[0].UID)
+
+	_, _, err = r.GetLink(ctx, otherUID, "link-1")
+	assert.ErrorIs(t, err, domain.ErrLinkNotFound)
+
+	_, revision, err := r.GetLink(ctx, testUID, "link-1")
+	require.NoError(t, err)
+	assert.ErrorIs(t, r.DeleteLink(ctx, testUID, "link-1", revision+1), domain.ErrRevisionMismatch)
+	require.NoError(t, r.DeleteLink(ctx, testUID, "link-1", revision))
+	_, _, err = r.GetLink(ctx, testUID, "link-1")
+	assert.ErrorIs(t, err, domain.ErrLinkNotFound)
+}
+
+func TestFolderNames(t *testing.T) {
+	ctx := context.Background()
+	r := NewRepository()
+	folder := &models.ProjectFolder{UID: "folder-1", ProjectUID: testUID, Name: "Reports"}
+
+	uniqueKey, err := r.UniqueFolderName(ctx, folder)
+	require.NoError(t, err)
+	require.NoError(t, r.CreateFolder(ctx, folder))
+
+	_, err = r.UniqueFolderName(ctx, &models.ProjectFolder{UID: "folder-2", ProjectUID: testUID, Name: "Reports"})
+	assert.ErrorIs(t, err, domain.ErrFolderNameExists)
+	_, err = r.UniqueFolderName(ctx, &models.ProjectFolder{UID: "folder-3", ProjectUID: otherUID, Name: "Reports"})
+	require.NoError(t, err)
+
+	// Deleting the folder releases its name.
+	_, revision, err := r.GetFolder(ctx, testUID, "folder-1")
+	require.NoError(t, err)
+	require.NoError(t, r.DeleteFolder(ctx, testUID, "folder-1", revision))
+	newKey, err := r.UniqueFolderName(ctx, &models.ProjectFolder{UID: "folder-2", ProjectUID: testUID, Name: "Reports"})
+	require.NoError(t, err)
+	assert.Equal(t, uniqueKey, newKey)
+}
+
+func TestDocuments(t *testing.T) {
+	ctx := context.Background()
+	r := NewRepository()
+	doc := &models.ProjectDocument{UID: "doc-1", ProjectUID: testUID, Name: "Charter"}
+
+	_, err := r.UniqueDocumentName(ctx, doc)
+	require.NoError(t, err)
+	require.NoError(t, r.CreateDocumentMetadata(ctx, doc))
+	require.NoError(t, r.PutDocumentFile(ctx, doc.UID, []byte("%PDF-1.7")))
+
+	_, err = r.UniqueDocumentName(ctx, &models.ProjectDocument{UID: "doc-2", ProjectUID: testUID, Name: "Charter"})
+	assert.ErrorIs(t, err, domain.ErrDocumentNameExists)
+
+	data, err := r.GetDocumentFile(ctx, doc.UID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("%PDF-1.7"), data)
+
+	docs, err := r.ListDocuments(ctx, testUID)
+	require.NoError(t, err)
+	assert.Len(t, docs, 1)
+
+	_, revision, err := r.GetDocumentMetadata(ctx, testUID, doc.UID)
+	require.NoError(t, err)
+	require.NoError(t, r.DeleteDocumentMetadata(ctx, testUID, doc.UID, revision))
+	require.NoError(t, r.DeleteDocumentFile(ctx, doc.UID))
+	_, err = r.GetDocumentFile(ctx, doc.UID)
+	assert.ErrorIs(t, err, domain.ErrDocumentNotFound)
+	_, err = r.UniqueDocumentName(ctx, &models.ProjectDocument{UID: "doc-2", ProjectUID: testUID, Name: "Charter"})
+	require.NoError(t, err)
+}
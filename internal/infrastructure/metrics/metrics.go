@@ -0,0 +1,248 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+// Package metrics exposes native Prometheus metrics for the project service.
+// These are served on /metrics alongside the OTel metrics exporter, for
+// clusters that scrape Prometheus directly rather than running a collector.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"goa.design/goa/v3/pkg"
+)
+
+const namespace = "project_service"
+
+const (
+	// OutcomeOK is the outcome label value for a successful operation.
+	OutcomeOK = "ok"
+	// OutcomeError is the outcome label value for a failed operation.
+	OutcomeError = "error"
+	// OutcomeNotFound is the outcome label value for a KV lookup of a missing key.
+	OutcomeNotFound = "not_found"
+	// OutcomeTimeout is the outcome label value for a NATS handler that ran out of time.
+	OutcomeTimeout = "timeout"
+)
+
+var (
+	registry = prometheus.NewRegistry()
+
+	endpointRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "endpoint_requests_total",
+		Help:      "Number of requests handled per Goa endpoint and outcome.",
+	}, []string{"service", "method", "outcome"})
+
+	endpointDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "endpoint_request_duration_seconds",
+		Help:      "Latency of requests handled per Goa endpoint.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "method"})
+
+	natsHandlerDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "nats_handler_duration_seconds",
+		Help:      "Latency of NATS message handlers per subject and outcome.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"subject", "outcome"})
+
+	natsHandlersInFlight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "nats_handlers_in_flight",
+		Help:      "Number of NATS message handlers running per subject.",
+	}, []string{"subject"})
+
+	natsHandlerWait = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "nats_handler_wait_seconds",
+		Help:      "Time NATS messages waited for a free handler worker, per subject.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"subject"})
+
+	natsPublishRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "nats_publish_retries_total",
+		Help:      "Number of retried indexer and access message sends per subject.",
+	}, []string{"subject"})
+
+	natsPublishCircuitOpen = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "nats_publish_circuit_open",
+		Help:      "Whether the NATS publish circuit breaker is open (1) or closed (0).",
+	})
+
+	kvOperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "kv_operation_duration_seconds",
+		Help:      "Latency of NATS KV operations per bucket, operation and outcome.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"bucket", "operation", "outcome"})
+
+	slugCollisions = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "slug_collisions_total",
+		Help:      "Number of project writes rejected because the slug was already taken.",
+	})
+
+	slugCacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "slug_cache_lookups_total",
+		Help:      "Number of slug-to-UID cache lookups per result (hit or miss).",
+	}, []string{"result"})
+
+	userCacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "user_cache_lookups_total",
+		Help:      "Number of user metadata cache lookups per result (hit or miss).",
+	}, []string{"result"})
+
+	integrityIssues = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "integrity_issues",
+		Help:      "Number of inconsistencies per kind found by the last integrity scan.",
+	}, []string{"kind"})
+
+	integrityLastScan = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "integrity_last_scan_timestamp_seconds",
+		Help:      "Unix time at which the last successful integrity scan started.",
+	})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		endpointRequests,
+		endpointDuration,
+		natsHandlerDuration,
+		natsHandlersInFlight,
+		natsHandlerWait,
+		natsPublishRetries,
+		natsPublishCircuitOpen,
+		kvOperationDuration,
+		slugCollisions,
+		slugCacheLookups,
+		userCacheLookups,
+		integrityIssues,
+		integrityLastScan,
+	)
+}
+
+// Handler returns the HTTP handler that serves the Prometheus metrics.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry})
+}
+
+// Outcome returns the outcome label value for an operation error.
+func Outcome(err error) string {
+	if err != nil {
+		return OutcomeError
+	}
+	return OutcomeOK
+}
+
+// EndpointMiddleware records a request counter and latency histogram for each
+// Goa endpoint. Errors are labelled with their Goa error name when available
+// (e.g. "NotFound") so that client errors can be told apart from failures.
+func EndpointMiddleware(next goa.Endpoint) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		service, _ := ctx.Value(goa.ServiceKey).(string)
+		method, _ := ctx.Value(goa.MethodKey).(string)
+
+		start := time.Now()
+		res, err := next(ctx, req)
+		endpointDuration.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+
+		outcome := OutcomeOK
+		if err != nil {
+			outcome = OutcomeError
+			var named goa.GoaErrorNamer
+			if errors.As(err, &named) && named.GoaErrorName() != "" {
+				outcome = named.GoaErrorName()
+			}
+		}
+		endpointRequests.WithLabelValues(service, method, outcome).Inc()
+
+		return res, err
+	}
+}
+
+// ObserveNATSHandler records the latency of a NATS message handler. A handler
+// that failed because its deadline passed is labelled as a timeout.
+func ObserveNATSHandler(subject string, start time.Time, err error) {
+	outcome := Outcome(err)
+	if errors.Is(err, context.DeadlineExceeded) {
+		outcome = OutcomeTimeout
+	}
+	natsHandlerDuration.WithLabelValues(subject, outcome).Observe(time.Since(start).Seconds())
+}
+
+// StartNATSHandler records that a NATS message waited since queued for a
+// handler worker and counts the handler as in flight until the returned
+// function is called.
+func StartNATSHandler(subject string, queued time.Time) func() {
+	natsHandlerWait.WithLabelValues(subject).Observe(time.Since(queued).Seconds())
+	inFlight := natsHandlersInFlight.WithLabelValues(subject)
+	inFlight.Inc()
+	return inFlight.Dec
+}
+
+// IncPublishRetry counts a retried indexer or access message send.
+func IncPublishRetry(subject string) {
+	natsPublishRetries.WithLabelValues(subject).Inc()
+}
+
+// SetPublishCircuitOpen records the state of the NATS publish circuit breaker.
+func SetPublishCircuitOpen(open bool) {
+	if open {
+		natsPublishCircuitOpen.Set(1)
+		return
+	}
+	natsPublishCircuitOpen.Set(0)
+}
+
+// ObserveKVOperation records the latency of a NATS KV operation.
+func ObserveKVOperation(bucket, operation, outcome string, start time.Time) {
+	kvOperationDuration.WithLabelValues(bucket, operation, outcome).Observe(time.Since(start).Seconds())
+}
+
+// IncSlugCollision counts a project write rejected because the slug was already taken.
+func IncSlugCollision() {
+	slugCollisions.Inc()
+}
+
+// ObserveSlugCacheLookup counts a slug-to-UID cache lookup.
+func ObserveSlugCacheLookup(hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	slugCacheLookups.WithLabelValues(result).Inc()
+}
+
+// ObserveUserCacheLookup counts a user metadata cache lookup.
+func ObserveUserCacheLookup(hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	userCacheLookups.WithLabelValues(result).Inc()
+}
+
+// SetIntegrityIssues records the number of inconsistencies per kind found by
+// the integrity scan that started at scannedAt.
+func SetIntegrityIssues(issues map[string]int, scannedAt time.Time) {
+	for kind, count := range issues {
+		integrityIssues.WithLabelValues(kind).Set(float64(count))
+	}
+	integrityLastScan.Set(float64(scannedAt.Unix()))
+}
@@ -0,0 +1,117 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// namedError is a test error implementing goa.GoaErrorNamer.
+type namedError struct{ name string }
+
+func (e *namedError) Error() string        { return e.name }
+func (e *namedError) GoaErrorName() string { return e.name }
+
+// scrape returns the current text exposition of the registry.
+func scrape(t *testing.T) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestEndpointMiddleware(t *testing.T) {
+	tests := []struct {
+		name            string
+		method          string
+		endpointErr     error
+		expectedOutcome string
+	}{
+		{
+			name:            "successful request",
+			method:          "get-projects",
+			expectedOutcome: OutcomeOK,
+		},
+		{
+			name:            "goa named error",
+			method:          "get-one-project-base",
+			endpointErr:     &namedError{name: "NotFound"},
+			expectedOutcome: "NotFound",
+		},
+		{
+			name:            "unnamed error",
+			method:          "delete-project",
+			endpointErr:     errors.New("boom"),
+			expectedOutcome: OutcomeError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint := EndpointMiddleware(func(_ context.Context, req any) (any, error) {
+				return req, tt.endpointErr
+			})
+
+			ctx := context.WithValue(context.Background(), goa.ServiceKey, "project-service")
+			ctx = context.WithValue(ctx, goa.MethodKey, tt.method)
+			res, err := endpoint(ctx, "payload")
+
+			assert.Equal(t, "payload", res)
+			assert.Equal(t, tt.endpointErr, err)
+
+			body := scrape(t)
+			assert.Contains(t, body, `project_service_endpoint_requests_total{method="`+tt.method+`",outcome="`+tt.expectedOutcome+`",service="project-service"} 1`)
+			assert.Contains(t, body, `project_service_endpoint_request_duration_seconds_count{method="`+tt.method+`",service="project-service"} 1`)
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	ObserveNATSHandler("lfx.projects-api.get_name", time.Now(), nil)
+	ObserveKVOperation("projects", "get", OutcomeNotFound, time.Now())
+	IncSlugCollision()
+
+	body := scrape(t)
+
+	assert.Contains(t, body, `project_service_nats_handler_duration_seconds_count{outcome="ok",subject="lfx.projects-api.get_name"} 1`)
+	assert.Contains(t, body, `project_service_kv_operation_duration_seconds_count{bucket="projects",operation="get",outcome="not_found"} 1`)
+	assert.Contains(t, body, "project_service_slug_collisions_total 1")
+	assert.Contains(t, body, "go_goroutines")
+}
+
+func TestStartNATSHandler(t *testing.T) {
+	subject := "lfx.projects-api.get_logo"
+
+	done := StartNATSHandler(subject, time.Now())
+	assert.Contains(t, scrape(t), `project_service_nats_handlers_in_flight{subject="`+subject+`"} 1`)
+	assert.Contains(t, scrape(t), `project_service_nats_handler_wait_seconds_count{subject="`+subject+`"} 1`)
+
+	done()
+	ObserveNATSHandler(subject, time.Now(), context.DeadlineExceeded)
+	body := scrape(t)
+	assert.Contains(t, body, `project_service_nats_handlers_in_flight{subject="`+subject+`"} 0`)
+	assert.Contains(t, body, `project_service_nats_handler_duration_seconds_count{outcome="timeout",subject="`+subject+`"} 1`)
+}
+
+func TestSetIntegrityIssues(t *testing.T) {
+	SetIntegrityIssues(map[string]int{"orphaned_settings": 2, "invalid_entries": 0}, time.Unix(1700000000, 0))
+
+	body := scrape(t)
+	assert.Contains(t, body, `project_service_integrity_issues{kind="orphaned_settings"} 2`)
+	assert.Contains(t, body, `project_service_integrity_issues{kind="invalid_entries"} 0`)
+	assert.Contains(t, body, "project_service_integrity_last_scan_timestamp_seconds 1.7e+09")
+}
@@ -3,7 +3,12 @@
 
 package middleware
 
-import "net/http"
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+)
 
 // BodyLimitMiddleware wraps every incoming request body with http.MaxBytesReader
 // so that no request can stream more than limit bytes regardless of how many
@@ -17,3 +22,27 @@ func BodyLimitMiddleware(limit int64) func(http.Handler) http.Handler {
 		})
 	}
 }
+
+// RequestBodyLimitMiddleware caps the body of every request other than a
+// multipart upload, which BodyLimitMiddleware caps separately. A request that
+// declares a larger Content-Length is rejected with 413 before its body is
+// read; a chunked body is cut off at the limit, which fails its decoding.
+func RequestBodyLimitMiddleware(limit int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if limit <= 0 || strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if r.ContentLength > limit {
+				slog.WarnContext(r.Context(), "request body too large",
+					"content_length", r.ContentLength, "max_bytes", limit)
+				WriteProblem(w, NewProblem(r, http.StatusRequestEntityTooLarge, "request-body-too-large", "Request body too large",
+					fmt.Sprintf("request body exceeds the maximum size of %d bytes", limit)))
+				return
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, limit)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
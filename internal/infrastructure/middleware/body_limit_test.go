@@ -4,11 +4,14 @@
 package middleware
 
 import (
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
 )
 
 func TestBodyLimitMiddleware(t *testing.T) {
@@ -62,3 +65,109 @@ func TestBodyLimitMiddleware(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestBodyLimitMiddleware(t *testing.T) {
+	tests := []struct {
+		name        string
+		limit       int64
+		contentType string
+		body        string
+		chunked     bool
+		wantStatus  int
+		wantReadErr bool
+	}{
+		{
+			name:        "body within limit passes through",
+			limit:       10,
+			contentType: "application/json",
+			body:        `{"a":1}`,
+			wantStatus:  http.StatusOK,
+		},
+		{
+			name:        "declared length over limit is rejected",
+			limit:       5,
+			contentType: "application/json",
+			body:        `{"description":"long"}`,
+			wantStatus:  http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:        "chunked body over limit fails to read",
+			limit:       5,
+			contentType: "application/json",
+			body:        `{"description":"long"}`,
+			chunked:     true,
+			wantStatus:  http.StatusOK,
+			wantReadErr: true,
+		},
+		{
+			name:        "multipart uploads are left to BodyLimitMiddleware",
+			limit:       5,
+			contentType: "multipart/form-data; boundary=x",
+			body:        "--x\r\n--x--",
+			wantStatus:  http.StatusOK,
+		},
+		{
+			name:        "zero limit disables the check",
+			contentType: "application/json",
+			body:        `{"description":"long"}`,
+			wantStatus:  http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var readErr error
+			inner := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, readErr = io.ReadAll(r.Body)
+			})
+
+			req := httptest.NewRequest(http.MethodPut, "/projects/1", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			if tt.chunked {
+				req.ContentLength = -1
+			}
+			rr := httptest.NewRecorder()
+			RequestBodyLimitMiddleware(tt.limit)(inner).ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rr.Code, tt.wantStatus)
+			}
+			if (readErr != nil) != tt.wantReadErr {
+				t.Errorf("read error = %v, want error: %v", readErr, tt.wantReadErr)
+			}
+		})
+	}
+}
+
+func TestRequestBodyLimitMiddleware_problem(t *testing.T) {
+	inner := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("handler called for an oversized body")
+	})
+	handler := RequestIDMiddleware()(RequestBodyLimitMiddleware(5)(inner))
+
+	req := httptest.NewRequest(http.MethodPut, "/projects/1", strings.NewReader(`{"description":"long"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(constants.RequestIDHeader, "req-1")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	if got := rr.Header().Get("Content-Type"); got != ProblemContentType {
+		t.Errorf("content type = %q, want %q", got, ProblemContentType)
+	}
+	var problem Problem
+	if err := json.Unmarshal(rr.Body.Bytes(), &problem); err != nil {
+		t.Fatalf("decoding problem: %v", err)
+	}
+	if problem.Type != ProblemTypePrefix+"request-body-too-large" || problem.Status != http.StatusRequestEntityTooLarge {
+		t.Errorf("problem = %s %d, want request-body-too-large 413", problem.Type, problem.Status)
+	}
+	if problem.Detail != "request body exceeds the maximum size of 5 bytes" {
+		t.Errorf("detail = %q", problem.Detail)
+	}
+	if problem.Instance == nil || *problem.Instance != "/projects/1" {
+		t.Errorf("instance = %v, want /projects/1", problem.Instance)
+	}
+	if problem.RequestID == nil || *problem.RequestID != "req-1" {
+		t.Errorf("request ID = %v, want req-1", problem.RequestID)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package middleware
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// Defaults used by [CORSMiddleware] when the corresponding CORSConfig list is
+// empty.
+var (
+	defaultCORSMethods = []string{
+		http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodDelete,
+	}
+	defaultCORSHeaders = []string{
+		"Authorization", "Content-Type", "If-Match", constants.RequestIDHeader,
+	}
+	// defaultCORSExposedHeaders lets browsers read the ETags needed for the
+	// If-Match of updates, the request ID and the Retry-After of 429s.
+	defaultCORSExposedHeaders = []string{
+		"ETag", "X-Settings-ETag", constants.RequestIDHeader, "Retry-After",
+	}
+)
+
+// CORSConfig configures [CORSMiddleware]. CORS is disabled when
+// AllowedOrigins is empty.
+type CORSConfig struct {
+	// AllowedOrigins are the origins, e.g. "https://app.lfx.dev", that browsers
+	// may call the API from. An entry may start with "*." after the scheme,
+	// e.g. "https://*.lfx.dev", to allow every subdomain, and "*" allows any
+	// origin.
+	AllowedOrigins []string
+	// AllowedMethods and AllowedHeaders are allowed in cross-origin requests.
+	// They default to the methods and request headers the API uses.
+	AllowedMethods []string
+	AllowedHeaders []string
+	// ExposedHeaders are the response headers browsers let scripts read.
+	ExposedHeaders []string
+	// AllowCredentials lets browsers send cookies and HTTP authentication with
+	// the requests. It is never combined with a literal "*" origin.
+	AllowCredentials bool
+	// MaxAge is how long browsers may cache a preflight response; zero leaves
+	// it to the browser.
+	MaxAge time.Duration
+}
+
+// CORSMiddleware answers CORS preflight requests and adds the CORS headers to
+// the responses of the requests from allowed origins, so that browser
+// front-ends on other domains can call the API directly. Preflight requests
+// never reach the API, so they need neither a token nor a rate limit budget.
+func CORSMiddleware(config CORSConfig) func(http.Handler) http.Handler {
+	if len(config.AllowedOrigins) == 0 {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	methods := config.AllowedMethods
+	if len(methods) == 0 {
+		methods = defaultCORSMethods
+	}
+	headers := config.AllowedHeaders
+	if len(headers) == 0 {
+		headers = defaultCORSHeaders
+	}
+	exposed := config.ExposedHeaders
+	if len(exposed) == 0 {
+		exposed = defaultCORSExposedHeaders
+	}
+	anyOrigin := slices.Contains(config.AllowedOrigins, "*")
+	allowMethods := strings.Join(methods, ", ")
+	allowHeaders := strings.Join(headers, ", ")
+	exposeHeaders := strings.Join(exposed, ", ")
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+			if origin == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Add("Vary", "Origin")
+			if !anyOrigin && !originAllowed(origin, config.AllowedOrigins) {
+				if preflight {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				// The response goes out without CORS headers, so the browser
+				// does not hand it to the calling script.
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if anyOrigin && !config.AllowCredentials {
+				w.Header().Set("Access-Control-Allow-Origin", "*")
+			} else {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+			}
+			if config.AllowCredentials {
+				w.Header().Set("Access-Control-Allow-Credentials", "true")
+			}
+
+			if !preflight {
+				w.Header().Set("Access-Control-Expose-Headers", exposeHeaders)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Add("Vary", "Access-Control-Request-Method")
+			w.Header().Add("Vary", "Access-Control-Request-Headers")
+			if !slices.Contains(methods, r.Header.Get("Access-Control-Request-Method")) {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.Header().Set("Access-Control-Allow-Methods", allowMethods)
+			w.Header().Set("Access-Control-Allow-Headers", allowHeaders)
+			if config.MaxAge > 0 {
+				w.Header().Set("Access-Control-Max-Age", strconv.Itoa(int(config.MaxAge.Seconds())))
+			}
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+}
+
+// originAllowed reports whether origin matches one of the allowed origins,
+// ignoring case.
+func originAllowed(origin string, allowed []string) bool {
+	origin = strings.ToLower(origin)
+	for _, pattern := range allowed {
+		pattern = strings.ToLower(pattern)
+		if scheme, domain, ok := strings.Cut(pattern, "://*."); ok {
+			rest, found := strings.CutPrefix(origin, scheme+"://")
+			if found && strings.HasSuffix(rest, "."+domain) {
+				return true
+			}
+			continue
+		}
+		if origin == pattern {
+			return true
+		}
+	}
+	return false
+}
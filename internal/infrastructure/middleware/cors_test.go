@@ -0,0 +1,170 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCORSMiddleware(t *testing.T) {
+	config := CORSConfig{
+		AllowedOrigins: []string{"https://app.lfx.dev", "https://*.lfx.example.org"},
+		MaxAge:         10 * time.Minute,
+	}
+
+	tests := []struct {
+		name           string
+		config         CORSConfig
+		method         string
+		headers        map[string]string
+		expectedStatus int
+		expectedNext   bool
+		expectedHeader map[string]string
+	}{
+		{
+			name:           "same-origin request",
+			config:         config,
+			method:         http.MethodGet,
+			expectedStatus: http.StatusOK,
+			expectedNext:   true,
+			expectedHeader: map[string]string{"Access-Control-Allow-Origin": ""},
+		},
+		{
+			name:           "allowed origin",
+			config:         config,
+			method:         http.MethodGet,
+			headers:        map[string]string{"Origin": "https://app.lfx.dev"},
+			expectedStatus: http.StatusOK,
+			expectedNext:   true,
+			expectedHeader: map[string]string{
+				"Access-Control-Allow-Origin":   "https://app.lfx.dev",
+				"Access-Control-Expose-Headers": "ETag, X-Settings-ETag, X-REQUEST-ID, Retry-After",
+				"Vary":                          "Origin",
+			},
+		},
+		{
+			name:           "allowed subdomain",
+			config:         config,
+			method:         http.MethodGet,
+			headers:        map[string]string{"Origin": "https://pcc.lfx.example.org"},
+			expectedStatus: http.StatusOK,
+			expectedNext:   true,
+			expectedHeader: map[string]string{"Access-Control-Allow-Origin": "https://pcc.lfx.example.org"},
+		},
+		{
+			name:           "subdomain pattern does not match the bare domain",
+			config:         config,
+			method:         http.MethodGet,
+			headers:        map[string]string{"Origin": "https://lfx.example.org"},
+			expectedStatus: http.StatusOK,
+			expectedNext:   true,
+			expectedHeader: map[string]string{"Access-Control-Allow-Origin": ""},
+		},
+		{
+			name:           "disallowed origin",
+			config:         config,
+			method:         http.MethodGet,
+			headers:        map[string]string{"Origin": "https://evil.example.com"},
+			expectedStatus: http.StatusOK,
+			expectedNext:   true,
+			expectedHeader: map[string]string{"Access-Control-Allow-Origin": ""},
+		},
+		{
+			name:   "preflight",
+			config: config,
+			method: http.MethodOptions,
+			headers: map[string]string{
+				"Origin":                         "https://app.lfx.dev",
+				"Access-Control-Request-Method":  http.MethodPut,
+				"Access-Control-Request-Headers": "authorization, if-match",
+			},
+			expectedStatus: http.StatusNoContent,
+			expectedHeader: map[string]string{
+				"Access-Control-Allow-Origin":  "https://app.lfx.dev",
+				"Access-Control-Allow-Methods": "GET, HEAD, POST, PUT, DELETE",
+				"Access-Control-Allow-Headers": "Authorization, Content-Type, If-Match, X-REQUEST-ID",
+				"Access-Control-Max-Age":       "600",
+			},
+		},
+		{
+			name:   "preflight from a disallowed origin",
+			config: config,
+			method: http.MethodOptions,
+			headers: map[string]string{
+				"Origin":                        "https://evil.example.com",
+				"Access-Control-Request-Method": http.MethodDelete,
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedHeader: map[string]string{"Access-Control-Allow-Origin": ""},
+		},
+		{
+			name:   "preflight for a disallowed method",
+			config: CORSConfig{AllowedOrigins: []string{"https://app.lfx.dev"}, AllowedMethods: []string{http.MethodGet}},
+			method: http.MethodOptions,
+			headers: map[string]string{
+				"Origin":                        "https://app.lfx.dev",
+				"Access-Control-Request-Method": http.MethodDelete,
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedHeader: map[string]string{"Access-Control-Allow-Methods": ""},
+		},
+		{
+			name:           "any origin",
+			config:         CORSConfig{AllowedOrigins: []string{"*"}},
+			method:         http.MethodGet,
+			headers:        map[string]string{"Origin": "https://anywhere.example.com"},
+			expectedStatus: http.StatusOK,
+			expectedNext:   true,
+			expectedHeader: map[string]string{"Access-Control-Allow-Origin": "*"},
+		},
+		{
+			name:           "any origin with credentials echoes the origin",
+			config:         CORSConfig{AllowedOrigins: []string{"*"}, AllowCredentials: true},
+			method:         http.MethodGet,
+			headers:        map[string]string{"Origin": "https://anywhere.example.com"},
+			expectedStatus: http.StatusOK,
+			expectedNext:   true,
+			expectedHeader: map[string]string{
+				"Access-Control-Allow-Origin":      "https://anywhere.example.com",
+				"Access-Control-Allow-Credentials": "true",
+			},
+		},
+		{
+			name:           "disabled",
+			method:         http.MethodOptions,
+			headers:        map[string]string{"Origin": "https://app.lfx.dev", "Access-Control-Request-Method": http.MethodGet},
+			expectedStatus: http.StatusOK,
+			expectedNext:   true,
+			expectedHeader: map[string]string{"Access-Control-Allow-Origin": "", "Vary": ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nextCalled := false
+			handler := CORSMiddleware(tt.config)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				nextCalled = true
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(tt.method, "/projects", nil)
+			for name, value := range tt.headers {
+				req.Header.Set(name, value)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Equal(t, tt.expectedNext, nextCalled)
+			for name, value := range tt.expectedHeader {
+				assert.Equal(t, value, rr.Header().Get(name), name)
+			}
+		})
+	}
+}
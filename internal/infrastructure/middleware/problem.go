@@ -0,0 +1,68 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+const (
+	// ProblemContentType is the media type of problem details documents (RFC 7807),
+	// as declared by the design's ProblemContentType.
+	ProblemContentType = "application/problem+json"
+	// ProblemTypePrefix prefixes the type URI of every problem, as declared by
+	// the design's ProblemTypePrefix.
+	ProblemTypePrefix = "urn:lfx:project-service:problem:"
+)
+
+// Problem is the problem details document of errors that are not declared in
+// the design, such as the errors of decoding a request or of a middleware
+// rejecting it. Code and Message repeat the status and detail for clients of
+// the API's former error shape.
+type Problem struct {
+	Type      string  `json:"type"`
+	Title     string  `json:"title"`
+	Status    int     `json:"status"`
+	Detail    string  `json:"detail"`
+	Instance  *string `json:"instance,omitempty"`
+	RequestID *string `json:"request_id,omitempty"`
+	Code      string  `json:"code"`
+	Message   string  `json:"message"`
+}
+
+// NewProblem returns the problem of type slug answered to r with status. Its
+// instance is the path of r, and its request ID the one RequestIDMiddleware
+// set, if any.
+func NewProblem(r *http.Request, status int, slug, title, detail string) *Problem {
+	instance := r.URL.Path
+	p := &Problem{
+		Type:     ProblemTypePrefix + slug,
+		Title:    title,
+		Status:   status,
+		Detail:   detail,
+		Instance: &instance,
+		Code:     strconv.Itoa(status),
+		Message:  detail,
+	}
+	if id, ok := r.Context().Value(constants.RequestIDContextID).(string); ok && id != "" {
+		p.RequestID = &id
+	}
+	return p
+}
+
+// StatusCode implements goahttp.Statuser.
+func (p *Problem) StatusCode() int {
+	return p.Status
+}
+
+// WriteProblem answers a request with a problem.
+func WriteProblem(w http.ResponseWriter, p *Problem) {
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
@@ -0,0 +1,232 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package middleware
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// RateLimitConfig configures [RateLimitMiddleware]. A zero rate disables the
+// corresponding limit.
+type RateLimitConfig struct {
+	// IPRate is the sustained number of requests per second allowed from one
+	// client IP, and IPBurst how many requests may be served at once. The burst
+	// defaults to the rate.
+	IPRate  float64
+	IPBurst int
+	// PrincipalRate and PrincipalBurst are the same limits for one principal.
+	PrincipalRate  float64
+	PrincipalBurst int
+	// TrustForwardedFor takes the client IP from the X-Forwarded-For header
+	// rather than from the connection: the rightmost entry that is not one of
+	// TrustedProxies. The entries left of it may have been sent by the client.
+	TrustForwardedFor bool
+	// TrustedProxies are the networks of the proxies in front of the service,
+	// such as the ingress and a load balancer, whose X-Forwarded-For entries
+	// are skipped. Without any, the entry added by the last proxy is used.
+	TrustedProxies []*net.IPNet
+	// Principal returns the principal the request claims to be, or "" if it
+	// has none; only the IP limit applies to such requests. See
+	// [BearerTokenSubject].
+	Principal func(*http.Request) string
+	// ExemptPaths are not rate limited, e.g. the health and metrics endpoints.
+	ExemptPaths []string
+}
+
+// RateLimitMiddleware limits the request rate of each client IP and of each
+// principal with token buckets. Requests over a limit are rejected with 429
+// and a Retry-After header telling the client when to try again.
+func RateLimitMiddleware(config RateLimitConfig) func(http.Handler) http.Handler {
+	ipLimiter := newRateLimiter(config.IPRate, config.IPBurst)
+	principalLimiter := newRateLimiter(config.PrincipalRate, config.PrincipalBurst)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for _, path := range config.ExemptPaths {
+				if r.URL.Path == path {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			if ipLimiter != nil {
+				ip := clientIP(r, config.TrustForwardedFor, config.TrustedProxies)
+				if ok, retryAfter := ipLimiter.allow(ip); !ok {
+					slog.WarnContext(r.Context(), "rate limit exceeded", "client_ip", ip)
+					writeTooManyRequests(w, r, retryAfter)
+					return
+				}
+			}
+			if principalLimiter != nil && config.Principal != nil {
+				if principal := config.Principal(r); principal != "" {
+					if ok, retryAfter := principalLimiter.allow(principal); !ok {
+						slog.WarnContext(r.Context(), "rate limit exceeded", "principal", principal)
+						writeTooManyRequests(w, r, retryAfter)
+						return
+					}
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// clientIP returns the IP of the client that sent r. With trustForwardedFor,
+// it is the rightmost X-Forwarded-For entry that is not a trusted proxy, as
+// every proxy appends the address it received the request from.
+func clientIP(r *http.Request, trustForwardedFor bool, trustedProxies []*net.IPNet) string {
+	if trustForwardedFor {
+		var hops []string
+		for _, forwarded := range r.Header.Values("X-Forwarded-For") {
+			for hop := range strings.SplitSeq(forwarded, ",") {
+				if hop = strings.TrimSpace(hop); hop != "" {
+					hops = append(hops, hop)
+				}
+			}
+		}
+		for i := len(hops) - 1; i >= 0; i-- {
+			if i == 0 || !trustedProxy(hops[i], trustedProxies) {
+				return hops[i]
+			}
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// trustedProxy reports whether hop is an IP in one of the trusted networks.
+func trustedProxy(hop string, trustedProxies []*net.IPNet) bool {
+	ip := net.ParseIP(hop)
+	if ip == nil {
+		return false
+	}
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// BearerTokenSubject returns the subject of the bearer token of r, or "" if
+// it has none. The token is not verified, which is left to the endpoints: the
+// limit is checked before them, and verifying every token twice would double
+// the cost of authentication. A forged token can only spend the limit of the
+// subject it names, while its sender is still held to the IP limit.
+func BearerTokenSubject(r *http.Request) string {
+	token, ok := strings.CutPrefix(r.Header.Get(constants.AuthorizationHeader), "Bearer ")
+	if !ok {
+		return ""
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return ""
+	}
+	var claims struct {
+		Subject string `json:"sub"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+	return claims.Subject
+}
+
+// writeTooManyRequests answers r with a 429 problem.
+func writeTooManyRequests(w http.ResponseWriter, r *http.Request, retryAfter time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	WriteProblem(w, NewProblem(r, http.StatusTooManyRequests, "rate-limit-exceeded", "Rate limit exceeded", "rate limit exceeded"))
+}
+
+// rateLimiter keeps a token bucket per key. Each bucket holds up to burst
+// tokens, refills at rate tokens per second, and spends one per request.
+type rateLimiter struct {
+	rate  float64
+	burst float64
+	now   func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter returns a limiter, or nil if rate is not positive. A burst
+// below one defaults to the rate, rounded up.
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = int(math.Ceil(rate))
+	}
+	return &rateLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		now:     time.Now,
+		buckets: map[string]*tokenBucket{},
+	}
+}
+
+// allow spends a token from the bucket of key. If the bucket is empty it
+// reports false and how long until a token is available.
+func (l *rateLimiter) allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[key] = bucket
+	}
+	bucket.tokens = math.Min(l.burst, bucket.tokens+now.Sub(bucket.last).Seconds()*l.rate)
+	bucket.last = now
+
+	if bucket.tokens < 1 {
+		return false, time.Duration((1 - bucket.tokens) / l.rate * float64(time.Second))
+	}
+	bucket.tokens--
+	return true, 0
+}
+
+// sweep drops, at most once per refill period, the buckets that have been idle
+// long enough to be full again, so that the limiter does not keep a bucket
+// for every client it has ever seen.
+func (l *rateLimiter) sweep(now time.Time) {
+	refill := time.Duration(l.burst / l.rate * float64(time.Second))
+	if now.Sub(l.lastSweep) < refill {
+		return
+	}
+	l.lastSweep = now
+	for key, bucket := range l.buckets {
+		if now.Sub(bucket.last) >= refill {
+			delete(l.buckets, key)
+		}
+	}
+}
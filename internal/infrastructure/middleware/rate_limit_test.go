@@ -0,0 +1,236 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package middleware
+
+import (
+	"encoding/base64"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter_allow(t *testing.T) {
+	now := time.Now()
+	limiter := newRateLimiter(2, 3)
+	limiter.now = func() time.Time { return now }
+
+	// The burst is served at once, then requests wait for the refill.
+	for i := 0; i < 3; i++ {
+		ok, _ := limiter.allow("a")
+		assert.True(t, ok, "request %d", i)
+	}
+	ok, retryAfter := limiter.allow("a")
+	assert.False(t, ok)
+	assert.Equal(t, 500*time.Millisecond, retryAfter)
+
+	// Other keys have their own bucket.
+	ok, _ = limiter.allow("b")
+	assert.True(t, ok)
+
+	now = now.Add(500 * time.Millisecond)
+	ok, _ = limiter.allow("a")
+	assert.True(t, ok)
+	ok, _ = limiter.allow("a")
+	assert.False(t, ok)
+
+	// Buckets idle long enough to be full again are dropped.
+	now = now.Add(2 * time.Second)
+	ok, _ = limiter.allow("c")
+	assert.True(t, ok)
+	assert.Len(t, limiter.buckets, 1)
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	assert.Nil(t, newRateLimiter(0, 10), "disabled")
+	assert.Equal(t, float64(10), newRateLimiter(2, 10).burst)
+	assert.Equal(t, float64(3), newRateLimiter(2.5, 0).burst, "burst defaults to the rate")
+	assert.Equal(t, float64(1), newRateLimiter(0.1, 0).burst)
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     RateLimitConfig
+		requests   func() []*http.Request
+		wantStatus []int
+	}{
+		{
+			name:   "per IP",
+			config: RateLimitConfig{IPRate: 1, IPBurst: 1},
+			requests: func() []*http.Request {
+				first := httptest.NewRequest(http.MethodGet, "/projects", nil)
+				first.RemoteAddr = "10.0.0.1:1234"
+				second := httptest.NewRequest(http.MethodGet, "/projects", nil)
+				second.RemoteAddr = "10.0.0.1:5678"
+				other := httptest.NewRequest(http.MethodGet, "/projects", nil)
+				other.RemoteAddr = "10.0.0.2:1234"
+				return []*http.Request{first, second, other}
+			},
+			wantStatus: []int{http.StatusOK, http.StatusTooManyRequests, http.StatusOK},
+		},
+		{
+			name:   "per forwarded IP",
+			config: RateLimitConfig{IPRate: 1, IPBurst: 1, TrustForwardedFor: true, TrustedProxies: []*net.IPNet{mustParseCIDR("10.0.0.0/8")}},
+			requests: func() []*http.Request {
+				var requests []*http.Request
+				for _, forwarded := range []string{"192.0.2.1, 10.0.0.1", "192.0.2.2, 10.0.0.1", "192.0.2.1"} {
+					r := httptest.NewRequest(http.MethodGet, "/projects", nil)
+					r.Header.Set("X-Forwarded-For", forwarded)
+					requests = append(requests, r)
+				}
+				return requests
+			},
+			wantStatus: []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests},
+		},
+		{
+			name: "per principal",
+			config: RateLimitConfig{
+				PrincipalRate:  1,
+				PrincipalBurst: 1,
+				Principal:      func(r *http.Request) string { return r.Header.Get("X-Test-Principal") },
+			},
+			requests: func() []*http.Request {
+				var requests []*http.Request
+				for _, principal := range []string{"alice", "bob", "alice", ""} {
+					r := httptest.NewRequest(http.MethodGet, "/projects", nil)
+					r.Header.Set("X-Test-Principal", principal)
+					requests = append(requests, r)
+				}
+				return requests
+			},
+			wantStatus: []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests, http.StatusOK},
+		},
+		{
+			name:   "exempt paths",
+			config: RateLimitConfig{IPRate: 1, IPBurst: 1, ExemptPaths: []string{"/livez"}},
+			requests: func() []*http.Request {
+				return []*http.Request{
+					httptest.NewRequest(http.MethodGet, "/livez", nil),
+					httptest.NewRequest(http.MethodGet, "/livez", nil),
+				}
+			},
+			wantStatus: []int{http.StatusOK, http.StatusOK},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := RateLimitMiddleware(tt.config)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			for i, r := range tt.requests() {
+				rr := httptest.NewRecorder()
+				handler.ServeHTTP(rr, r)
+				assert.Equal(t, tt.wantStatus[i], rr.Code, "request %d", i)
+				if rr.Code == http.StatusTooManyRequests {
+					assert.Equal(t, "1", rr.Header().Get("Retry-After"))
+					assert.Equal(t, ProblemContentType, rr.Header().Get("Content-Type"))
+					assert.JSONEq(t, `{"type":"urn:lfx:project-service:problem:rate-limit-exceeded","title":"Rate limit exceeded","status":429,`+
+						`"detail":"rate limit exceeded","instance":"`+r.URL.Path+`","code":"429","message":"rate limit exceeded"}`, rr.Body.String())
+				}
+			}
+		})
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	proxies := []*net.IPNet{mustParseCIDR("10.0.0.0/8")}
+
+	tests := []struct {
+		name              string
+		forwarded         []string
+		trustForwardedFor bool
+		trustedProxies    []*net.IPNet
+		want              string
+	}{
+		{
+			name:      "connection address",
+			forwarded: []string{"198.51.100.7"},
+			want:      "192.0.2.1",
+		},
+		{
+			name:              "entry added by the last proxy",
+			forwarded:         []string{"198.51.100.7, 203.0.113.9"},
+			trustForwardedFor: true,
+			want:              "203.0.113.9",
+		},
+		{
+			name:              "forged entries left of the client are ignored",
+			forwarded:         []string{"198.51.100.7, 203.0.113.9, 10.0.0.2"},
+			trustForwardedFor: true,
+			trustedProxies:    proxies,
+			want:              "203.0.113.9",
+		},
+		{
+			name:              "entries across headers",
+			forwarded:         []string{"198.51.100.7", "203.0.113.9", "10.0.0.3, 10.0.0.2"},
+			trustForwardedFor: true,
+			trustedProxies:    proxies,
+			want:              "203.0.113.9",
+		},
+		{
+			name:              "only trusted proxies",
+			forwarded:         []string{"10.0.0.3, 10.0.0.2"},
+			trustForwardedFor: true,
+			trustedProxies:    proxies,
+			want:              "10.0.0.3",
+		},
+		{
+			name:              "no header",
+			trustForwardedFor: true,
+			want:              "192.0.2.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/projects", nil)
+			for _, forwarded := range tt.forwarded {
+				r.Header.Add("X-Forwarded-For", forwarded)
+			}
+
+			assert.Equal(t, tt.want, clientIP(r, tt.trustForwardedFor, tt.trustedProxies))
+		})
+	}
+}
+
+func TestBearerTokenSubject(t *testing.T) {
+	// Signed with a key the service does not know; the subject is read anyway.
+	token := "eyJhbGciOiJIUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"auth0|alice"}`)) + ".c2lnbmF0dXJl"
+
+	tests := []struct {
+		name          string
+		authorization string
+		want          string
+	}{
+		{name: "bearer token", authorization: "Bearer " + token, want: "auth0|alice"},
+		{name: "no token"},
+		{name: "not a bearer token", authorization: "Basic YWxpY2U6c2VjcmV0"},
+		{name: "malformed token", authorization: "Bearer not-a-token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/projects", nil)
+			if tt.authorization != "" {
+				r.Header.Set("Authorization", tt.authorization)
+			}
+
+			assert.Equal(t, tt.want, BearerTokenSubject(r))
+		})
+	}
+}
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
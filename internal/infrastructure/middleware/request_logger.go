@@ -4,16 +4,60 @@
 package middleware
 
 import (
+	"bytes"
+	"encoding/json"
+	"io"
 	"log/slog"
+	"math/rand/v2"
 	"net/http"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
 	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
 )
 
-// RequestLoggerMiddleware creates a middleware that logs HTTP requests
-func RequestLoggerMiddleware() func(http.Handler) http.Handler {
+// defaultLoggedBodyBytes is how much of a sampled body is logged when
+// RequestLoggerConfig.MaxBodyBytes is not set.
+const defaultLoggedBodyBytes = 2048
+
+// emailPattern matches the email addresses redacted from logged bodies, such
+// as those of the writers and auditors in project settings payloads.
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+// redactedBodyFields are the fields of logged JSON bodies whose values
+// identify users, such as the members of project settings, and are redacted.
+var redactedBodyFields = map[string]bool{
+	"username": true,
+	"name":     true,
+	"email":    true,
+}
+
+// redacted replaces the redacted values of logged bodies.
+const redacted = "[redacted]"
+
+// RequestLoggerConfig configures the optional body logging of
+// RequestLoggerMiddleware.
+type RequestLoggerConfig struct {
+	// BodySamplePercent is the percentage of requests, from 0 to 100, whose
+	// request and response bodies are logged with the response. Only JSON
+	// bodies are logged, with their username, name and email fields and any
+	// other email address redacted; multipart uploads are never logged.
+	BodySamplePercent float64
+	// MaxBodyBytes is how much of each sampled body is logged; the rest is
+	// cut off. It defaults to 2 KiB.
+	MaxBodyBytes int
+}
+
+// RequestLoggerMiddleware creates a middleware that logs HTTP requests, and
+// logs their responses with their size and outcome.
+func RequestLoggerMiddleware(config RequestLoggerConfig) func(http.Handler) http.Handler {
+	maxBodyBytes := config.MaxBodyBytes
+	if maxBodyBytes <= 0 {
+		maxBodyBytes = defaultLoggedBodyBytes
+	}
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			start := time.Now()
@@ -31,13 +75,27 @@ func RequestLoggerMiddleware() func(http.Handler) http.Handler {
 				ctx = log.AppendCtx(ctx, slog.String("req_header_etag", r.Header.Get(constants.EtagHeader)))
 			}
 
-			isHealthCheck := r.URL.Path == "/livez" || r.URL.Path == "/readyz"
+			isHealthCheck := r.URL.Path == "/livez" || r.URL.Path == "/readyz" || r.URL.Path == "/healthz" || r.URL.Path == "/metrics"
+
+			// Count the request body as the handler reads it, and capture the
+			// bodies of the sampled requests.
+			sampled := !isHealthCheck && sampleBody(r, config.BodySamplePercent)
+			body := &bodyRecorder{ReadCloser: r.Body}
+			if sampled {
+				body.capture = &cappedBuffer{max: maxBodyBytes}
+			}
+			if r.Body != nil && r.Body != http.NoBody {
+				r.Body = body
+			}
 
 			// Create a new request with the updated context
 			r = r.WithContext(ctx)
 
 			// Create a response writer wrapper to capture status code
 			ww := &responseWriter{ResponseWriter: w}
+			if sampled {
+				ww.capture = &cappedBuffer{max: maxBodyBytes}
+			}
 
 			if isHealthCheck {
 				slog.DebugContext(ctx, "HTTP request")
@@ -51,20 +109,206 @@ func RequestLoggerMiddleware() func(http.Handler) http.Handler {
 			// Calculate duration
 			duration := time.Since(start)
 
+			status := ww.status()
 			if isHealthCheck {
-				slog.DebugContext(ctx, "HTTP response", "status", ww.statusCode, "duration", duration.String())
+				slog.DebugContext(ctx, "HTTP response", "status", status, "duration", duration.String())
 				return
 			}
+
+			attrs := []any{
+				"status", status,
+				"duration", duration.String(),
+				"outcome", outcome(status),
+				"request_bytes", body.size,
+				"response_bytes", ww.size,
+			}
+			if sampled {
+				attrs = append(attrs,
+					"request_body", body.capture.String(),
+					"response_body", ww.capture.String(),
+				)
+			}
 			// Log the response
-			slog.InfoContext(ctx, "HTTP response", "status", ww.statusCode, "duration", duration.String())
+			slog.InfoContext(ctx, "HTTP response", attrs...)
 		})
 	}
 }
 
+// sampleBody reports, at random, whether the bodies of r are logged.
+func sampleBody(r *http.Request, percent float64) bool {
+	if percent <= 0 || strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+		return false
+	}
+	return rand.Float64()*100 < percent
+}
+
+// outcome classifies a response status for filtering the logs: malformed
+// client payloads are told apart from the other client errors.
+func outcome(status int) string {
+	switch {
+	case status == http.StatusBadRequest, status == http.StatusRequestEntityTooLarge, status == http.StatusUnprocessableEntity:
+		return "invalid_payload"
+	case status >= 500:
+		return "server_error"
+	case status >= 400:
+		return "client_error"
+	default:
+		return "success"
+	}
+}
+
+// cappedBuffer keeps the first max bytes written to it.
+type cappedBuffer struct {
+	buf       bytes.Buffer
+	max       int
+	truncated bool
+}
+
+func (b *cappedBuffer) write(p []byte) {
+	if room := b.max - b.buf.Len(); len(p) > room {
+		p = p[:max(room, 0)]
+		b.truncated = true
+	}
+	b.buf.Write(p)
+}
+
+// String returns the kept bytes as redacted JSON, marking whether the body was
+// cut off. A body that is not JSON is left out.
+func (b *cappedBuffer) String() string {
+	if b.buf.Len() == 0 {
+		return ""
+	}
+	s, ok := redactJSON(b.buf.Bytes())
+	if !ok {
+		return "[not JSON, not logged]"
+	}
+	if b.truncated {
+		s += "...(truncated)"
+	}
+	return s
+}
+
+// redactJSON returns data, re-encoded token by token, with the values of the
+// redactedBodyFields and the email addresses of every other string redacted.
+// A body cut off by cappedBuffer is returned up to its last whole token. It
+// reports false when data does not start with a JSON value.
+func redactJSON(data []byte) (string, bool) {
+	type container struct {
+		object bool
+		// key is whether an object expects a key next.
+		key bool
+		// n is the number of values of the container so far.
+		n int
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var out strings.Builder
+	var stack []*container
+	redactValue := false
+	for {
+		// The end of data, or of its valid part, ends the body.
+		token, err := dec.Token()
+		if err != nil {
+			return out.String(), out.Len() > 0
+		}
+
+		if delim, ok := token.(json.Delim); ok && (delim == '}' || delim == ']') {
+			out.WriteRune(rune(delim))
+			stack = stack[:len(stack)-1]
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.n++
+				parent.key = parent.object
+			}
+			continue
+		}
+
+		var top *container
+		if len(stack) > 0 {
+			top = stack[len(stack)-1]
+		}
+		switch {
+		case top == nil:
+		case top.object && top.key:
+			if top.n > 0 {
+				out.WriteByte(',')
+			}
+		case top.object:
+			out.WriteByte(':')
+		case top.n > 0:
+			out.WriteByte(',')
+		}
+
+		if top != nil && top.object && top.key {
+			key, _ := token.(string)
+			out.WriteString(quoteJSON(key))
+			redactValue = redactedBodyFields[key]
+			top.key = false
+			continue
+		}
+
+		switch v := token.(type) {
+		case json.Delim:
+			out.WriteRune(rune(v))
+			stack = append(stack, &container{object: v == '{', key: v == '{'})
+			redactValue = false
+			continue
+		case string:
+			if redactValue {
+				v = redacted
+			}
+			out.WriteString(quoteJSON(emailPattern.ReplaceAllString(v, redacted)))
+		case json.Number:
+			if redactValue {
+				out.WriteString(quoteJSON(redacted))
+			} else {
+				out.WriteString(v.String())
+			}
+		default:
+			encoded, _ := json.Marshal(v)
+			out.Write(encoded)
+		}
+		redactValue = false
+		if top != nil {
+			top.n++
+			top.key = top.object
+		}
+	}
+}
+
+// quoteJSON returns s as a JSON string, without escaping HTML characters.
+func quoteJSON(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// bodyRecorder wraps a request body to count the bytes read from it and,
+// when the request is sampled, to capture them.
+type bodyRecorder struct {
+	io.ReadCloser
+	size    int64
+	capture *cappedBuffer
+}
+
+func (b *bodyRecorder) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.size += int64(n)
+	if b.capture != nil {
+		b.capture.write(p[:n])
+	}
+	return n, err
+}
+
 // responseWriter wraps http.ResponseWriter to capture status code
 type responseWriter struct {
 	http.ResponseWriter
 	statusCode int
+	size       int64
+	capture    *cappedBuffer
 }
 
 func (rw *responseWriter) WriteHeader(code int) {
@@ -73,5 +317,19 @@ func (rw *responseWriter) WriteHeader(code int) {
 }
 
 func (rw *responseWriter) Write(b []byte) (int, error) {
-	return rw.ResponseWriter.Write(b)
+	n, err := rw.ResponseWriter.Write(b)
+	rw.size += int64(n)
+	if rw.capture != nil {
+		rw.capture.write(b[:n])
+	}
+	return n, err
+}
+
+// status returns the status code of the response, which is 200 when the
+// handler wrote the body without setting one.
+func (rw *responseWriter) status() int {
+	if rw.statusCode == 0 {
+		return http.StatusOK
+	}
+	return rw.statusCode
 }
@@ -4,12 +4,18 @@
 package middleware
 
 import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRequestLoggerMiddleware(t *testing.T) {
@@ -60,7 +66,7 @@ func TestRequestLoggerMiddleware(t *testing.T) {
 			})
 
 			// Wrap with RequestLoggerMiddleware
-			middleware := RequestLoggerMiddleware()
+			middleware := RequestLoggerMiddleware(RequestLoggerConfig{})
 			wrappedHandler := middleware(handler)
 
 			// Create request
@@ -106,3 +112,146 @@ func TestResponseWriterWrapper(t *testing.T) {
 	assertion.Equal(len(content), n)
 	assertion.Equal(content, rec.Body.Bytes())
 }
+
+// loggedResponse serves req with handler behind RequestLoggerMiddleware and
+// returns the attributes of the logged response.
+func loggedResponse(t *testing.T, config RequestLoggerConfig, handler http.HandlerFunc, req *http.Request) map[string]any {
+	t.Helper()
+	var buf bytes.Buffer
+	previous := slog.Default()
+	defer slog.SetDefault(previous)
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&buf, nil)))
+
+	RequestLoggerMiddleware(config)(handler).ServeHTTP(httptest.NewRecorder(), req)
+
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var record map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		if record["msg"] == "HTTP response" {
+			return record
+		}
+	}
+	t.Fatal("no response logged")
+	return nil
+}
+
+func TestRequestLoggerMiddleware_Bodies(t *testing.T) {
+	echo := func(status int) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			w.WriteHeader(status)
+			w.Write(body) //nolint:errcheck
+		}
+	}
+	settings := `{"writers":[{"username":"alice","email":"alice@example.com"}]}`
+
+	tests := []struct {
+		name                 string
+		config               RequestLoggerConfig
+		status               int
+		body                 string
+		contentType          string
+		expectedOutcome      string
+		expectedRequestBody  any
+		expectedResponseBody any
+	}{
+		{
+			name:            "bodies not sampled",
+			config:          RequestLoggerConfig{},
+			status:          http.StatusOK,
+			body:            settings,
+			expectedOutcome: "success",
+		},
+		{
+			name:                 "sampled settings bodies are redacted",
+			config:               RequestLoggerConfig{BodySamplePercent: 100},
+			status:               http.StatusOK,
+			body:                 settings,
+			expectedOutcome:      "success",
+			expectedRequestBody:  `{"writers":[{"username":"[redacted]","email":"[redacted]"}]}`,
+			expectedResponseBody: `{"writers":[{"username":"[redacted]","email":"[redacted]"}]}`,
+		},
+		{
+			name:                 "sampled bodies keep the other fields",
+			config:               RequestLoggerConfig{BodySamplePercent: 100},
+			status:               http.StatusOK,
+			body:                 `{"uid":"p-1","name":"Jane Doe","stage":"<Active>","description":"ask jane@example.com","public":true,"depth":2,"tags":[null]}`,
+			expectedOutcome:      "success",
+			expectedRequestBody:  `{"uid":"p-1","name":"[redacted]","stage":"<Active>","description":"ask [redacted]","public":true,"depth":2,"tags":[null]}`,
+			expectedResponseBody: `{"uid":"p-1","name":"[redacted]","stage":"<Active>","description":"ask [redacted]","public":true,"depth":2,"tags":[null]}`,
+		},
+		{
+			name:                 "sampled bodies that are not JSON are left out",
+			config:               RequestLoggerConfig{BodySamplePercent: 100},
+			status:               http.StatusOK,
+			body:                 "username=alice",
+			expectedOutcome:      "success",
+			expectedRequestBody:  "[not JSON, not logged]",
+			expectedResponseBody: "[not JSON, not logged]",
+		},
+		{
+			name:                 "sampled bodies are truncated",
+			config:               RequestLoggerConfig{BodySamplePercent: 100, MaxBodyBytes: 5},
+			status:               http.StatusBadRequest,
+			body:                 `{"username":"alice"}`,
+			expectedOutcome:      "invalid_payload",
+			expectedRequestBody:  `{...(truncated)`,
+			expectedResponseBody: `{...(truncated)`,
+		},
+		{
+			name:                 "sampled bodies are redacted up to the cut",
+			config:               RequestLoggerConfig{BodySamplePercent: 100, MaxBodyBytes: 32},
+			status:               http.StatusOK,
+			body:                 settings,
+			expectedOutcome:      "success",
+			expectedRequestBody:  `{"writers":[{"username":"[redacted]"...(truncated)`,
+			expectedResponseBody: `{"writers":[{"username":"[redacted]"...(truncated)`,
+		},
+		{
+			name:            "multipart bodies are never sampled",
+			config:          RequestLoggerConfig{BodySamplePercent: 100},
+			status:          http.StatusInternalServerError,
+			body:            "--boundary--",
+			contentType:     "multipart/form-data; boundary=boundary",
+			expectedOutcome: "server_error",
+		},
+		{
+			name:            "other client errors",
+			config:          RequestLoggerConfig{},
+			status:          http.StatusNotFound,
+			body:            "{}",
+			expectedOutcome: "client_error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/projects/uid/settings", strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+
+			record := loggedResponse(t, tt.config, echo(tt.status), req)
+
+			assert.Equal(t, float64(tt.status), record["status"])
+			assert.Equal(t, tt.expectedOutcome, record["outcome"])
+			assert.Equal(t, float64(len(tt.body)), record["request_bytes"])
+			assert.Equal(t, float64(len(tt.body)), record["response_bytes"])
+			assert.Equal(t, tt.expectedRequestBody, record["request_body"])
+			assert.Equal(t, tt.expectedResponseBody, record["response_body"])
+		})
+	}
+}
+
+func TestRequestLoggerMiddleware_ImplicitStatus(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok")) //nolint:errcheck
+	}
+
+	record := loggedResponse(t, RequestLoggerConfig{}, handler, httptest.NewRequest(http.MethodGet, "/projects", nil))
+
+	assert.Equal(t, float64(http.StatusOK), record["status"])
+	assert.Equal(t, "success", record["outcome"])
+	assert.Equal(t, float64(0), record["request_bytes"])
+	assert.Equal(t, float64(2), record["response_bytes"])
+}
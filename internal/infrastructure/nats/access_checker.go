@@ -0,0 +1,66 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	natsgo "github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// AccessCheckerNATS implements domain.AccessChecker via NATS requests to the
+// access check service.
+type AccessCheckerNATS struct {
+	NatsConn INatsConn
+}
+
+// CheckAccess asks the access check service whether principal has relation on
+// object. The request is a single "<object>#<relation>@user:<principal>" tuple;
+// the reply repeats the tuple followed by a tab and "true" or "false".
+func (a *AccessCheckerNATS) CheckAccess(ctx context.Context, principal, object, relation string) (bool, error) {
+	tuple := fmt.Sprintf("%s#%s@user:%s", object, relation, principal)
+
+	ctx, span := tracer.Start(ctx, "nats.request",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "nats"),
+			attribute.String("messaging.destination.name", constants.AccessCheckSubject),
+			attribute.Int("messaging.message.body.size", len(tuple)),
+		),
+	)
+	defer span.End()
+
+	msg := natsgo.NewMsg(constants.AccessCheckSubject)
+	msg.Header = make(natsgo.Header)
+	msg.Data = []byte(tuple)
+	otel.GetTextMapPropagator().Inject(ctx, natsHeaderCarrier(msg.Header))
+
+	reply, err := a.NatsConn.RequestMsgWithContext(ctx, msg)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return false, fmt.Errorf("access check request failed: %w", err)
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(string(reply.Data)), "\n") {
+		checked, result, ok := strings.Cut(line, "\t")
+		if ok && checked == tuple {
+			span.SetStatus(codes.Ok, "")
+			return result == "true", nil
+		}
+	}
+
+	err = fmt.Errorf("access check response has no result for %q", tuple)
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	return false, err
+}
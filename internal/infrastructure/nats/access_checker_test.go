@@ -0,0 +1,70 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+func TestAccessCheckerNATS_CheckAccess(t *testing.T) {
+	const tuple = "project:project-1#writer@user:alice"
+
+	tests := []struct {
+		name       string
+		reply      *natsgo.Msg // nil simulates a transport error
+		replyErr   error
+		wantAllow  bool
+		wantErrStr string
+	}{
+		{
+			name:      "allowed",
+			reply:     replyMsg([]byte(tuple + "\ttrue\n")),
+			wantAllow: true,
+		},
+		{
+			name:  "denied",
+			reply: replyMsg([]byte(tuple + "\tfalse")),
+		},
+		{
+			name:       "response without the requested tuple",
+			reply:      replyMsg([]byte("project:other#writer@user:alice\ttrue")),
+			wantErrStr: "access check response has no result",
+		},
+		{
+			name:       "transport error",
+			replyErr:   errors.New("nats: timeout"),
+			wantErrStr: "access check request failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockConn := &MockNATSConn{}
+			mockConn.On("RequestMsgWithContext", mock.Anything, mock.MatchedBy(func(msg *natsgo.Msg) bool {
+				return msg.Subject == constants.AccessCheckSubject && string(msg.Data) == tuple
+			})).Return(tt.reply, tt.replyErr)
+
+			checker := &AccessCheckerNATS{NatsConn: mockConn}
+			allowed, err := checker.CheckAccess(context.Background(), "alice", "project:project-1", "writer")
+
+			if tt.wantErrStr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrStr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantAllow, allowed)
+			mockConn.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// announcementKey is the key of a project's announcement for one date, so that
+// moving the announcement date announces the project again.
+func announcementKey(announcement *models.ProjectAnnouncement) string {
+	return fmt.Sprintf("%s.%s", announcement.ProjectUID, announcement.AnnouncementDate.UTC().Format("2006-01-02"))
+}
+
+// CreateProjectAnnouncement records a published announcement, or returns
+// ErrAnnouncementExists when it was already recorded, e.g. by another replica.
+func (s *NatsRepository) CreateProjectAnnouncement(ctx context.Context, announcement *models.ProjectAnnouncement) error {
+	data, err := json.Marshal(announcement)
+	if err != nil {
+		slog.ErrorContext(ctx, "error marshalling project announcement into JSON", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	key := announcementKey(announcement)
+	if _, err := s.Announcements.Create(ctx, key, data); err != nil {
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			return domain.ErrAnnouncementExists
+		}
+		slog.ErrorContext(ctx, "error creating project announcement in NATS KV store", constants.ErrKey, err, "key", key)
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+// ListProjectAnnouncements returns the recorded announcements.
+func (s *NatsRepository) ListProjectAnnouncements(ctx context.Context) ([]*models.ProjectAnnouncement, error) {
+	keysLister, err := s.Announcements.ListKeys(ctx)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return []*models.ProjectAnnouncement{}, nil
+		}
+		slog.ErrorContext(ctx, "error listing project announcement keys from NATS KV store", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+
+	announcements := []*models.ProjectAnnouncement{}
+	for key := range keysLister.Keys() {
+		entry, err := s.Announcements.Get(ctx, key)
+		if err != nil {
+			if errors.Is(err, jetstream.ErrKeyNotFound) {
+				continue
+			}
+			slog.ErrorContext(ctx, "error getting project announcement from NATS KV store", constants.ErrKey, err, "key", key)
+			return nil, domain.ErrInternal
+		}
+		announcement := &models.ProjectAnnouncement{}
+		if err := json.Unmarshal(entry.Value(), announcement); err != nil {
+			slog.ErrorContext(ctx, "error unmarshalling project announcement from NATS KV store", constants.ErrKey, err, "key", key)
+			return nil, domain.ErrUnmarshal
+		}
+		announcements = append(announcements, announcement)
+	}
+	return announcements, nil
+}
@@ -0,0 +1,57 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// GetProjectAssociations returns a project's associations, or empty associations
+// when none were stored.
+func (s *NatsRepository) GetProjectAssociations(ctx context.Context, projectUID string) (*models.ProjectAssociations, error) {
+	entry, err := s.Associations.Get(ctx, projectUID)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return &models.ProjectAssociations{
+				ProjectUID:       projectUID,
+				CommitteeUIDs:    []string{},
+				MailingListIDs:   []string{},
+				MeetingSeriesIDs: []string{},
+			}, nil
+		}
+		slog.ErrorContext(ctx, "error getting project associations from NATS KV store", constants.ErrKey, err, "project_uid", projectUID)
+		return nil, domain.ErrInternal
+	}
+
+	associations := &models.ProjectAssociations{}
+	if err := json.Unmarshal(entry.Value(), associations); err != nil {
+		slog.ErrorContext(ctx, "error unmarshalling project associations from NATS KV store", constants.ErrKey, err, "project_uid", projectUID)
+		return nil, domain.ErrUnmarshal
+	}
+	return associations, nil
+}
+
+// PutProjectAssociations replaces a project's associations.
+func (s *NatsRepository) PutProjectAssociations(ctx context.Context, associations *models.ProjectAssociations) error {
+	data, err := json.Marshal(associations)
+	if err != nil {
+		slog.ErrorContext(ctx, "error marshalling project associations into JSON", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	if _, err := s.Associations.Put(ctx, associations.ProjectUID, data); err != nil {
+		slog.ErrorContext(ctx, "error putting project associations into NATS KV store", constants.ErrKey, err, "project_uid", associations.ProjectUID)
+		return domain.ErrInternal
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	natsgo "github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// associationSubjects maps each association kind to the lookup subject of the
+// service that owns the resources of that kind.
+var associationSubjects = map[models.AssociationKind]string{
+	models.AssociationKindCommittee:     constants.CommitteeGetNameSubject,
+	models.AssociationKindMailingList:   constants.MailingListGetNameSubject,
+	models.AssociationKindMeetingSeries: constants.MeetingSeriesGetNameSubject,
+}
+
+// AssociationCheckerNATS implements domain.AssociationChecker via NATS requests
+// to the services that own committees, mailing lists and meeting series.
+type AssociationCheckerNATS struct {
+	NatsConn INatsConn
+}
+
+// AssociationExists looks the resource up by its ID on the owning service's
+// get_name subject, which replies with the resource's name, or with an empty
+// body when it does not exist.
+func (a *AssociationCheckerNATS) AssociationExists(ctx context.Context, kind models.AssociationKind, id string) (bool, error) {
+	subject, ok := associationSubjects[kind]
+	if !ok {
+		return false, fmt.Errorf("unknown association kind %q", kind)
+	}
+
+	ctx, span := tracer.Start(ctx, "nats.request",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "nats"),
+			attribute.String("messaging.destination.name", subject),
+			attribute.Int("messaging.message.body.size", len(id)),
+		),
+	)
+	defer span.End()
+
+	msg := natsgo.NewMsg(subject)
+	msg.Header = make(natsgo.Header)
+	msg.Data = []byte(id)
+	otel.GetTextMapPropagator().Inject(ctx, natsHeaderCarrier(msg.Header))
+
+	reply, err := a.NatsConn.RequestMsgWithContext(ctx, msg)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return false, fmt.Errorf("%s lookup request failed: %w", kind, err)
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return strings.TrimSpace(string(reply.Data)) != "", nil
+}
@@ -0,0 +1,79 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+func TestAssociationCheckerNATS_AssociationExists(t *testing.T) {
+	tests := []struct {
+		name       string
+		kind       models.AssociationKind
+		subject    string
+		reply      *natsgo.Msg // nil simulates a transport error
+		replyErr   error
+		wantExists bool
+		wantErrStr string
+	}{
+		{
+			name:       "committee exists",
+			kind:       models.AssociationKindCommittee,
+			subject:    constants.CommitteeGetNameSubject,
+			reply:      replyMsg([]byte("Technical Steering Committee")),
+			wantExists: true,
+		},
+		{
+			name:    "mailing list not found",
+			kind:    models.AssociationKindMailingList,
+			subject: constants.MailingListGetNameSubject,
+			reply:   replyMsg([]byte("\n")),
+		},
+		{
+			name:       "meeting series lookup fails",
+			kind:       models.AssociationKindMeetingSeries,
+			subject:    constants.MeetingSeriesGetNameSubject,
+			replyErr:   errors.New("nats: no responders available for request"),
+			wantErrStr: "meeting_series lookup request failed",
+		},
+		{
+			name:       "unknown kind",
+			kind:       "repository",
+			wantErrStr: "unknown association kind",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockConn := &MockNATSConn{}
+			if tt.subject != "" {
+				mockConn.On("RequestMsgWithContext", mock.Anything, mock.MatchedBy(func(msg *natsgo.Msg) bool {
+					return msg.Subject == tt.subject && string(msg.Data) == "resource-1"
+				})).Return(tt.reply, tt.replyErr)
+			}
+
+			checker := &AssociationCheckerNATS{NatsConn: mockConn}
+			exists, err := checker.AssociationExists(context.Background(), tt.kind, "resource-1")
+
+			if tt.wantErrStr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrStr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantExists, exists)
+			mockConn.AssertExpectations(t)
+		})
+	}
+}
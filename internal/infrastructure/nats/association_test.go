@@ -0,0 +1,102 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNatsRepository_GetProjectAssociations(t *testing.T) {
+	stored := &models.ProjectAssociations{
+		ProjectUID:       "project-1",
+		CommitteeUIDs:    []string{"committee-1"},
+		MailingListIDs:   []string{"119563"},
+		MeetingSeriesIDs: []string{},
+	}
+	storedData, _ := json.Marshal(stored)
+
+	tests := []struct {
+		name        string
+		setupMock   func(kv *MockKeyValue)
+		expected    *models.ProjectAssociations
+		expectedErr error
+	}{
+		{
+			name: "stored associations",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("Get", mock.Anything, "project-1").Return(NewMockKeyValueEntry(storedData, 2), nil)
+			},
+			expected: stored,
+		},
+		{
+			name: "project without associations",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("Get", mock.Anything, "project-1").Return(nil, jetstream.ErrKeyNotFound)
+			},
+			expected: &models.ProjectAssociations{
+				ProjectUID:       "project-1",
+				CommitteeUIDs:    []string{},
+				MailingListIDs:   []string{},
+				MeetingSeriesIDs: []string{},
+			},
+		},
+		{
+			name: "store error",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("Get", mock.Anything, "project-1").Return(nil, errors.New("nats error"))
+			},
+			expectedErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kv := &MockKeyValue{}
+			tt.setupMock(kv)
+			repo := &NatsRepository{Associations: kv}
+
+			associations, err := repo.GetProjectAssociations(context.Background(), "project-1")
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, associations)
+			}
+			kv.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNatsRepository_PutProjectAssociations(t *testing.T) {
+	associations := &models.ProjectAssociations{ProjectUID: "project-1", CommitteeUIDs: []string{"committee-1"}}
+	data, _ := json.Marshal(associations)
+
+	t.Run("associations are stored under the project UID", func(t *testing.T) {
+		kv := &MockKeyValue{}
+		kv.On("Put", mock.Anything, "project-1", data).Return(uint64(1), nil)
+		repo := &NatsRepository{Associations: kv}
+
+		require.NoError(t, repo.PutProjectAssociations(context.Background(), associations))
+		kv.AssertExpectations(t)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		kv := &MockKeyValue{}
+		kv.On("Put", mock.Anything, "project-1", data).Return(uint64(0), errors.New("nats error"))
+		repo := &NatsRepository{Associations: kv}
+
+		assert.ErrorIs(t, repo.PutProjectAssociations(context.Background(), associations), domain.ErrInternal)
+	})
+}
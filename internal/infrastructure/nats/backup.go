@@ -0,0 +1,75 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// backupBuckets returns the buckets saved in backups by name.
+func (s *NatsRepository) backupBuckets() map[string]INatsKeyValue {
+	return map[string]INatsKeyValue{
+		constants.KVStoreNameProjects:        s.Projects,
+		constants.KVStoreNameProjectSettings: s.ProjectSettings,
+	}
+}
+
+// ExportBackupEntries returns every entry of the projects and project-settings
+// buckets, including slug and legacy ID mappings. Values are read through the
+// bucket wrappers, so compressed values are saved decompressed.
+func (s *NatsRepository) ExportBackupEntries(ctx context.Context) ([]models.BackupEntry, error) {
+	entries := []models.BackupEntry{}
+	for _, bucket := range []string{constants.KVStoreNameProjects, constants.KVStoreNameProjectSettings} {
+		err := scanKeys(ctx, s.backupBuckets()[bucket], func(key string, entry jetstream.KeyValueEntry) error {
+			entries = append(entries, models.BackupEntry{
+				Bucket:     bucket,
+				Key:        key,
+				ProjectUID: backupEntryProjectUID(key, entry.Value()),
+				Value:      entry.Value(),
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// backupEntryProjectUID returns the project an entry belongs to. The project
+// documents and settings are keyed by project UID, and slug and legacy ID
+// mappings hold the UID of the project they point at.
+func backupEntryProjectUID(key string, value []byte) string {
+	switch {
+	case strings.HasPrefix(key, slugKeyPrefix), strings.HasPrefix(key, legacyIDKeyPrefix):
+		return string(value)
+	case strings.HasPrefix(key, "lookup/"):
+		return ""
+	default:
+		return key
+	}
+}
+
+// RestoreBackupEntry writes an entry back to its bucket, replacing the current
+// value of the key.
+func (s *NatsRepository) RestoreBackupEntry(ctx context.Context, entry models.BackupEntry) error {
+	kv, ok := s.backupBuckets()[entry.Bucket]
+	if !ok {
+		slog.ErrorContext(ctx, "backup entry of an unknown bucket", "bucket", entry.Bucket, "key", entry.Key)
+		return domain.ErrValidationFailed
+	}
+	if _, err := kv.Put(ctx, entry.Key, entry.Value); err != nil {
+		slog.ErrorContext(ctx, "error restoring entry into NATS KV store", constants.ErrKey, err, "bucket", entry.Bucket, "key", entry.Key)
+		return domain.ErrInternal
+	}
+	return nil
+}
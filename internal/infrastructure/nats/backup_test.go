@@ -0,0 +1,58 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNatsRepository_ExportBackupEntries(t *testing.T) {
+	now := time.Now()
+	projectsKV := &MockKeyValue{}
+	settingsKV := &MockKeyValue{}
+	projectsKV.On("ListKeys", mock.Anything).Return(newStoppableKeyLister("project-1", "slug/one", "legacy/LEGACY1"), nil)
+	projectsKV.On("Get", mock.Anything, "project-1").Return(newScannedEntry([]byte(`{"uid":"project-1"}`), 1, now), nil)
+	projectsKV.On("Get", mock.Anything, "slug/one").Return(newScannedEntry([]byte("project-1"), 2, now), nil)
+	projectsKV.On("Get", mock.Anything, "legacy/LEGACY1").Return(newScannedEntry([]byte("project-1"), 3, now), nil)
+	settingsKV.On("ListKeys", mock.Anything).Return(newStoppableKeyLister("project-1", "lookup/writers/alice"), nil)
+	settingsKV.On("Get", mock.Anything, "project-1").Return(newScannedEntry([]byte(`{"uid":"project-1"}`), 4, now), nil)
+	settingsKV.On("Get", mock.Anything, "lookup/writers/alice").Return(newScannedEntry([]byte("project-1"), 5, now), nil)
+	repo := NewNatsRepository(projectsKV, settingsKV)
+
+	entries, err := repo.ExportBackupEntries(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.BackupEntry{
+		{Bucket: "projects", Key: "project-1", ProjectUID: "project-1", Value: []byte(`{"uid":"project-1"}`)},
+		{Bucket: "projects", Key: "slug/one", ProjectUID: "project-1", Value: []byte("project-1")},
+		{Bucket: "projects", Key: "legacy/LEGACY1", ProjectUID: "project-1", Value: []byte("project-1")},
+		{Bucket: "project-settings", Key: "project-1", ProjectUID: "project-1", Value: []byte(`{"uid":"project-1"}`)},
+		{Bucket: "project-settings", Key: "lookup/writers/alice", Value: []byte("project-1")},
+	}, entries)
+}
+
+func TestNatsRepository_RestoreBackupEntry(t *testing.T) {
+	projectsKV := &MockKeyValue{}
+	settingsKV := &MockKeyValue{}
+	settingsKV.On("Put", mock.Anything, "project-1", []byte(`{"uid":"project-1"}`)).Return(uint64(7), nil).Once()
+	repo := NewNatsRepository(projectsKV, settingsKV)
+
+	err := repo.RestoreBackupEntry(context.Background(), models.BackupEntry{
+		Bucket: "project-settings", Key: "project-1", Value: []byte(`{"uid":"project-1"}`),
+	})
+	require.NoError(t, err)
+	settingsKV.AssertExpectations(t)
+
+	err = repo.RestoreBackupEntry(context.Background(), models.BackupEntry{Bucket: "unknown", Key: "project-1"})
+	assert.ErrorIs(t, err, domain.ErrValidationFailed)
+	projectsKV.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+}
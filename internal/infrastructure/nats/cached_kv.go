@@ -0,0 +1,303 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// cacheLoadTimeout bounds how long [CachedKeyValue.Watch] waits for the initial
+// contents of the bucket.
+const cacheLoadTimeout = 30 * time.Second
+
+// CachedKeyValue wraps an [INatsKeyValue] with an in-memory copy of the bucket
+// that is kept warm by a JetStream KV watcher, so that Get is served from
+// memory instead of a KV round-trip.
+//
+// Writes go to the wrapped bucket. A key written through the cache is read
+// from the wrapped bucket until the watcher delivers that write, so callers
+// always read their own writes. Writes made by other replicas are visible
+// once the watcher delivers them. Until [CachedKeyValue.Watch] has loaded the
+// bucket, or after the watcher stops, every call goes to the wrapped bucket.
+type CachedKeyValue struct {
+	kv INatsKeyValue
+
+	mu      sync.RWMutex
+	ready   bool
+	stopped bool
+	// entries holds the latest entry for every key, including delete and purge
+	// markers, so that a missing key can be answered from memory.
+	entries map[string]jetstream.KeyValueEntry
+	// minRevisions holds, for keys written through the cache, the revision the
+	// cached entry must reach before it is served again.
+	minRevisions map[string]uint64
+}
+
+// NewCachedKeyValue returns a cache in front of kv. It serves reads from memory
+// once [CachedKeyValue.Watch] has been called.
+func NewCachedKeyValue(kv INatsKeyValue) *CachedKeyValue {
+	return &CachedKeyValue{
+		kv:           kv,
+		entries:      make(map[string]jetstream.KeyValueEntry),
+		minRevisions: make(map[string]uint64),
+	}
+}
+
+// Watch loads the bucket and keeps the cache up to date with its changes. It
+// returns once the current contents are loaded, or with an error if that takes
+// longer than cacheLoadTimeout. If the watcher later stops before ctx is done,
+// the cache falls back to the wrapped bucket.
+func (c *CachedKeyValue) Watch(ctx context.Context, kv jetstream.KeyValue) error {
+	watcher, err := kv.WatchAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	loaded := make(chan struct{})
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		defer func() { _ = watcher.Stop() }()
+		isLoaded := false
+		for entry := range watcher.Updates() {
+			// A nil entry marks the end of the initial values.
+			if entry == nil {
+				if !isLoaded {
+					isLoaded = true
+					c.mu.Lock()
+					c.ready = !c.stopped
+					c.mu.Unlock()
+					close(loaded)
+				}
+				continue
+			}
+			c.apply(entry)
+		}
+		if ctx.Err() == nil {
+			slog.WarnContext(ctx, "KV cache watcher stopped, reading from the bucket", "bucket", kv.Bucket())
+		}
+		c.stop()
+	}()
+
+	select {
+	case <-loaded:
+		return nil
+	case <-exited:
+		return errors.New("KV cache watcher stopped before loading the bucket")
+	case <-time.After(cacheLoadTimeout):
+		_ = watcher.Stop()
+		return errors.New("timed out loading the bucket into the KV cache")
+	}
+}
+
+// apply records an entry delivered by the watcher.
+func (c *CachedKeyValue) apply(entry jetstream.KeyValueEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.stopped {
+		return
+	}
+	c.entries[entry.Key()] = entry
+	if minRevision, ok := c.minRevisions[entry.Key()]; ok && entry.Revision() >= minRevision {
+		delete(c.minRevisions, entry.Key())
+	}
+}
+
+// stop empties the cache and sends every further call to the wrapped bucket.
+func (c *CachedKeyValue) stop() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ready = false
+	c.stopped = true
+	clear(c.entries)
+	clear(c.minRevisions)
+}
+
+// expect records that key was written at revision, so that older cached
+// entries for it are not served.
+func (c *CachedKeyValue) expect(key string, revision uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.stopped {
+		return
+	}
+	if entry, ok := c.entries[key]; ok && entry.Revision() >= revision {
+		return
+	}
+	c.minRevisions[key] = max(c.minRevisions[key], revision)
+}
+
+// expectNext records that key was deleted or purged. The revision of the
+// delete marker is not returned by the server, so any entry newer than the one
+// cached satisfies it. A cached marker already reports the key as missing.
+func (c *CachedKeyValue) expectNext(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.stopped {
+		return
+	}
+	var revision uint64
+	if entry, ok := c.entries[key]; ok {
+		if entry.Operation() != jetstream.KeyValuePut {
+			return
+		}
+		revision = entry.Revision()
+	}
+	c.minRevisions[key] = max(c.minRevisions[key], revision+1)
+}
+
+// lookup returns the cached entry for key. ok is false when the key must be
+// read from the wrapped bucket.
+func (c *CachedKeyValue) lookup(key string) (entry jetstream.KeyValueEntry, ok bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if !c.ready {
+		return nil, false
+	}
+	entry, found := c.entries[key]
+	minRevision, pending := c.minRevisions[key]
+	switch {
+	case pending && (!found || entry.Revision() < minRevision):
+		return nil, false
+	case !found:
+		return nil, true
+	case entry.Operation() != jetstream.KeyValuePut:
+		return nil, true
+	}
+	return entry, true
+}
+
+// HealthCheck reports an error once the watcher has stopped, and otherwise
+// checks that the wrapped bucket is reachable.
+func (c *CachedKeyValue) HealthCheck(ctx context.Context) error {
+	c.mu.RLock()
+	stopped := c.stopped
+	c.mu.RUnlock()
+	if stopped {
+		return errors.New("cache watcher stopped")
+	}
+	return KVHealthCheck(c.kv).HealthCheck(ctx)
+}
+
+func (c *CachedKeyValue) ListKeys(ctx context.Context, opts ...jetstream.WatchOpt) (jetstream.KeyLister, error) {
+	if len(opts) == 0 {
+		if keys, ok := c.keys(); ok {
+			return newCachedKeyLister(keys), nil
+		}
+	}
+	return c.kv.ListKeys(ctx, opts...)
+}
+
+// keys returns the keys that currently hold a value. ok is false when a key
+// written through the cache has not been delivered by the watcher yet.
+func (c *CachedKeyValue) keys() (keys []string, ok bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if !c.ready || len(c.minRevisions) > 0 {
+		return nil, false
+	}
+	keys = make([]string, 0, len(c.entries))
+	for key, entry := range c.entries {
+		if entry.Operation() == jetstream.KeyValuePut {
+			keys = append(keys, key)
+		}
+	}
+	return keys, true
+}
+
+// cachedKeyLister is a [jetstream.KeyLister] over a snapshot of cached keys.
+type cachedKeyLister struct {
+	keys chan string
+}
+
+func newCachedKeyLister(keys []string) *cachedKeyLister {
+	ch := make(chan string, len(keys))
+	for _, key := range keys {
+		ch <- key
+	}
+	close(ch)
+	return &cachedKeyLister{keys: ch}
+}
+
+func (l *cachedKeyLister) Keys() <-chan string {
+	return l.keys
+}
+
+func (l *cachedKeyLister) Stop() error {
+	return nil
+}
+
+func (c *CachedKeyValue) Get(ctx context.Context, key string) (jetstream.KeyValueEntry, error) {
+	if entry, ok := c.lookup(key); ok {
+		if entry == nil {
+			return nil, jetstream.ErrKeyNotFound
+		}
+		return entry, nil
+	}
+	return c.kv.Get(ctx, key)
+}
+
+// GetRevision reads from the bucket, since the cache only holds the latest
+// revision of each key.
+func (c *CachedKeyValue) GetRevision(ctx context.Context, key string, revision uint64) (jetstream.KeyValueEntry, error) {
+	return c.kv.GetRevision(ctx, key, revision)
+}
+
+// History reads from the bucket, since the cache only holds the latest
+// revision of each key.
+func (c *CachedKeyValue) History(ctx context.Context, key string, opts ...jetstream.WatchOpt) ([]jetstream.KeyValueEntry, error) {
+	return c.kv.History(ctx, key, opts...)
+}
+
+func (c *CachedKeyValue) Create(ctx context.Context, key string, value []byte, opts ...jetstream.KVCreateOpt) (uint64, error) {
+	revision, err := c.kv.Create(ctx, key, value, opts...)
+	if err == nil {
+		c.expect(key, revision)
+	}
+	return revision, err
+}
+
+func (c *CachedKeyValue) Put(ctx context.Context, key string, value []byte) (uint64, error) {
+	revision, err := c.kv.Put(ctx, key, value)
+	if err == nil {
+		c.expect(key, revision)
+	}
+	return revision, err
+}
+
+func (c *CachedKeyValue) Update(ctx context.Context, key string, value []byte, last uint64) (uint64, error) {
+	revision, err := c.kv.Update(ctx, key, value, last)
+	if err == nil {
+		c.expect(key, revision)
+	}
+	return revision, err
+}
+
+func (c *CachedKeyValue) Delete(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error {
+	err := c.kv.Delete(ctx, key, opts...)
+	if err == nil {
+		c.expectNext(key)
+	}
+	return err
+}
+
+func (c *CachedKeyValue) Purge(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error {
+	err := c.kv.Purge(ctx, key, opts...)
+	if err == nil {
+		c.expectNext(key)
+	}
+	return err
+}
@@ -0,0 +1,204 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newWatchedEntry(key string, value []byte, revision uint64, op jetstream.KeyValueOp) *MockKeyValueEntry {
+	entry := NewMockKeyValueEntry(value, revision)
+	entry.On("Key").Return(key).Maybe()
+	entry.On("Operation").Return(op).Maybe()
+	return entry
+}
+
+// newLoadedCache returns a cache that has loaded the given entries, as if
+// delivered by the watcher.
+func newLoadedCache(kv INatsKeyValue, entries ...jetstream.KeyValueEntry) *CachedKeyValue {
+	cache := NewCachedKeyValue(kv)
+	for _, entry := range entries {
+		cache.apply(entry)
+	}
+	cache.ready = true
+	return cache
+}
+
+func TestCachedKeyValue_Get(t *testing.T) {
+	tests := []struct {
+		name        string
+		setup       func(*MockKeyValue) *CachedKeyValue
+		key         string
+		expected    string
+		expectedErr error
+	}{
+		{
+			name: "served from cache",
+			setup: func(kv *MockKeyValue) *CachedKeyValue {
+				return newLoadedCache(kv, newWatchedEntry("project-1", []byte("v1"), 1, jetstream.KeyValuePut))
+			},
+			key:      "project-1",
+			expected: "v1",
+		},
+		{
+			name: "missing key is not found without a round-trip",
+			setup: func(kv *MockKeyValue) *CachedKeyValue {
+				return newLoadedCache(kv)
+			},
+			key:         "project-1",
+			expectedErr: jetstream.ErrKeyNotFound,
+		},
+		{
+			name: "deleted key is not found",
+			setup: func(kv *MockKeyValue) *CachedKeyValue {
+				return newLoadedCache(kv, newWatchedEntry("project-1", nil, 2, jetstream.KeyValueDelete))
+			},
+			key:         "project-1",
+			expectedErr: jetstream.ErrKeyNotFound,
+		},
+		{
+			name: "reads from the bucket until loaded",
+			setup: func(kv *MockKeyValue) *CachedKeyValue {
+				kv.On("Get", mock.Anything, "project-1").Return(NewMockKeyValueEntry([]byte("v1"), 1), nil)
+				return NewCachedKeyValue(kv)
+			},
+			key:      "project-1",
+			expected: "v1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockKV := &MockKeyValue{}
+			cache := tt.setup(mockKV)
+
+			entry, err := cache.Get(context.Background(), tt.key)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, string(entry.Value()))
+			}
+			mockKV.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCachedKeyValue_readsOwnWrites(t *testing.T) {
+	ctx := context.Background()
+	mockKV := &MockKeyValue{}
+	mockKV.On("Update", mock.Anything, "project-1", []byte("v2"), uint64(1)).Return(uint64(5), nil)
+	mockKV.On("Get", mock.Anything, "project-1").Return(NewMockKeyValueEntry([]byte("v2"), 5), nil).Once()
+
+	cache := newLoadedCache(mockKV, newWatchedEntry("project-1", []byte("v1"), 1, jetstream.KeyValuePut))
+
+	_, err := cache.Update(ctx, "project-1", []byte("v2"), 1)
+	require.NoError(t, err)
+
+	// The watcher has not delivered the update yet, so the bucket is read.
+	entry, err := cache.Get(ctx, "project-1")
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(entry.Value()))
+
+	// Once it has, the cache serves the key again.
+	cache.apply(newWatchedEntry("project-1", []byte("v2"), 5, jetstream.KeyValuePut))
+	entry, err = cache.Get(ctx, "project-1")
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(entry.Value()))
+
+	mockKV.AssertExpectations(t)
+}
+
+func TestCachedKeyValue_deleteReadsOwnWrites(t *testing.T) {
+	ctx := context.Background()
+	mockKV := &MockKeyValue{}
+	mockKV.On("Delete", mock.Anything, "project-1").Return(nil)
+	mockKV.On("Get", mock.Anything, "project-1").Return(nil, jetstream.ErrKeyNotFound).Once()
+
+	cache := newLoadedCache(mockKV, newWatchedEntry("project-1", []byte("v1"), 1, jetstream.KeyValuePut))
+
+	require.NoError(t, cache.Delete(ctx, "project-1"))
+	_, err := cache.Get(ctx, "project-1")
+	assert.ErrorIs(t, err, jetstream.ErrKeyNotFound)
+
+	cache.apply(newWatchedEntry("project-1", nil, 2, jetstream.KeyValueDelete))
+	_, err = cache.Get(ctx, "project-1")
+	assert.ErrorIs(t, err, jetstream.ErrKeyNotFound)
+
+	mockKV.AssertExpectations(t)
+}
+
+func TestCachedKeyValue_failedWriteKeepsCache(t *testing.T) {
+	ctx := context.Background()
+	mockKV := &MockKeyValue{}
+	mockKV.On("Update", mock.Anything, "project-1", []byte("v2"), uint64(0)).Return(uint64(0), errors.New("nats: wrong last sequence: 1"))
+
+	cache := newLoadedCache(mockKV, newWatchedEntry("project-1", []byte("v1"), 1, jetstream.KeyValuePut))
+
+	_, err := cache.Update(ctx, "project-1", []byte("v2"), 0)
+	require.Error(t, err)
+
+	entry, err := cache.Get(ctx, "project-1")
+	require.NoError(t, err)
+	assert.Equal(t, "v1", string(entry.Value()))
+	mockKV.AssertExpectations(t)
+}
+
+func TestCachedKeyValue_ListKeys(t *testing.T) {
+	ctx := context.Background()
+	mockKV := &MockKeyValue{}
+	mockKV.On("Put", mock.Anything, "project-3", []byte("v1")).Return(uint64(4), nil)
+	mockKV.On("ListKeys", mock.Anything).Return(NewMockKeyLister([]string{"project-1", "project-3"}), nil).Once()
+
+	cache := newLoadedCache(mockKV,
+		newWatchedEntry("project-1", []byte("v1"), 1, jetstream.KeyValuePut),
+		newWatchedEntry("project-2", nil, 3, jetstream.KeyValuePurge),
+	)
+
+	listKeys := func() []string {
+		lister, err := cache.ListKeys(ctx)
+		require.NoError(t, err)
+		keys := []string{}
+		for key := range lister.Keys() {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return keys
+	}
+
+	assert.Equal(t, []string{"project-1"}, listKeys())
+
+	// A write that the watcher has not delivered yet is listed from the bucket.
+	_, err := cache.Put(ctx, "project-3", []byte("v1"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"project-1", "project-3"}, listKeys())
+
+	cache.apply(newWatchedEntry("project-3", []byte("v1"), 4, jetstream.KeyValuePut))
+	assert.Equal(t, []string{"project-1", "project-3"}, listKeys())
+
+	mockKV.AssertExpectations(t)
+}
+
+func TestCachedKeyValue_stopFallsBackToBucket(t *testing.T) {
+	mockKV := &MockKeyValue{}
+	mockKV.On("Get", mock.Anything, "project-1").Return(NewMockKeyValueEntry([]byte("v2"), 2), nil)
+
+	cache := newLoadedCache(mockKV, newWatchedEntry("project-1", []byte("v1"), 1, jetstream.KeyValuePut))
+	cache.stop()
+
+	entry, err := cache.Get(context.Background(), "project-1")
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(entry.Value()))
+	assert.Error(t, cache.HealthCheck(context.Background()))
+	mockKV.AssertExpectations(t)
+}
@@ -0,0 +1,122 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/metrics"
+)
+
+// CachedUserReader is a domain.UserReader that keeps user metadata read from
+// another UserReader in an in-process LRU cache for at most ttl, so that
+// refreshing the names and avatars of a project's users does not send a
+// request to the auth service for every user on every read.
+//
+// Only successful metadata lookups are cached. UsernameByEmail is never
+// cached, as a stale username must not be written to a project.
+type CachedUserReader struct {
+	reader domain.UserReader
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu    sync.Mutex
+	size  int
+	order *list.List
+	items map[string]*list.Element
+}
+
+type userCacheEntry struct {
+	principal string
+	metadata  domain.UserMetadata
+	expiresAt time.Time
+}
+
+// NewCachedUserReader returns a UserReader that caches at most size metadata
+// lookups of reader for ttl each.
+func NewCachedUserReader(reader domain.UserReader, size int, ttl time.Duration) *CachedUserReader {
+	return &CachedUserReader{
+		reader: reader,
+		ttl:    ttl,
+		now:    time.Now,
+		size:   size,
+		order:  list.New(),
+		items:  make(map[string]*list.Element, size),
+	}
+}
+
+// UserMetadataByPrincipal returns the cached metadata for principal, reading
+// it from the underlying reader when it is not cached or has expired.
+func (c *CachedUserReader) UserMetadataByPrincipal(ctx context.Context, principal string) (*domain.UserMetadata, error) {
+	if meta, ok := c.get(principal); ok {
+		return meta, nil
+	}
+
+	meta, err := c.reader.UserMetadataByPrincipal(ctx, principal)
+	if err != nil {
+		return nil, err
+	}
+	c.add(principal, meta)
+	return meta, nil
+}
+
+// UsernameByEmail resolves the username from the underlying reader.
+func (c *CachedUserReader) UsernameByEmail(ctx context.Context, email string) (string, error) {
+	return c.reader.UsernameByEmail(ctx, email)
+}
+
+// Len returns the number of cached users, including expired ones not yet evicted.
+func (c *CachedUserReader) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// get returns a copy of the cached metadata, so that callers cannot change the
+// cached value.
+func (c *CachedUserReader) get(principal string) (*domain.UserMetadata, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[principal]
+	if ok && c.now().After(elem.Value.(*userCacheEntry).expiresAt) {
+		c.order.Remove(elem)
+		delete(c.items, principal)
+		ok = false
+	}
+	metrics.ObserveUserCacheLookup(ok)
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	meta := elem.Value.(*userCacheEntry).metadata
+	return &meta, true
+}
+
+func (c *CachedUserReader) add(principal string, meta *domain.UserMetadata) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.size <= 0 || c.ttl <= 0 || meta == nil {
+		return
+	}
+	expiresAt := c.now().Add(c.ttl)
+	if elem, ok := c.items[principal]; ok {
+		entry := elem.Value.(*userCacheEntry)
+		entry.metadata = *meta
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.items[principal] = c.order.PushFront(&userCacheEntry{principal: principal, metadata: *meta, expiresAt: expiresAt})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*userCacheEntry).principal)
+	}
+}
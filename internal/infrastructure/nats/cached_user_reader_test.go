@@ -0,0 +1,83 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedUserReader_UserMetadataByPrincipal(t *testing.T) {
+	ctx := context.Background()
+	reader := &domain.MockUserReader{}
+	reader.On("UserMetadataByPrincipal", mock.Anything, "jdoe").Return(&domain.UserMetadata{Name: "Jane Doe"}, nil).Twice()
+
+	now := time.Now()
+	cache := NewCachedUserReader(reader, 10, time.Hour)
+	cache.now = func() time.Time { return now }
+
+	meta, err := cache.UserMetadataByPrincipal(ctx, "jdoe")
+	require.NoError(t, err)
+	assert.Equal(t, "Jane Doe", meta.Name)
+
+	// A cached copy is returned until the TTL passes.
+	meta.Name = "changed"
+	meta, err = cache.UserMetadataByPrincipal(ctx, "jdoe")
+	require.NoError(t, err)
+	assert.Equal(t, "Jane Doe", meta.Name)
+	reader.AssertNumberOfCalls(t, "UserMetadataByPrincipal", 1)
+
+	now = now.Add(time.Hour + time.Second)
+	_, err = cache.UserMetadataByPrincipal(ctx, "jdoe")
+	require.NoError(t, err)
+	reader.AssertNumberOfCalls(t, "UserMetadataByPrincipal", 2)
+}
+
+func TestCachedUserReader_doesNotCacheErrors(t *testing.T) {
+	ctx := context.Background()
+	reader := &domain.MockUserReader{}
+	reader.On("UserMetadataByPrincipal", mock.Anything, "ghost").Return(nil, domain.ErrUserNotFound).Twice()
+
+	cache := NewCachedUserReader(reader, 10, time.Hour)
+	for range 2 {
+		_, err := cache.UserMetadataByPrincipal(ctx, "ghost")
+		assert.ErrorIs(t, err, domain.ErrUserNotFound)
+	}
+	assert.Equal(t, 0, cache.Len())
+	reader.AssertExpectations(t)
+}
+
+func TestCachedUserReader_evictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	reader := &domain.MockUserReader{}
+	reader.On("UserMetadataByPrincipal", mock.Anything, mock.Anything).Return(&domain.UserMetadata{}, nil)
+
+	cache := NewCachedUserReader(reader, 2, time.Hour)
+	for _, principal := range []string{"a", "b", "a", "c", "a"} {
+		_, err := cache.UserMetadataByPrincipal(ctx, principal)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 2, cache.Len())
+	reader.AssertNumberOfCalls(t, "UserMetadataByPrincipal", 3)
+}
+
+func TestCachedUserReader_UsernameByEmail(t *testing.T) {
+	reader := &domain.MockUserReader{}
+	reader.On("UsernameByEmail", mock.Anything, "jane@example.org").Return("jdoe", nil).Twice()
+
+	cache := NewCachedUserReader(reader, 10, time.Hour)
+	for range 2 {
+		username, err := cache.UsernameByEmail(context.Background(), "jane@example.org")
+		require.NoError(t, err)
+		assert.Equal(t, "jdoe", username)
+	}
+	reader.AssertExpectations(t)
+}
@@ -0,0 +1,92 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// getProjectCharter returns a project's charter history and its revision.
+func (s *NatsRepository) getProjectCharter(ctx context.Context, projectUID string) (*models.ProjectCharter, uint64, error) {
+	entry, err := s.Charters.Get(ctx, projectUID)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, 0, domain.ErrCharterNotFound
+		}
+		slog.ErrorContext(ctx, "error getting project charter from NATS KV store", constants.ErrKey, err, "project_uid", projectUID)
+		return nil, 0, domain.ErrInternal
+	}
+
+	charter := &models.ProjectCharter{}
+	if err := json.Unmarshal(entry.Value(), charter); err != nil {
+		slog.ErrorContext(ctx, "error unmarshalling project charter from NATS KV store", constants.ErrKey, err, "project_uid", projectUID)
+		return nil, 0, domain.ErrUnmarshal
+	}
+	return charter, entry.Revision(), nil
+}
+
+// GetProjectCharter returns the version history of a project's charter.
+func (s *NatsRepository) GetProjectCharter(ctx context.Context, projectUID string) (*models.ProjectCharter, error) {
+	charter, _, err := s.getProjectCharter(ctx, projectUID)
+	return charter, err
+}
+
+// AddProjectCharterVersion appends version to the project's charter history,
+// numbering it after the latest version. Concurrent uploads are retried so that
+// each gets its own version number.
+func (s *NatsRepository) AddProjectCharterVersion(ctx context.Context, projectUID string, version models.ProjectCharterVersion) (*models.ProjectCharter, error) {
+	for attempt := 1; attempt <= maxUpdateAttempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		charter, revision, err := s.getProjectCharter(ctx, projectUID)
+		switch {
+		case errors.Is(err, domain.ErrCharterNotFound):
+			charter = &models.ProjectCharter{ProjectUID: projectUID}
+		case err != nil:
+			return nil, err
+		}
+
+		version.Version = 1
+		if current := charter.Current(); current != nil {
+			version.Version = current.Version + 1
+		}
+		charter.Versions = append(charter.Versions, version)
+
+		data, err := json.Marshal(charter)
+		if err != nil {
+			slog.ErrorContext(ctx, "error marshalling project charter into JSON", constants.ErrKey, err)
+			return nil, domain.ErrInternal
+		}
+
+		if revision == 0 {
+			_, err = s.Charters.Create(ctx, projectUID, data)
+		} else {
+			_, err = s.Charters.Update(ctx, projectUID, data, revision)
+		}
+		if err != nil {
+			if errors.Is(err, jetstream.ErrKeyExists) || strings.Contains(err.Error(), "wrong last sequence") {
+				slog.DebugContext(ctx, "concurrent charter upload, retrying", "project_uid", projectUID, "attempt", attempt)
+				continue
+			}
+			slog.ErrorContext(ctx, "error putting project charter into NATS KV store", constants.ErrKey, err, "project_uid", projectUID)
+			return nil, domain.ErrInternal
+		}
+		return charter, nil
+	}
+
+	slog.WarnContext(ctx, "giving up charter update after repeated revision mismatches", "project_uid", projectUID, "attempts", maxUpdateAttempts)
+	return nil, domain.ErrRevisionMismatch
+}
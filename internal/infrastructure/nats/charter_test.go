@@ -0,0 +1,89 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNatsRepository_AddProjectCharterVersion(t *testing.T) {
+	existing, _ := json.Marshal(&models.ProjectCharter{
+		ProjectUID: "project-1",
+		Versions:   []models.ProjectCharterVersion{{Version: 1, URL: "https://charters.example.org/v1.pdf"}},
+	})
+
+	tests := []struct {
+		name             string
+		setupMock        func(kv *MockKeyValue)
+		expectedVersions []int
+		expectedErr      error
+	}{
+		{
+			name: "first version creates the history",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("Get", mock.Anything, "project-1").Return(nil, jetstream.ErrKeyNotFound)
+				kv.On("Create", mock.Anything, "project-1", mock.Anything).Return(uint64(1), nil)
+			},
+			expectedVersions: []int{1},
+		},
+		{
+			name: "later version is appended",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("Get", mock.Anything, "project-1").Return(NewMockKeyValueEntry(existing, 3), nil)
+				kv.On("Update", mock.Anything, "project-1", mock.Anything, uint64(3)).Return(uint64(4), nil)
+			},
+			expectedVersions: []int{1, 2},
+		},
+		{
+			name: "concurrent first upload is retried as the second version",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("Get", mock.Anything, "project-1").Return(nil, jetstream.ErrKeyNotFound).Once()
+				kv.On("Create", mock.Anything, "project-1", mock.Anything).Return(uint64(0), jetstream.ErrKeyExists)
+				kv.On("Get", mock.Anything, "project-1").Return(NewMockKeyValueEntry(existing, 1), nil)
+				kv.On("Update", mock.Anything, "project-1", mock.Anything, uint64(1)).Return(uint64(2), nil)
+			},
+			expectedVersions: []int{1, 2},
+		},
+		{
+			name: "store error",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("Get", mock.Anything, "project-1").Return(nil, errors.New("nats error"))
+			},
+			expectedErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kv := &MockKeyValue{}
+			tt.setupMock(kv)
+			repo := &NatsRepository{Charters: kv}
+
+			charter, err := repo.AddProjectCharterVersion(context.Background(), "project-1", models.ProjectCharterVersion{URL: "https://charters.example.org/new.pdf"})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			var versions []int
+			for _, v := range charter.Versions {
+				versions = append(versions, v.Version)
+			}
+			assert.Equal(t, tt.expectedVersions, versions)
+			assert.Equal(t, "https://charters.example.org/new.pdf", charter.Current().URL)
+			kv.AssertExpectations(t)
+		})
+	}
+}
@@ -21,15 +21,33 @@ type Config struct {
 	Timeout       time.Duration
 	MaxReconnect  int
 	ReconnectWait time.Duration
+	// Tenant, when set, namespaces the keys of every key-value bucket opened
+	// through the JetStream context.
+	Tenant string
+	// KVCompression and KVCompressionMinBytes are how values written to the
+	// project-settings bucket are compressed, see [Config.CompressSettings].
+	KVCompression         string
+	KVCompressionMinBytes int
 }
 
-// ConfigFromEnv builds Config using NATS_URL when set.
+// ConfigFromEnv builds Config using NATS_URL, TENANT, KV_COMPRESSION and
+// KV_COMPRESSION_MIN_BYTES when set.
 func ConfigFromEnv() Config {
 	return applyConfigDefaults(Config{
-		URL: env.Get("NATS_URL", defaultNATSURL),
+		URL:                   env.Get("NATS_URL", defaultNATSURL),
+		Tenant:                env.Get("TENANT", ""),
+		KVCompression:         env.Get("KV_COMPRESSION", CompressionNone),
+		KVCompressionMinBytes: env.GetInt("KV_COMPRESSION_MIN_BYTES", DefaultCompressionMinBytes),
 	})
 }
 
+// CompressSettings wraps the project-settings bucket so that its compressed
+// values are decompressed on read and new values are compressed as configured,
+// as the service does. Tools that read or write the bucket directly must use it.
+func (c Config) CompressSettings(kv INatsKeyValue) (INatsKeyValue, error) {
+	return CompressKeyValue(kv, c.KVCompression, c.KVCompressionMinBytes)
+}
+
 func applyConfigDefaults(cfg Config) Config {
 	if cfg.URL == "" {
 		cfg.URL = defaultNATSURL
@@ -46,9 +64,14 @@ func applyConfigDefaults(cfg Config) Config {
 	return cfg
 }
 
-// Connect establishes a NATS connection and JetStream context.
+// Connect establishes a NATS connection and JetStream context. With a tenant,
+// the key-value buckets of the JetStream context only hold the tenant's keys;
+// messages sent on the connection are not namespaced, see [NewTenantConn].
 func Connect(_ context.Context, cfg Config) (*nats.Conn, jetstream.JetStream, error) {
 	cfg = applyConfigDefaults(cfg)
+	if err := ValidateTenant(cfg.Tenant); err != nil {
+		return nil, nil, err
+	}
 
 	nc, err := nats.Connect(cfg.URL,
 		nats.Timeout(cfg.Timeout),
@@ -65,5 +88,5 @@ func Connect(_ context.Context, cfg Config) (*nats.Conn, jetstream.JetStream, er
 		return nil, nil, fmt.Errorf("failed to create JetStream context: %w", err)
 	}
 
-	return nc, js, nil
+	return nc, NewTenantJetStream(js, cfg.Tenant), nil
 }
@@ -0,0 +1,205 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/s2"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Compression algorithms for the values written through [CompressKeyValue].
+const (
+	CompressionNone   = ""
+	CompressionGzip   = "gzip"
+	CompressionSnappy = "snappy"
+)
+
+// DefaultCompressionMinBytes is the size from which values are compressed
+// unless configured otherwise.
+const DefaultCompressionMinBytes = 4096
+
+// compressedValueMagic starts every compressed value and is followed by one
+// byte naming the codec. JSON documents never start with a zero byte, so
+// values written before compression was enabled are read back unchanged.
+var compressedValueMagic = []byte{0x00, 'l', 'f', 'x', 'z'}
+
+const (
+	codecGzip   byte = 'g'
+	codecSnappy byte = 's'
+)
+
+// compressedKeyValue wraps an [INatsKeyValue] so that large values are
+// compressed on write and transparently decompressed on read.
+type compressedKeyValue struct {
+	kv      INatsKeyValue
+	codec   byte
+	minSize int
+}
+
+// CompressKeyValue returns an [INatsKeyValue] that compresses values of at
+// least minSize bytes with the given algorithm before delegating to kv, and
+// decompresses them on read. Compressed values are always decoded, even with
+// [CompressionNone], so that compression can be turned off without rewriting
+// the bucket.
+func CompressKeyValue(kv INatsKeyValue, algorithm string, minSize int) (INatsKeyValue, error) {
+	c := &compressedKeyValue{kv: kv, minSize: minSize}
+	switch algorithm {
+	case CompressionNone:
+	case CompressionGzip:
+		c.codec = codecGzip
+	case CompressionSnappy:
+		c.codec = codecSnappy
+	default:
+		return nil, fmt.Errorf("unknown compression algorithm %q", algorithm)
+	}
+	return c, nil
+}
+
+// encode compresses value when it is large enough and compression actually
+// makes it smaller; otherwise the value is stored as is.
+func (c *compressedKeyValue) encode(value []byte) ([]byte, error) {
+	if c.codec == 0 || len(value) < c.minSize {
+		return value, nil
+	}
+	var payload []byte
+	switch c.codec {
+	case codecGzip:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(value); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		payload = buf.Bytes()
+	case codecSnappy:
+		payload = s2.EncodeSnappy(nil, value)
+	}
+	if len(compressedValueMagic)+1+len(payload) >= len(value) {
+		return value, nil
+	}
+	encoded := make([]byte, 0, len(compressedValueMagic)+1+len(payload))
+	encoded = append(encoded, compressedValueMagic...)
+	encoded = append(encoded, c.codec)
+	return append(encoded, payload...), nil
+}
+
+// decodeValue returns the original bytes of a value read from the bucket.
+// Values without the compression header are returned unchanged.
+func decodeValue(value []byte) ([]byte, error) {
+	if len(value) <= len(compressedValueMagic) || !bytes.HasPrefix(value, compressedValueMagic) {
+		return value, nil
+	}
+	payload := value[len(compressedValueMagic)+1:]
+	switch codec := value[len(compressedValueMagic)]; codec {
+	case codecGzip:
+		r, err := gzip.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return nil, fmt.Errorf("decompressing gzip value: %w", err)
+		}
+		defer r.Close()
+		decoded, err := io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("decompressing gzip value: %w", err)
+		}
+		return decoded, nil
+	case codecSnappy:
+		decoded, err := s2.Decode(nil, payload)
+		if err != nil {
+			return nil, fmt.Errorf("decompressing snappy value: %w", err)
+		}
+		return decoded, nil
+	default:
+		return nil, fmt.Errorf("unknown compression codec %q", codec)
+	}
+}
+
+// decompressedEntry is a [jetstream.KeyValueEntry] whose value has been
+// decompressed.
+type decompressedEntry struct {
+	jetstream.KeyValueEntry
+	value []byte
+}
+
+// Value returns the decompressed value.
+func (e *decompressedEntry) Value() []byte {
+	return e.value
+}
+
+func (c *compressedKeyValue) decodeEntry(key string, entry jetstream.KeyValueEntry, err error) (jetstream.KeyValueEntry, error) {
+	if err != nil || entry == nil {
+		return entry, err
+	}
+	value, err := decodeValue(entry.Value())
+	if err != nil {
+		return nil, fmt.Errorf("reading key %s: %w", key, err)
+	}
+	return &decompressedEntry{KeyValueEntry: entry, value: value}, nil
+}
+
+func (c *compressedKeyValue) ListKeys(ctx context.Context, opts ...jetstream.WatchOpt) (jetstream.KeyLister, error) {
+	return c.kv.ListKeys(ctx, opts...)
+}
+
+func (c *compressedKeyValue) Get(ctx context.Context, key string) (jetstream.KeyValueEntry, error) {
+	entry, err := c.kv.Get(ctx, key)
+	return c.decodeEntry(key, entry, err)
+}
+
+func (c *compressedKeyValue) GetRevision(ctx context.Context, key string, revision uint64) (jetstream.KeyValueEntry, error) {
+	entry, err := c.kv.GetRevision(ctx, key, revision)
+	return c.decodeEntry(key, entry, err)
+}
+
+func (c *compressedKeyValue) History(ctx context.Context, key string, opts ...jetstream.WatchOpt) ([]jetstream.KeyValueEntry, error) {
+	entries, err := c.kv.History(ctx, key, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for i, entry := range entries {
+		if entries[i], err = c.decodeEntry(key, entry, nil); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+func (c *compressedKeyValue) Create(ctx context.Context, key string, value []byte, opts ...jetstream.KVCreateOpt) (uint64, error) {
+	encoded, err := c.encode(value)
+	if err != nil {
+		return 0, err
+	}
+	return c.kv.Create(ctx, key, encoded, opts...)
+}
+
+func (c *compressedKeyValue) Put(ctx context.Context, key string, value []byte) (uint64, error) {
+	encoded, err := c.encode(value)
+	if err != nil {
+		return 0, err
+	}
+	return c.kv.Put(ctx, key, encoded)
+}
+
+func (c *compressedKeyValue) Update(ctx context.Context, key string, value []byte, revision uint64) (uint64, error) {
+	encoded, err := c.encode(value)
+	if err != nil {
+		return 0, err
+	}
+	return c.kv.Update(ctx, key, encoded, revision)
+}
+
+func (c *compressedKeyValue) Delete(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error {
+	return c.kv.Delete(ctx, key, opts...)
+}
+
+func (c *compressedKeyValue) Purge(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error {
+	return c.kv.Purge(ctx, key, opts...)
+}
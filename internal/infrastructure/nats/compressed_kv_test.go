@@ -0,0 +1,95 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressKeyValue(t *testing.T) {
+	large := []byte(`{"uid":"project-1","writers":[` + strings.Repeat(`{"username":"alice","name":"Alice"},`, 200) + `{}]}`)
+	small := []byte(`{"uid":"project-1"}`)
+
+	tests := []struct {
+		name       string
+		algorithm  string
+		value      []byte
+		compressed bool
+	}{
+		{name: "gzip compresses large values", algorithm: CompressionGzip, value: large, compressed: true},
+		{name: "snappy compresses large values", algorithm: CompressionSnappy, value: large, compressed: true},
+		{name: "small values are stored as is", algorithm: CompressionGzip, value: small},
+		{name: "no compression stores values as is", algorithm: CompressionNone, value: large},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockKV := &MockKeyValue{}
+			var stored []byte
+			mockKV.On("Put", mock.Anything, "project-1", mock.Anything).
+				Run(func(args mock.Arguments) { stored = args.Get(2).([]byte) }).
+				Return(uint64(1), nil)
+			kv, err := CompressKeyValue(mockKV, tt.algorithm, 1024)
+			require.NoError(t, err)
+
+			_, err = kv.Put(context.Background(), "project-1", tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.compressed, bytes.HasPrefix(stored, compressedValueMagic))
+			if tt.compressed {
+				assert.Less(t, len(stored), len(tt.value))
+			}
+
+			mockKV.On("Get", mock.Anything, "project-1").Return(NewMockKeyValueEntry(stored, 1), nil)
+			entry, err := kv.Get(context.Background(), "project-1")
+			require.NoError(t, err)
+			assert.Equal(t, tt.value, entry.Value())
+			assert.Equal(t, uint64(1), entry.Revision())
+		})
+	}
+}
+
+func TestCompressKeyValue_readsAnyEncoding(t *testing.T) {
+	value := []byte(`{"uid":"project-1","auditors":[` + strings.Repeat(`{"username":"bob"},`, 100) + `{}]}`)
+	gzipKV, err := CompressKeyValue(nil, CompressionGzip, 0)
+	require.NoError(t, err)
+	gzipped, err := gzipKV.(*compressedKeyValue).encode(value)
+	require.NoError(t, err)
+
+	mockKV := &MockKeyValue{}
+	mockKV.On("GetRevision", mock.Anything, "project-1", uint64(3)).Return(NewMockKeyValueEntry(gzipped, 3), nil)
+	mockKV.On("Get", mock.Anything, "legacy").Return(NewMockKeyValueEntry(value, 2), nil)
+	mockKV.On("Get", mock.Anything, "missing").Return(nil, jetstream.ErrKeyNotFound)
+	mockKV.On("Get", mock.Anything, "corrupt").Return(NewMockKeyValueEntry(append(append([]byte{}, compressedValueMagic...), 'g', 'x'), 4), nil)
+
+	// Compression is off for writes, but compressed values are still decoded.
+	kv, err := CompressKeyValue(mockKV, CompressionNone, 0)
+	require.NoError(t, err)
+
+	entry, err := kv.GetRevision(context.Background(), "project-1", 3)
+	require.NoError(t, err)
+	assert.Equal(t, value, entry.Value())
+
+	entry, err = kv.Get(context.Background(), "legacy")
+	require.NoError(t, err)
+	assert.Equal(t, value, entry.Value())
+
+	_, err = kv.Get(context.Background(), "missing")
+	assert.ErrorIs(t, err, jetstream.ErrKeyNotFound)
+
+	_, err = kv.Get(context.Background(), "corrupt")
+	assert.ErrorContains(t, err, "reading key corrupt")
+}
+
+func TestCompressKeyValue_unknownAlgorithm(t *testing.T) {
+	_, err := CompressKeyValue(&MockKeyValue{}, "lz4", 0)
+	assert.ErrorContains(t, err, `unknown compression algorithm "lz4"`)
+}
@@ -0,0 +1,321 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// consistencyGracePeriod is how old an entry must be before the consistency
+// check treats it as inconsistent, so that the intermediate state of a create
+// or delete that is still in flight is not repaired.
+const consistencyGracePeriod = 5 * time.Minute
+
+// CheckConsistency scans the projects and project-settings buckets for the
+// partial state left behind by interrupted writes: slug and legacy ID mappings
+// without their project, projects without their slug or legacy ID mapping, and
+// settings without their project. When repair is set, dangling mappings and
+// orphaned settings are deleted and missing mappings are recreated. Every repair is conditional
+// on the revision that was scanned, so a concurrent write is never overwritten.
+// The slugs of merged projects are mapped to the project they were merged
+// into. Projects whose parent is missing and entries that cannot be decoded are
+// reported but never repaired; the mappings and settings of a project that
+// cannot be decoded are left as they are.
+func (s *NatsRepository) CheckConsistency(ctx context.Context, repair bool) (*models.ConsistencyReport, error) {
+	cutoff := time.Now().Add(-consistencyGracePeriod)
+
+	report := &models.ConsistencyReport{
+		DanglingSlugMappings:     []string{},
+		MissingSlugMappings:      []string{},
+		DanglingLegacyIDMappings: []string{},
+		MissingLegacyIDMappings:  []string{},
+		OrphanedSettings:         []string{},
+		OrphanedChildren:         []string{},
+		InvalidEntries:           []string{},
+	}
+
+	slugEntries := map[string]jetstream.KeyValueEntry{}
+	legacyIDEntries := map[string]jetstream.KeyValueEntry{}
+	projects := map[string]*models.ProjectBase{}
+	projectEntries := map[string]jetstream.KeyValueEntry{}
+	invalidProjects := map[string]bool{}
+	err := scanKeys(ctx, s.Projects, func(key string, entry jetstream.KeyValueEntry) error {
+		if slug, ok := strings.CutPrefix(key, slugKeyPrefix); ok {
+			slugEntries[slug] = entry
+			return nil
+		}
+		if legacyID, ok := strings.CutPrefix(key, legacyIDKeyPrefix); ok {
+			legacyIDEntries[legacyID] = entry
+			return nil
+		}
+		projectDB, _, err := decodeProjectBase(entry.Value())
+		if err != nil {
+			slog.WarnContext(ctx, "error unmarshalling project from NATS KV store", constants.ErrKey, err, "project_uid", key)
+			report.InvalidEntries = append(report.InvalidEntries, constants.KVStoreNameProjects+"/"+key)
+			invalidProjects[key] = true
+			return nil
+		}
+		projects[key] = projectDB
+		projectEntries[key] = entry
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	record := func(err error, msg string, args ...any) {
+		if !repair {
+			return
+		}
+		if err != nil {
+			report.Failed++
+			slog.ErrorContext(ctx, msg, append(args, constants.ErrKey, err)...)
+			return
+		}
+		report.Repaired++
+	}
+
+	for slug, entry := range slugEntries {
+		projectDB, ok := projects[string(entry.Value())]
+		if (ok && (projectDB.Slug == slug || projectDB.HasMergedSlug(slug))) || invalidProjects[string(entry.Value())] || entry.Created().After(cutoff) {
+			continue
+		}
+		report.DanglingSlugMappings = append(report.DanglingSlugMappings, slug)
+		if repair {
+			err := s.Projects.Delete(ctx, slugKeyPrefix+slug, jetstream.LastRevision(entry.Revision()))
+			if err == nil && s.SlugCache != nil {
+				s.SlugCache.Remove(slug)
+			}
+			record(err, "error deleting dangling slug mapping", "slug", slug)
+		}
+	}
+
+	for projectUID, projectDB := range projects {
+		if _, ok := slugEntries[projectDB.Slug]; ok || projectEntries[projectUID].Created().After(cutoff) {
+			continue
+		}
+		report.MissingSlugMappings = append(report.MissingSlugMappings, projectUID)
+		if repair {
+			record(s.createProjectSlugMapping(ctx, projectDB), "error recreating missing slug mapping", "project_uid", projectUID)
+		}
+	}
+
+	for legacyID, entry := range legacyIDEntries {
+		projectDB, ok := projects[string(entry.Value())]
+		if (ok && projectDB.LegacyID == legacyID) || invalidProjects[string(entry.Value())] || entry.Created().After(cutoff) {
+			continue
+		}
+		report.DanglingLegacyIDMappings = append(report.DanglingLegacyIDMappings, legacyID)
+		if repair {
+			err := s.Projects.Delete(ctx, legacyIDKeyPrefix+legacyID, jetstream.LastRevision(entry.Revision()))
+			record(err, "error deleting dangling legacy ID mapping", "legacy_id", legacyID)
+		}
+	}
+
+	for projectUID, projectDB := range projects {
+		if projectDB.LegacyID == "" {
+			continue
+		}
+		if _, ok := legacyIDEntries[projectDB.LegacyID]; ok || projectEntries[projectUID].Created().After(cutoff) {
+			continue
+		}
+		report.MissingLegacyIDMappings = append(report.MissingLegacyIDMappings, projectUID)
+		if repair {
+			record(s.createProjectLegacyIDMapping(ctx, projectDB), "error recreating missing legacy ID mapping", "project_uid", projectUID)
+		}
+	}
+
+	for projectUID, projectDB := range projects {
+		if projectDB.ParentUID == "" || invalidProjects[projectDB.ParentUID] {
+			continue
+		}
+		if _, ok := projects[projectDB.ParentUID]; !ok {
+			report.OrphanedChildren = append(report.OrphanedChildren, projectUID)
+		}
+	}
+
+	err = scanKeys(ctx, s.ProjectSettings, func(key string, entry jetstream.KeyValueEntry) error {
+		if strings.HasPrefix(key, "lookup/") {
+			return nil
+		}
+		if _, _, err := decodeProjectSettings(entry.Value()); err != nil {
+			slog.WarnContext(ctx, "error unmarshalling project settings from NATS KV store", constants.ErrKey, err, "project_uid", key)
+			report.InvalidEntries = append(report.InvalidEntries, constants.KVStoreNameProjectSettings+"/"+key)
+			return nil
+		}
+		if _, ok := projects[key]; ok || invalidProjects[key] || entry.Created().After(cutoff) {
+			return nil
+		}
+		report.OrphanedSettings = append(report.OrphanedSettings, key)
+		if repair {
+			err := s.ProjectSettings.Delete(ctx, key, jetstream.LastRevision(entry.Revision()))
+			record(err, "error deleting orphaned project settings", "project_uid", key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(report.DanglingSlugMappings)
+	sort.Strings(report.MissingSlugMappings)
+	sort.Strings(report.DanglingLegacyIDMappings)
+	sort.Strings(report.MissingLegacyIDMappings)
+	sort.Strings(report.OrphanedSettings)
+	sort.Strings(report.OrphanedChildren)
+	sort.Strings(report.InvalidEntries)
+
+	return report, nil
+}
+
+// RebuildSlugMappings rebuilds the slug mappings from the projects: the mapping
+// of each project's slug is created if it is missing, and pointed at the
+// project if it points at a missing project or at one with another slug.
+// Mappings of slugs that no project holds are reported as dangling and, when
+// removeDangling is set, deleted. Slugs held by more than one project are
+// reported and left as they are. As for CheckConsistency, mappings written in
+// the last few minutes are not touched, and every change is conditional on the
+// revision that was scanned. A project holds its slug and the slugs of the
+// projects merged into it, which redirect to it.
+func (s *NatsRepository) RebuildSlugMappings(ctx context.Context, removeDangling bool) (*models.SlugRebuildReport, error) {
+	cutoff := time.Now().Add(-consistencyGracePeriod)
+
+	slugEntries := map[string]jetstream.KeyValueEntry{}
+	projectsBySlug := map[string][]*models.ProjectBase{}
+	projectCount := 0
+	err := scanKeys(ctx, s.Projects, func(key string, entry jetstream.KeyValueEntry) error {
+		if slug, ok := strings.CutPrefix(key, slugKeyPrefix); ok {
+			slugEntries[slug] = entry
+			return nil
+		}
+		if strings.HasPrefix(key, legacyIDKeyPrefix) {
+			return nil
+		}
+		projectDB, _, err := decodeProjectBase(entry.Value())
+		if err != nil {
+			slog.ErrorContext(ctx, "error unmarshalling project from NATS KV store", constants.ErrKey, err, "project_uid", key)
+			return domain.ErrUnmarshal
+		}
+		projectCount++
+		if projectDB.Slug != "" {
+			projectsBySlug[projectDB.Slug] = append(projectsBySlug[projectDB.Slug], projectDB)
+		}
+		// The slugs of merged projects redirect to the project they were
+		// merged into.
+		for _, merged := range projectDB.MergedFrom {
+			projectsBySlug[merged.Slug] = append(projectsBySlug[merged.Slug], projectDB)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.SlugRebuildReport{
+		Projects:         projectCount,
+		CreatedMappings:  []string{},
+		UpdatedMappings:  []string{},
+		ConflictingSlugs: []string{},
+		DanglingMappings: []string{},
+	}
+
+	for slug, holders := range projectsBySlug {
+		if len(holders) > 1 {
+			report.ConflictingSlugs = append(report.ConflictingSlugs, slug)
+			continue
+		}
+		projectDB := holders[0]
+		entry, ok := slugEntries[slug]
+		if !ok {
+			if err := s.createProjectSlugMapping(ctx, projectDB); err != nil {
+				report.Failed++
+				slog.ErrorContext(ctx, "error creating missing slug mapping", constants.ErrKey, err, "slug", slug, "project_uid", projectDB.UID)
+				continue
+			}
+			report.CreatedMappings = append(report.CreatedMappings, slug)
+			continue
+		}
+		// The only project with the slug is not the one it maps to, so the
+		// mapped project is missing or has since changed its slug.
+		if string(entry.Value()) == projectDB.UID || entry.Created().After(cutoff) {
+			continue
+		}
+		if _, err := s.Projects.Update(ctx, slugKeyPrefix+slug, []byte(projectDB.UID), entry.Revision()); err != nil {
+			report.Failed++
+			slog.ErrorContext(ctx, "error updating stale slug mapping", constants.ErrKey, err, "slug", slug, "project_uid", projectDB.UID)
+			continue
+		}
+		if s.SlugCache != nil {
+			s.SlugCache.Set(slug, projectDB.UID)
+		}
+		report.UpdatedMappings = append(report.UpdatedMappings, slug)
+	}
+
+	for slug, entry := range slugEntries {
+		if _, ok := projectsBySlug[slug]; ok || entry.Created().After(cutoff) {
+			continue
+		}
+		report.DanglingMappings = append(report.DanglingMappings, slug)
+		if !removeDangling {
+			continue
+		}
+		if err := s.Projects.Delete(ctx, slugKeyPrefix+slug, jetstream.LastRevision(entry.Revision())); err != nil {
+			report.Failed++
+			slog.ErrorContext(ctx, "error deleting dangling slug mapping", constants.ErrKey, err, "slug", slug)
+			continue
+		}
+		if s.SlugCache != nil {
+			s.SlugCache.Remove(slug)
+		}
+		report.RemovedMappings++
+	}
+
+	sort.Strings(report.CreatedMappings)
+	sort.Strings(report.UpdatedMappings)
+	sort.Strings(report.ConflictingSlugs)
+	sort.Strings(report.DanglingMappings)
+
+	return report, nil
+}
+
+// scanKeys calls fn with the current entry of every key in kv. Keys deleted
+// while the scan runs are skipped.
+func scanKeys(ctx context.Context, kv INatsKeyValue, fn func(key string, entry jetstream.KeyValueEntry) error) error {
+	keysLister, err := kv.ListKeys(ctx)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return nil
+		}
+		slog.ErrorContext(ctx, "error listing keys from NATS KV store", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+	defer func() { _ = keysLister.Stop() }()
+
+	for key := range keysLister.Keys() {
+		entry, err := kv.Get(ctx, key)
+		if err != nil {
+			if errors.Is(err, jetstream.ErrKeyNotFound) {
+				continue
+			}
+			slog.ErrorContext(ctx, "error getting value from NATS KV store", constants.ErrKey, err, "key", key)
+			return domain.ErrInternal
+		}
+		if err := fn(key, entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,304 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newScannedEntry(value []byte, revision uint64, created time.Time) *MockKeyValueEntry {
+	entry := NewMockKeyValueEntry(value, revision)
+	entry.On("Created").Return(created).Maybe()
+	return entry
+}
+
+func newStoppableKeyLister(keys ...string) *MockKeyLister {
+	lister := NewMockKeyLister(keys)
+	lister.On("Stop").Return(nil)
+	return lister
+}
+
+func TestNatsRepository_CheckConsistency(t *testing.T) {
+	old := time.Now().Add(-time.Hour)
+	recent := time.Now()
+
+	project := func(uid, slug, legacyID string) []byte {
+		data, _ := json.Marshal(models.ProjectBase{UID: uid, Slug: slug, LegacyID: legacyID})
+		return data
+	}
+
+	// setupBuckets stores:
+	//   - project-1 with its slug and legacy ID mappings (consistent)
+	//   - project-2 without a slug mapping and without a legacy ID mapping
+	//   - slug/gone pointing at a project that does not exist
+	//   - slug/renamed pointing at project-1, which now has another slug
+	//   - slug/in-flight pointing at a project still being created
+	//   - legacy/gone pointing at a project that does not exist
+	//   - settings for project-1 (consistent), for a missing project, and for a
+	//     missing project created moments ago
+	setupBuckets := func(projectsKV, settingsKV *MockKeyValue) {
+		projectsKV.On("ListKeys", mock.Anything).Return(newStoppableKeyLister(
+			"project-1", "slug/project-1", "project-2", "slug/gone", "slug/renamed", "slug/in-flight",
+			"legacy/LEGACY1", "legacy/gone",
+		), nil)
+		projectsKV.On("Get", mock.Anything, "project-1").Return(newScannedEntry(project("project-1", "project-1", "LEGACY1"), 1, old), nil)
+		projectsKV.On("Get", mock.Anything, "slug/project-1").Return(newScannedEntry([]byte("project-1"), 2, old), nil)
+		projectsKV.On("Get", mock.Anything, "project-2").Return(newScannedEntry(project("project-2", "project-2", "LEGACY2"), 3, old), nil)
+		projectsKV.On("Get", mock.Anything, "slug/gone").Return(newScannedEntry([]byte("project-gone"), 4, old), nil)
+		projectsKV.On("Get", mock.Anything, "slug/renamed").Return(newScannedEntry([]byte("project-1"), 5, old), nil)
+		projectsKV.On("Get", mock.Anything, "slug/in-flight").Return(newScannedEntry([]byte("project-new"), 6, recent), nil)
+		projectsKV.On("Get", mock.Anything, "legacy/LEGACY1").Return(newScannedEntry([]byte("project-1"), 9, old), nil)
+		projectsKV.On("Get", mock.Anything, "legacy/gone").Return(newScannedEntry([]byte("project-gone"), 10, old), nil)
+
+		settingsKV.On("ListKeys", mock.Anything).Return(newStoppableKeyLister(
+			"project-1", "project-gone", "project-new", "lookup/abc",
+		), nil)
+		settingsKV.On("Get", mock.Anything, "project-1").Return(newScannedEntry([]byte(`{"uid":"project-1"}`), 1, old), nil)
+		settingsKV.On("Get", mock.Anything, "project-gone").Return(newScannedEntry([]byte(`{"uid":"project-gone"}`), 2, old), nil)
+		settingsKV.On("Get", mock.Anything, "project-new").Return(newScannedEntry([]byte(`{"uid":"project-new"}`), 3, recent), nil)
+		settingsKV.On("Get", mock.Anything, "lookup/abc").Return(newScannedEntry([]byte("project-1"), 4, old), nil)
+	}
+
+	tests := []struct {
+		name           string
+		repair         bool
+		setupMocks     func(*MockKeyValue, *MockKeyValue)
+		expectedReport *models.ConsistencyReport
+		expectedErr    error
+	}{
+		{
+			name:       "report only",
+			setupMocks: setupBuckets,
+			expectedReport: &models.ConsistencyReport{
+				DanglingSlugMappings:     []string{"gone", "renamed"},
+				MissingSlugMappings:      []string{"project-2"},
+				DanglingLegacyIDMappings: []string{"gone"},
+				MissingLegacyIDMappings:  []string{"project-2"},
+				OrphanedSettings:         []string{"project-gone"},
+				OrphanedChildren:         []string{},
+				InvalidEntries:           []string{},
+			},
+		},
+		{
+			name:   "repair",
+			repair: true,
+			setupMocks: func(projectsKV, settingsKV *MockKeyValue) {
+				setupBuckets(projectsKV, settingsKV)
+				projectsKV.On("Delete", mock.Anything, "slug/gone", mock.Anything).Return(nil)
+				projectsKV.On("Delete", mock.Anything, "slug/renamed", mock.Anything).Return(errors.New("nats: wrong last sequence: 7"))
+				projectsKV.On("Create", mock.Anything, "slug/project-2", []byte("project-2")).Return(uint64(8), nil)
+				projectsKV.On("Delete", mock.Anything, "legacy/gone", mock.Anything).Return(nil)
+				projectsKV.On("Create", mock.Anything, "legacy/LEGACY2", []byte("project-2")).Return(uint64(11), nil)
+				settingsKV.On("Delete", mock.Anything, "project-gone", mock.Anything).Return(nil)
+			},
+			expectedReport: &models.ConsistencyReport{
+				DanglingSlugMappings:     []string{"gone", "renamed"},
+				MissingSlugMappings:      []string{"project-2"},
+				DanglingLegacyIDMappings: []string{"gone"},
+				MissingLegacyIDMappings:  []string{"project-2"},
+				OrphanedSettings:         []string{"project-gone"},
+				OrphanedChildren:         []string{},
+				InvalidEntries:           []string{},
+				Repaired:                 5,
+				Failed:                   1,
+			},
+		},
+		{
+			name: "orphaned children and invalid entries",
+			setupMocks: func(projectsKV, settingsKV *MockKeyValue) {
+				child := func(uid, slug, parentUID string) []byte {
+					data, _ := json.Marshal(models.ProjectBase{UID: uid, Slug: slug, ParentUID: parentUID})
+					return data
+				}
+				projectsKV.On("ListKeys", mock.Anything).Return(newStoppableKeyLister(
+					"project-1", "slug/one", "project-2", "slug/two", "project-3", "slug/three", "project-4", "slug/four",
+				), nil)
+				projectsKV.On("Get", mock.Anything, "project-1").Return(newScannedEntry(child("project-1", "one", ""), 1, old), nil)
+				projectsKV.On("Get", mock.Anything, "slug/one").Return(newScannedEntry([]byte("project-1"), 2, old), nil)
+				projectsKV.On("Get", mock.Anything, "project-2").Return(newScannedEntry(child("project-2", "two", "project-gone"), 3, old), nil)
+				projectsKV.On("Get", mock.Anything, "slug/two").Return(newScannedEntry([]byte("project-2"), 4, old), nil)
+				projectsKV.On("Get", mock.Anything, "project-3").Return(newScannedEntry([]byte("{"), 5, old), nil)
+				projectsKV.On("Get", mock.Anything, "slug/three").Return(newScannedEntry([]byte("project-3"), 6, old), nil)
+				projectsKV.On("Get", mock.Anything, "project-4").Return(newScannedEntry(child("project-4", "four", "project-3"), 7, old), nil)
+				projectsKV.On("Get", mock.Anything, "slug/four").Return(newScannedEntry([]byte("project-4"), 8, old), nil)
+
+				settingsKV.On("ListKeys", mock.Anything).Return(newStoppableKeyLister("project-1", "project-3"), nil)
+				settingsKV.On("Get", mock.Anything, "project-1").Return(newScannedEntry([]byte("not json"), 1, old), nil)
+				settingsKV.On("Get", mock.Anything, "project-3").Return(newScannedEntry([]byte(`{"uid":"project-3"}`), 2, old), nil)
+			},
+			expectedReport: &models.ConsistencyReport{
+				DanglingSlugMappings:     []string{},
+				MissingSlugMappings:      []string{},
+				DanglingLegacyIDMappings: []string{},
+				MissingLegacyIDMappings:  []string{},
+				OrphanedSettings:         []string{},
+				OrphanedChildren:         []string{"project-2"},
+				InvalidEntries:           []string{"project-settings/project-1", "projects/project-3"},
+			},
+		},
+		{
+			name: "empty buckets",
+			setupMocks: func(projectsKV, settingsKV *MockKeyValue) {
+				projectsKV.On("ListKeys", mock.Anything).Return(nil, jetstream.ErrNoKeysFound)
+				settingsKV.On("ListKeys", mock.Anything).Return(nil, jetstream.ErrNoKeysFound)
+			},
+			expectedReport: &models.ConsistencyReport{
+				DanglingSlugMappings:     []string{},
+				MissingSlugMappings:      []string{},
+				DanglingLegacyIDMappings: []string{},
+				MissingLegacyIDMappings:  []string{},
+				OrphanedSettings:         []string{},
+				OrphanedChildren:         []string{},
+				InvalidEntries:           []string{},
+			},
+		},
+		{
+			name: "list error",
+			setupMocks: func(projectsKV, _ *MockKeyValue) {
+				projectsKV.On("ListKeys", mock.Anything).Return(nil, errors.New("nats: timeout"))
+			},
+			expectedErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectsKV := &MockKeyValue{}
+			settingsKV := &MockKeyValue{}
+			tt.setupMocks(projectsKV, settingsKV)
+			repo := NewNatsRepository(projectsKV, settingsKV)
+
+			report, err := repo.CheckConsistency(context.Background(), tt.repair)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedReport, report)
+			projectsKV.AssertExpectations(t)
+			settingsKV.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNatsRepository_RebuildSlugMappings(t *testing.T) {
+	old := time.Now().Add(-time.Hour)
+	recent := time.Now()
+
+	project := func(uid, slug string) []byte {
+		data, _ := json.Marshal(models.ProjectBase{UID: uid, Slug: slug})
+		return data
+	}
+
+	// setupBucket stores:
+	//   - project-1 with its slug mapping (consistent)
+	//   - project-2 without a slug mapping
+	//   - project-3 whose slug maps to a project that does not exist
+	//   - project-4 and project-5, which both have the slug dup
+	//   - slug/gone pointing at a project that does not exist
+	//   - slug/in-flight pointing at a project still being created
+	//   - a legacy ID mapping, which is not a project
+	setupBucket := func(projectsKV *MockKeyValue) {
+		projectsKV.On("ListKeys", mock.Anything).Return(newStoppableKeyLister(
+			"project-1", "slug/one", "project-2", "project-3", "slug/three", "project-4", "project-5", "slug/dup",
+			"slug/gone", "slug/in-flight", "legacy/LEGACY1",
+		), nil)
+		projectsKV.On("Get", mock.Anything, "project-1").Return(newScannedEntry(project("project-1", "one"), 1, old), nil)
+		projectsKV.On("Get", mock.Anything, "slug/one").Return(newScannedEntry([]byte("project-1"), 2, old), nil)
+		projectsKV.On("Get", mock.Anything, "project-2").Return(newScannedEntry(project("project-2", "two"), 3, old), nil)
+		projectsKV.On("Get", mock.Anything, "project-3").Return(newScannedEntry(project("project-3", "three"), 4, old), nil)
+		projectsKV.On("Get", mock.Anything, "slug/three").Return(newScannedEntry([]byte("project-gone"), 5, old), nil)
+		projectsKV.On("Get", mock.Anything, "project-4").Return(newScannedEntry(project("project-4", "dup"), 6, old), nil)
+		projectsKV.On("Get", mock.Anything, "project-5").Return(newScannedEntry(project("project-5", "dup"), 7, old), nil)
+		projectsKV.On("Get", mock.Anything, "slug/dup").Return(newScannedEntry([]byte("project-4"), 8, old), nil)
+		projectsKV.On("Get", mock.Anything, "slug/gone").Return(newScannedEntry([]byte("project-gone"), 9, old), nil)
+		projectsKV.On("Get", mock.Anything, "slug/in-flight").Return(newScannedEntry([]byte("project-new"), 10, recent), nil)
+		projectsKV.On("Get", mock.Anything, "legacy/LEGACY1").Return(newScannedEntry([]byte("project-1"), 11, old), nil)
+		projectsKV.On("Create", mock.Anything, "slug/two", []byte("project-2")).Return(uint64(12), nil)
+		projectsKV.On("Update", mock.Anything, "slug/three", []byte("project-3"), uint64(5)).Return(uint64(13), nil)
+	}
+
+	tests := []struct {
+		name           string
+		removeDangling bool
+		setupMocks     func(*MockKeyValue)
+		expectedReport *models.SlugRebuildReport
+	}{
+		{
+			name:       "dangling mappings are reported",
+			setupMocks: setupBucket,
+			expectedReport: &models.SlugRebuildReport{
+				Projects:         5,
+				CreatedMappings:  []string{"two"},
+				UpdatedMappings:  []string{"three"},
+				ConflictingSlugs: []string{"dup"},
+				DanglingMappings: []string{"gone"},
+			},
+		},
+		{
+			name:           "dangling mappings are removed",
+			removeDangling: true,
+			setupMocks: func(projectsKV *MockKeyValue) {
+				setupBucket(projectsKV)
+				projectsKV.On("Delete", mock.Anything, "slug/gone", mock.Anything).Return(nil)
+			},
+			expectedReport: &models.SlugRebuildReport{
+				Projects:         5,
+				CreatedMappings:  []string{"two"},
+				UpdatedMappings:  []string{"three"},
+				ConflictingSlugs: []string{"dup"},
+				DanglingMappings: []string{"gone"},
+				RemovedMappings:  1,
+			},
+		},
+		{
+			name: "failed writes are counted",
+			setupMocks: func(projectsKV *MockKeyValue) {
+				projectsKV.On("ListKeys", mock.Anything).Return(newStoppableKeyLister("project-2"), nil)
+				projectsKV.On("Get", mock.Anything, "project-2").Return(newScannedEntry(project("project-2", "two"), 3, old), nil)
+				projectsKV.On("Create", mock.Anything, "slug/two", []byte("project-2")).Return(uint64(0), jetstream.ErrKeyExists)
+			},
+			expectedReport: &models.SlugRebuildReport{
+				Projects:         1,
+				CreatedMappings:  []string{},
+				UpdatedMappings:  []string{},
+				ConflictingSlugs: []string{},
+				DanglingMappings: []string{},
+				Failed:           1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectsKV := &MockKeyValue{}
+			tt.setupMocks(projectsKV)
+			repo := NewNatsRepository(projectsKV, &MockKeyValue{})
+			repo.SlugCache = NewSlugCache(10)
+			repo.SlugCache.Set("three", "project-gone")
+
+			report, err := repo.RebuildSlugMappings(context.Background(), tt.removeDangling)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedReport, report)
+			projectsKV.AssertExpectations(t)
+			if len(tt.expectedReport.UpdatedMappings) > 0 {
+				uid, _ := repo.SlugCache.Get("three")
+				assert.Equal(t, "project-3", uid)
+			}
+		})
+	}
+}
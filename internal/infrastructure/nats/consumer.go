@@ -0,0 +1,127 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/metrics"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// ConsumerConfig configures a durable JetStream consumer read with [Consume].
+type ConsumerConfig struct {
+	// Stream is the stream the consumer reads.
+	Stream string
+	// Durable is the name under which the service's replicas share the
+	// consumer, and so its position in the stream.
+	Durable string
+	// FilterSubject selects the messages of the stream that are consumed.
+	FilterSubject string
+	// Subject is FilterSubject as the handler and the metrics know it, without
+	// the tenant and under the default subject prefix.
+	Subject string
+	// Retry bounds the deliveries of a message whose handler fails with a
+	// transient error, and the backoff between them.
+	Retry RetryPolicy
+}
+
+// Consume creates or updates the durable consumer of cfg and handles its
+// messages on workers until the returned context is stopped. Only one message
+// is handled at a time across every replica, so messages are handled in the
+// order they were published.
+//
+// A message is acknowledged once handle returns nil. While handle fails with
+// an error that domain.IsRetryable reports as transient, the message is
+// redelivered after the retry backoff, up to Retry.MaxAttempts deliveries.
+// It is then, or at once for other errors, terminated and passed to failed.
+func Consume(ctx context.Context, js jetstream.JetStream, workers *WorkerPool, cfg ConsumerConfig,
+	handle func(context.Context, domain.Message) error, failed func(context.Context, domain.Message, error),
+) (jetstream.ConsumeContext, error) {
+	consumerConfig := jetstream.ConsumerConfig{
+		Durable:       cfg.Durable,
+		FilterSubject: cfg.FilterSubject,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		MaxAckPending: 1,
+	}
+	if cfg.Retry.MaxAttempts > 0 {
+		// The server stops redelivering a message that is not acknowledged in
+		// time, such as when the replica handling it stops, after as many
+		// deliveries as the service would make.
+		consumerConfig.MaxDeliver = cfg.Retry.MaxAttempts
+	}
+	consumer, err := js.CreateOrUpdateConsumer(ctx, cfg.Stream, consumerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return consumer.Consume(func(msg jetstream.Msg) {
+		workers.Go(ctx, cfg.Subject, func(ctx context.Context) {
+			start := time.Now()
+			msgCtx, end := startProcessSpan(ctx, msg.Headers(), len(msg.Data()), cfg.Subject)
+			defer end()
+			handlerErr := handle(msgCtx, jetStreamMsg{msg})
+			metrics.ObserveNATSHandler(cfg.Subject, start, handlerErr)
+			settle(msgCtx, msg, handlerErr, cfg.Retry, failed)
+		})
+	}, jetstream.ConsumeErrHandler(func(_ jetstream.ConsumeContext, err error) {
+		slog.WarnContext(ctx, "error consuming JetStream messages", constants.ErrKey, err,
+			"stream", cfg.Stream, "consumer", cfg.Durable)
+	}))
+}
+
+// settle acknowledges msg once it is handled, or after handlerErr, decides
+// whether it is redelivered or terminated and passed to failed.
+func settle(ctx context.Context, msg jetstream.Msg, handlerErr error, retry RetryPolicy,
+	failed func(context.Context, domain.Message, error),
+) {
+	if handlerErr == nil {
+		if err := msg.Ack(); err != nil {
+			slog.WarnContext(ctx, "error acknowledging JetStream message", constants.ErrKey, err)
+		}
+		return
+	}
+
+	delivered := 1
+	if metadata, err := msg.Metadata(); err == nil {
+		delivered = int(metadata.NumDelivered)
+	}
+	if domain.IsRetryable(handlerErr) && delivered < retry.MaxAttempts {
+		delay := retry.delay(delivered + 1)
+		slog.WarnContext(ctx, "JetStream message handler failed, redelivering the message", constants.ErrKey, handlerErr,
+			"delivered", delivered, "delay", delay)
+		if err := msg.NakWithDelay(delay); err != nil {
+			slog.WarnContext(ctx, "error rejecting JetStream message", constants.ErrKey, err)
+		}
+		return
+	}
+
+	slog.WarnContext(ctx, "JetStream message handler failed, giving up on the message", constants.ErrKey, handlerErr,
+		"delivered", delivered)
+	if err := msg.TermWithReason(handlerErr.Error()); err != nil {
+		slog.WarnContext(ctx, "error terminating JetStream message", constants.ErrKey, err)
+	}
+	// The handler context may have expired; recording must not.
+	failed(context.WithoutCancel(ctx), jetStreamMsg{msg}, handlerErr)
+}
+
+// jetStreamMsg adapts a JetStream message to [domain.Message]. Messages
+// published to a stream are acknowledged by JetStream, so there is no
+// requester to reply to.
+type jetStreamMsg struct {
+	jetstream.Msg
+}
+
+// Respond implements [domain.Message.Respond].
+func (m jetStreamMsg) Respond([]byte) error {
+	return natsgo.ErrMsgNoReply
+}
+
+var _ domain.Message = jetStreamMsg{}
@@ -0,0 +1,80 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+)
+
+// testJetStreamMsg is a jetstream.Msg delivered delivered times, which
+// records how it was settled.
+type testJetStreamMsg struct {
+	jetstream.Msg
+	delivered uint64
+	settled   string
+	delay     time.Duration
+}
+
+func (m *testJetStreamMsg) Metadata() (*jetstream.MsgMetadata, error) {
+	return &jetstream.MsgMetadata{NumDelivered: m.delivered}, nil
+}
+func (m *testJetStreamMsg) Subject() string { return "lfx.projects-api.upsert" }
+func (m *testJetStreamMsg) Data() []byte    { return []byte(`{"legacy_id":"a0941000002wBz4AAE"}`) }
+func (m *testJetStreamMsg) Ack() error {
+	m.settled = "ack"
+	return nil
+}
+func (m *testJetStreamMsg) NakWithDelay(delay time.Duration) error {
+	m.settled, m.delay = "nak", delay
+	return nil
+}
+func (m *testJetStreamMsg) TermWithReason(string) error {
+	m.settled = "term"
+	return nil
+}
+
+func TestSettle(t *testing.T) {
+	retry := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute}
+
+	tests := []struct {
+		name       string
+		err        error
+		delivered  uint64
+		settled    string
+		deadLetter bool
+	}{
+		{name: "handled", delivered: 1, settled: "ack"},
+		{name: "transient error", err: domain.ErrInternal, delivered: 1, settled: "nak"},
+		{name: "transient error on the last delivery", err: domain.ErrRevisionMismatch, delivered: 3, settled: "term", deadLetter: true},
+		{name: "permanent error", err: domain.ErrProjectSlugExists, delivered: 1, settled: "term", deadLetter: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := &testJetStreamMsg{delivered: tt.delivered}
+			var failedErr error
+
+			settle(context.Background(), msg, tt.err, retry, func(_ context.Context, failedMsg domain.Message, err error) {
+				assert.Equal(t, "lfx.projects-api.upsert", failedMsg.Subject())
+				failedErr = err
+			})
+
+			assert.Equal(t, tt.settled, msg.settled)
+			assert.LessOrEqual(t, msg.delay, 2*time.Second)
+			if tt.deadLetter {
+				assert.True(t, errors.Is(failedErr, tt.err))
+			} else {
+				assert.NoError(t, failedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"testing"
+
+	fgaconstants "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/constants"
+	fgatypes "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/types"
+	indexerConstants "github.com/linuxfoundation/lfx-v2-indexer-service/pkg/constants"
+	indexerTypes "github.com/linuxfoundation/lfx-v2-indexer-service/pkg/types"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/api/messaging"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+const contractTestUID = "01234567-89ab-cdef-0123-456789abcdef"
+
+// publishedData sends message with send and returns the body published.
+func publishedData(t *testing.T, send func(*MessageBuilder) error) []byte {
+	t.Helper()
+	var data []byte
+	mockConn := &MockNATSConn{}
+	mockConn.On("PublishMsg", mock.Anything).Run(func(args mock.Arguments) {
+		data = args.Get(0).(*nats.Msg).Data
+	}).Return(nil).Once()
+
+	require.NoError(t, send(&MessageBuilder{NatsConn: mockConn}))
+
+	mockConn.AssertExpectations(t)
+	return data
+}
+
+// TestPublishedMessageContracts checks the messages published on the wire
+// against the schemas published in api/messaging.
+func TestPublishedMessageContracts(t *testing.T) {
+	ctx := context.WithValue(context.Background(), constants.AuthorizationContextID, "Bearer token")
+	project := models.ProjectBase{
+		UID:       contractTestUID,
+		Slug:      "test-project",
+		Name:      "Test Project",
+		Public:    true,
+		ParentUID: "11111111-2222-3333-4444-555555555555",
+	}
+
+	tests := []struct {
+		name    string
+		schema  string
+		subject string
+		send    func(*MessageBuilder, string) error
+	}{
+		{
+			name:    "created project",
+			schema:  "index.project.json",
+			subject: constants.IndexProjectSubject,
+			send: func(mb *MessageBuilder, subject string) error {
+				return mb.SendIndexerMessage(ctx, subject, indexerTypes.IndexerMessageEnvelope{
+					Action:         indexerConstants.ActionCreated,
+					Data:           project,
+					IndexingConfig: project.IndexingConfig(),
+				}, false)
+			},
+		},
+		{
+			name:    "deleted project",
+			schema:  "index.project.json",
+			subject: constants.IndexProjectSubject,
+			send: func(mb *MessageBuilder, subject string) error {
+				return mb.SendIndexerMessage(ctx, subject, indexerTypes.IndexerMessageEnvelope{
+					Action: indexerConstants.ActionDeleted,
+					Data:   project.UID,
+				}, false)
+			},
+		},
+		{
+			name:    "delete access",
+			schema:  "fga.delete_access.json",
+			subject: fgaconstants.GenericDeleteAccessSubject,
+			send: func(mb *MessageBuilder, subject string) error {
+				return mb.SendAccessMessage(ctx, subject, fgatypes.GenericFGAMessage{
+					ObjectType: "project",
+					Operation:  "delete_access",
+					Data:       fgatypes.GenericDeleteData{UID: project.UID},
+				}, false)
+			},
+		},
+		{
+			name:    "member put",
+			schema:  "fga.member.json",
+			subject: fgaconstants.GenericMemberPutSubject,
+			send: func(mb *MessageBuilder, subject string) error {
+				return mb.SendAccessMessage(ctx, subject, fgatypes.GenericFGAMessage{
+					ObjectType: "project",
+					Operation:  "member_put",
+					Data: fgatypes.GenericMemberData{
+						UID:       project.UID,
+						Username:  "alice",
+						Relations: []string{"writer"},
+					},
+				}, false)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := publishedData(t, func(mb *MessageBuilder) error {
+				return tt.send(mb, tt.subject)
+			})
+			assert.NoError(t, messaging.Validate(tt.schema, data), "message %s", data)
+		})
+	}
+}
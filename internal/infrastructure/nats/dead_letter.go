@@ -0,0 +1,93 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sort"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// PutDeadLetter stores a dead-lettered message keyed by its ID, replacing any
+// previous version of it.
+func (s *NatsRepository) PutDeadLetter(ctx context.Context, deadLetter *models.DeadLetter) error {
+	data, err := json.Marshal(deadLetter)
+	if err != nil {
+		slog.ErrorContext(ctx, "error marshalling dead letter into JSON", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	if _, err := s.DeadLetters.Put(ctx, deadLetter.ID, data); err != nil {
+		slog.ErrorContext(ctx, "error putting dead letter into NATS KV store", constants.ErrKey, err, "dead_letter_id", deadLetter.ID)
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+// GetDeadLetter returns a dead-lettered message.
+func (s *NatsRepository) GetDeadLetter(ctx context.Context, deadLetterID string) (*models.DeadLetter, error) {
+	entry, err := s.DeadLetters.Get(ctx, deadLetterID)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, domain.ErrDeadLetterNotFound
+		}
+		slog.ErrorContext(ctx, "error getting dead letter from NATS KV store", constants.ErrKey, err, "dead_letter_id", deadLetterID)
+		return nil, domain.ErrInternal
+	}
+
+	deadLetter := &models.DeadLetter{}
+	if err := json.Unmarshal(entry.Value(), deadLetter); err != nil {
+		slog.ErrorContext(ctx, "error unmarshalling dead letter from NATS KV store", constants.ErrKey, err, "dead_letter_id", deadLetterID)
+		return nil, domain.ErrUnmarshal
+	}
+	return deadLetter, nil
+}
+
+// ListDeadLetters returns every dead-lettered message, oldest first.
+func (s *NatsRepository) ListDeadLetters(ctx context.Context) ([]*models.DeadLetter, error) {
+	keysLister, err := s.DeadLetters.ListKeys(ctx)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return []*models.DeadLetter{}, nil
+		}
+		slog.ErrorContext(ctx, "error listing dead letter keys from NATS KV store", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+
+	deadLetters := []*models.DeadLetter{}
+	for key := range keysLister.Keys() {
+		deadLetter, err := s.GetDeadLetter(ctx, key)
+		if err != nil {
+			if errors.Is(err, domain.ErrDeadLetterNotFound) {
+				// Replayed and removed since the keys were listed.
+				continue
+			}
+			return nil, err
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+
+	sort.Slice(deadLetters, func(i, j int) bool {
+		return deadLetters[i].FailedAt.Before(deadLetters[j].FailedAt)
+	})
+
+	return deadLetters, nil
+}
+
+// DeleteDeadLetter removes a replayed message.
+func (s *NatsRepository) DeleteDeadLetter(ctx context.Context, deadLetterID string) error {
+	if err := s.DeadLetters.Purge(ctx, deadLetterID); err != nil {
+		slog.ErrorContext(ctx, "error purging dead letter from NATS KV store", constants.ErrKey, err, "dead_letter_id", deadLetterID)
+		return domain.ErrInternal
+	}
+	return nil
+}
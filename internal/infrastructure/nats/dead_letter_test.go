@@ -0,0 +1,106 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNatsRepository_ListDeadLetters(t *testing.T) {
+	now := time.Now()
+	older, _ := json.Marshal(&models.DeadLetter{ID: "dl-1", Subject: "lfx.projects-api.project_settings.updated", FailedAt: now.Add(-time.Minute)})
+	newer, _ := json.Marshal(&models.DeadLetter{ID: "dl-2", Subject: "lfx.projects-api.project_settings.updated", FailedAt: now})
+
+	tests := []struct {
+		name        string
+		setupMock   func(kv *MockKeyValue)
+		expectedIDs []string
+		expectedErr error
+	}{
+		{
+			name: "returns dead letters oldest first",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("ListKeys", mock.Anything).Return(NewMockKeyLister([]string{"dl-2", "dl-1"}), nil)
+				kv.On("Get", mock.Anything, "dl-1").Return(NewMockKeyValueEntry(older, 1), nil)
+				kv.On("Get", mock.Anything, "dl-2").Return(NewMockKeyValueEntry(newer, 2), nil)
+			},
+			expectedIDs: []string{"dl-1", "dl-2"},
+		},
+		{
+			name: "skips dead letters replayed since listing",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("ListKeys", mock.Anything).Return(NewMockKeyLister([]string{"dl-1", "dl-2"}), nil)
+				kv.On("Get", mock.Anything, "dl-1").Return(nil, jetstream.ErrKeyNotFound)
+				kv.On("Get", mock.Anything, "dl-2").Return(NewMockKeyValueEntry(newer, 2), nil)
+			},
+			expectedIDs: []string{"dl-2"},
+		},
+		{
+			name: "empty bucket",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("ListKeys", mock.Anything).Return(nil, jetstream.ErrNoKeysFound)
+			},
+			expectedIDs: []string{},
+		},
+		{
+			name: "list error",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("ListKeys", mock.Anything).Return(nil, errors.New("nats error"))
+			},
+			expectedErr: domain.ErrInternal,
+		},
+		{
+			name: "corrupt dead letter",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("ListKeys", mock.Anything).Return(NewMockKeyLister([]string{"dl-1"}), nil)
+				kv.On("Get", mock.Anything, "dl-1").Return(NewMockKeyValueEntry([]byte("not json"), 1), nil)
+			},
+			expectedErr: domain.ErrUnmarshal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDeadLettersKV := &MockKeyValue{}
+			tt.setupMock(mockDeadLettersKV)
+			repo := &NatsRepository{DeadLetters: mockDeadLettersKV}
+
+			deadLetters, err := repo.ListDeadLetters(context.Background())
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, deadLetters)
+			} else {
+				assert.NoError(t, err)
+				ids := []string{}
+				for _, deadLetter := range deadLetters {
+					ids = append(ids, deadLetter.ID)
+				}
+				assert.Equal(t, tt.expectedIDs, ids)
+			}
+
+			mockDeadLettersKV.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNatsRepository_GetDeadLetter_notFound(t *testing.T) {
+	mockDeadLettersKV := &MockKeyValue{}
+	mockDeadLettersKV.On("Get", mock.Anything, "dl-1").Return(nil, jetstream.ErrKeyNotFound)
+	repo := &NatsRepository{DeadLetters: mockDeadLettersKV}
+
+	_, err := repo.GetDeadLetter(context.Background(), "dl-1")
+
+	assert.ErrorIs(t, err, domain.ErrDeadLetterNotFound)
+}
@@ -0,0 +1,75 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// PutProjectDeletion stores the tombstone of a deleted project keyed by its UID.
+func (s *NatsRepository) PutProjectDeletion(ctx context.Context, deletion *models.ProjectDeletion) error {
+	data, err := json.Marshal(deletion)
+	if err != nil {
+		slog.ErrorContext(ctx, "error marshalling project deletion into JSON", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	if _, err := s.Deletions.Put(ctx, deletion.UID, data); err != nil {
+		slog.ErrorContext(ctx, "error putting project deletion in NATS KV store", constants.ErrKey, err, "project_uid", deletion.UID)
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+// ListProjectDeletions returns the tombstones of the projects deleted at or
+// after since, oldest first. Tombstones the bucket has expired are gone.
+func (s *NatsRepository) ListProjectDeletions(ctx context.Context, since time.Time) ([]*models.ProjectDeletion, error) {
+	keysLister, err := s.Deletions.ListKeys(ctx)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return []*models.ProjectDeletion{}, nil
+		}
+		slog.ErrorContext(ctx, "error listing project deletion keys from NATS KV store", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+
+	deletions := []*models.ProjectDeletion{}
+	for key := range keysLister.Keys() {
+		entry, err := s.Deletions.Get(ctx, key)
+		if err != nil {
+			if errors.Is(err, jetstream.ErrKeyNotFound) {
+				// Expired since the keys were listed.
+				continue
+			}
+			slog.ErrorContext(ctx, "error getting project deletion from NATS KV store", constants.ErrKey, err, "key", key)
+			return nil, domain.ErrInternal
+		}
+		deletion := &models.ProjectDeletion{}
+		if err := json.Unmarshal(entry.Value(), deletion); err != nil {
+			slog.ErrorContext(ctx, "error unmarshalling project deletion from NATS KV store", constants.ErrKey, err, "key", key)
+			return nil, domain.ErrUnmarshal
+		}
+		if deletion.DeletedAt.Before(since) {
+			continue
+		}
+		deletions = append(deletions, deletion)
+	}
+
+	sort.Slice(deletions, func(i, j int) bool {
+		return deletions[i].DeletedAt.Before(deletions[j].DeletedAt)
+	})
+
+	return deletions, nil
+}
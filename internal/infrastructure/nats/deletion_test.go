@@ -0,0 +1,75 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNatsRepository_PutProjectDeletion(t *testing.T) {
+	t.Run("stores the tombstone under the project UID", func(t *testing.T) {
+		deletionsKV := &MockKeyValue{}
+		deletionsKV.On("Put", mock.Anything, "project-1", mock.Anything).Return(uint64(1), nil)
+		repo := &NatsRepository{Deletions: deletionsKV}
+
+		err := repo.PutProjectDeletion(context.Background(), &models.ProjectDeletion{UID: "project-1", Slug: "project"})
+
+		assert.NoError(t, err)
+		deletionsKV.AssertExpectations(t)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		deletionsKV := &MockKeyValue{}
+		deletionsKV.On("Put", mock.Anything, "project-1", mock.Anything).Return(uint64(0), errors.New("nats error"))
+		repo := &NatsRepository{Deletions: deletionsKV}
+
+		err := repo.PutProjectDeletion(context.Background(), &models.ProjectDeletion{UID: "project-1"})
+
+		assert.ErrorIs(t, err, domain.ErrInternal)
+	})
+}
+
+func TestNatsRepository_ListProjectDeletions(t *testing.T) {
+	since := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	older, _ := json.Marshal(&models.ProjectDeletion{UID: "older", DeletedAt: since.Add(-time.Hour)})
+	first, _ := json.Marshal(&models.ProjectDeletion{UID: "first", DeletedAt: since})
+	second, _ := json.Marshal(&models.ProjectDeletion{UID: "second", DeletedAt: since.Add(time.Hour)})
+
+	deletionsKV := &MockKeyValue{}
+	deletionsKV.On("ListKeys", mock.Anything).Return(NewMockKeyLister([]string{"second", "older", "expired", "first"}), nil)
+	deletionsKV.On("Get", mock.Anything, "older").Return(NewMockKeyValueEntry(older, 1), nil)
+	deletionsKV.On("Get", mock.Anything, "first").Return(NewMockKeyValueEntry(first, 2), nil)
+	deletionsKV.On("Get", mock.Anything, "second").Return(NewMockKeyValueEntry(second, 3), nil)
+	deletionsKV.On("Get", mock.Anything, "expired").Return(nil, jetstream.ErrKeyNotFound)
+	repo := &NatsRepository{Deletions: deletionsKV}
+
+	deletions, err := repo.ListProjectDeletions(context.Background(), since)
+
+	require.NoError(t, err)
+	require.Len(t, deletions, 2)
+	assert.Equal(t, "first", deletions[0].UID)
+	assert.Equal(t, "second", deletions[1].UID)
+}
+
+func TestNatsRepository_ListProjectDeletions_empty(t *testing.T) {
+	deletionsKV := &MockKeyValue{}
+	deletionsKV.On("ListKeys", mock.Anything).Return(nil, jetstream.ErrNoKeysFound)
+	repo := &NatsRepository{Deletions: deletionsKV}
+
+	deletions, err := repo.ListProjectDeletions(context.Background(), time.Time{})
+
+	require.NoError(t, err)
+	assert.Empty(t, deletions)
+}
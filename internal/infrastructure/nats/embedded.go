@@ -0,0 +1,98 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// embeddedReadyTimeout bounds how long StartEmbeddedServer waits for the
+// server to accept connections.
+const embeddedReadyTimeout = 10 * time.Second
+
+// bucketConfig is a key-value bucket of the service and how it is provisioned.
+type bucketConfig struct {
+	name    string
+	history uint8
+	ttl     time.Duration
+}
+
+// buckets are the key-value buckets of the service, provisioned like in the
+// development setup of CLAUDE.md.
+var buckets = []bucketConfig{
+	{name: constants.KVStoreNameProjects, history: 20},
+	{name: constants.KVStoreNameProjectSettings, history: 20},
+	{name: constants.KVStoreNameProjectLinks, history: 20},
+	{name: constants.KVStoreNameProjectFolders, history: 20},
+	{name: constants.KVStoreNameProjectDocuments, history: 20},
+	{name: constants.KVStoreNameProjectOutbox, history: 1},
+	{name: constants.KVStoreNameProjectDeadLetters, history: 1},
+	{name: constants.KVStoreNameProjectCharters, history: 20},
+	{name: constants.KVStoreNameProjectAssociations, history: 1},
+	{name: constants.KVStoreNameProjectWebhooks, history: 1},
+	{name: constants.KVStoreNameProjectWebhookDeliveries, history: 1},
+	{name: constants.KVStoreNameProjectAnnouncements, history: 1},
+	{name: constants.KVStoreNameProjectTemplates, history: 1},
+	{name: constants.KVStoreNameProjectMetadata, history: 5},
+	{name: constants.KVStoreNameProjectDeletions, history: 1, ttl: 720 * time.Hour},
+}
+
+// StartEmbeddedServer starts an in-process NATS server with JetStream, storing
+// its streams in storeDir, and waits until it accepts connections. It listens
+// on a random port of the loopback interface; its URL is ClientURL.
+func StartEmbeddedServer(storeDir string) (*server.Server, error) {
+	ns, err := server.NewServer(&server.Options{
+		ServerName: "project-service-embedded",
+		Host:       "127.0.0.1",
+		Port:       server.RANDOM_PORT,
+		JetStream:  true,
+		StoreDir:   storeDir,
+		NoSigs:     true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	go ns.Start()
+	if !ns.ReadyForConnections(embeddedReadyTimeout) {
+		ns.Shutdown()
+		return nil, errors.New("embedded NATS server not ready for connections")
+	}
+
+	return ns, nil
+}
+
+// CreateBuckets creates the key-value buckets and the document object store
+// of the service that do not exist yet, leaving the existing ones unchanged.
+func CreateBuckets(ctx context.Context, js jetstream.JetStream) error {
+	for _, bucket := range buckets {
+		_, err := js.CreateKeyValue(ctx, jetstream.KeyValueConfig{
+			Bucket:  bucket.name,
+			History: bucket.history,
+			TTL:     bucket.ttl,
+			Storage: jetstream.FileStorage,
+		})
+		if err != nil && !errors.Is(err, jetstream.ErrBucketExists) {
+			return fmt.Errorf("creating key-value store %s: %w", bucket.name, err)
+		}
+	}
+
+	_, err := js.CreateObjectStore(ctx, jetstream.ObjectStoreConfig{
+		Bucket:  constants.ObjectStoreNameProjectDocuments,
+		Storage: jetstream.FileStorage,
+	})
+	if err != nil && !errors.Is(err, jetstream.ErrBucketExists) {
+		return fmt.Errorf("creating object store %s: %w", constants.ObjectStoreNameProjectDocuments, err)
+	}
+
+	return nil
+}
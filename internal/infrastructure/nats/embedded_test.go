@@ -0,0 +1,58 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+func TestEmbeddedServerCreateBuckets(t *testing.T) {
+	ns, err := StartEmbeddedServer(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(ns.Shutdown)
+
+	nc, err := nats.Connect(ns.ClientURL())
+	require.NoError(t, err)
+	t.Cleanup(nc.Close)
+	js, err := jetstream.New(nc)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, CreateBuckets(ctx, js))
+
+	// The existing buckets are kept, with their values.
+	projects, err := js.KeyValue(ctx, constants.KVStoreNameProjects)
+	require.NoError(t, err)
+	_, err = projects.Put(ctx, "project-uid", []byte(`{}`))
+	require.NoError(t, err)
+	require.NoError(t, CreateBuckets(ctx, js))
+	entry, err := projects.Get(ctx, "project-uid")
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`{}`), entry.Value())
+
+	for _, bucket := range buckets {
+		kv, err := js.KeyValue(ctx, bucket.name)
+		require.NoError(t, err, bucket.name)
+		status, err := kv.Status(ctx)
+		require.NoError(t, err, bucket.name)
+		assert.Equal(t, int64(bucket.history), status.History(), bucket.name)
+	}
+	deletions, err := js.KeyValue(ctx, constants.KVStoreNameProjectDeletions)
+	require.NoError(t, err)
+	status, err := deletions.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 720*time.Hour, status.TTL())
+
+	_, err = js.ObjectStore(ctx, constants.ObjectStoreNameProjectDocuments)
+	require.NoError(t, err)
+}
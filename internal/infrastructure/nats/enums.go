@@ -0,0 +1,57 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// projectEnumsKey is the key of the allowed values of the enumerated project
+// fields in the metadata bucket.
+const projectEnumsKey = "enums"
+
+// GetProjectEnums returns the stored values allowed for the enumerated
+// project fields.
+func (s *NatsRepository) GetProjectEnums(ctx context.Context) (*models.ProjectEnums, error) {
+	entry, err := s.Metadata.Get(ctx, projectEnumsKey)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, domain.ErrProjectEnumsNotFound
+		}
+		slog.ErrorContext(ctx, "error getting project enums from NATS KV store", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+
+	enums := &models.ProjectEnums{}
+	if err := json.Unmarshal(entry.Value(), enums); err != nil {
+		slog.ErrorContext(ctx, "error unmarshalling project enums from NATS KV store", constants.ErrKey, err)
+		return nil, domain.ErrUnmarshal
+	}
+	return enums, nil
+}
+
+// PutProjectEnums replaces the stored values allowed for the enumerated
+// project fields.
+func (s *NatsRepository) PutProjectEnums(ctx context.Context, enums *models.ProjectEnums) error {
+	data, err := json.Marshal(enums)
+	if err != nil {
+		slog.ErrorContext(ctx, "error marshalling project enums into JSON", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	if _, err := s.Metadata.Put(ctx, projectEnumsKey, data); err != nil {
+		slog.ErrorContext(ctx, "error putting project enums in NATS KV store", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNatsRepository_GetProjectEnums(t *testing.T) {
+	stored, _ := json.Marshal(&models.ProjectEnums{Stages: []string{"Active"}, Categories: []string{"Sandbox"}})
+
+	tests := []struct {
+		name        string
+		entry       jetstream.KeyValueEntry
+		getErr      error
+		expected    *models.ProjectEnums
+		expectedErr error
+	}{
+		{
+			name:     "stored enums",
+			entry:    NewMockKeyValueEntry(stored, 1),
+			expected: &models.ProjectEnums{Stages: []string{"Active"}, Categories: []string{"Sandbox"}},
+		},
+		{name: "none stored", getErr: jetstream.ErrKeyNotFound, expectedErr: domain.ErrProjectEnumsNotFound},
+		{name: "store error", getErr: errors.New("nats error"), expectedErr: domain.ErrInternal},
+		{name: "invalid document", entry: NewMockKeyValueEntry([]byte("{"), 1), expectedErr: domain.ErrUnmarshal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metadataKV := &MockKeyValue{}
+			if tt.entry != nil {
+				metadataKV.On("Get", mock.Anything, "enums").Return(tt.entry, nil)
+			} else {
+				metadataKV.On("Get", mock.Anything, "enums").Return(nil, tt.getErr)
+			}
+			repo := &NatsRepository{Metadata: metadataKV}
+
+			enums, err := repo.GetProjectEnums(context.Background())
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, enums)
+		})
+	}
+}
+
+func TestNatsRepository_PutProjectEnums(t *testing.T) {
+	metadataKV := &MockKeyValue{}
+	metadataKV.On("Put", mock.Anything, "enums", mock.MatchedBy(func(data []byte) bool {
+		var enums models.ProjectEnums
+		return json.Unmarshal(data, &enums) == nil && enums.Stages[0] == "Active"
+	})).Return(uint64(2), nil)
+	repo := &NatsRepository{Metadata: metadataKV}
+
+	require.NoError(t, repo.PutProjectEnums(context.Background(), &models.ProjectEnums{Stages: []string{"Active"}}))
+	metadataKV.AssertExpectations(t)
+}
@@ -0,0 +1,184 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// ErrInjectedFault is the error of the operations failed by a [FaultInjector].
+var ErrInjectedFault = errors.New("injected fault")
+
+// FaultConfig configures the faults a [FaultInjector] injects.
+type FaultConfig struct {
+	// FailurePercent is the percentage of operations, from 0 to 100, that fail
+	// with ErrInjectedFault instead of running.
+	FailurePercent float64
+	// DelayPercent is the percentage of operations that are delayed by Delay
+	// before they run or fail.
+	DelayPercent float64
+	Delay        time.Duration
+}
+
+// FaultInjector delays and fails a share of the KV operations and NATS
+// publishes it wraps, for testing the retries, the outbox and the dead letters
+// against an unreliable NATS. It is meant for test environments only.
+type FaultInjector struct {
+	config FaultConfig
+}
+
+// NewFaultInjector returns a FaultInjector that injects the faults of config
+// at random.
+func NewFaultInjector(config FaultConfig) *FaultInjector {
+	return &FaultInjector{config: config}
+}
+
+// chance reports, at random, whether an event of the given percentage happens.
+func chance(percent float64) bool {
+	return percent > 0 && rand.Float64()*100 < percent
+}
+
+// inject delays operation and fails it, or neither, as configured.
+func (f *FaultInjector) inject(ctx context.Context, operation string) error {
+	if f.config.Delay > 0 && chance(f.config.DelayPercent) {
+		slog.DebugContext(ctx, "injecting delay", "operation", operation, "delay", f.config.Delay)
+		timer := time.NewTimer(f.config.Delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	if chance(f.config.FailurePercent) {
+		slog.WarnContext(ctx, "injecting fault", "operation", operation)
+		return fmt.Errorf("%s: %w", operation, ErrInjectedFault)
+	}
+	return nil
+}
+
+// InjectFaults makes faults delay and fail the operations on every key-value
+// store of the repository.
+func (r *NatsRepository) InjectFaults(faults *FaultInjector) {
+	for _, kv := range []*INatsKeyValue{
+		&r.Projects, &r.ProjectSettings, &r.Links, &r.Folders, &r.Documents,
+		&r.Outbox, &r.DeadLetters, &r.Charters, &r.Associations,
+		&r.Webhooks, &r.WebhookDeliveries, &r.Announcements, &r.Templates,
+		&r.Metadata, &r.Deletions,
+	} {
+		if *kv != nil {
+			*kv = InjectKeyValueFaults(*kv, faults)
+		}
+	}
+}
+
+// faultyKeyValue is an [INatsKeyValue] whose operations are subject to faults.
+type faultyKeyValue struct {
+	kv     INatsKeyValue
+	faults *FaultInjector
+}
+
+// InjectKeyValueFaults returns an [INatsKeyValue] that delays and fails the
+// operations on kv as faults decides. A failed operation is not run.
+func InjectKeyValueFaults(kv INatsKeyValue, faults *FaultInjector) INatsKeyValue {
+	return &faultyKeyValue{kv: kv, faults: faults}
+}
+
+func (f *faultyKeyValue) ListKeys(ctx context.Context, opts ...jetstream.WatchOpt) (jetstream.KeyLister, error) {
+	if err := f.faults.inject(ctx, "kv.list_keys"); err != nil {
+		return nil, err
+	}
+	return f.kv.ListKeys(ctx, opts...)
+}
+
+func (f *faultyKeyValue) Get(ctx context.Context, key string) (jetstream.KeyValueEntry, error) {
+	if err := f.faults.inject(ctx, "kv.get"); err != nil {
+		return nil, err
+	}
+	return f.kv.Get(ctx, key)
+}
+
+func (f *faultyKeyValue) GetRevision(ctx context.Context, key string, revision uint64) (jetstream.KeyValueEntry, error) {
+	if err := f.faults.inject(ctx, "kv.get_revision"); err != nil {
+		return nil, err
+	}
+	return f.kv.GetRevision(ctx, key, revision)
+}
+
+func (f *faultyKeyValue) History(ctx context.Context, key string, opts ...jetstream.WatchOpt) ([]jetstream.KeyValueEntry, error) {
+	if err := f.faults.inject(ctx, "kv.history"); err != nil {
+		return nil, err
+	}
+	return f.kv.History(ctx, key, opts...)
+}
+
+func (f *faultyKeyValue) Create(ctx context.Context, key string, value []byte, opts ...jetstream.KVCreateOpt) (uint64, error) {
+	if err := f.faults.inject(ctx, "kv.create"); err != nil {
+		return 0, err
+	}
+	return f.kv.Create(ctx, key, value, opts...)
+}
+
+func (f *faultyKeyValue) Put(ctx context.Context, key string, value []byte) (uint64, error) {
+	if err := f.faults.inject(ctx, "kv.put"); err != nil {
+		return 0, err
+	}
+	return f.kv.Put(ctx, key, value)
+}
+
+func (f *faultyKeyValue) Update(ctx context.Context, key string, value []byte, revision uint64) (uint64, error) {
+	if err := f.faults.inject(ctx, "kv.update"); err != nil {
+		return 0, err
+	}
+	return f.kv.Update(ctx, key, value, revision)
+}
+
+func (f *faultyKeyValue) Delete(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error {
+	if err := f.faults.inject(ctx, "kv.delete"); err != nil {
+		return err
+	}
+	return f.kv.Delete(ctx, key, opts...)
+}
+
+func (f *faultyKeyValue) Purge(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error {
+	if err := f.faults.inject(ctx, "kv.purge"); err != nil {
+		return err
+	}
+	return f.kv.Purge(ctx, key, opts...)
+}
+
+// faultyConn is a connection whose publishes are subject to faults. Requests
+// are sent as they are.
+type faultyConn struct {
+	INatsConn
+	faults *FaultInjector
+}
+
+// InjectPublishFaults returns a connection that delays and fails the messages
+// published on conn as faults decides. A failed message is not published.
+func InjectPublishFaults(conn INatsConn, faults *FaultInjector) INatsConn {
+	return &faultyConn{INatsConn: conn, faults: faults}
+}
+
+func (c *faultyConn) Publish(subj string, data []byte) error {
+	if err := c.faults.inject(context.Background(), "publish "+subj); err != nil {
+		return err
+	}
+	return c.INatsConn.Publish(subj, data)
+}
+
+func (c *faultyConn) PublishMsg(msg *nats.Msg) error {
+	if err := c.faults.inject(context.Background(), "publish "+msg.Subject); err != nil {
+		return err
+	}
+	return c.INatsConn.PublishMsg(msg)
+}
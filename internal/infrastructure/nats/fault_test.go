@@ -0,0 +1,100 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestInjectKeyValueFaults(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      FaultConfig
+		cancel      bool
+		expectedErr error
+	}{
+		{
+			name:   "no faults",
+			config: FaultConfig{},
+		},
+		{
+			name:        "every operation fails",
+			config:      FaultConfig{FailurePercent: 100},
+			expectedErr: ErrInjectedFault,
+		},
+		{
+			name:   "delayed operation runs",
+			config: FaultConfig{DelayPercent: 100, Delay: time.Millisecond},
+		},
+		{
+			name:        "delay ends with the context",
+			config:      FaultConfig{DelayPercent: 100, Delay: time.Hour},
+			cancel:      true,
+			expectedErr: context.Canceled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockKV := &MockKeyValue{}
+			if tt.expectedErr == nil {
+				mockKV.On("Put", mock.Anything, "key", []byte("value")).Return(uint64(7), nil)
+			}
+			kv := InjectKeyValueFaults(mockKV, NewFaultInjector(tt.config))
+
+			ctx, cancel := context.WithCancel(context.Background())
+			if tt.cancel {
+				cancel()
+			}
+			defer cancel()
+			revision, err := kv.Put(ctx, "key", []byte("value"))
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, uint64(7), revision)
+			}
+			mockKV.AssertExpectations(t)
+		})
+	}
+}
+
+func TestInjectPublishFaults(t *testing.T) {
+	msg := nats.NewMsg("lfx.index.project")
+
+	failing := &MockNATSConn{}
+	conn := InjectPublishFaults(failing, NewFaultInjector(FaultConfig{FailurePercent: 100}))
+	assert.ErrorIs(t, conn.PublishMsg(msg), ErrInjectedFault)
+	assert.ErrorIs(t, conn.Publish(msg.Subject, nil), ErrInjectedFault)
+	failing.AssertExpectations(t)
+
+	// Requests are not subject to faults.
+	failing.On("Request", "lfx.projects-api.get_name", []byte("uid"), time.Second).Return(&nats.Msg{}, nil)
+	_, err := conn.Request("lfx.projects-api.get_name", []byte("uid"), time.Second)
+	assert.NoError(t, err)
+
+	working := &MockNATSConn{}
+	working.On("PublishMsg", msg).Return(nil)
+	conn = InjectPublishFaults(working, NewFaultInjector(FaultConfig{}))
+	assert.NoError(t, conn.PublishMsg(msg))
+	working.AssertExpectations(t)
+}
+
+func TestNatsRepository_InjectFaults(t *testing.T) {
+	repo := &NatsRepository{Projects: &MockKeyValue{}, Outbox: &MockKeyValue{}}
+
+	repo.InjectFaults(NewFaultInjector(FaultConfig{FailurePercent: 100}))
+
+	assert.IsType(t, &faultyKeyValue{}, repo.Projects)
+	assert.IsType(t, &faultyKeyValue{}, repo.Outbox)
+	assert.Nil(t, repo.ProjectSettings)
+	assert.Nil(t, repo.Deletions)
+}
@@ -0,0 +1,44 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// KVHealthCheck returns a health check that performs a round-trip Get on a sentinel
+// key in the given bucket. A "key not found" reply proves the bucket is reachable.
+// Buckets that implement [domain.HealthChecker], such as [CachedKeyValue], check
+// themselves.
+func KVHealthCheck(kv INatsKeyValue) domain.HealthCheckFunc {
+	if checker, ok := kv.(domain.HealthChecker); ok {
+		return checker.HealthCheck
+	}
+	return func(ctx context.Context) error {
+		if kv == nil {
+			return errors.New("bucket not initialized")
+		}
+		_, err := kv.Get(ctx, constants.KVHealthSentinelKey)
+		if err != nil && !errors.Is(err, jetstream.ErrKeyNotFound) {
+			return err
+		}
+		return nil
+	}
+}
+
+// HealthCheck reports whether the message builder has a connected NATS connection to publish on.
+func (m *MessageBuilder) HealthCheck(_ context.Context) error {
+	if m.NatsConn == nil {
+		return errors.New("NATS connection not initialized")
+	}
+	if !m.NatsConn.IsConnected() {
+		return errors.New("NATS connection not connected")
+	}
+	return nil
+}
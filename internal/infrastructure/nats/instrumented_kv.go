@@ -0,0 +1,146 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/metrics"
+)
+
+// instrumentedKeyValue wraps an [INatsKeyValue] so that each operation gets an
+// OTel client span and is recorded in the Prometheus KV operation histogram.
+type instrumentedKeyValue struct {
+	bucket string
+	kv     INatsKeyValue
+}
+
+// InstrumentKeyValue returns an [INatsKeyValue] that traces and times each
+// operation on the given bucket before delegating to kv.
+func InstrumentKeyValue(bucket string, kv INatsKeyValue) INatsKeyValue {
+	return &instrumentedKeyValue{bucket: bucket, kv: kv}
+}
+
+// kvOperation tracks a single in-flight KV operation.
+type kvOperation struct {
+	bucket    string
+	operation string
+	span      trace.Span
+	start     time.Time
+}
+
+func (i *instrumentedKeyValue) begin(ctx context.Context, operation, key string) (context.Context, *kvOperation) {
+	attrs := []attribute.KeyValue{
+		attribute.String("db.system.name", "nats"),
+		attribute.String("db.namespace", i.bucket),
+		attribute.String("db.operation.name", operation),
+	}
+	if key != "" {
+		attrs = append(attrs, attribute.String("nats.kv.key", key))
+	}
+	ctx, span := tracer.Start(ctx, "nats.kv."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	return ctx, &kvOperation{bucket: i.bucket, operation: operation, span: span, start: time.Now()}
+}
+
+// end records the outcome and revision of the operation and ends its span.
+// A missing key is an expected lookup result and is not marked as a span error.
+func (o *kvOperation) end(revision uint64, err error) {
+	outcome := metrics.Outcome(err)
+	switch {
+	case errors.Is(err, jetstream.ErrKeyNotFound):
+		outcome = metrics.OutcomeNotFound
+	case err != nil:
+		o.span.RecordError(err)
+		o.span.SetStatus(codes.Error, err.Error())
+	}
+	if revision > 0 {
+		o.span.SetAttributes(attribute.String("nats.kv.revision", strconv.FormatUint(revision, 10)))
+	}
+	o.span.SetAttributes(attribute.String("nats.kv.outcome", outcome))
+	o.span.End()
+	metrics.ObserveKVOperation(o.bucket, o.operation, outcome, o.start)
+}
+
+func (i *instrumentedKeyValue) ListKeys(ctx context.Context, opts ...jetstream.WatchOpt) (jetstream.KeyLister, error) {
+	ctx, op := i.begin(ctx, "list_keys", "")
+	lister, err := i.kv.ListKeys(ctx, opts...)
+	op.end(0, err)
+	return lister, err
+}
+
+func (i *instrumentedKeyValue) Get(ctx context.Context, key string) (jetstream.KeyValueEntry, error) {
+	ctx, op := i.begin(ctx, "get", key)
+	entry, err := i.kv.Get(ctx, key)
+	var revision uint64
+	if err == nil && entry != nil {
+		revision = entry.Revision()
+	}
+	op.end(revision, err)
+	return entry, err
+}
+
+func (i *instrumentedKeyValue) GetRevision(ctx context.Context, key string, revision uint64) (jetstream.KeyValueEntry, error) {
+	ctx, op := i.begin(ctx, "get_revision", key)
+	entry, err := i.kv.GetRevision(ctx, key, revision)
+	op.end(revision, err)
+	return entry, err
+}
+
+func (i *instrumentedKeyValue) History(ctx context.Context, key string, opts ...jetstream.WatchOpt) ([]jetstream.KeyValueEntry, error) {
+	ctx, op := i.begin(ctx, "history", key)
+	entries, err := i.kv.History(ctx, key, opts...)
+	var revision uint64
+	if len(entries) > 0 {
+		revision = entries[len(entries)-1].Revision()
+	}
+	op.end(revision, err)
+	return entries, err
+}
+
+func (i *instrumentedKeyValue) Create(ctx context.Context, key string, value []byte, opts ...jetstream.KVCreateOpt) (uint64, error) {
+	ctx, op := i.begin(ctx, "create", key)
+	rev, err := i.kv.Create(ctx, key, value, opts...)
+	op.end(rev, err)
+	return rev, err
+}
+
+func (i *instrumentedKeyValue) Put(ctx context.Context, key string, value []byte) (uint64, error) {
+	ctx, op := i.begin(ctx, "put", key)
+	rev, err := i.kv.Put(ctx, key, value)
+	op.end(rev, err)
+	return rev, err
+}
+
+func (i *instrumentedKeyValue) Update(ctx context.Context, key string, value []byte, revision uint64) (uint64, error) {
+	ctx, op := i.begin(ctx, "update", key)
+	op.span.SetAttributes(attribute.String("nats.kv.expected_revision", strconv.FormatUint(revision, 10)))
+	rev, err := i.kv.Update(ctx, key, value, revision)
+	op.end(rev, err)
+	return rev, err
+}
+
+func (i *instrumentedKeyValue) Delete(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error {
+	ctx, op := i.begin(ctx, "delete", key)
+	err := i.kv.Delete(ctx, key, opts...)
+	op.end(0, err)
+	return err
+}
+
+func (i *instrumentedKeyValue) Purge(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error {
+	ctx, op := i.begin(ctx, "purge", key)
+	err := i.kv.Purge(ctx, key, opts...)
+	op.end(0, err)
+	return err
+}
@@ -0,0 +1,110 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestInstrumentKeyValue(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	tests := []struct {
+		name           string
+		setupMock      func(kv *MockKeyValue)
+		call           func(kv INatsKeyValue) error
+		expectedSpan   string
+		expectedStatus codes.Code
+		expectedAttrs  map[attribute.Key]string
+	}{
+		{
+			name: "get records key and revision",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("Get", mock.Anything, "project-1").Return(NewMockKeyValueEntry([]byte(`{}`), 42), nil)
+			},
+			call: func(kv INatsKeyValue) error {
+				_, err := kv.Get(context.Background(), "project-1")
+				return err
+			},
+			expectedSpan:   "nats.kv.get",
+			expectedStatus: codes.Unset,
+			expectedAttrs: map[attribute.Key]string{
+				"db.namespace":     "projects",
+				"nats.kv.key":      "project-1",
+				"nats.kv.revision": "42",
+				"nats.kv.outcome":  "ok",
+			},
+		},
+		{
+			name: "get of missing key is not a span error",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("Get", mock.Anything, "missing").Return(nil, jetstream.ErrKeyNotFound)
+			},
+			call: func(kv INatsKeyValue) error {
+				_, err := kv.Get(context.Background(), "missing")
+				return err
+			},
+			expectedSpan:   "nats.kv.get",
+			expectedStatus: codes.Unset,
+			expectedAttrs: map[attribute.Key]string{
+				"nats.kv.key":     "missing",
+				"nats.kv.outcome": "not_found",
+			},
+		},
+		{
+			name: "failed update is a span error",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("Update", mock.Anything, "project-1", []byte(`{}`), uint64(7)).Return(uint64(0), errors.New("wrong last sequence"))
+			},
+			call: func(kv INatsKeyValue) error {
+				_, err := kv.Update(context.Background(), "project-1", []byte(`{}`), 7)
+				return err
+			},
+			expectedSpan:   "nats.kv.update",
+			expectedStatus: codes.Error,
+			expectedAttrs: map[attribute.Key]string{
+				"nats.kv.expected_revision": "7",
+				"nats.kv.outcome":           "error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockKV := &MockKeyValue{}
+			tt.setupMock(mockKV)
+			before := len(recorder.Ended())
+
+			_ = tt.call(InstrumentKeyValue("projects", mockKV))
+
+			mockKV.AssertExpectations(t)
+			spans := recorder.Ended()
+			require.Len(t, spans, before+1)
+			span := spans[len(spans)-1]
+			assert.Equal(t, tt.expectedSpan, span.Name())
+			assert.Equal(t, tt.expectedStatus, span.Status().Code)
+
+			attrs := make(map[attribute.Key]string)
+			for _, kv := range span.Attributes() {
+				attrs[kv.Key] = kv.Value.Emit()
+			}
+			for key, expected := range tt.expectedAttrs {
+				assert.Equal(t, expected, attrs[key], key)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// InspectProjectKV returns the entries of the project's base and settings
+// keys retained in the project buckets, as they are stored, for operators
+// debugging stale documents. Unlike the other reads, values are not decoded,
+// so entries that cannot be decoded are shown too.
+func (s *NatsRepository) InspectProjectKV(ctx context.Context, projectUID string) (*models.ProjectKVInspection, error) {
+	base, err := inspectKey(ctx, s.Projects, constants.KVStoreNameProjects, projectUID)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := inspectKey(ctx, s.ProjectSettings, constants.KVStoreNameProjectSettings, projectUID)
+	if err != nil {
+		return nil, err
+	}
+	if base.Current == nil && settings.Current == nil {
+		return nil, domain.ErrProjectNotFound
+	}
+	return &models.ProjectKVInspection{ProjectUID: projectUID, Base: base, Settings: settings}, nil
+}
+
+// inspectKey returns the retained entries of key in kv, the bucket named bucket.
+func inspectKey(ctx context.Context, kv INatsKeyValue, bucket, key string) (models.KVKeyInspection, error) {
+	inspection := models.KVKeyInspection{Bucket: bucket, Key: key, History: []models.KVEntry{}}
+	entries, err := keyHistory(ctx, kv, key)
+	if err != nil {
+		slog.ErrorContext(ctx, "error getting key history from NATS KV store", constants.ErrKey, err, "bucket", bucket, "key", key)
+		return inspection, domain.ErrInternal
+	}
+
+	for _, entry := range entries {
+		inspection.History = append(inspection.History, models.KVEntry{
+			Revision:  entry.Revision(),
+			Created:   entry.Created(),
+			Operation: kvEntryOperation(entry.Operation()),
+			Value:     entry.Value(),
+		})
+	}
+	if len(inspection.History) > 0 {
+		current := inspection.History[len(inspection.History)-1]
+		inspection.Current = &current
+	}
+	return inspection, nil
+}
+
+// kvEntryOperation names a KV entry operation.
+func kvEntryOperation(op jetstream.KeyValueOp) string {
+	switch op {
+	case jetstream.KeyValueDelete:
+		return models.KVOperationDelete
+	case jetstream.KeyValuePurge:
+		return models.KVOperationPurge
+	default:
+		return models.KVOperationPut
+	}
+}
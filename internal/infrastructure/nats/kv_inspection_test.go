@@ -0,0 +1,88 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+func TestNatsRepository_InspectProjectKV(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("entries of both buckets as stored", func(t *testing.T) {
+		projects, settings := &MockKeyValue{}, &MockKeyValue{}
+		projects.On("History", mock.Anything, "project-1").Return([]jetstream.KeyValueEntry{
+			historyEntry([]byte(`{"uid":"project-1","name":"First"}`), 10, created, jetstream.KeyValuePut),
+			historyEntry([]byte(`not json`), 11, created.Add(time.Minute), jetstream.KeyValuePut),
+		}, nil)
+		settings.On("History", mock.Anything, "project-1").Return([]jetstream.KeyValueEntry{
+			historyEntry([]byte(`{"uid":"project-1"}`), 3, created, jetstream.KeyValuePut),
+			historyEntry(nil, 4, created.Add(time.Hour), jetstream.KeyValueDelete),
+		}, nil)
+		repo := &NatsRepository{Projects: projects, ProjectSettings: settings}
+
+		inspection, err := repo.InspectProjectKV(context.Background(), "project-1")
+		require.NoError(t, err)
+
+		assert.Equal(t, "project-1", inspection.ProjectUID)
+		assert.Equal(t, "projects", inspection.Base.Bucket)
+		assert.Equal(t, "project-1", inspection.Base.Key)
+		require.Len(t, inspection.Base.History, 2)
+		assert.Equal(t, &models.KVEntry{
+			Revision:  11,
+			Created:   created.Add(time.Minute),
+			Operation: models.KVOperationPut,
+			Value:     []byte(`not json`),
+		}, inspection.Base.Current)
+
+		assert.Equal(t, "project-settings", inspection.Settings.Bucket)
+		require.Len(t, inspection.Settings.History, 2)
+		assert.Equal(t, models.KVOperationDelete, inspection.Settings.Current.Operation)
+		assert.Equal(t, uint64(4), inspection.Settings.Current.Revision)
+	})
+
+	t.Run("settings without history", func(t *testing.T) {
+		projects, settings := &MockKeyValue{}, &MockKeyValue{}
+		projects.On("History", mock.Anything, "project-1").Return([]jetstream.KeyValueEntry{
+			historyEntry([]byte(`{"uid":"project-1"}`), 10, created, jetstream.KeyValuePut),
+		}, nil)
+		settings.On("History", mock.Anything, "project-1").Return(nil, jetstream.ErrKeyNotFound)
+		repo := &NatsRepository{Projects: projects, ProjectSettings: settings}
+
+		inspection, err := repo.InspectProjectKV(context.Background(), "project-1")
+		require.NoError(t, err)
+		assert.Nil(t, inspection.Settings.Current)
+		assert.Empty(t, inspection.Settings.History)
+	})
+
+	t.Run("project without history", func(t *testing.T) {
+		projects, settings := &MockKeyValue{}, &MockKeyValue{}
+		projects.On("History", mock.Anything, "project-1").Return(nil, jetstream.ErrKeyNotFound)
+		settings.On("History", mock.Anything, "project-1").Return(nil, jetstream.ErrKeyNotFound)
+		repo := &NatsRepository{Projects: projects, ProjectSettings: settings}
+
+		_, err := repo.InspectProjectKV(context.Background(), "project-1")
+		assert.ErrorIs(t, err, domain.ErrProjectNotFound)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		projects := &MockKeyValue{}
+		projects.On("History", mock.Anything, "project-1").Return(nil, errors.New("nats error"))
+		repo := &NatsRepository{Projects: projects, ProjectSettings: &MockKeyValue{}}
+
+		_, err := repo.InspectProjectKV(context.Background(), "project-1")
+		assert.ErrorIs(t, err, domain.ErrInternal)
+	})
+}
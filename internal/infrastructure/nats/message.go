@@ -18,6 +18,7 @@ import (
 	inviteapi "github.com/linuxfoundation/lfx-v2-invite-service/pkg/api"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
 	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/redact"
 	"github.com/nats-io/nats.go"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -30,12 +31,29 @@ const defaultRequestTimeout = time.Second * 10
 // MessageBuilder is the builder for the message and sends it to the NATS server.
 type MessageBuilder struct {
 	NatsConn INatsConn
+	// Retry configures retries of indexer and access messages that fail to send.
+	Retry RetryPolicy
+	// Breaker, when set, stops sending indexer and access messages while NATS
+	// keeps failing.
+	Breaker *CircuitBreaker
+	// IndexerRedaction redacts the emails and names of users from the data and
+	// tags of indexer messages, which are then not searchable by them.
+	IndexerRedaction redact.Mode
 }
 
-// sendMessage sends the message to the NATS server.
+// PublishDegraded reports whether the circuit breaker is open, in which case
+// indexer and access messages are rejected until NATS recovers.
+func (m *MessageBuilder) PublishDegraded() bool {
+	return m.Breaker.Open()
+}
+
+// sendMessage sends the message to the NATS server, retrying transient failures.
 func (m *MessageBuilder) sendMessage(ctx context.Context, subject string, data []byte, sync bool) error {
 	if sync {
-		_, err := m.requestMessage(ctx, subject, data, defaultRequestTimeout)
+		err := m.sendWithRetry(ctx, subject, func() error {
+			_, err := m.requestMessage(ctx, subject, data, defaultRequestTimeout)
+			return err
+		})
 		if err != nil {
 			slog.ErrorContext(ctx, "error requesting message from NATS", constants.ErrKey, err, "subject", subject)
 			return err
@@ -45,7 +63,9 @@ func (m *MessageBuilder) sendMessage(ctx context.Context, subject string, data [
 	}
 
 	// Send message asynchronously.
-	err := m.publishMessage(ctx, subject, data)
+	err := m.sendWithRetry(ctx, subject, func() error {
+		return m.publishMessage(ctx, subject, data)
+	})
 	if err != nil {
 		slog.ErrorContext(ctx, "error sending message to NATS", constants.ErrKey, err, "subject", subject)
 		return err
@@ -156,6 +176,14 @@ func (m *MessageBuilder) sendIndexerMessage(
 			slog.ErrorContext(ctx, "error decoding data", constants.ErrKey, err, "subject", subject)
 			return err
 		}
+		payload = m.IndexerRedaction.Value(payload)
+		if m.IndexerRedaction.Enabled() {
+			redacted := make([]string, 0, len(tags))
+			for _, tag := range tags {
+				redacted = append(redacted, m.IndexerRedaction.Text(tag))
+			}
+			tags = redacted
+		}
 	case indexerConstants.ActionDeleted:
 		// The data should just be a string of the UID being deleted.
 		payload = string(data)
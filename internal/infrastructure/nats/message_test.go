@@ -20,6 +20,7 @@ import (
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
 	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
 	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/redact"
 	"github.com/nats-io/nats.go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -147,6 +148,51 @@ func TestMessageBuilder_PublishIndexerMessage(t *testing.T) {
 	}
 }
 
+func TestMessageBuilder_PublishIndexerMessage_Redaction(t *testing.T) {
+	settings := models.ProjectSettings{
+		UID:     "test-project",
+		Writers: []models.UserInfo{{Name: "Jane Doe", Email: "jane@example.com", Username: "jane"}},
+	}
+
+	for _, tt := range []struct {
+		mode           redact.Mode
+		expectedWriter map[string]any
+		expectedTag    string
+	}{
+		{
+			mode:           redact.Off,
+			expectedWriter: map[string]any{"name": "Jane Doe", "email": "jane@example.com", "username": "jane", "avatar": ""},
+			expectedTag:    "owner:jane@example.com",
+		},
+		{
+			mode:           redact.Mask,
+			expectedWriter: map[string]any{"name": "J***", "email": "j***@example.com", "username": "jane", "avatar": ""},
+			expectedTag:    "owner:j***@example.com",
+		},
+	} {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			data := publishedData(t, func(mb *MessageBuilder) error {
+				mb.IndexerRedaction = tt.mode
+				return mb.SendIndexerMessage(context.Background(), constants.IndexProjectSettingsSubject, indexerTypes.IndexerMessageEnvelope{
+					Action: indexerConstants.ActionUpdated,
+					Data:   settings,
+					Tags:   []string{"owner:jane@example.com"},
+				}, false)
+			})
+
+			var message struct {
+				Data struct {
+					Writers []map[string]any `json:"writers"`
+				} `json:"data"`
+				Tags []string `json:"tags"`
+			}
+			assert.NoError(t, json.Unmarshal(data, &message))
+			assert.Equal(t, []map[string]any{tt.expectedWriter}, message.Data.Writers)
+			assert.Equal(t, []string{tt.expectedTag}, message.Tags)
+		})
+	}
+}
+
 func TestMessageBuilder_PublishIndexerMessage_Sync(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,129 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/micro"
+	"golang.org/x/mod/semver"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+)
+
+// MicroMsg adapts a request received by a NATS micro service endpoint to
+// [domain.Message]. Replies are published on conn rather than through the
+// request, as they are sent from the worker pool after the endpoint handler
+// returned, and carry the trace context of ctx.
+type MicroMsg struct {
+	conn INatsConn
+	req  micro.Request
+	ctx  context.Context
+}
+
+// NewMicroMsg wraps req so that it can be handled as a [domain.Message].
+func NewMicroMsg(ctx context.Context, conn INatsConn, req micro.Request) *MicroMsg {
+	return &MicroMsg{conn: conn, req: req, ctx: ctx}
+}
+
+// Respond implements [domain.Message.Respond].
+func (m *MicroMsg) Respond(data []byte) error {
+	if m.req.Reply() == "" {
+		return natsgo.ErrMsgNoReply
+	}
+	reply := newReplyMsg(m.ctx, data)
+	reply.Subject = m.req.Reply()
+	return m.conn.PublishMsg(reply)
+}
+
+// Data implements [domain.Message.Data].
+func (m *MicroMsg) Data() []byte {
+	return m.req.Data()
+}
+
+// Subject implements [domain.Message.Subject].
+func (m *MicroMsg) Subject() string {
+	return m.req.Subject()
+}
+
+var _ domain.Message = (*MicroMsg)(nil)
+
+// EndpointName returns the name of the micro service endpoint handling
+// subject. Endpoint names cannot hold dots, so they are replaced with
+// underscores.
+func EndpointName(subject string) string {
+	return strings.ReplaceAll(subject, ".", "_")
+}
+
+// ServiceVersion returns version as the SemVer version a micro service must
+// have, such as 1.2.0 for v1.2. Builds that are not from a release tag, such
+// as dev builds, are 0.0.0.
+func ServiceVersion(version string) string {
+	canonical := semver.Canonical("v" + strings.TrimPrefix(version, "v"))
+	if canonical == "" {
+		return "0.0.0"
+	}
+	return strings.TrimPrefix(canonical, "v")
+}
+
+// HandlerStats counts, for each endpoint of a micro service, the messages
+// its handlers processed and the errors they returned. The micro service
+// counts the requests of an endpoint itself, but not the errors and the
+// processing time of handlers that finish on the worker pool after the
+// endpoint handler returned; HandlerStats reports them as the endpoint's
+// stats data instead.
+type HandlerStats struct {
+	mu        sync.Mutex
+	endpoints map[string]*EndpointHandlerStats
+}
+
+// EndpointHandlerStats are the stats data of an endpoint, as shown by
+// `nats micro stats`.
+type EndpointHandlerStats struct {
+	NumHandled            int           `json:"num_handled"`
+	NumErrors             int           `json:"num_errors"`
+	LastError             string        `json:"last_error,omitempty"`
+	ProcessingTime        time.Duration `json:"processing_time"`
+	AverageProcessingTime time.Duration `json:"average_processing_time"`
+}
+
+// NewHandlerStats returns stats with no handled message.
+func NewHandlerStats() *HandlerStats {
+	return &HandlerStats{endpoints: make(map[string]*EndpointHandlerStats)}
+}
+
+// Observe records a message handled by the handler of endpoint, which
+// started at start and returned err.
+func (s *HandlerStats) Observe(endpoint string, start time.Time, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats, ok := s.endpoints[endpoint]
+	if !ok {
+		stats = &EndpointHandlerStats{}
+		s.endpoints[endpoint] = stats
+	}
+	stats.NumHandled++
+	stats.ProcessingTime += time.Since(start)
+	stats.AverageProcessingTime = stats.ProcessingTime / time.Duration(stats.NumHandled)
+	if err != nil {
+		stats.NumErrors++
+		stats.LastError = err.Error()
+	}
+}
+
+// Stats returns the stats data of endpoint. It is a [micro.StatsHandler].
+func (s *HandlerStats) Stats(endpoint *micro.Endpoint) any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if stats, ok := s.endpoints[endpoint.Name]; ok {
+		return *stats
+	}
+	return EndpointHandlerStats{}
+}
@@ -0,0 +1,107 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/micro"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// testRequest is a micro.Request received on subject with reply.
+type testRequest struct {
+	micro.Request
+	subject string
+	reply   string
+	data    []byte
+}
+
+func (r *testRequest) Subject() string        { return r.subject }
+func (r *testRequest) Reply() string          { return r.reply }
+func (r *testRequest) Data() []byte           { return r.data }
+func (r *testRequest) Headers() micro.Headers { return nil }
+
+func TestMicroMsg(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	t.Run("replies on the reply subject with the trace context", func(t *testing.T) {
+		traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+		require.NoError(t, err)
+		spanID, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+		require.NoError(t, err)
+		ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		}))
+		conn := &MockNATSConn{}
+		conn.On("PublishMsg", mock.MatchedBy(func(msg *natsgo.Msg) bool {
+			return msg.Subject == "_INBOX.reply" && string(msg.Data) == "name" &&
+				msg.Header.Get("traceparent") == "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+		})).Return(nil)
+
+		msg := NewMicroMsg(ctx, conn, &testRequest{subject: "lfx.projects-api.get_name", reply: "_INBOX.reply", data: []byte("uid")})
+
+		require.NoError(t, msg.Respond([]byte("name")))
+		assert.Equal(t, "lfx.projects-api.get_name", msg.Subject())
+		assert.Equal(t, []byte("uid"), msg.Data())
+		conn.AssertExpectations(t)
+	})
+
+	t.Run("cannot reply to a request without a reply subject", func(t *testing.T) {
+		conn := &MockNATSConn{}
+
+		msg := NewMicroMsg(context.Background(), conn, &testRequest{subject: "lfx.projects-api.events.project.created"})
+
+		assert.ErrorIs(t, msg.Respond([]byte("ok")), natsgo.ErrMsgNoReply)
+		conn.AssertNotCalled(t, "PublishMsg", mock.Anything)
+	})
+}
+
+func TestEndpointName(t *testing.T) {
+	assert.Equal(t, "lfx_projects-api_get_name", EndpointName("lfx.projects-api.get_name"))
+}
+
+func TestServiceVersion(t *testing.T) {
+	tests := map[string]string{
+		"v1.2.3":                  "1.2.3",
+		"1.2.3":                   "1.2.3",
+		"v1.2":                    "1.2.0",
+		"v1.2.3-rc.1":             "1.2.3-rc.1",
+		"v1.2.3-4-gabcdef0":       "1.2.3-4-gabcdef0",
+		"dev":                     "0.0.0",
+		"abcdef0":                 "0.0.0",
+		"v1.2.3-4-gabcdef0-dirty": "1.2.3-4-gabcdef0-dirty",
+	}
+	for version, expected := range tests {
+		assert.Equal(t, expected, ServiceVersion(version), version)
+	}
+}
+
+func TestHandlerStats(t *testing.T) {
+	stats := NewHandlerStats()
+	start := time.Now().Add(-time.Second)
+
+	stats.Observe("lfx_projects-api_get_name", start, nil)
+	stats.Observe("lfx_projects-api_get_name", start, errors.New("project not found"))
+
+	endpointStats, ok := stats.Stats(&micro.Endpoint{Name: "lfx_projects-api_get_name"}).(EndpointHandlerStats)
+	require.True(t, ok)
+	assert.Equal(t, 2, endpointStats.NumHandled)
+	assert.Equal(t, 1, endpointStats.NumErrors)
+	assert.Equal(t, "project not found", endpointStats.LastError)
+	assert.GreaterOrEqual(t, endpointStats.AverageProcessingTime, time.Second)
+
+	assert.Equal(t, EndpointHandlerStats{}, stats.Stats(&micro.Endpoint{Name: "lfx_projects-api_get_slug"}))
+}
@@ -75,11 +75,21 @@ type INatsMsg interface {
 // NatsMsg is a wrapper around [nats.Msg] that implements [INatsMsg].
 type NatsMsg struct {
 	*nats.Msg
+	ctx context.Context
+}
+
+// NewNatsMsg wraps msg so that replies sent with [NatsMsg.Respond] carry the
+// trace context of ctx, connecting the requester's trace with the reply.
+func NewNatsMsg(ctx context.Context, msg *nats.Msg) *NatsMsg {
+	return &NatsMsg{Msg: msg, ctx: ctx}
 }
 
 // Respond implements [INatsMsg.Respond].
 func (m *NatsMsg) Respond(data []byte) error {
-	return m.Msg.Respond(data)
+	if m.ctx == nil {
+		return m.Msg.Respond(data)
+	}
+	return m.Msg.RespondMsg(newReplyMsg(m.ctx, data))
 }
 
 // Data implements [INatsMsg.Data].
@@ -118,6 +128,8 @@ func (m *MockNatsMsg) Subject() string {
 type INatsKeyValue interface {
 	ListKeys(context.Context, ...jetstream.WatchOpt) (jetstream.KeyLister, error)
 	Get(ctx context.Context, key string) (jetstream.KeyValueEntry, error)
+	GetRevision(ctx context.Context, key string, revision uint64) (jetstream.KeyValueEntry, error)
+	History(ctx context.Context, key string, opts ...jetstream.WatchOpt) ([]jetstream.KeyValueEntry, error)
 	Create(ctx context.Context, key string, value []byte, opts ...jetstream.KVCreateOpt) (uint64, error)
 	Put(context.Context, string, []byte) (uint64, error)
 	Update(context.Context, string, []byte, uint64) (uint64, error)
@@ -168,6 +180,24 @@ func (m *MockKeyValue) Get(ctx context.Context, key string) (jetstream.KeyValueE
 	return args.Get(0).(jetstream.KeyValueEntry), args.Error(1)
 }
 
+// GetRevision is a mock method for the [INatsKeyValue] interface.
+func (m *MockKeyValue) GetRevision(ctx context.Context, key string, revision uint64) (jetstream.KeyValueEntry, error) {
+	args := m.Called(ctx, key, revision)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(jetstream.KeyValueEntry), args.Error(1)
+}
+
+// History is a mock method for the [INatsKeyValue] interface.
+func (m *MockKeyValue) History(ctx context.Context, key string, _ ...jetstream.WatchOpt) ([]jetstream.KeyValueEntry, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]jetstream.KeyValueEntry), args.Error(1)
+}
+
 // Update is a mock method for the [INatsKeyValue] interface.
 func (m *MockKeyValue) Update(ctx context.Context, key string, value []byte, revision uint64) (uint64, error) {
 	args := m.Called(ctx, key, value, revision)
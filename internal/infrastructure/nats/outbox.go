@@ -0,0 +1,121 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// EnqueueOutboxMessages stores each message in the outbox bucket keyed by its ID.
+// Messages already stored are left in place if a later one fails, so that the
+// dispatcher still publishes them.
+func (s *NatsRepository) EnqueueOutboxMessages(ctx context.Context, messages []*models.OutboxMessage) error {
+	for _, message := range messages {
+		data, err := json.Marshal(message)
+		if err != nil {
+			slog.ErrorContext(ctx, "error marshalling outbox message into JSON", constants.ErrKey, err)
+			return domain.ErrInternal
+		}
+
+		revision, err := s.Outbox.Create(ctx, message.ID, data)
+		if err != nil {
+			slog.ErrorContext(ctx, "error putting outbox message into NATS KV store", constants.ErrKey, err, "outbox_message_id", message.ID)
+			return domain.ErrInternal
+		}
+		message.Revision = revision
+	}
+	return nil
+}
+
+// ListOutboxMessages returns every pending outbox message, oldest first.
+func (s *NatsRepository) ListOutboxMessages(ctx context.Context) ([]*models.OutboxMessage, error) {
+	keysLister, err := s.Outbox.ListKeys(ctx)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return []*models.OutboxMessage{}, nil
+		}
+		slog.ErrorContext(ctx, "error listing outbox keys from NATS KV store", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+
+	messages := []*models.OutboxMessage{}
+	for key := range keysLister.Keys() {
+		entry, err := s.Outbox.Get(ctx, key)
+		if err != nil {
+			if errors.Is(err, jetstream.ErrKeyNotFound) {
+				// Published and removed by another replica since the keys were listed.
+				continue
+			}
+			slog.ErrorContext(ctx, "error getting outbox message from NATS KV store", constants.ErrKey, err, "outbox_message_id", key)
+			return nil, domain.ErrInternal
+		}
+
+		message := &models.OutboxMessage{}
+		if err := json.Unmarshal(entry.Value(), message); err != nil {
+			slog.ErrorContext(ctx, "error unmarshalling outbox message from NATS KV store", constants.ErrKey, err, "outbox_message_id", key)
+			return nil, domain.ErrUnmarshal
+		}
+		message.Revision = entry.Revision()
+		messages = append(messages, message)
+	}
+
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].CreatedAt.Before(messages[j].CreatedAt)
+	})
+
+	return messages, nil
+}
+
+// UpdateOutboxMessage overwrites a pending outbox message at its revision, e.g.
+// to record a failed attempt. It returns domain.ErrRevisionMismatch when the
+// message was changed or removed since it was read.
+func (s *NatsRepository) UpdateOutboxMessage(ctx context.Context, message *models.OutboxMessage) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		slog.ErrorContext(ctx, "error marshalling outbox message into JSON", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	revision, err := s.Outbox.Update(ctx, message.ID, data, message.Revision)
+	if err != nil {
+		if isWrongLastSequence(err) {
+			return domain.ErrRevisionMismatch
+		}
+		slog.ErrorContext(ctx, "error updating outbox message in NATS KV store", constants.ErrKey, err, "outbox_message_id", message.ID)
+		return domain.ErrInternal
+	}
+	message.Revision = revision
+	return nil
+}
+
+// DeleteOutboxMessage removes a published message from the outbox at its
+// revision. It returns domain.ErrRevisionMismatch when the message was changed
+// or removed since it was read.
+func (s *NatsRepository) DeleteOutboxMessage(ctx context.Context, message *models.OutboxMessage) error {
+	if err := s.Outbox.Purge(ctx, message.ID, jetstream.LastRevision(message.Revision)); err != nil {
+		if isWrongLastSequence(err) {
+			return domain.ErrRevisionMismatch
+		}
+		slog.ErrorContext(ctx, "error purging outbox message from NATS KV store", constants.ErrKey, err, "outbox_message_id", message.ID)
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+// isWrongLastSequence reports whether err rejects a write made at a revision
+// that is no longer the key's last.
+func isWrongLastSequence(err error) bool {
+	return errors.Is(err, jetstream.ErrKeyExists) || strings.Contains(err.Error(), "wrong last sequence")
+}
@@ -0,0 +1,146 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNatsRepository_ListOutboxMessages(t *testing.T) {
+	now := time.Now()
+	older, _ := json.Marshal(&models.OutboxMessage{ID: "msg-1", Kind: models.OutboxKindIndexer, CreatedAt: now.Add(-time.Minute)})
+	newer, _ := json.Marshal(&models.OutboxMessage{ID: "msg-2", Kind: models.OutboxKindAccess, CreatedAt: now})
+
+	tests := []struct {
+		name        string
+		setupMock   func(kv *MockKeyValue)
+		expectedIDs []string
+		expectedErr error
+	}{
+		{
+			name: "returns messages oldest first",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("ListKeys", mock.Anything).Return(NewMockKeyLister([]string{"msg-2", "msg-1"}), nil)
+				kv.On("Get", mock.Anything, "msg-1").Return(NewMockKeyValueEntry(older, 1), nil)
+				kv.On("Get", mock.Anything, "msg-2").Return(NewMockKeyValueEntry(newer, 2), nil)
+			},
+			expectedIDs: []string{"msg-1", "msg-2"},
+		},
+		{
+			name: "skips messages removed since listing",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("ListKeys", mock.Anything).Return(NewMockKeyLister([]string{"msg-1", "msg-2"}), nil)
+				kv.On("Get", mock.Anything, "msg-1").Return(nil, jetstream.ErrKeyNotFound)
+				kv.On("Get", mock.Anything, "msg-2").Return(NewMockKeyValueEntry(newer, 2), nil)
+			},
+			expectedIDs: []string{"msg-2"},
+		},
+		{
+			name: "empty bucket",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("ListKeys", mock.Anything).Return(nil, jetstream.ErrNoKeysFound)
+			},
+			expectedIDs: []string{},
+		},
+		{
+			name: "list error",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("ListKeys", mock.Anything).Return(nil, errors.New("nats error"))
+			},
+			expectedErr: domain.ErrInternal,
+		},
+		{
+			name: "corrupt message",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("ListKeys", mock.Anything).Return(NewMockKeyLister([]string{"msg-1"}), nil)
+				kv.On("Get", mock.Anything, "msg-1").Return(NewMockKeyValueEntry([]byte("not json"), 1), nil)
+			},
+			expectedErr: domain.ErrUnmarshal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOutboxKV := &MockKeyValue{}
+			tt.setupMock(mockOutboxKV)
+			repo := &NatsRepository{Outbox: mockOutboxKV}
+
+			messages, err := repo.ListOutboxMessages(context.Background())
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, messages)
+			} else {
+				assert.NoError(t, err)
+				ids := []string{}
+				for _, message := range messages {
+					ids = append(ids, message.ID)
+				}
+				assert.Equal(t, tt.expectedIDs, ids)
+			}
+
+			mockOutboxKV.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNatsRepository_UpdateOutboxMessage(t *testing.T) {
+	t.Run("updates the message at its revision", func(t *testing.T) {
+		mockOutboxKV := &MockKeyValue{}
+		mockOutboxKV.On("Update", mock.Anything, "msg-1", mock.Anything, uint64(3)).Return(uint64(4), nil)
+		repo := &NatsRepository{Outbox: mockOutboxKV}
+		message := &models.OutboxMessage{ID: "msg-1", Attempts: 1, Revision: 3}
+
+		err := repo.UpdateOutboxMessage(context.Background(), message)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(4), message.Revision)
+		mockOutboxKV.AssertExpectations(t)
+	})
+
+	t.Run("does not bring back a message settled since", func(t *testing.T) {
+		mockOutboxKV := &MockKeyValue{}
+		mockOutboxKV.On("Update", mock.Anything, "msg-1", mock.Anything, uint64(3)).Return(uint64(0), jetstream.ErrKeyExists)
+		repo := &NatsRepository{Outbox: mockOutboxKV}
+
+		err := repo.UpdateOutboxMessage(context.Background(), &models.OutboxMessage{ID: "msg-1", Revision: 3})
+
+		assert.ErrorIs(t, err, domain.ErrRevisionMismatch)
+		mockOutboxKV.AssertExpectations(t)
+	})
+}
+
+func TestNatsRepository_DeleteOutboxMessage(t *testing.T) {
+	t.Run("purges the message at its revision", func(t *testing.T) {
+		mockOutboxKV := &MockKeyValue{}
+		mockOutboxKV.On("Purge", mock.Anything, "msg-1", mock.Anything).Return(nil)
+		repo := &NatsRepository{Outbox: mockOutboxKV}
+
+		err := repo.DeleteOutboxMessage(context.Background(), &models.OutboxMessage{ID: "msg-1", Revision: 3})
+
+		assert.NoError(t, err)
+		mockOutboxKV.AssertExpectations(t)
+	})
+
+	t.Run("message changed since it was read", func(t *testing.T) {
+		mockOutboxKV := &MockKeyValue{}
+		mockOutboxKV.On("Purge", mock.Anything, "msg-1", mock.Anything).Return(errors.New("nats: wrong last sequence: 5"))
+		repo := &NatsRepository{Outbox: mockOutboxKV}
+
+		err := repo.DeleteOutboxMessage(context.Background(), &models.OutboxMessage{ID: "msg-1", Revision: 3})
+
+		assert.ErrorIs(t, err, domain.ErrRevisionMismatch)
+		mockOutboxKV.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// GetProjectHistory returns the revisions of the project's base and settings
+// still retained in the history of the project buckets, oldest first. The
+// buckets number their revisions separately, so revisions are ordered by when
+// they were written, a base revision before a settings revision written at
+// the same time. A revision that cannot be decoded is logged and left out.
+func (s *NatsRepository) GetProjectHistory(ctx context.Context, projectUID string) ([]models.ProjectRevision, error) {
+	baseEntries, err := keyHistory(ctx, s.Projects, projectUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error getting project history from NATS KV store", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+	settingsEntries, err := keyHistory(ctx, s.ProjectSettings, projectUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error getting project settings history from NATS KV store", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+	if len(baseEntries) == 0 && len(settingsEntries) == 0 {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	revisions := make([]models.ProjectRevision, 0, len(baseEntries)+len(settingsEntries))
+	for _, entry := range baseEntries {
+		revision := models.ProjectRevision{Kind: models.ProjectRevisionBase, Revision: entry.Revision(), Created: entry.Created()}
+		if entry.Operation() != jetstream.KeyValuePut {
+			revision.Deleted = true
+		} else if revision.Project, _, err = decodeProjectBase(entry.Value()); err != nil {
+			slog.WarnContext(ctx, "project revision cannot be decoded, leaving it out of the history", constants.ErrKey, err, "revision", entry.Revision())
+			continue
+		}
+		revisions = append(revisions, revision)
+	}
+	for _, entry := range settingsEntries {
+		revision := models.ProjectRevision{Kind: models.ProjectRevisionSettings, Revision: entry.Revision(), Created: entry.Created()}
+		if entry.Operation() != jetstream.KeyValuePut {
+			revision.Deleted = true
+		} else if revision.Settings, _, err = decodeProjectSettings(entry.Value()); err != nil {
+			slog.WarnContext(ctx, "project settings revision cannot be decoded, leaving it out of the history", constants.ErrKey, err, "revision", entry.Revision())
+			continue
+		}
+		revisions = append(revisions, revision)
+	}
+
+	// The base revisions come first, so a stable sort keeps a base revision
+	// before a settings revision written at the same time.
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisions[i].Created.Before(revisions[j].Created)
+	})
+	return revisions, nil
+}
+
+// keyHistory returns the retained revisions of key in kv, oldest first, or none
+// when the key has no history.
+func keyHistory(ctx context.Context, kv INatsKeyValue, key string) ([]jetstream.KeyValueEntry, error) {
+	entries, err := kv.History(ctx, key)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return nil, nil
+	}
+	return entries, err
+}
@@ -0,0 +1,73 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// historyEntry returns a KV entry of the history of a project bucket.
+func historyEntry(value []byte, revision uint64, created time.Time, op jetstream.KeyValueOp) *MockKeyValueEntry {
+	entry := NewMockKeyValueEntry(value, revision)
+	entry.On("Created").Return(created)
+	entry.On("Operation").Return(op)
+	return entry
+}
+
+func TestNatsRepository_GetProjectHistory(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("revisions of both buckets in write order", func(t *testing.T) {
+		projects, settings := &MockKeyValue{}, &MockKeyValue{}
+		projects.On("History", mock.Anything, "project-1").Return([]jetstream.KeyValueEntry{
+			historyEntry([]byte(`{"uid":"project-1","name":"First"}`), 10, created, jetstream.KeyValuePut),
+			historyEntry([]byte(`not json`), 11, created.Add(time.Minute), jetstream.KeyValuePut),
+			historyEntry(nil, 12, created.Add(time.Hour), jetstream.KeyValueDelete),
+		}, nil)
+		settings.On("History", mock.Anything, "project-1").Return([]jetstream.KeyValueEntry{
+			historyEntry([]byte(`{"uid":"project-1","mission_statement":"Mission"}`), 3, created, jetstream.KeyValuePut),
+		}, nil)
+		repo := &NatsRepository{Projects: projects, ProjectSettings: settings}
+
+		revisions, err := repo.GetProjectHistory(context.Background(), "project-1")
+		require.NoError(t, err)
+		require.Len(t, revisions, 3)
+
+		assert.Equal(t, models.ProjectRevisionBase, revisions[0].Kind)
+		assert.Equal(t, "First", revisions[0].Project.Name)
+		assert.Equal(t, models.ProjectRevisionSettings, revisions[1].Kind)
+		assert.Equal(t, "Mission", revisions[1].Settings.MissionStatement)
+		assert.Equal(t, uint64(12), revisions[2].Revision)
+		assert.True(t, revisions[2].Deleted)
+	})
+
+	t.Run("project without history", func(t *testing.T) {
+		projects, settings := &MockKeyValue{}, &MockKeyValue{}
+		projects.On("History", mock.Anything, "project-1").Return(nil, jetstream.ErrKeyNotFound)
+		settings.On("History", mock.Anything, "project-1").Return(nil, jetstream.ErrKeyNotFound)
+		repo := &NatsRepository{Projects: projects, ProjectSettings: settings}
+
+		_, err := repo.GetProjectHistory(context.Background(), "project-1")
+		assert.ErrorIs(t, err, domain.ErrProjectNotFound)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		projects := &MockKeyValue{}
+		projects.On("History", mock.Anything, "project-1").Return(nil, errors.New("nats error"))
+		repo := &NatsRepository{Projects: projects, ProjectSettings: &MockKeyValue{}}
+
+		_, err := repo.GetProjectHistory(context.Background(), "project-1")
+		assert.ErrorIs(t, err, domain.ErrInternal)
+	})
+}
@@ -0,0 +1,210 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// ProjectPrefixIndex is an in-memory index of the project names and slugs in
+// the projects bucket, for typeahead. It is loaded and kept up to date by a
+// JetStream KV watcher, so lookups never scan the bucket.
+//
+// The index holds the tokens of every project, as returned by
+// [models.ProjectSuggestion.Tokens], sorted, so that the projects with a token
+// starting with a prefix are found with a binary search.
+type ProjectPrefixIndex struct {
+	mu       sync.RWMutex
+	ready    bool
+	stopped  bool
+	projects map[string]models.ProjectSuggestion
+	tokens   []prefixToken
+}
+
+// prefixToken is one token of the project with the UID uid.
+type prefixToken struct {
+	token string
+	uid   string
+}
+
+func comparePrefixTokens(a, b prefixToken) int {
+	return cmp.Or(strings.Compare(a.token, b.token), strings.Compare(a.uid, b.uid))
+}
+
+// NewProjectPrefixIndex returns an empty index. It answers lookups once
+// [ProjectPrefixIndex.Watch] has loaded the projects bucket.
+func NewProjectPrefixIndex() *ProjectPrefixIndex {
+	return &ProjectPrefixIndex{projects: make(map[string]models.ProjectSuggestion)}
+}
+
+// Watch loads the projects bucket and keeps the index up to date with its
+// changes. It returns once the current projects are loaded, or with an error
+// if that takes longer than cacheLoadTimeout. If the watcher later stops
+// before ctx is done, the index stops answering lookups.
+func (x *ProjectPrefixIndex) Watch(ctx context.Context, kv jetstream.KeyValue) error {
+	watcher, err := kv.WatchAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	loaded := make(chan struct{})
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		defer func() { _ = watcher.Stop() }()
+		isLoaded := false
+		for entry := range watcher.Updates() {
+			// A nil entry marks the end of the initial values.
+			if entry == nil {
+				if !isLoaded {
+					isLoaded = true
+					x.mu.Lock()
+					x.ready = !x.stopped
+					x.mu.Unlock()
+					close(loaded)
+				}
+				continue
+			}
+			x.apply(ctx, entry)
+		}
+		if ctx.Err() == nil {
+			slog.WarnContext(ctx, "project prefix index watcher stopped, autocomplete scans the project store")
+		}
+		x.stop()
+	}()
+
+	select {
+	case <-loaded:
+		return nil
+	case <-exited:
+		return errors.New("project prefix index watcher stopped before loading the bucket")
+	case <-time.After(cacheLoadTimeout):
+		_ = watcher.Stop()
+		return errors.New("timed out loading the bucket into the project prefix index")
+	}
+}
+
+// apply updates the index with an entry delivered by the watcher. Slug and
+// legacy ID mappings are ignored.
+func (x *ProjectPrefixIndex) apply(ctx context.Context, entry jetstream.KeyValueEntry) {
+	key := entry.Key()
+	if strings.HasPrefix(key, slugKeyPrefix) || strings.HasPrefix(key, legacyIDKeyPrefix) {
+		return
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if x.stopped {
+		return
+	}
+	x.remove(key)
+	if op := entry.Operation(); op == jetstream.KeyValueDelete || op == jetstream.KeyValuePurge {
+		return
+	}
+	var project models.ProjectBase
+	if err := json.Unmarshal(entry.Value(), &project); err != nil {
+		slog.WarnContext(ctx, "error unmarshalling project for the prefix index", constants.ErrKey, err, "project_uid", key)
+		return
+	}
+	project.UID = key
+	x.put(models.NewProjectSuggestion(&project))
+}
+
+// put adds the tokens of suggestion. The caller holds the lock.
+func (x *ProjectPrefixIndex) put(suggestion models.ProjectSuggestion) {
+	x.projects[suggestion.UID] = suggestion
+	for _, token := range projectTokens(suggestion) {
+		if i, found := slices.BinarySearchFunc(x.tokens, token, comparePrefixTokens); !found {
+			x.tokens = slices.Insert(x.tokens, i, token)
+		}
+	}
+}
+
+// remove drops the tokens of the project with the UID uid. The caller holds
+// the lock.
+func (x *ProjectPrefixIndex) remove(uid string) {
+	suggestion, ok := x.projects[uid]
+	if !ok {
+		return
+	}
+	delete(x.projects, uid)
+	for _, token := range projectTokens(suggestion) {
+		if i, found := slices.BinarySearchFunc(x.tokens, token, comparePrefixTokens); found {
+			x.tokens = slices.Delete(x.tokens, i, i+1)
+		}
+	}
+}
+
+// projectTokens returns the distinct tokens of suggestion.
+func projectTokens(suggestion models.ProjectSuggestion) []prefixToken {
+	words := suggestion.Tokens()
+	slices.Sort(words)
+	words = slices.Compact(words)
+	tokens := make([]prefixToken, 0, len(words))
+	for _, word := range words {
+		if word != "" {
+			tokens = append(tokens, prefixToken{token: word, uid: suggestion.UID})
+		}
+	}
+	return tokens
+}
+
+// stop empties the index and stops it from answering lookups.
+func (x *ProjectPrefixIndex) stop() {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	x.ready = false
+	x.stopped = true
+	clear(x.projects)
+	x.tokens = nil
+}
+
+// SuggestProjects returns the projects with a token starting with the
+// lowercase prefix, in no particular order. It returns
+// domain.ErrServiceUnavailable until the bucket is loaded and after the
+// watcher stops.
+func (x *ProjectPrefixIndex) SuggestProjects(_ context.Context, prefix string) ([]models.ProjectSuggestion, error) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	if !x.ready {
+		return nil, domain.ErrServiceUnavailable
+	}
+	start, _ := slices.BinarySearchFunc(x.tokens, prefixToken{token: prefix}, comparePrefixTokens)
+	seen := map[string]bool{}
+	var suggestions []models.ProjectSuggestion
+	for _, token := range x.tokens[start:] {
+		if !strings.HasPrefix(token.token, prefix) {
+			break
+		}
+		if !seen[token.uid] {
+			seen[token.uid] = true
+			suggestions = append(suggestions, x.projects[token.uid])
+		}
+	}
+	return suggestions, nil
+}
+
+// Len returns the number of indexed projects.
+func (x *ProjectPrefixIndex) Len() int {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return len(x.projects)
+}
@@ -0,0 +1,77 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+func projectEntry(t *testing.T, project models.ProjectBase, revision uint64) jetstream.KeyValueEntry {
+	value, err := json.Marshal(project)
+	require.NoError(t, err)
+	return newWatchedEntry(project.UID, value, revision, jetstream.KeyValuePut)
+}
+
+func suggestedSlugs(t *testing.T, index *ProjectPrefixIndex, prefix string) []string {
+	suggestions, err := index.SuggestProjects(context.Background(), prefix)
+	require.NoError(t, err)
+	slugs := make([]string, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		slugs = append(slugs, suggestion.Slug)
+	}
+	return slugs
+}
+
+func TestProjectPrefixIndex(t *testing.T) {
+	ctx := context.Background()
+	index := NewProjectPrefixIndex()
+	index.ready = true
+
+	index.apply(ctx, projectEntry(t, models.ProjectBase{UID: "project-1", Slug: "kubernetes", Name: "Kubernetes"}, 1))
+	index.apply(ctx, projectEntry(t, models.ProjectBase{UID: "project-2", Slug: "cloud-native", Name: "Cloud Native Kube Tools", LogoURL: "https://example.org/logo.svg"}, 2))
+	index.apply(ctx, projectEntry(t, models.ProjectBase{UID: "project-3", Slug: "linux", Name: "Linux"}, 3))
+	index.apply(ctx, newWatchedEntry("slug/kubernetes", []byte("project-1"), 4, jetstream.KeyValuePut))
+
+	assert.Equal(t, 3, index.Len())
+	assert.ElementsMatch(t, []string{"kubernetes", "cloud-native"}, suggestedSlugs(t, index, "kube"))
+	assert.Equal(t, []string{"cloud-native"}, suggestedSlugs(t, index, "native"))
+	assert.Empty(t, suggestedSlugs(t, index, "zephyr"))
+
+	suggestions, err := index.SuggestProjects(ctx, "cloud")
+	require.NoError(t, err)
+	assert.Equal(t, []models.ProjectSuggestion{
+		{UID: "project-2", Name: "Cloud Native Kube Tools", Slug: "cloud-native", LogoURL: "https://example.org/logo.svg"},
+	}, suggestions)
+
+	// A renamed project is only found by its new name.
+	index.apply(ctx, projectEntry(t, models.ProjectBase{UID: "project-1", Slug: "kubernetes", Name: "K8s"}, 5))
+	assert.Equal(t, []string{"kubernetes"}, suggestedSlugs(t, index, "k8"))
+	assert.ElementsMatch(t, []string{"kubernetes", "cloud-native"}, suggestedSlugs(t, index, "kube"))
+
+	// A deleted project is no longer found.
+	index.apply(ctx, newWatchedEntry("project-2", nil, 6, jetstream.KeyValueDelete))
+	assert.Equal(t, []string{"kubernetes"}, suggestedSlugs(t, index, "kube"))
+	assert.Equal(t, 2, index.Len())
+}
+
+func TestProjectPrefixIndex_notReady(t *testing.T) {
+	index := NewProjectPrefixIndex()
+
+	_, err := index.SuggestProjects(context.Background(), "kube")
+	assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+
+	index.ready = true
+	index.stop()
+	_, err = index.SuggestProjects(context.Background(), "kube")
+	assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+}
@@ -0,0 +1,164 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// DefaultListConcurrency is the number of concurrent gets of a bulk listing
+// when NatsRepository.ListConcurrency is not set.
+const DefaultListConcurrency = 16
+
+// listConcurrency returns the number of concurrent gets of a bulk listing.
+func (s *NatsRepository) listConcurrency() int {
+	if s.ListConcurrency > 0 {
+		return s.ListConcurrency
+	}
+	return DefaultListConcurrency
+}
+
+// StreamProjectsBase calls fn with each project base, in no particular order.
+// The projects are fetched concurrently, but fn is only called from the
+// calling goroutine. It stops at the first error of the store or of fn.
+func (s *NatsRepository) StreamProjectsBase(ctx context.Context, fn func(*models.ProjectBase) error) error {
+	return streamKeys(ctx, s.Projects, s.listConcurrency(), "project",
+		func(key string) bool {
+			// Skip slug and legacy ID mappings
+			return !strings.HasPrefix(key, slugKeyPrefix) && !strings.HasPrefix(key, legacyIDKeyPrefix)
+		},
+		func(ctx context.Context, key string) (*models.ProjectBase, error) {
+			entry, err := s.getProjectBase(ctx, key)
+			if err != nil {
+				slog.ErrorContext(ctx, "error getting project from NATS KV store", constants.ErrKey, err, "project_uid", key)
+				return nil, domain.ErrInternal
+			}
+			projectDB, _, err := s.getProjectBaseUnmarshal(ctx, key, entry)
+			if err != nil {
+				slog.ErrorContext(ctx, "error unmarshalling project from NATS KV store", constants.ErrKey, err, "project_uid", key)
+				return nil, domain.ErrUnmarshal
+			}
+			return projectDB, nil
+		},
+		fn)
+}
+
+// StreamProjectsSettings calls fn with the settings of each project, in no
+// particular order. The settings are fetched concurrently, but fn is only
+// called from the calling goroutine. It stops at the first error of the store
+// or of fn.
+func (s *NatsRepository) StreamProjectsSettings(ctx context.Context, fn func(*models.ProjectSettings) error) error {
+	return streamKeys(ctx, s.ProjectSettings, s.listConcurrency(), "project settings",
+		func(key string) bool {
+			return !strings.HasPrefix(key, "lookup/")
+		},
+		func(ctx context.Context, key string) (*models.ProjectSettings, error) {
+			entry, err := s.ProjectSettings.Get(ctx, key)
+			if err != nil {
+				slog.ErrorContext(ctx, "error getting project settings from NATS KV store", constants.ErrKey, err, "project_uid", key)
+				return nil, domain.ErrInternal
+			}
+			projectSettingsDB, _, err := s.getProjectSettingsUnmarshal(ctx, key, entry)
+			if err != nil {
+				slog.ErrorContext(ctx, "error unmarshalling project settings from NATS KV store", constants.ErrKey, err, "project_uid", key)
+				return nil, domain.ErrUnmarshal
+			}
+			return projectSettingsDB, nil
+		},
+		fn)
+}
+
+// streamKeys gets the value of each key of kv that keep selects with up to
+// workers concurrent calls of get, and calls fn with each value from the
+// calling goroutine. At most workers values wait for fn, so a slow fn holds
+// back the gets rather than piling up values. The first error of get or fn
+// cancels the gets still running and is returned.
+func streamKeys[T any](
+	ctx context.Context,
+	kv INatsKeyValue,
+	workers int,
+	kind string,
+	keep func(key string) bool,
+	get func(ctx context.Context, key string) (*T, error),
+	fn func(*T) error,
+) error {
+	keysLister, err := kv.ListKeys(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "error listing "+kind+" keys from NATS KV store", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	g, gctx := errgroup.WithContext(ctx)
+
+	keys := make(chan string)
+	g.Go(func() error {
+		defer close(keys)
+		for key := range keysLister.Keys() {
+			if !keep(key) {
+				continue
+			}
+			select {
+			case keys <- key:
+			case <-gctx.Done():
+				// The remaining keys are not read, so the lister is stopped.
+				_ = keysLister.Stop()
+				return gctx.Err()
+			}
+		}
+		return nil
+	})
+
+	values := make(chan *T, workers)
+	var getters sync.WaitGroup
+	for range workers {
+		getters.Add(1)
+		g.Go(func() error {
+			defer getters.Done()
+			for key := range keys {
+				value, err := get(gctx, key)
+				if err != nil {
+					return err
+				}
+				select {
+				case values <- value:
+				case <-gctx.Done():
+					return gctx.Err()
+				}
+			}
+			return nil
+		})
+	}
+	go func() {
+		getters.Wait()
+		close(values)
+	}()
+
+	var fnErr error
+	for value := range values {
+		if fnErr != nil {
+			// Drain the values already fetched while the gets wind down.
+			continue
+		}
+		if fnErr = fn(value); fnErr != nil {
+			cancel()
+		}
+	}
+
+	err = g.Wait()
+	if fnErr != nil {
+		return fnErr
+	}
+	return err
+}
@@ -0,0 +1,163 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockProjects sets up projectsKV to hold count projects, whose gets take delay.
+// It returns the UIDs of the projects and the highest number of gets that ran
+// at once.
+func mockProjects(projectsKV *MockKeyValue, count int, delay time.Duration) ([]string, *atomic.Int32) {
+	var running, maxRunning atomic.Int32
+	uids := make([]string, 0, count)
+	for i := range count {
+		uid := fmt.Sprintf("project-%02d", i)
+		uids = append(uids, uid)
+		data, _ := json.Marshal(&models.ProjectBase{UID: uid})
+		projectsKV.On("Get", mock.Anything, uid).Run(func(mock.Arguments) {
+			n := running.Add(1)
+			for {
+				m := maxRunning.Load()
+				if n <= m || maxRunning.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(delay)
+			running.Add(-1)
+		}).Return(NewMockKeyValueEntry(data, 1), nil).Maybe()
+	}
+	lister := NewMockKeyLister(append([]string{"slug/project-00", "legacy/1"}, uids...))
+	lister.On("Stop").Return(nil).Maybe()
+	projectsKV.On("ListKeys", mock.Anything).Return(lister, nil)
+	return uids, &maxRunning
+}
+
+func TestNatsRepository_StreamProjectsBase(t *testing.T) {
+	projectsKV := &MockKeyValue{}
+	uids, maxRunning := mockProjects(projectsKV, 20, 5*time.Millisecond)
+	repo := &NatsRepository{Projects: projectsKV, ListConcurrency: 4}
+
+	var streamed []string
+	err := repo.StreamProjectsBase(context.Background(), func(projectBase *models.ProjectBase) error {
+		streamed = append(streamed, projectBase.UID)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, uids, streamed)
+	assert.Greater(t, maxRunning.Load(), int32(1), "gets run concurrently")
+	assert.LessOrEqual(t, maxRunning.Load(), int32(4), "gets are bounded by ListConcurrency")
+}
+
+func TestNatsRepository_StreamProjectsBase_stops(t *testing.T) {
+	t.Run("at the first error of fn", func(t *testing.T) {
+		projectsKV := &MockKeyValue{}
+		mockProjects(projectsKV, 20, time.Millisecond)
+		repo := &NatsRepository{Projects: projectsKV, ListConcurrency: 2}
+		errStop := errors.New("stop")
+
+		calls := 0
+		err := repo.StreamProjectsBase(context.Background(), func(*models.ProjectBase) error {
+			calls++
+			return errStop
+		})
+
+		assert.ErrorIs(t, err, errStop)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("at the first error of the store", func(t *testing.T) {
+		projectsKV := &MockKeyValue{}
+		lister := NewMockKeyLister([]string{"project-1"})
+		lister.On("Stop").Return(nil).Maybe()
+		projectsKV.On("ListKeys", mock.Anything).Return(lister, nil)
+		projectsKV.On("Get", mock.Anything, "project-1").Return(nil, errors.New("nats error"))
+		repo := &NatsRepository{Projects: projectsKV}
+
+		err := repo.StreamProjectsBase(context.Background(), func(*models.ProjectBase) error {
+			t.Fatal("fn called")
+			return nil
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInternal)
+	})
+
+	t.Run("when the context is canceled", func(t *testing.T) {
+		projectsKV := &MockKeyValue{}
+		mockProjects(projectsKV, 20, time.Millisecond)
+		repo := &NatsRepository{Projects: projectsKV, ListConcurrency: 2}
+		ctx, cancel := context.WithCancel(context.Background())
+
+		err := repo.StreamProjectsBase(ctx, func(*models.ProjectBase) error {
+			cancel()
+			return nil
+		})
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestNatsRepository_ListAllProjectsBase_sorted(t *testing.T) {
+	projectsKV := &MockKeyValue{}
+	uids, _ := mockProjects(projectsKV, 10, 0)
+	repo := &NatsRepository{Projects: projectsKV}
+
+	projects, err := repo.ListAllProjectsBase(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, projects, len(uids))
+	for i, project := range projects {
+		assert.Equal(t, uids[i], project.UID)
+	}
+}
+
+func TestNatsRepository_ListAllProjectsBase_tenants(t *testing.T) {
+	project := func(uid string) []byte {
+		data, _ := json.Marshal(&models.ProjectBase{UID: uid})
+		return data
+	}
+	shared := &memoryKeyValue{bucket: constants.KVStoreNameProjects, values: map[string][]byte{
+		"project-1":          project("project-1"),
+		"slug/one":           []byte("project-1"),
+		"legacy/a1":          []byte("project-1"),
+		"sandbox.project-2":  project("project-2"),
+		"sandbox.slug/two":   []byte("project-2"),
+		"sandbox.legacy/a2":  []byte("project-2"),
+		"staging.project-3":  project("project-3"),
+		"staging.slug/three": []byte("project-3"),
+	}}
+
+	for _, tt := range []struct {
+		tenant string
+		want   string
+	}{
+		{tenant: "", want: "project-1"},
+		{tenant: "sandbox", want: "project-2"},
+	} {
+		t.Run("tenant "+tt.tenant, func(t *testing.T) {
+			repo := &NatsRepository{Projects: NewTenantKeyValue(shared, tt.tenant)}
+
+			projects, err := repo.ListAllProjectsBase(context.Background())
+
+			require.NoError(t, err)
+			require.Len(t, projects, 1)
+			assert.Equal(t, tt.want, projects[0].UID)
+		})
+	}
+}
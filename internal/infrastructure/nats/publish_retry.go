@@ -0,0 +1,148 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/metrics"
+)
+
+// ErrPublishCircuitOpen is returned instead of publishing while the publish
+// circuit breaker is open.
+var ErrPublishCircuitOpen = errors.New("NATS publish circuit breaker is open")
+
+// RetryPolicy configures how the [MessageBuilder] retries a failed indexer or
+// access message. The zero value makes a single attempt.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts, including the first one.
+	MaxAttempts int
+	// BaseDelay is the backoff before the second attempt; it doubles on each
+	// further attempt up to MaxDelay. Each delay is jittered between zero and
+	// its full value so that replicas do not retry in lockstep.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// delay returns the jittered backoff before the given attempt, counted from 2.
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	backoff := p.BaseDelay << min(attempt-2, 30)
+	if backoff <= 0 || (p.MaxDelay > 0 && backoff > p.MaxDelay) {
+		backoff = p.MaxDelay
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	return rand.N(backoff + 1)
+}
+
+// CircuitBreaker stops publishing after a run of failed messages, so that
+// while NATS is flaky requests fail fast instead of each waiting through its
+// retries. With the outbox enabled, messages are then left to the outbox
+// dispatcher, making publishing asynchronous until NATS recovers.
+//
+// After the cooldown one message is let through; its success closes the
+// breaker and its failure opens it for another cooldown.
+type CircuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// NewCircuitBreaker returns a breaker that opens after threshold consecutive
+// failed messages, or nil, meaning no breaker, if threshold is not positive.
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	if threshold <= 0 {
+		return nil
+	}
+	return &CircuitBreaker{threshold: threshold, cooldown: cooldown, now: time.Now}
+}
+
+// allow reports whether a message may be published.
+func (b *CircuitBreaker) allow() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return true
+	}
+	if b.probing || b.now().Before(b.openUntil) {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+// record updates the breaker with the outcome of a published message.
+func (b *CircuitBreaker) record(err error) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if err == nil {
+		b.failures = 0
+		metrics.SetPublishCircuitOpen(false)
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openUntil = b.now().Add(b.cooldown)
+		metrics.SetPublishCircuitOpen(true)
+	}
+}
+
+// Open reports whether the breaker is rejecting messages.
+func (b *CircuitBreaker) Open() bool {
+	if b == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.failures >= b.threshold
+}
+
+// sendWithRetry calls send until it succeeds, the retry policy is exhausted or
+// ctx is done, sleeping with backoff between attempts, and records the outcome
+// in the circuit breaker.
+func (m *MessageBuilder) sendWithRetry(ctx context.Context, subject string, send func() error) error {
+	if !m.Breaker.allow() {
+		return ErrPublishCircuitOpen
+	}
+
+	attempts := max(m.Retry.MaxAttempts, 1)
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if attempt > 1 {
+			metrics.IncPublishRetry(subject)
+			timer := time.NewTimer(m.Retry.delay(attempt))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				m.Breaker.record(err)
+				return err
+			case <-timer.C:
+			}
+		}
+		if err = send(); err == nil || ctx.Err() != nil {
+			break
+		}
+	}
+	m.Breaker.record(err)
+	return err
+}
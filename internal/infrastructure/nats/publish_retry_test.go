@@ -0,0 +1,133 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMessageBuilder_sendMessage_retry(t *testing.T) {
+	errTransient := errors.New("nats: connection closed")
+
+	tests := []struct {
+		name          string
+		maxAttempts   int
+		failures      int
+		expectedCalls int
+		expectedErr   error
+	}{
+		{
+			name:          "no retry policy makes a single attempt",
+			failures:      1,
+			expectedCalls: 1,
+			expectedErr:   errTransient,
+		},
+		{
+			name:          "transient failure succeeds on retry",
+			maxAttempts:   3,
+			failures:      2,
+			expectedCalls: 3,
+		},
+		{
+			name:          "gives up after max attempts",
+			maxAttempts:   3,
+			failures:      5,
+			expectedCalls: 3,
+			expectedErr:   errTransient,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockConn := &MockNATSConn{}
+			if tt.failures > 0 {
+				mockConn.On("PublishMsg", mock.Anything).Return(errTransient).Times(min(tt.failures, tt.expectedCalls))
+			}
+			if tt.failures < tt.expectedCalls {
+				mockConn.On("PublishMsg", mock.Anything).Return(nil).Once()
+			}
+
+			builder := &MessageBuilder{
+				NatsConn: mockConn,
+				Retry:    RetryPolicy{MaxAttempts: tt.maxAttempts, BaseDelay: time.Millisecond, MaxDelay: 2 * time.Millisecond},
+			}
+			err := builder.sendMessage(context.Background(), "test.subject", []byte("{}"), false)
+
+			assert.Equal(t, tt.expectedErr, err)
+			mockConn.AssertNumberOfCalls(t, "PublishMsg", tt.expectedCalls)
+		})
+	}
+}
+
+func TestMessageBuilder_sendMessage_cancelledDuringBackoff(t *testing.T) {
+	errTransient := errors.New("nats: timeout")
+	mockConn := &MockNATSConn{}
+	mockConn.On("PublishMsg", mock.Anything).Return(errTransient)
+
+	builder := &MessageBuilder{
+		NatsConn: mockConn,
+		Retry:    RetryPolicy{MaxAttempts: 5, BaseDelay: time.Hour, MaxDelay: time.Hour},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := builder.sendMessage(ctx, "test.subject", []byte("{}"), false)
+
+	assert.Equal(t, errTransient, err)
+	mockConn.AssertNumberOfCalls(t, "PublishMsg", 1)
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	breaker := NewCircuitBreaker(2, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	errTransient := errors.New("nats: no responders")
+	mockConn := &MockNATSConn{}
+	mockConn.On("RequestMsgWithContext", mock.Anything, mock.Anything).Return((*nats.Msg)(nil), errTransient).Twice()
+	builder := &MessageBuilder{NatsConn: mockConn, Breaker: breaker}
+
+	// Two failed messages open the breaker.
+	for i := 0; i < 2; i++ {
+		assert.Equal(t, errTransient, builder.sendMessage(context.Background(), "test.subject", []byte("{}"), true))
+	}
+	assert.True(t, builder.PublishDegraded())
+
+	// While open, messages fail fast without reaching NATS.
+	err := builder.sendMessage(context.Background(), "test.subject", []byte("{}"), true)
+	assert.ErrorIs(t, err, ErrPublishCircuitOpen)
+	mockConn.AssertNumberOfCalls(t, "RequestMsgWithContext", 2)
+
+	// After the cooldown a trial message is let through and closes the breaker.
+	now = now.Add(time.Minute)
+	mockConn.On("RequestMsgWithContext", mock.Anything, mock.Anything).Return(&nats.Msg{}, nil).Once()
+	assert.NoError(t, builder.sendMessage(context.Background(), "test.subject", []byte("{}"), true))
+	assert.False(t, builder.PublishDegraded())
+}
+
+func TestNewCircuitBreaker_disabled(t *testing.T) {
+	breaker := NewCircuitBreaker(0, time.Minute)
+
+	assert.Nil(t, breaker)
+	assert.True(t, breaker.allow())
+	assert.False(t, breaker.Open())
+}
+
+func TestRetryPolicy_delay(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+
+	for attempt := 2; attempt <= 40; attempt++ {
+		delay := policy.delay(attempt)
+		assert.GreaterOrEqual(t, delay, time.Duration(0))
+		assert.LessOrEqual(t, delay, 300*time.Millisecond)
+	}
+	assert.LessOrEqual(t, policy.delay(2), 100*time.Millisecond)
+}
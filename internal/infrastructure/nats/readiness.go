@@ -0,0 +1,145 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// errNotProbed is reported by a [ReadinessProber] that has not completed a probe yet.
+var errNotProbed = errors.New("readiness not probed yet")
+
+// INatsRTT is the part of a NATS connection that measures the round trip to the server.
+type INatsRTT interface {
+	RTT() (time.Duration, error)
+}
+
+// ReadinessProber periodically measures the NATS round trip and reads a KV bucket,
+// so that readiness reflects whether JetStream is actually serving requests rather
+// than whether the clients were created. It is not ready until its first probe
+// succeeds, and becomes unready after FailureThreshold consecutive failed probes.
+type ReadinessProber struct {
+	// Conn is the NATS connection whose round trip is measured.
+	Conn INatsRTT
+	// KV is the bucket read on every probe.
+	KV INatsKeyValue
+	// Timeout bounds each probe; a round trip slower than it fails the probe.
+	Timeout time.Duration
+	// FailureThreshold is the number of consecutive failed probes after which
+	// the prober reports not ready. Values below 1 are treated as 1.
+	FailureThreshold int
+
+	mu       sync.RWMutex
+	err      error
+	probed   bool
+	failures int
+}
+
+// NewReadinessProber creates a prober of the connection and bucket.
+func NewReadinessProber(conn INatsRTT, kv INatsKeyValue, timeout time.Duration, failureThreshold int) *ReadinessProber {
+	return &ReadinessProber{
+		Conn:             conn,
+		KV:               kv,
+		Timeout:          timeout,
+		FailureThreshold: failureThreshold,
+	}
+}
+
+// Ready returns nil when the service may receive traffic, or the error of the
+// probe that made it unready.
+func (p *ReadinessProber) Ready() error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if !p.probed {
+		return errNotProbed
+	}
+	return p.err
+}
+
+// Run probes immediately and then every interval until ctx is done.
+func (p *ReadinessProber) Run(ctx context.Context, interval time.Duration) {
+	p.Probe(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.Probe(ctx)
+		}
+	}
+}
+
+// Probe runs one probe and updates the readiness accordingly.
+func (p *ReadinessProber) Probe(ctx context.Context) {
+	err := p.probe(ctx)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err == nil {
+		if p.probed && p.err != nil {
+			slog.InfoContext(ctx, "NATS readiness restored")
+		}
+		p.probed = true
+		p.err = nil
+		p.failures = 0
+		return
+	}
+
+	p.failures++
+	slog.WarnContext(ctx, "NATS readiness probe failed", "consecutive_failures", p.failures, constants.ErrKey, err)
+	if p.failures < max(p.FailureThreshold, 1) {
+		return
+	}
+	if p.err == nil {
+		slog.ErrorContext(ctx, "NATS not ready, reporting service unready", "consecutive_failures", p.failures, constants.ErrKey, err)
+	}
+	p.probed = true
+	p.err = err
+}
+
+// probe measures the round trip to the NATS server and reads the bucket, both
+// bounded by Timeout.
+func (p *ReadinessProber) probe(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, p.Timeout)
+	defer cancel()
+
+	type result struct {
+		rtt time.Duration
+		err error
+	}
+	// RTT has a fixed flush timeout of its own, so it runs aside to honor ctx.
+	done := make(chan result, 1)
+	go func() {
+		rtt, err := p.Conn.RTT()
+		done <- result{rtt: rtt, err: err}
+	}()
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("NATS round trip: %w", ctx.Err())
+	case res := <-done:
+		if res.err != nil {
+			return fmt.Errorf("NATS round trip: %w", res.err)
+		}
+		if res.rtt > p.Timeout {
+			return fmt.Errorf("NATS round trip took %s", res.rtt)
+		}
+	}
+
+	if err := KVHealthCheck(p.KV).HealthCheck(ctx); err != nil {
+		return fmt.Errorf("KV bucket: %w", err)
+	}
+	return nil
+}
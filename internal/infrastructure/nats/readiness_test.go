@@ -0,0 +1,102 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// fakeRTT is an [INatsRTT] returning a fixed round trip, after an optional delay.
+type fakeRTT struct {
+	rtt   time.Duration
+	err   error
+	delay time.Duration
+}
+
+func (f *fakeRTT) RTT() (time.Duration, error) {
+	time.Sleep(f.delay)
+	return f.rtt, f.err
+}
+
+func TestReadinessProber(t *testing.T) {
+	ctx := context.Background()
+	bucketDown := errors.New("bucket unavailable")
+
+	t.Run("not ready before the first probe", func(t *testing.T) {
+		prober := NewReadinessProber(&fakeRTT{}, &MockKeyValue{}, time.Second, 1)
+		assert.ErrorIs(t, prober.Ready(), errNotProbed)
+	})
+
+	t.Run("ready after a successful probe", func(t *testing.T) {
+		kv := &MockKeyValue{}
+		kv.On("Get", mock.Anything, constants.KVHealthSentinelKey).Return(nil, jetstream.ErrKeyNotFound)
+		prober := NewReadinessProber(&fakeRTT{rtt: time.Millisecond}, kv, time.Second, 1)
+
+		prober.Probe(ctx)
+
+		assert.NoError(t, prober.Ready())
+	})
+
+	t.Run("unready after consecutive failures reach the threshold", func(t *testing.T) {
+		kv := &MockKeyValue{}
+		kv.On("Get", mock.Anything, constants.KVHealthSentinelKey).Return(nil, jetstream.ErrKeyNotFound).Once()
+		kv.On("Get", mock.Anything, constants.KVHealthSentinelKey).Return(nil, bucketDown)
+		prober := NewReadinessProber(&fakeRTT{rtt: time.Millisecond}, kv, time.Second, 2)
+
+		prober.Probe(ctx)
+		prober.Probe(ctx)
+		assert.NoError(t, prober.Ready(), "a single failure is below the threshold")
+
+		prober.Probe(ctx)
+		assert.ErrorIs(t, prober.Ready(), bucketDown)
+	})
+
+	t.Run("recovers after a successful probe", func(t *testing.T) {
+		kv := &MockKeyValue{}
+		kv.On("Get", mock.Anything, constants.KVHealthSentinelKey).Return(nil, bucketDown).Once()
+		kv.On("Get", mock.Anything, constants.KVHealthSentinelKey).Return(nil, jetstream.ErrKeyNotFound)
+		prober := NewReadinessProber(&fakeRTT{rtt: time.Millisecond}, kv, time.Second, 1)
+
+		prober.Probe(ctx)
+		assert.ErrorIs(t, prober.Ready(), bucketDown)
+
+		prober.Probe(ctx)
+		assert.NoError(t, prober.Ready())
+	})
+
+	t.Run("fails when the connection is down", func(t *testing.T) {
+		prober := NewReadinessProber(&fakeRTT{err: nats.ErrDisconnected}, &MockKeyValue{}, time.Second, 1)
+
+		prober.Probe(ctx)
+
+		assert.ErrorIs(t, prober.Ready(), nats.ErrDisconnected)
+	})
+
+	t.Run("fails when the round trip exceeds the timeout", func(t *testing.T) {
+		prober := NewReadinessProber(&fakeRTT{rtt: 2 * time.Second}, &MockKeyValue{}, time.Second, 1)
+
+		prober.Probe(ctx)
+
+		assert.ErrorContains(t, prober.Ready(), "round trip took")
+	})
+
+	t.Run("does not wait for a hung round trip", func(t *testing.T) {
+		prober := NewReadinessProber(&fakeRTT{delay: time.Second}, &MockKeyValue{}, 10*time.Millisecond, 1)
+
+		start := time.Now()
+		prober.Probe(ctx)
+
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+		assert.ErrorIs(t, prober.Ready(), context.DeadlineExceeded)
+	})
+}
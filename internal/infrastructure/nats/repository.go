@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"slices"
 	"strings"
 
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
@@ -19,6 +20,9 @@ import (
 	"github.com/nats-io/nats.go/jetstream"
 )
 
+// legacyIDKeyPrefix is the prefix of the legacy-ID-to-UID mapping keys in the projects bucket.
+const legacyIDKeyPrefix = "legacy/"
+
 type NatsRepository struct {
 	Projects        INatsKeyValue
 	ProjectSettings INatsKeyValue
@@ -26,6 +30,31 @@ type NatsRepository struct {
 	Folders         INatsKeyValue
 	Documents       INatsKeyValue
 	DocumentFiles   INatsObjectStore
+	Outbox          INatsKeyValue
+	DeadLetters     INatsKeyValue
+	Charters        INatsKeyValue
+	Associations    INatsKeyValue
+	// Webhooks and WebhookDeliveries hold the registered webhooks and the
+	// events delivered to them.
+	Webhooks          INatsKeyValue
+	WebhookDeliveries INatsKeyValue
+	// Announcements records the project announcements that were published.
+	Announcements INatsKeyValue
+	// Templates holds the templates that new projects can be created from.
+	Templates INatsKeyValue
+	// Metadata holds the admin-managed configuration of project fields, such
+	// as the allowed stages and categories.
+	Metadata INatsKeyValue
+	// Deletions holds the tombstones of deleted projects until the bucket's TTL
+	// expires them.
+	Deletions INatsKeyValue
+	// SlugCache caches slug-to-UID lookups when set.
+	SlugCache *SlugCache
+	// PrefixIndex indexes the project names and slugs for autocomplete when set.
+	PrefixIndex *ProjectPrefixIndex
+	// ListConcurrency bounds the concurrent gets of the bulk listings of
+	// projects, which default to DefaultListConcurrency.
+	ListConcurrency int
 }
 
 func NewNatsRepository(projects INatsKeyValue, projectSettings INatsKeyValue) *NatsRepository {
@@ -44,15 +73,17 @@ func (s *NatsRepository) getProjectBase(ctx context.Context, projectUID string)
 	return entry, nil
 }
 
-func (s *NatsRepository) getProjectBaseUnmarshal(ctx context.Context, entry jetstream.KeyValueEntry) (*models.ProjectBase, error) {
-	projectDB := models.ProjectBase{}
-	err := json.Unmarshal(entry.Value(), &projectDB)
+// getProjectBaseUnmarshal decodes a stored project base, writing it back when
+// it was upgraded to the current schema version, and returns it with the
+// revision it is now stored at.
+func (s *NatsRepository) getProjectBaseUnmarshal(ctx context.Context, projectUID string, entry jetstream.KeyValueEntry) (*models.ProjectBase, uint64, error) {
+	projectDB, upgraded, err := decodeProjectBase(entry.Value())
 	if err != nil {
 		slog.ErrorContext(ctx, "error unmarshalling project from NATS KV store", constants.ErrKey, err)
-		return nil, err
+		return nil, 0, err
 	}
 
-	return &projectDB, nil
+	return projectDB, writeBackUpgrade(ctx, s.Projects, projectUID, upgraded, entry.Revision()), nil
 }
 
 // GetProjectBase gets the project base from the NATS KV store.
@@ -66,7 +97,7 @@ func (s *NatsRepository) GetProjectBase(ctx context.Context, projectUID string)
 		return nil, domain.ErrInternal
 	}
 
-	projectDB, err := s.getProjectBaseUnmarshal(ctx, entry)
+	projectDB, _, err := s.getProjectBaseUnmarshal(ctx, projectUID, entry)
 	if err != nil {
 		return nil, domain.ErrUnmarshal
 	}
@@ -85,12 +116,12 @@ func (s *NatsRepository) GetProjectBaseWithRevision(ctx context.Context, project
 		return nil, 0, domain.ErrInternal
 	}
 
-	projectDB, err := s.getProjectBaseUnmarshal(ctx, entry)
+	projectDB, revision, err := s.getProjectBaseUnmarshal(ctx, projectUID, entry)
 	if err != nil {
 		return nil, 0, domain.ErrUnmarshal
 	}
 
-	return projectDB, entry.Revision(), nil
+	return projectDB, revision, nil
 }
 
 // ProjectExists checks if a project exists in the NATS KV store.
@@ -108,8 +139,16 @@ func (s *NatsRepository) ProjectExists(ctx context.Context, projectUID string) (
 
 // GetProjectUIDFromSlug gets the project UID from the project slug.
 func (s *NatsRepository) GetProjectUIDFromSlug(ctx context.Context, projectSlug string) (projectUID string, err error) {
+	var epoch uint64
+	if s.SlugCache != nil {
+		if uid, ok := s.SlugCache.Get(projectSlug); ok {
+			return uid, nil
+		}
+		epoch = s.SlugCache.Epoch()
+	}
+
 	var entry jetstream.KeyValueEntry
-	entry, err = s.Projects.Get(ctx, fmt.Sprintf("slug/%s", projectSlug))
+	entry, err = s.Projects.Get(ctx, slugKeyPrefix+projectSlug)
 	if err != nil {
 		if errors.Is(err, jetstream.ErrKeyNotFound) {
 			return "", domain.ErrProjectNotFound
@@ -118,7 +157,12 @@ func (s *NatsRepository) GetProjectUIDFromSlug(ctx context.Context, projectSlug
 		return "", domain.ErrInternal
 	}
 
-	return string(entry.Value()), nil
+	projectUID = string(entry.Value())
+	if s.SlugCache != nil {
+		s.SlugCache.Add(projectSlug, projectUID, epoch)
+	}
+
+	return projectUID, nil
 }
 
 // ProjectSlugExists checks if a project slug exists in the NATS KV store.
@@ -135,68 +179,79 @@ func (s *NatsRepository) ProjectSlugExists(ctx context.Context, projectSlug stri
 	return true, nil
 }
 
-// ListAllProjectsBase lists all project base data from the NATS KV stores.
-func (s *NatsRepository) ListAllProjectsBase(ctx context.Context) ([]*models.ProjectBase, error) {
-	keysLister, err := s.Projects.ListKeys(ctx)
-	if err != nil {
-		slog.ErrorContext(ctx, "error listing project keys from NATS KV store", constants.ErrKey, err)
-		return nil, domain.ErrInternal
+// PutSlugRedirect maps slug, the slug of a project merged into the project
+// projectUID, to projectUID. A mapping that already points at the project is
+// kept, so that a retried merge succeeds; one that points at another project
+// fails with domain.ErrProjectSlugExists.
+func (s *NatsRepository) PutSlugRedirect(ctx context.Context, slug, projectUID string) error {
+	key := slugKeyPrefix + slug
+	_, err := s.Projects.Create(ctx, key, []byte(projectUID))
+	if err == nil {
+		if s.SlugCache != nil {
+			s.SlugCache.Set(slug, projectUID)
+		}
+		return nil
+	}
+	if !errors.Is(err, jetstream.ErrKeyExists) {
+		slog.ErrorContext(ctx, "error putting slug redirect into NATS KV store", constants.ErrKey, err)
+		return domain.ErrInternal
 	}
 
-	projectsBase := []*models.ProjectBase{}
-	for key := range keysLister.Keys() {
-		// Skip slug mappings
-		if strings.HasPrefix(key, "slug/") {
-			continue
-		}
+	entry, err := s.Projects.Get(ctx, key)
+	if err == nil && string(entry.Value()) == projectUID {
+		return nil
+	}
+	slog.WarnContext(ctx, "project slug already exists", "slug", slug)
+	return domain.ErrProjectSlugExists
+}
 
-		entry, err := s.getProjectBase(ctx, key)
-		if err != nil {
-			slog.ErrorContext(ctx, "error getting project from NATS KV store", constants.ErrKey, err, "project_uid", key)
-			return nil, domain.ErrInternal
+// GetProjectUIDFromLegacyID gets the project UID from the project's LFX v1 ID.
+func (s *NatsRepository) GetProjectUIDFromLegacyID(ctx context.Context, legacyID string) (string, error) {
+	entry, err := s.Projects.Get(ctx, legacyIDKeyPrefix+legacyID)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) || errors.Is(err, jetstream.ErrInvalidKey) {
+			return "", domain.ErrProjectNotFound
 		}
+		slog.ErrorContext(ctx, "error getting project legacy ID mapping from NATS KV store", constants.ErrKey, err)
+		return "", domain.ErrInternal
+	}
 
-		projectDB, err := s.getProjectBaseUnmarshal(ctx, entry)
-		if err != nil {
-			slog.ErrorContext(ctx, "error unmarshalling project from NATS KV store", constants.ErrKey, err, "project_uid", key)
-			return nil, domain.ErrUnmarshal
-		}
+	return string(entry.Value()), nil
+}
 
-		projectsBase = append(projectsBase, projectDB)
+// ListAllProjectsBase lists all project base data from the NATS KV stores,
+// sorted by UID.
+func (s *NatsRepository) ListAllProjectsBase(ctx context.Context) ([]*models.ProjectBase, error) {
+	projectsBase := []*models.ProjectBase{}
+	err := s.StreamProjectsBase(ctx, func(projectBase *models.ProjectBase) error {
+		projectsBase = append(projectsBase, projectBase)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	slices.SortFunc(projectsBase, func(a, b *models.ProjectBase) int {
+		return strings.Compare(a.UID, b.UID)
+	})
 	return projectsBase, nil
 }
 
-// ListAllProjectsSettings lists all project settings data from the NATS KV stores.
+// ListAllProjectsSettings lists all project settings data from the NATS KV
+// stores, sorted by UID.
 func (s *NatsRepository) ListAllProjectsSettings(ctx context.Context) ([]*models.ProjectSettings, error) {
-	keysLister, err := s.ProjectSettings.ListKeys(ctx)
-	if err != nil {
-		slog.ErrorContext(ctx, "error listing project settings keys from NATS KV store", constants.ErrKey, err)
-		return nil, domain.ErrInternal
-	}
-
 	projectsSettings := []*models.ProjectSettings{}
-	for key := range keysLister.Keys() {
-		if strings.HasPrefix(key, "lookup/") {
-			continue
-		}
-		entry, err := s.ProjectSettings.Get(ctx, key)
-		if err != nil {
-			slog.ErrorContext(ctx, "error getting project settings from NATS KV store", constants.ErrKey, err, "project_uid", key)
-			return nil, domain.ErrInternal
-		}
-
-		projectSettingsDB := &models.ProjectSettings{}
-		err = json.Unmarshal(entry.Value(), projectSettingsDB)
-		if err != nil {
-			slog.ErrorContext(ctx, "error unmarshalling project settings from NATS KV store", constants.ErrKey, err, "project_uid", key)
-			return nil, domain.ErrUnmarshal
-		}
-
-		projectsSettings = append(projectsSettings, projectSettingsDB)
+	err := s.StreamProjectsSettings(ctx, func(projectSettings *models.ProjectSettings) error {
+		projectsSettings = append(projectsSettings, projectSettings)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	slices.SortFunc(projectsSettings, func(a, b *models.ProjectSettings) int {
+		return strings.Compare(a.UID, b.UID)
+	})
 	return projectsSettings, nil
 }
 
@@ -216,16 +271,19 @@ func (s *NatsRepository) ListAllProjects(ctx context.Context) ([]*models.Project
 }
 
 func (s *NatsRepository) putProjectSlugMapping(ctx context.Context, projectBase *models.ProjectBase) (uint64, error) {
-	revision, err := s.Projects.Put(ctx, fmt.Sprintf("slug/%s", projectBase.Slug), []byte(projectBase.UID))
+	revision, err := s.Projects.Put(ctx, slugKeyPrefix+projectBase.Slug, []byte(projectBase.UID))
 	if err != nil {
 		return 0, err
 	}
+	if s.SlugCache != nil {
+		s.SlugCache.Set(projectBase.Slug, projectBase.UID)
+	}
 
 	return revision, nil
 }
 
 func (s *NatsRepository) putProjectBase(ctx context.Context, projectBase *models.ProjectBase) (uint64, error) {
-	projectBaseBytes, err := json.Marshal(projectBase)
+	projectBaseBytes, err := encodeProjectBase(projectBase)
 	if err != nil {
 		slog.ErrorContext(ctx, "error marshalling project into JSON", constants.ErrKey, err)
 		return 0, err
@@ -241,7 +299,7 @@ func (s *NatsRepository) putProjectBase(ctx context.Context, projectBase *models
 }
 
 func (s *NatsRepository) putProjectSettings(ctx context.Context, projectSettings *models.ProjectSettings) (uint64, error) {
-	projectSettingsBytes, err := json.Marshal(projectSettings)
+	projectSettingsBytes, err := encodeProjectSettings(projectSettings)
 	if err != nil {
 		slog.ErrorContext(ctx, "error marshalling project settings into JSON", constants.ErrKey, err)
 		return 0, err
@@ -256,11 +314,63 @@ func (s *NatsRepository) putProjectSettings(ctx context.Context, projectSettings
 	return revision, nil
 }
 
+// createProjectSlugMapping creates the slug mapping of a new project, failing with
+// jetstream.ErrKeyExists if the slug is already taken.
+func (s *NatsRepository) createProjectSlugMapping(ctx context.Context, projectBase *models.ProjectBase) error {
+	_, err := s.Projects.Create(ctx, slugKeyPrefix+projectBase.Slug, []byte(projectBase.UID))
+	if err != nil {
+		return err
+	}
+	if s.SlugCache != nil {
+		s.SlugCache.Set(projectBase.Slug, projectBase.UID)
+	}
+
+	return nil
+}
+
+// createProjectLegacyIDMapping maps the legacy ID of projectBase to its UID. A
+// mapping that already points at the project is kept, so that a retried write
+// succeeds; one that points at another project fails with
+// domain.ErrProjectLegacyIDExists.
+func (s *NatsRepository) createProjectLegacyIDMapping(ctx context.Context, projectBase *models.ProjectBase) error {
+	key := legacyIDKeyPrefix + projectBase.LegacyID
+	_, err := s.Projects.Create(ctx, key, []byte(projectBase.UID))
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, jetstream.ErrKeyExists) {
+		slog.ErrorContext(ctx, "error putting project legacy ID mapping into NATS KV store", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	entry, err := s.Projects.Get(ctx, key)
+	if err == nil && string(entry.Value()) == projectBase.UID {
+		return nil
+	}
+	slog.WarnContext(ctx, "project legacy ID already exists", "legacy_id", projectBase.LegacyID)
+	return domain.ErrProjectLegacyIDExists
+}
+
+func (s *NatsRepository) deleteProjectLegacyIDMapping(ctx context.Context, legacyID string) error {
+	err := s.Projects.Delete(ctx, legacyIDKeyPrefix+legacyID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error deleting legacy ID mapping from NATS KV store", constants.ErrKey, err)
+		return err
+	}
+
+	return nil
+}
+
 // CreateProject creates a new project in the NATS KV stores.
+//
+// The slug mapping, legacy ID mapping, project base and project settings are
+// written one after the other. If a later write fails, the earlier ones are deleted again so that a
+// failed create leaves no partial project behind; anything the cleanup itself
+// cannot remove is repaired by [NatsRepository.CheckConsistency].
 func (s *NatsRepository) CreateProject(ctx context.Context, projectBase *models.ProjectBase, projectSettings *models.ProjectSettings) error {
 
 	// Create slug mapping first
-	_, err := s.putProjectSlugMapping(ctx, projectBase)
+	err := s.createProjectSlugMapping(ctx, projectBase)
 	if err != nil {
 		if errors.Is(err, jetstream.ErrKeyExists) {
 			slog.WarnContext(ctx, "project slug already exists", constants.ErrKey, err)
@@ -270,9 +380,20 @@ func (s *NatsRepository) CreateProject(ctx context.Context, projectBase *models.
 		return err
 	}
 
+	// Map the legacy ID, if any
+	legacyIDMapped := false
+	if projectBase.LegacyID != "" {
+		if err := s.createProjectLegacyIDMapping(ctx, projectBase); err != nil {
+			s.compensateCreateProject(ctx, projectBase, 0, false)
+			return err
+		}
+		legacyIDMapped = true
+	}
+
 	// Store the project base data
-	_, err = s.putProjectBase(ctx, projectBase)
+	baseRevision, err := s.putProjectBase(ctx, projectBase)
 	if err != nil {
+		s.compensateCreateProject(ctx, projectBase, 0, legacyIDMapped)
 		return domain.ErrInternal
 	}
 
@@ -280,6 +401,7 @@ func (s *NatsRepository) CreateProject(ctx context.Context, projectBase *models.
 	if projectSettings != nil {
 		_, err = s.putProjectSettings(ctx, projectSettings)
 		if err != nil {
+			s.compensateCreateProject(ctx, projectBase, baseRevision, legacyIDMapped)
 			return domain.ErrInternal
 		}
 	}
@@ -287,8 +409,31 @@ func (s *NatsRepository) CreateProject(ctx context.Context, projectBase *models.
 	return nil
 }
 
+// compensateCreateProject removes what a failed CreateProject wrote: the project
+// base, when baseRevision is set, the legacy ID mapping, when legacyIDMapped is
+// set, and the slug mapping. It runs even if the request context is cancelled,
+// and only logs failures.
+func (s *NatsRepository) compensateCreateProject(ctx context.Context, projectBase *models.ProjectBase, baseRevision uint64, legacyIDMapped bool) {
+	ctx = context.WithoutCancel(ctx)
+	slog.WarnContext(ctx, "rolling back partially created project", "project_uid", projectBase.UID)
+
+	if baseRevision != 0 {
+		if err := s.deleteProjectBase(ctx, projectBase.UID, baseRevision); err != nil {
+			slog.ErrorContext(ctx, "error rolling back project base, left for the consistency check", constants.ErrKey, err, "project_uid", projectBase.UID)
+		}
+	}
+	if legacyIDMapped {
+		if err := s.deleteProjectLegacyIDMapping(ctx, projectBase.LegacyID); err != nil {
+			slog.ErrorContext(ctx, "error rolling back project legacy ID mapping, left for the consistency check", constants.ErrKey, err, "project_uid", projectBase.UID)
+		}
+	}
+	if err := s.deleteProjectSlugMapping(ctx, projectBase.Slug); err != nil {
+		slog.ErrorContext(ctx, "error rolling back project slug mapping, left for the consistency check", constants.ErrKey, err, "project_uid", projectBase.UID)
+	}
+}
+
 func (s *NatsRepository) updateProjectBase(ctx context.Context, projectBase *models.ProjectBase, revision uint64) error {
-	projectBaseBytes, err := json.Marshal(projectBase)
+	projectBaseBytes, err := encodeProjectBase(projectBase)
 	if err != nil {
 		slog.ErrorContext(ctx, "error marshalling project into JSON", constants.ErrKey, err)
 		return err
@@ -311,6 +456,21 @@ func (s *NatsRepository) UpdateProjectBase(ctx context.Context, projectBase *mod
 		return err
 	}
 
+	// If the legacy ID is changing, map the new one before unmapping the old one,
+	// so that a conflicting legacy ID is rejected before anything is changed
+	if existingProject.LegacyID != projectBase.LegacyID {
+		if projectBase.LegacyID != "" {
+			if err := s.createProjectLegacyIDMapping(ctx, projectBase); err != nil {
+				return err
+			}
+		}
+		if existingProject.LegacyID != "" {
+			if err := s.deleteProjectLegacyIDMapping(ctx, existingProject.LegacyID); err != nil {
+				return domain.ErrInternal
+			}
+		}
+	}
+
 	// If the slug is changing, update the slug mapping
 	if existingProject.Slug != projectBase.Slug {
 		// Delete the old slug mapping
@@ -349,15 +509,17 @@ func (s *NatsRepository) getProjectSettings(ctx context.Context, projectUID stri
 	return entry, nil
 }
 
-func (s *NatsRepository) getProjectSettingsUnmarshal(ctx context.Context, entry jetstream.KeyValueEntry) (*models.ProjectSettings, error) {
-	projectSettingsDB := models.ProjectSettings{}
-	err := json.Unmarshal(entry.Value(), &projectSettingsDB)
+// getProjectSettingsUnmarshal decodes stored project settings, writing them
+// back when they were upgraded to the current schema version, and returns
+// them with the revision they are now stored at.
+func (s *NatsRepository) getProjectSettingsUnmarshal(ctx context.Context, projectUID string, entry jetstream.KeyValueEntry) (*models.ProjectSettings, uint64, error) {
+	projectSettingsDB, upgraded, err := decodeProjectSettings(entry.Value())
 	if err != nil {
 		slog.ErrorContext(ctx, "error unmarshalling project settings from NATS KV store", constants.ErrKey, err)
-		return nil, err
+		return nil, 0, err
 	}
 
-	return &projectSettingsDB, nil
+	return projectSettingsDB, writeBackUpgrade(ctx, s.ProjectSettings, projectUID, upgraded, entry.Revision()), nil
 }
 
 // GetProjectSettings gets the project settings from the NATS KV store.
@@ -367,7 +529,12 @@ func (s *NatsRepository) GetProjectSettings(ctx context.Context, projectUID stri
 		return nil, err
 	}
 
-	return s.getProjectSettingsUnmarshal(ctx, entry)
+	projectSettingsDB, _, err := s.getProjectSettingsUnmarshal(ctx, projectUID, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return projectSettingsDB, nil
 }
 
 // GetProjectSettingsWithRevision gets the project settings from the NATS KV store along with its revision.
@@ -378,16 +545,16 @@ func (s *NatsRepository) GetProjectSettingsWithRevision(ctx context.Context, pro
 	}
 	slog.InfoContext(ctx, "GetProjectSettingsWithRevision", "revision", entry.Revision())
 
-	projectSettingsDB, err := s.getProjectSettingsUnmarshal(ctx, entry)
+	projectSettingsDB, revision, err := s.getProjectSettingsUnmarshal(ctx, projectUID, entry)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	return projectSettingsDB, entry.Revision(), nil
+	return projectSettingsDB, revision, nil
 }
 
 func (s *NatsRepository) updateProjectSettings(ctx context.Context, projectSettings *models.ProjectSettings, revision uint64) error {
-	projectSettingsBytes, err := json.Marshal(projectSettings)
+	projectSettingsBytes, err := encodeProjectSettings(projectSettings)
 	if err != nil {
 		slog.ErrorContext(ctx, "error marshalling project settings into JSON", constants.ErrKey, err)
 		return err
@@ -417,8 +584,143 @@ func (s *NatsRepository) UpdateProjectSettings(ctx context.Context, projectSetti
 	return nil
 }
 
+// documentCodec converts between a model and its stored form. decode also
+// returns the document upgraded to the current schema version, if any.
+type documentCodec[T any] struct {
+	decode func([]byte) (*T, []byte, error)
+	encode func(*T) ([]byte, error)
+}
+
+var (
+	projectBaseCodec     = documentCodec[models.ProjectBase]{decode: decodeProjectBase, encode: encodeProjectBase}
+	projectSettingsCodec = documentCodec[models.ProjectSettings]{decode: decodeProjectSettings, encode: encodeProjectSettings}
+)
+
+// writeBackUpgrade stores a document that was upgraded on read, so that each
+// document is migrated once, and returns the revision it is now stored at.
+// Nothing is written when upgraded is nil. A concurrent write wins over the
+// upgrade, since the writer stores the current schema version itself.
+func writeBackUpgrade(ctx context.Context, kv INatsKeyValue, key string, upgraded []byte, revision uint64) uint64 {
+	if upgraded == nil {
+		return revision
+	}
+	newRevision, err := kv.Update(ctx, key, upgraded, revision)
+	if err != nil {
+		slog.WarnContext(ctx, "error writing back upgraded document to NATS KV store", constants.ErrKey, err, "key", key)
+		return revision
+	}
+	slog.InfoContext(ctx, "upgraded stored document to the current schema version", "key", key, "revision", newRevision)
+	return newRevision
+}
+
+// maxUpdateAttempts bounds the number of read-modify-write cycles performed by
+// updateWithRetry before giving up with domain.ErrRevisionMismatch.
+const maxUpdateAttempts = 5
+
+// updateWithRetry performs an optimistic-concurrency read-modify-write on a JSON
+// value stored under key. It reads the current entry, hands the decoded value to
+// modify, and writes the result back with a compare-and-swap on the revision that
+// was read. When the write fails with "wrong last sequence" (an incidental
+// concurrent write), the whole cycle is retried against the fresh value, up to
+// maxUpdateAttempts times. Errors returned by modify abort the update unchanged.
+func updateWithRetry[T any](ctx context.Context, kv INatsKeyValue, key string, codec documentCodec[T], modify func(*T) error) (*T, uint64, error) {
+	for attempt := 1; attempt <= maxUpdateAttempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, 0, err
+		}
+
+		entry, err := kv.Get(ctx, key)
+		if err != nil {
+			if errors.Is(err, jetstream.ErrKeyNotFound) {
+				return nil, 0, domain.ErrProjectNotFound
+			}
+			slog.ErrorContext(ctx, "error getting value from NATS KV store", constants.ErrKey, err, "key", key)
+			return nil, 0, domain.ErrInternal
+		}
+
+		value, _, err := codec.decode(entry.Value())
+		if err != nil {
+			slog.ErrorContext(ctx, "error unmarshalling value from NATS KV store", constants.ErrKey, err, "key", key)
+			return nil, 0, domain.ErrUnmarshal
+		}
+
+		if err := modify(value); err != nil {
+			return nil, 0, err
+		}
+
+		valueBytes, err := codec.encode(value)
+		if err != nil {
+			slog.ErrorContext(ctx, "error marshalling value into JSON", constants.ErrKey, err, "key", key)
+			return nil, 0, domain.ErrInternal
+		}
+
+		revision, err := kv.Update(ctx, key, valueBytes, entry.Revision())
+		if err != nil {
+			if strings.Contains(err.Error(), "wrong last sequence") {
+				slog.DebugContext(ctx, "revision mismatch, retrying update", "key", key, "attempt", attempt)
+				continue
+			}
+			slog.ErrorContext(ctx, "error updating value in NATS KV store", constants.ErrKey, err, "key", key)
+			return nil, 0, domain.ErrInternal
+		}
+
+		return value, revision, nil
+	}
+
+	slog.WarnContext(ctx, "giving up update after repeated revision mismatches", "key", key, "attempts", maxUpdateAttempts)
+	return nil, 0, domain.ErrRevisionMismatch
+}
+
+// UpdateProjectBaseWithRetry applies modify to the latest stored project base and
+// writes it back, retrying on concurrent writes. It is intended for server-initiated
+// updates that should not fail because of an unrelated write racing with them.
+// The modifier must not change the project UID, slug or legacy ID, since their
+// mappings are not maintained on this path.
+func (s *NatsRepository) UpdateProjectBaseWithRetry(ctx context.Context, projectUID string, modify func(*models.ProjectBase) error) (*models.ProjectBase, error) {
+	projectBase, _, err := updateWithRetry(ctx, s.Projects, projectUID, projectBaseCodec, func(p *models.ProjectBase) error {
+		uid, slug, legacyID := p.UID, p.Slug, p.LegacyID
+		if err := modify(p); err != nil {
+			return err
+		}
+		if p.UID != uid || p.Slug != slug || p.LegacyID != legacyID {
+			slog.ErrorContext(ctx, "project UID, slug or legacy ID changed in retrying update", "project_uid", projectUID)
+			return domain.ErrValidationFailed
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return projectBase, nil
+}
+
+// UpdateProjectSettingsWithRetry applies modify to the latest stored project settings
+// and writes them back, retrying on concurrent writes.
+func (s *NatsRepository) UpdateProjectSettingsWithRetry(ctx context.Context, projectUID string, modify func(*models.ProjectSettings) error) (*models.ProjectSettings, error) {
+	projectSettings, _, err := updateWithRetry(ctx, s.ProjectSettings, projectUID, projectSettingsCodec, func(p *models.ProjectSettings) error {
+		uid := p.UID
+		if err := modify(p); err != nil {
+			return err
+		}
+		if p.UID != uid {
+			slog.ErrorContext(ctx, "project settings UID changed in retrying update", "project_uid", projectUID)
+			return domain.ErrValidationFailed
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return projectSettings, nil
+}
+
 func (s *NatsRepository) deleteProjectSlugMapping(ctx context.Context, projectSlug string) error {
-	err := s.Projects.Delete(ctx, fmt.Sprintf("slug/%s", projectSlug))
+	if s.SlugCache != nil {
+		defer s.SlugCache.Remove(projectSlug)
+	}
+	err := s.Projects.Delete(ctx, slugKeyPrefix+projectSlug)
 	if err != nil {
 		slog.ErrorContext(ctx, "error deleting slug mapping from NATS KV store", constants.ErrKey, err)
 		return err
@@ -471,6 +773,21 @@ func (s *NatsRepository) DeleteProject(ctx context.Context, projectUID string, r
 		return domain.ErrInternal
 	}
 
+	// Delete the redirects of the slugs of merged projects
+	for _, merged := range project.MergedFrom {
+		if err := s.deleteProjectSlugMapping(ctx, merged.Slug); err != nil {
+			slog.WarnContext(ctx, "error deleting slug redirect, left for the consistency check", constants.ErrKey, err, "slug", merged.Slug)
+		}
+	}
+
+	// Delete the legacy ID mapping
+	if project.LegacyID != "" {
+		err = s.deleteProjectLegacyIDMapping(ctx, project.LegacyID)
+		if err != nil {
+			return domain.ErrInternal
+		}
+	}
+
 	// Delete the project settings (if they exist)
 	err = s.deleteProjectSettings(ctx, projectUID)
 	if err != nil {
@@ -257,8 +257,8 @@ func TestNatsRepository_CreateProject(t *testing.T) {
 		{
 			name: "successful project creation",
 			setupMocks: func(mockProjectsKV, mockSettingsKV *MockKeyValue) {
-				// Put slug mapping
-				mockProjectsKV.On("Put", mock.Anything, "slug/test-project", []byte("test-project-uid")).Return(uint64(1), nil)
+				// Create slug mapping
+				mockProjectsKV.On("Create", mock.Anything, "slug/test-project", []byte("test-project-uid")).Return(uint64(1), nil)
 				// Put project base
 				mockProjectsKV.On("Put", mock.Anything, "test-project-uid", mock.Anything).Return(uint64(1), nil)
 				// Put project settings
@@ -269,19 +269,45 @@ func TestNatsRepository_CreateProject(t *testing.T) {
 		{
 			name: "slug already exists",
 			setupMocks: func(mockProjectsKV, mockSettingsKV *MockKeyValue) {
-				// Slug mapping Put call fails with ErrKeyExists
-				mockProjectsKV.On("Put", mock.Anything, "slug/test-project", []byte("test-project-uid")).Return(uint64(0), jetstream.ErrKeyExists)
+				// Slug mapping Create call fails with ErrKeyExists
+				mockProjectsKV.On("Create", mock.Anything, "slug/test-project", []byte("test-project-uid")).Return(uint64(0), jetstream.ErrKeyExists)
 			},
 			wantErr:     true,
 			expectedErr: domain.ErrProjectSlugExists,
 		},
 		{
-			name: "error putting project base",
+			name: "error putting project base rolls back slug mapping",
 			setupMocks: func(mockProjectsKV, mockSettingsKV *MockKeyValue) {
-				// Put slug mapping succeeds
-				mockProjectsKV.On("Put", mock.Anything, "slug/test-project", []byte("test-project-uid")).Return(uint64(1), nil)
+				// Create slug mapping succeeds
+				mockProjectsKV.On("Create", mock.Anything, "slug/test-project", []byte("test-project-uid")).Return(uint64(1), nil)
 				// Put project base fails
 				mockProjectsKV.On("Put", mock.Anything, "test-project-uid", mock.Anything).Return(uint64(0), errors.New("nats error"))
+				// Slug mapping is deleted again
+				mockProjectsKV.On("Delete", mock.Anything, "slug/test-project").Return(nil)
+			},
+			wantErr:     true,
+			expectedErr: domain.ErrInternal,
+		},
+		{
+			name: "error putting project settings rolls back base and slug mapping",
+			setupMocks: func(mockProjectsKV, mockSettingsKV *MockKeyValue) {
+				mockProjectsKV.On("Create", mock.Anything, "slug/test-project", []byte("test-project-uid")).Return(uint64(1), nil)
+				mockProjectsKV.On("Put", mock.Anything, "test-project-uid", mock.Anything).Return(uint64(2), nil)
+				// Put project settings fails
+				mockSettingsKV.On("Put", mock.Anything, "test-project-uid", mock.Anything).Return(uint64(0), errors.New("nats error"))
+				// Project base and slug mapping are deleted again
+				mockProjectsKV.On("Delete", mock.Anything, "test-project-uid", mock.Anything).Return(nil)
+				mockProjectsKV.On("Delete", mock.Anything, "slug/test-project").Return(nil)
+			},
+			wantErr:     true,
+			expectedErr: domain.ErrInternal,
+		},
+		{
+			name: "failed rollback still returns the create error",
+			setupMocks: func(mockProjectsKV, mockSettingsKV *MockKeyValue) {
+				mockProjectsKV.On("Create", mock.Anything, "slug/test-project", []byte("test-project-uid")).Return(uint64(1), nil)
+				mockProjectsKV.On("Put", mock.Anything, "test-project-uid", mock.Anything).Return(uint64(0), errors.New("nats error"))
+				mockProjectsKV.On("Delete", mock.Anything, "slug/test-project").Return(errors.New("nats: timeout"))
 			},
 			wantErr:     true,
 			expectedErr: domain.ErrInternal,
@@ -314,6 +340,265 @@ func TestNatsRepository_CreateProject(t *testing.T) {
 	}
 }
 
+func TestNatsRepository_CreateProject_legacyID(t *testing.T) {
+	projectBase := &models.ProjectBase{
+		UID:      "test-project-uid",
+		Slug:     "test-project",
+		LegacyID: "a0941000002wBz9AAE",
+	}
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*MockKeyValue)
+		expectedErr error
+	}{
+		{
+			name: "legacy ID is mapped",
+			setupMocks: func(mockProjectsKV *MockKeyValue) {
+				mockProjectsKV.On("Create", mock.Anything, "slug/test-project", []byte("test-project-uid")).Return(uint64(1), nil)
+				mockProjectsKV.On("Create", mock.Anything, "legacy/a0941000002wBz9AAE", []byte("test-project-uid")).Return(uint64(2), nil)
+				mockProjectsKV.On("Put", mock.Anything, "test-project-uid", mock.Anything).Return(uint64(3), nil)
+			},
+		},
+		{
+			name: "legacy ID of another project rolls back slug mapping",
+			setupMocks: func(mockProjectsKV *MockKeyValue) {
+				mockProjectsKV.On("Create", mock.Anything, "slug/test-project", []byte("test-project-uid")).Return(uint64(1), nil)
+				mockProjectsKV.On("Create", mock.Anything, "legacy/a0941000002wBz9AAE", []byte("test-project-uid")).Return(uint64(0), jetstream.ErrKeyExists)
+				mockProjectsKV.On("Get", mock.Anything, "legacy/a0941000002wBz9AAE").Return(NewMockKeyValueEntry([]byte("other-project-uid"), 1), nil)
+				mockProjectsKV.On("Delete", mock.Anything, "slug/test-project").Return(nil)
+			},
+			expectedErr: domain.ErrProjectLegacyIDExists,
+		},
+		{
+			name: "error putting project base rolls back both mappings",
+			setupMocks: func(mockProjectsKV *MockKeyValue) {
+				mockProjectsKV.On("Create", mock.Anything, "slug/test-project", []byte("test-project-uid")).Return(uint64(1), nil)
+				mockProjectsKV.On("Create", mock.Anything, "legacy/a0941000002wBz9AAE", []byte("test-project-uid")).Return(uint64(2), nil)
+				mockProjectsKV.On("Put", mock.Anything, "test-project-uid", mock.Anything).Return(uint64(0), errors.New("nats error"))
+				mockProjectsKV.On("Delete", mock.Anything, "legacy/a0941000002wBz9AAE").Return(nil)
+				mockProjectsKV.On("Delete", mock.Anything, "slug/test-project").Return(nil)
+			},
+			expectedErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockProjectsKV := &MockKeyValue{}
+			tt.setupMocks(mockProjectsKV)
+			repo := NewNatsRepository(mockProjectsKV, &MockKeyValue{})
+
+			err := repo.CreateProject(context.Background(), projectBase, nil)
+
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockProjectsKV.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNatsRepository_UpdateProjectBase_legacyID(t *testing.T) {
+	existing := func(legacyID string) []byte {
+		data, _ := json.Marshal(models.ProjectBase{UID: "test-project-uid", Slug: "test-project", LegacyID: legacyID})
+		return data
+	}
+
+	tests := []struct {
+		name        string
+		oldLegacyID string
+		newLegacyID string
+		setupMocks  func(*MockKeyValue)
+		expectedErr error
+	}{
+		{
+			name:        "unchanged legacy ID is left alone",
+			oldLegacyID: "a0941000002wBz9AAE",
+			newLegacyID: "a0941000002wBz9AAE",
+			setupMocks: func(mockProjectsKV *MockKeyValue) {
+				mockProjectsKV.On("Update", mock.Anything, "test-project-uid", mock.Anything, uint64(5)).Return(uint64(6), nil)
+			},
+		},
+		{
+			name:        "changed legacy ID is remapped",
+			oldLegacyID: "a0941000002wBz9AAE",
+			newLegacyID: "a0941000003xYz1AAA",
+			setupMocks: func(mockProjectsKV *MockKeyValue) {
+				mockProjectsKV.On("Create", mock.Anything, "legacy/a0941000003xYz1AAA", []byte("test-project-uid")).Return(uint64(6), nil)
+				mockProjectsKV.On("Delete", mock.Anything, "legacy/a0941000002wBz9AAE").Return(nil)
+				mockProjectsKV.On("Update", mock.Anything, "test-project-uid", mock.Anything, uint64(5)).Return(uint64(7), nil)
+			},
+		},
+		{
+			name:        "cleared legacy ID is unmapped",
+			oldLegacyID: "a0941000002wBz9AAE",
+			setupMocks: func(mockProjectsKV *MockKeyValue) {
+				mockProjectsKV.On("Delete", mock.Anything, "legacy/a0941000002wBz9AAE").Return(nil)
+				mockProjectsKV.On("Update", mock.Anything, "test-project-uid", mock.Anything, uint64(5)).Return(uint64(6), nil)
+			},
+		},
+		{
+			name:        "retried remap keeps the mapping it already wrote",
+			newLegacyID: "a0941000003xYz1AAA",
+			setupMocks: func(mockProjectsKV *MockKeyValue) {
+				mockProjectsKV.On("Create", mock.Anything, "legacy/a0941000003xYz1AAA", []byte("test-project-uid")).Return(uint64(0), jetstream.ErrKeyExists)
+				mockProjectsKV.On("Get", mock.Anything, "legacy/a0941000003xYz1AAA").Return(NewMockKeyValueEntry([]byte("test-project-uid"), 4), nil)
+				mockProjectsKV.On("Update", mock.Anything, "test-project-uid", mock.Anything, uint64(5)).Return(uint64(6), nil)
+			},
+		},
+		{
+			name:        "legacy ID of another project is rejected",
+			newLegacyID: "a0941000003xYz1AAA",
+			setupMocks: func(mockProjectsKV *MockKeyValue) {
+				mockProjectsKV.On("Create", mock.Anything, "legacy/a0941000003xYz1AAA", []byte("test-project-uid")).Return(uint64(0), jetstream.ErrKeyExists)
+				mockProjectsKV.On("Get", mock.Anything, "legacy/a0941000003xYz1AAA").Return(NewMockKeyValueEntry([]byte("other-project-uid"), 4), nil)
+			},
+			expectedErr: domain.ErrProjectLegacyIDExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockProjectsKV := &MockKeyValue{}
+			mockProjectsKV.On("Get", mock.Anything, "test-project-uid").Return(NewMockKeyValueEntry(existing(tt.oldLegacyID), 5), nil)
+			tt.setupMocks(mockProjectsKV)
+			repo := NewNatsRepository(mockProjectsKV, &MockKeyValue{})
+
+			err := repo.UpdateProjectBase(context.Background(), &models.ProjectBase{
+				UID:      "test-project-uid",
+				Slug:     "test-project",
+				LegacyID: tt.newLegacyID,
+			}, 5)
+
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockProjectsKV.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNatsRepository_UpdateProjectBaseWithRetry(t *testing.T) {
+	projectBase := &models.ProjectBase{
+		UID:   "test-project-uid",
+		Slug:  "test-project",
+		Name:  "Test Project",
+		Stage: "Active",
+	}
+	projectData, _ := json.Marshal(projectBase)
+	wrongSequenceErr := errors.New("nats: wrong last sequence: 4")
+
+	setStage := func(p *models.ProjectBase) error {
+		p.Stage = "Archived"
+		return nil
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(*models.ProjectBase) error
+		setupMocks  func(*MockKeyValue)
+		wantStage   string
+		expectedErr error
+	}{
+		{
+			name:   "update succeeds on first attempt",
+			modify: setStage,
+			setupMocks: func(mockKV *MockKeyValue) {
+				mockKV.On("Get", mock.Anything, "test-project-uid").Return(NewMockKeyValueEntry(projectData, 3), nil).Once()
+				mockKV.On("Update", mock.Anything, "test-project-uid", mock.Anything, uint64(3)).Return(uint64(4), nil).Once()
+			},
+			wantStage: "Archived",
+		},
+		{
+			name:   "update retries after concurrent write",
+			modify: setStage,
+			setupMocks: func(mockKV *MockKeyValue) {
+				mockKV.On("Get", mock.Anything, "test-project-uid").Return(NewMockKeyValueEntry(projectData, 3), nil).Once()
+				mockKV.On("Update", mock.Anything, "test-project-uid", mock.Anything, uint64(3)).Return(uint64(0), wrongSequenceErr).Once()
+				mockKV.On("Get", mock.Anything, "test-project-uid").Return(NewMockKeyValueEntry(projectData, 4), nil).Once()
+				mockKV.On("Update", mock.Anything, "test-project-uid", mock.Anything, uint64(4)).Return(uint64(5), nil).Once()
+			},
+			wantStage: "Archived",
+		},
+		{
+			name:   "gives up after max attempts",
+			modify: setStage,
+			setupMocks: func(mockKV *MockKeyValue) {
+				mockKV.On("Get", mock.Anything, "test-project-uid").Return(NewMockKeyValueEntry(projectData, 3), nil).Times(maxUpdateAttempts)
+				mockKV.On("Update", mock.Anything, "test-project-uid", mock.Anything, uint64(3)).Return(uint64(0), wrongSequenceErr).Times(maxUpdateAttempts)
+			},
+			expectedErr: domain.ErrRevisionMismatch,
+		},
+		{
+			name: "modifier error aborts the update",
+			modify: func(_ *models.ProjectBase) error {
+				return domain.ErrValidationFailed
+			},
+			setupMocks: func(mockKV *MockKeyValue) {
+				mockKV.On("Get", mock.Anything, "test-project-uid").Return(NewMockKeyValueEntry(projectData, 3), nil).Once()
+			},
+			expectedErr: domain.ErrValidationFailed,
+		},
+		{
+			name: "modifier changing the slug is rejected",
+			modify: func(p *models.ProjectBase) error {
+				p.Slug = "other-slug"
+				return nil
+			},
+			setupMocks: func(mockKV *MockKeyValue) {
+				mockKV.On("Get", mock.Anything, "test-project-uid").Return(NewMockKeyValueEntry(projectData, 3), nil).Once()
+			},
+			expectedErr: domain.ErrValidationFailed,
+		},
+		{
+			name:   "project not found",
+			modify: setStage,
+			setupMocks: func(mockKV *MockKeyValue) {
+				mockKV.On("Get", mock.Anything, "test-project-uid").Return(nil, jetstream.ErrKeyNotFound).Once()
+			},
+			expectedErr: domain.ErrProjectNotFound,
+		},
+		{
+			name:   "non-conflict update error",
+			modify: setStage,
+			setupMocks: func(mockKV *MockKeyValue) {
+				mockKV.On("Get", mock.Anything, "test-project-uid").Return(NewMockKeyValueEntry(projectData, 3), nil).Once()
+				mockKV.On("Update", mock.Anything, "test-project-uid", mock.Anything, uint64(3)).Return(uint64(0), errors.New("nats error")).Once()
+			},
+			expectedErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockProjectsKV := &MockKeyValue{}
+			mockSettingsKV := &MockKeyValue{}
+
+			tt.setupMocks(mockProjectsKV)
+
+			repo := NewNatsRepository(mockProjectsKV, mockSettingsKV)
+
+			result, err := repo.UpdateProjectBaseWithRetry(context.Background(), "test-project-uid", tt.modify)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantStage, result.Stage)
+				assert.Equal(t, projectBase.Slug, result.Slug)
+			}
+
+			mockProjectsKV.AssertExpectations(t)
+		})
+	}
+}
+
 func TestNatsRepository_ProjectExists(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -443,6 +728,118 @@ func TestNatsRepository_GetProjectUIDFromSlug(t *testing.T) {
 	}
 }
 
+func TestNatsRepository_PutSlugRedirect(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupMocks  func(*MockKeyValue)
+		expectedErr error
+	}{
+		{
+			name: "slug is mapped to the project",
+			setupMocks: func(mockKV *MockKeyValue) {
+				mockKV.On("Create", mock.Anything, "slug/merged-project", []byte("target-uid")).Return(uint64(1), nil)
+			},
+		},
+		{
+			name: "slug already mapped to the project",
+			setupMocks: func(mockKV *MockKeyValue) {
+				mockKV.On("Create", mock.Anything, "slug/merged-project", []byte("target-uid")).Return(uint64(0), jetstream.ErrKeyExists)
+				mockKV.On("Get", mock.Anything, "slug/merged-project").Return(&MockKeyValueEntry{value: []byte("target-uid")}, nil)
+			},
+		},
+		{
+			name: "slug mapped to another project",
+			setupMocks: func(mockKV *MockKeyValue) {
+				mockKV.On("Create", mock.Anything, "slug/merged-project", []byte("target-uid")).Return(uint64(0), jetstream.ErrKeyExists)
+				mockKV.On("Get", mock.Anything, "slug/merged-project").Return(&MockKeyValueEntry{value: []byte("other-uid")}, nil)
+			},
+			expectedErr: domain.ErrProjectSlugExists,
+		},
+		{
+			name: "key-value store error",
+			setupMocks: func(mockKV *MockKeyValue) {
+				mockKV.On("Create", mock.Anything, "slug/merged-project", []byte("target-uid")).Return(uint64(0), errors.New("nats connection error"))
+			},
+			expectedErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockProjectsKV := &MockKeyValue{}
+			tt.setupMocks(mockProjectsKV)
+
+			repo := NewNatsRepository(mockProjectsKV, &MockKeyValue{})
+
+			err := repo.PutSlugRedirect(context.Background(), "merged-project", "target-uid")
+			assert.Equal(t, tt.expectedErr, err)
+			mockProjectsKV.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNatsRepository_GetProjectUIDFromLegacyID(t *testing.T) {
+	tests := []struct {
+		name        string
+		legacyID    string
+		setupMocks  func(*MockKeyValue)
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:     "legacy ID is resolved",
+			legacyID: "a0941000002wBz9AAE",
+			setupMocks: func(mockKV *MockKeyValue) {
+				mockKV.On("Get", mock.Anything, "legacy/a0941000002wBz9AAE").Return(NewMockKeyValueEntry([]byte("test-project-uid"), 1), nil)
+			},
+			expected: "test-project-uid",
+		},
+		{
+			name:     "legacy ID not found",
+			legacyID: "a0941000002wBz9AAE",
+			setupMocks: func(mockKV *MockKeyValue) {
+				mockKV.On("Get", mock.Anything, "legacy/a0941000002wBz9AAE").Return(nil, jetstream.ErrKeyNotFound)
+			},
+			expectedErr: domain.ErrProjectNotFound,
+		},
+		{
+			name:     "invalid legacy ID is not found",
+			legacyID: "a b",
+			setupMocks: func(mockKV *MockKeyValue) {
+				mockKV.On("Get", mock.Anything, "legacy/a b").Return(nil, jetstream.ErrInvalidKey)
+			},
+			expectedErr: domain.ErrProjectNotFound,
+		},
+		{
+			name:     "key-value store error",
+			legacyID: "a0941000002wBz9AAE",
+			setupMocks: func(mockKV *MockKeyValue) {
+				mockKV.On("Get", mock.Anything, "legacy/a0941000002wBz9AAE").Return(nil, errors.New("nats connection error"))
+			},
+			expectedErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockProjectsKV := &MockKeyValue{}
+			tt.setupMocks(mockProjectsKV)
+			repo := NewNatsRepository(mockProjectsKV, &MockKeyValue{})
+
+			uid, err := repo.GetProjectUIDFromLegacyID(context.Background(), tt.legacyID)
+
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				assert.Empty(t, uid)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, uid)
+			}
+			mockProjectsKV.AssertExpectations(t)
+		})
+	}
+}
+
 func TestNatsRepository_ListAllProjects(t *testing.T) {
 	now := time.Now()
 
@@ -0,0 +1,156 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+// schemaMigration upgrades a stored document, decoded into its top-level JSON
+// fields, from one schema version to the next. It reports whether it changed
+// the document.
+type schemaMigration func(doc map[string]json.RawMessage) (bool, error)
+
+// projectBaseMigrations[v] upgrades a stored project base from schema version
+// v to v+1. The current schema version is the number of migrations, so a
+// format change is made by appending a migration.
+var projectBaseMigrations = []schemaMigration{
+	// Documents without a schema version need no changes.
+	func(map[string]json.RawMessage) (bool, error) { return false, nil },
+}
+
+// projectSettingsMigrations[v] upgrades stored project settings from schema
+// version v to v+1.
+var projectSettingsMigrations = []schemaMigration{
+	migrateSettingsMemberUsernames,
+}
+
+// projectBaseDocument is the stored form of a project base.
+type projectBaseDocument struct {
+	SchemaVersion int `json:"schema_version"`
+	*models.ProjectBase
+}
+
+// projectSettingsDocument is the stored form of project settings.
+type projectSettingsDocument struct {
+	SchemaVersion int `json:"schema_version"`
+	*models.ProjectSettings
+}
+
+// encodeProjectBase returns the stored form of a project base at the current
+// schema version.
+func encodeProjectBase(projectBase *models.ProjectBase) ([]byte, error) {
+	return json.Marshal(projectBaseDocument{SchemaVersion: len(projectBaseMigrations), ProjectBase: projectBase})
+}
+
+// encodeProjectSettings returns the stored form of project settings at the
+// current schema version.
+func encodeProjectSettings(projectSettings *models.ProjectSettings) ([]byte, error) {
+	return json.Marshal(projectSettingsDocument{SchemaVersion: len(projectSettingsMigrations), ProjectSettings: projectSettings})
+}
+
+// decodeProjectBase decodes a stored project base, upgrading it to the
+// current schema version. The upgraded document is returned when a migration
+// changed it, so that it can be written back.
+func decodeProjectBase(value []byte) (*models.ProjectBase, []byte, error) {
+	upgraded, err := upgradeDocument(value, projectBaseMigrations)
+	if err != nil {
+		return nil, nil, err
+	}
+	projectBase := &models.ProjectBase{}
+	if err := json.Unmarshal(orValue(upgraded, value), projectBase); err != nil {
+		return nil, nil, err
+	}
+	return projectBase, upgraded, nil
+}
+
+// decodeProjectSettings decodes stored project settings, upgrading them to the
+// current schema version. The upgraded document is returned when a migration
+// changed it, so that it can be written back.
+func decodeProjectSettings(value []byte) (*models.ProjectSettings, []byte, error) {
+	upgraded, err := upgradeDocument(value, projectSettingsMigrations)
+	if err != nil {
+		return nil, nil, err
+	}
+	projectSettings := &models.ProjectSettings{}
+	if err := json.Unmarshal(orValue(upgraded, value), projectSettings); err != nil {
+		return nil, nil, err
+	}
+	return projectSettings, upgraded, nil
+}
+
+func orValue(upgraded, value []byte) []byte {
+	if upgraded != nil {
+		return upgraded
+	}
+	return value
+}
+
+// upgradeDocument applies the migrations that a stored document has not been
+// through yet. It returns the upgraded document at the current schema
+// version, or nil when no migration changed it: documents that only lack the
+// current version are stamped on their next write rather than rewritten on
+// read. Documents written by a newer version of the service are left as is.
+func upgradeDocument(value []byte, migrations []schemaMigration) ([]byte, error) {
+	var header struct {
+		SchemaVersion int `json:"schema_version"`
+	}
+	if err := json.Unmarshal(value, &header); err != nil {
+		return nil, err
+	}
+	if header.SchemaVersion >= len(migrations) {
+		return nil, nil
+	}
+
+	doc := map[string]json.RawMessage{}
+	if err := json.Unmarshal(value, &doc); err != nil {
+		return nil, err
+	}
+	changed := false
+	for version := header.SchemaVersion; version < len(migrations); version++ {
+		migrated, err := migrations[version](doc)
+		if err != nil {
+			return nil, fmt.Errorf("upgrading document from schema version %d: %w", version, err)
+		}
+		changed = changed || migrated
+	}
+	if !changed {
+		return nil, nil
+	}
+
+	version, err := json.Marshal(len(migrations))
+	if err != nil {
+		return nil, err
+	}
+	doc["schema_version"] = version
+	return json.Marshal(doc)
+}
+
+// migrateSettingsMemberUsernames converts member lists stored as plain
+// usernames, the format used before member names and emails were kept, into
+// user objects.
+func migrateSettingsMemberUsernames(doc map[string]json.RawMessage) (bool, error) {
+	changed := false
+	for _, field := range []string{"writers", "auditors", "meeting_coordinators"} {
+		var usernames []string
+		if raw, ok := doc[field]; !ok || json.Unmarshal(raw, &usernames) != nil || len(usernames) == 0 {
+			// Missing, empty, or already a list of user objects.
+			continue
+		}
+		users := make([]models.UserInfo, 0, len(usernames))
+		for _, username := range usernames {
+			users = append(users, models.UserInfo{Username: username})
+		}
+		raw, err := json.Marshal(users)
+		if err != nil {
+			return false, err
+		}
+		doc[field] = raw
+		changed = true
+	}
+	return changed, nil
+}
@@ -0,0 +1,123 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+func TestDecodeProjectSettings(t *testing.T) {
+	tests := []struct {
+		name            string
+		value           string
+		expected        *models.ProjectSettings
+		expectedUpgrade bool
+	}{
+		{
+			name:  "usernames are upgraded to user objects",
+			value: `{"uid":"project-1","writers":["alice"],"auditors":["bob","carol"],"meeting_coordinators":[]}`,
+			expected: &models.ProjectSettings{
+				UID:                 "project-1",
+				Writers:             []models.UserInfo{{Username: "alice"}},
+				Auditors:            []models.UserInfo{{Username: "bob"}, {Username: "carol"}},
+				MeetingCoordinators: []models.UserInfo{},
+			},
+			expectedUpgrade: true,
+		},
+		{
+			name:  "unversioned settings with user objects are not rewritten",
+			value: `{"uid":"project-1","writers":[{"username":"alice","name":"Alice"}],"auditors":null}`,
+			expected: &models.ProjectSettings{
+				UID:     "project-1",
+				Writers: []models.UserInfo{{Username: "alice", Name: "Alice"}},
+			},
+		},
+		{
+			name:  "current schema version is read as is",
+			value: `{"schema_version":1,"uid":"project-1","writers":[{"username":"alice"}]}`,
+			expected: &models.ProjectSettings{
+				UID:     "project-1",
+				Writers: []models.UserInfo{{Username: "alice"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings, upgraded, err := decodeProjectSettings([]byte(tt.value))
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, settings)
+			if !tt.expectedUpgrade {
+				assert.Nil(t, upgraded)
+				return
+			}
+			var header struct {
+				SchemaVersion int `json:"schema_version"`
+			}
+			require.NoError(t, json.Unmarshal(upgraded, &header))
+			assert.Equal(t, len(projectSettingsMigrations), header.SchemaVersion)
+			reread, again, err := decodeProjectSettings(upgraded)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, reread)
+			assert.Nil(t, again)
+		})
+	}
+}
+
+func TestEncodeProjectBase(t *testing.T) {
+	value, err := encodeProjectBase(&models.ProjectBase{UID: "project-1", Slug: "project"})
+	require.NoError(t, err)
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(value, &doc))
+	assert.EqualValues(t, len(projectBaseMigrations), doc["schema_version"])
+	assert.Equal(t, "project", doc["slug"])
+
+	projectBase, upgraded, err := decodeProjectBase(value)
+	require.NoError(t, err)
+	assert.Equal(t, &models.ProjectBase{UID: "project-1", Slug: "project"}, projectBase)
+	assert.Nil(t, upgraded)
+}
+
+func TestNatsRepository_GetProjectSettingsWithRevision_upgrade(t *testing.T) {
+	legacy := []byte(`{"uid":"project-1","writers":["alice"]}`)
+
+	tests := []struct {
+		name             string
+		updateErr        error
+		expectedRevision uint64
+	}{
+		{name: "upgraded settings are written back", expectedRevision: 8},
+		{name: "concurrent write keeps the read revision", updateErr: errors.New("nats: wrong last sequence: 7"), expectedRevision: 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settingsKV := &MockKeyValue{}
+			settingsKV.On("Get", mock.Anything, "project-1").Return(NewMockKeyValueEntry(legacy, 7), nil)
+			settingsKV.On("Update", mock.Anything, "project-1", mock.MatchedBy(func(value []byte) bool {
+				settings, upgraded, err := decodeProjectSettings(value)
+				return err == nil && upgraded == nil && settings.Writers[0].Username == "alice"
+			}), uint64(7)).Return(uint64(8), tt.updateErr)
+			repo := NewNatsRepository(&MockKeyValue{}, settingsKV)
+
+			settings, revision, err := repo.GetProjectSettingsWithRevision(context.Background(), "project-1")
+
+			require.NoError(t, err)
+			assert.Equal(t, []models.UserInfo{{Username: "alice"}}, settings.Writers)
+			assert.Equal(t, tt.expectedRevision, revision)
+			settingsKV.AssertExpectations(t)
+		})
+	}
+}
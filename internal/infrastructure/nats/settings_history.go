@@ -0,0 +1,41 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// GetProjectSettingsAtRevision returns the project's settings as stored at
+// revision, read from the history of the project settings bucket. A revision
+// of another key, one that is no longer retained, or a delete marker is
+// reported as ErrSettingsRevisionNotFound.
+func (s *NatsRepository) GetProjectSettingsAtRevision(ctx context.Context, projectUID string, revision uint64) (*models.ProjectSettings, error) {
+	entry, err := s.ProjectSettings.GetRevision(ctx, projectUID, revision)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) || errors.Is(err, jetstream.ErrKeyDeleted) {
+			return nil, domain.ErrSettingsRevisionNotFound
+		}
+		slog.ErrorContext(ctx, "error getting project settings revision from NATS KV store", constants.ErrKey, err, "revision", revision)
+		return nil, domain.ErrInternal
+	}
+	if len(entry.Value()) == 0 {
+		return nil, domain.ErrSettingsRevisionNotFound
+	}
+
+	settings, _, err := decodeProjectSettings(entry.Value())
+	if err != nil {
+		slog.ErrorContext(ctx, "error unmarshalling project settings revision from NATS KV store", constants.ErrKey, err, "revision", revision)
+		return nil, domain.ErrUnmarshal
+	}
+	return settings, nil
+}
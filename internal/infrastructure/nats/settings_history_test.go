@@ -0,0 +1,73 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNatsRepository_GetProjectSettingsAtRevision(t *testing.T) {
+	tests := []struct {
+		name         string
+		setupMock    func(kv *MockKeyValue)
+		expectedStmt string
+		expectedErr  error
+	}{
+		{
+			name: "stored revision",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("GetRevision", mock.Anything, "project-1", uint64(4)).
+					Return(NewMockKeyValueEntry([]byte(`{"uid":"project-1","mission_statement":"Old mission"}`), 4), nil)
+			},
+			expectedStmt: "Old mission",
+		},
+		{
+			name: "revision of another key",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("GetRevision", mock.Anything, "project-1", uint64(4)).Return(nil, jetstream.ErrKeyNotFound)
+			},
+			expectedErr: domain.ErrSettingsRevisionNotFound,
+		},
+		{
+			name: "delete marker",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("GetRevision", mock.Anything, "project-1", uint64(4)).Return(NewMockKeyValueEntry(nil, 4), nil)
+			},
+			expectedErr: domain.ErrSettingsRevisionNotFound,
+		},
+		{
+			name: "store error",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("GetRevision", mock.Anything, "project-1", uint64(4)).Return(nil, errors.New("nats error"))
+			},
+			expectedErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kv := &MockKeyValue{}
+			tt.setupMock(kv)
+			repo := &NatsRepository{ProjectSettings: kv}
+
+			settings, err := repo.GetProjectSettingsAtRevision(context.Background(), "project-1", 4)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedStmt, settings.MissionStatement)
+			}
+			kv.AssertExpectations(t)
+		})
+	}
+}
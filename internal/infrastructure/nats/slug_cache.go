@@ -0,0 +1,182 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"container/list"
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/metrics"
+)
+
+// slugKeyPrefix is the prefix of the slug-to-UID mapping keys in the projects bucket.
+const slugKeyPrefix = "slug/"
+
+// SlugCache is an in-process LRU cache of slug-to-UID mappings.
+//
+// Entries are kept consistent with the projects bucket by [SlugCache.Watch],
+// which evicts a slug whenever its mapping key changes, including changes made
+// by other replicas. Only successful lookups are cached, so a newly created
+// slug is never hidden behind a cached miss.
+type SlugCache struct {
+	mu       sync.Mutex
+	size     int
+	order    *list.List
+	items    map[string]*list.Element
+	epoch    uint64
+	disabled bool
+}
+
+type slugCacheEntry struct {
+	slug string
+	uid  string
+}
+
+// NewSlugCache returns a slug cache holding at most size entries.
+func NewSlugCache(size int) *SlugCache {
+	return &SlugCache{
+		size:  size,
+		order: list.New(),
+		items: make(map[string]*list.Element, size),
+	}
+}
+
+// Get returns the cached UID for the slug.
+func (c *SlugCache) Get(slug string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[slug]
+	metrics.ObserveSlugCacheLookup(ok)
+	if !ok {
+		return "", false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*slugCacheEntry).uid, true
+}
+
+// Epoch returns the current invalidation epoch. A caller that reads a mapping
+// from the KV store takes the epoch before the read and passes it to
+// [SlugCache.Add], so that a value read before a concurrent invalidation is
+// not cached.
+func (c *SlugCache) Epoch() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.epoch
+}
+
+// Add caches the UID for the slug, unless the cache was invalidated since epoch.
+func (c *SlugCache) Add(slug, uid string, epoch uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.disabled || c.size <= 0 || epoch != c.epoch {
+		return
+	}
+	c.set(slug, uid)
+}
+
+// Set caches the UID for the slug after this process wrote the mapping.
+func (c *SlugCache) Set(slug, uid string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.disabled || c.size <= 0 {
+		return
+	}
+	c.epoch++
+	c.set(slug, uid)
+}
+
+func (c *SlugCache) set(slug, uid string) {
+	if elem, ok := c.items[slug]; ok {
+		elem.Value.(*slugCacheEntry).uid = uid
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.items[slug] = c.order.PushFront(&slugCacheEntry{slug: slug, uid: uid})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*slugCacheEntry).slug)
+	}
+}
+
+// Remove evicts the slug from the cache.
+func (c *SlugCache) Remove(slug string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.epoch++
+	if elem, ok := c.items[slug]; ok {
+		c.order.Remove(elem)
+		delete(c.items, slug)
+	}
+}
+
+// Len returns the number of cached slugs.
+func (c *SlugCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// disable empties the cache and stops it from caching anything further, so
+// that lookups fall through to the KV store once invalidations are lost.
+func (c *SlugCache) disable() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.epoch++
+	c.disabled = true
+	c.order.Init()
+	clear(c.items)
+}
+
+// Watch starts evicting slugs whose mapping key changes in the projects bucket.
+// The watcher is created before Watch returns, so no update made after that is
+// missed. If the watcher stops before ctx is done, the cache is disabled.
+//
+// The watch covers the whole bucket, headers only, rather than the slug
+// mappings alone: a key such as "slug/my-project" is a single subject token,
+// and NATS wildcards only match whole tokens, so a "slug/>" filter would match
+// no key and leave the cache stale. Keys other than slug mappings are dropped
+// by invalidate.
+func (c *SlugCache) Watch(ctx context.Context, kv jetstream.KeyValue) error {
+	watcher, err := kv.Watch(ctx, ">", jetstream.UpdatesOnly(), jetstream.MetaOnly())
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer func() { _ = watcher.Stop() }()
+		for entry := range watcher.Updates() {
+			if entry == nil {
+				continue
+			}
+			c.invalidate(entry.Key())
+		}
+		if ctx.Err() == nil {
+			slog.WarnContext(ctx, "slug cache watcher stopped, disabling slug cache")
+			c.disable()
+		}
+	}()
+
+	return nil
+}
+
+// invalidate evicts the slug for a changed key in the projects bucket. Keys
+// other than slug mappings are ignored.
+func (c *SlugCache) invalidate(key string) {
+	slug, ok := strings.CutPrefix(key, slugKeyPrefix)
+	if !ok {
+		return
+	}
+	c.Remove(slug)
+}
@@ -0,0 +1,147 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlugCache_evictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewSlugCache(2)
+	cache.Add("a", "uid-a", cache.Epoch())
+	cache.Add("b", "uid-b", cache.Epoch())
+
+	_, ok := cache.Get("a")
+	require.True(t, ok)
+	cache.Add("c", "uid-c", cache.Epoch())
+
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	uid, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, "uid-a", uid)
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestSlugCache_dropsAddAfterInvalidation(t *testing.T) {
+	cache := NewSlugCache(10)
+
+	epoch := cache.Epoch()
+	cache.invalidate("slug/a")
+	cache.Add("a", "stale-uid", epoch)
+
+	_, ok := cache.Get("a")
+	assert.False(t, ok)
+}
+
+func TestSlugCache_invalidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       string
+		wantEvict bool
+	}{
+		{name: "slug mapping key", key: "slug/a", wantEvict: true},
+		{name: "other slug mapping key", key: "slug/b"},
+		{name: "project key", key: "a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := NewSlugCache(10)
+			cache.Set("a", "uid-a")
+
+			cache.invalidate(tt.key)
+
+			_, ok := cache.Get("a")
+			assert.Equal(t, !tt.wantEvict, ok)
+		})
+	}
+}
+
+func TestSlugCache_Watch(t *testing.T) {
+	ns, err := StartEmbeddedServer(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(ns.Shutdown)
+	nc, err := nats.Connect(ns.ClientURL())
+	require.NoError(t, err)
+	t.Cleanup(nc.Close)
+	js, err := jetstream.New(nc)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	kv, err := js.CreateKeyValue(ctx, jetstream.KeyValueConfig{Bucket: "slug-cache-watch"})
+	require.NoError(t, err)
+
+	cache := NewSlugCache(10)
+	require.NoError(t, cache.Watch(ctx, kv))
+	cache.Set("a", "uid-a")
+
+	_, err = kv.Put(ctx, slugKeyPrefix+"a", []byte("uid-b"))
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		_, ok := cache.Get("a")
+		return !ok
+	}, 5*time.Second, 10*time.Millisecond, "a changed slug mapping is evicted")
+}
+
+func TestSlugCache_disable(t *testing.T) {
+	cache := NewSlugCache(10)
+	cache.Set("a", "uid-a")
+
+	cache.disable()
+	cache.Set("b", "uid-b")
+	cache.Add("c", "uid-c", cache.Epoch())
+
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestNatsRepository_GetProjectUIDFromSlug_cached(t *testing.T) {
+	mockKV := &MockKeyValue{}
+	mockKV.On("Get", mock.Anything, "slug/test-project").Return(NewMockKeyValueEntry([]byte("test-project-uid"), 1), nil).Once()
+	mockKV.On("Get", mock.Anything, "slug/missing").Return(nil, jetstream.ErrKeyNotFound).Twice()
+
+	repo := &NatsRepository{Projects: mockKV, SlugCache: NewSlugCache(10)}
+	ctx := context.Background()
+
+	for range 2 {
+		uid, err := repo.GetProjectUIDFromSlug(ctx, "test-project")
+		require.NoError(t, err)
+		assert.Equal(t, "test-project-uid", uid)
+	}
+
+	// Misses are not cached, so a slug created later is found.
+	for range 2 {
+		_, err := repo.GetProjectUIDFromSlug(ctx, "missing")
+		assert.ErrorIs(t, err, domain.ErrProjectNotFound)
+	}
+
+	mockKV.AssertExpectations(t)
+}
+
+func TestNatsRepository_deleteProjectSlugMapping_evictsCache(t *testing.T) {
+	mockKV := &MockKeyValue{}
+	mockKV.On("Delete", mock.Anything, "slug/test-project").Return(nil)
+	mockKV.On("Get", mock.Anything, "slug/test-project").Return(nil, jetstream.ErrKeyNotFound)
+
+	repo := &NatsRepository{Projects: mockKV, SlugCache: NewSlugCache(10)}
+	repo.SlugCache.Set("test-project", "test-project-uid")
+	ctx := context.Background()
+
+	require.NoError(t, repo.deleteProjectSlugMapping(ctx, "test-project"))
+
+	_, err := repo.GetProjectUIDFromSlug(ctx, "test-project")
+	assert.ErrorIs(t, err, domain.ErrProjectNotFound)
+	mockKV.AssertExpectations(t)
+}
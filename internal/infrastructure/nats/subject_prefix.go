@@ -0,0 +1,50 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// subjectPrefixPattern matches the subject prefixes made of dot-separated
+// NATS subject tokens.
+var subjectPrefixPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+(\.[A-Za-z0-9_-]+)*$`)
+
+// ValidateSubjectPrefix reports whether prefix can replace the project
+// service's subject prefix. The empty prefix, which keeps it, is valid.
+func ValidateSubjectPrefix(prefix string) error {
+	if prefix != "" && !subjectPrefixPattern.MatchString(prefix) {
+		return fmt.Errorf("subject prefix %q may only contain dot-separated letters, digits, '-' and '_'", prefix)
+	}
+	return nil
+}
+
+// ServiceSubject returns subject under prefix when it is one of the project
+// service's own subjects, which are under constants.ProjectsAPISubjectPrefix.
+// Other subjects, and every subject when prefix is empty, are returned as is.
+func ServiceSubject(prefix, subject string) string {
+	if prefix == "" {
+		return subject
+	}
+	if rest, ok := strings.CutPrefix(subject, constants.ProjectsAPISubjectPrefix+"."); ok {
+		return prefix + "." + rest
+	}
+	return subject
+}
+
+// NewSubjectPrefixConn returns a connection that sends the messages on the
+// project service's own subjects under prefix, or conn itself when prefix is
+// empty.
+func NewSubjectPrefixConn(conn INatsConn, prefix string) INatsConn {
+	if prefix == "" {
+		return conn
+	}
+	return &subjectConn{INatsConn: conn, subject: func(subject string) string {
+		return ServiceSubject(prefix, subject)
+	}}
+}
@@ -0,0 +1,39 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceSubject(t *testing.T) {
+	assert.Equal(t, "lfx.projects-api-green.get_name", ServiceSubject("lfx.projects-api-green", "lfx.projects-api.get_name"))
+	assert.Equal(t, "lfx.projects-api-green.events.project.created", ServiceSubject("lfx.projects-api-green", "lfx.projects-api.events.project.created"))
+	assert.Equal(t, "lfx.index.project", ServiceSubject("lfx.projects-api-green", "lfx.index.project"), "other services' subjects are kept")
+	assert.Equal(t, "lfx.projects-api.get_name", ServiceSubject("", "lfx.projects-api.get_name"))
+}
+
+func TestValidateSubjectPrefix(t *testing.T) {
+	assert.NoError(t, ValidateSubjectPrefix(""))
+	assert.NoError(t, ValidateSubjectPrefix("lfx.projects-api-blue"))
+	assert.Error(t, ValidateSubjectPrefix("lfx.projects-api."))
+	assert.Error(t, ValidateSubjectPrefix("lfx.*"))
+	assert.Error(t, ValidateSubjectPrefix("lfx projects"))
+}
+
+func TestSubjectPrefixConn(t *testing.T) {
+	conn := &MockNATSConn{}
+	conn.On("Publish", "sandbox.lfx.projects-api-blue.events.project.created", []byte("event")).Return(nil).Once()
+	conn.On("Publish", "sandbox.lfx.index.project", []byte("index")).Return(nil).Once()
+
+	prefixConn := NewSubjectPrefixConn(NewTenantConn(conn, "sandbox"), "lfx.projects-api-blue")
+	require.NoError(t, prefixConn.Publish("lfx.projects-api.events.project.created", []byte("event")))
+	require.NoError(t, prefixConn.Publish("lfx.index.project", []byte("index")))
+	conn.AssertExpectations(t)
+
+	assert.Same(t, conn, NewSubjectPrefixConn(conn, "").(*MockNATSConn))
+}
@@ -0,0 +1,130 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sort"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// CreateProjectTemplate stores a new project template keyed by its name.
+func (s *NatsRepository) CreateProjectTemplate(ctx context.Context, template *models.ProjectTemplate) error {
+	data, err := json.Marshal(template)
+	if err != nil {
+		slog.ErrorContext(ctx, "error marshalling project template into JSON", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	if _, err := s.Templates.Create(ctx, template.Name, data); err != nil {
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			return domain.ErrProjectTemplateExists
+		}
+		slog.ErrorContext(ctx, "error creating project template in NATS KV store", constants.ErrKey, err, "template", template.Name)
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+// GetProjectTemplate returns a project template.
+func (s *NatsRepository) GetProjectTemplate(ctx context.Context, name string) (*models.ProjectTemplate, error) {
+	template, _, err := s.getProjectTemplate(ctx, name)
+	return template, err
+}
+
+// getProjectTemplate returns a project template with the revision it is stored at.
+func (s *NatsRepository) getProjectTemplate(ctx context.Context, name string) (*models.ProjectTemplate, uint64, error) {
+	entry, err := s.Templates.Get(ctx, name)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, 0, domain.ErrProjectTemplateNotFound
+		}
+		slog.ErrorContext(ctx, "error getting project template from NATS KV store", constants.ErrKey, err, "template", name)
+		return nil, 0, domain.ErrInternal
+	}
+
+	template := &models.ProjectTemplate{}
+	if err := json.Unmarshal(entry.Value(), template); err != nil {
+		slog.ErrorContext(ctx, "error unmarshalling project template from NATS KV store", constants.ErrKey, err, "template", name)
+		return nil, 0, domain.ErrUnmarshal
+	}
+	return template, entry.Revision(), nil
+}
+
+// ListProjectTemplates returns every project template, sorted by name.
+func (s *NatsRepository) ListProjectTemplates(ctx context.Context) ([]*models.ProjectTemplate, error) {
+	keysLister, err := s.Templates.ListKeys(ctx)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return []*models.ProjectTemplate{}, nil
+		}
+		slog.ErrorContext(ctx, "error listing project template keys from NATS KV store", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+
+	templates := []*models.ProjectTemplate{}
+	for key := range keysLister.Keys() {
+		template, err := s.GetProjectTemplate(ctx, key)
+		if err != nil {
+			if errors.Is(err, domain.ErrProjectTemplateNotFound) {
+				// Deleted since the keys were listed.
+				continue
+			}
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+
+	return templates, nil
+}
+
+// UpdateProjectTemplate replaces a stored project template. The template is
+// only written if it was not changed since it was read.
+func (s *NatsRepository) UpdateProjectTemplate(ctx context.Context, template *models.ProjectTemplate) error {
+	_, revision, err := s.getProjectTemplate(ctx, template.Name)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(template)
+	if err != nil {
+		slog.ErrorContext(ctx, "error marshalling project template into JSON", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	if _, err := s.Templates.Update(ctx, template.Name, data, revision); err != nil {
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			return domain.ErrRevisionMismatch
+		}
+		slog.ErrorContext(ctx, "error updating project template in NATS KV store", constants.ErrKey, err, "template", template.Name)
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+// DeleteProjectTemplate removes a project template. Projects created from it
+// are not changed.
+func (s *NatsRepository) DeleteProjectTemplate(ctx context.Context, name string) error {
+	if _, err := s.GetProjectTemplate(ctx, name); err != nil {
+		return err
+	}
+
+	if err := s.Templates.Purge(ctx, name); err != nil {
+		slog.ErrorContext(ctx, "error purging project template from NATS KV store", constants.ErrKey, err, "template", name)
+		return domain.ErrInternal
+	}
+	return nil
+}
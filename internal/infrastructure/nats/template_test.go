@@ -0,0 +1,91 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNatsRepository_CreateProjectTemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		createErr   error
+		expectedErr error
+	}{
+		{name: "stores the template under its name"},
+		{name: "name taken", createErr: jetstream.ErrKeyExists, expectedErr: domain.ErrProjectTemplateExists},
+		{name: "store error", createErr: errors.New("nats error"), expectedErr: domain.ErrInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			templatesKV := &MockKeyValue{}
+			templatesKV.On("Create", mock.Anything, "cncf-sandbox", mock.Anything).Return(uint64(1), tt.createErr)
+			repo := &NatsRepository{Templates: templatesKV}
+
+			err := repo.CreateProjectTemplate(context.Background(), &models.ProjectTemplate{Name: "cncf-sandbox"})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			templatesKV.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNatsRepository_ListProjectTemplates(t *testing.T) {
+	sandbox, _ := json.Marshal(&models.ProjectTemplate{Name: "cncf-sandbox"})
+	incubating, _ := json.Marshal(&models.ProjectTemplate{Name: "cncf-incubating"})
+
+	templatesKV := &MockKeyValue{}
+	templatesKV.On("ListKeys", mock.Anything).Return(NewMockKeyLister([]string{"cncf-sandbox", "cncf-incubating", "deleted"}), nil)
+	templatesKV.On("Get", mock.Anything, "cncf-sandbox").Return(NewMockKeyValueEntry(sandbox, 1), nil)
+	templatesKV.On("Get", mock.Anything, "cncf-incubating").Return(NewMockKeyValueEntry(incubating, 2), nil)
+	templatesKV.On("Get", mock.Anything, "deleted").Return(nil, jetstream.ErrKeyNotFound)
+	repo := &NatsRepository{Templates: templatesKV}
+
+	templates, err := repo.ListProjectTemplates(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+	assert.Equal(t, "cncf-incubating", templates[0].Name)
+	assert.Equal(t, "cncf-sandbox", templates[1].Name)
+}
+
+func TestNatsRepository_UpdateProjectTemplate(t *testing.T) {
+	stored, _ := json.Marshal(&models.ProjectTemplate{Name: "cncf-sandbox"})
+
+	t.Run("writes at the read revision", func(t *testing.T) {
+		templatesKV := &MockKeyValue{}
+		templatesKV.On("Get", mock.Anything, "cncf-sandbox").Return(NewMockKeyValueEntry(stored, 4), nil)
+		templatesKV.On("Update", mock.Anything, "cncf-sandbox", mock.Anything, uint64(4)).Return(uint64(5), nil)
+		repo := &NatsRepository{Templates: templatesKV}
+
+		require.NoError(t, repo.UpdateProjectTemplate(context.Background(), &models.ProjectTemplate{Name: "cncf-sandbox", Stage: "Active"}))
+		templatesKV.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		templatesKV := &MockKeyValue{}
+		templatesKV.On("Get", mock.Anything, "cncf-sandbox").Return(nil, jetstream.ErrKeyNotFound)
+		repo := &NatsRepository{Templates: templatesKV}
+
+		err := repo.UpdateProjectTemplate(context.Background(), &models.ProjectTemplate{Name: "cncf-sandbox"})
+
+		assert.ErrorIs(t, err, domain.ErrProjectTemplateNotFound)
+		templatesKV.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
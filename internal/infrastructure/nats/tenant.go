@@ -0,0 +1,489 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// tenantPattern matches the tenant names that are a single NATS subject token.
+var tenantPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// ValidateTenant reports whether tenant can namespace KV keys and NATS
+// subjects. The empty tenant, which namespaces nothing, is valid.
+func ValidateTenant(tenant string) error {
+	if tenant != "" && !tenantPattern.MatchString(tenant) {
+		return fmt.Errorf("tenant %q may only contain letters, digits, '-' and '_'", tenant)
+	}
+	return nil
+}
+
+// TenantSubject returns subject in the namespace of tenant, or subject itself
+// when tenant is empty.
+func TenantSubject(tenant, subject string) string {
+	if tenant == "" {
+		return subject
+	}
+	return tenant + constants.TenantSeparator + subject
+}
+
+// subjectConn maps the subject of every message sent on a connection, such as
+// to send requests to the services of the same tenant.
+type subjectConn struct {
+	INatsConn
+	subject func(subject string) string
+}
+
+// NewTenantConn returns a connection that sends messages on the subjects of
+// tenant, or conn itself when tenant is empty.
+func NewTenantConn(conn INatsConn, tenant string) INatsConn {
+	if tenant == "" {
+		return conn
+	}
+	return &subjectConn{INatsConn: conn, subject: func(subject string) string {
+		return TenantSubject(tenant, subject)
+	}}
+}
+
+func (c *subjectConn) Publish(subj string, data []byte) error {
+	return c.INatsConn.Publish(c.subject(subj), data)
+}
+
+func (c *subjectConn) PublishMsg(msg *nats.Msg) error {
+	return c.INatsConn.PublishMsg(c.mapMsg(msg))
+}
+
+func (c *subjectConn) Request(subj string, data []byte, timeout time.Duration) (*nats.Msg, error) {
+	return c.INatsConn.Request(c.subject(subj), data, timeout)
+}
+
+func (c *subjectConn) RequestMsgWithContext(ctx context.Context, msg *nats.Msg) (*nats.Msg, error) {
+	return c.INatsConn.RequestMsgWithContext(ctx, c.mapMsg(msg))
+}
+
+// mapMsg returns a copy of msg sent on the mapped subject.
+func (c *subjectConn) mapMsg(msg *nats.Msg) *nats.Msg {
+	mapped := nats.NewMsg(c.subject(msg.Subject))
+	mapped.Reply = msg.Reply
+	mapped.Header = msg.Header
+	mapped.Data = msg.Data
+	return mapped
+}
+
+// tenantJetStream opens key-value buckets whose keys are namespaced by a
+// tenant, or by no tenant.
+type tenantJetStream struct {
+	jetstream.JetStream
+	tenant string
+}
+
+// NewTenantJetStream returns a JetStream client whose key-value buckets only
+// hold the keys of tenant, or, when tenant is empty, the keys of no tenant.
+// Object stores and streams are shared by every tenant.
+func NewTenantJetStream(js jetstream.JetStream, tenant string) jetstream.JetStream {
+	return &tenantJetStream{JetStream: js, tenant: tenant}
+}
+
+func (j *tenantJetStream) KeyValue(ctx context.Context, bucket string) (jetstream.KeyValue, error) {
+	kv, err := j.JetStream.KeyValue(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return NewTenantKeyValue(kv, j.tenant), nil
+}
+
+// tenantKeyValue stores the keys of a tenant under the tenant prefix of a
+// bucket shared with other tenants. Keys are passed and returned without the
+// prefix, and the keys of other tenants are never listed or watched.
+type tenantKeyValue struct {
+	jetstream.KeyValue
+	prefix string
+}
+
+// NewTenantKeyValue returns a bucket that only holds the keys of tenant, or,
+// when tenant is empty, the keys of no tenant, so that an untenanted
+// deployment sharing the bucket neither lists nor reads the keys of tenants.
+// Bucket-wide operations, such as PurgeDeletes and Status, still apply to
+// every tenant.
+func NewTenantKeyValue(kv jetstream.KeyValue, tenant string) jetstream.KeyValue {
+	if tenant == "" {
+		return &untenantedKeyValue{KeyValue: kv, separators: keySeparators[kv.Bucket()]}
+	}
+	return &tenantKeyValue{KeyValue: kv, prefix: tenant + constants.TenantSeparator}
+}
+
+// keySeparators is the number of TenantSeparators in the untenanted keys of
+// the buckets whose keys hold any, such as <webhook>.<delivery>. The keys of
+// every other bucket hold none.
+var keySeparators = map[string]int{
+	constants.KVStoreNameProjectWebhookDeliveries: 1,
+	constants.KVStoreNameProjectAnnouncements:     1,
+}
+
+// untenantedKeyValue is a bucket shared with tenants whose keys are used
+// without a prefix. The keys of tenants are neither listed nor watched, and
+// are read, written and deleted as if they did not exist.
+type untenantedKeyValue struct {
+	jetstream.KeyValue
+	// separators is the number of TenantSeparators in the bucket's own keys;
+	// a tenant's key has one more.
+	separators int
+}
+
+// isTenantKey reports whether key is in the namespace of a tenant: it holds
+// more TenantSeparators than the bucket's own keys, and its first token is a
+// tenant name. Keys such as slug/<slug> mappings, whose first token is not a
+// tenant name, are never a tenant's.
+func (u *untenantedKeyValue) isTenantKey(key string) bool {
+	tenant, _, _ := strings.Cut(key, constants.TenantSeparator)
+	return strings.Count(key, constants.TenantSeparator) > u.separators && tenantPattern.MatchString(tenant)
+}
+
+func (t *tenantKeyValue) Get(ctx context.Context, key string) (jetstream.KeyValueEntry, error) {
+	entry, err := t.KeyValue.Get(ctx, t.prefix+key)
+	if err != nil {
+		return nil, err
+	}
+	return t.entry(entry), nil
+}
+
+func (t *tenantKeyValue) GetRevision(ctx context.Context, key string, revision uint64) (jetstream.KeyValueEntry, error) {
+	entry, err := t.KeyValue.GetRevision(ctx, t.prefix+key, revision)
+	if err != nil {
+		return nil, err
+	}
+	return t.entry(entry), nil
+}
+
+func (t *tenantKeyValue) Put(ctx context.Context, key string, value []byte) (uint64, error) {
+	return t.KeyValue.Put(ctx, t.prefix+key, value)
+}
+
+func (t *tenantKeyValue) PutString(ctx context.Context, key string, value string) (uint64, error) {
+	return t.KeyValue.PutString(ctx, t.prefix+key, value)
+}
+
+func (t *tenantKeyValue) Create(ctx context.Context, key string, value []byte, opts ...jetstream.KVCreateOpt) (uint64, error) {
+	return t.KeyValue.Create(ctx, t.prefix+key, value, opts...)
+}
+
+func (t *tenantKeyValue) Update(ctx context.Context, key string, value []byte, revision uint64) (uint64, error) {
+	return t.KeyValue.Update(ctx, t.prefix+key, value, revision)
+}
+
+func (t *tenantKeyValue) Delete(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error {
+	return t.KeyValue.Delete(ctx, t.prefix+key, opts...)
+}
+
+func (t *tenantKeyValue) Purge(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error {
+	return t.KeyValue.Purge(ctx, t.prefix+key, opts...)
+}
+
+func (t *tenantKeyValue) History(ctx context.Context, key string, opts ...jetstream.WatchOpt) ([]jetstream.KeyValueEntry, error) {
+	entries, err := t.KeyValue.History(ctx, t.prefix+key, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for i, entry := range entries {
+		entries[i] = t.entry(entry)
+	}
+	return entries, nil
+}
+
+func (t *tenantKeyValue) Watch(ctx context.Context, keys string, opts ...jetstream.WatchOpt) (jetstream.KeyWatcher, error) {
+	watcher, err := t.KeyValue.Watch(ctx, t.prefix+keys, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return t.watcher(watcher), nil
+}
+
+func (t *tenantKeyValue) WatchAll(ctx context.Context, opts ...jetstream.WatchOpt) (jetstream.KeyWatcher, error) {
+	return t.Watch(ctx, ">", opts...)
+}
+
+func (t *tenantKeyValue) WatchFiltered(ctx context.Context, keys []string, opts ...jetstream.WatchOpt) (jetstream.KeyWatcher, error) {
+	watcher, err := t.KeyValue.WatchFiltered(ctx, t.prefixAll(keys), opts...)
+	if err != nil {
+		return nil, err
+	}
+	return t.watcher(watcher), nil
+}
+
+func (t *tenantKeyValue) Keys(ctx context.Context, opts ...jetstream.WatchOpt) ([]string, error) {
+	lister, err := t.ListKeys(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = lister.Stop() }()
+
+	var keys []string
+	for key := range lister.Keys() {
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, jetstream.ErrNoKeysFound
+	}
+	return keys, nil
+}
+
+func (t *tenantKeyValue) ListKeys(ctx context.Context, _ ...jetstream.WatchOpt) (jetstream.KeyLister, error) {
+	return t.ListKeysFiltered(ctx, ">")
+}
+
+func (t *tenantKeyValue) ListKeysFiltered(ctx context.Context, filters ...string) (jetstream.KeyLister, error) {
+	lister, err := t.KeyValue.ListKeysFiltered(ctx, t.prefixAll(filters)...)
+	if err != nil {
+		return nil, err
+	}
+	return mapKeyLister(lister, func(key string) (string, bool) {
+		return strings.TrimPrefix(key, t.prefix), true
+	}), nil
+}
+
+// prefixAll returns keys in the tenant's namespace.
+func (t *tenantKeyValue) prefixAll(keys []string) []string {
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = t.prefix + key
+	}
+	return prefixed
+}
+
+// entry returns entry with its key without the tenant prefix.
+func (t *tenantKeyValue) entry(entry jetstream.KeyValueEntry) jetstream.KeyValueEntry {
+	return &tenantEntry{KeyValueEntry: entry, key: strings.TrimPrefix(entry.Key(), t.prefix)}
+}
+
+// watcher returns a watcher delivering the entries of watcher with their keys
+// without the tenant prefix. The nil entry marking the end of the initial
+// values is passed on as is.
+func (t *tenantKeyValue) watcher(watcher jetstream.KeyWatcher) jetstream.KeyWatcher {
+	return mapKeyWatcher(watcher, func(entry jetstream.KeyValueEntry) (jetstream.KeyValueEntry, bool) {
+		return t.entry(entry), true
+	})
+}
+
+func (u *untenantedKeyValue) Get(ctx context.Context, key string) (jetstream.KeyValueEntry, error) {
+	if u.isTenantKey(key) {
+		return nil, jetstream.ErrKeyNotFound
+	}
+	return u.KeyValue.Get(ctx, key)
+}
+
+func (u *untenantedKeyValue) GetRevision(ctx context.Context, key string, revision uint64) (jetstream.KeyValueEntry, error) {
+	if u.isTenantKey(key) {
+		return nil, jetstream.ErrKeyNotFound
+	}
+	return u.KeyValue.GetRevision(ctx, key, revision)
+}
+
+func (u *untenantedKeyValue) Put(ctx context.Context, key string, value []byte) (uint64, error) {
+	if u.isTenantKey(key) {
+		return 0, jetstream.ErrInvalidKey
+	}
+	return u.KeyValue.Put(ctx, key, value)
+}
+
+func (u *untenantedKeyValue) PutString(ctx context.Context, key string, value string) (uint64, error) {
+	if u.isTenantKey(key) {
+		return 0, jetstream.ErrInvalidKey
+	}
+	return u.KeyValue.PutString(ctx, key, value)
+}
+
+func (u *untenantedKeyValue) Create(ctx context.Context, key string, value []byte, opts ...jetstream.KVCreateOpt) (uint64, error) {
+	if u.isTenantKey(key) {
+		return 0, jetstream.ErrInvalidKey
+	}
+	return u.KeyValue.Create(ctx, key, value, opts...)
+}
+
+func (u *untenantedKeyValue) Update(ctx context.Context, key string, value []byte, revision uint64) (uint64, error) {
+	if u.isTenantKey(key) {
+		return 0, jetstream.ErrInvalidKey
+	}
+	return u.KeyValue.Update(ctx, key, value, revision)
+}
+
+func (u *untenantedKeyValue) Delete(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error {
+	if u.isTenantKey(key) {
+		return jetstream.ErrKeyNotFound
+	}
+	return u.KeyValue.Delete(ctx, key, opts...)
+}
+
+func (u *untenantedKeyValue) Purge(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error {
+	if u.isTenantKey(key) {
+		return jetstream.ErrKeyNotFound
+	}
+	return u.KeyValue.Purge(ctx, key, opts...)
+}
+
+func (u *untenantedKeyValue) History(ctx context.Context, key string, opts ...jetstream.WatchOpt) ([]jetstream.KeyValueEntry, error) {
+	if u.isTenantKey(key) {
+		return nil, jetstream.ErrKeyNotFound
+	}
+	return u.KeyValue.History(ctx, key, opts...)
+}
+
+func (u *untenantedKeyValue) Watch(ctx context.Context, keys string, opts ...jetstream.WatchOpt) (jetstream.KeyWatcher, error) {
+	watcher, err := u.KeyValue.Watch(ctx, keys, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return u.watcher(watcher), nil
+}
+
+func (u *untenantedKeyValue) WatchAll(ctx context.Context, opts ...jetstream.WatchOpt) (jetstream.KeyWatcher, error) {
+	return u.Watch(ctx, ">", opts...)
+}
+
+func (u *untenantedKeyValue) WatchFiltered(ctx context.Context, keys []string, opts ...jetstream.WatchOpt) (jetstream.KeyWatcher, error) {
+	watcher, err := u.KeyValue.WatchFiltered(ctx, keys, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return u.watcher(watcher), nil
+}
+
+func (u *untenantedKeyValue) Keys(ctx context.Context, opts ...jetstream.WatchOpt) ([]string, error) {
+	lister, err := u.ListKeys(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = lister.Stop() }()
+
+	var keys []string
+	for key := range lister.Keys() {
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, jetstream.ErrNoKeysFound
+	}
+	return keys, nil
+}
+
+func (u *untenantedKeyValue) ListKeys(ctx context.Context, _ ...jetstream.WatchOpt) (jetstream.KeyLister, error) {
+	return u.ListKeysFiltered(ctx, ">")
+}
+
+func (u *untenantedKeyValue) ListKeysFiltered(ctx context.Context, filters ...string) (jetstream.KeyLister, error) {
+	lister, err := u.KeyValue.ListKeysFiltered(ctx, filters...)
+	if err != nil {
+		return nil, err
+	}
+	return mapKeyLister(lister, func(key string) (string, bool) {
+		return key, !u.isTenantKey(key)
+	}), nil
+}
+
+// watcher returns a watcher delivering the entries of watcher whose keys are
+// not a tenant's.
+func (u *untenantedKeyValue) watcher(watcher jetstream.KeyWatcher) jetstream.KeyWatcher {
+	return mapKeyWatcher(watcher, func(entry jetstream.KeyValueEntry) (jetstream.KeyValueEntry, bool) {
+		return entry, !u.isTenantKey(entry.Key())
+	})
+}
+
+// mapKeyWatcher returns a watcher delivering the entries of watcher mapped by
+// fn, leaving out those fn does not keep. The nil entry marking the end of the
+// initial values is passed on as is.
+func mapKeyWatcher(watcher jetstream.KeyWatcher, fn func(jetstream.KeyValueEntry) (jetstream.KeyValueEntry, bool)) jetstream.KeyWatcher {
+	mapped := &tenantKeyWatcher{KeyWatcher: watcher, updates: make(chan jetstream.KeyValueEntry), done: make(chan struct{})}
+	go func() {
+		defer close(mapped.updates)
+		for entry := range watcher.Updates() {
+			if entry != nil {
+				var keep bool
+				if entry, keep = fn(entry); !keep {
+					continue
+				}
+			}
+			select {
+			case mapped.updates <- entry:
+			case <-mapped.done:
+				return
+			}
+		}
+	}()
+	return mapped
+}
+
+// mapKeyLister returns a lister of the keys of lister mapped by fn, leaving
+// out those fn does not keep.
+func mapKeyLister(lister jetstream.KeyLister, fn func(string) (string, bool)) jetstream.KeyLister {
+	mapped := &tenantKeyLister{KeyLister: lister, keys: make(chan string), done: make(chan struct{})}
+	go func() {
+		defer close(mapped.keys)
+		for key := range lister.Keys() {
+			key, keep := fn(key)
+			if !keep {
+				continue
+			}
+			select {
+			case mapped.keys <- key:
+			case <-mapped.done:
+				return
+			}
+		}
+	}()
+	return mapped
+}
+
+// tenantEntry is an entry of a tenant's key.
+type tenantEntry struct {
+	jetstream.KeyValueEntry
+	key string
+}
+
+func (e *tenantEntry) Key() string {
+	return e.key
+}
+
+// tenantKeyWatcher delivers the entries of the keys of a tenant, or of no
+// tenant. Stopping it also stops delivering the entries already received from
+// the wrapped watcher.
+type tenantKeyWatcher struct {
+	jetstream.KeyWatcher
+	updates  chan jetstream.KeyValueEntry
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+func (w *tenantKeyWatcher) Updates() <-chan jetstream.KeyValueEntry {
+	return w.updates
+}
+
+func (w *tenantKeyWatcher) Stop() error {
+	w.stopOnce.Do(func() { close(w.done) })
+	return w.KeyWatcher.Stop()
+}
+
+// tenantKeyLister lists the keys of a tenant, or of no tenant.
+type tenantKeyLister struct {
+	jetstream.KeyLister
+	keys     chan string
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+func (l *tenantKeyLister) Keys() <-chan string {
+	return l.keys
+}
+
+func (l *tenantKeyLister) Stop() error {
+	l.stopOnce.Do(func() { close(l.done) })
+	return l.KeyLister.Stop()
+}
@@ -0,0 +1,198 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// memoryKeyValue is an in-memory bucket implementing the parts of
+// [jetstream.KeyValue] that tenantKeyValue calls.
+type memoryKeyValue struct {
+	jetstream.KeyValue
+	bucket  string
+	values  map[string][]byte
+	watched []string
+}
+
+func (m *memoryKeyValue) Bucket() string {
+	return m.bucket
+}
+
+func (m *memoryKeyValue) Get(_ context.Context, key string) (jetstream.KeyValueEntry, error) {
+	value, ok := m.values[key]
+	if !ok {
+		return nil, jetstream.ErrKeyNotFound
+	}
+	return newWatchedEntry(key, value, 1, jetstream.KeyValuePut), nil
+}
+
+func (m *memoryKeyValue) Put(_ context.Context, key string, value []byte) (uint64, error) {
+	m.values[key] = value
+	return 1, nil
+}
+
+func (m *memoryKeyValue) ListKeysFiltered(_ context.Context, filters ...string) (jetstream.KeyLister, error) {
+	var keys []string
+	for key := range m.values {
+		for _, filter := range filters {
+			if strings.HasPrefix(key, strings.TrimSuffix(filter, ">")) {
+				keys = append(keys, key)
+				break
+			}
+		}
+	}
+	return newStoppableKeyLister(keys...), nil
+}
+
+func (m *memoryKeyValue) Watch(_ context.Context, keys string, _ ...jetstream.WatchOpt) (jetstream.KeyWatcher, error) {
+	m.watched = append(m.watched, keys)
+	updates := make(chan jetstream.KeyValueEntry, 2)
+	updates <- newWatchedEntry("sandbox.project-1", []byte("v1"), 1, jetstream.KeyValuePut)
+	updates <- nil
+	close(updates)
+	return &memoryKeyWatcher{updates: updates}, nil
+}
+
+type memoryKeyWatcher struct {
+	jetstream.KeyWatcher
+	updates chan jetstream.KeyValueEntry
+}
+
+func (w *memoryKeyWatcher) Updates() <-chan jetstream.KeyValueEntry { return w.updates }
+
+func (w *memoryKeyWatcher) Stop() error { return nil }
+
+func TestValidateTenant(t *testing.T) {
+	assert.NoError(t, ValidateTenant(""))
+	assert.NoError(t, ValidateTenant("staging-demo_1"))
+	assert.Error(t, ValidateTenant("sandbox.demo"))
+	assert.Error(t, ValidateTenant("sandbox>"))
+}
+
+func TestTenantKeyValue(t *testing.T) {
+	ctx := context.Background()
+	shared := &memoryKeyValue{values: map[string][]byte{
+		"project-1":         []byte("untenanted"),
+		"staging.project-1": []byte("staging"),
+	}}
+	kv := NewTenantKeyValue(shared, "sandbox")
+
+	_, err := kv.Get(ctx, "project-1")
+	assert.ErrorIs(t, err, jetstream.ErrKeyNotFound)
+
+	_, err = kv.Put(ctx, "project-1", []byte("sandbox"))
+	require.NoError(t, err)
+	_, err = kv.Put(ctx, "slug/one", []byte("project-1"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("sandbox"), shared.values["sandbox.project-1"])
+	assert.Equal(t, []byte("untenanted"), shared.values["project-1"])
+
+	entry, err := kv.Get(ctx, "project-1")
+	require.NoError(t, err)
+	assert.Equal(t, "project-1", entry.Key())
+	assert.Equal(t, []byte("sandbox"), entry.Value())
+
+	lister, err := kv.ListKeys(ctx)
+	require.NoError(t, err)
+	var keys []string
+	for key := range lister.Keys() {
+		keys = append(keys, key)
+	}
+	require.NoError(t, lister.Stop())
+	sort.Strings(keys)
+	assert.Equal(t, []string{"project-1", "slug/one"}, keys)
+
+	watcher, err := kv.WatchAll(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sandbox.>"}, shared.watched)
+	update := <-watcher.Updates()
+	assert.Equal(t, "project-1", update.Key())
+	assert.Nil(t, <-watcher.Updates())
+	require.NoError(t, watcher.Stop())
+}
+
+func TestNewTenantKeyValue_noTenant(t *testing.T) {
+	ctx := context.Background()
+	listKeys := func(kv jetstream.KeyValue) []string {
+		lister, err := kv.ListKeys(ctx)
+		require.NoError(t, err)
+		var keys []string
+		for key := range lister.Keys() {
+			keys = append(keys, key)
+		}
+		require.NoError(t, lister.Stop())
+		sort.Strings(keys)
+		return keys
+	}
+
+	t.Run("keys of tenants are left out", func(t *testing.T) {
+		shared := &memoryKeyValue{bucket: "projects", values: map[string][]byte{
+			"project-1":                []byte("untenanted"),
+			"slug/one.two":             []byte("project-1"),
+			"sandbox.project-1":        []byte("sandbox"),
+			"sandbox.slug/one":         []byte("project-1"),
+			"staging-demo_1.legacy/ab": []byte("project-1"),
+		}}
+		kv := NewTenantKeyValue(shared, "")
+
+		assert.Equal(t, []string{"project-1", "slug/one.two"}, listKeys(kv))
+
+		_, err := kv.Get(ctx, "sandbox.project-1")
+		assert.ErrorIs(t, err, jetstream.ErrKeyNotFound)
+		_, err = kv.Put(ctx, "sandbox.project-2", []byte("untenanted"))
+		assert.ErrorIs(t, err, jetstream.ErrInvalidKey)
+		entry, err := kv.Get(ctx, "project-1")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("untenanted"), entry.Value())
+
+		watcher, err := kv.WatchAll(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, <-watcher.Updates(), "the sandbox entry is not delivered")
+		require.NoError(t, watcher.Stop())
+	})
+
+	t.Run("buckets whose own keys hold a separator", func(t *testing.T) {
+		shared := &memoryKeyValue{bucket: constants.KVStoreNameProjectWebhookDeliveries, values: map[string][]byte{
+			"hook-1.delivery-1":         []byte("untenanted"),
+			"sandbox.hook-1.delivery-1": []byte("sandbox"),
+		}}
+
+		assert.Equal(t, []string{"hook-1.delivery-1"}, listKeys(NewTenantKeyValue(shared, "")))
+	})
+}
+
+func TestTenantConn(t *testing.T) {
+	conn := &MockNATSConn{}
+	conn.On("PublishMsg", mock.MatchedBy(func(msg *nats.Msg) bool {
+		return msg.Subject == "sandbox.lfx.index.project" && string(msg.Data) == "data"
+	})).Return(nil).Once()
+	conn.On("RequestMsgWithContext", mock.Anything, mock.MatchedBy(func(msg *nats.Msg) bool {
+		return msg.Subject == "sandbox.lfx.auth-service.user_metadata.read"
+	})).Return(&nats.Msg{Data: []byte("reply")}, nil).Once()
+
+	tenantConn := NewTenantConn(conn, "sandbox")
+	msg := nats.NewMsg("lfx.index.project")
+	msg.Data = []byte("data")
+	require.NoError(t, tenantConn.PublishMsg(msg))
+	assert.Equal(t, "lfx.index.project", msg.Subject)
+
+	reply, err := tenantConn.RequestMsgWithContext(context.Background(), nats.NewMsg("lfx.auth-service.user_metadata.read"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("reply"), reply.Data)
+	conn.AssertExpectations(t)
+
+	assert.Same(t, conn, NewTenantConn(conn, "").(*MockNATSConn))
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/micro"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/propagation"
@@ -55,18 +56,41 @@ func ExtractTraceContext(ctx context.Context, header natsgo.Header) context.Cont
 	return otel.GetTextMapPropagator().Extract(ctx, natsHeaderCarrier(header))
 }
 
+// newReplyMsg builds a reply message with the trace context of ctx injected
+// into its headers. The subject is filled in by [natsgo.Msg.RespondMsg].
+func newReplyMsg(ctx context.Context, data []byte) *natsgo.Msg {
+	reply := &natsgo.Msg{
+		Header: make(natsgo.Header),
+		Data:   data,
+	}
+	otel.GetTextMapPropagator().Inject(ctx, natsHeaderCarrier(reply.Header))
+	return reply
+}
+
 // ExtractMsgContext extracts trace context from NATS message headers and starts a consumer span.
 // It returns a new context with the extracted trace and a function to end the span.
 // The returned function must be called with defer to ensure the span is properly closed.
 func ExtractMsgContext(ctx context.Context, msg *natsgo.Msg, subject string) (context.Context, func()) {
-	msgCtx := ExtractTraceContext(ctx, msg.Header)
+	return startProcessSpan(ctx, msg.Header, len(msg.Data), subject)
+}
+
+// ExtractRequestContext is [ExtractMsgContext] for a request received by a
+// NATS micro service endpoint.
+func ExtractRequestContext(ctx context.Context, req micro.Request, subject string) (context.Context, func()) {
+	return startProcessSpan(ctx, natsgo.Header(req.Headers()), len(req.Data()), subject)
+}
+
+// startProcessSpan starts the consumer span of a message with header and a
+// body of size bytes received on subject.
+func startProcessSpan(ctx context.Context, header natsgo.Header, size int, subject string) (context.Context, func()) {
+	msgCtx := ExtractTraceContext(ctx, header)
 	msgCtx, span := tracer.Start(msgCtx, "nats.process",
 		trace.WithSpanKind(trace.SpanKindConsumer),
 		trace.WithAttributes(
 			attribute.String("messaging.system", "nats"),
 			attribute.String("messaging.destination.name", subject),
 			attribute.String("messaging.operation.type", "process"),
-			attribute.Int("messaging.message.body.size", len(msg.Data)),
+			attribute.Int("messaging.message.body.size", size),
 		),
 	)
 	return msgCtx, func() { span.End() }
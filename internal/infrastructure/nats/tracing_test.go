@@ -10,7 +10,9 @@ import (
 	natsgo "github.com/nats-io/nats.go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestNatsHeaderCarrier_Get(t *testing.T) {
@@ -134,3 +136,35 @@ func TestExtractMsgContext(t *testing.T) {
 		end() // must not panic
 	})
 }
+
+func TestNewReplyMsg(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	t.Run("injects traceparent from a sampled span context", func(t *testing.T) {
+		traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+		require.NoError(t, err)
+		spanID, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+		require.NoError(t, err)
+		ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		}))
+
+		reply := newReplyMsg(ctx, []byte("payload"))
+
+		assert.Equal(t, []byte("payload"), reply.Data)
+		assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", reply.Header.Get("traceparent"))
+
+		extracted := trace.SpanContextFromContext(ExtractTraceContext(context.Background(), reply.Header))
+		assert.Equal(t, traceID, extracted.TraceID())
+		assert.Equal(t, spanID, extracted.SpanID())
+	})
+
+	t.Run("leaves headers empty without a span context", func(t *testing.T) {
+		reply := newReplyMsg(context.Background(), nil)
+
+		require.NotNil(t, reply.Header)
+		assert.Empty(t, reply.Header.Get("traceparent"))
+	})
+}
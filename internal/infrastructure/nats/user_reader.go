@@ -82,12 +82,12 @@ func (u *UserReaderNATS) UserMetadataByPrincipal(ctx context.Context, principal
 
 	if !response.Success || response.Data == nil {
 		if response.Error != "" {
-			err := fmt.Errorf("user metadata not found: %s", response.Error)
+			err := fmt.Errorf("%w: user metadata not found: %s", domain.ErrUserNotFound, response.Error)
 			span.RecordError(err)
 			span.SetStatus(codes.Error, err.Error())
 			return nil, err
 		}
-		err := fmt.Errorf("user metadata not found")
+		err := fmt.Errorf("%w: user metadata not found", domain.ErrUserNotFound)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
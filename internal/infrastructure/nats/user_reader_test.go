@@ -124,6 +124,7 @@ func TestUserReaderNATS_UserMetadataByPrincipal(t *testing.T) {
 		reply      *natsgo.Msg // nil simulates a transport error
 		replyErr   error
 		wantMeta   *domain.UserMetadata
+		wantErr    error
 		wantErrStr string
 	}{
 		{
@@ -169,6 +170,7 @@ func TestUserReaderNATS_UserMetadataByPrincipal(t *testing.T) {
 		{
 			name:       "success=false returns error",
 			reply:      replyMsg([]byte(`{"success":false,"error":"not found"}`)),
+			wantErr:    domain.ErrUserNotFound,
 			wantErrStr: "user metadata not found",
 		},
 		{
@@ -197,6 +199,9 @@ func TestUserReaderNATS_UserMetadataByPrincipal(t *testing.T) {
 			if tt.wantErrStr != "" {
 				require.Error(t, err)
 				assert.Contains(t, err.Error(), tt.wantErrStr)
+				if tt.wantErr != nil {
+					assert.ErrorIs(t, err, tt.wantErr)
+				}
 				assert.Nil(t, got)
 			} else {
 				require.NoError(t, err)
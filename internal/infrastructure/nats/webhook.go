@@ -0,0 +1,217 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// webhookDeliveryKey is the key of a delivery in the webhook deliveries bucket.
+// Keys are prefixed with the webhook ID, so that the deliveries of one webhook
+// can be listed and removed together.
+func webhookDeliveryKey(webhookID, deliveryID string) string {
+	return webhookID + "." + deliveryID
+}
+
+// CreateWebhook stores a new webhook keyed by its ID.
+func (s *NatsRepository) CreateWebhook(ctx context.Context, webhook *models.Webhook) error {
+	data, err := json.Marshal(webhook)
+	if err != nil {
+		slog.ErrorContext(ctx, "error marshalling webhook into JSON", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	if _, err := s.Webhooks.Create(ctx, webhook.ID, data); err != nil {
+		slog.ErrorContext(ctx, "error creating webhook in NATS KV store", constants.ErrKey, err, "webhook_id", webhook.ID)
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+// GetWebhook returns a webhook.
+func (s *NatsRepository) GetWebhook(ctx context.Context, webhookID string) (*models.Webhook, error) {
+	entry, err := s.Webhooks.Get(ctx, webhookID)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, domain.ErrWebhookNotFound
+		}
+		slog.ErrorContext(ctx, "error getting webhook from NATS KV store", constants.ErrKey, err, "webhook_id", webhookID)
+		return nil, domain.ErrInternal
+	}
+
+	webhook := &models.Webhook{}
+	if err := json.Unmarshal(entry.Value(), webhook); err != nil {
+		slog.ErrorContext(ctx, "error unmarshalling webhook from NATS KV store", constants.ErrKey, err, "webhook_id", webhookID)
+		return nil, domain.ErrUnmarshal
+	}
+	return webhook, nil
+}
+
+// ListWebhooks returns every webhook, oldest first.
+func (s *NatsRepository) ListWebhooks(ctx context.Context) ([]*models.Webhook, error) {
+	keysLister, err := s.Webhooks.ListKeys(ctx)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return []*models.Webhook{}, nil
+		}
+		slog.ErrorContext(ctx, "error listing webhook keys from NATS KV store", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+
+	webhooks := []*models.Webhook{}
+	for key := range keysLister.Keys() {
+		webhook, err := s.GetWebhook(ctx, key)
+		if err != nil {
+			if errors.Is(err, domain.ErrWebhookNotFound) {
+				// Deleted since the keys were listed.
+				continue
+			}
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	sort.Slice(webhooks, func(i, j int) bool {
+		return webhooks[i].CreatedAt.Before(webhooks[j].CreatedAt)
+	})
+
+	return webhooks, nil
+}
+
+// DeleteWebhook removes a webhook and its deliveries. Deliveries are removed
+// first, so that a failure leaves the webhook in place to be deleted again.
+func (s *NatsRepository) DeleteWebhook(ctx context.Context, webhookID string) error {
+	if _, err := s.GetWebhook(ctx, webhookID); err != nil {
+		return err
+	}
+
+	keys, err := s.webhookDeliveryKeys(ctx, webhookID)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := s.WebhookDeliveries.Purge(ctx, key); err != nil {
+			slog.ErrorContext(ctx, "error purging webhook delivery from NATS KV store", constants.ErrKey, err, "webhook_id", webhookID, "key", key)
+			return domain.ErrInternal
+		}
+	}
+
+	if err := s.Webhooks.Purge(ctx, webhookID); err != nil {
+		slog.ErrorContext(ctx, "error purging webhook from NATS KV store", constants.ErrKey, err, "webhook_id", webhookID)
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+// CreateWebhookDelivery stores a new delivery, keyed by its webhook and event.
+func (s *NatsRepository) CreateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	data, err := json.Marshal(delivery)
+	if err != nil {
+		slog.ErrorContext(ctx, "error marshalling webhook delivery into JSON", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	key := webhookDeliveryKey(delivery.WebhookID, delivery.ID)
+	revision, err := s.WebhookDeliveries.Create(ctx, key, data)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyExists) {
+			return domain.ErrWebhookDeliveryExists
+		}
+		slog.ErrorContext(ctx, "error creating webhook delivery in NATS KV store", constants.ErrKey, err, "key", key)
+		return domain.ErrInternal
+	}
+	delivery.Revision = revision
+	return nil
+}
+
+// UpdateWebhookDelivery overwrites a delivery at its revision, e.g. to claim it
+// or to record an attempt. It returns domain.ErrRevisionMismatch when the
+// delivery was changed or removed since it was read.
+func (s *NatsRepository) UpdateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	data, err := json.Marshal(delivery)
+	if err != nil {
+		slog.ErrorContext(ctx, "error marshalling webhook delivery into JSON", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	key := webhookDeliveryKey(delivery.WebhookID, delivery.ID)
+	revision, err := s.WebhookDeliveries.Update(ctx, key, data, delivery.Revision)
+	if err != nil {
+		if isWrongLastSequence(err) {
+			return domain.ErrRevisionMismatch
+		}
+		slog.ErrorContext(ctx, "error updating webhook delivery in NATS KV store", constants.ErrKey, err, "key", key)
+		return domain.ErrInternal
+	}
+	delivery.Revision = revision
+	return nil
+}
+
+// ListWebhookDeliveries returns the deliveries of a webhook, or of every webhook
+// when webhookID is empty, newest first.
+func (s *NatsRepository) ListWebhookDeliveries(ctx context.Context, webhookID string) ([]*models.WebhookDelivery, error) {
+	keys, err := s.webhookDeliveryKeys(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := []*models.WebhookDelivery{}
+	for _, key := range keys {
+		entry, err := s.WebhookDeliveries.Get(ctx, key)
+		if err != nil {
+			if errors.Is(err, jetstream.ErrKeyNotFound) {
+				// Expired or deleted with its webhook since the keys were listed.
+				continue
+			}
+			slog.ErrorContext(ctx, "error getting webhook delivery from NATS KV store", constants.ErrKey, err, "key", key)
+			return nil, domain.ErrInternal
+		}
+
+		delivery := &models.WebhookDelivery{}
+		if err := json.Unmarshal(entry.Value(), delivery); err != nil {
+			slog.ErrorContext(ctx, "error unmarshalling webhook delivery from NATS KV store", constants.ErrKey, err, "key", key)
+			return nil, domain.ErrUnmarshal
+		}
+		delivery.Revision = entry.Revision()
+		deliveries = append(deliveries, delivery)
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].CreatedAt.After(deliveries[j].CreatedAt)
+	})
+
+	return deliveries, nil
+}
+
+// webhookDeliveryKeys lists the delivery keys of a webhook, or every delivery
+// key when webhookID is empty.
+func (s *NatsRepository) webhookDeliveryKeys(ctx context.Context, webhookID string) ([]string, error) {
+	keysLister, err := s.WebhookDeliveries.ListKeys(ctx)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return nil, nil
+		}
+		slog.ErrorContext(ctx, "error listing webhook delivery keys from NATS KV store", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+
+	var keys []string
+	for key := range keysLister.Keys() {
+		if webhookID == "" || strings.HasPrefix(key, webhookID+".") {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
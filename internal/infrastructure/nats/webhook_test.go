@@ -0,0 +1,190 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNatsRepository_CreateWebhookDelivery(t *testing.T) {
+	tests := []struct {
+		name        string
+		createErr   error
+		expectedErr error
+	}{
+		{name: "stores the delivery under its webhook"},
+		{name: "event already recorded", createErr: jetstream.ErrKeyExists, expectedErr: domain.ErrWebhookDeliveryExists},
+		{name: "store error", createErr: errors.New("nats error"), expectedErr: domain.ErrInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deliveriesKV := &MockKeyValue{}
+			deliveriesKV.On("Create", mock.Anything, "hook-1.event-1", mock.Anything).Return(uint64(1), tt.createErr)
+			repo := &NatsRepository{WebhookDeliveries: deliveriesKV}
+
+			delivery := &models.WebhookDelivery{ID: "event-1", WebhookID: "hook-1"}
+			err := repo.CreateWebhookDelivery(context.Background(), delivery)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, uint64(1), delivery.Revision)
+			}
+			deliveriesKV.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNatsRepository_UpdateWebhookDelivery(t *testing.T) {
+	t.Run("updates the delivery at its revision", func(t *testing.T) {
+		deliveriesKV := &MockKeyValue{}
+		deliveriesKV.On("Update", mock.Anything, "hook-1.event-1", mock.Anything, uint64(3)).Return(uint64(4), nil)
+		repo := &NatsRepository{WebhookDeliveries: deliveriesKV}
+		delivery := &models.WebhookDelivery{ID: "event-1", WebhookID: "hook-1", Attempts: 1, Revision: 3}
+
+		err := repo.UpdateWebhookDelivery(context.Background(), delivery)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(4), delivery.Revision)
+		deliveriesKV.AssertExpectations(t)
+	})
+
+	t.Run("does not overwrite a delivery claimed since", func(t *testing.T) {
+		deliveriesKV := &MockKeyValue{}
+		deliveriesKV.On("Update", mock.Anything, "hook-1.event-1", mock.Anything, uint64(3)).Return(uint64(0), jetstream.ErrKeyExists)
+		repo := &NatsRepository{WebhookDeliveries: deliveriesKV}
+
+		err := repo.UpdateWebhookDelivery(context.Background(), &models.WebhookDelivery{ID: "event-1", WebhookID: "hook-1", Revision: 3})
+
+		assert.ErrorIs(t, err, domain.ErrRevisionMismatch)
+		deliveriesKV.AssertExpectations(t)
+	})
+}
+
+func TestNatsRepository_ListWebhookDeliveries(t *testing.T) {
+	now := time.Now()
+	older, _ := json.Marshal(&models.WebhookDelivery{ID: "event-1", WebhookID: "hook-1", CreatedAt: now.Add(-time.Minute)})
+	newer, _ := json.Marshal(&models.WebhookDelivery{ID: "event-2", WebhookID: "hook-1", CreatedAt: now})
+	other, _ := json.Marshal(&models.WebhookDelivery{ID: "event-1", WebhookID: "hook-2", CreatedAt: now})
+
+	tests := []struct {
+		name        string
+		webhookID   string
+		setupMock   func(kv *MockKeyValue)
+		expectedIDs []string
+		expectedErr error
+	}{
+		{
+			name:      "returns the webhook's deliveries newest first",
+			webhookID: "hook-1",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("ListKeys", mock.Anything).Return(NewMockKeyLister([]string{"hook-1.event-1", "hook-2.event-1", "hook-1.event-2"}), nil)
+				kv.On("Get", mock.Anything, "hook-1.event-1").Return(NewMockKeyValueEntry(older, 1), nil)
+				kv.On("Get", mock.Anything, "hook-1.event-2").Return(NewMockKeyValueEntry(newer, 3), nil)
+			},
+			expectedIDs: []string{"hook-1/event-2", "hook-1/event-1"},
+		},
+		{
+			name: "returns every delivery without a webhook ID",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("ListKeys", mock.Anything).Return(NewMockKeyLister([]string{"hook-1.event-1", "hook-2.event-1"}), nil)
+				kv.On("Get", mock.Anything, "hook-1.event-1").Return(NewMockKeyValueEntry(older, 1), nil)
+				kv.On("Get", mock.Anything, "hook-2.event-1").Return(NewMockKeyValueEntry(other, 2), nil)
+			},
+			expectedIDs: []string{"hook-2/event-1", "hook-1/event-1"},
+		},
+		{
+			name:      "skips deliveries removed since listing",
+			webhookID: "hook-1",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("ListKeys", mock.Anything).Return(NewMockKeyLister([]string{"hook-1.event-1", "hook-1.event-2"}), nil)
+				kv.On("Get", mock.Anything, "hook-1.event-1").Return(nil, jetstream.ErrKeyNotFound)
+				kv.On("Get", mock.Anything, "hook-1.event-2").Return(NewMockKeyValueEntry(newer, 3), nil)
+			},
+			expectedIDs: []string{"hook-1/event-2"},
+		},
+		{
+			name:      "empty bucket",
+			webhookID: "hook-1",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("ListKeys", mock.Anything).Return(nil, jetstream.ErrNoKeysFound)
+			},
+			expectedIDs: []string{},
+		},
+		{
+			name:      "corrupt delivery",
+			webhookID: "hook-1",
+			setupMock: func(kv *MockKeyValue) {
+				kv.On("ListKeys", mock.Anything).Return(NewMockKeyLister([]string{"hook-1.event-1"}), nil)
+				kv.On("Get", mock.Anything, "hook-1.event-1").Return(NewMockKeyValueEntry([]byte("not json"), 1), nil)
+			},
+			expectedErr: domain.ErrUnmarshal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deliveriesKV := &MockKeyValue{}
+			tt.setupMock(deliveriesKV)
+			repo := &NatsRepository{WebhookDeliveries: deliveriesKV}
+
+			deliveries, err := repo.ListWebhookDeliveries(context.Background(), tt.webhookID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, deliveries)
+			} else {
+				require.NoError(t, err)
+				ids := []string{}
+				for _, delivery := range deliveries {
+					ids = append(ids, delivery.WebhookID+"/"+delivery.ID)
+					assert.NotZero(t, delivery.Revision)
+				}
+				assert.Equal(t, tt.expectedIDs, ids)
+			}
+			deliveriesKV.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNatsRepository_DeleteWebhook(t *testing.T) {
+	webhook, _ := json.Marshal(&models.Webhook{ID: "hook-1"})
+
+	webhooksKV := &MockKeyValue{}
+	webhooksKV.On("Get", mock.Anything, "hook-1").Return(NewMockKeyValueEntry(webhook, 1), nil)
+	webhooksKV.On("Purge", mock.Anything, "hook-1").Return(nil)
+	deliveriesKV := &MockKeyValue{}
+	deliveriesKV.On("ListKeys", mock.Anything).Return(NewMockKeyLister([]string{"hook-1.event-1", "hook-10.event-1"}), nil)
+	deliveriesKV.On("Purge", mock.Anything, "hook-1.event-1").Return(nil)
+	repo := &NatsRepository{Webhooks: webhooksKV, WebhookDeliveries: deliveriesKV}
+
+	require.NoError(t, repo.DeleteWebhook(context.Background(), "hook-1"))
+
+	deliveriesKV.AssertNotCalled(t, "Purge", mock.Anything, "hook-10.event-1")
+	webhooksKV.AssertExpectations(t)
+	deliveriesKV.AssertExpectations(t)
+}
+
+func TestNatsRepository_DeleteWebhook_notFound(t *testing.T) {
+	webhooksKV := &MockKeyValue{}
+	webhooksKV.On("Get", mock.Anything, "hook-1").Return(nil, jetstream.ErrKeyNotFound)
+	repo := &NatsRepository{Webhooks: webhooksKV}
+
+	err := repo.DeleteWebhook(context.Background(), "hook-1")
+
+	assert.ErrorIs(t, err, domain.ErrWebhookNotFound)
+}
@@ -0,0 +1,68 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/metrics"
+)
+
+// WorkerPool runs NATS message handlers on a bounded number of goroutines,
+// each with a deadline, so that a burst of messages cannot take every
+// connection and CPU away from the HTTP API.
+type WorkerPool struct {
+	// slots holds a token per running handler; nil means no limit.
+	slots   chan struct{}
+	timeout time.Duration
+	wg      sync.WaitGroup
+}
+
+// NewWorkerPool returns a pool that runs at most concurrency handlers at once
+// and gives each of them timeout to finish. A concurrency or timeout of zero
+// means no limit.
+func NewWorkerPool(concurrency int, timeout time.Duration) *WorkerPool {
+	pool := &WorkerPool{timeout: timeout}
+	if concurrency > 0 {
+		pool.slots = make(chan struct{}, concurrency)
+	}
+	return pool
+}
+
+// Go runs handle for a message received on subject with a context that
+// expires after the handler timeout. While every worker is busy it blocks, so
+// messages wait in the subscription's pending buffer, and NATS reports a slow
+// consumer once that is full.
+func (p *WorkerPool) Go(ctx context.Context, subject string, handle func(ctx context.Context)) {
+	queued := time.Now()
+	if p.slots != nil {
+		p.slots <- struct{}{}
+	}
+	p.wg.Add(1)
+	done := metrics.StartNATSHandler(subject, queued)
+
+	go func() {
+		defer func() {
+			done()
+			if p.slots != nil {
+				<-p.slots
+			}
+			p.wg.Done()
+		}()
+
+		if p.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, p.timeout)
+			defer cancel()
+		}
+		handle(ctx)
+	}()
+}
+
+// Wait blocks until the running handlers have returned.
+func (p *WorkerPool) Wait() {
+	p.wg.Wait()
+}
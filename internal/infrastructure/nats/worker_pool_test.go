@@ -0,0 +1,81 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package nats
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerPool_concurrency(t *testing.T) {
+	pool := NewWorkerPool(2, 0)
+	release := make(chan struct{})
+	var running, maxRunning atomic.Int32
+
+	dispatched := make(chan struct{})
+	go func() {
+		for i := 0; i < 5; i++ {
+			pool.Go(context.Background(), "test.subject", func(context.Context) {
+				n := running.Add(1)
+				for {
+					m := maxRunning.Load()
+					if n <= m || maxRunning.CompareAndSwap(m, n) {
+						break
+					}
+				}
+				<-release
+				running.Add(-1)
+			})
+		}
+		close(dispatched)
+	}()
+
+	assert.Eventually(t, func() bool { return running.Load() == 2 }, time.Second, time.Millisecond)
+	select {
+	case <-dispatched:
+		t.Fatal("dispatch did not block while every worker was busy")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	<-dispatched
+	pool.Wait()
+	assert.Equal(t, int32(2), maxRunning.Load())
+}
+
+func TestWorkerPool_timeout(t *testing.T) {
+	pool := NewWorkerPool(1, 10*time.Millisecond)
+
+	var err error
+	pool.Go(context.Background(), "test.subject", func(ctx context.Context) {
+		<-ctx.Done()
+		err = ctx.Err()
+	})
+	pool.Wait()
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWorkerPool_unlimited(t *testing.T) {
+	pool := NewWorkerPool(0, 0)
+	release := make(chan struct{})
+	var running atomic.Int32
+
+	for i := 0; i < 10; i++ {
+		pool.Go(context.Background(), "test.subject", func(ctx context.Context) {
+			_, hasDeadline := ctx.Deadline()
+			assert.False(t, hasDeadline)
+			running.Add(1)
+			<-release
+		})
+	}
+
+	assert.Eventually(t, func() bool { return running.Load() == 10 }, time.Second, time.Millisecond)
+	close(release)
+	pool.Wait()
+}
@@ -1,7 +1,8 @@
 // Copyright The Linux Foundation and each contributor to LFX.
 // SPDX-License-Identifier: MIT
 
-// Package opensearch provides a shared OpenSearch client for operational tooling.
+// Package opensearch provides a shared OpenSearch client, used by operational
+// tooling and by the project search.
 package opensearch
 
 import (
@@ -0,0 +1,105 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package opensearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	opensearchgo "github.com/opensearch-project/opensearch-go/v2"
+)
+
+// resourcesIndex is the shared index the indexer writes LFX resources to.
+const resourcesIndex = "resources"
+
+// ProjectSearcher searches the projects in the shared resources index.
+type ProjectSearcher struct {
+	client *opensearchgo.Client
+}
+
+// NewProjectSearcher creates a ProjectSearcher that uses client.
+func NewProjectSearcher(client *opensearchgo.Client) *ProjectSearcher {
+	return &ProjectSearcher{client: client}
+}
+
+// SearchProjects returns the UIDs of at most limit projects whose name, slug or
+// description match query, best match first. Names and slugs weigh more than
+// descriptions.
+func (s *ProjectSearcher) SearchProjects(ctx context.Context, query string, limit int) ([]string, error) {
+	body, err := json.Marshal(map[string]any{
+		"size":    limit,
+		"_source": []string{"object_id"},
+		"query": map[string]any{
+			"bool": map[string]any{
+				"filter": []any{
+					map[string]any{"term": map[string]any{"object_type": "project"}},
+					map[string]any{"term": map[string]any{"latest": true}},
+				},
+				"must": map[string]any{
+					"multi_match": map[string]any{
+						"query":     query,
+						"fields":    []string{"name_and_aliases^3", "fulltext"},
+						"operator":  "and",
+						"fuzziness": "AUTO",
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.client.Search(
+		s.client.Search.WithContext(ctx),
+		s.client.Search.WithIndex(resourcesIndex),
+		s.client.Search.WithBody(bytes.NewReader(body)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("search request failed: %w", err)
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.IsError() {
+		raw, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("search error %s: %s", res.Status(), raw)
+	}
+
+	var result struct {
+		Hits struct {
+			Hits []struct {
+				Source struct {
+					ObjectID string `json:"object_id"`
+				} `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode search response: %w", err)
+	}
+
+	uids := make([]string, 0, len(result.Hits.Hits))
+	for _, hit := range result.Hits.Hits {
+		if hit.Source.ObjectID != "" {
+			uids = append(uids, hit.Source.ObjectID)
+		}
+	}
+	return uids, nil
+}
+
+// HealthCheck reports whether the resources index can be reached.
+func (s *ProjectSearcher) HealthCheck(ctx context.Context) error {
+	res, err := s.client.Indices.Exists([]string{resourcesIndex}, s.client.Indices.Exists.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.IsError() {
+		return fmt.Errorf("resources index unavailable: %s", res.Status())
+	}
+	return nil
+}
@@ -0,0 +1,503 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+// Package postgres implements the project repository on top of PostgreSQL, for
+// deployments that need relational queries, transactions spanning a project's
+// base and settings, and standard backup tooling.
+//
+// Project base and settings are stored as JSONB documents next to a few
+// promoted columns (slug, legacy ID, parent, stage, timestamps) that are indexed for
+// queries. Each row carries a revision that is incremented on every write and
+// plays the role of the NATS KV revision for ETag and optimistic concurrency
+// checks.
+package postgres
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+//go:embed schema.sql
+var schema string
+
+// uniqueViolation is the SQLSTATE code for a unique constraint violation.
+const uniqueViolation = "23505"
+
+// Repository is a [domain.ProjectRepository] backed by PostgreSQL.
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+// Connect opens a connection pool to the database at url.
+func Connect(ctx context.Context, url string) (*Repository, error) {
+	pool, err := pgxpool.New(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+	return &Repository{pool: pool}, nil
+}
+
+// Close closes the connection pool.
+func (r *Repository) Close() {
+	r.pool.Close()
+}
+
+// Migrate creates the tables and indexes used by the repository if they do not exist.
+func (r *Repository) Migrate(ctx context.Context) error {
+	_, err := r.pool.Exec(ctx, schema)
+	return err
+}
+
+// HealthCheck reports whether the database is reachable.
+func (r *Repository) HealthCheck(ctx context.Context) error {
+	return r.pool.Ping(ctx)
+}
+
+// CreateProject creates a project and its settings in a single transaction.
+func (r *Repository) CreateProject(ctx context.Context, projectBase *models.ProjectBase, projectSettings *models.ProjectSettings) error {
+	return r.inTx(ctx, func(tx pgx.Tx) error {
+		if err := insertProjectBase(ctx, tx, projectBase); err != nil {
+			if isUniqueViolation(err, "projects_slug_key") {
+				slog.WarnContext(ctx, "project slug already exists", constants.ErrKey, err)
+				return domain.ErrProjectSlugExists
+			}
+			if isUniqueViolation(err, "projects_legacy_id_key") {
+				slog.WarnContext(ctx, "project legacy ID already exists", constants.ErrKey, err)
+				return domain.ErrProjectLegacyIDExists
+			}
+			slog.ErrorContext(ctx, "error inserting project into database", constants.ErrKey, err)
+			return domain.ErrInternal
+		}
+
+		if projectSettings != nil {
+			if err := insertProjectSettings(ctx, tx, projectSettings); err != nil {
+				slog.ErrorContext(ctx, "error inserting project settings into database", constants.ErrKey, err)
+				return domain.ErrInternal
+			}
+		}
+
+		return nil
+	})
+}
+
+// ProjectExists checks if a project exists in the database.
+func (r *Repository) ProjectExists(ctx context.Context, projectUID string) (bool, error) {
+	var exists bool
+	err := r.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM projects WHERE uid = $1)`, projectUID).Scan(&exists)
+	if err != nil {
+		slog.ErrorContext(ctx, "error checking project existence in database", constants.ErrKey, err, "project_uid", projectUID)
+		return false, domain.ErrInternal
+	}
+
+	return exists, nil
+}
+
+// DeleteProject deletes a project and its settings if the project is at the given revision.
+func (r *Repository) DeleteProject(ctx context.Context, projectUID string, revision uint64) error {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM projects WHERE uid = $1 AND revision = $2`, projectUID, revision)
+	if err != nil {
+		slog.ErrorContext(ctx, "error deleting project from database", constants.ErrKey, err, "project_uid", projectUID)
+		return domain.ErrInternal
+	}
+	if tag.RowsAffected() == 0 {
+		return r.missingOrMismatch(ctx, "projects", projectUID)
+	}
+
+	return nil
+}
+
+// GetProjectBase gets the project base from the database.
+func (r *Repository) GetProjectBase(ctx context.Context, projectUID string) (*models.ProjectBase, error) {
+	projectBase, _, err := r.GetProjectBaseWithRevision(ctx, projectUID)
+	return projectBase, err
+}
+
+// GetProjectBaseWithRevision gets the project base from the database along with its revision.
+func (r *Repository) GetProjectBaseWithRevision(ctx context.Context, projectUID string) (*models.ProjectBase, uint64, error) {
+	return getWithRevision[models.ProjectBase](ctx, r.pool, `SELECT data, revision FROM projects WHERE uid = $1`, projectUID)
+}
+
+// UpdateProjectBase updates a project's base information if it is at the given revision.
+func (r *Repository) UpdateProjectBase(ctx context.Context, projectBase *models.ProjectBase, revision uint64) error {
+	tag, err := updateProjectBase(ctx, r.pool, projectBase, revision)
+	if err != nil {
+		if isUniqueViolation(err, "projects_slug_key") {
+			slog.WarnContext(ctx, "project slug already exists", constants.ErrKey, err)
+			return domain.ErrProjectSlugExists
+		}
+		if isUniqueViolation(err, "projects_legacy_id_key") {
+			slog.WarnContext(ctx, "project legacy ID already exists", constants.ErrKey, err)
+			return domain.ErrProjectLegacyIDExists
+		}
+		slog.ErrorContext(ctx, "error updating project in database", constants.ErrKey, err, "project_uid", projectBase.UID)
+		return domain.ErrInternal
+	}
+	if tag.RowsAffected() == 0 {
+		return r.missingOrMismatch(ctx, "projects", projectBase.UID)
+	}
+
+	return nil
+}
+
+// UpdateProjectBaseWithRetry applies modify to the stored project base and writes it
+// back. The row is locked for the duration of the update, so concurrent writers are
+// serialized instead of retried. The modifier must not change the project UID or slug.
+func (r *Repository) UpdateProjectBaseWithRetry(ctx context.Context, projectUID string, modify func(*models.ProjectBase) error) (*models.ProjectBase, error) {
+	var projectBase *models.ProjectBase
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		var revision uint64
+		var err error
+		projectBase, revision, err = getWithRevision[models.ProjectBase](ctx, tx, `SELECT data, revision FROM projects WHERE uid = $1 FOR UPDATE`, projectUID)
+		if err != nil {
+			return err
+		}
+
+		uid, slug := projectBase.UID, projectBase.Slug
+		if err := modify(projectBase); err != nil {
+			return err
+		}
+		if projectBase.UID != uid || projectBase.Slug != slug {
+			slog.ErrorContext(ctx, "project UID or slug changed in retrying update", "project_uid", projectUID)
+			return domain.ErrValidationFailed
+		}
+
+		if _, err := updateProjectBase(ctx, tx, projectBase, revision); err != nil {
+			slog.ErrorContext(ctx, "error updating project in database", constants.ErrKey, err, "project_uid", projectUID)
+			return domain.ErrInternal
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return projectBase, nil
+}
+
+// GetProjectSettings gets the project settings from the database.
+func (r *Repository) GetProjectSettings(ctx context.Context, projectUID string) (*models.ProjectSettings, error) {
+	projectSettings, _, err := r.GetProjectSettingsWithRevision(ctx, projectUID)
+	return projectSettings, err
+}
+
+// GetProjectSettingsWithRevision gets the project settings from the database along with its revision.
+func (r *Repository) GetProjectSettingsWithRevision(ctx context.Context, projectUID string) (*models.ProjectSettings, uint64, error) {
+	return getWithRevision[models.ProjectSettings](ctx, r.pool, `SELECT data, revision FROM project_settings WHERE uid = $1`, projectUID)
+}
+
+// UpdateProjectSettings updates a project's settings if they are at the given revision.
+func (r *Repository) UpdateProjectSettings(ctx context.Context, projectSettings *models.ProjectSettings, revision uint64) error {
+	tag, err := updateProjectSettings(ctx, r.pool, projectSettings, revision)
+	if err != nil {
+		slog.ErrorContext(ctx, "error updating project settings in database", constants.ErrKey, err, "project_uid", projectSettings.UID)
+		return domain.ErrInternal
+	}
+	if tag.RowsAffected() == 0 {
+		return r.missingOrMismatch(ctx, "project_settings", projectSettings.UID)
+	}
+
+	return nil
+}
+
+// UpdateProjectSettingsWithRetry applies modify to the stored project settings and
+// writes them back, holding a row lock for the duration of the update.
+func (r *Repository) UpdateProjectSettingsWithRetry(ctx context.Context, projectUID string, modify func(*models.ProjectSettings) error) (*models.ProjectSettings, error) {
+	var projectSettings *models.ProjectSettings
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		var revision uint64
+		var err error
+		projectSettings, revision, err = getWithRevision[models.ProjectSettings](ctx, tx, `SELECT data, revision FROM project_settings WHERE uid = $1 FOR UPDATE`, projectUID)
+		if err != nil {
+			return err
+		}
+
+		uid := projectSettings.UID
+		if err := modify(projectSettings); err != nil {
+			return err
+		}
+		if projectSettings.UID != uid {
+			slog.ErrorContext(ctx, "project settings UID changed in retrying update", "project_uid", projectUID)
+			return domain.ErrValidationFailed
+		}
+
+		if _, err := updateProjectSettings(ctx, tx, projectSettings, revision); err != nil {
+			slog.ErrorContext(ctx, "error updating project settings in database", constants.ErrKey, err, "project_uid", projectUID)
+			return domain.ErrInternal
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return projectSettings, nil
+}
+
+// GetProjectUIDFromSlug gets the project UID from the project slug.
+func (r *Repository) GetProjectUIDFromSlug(ctx context.Context, projectSlug string) (string, error) {
+	var projectUID string
+	err := r.pool.QueryRow(ctx, `SELECT uid FROM projects WHERE slug = $1`, projectSlug).Scan(&projectUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", domain.ErrProjectNotFound
+		}
+		slog.ErrorContext(ctx, "error getting project from database", constants.ErrKey, err)
+		return "", domain.ErrInternal
+	}
+
+	return projectUID, nil
+}
+
+// ProjectSlugExists checks if a project slug exists in the database.
+func (r *Repository) ProjectSlugExists(ctx context.Context, projectSlug string) (bool, error) {
+	var exists bool
+	err := r.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM projects WHERE slug = $1)`, projectSlug).Scan(&exists)
+	if err != nil {
+		slog.ErrorContext(ctx, "error checking project slug existence in database", constants.ErrKey, err)
+		return false, domain.ErrInternal
+	}
+
+	return exists, nil
+}
+
+// GetProjectUIDFromLegacyID gets the project UID from the project's LFX v1 ID.
+func (r *Repository) GetProjectUIDFromLegacyID(ctx context.Context, legacyID string) (string, error) {
+	var projectUID string
+	err := r.pool.QueryRow(ctx, `SELECT uid FROM projects WHERE legacy_id = $1`, legacyID).Scan(&projectUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", domain.ErrProjectNotFound
+		}
+		slog.ErrorContext(ctx, "error getting project from database", constants.ErrKey, err)
+		return "", domain.ErrInternal
+	}
+
+	return projectUID, nil
+}
+
+// ListAllProjects lists all projects and their settings from the database.
+func (r *Repository) ListAllProjects(ctx context.Context) ([]*models.ProjectBase, []*models.ProjectSettings, error) {
+	projectsBase, err := r.ListAllProjectsBase(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projectsSettings, err := r.ListAllProjectsSettings(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return projectsBase, projectsSettings, nil
+}
+
+// ListAllProjectsBase lists all project base data from the database.
+func (r *Repository) ListAllProjectsBase(ctx context.Context) ([]*models.ProjectBase, error) {
+	return listAll[models.ProjectBase](ctx, r.pool, `SELECT data FROM projects ORDER BY uid`)
+}
+
+// ListAllProjectsSettings lists all project settings data from the database.
+func (r *Repository) ListAllProjectsSettings(ctx context.Context) ([]*models.ProjectSettings, error) {
+	return listAll[models.ProjectSettings](ctx, r.pool, `SELECT data FROM project_settings ORDER BY uid`)
+}
+
+// StreamProjectsBase calls fn with each project base as its row is read.
+func (r *Repository) StreamProjectsBase(ctx context.Context, fn func(*models.ProjectBase) error) error {
+	return streamAll(ctx, r.pool, `SELECT data FROM projects`, fn)
+}
+
+// StreamProjectsSettings calls fn with the settings of each project as their
+// row is read.
+func (r *Repository) StreamProjectsSettings(ctx context.Context, fn func(*models.ProjectSettings) error) error {
+	return streamAll(ctx, r.pool, `SELECT data FROM project_settings`, fn)
+}
+
+// querier is the subset of [pgxpool.Pool] and [pgx.Tx] used by the queries below.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// inTx runs fn in a transaction, committing if it returns nil and rolling back otherwise.
+func (r *Repository) inTx(ctx context.Context, fn func(pgx.Tx) error) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "error starting database transaction", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "error committing database transaction", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	return nil
+}
+
+// missingOrMismatch explains why a conditional write on table matched no row.
+func (r *Repository) missingOrMismatch(ctx context.Context, table, projectUID string) error {
+	var exists bool
+	err := r.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM `+pgx.Identifier{table}.Sanitize()+` WHERE uid = $1)`, projectUID).Scan(&exists)
+	if err != nil {
+		slog.ErrorContext(ctx, "error checking row existence in database", constants.ErrKey, err, "project_uid", projectUID)
+		return domain.ErrInternal
+	}
+	if !exists {
+		return domain.ErrProjectNotFound
+	}
+
+	slog.WarnContext(ctx, "revision mismatch", "project_uid", projectUID, "table", table)
+	return domain.ErrRevisionMismatch
+}
+
+func insertProjectBase(ctx context.Context, q querier, projectBase *models.ProjectBase) error {
+	data, err := json.Marshal(projectBase)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.Exec(ctx, `
+		INSERT INTO projects (uid, slug, legacy_id, parent_uid, stage, data, created_at, updated_at)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, $7, $8)`,
+		projectBase.UID, projectBase.Slug, projectBase.LegacyID, projectBase.ParentUID, projectBase.Stage, data, projectBase.CreatedAt, projectBase.UpdatedAt,
+	)
+	return err
+}
+
+func updateProjectBase(ctx context.Context, q querier, projectBase *models.ProjectBase, revision uint64) (pgconn.CommandTag, error) {
+	data, err := json.Marshal(projectBase)
+	if err != nil {
+		return pgconn.CommandTag{}, err
+	}
+
+	return q.Exec(ctx, `
+		UPDATE projects
+		SET slug = $2, legacy_id = NULLIF($3, ''), parent_uid = $4, stage = $5, data = $6, updated_at = $7, revision = revision + 1
+		WHERE uid = $1 AND revision = $8`,
+		projectBase.UID, projectBase.Slug, projectBase.LegacyID, projectBase.ParentUID, projectBase.Stage, data, projectBase.UpdatedAt, revision,
+	)
+}
+
+func insertProjectSettings(ctx context.Context, q querier, projectSettings *models.ProjectSettings) error {
+	data, err := json.Marshal(projectSettings)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.Exec(ctx, `
+		INSERT INTO project_settings (uid, data, created_at, updated_at)
+		VALUES ($1, $2, $3, $4)`,
+		projectSettings.UID, data, projectSettings.CreatedAt, projectSettings.UpdatedAt,
+	)
+	return err
+}
+
+func updateProjectSettings(ctx context.Context, q querier, projectSettings *models.ProjectSettings, revision uint64) (pgconn.CommandTag, error) {
+	data, err := json.Marshal(projectSettings)
+	if err != nil {
+		return pgconn.CommandTag{}, err
+	}
+
+	return q.Exec(ctx, `
+		UPDATE project_settings
+		SET data = $2, updated_at = $3, revision = revision + 1
+		WHERE uid = $1 AND revision = $4`,
+		projectSettings.UID, data, projectSettings.UpdatedAt, revision,
+	)
+}
+
+// getWithRevision runs a query selecting the JSON document and revision of a
+// single row keyed by projectUID.
+func getWithRevision[T any](ctx context.Context, q querier, sql, projectUID string) (*T, uint64, error) {
+	var data []byte
+	var revision uint64
+	err := q.QueryRow(ctx, sql, projectUID).Scan(&data, &revision)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, 0, domain.ErrProjectNotFound
+		}
+		slog.ErrorContext(ctx, "error getting project from database", constants.ErrKey, err, "project_uid", projectUID)
+		return nil, 0, domain.ErrInternal
+	}
+
+	value := new(T)
+	if err := json.Unmarshal(data, value); err != nil {
+		slog.ErrorContext(ctx, "error unmarshalling project from database", constants.ErrKey, err, "project_uid", projectUID)
+		return nil, 0, domain.ErrUnmarshal
+	}
+
+	return value, revision, nil
+}
+
+// listAll runs a query selecting JSON documents and decodes every row.
+func listAll[T any](ctx context.Context, q querier, sql string) ([]*T, error) {
+	values := []*T{}
+	err := streamAll(ctx, q, sql, func(value *T) error {
+		values = append(values, value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// streamAll runs sql, which selects one JSON data column, and calls fn with
+// each row's value as it is read. It stops at and returns the first error of
+// fn.
+func streamAll[T any](ctx context.Context, q querier, sql string, fn func(*T) error) error {
+	rows, err := q.Query(ctx, sql)
+	if err != nil {
+		slog.ErrorContext(ctx, "error listing projects from database", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			slog.ErrorContext(ctx, "error reading project row from database", constants.ErrKey, err)
+			return domain.ErrInternal
+		}
+		value := new(T)
+		if err := json.Unmarshal(data, value); err != nil {
+			slog.ErrorContext(ctx, "error unmarshalling project from database", constants.ErrKey, err)
+			return domain.ErrUnmarshal
+		}
+		if err := fn(value); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		slog.ErrorContext(ctx, "error listing projects from database", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	return nil
+}
+
+// isUniqueViolation reports whether err is a violation of the named unique constraint.
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == constraint
+}
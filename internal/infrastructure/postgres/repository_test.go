@@ -0,0 +1,113 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+var _ domain.ProjectRepository = (*Repository)(nil)
+
+func TestIsUniqueViolation(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "slug constraint",
+			err:      &pgconn.PgError{Code: uniqueViolation, ConstraintName: "projects_slug_key"},
+			expected: true,
+		},
+		{
+			name:     "wrapped slug constraint",
+			err:      fmt.Errorf("insert: %w", &pgconn.PgError{Code: uniqueViolation, ConstraintName: "projects_slug_key"}),
+			expected: true,
+		},
+		{
+			name: "other constraint",
+			err:  &pgconn.PgError{Code: uniqueViolation, ConstraintName: "projects_pkey"},
+		},
+		{
+			name: "other error code",
+			err:  &pgconn.PgError{Code: "23503", ConstraintName: "projects_slug_key"},
+		},
+		{
+			name: "not a database error",
+			err:  errors.New("connection refused"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isUniqueViolation(tt.err, "projects_slug_key"))
+		})
+	}
+}
+
+// TestRepository_integration exercises the repository against a real database.
+// It runs only when POSTGRES_TEST_URL points at a disposable database.
+func TestRepository_integration(t *testing.T) {
+	url := os.Getenv("POSTGRES_TEST_URL")
+	if url == "" {
+		t.Skip("POSTGRES_TEST_URL not set")
+	}
+
+	ctx := context.Background()
+	repo, err := Connect(ctx, url)
+	require.NoError(t, err)
+	defer repo.Close()
+	require.NoError(t, repo.Migrate(ctx))
+
+	uid := fmt.Sprintf("test-%d", time.Now().UnixNano())
+	now := time.Now().UTC()
+	base := &models.ProjectBase{UID: uid, Slug: uid, Name: "Test", Stage: "Active", CreatedAt: &now, UpdatedAt: &now}
+	settings := &models.ProjectSettings{UID: uid, MissionStatement: "Test", CreatedAt: &now, UpdatedAt: &now}
+	t.Cleanup(func() {
+		_, _ = repo.pool.Exec(context.Background(), `DELETE FROM projects WHERE uid = $1`, uid)
+	})
+
+	require.NoError(t, repo.CreateProject(ctx, base, settings))
+	assert.ErrorIs(t, repo.CreateProject(ctx, &models.ProjectBase{UID: uid + "-2", Slug: uid}, nil), domain.ErrProjectSlugExists)
+
+	gotUID, err := repo.GetProjectUIDFromSlug(ctx, uid)
+	require.NoError(t, err)
+	assert.Equal(t, uid, gotUID)
+
+	got, revision, err := repo.GetProjectBaseWithRevision(ctx, uid)
+	require.NoError(t, err)
+	assert.Equal(t, "Test", got.Name)
+	assert.Equal(t, uint64(1), revision)
+
+	got.Name = "Renamed"
+	require.NoError(t, repo.UpdateProjectBase(ctx, got, revision))
+	assert.ErrorIs(t, repo.UpdateProjectBase(ctx, got, revision), domain.ErrRevisionMismatch)
+
+	updated, err := repo.UpdateProjectSettingsWithRetry(ctx, uid, func(s *models.ProjectSettings) error {
+		s.MissionStatement = "Updated"
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Updated", updated.MissionStatement)
+
+	_, revision, err = repo.GetProjectBaseWithRevision(ctx, uid)
+	require.NoError(t, err)
+	require.NoError(t, repo.DeleteProject(ctx, uid, revision))
+
+	_, err = repo.GetProjectSettings(ctx, uid)
+	assert.ErrorIs(t, err, domain.ErrProjectNotFound)
+	assert.ErrorIs(t, repo.DeleteProject(ctx, uid, revision), domain.ErrProjectNotFound)
+}
@@ -0,0 +1,30 @@
+-- Copyright The Linux Foundation and each contributor to LFX.
+-- SPDX-License-Identifier: MIT
+
+-- Schema for the Postgres project repository. Statements are idempotent and
+-- are applied on startup by Repository.Migrate.
+
+CREATE TABLE IF NOT EXISTS projects (
+    uid         text PRIMARY KEY,
+    slug        text NOT NULL UNIQUE,
+    parent_uid  text NOT NULL DEFAULT '',
+    stage       text NOT NULL DEFAULT '',
+    data        jsonb NOT NULL,
+    revision    bigint NOT NULL DEFAULT 1,
+    created_at  timestamptz,
+    updated_at  timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS projects_parent_uid_idx ON projects (parent_uid);
+CREATE INDEX IF NOT EXISTS projects_stage_idx ON projects (stage);
+
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS legacy_id text;
+CREATE UNIQUE INDEX IF NOT EXISTS projects_legacy_id_key ON projects (legacy_id);
+
+CREATE TABLE IF NOT EXISTS project_settings (
+    uid         text PRIMARY KEY REFERENCES projects (uid) ON DELETE CASCADE,
+    data        jsonb NOT NULL,
+    revision    bigint NOT NULL DEFAULT 1,
+    created_at  timestamptz,
+    updated_at  timestamptz
+);
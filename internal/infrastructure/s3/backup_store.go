@@ -0,0 +1,122 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// backupContentType is the content type of backup archives, which hold one
+// JSON entry per line.
+const backupContentType = "application/x-ndjson"
+
+// BackupObjectAPI is the part of the S3 client used to store backups.
+type BackupObjectAPI interface {
+	PutObjectAPI
+	GetObject(ctx context.Context, params *awss3.GetObjectInput, optFns ...func(*awss3.Options)) (*awss3.GetObjectOutput, error)
+	awss3.ListObjectsV2APIClient
+}
+
+// BackupStore stores backup archives of the project stores in a private S3
+// bucket, under a key prefix so that the bucket can be shared.
+type BackupStore struct {
+	Client BackupObjectAPI
+	Bucket string
+	// Prefix is prepended to backup names to build their keys.
+	Prefix string
+}
+
+// NewBackupStore returns a store for the named bucket using the default AWS
+// credentials.
+func NewBackupStore(ctx context.Context, bucket, region, prefix string) (*BackupStore, error) {
+	client, err := newClient(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+	return &BackupStore{Client: client, Bucket: bucket, Prefix: prefix}, nil
+}
+
+// PutBackup stores an archive under name.
+func (s *BackupStore) PutBackup(ctx context.Context, name string, data []byte) error {
+	_, err := s.Client.PutObject(ctx, &awss3.PutObjectInput{
+		Bucket:      aws.String(s.Bucket),
+		Key:         aws.String(s.Prefix + name),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String(backupContentType),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "error putting backup into S3 bucket", constants.ErrKey, err, "bucket", s.Bucket, "key", s.Prefix+name)
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+// GetBackup returns the archive stored under name.
+func (s *BackupStore) GetBackup(ctx context.Context, name string) ([]byte, error) {
+	out, err := s.Client.GetObject(ctx, &awss3.GetObjectInput{
+		Bucket: aws.String(s.Bucket),
+		Key:    aws.String(s.Prefix + name),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, domain.ErrBackupNotFound
+		}
+		slog.ErrorContext(ctx, "error getting backup from S3 bucket", constants.ErrKey, err, "bucket", s.Bucket, "key", s.Prefix+name)
+		return nil, domain.ErrInternal
+	}
+	defer func() { _ = out.Body.Close() }()
+
+	data, err := io.ReadAll(out.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "error reading backup from S3 bucket", constants.ErrKey, err, "bucket", s.Bucket, "key", s.Prefix+name)
+		return nil, domain.ErrInternal
+	}
+	return data, nil
+}
+
+// ListBackups lists the stored archives, newest first.
+func (s *BackupStore) ListBackups(ctx context.Context) ([]models.BackupInfo, error) {
+	backups := []models.BackupInfo{}
+	paginator := awss3.NewListObjectsV2Paginator(s.Client, &awss3.ListObjectsV2Input{
+		Bucket: aws.String(s.Bucket),
+		Prefix: aws.String(s.Prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "error listing backups in S3 bucket", constants.ErrKey, err, "bucket", s.Bucket, "prefix", s.Prefix)
+			return nil, domain.ErrInternal
+		}
+		for _, object := range page.Contents {
+			name := strings.TrimPrefix(aws.ToString(object.Key), s.Prefix)
+			if name == "" || strings.Contains(name, "/") {
+				continue
+			}
+			backups = append(backups, models.BackupInfo{
+				Name:      name,
+				Size:      aws.ToInt64(object.Size),
+				CreatedAt: aws.ToTime(object.LastModified),
+			})
+		}
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].CreatedAt.After(backups[j].CreatedAt)
+	})
+	return backups, nil
+}
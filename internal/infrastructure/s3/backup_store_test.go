@@ -0,0 +1,85 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package s3
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+// fakeBackupObjects keeps the objects put into it in memory.
+type fakeBackupObjects struct {
+	fakePutObject
+	objects  map[string]string
+	modified map[string]time.Time
+}
+
+func (f *fakeBackupObjects) GetObject(_ context.Context, params *awss3.GetObjectInput, _ ...func(*awss3.Options)) (*awss3.GetObjectOutput, error) {
+	body, ok := f.objects[*params.Key]
+	if !ok {
+		return nil, &types.NoSuchKey{}
+	}
+	return &awss3.GetObjectOutput{Body: io.NopCloser(strings.NewReader(body))}, nil
+}
+
+func (f *fakeBackupObjects) ListObjectsV2(_ context.Context, params *awss3.ListObjectsV2Input, _ ...func(*awss3.Options)) (*awss3.ListObjectsV2Output, error) {
+	out := &awss3.ListObjectsV2Output{}
+	for key, body := range f.objects {
+		if strings.HasPrefix(key, *params.Prefix) {
+			out.Contents = append(out.Contents, types.Object{
+				Key:          aws.String(key),
+				Size:         aws.Int64(int64(len(body))),
+				LastModified: aws.Time(f.modified[key]),
+			})
+		}
+	}
+	return out, nil
+}
+
+func TestBackupStore(t *testing.T) {
+	older := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	client := &fakeBackupObjects{
+		objects: map[string]string{
+			"project-service/20250101T000000Z.ndjson":   "{}\n",
+			"project-service/20250102T000000Z.ndjson":   "{}\n{}\n",
+			"project-service/nested/ignored.ndjson":     "{}\n",
+			"committee-service/20250101T000000Z.ndjson": "{}\n",
+		},
+		modified: map[string]time.Time{
+			"project-service/20250101T000000Z.ndjson": older,
+			"project-service/20250102T000000Z.ndjson": older.Add(24 * time.Hour),
+		},
+	}
+	store := &BackupStore{Client: client, Bucket: "backups", Prefix: "project-service/"}
+
+	require.NoError(t, store.PutBackup(context.Background(), "20250103T000000Z.ndjson", []byte("{}\n")))
+	assert.Equal(t, "project-service/20250103T000000Z.ndjson", *client.input.Key)
+	assert.Equal(t, "application/x-ndjson", *client.input.ContentType)
+
+	data, err := store.GetBackup(context.Background(), "20250102T000000Z.ndjson")
+	require.NoError(t, err)
+	assert.Equal(t, "{}\n{}\n", string(data))
+
+	_, err = store.GetBackup(context.Background(), "missing.ndjson")
+	assert.ErrorIs(t, err, domain.ErrBackupNotFound)
+
+	backups, err := store.ListBackups(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []models.BackupInfo{
+		{Name: "20250102T000000Z.ndjson", Size: 6, CreatedAt: older.Add(24 * time.Hour)},
+		{Name: "20250101T000000Z.ndjson", Size: 3, CreatedAt: older},
+	}, backups)
+}
@@ -0,0 +1,67 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// charterCacheControl lets charters be cached indefinitely: each version is
+// stored under its own key and never replaced.
+const charterCacheControl = "public, max-age=31536000, immutable"
+
+// CharterStore stores project charter documents in an S3 bucket that is served publicly.
+type CharterStore struct {
+	Client PutObjectAPI
+	Bucket string
+	// BaseURL is the public URL of the bucket that charter URLs are built from.
+	BaseURL string
+}
+
+// NewCharterStore returns a store for the named bucket using the default AWS
+// credentials. Charter URLs are built from baseURL, or from the bucket's
+// public S3 address when it is empty.
+func NewCharterStore(ctx context.Context, bucket, region, baseURL string) (*CharterStore, error) {
+	client, err := newClient(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+	return &CharterStore{
+		Client:  client,
+		Bucket:  bucket,
+		BaseURL: publicBaseURL(bucket, region, baseURL),
+	}, nil
+}
+
+// PutCharter stores a PDF charter under key and returns its public URL.
+func (s *CharterStore) PutCharter(ctx context.Context, key string, data []byte) (string, error) {
+	_, err := s.Client.PutObject(ctx, &awss3.PutObjectInput{
+		Bucket:       aws.String(s.Bucket),
+		Key:          aws.String(key),
+		Body:         bytes.NewReader(data),
+		ContentType:  aws.String(models.CharterContentType),
+		CacheControl: aws.String(charterCacheControl),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "error putting charter into S3 bucket", constants.ErrKey, err, "bucket", s.Bucket, "key", key)
+		return "", domain.ErrInternal
+	}
+
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return s.BaseURL + "/" + strings.Join(segments, "/"), nil
+}
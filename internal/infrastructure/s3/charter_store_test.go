@@ -0,0 +1,36 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+)
+
+func TestCharterStore_PutCharter(t *testing.T) {
+	client := &fakePutObject{}
+	store := &CharterStore{Client: client, Bucket: "project-charters", BaseURL: "https://charters.example.org"}
+
+	charterURL, err := store.PutCharter(context.Background(), "project-1/6f1c3a52.pdf", []byte("%PDF-1.7"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "https://charters.example.org/project-1/6f1c3a52.pdf", charterURL)
+	assert.Equal(t, "project-charters", *client.input.Bucket)
+	assert.Equal(t, "project-1/6f1c3a52.pdf", *client.input.Key)
+	assert.Equal(t, "application/pdf", *client.input.ContentType)
+	assert.Equal(t, []byte("%PDF-1.7"), client.body)
+}
+
+func TestCharterStore_PutCharter_error(t *testing.T) {
+	store := &CharterStore{Client: &fakePutObject{err: errors.New("access denied")}, Bucket: "project-charters"}
+
+	_, err := store.PutCharter(context.Background(), "project-1/6f1c3a52.pdf", []byte("%PDF-1.7"))
+
+	assert.ErrorIs(t, err, domain.ErrInternal)
+}
@@ -0,0 +1,90 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+// Package s3 stores project files, such as logos and backups, in Amazon S3.
+package s3
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// logoCacheControl lets browsers and email clients cache logos briefly, so
+// that a replaced logo shows up within minutes.
+const logoCacheControl = "public, max-age=300"
+
+// PutObjectAPI is the part of the S3 client used to store files.
+type PutObjectAPI interface {
+	PutObject(ctx context.Context, params *awss3.PutObjectInput, optFns ...func(*awss3.Options)) (*awss3.PutObjectOutput, error)
+}
+
+// LogoStore stores project logos in an S3 bucket that is served publicly.
+type LogoStore struct {
+	Client PutObjectAPI
+	Bucket string
+	// BaseURL is the public URL of the bucket that logo URLs are built from.
+	BaseURL string
+}
+
+// NewLogoStore returns a store for the named bucket using the default AWS
+// credentials. Logo URLs are built from baseURL, or from the bucket's public
+// S3 address when it is empty.
+func NewLogoStore(ctx context.Context, bucket, region, baseURL string) (*LogoStore, error) {
+	client, err := newClient(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+	return &LogoStore{
+		Client:  client,
+		Bucket:  bucket,
+		BaseURL: publicBaseURL(bucket, region, baseURL),
+	}, nil
+}
+
+// newClient returns an S3 client for region using the default AWS credentials.
+func newClient(ctx context.Context, region string) (*awss3.Client, error) {
+	awsConfig, err := config.LoadDefaultConfig(ctx, config.WithRegion(region))
+	if err != nil {
+		return nil, fmt.Errorf("loading AWS config: %w", err)
+	}
+	return awss3.NewFromConfig(awsConfig, func(o *awss3.Options) {
+		o.UsePathStyle = true
+	}), nil
+}
+
+// publicBaseURL returns baseURL without a trailing slash, or the bucket's
+// public S3 address when it is empty.
+func publicBaseURL(bucket, region, baseURL string) string {
+	if baseURL == "" {
+		baseURL = fmt.Sprintf("https://%s.s3.%s.amazonaws.com", bucket, region)
+	}
+	return strings.TrimSuffix(baseURL, "/")
+}
+
+// PutLogo stores a logo file under name, replacing any previous version, and
+// returns its public URL.
+func (s *LogoStore) PutLogo(ctx context.Context, name, contentType string, data []byte) (string, error) {
+	_, err := s.Client.PutObject(ctx, &awss3.PutObjectInput{
+		Bucket:       aws.String(s.Bucket),
+		Key:          aws.String(name),
+		Body:         bytes.NewReader(data),
+		ContentType:  aws.String(contentType),
+		CacheControl: aws.String(logoCacheControl),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "error putting logo into S3 bucket", constants.ErrKey, err, "bucket", s.Bucket, "key", name)
+		return "", domain.ErrInternal
+	}
+	return s.BaseURL + "/" + url.PathEscape(name), nil
+}
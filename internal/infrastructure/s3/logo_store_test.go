@@ -0,0 +1,51 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+)
+
+// fakePutObject records the objects put into it.
+type fakePutObject struct {
+	input *awss3.PutObjectInput
+	body  []byte
+	err   error
+}
+
+func (f *fakePutObject) PutObject(_ context.Context, params *awss3.PutObjectInput, _ ...func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+	f.input = params
+	f.body, _ = io.ReadAll(params.Body)
+	return &awss3.PutObjectOutput{}, f.err
+}
+
+func TestLogoStore_PutLogo(t *testing.T) {
+	client := &fakePutObject{}
+	store := &LogoStore{Client: client, Bucket: "project-logos", BaseURL: "https://logos.example.org"}
+
+	logoURL, err := store.PutLogo(context.Background(), "project-1.png", "image/png", []byte("png"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "https://logos.example.org/project-1.png", logoURL)
+	assert.Equal(t, "project-logos", *client.input.Bucket)
+	assert.Equal(t, "project-1.png", *client.input.Key)
+	assert.Equal(t, "image/png", *client.input.ContentType)
+	assert.Equal(t, []byte("png"), client.body)
+}
+
+func TestLogoStore_PutLogo_error(t *testing.T) {
+	store := &LogoStore{Client: &fakePutObject{err: errors.New("access denied")}, Bucket: "project-logos"}
+
+	_, err := store.PutLogo(context.Background(), "project-1.svg", "image/svg+xml", []byte("<svg/>"))
+
+	assert.ErrorIs(t, err, domain.ErrInternal)
+}
@@ -0,0 +1,98 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+// Package webhook sends project lifecycle events to the HTTPS endpoints of
+// registered webhooks.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+// Headers set on every delivery.
+const (
+	// EventHeader carries the lifecycle event type, such as project.created.
+	EventHeader = "X-LFX-Event"
+	// DeliveryHeader carries the delivery ID, which is the event ID. A retried
+	// delivery keeps its ID, so receivers can use it to drop duplicates.
+	DeliveryHeader = "X-LFX-Delivery"
+	// TimestampHeader carries the Unix time the request was signed at.
+	TimestampHeader = "X-LFX-Timestamp"
+	// SignatureHeader carries "sha256=" and the hex HMAC-SHA256 of the
+	// timestamp, a period and the body, keyed with the webhook's secret.
+	SignatureHeader = "X-LFX-Signature-256"
+)
+
+const defaultSendTimeout = 10 * time.Second
+
+// Sender POSTs webhook deliveries over HTTPS.
+type Sender struct {
+	Client *http.Client
+	// Now returns the time deliveries are signed at.
+	Now func() time.Time
+}
+
+// NewSender returns a Sender that gives up on an endpoint after 10 seconds.
+// Redirects are not followed, as the signature is only meant for the
+// registered URL.
+func NewSender() *Sender {
+	return &Sender{
+		Client: &http.Client{
+			Timeout: defaultSendTimeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		Now: time.Now,
+	}
+}
+
+// Sign returns the signature of body sent at timestamp, in the form of the
+// SignatureHeader value.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// SendWebhook POSTs the delivery's payload to the webhook's URL and returns the
+// HTTP status of the response.
+func (s *Sender) SendWebhook(ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := s.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "lfx-v2-project-service")
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, delivery.ID)
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, timestamp, delivery.Payload))
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	// Drain a little of the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook endpoint returned %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
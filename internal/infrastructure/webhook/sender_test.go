@@ -0,0 +1,74 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+func TestSender_SendWebhook(t *testing.T) {
+	payload := []byte(`{"type":"project.created"}`)
+	signedAt := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name           string
+		status         int
+		expectedStatus int
+		wantErr        bool
+	}{
+		{name: "accepted", status: http.StatusNoContent, expectedStatus: http.StatusNoContent},
+		{name: "rejected", status: http.StatusInternalServerError, expectedStatus: http.StatusInternalServerError, wantErr: true},
+		{name: "redirect is not followed", status: http.StatusFound, expectedStatus: http.StatusFound, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Equal(t, payload, body)
+				assert.Equal(t, "project.created", r.Header.Get(EventHeader))
+				assert.Equal(t, "event-1", r.Header.Get(DeliveryHeader))
+				assert.Equal(t, "1700000000", r.Header.Get(TimestampHeader))
+				assert.Equal(t, Sign("secret", 1700000000, payload), r.Header.Get(SignatureHeader))
+				if tt.status == http.StatusFound {
+					w.Header().Set("Location", "/elsewhere")
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			sender := NewSender()
+			sender.Client.Transport = server.Client().Transport
+			sender.Now = func() time.Time { return signedAt }
+
+			status, err := sender.SendWebhook(context.Background(),
+				&models.Webhook{ID: "hook-1", URL: server.URL + "/hook", Secret: "secret"},
+				&models.WebhookDelivery{ID: "event-1", WebhookID: "hook-1", EventType: "project.created", Payload: payload},
+			)
+
+			assert.Equal(t, tt.expectedStatus, status)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	// printf '1700000000.{}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t, "sha256=b8569b78799ff9e3cbff0fc2d63a33a2b57f3282abd07c37ae5e8e7d79a5f163", Sign("secret", 1700000000, []byte("{}")))
+}
@@ -0,0 +1,233 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+)
+
+// maxAnnouncementDelay bounds how late an announcement is published, so that
+// enabling the scheduler does not announce projects whose date passed long ago.
+const maxAnnouncementDelay = 7 * 24 * time.Hour
+
+// errAnnouncementUnchanged aborts the project update of an announcement that
+// would not change the project's stage or visibility.
+var errAnnouncementUnchanged = errors.New("announcement does not change the project")
+
+// ListPendingAnnouncements returns the projects whose announcement date is set
+// and not yet announced, earliest date first, to LF staff or a trusted service
+// principal.
+func (s *ProjectsService) ListPendingAnnouncements(ctx context.Context) ([]*models.PendingAnnouncement, error) {
+	if s.AnnouncementRepository == nil {
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "list pending announcements"); err != nil {
+		return nil, err
+	}
+	return s.pendingAnnouncements(ctx, time.Now().UTC())
+}
+
+// PublishDueAnnouncements publishes a project.announced event for every pending
+// announcement whose date is reached and returns how many were published. When
+// configured, the announced projects are also made public or moved to
+// Config.AnnouncementStage. A failing project does not stop the others.
+func (s *ProjectsService) PublishDueAnnouncements(ctx context.Context, now time.Time) (int, error) {
+	if s.AnnouncementRepository == nil {
+		return 0, domain.ErrServiceUnavailable
+	}
+
+	pending, err := s.pendingAnnouncements(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, announcement := range pending {
+		if !announcement.Due {
+			continue
+		}
+		if ctx.Err() != nil {
+			return published, ctx.Err()
+		}
+		announced, err := s.publishAnnouncement(ctx, announcement, now)
+		if err != nil {
+			slog.WarnContext(ctx, "error publishing project announcement", constants.ErrKey, err, "project_uid", announcement.ProjectUID)
+			continue
+		}
+		if announced {
+			published++
+		}
+	}
+	return published, nil
+}
+
+// pendingAnnouncements returns the projects with an announcement date that was
+// not announced yet, leaving out dates more than maxAnnouncementDelay ago.
+func (s *ProjectsService) pendingAnnouncements(ctx context.Context, now time.Time) ([]*models.PendingAnnouncement, error) {
+	allSettings, err := s.ProjectRepository.ListAllProjectsSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	announced, err := s.AnnouncementRepository.ListProjectAnnouncements(ctx)
+	if err != nil {
+		return nil, err
+	}
+	done := make(map[string]bool, len(announced))
+	for _, announcement := range announced {
+		done[announcement.ProjectUID+"/"+announcementDay(announcement.AnnouncementDate)] = true
+	}
+
+	var candidates []*models.ProjectSettings
+	for _, settings := range allSettings {
+		if settings.AnnouncementDate == nil || now.Sub(*settings.AnnouncementDate) > maxAnnouncementDelay {
+			continue
+		}
+		if done[settings.UID+"/"+announcementDay(*settings.AnnouncementDate)] {
+			continue
+		}
+		candidates = append(candidates, settings)
+	}
+	pending := []*models.PendingAnnouncement{}
+	if len(candidates) == 0 {
+		return pending, nil
+	}
+
+	projects, err := s.ProjectRepository.ListAllProjectsBase(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projectsByUID := make(map[string]*models.ProjectBase, len(projects))
+	for _, project := range projects {
+		projectsByUID[project.UID] = project
+	}
+	for _, settings := range candidates {
+		project, ok := projectsByUID[settings.UID]
+		if !ok {
+			continue
+		}
+		pending = append(pending, &models.PendingAnnouncement{
+			ProjectUID:       project.UID,
+			Slug:             project.Slug,
+			Name:             project.Name,
+			AnnouncementDate: *settings.AnnouncementDate,
+			Due:              !settings.AnnouncementDate.After(now),
+		})
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		if !pending[i].AnnouncementDate.Equal(pending[j].AnnouncementDate) {
+			return pending[i].AnnouncementDate.Before(pending[j].AnnouncementDate)
+		}
+		return pending[i].Slug < pending[j].Slug
+	})
+	return pending, nil
+}
+
+// publishAnnouncement records one announcement and publishes its messages. It
+// reports false when another replica already recorded the announcement.
+//
+// The announcement is recorded first so that it is published at most once;
+// with an outbox, messages that fail to send are retried by the dispatcher.
+func (s *ProjectsService) publishAnnouncement(ctx context.Context, pending *models.PendingAnnouncement, now time.Time) (bool, error) {
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", pending.ProjectUID))
+
+	err := s.AnnouncementRepository.CreateProjectAnnouncement(ctx, &models.ProjectAnnouncement{
+		ProjectUID:       pending.ProjectUID,
+		AnnouncementDate: pending.AnnouncementDate,
+		AnnouncedAt:      now,
+	})
+	if errors.Is(err, domain.ErrAnnouncementExists) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	var previous *models.ProjectBase
+	projectDB, err := s.ProjectRepository.UpdateProjectBaseWithRetry(ctx, pending.ProjectUID, func(p *models.ProjectBase) error {
+		unchanged := *p
+		if s.Config.AnnouncementPublic {
+			p.Public = true
+		}
+		if s.Config.AnnouncementStage != "" {
+			p.Stage = s.Config.AnnouncementStage
+		}
+		if p.Public == unchanged.Public && p.Stage == unchanged.Stage {
+			return errAnnouncementUnchanged
+		}
+		previous = &unchanged
+		updatedAt := now
+		p.UpdatedAt = &updatedAt
+		return nil
+	})
+	if errors.Is(err, errAnnouncementUnchanged) {
+		projectDB, err = s.ProjectRepository.GetProjectBase(ctx, pending.ProjectUID)
+	}
+	if err != nil {
+		return true, err
+	}
+
+	settingsDB, err := s.ProjectRepository.GetProjectSettings(ctx, pending.ProjectUID)
+	if err != nil {
+		return true, err
+	}
+
+	var messages []outboundMessage
+	if previous != nil {
+		messages = projectBaseUpdatedMessages(ctx, projectDB, previous)
+	}
+	event := newProjectLifecycleEvent(ctx, events.ProjectAnnounced, projectDB.UID)
+	event.Project = DomainProjectToEvent(projectDB)
+	event.PreviousProject = DomainProjectToEvent(previous)
+	eventSettings := DomainSettingsToEvent(settingsDB)
+	event.Settings = &eventSettings
+	messages = append(messages, outboundMessage{
+		kind:    models.OutboxKindEvent,
+		subject: projectEventSubject(events.ProjectAnnounced),
+		message: event,
+	})
+	if err := s.publishProjectMessages(ctx, projectDB.UID, false, messages); err != nil {
+		return true, err
+	}
+
+	slog.InfoContext(ctx, "published project announcement",
+		"announcement_date", announcementDay(pending.AnnouncementDate),
+		"stage_or_visibility_changed", previous != nil,
+	)
+	return true, nil
+}
+
+// announcementDay formats an announcement date the way it is stored in settings.
+func announcementDay(date time.Time) string {
+	return date.UTC().Format(time.DateOnly)
+}
+
+// RunAnnouncementScheduler calls PublishDueAnnouncements every interval until ctx is cancelled.
+func (s *ProjectsService) RunAnnouncementScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			published, err := s.PublishDueAnnouncements(ctx, time.Now().UTC())
+			if err != nil {
+				slog.ErrorContext(ctx, "error publishing project announcements", constants.ErrKey, err)
+				continue
+			}
+			slog.DebugContext(ctx, "published due project announcements", "announcements_published", published)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+)
+
+func announcementDate(day string) *time.Time {
+	date, _ := time.Parse(time.DateOnly, day)
+	return &date
+}
+
+func TestProjectsService_pendingAnnouncements(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	service, mockRepo, _, _ := setupServiceForTesting()
+	announcements := &domain.MockAnnouncementRepository{}
+	service.AnnouncementRepository = announcements
+
+	mockRepo.On("ListAllProjectsSettings", mock.Anything).Return([]*models.ProjectSettings{
+		{UID: "future", AnnouncementDate: announcementDate("2025-06-10")},
+		{UID: "today", AnnouncementDate: announcementDate("2025-06-01")},
+		{UID: "announced", AnnouncementDate: announcementDate("2025-05-30")},
+		{UID: "moved", AnnouncementDate: announcementDate("2025-05-31")},
+		{UID: "too-old", AnnouncementDate: announcementDate("2025-05-01")},
+		{UID: "deleted", AnnouncementDate: announcementDate("2025-06-01")},
+		{UID: "no-date"},
+	}, nil)
+	announcements.On("ListProjectAnnouncements", mock.Anything).Return([]*models.ProjectAnnouncement{
+		{ProjectUID: "announced", AnnouncementDate: *announcementDate("2025-05-30")},
+		{ProjectUID: "moved", AnnouncementDate: *announcementDate("2025-05-20")},
+	}, nil)
+	mockRepo.On("ListAllProjectsBase", mock.Anything).Return([]*models.ProjectBase{
+		{UID: "future", Slug: "future", Name: "Future"},
+		{UID: "today", Slug: "today", Name: "Today"},
+		{UID: "announced", Slug: "announced", Name: "Announced"},
+		{UID: "moved", Slug: "moved", Name: "Moved"},
+		{UID: "too-old", Slug: "too-old", Name: "Too Old"},
+		{UID: "no-date", Slug: "no-date", Name: "No Date"},
+	}, nil)
+
+	pending, err := service.pendingAnnouncements(context.Background(), now)
+
+	require.NoError(t, err)
+	assert.Equal(t, []*models.PendingAnnouncement{
+		{ProjectUID: "moved", Slug: "moved", Name: "Moved", AnnouncementDate: *announcementDate("2025-05-31"), Due: true},
+		{ProjectUID: "today", Slug: "today", Name: "Today", AnnouncementDate: *announcementDate("2025-06-01"), Due: true},
+		{ProjectUID: "future", Slug: "future", Name: "Future", AnnouncementDate: *announcementDate("2025-06-10")},
+	}, pending)
+}
+
+func TestProjectsService_ListPendingAnnouncements_forbidden(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	service.AnnouncementRepository = &domain.MockAnnouncementRepository{}
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user-1")
+
+	_, err := service.ListPendingAnnouncements(ctx)
+
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	mockRepo.AssertNotCalled(t, "ListAllProjectsSettings", mock.Anything)
+}
+
+func TestProjectsService_PublishDueAnnouncements(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name              string
+		config            ServiceConfig
+		claimErr          error
+		expectedPublished int
+		expectedProject   *models.ProjectBase
+		expectUpdate      bool
+	}{
+		{
+			name:              "announced without changing the project",
+			expectedPublished: 1,
+			expectedProject:   &models.ProjectBase{UID: "project-1", Slug: "project-1", Stage: "Prospect"},
+		},
+		{
+			name:              "announced and made public in the configured stage",
+			config:            ServiceConfig{AnnouncementPublic: true, AnnouncementStage: "Active"},
+			expectedPublished: 1,
+			expectedProject:   &models.ProjectBase{UID: "project-1", Slug: "project-1", Public: true, Stage: "Active", UpdatedAt: &now},
+			expectUpdate:      true,
+		},
+		{
+			name:     "already announced by another replica",
+			config:   ServiceConfig{AnnouncementPublic: true},
+			claimErr: domain.ErrAnnouncementExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+			service.Config = tt.config
+			announcements := &domain.MockAnnouncementRepository{}
+			service.AnnouncementRepository = announcements
+			stored := &models.ProjectBase{UID: "project-1", Slug: "project-1", Stage: "Prospect"}
+			settings := &models.ProjectSettings{UID: "project-1", AnnouncementDate: announcementDate("2025-06-01")}
+
+			mockRepo.On("ListAllProjectsSettings", mock.Anything).Return([]*models.ProjectSettings{settings}, nil)
+			mockRepo.On("ListAllProjectsBase", mock.Anything).Return([]*models.ProjectBase{stored}, nil)
+			announcements.On("ListProjectAnnouncements", mock.Anything).Return([]*models.ProjectAnnouncement{}, nil)
+			announcements.On("CreateProjectAnnouncement", mock.Anything, &models.ProjectAnnouncement{
+				ProjectUID:       "project-1",
+				AnnouncementDate: *settings.AnnouncementDate,
+				AnnouncedAt:      now,
+			}).Return(tt.claimErr)
+			if tt.claimErr == nil {
+				update := mockRepo.On("UpdateProjectBaseWithRetry", mock.Anything, "project-1", mock.Anything).
+					Run(func(args mock.Arguments) {
+						_ = args.Get(2).(func(*models.ProjectBase) error)(stored)
+					})
+				if tt.expectUpdate {
+					update.Return(stored, nil)
+				} else {
+					update.Return(nil, errAnnouncementUnchanged)
+					mockRepo.On("GetProjectBase", mock.Anything, "project-1").Return(stored, nil)
+				}
+				mockRepo.On("GetProjectSettings", mock.Anything, "project-1").Return(settings, nil)
+				mockBuilder.On("SendIndexerMessage", mock.Anything, constants.IndexProjectSubject, mock.Anything, false).Return(nil)
+				mockBuilder.On("SendAccessMessage", mock.Anything, mock.Anything, mock.Anything, false).Return(nil)
+				mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			}
+
+			published, err := service.PublishDueAnnouncements(context.Background(), now)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPublished, published)
+			if tt.expectedProject != nil {
+				assert.Equal(t, tt.expectedProject, stored)
+				mockBuilder.AssertCalled(t, "SendProjectEventMessage", mock.Anything, projectEventSubject(events.ProjectAnnounced), mock.Anything)
+			} else {
+				mockBuilder.AssertNotCalled(t, "SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything)
+			}
+			if tt.expectUpdate {
+				mockBuilder.AssertCalled(t, "SendProjectEventMessage", mock.Anything, projectEventSubject(events.ProjectUpdated), mock.Anything)
+			} else {
+				mockBuilder.AssertNotCalled(t, "SendIndexerMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+			mockRepo.AssertExpectations(t)
+			announcements.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectsService_PublishDueAnnouncements_disabled(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+
+	_, err := service.PublishDueAnnouncements(context.Background(), time.Now())
+
+	assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+}
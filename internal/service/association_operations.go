@@ -0,0 +1,169 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// associationList is one list of references in a project's associations.
+type associationList struct {
+	path string
+	kind models.AssociationKind
+	ids  *[]string
+}
+
+// associationLists returns the lists of references in associations, by kind.
+func associationLists(associations *models.ProjectAssociations) []associationList {
+	return []associationList{
+		{path: "committee_uids", kind: models.AssociationKindCommittee, ids: &associations.CommitteeUIDs},
+		{path: "mailing_list_ids", kind: models.AssociationKindMailingList, ids: &associations.MailingListIDs},
+		{path: "meeting_series_ids", kind: models.AssociationKindMeetingSeries, ids: &associations.MeetingSeriesIDs},
+	}
+}
+
+// GetProjectAssociations returns the committees, mailing lists and meeting
+// series associated with a project.
+func (s *ProjectsService) GetProjectAssociations(ctx context.Context, projectUID string) (*models.ProjectAssociations, error) {
+	if !s.ServiceReady() || s.AssociationRepository == nil {
+		slog.ErrorContext(ctx, "service not ready or association storage not configured")
+		return nil, domain.ErrServiceUnavailable
+	}
+
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", projectUID))
+
+	exists, err := s.ProjectRepository.ProjectExists(ctx, projectUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error checking if project exists", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+	if !exists {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	return s.AssociationRepository.GetProjectAssociations(ctx, projectUID)
+}
+
+// UpdateProjectAssociations replaces the committees, mailing lists and meeting
+// series associated with a project. References that the project did not have
+// yet must exist in the service that owns them; references it already had are
+// kept without checking, so that a deleted resource does not block updates.
+func (s *ProjectsService) UpdateProjectAssociations(ctx context.Context, associations *models.ProjectAssociations) (*models.ProjectAssociations, error) {
+	ctx, span := startSpan(ctx, "ProjectsService.UpdateProjectAssociations", attribute.String("project_uid", associations.ProjectUID))
+	defer span.End()
+
+	result, err := s.updateProjectAssociations(ctx, associations)
+	recordSpanError(span, err)
+	return result, err
+}
+
+func (s *ProjectsService) updateProjectAssociations(ctx context.Context, associations *models.ProjectAssociations) (*models.ProjectAssociations, error) {
+	if !s.ServiceReady() || s.AssociationRepository == nil || s.AssociationChecker == nil {
+		slog.ErrorContext(ctx, "service not ready or association storage not configured")
+		return nil, domain.ErrServiceUnavailable
+	}
+
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", associations.ProjectUID))
+
+	if err := normalizeAssociations(associations); err != nil {
+		slog.WarnContext(ctx, "invalid project associations", constants.ErrKey, err)
+		return nil, err
+	}
+
+	if err := s.authorizeProject(ctx, associations.ProjectUID, relationWriter); err != nil {
+		return nil, err
+	}
+	exists, err := s.ProjectRepository.ProjectExists(ctx, associations.ProjectUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error checking if project exists", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+	if !exists {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	current, err := s.AssociationRepository.GetProjectAssociations(ctx, associations.ProjectUID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNewAssociations(ctx, current, associations); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	associations.UpdatedAt = &now
+	if err := s.AssociationRepository.PutProjectAssociations(ctx, associations); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "updated project associations",
+		"committees", len(associations.CommitteeUIDs),
+		"mailing_lists", len(associations.MailingListIDs),
+		"meeting_series", len(associations.MeetingSeriesIDs),
+	)
+	return associations, nil
+}
+
+// normalizeAssociations trims the references in associations and removes
+// duplicates, keeping the first occurrence. Empty references are rejected.
+func normalizeAssociations(associations *models.ProjectAssociations) error {
+	validation := &domain.ValidationError{}
+	for _, list := range associationLists(associations) {
+		normalized := make([]string, 0, len(*list.ids))
+		for i, id := range *list.ids {
+			id = strings.TrimSpace(id)
+			if id == "" {
+				validation.Add(fmt.Sprintf("%s[%d]", list.path, i), domain.FieldErrorRequired, fmt.Errorf("%s must not be empty", list.path))
+				continue
+			}
+			if !slices.Contains(normalized, id) {
+				normalized = append(normalized, id)
+			}
+		}
+		if len(normalized) > models.MaxAssociationsPerKind {
+			validation.Add(list.path, domain.FieldErrorTooLarge, fmt.Errorf("%s must have at most %d entries", list.path, models.MaxAssociationsPerKind))
+		}
+		*list.ids = normalized
+	}
+	return validation.Err()
+}
+
+// checkNewAssociations looks up the references in associations that are not in
+// current, and reports each one that does not exist.
+func (s *ProjectsService) checkNewAssociations(ctx context.Context, current, associations *models.ProjectAssociations) error {
+	currentLists := associationLists(current)
+	validation := &domain.ValidationError{}
+	for n, list := range associationLists(associations) {
+		for i, id := range *list.ids {
+			if slices.Contains(*currentLists[n].ids, id) {
+				continue
+			}
+			exists, err := s.AssociationChecker.AssociationExists(ctx, list.kind, id)
+			if err != nil {
+				slog.ErrorContext(ctx, "error checking associated resource", constants.ErrKey, err, "kind", list.kind, "id", id)
+				return domain.ErrServiceUnavailable
+			}
+			if !exists {
+				validation.Add(fmt.Sprintf("%s[%d]", list.path, i), domain.FieldErrorNotFound,
+					fmt.Errorf("%w: %s %q", domain.ErrAssociatedResourceNotFound, list.kind, id))
+			}
+		}
+	}
+	if err := validation.Err(); err != nil {
+		slog.WarnContext(ctx, "project associations refer to missing resources", constants.ErrKey, err)
+		return err
+	}
+	return nil
+}
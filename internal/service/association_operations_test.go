@@ -0,0 +1,164 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+func TestProjectsService_UpdateProjectAssociations(t *testing.T) {
+	current := &models.ProjectAssociations{
+		ProjectUID:       "project-1",
+		CommitteeUIDs:    []string{"committee-1"},
+		MailingListIDs:   []string{},
+		MeetingSeriesIDs: []string{},
+	}
+
+	tests := []struct {
+		name         string
+		associations *models.ProjectAssociations
+		setupMocks   func(*domain.MockProjectRepository, *domain.MockAssociationRepository, *domain.MockAssociationChecker)
+		expected     *models.ProjectAssociations
+		expectedErr  error
+		expectedPath string
+	}{
+		{
+			name: "new references are checked and stored",
+			associations: &models.ProjectAssociations{
+				ProjectUID:       "project-1",
+				CommitteeUIDs:    []string{"committee-1", " committee-2 ", "committee-2"},
+				MailingListIDs:   []string{"119563"},
+				MeetingSeriesIDs: []string{},
+			},
+			setupMocks: func(repo *domain.MockProjectRepository, associations *domain.MockAssociationRepository, checker *domain.MockAssociationChecker) {
+				repo.On("ProjectExists", mock.Anything, "project-1").Return(true, nil)
+				associations.On("GetProjectAssociations", mock.Anything, "project-1").Return(current, nil)
+				checker.On("AssociationExists", mock.Anything, models.AssociationKindCommittee, "committee-2").Return(true, nil)
+				checker.On("AssociationExists", mock.Anything, models.AssociationKindMailingList, "119563").Return(true, nil)
+				associations.On("PutProjectAssociations", mock.Anything, mock.Anything).Return(nil)
+			},
+			expected: &models.ProjectAssociations{
+				ProjectUID:       "project-1",
+				CommitteeUIDs:    []string{"committee-1", "committee-2"},
+				MailingListIDs:   []string{"119563"},
+				MeetingSeriesIDs: []string{},
+			},
+		},
+		{
+			name: "missing resource is reported by field",
+			associations: &models.ProjectAssociations{
+				ProjectUID:       "project-1",
+				CommitteeUIDs:    []string{},
+				MailingListIDs:   []string{},
+				MeetingSeriesIDs: []string{"series-1"},
+			},
+			setupMocks: func(repo *domain.MockProjectRepository, associations *domain.MockAssociationRepository, checker *domain.MockAssociationChecker) {
+				repo.On("ProjectExists", mock.Anything, "project-1").Return(true, nil)
+				associations.On("GetProjectAssociations", mock.Anything, "project-1").Return(current, nil)
+				checker.On("AssociationExists", mock.Anything, models.AssociationKindMeetingSeries, "series-1").Return(false, nil)
+			},
+			expectedErr:  domain.ErrAssociatedResourceNotFound,
+			expectedPath: "meeting_series_ids[0]",
+		},
+		{
+			name: "owning service unavailable",
+			associations: &models.ProjectAssociations{
+				ProjectUID:     "project-1",
+				MailingListIDs: []string{"119563"},
+			},
+			setupMocks: func(repo *domain.MockProjectRepository, associations *domain.MockAssociationRepository, checker *domain.MockAssociationChecker) {
+				repo.On("ProjectExists", mock.Anything, "project-1").Return(true, nil)
+				associations.On("GetProjectAssociations", mock.Anything, "project-1").Return(current, nil)
+				checker.On("AssociationExists", mock.Anything, models.AssociationKindMailingList, "119563").
+					Return(false, errors.New("nats: no responders available for request"))
+			},
+			expectedErr: domain.ErrServiceUnavailable,
+		},
+		{
+			name: "empty reference",
+			associations: &models.ProjectAssociations{
+				ProjectUID:    "project-1",
+				CommitteeUIDs: []string{" "},
+			},
+			expectedErr:  domain.ErrValidationFailed,
+			expectedPath: "committee_uids[0]",
+		},
+		{
+			name:         "project not found",
+			associations: &models.ProjectAssociations{ProjectUID: "project-1"},
+			setupMocks: func(repo *domain.MockProjectRepository, _ *domain.MockAssociationRepository, _ *domain.MockAssociationChecker) {
+				repo.On("ProjectExists", mock.Anything, "project-1").Return(false, nil)
+			},
+			expectedErr: domain.ErrProjectNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := setupServiceForTesting()
+			mockAssociations := &domain.MockAssociationRepository{}
+			mockChecker := &domain.MockAssociationChecker{}
+			service.AssociationRepository = mockAssociations
+			service.AssociationChecker = mockChecker
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockRepo, mockAssociations, mockChecker)
+			}
+
+			associations, err := service.UpdateProjectAssociations(context.Background(), tt.associations)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				if tt.expectedPath != "" {
+					var validationErr *domain.ValidationError
+					require.ErrorAs(t, err, &validationErr)
+					require.Len(t, validationErr.Fields, 1)
+					assert.Equal(t, tt.expectedPath, validationErr.Fields[0].Path)
+				}
+				mockAssociations.AssertNotCalled(t, "PutProjectAssociations", mock.Anything, mock.Anything)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected.CommitteeUIDs, associations.CommitteeUIDs)
+				assert.Equal(t, tt.expected.MailingListIDs, associations.MailingListIDs)
+				assert.Equal(t, tt.expected.MeetingSeriesIDs, associations.MeetingSeriesIDs)
+				assert.NotNil(t, associations.UpdatedAt)
+			}
+			mockRepo.AssertExpectations(t)
+			mockAssociations.AssertExpectations(t)
+			mockChecker.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectsService_GetProjectAssociations(t *testing.T) {
+	t.Run("associations are returned", func(t *testing.T) {
+		service, mockRepo, _, _ := setupServiceForTesting()
+		mockAssociations := &domain.MockAssociationRepository{}
+		service.AssociationRepository = mockAssociations
+		stored := &models.ProjectAssociations{ProjectUID: "project-1", CommitteeUIDs: []string{"committee-1"}}
+		mockRepo.On("ProjectExists", mock.Anything, "project-1").Return(true, nil)
+		mockAssociations.On("GetProjectAssociations", mock.Anything, "project-1").Return(stored, nil)
+
+		associations, err := service.GetProjectAssociations(context.Background(), "project-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, stored, associations)
+	})
+
+	t.Run("associations not configured", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+
+		_, err := service.GetProjectAssociations(context.Background(), "project-1")
+
+		assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+	})
+}
@@ -0,0 +1,269 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// OpenFGA relations on a project checked by the service.
+const (
+	relationViewer  = "viewer"
+	relationAuditor = "auditor"
+	relationWriter  = "writer"
+	relationOwner   = "owner"
+)
+
+// authorizeProject checks that the principal in ctx has relation on the
+// project projectUID. It does nothing when no AccessChecker is configured, and
+// trusted service principals and the upsert publisher are let through without a
+// check. A failed check denies the request rather than letting it through.
+func (s *ProjectsService) authorizeProject(ctx context.Context, projectUID, relation string) error {
+	if s.AccessChecker == nil {
+		return nil
+	}
+
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	if principal == "" {
+		slog.WarnContext(ctx, "no principal to authorize", "relation", relation)
+		return domain.ErrForbidden
+	}
+	if s.isTrusted(ctx) || isUpsertPublisher(ctx) {
+		return nil
+	}
+
+	allowed, err := s.AccessChecker.CheckAccess(ctx, principal, "project:"+projectUID, relation)
+	if err != nil {
+		slog.ErrorContext(ctx, "error checking project access", constants.ErrKey, err,
+			"principal", principal, "relation", relation, "object_uid", projectUID)
+		return domain.ErrServiceUnavailable
+	}
+	if !allowed {
+		slog.WarnContext(ctx, "principal lacks project relation",
+			"principal", principal, "relation", relation, "object_uid", projectUID)
+		return domain.ErrForbidden
+	}
+	return nil
+}
+
+// authorizeUser checks that the principal in ctx is the user with username, LF
+// staff, or a trusted service principal, so that users only read their own
+// data.
+func (s *ProjectsService) authorizeUser(ctx context.Context, username string) error {
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	roles, _ := ctx.Value(constants.RolesContextID).([]string)
+	if principal != "" && (principal == username || slices.Contains(s.Config.TrustedPrincipals, principal)) {
+		return nil
+	}
+	if slices.Contains(roles, domain.RoleStaff) {
+		return nil
+	}
+	slog.WarnContext(ctx, "principal cannot read another user's data")
+	return domain.ErrForbidden
+}
+
+// serviceContextKey marks the context of work the service does on its own
+// behalf, whose source NATS authenticated rather than a token.
+type serviceContextKey struct{}
+
+// WithServicePrincipal returns ctx acting as the project service itself,
+// which is trusted like a trusted service principal. Operator tools that
+// connect to NATS with the service's credentials use it too.
+func WithServicePrincipal(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, constants.PrincipalContextID, constants.ProjectsAPIServiceName)
+	return context.WithValue(ctx, serviceContextKey{}, true)
+}
+
+// upsertPublisherContextKey marks the context of a project upsert pushed by the
+// LFX v1 sync pipeline.
+type upsertPublisherContextKey struct{}
+
+// withUpsertPublisher returns ctx acting as the LFX v1 sync pipeline, which
+// NATS authenticated as the only publisher of project upserts. It may write any
+// project, but unlike the service itself it is not trusted: the restricted
+// fields and the legal fields of dissolved projects stay guarded.
+func withUpsertPublisher(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, constants.PrincipalContextID, constants.ProjectUpsertPublisher)
+	return context.WithValue(ctx, upsertPublisherContextKey{}, true)
+}
+
+// isUpsertPublisher reports whether ctx acts as the publisher of project upserts.
+func isUpsertPublisher(ctx context.Context) bool {
+	publisher, _ := ctx.Value(upsertPublisherContextKey{}).(bool)
+	return publisher
+}
+
+// isTrusted reports whether the principal in ctx, as read from its token's
+// claims, is a trusted service principal, or ctx acts as the service itself.
+func (s *ProjectsService) isTrusted(ctx context.Context) bool {
+	if service, _ := ctx.Value(serviceContextKey{}).(bool); service {
+		return true
+	}
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	return principal != "" && slices.Contains(s.Config.TrustedPrincipals, principal)
+}
+
+// isStaffOrTrusted reports whether the principal in ctx is LF staff or a
+// trusted service principal.
+func (s *ProjectsService) isStaffOrTrusted(ctx context.Context) bool {
+	roles, _ := ctx.Value(constants.RolesContextID).([]string)
+	return slices.Contains(roles, domain.RoleStaff) || s.isTrusted(ctx)
+}
+
+// authorizeAdmin checks that the principal in ctx is LF staff or a trusted
+// service principal, who alone may use the admin endpoints. The action names
+// the admin operation in the log.
+func (s *ProjectsService) authorizeAdmin(ctx context.Context, action string) error {
+	if s.isStaffOrTrusted(ctx) {
+		return nil
+	}
+	slog.WarnContext(ctx, "principal is not allowed to use an admin endpoint", "action", action)
+	return domain.ErrForbidden
+}
+
+// checkRestrictedFieldChanges rejects changes to the restricted project fields,
+// reporting each of the changed fields, unless the principal in ctx is LF staff
+// or a trusted service principal. Project writers may still change every other
+// field. A project created, cloned or made from a template changes each
+// restricted field it sets.
+func (s *ProjectsService) checkRestrictedFieldChanges(ctx context.Context, changed []string) error {
+	if !s.Config.EnforceFieldPermissions || len(changed) == 0 {
+		return nil
+	}
+
+	if s.isStaffOrTrusted(ctx) {
+		return nil
+	}
+
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	validation := &domain.ValidationError{}
+	for _, field := range changed {
+		validation.Fields = append(validation.Fields, domain.FieldError{
+			Path:    field,
+			Code:    domain.FieldErrorForbidden,
+			Message: field + " can only be changed by LF staff",
+			Err:     domain.ErrRestrictedField,
+		})
+	}
+	if err := validation.Err(); err != nil {
+		slog.WarnContext(ctx, "principal cannot change restricted project fields",
+			"principal", principal, constants.ErrKey, err)
+		return err
+	}
+	return nil
+}
+
+// changedRestrictedFields returns the names of the restricted fields whose
+// value in payload differs from the stored project.
+func changedRestrictedFields(payload *projsvc.UpdateProjectBasePayload, existing *models.ProjectBase) []string {
+	var changed []string
+	if stringValue(payload.LegalEntityType) != existing.LegalEntityType {
+		changed = append(changed, "legal_entity_type")
+	}
+	if !slices.Equal(payload.FundingModel, existing.FundingModel) {
+		changed = append(changed, "funding_model")
+	}
+	if stringValue(payload.EntityFormationDocumentURL) != existing.EntityFormationDocumentURL {
+		changed = append(changed, "entity_formation_document_url")
+	}
+	return changed
+}
+
+// setRestrictedFields returns the names of the restricted fields that a new
+// project sets.
+func setRestrictedFields(payload *projsvc.CreateProjectPayload) []string {
+	var set []string
+	if stringValue(payload.LegalEntityType) != "" {
+		set = append(set, "legal_entity_type")
+	}
+	if len(payload.FundingModel) > 0 {
+		set = append(set, "funding_model")
+	}
+	if stringValue(payload.EntityFormationDocumentURL) != "" {
+		set = append(set, "entity_formation_document_url")
+	}
+	return set
+}
+
+// checkDissolvedEntityChanges rejects changes to the legal fields of a project
+// whose legal entity is dissolved, reporting each changed field. LF staff and
+// trusted service principals may still change them with the X-Admin-Override
+// header; those changes are audited.
+func (s *ProjectsService) checkDissolvedEntityChanges(ctx context.Context, payload *projsvc.UpdateProjectBasePayload, existing *models.ProjectBase) error {
+	if !existing.Dissolved(time.Now()) {
+		return nil
+	}
+	changed := changedLegalFields(payload, existing)
+	if len(changed) == 0 {
+		return nil
+	}
+
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	override := payload.AdminOverride != nil && *payload.AdminOverride
+	if override && s.isStaffOrTrusted(ctx) {
+		log.Audit(ctx, "legal fields of dissolved project changed with admin override",
+			"project_uid", existing.UID,
+			"actor", principal,
+			"fields", changed,
+		)
+		return nil
+	}
+
+	validation := &domain.ValidationError{}
+	for _, field := range changed {
+		validation.Fields = append(validation.Fields, domain.FieldError{
+			Path:    field,
+			Code:    domain.FieldErrorForbidden,
+			Message: field + " cannot be changed once the legal entity of the project is dissolved",
+			Err:     domain.ErrProjectDissolved,
+		})
+	}
+	err := validation.Err()
+	slog.WarnContext(ctx, "legal fields of dissolved project cannot be changed",
+		"principal", principal, "admin_override", override, constants.ErrKey, err)
+	return err
+}
+
+// changedLegalFields returns the names of the legal fields whose value in
+// payload differs from the stored project.
+func changedLegalFields(payload *projsvc.UpdateProjectBasePayload, existing *models.ProjectBase) []string {
+	var changed []string
+	for _, field := range []struct {
+		name            string
+		value, existing string
+	}{
+		{"legal_entity_type", stringValue(payload.LegalEntityType), existing.LegalEntityType},
+		{"legal_entity_name", stringValue(payload.LegalEntityName), existing.LegalEntityName},
+		{"legal_parent_uid", stringValue(payload.LegalParentUID), existing.LegalParentUID},
+		{"funding", stringValue(payload.Funding), existing.Funding},
+		{"entity_dissolution_date", stringValue(payload.EntityDissolutionDate), formatDate(existing.EntityDissolutionDate)},
+		{"entity_formation_document_url", stringValue(payload.EntityFormationDocumentURL), existing.EntityFormationDocumentURL},
+		{"formation_date", stringValue(payload.FormationDate), formatDate(existing.FormationDate)},
+	} {
+		if field.value != field.existing {
+			changed = append(changed, field.name)
+		}
+	}
+	if !slices.Equal(payload.FundingModel, existing.FundingModel) {
+		changed = append(changed, "funding_model")
+	}
+	return changed
+}
+
+// stringValue returns the value of s, or "" when s is nil.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
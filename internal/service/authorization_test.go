@@ -0,0 +1,342 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+func TestProjectsService_authorizeProject(t *testing.T) {
+	tests := []struct {
+		name        string
+		principal   string
+		noChecker   bool
+		setupMocks  func(*domain.MockAccessChecker)
+		expectedErr error
+	}{
+		{
+			name:      "no access checker configured",
+			principal: "alice",
+			noChecker: true,
+		},
+		{
+			name:      "allowed",
+			principal: "alice",
+			setupMocks: func(checker *domain.MockAccessChecker) {
+				checker.On("CheckAccess", mock.Anything, "alice", "project:project-1", relationWriter).Return(true, nil)
+			},
+		},
+		{
+			name:      "denied",
+			principal: "alice",
+			setupMocks: func(checker *domain.MockAccessChecker) {
+				checker.On("CheckAccess", mock.Anything, "alice", "project:project-1", relationWriter).Return(false, nil)
+			},
+			expectedErr: domain.ErrForbidden,
+		},
+		{
+			name:        "missing principal",
+			expectedErr: domain.ErrForbidden,
+		},
+		{
+			name:      "trusted service principal bypasses the check",
+			principal: "clients@project-sync",
+		},
+		{
+			name:      "access check failure denies",
+			principal: "alice",
+			setupMocks: func(checker *domain.MockAccessChecker) {
+				checker.On("CheckAccess", mock.Anything, "alice", "project:project-1", relationWriter).Return(false, errors.New("nats: timeout"))
+			},
+			expectedErr: domain.ErrServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _, _, _ := setupServiceForTesting()
+			service.Config.TrustedPrincipals = []string{"clients@project-sync"}
+			checker := &domain.MockAccessChecker{}
+			if !tt.noChecker {
+				service.AccessChecker = checker
+			}
+			if tt.setupMocks != nil {
+				tt.setupMocks(checker)
+			}
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tt.principal)
+
+			err := service.authorizeProject(ctx, "project-1", relationWriter)
+
+			assert.Equal(t, tt.expectedErr, err)
+			checker.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectsService_writesRequireRelation(t *testing.T) {
+	tests := []struct {
+		name     string
+		relation string
+		object   string
+		call     func(context.Context, *ProjectsService) error
+	}{
+		{
+			name:     "create under a parent requires writer on the parent",
+			relation: relationWriter,
+			object:   "project:787620d0-d7de-449a-b0bf-9d28b13da818",
+			call: func(ctx context.Context, s *ProjectsService) error {
+				_, err := s.CreateProject(ctx, &projsvc.CreateProjectPayload{
+					Slug:      misc.StringPtr("child"),
+					Name:      "Child",
+					ParentUID: "787620d0-d7de-449a-b0bf-9d28b13da818",
+				})
+				return err
+			},
+		},
+		{
+			name:     "update base requires writer",
+			relation: relationWriter,
+			object:   "project:project-1",
+			call: func(ctx context.Context, s *ProjectsService) error {
+				_, err := s.UpdateProjectBase(ctx, &projsvc.UpdateProjectBasePayload{UID: misc.StringPtr("project-1"), IfMatch: misc.StringPtr("1")})
+				return err
+			},
+		},
+		{
+			name:     "update settings requires writer",
+			relation: relationWriter,
+			object:   "project:project-1",
+			call: func(ctx context.Context, s *ProjectsService) error {
+				_, err := s.UpdateProjectSettings(ctx, &projsvc.UpdateProjectSettingsPayload{UID: misc.StringPtr("project-1"), IfMatch: misc.StringPtr("1")})
+				return err
+			},
+		},
+		{
+			name:     "delete requires owner",
+			relation: relationOwner,
+			object:   "project:project-1",
+			call: func(ctx context.Context, s *ProjectsService) error {
+				return s.DeleteProject(ctx, &projsvc.DeleteProjectPayload{UID: misc.StringPtr("project-1"), IfMatch: misc.StringPtr("1")})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+			checker := &domain.MockAccessChecker{}
+			checker.On("CheckAccess", mock.Anything, "alice", tt.object, tt.relation).Return(false, nil)
+			service.AccessChecker = checker
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "alice")
+
+			err := tt.call(ctx, service)
+
+			assert.ErrorIs(t, err, domain.ErrForbidden)
+			checker.AssertExpectations(t)
+			// Denied writes never reach the repository or the message bus.
+			mockRepo.AssertExpectations(t)
+			mockBuilder.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectsService_checkRestrictedFieldChanges(t *testing.T) {
+	existing := &models.ProjectBase{
+		UID:                        "project-1",
+		Description:                "Old description",
+		LegalEntityType:            "Subsidiary",
+		FundingModel:               []string{"Membership"},
+		EntityFormationDocumentURL: "https://example.com/formation.pdf",
+	}
+	unchanged := func() *projsvc.UpdateProjectBasePayload {
+		return &projsvc.UpdateProjectBasePayload{
+			UID:                        misc.StringPtr("project-1"),
+			Description:                "New description",
+			LegalEntityType:            misc.StringPtr("Subsidiary"),
+			FundingModel:               []string{"Membership"},
+			EntityFormationDocumentURL: misc.StringPtr("https://example.com/formation.pdf"),
+		}
+	}
+	restricted := func() *projsvc.UpdateProjectBasePayload {
+		payload := unchanged()
+		payload.LegalEntityType = misc.StringPtr("Series LLC")
+		payload.FundingModel = []string{"Crowdfunding"}
+		payload.EntityFormationDocumentURL = nil
+		return payload
+	}
+
+	tests := []struct {
+		name           string
+		disabled       bool
+		principal      string
+		roles          []string
+		payload        *projsvc.UpdateProjectBasePayload
+		expectedFields []string
+	}{
+		{
+			name:      "writer changes unrestricted fields",
+			principal: "alice",
+			payload:   unchanged(),
+		},
+		{
+			name:           "writer changes restricted fields",
+			principal:      "alice",
+			payload:        restricted(),
+			expectedFields: []string{"legal_entity_type", "funding_model", "entity_formation_document_url"},
+		},
+		{
+			name:      "staff changes restricted fields",
+			principal: "alice",
+			roles:     []string{domain.RoleStaff},
+			payload:   restricted(),
+		},
+		{
+			name:      "trusted service principal changes restricted fields",
+			principal: "clients@project-sync",
+			payload:   restricted(),
+		},
+		{
+			name:      "field permissions disabled",
+			disabled:  true,
+			principal: "alice",
+			payload:   restricted(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _, _, _ := setupServiceForTesting()
+			service.Config.EnforceFieldPermissions = !tt.disabled
+			service.Config.TrustedPrincipals = []string{"clients@project-sync"}
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tt.principal)
+			ctx = context.WithValue(ctx, constants.RolesContextID, tt.roles)
+
+			err := service.checkRestrictedFieldChanges(ctx, changedRestrictedFields(tt.payload, existing))
+
+			if tt.expectedFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, domain.ErrRestrictedField)
+			var validationErr *domain.ValidationError
+			if assert.ErrorAs(t, err, &validationErr) {
+				var paths []string
+				for _, field := range validationErr.Fields {
+					assert.Equal(t, domain.FieldErrorForbidden, field.Code)
+					paths = append(paths, field.Path)
+				}
+				assert.Equal(t, tt.expectedFields, paths)
+			}
+		})
+	}
+}
+
+func TestProjectsService_checkDissolvedEntityChanges(t *testing.T) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	dissolvedAt := today.AddDate(0, 0, -1)
+	existing := &models.ProjectBase{
+		UID:                   "project-1",
+		Description:           "Old description",
+		LegalEntityType:       "Subsidiary",
+		FundingModel:          []string{"Membership"},
+		EntityDissolutionDate: &dissolvedAt,
+	}
+	unchanged := func() *projsvc.UpdateProjectBasePayload {
+		return &projsvc.UpdateProjectBasePayload{
+			UID:                   misc.StringPtr("project-1"),
+			Description:           "New description",
+			LegalEntityType:       misc.StringPtr("Subsidiary"),
+			FundingModel:          []string{"Membership"},
+			EntityDissolutionDate: misc.StringPtr(dissolvedAt.Format(time.DateOnly)),
+		}
+	}
+	legal := func(override bool) *projsvc.UpdateProjectBasePayload {
+		payload := unchanged()
+		payload.FundingModel = []string{"Crowdfunding"}
+		payload.EntityDissolutionDate = nil
+		payload.AdminOverride = &override
+		return payload
+	}
+
+	tests := []struct {
+		name           string
+		existing       *models.ProjectBase
+		roles          []string
+		payload        *projsvc.UpdateProjectBasePayload
+		expectedFields []string
+	}{
+		{
+			name:     "other fields of a dissolved project",
+			existing: existing,
+			payload:  unchanged(),
+		},
+		{
+			name:           "legal fields of a dissolved project",
+			existing:       existing,
+			payload:        legal(false),
+			expectedFields: []string{"entity_dissolution_date", "funding_model"},
+		},
+		{
+			name:           "override by a writer is not honored",
+			existing:       existing,
+			payload:        legal(true),
+			expectedFields: []string{"entity_dissolution_date", "funding_model"},
+		},
+		{
+			name:           "staff without override",
+			existing:       existing,
+			roles:          []string{domain.RoleStaff},
+			payload:        legal(false),
+			expectedFields: []string{"entity_dissolution_date", "funding_model"},
+		},
+		{
+			name:     "staff with override",
+			existing: existing,
+			roles:    []string{domain.RoleStaff},
+			payload:  legal(true),
+		},
+		{
+			name:     "project dissolved today is not yet dissolved",
+			existing: &models.ProjectBase{UID: "project-1", LegalEntityType: "Subsidiary", FundingModel: []string{"Membership"}, EntityDissolutionDate: &today},
+			payload:  legal(false),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _, _, _ := setupServiceForTesting()
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "alice")
+			ctx = context.WithValue(ctx, constants.RolesContextID, tt.roles)
+
+			err := service.checkDissolvedEntityChanges(ctx, tt.payload, tt.existing)
+
+			if tt.expectedFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, domain.ErrProjectDissolved)
+			var validationErr *domain.ValidationError
+			if assert.ErrorAs(t, err, &validationErr) {
+				var paths []string
+				for _, field := range validationErr.Fields {
+					assert.Equal(t, domain.FieldErrorForbidden, field.Code)
+					paths = append(paths, field.Path)
+				}
+				assert.Equal(t, tt.expectedFields, paths)
+			}
+		})
+	}
+}
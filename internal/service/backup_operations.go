@@ -0,0 +1,209 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// backupNameLayout is the timestamp format of backup archive names.
+const backupNameLayout = "20060102T150405Z"
+
+// maxBackupLineBytes bounds the size of a single entry read from an archive.
+const maxBackupLineBytes = 16 << 20
+
+// BackupProjects saves every entry of the project stores, including slug and
+// legacy ID mappings, to a new archive named after the current time. Only LF
+// staff and trusted service principals may back up the stores.
+func (s *ProjectsService) BackupProjects(ctx context.Context) (*models.BackupReport, error) {
+	if s.BackupStorage == nil || s.BackupRepository == nil {
+		slog.ErrorContext(ctx, "backup storage or repository not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "back up projects"); err != nil {
+		return nil, err
+	}
+
+	ctx, span := startSpan(ctx, "ProjectsService.BackupProjects")
+	defer span.End()
+
+	entries, err := s.BackupRepository.ExportBackupEntries(ctx)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	projects := map[string]bool{}
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			slog.ErrorContext(ctx, "error encoding backup entry", constants.ErrKey, err, "bucket", entry.Bucket, "key", entry.Key)
+			recordSpanError(span, err)
+			return nil, domain.ErrInternal
+		}
+		if entry.Bucket == constants.KVStoreNameProjects && entry.Key == entry.ProjectUID {
+			projects[entry.ProjectUID] = true
+		}
+	}
+
+	report := &models.BackupReport{
+		Name:     time.Now().UTC().Format(backupNameLayout) + ".ndjson",
+		Entries:  len(entries),
+		Projects: len(projects),
+	}
+	if err := s.BackupStorage.PutBackup(ctx, report.Name, buf.Bytes()); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.String("backup.name", report.Name),
+		attribute.Int("backup.entries", report.Entries),
+	)
+	slog.InfoContext(ctx, "backed up project stores",
+		"backup", report.Name,
+		"entries", report.Entries,
+		"projects", report.Projects,
+		"bytes", buf.Len(),
+	)
+
+	return report, nil
+}
+
+// ListBackups lists the stored backup archives, newest first, to LF staff or
+// a trusted service principal.
+func (s *ProjectsService) ListBackups(ctx context.Context) ([]models.BackupInfo, error) {
+	if s.BackupStorage == nil {
+		slog.ErrorContext(ctx, "backup storage not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "list backups"); err != nil {
+		return nil, err
+	}
+
+	ctx, span := startSpan(ctx, "ProjectsService.ListBackups")
+	defer span.End()
+
+	backups, err := s.BackupStorage.ListBackups(ctx)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	return backups, nil
+}
+
+// RestoreProjects writes the entries of a backup archive back to the project
+// stores. When projectUIDs is not empty, only the entries of those projects
+// are restored, along with the slug and legacy ID mappings pointing at them.
+// The restore does not publish indexer or access messages; resync the
+// restored projects afterwards. Only LF staff and trusted service principals
+// may restore.
+func (s *ProjectsService) RestoreProjects(ctx context.Context, name string, projectUIDs []string, dryRun bool) (*models.RestoreReport, error) {
+	if s.BackupStorage == nil || s.BackupRepository == nil {
+		slog.ErrorContext(ctx, "backup storage or repository not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "restore projects"); err != nil {
+		return nil, err
+	}
+	if name == "" || strings.Contains(name, "/") {
+		return nil, domain.ErrValidationFailed
+	}
+
+	ctx, span := startSpan(ctx, "ProjectsService.RestoreProjects",
+		attribute.String("backup.name", name),
+		attribute.Int("restore.requested_projects", len(projectUIDs)),
+		attribute.Bool("dry_run", dryRun),
+	)
+	defer span.End()
+
+	data, err := s.BackupStorage.GetBackup(ctx, name)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	entries, err := decodeBackupEntries(data)
+	if err != nil {
+		slog.ErrorContext(ctx, "error decoding backup", constants.ErrKey, err, "backup", name)
+		recordSpanError(span, err)
+		return nil, domain.ErrInternal
+	}
+
+	selected := make(map[string]bool, len(projectUIDs))
+	for _, uid := range projectUIDs {
+		selected[uid] = true
+	}
+
+	report := &models.RestoreReport{Backup: name, ProjectUIDs: []string{}}
+	restored := map[string]bool{}
+	for _, entry := range entries {
+		if len(selected) > 0 && !selected[entry.ProjectUID] {
+			continue
+		}
+		if entry.ProjectUID != "" && !restored[entry.ProjectUID] {
+			restored[entry.ProjectUID] = true
+			report.ProjectUIDs = append(report.ProjectUIDs, entry.ProjectUID)
+		}
+		if dryRun {
+			report.Entries++
+			continue
+		}
+		if err := s.BackupRepository.RestoreBackupEntry(ctx, entry); err != nil {
+			slog.WarnContext(ctx, "error restoring backup entry", constants.ErrKey, err, "bucket", entry.Bucket, "key", entry.Key)
+			report.Failed++
+			continue
+		}
+		report.Entries++
+	}
+	sort.Strings(report.ProjectUIDs)
+
+	span.SetAttributes(
+		attribute.Int("restore.entries", report.Entries),
+		attribute.Int("restore.failed", report.Failed),
+	)
+	slog.InfoContext(ctx, "restored project stores",
+		"backup", name,
+		"projects", len(report.ProjectUIDs),
+		"entries", report.Entries,
+		"failed", report.Failed,
+		"dry_run", dryRun,
+	)
+
+	return report, nil
+}
+
+// decodeBackupEntries reads the entries of an archive, one JSON entry per line.
+func decodeBackupEntries(data []byte) ([]models.BackupEntry, error) {
+	var entries []models.BackupEntry
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxBackupLineBytes)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var entry models.BackupEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
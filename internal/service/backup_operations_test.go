@@ -0,0 +1,159 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+func backupTestEntries() []models.BackupEntry {
+	return []models.BackupEntry{
+		{Bucket: "projects", Key: "project-1", ProjectUID: "project-1", Value: []byte(`{"uid":"project-1"}`)},
+		{Bucket: "projects", Key: "project-2", ProjectUID: "project-2", Value: []byte(`{"uid":"project-2"}`)},
+		{Bucket: "projects", Key: "slug/one", ProjectUID: "project-1", Value: []byte("project-1")},
+		{Bucket: "project-settings", Key: "project-1", ProjectUID: "project-1", Value: []byte(`{"uid":"project-1"}`)},
+		{Bucket: "project-settings", Key: "lookup/writers", Value: []byte(`{}`)},
+	}
+}
+
+func TestProjectsService_BackupProjects(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+	storage := &domain.MockBackupStorage{}
+	repo := &domain.MockBackupRepository{}
+	service.BackupStorage = storage
+	service.BackupRepository = repo
+
+	var archive []byte
+	repo.On("ExportBackupEntries", mock.Anything).Return(backupTestEntries(), nil).Once()
+	storage.On("PutBackup", mock.Anything, mock.MatchedBy(func(name string) bool {
+		return strings.HasSuffix(name, ".ndjson")
+	}), mock.Anything).Run(func(args mock.Arguments) {
+		archive = args.Get(2).([]byte)
+	}).Return(nil).Once()
+
+	report, err := service.BackupProjects(staffContext())
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, report.Entries)
+	assert.Equal(t, 2, report.Projects)
+	entries, err := decodeBackupEntries(archive)
+	require.NoError(t, err)
+	assert.Equal(t, backupTestEntries(), entries)
+	storage.AssertExpectations(t)
+	repo.AssertExpectations(t)
+}
+
+func TestProjectsService_RestoreProjects(t *testing.T) {
+	newService := func(t *testing.T) (*ProjectsService, *domain.MockBackupRepository) {
+		service, _, _, _ := setupServiceForTesting()
+		storage := &domain.MockBackupStorage{}
+		repo := &domain.MockBackupRepository{}
+		service.BackupStorage = storage
+		service.BackupRepository = repo
+
+		var buf strings.Builder
+		for _, entry := range backupTestEntries() {
+			line, err := jsonLine(entry)
+			require.NoError(t, err)
+			buf.WriteString(line)
+		}
+		storage.On("GetBackup", mock.Anything, "backup.ndjson").Return([]byte(buf.String()), nil)
+		return service, repo
+	}
+
+	t.Run("selected projects with their slug mappings", func(t *testing.T) {
+		service, repo := newService(t)
+		repo.On("RestoreBackupEntry", mock.Anything, mock.MatchedBy(func(entry models.BackupEntry) bool {
+			return entry.ProjectUID == "project-1"
+		})).Return(nil).Times(3)
+
+		report, err := service.RestoreProjects(staffContext(), "backup.ndjson", []string{"project-1"}, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"project-1"}, report.ProjectUIDs)
+		assert.Equal(t, 3, report.Entries)
+		assert.Zero(t, report.Failed)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("full restore counts failures", func(t *testing.T) {
+		service, repo := newService(t)
+		repo.On("RestoreBackupEntry", mock.Anything, mock.MatchedBy(func(entry models.BackupEntry) bool {
+			return entry.Key == "project-2"
+		})).Return(errors.New("boom")).Once()
+		repo.On("RestoreBackupEntry", mock.Anything, mock.Anything).Return(nil).Times(4)
+
+		report, err := service.RestoreProjects(staffContext(), "backup.ndjson", nil, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"project-1", "project-2"}, report.ProjectUIDs)
+		assert.Equal(t, 4, report.Entries)
+		assert.Equal(t, 1, report.Failed)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("dry run writes nothing", func(t *testing.T) {
+		service, repo := newService(t)
+
+		report, err := service.RestoreProjects(staffContext(), "backup.ndjson", nil, true)
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, report.Entries)
+		repo.AssertNotCalled(t, "RestoreBackupEntry", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		service, _ := newService(t)
+
+		_, err := service.RestoreProjects(staffContext(), "../backup.ndjson", nil, false)
+
+		assert.ErrorIs(t, err, domain.ErrValidationFailed)
+	})
+}
+
+func TestProjectsService_Backup_forbidden(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+	storage := &domain.MockBackupStorage{}
+	repo := &domain.MockBackupRepository{}
+	service.BackupStorage = storage
+	service.BackupRepository = repo
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user-1")
+
+	_, err := service.BackupProjects(ctx)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = service.ListBackups(ctx)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = service.RestoreProjects(ctx, "backup.ndjson", nil, false)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	repo.AssertNotCalled(t, "ExportBackupEntries", mock.Anything)
+	storage.AssertNotCalled(t, "GetBackup", mock.Anything, mock.Anything)
+}
+
+func TestProjectsService_Backup_unavailable(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+
+	_, err := service.BackupProjects(context.Background())
+	assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+	_, err = service.ListBackups(context.Background())
+	assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+	_, err = service.RestoreProjects(context.Background(), "backup.ndjson", nil, false)
+	assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+}
+
+func jsonLine(entry models.BackupEntry) (string, error) {
+	data, err := json.Marshal(entry)
+	return string(data) + "\n", err
+}
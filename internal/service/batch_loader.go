@@ -0,0 +1,105 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// batchLoader collects the keys requested within wait of the first one and
+// fetches them with a single call, so that resolving a list of N items costs
+// one fetch rather than N. Results, including misses and errors, are cached
+// for the life of the loader, which is meant to be one request.
+type batchLoader[K comparable, V any] struct {
+	// fetch returns the values of the keys it found; keys missing from the
+	// map resolve to the zero value.
+	fetch    func(ctx context.Context, keys []K) (map[K]V, error)
+	wait     time.Duration
+	maxBatch int
+
+	mu      sync.Mutex
+	results map[K]*loaderResult[V]
+	pending []K
+}
+
+// loaderResult is the value of one key, available once done is closed.
+type loaderResult[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+func newBatchLoader[K comparable, V any](wait time.Duration, maxBatch int, fetch func(ctx context.Context, keys []K) (map[K]V, error)) *batchLoader[K, V] {
+	return &batchLoader[K, V]{
+		fetch:    fetch,
+		wait:     wait,
+		maxBatch: maxBatch,
+		results:  map[K]*loaderResult[V]{},
+	}
+}
+
+// Load returns the value of key, waiting for the batch it joins to be fetched.
+func (l *batchLoader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	result, ok := l.results[key]
+	if !ok {
+		result = &loaderResult[V]{done: make(chan struct{})}
+		l.results[key] = result
+		l.pending = append(l.pending, key)
+		switch {
+		case len(l.pending) >= l.maxBatch:
+			keys := l.pending
+			l.pending = nil
+			go l.dispatch(context.WithoutCancel(ctx), keys)
+		case len(l.pending) == 1:
+			// The first key of a batch schedules its fetch; the keys requested
+			// until then are fetched with it.
+			time.AfterFunc(l.wait, func() {
+				l.mu.Lock()
+				keys := l.pending
+				l.pending = nil
+				l.mu.Unlock()
+				if len(keys) > 0 {
+					l.dispatch(context.WithoutCancel(ctx), keys)
+				}
+			})
+		}
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-result.done:
+		return result.value, result.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// Prime caches value for key unless the key was already requested.
+func (l *batchLoader[K, V]) Prime(key K, value V) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.results[key]; ok {
+		return
+	}
+	result := &loaderResult[V]{done: make(chan struct{}), value: value}
+	close(result.done)
+	l.results[key] = result
+}
+
+// dispatch fetches keys and hands each waiting Load its result.
+func (l *batchLoader[K, V]) dispatch(ctx context.Context, keys []K) {
+	values, err := l.fetch(ctx, keys)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, key := range keys {
+		result := l.results[key]
+		result.value, result.err = values[key], err
+		close(result.done)
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// maxBulkDeletions caps the projects one bulk deletion may request.
+const maxBulkDeletions = 100
+
+var (
+	errBulkDeletionNoProjects  = errors.New("at least one project UID is required")
+	errBulkDeletionTooMany     = fmt.Errorf("at most %d projects can be deleted at once", maxBulkDeletions)
+	errBulkDeletionDuplicate   = errors.New("project is listed more than once")
+	errBulkDeletionHasChildren = errors.New("project has children that are not deleted; set cascade to delete them")
+)
+
+// BulkDeleteProjects deletes several projects at once, such as the test data
+// of a load. The whole set is checked before anything is deleted: a project
+// with children outside the set, or whose funding model is not Crowdfunding
+// only, blocks the deletion of every project. With deletion.Cascade the
+// descendants of the requested projects are added to the set, and with
+// deletion.Force the funding model is not checked. The projects are deleted
+// children first; the first one that fails stops the deletion. The outcome
+// of each project is returned in deletion order, followed by the requested
+// projects that were not found.
+func (s *ProjectsService) BulkDeleteProjects(ctx context.Context, deletion *models.ProjectBulkDeletion, xSync bool) ([]models.ProjectDeletionResult, error) {
+	var attrs []attribute.KeyValue
+	if deletion != nil {
+		attrs = append(attrs,
+			attribute.Int("projects", len(deletion.ProjectUIDs)),
+			attribute.Bool("dry_run", deletion.DryRun),
+			attribute.Bool("cascade", deletion.Cascade),
+		)
+	}
+	ctx, span := startSpan(ctx, "ProjectsService.BulkDeleteProjects", attrs...)
+	defer span.End()
+
+	results, err := s.bulkDeleteProjects(ctx, deletion, xSync)
+	recordSpanError(span, err)
+	return results, err
+}
+
+func (s *ProjectsService) bulkDeleteProjects(ctx context.Context, deletion *models.ProjectBulkDeletion, xSync bool) ([]models.ProjectDeletionResult, error) {
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+	// The projects span any part of the hierarchy, which the gateway cannot
+	// check, so only LF staff and trusted service principals may delete them.
+	if !s.isStaffOrTrusted(ctx) {
+		principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+		slog.WarnContext(ctx, "principal cannot bulk delete projects", "principal", principal)
+		return nil, domain.ErrForbidden
+	}
+
+	if deletion == nil {
+		deletion = &models.ProjectBulkDeletion{}
+	}
+	for i, uid := range deletion.ProjectUIDs {
+		deletion.ProjectUIDs[i] = strings.TrimSpace(uid)
+	}
+	if err := validateProjectBulkDeletion(deletion); err != nil {
+		slog.WarnContext(ctx, "invalid bulk project deletion", constants.ErrKey, err)
+		return nil, err
+	}
+
+	projects, err := s.ProjectRepository.ListAllProjectsBase(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "error listing projects from store", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+	targets, results := planProjectBulkDeletion(deletion, projects)
+
+	blocked := slices.ContainsFunc(results, func(r models.ProjectDeletionResult) bool {
+		return r.Status == models.ProjectDeletionBlocked
+	})
+	switch {
+	case blocked:
+		setPendingDeletions(results, models.ProjectDeletionSkipped)
+	case deletion.DryRun:
+		setPendingDeletions(results, models.ProjectDeletionWouldDelete)
+	default:
+		s.deleteProjectTargets(ctx, targets, results, xSync)
+	}
+
+	counts := map[string]int{}
+	for _, result := range results {
+		counts[result.Status]++
+	}
+	slog.InfoContext(ctx, "bulk deleted projects",
+		"dry_run", deletion.DryRun,
+		"cascade", deletion.Cascade,
+		"force", deletion.Force,
+		"deleted", counts[models.ProjectDeletionDeleted],
+		"would_delete", counts[models.ProjectDeletionWouldDelete],
+		"not_found", counts[models.ProjectDeletionNotFound],
+		"blocked", counts[models.ProjectDeletionBlocked],
+		"skipped", counts[models.ProjectDeletionSkipped],
+		"failed", counts[models.ProjectDeletionFailed],
+	)
+	return results, nil
+}
+
+// validateProjectBulkDeletion reports every malformed project UID of deletion
+// at once, with field paths such as "uids[1]".
+func validateProjectBulkDeletion(deletion *models.ProjectBulkDeletion) error {
+	validation := &domain.ValidationError{}
+	switch {
+	case len(deletion.ProjectUIDs) == 0:
+		validation.Add("uids", domain.FieldErrorRequired, errBulkDeletionNoProjects)
+	case len(deletion.ProjectUIDs) > maxBulkDeletions:
+		validation.Add("uids", domain.FieldErrorTooLarge, errBulkDeletionTooMany)
+	}
+
+	seen := make(map[string]bool, len(deletion.ProjectUIDs))
+	for i, uid := range deletion.ProjectUIDs {
+		path := fmt.Sprintf("uids[%d]", i)
+		switch {
+		case uid == "":
+			validation.Add(path, domain.FieldErrorRequired, domain.ErrValidationFailed)
+		case seen[uid]:
+			validation.Add(path, domain.FieldErrorInvalid, errBulkDeletionDuplicate)
+		}
+		seen[uid] = true
+	}
+	return validation.Err()
+}
+
+// planProjectBulkDeletion returns the projects deletion deletes, children
+// first, and their results in the same order followed by those of the
+// requested projects that are not in projects. The results of the projects
+// that block the deletion have the blocked status; the others have none yet.
+func planProjectBulkDeletion(deletion *models.ProjectBulkDeletion, projects []*models.ProjectBase) ([]*models.ProjectBase, []models.ProjectDeletionResult) {
+	byUID := make(map[string]*models.ProjectBase, len(projects))
+	children := map[string][]string{}
+	for _, project := range projects {
+		byUID[project.UID] = project
+		if project.ParentUID != "" {
+			children[project.ParentUID] = append(children[project.ParentUID], project.UID)
+		}
+	}
+
+	var (
+		selected  []string
+		requested = map[string]bool{}
+		inSet     = map[string]bool{}
+		notFound  []models.ProjectDeletionResult
+	)
+	for _, uid := range deletion.ProjectUIDs {
+		if byUID[uid] == nil {
+			notFound = append(notFound, models.ProjectDeletionResult{ProjectUID: uid, Requested: true, Status: models.ProjectDeletionNotFound})
+			continue
+		}
+		requested[uid] = true
+		if !inSet[uid] {
+			inSet[uid] = true
+			selected = append(selected, uid)
+		}
+	}
+	if deletion.Cascade {
+		// The selection grows as it is walked, so descendants at any depth
+		// are added.
+		for i := 0; i < len(selected); i++ {
+			for _, child := range children[selected[i]] {
+				if !inSet[child] {
+					inSet[child] = true
+					selected = append(selected, child)
+				}
+			}
+		}
+	}
+
+	depths := make(map[string]int, len(selected))
+	for _, uid := range selected {
+		depths[uid] = hierarchyDepth(byUID, uid)
+	}
+	slices.SortStableFunc(selected, func(a, b string) int {
+		return cmp.Compare(depths[b], depths[a])
+	})
+
+	targets := make([]*models.ProjectBase, 0, len(selected))
+	results := make([]models.ProjectDeletionResult, 0, len(selected)+len(notFound))
+	for _, uid := range selected {
+		project := byUID[uid]
+		result := models.ProjectDeletionResult{ProjectUID: uid, Slug: project.Slug, Requested: requested[uid]}
+		switch {
+		case slices.ContainsFunc(children[uid], func(child string) bool { return !inSet[child] }):
+			result.Status = models.ProjectDeletionBlocked
+			result.Error = errBulkDeletionHasChildren.Error()
+		case !deletion.Force && !isCrowdfundingOnly(project.FundingModel):
+			result.Status = models.ProjectDeletionBlocked
+			result.Error = domain.ErrCannotDeleteNonCrowdfundingProject.Error()
+		}
+		targets = append(targets, project)
+		results = append(results, result)
+	}
+	return targets, append(results, notFound...)
+}
+
+// hierarchyDepth returns the number of ancestors of the project uid found in
+// byUID, stopping at a cycle.
+func hierarchyDepth(byUID map[string]*models.ProjectBase, uid string) int {
+	depth := 0
+	visited := map[string]bool{uid: true}
+	for parent := byUID[uid].ParentUID; byUID[parent] != nil && !visited[parent]; parent = byUID[parent].ParentUID {
+		visited[parent] = true
+		depth++
+	}
+	return depth
+}
+
+// setPendingDeletions gives status to the results that have none yet.
+func setPendingDeletions(results []models.ProjectDeletionResult, status string) {
+	for i := range results {
+		if results[i].Status == "" {
+			results[i].Status = status
+		}
+	}
+}
+
+// deleteProjectTargets deletes targets in order, recording the outcome in the
+// result at the same index. A project that fails to be deleted stops the
+// deletion, so that its ancestors are not deleted before it.
+func (s *ProjectsService) deleteProjectTargets(ctx context.Context, targets []*models.ProjectBase, results []models.ProjectDeletionResult, xSync bool) {
+	var deleted []string
+	for i, target := range targets {
+		if err := s.deleteProjectTarget(ctx, target.UID, xSync); err != nil {
+			results[i].Status = models.ProjectDeletionFailed
+			results[i].Error = err.Error()
+			break
+		}
+		results[i].Status = models.ProjectDeletionDeleted
+		deleted = append(deleted, target.UID)
+	}
+	setPendingDeletions(results, models.ProjectDeletionSkipped)
+
+	if len(deleted) == 0 {
+		return
+	}
+	s.invalidateProjectHierarchy()
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	log.Audit(ctx, "projects bulk deleted",
+		"actor", principal,
+		"project_uids", deleted,
+	)
+}
+
+// deleteProjectTarget deletes the project projectUID at its current revision
+// and publishes the messages that follow its deletion.
+func (s *ProjectsService) deleteProjectTarget(ctx context.Context, projectUID string, xSync bool) error {
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", projectUID))
+
+	projectDB, revision, err := s.ProjectRepository.GetProjectBaseWithRevision(ctx, projectUID)
+	if err == nil {
+		err = s.ProjectRepository.DeleteProject(ctx, projectUID, revision)
+	}
+	if err != nil {
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			slog.WarnContext(ctx, "project deleted during bulk deletion", constants.ErrKey, err)
+			return domain.ErrProjectNotFound
+		}
+		if errors.Is(err, domain.ErrRevisionMismatch) {
+			slog.WarnContext(ctx, "project changed during bulk deletion", constants.ErrKey, err)
+			return domain.ErrRevisionMismatch
+		}
+		slog.ErrorContext(ctx, "error deleting project from store", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	s.recordProjectDeletion(ctx, projectDB, "")
+	return s.publishProjectMessages(ctx, projectUID, xSync, projectDeletedMessages(ctx, projectDB))
+}
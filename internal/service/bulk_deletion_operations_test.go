@@ -0,0 +1,188 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+func TestPlanProjectBulkDeletion(t *testing.T) {
+	crowdfunding := []string{"Crowdfunding"}
+	projects := []*models.ProjectBase{
+		{UID: "root", Slug: "root", FundingModel: crowdfunding},
+		{UID: "parent", Slug: "parent", ParentUID: "root", FundingModel: crowdfunding},
+		{UID: "child", Slug: "child", ParentUID: "parent", FundingModel: crowdfunding},
+		{UID: "grandchild", Slug: "grandchild", ParentUID: "child", FundingModel: crowdfunding},
+		{UID: "funded", Slug: "funded", ParentUID: "root", FundingModel: []string{"Membership"}},
+	}
+	result := func(uid string, requested bool, status, err string) models.ProjectDeletionResult {
+		return models.ProjectDeletionResult{ProjectUID: uid, Slug: uid, Requested: requested, Status: status, Error: err}
+	}
+
+	tests := []struct {
+		name     string
+		deletion models.ProjectBulkDeletion
+		expected []models.ProjectDeletionResult
+	}{
+		{
+			name:     "children are deleted before their parents",
+			deletion: models.ProjectBulkDeletion{ProjectUIDs: []string{"parent", "grandchild", "child"}},
+			expected: []models.ProjectDeletionResult{
+				result("grandchild", true, "", ""),
+				result("child", true, "", ""),
+				result("parent", true, "", ""),
+			},
+		},
+		{
+			name:     "children outside the set block the deletion",
+			deletion: models.ProjectBulkDeletion{ProjectUIDs: []string{"parent"}},
+			expected: []models.ProjectDeletionResult{
+				result("parent", true, models.ProjectDeletionBlocked, errBulkDeletionHasChildren.Error()),
+			},
+		},
+		{
+			name:     "cascade adds the descendants",
+			deletion: models.ProjectBulkDeletion{ProjectUIDs: []string{"parent"}, Cascade: true},
+			expected: []models.ProjectDeletionResult{
+				result("grandchild", false, "", ""),
+				result("child", false, "", ""),
+				result("parent", true, "", ""),
+			},
+		},
+		{
+			name:     "funding model blocks the deletion unless forced",
+			deletion: models.ProjectBulkDeletion{ProjectUIDs: []string{"funded", "grandchild"}},
+			expected: []models.ProjectDeletionResult{
+				result("grandchild", true, "", ""),
+				result("funded", true, models.ProjectDeletionBlocked, domain.ErrCannotDeleteNonCrowdfundingProject.Error()),
+			},
+		},
+		{
+			name:     "force skips the funding model check",
+			deletion: models.ProjectBulkDeletion{ProjectUIDs: []string{"funded"}, Force: true},
+			expected: []models.ProjectDeletionResult{
+				result("funded", true, "", ""),
+			},
+		},
+		{
+			name:     "missing projects are reported last",
+			deletion: models.ProjectBulkDeletion{ProjectUIDs: []string{"missing", "grandchild"}},
+			expected: []models.ProjectDeletionResult{
+				result("grandchild", true, "", ""),
+				{ProjectUID: "missing", Requested: true, Status: models.ProjectDeletionNotFound},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targets, results := planProjectBulkDeletion(&tt.deletion, projects)
+
+			assert.Equal(t, tt.expected, results)
+			for i, target := range targets {
+				assert.Equal(t, results[i].ProjectUID, target.UID)
+			}
+		})
+	}
+}
+
+func TestProjectsService_BulkDeleteProjects(t *testing.T) {
+	crowdfunding := []string{"Crowdfunding"}
+	projects := []*models.ProjectBase{
+		{UID: "parent", Slug: "parent", FundingModel: crowdfunding},
+		{UID: "child", Slug: "child", ParentUID: "parent", FundingModel: crowdfunding},
+	}
+	statuses := func(results []models.ProjectDeletionResult) map[string]string {
+		byUID := map[string]string{}
+		for _, r := range results {
+			byUID[r.ProjectUID] = r.Status
+		}
+		return byUID
+	}
+
+	t.Run("cascade deletes the children first", func(t *testing.T) {
+		service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+		mockRepo.On("ListAllProjectsBase", mock.Anything).Return(projects, nil)
+		var order []string
+		for _, project := range projects {
+			mockRepo.On("GetProjectBaseWithRevision", mock.Anything, project.UID).Return(project, uint64(2), nil)
+			mockRepo.On("DeleteProject", mock.Anything, project.UID, uint64(2)).Run(func(args mock.Arguments) {
+				order = append(order, args.String(1))
+			}).Return(nil)
+		}
+		mockBuilder.On("SendIndexerMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockBuilder.On("SendAccessMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		results, err := service.BulkDeleteProjects(staffContext(), &models.ProjectBulkDeletion{ProjectUIDs: []string{"parent"}, Cascade: true}, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"child", "parent"}, order)
+		assert.Equal(t, map[string]string{"child": models.ProjectDeletionDeleted, "parent": models.ProjectDeletionDeleted}, statuses(results))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("dry run deletes nothing", func(t *testing.T) {
+		service, mockRepo, _, _ := setupServiceForTesting()
+		mockRepo.On("ListAllProjectsBase", mock.Anything).Return(projects, nil)
+
+		results, err := service.BulkDeleteProjects(staffContext(), &models.ProjectBulkDeletion{ProjectUIDs: []string{"parent"}, Cascade: true, DryRun: true}, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"child": models.ProjectDeletionWouldDelete, "parent": models.ProjectDeletionWouldDelete}, statuses(results))
+		mockRepo.AssertNotCalled(t, "DeleteProject", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("a blocked project skips the others", func(t *testing.T) {
+		service, mockRepo, _, _ := setupServiceForTesting()
+		mockRepo.On("ListAllProjectsBase", mock.Anything).Return(projects, nil)
+
+		results, err := service.BulkDeleteProjects(staffContext(), &models.ProjectBulkDeletion{ProjectUIDs: []string{"parent", "missing"}}, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"parent": models.ProjectDeletionBlocked, "missing": models.ProjectDeletionNotFound}, statuses(results))
+		mockRepo.AssertNotCalled(t, "DeleteProject", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("a failed deletion stops the others", func(t *testing.T) {
+		service, mockRepo, _, _ := setupServiceForTesting()
+		mockRepo.On("ListAllProjectsBase", mock.Anything).Return(projects, nil)
+		mockRepo.On("GetProjectBaseWithRevision", mock.Anything, "child").Return(projects[1], uint64(2), nil)
+		mockRepo.On("DeleteProject", mock.Anything, "child", uint64(2)).Return(domain.ErrRevisionMismatch)
+
+		results, err := service.BulkDeleteProjects(staffContext(), &models.ProjectBulkDeletion{ProjectUIDs: []string{"parent", "child"}}, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"child": models.ProjectDeletionFailed, "parent": models.ProjectDeletionSkipped}, statuses(results))
+		assert.Equal(t, domain.ErrRevisionMismatch.Error(), results[0].Error)
+		mockRepo.AssertNotCalled(t, "DeleteProject", mock.Anything, "parent", mock.Anything)
+	})
+
+	t.Run("invalid requests are rejected", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+
+		_, err := service.BulkDeleteProjects(staffContext(), &models.ProjectBulkDeletion{ProjectUIDs: []string{"parent", " parent", ""}}, false)
+
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "uids[1]", validationErr.Fields[0].Path)
+		assert.Equal(t, "uids[2]", validationErr.Fields[1].Path)
+	})
+
+	t.Run("only staff may bulk delete", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+
+		_, err := service.BulkDeleteProjects(context.Background(), &models.ProjectBulkDeletion{ProjectUIDs: []string{"parent"}}, false)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
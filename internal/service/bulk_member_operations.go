@@ -0,0 +1,257 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// maxBulkMemberChanges caps the projects one bulk member update may change.
+const maxBulkMemberChanges = 100
+
+var (
+	errBulkNoChanges        = errors.New("at least one project change is required")
+	errBulkTooManyChanges   = fmt.Errorf("at most %d projects can be changed at once", maxBulkMemberChanges)
+	errBulkDuplicateProject = errors.New("project is changed more than once")
+	errBulkNoMembers        = errors.New("no users to add or remove")
+	errBulkAddAndRemove     = errors.New("user is both added to and removed from the role")
+)
+
+// memberRoleChange is the users added to and removed from one role of a
+// project, with the request fields they come from.
+type memberRoleChange struct {
+	role                  models.ProjectMemberRole
+	add, remove           []string
+	addField, removeField string
+}
+
+// roleChanges splits change by role.
+func roleChanges(change *models.ProjectMembersChange) []memberRoleChange {
+	return []memberRoleChange{
+		{models.ProjectMemberRoleWriter, change.WritersAdd, change.WritersRemove, "writers_add", "writers_remove"},
+		{models.ProjectMemberRoleAuditor, change.AuditorsAdd, change.AuditorsRemove, "auditors_add", "auditors_remove"},
+	}
+}
+
+// BulkUpdateProjectMembers adds users to and removes users from the writers and
+// auditors of several projects, so that LF staff can on-board or off-board a
+// user across projects in one request. Each project is updated on its own,
+// re-reading the settings and retrying on concurrent writes, and is followed by
+// one FGA sync message for all its relations. A project that fails does not
+// stop the others; the outcome of each change is returned in request order.
+func (s *ProjectsService) BulkUpdateProjectMembers(ctx context.Context, changes []models.ProjectMembersChange, xSync bool) ([]models.ProjectMembersChangeResult, error) {
+	ctx, span := startSpan(ctx, "ProjectsService.BulkUpdateProjectMembers", attribute.Int("changes", len(changes)))
+	defer span.End()
+
+	results, err := s.bulkUpdateProjectMembers(ctx, changes, xSync)
+	recordSpanError(span, err)
+	return results, err
+}
+
+func (s *ProjectsService) bulkUpdateProjectMembers(ctx context.Context, changes []models.ProjectMembersChange, xSync bool) ([]models.ProjectMembersChangeResult, error) {
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+	// The changes span any number of projects, which the gateway cannot check,
+	// so only LF staff and trusted service principals may make them.
+	if !s.isStaffOrTrusted(ctx) {
+		principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+		slog.WarnContext(ctx, "principal cannot bulk update project members", "principal", principal)
+		return nil, domain.ErrForbidden
+	}
+
+	for i := range changes {
+		normalizeProjectMembersChange(&changes[i])
+	}
+	if err := validateProjectMembersChanges(changes); err != nil {
+		return nil, err
+	}
+	users, err := s.lookupBulkMembers(ctx, changes)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]models.ProjectMembersChangeResult, 0, len(changes))
+	counts := map[string]int{}
+	for i := range changes {
+		result := s.applyProjectMembersChange(ctx, &changes[i], users, xSync)
+		counts[result.Status]++
+		results = append(results, result)
+	}
+
+	slog.InfoContext(ctx, "bulk updated project members",
+		"updated", counts[models.ProjectMembersChangeUpdated],
+		"unchanged", counts[models.ProjectMembersChangeUnchanged],
+		"not_found", counts[models.ProjectMembersChangeNotFound],
+		"failed", counts[models.ProjectMembersChangeFailed],
+	)
+	return results, nil
+}
+
+// normalizeProjectMembersChange trims the project UID and usernames of change.
+func normalizeProjectMembersChange(change *models.ProjectMembersChange) {
+	change.ProjectUID = strings.TrimSpace(change.ProjectUID)
+	for _, usernames := range []*[]string{&change.WritersAdd, &change.WritersRemove, &change.AuditorsAdd, &change.AuditorsRemove} {
+		trimmed := make([]string, len(*usernames))
+		for i, username := range *usernames {
+			trimmed[i] = strings.TrimSpace(username)
+		}
+		*usernames = trimmed
+	}
+}
+
+// validateProjectMembersChanges reports every malformed change at once, with
+// field paths such as "operations[1].writers_add[0]".
+func validateProjectMembersChanges(changes []models.ProjectMembersChange) error {
+	validation := &domain.ValidationError{}
+	switch {
+	case len(changes) == 0:
+		validation.Add("operations", domain.FieldErrorRequired, errBulkNoChanges)
+	case len(changes) > maxBulkMemberChanges:
+		validation.Add("operations", domain.FieldErrorTooLarge, errBulkTooManyChanges)
+	}
+
+	seen := make(map[string]bool, len(changes))
+	for i := range changes {
+		change := &changes[i]
+		path := fmt.Sprintf("operations[%d]", i)
+		switch {
+		case change.ProjectUID == "":
+			validation.Add(path+".uid", domain.FieldErrorRequired, domain.ErrValidationFailed)
+		case seen[change.ProjectUID]:
+			validation.Add(path+".uid", domain.FieldErrorInvalid, errBulkDuplicateProject)
+		}
+		seen[change.ProjectUID] = true
+
+		empty := true
+		for _, rc := range roleChanges(change) {
+			for j, username := range rc.add {
+				if username == "" {
+					validation.Add(fmt.Sprintf("%s.%s[%d]", path, rc.addField, j), domain.FieldErrorRequired, domain.ErrValidationFailed)
+				}
+			}
+			for j, username := range rc.remove {
+				switch {
+				case username == "":
+					validation.Add(fmt.Sprintf("%s.%s[%d]", path, rc.removeField, j), domain.FieldErrorRequired, domain.ErrValidationFailed)
+				case slices.Contains(rc.add, username):
+					validation.Add(fmt.Sprintf("%s.%s[%d]", path, rc.removeField, j), domain.FieldErrorInvalid, errBulkAddAndRemove)
+				}
+			}
+			if len(rc.add) > 0 || len(rc.remove) > 0 {
+				empty = false
+			}
+		}
+		if empty {
+			validation.Add(path, domain.FieldErrorRequired, errBulkNoMembers)
+		}
+	}
+	return validation.Err()
+}
+
+// lookupBulkMembers reads the profile of every user added by changes, once per
+// user, before any project is changed. Unknown users are reported as field
+// errors.
+func (s *ProjectsService) lookupBulkMembers(ctx context.Context, changes []models.ProjectMembersChange) (map[string]models.UserInfo, error) {
+	users := map[string]models.UserInfo{}
+	validation := &domain.ValidationError{}
+	for i := range changes {
+		for _, rc := range roleChanges(&changes[i]) {
+			for j, username := range rc.add {
+				if _, ok := users[username]; ok {
+					continue
+				}
+				meta, err := s.UserReader.UserMetadataByPrincipal(ctx, username)
+				if errors.Is(err, domain.ErrUserNotFound) {
+					validation.Add(fmt.Sprintf("operations[%d].%s[%d]", i, rc.addField, j), domain.FieldErrorNotFound, domain.ErrUserNotFound)
+					continue
+				}
+				if err != nil {
+					slog.ErrorContext(ctx, "error looking up project member", constants.ErrKey, err)
+					return nil, domain.ErrServiceUnavailable
+				}
+				users[username] = models.UserInfo{Username: username, Name: meta.Name, Avatar: meta.Picture}
+			}
+		}
+	}
+	if err := validation.Err(); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// applyProjectMembersChange applies one change and publishes the messages that
+// follow it, reporting the outcome rather than returning an error.
+func (s *ProjectsService) applyProjectMembersChange(ctx context.Context, change *models.ProjectMembersChange, users map[string]models.UserInfo, xSync bool) models.ProjectMembersChangeResult {
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", change.ProjectUID))
+	result := models.ProjectMembersChangeResult{ProjectUID: change.ProjectUID}
+
+	var previous models.ProjectSettings
+	settings, err := s.ProjectRepository.UpdateProjectSettingsWithRetry(ctx, change.ProjectUID, func(p *models.ProjectSettings) error {
+		updated := *p
+		changed := false
+		for _, rc := range roleChanges(change) {
+			members := updated.Members(rc.role)
+			next := slices.DeleteFunc(slices.Clone(*members), func(u models.UserInfo) bool { return slices.Contains(rc.remove, u.Username) })
+			changed = changed || len(next) != len(*members)
+			for _, username := range rc.add {
+				if !slices.ContainsFunc(next, func(u models.UserInfo) bool { return u.Username == username }) {
+					next = append(next, users[username])
+					changed = true
+				}
+			}
+			*members = next
+		}
+		if !changed {
+			return errMemberUnchanged
+		}
+		previous = *p
+		now := time.Now().UTC()
+		updated.UpdatedAt = &now
+		*p = updated
+		return nil
+	})
+	switch {
+	case errors.Is(err, errMemberUnchanged):
+		result.Status = models.ProjectMembersChangeUnchanged
+		return result
+	case errors.Is(err, domain.ErrProjectNotFound):
+		result.Status = models.ProjectMembersChangeNotFound
+		return result
+	case err != nil:
+		result.Status = models.ProjectMembersChangeFailed
+		result.Error = s.projectMemberUpdateError(ctx, err).Error()
+		return result
+	}
+
+	projectDB, err := s.ProjectRepository.GetProjectBase(ctx, change.ProjectUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error getting project from store", constants.ErrKey, err)
+		result.Status = models.ProjectMembersChangeFailed
+		result.Error = domain.ErrInternal.Error()
+		return result
+	}
+	messages := projectSettingsUpdatedMessages(ctx, projectDB, &previous, settings, projectSettingsAccessMessages(projectDB, &previous, settings)...)
+	if err := s.publishProjectMessages(ctx, change.ProjectUID, xSync, messages); err != nil {
+		result.Status = models.ProjectMembersChangeFailed
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Status = models.ProjectMembersChangeUpdated
+	return result
+}
@@ -0,0 +1,140 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	fgaconstants "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+func staffContext() context.Context {
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "staff-user")
+	return context.WithValue(ctx, constants.RolesContextID, []string{domain.RoleStaff})
+}
+
+func TestProjectsService_BulkUpdateProjectMembers(t *testing.T) {
+	alice := models.UserInfo{Name: "Alice", Username: "alice"}
+	bob := models.UserInfo{Name: "Bob", Username: "bob"}
+	stored := map[string]*models.ProjectSettings{
+		"project-1": {UID: "project-1", Writers: []models.UserInfo{alice}},
+		"project-2": {UID: "project-2", Writers: []models.UserInfo{bob}, Auditors: []models.UserInfo{alice}},
+	}
+
+	service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+	mockUsers := service.UserReader.(*domain.MockUserReader)
+	mockUsers.On("UserMetadataByPrincipal", mock.Anything, "bob").Return(&domain.UserMetadata{Name: "Bob"}, nil).Once()
+	for uid, settings := range stored {
+		update := mockRepo.On("UpdateProjectSettingsWithRetry", mock.Anything, uid, mock.Anything).
+			Run(func(args mock.Arguments) {
+				_ = args.Get(2).(func(*models.ProjectSettings) error)(settings)
+			})
+		if uid == "project-1" {
+			update.Return(settings, nil)
+			mockRepo.On("GetProjectBase", mock.Anything, uid).Return(&models.ProjectBase{UID: uid, Slug: uid}, nil)
+		} else {
+			update.Return(nil, errMemberUnchanged)
+		}
+	}
+	mockRepo.On("UpdateProjectSettingsWithRetry", mock.Anything, "missing", mock.Anything).Return(nil, domain.ErrProjectNotFound)
+	mockBuilder.On("SendIndexerMessage", mock.Anything, constants.IndexProjectSettingsSubject, mock.Anything, false).Return(nil)
+	mockBuilder.On("SendAccessMessage", mock.Anything, fgaconstants.GenericUpdateAccessSubject, mock.Anything, false).Return(nil)
+	mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	results, err := service.BulkUpdateProjectMembers(staffContext(), []models.ProjectMembersChange{
+		{ProjectUID: "project-1", WritersAdd: []string{" bob "}, WritersRemove: []string{"alice"}, AuditorsAdd: []string{"bob"}},
+		{ProjectUID: "project-2", WritersAdd: []string{"bob"}, AuditorsRemove: []string{"carol"}},
+		{ProjectUID: "missing", WritersRemove: []string{"alice"}},
+	}, false)
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.ProjectMembersChangeResult{
+		{ProjectUID: "project-1", Status: models.ProjectMembersChangeUpdated},
+		{ProjectUID: "project-2", Status: models.ProjectMembersChangeUnchanged},
+		{ProjectUID: "missing", Status: models.ProjectMembersChangeNotFound},
+	}, results)
+	assert.Equal(t, []models.UserInfo{bob}, stored["project-1"].Writers)
+	assert.Equal(t, []models.UserInfo{bob}, stored["project-1"].Auditors)
+	mockBuilder.AssertNumberOfCalls(t, "SendAccessMessage", 1)
+	mockRepo.AssertExpectations(t)
+	mockUsers.AssertExpectations(t)
+}
+
+func TestProjectsService_BulkUpdateProjectMembers_rejected(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		changes     []models.ProjectMembersChange
+		setupUsers  func(*domain.MockUserReader)
+		expectedErr error
+		paths       []string
+	}{
+		{
+			name:        "not staff",
+			ctx:         context.WithValue(context.Background(), constants.PrincipalContextID, "user"),
+			changes:     []models.ProjectMembersChange{{ProjectUID: "project-1", WritersAdd: []string{"bob"}}},
+			expectedErr: domain.ErrForbidden,
+		},
+		{
+			name: "malformed changes",
+			ctx:  staffContext(),
+			changes: []models.ProjectMembersChange{
+				{ProjectUID: "project-1", WritersAdd: []string{"bob"}, WritersRemove: []string{"bob"}},
+				{ProjectUID: "project-1", AuditorsAdd: []string{" "}},
+				{ProjectUID: "project-2"},
+			},
+			expectedErr: domain.ErrValidationFailed,
+			paths: []string{
+				"operations[0].writers_remove[0]",
+				"operations[1].uid",
+				"operations[1].auditors_add[0]",
+				"operations[2]",
+			},
+		},
+		{
+			name:    "unknown user",
+			ctx:     staffContext(),
+			changes: []models.ProjectMembersChange{{ProjectUID: "project-1", AuditorsAdd: []string{"nobody"}}},
+			setupUsers: func(users *domain.MockUserReader) {
+				users.On("UserMetadataByPrincipal", mock.Anything, "nobody").Return(nil, domain.ErrUserNotFound)
+			},
+			expectedErr: domain.ErrValidationFailed,
+			paths: []string{
+				"operations[0].auditors_add[0]",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := setupServiceForTesting()
+			mockUsers := service.UserReader.(*domain.MockUserReader)
+			if tt.setupUsers != nil {
+				tt.setupUsers(mockUsers)
+			}
+
+			_, err := service.BulkUpdateProjectMembers(tt.ctx, tt.changes, false)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			if tt.paths != nil {
+				var validationErr *domain.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				paths := make([]string, 0, len(validationErr.Fields))
+				for _, field := range validationErr.Fields {
+					paths = append(paths, field.Path)
+				}
+				assert.Equal(t, tt.paths, paths)
+			}
+			mockRepo.AssertNotCalled(t, "UpdateProjectSettingsWithRetry", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+)
+
+// cacheControlPrivate is the Cache-Control of responses that hold private
+// projects or project settings, which must not be stored by any cache.
+const cacheControlPrivate = "private, no-store"
+
+// CachePolicy is how long caches, such as CDNs and browsers, may keep the
+// responses of an endpoint that only hold public project metadata.
+type CachePolicy struct {
+	// MaxAge is how long a response is fresh. Zero makes caches revalidate
+	// the response, with its ETag, every time it is used.
+	MaxAge time.Duration
+	// StaleWhileRevalidate is how long after MaxAge a stale response may
+	// still be used while the cache revalidates it in the background.
+	StaleWhileRevalidate time.Duration
+}
+
+// cacheControl returns the Cache-Control of a response under p, public when
+// the response only holds public project metadata.
+func (p CachePolicy) cacheControl(public bool) string {
+	if !public {
+		return cacheControlPrivate
+	}
+	if p.MaxAge <= 0 {
+		return "public, no-cache"
+	}
+	directives := []string{"public", fmt.Sprintf("max-age=%d", int(p.MaxAge.Seconds()))}
+	if p.StaleWhileRevalidate > 0 {
+		directives = append(directives, fmt.Sprintf("stale-while-revalidate=%d", int(p.StaleWhileRevalidate.Seconds())))
+	}
+	return strings.Join(directives, ", ")
+}
+
+// hasProjectSettingsFields reports whether any of the settings fields of
+// project are set. Settings are only readable by the project's auditors, so
+// a response holding them must not be cached for others.
+func hasProjectSettingsFields(project *projsvc.ProjectFull) bool {
+	return project.Settings != nil || project.MissionStatement != nil || project.OverviewHTML != nil ||
+		project.AnnouncementDate != nil || project.Writers != nil || project.Auditors != nil ||
+		project.MeetingCoordinators != nil || project.ExecutiveDirector != nil ||
+		project.ProgramManager != nil || project.OpportunityOwner != nil
+}
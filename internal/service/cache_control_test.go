@@ -0,0 +1,112 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+func TestCachePolicy_cacheControl(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   CachePolicy
+		public   bool
+		expected string
+	}{
+		{
+			name:     "private response",
+			policy:   CachePolicy{MaxAge: 5 * time.Minute},
+			expected: "private, no-store",
+		},
+		{
+			name:     "public response without max age",
+			public:   true,
+			expected: "public, no-cache",
+		},
+		{
+			name:     "public response",
+			policy:   CachePolicy{MaxAge: 5 * time.Minute},
+			public:   true,
+			expected: "public, max-age=300",
+		},
+		{
+			name:     "public response with stale-while-revalidate",
+			policy:   CachePolicy{MaxAge: 5 * time.Minute, StaleWhileRevalidate: time.Minute},
+			public:   true,
+			expected: "public, max-age=300, stale-while-revalidate=60",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.policy.cacheControl(tt.public))
+		})
+	}
+}
+
+func TestProjectsService_GetProjects_cacheControl(t *testing.T) {
+	policy := CachePolicy{MaxAge: 10 * time.Minute}
+	projects := []*models.ProjectBase{
+		{UID: "project-1", Slug: "project-1", Public: true},
+		{UID: "project-2", Slug: "project-2", Public: true},
+	}
+	settings := []*models.ProjectSettings{{UID: "project-1", MissionStatement: "Mission"}}
+
+	tests := []struct {
+		name     string
+		payload  *projsvc.GetProjectsPayload
+		private  bool
+		expected string
+	}{
+		{
+			name:     "settings are included",
+			payload:  &projsvc.GetProjectsPayload{},
+			expected: "private, no-store",
+		},
+		{
+			name:     "only base fields of public projects",
+			payload:  &projsvc.GetProjectsPayload{BaseOnly: true},
+			expected: "public, max-age=600",
+		},
+		{
+			name:     "settings fields are not selected",
+			payload:  &projsvc.GetProjectsPayload{Fields: misc.StringPtr("uid,name")},
+			expected: "public, max-age=600",
+		},
+		{
+			name:     "a private project is included",
+			payload:  &projsvc.GetProjectsPayload{BaseOnly: true},
+			private:  true,
+			expected: "private, no-store",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := setupServiceForTesting()
+			service.Config.ProjectListCachePolicy = policy
+			listed := []*models.ProjectBase{projects[0], projects[1]}
+			if tt.private {
+				listed = append(listed, &models.ProjectBase{UID: "project-3", Slug: "project-3"})
+			}
+			mockRepo.On("ListAllProjects", mock.Anything).Return(listed, settings, nil).Maybe()
+			mockRepo.On("ListAllProjectsBase", mock.Anything).Return(listed, nil).Maybe()
+
+			result, err := service.GetProjects(context.Background(), tt.payload)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, *result.CacheControl)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// pdfSignature is the header every PDF file starts with.
+var pdfSignature = []byte("%PDF-")
+
+// UploadProjectCharter stores a new version of a project's PDF charter, adds it
+// to the charter's version history, and points the project's charter_url at it.
+func (s *ProjectsService) UploadProjectCharter(ctx context.Context, projectUID, fileName string, data []byte, xSync bool) (*models.ProjectCharter, error) {
+	ctx, span := startSpan(ctx, "ProjectsService.UploadProjectCharter", attribute.String("project_uid", projectUID))
+	defer span.End()
+
+	charter, err := s.uploadProjectCharter(ctx, projectUID, fileName, data, xSync)
+	recordSpanError(span, err)
+	return charter, err
+}
+
+func (s *ProjectsService) uploadProjectCharter(ctx context.Context, projectUID, fileName string, data []byte, xSync bool) (*models.ProjectCharter, error) {
+	if !s.ServiceReady() || s.CharterStorage == nil || s.CharterRepository == nil {
+		slog.ErrorContext(ctx, "service not ready or charter storage not configured")
+		return nil, domain.ErrServiceUnavailable
+	}
+
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", projectUID))
+
+	if len(data) == 0 {
+		return nil, domain.ErrValidationFailed
+	}
+	if int64(len(data)) > models.MaxCharterFileSize {
+		return nil, domain.ErrFileTooLarge
+	}
+	if !bytes.HasPrefix(data, pdfSignature) {
+		slog.WarnContext(ctx, "charter is not a PDF file", "file_name", fileName)
+		return nil, domain.ErrInvalidContentType
+	}
+
+	if err := s.authorizeProject(ctx, projectUID, relationWriter); err != nil {
+		return nil, err
+	}
+	exists, err := s.ProjectRepository.ProjectExists(ctx, projectUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error checking if project exists", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+	if !exists {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	// Each version gets its own key, so that earlier versions stay available
+	// at the URLs recorded in the history.
+	charterURL, err := s.CharterStorage.PutCharter(ctx, projectUID+"/"+uuid.NewString()+".pdf", data)
+	if err != nil {
+		return nil, err
+	}
+
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	checksum := sha256.Sum256(data)
+	charter, err := s.CharterRepository.AddProjectCharterVersion(ctx, projectUID, models.ProjectCharterVersion{
+		URL:                charterURL,
+		FileName:           fileName,
+		FileSize:           int64(len(data)),
+		SHA256:             hex.EncodeToString(checksum[:]),
+		UploadedByUsername: principal,
+		UploadedAt:         time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	current := charter.Current()
+
+	var previous models.ProjectBase
+	projectDB, err := s.ProjectRepository.UpdateProjectBaseWithRetry(ctx, projectUID, func(p *models.ProjectBase) error {
+		previous = *p
+		now := time.Now().UTC()
+		p.CharterURL = current.URL
+		p.UpdatedAt = &now
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			return nil, domain.ErrProjectNotFound
+		}
+		slog.ErrorContext(ctx, "error updating project charter URL", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+
+	messages := projectBaseUpdatedMessages(ctx, projectDB, &previous)
+	if err := s.publishProjectMessages(ctx, projectDB.UID, xSync, messages); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "uploaded project charter", "version", current.Version, "charter_url", current.URL)
+	return charter, nil
+}
+
+// GetProjectCharter returns a project's charter and its version history.
+func (s *ProjectsService) GetProjectCharter(ctx context.Context, projectUID string) (*models.ProjectCharter, error) {
+	if !s.ServiceReady() || s.CharterRepository == nil {
+		slog.ErrorContext(ctx, "service not ready or charter storage not configured")
+		return nil, domain.ErrServiceUnavailable
+	}
+
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", projectUID))
+
+	exists, err := s.ProjectRepository.ProjectExists(ctx, projectUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error checking if project exists", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+	if !exists {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	charter, err := s.CharterRepository.GetProjectCharter(ctx, projectUID)
+	if err != nil {
+		if errors.Is(err, domain.ErrCharterNotFound) {
+			return nil, domain.ErrCharterNotFound
+		}
+		slog.ErrorContext(ctx, "error getting project charter", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+	return charter, nil
+}
@@ -0,0 +1,193 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+func TestProjectsService_UploadProjectCharter(t *testing.T) {
+	const charterURL = "https://charters.example.org/project-1/v2.pdf"
+	pdf := []byte("%PDF-1.7\n...")
+	sum := sha256.Sum256(pdf)
+	checksum := hex.EncodeToString(sum[:])
+	stored := &models.ProjectBase{UID: "project-1", Slug: "project-1", CharterURL: "https://charters.example.org/project-1/v1.pdf"}
+	history := &models.ProjectCharter{
+		ProjectUID: "project-1",
+		Versions: []models.ProjectCharterVersion{
+			{Version: 1, URL: "https://charters.example.org/project-1/v1.pdf"},
+			{Version: 2, URL: charterURL},
+		},
+	}
+	isCharterKey := mock.MatchedBy(func(key string) bool {
+		return strings.HasPrefix(key, "project-1/") && strings.HasSuffix(key, ".pdf")
+	})
+
+	tests := []struct {
+		name           string
+		data           []byte
+		withoutStorage bool
+		setupMocks     func(*domain.MockProjectRepository, *domain.MockMessageBuilder, *domain.MockCharterStorage, *domain.MockCharterRepository)
+		expectedErr    error
+	}{
+		{
+			name: "charter is stored as a new version and set as the charter URL",
+			data: pdf,
+			setupMocks: func(repo *domain.MockProjectRepository, mb *domain.MockMessageBuilder, storage *domain.MockCharterStorage, charters *domain.MockCharterRepository) {
+				repo.On("ProjectExists", mock.Anything, "project-1").Return(true, nil)
+				storage.On("PutCharter", mock.Anything, isCharterKey, pdf).Return(charterURL, nil)
+				charters.On("AddProjectCharterVersion", mock.Anything, "project-1", mock.MatchedBy(func(v models.ProjectCharterVersion) bool {
+					return v.URL == charterURL && v.FileName == "charter.pdf" && v.FileSize == int64(len(pdf)) && v.SHA256 == checksum
+				})).Return(history, nil)
+				expectLogoUpdate(repo, mb, stored)
+			},
+		},
+		{
+			name:        "not a PDF",
+			data:        []byte("<html></html>"),
+			expectedErr: domain.ErrInvalidContentType,
+		},
+		{
+			name:        "empty file",
+			data:        []byte{},
+			expectedErr: domain.ErrValidationFailed,
+		},
+		{
+			name:        "file too large",
+			data:        append([]byte("%PDF-"), make([]byte, models.MaxCharterFileSize)...),
+			expectedErr: domain.ErrFileTooLarge,
+		},
+		{
+			name: "project not found",
+			data: pdf,
+			setupMocks: func(repo *domain.MockProjectRepository, _ *domain.MockMessageBuilder, _ *domain.MockCharterStorage, _ *domain.MockCharterRepository) {
+				repo.On("ProjectExists", mock.Anything, "project-1").Return(false, nil)
+			},
+			expectedErr: domain.ErrProjectNotFound,
+		},
+		{
+			name: "history update conflict",
+			data: pdf,
+			setupMocks: func(repo *domain.MockProjectRepository, _ *domain.MockMessageBuilder, storage *domain.MockCharterStorage, charters *domain.MockCharterRepository) {
+				repo.On("ProjectExists", mock.Anything, "project-1").Return(true, nil)
+				storage.On("PutCharter", mock.Anything, isCharterKey, pdf).Return(charterURL, nil)
+				charters.On("AddProjectCharterVersion", mock.Anything, "project-1", mock.Anything).Return(nil, domain.ErrRevisionMismatch)
+			},
+			expectedErr: domain.ErrRevisionMismatch,
+		},
+		{
+			name:           "charter storage not configured",
+			data:           pdf,
+			withoutStorage: true,
+			expectedErr:    domain.ErrServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+			mockStorage := &domain.MockCharterStorage{}
+			mockCharters := &domain.MockCharterRepository{}
+			if !tt.withoutStorage {
+				service.CharterStorage = mockStorage
+			}
+			service.CharterRepository = mockCharters
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockRepo, mockBuilder, mockStorage, mockCharters)
+			}
+
+			charter, err := service.UploadProjectCharter(context.Background(), "project-1", "charter.pdf", tt.data, false)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, history, charter)
+				assert.Equal(t, charterURL, stored.CharterURL)
+			}
+			mockRepo.AssertExpectations(t)
+			mockStorage.AssertExpectations(t)
+			mockCharters.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectsService_GetProjectCharter(t *testing.T) {
+	charter := &models.ProjectCharter{
+		ProjectUID: "project-1",
+		Versions:   []models.ProjectCharterVersion{{Version: 1, URL: "https://charters.example.org/project-1/v1.pdf", UploadedAt: time.Now().UTC()}},
+	}
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*domain.MockProjectRepository, *domain.MockCharterRepository)
+		expected    *models.ProjectCharter
+		expectedErr error
+	}{
+		{
+			name: "charter history is returned",
+			setupMocks: func(repo *domain.MockProjectRepository, charters *domain.MockCharterRepository) {
+				repo.On("ProjectExists", mock.Anything, "project-1").Return(true, nil)
+				charters.On("GetProjectCharter", mock.Anything, "project-1").Return(charter, nil)
+			},
+			expected: charter,
+		},
+		{
+			name: "project without a charter",
+			setupMocks: func(repo *domain.MockProjectRepository, charters *domain.MockCharterRepository) {
+				repo.On("ProjectExists", mock.Anything, "project-1").Return(true, nil)
+				charters.On("GetProjectCharter", mock.Anything, "project-1").Return(nil, domain.ErrCharterNotFound)
+			},
+			expectedErr: domain.ErrCharterNotFound,
+		},
+		{
+			name: "project not found",
+			setupMocks: func(repo *domain.MockProjectRepository, _ *domain.MockCharterRepository) {
+				repo.On("ProjectExists", mock.Anything, "project-1").Return(false, nil)
+			},
+			expectedErr: domain.ErrProjectNotFound,
+		},
+		{
+			name: "store error",
+			setupMocks: func(repo *domain.MockProjectRepository, charters *domain.MockCharterRepository) {
+				repo.On("ProjectExists", mock.Anything, "project-1").Return(true, nil)
+				charters.On("GetProjectCharter", mock.Anything, "project-1").Return(nil, errors.New("nats: timeout"))
+			},
+			expectedErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := setupServiceForTesting()
+			mockCharters := &domain.MockCharterRepository{}
+			service.CharterRepository = mockCharters
+			tt.setupMocks(mockRepo, mockCharters)
+
+			charter, err := service.GetProjectCharter(context.Background(), "project-1")
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, charter)
+			}
+			mockRepo.AssertExpectations(t)
+			mockCharters.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// CloneProject creates a new project with a copy of the base and settings of
+// the project payload.UID, overridden by the fields set in the payload. The
+// copy is created like any new project, so it gets a new UID and timestamps
+// and the usual indexer, access and event messages are sent. Its legacy ID
+// and announcement date are not copied, and it is not rejected as a
+// duplicate of the project it was cloned from.
+func (s *ProjectsService) CloneProject(ctx context.Context, payload *projsvc.CloneProjectPayload) (*projsvc.ProjectFull, error) {
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+
+	ctx = log.AppendCtx(ctx, slog.String("source_project_uid", payload.UID))
+	ctx, span := startSpan(ctx, "ProjectsService.CloneProject", attribute.String("source_project.uid", payload.UID))
+	defer span.End()
+
+	// The clone copies the source's settings, so only writers may clone it.
+	if err := s.authorizeProject(ctx, payload.UID, relationWriter); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	base, err := s.ProjectRepository.GetProjectBase(ctx, payload.UID)
+	if err != nil {
+		if !errors.Is(err, domain.ErrProjectNotFound) {
+			slog.ErrorContext(ctx, "error getting project base", constants.ErrKey, err)
+			err = domain.ErrInternal
+		}
+		recordSpanError(span, err)
+		return nil, err
+	}
+	settings, err := s.ProjectRepository.GetProjectSettings(ctx, payload.UID)
+	if err != nil {
+		if !errors.Is(err, domain.ErrProjectNotFound) {
+			slog.ErrorContext(ctx, "error getting project settings", constants.ErrKey, err)
+			err = domain.ErrInternal
+		}
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	project, err := s.createProject(ctx, cloneProjectPayload(ConvertToProjectFull(base, settings, nil), payload))
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	if project.UID != nil {
+		span.SetAttributes(projectUIDAttr(*project.UID))
+	}
+
+	slog.InfoContext(ctx, "cloned project", "project_uid", stringValue(project.UID), "project_slug", payload.Slug)
+	return project, nil
+}
+
+// cloneProjectPayload returns the payload creating a copy of source with the
+// fields set in payload.
+func cloneProjectPayload(source *projsvc.ProjectFull, payload *projsvc.CloneProjectPayload) *projsvc.CreateProjectPayload {
+	create := &projsvc.CreateProjectPayload{
+		BearerToken:                payload.BearerToken,
+		XSync:                      payload.XSync,
+		Version:                    payload.Version,
+		AllowDuplicates:            true,
+		Slug:                       &payload.Slug,
+		Description:                stringValue(source.Description),
+		Name:                       stringValue(source.Name),
+		Public:                     source.Public,
+		IsFoundation:               source.IsFoundation,
+		ParentUID:                  stringValue(source.ParentUID),
+		Stage:                      source.Stage,
+		Category:                   source.Category,
+		Funding:                    source.Funding,
+		FundingModel:               source.FundingModel,
+		CharterURL:                 source.CharterURL,
+		LegalEntityType:            source.LegalEntityType,
+		LegalEntityName:            source.LegalEntityName,
+		LegalParentUID:             source.LegalParentUID,
+		EntityDissolutionDate:      source.EntityDissolutionDate,
+		EntityFormationDocumentURL: source.EntityFormationDocumentURL,
+		AutojoinEnabled:            source.AutojoinEnabled,
+		FormationDate:              source.FormationDate,
+		LogoURL:                    source.LogoURL,
+		RepositoryURL:              source.RepositoryURL,
+		Links:                      source.Links,
+		WebsiteURL:                 source.WebsiteURL,
+		Annotations:                source.Annotations,
+		NameI18n:                   source.NameI18n,
+		DescriptionI18n:            source.DescriptionI18n,
+		Tags:                       source.Tags,
+		MissionStatement:           source.MissionStatement,
+		OverviewHTML:               source.OverviewHTML,
+		Writers:                    source.Writers,
+		MeetingCoordinators:        source.MeetingCoordinators,
+		Auditors:                   source.Auditors,
+		ExecutiveDirector:          source.ExecutiveDirector,
+		ProgramManager:             source.ProgramManager,
+		OpportunityOwner:           source.OpportunityOwner,
+	}
+
+	if payload.Name != nil {
+		create.Name = *payload.Name
+	}
+	if payload.Description != nil {
+		create.Description = *payload.Description
+	}
+	if payload.Public != nil {
+		create.Public = payload.Public
+	}
+	if payload.ParentUID != nil {
+		create.ParentUID = *payload.ParentUID
+	}
+	if payload.Stage != nil {
+		create.Stage = payload.Stage
+	}
+	if payload.Category != nil {
+		create.Category = payload.Category
+	}
+	if payload.MissionStatement != nil {
+		create.MissionStatement = payload.MissionStatement
+	}
+	return create
+}
@@ -0,0 +1,109 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+func TestProjectsService_CloneProject(t *testing.T) {
+	const sourceUID = "7cad5a8d-19d0-41a4-81a6-043453daf9ee"
+	source := &models.ProjectBase{
+		UID:          sourceUID,
+		Slug:         "wg-one",
+		Name:         "Working Group One",
+		Description:  "The first working group",
+		Stage:        "Active",
+		Category:     "Active",
+		FundingModel: []string{"Membership"},
+		WebsiteURL:   "https://wg-one.example.org",
+		LegacyID:     "a0941000002wBz9AAE",
+		Annotations:  map[string]string{"billing": "wg"},
+	}
+	sourceSettings := &models.ProjectSettings{
+		UID:              sourceUID,
+		MissionStatement: "Standardize one thing.",
+	}
+
+	t.Run("copies the source with overrides", func(t *testing.T) {
+		service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+		mockRepo.On("GetProjectBase", mock.Anything, sourceUID).Return(source, nil)
+		mockRepo.On("GetProjectSettings", mock.Anything, sourceUID).Return(sourceSettings, nil)
+		mockRepo.On("ProjectSlugExists", mock.Anything, "wg-two").Return(false, nil)
+		var created *models.ProjectBase
+		var createdSettings *models.ProjectSettings
+		mockRepo.On("CreateProject", mock.Anything, mock.AnythingOfType("*models.ProjectBase"), mock.AnythingOfType("*models.ProjectSettings")).Run(func(args mock.Arguments) {
+			created = args.Get(1).(*models.ProjectBase)
+			createdSettings = args.Get(2).(*models.ProjectSettings)
+		}).Return(nil)
+		mockBuilder.On("SendIndexerMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("types.IndexerMessageEnvelope"), mock.AnythingOfType("bool")).Return(nil).Times(2)
+		mockBuilder.On("SendAccessMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("types.GenericFGAMessage"), mock.AnythingOfType("bool")).Return(nil)
+		mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("events.ProjectLifecycleEvent")).Return(nil)
+
+		result, err := service.CloneProject(context.Background(), &projsvc.CloneProjectPayload{
+			UID:  sourceUID,
+			Slug: "wg-two",
+			Name: misc.StringPtr("Working Group Two"),
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, created)
+		assert.NotEqual(t, sourceUID, created.UID)
+		assert.Equal(t, *result.UID, created.UID)
+		assert.Equal(t, "wg-two", created.Slug)
+		assert.Equal(t, "Working Group Two", created.Name)
+		assert.Equal(t, "The first working group", created.Description)
+		assert.Equal(t, []string{"Membership"}, created.FundingModel)
+		assert.Equal(t, map[string]string{"billing": "wg"}, created.Annotations)
+		assert.Empty(t, created.LegacyID)
+		assert.Equal(t, "Standardize one thing.", createdSettings.MissionStatement)
+		mockRepo.AssertExpectations(t)
+		mockBuilder.AssertExpectations(t)
+	})
+
+	t.Run("source not found", func(t *testing.T) {
+		service, mockRepo, _, _ := setupServiceForTesting()
+		mockRepo.On("GetProjectBase", mock.Anything, sourceUID).Return(nil, domain.ErrProjectNotFound)
+
+		_, err := service.CloneProject(context.Background(), &projsvc.CloneProjectPayload{UID: sourceUID, Slug: "wg-two"})
+
+		assert.ErrorIs(t, err, domain.ErrProjectNotFound)
+		mockRepo.AssertNotCalled(t, "CreateProject", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("writer cannot copy restricted fields", func(t *testing.T) {
+		service, mockRepo, _, _ := setupServiceForTesting()
+		service.Config.EnforceFieldPermissions = true
+		mockRepo.On("GetProjectBase", mock.Anything, sourceUID).Return(source, nil)
+		mockRepo.On("GetProjectSettings", mock.Anything, sourceUID).Return(sourceSettings, nil)
+		ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "alice")
+
+		_, err := service.CloneProject(ctx, &projsvc.CloneProjectPayload{UID: sourceUID, Slug: "wg-two"})
+
+		assert.ErrorIs(t, err, domain.ErrRestrictedField)
+		mockRepo.AssertNotCalled(t, "CreateProject", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("slug taken", func(t *testing.T) {
+		service, mockRepo, _, _ := setupServiceForTesting()
+		mockRepo.On("GetProjectBase", mock.Anything, sourceUID).Return(source, nil)
+		mockRepo.On("GetProjectSettings", mock.Anything, sourceUID).Return(sourceSettings, nil)
+		mockRepo.On("ProjectSlugExists", mock.Anything, "wg-one").Return(true, nil)
+
+		_, err := service.CloneProject(context.Background(), &projsvc.CloneProjectPayload{UID: sourceUID, Slug: "wg-one"})
+
+		assert.ErrorIs(t, err, domain.ErrProjectSlugExists)
+	})
+}
@@ -0,0 +1,162 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/api/messaging"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// respondTo sends request on subject and returns the raw reply.
+func respondTo(t *testing.T, service *ProjectsService, subject, request string) []byte {
+	t.Helper()
+	var reply []byte
+	msg := newMockMessage(subject, []byte(request))
+	msg.On("Respond", mock.Anything).Run(func(args mock.Arguments) {
+		reply, _ = args.Get(0).([]byte)
+	}).Return(nil).Once()
+
+	service.HandleMessage(context.Background(), msg)
+
+	msg.AssertExpectations(t)
+	return reply
+}
+
+// TestLookupContracts checks the requests and replies of the lookup subjects
+// against the schemas published in api/messaging.
+func TestLookupContracts(t *testing.T) {
+	renamedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	project := &models.ProjectBase{
+		UID:           lookupTestUID,
+		Name:          "Test Project",
+		Slug:          "test-project",
+		Public:        true,
+		PreviousNames: []models.PreviousName{{Name: "Old Project", RenamedAt: renamedAt}},
+	}
+
+	tests := []struct {
+		name       string
+		subject    string
+		request    string
+		setupMocks func(*domain.MockProjectRepository)
+	}{
+		{
+			name:    "plain-text get_name",
+			subject: constants.ProjectGetNameSubject,
+			request: lookupTestUID,
+			setupMocks: func(repo *domain.MockProjectRepository) {
+				repo.On("GetProjectBase", mock.Anything, lookupTestUID).Return(project, nil)
+			},
+		},
+		{
+			name:    "get_name with previous names",
+			subject: constants.ProjectGetNameSubject,
+			request: `{"uid":"` + lookupTestUID + `","include_previous_names":true}`,
+			setupMocks: func(repo *domain.MockProjectRepository) {
+				repo.On("GetProjectBase", mock.Anything, lookupTestUID).Return(project, nil)
+			},
+		},
+		{
+			name:    "versioned get_name",
+			subject: constants.ProjectGetNameSubject,
+			request: `{"version":"v1","request_id":"r1","payload":{"uid":"` + lookupTestUID + `","include_previous_names":true}}`,
+			setupMocks: func(repo *domain.MockProjectRepository) {
+				repo.On("GetProjectBase", mock.Anything, lookupTestUID).Return(project, nil)
+			},
+		},
+		{
+			name:    "versioned get_name of a missing project",
+			subject: constants.ProjectGetNameSubject,
+			request: `{"version":"v1","payload":{"uid":"` + lookupTestUID + `"}}`,
+			setupMocks: func(repo *domain.MockProjectRepository) {
+				repo.On("GetProjectBase", mock.Anything, lookupTestUID).Return(nil, domain.ErrProjectNotFound)
+			},
+		},
+		{
+			name:    "plain-text slug_to_uid",
+			subject: constants.ProjectSlugToUIDSubject,
+			request: "test-project",
+			setupMocks: func(repo *domain.MockProjectRepository) {
+				repo.On("GetProjectUIDFromSlug", mock.Anything, "test-project").Return(lookupTestUID, nil)
+			},
+		},
+		{
+			name:    "plain-text slug_to_uid of a missing project",
+			subject: constants.ProjectSlugToUIDSubject,
+			request: "missing-project",
+			setupMocks: func(repo *domain.MockProjectRepository) {
+				repo.On("GetProjectUIDFromSlug", mock.Anything, "missing-project").Return("", domain.ErrProjectNotFound)
+			},
+		},
+		{
+			name:    "versioned slug_to_uid",
+			subject: constants.ProjectSlugToUIDSubject,
+			request: `{"version":"v1","request_id":"r2","payload":{"slug":"test-project"}}`,
+			setupMocks: func(repo *domain.MockProjectRepository) {
+				repo.On("GetProjectUIDFromSlug", mock.Anything, "test-project").Return(lookupTestUID, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contract := lookupContract(t, tt.subject)
+			require.NoError(t, messaging.Validate(contract.Message, []byte(tt.request)), "request")
+
+			service, repo, _, _ := setupServiceForTesting()
+			tt.setupMocks(repo)
+			reply := respondTo(t, service, tt.subject, tt.request)
+
+			assert.NoError(t, messaging.Validate(contract.Reply, reply), "reply %s", reply)
+		})
+	}
+}
+
+// TestAccessMessageContracts checks the update_access messages built for
+// projects against the schema published in api/messaging.
+func TestAccessMessageContracts(t *testing.T) {
+	project := &models.ProjectBase{UID: lookupTestUID, ParentUID: "11111111-2222-3333-4444-555555555555"}
+	previous := &models.ProjectSettings{UID: lookupTestUID, Writers: []models.UserInfo{{Username: "alice"}}}
+	settings := &models.ProjectSettings{
+		UID:     lookupTestUID,
+		Writers: []models.UserInfo{{Username: "alice"}, {Username: "bob"}},
+	}
+	changed, ok := buildFGASettingsAccessMessage(project, previous, settings)
+	require.True(t, ok)
+
+	for name, message := range map[string]any{
+		"full sync":       buildFGAUpdateAccessMessage(project, settings),
+		"base sync":       buildFGABaseAccessMessage(project),
+		"settings change": changed,
+	} {
+		t.Run(name, func(t *testing.T) {
+			data, err := json.Marshal(message)
+			require.NoError(t, err)
+			assert.NoError(t, messaging.Validate("fga.update_access.json", data), "message %s", data)
+		})
+	}
+}
+
+// lookupContract returns the contract of a lookup subject.
+func lookupContract(t *testing.T, subject string) messaging.Contract {
+	t.Helper()
+	for _, contract := range messaging.Contracts {
+		if contract.Subject == subject {
+			return contract
+		}
+	}
+	t.Fatalf("no contract for subject %s", subject)
+	return messaging.Contract{}
+}
@@ -4,6 +4,7 @@
 package service
 
 import (
+	"slices"
 	"strings"
 	"time"
 
@@ -16,7 +17,7 @@ import (
 )
 
 // ConvertToProjectFull merges ProjectBase and ProjectSettings into ProjectFull
-func ConvertToProjectFull(base *models.ProjectBase, settings *models.ProjectSettings) *projsvc.ProjectFull {
+func ConvertToProjectFull(base *models.ProjectBase, settings *models.ProjectSettings, hierarchy *models.ProjectHierarchy) *projsvc.ProjectFull {
 	if base == nil {
 		return nil
 	}
@@ -65,12 +66,16 @@ func ConvertToProjectFull(base *models.ProjectBase, settings *models.ProjectSett
 	if base.LogoURL != "" {
 		full.LogoURL = &base.LogoURL
 	}
+	if base.PNGLogoURL != "" {
+		full.PngLogoURL = &base.PNGLogoURL
+	}
 	if base.WebsiteURL != "" {
 		full.WebsiteURL = &base.WebsiteURL
 	}
 	if base.RepositoryURL != "" {
 		full.RepositoryURL = &base.RepositoryURL
 	}
+	full.Links = convertLinksToAPI(base.Links)
 	if base.EntityFormationDocumentURL != "" {
 		full.EntityFormationDocumentURL = &base.EntityFormationDocumentURL
 	}
@@ -79,6 +84,21 @@ func ConvertToProjectFull(base *models.ProjectBase, settings *models.ProjectSett
 	if len(base.FundingModel) > 0 {
 		full.FundingModel = base.FundingModel
 	}
+	if len(base.Annotations) > 0 {
+		full.Annotations = base.Annotations
+	}
+	if len(base.NameI18n) > 0 {
+		full.NameI18n = base.NameI18n
+	}
+	if len(base.DescriptionI18n) > 0 {
+		full.DescriptionI18n = base.DescriptionI18n
+	}
+	if base.LegacyID != "" {
+		full.LegacyID = &base.LegacyID
+	}
+	if len(base.ProjectTags) > 0 {
+		full.Tags = base.ProjectTags
+	}
 	// Handle base fields that are pointers
 	if base.CreatedAt != nil {
 		full.CreatedAt = misc.StringPtr(base.CreatedAt.Format(time.RFC3339))
@@ -89,16 +109,25 @@ func ConvertToProjectFull(base *models.ProjectBase, settings *models.ProjectSett
 	if base.EntityDissolutionDate != nil {
 		full.EntityDissolutionDate = misc.StringPtr(base.EntityDissolutionDate.Format(time.DateOnly))
 	}
+	full.EntityStatus = misc.StringPtr(base.EntityStatus(time.Now()))
 	if base.FormationDate != nil {
 		full.FormationDate = misc.StringPtr(base.FormationDate.Format(time.DateOnly))
 	}
 
+	// Computed fields
+	full.HasSettings = misc.BoolPtr(settings != nil)
+	full.DisplayStatus = misc.StringPtr(models.DisplayStatus(base, settings, time.Now()))
+	setProjectHierarchyFields(full, hierarchy)
+
 	// Add settings fields if available
 	if settings != nil {
 		// Only set string fields if they're not empty
 		if settings.MissionStatement != "" {
 			full.MissionStatement = &settings.MissionStatement
 		}
+		if settings.OverviewHTML != "" {
+			full.OverviewHTML = &settings.OverviewHTML
+		}
 
 		// Only set array fields if they're not empty
 		if len(settings.Writers) > 0 {
@@ -129,6 +158,60 @@ func ConvertToProjectFull(base *models.ProjectBase, settings *models.ProjectSett
 	return full
 }
 
+// ConvertToProjectFullV2 converts a project base and settings to the version 2
+// representation of a full project, which nests the settings, with their own
+// timestamps, instead of merging them into the project. The version 1
+// representation is left as it is so that its clients keep working as the
+// models change.
+func ConvertToProjectFullV2(base *models.ProjectBase, settings *models.ProjectSettings, hierarchy *models.ProjectHierarchy) *projsvc.ProjectFull {
+	full := ConvertToProjectFull(base, nil, hierarchy)
+	if full != nil && settings != nil {
+		full.Settings = ConvertToServiceProjectSettings(settings)
+		full.HasSettings = misc.BoolPtr(true)
+		full.DisplayStatus = misc.StringPtr(models.DisplayStatus(base, settings, time.Now()))
+	}
+	return full
+}
+
+// projectExpandSettings is the expand value that merges the settings of a
+// project into it.
+const projectExpandSettings = "settings"
+
+// convertToProjectFullBase converts a project base to a full project with only
+// the base fields, for responses that leave out the settings. The fields
+// computed from the settings are left out as well, rather than computed as if
+// the project had none.
+func convertToProjectFullBase(base *models.ProjectBase) *projsvc.ProjectFull {
+	full := ConvertToProjectFull(base, nil, nil)
+	if full != nil {
+		full.HasSettings = nil
+		full.DisplayStatus = nil
+	}
+	return full
+}
+
+// setProjectHierarchyFields sets the fields of full computed from its place in
+// hierarchy, which is left out when nil. The project itself need not be in
+// hierarchy.
+func setProjectHierarchyFields(full *projsvc.ProjectFull, hierarchy *models.ProjectHierarchy) {
+	if hierarchy == nil {
+		return
+	}
+	var uid, slug, parentUID string
+	if full.UID != nil {
+		uid = *full.UID
+	}
+	if full.Slug != nil {
+		slug = *full.Slug
+	}
+	if full.ParentUID != nil {
+		parentUID = *full.ParentUID
+	}
+	full.SlugPath = misc.StringPtr(hierarchy.SlugPath(slug, parentUID))
+	full.Depth = misc.IntPtr(hierarchy.Depth(parentUID))
+	full.ChildCount = misc.IntPtr(hierarchy.ChildCount(uid))
+}
+
 // ConvertToDBProjectBase converts a project service project to a project database representation.
 func ConvertToDBProjectBase(project *projsvc.ProjectBase) (*models.ProjectBase, error) {
 	if project == nil {
@@ -206,12 +289,31 @@ func ConvertToDBProjectBase(project *projsvc.ProjectBase) (*models.ProjectBase,
 	if project.LogoURL != nil {
 		p.LogoURL = *project.LogoURL
 	}
+	if project.PngLogoURL != nil {
+		p.PNGLogoURL = *project.PngLogoURL
+	}
 	if project.WebsiteURL != nil {
 		p.WebsiteURL = *project.WebsiteURL
 	}
 	if project.RepositoryURL != nil {
 		p.RepositoryURL = *project.RepositoryURL
 	}
+	p.Links = convertLinksFromAPI(project.Links)
+	if project.Annotations != nil {
+		p.Annotations = project.Annotations
+	}
+	if project.NameI18n != nil {
+		p.NameI18n = project.NameI18n
+	}
+	if project.DescriptionI18n != nil {
+		p.DescriptionI18n = project.DescriptionI18n
+	}
+	if project.LegacyID != nil {
+		p.LegacyID = *project.LegacyID
+	}
+	if project.Tags != nil {
+		p.ProjectTags = normalizeProjectTags(project.Tags)
+	}
 	if project.CreatedAt != nil {
 		createdAt, err := time.Parse(time.RFC3339, *project.CreatedAt)
 		if err != nil {
@@ -281,22 +383,42 @@ func ConvertToServiceProjectBase(p *models.ProjectBase) *projsvc.ProjectBase {
 	if p.LogoURL != "" {
 		project.LogoURL = &p.LogoURL
 	}
+	if p.PNGLogoURL != "" {
+		project.PngLogoURL = &p.PNGLogoURL
+	}
 	if p.WebsiteURL != "" {
 		project.WebsiteURL = &p.WebsiteURL
 	}
 	if p.RepositoryURL != "" {
 		project.RepositoryURL = &p.RepositoryURL
 	}
+	project.Links = convertLinksToAPI(p.Links)
 
 	// Only set array fields if they're not empty
 	if len(p.FundingModel) > 0 {
 		project.FundingModel = p.FundingModel
 	}
+	if len(p.Annotations) > 0 {
+		project.Annotations = p.Annotations
+	}
+	if len(p.NameI18n) > 0 {
+		project.NameI18n = p.NameI18n
+	}
+	if len(p.DescriptionI18n) > 0 {
+		project.DescriptionI18n = p.DescriptionI18n
+	}
+	if p.LegacyID != "" {
+		project.LegacyID = &p.LegacyID
+	}
+	if len(p.ProjectTags) > 0 {
+		project.Tags = p.ProjectTags
+	}
 
 	// Handle date fields that are pointers
 	if p.EntityDissolutionDate != nil {
 		project.EntityDissolutionDate = misc.StringPtr(p.EntityDissolutionDate.Format(time.DateOnly))
 	}
+	project.EntityStatus = misc.StringPtr(p.EntityStatus(time.Now()))
 	if p.FormationDate != nil {
 		project.FormationDate = misc.StringPtr(p.FormationDate.Format(time.DateOnly))
 	}
@@ -334,6 +456,9 @@ func ConvertToDBProjectSettings(settings *projsvc.ProjectSettings, existing *mod
 	if settings.MissionStatement != nil {
 		s.MissionStatement = *settings.MissionStatement
 	}
+	if settings.OverviewHTML != nil {
+		s.OverviewHTML, s.OverviewText = sanitizeOverviewHTML(*settings.OverviewHTML)
+	}
 	if settings.AnnouncementDate != nil {
 		announcementDate, err := time.Parse(time.DateOnly, *settings.AnnouncementDate)
 		if err != nil {
@@ -391,6 +516,9 @@ func ConvertToServiceProjectSettings(s *models.ProjectSettings) *projsvc.Project
 	if s.MissionStatement != "" {
 		settings.MissionStatement = &s.MissionStatement
 	}
+	if s.OverviewHTML != "" {
+		settings.OverviewHTML = &s.OverviewHTML
+	}
 
 	// Only set array fields if they're not empty
 	if len(s.Writers) > 0 {
@@ -426,6 +554,165 @@ func ConvertToServiceProjectSettings(s *models.ProjectSettings) *projsvc.Project
 	return settings
 }
 
+// ConvertToServiceSettingsDiff converts a project settings diff to its service representation.
+func ConvertToServiceSettingsDiff(d *models.ProjectSettingsDiff) *projsvc.ProjectSettingsDiff {
+	diff := &projsvc.ProjectSettingsDiff{
+		ProjectUID:   d.ProjectUID,
+		FromRevision: d.FromRevision,
+		ToRevision:   d.ToRevision,
+		Changes:      make([]*projsvc.ProjectSettingsChange, len(d.Changes)),
+	}
+	for i, c := range d.Changes {
+		change := &projsvc.ProjectSettingsChange{
+			Field:   c.Field,
+			Added:   convertUsersToAPI(c.Added),
+			Removed: convertUsersToAPI(c.Removed),
+		}
+		if c.From != "" {
+			change.From = misc.StringPtr(c.From)
+		}
+		if c.To != "" {
+			change.To = misc.StringPtr(c.To)
+		}
+		diff.Changes[i] = change
+	}
+	return diff
+}
+
+// ConvertToServiceVisibilityImpact converts a visibility impact report to its API type.
+func ConvertToServiceVisibilityImpact(v *models.ProjectVisibilityImpact) *projsvc.ProjectVisibilityImpact {
+	impact := &projsvc.ProjectVisibilityImpact{
+		ProjectUID:            v.ProjectUID,
+		Public:                v.Public,
+		TargetPublic:          v.TargetPublic,
+		Descendants:           make([]*projsvc.VisibilityImpactProject, len(v.Descendants)),
+		MismatchedDescendants: v.MismatchedDescendants,
+		ExternalReferences:    make([]*projsvc.ExternalReference, len(v.ExternalReferences)),
+	}
+	for i, d := range v.Descendants {
+		impact.Descendants[i] = &projsvc.VisibilityImpactProject{
+			UID:       d.UID,
+			Slug:      d.Slug,
+			Name:      d.Name,
+			ParentUID: d.ParentUID,
+			Public:    d.Public,
+		}
+	}
+	for i, r := range v.ExternalReferences {
+		impact.ExternalReferences[i] = &projsvc.ExternalReference{Field: r.Field, URL: r.URL}
+	}
+	if v.Subscribers != nil {
+		impact.Subscribers = &projsvc.VisibilitySubscribers{
+			Committees:    v.Subscribers.Committees,
+			MailingLists:  v.Subscribers.MailingLists,
+			MeetingSeries: v.Subscribers.MeetingSeries,
+			Webhooks:      v.Subscribers.Webhooks,
+		}
+	}
+	return impact
+}
+
+// ConvertToServiceUserDataExport converts a user data export to its API type.
+func ConvertToServiceUserDataExport(e *models.UserDataExport) *projsvc.UserDataExport {
+	export := &projsvc.UserDataExport{
+		Username:    e.Username,
+		GeneratedAt: e.GeneratedAt.UTC().Format(time.RFC3339),
+		Memberships: make([]*projsvc.UserMembership, len(e.Memberships)),
+	}
+	for i, m := range e.Memberships {
+		membership := &projsvc.UserMembership{
+			ProjectUID: m.ProjectUID,
+			Slug:       m.Slug,
+			Name:       m.Name,
+			Role:       m.Role,
+			User:       convertUserToAPI(&m.User),
+		}
+		if m.AddedAt != nil {
+			membership.AddedAt = misc.StringPtr(m.AddedAt.UTC().Format(time.RFC3339))
+		}
+		export.Memberships[i] = membership
+	}
+	return export
+}
+
+// ConvertToServiceLegalLineage converts a legal lineage to its API type.
+func ConvertToServiceLegalLineage(l *models.ProjectLegalLineage) *projsvc.ProjectLegalLineage {
+	lineage := &projsvc.ProjectLegalLineage{
+		ProjectUID:   l.ProjectUID,
+		LegalParents: make([]*projsvc.LegalLineageProject, len(l.LegalParents)),
+		Complete:     l.Complete,
+	}
+	for i, p := range l.LegalParents {
+		lineage.LegalParents[i] = &projsvc.LegalLineageProject{
+			UID:  p.UID,
+			Slug: p.Slug,
+			Name: p.Name,
+		}
+		if p.LegalEntityType != "" {
+			lineage.LegalParents[i].LegalEntityType = &p.LegalEntityType
+		}
+		if p.LegalEntityName != "" {
+			lineage.LegalParents[i].LegalEntityName = &p.LegalEntityName
+		}
+	}
+	return lineage
+}
+
+// normalizeProjectTags drops duplicate tags, keeping the first occurrence of each.
+func normalizeProjectTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized
+}
+
+// convertLinksFromAPI converts the links of a project to their database
+// representation, nil when none is set.
+func convertLinksFromAPI(links *projsvc.ProjectLinks) *models.ProjectLinks {
+	if links == nil {
+		return nil
+	}
+	l := &models.ProjectLinks{
+		Twitter:      stringValue(links.Twitter),
+		LinkedIn:     stringValue(links.Linkedin),
+		YouTube:      stringValue(links.Youtube),
+		Slack:        stringValue(links.Slack),
+		Discord:      stringValue(links.Discord),
+		MailingList:  stringValue(links.MailingList),
+		ContactEmail: stringValue(links.ContactEmail),
+	}
+	if *l == (models.ProjectLinks{}) {
+		return nil
+	}
+	return l
+}
+
+// convertLinksToAPI converts the links of a project to their service
+// representation, leaving out the links that are not set.
+func convertLinksToAPI(links *models.ProjectLinks) *projsvc.ProjectLinks {
+	if links == nil {
+		return nil
+	}
+	optional := func(value string) *string {
+		if value == "" {
+			return nil
+		}
+		return &value
+	}
+	return &projsvc.ProjectLinks{
+		Twitter:      optional(links.Twitter),
+		Linkedin:     optional(links.LinkedIn),
+		Youtube:      optional(links.YouTube),
+		Slack:        optional(links.Slack),
+		Discord:      optional(links.Discord),
+		MailingList:  optional(links.MailingList),
+		ContactEmail: optional(links.ContactEmail),
+	}
+}
+
 // convertUsersToAPI converts domain UserInfo slice to API UserInfo slice
 func convertUsersToAPI(users []models.UserInfo) []*projsvc.UserInfo {
 	if len(users) == 0 {
@@ -556,10 +843,59 @@ func extractUsernames(users []models.UserInfo) []string {
 	return usernames
 }
 
+// projectSettingsRelations are the FGA relations of a project that are set
+// from its settings rather than its base.
+var projectSettingsRelations = []string{
+	fgaconstants.RelationWriter,
+	fgaconstants.RelationAuditor,
+	fgaconstants.RelationMeetingCoordinator,
+	"executive_director",
+}
+
 // buildFGAUpdateAccessMessage builds a GenericFGAMessage for update_access operations.
 // It constructs the relations map from project settings and references map from project base.
+// This is a full sync of the project's access.
 func buildFGAUpdateAccessMessage(projectDB *models.ProjectBase, projectSettingsDB *models.ProjectSettings) fgatypes.GenericFGAMessage {
-	// Build relations map for FGA sync
+	return newFGAUpdateAccessMessage(projectDB, fgaSettingsRelations(projectSettingsDB), nil)
+}
+
+// buildFGABaseAccessMessage builds an update_access message that syncs only
+// what the project base sets: the public flag and the parent reference. The
+// relations set from the settings are excluded, so FGA sync leaves them as
+// they are.
+func buildFGABaseAccessMessage(projectDB *models.ProjectBase) fgatypes.GenericFGAMessage {
+	return newFGAUpdateAccessMessage(projectDB, map[string][]string{}, projectSettingsRelations)
+}
+
+// buildFGASettingsAccessMessage builds an update_access message that syncs
+// only the relations that changed from the previous settings, such as the
+// writers when only the auditors are unchanged; the others are excluded. It
+// returns false when no relation changed and there is nothing to sync.
+func buildFGASettingsAccessMessage(projectDB *models.ProjectBase, previous, settings *models.ProjectSettings) (fgatypes.GenericFGAMessage, bool) {
+	if previous == nil {
+		return buildFGAUpdateAccessMessage(projectDB, settings), true
+	}
+	before, current := fgaSettingsRelations(previous), fgaSettingsRelations(settings)
+
+	relations := make(map[string][]string)
+	var excluded []string
+	for _, relation := range projectSettingsRelations {
+		if sameUsernames(before[relation], current[relation]) {
+			excluded = append(excluded, relation)
+			continue
+		}
+		if usernames := current[relation]; len(usernames) > 0 {
+			relations[relation] = usernames
+		}
+	}
+	if len(excluded) == len(projectSettingsRelations) {
+		return fgatypes.GenericFGAMessage{}, false
+	}
+	return newFGAUpdateAccessMessage(projectDB, relations, excluded), true
+}
+
+// fgaSettingsRelations returns the FGA relations set from the project settings.
+func fgaSettingsRelations(projectSettingsDB *models.ProjectSettings) map[string][]string {
 	relations := make(map[string][]string)
 	if writers := extractUsernames(projectSettingsDB.Writers); len(writers) > 0 {
 		relations[fgaconstants.RelationWriter] = writers
@@ -573,7 +909,24 @@ func buildFGAUpdateAccessMessage(projectDB *models.ProjectBase, projectSettingsD
 	if ed := extractUsername(projectSettingsDB.ExecutiveDirector); ed != "" {
 		relations["executive_director"] = []string{ed}
 	}
+	return relations
+}
+
+// sameUsernames reports whether a and b hold the same usernames, in any order.
+func sameUsernames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
 
+// newFGAUpdateAccessMessage builds an update_access message with the project's
+// public flag and parent reference, the given relations, and the relations FGA
+// sync must leave as they are.
+func newFGAUpdateAccessMessage(projectDB *models.ProjectBase, relations map[string][]string, excludeRelations []string) fgatypes.GenericFGAMessage {
 	// Build references map for parent relationship
 	references := make(map[string][]string)
 	if projectDB.ParentUID != "" {
@@ -584,10 +937,11 @@ func buildFGAUpdateAccessMessage(projectDB *models.ProjectBase, projectSettingsD
 		ObjectType: "project",
 		Operation:  "update_access",
 		Data: fgatypes.GenericAccessData{
-			UID:        projectDB.UID,
-			Public:     projectDB.Public,
-			Relations:  relations,
-			References: references,
+			UID:              projectDB.UID,
+			Public:           projectDB.Public,
+			Relations:        relations,
+			References:       references,
+			ExcludeRelations: excludeRelations,
 		},
 	}
 }
@@ -601,6 +955,7 @@ func DomainSettingsToEvent(s *models.ProjectSettings) events.ProjectSettings {
 	return events.ProjectSettings{
 		UID:                 s.UID,
 		MissionStatement:    s.MissionStatement,
+		OverviewHTML:        s.OverviewHTML,
 		AnnouncementDate:    s.AnnouncementDate,
 		Auditors:            domainUsersToEvent(s.Auditors),
 		Writers:             domainUsersToEvent(s.Writers),
@@ -649,6 +1004,26 @@ func domainUserPtrToEvent(u *models.UserInfo) *events.UserInfo {
 	return &ev
 }
 
+func domainPreviousNamesToEvent(names []models.PreviousName) []events.PreviousName {
+	result := make([]events.PreviousName, len(names))
+	for i, n := range names {
+		result[i] = events.PreviousName{Name: n.Name, RenamedAt: n.RenamedAt}
+	}
+	return result
+}
+
+func domainUserProjectsToEvent(projects []models.UserProject) []events.UserProject {
+	result := make([]events.UserProject, len(projects))
+	for i, p := range projects {
+		roles := make([]string, len(p.Roles))
+		for j, role := range p.Roles {
+			roles[j] = string(role)
+		}
+		result[i] = events.UserProject{ProjectUID: p.ProjectUID, Slug: p.Slug, Name: p.Name, Roles: roles}
+	}
+	return result
+}
+
 // createTestUserInfo creates a UserInfo for testing purposes
 func createTestUserInfo(username, name, email, avatar string) models.UserInfo {
 	return models.UserInfo{
@@ -700,3 +1075,69 @@ func DomainLinkToEvent(link *models.ProjectLink) events.ProjectLinkCreatedMessag
 		CreatedBy:  link.CreatedByUsername,
 	}
 }
+
+// DomainProjectToEvent converts an internal ProjectBase domain model to its
+// event wire type for publishing on NATS.
+func DomainProjectToEvent(p *models.ProjectBase) *events.Project {
+	if p == nil {
+		return nil
+	}
+	return &events.Project{
+		UID:                        p.UID,
+		Slug:                       p.Slug,
+		Name:                       p.Name,
+		Description:                p.Description,
+		Public:                     p.Public,
+		IsFoundation:               p.IsFoundation,
+		ParentUID:                  p.ParentUID,
+		Stage:                      p.Stage,
+		Category:                   p.Category,
+		LegalEntityType:            p.LegalEntityType,
+		LegalEntityName:            p.LegalEntityName,
+		LegalParentUID:             p.LegalParentUID,
+		Funding:                    p.Funding,
+		FundingModel:               p.FundingModel,
+		EntityDissolutionDate:      p.EntityDissolutionDate,
+		EntityFormationDocumentURL: p.EntityFormationDocumentURL,
+		FormationDate:              p.FormationDate,
+		AutojoinEnabled:            p.AutojoinEnabled,
+		CharterURL:                 p.CharterURL,
+		LogoURL:                    p.LogoURL,
+		PNGLogoURL:                 p.PNGLogoURL,
+		WebsiteURL:                 p.WebsiteURL,
+		RepositoryURL:              p.RepositoryURL,
+		Links:                      domainLinksToEvent(p.Links),
+		Annotations:                p.Annotations,
+		NameI18n:                   p.NameI18n,
+		DescriptionI18n:            p.DescriptionI18n,
+		LegacyID:                   p.LegacyID,
+		Tags:                       p.ProjectTags,
+		CreatedAt:                  p.CreatedAt,
+		UpdatedAt:                  p.UpdatedAt,
+	}
+}
+
+// domainLinksToEvent converts the links of a project to their event wire type.
+func domainLinksToEvent(links *models.ProjectLinks) *events.ProjectLinks {
+	if links == nil {
+		return nil
+	}
+	return &events.ProjectLinks{
+		Twitter:      links.Twitter,
+		LinkedIn:     links.LinkedIn,
+		YouTube:      links.YouTube,
+		Slack:        links.Slack,
+		Discord:      links.Discord,
+		MailingList:  links.MailingList,
+		ContactEmail: links.ContactEmail,
+	}
+}
+
+// domainSettingsPtrToEvent converts optional project settings to their event wire type.
+func domainSettingsPtrToEvent(s *models.ProjectSettings) *events.ProjectSettings {
+	if s == nil {
+		return nil
+	}
+	ev := DomainSettingsToEvent(s)
+	return &ev
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	fgatypes "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/types"
 	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
 	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
@@ -31,6 +32,8 @@ func TestConvertToDBProjectBase(t *testing.T) {
 				Description: misc.StringPtr("Test Description"),
 				Public:      misc.BoolPtr(true),
 				ParentUID:   misc.StringPtr("parent-uid"),
+				Annotations: map[string]string{"salesforce.v1/id": "a0941000002wBz9AAE"},
+				Tags:        []string{"cncf", "security-critical", "cncf"},
 			},
 			expected: &models.ProjectBase{
 				UID:         "test-uid",
@@ -39,6 +42,8 @@ func TestConvertToDBProjectBase(t *testing.T) {
 				Description: "Test Description",
 				Public:      true,
 				ParentUID:   "parent-uid",
+				Annotations: map[string]string{"salesforce.v1/id": "a0941000002wBz9AAE"},
+				ProjectTags: []string{"cncf", "security-critical"},
 			},
 			wantErr: false,
 		},
@@ -85,6 +90,8 @@ func TestConvertToDBProjectBase(t *testing.T) {
 				assert.Equal(t, tt.expected.Description, result.Description)
 				assert.Equal(t, tt.expected.Public, result.Public)
 				assert.Equal(t, tt.expected.ParentUID, result.ParentUID)
+				assert.Equal(t, tt.expected.Annotations, result.Annotations)
+				assert.Equal(t, tt.expected.ProjectTags, result.ProjectTags)
 			}
 		})
 	}
@@ -326,7 +333,7 @@ func TestConvertToProjectFull(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := ConvertToProjectFull(tt.base, tt.settings)
+			result := ConvertToProjectFull(tt.base, tt.settings, nil)
 			if tt.expected == nil {
 				assert.Nil(t, result)
 			} else {
@@ -346,6 +353,37 @@ func TestConvertToProjectFull(t *testing.T) {
 	}
 }
 
+func TestConvertToProjectFullV2(t *testing.T) {
+	updatedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	base := &models.ProjectBase{UID: "test-uid", Slug: "test-slug", Name: "Test Project"}
+	settings := &models.ProjectSettings{
+		UID:              "test-uid",
+		MissionStatement: "Test Mission",
+		Writers: []models.UserInfo{
+			createTestUserInfo("writer1", "Writer One", "writer1@example.com", ""),
+		},
+		UpdatedAt: &updatedAt,
+	}
+
+	result := ConvertToProjectFullV2(base, settings, nil)
+
+	require.NotNil(t, result)
+	assert.Equal(t, "test-slug", *result.Slug)
+	assert.Equal(t, "Test Project", *result.Name)
+	// The settings are nested, not merged into the project.
+	assert.Nil(t, result.MissionStatement)
+	assert.Nil(t, result.Writers)
+	require.NotNil(t, result.Settings)
+	assert.Equal(t, "Test Mission", *result.Settings.MissionStatement)
+	assert.Equal(t, []*projsvc.UserInfo{
+		createTestAPIUserInfo("writer1", "Writer One", "writer1@example.com", ""),
+	}, result.Settings.Writers)
+	assert.Equal(t, "2024-05-01T12:00:00Z", *result.Settings.UpdatedAt)
+
+	assert.Nil(t, ConvertToProjectFullV2(base, nil, nil).Settings)
+	assert.Nil(t, ConvertToProjectFullV2(nil, settings, nil))
+}
+
 func TestConvertToServiceProjectBase(t *testing.T) {
 	now := time.Now()
 	formationDate := time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC)
@@ -368,6 +406,7 @@ func TestConvertToServiceProjectBase(t *testing.T) {
 				Category:        "infrastructure",
 				FormationDate:   &formationDate,
 				AutojoinEnabled: true,
+				Annotations:     map[string]string{"salesforce.v1/id": "a0941000002wBz9AAE"},
 				CreatedAt:       &now,
 				UpdatedAt:       &now,
 			},
@@ -381,6 +420,7 @@ func TestConvertToServiceProjectBase(t *testing.T) {
 				Stage:           misc.StringPtr("sandbox"),
 				Category:        misc.StringPtr("infrastructure"),
 				AutojoinEnabled: misc.BoolPtr(true),
+				Annotations:     map[string]string{"salesforce.v1/id": "a0941000002wBz9AAE"},
 			},
 		},
 		{
@@ -406,6 +446,7 @@ func TestConvertToServiceProjectBase(t *testing.T) {
 			assert.Equal(t, tt.expected.Slug, result.Slug)
 			assert.Equal(t, tt.expected.Public, result.Public)
 			assert.Equal(t, tt.expected.AutojoinEnabled, result.AutojoinEnabled)
+			assert.Equal(t, tt.expected.Annotations, result.Annotations)
 			if tt.expected.Name != nil {
 				assert.Equal(t, tt.expected.Name, result.Name)
 			}
@@ -416,6 +457,37 @@ func TestConvertToServiceProjectBase(t *testing.T) {
 	}
 }
 
+func TestConvertProjectLinks(t *testing.T) {
+	project, err := ConvertToDBProjectBase(&projsvc.ProjectBase{
+		UID: misc.StringPtr("test-uid"),
+		Links: &projsvc.ProjectLinks{
+			Twitter:      misc.StringPtr("https://x.com/example"),
+			Discord:      misc.StringPtr("https://discord.gg/example"),
+			ContactEmail: misc.StringPtr("info@example.org"),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &models.ProjectLinks{
+		Twitter:      "https://x.com/example",
+		Discord:      "https://discord.gg/example",
+		ContactEmail: "info@example.org",
+	}, project.Links)
+
+	links := ConvertToServiceProjectBase(project).Links
+	require.NotNil(t, links)
+	assert.Equal(t, misc.StringPtr("https://x.com/example"), links.Twitter)
+	assert.Equal(t, misc.StringPtr("info@example.org"), links.ContactEmail)
+	assert.Nil(t, links.Linkedin)
+
+	assert.Equal(t, "https://discord.gg/example", DomainProjectToEvent(project).Links.Discord)
+
+	// Links without any value set are not stored.
+	project, err = ConvertToDBProjectBase(&projsvc.ProjectBase{Links: &projsvc.ProjectLinks{Slack: misc.StringPtr("")}})
+	require.NoError(t, err)
+	assert.Nil(t, project.Links)
+	assert.Nil(t, ConvertToServiceProjectBase(project).Links)
+}
+
 func TestConvertToServiceProjectSettings(t *testing.T) {
 	now := time.Now()
 	announcementDate := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
@@ -530,6 +602,112 @@ func TestConvertToServiceProjectSettings(t *testing.T) {
 	}
 }
 
+func TestBuildFGASettingsAccessMessage(t *testing.T) {
+	project := &models.ProjectBase{UID: "uid-1", Public: true, ParentUID: "parent-1"}
+	previous := &models.ProjectSettings{
+		UID:                 "uid-1",
+		Writers:             []models.UserInfo{{Username: "alice"}, {Username: "bob"}},
+		Auditors:            []models.UserInfo{{Username: "carol"}},
+		MeetingCoordinators: []models.UserInfo{{Username: "dave"}},
+	}
+
+	tests := []struct {
+		name      string
+		previous  *models.ProjectSettings
+		settings  *models.ProjectSettings
+		expected  *fgatypes.GenericAccessData
+		unchanged bool
+	}{
+		{
+			name:     "without previous settings every relation is synced",
+			settings: previous,
+			expected: &fgatypes.GenericAccessData{
+				UID:    "uid-1",
+				Public: true,
+				Relations: map[string][]string{
+					"writer":              {"alice", "bob"},
+					"auditor":             {"carol"},
+					"meeting_coordinator": {"dave"},
+				},
+				References: map[string][]string{"parent": {"project:parent-1"}},
+			},
+		},
+		{
+			name:     "only changed relations are synced",
+			previous: previous,
+			settings: &models.ProjectSettings{
+				UID:                 "uid-1",
+				Writers:             []models.UserInfo{{Username: "bob"}, {Username: "alice"}},
+				Auditors:            []models.UserInfo{{Username: "carol"}},
+				MeetingCoordinators: []models.UserInfo{{Username: "dave"}, {Username: "erin"}},
+				ExecutiveDirector:   &models.UserInfo{Username: "frank"},
+			},
+			expected: &fgatypes.GenericAccessData{
+				UID:    "uid-1",
+				Public: true,
+				Relations: map[string][]string{
+					"meeting_coordinator": {"dave", "erin"},
+					"executive_director":  {"frank"},
+				},
+				References:       map[string][]string{"parent": {"project:parent-1"}},
+				ExcludeRelations: []string{"writer", "auditor"},
+			},
+		},
+		{
+			name:     "emptied relation is synced without users",
+			previous: previous,
+			settings: &models.ProjectSettings{
+				UID:                 "uid-1",
+				Writers:             previous.Writers,
+				MeetingCoordinators: previous.MeetingCoordinators,
+			},
+			expected: &fgatypes.GenericAccessData{
+				UID:              "uid-1",
+				Public:           true,
+				Relations:        map[string][]string{},
+				References:       map[string][]string{"parent": {"project:parent-1"}},
+				ExcludeRelations: []string{"writer", "meeting_coordinator", "executive_director"},
+			},
+		},
+		{
+			name:     "settings without relation changes are not synced",
+			previous: previous,
+			settings: &models.ProjectSettings{
+				UID:                 "uid-1",
+				MissionStatement:    "new mission",
+				Writers:             append(previous.Writers, models.UserInfo{Name: "No Username"}),
+				Auditors:            previous.Auditors,
+				MeetingCoordinators: previous.MeetingCoordinators,
+			},
+			unchanged: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message, changed := buildFGASettingsAccessMessage(project, tt.previous, tt.settings)
+			if tt.unchanged {
+				assert.False(t, changed)
+				return
+			}
+			require.True(t, changed)
+			assert.Equal(t, "update_access", message.Operation)
+			assert.Equal(t, *tt.expected, message.Data)
+		})
+	}
+}
+
+func TestBuildFGABaseAccessMessage(t *testing.T) {
+	message := buildFGABaseAccessMessage(&models.ProjectBase{UID: "uid-1", ParentUID: "parent-1"})
+
+	assert.Equal(t, fgatypes.GenericAccessData{
+		UID:              "uid-1",
+		Relations:        map[string][]string{},
+		References:       map[string][]string{"parent": {"project:parent-1"}},
+		ExcludeRelations: []string{"writer", "auditor", "meeting_coordinator", "executive_director"},
+	}, message.Data)
+}
+
 func TestDomainSettingsToEvent(t *testing.T) {
 	now := time.Now()
 	tests := []struct {
@@ -620,6 +798,60 @@ func TestDomainSettingsToEvent(t *testing.T) {
 	}
 }
 
+func TestDomainProjectToEvent(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		input    *models.ProjectBase
+		expected *events.Project
+	}{
+		{
+			name:     "nil input returns nil",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name: "project base mapped correctly",
+			input: &models.ProjectBase{
+				UID:             "uid-1",
+				Slug:            "test-project",
+				Name:            "Test Project",
+				Description:     "A test project",
+				Public:          true,
+				ParentUID:       "parent-uid",
+				Stage:           "Active",
+				FundingModel:    []string{"Crowdfunding"},
+				FormationDate:   &now,
+				AutojoinEnabled: true,
+				LogoURL:         "https://example.com/logo.png",
+				CreatedAt:       &now,
+				UpdatedAt:       &now,
+			},
+			expected: &events.Project{
+				UID:             "uid-1",
+				Slug:            "test-project",
+				Name:            "Test Project",
+				Description:     "A test project",
+				Public:          true,
+				ParentUID:       "parent-uid",
+				Stage:           "Active",
+				FundingModel:    []string{"Crowdfunding"},
+				FormationDate:   &now,
+				AutojoinEnabled: true,
+				LogoURL:         "https://example.com/logo.png",
+				CreatedAt:       &now,
+				UpdatedAt:       &now,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DomainProjectToEvent(tt.input))
+		})
+	}
+}
+
 func TestDomainDocumentToEvent(t *testing.T) {
 	folderUID := "folder-1"
 	tests := []struct {
@@ -0,0 +1,156 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// exportRoles lists the roles of a data export in settings order.
+var exportRoles = []string{
+	string(models.ProjectMemberRoleWriter),
+	string(models.ProjectMemberRoleAuditor),
+	string(models.ProjectMemberRoleMeetingCoordinator),
+	models.ProjectContactRoleExecutiveDirector,
+	models.ProjectContactRoleProgramManager,
+	models.ProjectContactRoleOpportunityOwner,
+}
+
+// ExportUserData returns everything stored about the user with username, for
+// data-subject access requests: each member and contact role the user holds
+// in a project, with the name, email and avatar stored for it and, when the
+// project history is available, when the user was given the role. Users may
+// only export their own data, unless they are LF staff or a trusted service.
+func (s *ProjectsService) ExportUserData(ctx context.Context, username string) (*models.UserDataExport, error) {
+	ctx, span := startSpan(ctx, "ProjectsService.ExportUserData")
+	defer span.End()
+
+	export, err := s.exportUserData(ctx, strings.TrimSpace(username))
+	recordSpanError(span, err)
+	return export, err
+}
+
+func (s *ProjectsService) exportUserData(ctx context.Context, username string) (*models.UserDataExport, error) {
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+	if username == "" {
+		return nil, domain.NewFieldValidationError("username", domain.FieldErrorRequired, domain.ErrValidationFailed)
+	}
+	if err := s.authorizeUser(ctx, username); err != nil {
+		return nil, err
+	}
+
+	projectsBase, projectsSettings, err := s.ProjectRepository.ListAllProjects(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "error listing projects", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+	projects := make(map[string]*models.ProjectBase, len(projectsBase))
+	for _, project := range projectsBase {
+		projects[project.UID] = project
+	}
+
+	export := &models.UserDataExport{
+		Username:    username,
+		GeneratedAt: time.Now().UTC(),
+		Memberships: []models.UserMembership{},
+	}
+	for _, settings := range projectsSettings {
+		project, ok := projects[settings.UID]
+		if !ok {
+			continue
+		}
+		roles := userRoles(settings, username)
+		if len(roles) == 0 {
+			continue
+		}
+		addedAt := s.userRolesAddedAt(ctx, settings.UID, username)
+		for _, role := range exportRoles {
+			user, ok := roles[role]
+			if !ok {
+				continue
+			}
+			export.Memberships = append(export.Memberships, models.UserMembership{
+				ProjectUID: project.UID,
+				Slug:       project.Slug,
+				Name:       project.Name,
+				Role:       role,
+				User:       user,
+				AddedAt:    addedAt[role],
+			})
+		}
+	}
+	slices.SortStableFunc(export.Memberships, func(a, b models.UserMembership) int {
+		return strings.Compare(a.Slug, b.Slug)
+	})
+
+	slog.InfoContext(ctx, "exported user data", "memberships", len(export.Memberships))
+	return export, nil
+}
+
+// userRoles returns the user information stored for each role the user with
+// username holds in the settings.
+func userRoles(settings *models.ProjectSettings, username string) map[string]models.UserInfo {
+	roles := map[string]models.UserInfo{}
+	for _, role := range models.ProjectMemberRoles {
+		index := slices.IndexFunc(*settings.Members(role), func(u models.UserInfo) bool { return u.Username == username })
+		if index >= 0 {
+			roles[string(role)] = (*settings.Members(role))[index]
+		}
+	}
+	for role, user := range settings.Contacts() {
+		if user.Username == username {
+			roles[role] = *user
+		}
+	}
+	return roles
+}
+
+// userRolesAddedAt returns when the user with username was given each role
+// they hold in the project, from the settings revisions retained in the
+// project history. A role held since the oldest retained revision is dated
+// by that revision. Nothing is returned when the history is not available.
+func (s *ProjectsService) userRolesAddedAt(ctx context.Context, projectUID, username string) map[string]*time.Time {
+	addedAt := map[string]*time.Time{}
+	if s.ProjectHistoryRepository == nil {
+		return addedAt
+	}
+	revisions, err := s.ProjectHistoryRepository.GetProjectHistory(ctx, projectUID)
+	if err != nil {
+		slog.WarnContext(ctx, "project history not available, leaving out when roles were given",
+			constants.ErrKey, err, "project_uid", projectUID)
+		return addedAt
+	}
+
+	for _, revision := range revisions {
+		if revision.Kind != models.ProjectRevisionSettings {
+			continue
+		}
+		var roles map[string]models.UserInfo
+		if !revision.Deleted && revision.Settings != nil {
+			roles = userRoles(revision.Settings, username)
+		}
+		for _, role := range exportRoles {
+			_, held := roles[role]
+			switch {
+			case !held:
+				delete(addedAt, role)
+			case addedAt[role] == nil:
+				created := revision.Created.UTC()
+				addedAt[role] = &created
+			}
+		}
+	}
+	return addedAt
+}
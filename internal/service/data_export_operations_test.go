@@ -0,0 +1,113 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+func TestProjectsService_ExportUserData(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	history := &domain.MockProjectHistoryRepository{}
+	service.ProjectHistoryRepository = history
+	expectUserProjects(mockRepo)
+
+	alice := models.UserInfo{Username: "alice", Email: "alice@example.org"}
+	first := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	removed := first.Add(24 * time.Hour)
+	readded := first.Add(48 * time.Hour)
+	// alice was a writer of project-1 from the oldest retained revision, and a
+	// meeting coordinator again since being re-added.
+	history.On("GetProjectHistory", mock.Anything, "project-1").Return([]models.ProjectRevision{
+		{Kind: models.ProjectRevisionBase, Created: first, Project: &models.ProjectBase{UID: "project-1"}},
+		{Kind: models.ProjectRevisionSettings, Created: first, Settings: &models.ProjectSettings{
+			Writers: []models.UserInfo{alice}, MeetingCoordinators: []models.UserInfo{alice},
+		}},
+		{Kind: models.ProjectRevisionSettings, Created: removed, Settings: &models.ProjectSettings{
+			Writers: []models.UserInfo{alice},
+		}},
+		{Kind: models.ProjectRevisionSettings, Created: readded, Settings: &models.ProjectSettings{
+			Writers: []models.UserInfo{alice}, MeetingCoordinators: []models.UserInfo{alice},
+		}},
+	}, nil)
+	history.On("GetProjectHistory", mock.Anything, "project-2").Return([]models.ProjectRevision(nil), errors.New("history unavailable"))
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "alice")
+
+	export, err := service.ExportUserData(ctx, " alice ")
+
+	require.NoError(t, err)
+	assert.Equal(t, "alice", export.Username)
+	assert.False(t, export.GeneratedAt.IsZero())
+	assert.Equal(t, []models.UserMembership{
+		{ProjectUID: "project-2", Slug: "alpha", Name: "Alpha", Role: "auditor", User: alice},
+		{ProjectUID: "project-1", Slug: "zeta", Name: "Zeta", Role: "writer", User: alice, AddedAt: &first},
+		{ProjectUID: "project-1", Slug: "zeta", Name: "Zeta", Role: "meeting_coordinator", User: alice, AddedAt: &readded},
+	}, export.Memberships)
+	mockRepo.AssertExpectations(t)
+	history.AssertExpectations(t)
+}
+
+func TestProjectsService_ExportUserData_Contacts(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	director := models.UserInfo{Username: "dana", Name: "Dana", Email: "dana@example.org"}
+	mockRepo.On("ListAllProjects", mock.Anything).Return(
+		[]*models.ProjectBase{{UID: "project-1", Slug: "zeta", Name: "Zeta"}},
+		[]*models.ProjectSettings{{UID: "project-1", ExecutiveDirector: &director, ProgramManager: &director}},
+		nil,
+	)
+	ctx := context.WithValue(context.Background(), constants.RolesContextID, []string{domain.RoleStaff})
+
+	export, err := service.ExportUserData(ctx, "dana")
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.UserMembership{
+		{ProjectUID: "project-1", Slug: "zeta", Name: "Zeta", Role: models.ProjectContactRoleExecutiveDirector, User: director},
+		{ProjectUID: "project-1", Slug: "zeta", Name: "Zeta", Role: models.ProjectContactRoleProgramManager, User: director},
+	}, export.Memberships)
+}
+
+func TestProjectsService_ExportUserData_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		principal   string
+		username    string
+		expectedErr error
+	}{
+		{
+			name:        "missing username",
+			principal:   "alice",
+			username:    " ",
+			expectedErr: domain.ErrValidationFailed,
+		},
+		{
+			name:        "another user's data",
+			principal:   "bob",
+			username:    "alice",
+			expectedErr: domain.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := setupServiceForTesting()
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tt.principal)
+
+			_, err := service.ExportUserData(ctx, tt.username)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
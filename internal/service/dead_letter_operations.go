@@ -0,0 +1,133 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// DeadLetterReplayResult is the outcome of replaying a dead-lettered message.
+type DeadLetterReplayResult struct {
+	// Replayed reports whether the handler succeeded and the dead letter was removed.
+	Replayed bool
+	// DeadLetter is the replayed message; when the replay failed, it carries
+	// the new error and attempt count.
+	DeadLetter *models.DeadLetter
+}
+
+// replayedMessage hands a dead-lettered message back to its event handler.
+type replayedMessage struct {
+	subject string
+	data    []byte
+}
+
+func (m *replayedMessage) Subject() string { return m.subject }
+
+func (m *replayedMessage) Data() []byte { return m.data }
+
+// Respond discards the reply; events are not requests.
+func (m *replayedMessage) Respond([]byte) error { return nil }
+
+// RecordDeadLetter stores an event whose handler failed, with its payload and
+// the error, so that it can be replayed once the cause is fixed. Without a
+// dead-letter repository the event is dropped, as it always was.
+func (s *ProjectsService) RecordDeadLetter(ctx context.Context, msg domain.Message, handlerErr error) {
+	if s.DeadLetterRepository == nil {
+		return
+	}
+
+	now := time.Now()
+	deadLetter := &models.DeadLetter{
+		ID:           uuid.NewString(),
+		Subject:      s.Config.localSubject(msg.Subject()),
+		Payload:      msg.Data(),
+		Error:        handlerErr.Error(),
+		Attempts:     1,
+		FailedAt:     now,
+		LastFailedAt: now,
+	}
+	if err := s.DeadLetterRepository.PutDeadLetter(ctx, deadLetter); err != nil {
+		slog.ErrorContext(ctx, "error recording dead letter, the event is dropped", constants.ErrKey, err,
+			"subject", deadLetter.Subject)
+		return
+	}
+	slog.InfoContext(ctx, "recorded dead letter", "dead_letter_id", deadLetter.ID, "subject", deadLetter.Subject)
+}
+
+// ListDeadLetters returns every dead-lettered message, oldest first, to LF
+// staff or a trusted service principal.
+func (s *ProjectsService) ListDeadLetters(ctx context.Context) ([]*models.DeadLetter, error) {
+	if s.DeadLetterRepository == nil {
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "list dead letters"); err != nil {
+		return nil, err
+	}
+	return s.DeadLetterRepository.ListDeadLetters(ctx)
+}
+
+// ReplayDeadLetter hands a dead-lettered message back to the handler of its
+// subject. The dead letter is removed if the handler succeeds; otherwise the
+// failed attempt is recorded on it. Only LF staff and trusted service
+// principals may replay.
+func (s *ProjectsService) ReplayDeadLetter(ctx context.Context, deadLetterID string) (*DeadLetterReplayResult, error) {
+	if s.DeadLetterRepository == nil {
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "replay dead letter"); err != nil {
+		return nil, err
+	}
+
+	ctx, span := startSpan(ctx, "ProjectsService.ReplayDeadLetter", attribute.String("dead_letter_id", deadLetterID))
+	defer span.End()
+
+	deadLetter, err := s.DeadLetterRepository.GetDeadLetter(ctx, deadLetterID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	var handlerErr error
+	handle, ok := s.EventHandlers()[deadLetter.Subject]
+	if !ok {
+		handle, ok = s.StreamHandlers()[deadLetter.Subject]
+	}
+	if ok {
+		handlerErr = handle(ctx, &replayedMessage{subject: deadLetter.Subject, data: deadLetter.Payload})
+	} else {
+		handlerErr = fmt.Errorf("no handler for subject %s", deadLetter.Subject)
+	}
+
+	if handlerErr == nil {
+		if err := s.DeadLetterRepository.DeleteDeadLetter(ctx, deadLetter.ID); err != nil {
+			recordSpanError(span, err)
+			return nil, err
+		}
+		slog.InfoContext(ctx, "replayed dead letter", "dead_letter_id", deadLetter.ID, "subject", deadLetter.Subject)
+		return &DeadLetterReplayResult{Replayed: true, DeadLetter: deadLetter}, nil
+	}
+
+	deadLetter.Attempts++
+	deadLetter.Error = handlerErr.Error()
+	deadLetter.LastFailedAt = time.Now()
+	slog.WarnContext(ctx, "dead letter replay failed", constants.ErrKey, handlerErr,
+		"dead_letter_id", deadLetter.ID,
+		"subject", deadLetter.Subject,
+		"attempts", deadLetter.Attempts,
+	)
+	if err := s.DeadLetterRepository.PutDeadLetter(ctx, deadLetter); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	return &DeadLetterReplayResult{DeadLetter: deadLetter}, nil
+}
@@ -0,0 +1,168 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectsService_RecordDeadLetter(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+	deadLetters := &domain.MockDeadLetterRepository{}
+	service.DeadLetterRepository = deadLetters
+
+	deadLetters.On("PutDeadLetter", mock.Anything, mock.MatchedBy(func(deadLetter *models.DeadLetter) bool {
+		return deadLetter.ID != "" &&
+			deadLetter.Subject == constants.ProjectLinkCreatedSubject &&
+			string(deadLetter.Payload) == `{"project_uid":"project-1"}` &&
+			deadLetter.Error == "boom" &&
+			deadLetter.Attempts == 1 &&
+			!deadLetter.FailedAt.IsZero()
+	})).Return(nil).Once()
+
+	msg := newMockMessage(constants.ProjectLinkCreatedSubject, []byte(`{"project_uid":"project-1"}`))
+	service.RecordDeadLetter(context.Background(), msg, errors.New("boom"))
+
+	deadLetters.AssertExpectations(t)
+}
+
+func TestProjectsService_RecordDeadLetter_tenant(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+	service.Config.Tenant = "sandbox"
+	deadLetters := &domain.MockDeadLetterRepository{}
+	service.DeadLetterRepository = deadLetters
+
+	// The subject is recorded without the tenant prefix, so that the event is
+	// replayed to its handler.
+	deadLetters.On("PutDeadLetter", mock.Anything, mock.MatchedBy(func(deadLetter *models.DeadLetter) bool {
+		return deadLetter.Subject == constants.ProjectLinkCreatedSubject
+	})).Return(nil).Once()
+
+	msg := newMockMessage("sandbox."+constants.ProjectLinkCreatedSubject, []byte(`{"project_uid":"project-1"}`))
+	service.RecordDeadLetter(context.Background(), msg, errors.New("boom"))
+
+	deadLetters.AssertExpectations(t)
+}
+
+func TestProjectsService_RecordDeadLetter_subjectPrefix(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+	service.Config.Tenant = "sandbox"
+	service.Config.SubjectPrefix = "lfx.projects-api-blue"
+	deadLetters := &domain.MockDeadLetterRepository{}
+	service.DeadLetterRepository = deadLetters
+
+	deadLetters.On("PutDeadLetter", mock.Anything, mock.MatchedBy(func(deadLetter *models.DeadLetter) bool {
+		return deadLetter.Subject == constants.ProjectLinkCreatedSubject
+	})).Return(nil).Once()
+
+	msg := newMockMessage("sandbox.lfx.projects-api-blue.project_link.created", []byte(`{"project_uid":"project-1"}`))
+	service.RecordDeadLetter(context.Background(), msg, errors.New("boom"))
+
+	deadLetters.AssertExpectations(t)
+}
+
+func TestProjectsService_RecordDeadLetter_withoutRepository(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+
+	assert.NotPanics(t, func() {
+		service.RecordDeadLetter(context.Background(), newMockMessage("subject", nil), errors.New("boom"))
+	})
+}
+
+func TestProjectsService_ReplayDeadLetter(t *testing.T) {
+	failedAt := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name             string
+		deadLetter       *models.DeadLetter
+		getErr           error
+		setupMocks       func(*domain.MockDeadLetterRepository)
+		expectedReplayed bool
+		expectedErr      error
+	}{
+		{
+			name:       "successful replay removes the dead letter",
+			deadLetter: &models.DeadLetter{ID: "dl-1", Subject: constants.ProjectLinkCreatedSubject, Payload: []byte(`{}`), Attempts: 1, FailedAt: failedAt},
+			setupMocks: func(repo *domain.MockDeadLetterRepository) {
+				repo.On("DeleteDeadLetter", mock.Anything, "dl-1").Return(nil).Once()
+			},
+			expectedReplayed: true,
+		},
+		{
+			name:       "failed replay records the attempt",
+			deadLetter: &models.DeadLetter{ID: "dl-1", Subject: "lfx.unknown.subject", Attempts: 1, FailedAt: failedAt},
+			setupMocks: func(repo *domain.MockDeadLetterRepository) {
+				repo.On("PutDeadLetter", mock.Anything, mock.MatchedBy(func(deadLetter *models.DeadLetter) bool {
+					return deadLetter.Attempts == 2 &&
+						deadLetter.Error == "no handler for subject lfx.unknown.subject" &&
+						deadLetter.LastFailedAt.After(failedAt)
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name:        "unknown dead letter",
+			getErr:      domain.ErrDeadLetterNotFound,
+			setupMocks:  func(*domain.MockDeadLetterRepository) {},
+			expectedErr: domain.ErrDeadLetterNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _, _, _ := setupServiceForTesting()
+			deadLetters := &domain.MockDeadLetterRepository{}
+			service.DeadLetterRepository = deadLetters
+			if tt.getErr != nil {
+				deadLetters.On("GetDeadLetter", mock.Anything, "dl-1").Return(nil, tt.getErr)
+			} else {
+				deadLetters.On("GetDeadLetter", mock.Anything, "dl-1").Return(tt.deadLetter, nil)
+			}
+			tt.setupMocks(deadLetters)
+
+			result, err := service.ReplayDeadLetter(staffContext(), "dl-1")
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedReplayed, result.Replayed)
+			assert.Equal(t, "dl-1", result.DeadLetter.ID)
+			deadLetters.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectsService_DeadLetters_forbidden(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+	deadLetters := &domain.MockDeadLetterRepository{}
+	service.DeadLetterRepository = deadLetters
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user-1")
+
+	_, err := service.ListDeadLetters(ctx)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = service.ReplayDeadLetter(ctx, "dl-1")
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	deadLetters.AssertNotCalled(t, "ListDeadLetters", mock.Anything)
+	deadLetters.AssertNotCalled(t, "GetDeadLetter", mock.Anything, mock.Anything)
+}
+
+func TestProjectsService_DeadLetters_withoutRepository(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+
+	_, err := service.ListDeadLetters(context.Background())
+	assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+	_, err = service.ReplayDeadLetter(context.Background(), "dl-1")
+	assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+}
@@ -0,0 +1,63 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// recordProjectDeletion stores the tombstone of a deleted project, naming the
+// project it was merged into when mergedInto is set. The project is already
+// deleted, so a failure is logged rather than returned.
+func (s *ProjectsService) recordProjectDeletion(ctx context.Context, project *models.ProjectBase, mergedInto string) {
+	if s.ProjectDeletionRepository == nil {
+		return
+	}
+
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	deletion := &models.ProjectDeletion{
+		UID:        project.UID,
+		Slug:       project.Slug,
+		DeletedAt:  time.Now().UTC(),
+		DeletedBy:  principal,
+		MergedInto: mergedInto,
+	}
+	if err := s.ProjectDeletionRepository.PutProjectDeletion(ctx, deletion); err != nil {
+		slog.ErrorContext(ctx, "error recording project deletion", constants.ErrKey, err)
+	}
+}
+
+// ListProjectDeletions returns the tombstones of the projects deleted at or
+// after since, oldest first. Only LF staff and trusted service principals may
+// list them.
+func (s *ProjectsService) ListProjectDeletions(ctx context.Context, since time.Time) ([]*models.ProjectDeletion, error) {
+	if s.ProjectDeletionRepository == nil {
+		slog.ErrorContext(ctx, "project deletion repository not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+
+	ctx, span := startSpan(ctx, "ProjectsService.ListProjectDeletions", attribute.String("since", since.Format(time.RFC3339)))
+	defer span.End()
+
+	if !s.isStaffOrTrusted(ctx) {
+		principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+		slog.WarnContext(ctx, "principal cannot list project deletions", "principal", principal)
+		recordSpanError(span, domain.ErrForbidden)
+		return nil, domain.ErrForbidden
+	}
+
+	deletions, err := s.ProjectDeletionRepository.ListProjectDeletions(ctx, since)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	return deletions, nil
+}
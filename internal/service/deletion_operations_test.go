@@ -0,0 +1,92 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+func TestProjectsService_DeleteProject_recordsDeletion(t *testing.T) {
+	for _, putErr := range []error{nil, errors.New("nats error")} {
+		service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+		deletions := &domain.MockProjectDeletionRepository{}
+		service.ProjectDeletionRepository = deletions
+		mockRepo.On("GetProjectBase", mock.Anything, "test-project-uid").Return(&models.ProjectBase{
+			UID:          "test-project-uid",
+			Slug:         "test-project",
+			FundingModel: []string{"Crowdfunding"},
+		}, nil)
+		mockRepo.On("DeleteProject", mock.Anything, "test-project-uid", uint64(123)).Return(nil)
+		mockBuilder.On("SendIndexerMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockBuilder.On("SendAccessMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		var recorded *models.ProjectDeletion
+		deletions.On("PutProjectDeletion", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			recorded = args.Get(1).(*models.ProjectDeletion)
+		}).Return(putErr)
+
+		ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "deleter")
+		err := service.DeleteProject(ctx, &projsvc.DeleteProjectPayload{
+			UID:     misc.StringPtr("test-project-uid"),
+			IfMatch: misc.StringPtr("123"),
+		})
+
+		// A tombstone that cannot be stored does not fail the deletion.
+		require.NoError(t, err)
+		require.NotNil(t, recorded)
+		assert.Equal(t, "test-project-uid", recorded.UID)
+		assert.Equal(t, "test-project", recorded.Slug)
+		assert.Equal(t, "deleter", recorded.DeletedBy)
+		assert.WithinDuration(t, time.Now(), recorded.DeletedAt, time.Minute)
+	}
+}
+
+func TestProjectsService_ListProjectDeletions(t *testing.T) {
+	since := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("staff", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+		deletions := &domain.MockProjectDeletionRepository{}
+		service.ProjectDeletionRepository = deletions
+		deletions.On("ListProjectDeletions", mock.Anything, since).Return([]*models.ProjectDeletion{{UID: "test-project-uid"}}, nil)
+
+		result, err := service.ListProjectDeletions(staffContext(), since)
+
+		require.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("not staff", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+		deletions := &domain.MockProjectDeletionRepository{}
+		service.ProjectDeletionRepository = deletions
+
+		ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user")
+		_, err := service.ListProjectDeletions(ctx, since)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		deletions.AssertNotCalled(t, "ListProjectDeletions", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unavailable", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+
+		_, err := service.ListProjectDeletions(staffContext(), since)
+
+		assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+	})
+}
@@ -210,7 +210,7 @@ func (s *ProjectsService) GetDocumentFile(ctx context.Context, projectUID, docum
 }
 
 // DeleteDocument deletes document metadata and its binary file.
-func (s *ProjectsService) DeleteDocument(ctx context.Context, projectUID, documentUID string, ifMatch *string, xSync bool) error {
+func (s *ProjectsService) DeleteDocument(ctx context.Context, projectUID, documentUID string, ifMatch *string, forceUpdate, xSync bool) error {
 	if !s.ServiceReady() {
 		slog.ErrorContext(ctx, "service not ready")
 		return domain.ErrServiceUnavailable
@@ -219,24 +219,12 @@ func (s *ProjectsService) DeleteDocument(ctx context.Context, projectUID, docume
 	ctx = log.AppendCtx(ctx, slog.String("project_uid", projectUID))
 	ctx = log.AppendCtx(ctx, slog.String("document_uid", documentUID))
 
-	var revision uint64
-	var err error
-
-	if !s.Config.SkipEtagValidation {
-		if ifMatch == nil {
-			slog.WarnContext(ctx, "If-Match header is missing")
-			return domain.ErrValidationFailed
-		}
-		revision, err = strconv.ParseUint(*ifMatch, 10, 64)
-		if err != nil {
-			slog.ErrorContext(ctx, "error parsing If-Match header", constants.ErrKey, err)
-			return domain.ErrValidationFailed
-		}
-	} else {
-		_, revision, err = s.DocumentRepository.GetDocumentMetadata(ctx, projectUID, documentUID)
-		if err != nil {
-			return err
-		}
+	revision, err := s.ifMatchRevision(ctx, "document:"+documentUID, ifMatch, forceUpdate, func() (uint64, error) {
+		_, revision, err := s.DocumentRepository.GetDocumentMetadata(ctx, projectUID, documentUID)
+		return revision, err
+	})
+	if err != nil {
+		return err
 	}
 
 	if err := s.DocumentRepository.DeleteDocumentMetadata(ctx, projectUID, documentUID, revision); err != nil {
@@ -344,7 +344,7 @@ func TestProjectsService_DeleteDocument(t *testing.T) {
 			_ = now
 			tt.setupMocks(mockDoc, mockMsg)
 
-			err := svc.DeleteDocument(context.Background(), tt.projectUID, tt.documentUID, tt.ifMatch, false)
+			err := svc.DeleteDocument(context.Background(), tt.projectUID, tt.documentUID, tt.ifMatch, false, false)
 
 			if tt.wantErr != nil {
 				assert.ErrorIs(t, err, tt.wantErr)
@@ -14,6 +14,7 @@ import (
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/service/email"
 	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
 	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -83,6 +84,7 @@ func (s *ProjectsService) HandleProjectLinkCreated(ctx context.Context, rawMsg d
 // handleProjectContentCreated fans out notification emails to all LFID writers and auditors
 // of the project. Individual send failures are logged but never abort the batch.
 func (s *ProjectsService) handleProjectContentCreated(ctx context.Context, item projectContentItem) {
+	trace.SpanFromContext(ctx).SetAttributes(projectUIDAttr(item.projectUID))
 	slog.DebugContext(ctx, "document_subscriber: handling content created event",
 		"project_uid", item.projectUID,
 		"item_type", item.itemType,
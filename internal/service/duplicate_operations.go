@@ -0,0 +1,135 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// maxDuplicateCandidates caps the existing projects reported for a rejected duplicate.
+const maxDuplicateCandidates = 10
+
+// checkDuplicateProject rejects a new project with a [domain.DuplicateProjectError]
+// when an existing project has a similar normalized name, or the same website
+// or repository URL. It does nothing unless Config.DuplicateCheck is set.
+func (s *ProjectsService) checkDuplicateProject(ctx context.Context, name string, websiteURL, repositoryURL *string) error {
+	if !s.Config.DuplicateCheck {
+		return nil
+	}
+
+	projects, err := s.ProjectRepository.ListAllProjectsBase(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "error listing projects for duplicate check", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	normalizedName := normalizeProjectName(name)
+	website := normalizeProjectURL(stringValue(websiteURL))
+	repository := normalizeProjectURL(stringValue(repositoryURL))
+	var candidates []domain.DuplicateCandidate
+	for _, project := range projects {
+		reason := ""
+		switch {
+		case website != "" && normalizeProjectURL(project.WebsiteURL) == website:
+			reason = domain.DuplicateReasonWebsiteURL
+		case repository != "" && normalizeProjectURL(project.RepositoryURL) == repository:
+			reason = domain.DuplicateReasonRepositoryURL
+		case normalizedName != "" &&
+			nameSimilarity(normalizedName, normalizeProjectName(project.Name)) >= s.Config.DuplicateNameSimilarity:
+			reason = domain.DuplicateReasonSimilarName
+		default:
+			continue
+		}
+		candidates = append(candidates, domain.DuplicateCandidate{
+			UID:    project.UID,
+			Slug:   project.Slug,
+			Name:   project.Name,
+			Reason: reason,
+		})
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Slug < candidates[j].Slug })
+	if len(candidates) > maxDuplicateCandidates {
+		candidates = candidates[:maxDuplicateCandidates]
+	}
+	slog.WarnContext(ctx, "project likely duplicates existing projects",
+		slog.String("project_name", name),
+		slog.Int("candidates", len(candidates)),
+	)
+	return &domain.DuplicateProjectError{Candidates: candidates}
+}
+
+// normalizeProjectName lowercases name and drops everything but letters and
+// digits, so that "Open-Source Foo" and "open source foo" compare equal.
+func normalizeProjectName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// normalizeProjectURL reduces a website or repository URL to its lowercased
+// host and path, without a "www." prefix, trailing slash or ".git" suffix. It
+// returns "" for an empty or unparsable URL.
+func normalizeProjectURL(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return ""
+	}
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	path := strings.TrimSuffix(strings.TrimRight(strings.ToLower(u.Path), "/"), ".git")
+	return host + path
+}
+
+// nameSimilarity returns the similarity of two names between 0 and 1, as one
+// minus their Levenshtein distance over the length of the longer name.
+func nameSimilarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 0
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,128 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+func TestProjectsService_checkDuplicateProject(t *testing.T) {
+	existing := []*models.ProjectBase{
+		{UID: "uid-1", Slug: "open-foo", Name: "Open Foo Foundation", WebsiteURL: "https://www.openfoo.org/"},
+		{UID: "uid-2", Slug: "bar", Name: "Bar", RepositoryURL: "https://github.com/example/bar.git"},
+	}
+
+	tests := []struct {
+		name               string
+		projectName        string
+		websiteURL         string
+		repositoryURL      string
+		expectedCandidates []domain.DuplicateCandidate
+	}{
+		{
+			name:        "similar name",
+			projectName: "open-foo foundation!",
+			expectedCandidates: []domain.DuplicateCandidate{
+				{UID: "uid-1", Slug: "open-foo", Name: "Open Foo Foundation", Reason: domain.DuplicateReasonSimilarName},
+			},
+		},
+		{
+			name:        "website URL without scheme or www",
+			projectName: "Something Else",
+			websiteURL:  "openfoo.org",
+			expectedCandidates: []domain.DuplicateCandidate{
+				{UID: "uid-1", Slug: "open-foo", Name: "Open Foo Foundation", Reason: domain.DuplicateReasonWebsiteURL},
+			},
+		},
+		{
+			name:          "repository URL without .git suffix",
+			projectName:   "Something Else",
+			repositoryURL: "https://GitHub.com/example/bar",
+			expectedCandidates: []domain.DuplicateCandidate{
+				{UID: "uid-2", Slug: "bar", Name: "Bar", Reason: domain.DuplicateReasonRepositoryURL},
+			},
+		},
+		{
+			name:          "no match",
+			projectName:   "Baz",
+			websiteURL:    "https://baz.org",
+			repositoryURL: "https://github.com/example/baz",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := setupServiceForTesting()
+			service.Config.DuplicateCheck = true
+			service.Config.DuplicateNameSimilarity = 0.9
+			mockRepo.On("ListAllProjectsBase", mock.Anything).Return(existing, nil).Once()
+
+			err := service.checkDuplicateProject(context.Background(), tt.projectName, &tt.websiteURL, &tt.repositoryURL)
+
+			if tt.expectedCandidates == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var duplicateErr *domain.DuplicateProjectError
+			require.ErrorAs(t, err, &duplicateErr)
+			assert.ErrorIs(t, err, domain.ErrProjectDuplicate)
+			assert.Equal(t, tt.expectedCandidates, duplicateErr.Candidates)
+		})
+	}
+}
+
+func TestProjectsService_checkDuplicateProject_disabled(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+
+	err := service.checkDuplicateProject(context.Background(), "Bar", nil, nil)
+
+	assert.NoError(t, err)
+	mockRepo.AssertNotCalled(t, "ListAllProjectsBase", mock.Anything)
+}
+
+func TestProjectsService_checkDuplicateProject_listError(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	service.Config.DuplicateCheck = true
+	mockRepo.On("ListAllProjectsBase", mock.Anything).Return(nil, errors.New("boom")).Once()
+
+	err := service.checkDuplicateProject(context.Background(), "Bar", nil, nil)
+
+	assert.ErrorIs(t, err, domain.ErrInternal)
+}
+
+func TestProjectsService_CreateProject_duplicate(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	service.Config.DuplicateCheck = true
+	service.Config.DuplicateNameSimilarity = 0.9
+	mockRepo.On("ProjectSlugExists", mock.Anything, "bar-2").Return(false, nil).Once()
+	mockRepo.On("ListAllProjectsBase", mock.Anything).Return([]*models.ProjectBase{
+		{UID: "uid-2", Slug: "bar", Name: "Bar"},
+	}, nil).Once()
+
+	_, err := service.CreateProject(context.Background(), &projsvc.CreateProjectPayload{
+		Slug:        misc.StringPtr("bar-2"),
+		Name:        "BAR",
+		Description: "Bar",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrProjectDuplicate)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestNameSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, nameSimilarity("kubernetes", "kubernetes"))
+	assert.InDelta(t, 0.9, nameSimilarity("kubernetes", "kubernetez"), 0.001)
+	assert.Equal(t, 0.0, nameSimilarity("", ""))
+}
@@ -0,0 +1,176 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// projectEnumsTTL is how long the cached allowed values of the enumerated
+// project fields are used before they are read again, so that a change by an
+// admin reaches every replica within it.
+const projectEnumsTTL = 30 * time.Second
+
+// projectEnumsCache holds the allowed values last read, so that validating a
+// project write does not read them every time.
+type projectEnumsCache struct {
+	mu       sync.Mutex
+	enums    *models.ProjectEnums
+	loadedAt time.Time
+}
+
+// GetProjectEnums returns the values currently allowed for the enumerated
+// project fields: the stored ones, or the defaults when none were stored.
+func (s *ProjectsService) GetProjectEnums(ctx context.Context) (*models.ProjectEnums, error) {
+	ctx, span := startSpan(ctx, "ProjectsService.GetProjectEnums")
+	defer span.End()
+
+	enums, err := s.projectEnums(ctx)
+	recordSpanError(span, err)
+	return enums, err
+}
+
+// UpdateProjectEnums replaces the values allowed for the enumerated project
+// fields, for LF staff. Projects that already have a value no longer allowed
+// keep it until the field is changed.
+func (s *ProjectsService) UpdateProjectEnums(ctx context.Context, enums *models.ProjectEnums) (*models.ProjectEnums, error) {
+	if s.ProjectEnumsRepository == nil {
+		slog.ErrorContext(ctx, "project enums repository not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "update project enums"); err != nil {
+		return nil, err
+	}
+
+	ctx, span := startSpan(ctx, "ProjectsService.UpdateProjectEnums")
+	defer span.End()
+
+	validation := &domain.ValidationError{}
+	enums.Stages = validateEnumValues(validation, "stages", enums.Stages)
+	enums.Categories = validateEnumValues(validation, "categories", enums.Categories)
+	if err := validation.Err(); err != nil {
+		slog.WarnContext(ctx, "invalid project enums", constants.ErrKey, err)
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	now := time.Now().UTC()
+	enums.UpdatedBy = principal
+	enums.UpdatedAt = &now
+	if err := s.ProjectEnumsRepository.PutProjectEnums(ctx, enums); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	s.cacheProjectEnums(enums)
+
+	slog.InfoContext(ctx, "updated project enums", "stages", len(enums.Stages), "categories", len(enums.Categories))
+	return enums, nil
+}
+
+// validateEnumValues adds to validation an error for the field path when
+// values is empty or holds a blank or repeated value, and returns the values
+// with surrounding spaces trimmed.
+func validateEnumValues(validation *domain.ValidationError, path string, values []string) []string {
+	if len(values) == 0 {
+		validation.Add(path, domain.FieldErrorRequired, domain.ErrInvalidProjectEnums)
+		return values
+	}
+	trimmed := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" || slices.Contains(trimmed, value) {
+			validation.Add(path, domain.FieldErrorInvalid, domain.ErrInvalidProjectEnums)
+			return values
+		}
+		trimmed = append(trimmed, value)
+	}
+	return trimmed
+}
+
+// projectEnums returns the allowed values of the enumerated project fields,
+// from the cache when it is fresh. When they cannot be read, the cached
+// values are used however old they are.
+func (s *ProjectsService) projectEnums(ctx context.Context) (*models.ProjectEnums, error) {
+	if s.ProjectEnumsRepository == nil {
+		return models.DefaultProjectEnums(), nil
+	}
+
+	s.enumsCache.mu.Lock()
+	cached, loadedAt := s.enumsCache.enums, s.enumsCache.loadedAt
+	s.enumsCache.mu.Unlock()
+	if cached != nil && time.Since(loadedAt) < projectEnumsTTL {
+		return cached, nil
+	}
+
+	enums, err := s.ProjectEnumsRepository.GetProjectEnums(ctx)
+	switch {
+	case errors.Is(err, domain.ErrProjectEnumsNotFound):
+		enums = models.DefaultProjectEnums()
+	case err != nil:
+		if cached != nil {
+			slog.WarnContext(ctx, "using stale project enums", constants.ErrKey, err, "loaded_at", loadedAt)
+			return cached, nil
+		}
+		return nil, domain.ErrInternal
+	}
+	s.cacheProjectEnums(enums)
+	return enums, nil
+}
+
+// cacheProjectEnums replaces the cached allowed values with enums.
+func (s *ProjectsService) cacheProjectEnums(enums *models.ProjectEnums) {
+	s.enumsCache.mu.Lock()
+	defer s.enumsCache.mu.Unlock()
+	s.enumsCache.enums = enums
+	s.enumsCache.loadedAt = time.Now()
+}
+
+// checkProjectEnums checks that the stage and category of a project write are
+// allowed values. Values equal to those of existing, the stored project of an
+// update, are kept even when they are no longer allowed.
+func (s *ProjectsService) checkProjectEnums(ctx context.Context, stage, category *string, existing *models.ProjectBase) error {
+	stageValue, categoryValue := stringValue(stage), stringValue(category)
+	if existing != nil {
+		if stageValue == existing.Stage {
+			stageValue = ""
+		}
+		if categoryValue == existing.Category {
+			categoryValue = ""
+		}
+	}
+	if stageValue == "" && categoryValue == "" {
+		return nil
+	}
+
+	enums, err := s.projectEnums(ctx)
+	if err != nil {
+		return err
+	}
+	validation := &domain.ValidationError{}
+	if stageValue != "" && !slices.Contains(enums.Stages, stageValue) {
+		validation.Add("stage", domain.FieldErrorInvalid, domain.ErrInvalidEnumValue)
+	}
+	if categoryValue != "" && !slices.Contains(enums.Categories, categoryValue) {
+		validation.Add("category", domain.FieldErrorInvalid, domain.ErrInvalidEnumValue)
+	}
+	if err := validation.Err(); err != nil {
+		slog.WarnContext(ctx, "project stage or category not allowed",
+			slog.String("stage", stageValue),
+			slog.String("category", categoryValue),
+		)
+		return err
+	}
+	return nil
+}
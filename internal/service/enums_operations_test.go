@@ -0,0 +1,179 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+func TestProjectsService_GetProjectEnums(t *testing.T) {
+	stored := &models.ProjectEnums{Stages: []string{"Active"}, Categories: []string{"Sandbox", "Lab"}}
+
+	t.Run("defaults without a repository", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+
+		enums, err := service.GetProjectEnums(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, models.DefaultProjectEnums(), enums)
+	})
+
+	t.Run("defaults when none are stored", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+		repo := &domain.MockProjectEnumsRepository{}
+		service.ProjectEnumsRepository = repo
+		repo.On("GetProjectEnums", mock.Anything).Return(nil, domain.ErrProjectEnumsNotFound)
+
+		enums, err := service.GetProjectEnums(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, models.DefaultProjectEnums(), enums)
+	})
+
+	t.Run("stored enums are cached", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+		repo := &domain.MockProjectEnumsRepository{}
+		service.ProjectEnumsRepository = repo
+		repo.On("GetProjectEnums", mock.Anything).Return(stored, nil).Once()
+
+		for range 2 {
+			enums, err := service.GetProjectEnums(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, stored, enums)
+		}
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("stale enums are used when they cannot be read", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+		repo := &domain.MockProjectEnumsRepository{}
+		service.ProjectEnumsRepository = repo
+		service.enumsCache.enums = stored
+		service.enumsCache.loadedAt = time.Now().Add(-time.Hour)
+		repo.On("GetProjectEnums", mock.Anything).Return(nil, errors.New("nats error"))
+
+		enums, err := service.GetProjectEnums(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, stored, enums)
+	})
+}
+
+func TestProjectsService_UpdateProjectEnums(t *testing.T) {
+	tests := []struct {
+		name        string
+		enums       *models.ProjectEnums
+		expected    *models.ProjectEnums
+		expectedErr error
+	}{
+		{
+			name:     "values are trimmed and stored",
+			enums:    &models.ProjectEnums{Stages: []string{" Active "}, Categories: []string{"Sandbox", "Lab"}},
+			expected: &models.ProjectEnums{Stages: []string{"Active"}, Categories: []string{"Sandbox", "Lab"}},
+		},
+		{
+			name:        "empty list",
+			enums:       &models.ProjectEnums{Stages: []string{"Active"}},
+			expectedErr: domain.ErrInvalidProjectEnums,
+		},
+		{
+			name:        "repeated value",
+			enums:       &models.ProjectEnums{Stages: []string{"Active", "Active"}, Categories: []string{"Sandbox"}},
+			expectedErr: domain.ErrInvalidProjectEnums,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _, _, _ := setupServiceForTesting()
+			repo := &domain.MockProjectEnumsRepository{}
+			service.ProjectEnumsRepository = repo
+			repo.On("PutProjectEnums", mock.Anything, mock.Anything).Return(nil)
+
+			enums, err := service.UpdateProjectEnums(staffContext(), tt.enums)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				repo.AssertNotCalled(t, "PutProjectEnums", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected.Stages, enums.Stages)
+			assert.Equal(t, tt.expected.Categories, enums.Categories)
+			assert.Equal(t, "staff-user", enums.UpdatedBy)
+			assert.NotNil(t, enums.UpdatedAt)
+
+			// The new values apply at once on this replica.
+			live, err := service.GetProjectEnums(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, enums, live)
+		})
+	}
+}
+
+func TestProjectsService_UpdateProjectEnums_forbidden(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+	repo := &domain.MockProjectEnumsRepository{}
+	service.ProjectEnumsRepository = repo
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user-1")
+
+	_, err := service.UpdateProjectEnums(ctx, &models.ProjectEnums{Stages: []string{"Active"}, Categories: []string{"Sandbox"}})
+
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	repo.AssertNotCalled(t, "PutProjectEnums", mock.Anything, mock.Anything)
+}
+
+func TestProjectsService_checkProjectEnums(t *testing.T) {
+	existing := &models.ProjectBase{Stage: "Retired", Category: "Sandbox"}
+
+	tests := []struct {
+		name           string
+		stage          *string
+		category       *string
+		existing       *models.ProjectBase
+		expectedFields []string
+	}{
+		{name: "allowed values", stage: misc.StringPtr("Active"), category: misc.StringPtr("Lab")},
+		{name: "unset values", existing: existing},
+		{name: "values that are not allowed", stage: misc.StringPtr("Retired"), category: misc.StringPtr("Graduated"), expectedFields: []string{"stage", "category"}},
+		{name: "unchanged value no longer allowed", stage: misc.StringPtr("Retired"), category: misc.StringPtr("Lab"), existing: existing},
+		{name: "changed value", stage: misc.StringPtr("Retired"), category: misc.StringPtr("Graduated"), existing: existing, expectedFields: []string{"category"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _, _, _ := setupServiceForTesting()
+			repo := &domain.MockProjectEnumsRepository{}
+			service.ProjectEnumsRepository = repo
+			repo.On("GetProjectEnums", mock.Anything).Return(&models.ProjectEnums{Stages: []string{"Active"}, Categories: []string{"Sandbox", "Lab"}}, nil)
+
+			err := service.checkProjectEnums(context.Background(), tt.stage, tt.category, tt.existing)
+
+			if tt.expectedFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, domain.ErrInvalidEnumValue)
+			var validationErr *domain.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			var fields []string
+			for _, field := range validationErr.Fields {
+				fields = append(fields, field.Path)
+			}
+			assert.Equal(t, tt.expectedFields, fields)
+		})
+	}
+}
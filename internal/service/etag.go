@@ -0,0 +1,110 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// parseETag returns the revision held by etag, a value of the If-Match
+// header. The revision may be sent as returned in the ETag header, in double
+// quotes, or as a weak ETag such as W/"42": as revisions change with every
+// write, weak and strong ETags of a resource match the same revision.
+func parseETag(etag string) (uint64, error) {
+	value := strings.TrimSpace(etag)
+	value = strings.TrimPrefix(value, "W/")
+	if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+		value = value[1 : len(value)-1]
+	}
+	revision, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ETag %q: %w", etag, err)
+	}
+	return revision, nil
+}
+
+// ifMatchRevision returns the revision a write to object, such as
+// "project:<uid>", applies to: the one in ifMatch, which is required, or the
+// current revision returned by current, whose errors are returned as they
+// are, when ETag validation is skipped. forceUpdate, from the X-Force-Update
+// header, skips the validation of one request for trusted service principals
+// and is audited; it is ignored for other principals.
+func (s *ProjectsService) ifMatchRevision(ctx context.Context, object string, ifMatch *string, forceUpdate bool, current func() (uint64, error)) (uint64, error) {
+	if s.Config.SkipEtagValidation {
+		return current()
+	}
+	if forceUpdate {
+		principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+		if !s.isTrusted(ctx) {
+			slog.WarnContext(ctx, "X-Force-Update header ignored for untrusted principal", "principal", principal, "object", object)
+			return parseIfMatch(ctx, ifMatch)
+		}
+		revision, err := current()
+		if err != nil {
+			return 0, err
+		}
+		log.Audit(ctx, "If-Match validation bypassed with X-Force-Update",
+			"object", object,
+			"actor", principal,
+			"if_match", stringValue(ifMatch),
+			"revision", revision,
+		)
+		return revision, nil
+	}
+	return parseIfMatch(ctx, ifMatch)
+}
+
+// parseIfMatch returns the revision in ifMatch, which is required.
+func parseIfMatch(ctx context.Context, ifMatch *string) (uint64, error) {
+	if ifMatch == nil {
+		slog.WarnContext(ctx, "If-Match header is missing")
+		return 0, domain.ErrValidationFailed
+	}
+	revision, err := parseETag(*ifMatch)
+	if err != nil {
+		slog.ErrorContext(ctx, "error parsing If-Match header", constants.ErrKey, err)
+		return 0, domain.ErrValidationFailed
+	}
+	return revision, nil
+}
+
+// updateProjectSettingsIfMatch applies modify to the project's settings. When
+// ifMatch is set, the update only applies to that revision of the settings and
+// fails with ErrRevisionMismatch if they changed since; otherwise the settings
+// are re-read and the update retried on concurrent writes. An ifMatch is
+// ignored when ETag validation is skipped.
+func (s *ProjectsService) updateProjectSettingsIfMatch(ctx context.Context, projectUID string, ifMatch *string, modify func(*models.ProjectSettings) error) (*models.ProjectSettings, error) {
+	if ifMatch == nil || s.Config.SkipEtagValidation {
+		return s.ProjectRepository.UpdateProjectSettingsWithRetry(ctx, projectUID, modify)
+	}
+	revision, err := parseIfMatch(ctx, ifMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, current, err := s.ProjectRepository.GetProjectSettingsWithRevision(ctx, projectUID)
+	if err != nil {
+		return nil, err
+	}
+	if current != revision {
+		slog.WarnContext(ctx, "etag header is invalid", "etag", revision, "revision", current)
+		return nil, domain.ErrRevisionMismatch
+	}
+	if err := modify(settings); err != nil {
+		return nil, err
+	}
+	if err := s.ProjectRepository.UpdateProjectSettings(ctx, settings, revision); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
@@ -0,0 +1,88 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+func TestParseETag(t *testing.T) {
+	tests := []struct {
+		etag     string
+		expected uint64
+		wantErr  bool
+	}{
+		{etag: "42", expected: 42},
+		{etag: `"42"`, expected: 42},
+		{etag: `W/"42"`, expected: 42},
+		{etag: ` W/"42" `, expected: 42},
+		{etag: "W/42", expected: 42},
+		{etag: "", wantErr: true},
+		{etag: `"abc"`, wantErr: true},
+		{etag: `W/"42`, wantErr: true},
+		{etag: "-1", wantErr: true},
+		{etag: "*", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.etag, func(t *testing.T) {
+			revision, err := parseETag(tt.etag)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, revision)
+		})
+	}
+}
+
+func TestProjectsService_ifMatchRevision(t *testing.T) {
+	current := func() (uint64, error) { return 9, nil }
+	trusted := context.WithValue(context.Background(), constants.PrincipalContextID, "clients@automation")
+	untrusted := context.WithValue(context.Background(), constants.PrincipalContextID, "jdoe")
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		skip        bool
+		ifMatch     *string
+		forceUpdate bool
+		expected    uint64
+		expectedErr error
+	}{
+		{name: "If-Match is used", ctx: untrusted, ifMatch: misc.StringPtr(`W/"3"`), expected: 3},
+		{name: "If-Match is required", ctx: untrusted, expectedErr: domain.ErrValidationFailed},
+		{name: "trusted principal forces the update", ctx: trusted, forceUpdate: true, expected: 9},
+		{name: "trusted principal forces the update over a stale If-Match", ctx: trusted, ifMatch: misc.StringPtr("3"), forceUpdate: true, expected: 9},
+		{name: "force update is ignored for other principals", ctx: untrusted, forceUpdate: true, expectedErr: domain.ErrValidationFailed},
+		{name: "force update is ignored for other principals with If-Match", ctx: untrusted, ifMatch: misc.StringPtr("3"), forceUpdate: true, expected: 3},
+		{name: "validation skipped", ctx: untrusted, skip: true, expected: 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &ProjectsService{Config: ServiceConfig{
+				SkipEtagValidation: tt.skip,
+				TrustedPrincipals:  []string{"clients@automation"},
+			}}
+
+			revision, err := service.ifMatchRevision(tt.ctx, "project:project-1", tt.ifMatch, tt.forceUpdate, current)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, revision)
+		})
+	}
+}
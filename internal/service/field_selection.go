@@ -0,0 +1,90 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+)
+
+// fieldSelection is the set of API field names of a response type selected
+// with the fields query parameter. A nil selection selects every field.
+type fieldSelection map[string]bool
+
+// parseFieldSelection parses a comma-separated list of the API field names of
+// T, a struct type of the generated API whose fields are all optional. It
+// returns nil when fields is empty, and a validation error for a name that is
+// not a field of T.
+func parseFieldSelection[T any](fields *string) (fieldSelection, error) {
+	if fields == nil || strings.TrimSpace(*fields) == "" {
+		return nil, nil
+	}
+	known := apiFieldNames(reflect.TypeFor[T]())
+	selection := fieldSelection{}
+	validation := &domain.ValidationError{}
+	for _, name := range strings.Split(*fields, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !known[name] {
+			validation.Add("fields", domain.FieldErrorInvalid, fmt.Errorf("%s is not a field that can be selected", name))
+			continue
+		}
+		selection[name] = true
+	}
+	if err := validation.Err(); err != nil {
+		return nil, err
+	}
+	return selection, nil
+}
+
+// applyFieldSelection clears the fields of v that are not in selection, so that
+// they are left out of the response. It does nothing when selection is nil.
+func applyFieldSelection[T any](v *T, selection fieldSelection) {
+	if v == nil || selection == nil {
+		return
+	}
+	value := reflect.ValueOf(v).Elem()
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+		if field.IsExported() && !selection[apiFieldName(field.Name)] {
+			value.Field(i).SetZero()
+		}
+	}
+}
+
+// apiFieldNames returns the API field names of the struct type t.
+func apiFieldNames(t reflect.Type) map[string]bool {
+	names := make(map[string]bool, t.NumField())
+	for i := range t.NumField() {
+		if field := t.Field(i); field.IsExported() {
+			names[apiFieldName(field.Name)] = true
+		}
+	}
+	return names
+}
+
+// apiFieldName returns the API name of a field of a generated type from its Go
+// name, undoing the naming of the generator: LogoURL is logo_url and
+// ParentUID is parent_uid.
+func apiFieldName(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
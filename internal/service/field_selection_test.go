@@ -0,0 +1,85 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/http/project_service/server"
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+func TestAPIFieldName(t *testing.T) {
+	tests := map[string]string{
+		"UID":                        "uid",
+		"Name":                       "name",
+		"ParentUID":                  "parent_uid",
+		"LogoURL":                    "logo_url",
+		"PngLogoURL":                 "png_logo_url",
+		"OverviewHTML":               "overview_html",
+		"EntityFormationDocumentURL": "entity_formation_document_url",
+		"IsFoundation":               "is_foundation",
+	}
+	for name, expected := range tests {
+		assert.Equal(t, expected, apiFieldName(name))
+	}
+}
+
+func TestParseFieldSelection(t *testing.T) {
+	t.Run("no fields selects every field", func(t *testing.T) {
+		selection, err := parseFieldSelection[projsvc.ProjectFull](misc.StringPtr(" "))
+		require.NoError(t, err)
+		assert.Nil(t, selection)
+	})
+
+	t.Run("known fields", func(t *testing.T) {
+		selection, err := parseFieldSelection[projsvc.ProjectFull](misc.StringPtr("uid, name,logo_url,"))
+		require.NoError(t, err)
+		assert.Equal(t, fieldSelection{"uid": true, "name": true, "logo_url": true}, selection)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := parseFieldSelection[projsvc.ProjectBase](misc.StringPtr("uid,writers"))
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Len(t, validationErr.Fields, 1)
+		assert.Equal(t, "fields", validationErr.Fields[0].Path)
+	})
+
+	t.Run("field names match the response bodies", func(t *testing.T) {
+		for _, body := range []reflect.Type{
+			reflect.TypeFor[server.ProjectFullResponseBody](),
+			reflect.TypeFor[server.ProjectBaseResponseBody](),
+		} {
+			for i := range body.NumField() {
+				name, _, _ := strings.Cut(body.Field(i).Tag.Get("json"), ",")
+				assert.Equal(t, name, apiFieldName(body.Field(i).Name))
+			}
+		}
+	})
+}
+
+func TestApplyFieldSelection(t *testing.T) {
+	project := &projsvc.ProjectFull{
+		UID:     misc.StringPtr("p1"),
+		Name:    misc.StringPtr("Project"),
+		Slug:    misc.StringPtr("project"),
+		LogoURL: misc.StringPtr("https://example.com/logo.png"),
+		Tags:    []string{"cncf"},
+	}
+
+	applyFieldSelection(project, fieldSelection{"uid": true, "logo_url": true})
+
+	assert.Equal(t, &projsvc.ProjectFull{
+		UID:     misc.StringPtr("p1"),
+		LogoURL: misc.StringPtr("https://example.com/logo.png"),
+	}, project)
+}
@@ -113,7 +113,7 @@ func (s *ProjectsService) GetFolder(ctx context.Context, projectUID, folderUID s
 
 // DeleteFolder deletes a project folder with optimistic concurrency.
 // Returns ErrFolderNotEmpty if the folder still has links or documents.
-func (s *ProjectsService) DeleteFolder(ctx context.Context, projectUID, folderUID string, ifMatch *string, xSync bool) error {
+func (s *ProjectsService) DeleteFolder(ctx context.Context, projectUID, folderUID string, ifMatch *string, forceUpdate, xSync bool) error {
 	if !s.ServiceReady() {
 		slog.ErrorContext(ctx, "service not ready")
 		return domain.ErrServiceUnavailable
@@ -122,24 +122,12 @@ func (s *ProjectsService) DeleteFolder(ctx context.Context, projectUID, folderUI
 	ctx = log.AppendCtx(ctx, slog.String("project_uid", projectUID))
 	ctx = log.AppendCtx(ctx, slog.String("folder_uid", folderUID))
 
-	var revision uint64
-	var err error
-
-	if !s.Config.SkipEtagValidation {
-		if ifMatch == nil {
-			slog.WarnContext(ctx, "If-Match header is missing")
-			return domain.ErrValidationFailed
-		}
-		revision, err = strconv.ParseUint(*ifMatch, 10, 64)
-		if err != nil {
-			slog.ErrorContext(ctx, "error parsing If-Match header", constants.ErrKey, err)
-			return domain.ErrValidationFailed
-		}
-	} else {
-		_, revision, err = s.FolderRepository.GetFolder(ctx, projectUID, folderUID)
-		if err != nil {
-			return err
-		}
+	revision, err := s.ifMatchRevision(ctx, "folder:"+folderUID, ifMatch, forceUpdate, func() (uint64, error) {
+		_, revision, err := s.FolderRepository.GetFolder(ctx, projectUID, folderUID)
+		return revision, err
+	})
+	if err != nil {
+		return err
 	}
 
 	// Block deletion if the folder still has links.
@@ -234,7 +234,7 @@ func TestProjectsService_DeleteFolder(t *testing.T) {
 			mockDoc := svc.DocumentRepository.(*domain.MockDocumentRepository)
 			tt.setupMocks(mockFolder, mockLink, mockDoc, mockMsg)
 
-			err := svc.DeleteFolder(context.Background(), tt.projectUID, tt.folderUID, tt.ifMatch, false)
+			err := svc.DeleteFolder(context.Background(), tt.projectUID, tt.folderUID, tt.ifMatch, false, false)
 
 			if tt.wantErr != nil {
 				assert.ErrorIs(t, err, tt.wantErr)
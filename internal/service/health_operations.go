@@ -0,0 +1,122 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+const (
+	// healthStatusOK is the status reported for a healthy dependency or service.
+	healthStatusOK = "ok"
+	// healthStatusError is the status reported for an unhealthy dependency or service.
+	healthStatusError = "error"
+	// healthCheckTimeout bounds each dependency check so one hung dependency cannot stall the report.
+	healthCheckTimeout = 2 * time.Second
+)
+
+// healthCheck is a named dependency check included in the health report.
+type healthCheck struct {
+	name    string
+	checker domain.HealthChecker
+}
+
+// RegisterHealthCheck adds a named dependency check to the health report.
+// It is safe to call while health requests are being served.
+func (s *ProjectsService) RegisterHealthCheck(name string, checker domain.HealthChecker) {
+	s.healthChecksMu.Lock()
+	defer s.healthChecksMu.Unlock()
+	s.healthChecks = append(s.healthChecks, healthCheck{name: name, checker: checker})
+}
+
+// CheckHealth runs every registered dependency check concurrently and returns a report
+// with the status and latency of each. The boolean result is true only when the service
+// is ready and every dependency is healthy.
+func (s *ProjectsService) CheckHealth(ctx context.Context) (*projsvc.HealthReport, bool) {
+	s.healthChecksMu.RLock()
+	registered := append([]healthCheck(nil), s.healthChecks...)
+	s.healthChecksMu.RUnlock()
+
+	checks := make([]*projsvc.DependencyHealth, len(registered))
+
+	var wg sync.WaitGroup
+	for i, hc := range registered {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checks[i] = runHealthCheck(ctx, hc)
+		}()
+	}
+	wg.Wait()
+
+	healthy := s.ServiceReady()
+	if !healthy {
+		reason := domain.ErrServiceUnavailable.Error()
+		checks = append([]*projsvc.DependencyHealth{{
+			Name:   "service",
+			Status: healthStatusError,
+			Error:  &reason,
+		}}, checks...)
+	}
+	for _, check := range checks {
+		if check.Status != healthStatusOK {
+			healthy = false
+		}
+	}
+
+	status := healthStatusOK
+	if !healthy {
+		status = healthStatusError
+	}
+
+	return &projsvc.HealthReport{
+		Status: status,
+		Checks: checks,
+	}, healthy
+}
+
+// CheckReadiness returns nil when the service may receive traffic: its dependencies
+// are set up and, when a ReadinessChecker is set, its last probes succeeded.
+func (s *ProjectsService) CheckReadiness() error {
+	if !s.ServiceReady() {
+		return domain.ErrServiceUnavailable
+	}
+	if s.ReadinessChecker != nil {
+		if err := s.ReadinessChecker.Ready(); err != nil {
+			return fmt.Errorf("%w: %w", domain.ErrServiceUnavailable, err)
+		}
+	}
+	return nil
+}
+
+// runHealthCheck runs a single dependency check under healthCheckTimeout and records its latency.
+func runHealthCheck(ctx context.Context, hc healthCheck) *projsvc.DependencyHealth {
+	checkCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := hc.checker.HealthCheck(checkCtx)
+	latency := time.Since(start)
+
+	result := &projsvc.DependencyHealth{
+		Name:      hc.name,
+		Status:    healthStatusOK,
+		LatencyMs: float64(latency.Microseconds()) / 1000,
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "dependency health check failed", "dependency", hc.name, constants.ErrKey, err)
+		reason := err.Error()
+		result.Status = healthStatusError
+		result.Error = &reason
+	}
+	return result
+}
@@ -0,0 +1,158 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectsService_CheckHealth(t *testing.T) {
+	healthy := domain.HealthCheckFunc(func(context.Context) error { return nil })
+	failing := domain.HealthCheckFunc(func(context.Context) error { return errors.New("connection refused") })
+
+	readyService := func() *ProjectsService {
+		return &ProjectsService{
+			ProjectRepository:  &domain.MockProjectRepository{},
+			DocumentRepository: &domain.MockDocumentRepository{},
+			LinkRepository:     &domain.MockLinkRepository{},
+			FolderRepository:   &domain.MockFolderRepository{},
+			MessageBuilder:     &domain.MockMessageBuilder{},
+			Auth:               &auth.MockJWTAuth{},
+			UserReader:         &domain.MockUserReader{},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		setupService    func() *ProjectsService
+		expectedHealthy bool
+		expectedStatus  string
+		expectedChecks  map[string]string
+	}{
+		{
+			name: "all dependencies healthy",
+			setupService: func() *ProjectsService {
+				s := readyService()
+				s.RegisterHealthCheck("nats", healthy)
+				s.RegisterHealthCheck("kv:projects", healthy)
+				return s
+			},
+			expectedHealthy: true,
+			expectedStatus:  healthStatusOK,
+			expectedChecks: map[string]string{
+				"nats":        healthStatusOK,
+				"kv:projects": healthStatusOK,
+			},
+		},
+		{
+			name: "one dependency unhealthy",
+			setupService: func() *ProjectsService {
+				s := readyService()
+				s.RegisterHealthCheck("nats", healthy)
+				s.RegisterHealthCheck("kv:projects", failing)
+				return s
+			},
+			expectedHealthy: false,
+			expectedStatus:  healthStatusError,
+			expectedChecks: map[string]string{
+				"nats":        healthStatusOK,
+				"kv:projects": healthStatusError,
+			},
+		},
+		{
+			name: "service not ready",
+			setupService: func() *ProjectsService {
+				s := &ProjectsService{}
+				s.RegisterHealthCheck("nats", healthy)
+				return s
+			},
+			expectedHealthy: false,
+			expectedStatus:  healthStatusError,
+			expectedChecks: map[string]string{
+				"service": healthStatusError,
+				"nats":    healthStatusOK,
+			},
+		},
+		{
+			name:            "no registered checks",
+			setupService:    readyService,
+			expectedHealthy: true,
+			expectedStatus:  healthStatusOK,
+			expectedChecks:  map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := tt.setupService()
+
+			report, healthy := service.CheckHealth(context.Background())
+
+			require.NotNil(t, report)
+			assert.Equal(t, tt.expectedHealthy, healthy)
+			assert.Equal(t, tt.expectedStatus, report.Status)
+			require.Len(t, report.Checks, len(tt.expectedChecks))
+			for _, check := range report.Checks {
+				expected, ok := tt.expectedChecks[check.Name]
+				require.True(t, ok, "unexpected check %q", check.Name)
+				assert.Equal(t, expected, check.Status)
+				if expected == healthStatusOK {
+					assert.Nil(t, check.Error)
+				} else {
+					assert.NotNil(t, check.Error)
+				}
+				assert.GreaterOrEqual(t, check.LatencyMs, float64(0))
+			}
+		})
+	}
+}
+
+func TestProjectsService_CheckReadiness(t *testing.T) {
+	readyService := func() *ProjectsService {
+		return &ProjectsService{
+			ProjectRepository:  &domain.MockProjectRepository{},
+			DocumentRepository: &domain.MockDocumentRepository{},
+			LinkRepository:     &domain.MockLinkRepository{},
+			FolderRepository:   &domain.MockFolderRepository{},
+			MessageBuilder:     &domain.MockMessageBuilder{},
+			UserReader:         &domain.MockUserReader{},
+		}
+	}
+
+	t.Run("ready without a readiness checker", func(t *testing.T) {
+		assert.NoError(t, readyService().CheckReadiness())
+	})
+
+	t.Run("not ready when dependencies are missing", func(t *testing.T) {
+		assert.ErrorIs(t, (&ProjectsService{}).CheckReadiness(), domain.ErrServiceUnavailable)
+	})
+
+	t.Run("ready while the probes succeed", func(t *testing.T) {
+		readiness := &domain.MockReadinessChecker{}
+		readiness.On("Ready").Return(nil)
+		service := readyService()
+		service.ReadinessChecker = readiness
+
+		assert.NoError(t, service.CheckReadiness())
+	})
+
+	t.Run("not ready while the probes fail", func(t *testing.T) {
+		probeErr := errors.New("KV bucket: timeout")
+		readiness := &domain.MockReadinessChecker{}
+		readiness.On("Ready").Return(probeErr)
+		service := readyService()
+		service.ReadinessChecker = readiness
+
+		err := service.CheckReadiness()
+		assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+		assert.ErrorIs(t, err, probeErr)
+	})
+}
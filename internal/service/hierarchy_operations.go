@@ -0,0 +1,164 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// ListProjectChildren returns a page of the direct children of the project
+// projectUID that the principal can view.
+func (s *ProjectsService) ListProjectChildren(ctx context.Context, projectUID string, request models.ProjectPageRequest) (*models.ProjectPage, error) {
+	ctx, span := startSpan(ctx, "ProjectsService.ListProjectChildren", projectUIDAttr(projectUID), attribute.String("sort", request.Sort))
+	defer span.End()
+
+	page, err := s.listProjectHierarchy(ctx, projectUID, request, false)
+	recordSpanError(span, err)
+	return page, err
+}
+
+// ListProjectDescendants returns a page of the projects under the project
+// projectUID, at any depth, that the principal can view.
+func (s *ProjectsService) ListProjectDescendants(ctx context.Context, projectUID string, request models.ProjectPageRequest) (*models.ProjectPage, error) {
+	ctx, span := startSpan(ctx, "ProjectsService.ListProjectDescendants", projectUIDAttr(projectUID), attribute.String("sort", request.Sort))
+	defer span.End()
+
+	page, err := s.listProjectHierarchy(ctx, projectUID, request, true)
+	recordSpanError(span, err)
+	return page, err
+}
+
+func (s *ProjectsService) listProjectHierarchy(ctx context.Context, projectUID string, request models.ProjectPageRequest, descendants bool) (*models.ProjectPage, error) {
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+	var cursor *projectPageCursor
+	if request.PageToken != "" {
+		var err error
+		if cursor, err = decodeProjectPageToken(request); err != nil {
+			slog.WarnContext(ctx, "invalid page token", constants.ErrKey, err)
+			return nil, domain.NewFieldValidationError("page_token", domain.FieldErrorInvalid, domain.ErrInvalidPageToken)
+		}
+	}
+
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", projectUID))
+
+	if _, err := s.ProjectRepository.GetProjectBase(ctx, projectUID); err != nil {
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			return nil, domain.ErrProjectNotFound
+		}
+		slog.ErrorContext(ctx, "error getting project from store", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+	projects, err := s.ProjectRepository.ListAllProjectsBase(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "error listing projects for the project hierarchy", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+
+	var members []*models.ProjectBase
+	if descendants {
+		members = descendantBases(projects, projectUID)
+	} else {
+		for _, project := range projects {
+			if project.ParentUID == projectUID && project.UID != projectUID {
+				members = append(members, project)
+			}
+		}
+	}
+
+	// Projects are ordered by their sort key, then UID, so that every project
+	// has a distinct position that a cursor can resume after.
+	entries := make([]projectPageCursor, len(members))
+	byUID := make(map[string]*models.ProjectBase, len(members))
+	for i, project := range members {
+		entries[i] = projectPageCursor{
+			Sort:       request.Sort,
+			Descending: request.Descending,
+			Key:        models.ProjectSortKey(project, request.Sort),
+			UID:        project.UID,
+		}
+		byUID[project.UID] = project
+	}
+	slices.SortFunc(entries, projectPageCursor.compare)
+
+	page := &models.ProjectPage{Projects: []*models.ProjectBase{}}
+	var last projectPageCursor
+	for _, entry := range entries {
+		if cursor != nil && entry.compare(*cursor) <= 0 {
+			continue
+		}
+		project := byUID[entry.UID]
+		ok, err := s.canViewProject(ctx, project.UID, project.Public)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		if len(page.Projects) == request.PageSize {
+			page.NextPageToken = last.encode()
+			break
+		}
+		page.Projects = append(page.Projects, project)
+		last = entry
+	}
+	return page, nil
+}
+
+// projectPageCursor is the position of a project in a sorted list of
+// projects; a page token holds the position of the last project of a page.
+type projectPageCursor struct {
+	Sort       string `json:"s"`
+	Descending bool   `json:"d,omitempty"`
+	Key        string `json:"k"`
+	UID        string `json:"u"`
+}
+
+// compare orders c before other when it sorts first in c's order.
+func (c projectPageCursor) compare(other projectPageCursor) int {
+	order := cmp.Or(strings.Compare(c.Key, other.Key), strings.Compare(c.UID, other.UID))
+	if c.Descending {
+		return -order
+	}
+	return order
+}
+
+// encode returns the page token of the page that starts after c.
+func (c projectPageCursor) encode() string {
+	token, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(token)
+}
+
+// decodeProjectPageToken returns the cursor of the request's page token,
+// which must have been issued for the same sort and order.
+func decodeProjectPageToken(request models.ProjectPageRequest) (*projectPageCursor, error) {
+	token, err := base64.RawURLEncoding.DecodeString(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	var cursor projectPageCursor
+	if err := json.Unmarshal(token, &cursor); err != nil {
+		return nil, err
+	}
+	if cursor.Sort != request.Sort || cursor.Descending != request.Descending || cursor.UID == "" {
+		return nil, domain.ErrInvalidPageToken
+	}
+	return &cursor, nil
+}
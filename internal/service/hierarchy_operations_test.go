@@ -0,0 +1,143 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+// hierarchyProjects returns a foundation with five public children, one of
+// which has children of its own, and a private child.
+func hierarchyProjects() []*models.ProjectBase {
+	at := func(day int) *time.Time {
+		t := time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	return []*models.ProjectBase{
+		{UID: "root", Slug: "root", Name: "Root", Public: true},
+		{UID: "u-delta", Name: "Delta", ParentUID: "root", Public: true, Stage: "Active", CreatedAt: at(4)},
+		{UID: "u-alpha", Name: "alpha", ParentUID: "root", Public: true, Stage: "Incubating", CreatedAt: at(3)},
+		{UID: "u-charlie", Name: "Charlie", ParentUID: "root", Public: true, Stage: "Active", CreatedAt: at(1)},
+		{UID: "u-bravo", Name: "Bravo", ParentUID: "root", Public: true, Stage: "Archived", CreatedAt: at(5)},
+		{UID: "u-bravo-2", Name: "Bravo", ParentUID: "root", Public: true, Stage: "Active", CreatedAt: at(2)},
+		{UID: "u-private", Name: "Echo", ParentUID: "root"},
+		{UID: "u-grandchild", Name: "Foxtrot", ParentUID: "u-alpha", Public: true},
+		{UID: "other", Name: "Other", Public: true},
+	}
+}
+
+func projectUIDs(projects []*models.ProjectBase) []string {
+	uids := make([]string, len(projects))
+	for i, project := range projects {
+		uids[i] = project.UID
+	}
+	return uids
+}
+
+func TestProjectsService_ListProjectChildren(t *testing.T) {
+	tests := []struct {
+		name       string
+		sort       string
+		descending bool
+		expected   []string
+	}{
+		{
+			name:     "by name, ties broken by UID",
+			sort:     models.ProjectSortName,
+			expected: []string{"u-alpha", "u-bravo", "u-bravo-2", "u-charlie", "u-delta"},
+		},
+		{
+			name:       "by name descending",
+			sort:       models.ProjectSortName,
+			descending: true,
+			expected:   []string{"u-delta", "u-charlie", "u-bravo-2", "u-bravo", "u-alpha"},
+		},
+		{
+			name:     "by creation time",
+			sort:     models.ProjectSortCreatedAt,
+			expected: []string{"u-charlie", "u-bravo-2", "u-alpha", "u-delta", "u-bravo"},
+		},
+		{
+			name:     "by stage",
+			sort:     models.ProjectSortStage,
+			expected: []string{"u-bravo-2", "u-charlie", "u-delta", "u-bravo", "u-alpha"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := setupServiceForTesting()
+			mockRepo.On("GetProjectBase", mock.Anything, "root").Return(&models.ProjectBase{UID: "root"}, nil)
+			mockRepo.On("ListAllProjectsBase", mock.Anything).Return(hierarchyProjects(), nil)
+
+			// Pages of two are read until the last one, which has no next page token.
+			request := models.ProjectPageRequest{Sort: tt.sort, Descending: tt.descending, PageSize: 2}
+			var uids []string
+			for pages := 1; ; pages++ {
+				page, err := service.ListProjectChildren(context.Background(), "root", request)
+				require.NoError(t, err)
+				uids = append(uids, projectUIDs(page.Projects)...)
+				if page.NextPageToken == "" {
+					assert.Equal(t, 3, pages)
+					break
+				}
+				request.PageToken = page.NextPageToken
+			}
+
+			assert.Equal(t, tt.expected, uids)
+		})
+	}
+}
+
+func TestProjectsService_ListProjectDescendants(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	mockRepo.On("GetProjectBase", mock.Anything, "root").Return(&models.ProjectBase{UID: "root"}, nil)
+	mockRepo.On("ListAllProjectsBase", mock.Anything).Return(hierarchyProjects(), nil)
+
+	page, err := service.ListProjectDescendants(staffContext(), "root", models.ProjectPageRequest{Sort: models.ProjectSortName, PageSize: 10})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u-alpha", "u-bravo", "u-bravo-2", "u-charlie", "u-delta", "u-private", "u-grandchild"}, projectUIDs(page.Projects))
+	assert.Empty(t, page.NextPageToken)
+}
+
+func TestProjectsService_ListProjectChildren_invalidPageToken(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	mockRepo.On("GetProjectBase", mock.Anything, "root").Return(&models.ProjectBase{UID: "root"}, nil)
+	mockRepo.On("ListAllProjectsBase", mock.Anything).Return(hierarchyProjects(), nil)
+
+	page, err := service.ListProjectChildren(context.Background(), "root", models.ProjectPageRequest{Sort: models.ProjectSortName, PageSize: 1})
+	require.NoError(t, err)
+	require.NotEmpty(t, page.NextPageToken)
+
+	// A token is only valid for the sort and order it was issued for.
+	_, err = service.ListProjectChildren(context.Background(), "root", models.ProjectPageRequest{
+		Sort: models.ProjectSortStage, PageSize: 1, PageToken: page.NextPageToken,
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken)
+
+	_, err = service.ListProjectChildren(context.Background(), "root", models.ProjectPageRequest{
+		Sort: models.ProjectSortName, PageSize: 1, PageToken: "not a token",
+	})
+	assert.ErrorIs(t, err, domain.ErrValidationFailed)
+}
+
+func TestProjectsService_ListProjectChildren_notFound(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	mockRepo.On("GetProjectBase", mock.Anything, "missing").Return(nil, domain.ErrProjectNotFound)
+
+	_, err := service.ListProjectChildren(context.Background(), "missing", models.ProjectPageRequest{Sort: models.ProjectSortName, PageSize: 10})
+
+	assert.ErrorIs(t, err, domain.ErrProjectNotFound)
+	mockRepo.AssertNotCalled(t, "ListAllProjectsBase", mock.Anything)
+}
@@ -0,0 +1,100 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/metrics"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// ScanIntegrity scans the project store for inconsistencies without repairing
+// them, and keeps the report for GetIntegrityReport. The number of
+// inconsistencies of each kind is exported as metrics.
+func (s *ProjectsService) ScanIntegrity(ctx context.Context) (*models.IntegrityReport, error) {
+	if s.ConsistencyChecker == nil {
+		slog.ErrorContext(ctx, "the project store cannot be scanned for inconsistencies")
+		return nil, domain.ErrServiceUnavailable
+	}
+
+	ctx, span := startSpan(ctx, "ProjectsService.ScanIntegrity")
+	defer span.End()
+
+	scannedAt := time.Now().UTC()
+	consistency, err := s.ConsistencyChecker.CheckConsistency(ctx, false)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	report := &models.IntegrityReport{ConsistencyReport: *consistency, ScannedAt: scannedAt}
+
+	metrics.SetIntegrityIssues(map[string]int{
+		"dangling_slug_mappings":      len(report.DanglingSlugMappings),
+		"missing_slug_mappings":       len(report.MissingSlugMappings),
+		"dangling_legacy_id_mappings": len(report.DanglingLegacyIDMappings),
+		"missing_legacy_id_mappings":  len(report.MissingLegacyIDMappings),
+		"orphaned_settings":           len(report.OrphanedSettings),
+		"orphaned_children":           len(report.OrphanedChildren),
+		"invalid_entries":             len(report.InvalidEntries),
+	}, scannedAt)
+	if report.Found() > 0 {
+		slog.WarnContext(ctx, "integrity scan found inconsistencies",
+			"dangling_slug_mappings", report.DanglingSlugMappings,
+			"missing_slug_mappings", report.MissingSlugMappings,
+			"dangling_legacy_id_mappings", report.DanglingLegacyIDMappings,
+			"missing_legacy_id_mappings", report.MissingLegacyIDMappings,
+			"orphaned_settings", report.OrphanedSettings,
+			"orphaned_children", report.OrphanedChildren,
+			"invalid_entries", report.InvalidEntries,
+		)
+	} else {
+		slog.DebugContext(ctx, "integrity scan found no inconsistencies")
+	}
+
+	s.integrityMu.Lock()
+	s.integrityReport = report
+	s.integrityMu.Unlock()
+	return report, nil
+}
+
+// GetIntegrityReport returns the report of the last integrity scan, scanning
+// the project store first when it was not scanned yet, to LF staff or a
+// trusted service principal.
+func (s *ProjectsService) GetIntegrityReport(ctx context.Context) (*models.IntegrityReport, error) {
+	if s.ConsistencyChecker == nil {
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "get integrity report"); err != nil {
+		return nil, err
+	}
+	s.integrityMu.Lock()
+	report := s.integrityReport
+	s.integrityMu.Unlock()
+	if report != nil {
+		return report, nil
+	}
+	return s.ScanIntegrity(ctx)
+}
+
+// RunIntegrityScanner calls ScanIntegrity every interval until ctx is cancelled.
+func (s *ProjectsService) RunIntegrityScanner(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.ScanIntegrity(ctx); err != nil {
+				slog.ErrorContext(ctx, "error scanning project store integrity", constants.ErrKey, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+func TestProjectsService_GetIntegrityReport(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+	checker := &domain.MockConsistencyChecker{}
+	service.ConsistencyChecker = checker
+	consistency := &models.ConsistencyReport{OrphanedChildren: []string{"project-2"}, InvalidEntries: []string{"projects/project-3"}}
+	checker.On("CheckConsistency", mock.Anything, false).Return(consistency, nil).Once()
+
+	// The first read scans the store; later reads return the same report
+	// until the next scan.
+	report, err := service.GetIntegrityReport(staffContext())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"project-2"}, report.OrphanedChildren)
+	assert.Equal(t, 2, report.Found())
+	assert.False(t, report.ScannedAt.IsZero())
+
+	again, err := service.GetIntegrityReport(staffContext())
+	require.NoError(t, err)
+	assert.Same(t, report, again)
+	checker.AssertExpectations(t)
+
+	checker.On("CheckConsistency", mock.Anything, false).Return(&models.ConsistencyReport{}, nil).Once()
+	scanned, err := service.ScanIntegrity(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, scanned.Found())
+
+	latest, err := service.GetIntegrityReport(staffContext())
+	require.NoError(t, err)
+	assert.Same(t, scanned, latest)
+}
+
+func TestProjectsService_GetIntegrityReport_forbidden(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+	checker := &domain.MockConsistencyChecker{}
+	service.ConsistencyChecker = checker
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user-1")
+
+	_, err := service.GetIntegrityReport(ctx)
+
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	checker.AssertNotCalled(t, "CheckConsistency", mock.Anything, mock.Anything)
+}
+
+func TestProjectsService_GetIntegrityReport_unavailable(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+
+	_, err := service.GetIntegrityReport(context.Background())
+
+	assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+}
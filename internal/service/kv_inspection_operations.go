@@ -0,0 +1,39 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+)
+
+// InspectProjectKV returns the entries stored for the project's base and
+// settings as they are, with their revisions and retained history, so that
+// LF staff can debug stale documents without the nats CLI.
+func (s *ProjectsService) InspectProjectKV(ctx context.Context, projectUID string) (*models.ProjectKVInspection, error) {
+	if s.KVInspector == nil {
+		slog.ErrorContext(ctx, "KV inspection not available, projects are not stored in NATS")
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "inspect project KV"); err != nil {
+		return nil, err
+	}
+
+	ctx, span := startSpan(ctx, "ProjectsService.InspectProjectKV", attribute.String("project.uid", projectUID))
+	defer span.End()
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", projectUID))
+
+	inspection, err := s.KVInspector.InspectProjectKV(ctx, projectUID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	return inspection, nil
+}
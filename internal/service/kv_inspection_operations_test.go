@@ -0,0 +1,64 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+func TestProjectsService_InspectProjectKV(t *testing.T) {
+	t.Run("entries of the project", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+		inspector := &domain.MockKVInspector{}
+		service.KVInspector = inspector
+		expected := &models.ProjectKVInspection{ProjectUID: "project-1"}
+		inspector.On("InspectProjectKV", mock.Anything, "project-1").Return(expected, nil)
+
+		inspection, err := service.InspectProjectKV(staffContext(), "project-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, inspection)
+		inspector.AssertExpectations(t)
+	})
+
+	t.Run("project not found", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+		inspector := &domain.MockKVInspector{}
+		service.KVInspector = inspector
+		inspector.On("InspectProjectKV", mock.Anything, "project-1").Return(nil, domain.ErrProjectNotFound)
+
+		_, err := service.InspectProjectKV(staffContext(), "project-1")
+
+		assert.ErrorIs(t, err, domain.ErrProjectNotFound)
+	})
+
+	t.Run("not LF staff", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+		inspector := &domain.MockKVInspector{}
+		service.KVInspector = inspector
+		ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user-1")
+
+		_, err := service.InspectProjectKV(ctx, "project-1")
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		inspector.AssertNotCalled(t, "InspectProjectKV", mock.Anything, mock.Anything)
+	})
+
+	t.Run("projects not stored in NATS", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+
+		_, err := service.InspectProjectKV(staffContext(), "project-1")
+
+		assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+	})
+}
@@ -0,0 +1,158 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// legalParentEntityTypes are the legal entity types of the projects that can
+// be the legal parent of another project.
+var legalParentEntityTypes = []string{"Incorporated Entity", "Series LLC"}
+
+// GetProjectLegalLineage returns the chain of legal parents of the project
+// projectUID: its legal parent, that project's legal parent, and so on. Legal
+// parents the principal may not view are left out.
+func (s *ProjectsService) GetProjectLegalLineage(ctx context.Context, projectUID string) (*models.ProjectLegalLineage, error) {
+	ctx, span := startSpan(ctx, "ProjectsService.GetProjectLegalLineage", projectUIDAttr(projectUID))
+	defer span.End()
+
+	lineage, err := s.getProjectLegalLineage(ctx, projectUID)
+	recordSpanError(span, err)
+	return lineage, err
+}
+
+func (s *ProjectsService) getProjectLegalLineage(ctx context.Context, projectUID string) (*models.ProjectLegalLineage, error) {
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", projectUID))
+
+	legalParents, complete, err := s.legalParents(ctx, projectUID)
+	if err != nil {
+		return nil, err
+	}
+
+	lineage := &models.ProjectLegalLineage{
+		ProjectUID:   projectUID,
+		LegalParents: []models.LegalLineageProject{},
+		Complete:     complete,
+	}
+	for _, legalParent := range legalParents {
+		ok, err := s.canViewProject(ctx, legalParent.UID, legalParent.Public)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		lineage.LegalParents = append(lineage.LegalParents, models.LegalLineageProject{
+			UID:             legalParent.UID,
+			Slug:            legalParent.Slug,
+			Name:            legalParent.Name,
+			LegalEntityType: legalParent.LegalEntityType,
+			LegalEntityName: legalParent.LegalEntityName,
+		})
+	}
+	return lineage, nil
+}
+
+// legalParents returns the legal parents of the project projectUID, nearest
+// first, and whether the chain ends at a project without a legal parent
+// rather than at a missing or repeated one.
+func (s *ProjectsService) legalParents(ctx context.Context, projectUID string) ([]*models.ProjectBase, bool, error) {
+	project, err := s.ProjectRepository.GetProjectBase(ctx, projectUID)
+	if err != nil {
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			return nil, false, domain.ErrProjectNotFound
+		}
+		slog.ErrorContext(ctx, "error getting project from store", constants.ErrKey, err)
+		return nil, false, domain.ErrInternal
+	}
+
+	var legalParents []*models.ProjectBase
+	visited := map[string]bool{projectUID: true}
+	for legalParentUID := project.LegalParentUID; legalParentUID != ""; {
+		if visited[legalParentUID] {
+			slog.WarnContext(ctx, "legal lineage of the project contains a cycle",
+				slog.String("legal_parent_uid", legalParentUID),
+			)
+			return legalParents, false, nil
+		}
+		visited[legalParentUID] = true
+
+		legalParent, err := s.ProjectRepository.GetProjectBase(ctx, legalParentUID)
+		if err != nil {
+			if errors.Is(err, domain.ErrProjectNotFound) {
+				// A dangling legal parent reference ends the chain.
+				slog.WarnContext(ctx, "legal parent of the legal lineage not found",
+					slog.String("legal_parent_uid", legalParentUID),
+				)
+				return legalParents, false, nil
+			}
+			slog.ErrorContext(ctx, "error getting legal parent project", constants.ErrKey, err, "legal_parent_uid", legalParentUID)
+			return nil, false, domain.ErrInternal
+		}
+		legalParents = append(legalParents, legalParent)
+		legalParentUID = legalParent.LegalParentUID
+	}
+	return legalParents, true, nil
+}
+
+// checkLegalParent checks that the project legalParentUID may be the legal
+// parent of the project projectUID, which is empty for a new project: the
+// legal parent exists, its legal entity is an Incorporated Entity or a Series
+// LLC, and it does not have the project in its own legal lineage.
+func (s *ProjectsService) checkLegalParent(ctx context.Context, projectUID, legalParentUID string) error {
+	if legalParentUID == projectUID {
+		slog.WarnContext(ctx, "project cannot be its own legal parent")
+		return domain.NewFieldValidationError("legal_parent_uid", domain.FieldErrorInvalid, domain.ErrInvalidLegalParent)
+	}
+
+	legalParent, err := s.ProjectRepository.GetProjectBase(ctx, legalParentUID)
+	if err != nil {
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			slog.WarnContext(ctx, "legal parent project not found", slog.String("legal_parent_uid", legalParentUID))
+			return domain.NewFieldValidationError("legal_parent_uid", domain.FieldErrorNotFound, domain.ErrInvalidLegalParent)
+		}
+		slog.ErrorContext(ctx, "error getting legal parent project", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+	if !slices.Contains(legalParentEntityTypes, legalParent.LegalEntityType) {
+		slog.WarnContext(ctx, "legal parent project cannot be a legal parent",
+			slog.String("legal_parent_uid", legalParentUID),
+			slog.String("legal_entity_type", legalParent.LegalEntityType),
+		)
+		return domain.NewFieldValidationError("legal_parent_uid", domain.FieldErrorInvalid, domain.ErrInvalidLegalParent)
+	}
+	if projectUID == "" {
+		return nil
+	}
+
+	// A project with the project in its legal lineage would make the
+	// project its own legal ancestor.
+	ancestors, _, err := s.legalParents(ctx, legalParentUID)
+	if err != nil {
+		return err
+	}
+	for _, ancestor := range ancestors {
+		if ancestor.UID == projectUID {
+			slog.WarnContext(ctx, "legal parent would create a legal lineage cycle",
+				slog.String("legal_parent_uid", legalParentUID),
+			)
+			return domain.NewFieldValidationError("legal_parent_uid", domain.FieldErrorInvalid, domain.ErrInvalidLegalParent)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+func TestProjectsService_GetProjectLegalLineage(t *testing.T) {
+	tests := []struct {
+		name       string
+		projects   []*models.ProjectBase
+		expected   []string
+		incomplete bool
+	}{
+		{
+			name: "legal parents up to a project without one",
+			projects: []*models.ProjectBase{
+				{UID: "project", LegalParentUID: "series"},
+				{UID: "series", Slug: "series", LegalEntityType: "Series LLC", LegalParentUID: "foundation"},
+				{UID: "foundation", Slug: "foundation", LegalEntityType: "Incorporated Entity"},
+			},
+			expected: []string{"series", "foundation"},
+		},
+		{
+			name: "project without a legal parent",
+			projects: []*models.ProjectBase{
+				{UID: "project"},
+			},
+			expected: []string{},
+		},
+		{
+			name: "missing legal parent ends the chain",
+			projects: []*models.ProjectBase{
+				{UID: "project", LegalParentUID: "series"},
+				{UID: "series", LegalEntityType: "Series LLC", LegalParentUID: "deleted"},
+			},
+			expected:   []string{"series"},
+			incomplete: true,
+		},
+		{
+			name: "looping chain ends at the first repeated project",
+			projects: []*models.ProjectBase{
+				{UID: "project", LegalParentUID: "a"},
+				{UID: "a", LegalEntityType: "Series LLC", LegalParentUID: "b"},
+				{UID: "b", LegalEntityType: "Series LLC", LegalParentUID: "a"},
+			},
+			expected:   []string{"a", "b"},
+			incomplete: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo, _, _ := setupServiceForTesting()
+			for _, project := range tt.projects {
+				repo.On("GetProjectBase", mock.Anything, project.UID).Return(project, nil)
+			}
+			repo.On("GetProjectBase", mock.Anything, mock.Anything).Return(nil, domain.ErrProjectNotFound)
+
+			lineage, err := service.GetProjectLegalLineage(staffContext(), "project")
+			require.NoError(t, err)
+
+			uids := []string{}
+			for _, legalParent := range lineage.LegalParents {
+				uids = append(uids, legalParent.UID)
+			}
+			assert.Equal(t, tt.expected, uids)
+			assert.Equal(t, !tt.incomplete, lineage.Complete)
+		})
+	}
+}
+
+func TestProjectsService_GetProjectLegalLineage_leavesOutPrivateLegalParents(t *testing.T) {
+	service, repo, _, _ := setupServiceForTesting()
+	accessChecker := &domain.MockAccessChecker{}
+	service.AccessChecker = accessChecker
+	for _, project := range []*models.ProjectBase{
+		{UID: "project", LegalParentUID: "series"},
+		{UID: "series", Slug: "series", Name: "Private Series", LegalEntityType: "Series LLC", LegalEntityName: "Private Series LLC", LegalParentUID: "foundation"},
+		{UID: "foundation", Slug: "foundation", Name: "Foundation", LegalEntityType: "Incorporated Entity", Public: true},
+	} {
+		repo.On("GetProjectBase", mock.Anything, project.UID).Return(project, nil)
+	}
+	accessChecker.On("CheckAccess", mock.Anything, "user-1", "project:series", "viewer").Return(false, nil)
+	accessChecker.On("CheckAccess", mock.Anything, "user-1", "project:foundation", "viewer").Return(true, nil)
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user-1")
+
+	lineage, err := service.GetProjectLegalLineage(ctx, "project")
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.LegalLineageProject{
+		{UID: "foundation", Slug: "foundation", Name: "Foundation", LegalEntityType: "Incorporated Entity"},
+	}, lineage.LegalParents)
+	assert.True(t, lineage.Complete)
+	accessChecker.AssertExpectations(t)
+}
+
+func TestProjectsService_checkLegalParent(t *testing.T) {
+	projects := []*models.ProjectBase{
+		{UID: "project", LegalEntityType: "Series LLC"},
+		{UID: "foundation", LegalEntityType: "Incorporated Entity"},
+		{UID: "series", LegalEntityType: "Series LLC", LegalParentUID: "project"},
+		{UID: "subproject", LegalEntityType: "Subproject"},
+	}
+
+	tests := []struct {
+		name           string
+		projectUID     string
+		legalParentUID string
+		expectedCode   string
+	}{
+		{name: "incorporated entity", projectUID: "project", legalParentUID: "foundation"},
+		{name: "new project", legalParentUID: "series"},
+		{name: "missing project", projectUID: "project", legalParentUID: "missing", expectedCode: domain.FieldErrorNotFound},
+		{name: "entity type that cannot be a legal parent", projectUID: "project", legalParentUID: "subproject", expectedCode: domain.FieldErrorInvalid},
+		{name: "project itself", projectUID: "project", legalParentUID: "project", expectedCode: domain.FieldErrorInvalid},
+		{name: "project in the legal lineage", projectUID: "project", legalParentUID: "series", expectedCode: domain.FieldErrorInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo, _, _ := setupServiceForTesting()
+			for _, project := range projects {
+				repo.On("GetProjectBase", mock.Anything, project.UID).Return(project, nil)
+			}
+			repo.On("GetProjectBase", mock.Anything, mock.Anything).Return(nil, domain.ErrProjectNotFound)
+
+			err := service.checkLegalParent(context.Background(), tt.projectUID, tt.legalParentUID)
+			if tt.expectedCode == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, domain.ErrInvalidLegalParent)
+			var validationErr *domain.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, "legal_parent_uid", validationErr.Fields[0].Path)
+			assert.Equal(t, tt.expectedCode, validationErr.Fields[0].Code)
+		})
+	}
+}
@@ -133,7 +133,7 @@ func (s *ProjectsService) GetLink(ctx context.Context, projectUID, linkUID strin
 }
 
 // DeleteLink deletes a project link with optimistic concurrency.
-func (s *ProjectsService) DeleteLink(ctx context.Context, projectUID, linkUID string, ifMatch *string, xSync bool) error {
+func (s *ProjectsService) DeleteLink(ctx context.Context, projectUID, linkUID string, ifMatch *string, forceUpdate, xSync bool) error {
 	if !s.ServiceReady() {
 		slog.ErrorContext(ctx, "service not ready")
 		return domain.ErrServiceUnavailable
@@ -142,24 +142,12 @@ func (s *ProjectsService) DeleteLink(ctx context.Context, projectUID, linkUID st
 	ctx = log.AppendCtx(ctx, slog.String("project_uid", projectUID))
 	ctx = log.AppendCtx(ctx, slog.String("link_uid", linkUID))
 
-	var revision uint64
-	var err error
-
-	if !s.Config.SkipEtagValidation {
-		if ifMatch == nil {
-			slog.WarnContext(ctx, "If-Match header is missing")
-			return domain.ErrValidationFailed
-		}
-		revision, err = strconv.ParseUint(*ifMatch, 10, 64)
-		if err != nil {
-			slog.ErrorContext(ctx, "error parsing If-Match header", constants.ErrKey, err)
-			return domain.ErrValidationFailed
-		}
-	} else {
-		_, revision, err = s.LinkRepository.GetLink(ctx, projectUID, linkUID)
-		if err != nil {
-			return err
-		}
+	revision, err := s.ifMatchRevision(ctx, "link:"+linkUID, ifMatch, forceUpdate, func() (uint64, error) {
+		_, revision, err := s.LinkRepository.GetLink(ctx, projectUID, linkUID)
+		return revision, err
+	})
+	if err != nil {
+		return err
 	}
 
 	if err := s.LinkRepository.DeleteLink(ctx, projectUID, linkUID, revision); err != nil {
@@ -270,7 +270,7 @@ func TestProjectsService_DeleteLink(t *testing.T) {
 			mockLink := svc.LinkRepository.(*domain.MockLinkRepository)
 			tt.setupMocks(mockLink, mockMsg)
 
-			err := svc.DeleteLink(context.Background(), tt.projectUID, tt.linkUID, tt.ifMatch, false)
+			err := svc.DeleteLink(context.Background(), tt.projectUID, tt.linkUID, tt.ifMatch, false, false)
 
 			if tt.wantErr != nil {
 				assert.ErrorIs(t, err, tt.wantErr)
@@ -0,0 +1,153 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image/png"
+	"log/slog"
+	"net/http"
+	"time"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/logo"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// UploadProjectLogo validates an SVG or PNG logo, stores it, and points the
+// project's logo_url at it. An SVG logo is also converted, stored as {uid}.png
+// for clients that cannot display SVG, and set as the project's png_logo_url.
+func (s *ProjectsService) UploadProjectLogo(ctx context.Context, projectUID, contentType string, data []byte, xSync bool) (*projsvc.ProjectBase, error) {
+	ctx, span := startSpan(ctx, "ProjectsService.UploadProjectLogo", attribute.String("project_uid", projectUID))
+	defer span.End()
+
+	project, err := s.uploadProjectLogo(ctx, projectUID, contentType, data, xSync)
+	recordSpanError(span, err)
+	return project, err
+}
+
+func (s *ProjectsService) uploadProjectLogo(ctx context.Context, projectUID, contentType string, data []byte, xSync bool) (*projsvc.ProjectBase, error) {
+	if !s.ServiceReady() || s.LogoStorage == nil {
+		slog.ErrorContext(ctx, "service not ready or logo storage not configured")
+		return nil, domain.ErrServiceUnavailable
+	}
+
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", projectUID))
+
+	if len(data) == 0 {
+		return nil, domain.ErrValidationFailed
+	}
+	if int64(len(data)) > models.MaxLogoFileSize {
+		return nil, domain.ErrFileTooLarge
+	}
+	contentType, width, height, err := readLogo(ctx, contentType, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeProject(ctx, projectUID, relationWriter); err != nil {
+		return nil, err
+	}
+	exists, err := s.ProjectRepository.ProjectExists(ctx, projectUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error checking if project exists", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+	if !exists {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	// Convert before storing anything, so a failed conversion leaves the
+	// current logo in place.
+	var pngData []byte
+	switch {
+	case contentType != models.LogoContentTypeSVG:
+	case s.LogoConverter == nil:
+		slog.WarnContext(ctx, "no logo converter configured, SVG logo is not stored as PNG")
+	default:
+		pngWidth, pngHeight := logo.PNGSize(width, height)
+		pngData, err = s.LogoConverter.ConvertSVGToPNG(ctx, data, pngWidth, pngHeight)
+		if err != nil {
+			slog.ErrorContext(ctx, "error converting SVG logo to PNG", constants.ErrKey, err)
+			return nil, domain.ErrInternal
+		}
+	}
+
+	var pngLogoURL string
+	if pngData != nil {
+		if pngLogoURL, err = s.LogoStorage.PutLogo(ctx, projectUID+".png", models.LogoContentTypePNG, pngData); err != nil {
+			return nil, err
+		}
+	}
+	name := projectUID + ".png"
+	if contentType == models.LogoContentTypeSVG {
+		name = projectUID + ".svg"
+	}
+	logoURL, err := s.LogoStorage.PutLogo(ctx, name, contentType, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var previous models.ProjectBase
+	projectDB, err := s.ProjectRepository.UpdateProjectBaseWithRetry(ctx, projectUID, func(p *models.ProjectBase) error {
+		previous = *p
+		now := time.Now().UTC()
+		p.LogoURL = logoURL
+		p.PNGLogoURL = pngLogoURL
+		p.UpdatedAt = &now
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			return nil, domain.ErrProjectNotFound
+		}
+		slog.ErrorContext(ctx, "error updating project logo URL", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+
+	messages := projectBaseUpdatedMessages(ctx, projectDB, &previous)
+	if err := s.publishProjectMessages(ctx, projectDB.UID, xSync, messages); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "uploaded project logo", "logo_url", logoURL, "content_type", contentType)
+	return ConvertToServiceProjectBase(projectDB), nil
+}
+
+// readLogo checks that data is a PNG within the allowed dimensions or an SVG
+// with a readable size, and returns its content type and size. The content
+// type is sniffed from the data, except that SVG, which has no reliable
+// signature, must be declared.
+func readLogo(ctx context.Context, declared string, data []byte) (string, int, int, error) {
+	if http.DetectContentType(data) == models.LogoContentTypePNG {
+		config, err := png.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			slog.WarnContext(ctx, "unreadable PNG logo", constants.ErrKey, err)
+			return "", 0, 0, domain.ErrInvalidLogo
+		}
+		if config.Width < models.MinLogoDimension || config.Height < models.MinLogoDimension ||
+			config.Width > models.MaxLogoDimension || config.Height > models.MaxLogoDimension {
+			slog.WarnContext(ctx, "PNG logo dimensions out of bounds", "width", config.Width, "height", config.Height)
+			return "", 0, 0, domain.ErrInvalidLogo
+		}
+		return models.LogoContentTypePNG, config.Width, config.Height, nil
+	}
+
+	if declared != models.LogoContentTypeSVG {
+		slog.WarnContext(ctx, "logo is neither PNG nor SVG", "declared", declared)
+		return "", 0, 0, domain.ErrInvalidContentType
+	}
+	width, height, err := logo.SVGSize(data)
+	if err != nil {
+		slog.WarnContext(ctx, "unreadable SVG logo", constants.ErrKey, err)
+		return "", 0, 0, domain.ErrInvalidLogo
+	}
+	return models.LogoContentTypeSVG, width, height, nil
+}
@@ -0,0 +1,173 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// pngLogo returns a blank PNG image of the given size.
+func pngLogo(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
+
+func TestProjectsService_UploadProjectLogo(t *testing.T) {
+	svgLogo := []byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 300 150"></svg>`)
+	validPNG := pngLogo(t, 200, 100)
+
+	tests := []struct {
+		name            string
+		contentType     string
+		data            []byte
+		withoutStorage  bool
+		setupMocks      func(*domain.MockProjectRepository, *domain.MockMessageBuilder, *domain.MockLogoStorage, *domain.MockLogoConverter)
+		expectedLogoURL string
+		expectedPNGURL  string
+		expectedErr     error
+	}{
+		{
+			name:        "png logo is stored and set as the logo URL",
+			contentType: "image/png",
+			data:        validPNG,
+			setupMocks: func(repo *domain.MockProjectRepository, mb *domain.MockMessageBuilder, storage *domain.MockLogoStorage, _ *domain.MockLogoConverter) {
+				repo.On("ProjectExists", mock.Anything, "project-1").Return(true, nil)
+				storage.On("PutLogo", mock.Anything, "project-1.png", models.LogoContentTypePNG, validPNG).
+					Return("https://logos.example.org/project-1.png", nil)
+				expectLogoUpdate(repo, mb, nil)
+			},
+			expectedLogoURL: "https://logos.example.org/project-1.png",
+		},
+		{
+			name:        "svg logo is converted and both files are stored",
+			contentType: models.LogoContentTypeSVG,
+			data:        svgLogo,
+			setupMocks: func(repo *domain.MockProjectRepository, mb *domain.MockMessageBuilder, storage *domain.MockLogoStorage, converter *domain.MockLogoConverter) {
+				repo.On("ProjectExists", mock.Anything, "project-1").Return(true, nil)
+				converter.On("ConvertSVGToPNG", mock.Anything, svgLogo, 1600, 800).Return([]byte("png"), nil)
+				storage.On("PutLogo", mock.Anything, "project-1.png", models.LogoContentTypePNG, []byte("png")).
+					Return("https://logos.example.org/project-1.png", nil)
+				storage.On("PutLogo", mock.Anything, "project-1.svg", models.LogoContentTypeSVG, svgLogo).
+					Return("https://logos.example.org/project-1.svg", nil)
+				expectLogoUpdate(repo, mb, nil)
+			},
+			expectedLogoURL: "https://logos.example.org/project-1.svg",
+			expectedPNGURL:  "https://logos.example.org/project-1.png",
+		},
+		{
+			name:        "failed conversion keeps the current logo",
+			contentType: models.LogoContentTypeSVG,
+			data:        svgLogo,
+			setupMocks: func(repo *domain.MockProjectRepository, _ *domain.MockMessageBuilder, _ *domain.MockLogoStorage, converter *domain.MockLogoConverter) {
+				repo.On("ProjectExists", mock.Anything, "project-1").Return(true, nil)
+				converter.On("ConvertSVGToPNG", mock.Anything, svgLogo, 1600, 800).Return(nil, errors.New("inkscape: exit status 1"))
+			},
+			expectedErr: domain.ErrInternal,
+		},
+		{
+			name:        "png too small",
+			contentType: "image/png",
+			data:        pngLogo(t, 16, 16),
+			expectedErr: domain.ErrInvalidLogo,
+		},
+		{
+			name:        "svg without a size",
+			contentType: models.LogoContentTypeSVG,
+			data:        []byte(`<svg width="100%"></svg>`),
+			expectedErr: domain.ErrInvalidLogo,
+		},
+		{
+			name:        "unsupported content type",
+			contentType: "image/jpeg",
+			data:        []byte("\xff\xd8\xff\xe0 jpeg"),
+			expectedErr: domain.ErrInvalidContentType,
+		},
+		{
+			name:        "file too large",
+			contentType: "image/png",
+			data:        make([]byte, models.MaxLogoFileSize+1),
+			expectedErr: domain.ErrFileTooLarge,
+		},
+		{
+			name:        "project not found",
+			contentType: "image/png",
+			data:        validPNG,
+			setupMocks: func(repo *domain.MockProjectRepository, _ *domain.MockMessageBuilder, _ *domain.MockLogoStorage, _ *domain.MockLogoConverter) {
+				repo.On("ProjectExists", mock.Anything, "project-1").Return(false, nil)
+			},
+			expectedErr: domain.ErrProjectNotFound,
+		},
+		{
+			name:           "logo storage not configured",
+			contentType:    "image/png",
+			data:           validPNG,
+			withoutStorage: true,
+			expectedErr:    domain.ErrServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+			mockStorage := &domain.MockLogoStorage{}
+			mockConverter := &domain.MockLogoConverter{}
+			if !tt.withoutStorage {
+				service.LogoStorage = mockStorage
+			}
+			service.LogoConverter = mockConverter
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockRepo, mockBuilder, mockStorage, mockConverter)
+			}
+
+			project, err := service.UploadProjectLogo(context.Background(), "project-1", tt.contentType, tt.data, false)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				mockStorage.AssertNotCalled(t, "PutLogo", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedLogoURL, *project.LogoURL)
+				if tt.expectedPNGURL != "" {
+					require.NotNil(t, project.PngLogoURL)
+					assert.Equal(t, tt.expectedPNGURL, *project.PngLogoURL)
+				} else {
+					assert.Nil(t, project.PngLogoURL)
+				}
+			}
+			mockRepo.AssertExpectations(t)
+			mockStorage.AssertExpectations(t)
+			mockConverter.AssertExpectations(t)
+		})
+	}
+}
+
+// expectLogoUpdate sets up the update of the stored project, by default one
+// without a logo, and the messages that follow a logo change.
+func expectLogoUpdate(repo *domain.MockProjectRepository, mb *domain.MockMessageBuilder, project *models.ProjectBase) {
+	if project == nil {
+		project = &models.ProjectBase{UID: "project-1", Slug: "project-1", Name: "Project 1"}
+	}
+	repo.On("UpdateProjectBaseWithRetry", mock.Anything, "project-1", mock.Anything).
+		Run(func(args mock.Arguments) {
+			_ = args.Get(2).(func(*models.ProjectBase) error)(project)
+		}).
+		Return(project, nil)
+	mb.On("SendIndexerMessage", mock.Anything, constants.IndexProjectSubject, mock.Anything, false).Return(nil)
+	mb.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+}
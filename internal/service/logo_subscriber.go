@@ -0,0 +1,104 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/logo"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// errLogoChanged aborts storing a PNG logo whose SVG is no longer the project's logo.
+var errLogoChanged = errors.New("project logo changed during conversion")
+
+// HandleProjectLogoChanged handles project.created and project.updated events and,
+// when the project's logo_url is an SVG without a PNG rendering, downloads it,
+// converts it to PNG, stores the PNG as {uid}.png, and sets png_logo_url.
+//
+// A logo that is not a usable SVG is logged and skipped. Download, conversion and
+// storage errors are returned so that the event is recorded as a dead letter and
+// can be replayed.
+func (s *ProjectsService) HandleProjectLogoChanged(ctx context.Context, msg domain.Message) error {
+	if !s.ServiceReady() || s.LogoStorage == nil || s.LogoConverter == nil || s.LogoFetcher == nil {
+		return nil
+	}
+
+	var event events.ProjectLifecycleEvent
+	if err := json.Unmarshal(msg.Data(), &event); err != nil {
+		slog.WarnContext(ctx, "logo_subscriber: failed to unmarshal project event", constants.ErrKey, err)
+		return nil
+	}
+	if event.Project == nil || event.Project.PNGLogoURL != "" || !logo.IsSVGURL(event.Project.LogoURL) {
+		return nil
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(projectUIDAttr(event.ProjectUID))
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", event.ProjectUID))
+
+	return s.convertProjectLogo(ctx, event.ProjectUID, event.Project.LogoURL)
+}
+
+// convertProjectLogo stores a PNG rendering of the SVG logo at logoURL and sets it
+// as the project's png_logo_url, unless the project's logo has changed meanwhile.
+func (s *ProjectsService) convertProjectLogo(ctx context.Context, projectUID, logoURL string) error {
+	svg, err := s.LogoFetcher.FetchLogo(ctx, logoURL)
+	if err != nil {
+		slog.WarnContext(ctx, "logo_subscriber: error fetching SVG logo", constants.ErrKey, err, "logo_url", logoURL)
+		return err
+	}
+	width, height, err := logo.SVGSize(svg)
+	if err != nil {
+		slog.WarnContext(ctx, "logo_subscriber: unreadable SVG logo, not converting", constants.ErrKey, err, "logo_url", logoURL)
+		return nil
+	}
+
+	pngWidth, pngHeight := logo.PNGSize(width, height)
+	pngData, err := s.LogoConverter.ConvertSVGToPNG(ctx, svg, pngWidth, pngHeight)
+	if err != nil {
+		slog.ErrorContext(ctx, "logo_subscriber: error converting SVG logo to PNG", constants.ErrKey, err, "logo_url", logoURL)
+		return err
+	}
+	pngLogoURL, err := s.LogoStorage.PutLogo(ctx, projectUID+".png", models.LogoContentTypePNG, pngData)
+	if err != nil {
+		return err
+	}
+
+	var previous models.ProjectBase
+	projectDB, err := s.ProjectRepository.UpdateProjectBaseWithRetry(ctx, projectUID, func(p *models.ProjectBase) error {
+		if p.LogoURL != logoURL {
+			return errLogoChanged
+		}
+		previous = *p
+		now := time.Now().UTC()
+		p.PNGLogoURL = pngLogoURL
+		p.UpdatedAt = &now
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, errLogoChanged) || errors.Is(err, domain.ErrProjectNotFound) {
+			slog.DebugContext(ctx, "logo_subscriber: project logo changed or project deleted, discarding PNG logo", constants.ErrKey, err)
+			return nil
+		}
+		slog.ErrorContext(ctx, "logo_subscriber: error updating project PNG logo URL", constants.ErrKey, err)
+		return err
+	}
+
+	messages := projectBaseUpdatedMessages(ctx, projectDB, &previous)
+	if err := s.publishProjectMessages(ctx, projectDB.UID, false, messages); err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "logo_subscriber: converted project logo to PNG", "logo_url", logoURL, "png_logo_url", pngLogoURL)
+	return nil
+}
@@ -0,0 +1,124 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+)
+
+func TestProjectsService_HandleProjectLogoChanged(t *testing.T) {
+	const svgURL = "https://example.org/logo.svg"
+	svgLogo := []byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 300 150"></svg>`)
+	stored := &models.ProjectBase{UID: "project-1", Slug: "project-1", LogoURL: svgURL}
+
+	tests := []struct {
+		name        string
+		project     *events.Project
+		setupMocks  func(*domain.MockProjectRepository, *domain.MockMessageBuilder, *domain.MockLogoStorage, *domain.MockLogoConverter, *domain.MockLogoFetcher)
+		expectedPNG string
+		wantErr     bool
+	}{
+		{
+			name:    "svg logo is converted and set as the PNG logo URL",
+			project: &events.Project{UID: "project-1", LogoURL: svgURL},
+			setupMocks: func(repo *domain.MockProjectRepository, mb *domain.MockMessageBuilder, storage *domain.MockLogoStorage, converter *domain.MockLogoConverter, fetcher *domain.MockLogoFetcher) {
+				fetcher.On("FetchLogo", mock.Anything, svgURL).Return(svgLogo, nil)
+				converter.On("ConvertSVGToPNG", mock.Anything, svgLogo, 1600, 800).Return([]byte("png"), nil)
+				storage.On("PutLogo", mock.Anything, "project-1.png", models.LogoContentTypePNG, []byte("png")).
+					Return("https://logos.example.org/project-1.png", nil)
+				expectLogoUpdate(repo, mb, stored)
+			},
+			expectedPNG: "https://logos.example.org/project-1.png",
+		},
+		{
+			name:    "logo changed during conversion",
+			project: &events.Project{UID: "project-1", LogoURL: svgURL},
+			setupMocks: func(repo *domain.MockProjectRepository, _ *domain.MockMessageBuilder, storage *domain.MockLogoStorage, converter *domain.MockLogoConverter, fetcher *domain.MockLogoFetcher) {
+				fetcher.On("FetchLogo", mock.Anything, svgURL).Return(svgLogo, nil)
+				converter.On("ConvertSVGToPNG", mock.Anything, svgLogo, 1600, 800).Return([]byte("png"), nil)
+				storage.On("PutLogo", mock.Anything, "project-1.png", models.LogoContentTypePNG, []byte("png")).
+					Return("https://logos.example.org/project-1.png", nil)
+				repo.On("UpdateProjectBaseWithRetry", mock.Anything, "project-1", mock.Anything).
+					Run(func(args mock.Arguments) {
+						err := args.Get(2).(func(*models.ProjectBase) error)(&models.ProjectBase{UID: "project-1", LogoURL: "https://example.org/new.svg"})
+						assert.ErrorIs(t, err, errLogoChanged)
+					}).
+					Return(nil, errLogoChanged)
+			},
+		},
+		{
+			name:    "download failure is returned for replay",
+			project: &events.Project{UID: "project-1", LogoURL: svgURL},
+			setupMocks: func(_ *domain.MockProjectRepository, _ *domain.MockMessageBuilder, _ *domain.MockLogoStorage, _ *domain.MockLogoConverter, fetcher *domain.MockLogoFetcher) {
+				fetcher.On("FetchLogo", mock.Anything, svgURL).Return(nil, errors.New("connection refused"))
+			},
+			wantErr: true,
+		},
+		{
+			name:    "unreadable svg is skipped",
+			project: &events.Project{UID: "project-1", LogoURL: svgURL},
+			setupMocks: func(_ *domain.MockProjectRepository, _ *domain.MockMessageBuilder, _ *domain.MockLogoStorage, _ *domain.MockLogoConverter, fetcher *domain.MockLogoFetcher) {
+				fetcher.On("FetchLogo", mock.Anything, svgURL).Return([]byte("<html></html>"), nil)
+			},
+		},
+		{
+			name:    "png logo is not converted",
+			project: &events.Project{UID: "project-1", LogoURL: "https://example.org/logo.png"},
+		},
+		{
+			name:    "svg logo that already has a PNG is not converted",
+			project: &events.Project{UID: "project-1", LogoURL: svgURL, PNGLogoURL: "https://logos.example.org/project-1.png"},
+		},
+		{
+			name: "event without a project",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+			mockStorage := &domain.MockLogoStorage{}
+			mockConverter := &domain.MockLogoConverter{}
+			mockFetcher := &domain.MockLogoFetcher{}
+			service.LogoStorage = mockStorage
+			service.LogoConverter = mockConverter
+			service.LogoFetcher = mockFetcher
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockRepo, mockBuilder, mockStorage, mockConverter, mockFetcher)
+			}
+
+			event := events.ProjectLifecycleEvent{Type: events.ProjectUpdated, ProjectUID: "project-1", Project: tt.project}
+			data, err := json.Marshal(event)
+			require.NoError(t, err)
+
+			err = service.HandleProjectLogoChanged(context.Background(), domain.NewMockMessage(data, projectEventSubject(events.ProjectUpdated)))
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.expectedPNG != "" {
+				assert.Equal(t, tt.expectedPNG, stored.PNGLogoURL)
+				mockBuilder.AssertCalled(t, "SendIndexerMessage", mock.Anything, constants.IndexProjectSubject, mock.Anything, false)
+			}
+			mockRepo.AssertExpectations(t)
+			mockStorage.AssertExpectations(t)
+			mockConverter.AssertExpectations(t)
+			mockFetcher.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+)
+
+// lookupHandler answers the payload of a versioned lookup request with the
+// response payload of its subject.
+type lookupHandler func(ctx context.Context, payload json.RawMessage) (any, error)
+
+// lookupHandlers returns the handlers of versioned lookup requests, keyed by subject.
+func (s *ProjectsService) lookupHandlers() map[string]lookupHandler {
+	return map[string]lookupHandler{
+		constants.ProjectGetNameSubject:      s.lookupProjectName,
+		constants.ProjectGetSlugSubject:      s.lookupProjectSlug,
+		constants.ProjectGetLogoSubject:      s.lookupProjectLogo,
+		constants.ProjectSlugToUIDSubject:    s.lookupProjectUIDFromSlug,
+		constants.ProjectLegacyToUIDSubject:  s.lookupProjectUIDFromLegacyID,
+		constants.ProjectGetParentUIDSubject: s.lookupProjectParentUID,
+		constants.ProjectGetWritersSubject:   s.lookupProjectWriters,
+		constants.UserGetProjectsSubject:     s.lookupUserProjects,
+	}
+}
+
+// handleLookupRequest answers a versioned lookup request on subject. Failures
+// are returned in the response's error rather than as an empty reply.
+func (s *ProjectsService) handleLookupRequest(ctx context.Context, subject string, request events.LookupRequest) events.LookupResponse {
+	response := events.LookupResponse{Version: events.LookupVersion, RequestID: request.RequestID}
+	if request.RequestID != "" {
+		ctx = log.AppendCtx(ctx, slog.String("request_id", request.RequestID))
+	}
+
+	if request.Version != events.LookupVersion {
+		response.Error = &events.LookupError{
+			Code:    events.LookupErrorUnsupportedVersion,
+			Message: fmt.Sprintf("unsupported version %q, expected %q", request.Version, events.LookupVersion),
+		}
+		return response
+	}
+
+	handler, ok := s.lookupHandlers()[subject]
+	if !ok {
+		slog.WarnContext(ctx, "unknown subject")
+		response.Error = &events.LookupError{Code: events.LookupErrorInvalidRequest, Message: "unknown subject"}
+		return response
+	}
+
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS KV store not initialized")
+		response.Error = lookupError(domain.ErrServiceUnavailable)
+		return response
+	}
+
+	result, err := handler(ctx, request.Payload)
+	if err != nil {
+		// Repositories hide the cause of failed KV calls, so an expired handler
+		// context is checked for as well.
+		err = errors.Join(ctx.Err(), err)
+		switch {
+		case ctx.Err() != nil:
+			slog.WarnContext(ctx, "NATS handler did not finish in time", constants.ErrKey, err)
+		case errors.Is(err, domain.ErrProjectNotFound) || errors.Is(err, errLookupRedacted):
+			slog.WarnContext(ctx, "project lookup not answered", constants.ErrKey, err)
+		default:
+			slog.ErrorContext(ctx, "error handling message", constants.ErrKey, err)
+		}
+		response.Error = lookupError(err)
+		return response
+	}
+
+	payload, err := json.Marshal(result)
+	if err != nil {
+		slog.ErrorContext(ctx, "error marshalling lookup response", constants.ErrKey, err)
+		response.Error = lookupError(err)
+		return response
+	}
+	response.Payload = payload
+	return response
+}
+
+// lookupError returns the error of a lookup response for err. Only the
+// messages of request errors are passed on to the requester.
+func lookupError(err error) *events.LookupError {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return &events.LookupError{Code: events.LookupErrorTimeout, Message: "lookup timed out"}
+	case errors.Is(err, context.Canceled):
+		return &events.LookupError{Code: events.LookupErrorUnavailable, Message: "service shutting down"}
+	case errors.Is(err, domain.ErrValidationFailed):
+		return &events.LookupError{Code: events.LookupErrorInvalidRequest, Message: err.Error()}
+	case errors.Is(err, domain.ErrProjectNotFound):
+		return &events.LookupError{Code: events.LookupErrorNotFound, Message: "project not found"}
+	case errors.Is(err, errLookupRedacted):
+		return &events.LookupError{Code: events.LookupErrorForbidden, Message: errLookupRedacted.Error()}
+	case errors.Is(err, domain.ErrServiceUnavailable):
+		return &events.LookupError{Code: events.LookupErrorUnavailable, Message: "service unavailable"}
+	default:
+		return &events.LookupError{Code: events.LookupErrorInternal, Message: "internal error"}
+	}
+}
+
+// decodeLookupPayload decodes the payload of a versioned lookup request.
+func decodeLookupPayload(payload json.RawMessage, v any) error {
+	if len(payload) == 0 {
+		return fmt.Errorf("%w: payload is required", domain.ErrValidationFailed)
+	}
+	if err := json.Unmarshal(payload, v); err != nil {
+		return fmt.Errorf("%w: invalid payload: %w", domain.ErrValidationFailed, err)
+	}
+	return nil
+}
+
+// lookupProjectPayload decodes a events.ProjectLookupRequest payload and
+// returns its project.
+func (s *ProjectsService) lookupProjectPayload(ctx context.Context, payload json.RawMessage, subject, getAttribute string, redactPrivate bool) (*models.ProjectBase, events.ProjectLookupRequest, error) {
+	var request events.ProjectLookupRequest
+	if err := decodeLookupPayload(payload, &request); err != nil {
+		return nil, request, err
+	}
+	project, err := s.lookupProject(ctx, subject, getAttribute, request, redactPrivate)
+	return project, request, err
+}
+
+func (s *ProjectsService) lookupProjectName(ctx context.Context, payload json.RawMessage) (any, error) {
+	project, request, err := s.lookupProjectPayload(ctx, payload, constants.ProjectGetNameSubject, "name", true)
+	if err != nil {
+		return nil, err
+	}
+	response := events.ProjectNameResponse{Name: project.Name}
+	if request.IncludePreviousNames {
+		response.PreviousNames = domainPreviousNamesToEvent(project.PreviousNames)
+	}
+	return response, nil
+}
+
+func (s *ProjectsService) lookupProjectSlug(ctx context.Context, payload json.RawMessage) (any, error) {
+	project, _, err := s.lookupProjectPayload(ctx, payload, constants.ProjectGetSlugSubject, "slug", false)
+	if err != nil {
+		return nil, err
+	}
+	return events.ProjectSlugResponse{Slug: project.Slug}, nil
+}
+
+func (s *ProjectsService) lookupProjectLogo(ctx context.Context, payload json.RawMessage) (any, error) {
+	project, _, err := s.lookupProjectPayload(ctx, payload, constants.ProjectGetLogoSubject, "logo_url", true)
+	if err != nil {
+		return nil, err
+	}
+	return events.ProjectLogoResponse{LogoURL: project.LogoURL}, nil
+}
+
+func (s *ProjectsService) lookupProjectParentUID(ctx context.Context, payload json.RawMessage) (any, error) {
+	project, _, err := s.lookupProjectPayload(ctx, payload, constants.ProjectGetParentUIDSubject, "parent_uid", false)
+	if err != nil {
+		return nil, err
+	}
+	return events.ProjectParentUIDResponse{ParentUID: project.ParentUID}, nil
+}
+
+func (s *ProjectsService) lookupProjectUIDFromSlug(ctx context.Context, payload json.RawMessage) (any, error) {
+	var request events.ProjectSlugRequest
+	if err := decodeLookupPayload(payload, &request); err != nil {
+		return nil, err
+	}
+	if request.Slug == "" {
+		return nil, fmt.Errorf("%w: slug is required", domain.ErrValidationFailed)
+	}
+	ctx = log.AppendCtx(ctx, slog.String("project_slug", request.Slug))
+
+	projectUID, err := s.ProjectRepository.GetProjectUIDFromSlug(ctx, request.Slug)
+	if err != nil {
+		return nil, err
+	}
+	return events.ProjectUIDResponse{UID: projectUID}, nil
+}
+
+func (s *ProjectsService) lookupProjectUIDFromLegacyID(ctx context.Context, payload json.RawMessage) (any, error) {
+	var request events.ProjectLegacyIDRequest
+	if err := decodeLookupPayload(payload, &request); err != nil {
+		return nil, err
+	}
+	if request.LegacyID == "" {
+		return nil, fmt.Errorf("%w: legacy_id is required", domain.ErrValidationFailed)
+	}
+	ctx = log.AppendCtx(ctx, slog.String("legacy_id", request.LegacyID))
+
+	projectUID, err := s.ProjectRepository.GetProjectUIDFromLegacyID(ctx, request.LegacyID)
+	if err != nil {
+		return nil, err
+	}
+	return events.ProjectUIDResponse{UID: projectUID}, nil
+}
+
+func (s *ProjectsService) lookupProjectWriters(ctx context.Context, payload json.RawMessage) (any, error) {
+	var request events.ProjectLookupRequest
+	if err := decodeLookupPayload(payload, &request); err != nil {
+		return nil, err
+	}
+	writers, err := s.projectWriters(ctx, request.UID)
+	if err != nil {
+		return nil, err
+	}
+	return events.ProjectWritersResponse{Writers: domainUsersToEvent(writers)}, nil
+}
+
+func (s *ProjectsService) lookupUserProjects(ctx context.Context, payload json.RawMessage) (any, error) {
+	var request events.UserProjectsRequest
+	if err := decodeLookupPayload(payload, &request); err != nil {
+		return nil, err
+	}
+	username := strings.TrimSpace(request.Username)
+	if username == "" {
+		return nil, fmt.Errorf("%w: username is required", domain.ErrValidationFailed)
+	}
+	projects, err := s.listUserProjects(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	return events.UserProjectsResponse{Projects: domainUserProjectsToEvent(projects)}, nil
+}
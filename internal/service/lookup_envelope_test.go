@@ -0,0 +1,226 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+)
+
+const lookupTestUID = "01234567-89ab-cdef-0123-456789abcdef"
+
+// handleLookup sends a versioned lookup request on subject and returns the
+// response envelope.
+func handleLookup(t *testing.T, service *ProjectsService, subject, request string) events.LookupResponse {
+	t.Helper()
+	var reply []byte
+	msg := newMockMessage(subject, []byte(request))
+	msg.On("Respond", mock.Anything).Run(func(args mock.Arguments) {
+		reply = args.Get(0).([]byte)
+	}).Return(nil).Once()
+
+	service.HandleMessage(context.Background(), msg)
+
+	msg.AssertExpectations(t)
+	var response events.LookupResponse
+	require.NoError(t, json.Unmarshal(reply, &response))
+	return response
+}
+
+func TestProjectsService_HandleMessage_lookupEnvelope(t *testing.T) {
+	renamedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	project := &models.ProjectBase{
+		UID:           lookupTestUID,
+		Name:          "Test Project",
+		Slug:          "test-project",
+		LogoURL:       "https://example.org/logo.svg",
+		ParentUID:     "parent-uid",
+		PreviousNames: []models.PreviousName{{Name: "Old Project", RenamedAt: renamedAt}},
+	}
+
+	tests := []struct {
+		name            string
+		subject         string
+		request         string
+		setupMocks      func(*domain.MockProjectRepository)
+		expectedPayload string
+		expectedError   string
+	}{
+		{
+			name:    "get name",
+			subject: constants.ProjectGetNameSubject,
+			request: `{"version":"v1","request_id":"req-1","payload":{"uid":"` + lookupTestUID + `"}}`,
+			setupMocks: func(mockRepo *domain.MockProjectRepository) {
+				mockRepo.On("GetProjectBase", mock.Anything, lookupTestUID).Return(project, nil)
+			},
+			expectedPayload: `{"name":"Test Project"}`,
+		},
+		{
+			name:    "get name with previous names",
+			subject: constants.ProjectGetNameSubject,
+			request: `{"version":"v1","request_id":"req-1","payload":{"uid":"` + lookupTestUID + `","include_previous_names":true}}`,
+			setupMocks: func(mockRepo *domain.MockProjectRepository) {
+				mockRepo.On("GetProjectBase", mock.Anything, lookupTestUID).Return(project, nil)
+			},
+			expectedPayload: `{"name":"Test Project","previous_names":[{"name":"Old Project","renamed_at":"2024-05-01T12:00:00Z"}]}`,
+		},
+		{
+			name:    "get logo",
+			subject: constants.ProjectGetLogoSubject,
+			request: `{"version":"v1","request_id":"req-1","payload":{"uid":"` + lookupTestUID + `"}}`,
+			setupMocks: func(mockRepo *domain.MockProjectRepository) {
+				mockRepo.On("GetProjectBase", mock.Anything, lookupTestUID).Return(project, nil)
+			},
+			expectedPayload: `{"logo_url":"https://example.org/logo.svg"}`,
+		},
+		{
+			name:    "get parent UID",
+			subject: constants.ProjectGetParentUIDSubject,
+			request: `{"version":"v1","request_id":"req-1","payload":{"uid":"` + lookupTestUID + `"}}`,
+			setupMocks: func(mockRepo *domain.MockProjectRepository) {
+				mockRepo.On("GetProjectBase", mock.Anything, lookupTestUID).Return(project, nil)
+			},
+			expectedPayload: `{"parent_uid":"parent-uid"}`,
+		},
+		{
+			name:    "slug to UID",
+			subject: constants.ProjectSlugToUIDSubject,
+			request: `{"version":"v1","request_id":"req-1","payload":{"slug":"test-project"}}`,
+			setupMocks: func(mockRepo *domain.MockProjectRepository) {
+				mockRepo.On("GetProjectUIDFromSlug", mock.Anything, "test-project").Return(lookupTestUID, nil)
+			},
+			expectedPayload: `{"uid":"` + lookupTestUID + `"}`,
+		},
+		{
+			name:    "get writers of a project without writers",
+			subject: constants.ProjectGetWritersSubject,
+			request: `{"version":"v1","request_id":"req-1","payload":{"uid":"` + lookupTestUID + `"}}`,
+			setupMocks: func(mockRepo *domain.MockProjectRepository) {
+				mockRepo.On("GetProjectSettings", mock.Anything, lookupTestUID).Return(&models.ProjectSettings{UID: lookupTestUID}, nil)
+			},
+			expectedPayload: `{"writers":[]}`,
+		},
+		{
+			name:    "project not found",
+			subject: constants.ProjectGetSlugSubject,
+			request: `{"version":"v1","request_id":"req-1","payload":{"uid":"` + lookupTestUID + `"}}`,
+			setupMocks: func(mockRepo *domain.MockProjectRepository) {
+				mockRepo.On("GetProjectBase", mock.Anything, lookupTestUID).Return(nil, domain.ErrProjectNotFound)
+			},
+			expectedError: events.LookupErrorNotFound,
+		},
+		{
+			name:          "invalid project UID",
+			subject:       constants.ProjectGetSlugSubject,
+			request:       `{"version":"v1","request_id":"req-1","payload":{"uid":"not-a-uuid"}}`,
+			setupMocks:    func(*domain.MockProjectRepository) {},
+			expectedError: events.LookupErrorInvalidRequest,
+		},
+		{
+			name:          "missing payload",
+			subject:       constants.ProjectLegacyToUIDSubject,
+			request:       `{"version":"v1","request_id":"req-1"}`,
+			setupMocks:    func(*domain.MockProjectRepository) {},
+			expectedError: events.LookupErrorInvalidRequest,
+		},
+		{
+			name:          "unsupported version",
+			subject:       constants.ProjectGetNameSubject,
+			request:       `{"version":"v9","request_id":"req-1","payload":{"uid":"` + lookupTestUID + `"}}`,
+			setupMocks:    func(*domain.MockProjectRepository) {},
+			expectedError: events.LookupErrorUnsupportedVersion,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := setupServiceForTesting()
+			tt.setupMocks(mockRepo)
+
+			response := handleLookup(t, service, tt.subject, tt.request)
+
+			assert.Equal(t, events.LookupVersion, response.Version)
+			assert.Equal(t, "req-1", response.RequestID)
+			if tt.expectedError != "" {
+				require.NotNil(t, response.Error)
+				assert.Equal(t, tt.expectedError, response.Error.Code)
+				assert.Empty(t, response.Payload)
+			} else {
+				assert.Nil(t, response.Error)
+				assert.JSONEq(t, tt.expectedPayload, string(response.Payload))
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectsService_HandleMessage_lookupEnvelopeRedacted(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	service.Config.PrivateLookupPolicy = PrivateLookupRedact
+	mockRepo.On("GetProjectBase", mock.Anything, lookupTestUID).Return(
+		&models.ProjectBase{UID: lookupTestUID, Name: "Private Project"}, nil,
+	)
+
+	response := handleLookup(t, service, constants.ProjectGetNameSubject,
+		`{"version":"v1","payload":{"uid":"`+lookupTestUID+`"}}`)
+
+	require.NotNil(t, response.Error)
+	assert.Equal(t, events.LookupErrorForbidden, response.Error.Code)
+}
+
+func TestProjectsService_handleLookupRequest_timeout(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	mockRepo.On("GetProjectBase", mock.Anything, lookupTestUID).Run(func(args mock.Arguments) {
+		<-args.Get(0).(context.Context).Done()
+	}).Return(nil, domain.ErrInternal)
+
+	response := service.handleLookupRequest(ctx, constants.ProjectGetNameSubject, events.LookupRequest{
+		Version:   events.LookupVersion,
+		RequestID: "req-1",
+		Payload:   json.RawMessage(`{"uid":"` + lookupTestUID + `"}`),
+	})
+
+	require.NotNil(t, response.Error)
+	assert.Equal(t, events.LookupErrorTimeout, response.Error.Code)
+	assert.Equal(t, "req-1", response.RequestID)
+}
+
+func TestProjectsService_HandleMessage_versionedLookupsOnly(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	service.Config.VersionedLookupsOnly = true
+
+	msg := newMockMessage(constants.ProjectGetNameSubject, []byte(lookupTestUID))
+	msg.On("Respond", []byte(nil)).Return(nil).Once()
+
+	service.HandleMessage(context.Background(), msg)
+
+	msg.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "GetProjectBase", mock.Anything, mock.Anything)
+}
+
+func TestParseLookupRequest(t *testing.T) {
+	_, ok := events.ParseLookupRequest([]byte(lookupTestUID))
+	assert.False(t, ok, "plain-text UID")
+
+	_, ok = events.ParseLookupRequest([]byte(`{"uid":"` + lookupTestUID + `"}`))
+	assert.False(t, ok, "unversioned JSON lookup")
+
+	request, ok := events.ParseLookupRequest([]byte(`{"version":"v1","request_id":"req-1","payload":{"slug":"x"}}`))
+	assert.True(t, ok)
+	assert.Equal(t, "req-1", request.RequestID)
+	assert.JSONEq(t, `{"slug":"x"}`, string(request.Payload))
+}
@@ -0,0 +1,298 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	fgaconstants "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/constants"
+	fgatypes "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/types"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// errMemberUnchanged aborts a member update that would not change the settings.
+var errMemberUnchanged = errors.New("project member unchanged")
+
+// AddProjectMember adds the user with username to a project's writers or
+// auditors. Unlike a settings update, it only changes that one user, re-reading
+// the settings and retrying on concurrent writes, so that two admins adding
+// users at the same time do not overwrite each other. Adding a user who
+// already holds the role changes nothing. With ifMatch, the user is only added
+// to that revision of the settings.
+func (s *ProjectsService) AddProjectMember(ctx context.Context, projectUID string, role models.ProjectMemberRole, username string, ifMatch *string, xSync bool) (*models.ProjectSettings, error) {
+	ctx, span := startSpan(ctx, "ProjectsService.AddProjectMember",
+		attribute.String("project_uid", projectUID), attribute.String("role", string(role)))
+	defer span.End()
+
+	settings, err := s.addProjectMember(ctx, projectUID, role, strings.TrimSpace(username), ifMatch, xSync)
+	recordSpanError(span, err)
+	return settings, err
+}
+
+func (s *ProjectsService) addProjectMember(ctx context.Context, projectUID string, role models.ProjectMemberRole, username string, ifMatch *string, xSync bool) (*models.ProjectSettings, error) {
+	if err := s.checkProjectMemberRequest(ctx, projectUID, role, username); err != nil {
+		return nil, err
+	}
+
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", projectUID))
+	ctx = log.AppendCtx(ctx, slog.String("role", string(role)))
+	return s.putProjectMember(ctx, projectUID, role, username, ifMatch, xSync)
+}
+
+// JoinProject adds the principal in ctx to the member role configured by
+// Config.AutojoinRole of a project that has autojoin enabled. It returns an
+// [domain.AutojoinDisabledError] naming the people to contact otherwise.
+func (s *ProjectsService) JoinProject(ctx context.Context, projectUID string, xSync bool) (models.ProjectMemberRole, string, error) {
+	ctx, span := startSpan(ctx, "ProjectsService.JoinProject", projectUIDAttr(projectUID))
+	defer span.End()
+
+	role, username, err := s.joinProject(ctx, projectUID, xSync)
+	recordSpanError(span, err)
+	return role, username, err
+}
+
+func (s *ProjectsService) joinProject(ctx context.Context, projectUID string, xSync bool) (models.ProjectMemberRole, string, error) {
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return "", "", domain.ErrServiceUnavailable
+	}
+	role := s.Config.AutojoinRole
+	if role == "" {
+		role = models.ProjectMemberRoleAuditor
+	}
+	username, _ := ctx.Value(constants.PrincipalContextID).(string)
+	if username == "" {
+		slog.WarnContext(ctx, "no principal to join the project")
+		return "", "", domain.ErrForbidden
+	}
+	if err := s.authorizeProject(ctx, projectUID, relationViewer); err != nil {
+		return "", "", err
+	}
+
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", projectUID))
+	ctx = log.AppendCtx(ctx, slog.String("role", string(role)))
+
+	project, err := s.ProjectRepository.GetProjectBase(ctx, projectUID)
+	if err != nil {
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			return "", "", domain.ErrProjectNotFound
+		}
+		slog.ErrorContext(ctx, "error getting project from store", constants.ErrKey, err)
+		return "", "", domain.ErrInternal
+	}
+	if !project.AutojoinEnabled {
+		slog.InfoContext(ctx, "principal asked to join a project without autojoin", "principal", username)
+		return "", "", &domain.AutojoinDisabledError{Contacts: s.projectContacts(ctx, projectUID)}
+	}
+
+	if _, err := s.putProjectMember(ctx, projectUID, role, username, nil, xSync); err != nil {
+		return "", "", err
+	}
+	return role, username, nil
+}
+
+// projectContacts returns the executive director and program manager of a
+// project who have an email address. A failure to read the settings is logged
+// and leaves the contacts empty, as they only help the caller.
+func (s *ProjectsService) projectContacts(ctx context.Context, projectUID string) []domain.ProjectContact {
+	settings, err := s.ProjectRepository.GetProjectSettings(ctx, projectUID)
+	if err != nil {
+		slog.WarnContext(ctx, "error getting project settings for contacts", constants.ErrKey, err)
+		return nil
+	}
+	var contacts []domain.ProjectContact
+	for _, c := range []struct {
+		role string
+		user *models.UserInfo
+	}{
+		{domain.ContactRoleExecutiveDirector, settings.ExecutiveDirector},
+		{domain.ContactRoleProgramManager, settings.ProgramManager},
+	} {
+		if c.user != nil && c.user.Email != "" {
+			contacts = append(contacts, domain.ProjectContact{Role: c.role, Name: c.user.Name, Email: c.user.Email})
+		}
+	}
+	return contacts
+}
+
+// putProjectMember adds the user with username to a project's role, once the
+// request has been checked.
+func (s *ProjectsService) putProjectMember(ctx context.Context, projectUID string, role models.ProjectMemberRole, username string, ifMatch *string, xSync bool) (*models.ProjectSettings, error) {
+	// The username comes from the caller, so it must belong to a registered
+	// user; the profile fills in the display name and avatar.
+	member := models.UserInfo{Username: username}
+	meta, err := s.UserReader.UserMetadataByPrincipal(ctx, username)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil, domain.NewFieldValidationError("username", domain.FieldErrorNotFound, domain.ErrUserNotFound)
+		}
+		slog.ErrorContext(ctx, "error looking up project member", constants.ErrKey, err)
+		return nil, domain.ErrServiceUnavailable
+	}
+	member.Name = meta.Name
+	member.Avatar = meta.Picture
+
+	var previous models.ProjectSettings
+	settings, err := s.updateProjectSettingsIfMatch(ctx, projectUID, ifMatch, func(p *models.ProjectSettings) error {
+		members := p.Members(role)
+		if slices.ContainsFunc(*members, func(u models.UserInfo) bool { return u.Username == username }) {
+			return errMemberUnchanged
+		}
+		previous = *p
+		now := time.Now().UTC()
+		*members = append(slices.Clip(*members), member)
+		p.UpdatedAt = &now
+		return nil
+	})
+	if errors.Is(err, errMemberUnchanged) {
+		return s.getProjectMemberSettings(ctx, projectUID)
+	}
+	if err != nil {
+		return nil, s.projectMemberUpdateError(ctx, err)
+	}
+
+	if err := s.publishProjectMemberMessages(ctx, projectUID, &previous, settings, fgaconstants.GenericMemberPutSubject, "member_put", role, username, xSync); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "added project member")
+	return settings, nil
+}
+
+// RemoveProjectMember removes the user with username from a project's writers
+// or auditors, re-reading the settings and retrying on concurrent writes, or,
+// with ifMatch, only from that revision of the settings. It returns
+// ErrProjectMemberNotFound when the user does not hold the role.
+func (s *ProjectsService) RemoveProjectMember(ctx context.Context, projectUID string, role models.ProjectMemberRole, username string, ifMatch *string, xSync bool) error {
+	ctx, span := startSpan(ctx, "ProjectsService.RemoveProjectMember",
+		attribute.String("project_uid", projectUID), attribute.String("role", string(role)))
+	defer span.End()
+
+	err := s.removeProjectMember(ctx, projectUID, role, strings.TrimSpace(username), ifMatch, xSync)
+	recordSpanError(span, err)
+	return err
+}
+
+func (s *ProjectsService) removeProjectMember(ctx context.Context, projectUID string, role models.ProjectMemberRole, username string, ifMatch *string, xSync bool) error {
+	if err := s.checkProjectMemberRequest(ctx, projectUID, role, username); err != nil {
+		return err
+	}
+
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", projectUID))
+	ctx = log.AppendCtx(ctx, slog.String("role", string(role)))
+
+	var previous models.ProjectSettings
+	settings, err := s.updateProjectSettingsIfMatch(ctx, projectUID, ifMatch, func(p *models.ProjectSettings) error {
+		members := p.Members(role)
+		remaining := slices.DeleteFunc(slices.Clone(*members), func(u models.UserInfo) bool { return u.Username == username })
+		if len(remaining) == len(*members) {
+			return domain.ErrProjectMemberNotFound
+		}
+		previous = *p
+		now := time.Now().UTC()
+		*members = remaining
+		p.UpdatedAt = &now
+		return nil
+	})
+	if errors.Is(err, domain.ErrProjectMemberNotFound) {
+		return domain.ErrProjectMemberNotFound
+	}
+	if err != nil {
+		return s.projectMemberUpdateError(ctx, err)
+	}
+
+	if err := s.publishProjectMemberMessages(ctx, projectUID, &previous, settings, fgaconstants.GenericMemberRemoveSubject, "member_remove", role, username, xSync); err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "removed project member")
+	return nil
+}
+
+// checkProjectMemberRequest checks what adding and removing a member have in
+// common: a ready service, a known role, a username, and the writer relation on
+// the project.
+func (s *ProjectsService) checkProjectMemberRequest(ctx context.Context, projectUID string, role models.ProjectMemberRole, username string) error {
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return domain.ErrServiceUnavailable
+	}
+	if !role.IsValid() {
+		slog.ErrorContext(ctx, "unknown project member role", "role", role)
+		return domain.ErrValidationFailed
+	}
+	if username == "" {
+		return domain.NewFieldValidationError("username", domain.FieldErrorRequired, domain.ErrValidationFailed)
+	}
+	return s.authorizeProject(ctx, projectUID, relationWriter)
+}
+
+// getProjectMemberSettings returns the project's settings after a member update
+// that changed nothing.
+func (s *ProjectsService) getProjectMemberSettings(ctx context.Context, projectUID string) (*models.ProjectSettings, error) {
+	settings, err := s.ProjectRepository.GetProjectSettings(ctx, projectUID)
+	if err != nil {
+		return nil, s.projectMemberUpdateError(ctx, err)
+	}
+	return settings, nil
+}
+
+// projectMemberUpdateError maps an error from reading or updating the project
+// settings to the error returned to the caller.
+func (s *ProjectsService) projectMemberUpdateError(ctx context.Context, err error) error {
+	if errors.Is(err, domain.ErrProjectNotFound) {
+		return domain.ErrProjectNotFound
+	}
+	if errors.Is(err, domain.ErrRevisionMismatch) {
+		return domain.ErrRevisionMismatch
+	}
+	if errors.Is(err, domain.ErrValidationFailed) {
+		return domain.ErrValidationFailed
+	}
+	slog.ErrorContext(ctx, "error updating project members", constants.ErrKey, err)
+	return domain.ErrInternal
+}
+
+// publishProjectMemberMessages publishes the messages that follow adding or
+// removing a member. The FGA message only changes the relation of that one
+// user, instead of syncing every relation on the project.
+func (s *ProjectsService) publishProjectMemberMessages(
+	ctx context.Context,
+	projectUID string,
+	previous, settings *models.ProjectSettings,
+	subject, operation string,
+	role models.ProjectMemberRole,
+	username string,
+	xSync bool,
+) error {
+	projectDB, err := s.ProjectRepository.GetProjectBase(ctx, projectUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error getting project from store", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	messages := projectSettingsUpdatedMessages(ctx, projectDB, previous, settings, outboundMessage{
+		kind:    models.OutboxKindAccess,
+		subject: subject,
+		message: fgatypes.GenericFGAMessage{
+			ObjectType: "project",
+			Operation:  operation,
+			Data: fgatypes.GenericMemberData{
+				UID:       projectUID,
+				Username:  username,
+				Relations: []string{string(role)},
+			},
+		},
+	})
+	return s.publishProjectMessages(ctx, projectUID, xSync, messages)
+}
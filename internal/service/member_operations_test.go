@@ -0,0 +1,318 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	fgaconstants "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/constants"
+	fgatypes "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+// expectMemberUpdate makes UpdateProjectSettingsWithRetry apply its modify
+// function to stored and return its result, and accepts the messages sent after
+// a successful update.
+func expectMemberUpdate(repo *domain.MockProjectRepository, mb *domain.MockMessageBuilder, stored *models.ProjectSettings, err error) {
+	call := repo.On("UpdateProjectSettingsWithRetry", mock.Anything, "project-1", mock.Anything).
+		Run(func(args mock.Arguments) {
+			_ = args.Get(2).(func(*models.ProjectSettings) error)(stored)
+		})
+	if err != nil {
+		call.Return(nil, err)
+		return
+	}
+	call.Return(stored, nil)
+	repo.On("GetProjectBase", mock.Anything, "project-1").Return(&models.ProjectBase{UID: "project-1", Slug: "project-1"}, nil)
+	mb.On("SendIndexerMessage", mock.Anything, constants.IndexProjectSettingsSubject, mock.Anything, false).Return(nil)
+	mb.On("SendAccessMessage", mock.Anything, mock.Anything, mock.Anything, false).Return(nil)
+	mb.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+}
+
+// memberMessage matches the FGA message for a single member change.
+func memberMessage(operation, username, relation string) any {
+	return fgatypes.GenericFGAMessage{
+		ObjectType: "project",
+		Operation:  operation,
+		Data: fgatypes.GenericMemberData{
+			UID:       "project-1",
+			Username:  username,
+			Relations: []string{relation},
+		},
+	}
+}
+
+func TestProjectsService_AddProjectMember(t *testing.T) {
+	alice := models.UserInfo{Name: "Alice", Username: "alice", Email: "alice@example.org"}
+
+	tests := []struct {
+		name          string
+		role          models.ProjectMemberRole
+		username      string
+		setupMocks    func(*domain.MockProjectRepository, *domain.MockMessageBuilder, *domain.MockUserReader)
+		expectedUsers []models.UserInfo
+		expectedErr   error
+		expectedFGA   any
+	}{
+		{
+			name:     "new writer is appended with their profile",
+			role:     models.ProjectMemberRoleWriter,
+			username: " bob ",
+			setupMocks: func(repo *domain.MockProjectRepository, mb *domain.MockMessageBuilder, users *domain.MockUserReader) {
+				users.On("UserMetadataByPrincipal", mock.Anything, "bob").
+					Return(&domain.UserMetadata{Name: "Bob", Picture: "https://example.org/bob.png"}, nil)
+				expectMemberUpdate(repo, mb, &models.ProjectSettings{UID: "project-1", Writers: []models.UserInfo{alice}}, nil)
+			},
+			expectedUsers: []models.UserInfo{alice, {Name: "Bob", Username: "bob", Avatar: "https://example.org/bob.png"}},
+			expectedFGA:   memberMessage("member_put", "bob", fgaconstants.RelationWriter),
+		},
+		{
+			name:     "existing auditor is left unchanged",
+			role:     models.ProjectMemberRoleAuditor,
+			username: "alice",
+			setupMocks: func(repo *domain.MockProjectRepository, mb *domain.MockMessageBuilder, users *domain.MockUserReader) {
+				users.On("UserMetadataByPrincipal", mock.Anything, "alice").Return(&domain.UserMetadata{Name: "Alice"}, nil)
+				stored := &models.ProjectSettings{UID: "project-1", Auditors: []models.UserInfo{alice}}
+				expectMemberUpdate(repo, mb, stored, errMemberUnchanged)
+				repo.On("GetProjectSettings", mock.Anything, "project-1").Return(stored, nil)
+			},
+			expectedUsers: []models.UserInfo{alice},
+		},
+		{
+			name:     "unknown user",
+			role:     models.ProjectMemberRoleWriter,
+			username: "nobody",
+			setupMocks: func(_ *domain.MockProjectRepository, _ *domain.MockMessageBuilder, users *domain.MockUserReader) {
+				users.On("UserMetadataByPrincipal", mock.Anything, "nobody").Return(nil, domain.ErrUserNotFound)
+			},
+			expectedErr: domain.ErrUserNotFound,
+		},
+		{
+			name:     "project not found",
+			role:     models.ProjectMemberRoleWriter,
+			username: "bob",
+			setupMocks: func(repo *domain.MockProjectRepository, _ *domain.MockMessageBuilder, users *domain.MockUserReader) {
+				users.On("UserMetadataByPrincipal", mock.Anything, "bob").Return(&domain.UserMetadata{Name: "Bob"}, nil)
+				repo.On("UpdateProjectSettingsWithRetry", mock.Anything, "project-1", mock.Anything).Return(nil, domain.ErrProjectNotFound)
+			},
+			expectedErr: domain.ErrProjectNotFound,
+		},
+		{
+			name:        "empty username",
+			role:        models.ProjectMemberRoleWriter,
+			username:    " ",
+			expectedErr: domain.ErrValidationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+			mockUsers := service.UserReader.(*domain.MockUserReader)
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockRepo, mockBuilder, mockUsers)
+			}
+
+			settings, err := service.AddProjectMember(context.Background(), "project-1", tt.role, tt.username, nil, false)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedUsers, *settings.Members(tt.role))
+			}
+			if tt.expectedFGA != nil {
+				mockBuilder.AssertCalled(t, "SendAccessMessage", mock.Anything, fgaconstants.GenericMemberPutSubject, tt.expectedFGA, false)
+			} else {
+				mockBuilder.AssertNotCalled(t, "SendAccessMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+			mockRepo.AssertExpectations(t)
+			mockUsers.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectsService_RemoveProjectMember(t *testing.T) {
+	alice := models.UserInfo{Name: "Alice", Username: "alice", Email: "alice@example.org"}
+	bob := models.UserInfo{Name: "Bob", Username: "bob", Email: "bob@example.org"}
+
+	t.Run("member is removed", func(t *testing.T) {
+		service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+		stored := &models.ProjectSettings{UID: "project-1", Writers: []models.UserInfo{alice, bob}}
+		expectMemberUpdate(mockRepo, mockBuilder, stored, nil)
+
+		err := service.RemoveProjectMember(context.Background(), "project-1", models.ProjectMemberRoleWriter, "alice", nil, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, []models.UserInfo{bob}, stored.Writers)
+		mockBuilder.AssertCalled(t, "SendAccessMessage", mock.Anything, fgaconstants.GenericMemberRemoveSubject,
+			memberMessage("member_remove", "alice", fgaconstants.RelationWriter), false)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("user without the role", func(t *testing.T) {
+		service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+		stored := &models.ProjectSettings{UID: "project-1", Writers: []models.UserInfo{alice}, Auditors: []models.UserInfo{bob}}
+		expectMemberUpdate(mockRepo, mockBuilder, stored, domain.ErrProjectMemberNotFound)
+
+		err := service.RemoveProjectMember(context.Background(), "project-1", models.ProjectMemberRoleWriter, "bob", nil, false)
+
+		assert.ErrorIs(t, err, domain.ErrProjectMemberNotFound)
+		assert.Equal(t, []models.UserInfo{alice}, stored.Writers)
+		mockBuilder.AssertNotCalled(t, "SendAccessMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestProjectsService_RemoveProjectMember_ifMatch(t *testing.T) {
+	alice := models.UserInfo{Name: "Alice", Username: "alice", Email: "alice@example.org"}
+	bob := models.UserInfo{Name: "Bob", Username: "bob", Email: "bob@example.org"}
+
+	t.Run("member is removed from the matching revision", func(t *testing.T) {
+		service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+		stored := &models.ProjectSettings{UID: "project-1", Writers: []models.UserInfo{alice, bob}}
+		mockRepo.On("GetProjectSettingsWithRevision", mock.Anything, "project-1").Return(stored, uint64(7), nil)
+		mockRepo.On("UpdateProjectSettings", mock.Anything, stored, uint64(7)).Return(nil)
+		mockRepo.On("GetProjectBase", mock.Anything, "project-1").Return(&models.ProjectBase{UID: "project-1", Slug: "project-1"}, nil)
+		mockBuilder.On("SendIndexerMessage", mock.Anything, constants.IndexProjectSettingsSubject, mock.Anything, false).Return(nil)
+		mockBuilder.On("SendAccessMessage", mock.Anything, mock.Anything, mock.Anything, false).Return(nil)
+		mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		err := service.RemoveProjectMember(context.Background(), "project-1", models.ProjectMemberRoleWriter, "alice", misc.StringPtr(`W/"7"`), false)
+
+		require.NoError(t, err)
+		assert.Equal(t, []models.UserInfo{bob}, stored.Writers)
+		mockRepo.AssertNotCalled(t, "UpdateProjectSettingsWithRetry", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("stale revision", func(t *testing.T) {
+		service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+		stored := &models.ProjectSettings{UID: "project-1", Writers: []models.UserInfo{alice, bob}}
+		mockRepo.On("GetProjectSettingsWithRevision", mock.Anything, "project-1").Return(stored, uint64(8), nil)
+
+		err := service.RemoveProjectMember(context.Background(), "project-1", models.ProjectMemberRoleWriter, "alice", misc.StringPtr("7"), false)
+
+		assert.ErrorIs(t, err, domain.ErrRevisionMismatch)
+		assert.Equal(t, []models.UserInfo{alice, bob}, stored.Writers)
+		mockRepo.AssertNotCalled(t, "UpdateProjectSettings", mock.Anything, mock.Anything, mock.Anything)
+		mockBuilder.AssertNotCalled(t, "SendAccessMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid If-Match", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+
+		err := service.RemoveProjectMember(context.Background(), "project-1", models.ProjectMemberRoleWriter, "alice", misc.StringPtr("abc"), false)
+
+		assert.ErrorIs(t, err, domain.ErrValidationFailed)
+	})
+}
+
+func TestProjectsService_JoinProject(t *testing.T) {
+	director := &models.UserInfo{Name: "Dana", Username: "dana", Email: "dana@example.org"}
+
+	tests := []struct {
+		name             string
+		principal        string
+		autojoinRole     models.ProjectMemberRole
+		setupMocks       func(*domain.MockProjectRepository, *domain.MockMessageBuilder, *domain.MockUserReader)
+		expectedRole     models.ProjectMemberRole
+		expectedErr      error
+		expectedContacts []domain.ProjectContact
+		expectedFGA      any
+	}{
+		{
+			name:      "principal joins as an auditor by default",
+			principal: "bob",
+			setupMocks: func(repo *domain.MockProjectRepository, mb *domain.MockMessageBuilder, users *domain.MockUserReader) {
+				repo.On("GetProjectBase", mock.Anything, "project-1").Return(&models.ProjectBase{UID: "project-1", AutojoinEnabled: true}, nil)
+				users.On("UserMetadataByPrincipal", mock.Anything, "bob").Return(&domain.UserMetadata{Name: "Bob"}, nil)
+				expectMemberUpdate(repo, mb, &models.ProjectSettings{UID: "project-1"}, nil)
+			},
+			expectedRole: models.ProjectMemberRoleAuditor,
+			expectedFGA:  memberMessage("member_put", "bob", fgaconstants.RelationAuditor),
+		},
+		{
+			name:         "configured role",
+			principal:    "bob",
+			autojoinRole: models.ProjectMemberRoleMeetingCoordinator,
+			setupMocks: func(repo *domain.MockProjectRepository, mb *domain.MockMessageBuilder, users *domain.MockUserReader) {
+				repo.On("GetProjectBase", mock.Anything, "project-1").Return(&models.ProjectBase{UID: "project-1", AutojoinEnabled: true}, nil)
+				users.On("UserMetadataByPrincipal", mock.Anything, "bob").Return(&domain.UserMetadata{Name: "Bob"}, nil)
+				expectMemberUpdate(repo, mb, &models.ProjectSettings{UID: "project-1"}, nil)
+			},
+			expectedRole: models.ProjectMemberRoleMeetingCoordinator,
+			expectedFGA:  memberMessage("member_put", "bob", string(models.ProjectMemberRoleMeetingCoordinator)),
+		},
+		{
+			name:      "autojoin disabled returns the contacts",
+			principal: "bob",
+			setupMocks: func(repo *domain.MockProjectRepository, _ *domain.MockMessageBuilder, _ *domain.MockUserReader) {
+				repo.On("GetProjectBase", mock.Anything, "project-1").Return(&models.ProjectBase{UID: "project-1"}, nil)
+				repo.On("GetProjectSettings", mock.Anything, "project-1").Return(&models.ProjectSettings{
+					UID:               "project-1",
+					ExecutiveDirector: director,
+					ProgramManager:    &models.UserInfo{Username: "no-email"},
+				}, nil)
+			},
+			expectedErr: domain.ErrAutojoinDisabled,
+			expectedContacts: []domain.ProjectContact{
+				{Role: domain.ContactRoleExecutiveDirector, Name: "Dana", Email: "dana@example.org"},
+			},
+		},
+		{
+			name:      "project not found",
+			principal: "bob",
+			setupMocks: func(repo *domain.MockProjectRepository, _ *domain.MockMessageBuilder, _ *domain.MockUserReader) {
+				repo.On("GetProjectBase", mock.Anything, "project-1").Return(nil, domain.ErrProjectNotFound)
+			},
+			expectedErr: domain.ErrProjectNotFound,
+		},
+		{
+			name:        "no principal",
+			expectedErr: domain.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+			service.Config.AutojoinRole = tt.autojoinRole
+			mockUsers := service.UserReader.(*domain.MockUserReader)
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockRepo, mockBuilder, mockUsers)
+			}
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tt.principal)
+
+			role, username, err := service.JoinProject(ctx, "project-1", false)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedRole, role)
+				assert.Equal(t, tt.principal, username)
+			}
+			var autojoinErr *domain.AutojoinDisabledError
+			if errors.As(err, &autojoinErr) {
+				assert.Equal(t, tt.expectedContacts, autojoinErr.Contacts)
+			}
+			if tt.expectedFGA != nil {
+				mockBuilder.AssertCalled(t, "SendAccessMessage", mock.Anything, fgaconstants.GenericMemberPutSubject, tt.expectedFGA, false)
+			} else {
+				mockBuilder.AssertNotCalled(t, "SendAccessMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+			mockRepo.AssertExpectations(t)
+			mockUsers.AssertExpectations(t)
+		})
+	}
+}
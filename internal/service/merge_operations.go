@@ -0,0 +1,349 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strconv"
+	"time"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// errMergeUnchanged aborts a project update of a merge that would not change
+// the project.
+var errMergeUnchanged = errors.New("merge does not change the project")
+
+// MergeProject merges the project payload.UID, such as a duplicate created by
+// an import, into the project payload.TargetUID. The children of the merged
+// project are moved under the target, its writers and auditors are added to
+// the target's, and it is deleted with a tombstone naming the target. Its slug
+// then redirects to the target. Each step is stored before the next one
+// starts, so a merge that failed before the project was deleted can be
+// retried. Returns the target project.
+func (s *ProjectsService) MergeProject(ctx context.Context, payload *projsvc.MergeProjectPayload) (*projsvc.ProjectFull, error) {
+	var attrs []attribute.KeyValue
+	if payload != nil {
+		attrs = append(payloadSpanAttrs(&payload.UID, payload.IfMatch), attribute.String("target_project.uid", payload.TargetUID))
+	}
+	ctx, span := startSpan(ctx, "ProjectsService.MergeProject", attrs...)
+	defer span.End()
+
+	project, err := s.mergeProject(ctx, payload)
+	recordSpanError(span, err)
+	return project, err
+}
+
+func (s *ProjectsService) mergeProject(ctx context.Context, payload *projsvc.MergeProjectPayload) (*projsvc.ProjectFull, error) {
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+
+	if payload == nil || payload.UID == "" || payload.TargetUID == "" {
+		slog.WarnContext(ctx, "project UID and target UID are required")
+		return nil, domain.ErrValidationFailed
+	}
+	if payload.UID == payload.TargetUID {
+		slog.WarnContext(ctx, "project cannot be merged into itself")
+		return nil, domain.NewFieldValidationError("target_uid", domain.FieldErrorInvalid, domain.ErrValidationFailed)
+	}
+
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", payload.UID))
+	ctx = log.AppendCtx(ctx, slog.String("target_project_uid", payload.TargetUID))
+
+	// The merged project is deleted, so only its owners may merge it.
+	if err := s.authorizeProject(ctx, payload.UID, relationOwner); err != nil {
+		return nil, err
+	}
+	if err := s.authorizeProject(ctx, payload.TargetUID, relationWriter); err != nil {
+		return nil, err
+	}
+
+	source, current, err := s.ProjectRepository.GetProjectBaseWithRevision(ctx, payload.UID)
+	if err != nil {
+		return nil, mergeReadError(ctx, err)
+	}
+	revision, err := s.ifMatchRevision(ctx, "project:"+payload.UID, payload.IfMatch, payload.ForceUpdate != nil && *payload.ForceUpdate, func() (uint64, error) {
+		return current, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if revision != current {
+		slog.WarnContext(ctx, "etag header is invalid", "etag", revision, "revision", current)
+		return nil, domain.ErrRevisionMismatch
+	}
+	ctx = log.AppendCtx(ctx, slog.String("etag", strconv.FormatUint(revision, 10)))
+
+	target, err := s.ProjectRepository.GetProjectBase(ctx, payload.TargetUID)
+	if err != nil {
+		return nil, mergeReadError(ctx, err)
+	}
+	sourceSettings, err := s.ProjectRepository.GetProjectSettings(ctx, source.UID)
+	if err != nil {
+		return nil, mergeReadError(ctx, err)
+	}
+
+	projects, err := s.ProjectRepository.ListAllProjectsBase(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "error listing projects for merge", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+	childUIDs, err := s.checkMergeHierarchy(ctx, projects, source.UID, target.UID)
+	if err != nil {
+		return nil, err
+	}
+
+	runSync := payload.XSync != nil && *payload.XSync
+	now := time.Now().UTC()
+
+	reparented, err := s.reparentProjectChildren(ctx, childUIDs, source.UID, target.UID, now, runSync)
+	if err != nil {
+		return nil, err
+	}
+
+	targetSettings, addedWriters, addedAuditors, err := s.mergeProjectMembers(ctx, target, sourceSettings, now, runSync)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ProjectRepository.DeleteProject(ctx, source.UID, revision); err != nil {
+		if errors.Is(err, domain.ErrRevisionMismatch) {
+			slog.WarnContext(ctx, "etag header is invalid", constants.ErrKey, err)
+			return nil, domain.ErrRevisionMismatch
+		}
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			return nil, domain.ErrProjectNotFound
+		}
+		slog.ErrorContext(ctx, "error deleting merged project from store", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+	s.recordProjectDeletion(ctx, source, target.UID)
+	s.invalidateProjectHierarchy()
+
+	mergeEvent := newProjectLifecycleEvent(ctx, events.ProjectMerged, source.UID)
+	mergeEvent.Project = DomainProjectToEvent(source)
+	mergeEvent.Merge = &events.ProjectMerge{
+		TargetUID:          target.UID,
+		TargetSlug:         target.Slug,
+		ReparentedChildren: reparented,
+		AddedWriters:       addedWriters,
+		AddedAuditors:      addedAuditors,
+	}
+	messages := append(projectDeletedMessages(ctx, source), outboundMessage{
+		kind:    models.OutboxKindEvent,
+		subject: projectEventSubject(mergeEvent.Type),
+		message: mergeEvent,
+	})
+	if err := s.publishProjectMessages(ctx, source.UID, runSync, messages); err != nil {
+		return nil, err
+	}
+
+	target, err = s.redirectMergedSlug(ctx, source, target.UID, now, runSync)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Audit(ctx, "project merged",
+		"project_uid", source.UID,
+		"project_slug", source.Slug,
+		"target_project_uid", target.UID,
+		"actor", mergeEvent.Actor.Username,
+		"event_id", mergeEvent.ID,
+		"reparented_children", reparented,
+		"added_writers", addedWriters,
+		"added_auditors", addedAuditors,
+	)
+
+	hierarchy, err := s.projectHierarchy(ctx, target.UID)
+	if err != nil {
+		slog.WarnContext(ctx, "project hierarchy fields are left out of the merged project", constants.ErrKey, err)
+	}
+	return ConvertToProjectFull(target, targetSettings, hierarchy), nil
+}
+
+// mergeReadError maps an error from reading a project of a merge to the error
+// returned to the caller.
+func mergeReadError(ctx context.Context, err error) error {
+	if errors.Is(err, domain.ErrProjectNotFound) {
+		slog.WarnContext(ctx, "project not found", constants.ErrKey, err)
+		return domain.ErrProjectNotFound
+	}
+	slog.ErrorContext(ctx, "error getting project from store", constants.ErrKey, err)
+	return domain.ErrInternal
+}
+
+// checkMergeHierarchy returns the UIDs of the children of the project
+// sourceUID, checking that moving them under the project targetUID neither
+// makes a project its own ancestor nor breaks the MaxChildProjects and
+// MaxHierarchyDepth limits.
+func (s *ProjectsService) checkMergeHierarchy(ctx context.Context, projects []*models.ProjectBase, sourceUID, targetUID string) ([]string, error) {
+	parents := make(map[string]string, len(projects))
+	children := map[string][]string{}
+	for _, project := range projects {
+		parents[project.UID] = project.ParentUID
+		if project.ParentUID != "" {
+			children[project.ParentUID] = append(children[project.ParentUID], project.UID)
+		}
+	}
+
+	// The target's level, counting the target itself and each of its ancestors.
+	targetLevel := 0
+	visited := map[string]bool{}
+	for ancestorUID := targetUID; ancestorUID != "" && !visited[ancestorUID]; ancestorUID = parents[ancestorUID] {
+		if ancestorUID == sourceUID {
+			slog.WarnContext(ctx, "project cannot be merged into one of its descendants")
+			return nil, domain.ErrProjectHierarchyCycle
+		}
+		visited[ancestorUID] = true
+		targetLevel++
+	}
+
+	childUIDs := children[sourceUID]
+	if len(childUIDs) == 0 {
+		return childUIDs, nil
+	}
+
+	if limit := s.Config.MaxChildProjects; limit > 0 {
+		count := len(children[targetUID]) + len(childUIDs)
+		if parents[sourceUID] == targetUID {
+			// The merged project is one of the target's children and goes away.
+			count--
+		}
+		if count > limit {
+			slog.WarnContext(ctx, "target project would exceed the maximum number of children", slog.Int("limit", limit))
+			return nil, &domain.PolicyViolationError{Policy: domain.PolicyMaxChildProjects, Limit: limit}
+		}
+	}
+
+	if limit := s.Config.MaxHierarchyDepth; limit > 0 {
+		for _, childUID := range childUIDs {
+			if targetLevel+subtreeHeight(children, childUID) > limit {
+				slog.WarnContext(ctx, "project hierarchy would exceed the maximum depth", slog.Int("limit", limit))
+				return nil, &domain.PolicyViolationError{Policy: domain.PolicyMaxHierarchyDepth, Limit: limit}
+			}
+		}
+	}
+
+	return childUIDs, nil
+}
+
+// reparentProjectChildren moves the children childUIDs of the project sourceUID
+// under the project targetUID and returns the UIDs of those moved. A child
+// moved elsewhere since it was listed is left where it is.
+func (s *ProjectsService) reparentProjectChildren(ctx context.Context, childUIDs []string, sourceUID, targetUID string, now time.Time, runSync bool) ([]string, error) {
+	reparented := []string{}
+	for _, childUID := range childUIDs {
+		var previous models.ProjectBase
+		child, err := s.ProjectRepository.UpdateProjectBaseWithRetry(ctx, childUID, func(p *models.ProjectBase) error {
+			if p.ParentUID != sourceUID {
+				return errMergeUnchanged
+			}
+			previous = *p
+			p.ParentUID = targetUID
+			p.UpdatedAt = &now
+			return nil
+		})
+		if errors.Is(err, errMergeUnchanged) || errors.Is(err, domain.ErrProjectNotFound) {
+			continue
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "error moving child project under merge target", constants.ErrKey, err, "child_project_uid", childUID)
+			return nil, domain.ErrInternal
+		}
+		s.invalidateProjectHierarchy()
+		reparented = append(reparented, childUID)
+
+		if err := s.publishProjectMessages(ctx, childUID, runSync, projectBaseUpdatedMessages(ctx, child, &previous)); err != nil {
+			return nil, err
+		}
+	}
+	return reparented, nil
+}
+
+// mergeProjectMembers adds the writers and auditors in sourceSettings that the
+// target project does not have to its settings. It returns the target's
+// settings and the usernames of the writers and auditors added.
+func (s *ProjectsService) mergeProjectMembers(ctx context.Context, target *models.ProjectBase, sourceSettings *models.ProjectSettings, now time.Time, runSync bool) (*models.ProjectSettings, []string, []string, error) {
+	var previous models.ProjectSettings
+	var addedWriters, addedAuditors []string
+	settings, err := s.ProjectRepository.UpdateProjectSettingsWithRetry(ctx, target.UID, func(p *models.ProjectSettings) error {
+		previous = *p
+		addedWriters = addMissingMembers(&p.Writers, sourceSettings.Writers)
+		addedAuditors = addMissingMembers(&p.Auditors, sourceSettings.Auditors)
+		if len(addedWriters) == 0 && len(addedAuditors) == 0 {
+			return errMergeUnchanged
+		}
+		p.UpdatedAt = &now
+		return nil
+	})
+	if errors.Is(err, errMergeUnchanged) {
+		settings, err = s.ProjectRepository.GetProjectSettings(ctx, target.UID)
+		if err != nil {
+			return nil, nil, nil, mergeReadError(ctx, err)
+		}
+		return settings, []string{}, []string{}, nil
+	}
+	if err != nil {
+		return nil, nil, nil, mergeReadError(ctx, err)
+	}
+
+	messages := projectSettingsUpdatedMessages(ctx, target, &previous, settings, projectSettingsAccessMessages(target, &previous, settings)...)
+	if err := s.publishProjectMessages(ctx, target.UID, runSync, messages); err != nil {
+		return nil, nil, nil, err
+	}
+	return settings, addedWriters, addedAuditors, nil
+}
+
+// addMissingMembers appends the users in from whose username is not in
+// members yet, and returns their usernames.
+func addMissingMembers(members *[]models.UserInfo, from []models.UserInfo) []string {
+	added := []string{}
+	for _, user := range from {
+		if user.Username == "" || slices.ContainsFunc(*members, func(u models.UserInfo) bool { return u.Username == user.Username }) {
+			continue
+		}
+		*members = append(slices.Clip(*members), user)
+		added = append(added, user.Username)
+	}
+	return added
+}
+
+// redirectMergedSlug records source, a project merged and deleted, on the
+// project targetUID it was merged into, and maps its slug to the target. The
+// merged project is already deleted, so a slug that cannot be redirected is
+// logged rather than returned.
+func (s *ProjectsService) redirectMergedSlug(ctx context.Context, source *models.ProjectBase, targetUID string, now time.Time, runSync bool) (*models.ProjectBase, error) {
+	var previous models.ProjectBase
+	target, err := s.ProjectRepository.UpdateProjectBaseWithRetry(ctx, targetUID, func(p *models.ProjectBase) error {
+		previous = *p
+		p.MergedFrom = append(slices.Clip(p.MergedFrom), models.MergedProject{UID: source.UID, Slug: source.Slug, MergedAt: now})
+		p.UpdatedAt = &now
+		return nil
+	})
+	if err != nil {
+		return nil, mergeReadError(ctx, err)
+	}
+
+	if s.SlugMappingRepository == nil {
+		slog.WarnContext(ctx, "slug of merged project not redirected, slug mappings are not stored apart from projects", "project_slug", source.Slug)
+	} else if err := s.SlugMappingRepository.PutSlugRedirect(ctx, source.Slug, targetUID); err != nil {
+		slog.ErrorContext(ctx, "error redirecting slug of merged project, rebuild the slug mappings to redirect it", constants.ErrKey, err, "project_slug", source.Slug)
+	}
+
+	if err := s.publishProjectMessages(ctx, targetUID, runSync, projectBaseUpdatedMessages(ctx, target, &previous)); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
@@ -0,0 +1,140 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+func TestProjectsService_MergeProject(t *testing.T) {
+	t.Run("children, members and slug move to the target", func(t *testing.T) {
+		service, repo, mb, _ := setupServiceForTesting()
+		slugs := &domain.MockSlugMappingRepository{}
+		service.SlugMappingRepository = slugs
+
+		source := &models.ProjectBase{UID: "source", Slug: "duplicate", Name: "Duplicate"}
+		target := &models.ProjectBase{UID: "target", Slug: "main", Name: "Main"}
+		child := &models.ProjectBase{UID: "child", Slug: "child", ParentUID: "source"}
+		sourceSettings := &models.ProjectSettings{
+			UID:      "source",
+			Writers:  []models.UserInfo{{Username: "alice"}, {Username: "bob"}},
+			Auditors: []models.UserInfo{{Username: "carol"}},
+		}
+		targetSettings := &models.ProjectSettings{UID: "target", Writers: []models.UserInfo{{Username: "alice"}}}
+
+		repo.On("GetProjectBaseWithRevision", mock.Anything, "source").Return(source, uint64(3), nil)
+		repo.On("GetProjectBase", mock.Anything, "target").Return(target, nil)
+		repo.On("GetProjectSettings", mock.Anything, "source").Return(sourceSettings, nil)
+		repo.On("ListAllProjectsBase", mock.Anything).Return([]*models.ProjectBase{source, target, child}, nil)
+		repo.On("UpdateProjectBaseWithRetry", mock.Anything, "child", mock.Anything).
+			Run(func(args mock.Arguments) {
+				require.NoError(t, args.Get(2).(func(*models.ProjectBase) error)(child))
+			}).
+			Return(child, nil)
+		repo.On("UpdateProjectSettingsWithRetry", mock.Anything, "target", mock.Anything).
+			Run(func(args mock.Arguments) {
+				require.NoError(t, args.Get(2).(func(*models.ProjectSettings) error)(targetSettings))
+			}).
+			Return(targetSettings, nil)
+		repo.On("DeleteProject", mock.Anything, "source", uint64(3)).Return(nil)
+		repo.On("UpdateProjectBaseWithRetry", mock.Anything, "target", mock.Anything).
+			Run(func(args mock.Arguments) {
+				require.NoError(t, args.Get(2).(func(*models.ProjectBase) error)(target))
+			}).
+			Return(target, nil)
+		slugs.On("PutSlugRedirect", mock.Anything, "duplicate", "target").Return(nil)
+
+		var merge *events.ProjectMerge
+		mb.On("SendIndexerMessage", mock.Anything, mock.Anything, mock.Anything, false).Return(nil)
+		mb.On("SendAccessMessage", mock.Anything, mock.Anything, mock.Anything, false).Return(nil)
+		mb.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				if event, ok := args.Get(2).(events.ProjectLifecycleEvent); ok && event.Type == events.ProjectMerged {
+					merge = event.Merge
+				}
+			}).
+			Return(nil)
+
+		result, err := service.MergeProject(context.Background(), &projsvc.MergeProjectPayload{
+			UID:       "source",
+			TargetUID: "target",
+			IfMatch:   misc.StringPtr("3"),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "target", *result.UID)
+		assert.Equal(t, "target", child.ParentUID)
+		assert.Equal(t, []models.UserInfo{{Username: "alice"}, {Username: "bob"}}, targetSettings.Writers)
+		assert.Equal(t, []models.UserInfo{{Username: "carol"}}, targetSettings.Auditors)
+		require.Len(t, target.MergedFrom, 1)
+		assert.Equal(t, "source", target.MergedFrom[0].UID)
+		assert.Equal(t, "duplicate", target.MergedFrom[0].Slug)
+		assert.True(t, target.HasMergedSlug("duplicate"))
+
+		require.NotNil(t, merge)
+		assert.Equal(t, "target", merge.TargetUID)
+		assert.Equal(t, []string{"child"}, merge.ReparentedChildren)
+		assert.Equal(t, []string{"bob"}, merge.AddedWriters)
+		assert.Equal(t, []string{"carol"}, merge.AddedAuditors)
+
+		repo.AssertExpectations(t)
+		slugs.AssertExpectations(t)
+	})
+
+	t.Run("project cannot be merged into itself", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+
+		_, err := service.MergeProject(context.Background(), &projsvc.MergeProjectPayload{
+			UID:       "source",
+			TargetUID: "source",
+			IfMatch:   misc.StringPtr("3"),
+		})
+		assert.ErrorIs(t, err, domain.ErrValidationFailed)
+	})
+
+	t.Run("project cannot be merged into its descendant", func(t *testing.T) {
+		service, repo, _, _ := setupServiceForTesting()
+
+		source := &models.ProjectBase{UID: "source", Slug: "duplicate"}
+		target := &models.ProjectBase{UID: "target", Slug: "main", ParentUID: "child"}
+		child := &models.ProjectBase{UID: "child", Slug: "child", ParentUID: "source"}
+		repo.On("GetProjectBaseWithRevision", mock.Anything, "source").Return(source, uint64(3), nil)
+		repo.On("GetProjectBase", mock.Anything, "target").Return(target, nil)
+		repo.On("GetProjectSettings", mock.Anything, "source").Return(&models.ProjectSettings{UID: "source"}, nil)
+		repo.On("ListAllProjectsBase", mock.Anything).Return([]*models.ProjectBase{source, target, child}, nil)
+
+		_, err := service.MergeProject(context.Background(), &projsvc.MergeProjectPayload{
+			UID:       "source",
+			TargetUID: "target",
+			IfMatch:   misc.StringPtr("3"),
+		})
+		assert.ErrorIs(t, err, domain.ErrProjectHierarchyCycle)
+		repo.AssertNotCalled(t, "DeleteProject", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("stale If-Match is rejected", func(t *testing.T) {
+		service, repo, _, _ := setupServiceForTesting()
+
+		repo.On("GetProjectBaseWithRevision", mock.Anything, "source").
+			Return(&models.ProjectBase{UID: "source", Slug: "duplicate"}, uint64(4), nil)
+
+		_, err := service.MergeProject(context.Background(), &projsvc.MergeProjectPayload{
+			UID:       "source",
+			TargetUID: "target",
+			IfMatch:   misc.StringPtr("3"),
+		})
+		assert.ErrorIs(t, err, domain.ErrRevisionMismatch)
+	})
+}
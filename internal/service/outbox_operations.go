@@ -0,0 +1,307 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	fgatypes "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/types"
+	indexerTypes "github.com/linuxfoundation/lfx-v2-indexer-service/pkg/types"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// outboxBaseRetryDelay is the delay before the first retry of a failed outbox message.
+	outboxBaseRetryDelay = 5 * time.Second
+	// outboxMaxRetryDelay caps the exponential backoff between outbox retries.
+	outboxMaxRetryDelay = 10 * time.Minute
+	// outboxInlineSendTimeout bounds the send that follows a write, retries
+	// included, after which the message is left to the dispatcher.
+	outboxInlineSendTimeout = 30 * time.Second
+	// outboxInlineSendGrace delays the first dispatch of a new message past
+	// outboxInlineSendTimeout, so that the dispatcher does not publish it again
+	// while the inline send is still running.
+	outboxInlineSendGrace = outboxInlineSendTimeout + 15*time.Second
+)
+
+// outboundMessage is a message to publish after a successful project write.
+type outboundMessage struct {
+	kind    models.OutboxMessageKind
+	subject string
+	message any
+}
+
+// OutboxDispatchResult summarizes a single pass over the outbox.
+type OutboxDispatchResult struct {
+	// Published is the number of messages published and removed from the outbox.
+	Published int
+	// Failed is the number of messages that failed again and were rescheduled.
+	Failed int
+	// Pending is the number of messages left waiting for their next retry.
+	Pending int
+}
+
+// publishProjectMessages publishes the messages that follow a project write.
+//
+// Without an outbox the messages are sent directly and any failure is returned.
+// With an outbox they are first recorded there, then sent; a message that fails
+// to send stays in the outbox for the dispatcher to retry, so the write is still
+// reported as successful. While the message builder reports publishing as
+// degraded, the messages are only recorded and left to the dispatcher.
+func (s *ProjectsService) publishProjectMessages(ctx context.Context, projectUID string, runSync bool, messages []outboundMessage) error {
+	if s.OutboxRepository == nil {
+		return s.sendOutboundMessages(ctx, runSync, messages)
+	}
+
+	records, err := newOutboxMessages(ctx, projectUID, messages)
+	if err == nil {
+		err = s.OutboxRepository.EnqueueOutboxMessages(ctx, records)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "error recording messages in the outbox, publishing directly", constants.ErrKey, err, "project_uid", projectUID)
+		return s.sendOutboundMessages(ctx, runSync, messages)
+	}
+
+	if publisher, ok := s.MessageBuilder.(domain.DegradedPublisher); ok && publisher.PublishDegraded() {
+		slog.WarnContext(ctx, "NATS publishing is degraded, leaving messages to the outbox dispatcher",
+			"project_uid", projectUID,
+			"messages", len(records),
+		)
+		return nil
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, outboxInlineSendTimeout)
+	defer cancel()
+
+	g := new(errgroup.Group)
+	for i, m := range messages {
+		record := records[i]
+		g.Go(func() error {
+			err := s.sendOutboundMessage(sendCtx, m.kind, m.subject, m.message, runSync)
+			s.settleOutboxMessage(ctx, record, err)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return nil
+}
+
+// sendOutboundMessages sends every message concurrently, returning domain.ErrInternal
+// if any of them fails.
+func (s *ProjectsService) sendOutboundMessages(ctx context.Context, runSync bool, messages []outboundMessage) error {
+	g := new(errgroup.Group)
+	for _, m := range messages {
+		g.Go(func() error {
+			return s.sendOutboundMessage(ctx, m.kind, m.subject, m.message, runSync)
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		// Return the first error from the goroutines.
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+// sendOutboundMessage sends a single message with the message builder method for its kind.
+func (s *ProjectsService) sendOutboundMessage(ctx context.Context, kind models.OutboxMessageKind, subject string, message any, sync bool) error {
+	switch kind {
+	case models.OutboxKindIndexer:
+		return s.MessageBuilder.SendIndexerMessage(ctx, subject, message, sync)
+	case models.OutboxKindAccess:
+		return s.MessageBuilder.SendAccessMessage(ctx, subject, message, sync)
+	case models.OutboxKindEvent:
+		return s.MessageBuilder.SendProjectEventMessage(ctx, subject, message)
+	default:
+		return fmt.Errorf("unsupported outbox message kind: %s", kind)
+	}
+}
+
+// settleOutboxMessage removes a message from the outbox once it has been sent, or
+// records the failed attempt and schedules the next retry. Either is only done
+// at the revision the message was read at: a message another replica settled
+// in the meantime is left as that replica left it.
+func (s *ProjectsService) settleOutboxMessage(ctx context.Context, record *models.OutboxMessage, sendErr error) {
+	if sendErr == nil {
+		err := s.OutboxRepository.DeleteOutboxMessage(ctx, record)
+		switch {
+		case errors.Is(err, domain.ErrRevisionMismatch):
+			slog.DebugContext(ctx, "outbox message settled elsewhere", "outbox_message_id", record.ID)
+		case err != nil:
+			// The message will be published again by the dispatcher; consumers are idempotent.
+			slog.WarnContext(ctx, "error removing published message from the outbox", constants.ErrKey, err, "outbox_message_id", record.ID)
+		}
+		return
+	}
+
+	record.Attempts++
+	record.LastError = sendErr.Error()
+	record.NextAttemptAt = time.Now().Add(outboxRetryDelay(record.Attempts))
+	slog.WarnContext(ctx, "error publishing outbox message, will retry", constants.ErrKey, sendErr,
+		"outbox_message_id", record.ID,
+		"subject", record.Subject,
+		"attempts", record.Attempts,
+		"next_attempt_at", record.NextAttemptAt,
+	)
+	err := s.OutboxRepository.UpdateOutboxMessage(ctx, record)
+	switch {
+	case errors.Is(err, domain.ErrRevisionMismatch):
+		slog.DebugContext(ctx, "outbox message settled elsewhere", "outbox_message_id", record.ID)
+	case err != nil:
+		slog.ErrorContext(ctx, "error recording failed outbox attempt", constants.ErrKey, err, "outbox_message_id", record.ID)
+	}
+}
+
+// ReconcileOutbox publishes every pending outbox message now, ignoring retry
+// backoff, on behalf of LF staff or a trusted service principal.
+func (s *ProjectsService) ReconcileOutbox(ctx context.Context) (*OutboxDispatchResult, error) {
+	if err := s.authorizeAdmin(ctx, "reconcile outbox"); err != nil {
+		return nil, err
+	}
+	return s.DispatchOutbox(ctx, true)
+}
+
+// DispatchOutbox publishes every outbox message that is due for a retry. When
+// force is set, messages are attempted regardless of their backoff schedule.
+func (s *ProjectsService) DispatchOutbox(ctx context.Context, force bool) (*OutboxDispatchResult, error) {
+	if s.OutboxRepository == nil || s.MessageBuilder == nil {
+		return nil, domain.ErrServiceUnavailable
+	}
+
+	ctx, span := startSpan(ctx, "ProjectsService.DispatchOutbox")
+	defer span.End()
+
+	messages, err := s.OutboxRepository.ListOutboxMessages(ctx)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	result := &OutboxDispatchResult{}
+	now := time.Now()
+	for _, record := range messages {
+		if !force && !record.Due(now) {
+			result.Pending++
+			continue
+		}
+
+		message, err := decodeOutboxPayload(record)
+		if err == nil {
+			msgCtx := ctx
+			if record.Principal != "" {
+				msgCtx = context.WithValue(ctx, constants.PrincipalContextID, record.Principal)
+			}
+			// Replays wait for a reply so that a message is only removed once a consumer has it.
+			err = s.sendOutboundMessage(msgCtx, record.Kind, record.Subject, message, true)
+		}
+		s.settleOutboxMessage(ctx, record, err)
+		if err != nil {
+			result.Failed++
+			continue
+		}
+		result.Published++
+	}
+
+	if result.Published > 0 || result.Failed > 0 {
+		slog.InfoContext(ctx, "dispatched outbox messages",
+			"published", result.Published,
+			"failed", result.Failed,
+			"pending", result.Pending,
+		)
+	}
+
+	return result, nil
+}
+
+// RunOutboxDispatcher calls DispatchOutbox every interval until ctx is cancelled.
+func (s *ProjectsService) RunOutboxDispatcher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.DispatchOutbox(ctx, false); err != nil {
+				slog.ErrorContext(ctx, "error dispatching outbox messages", constants.ErrKey, err)
+			}
+		}
+	}
+}
+
+// newOutboxMessages builds the outbox records for messages, attributed to the
+// principal on ctx. They are first due once the inline send is given up on.
+func newOutboxMessages(ctx context.Context, projectUID string, messages []outboundMessage) ([]*models.OutboxMessage, error) {
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	now := time.Now().UTC()
+	nextAttemptAt := now.Add(outboxInlineSendGrace)
+
+	records := make([]*models.OutboxMessage, 0, len(messages))
+	for _, m := range messages {
+		payload, err := json.Marshal(m.message)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, &models.OutboxMessage{
+			ID:            uuid.NewString(),
+			ProjectUID:    projectUID,
+			Kind:          m.kind,
+			Subject:       m.subject,
+			Payload:       payload,
+			Principal:     principal,
+			CreatedAt:     now,
+			NextAttemptAt: nextAttemptAt,
+		})
+	}
+	return records, nil
+}
+
+// decodeOutboxPayload restores the message value expected by the message builder
+// method for the record's kind.
+func decodeOutboxPayload(record *models.OutboxMessage) (any, error) {
+	switch record.Kind {
+	case models.OutboxKindIndexer:
+		// Delete messages are sent as the bare UID string.
+		var uid string
+		if err := json.Unmarshal(record.Payload, &uid); err == nil {
+			return uid, nil
+		}
+		var envelope indexerTypes.IndexerMessageEnvelope
+		if err := json.Unmarshal(record.Payload, &envelope); err != nil {
+			return nil, err
+		}
+		return envelope, nil
+	case models.OutboxKindAccess:
+		var message fgatypes.GenericFGAMessage
+		if err := json.Unmarshal(record.Payload, &message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	case models.OutboxKindEvent:
+		return record.Payload, nil
+	default:
+		return nil, fmt.Errorf("unsupported outbox message kind: %s", record.Kind)
+	}
+}
+
+// outboxRetryDelay returns the backoff before the next attempt after the given
+// number of failed attempts.
+func outboxRetryDelay(attempts int) time.Duration {
+	delay := outboxBaseRetryDelay
+	for i := 1; i < attempts && delay < outboxMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxMaxRetryDelay)
+}
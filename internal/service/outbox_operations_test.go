@@ -0,0 +1,271 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	fgatypes "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/types"
+	indexerConstants "github.com/linuxfoundation/lfx-v2-indexer-service/pkg/constants"
+	indexerTypes "github.com/linuxfoundation/lfx-v2-indexer-service/pkg/types"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectsService_publishProjectMessages(t *testing.T) {
+	messages := []outboundMessage{
+		{
+			kind:    models.OutboxKindIndexer,
+			subject: constants.IndexProjectSubject,
+			message: "project-1",
+		},
+		{
+			kind:    models.OutboxKindAccess,
+			subject: "lfx.fga-sync.update_access",
+			message: fgatypes.GenericFGAMessage{ObjectType: "project", Operation: "update_access"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		withOutbox  bool
+		setupMocks  func(*domain.MockMessageBuilder, *domain.MockOutboxRepository)
+		expectedErr error
+	}{
+		{
+			name: "without outbox a failed publish fails the request",
+			setupMocks: func(mb *domain.MockMessageBuilder, _ *domain.MockOutboxRepository) {
+				mb.On("SendIndexerMessage", mock.Anything, constants.IndexProjectSubject, "project-1", true).Return(nil)
+				mb.On("SendAccessMessage", mock.Anything, "lfx.fga-sync.update_access", mock.Anything, true).Return(errors.New("nats: no responders"))
+			},
+			expectedErr: domain.ErrInternal,
+		},
+		{
+			name:       "published messages are removed from the outbox",
+			withOutbox: true,
+			setupMocks: func(mb *domain.MockMessageBuilder, outbox *domain.MockOutboxRepository) {
+				outbox.On("EnqueueOutboxMessages", mock.Anything, mock.MatchedBy(func(records []*models.OutboxMessage) bool {
+					// The dispatcher leaves new messages to the inline send for a while.
+					return len(records) == 2 && records[0].ProjectUID == "project-1" && records[0].Principal == "user-1" &&
+						!records[0].Due(time.Now().Add(outboxInlineSendTimeout))
+				})).Return(nil)
+				mb.On("SendIndexerMessage", mock.Anything, constants.IndexProjectSubject, "project-1", true).Return(nil)
+				mb.On("SendAccessMessage", mock.Anything, "lfx.fga-sync.update_access", mock.Anything, true).Return(nil)
+				outbox.On("DeleteOutboxMessage", mock.Anything, mock.AnythingOfType("*models.OutboxMessage")).Return(nil).Times(2)
+			},
+		},
+		{
+			name:       "failed publish is kept in the outbox for retry",
+			withOutbox: true,
+			setupMocks: func(mb *domain.MockMessageBuilder, outbox *domain.MockOutboxRepository) {
+				outbox.On("EnqueueOutboxMessages", mock.Anything, mock.Anything).Return(nil)
+				mb.On("SendIndexerMessage", mock.Anything, constants.IndexProjectSubject, "project-1", true).Return(nil)
+				mb.On("SendAccessMessage", mock.Anything, "lfx.fga-sync.update_access", mock.Anything, true).Return(errors.New("nats: no responders"))
+				outbox.On("DeleteOutboxMessage", mock.Anything, mock.AnythingOfType("*models.OutboxMessage")).Return(nil).Once()
+				outbox.On("UpdateOutboxMessage", mock.Anything, mock.MatchedBy(func(record *models.OutboxMessage) bool {
+					return record.Kind == models.OutboxKindAccess && record.Attempts == 1 && record.LastError == "nats: no responders"
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name:       "outbox unavailable falls back to direct publish",
+			withOutbox: true,
+			setupMocks: func(mb *domain.MockMessageBuilder, outbox *domain.MockOutboxRepository) {
+				outbox.On("EnqueueOutboxMessages", mock.Anything, mock.Anything).Return(domain.ErrInternal)
+				mb.On("SendIndexerMessage", mock.Anything, constants.IndexProjectSubject, "project-1", true).Return(nil)
+				mb.On("SendAccessMessage", mock.Anything, "lfx.fga-sync.update_access", mock.Anything, true).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockBuilder := &domain.MockMessageBuilder{}
+			mockOutbox := &domain.MockOutboxRepository{}
+			tt.setupMocks(mockBuilder, mockOutbox)
+
+			s := &ProjectsService{MessageBuilder: mockBuilder}
+			if tt.withOutbox {
+				s.OutboxRepository = mockOutbox
+			}
+
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user-1")
+			err := s.publishProjectMessages(ctx, "project-1", true, messages)
+
+			assert.Equal(t, tt.expectedErr, err)
+			mockBuilder.AssertExpectations(t)
+			mockOutbox.AssertExpectations(t)
+		})
+	}
+}
+
+// outboxMessageWithID matches the outbox message with the ID.
+func outboxMessageWithID(id string) any {
+	return mock.MatchedBy(func(record *models.OutboxMessage) bool {
+		return record.ID == id
+	})
+}
+
+// degradedMessageBuilder is a message builder that reports publishing as degraded.
+type degradedMessageBuilder struct {
+	*domain.MockMessageBuilder
+}
+
+func (degradedMessageBuilder) PublishDegraded() bool { return true }
+
+func TestProjectsService_publishProjectMessages_degraded(t *testing.T) {
+	mockBuilder := &domain.MockMessageBuilder{}
+	mockOutbox := &domain.MockOutboxRepository{}
+	mockOutbox.On("EnqueueOutboxMessages", mock.Anything, mock.MatchedBy(func(records []*models.OutboxMessage) bool {
+		return len(records) == 1
+	})).Return(nil)
+
+	s := &ProjectsService{
+		MessageBuilder:   degradedMessageBuilder{mockBuilder},
+		OutboxRepository: mockOutbox,
+	}
+	err := s.publishProjectMessages(context.Background(), "project-1", true, []outboundMessage{
+		{kind: models.OutboxKindIndexer, subject: constants.IndexProjectSubject, message: "project-1"},
+	})
+
+	require.NoError(t, err)
+	mockBuilder.AssertNotCalled(t, "SendIndexerMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockOutbox.AssertExpectations(t)
+}
+
+func TestProjectsService_DispatchOutbox(t *testing.T) {
+	now := time.Now()
+	envelope, err := json.Marshal(indexerTypes.IndexerMessageEnvelope{Action: indexerConstants.ActionUpdated, Data: map[string]any{"uid": "project-1"}})
+	require.NoError(t, err)
+
+	dueIndexer := func() *models.OutboxMessage {
+		return &models.OutboxMessage{
+			ID:            "msg-1",
+			Kind:          models.OutboxKindIndexer,
+			Subject:       constants.IndexProjectSubject,
+			Payload:       envelope,
+			Principal:     "user-1",
+			NextAttemptAt: now.Add(-time.Second),
+		}
+	}
+	dueDelete := func() *models.OutboxMessage {
+		return &models.OutboxMessage{
+			ID:            "msg-2",
+			Kind:          models.OutboxKindIndexer,
+			Subject:       constants.IndexProjectSettingsSubject,
+			Payload:       json.RawMessage(`"project-1"`),
+			NextAttemptAt: now.Add(-time.Second),
+		}
+	}
+	backingOff := func() *models.OutboxMessage {
+		return &models.OutboxMessage{
+			ID:            "msg-3",
+			Kind:          models.OutboxKindEvent,
+			Subject:       "lfx.projects-api.events.project.updated",
+			Payload:       json.RawMessage(`{"type":"project.updated"}`),
+			Attempts:      2,
+			NextAttemptAt: now.Add(time.Hour),
+		}
+	}
+
+	tests := []struct {
+		name           string
+		force          bool
+		setupMocks     func(*domain.MockMessageBuilder, *domain.MockOutboxRepository)
+		expectedResult *OutboxDispatchResult
+		expectedErr    error
+	}{
+		{
+			name: "publishes due messages and skips those backing off",
+			setupMocks: func(mb *domain.MockMessageBuilder, outbox *domain.MockOutboxRepository) {
+				outbox.On("ListOutboxMessages", mock.Anything).Return([]*models.OutboxMessage{dueIndexer(), dueDelete(), backingOff()}, nil)
+				mb.On("SendIndexerMessage", mock.MatchedBy(func(ctx context.Context) bool {
+					principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+					return principal == "user-1"
+				}), constants.IndexProjectSubject, mock.AnythingOfType("types.IndexerMessageEnvelope"), true).Return(nil)
+				mb.On("SendIndexerMessage", mock.Anything, constants.IndexProjectSettingsSubject, "project-1", true).Return(nil)
+				outbox.On("DeleteOutboxMessage", mock.Anything, outboxMessageWithID("msg-1")).Return(nil)
+				// Settled by another replica in the meantime.
+				outbox.On("DeleteOutboxMessage", mock.Anything, outboxMessageWithID("msg-2")).Return(domain.ErrRevisionMismatch)
+			},
+			expectedResult: &OutboxDispatchResult{Published: 2, Pending: 1},
+		},
+		{
+			name:  "force retries messages that are backing off",
+			force: true,
+			setupMocks: func(mb *domain.MockMessageBuilder, outbox *domain.MockOutboxRepository) {
+				outbox.On("ListOutboxMessages", mock.Anything).Return([]*models.OutboxMessage{backingOff()}, nil)
+				mb.On("SendProjectEventMessage", mock.Anything, "lfx.projects-api.events.project.updated", json.RawMessage(`{"type":"project.updated"}`)).Return(errors.New("nats: connection closed"))
+				outbox.On("UpdateOutboxMessage", mock.Anything, mock.MatchedBy(func(record *models.OutboxMessage) bool {
+					return record.ID == "msg-3" && record.Attempts == 3 && record.NextAttemptAt.After(now)
+				})).Return(nil)
+			},
+			expectedResult: &OutboxDispatchResult{Failed: 1},
+		},
+		{
+			name: "list error",
+			setupMocks: func(_ *domain.MockMessageBuilder, outbox *domain.MockOutboxRepository) {
+				outbox.On("ListOutboxMessages", mock.Anything).Return(nil, domain.ErrInternal)
+			},
+			expectedErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockBuilder := &domain.MockMessageBuilder{}
+			mockOutbox := &domain.MockOutboxRepository{}
+			tt.setupMocks(mockBuilder, mockOutbox)
+			s := &ProjectsService{MessageBuilder: mockBuilder, OutboxRepository: mockOutbox}
+
+			result, err := s.DispatchOutbox(context.Background(), tt.force)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedResult, result)
+			mockBuilder.AssertExpectations(t)
+			mockOutbox.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectsService_ReconcileOutbox(t *testing.T) {
+	t.Run("forbidden for a principal that is not staff", func(t *testing.T) {
+		mockOutbox := &domain.MockOutboxRepository{}
+		s := &ProjectsService{MessageBuilder: &domain.MockMessageBuilder{}, OutboxRepository: mockOutbox}
+		ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user-1")
+
+		result, err := s.ReconcileOutbox(ctx)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		assert.Nil(t, result)
+		mockOutbox.AssertNotCalled(t, "ListOutboxMessages", mock.Anything)
+	})
+
+	t.Run("publishes pending messages for staff", func(t *testing.T) {
+		mockOutbox := &domain.MockOutboxRepository{}
+		mockOutbox.On("ListOutboxMessages", mock.Anything).Return([]*models.OutboxMessage{}, nil)
+		s := &ProjectsService{MessageBuilder: &domain.MockMessageBuilder{}, OutboxRepository: mockOutbox}
+
+		result, err := s.ReconcileOutbox(staffContext())
+
+		require.NoError(t, err)
+		assert.Equal(t, &OutboxDispatchResult{}, result)
+		mockOutbox.AssertExpectations(t)
+	})
+}
+
+func TestOutboxRetryDelay(t *testing.T) {
+	assert.Equal(t, 5*time.Second, outboxRetryDelay(1))
+	assert.Equal(t, 10*time.Second, outboxRetryDelay(2))
+	assert.Equal(t, 40*time.Second, outboxRetryDelay(4))
+	assert.Equal(t, outboxMaxRetryDelay, outboxRetryDelay(50))
+}
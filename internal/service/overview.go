@@ -0,0 +1,181 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// overviewElements are the elements kept in a project overview, with the
+// attributes kept on each. Other elements are removed but their text is kept.
+var overviewElements = map[atom.Atom][]string{
+	atom.P:          nil,
+	atom.Br:         nil,
+	atom.Hr:         nil,
+	atom.H2:         nil,
+	atom.H3:         nil,
+	atom.H4:         nil,
+	atom.Strong:     nil,
+	atom.B:          nil,
+	atom.Em:         nil,
+	atom.I:          nil,
+	atom.U:          nil,
+	atom.S:          nil,
+	atom.Code:       nil,
+	atom.Pre:        nil,
+	atom.Blockquote: nil,
+	atom.Ul:         nil,
+	atom.Ol:         nil,
+	atom.Li:         nil,
+	atom.Table:      nil,
+	atom.Thead:      nil,
+	atom.Tbody:      nil,
+	atom.Tr:         nil,
+	atom.Th:         nil,
+	atom.Td:         nil,
+	atom.A:          {"href", "title"},
+}
+
+// overviewDroppedElements are the elements removed from a project overview
+// together with their content.
+var overviewDroppedElements = map[atom.Atom]bool{
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Iframe:   true,
+	atom.Object:   true,
+	atom.Embed:    true,
+	atom.Noscript: true,
+	atom.Template: true,
+	atom.Title:    true,
+	atom.Textarea: true,
+	atom.Select:   true,
+	atom.Svg:      true,
+	atom.Math:     true,
+}
+
+// overviewLinkSchemes are the URL schemes that overview links may use.
+var overviewLinkSchemes = map[string]bool{
+	"http":   true,
+	"https":  true,
+	"mailto": true,
+}
+
+// sanitizeOverviewHTML returns the HTML of a project overview with only the
+// overviewElements kept, all of them closed, and the text of the overview with
+// the markup removed and its whitespace collapsed, for the search index. Links
+// are kept only with an absolute http, https or mailto URL and are marked
+// nofollow.
+func sanitizeOverviewHTML(raw string) (sanitized, text string) {
+	var out, plain strings.Builder
+	var open []atom.Atom
+	// dropped counts the open elements whose content is being removed.
+	dropped := 0
+
+	z := html.NewTokenizer(strings.NewReader(raw))
+	for {
+		tokenType := z.Next()
+		switch tokenType {
+		case html.ErrorToken:
+			// The input is read to its end; elements left open are closed.
+			for i := len(open) - 1; i >= 0; i-- {
+				out.WriteString("</" + open[i].String() + ">")
+			}
+			return out.String(), strings.Join(strings.Fields(plain.String()), " ")
+
+		case html.TextToken:
+			if dropped > 0 {
+				continue
+			}
+			content := string(z.Text())
+			out.WriteString(html.EscapeString(content))
+			plain.WriteString(content)
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := z.Token()
+			if overviewDroppedElements[token.DataAtom] {
+				if tokenType == html.StartTagToken {
+					dropped++
+				}
+				continue
+			}
+			if dropped > 0 {
+				continue
+			}
+			// Tags separate words in the text even when they are removed.
+			plain.WriteString(" ")
+			attrs, ok := overviewElements[token.DataAtom]
+			if !ok {
+				continue
+			}
+			out.WriteString("<" + token.DataAtom.String())
+			writeOverviewAttrs(&out, token, attrs)
+			out.WriteString(">")
+			if token.DataAtom != atom.Br && token.DataAtom != atom.Hr {
+				open = append(open, token.DataAtom)
+			}
+
+		case html.EndTagToken:
+			token := z.Token()
+			if overviewDroppedElements[token.DataAtom] {
+				if dropped > 0 {
+					dropped--
+				}
+				continue
+			}
+			if dropped > 0 {
+				continue
+			}
+			plain.WriteString(" ")
+			// An end tag closes the innermost open element it matches, and the
+			// elements opened inside it; unmatched end tags are removed.
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] != token.DataAtom {
+					continue
+				}
+				for j := len(open) - 1; j >= i; j-- {
+					out.WriteString("</" + open[j].String() + ">")
+				}
+				open = open[:i]
+				break
+			}
+		}
+	}
+}
+
+// writeOverviewAttrs writes the attributes of token that are in allowed.
+func writeOverviewAttrs(out *strings.Builder, token html.Token, allowed []string) {
+	for _, attr := range token.Attr {
+		if attr.Namespace != "" || !slices.Contains(allowed, attr.Key) {
+			continue
+		}
+		if attr.Key == "href" && !isOverviewLink(attr.Val) {
+			continue
+		}
+		out.WriteString(" " + attr.Key + `="` + html.EscapeString(attr.Val) + `"`)
+	}
+	if token.DataAtom == atom.A {
+		out.WriteString(` rel="nofollow noopener"`)
+	}
+}
+
+// isOverviewLink reports whether link is an absolute URL with one of the
+// overviewLinkSchemes.
+func isOverviewLink(link string) bool {
+	u, err := url.Parse(strings.TrimSpace(link))
+	if err != nil {
+		return false
+	}
+	if !overviewLinkSchemes[u.Scheme] {
+		return false
+	}
+	if u.Scheme == "mailto" {
+		return u.Opaque != ""
+	}
+	return u.Host != ""
+}
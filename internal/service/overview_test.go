@@ -0,0 +1,99 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+func TestSanitizeOverviewHTML(t *testing.T) {
+	tests := []struct {
+		name         string
+		raw          string
+		expectedHTML string
+		expectedText string
+	}{
+		{
+			name:         "formatting is kept",
+			raw:          "<h2>About</h2><p>We build <strong>open</strong> <em>source</em>.</p><ul><li>One</li><li>Two</li></ul>",
+			expectedHTML: "<h2>About</h2><p>We build <strong>open</strong> <em>source</em>.</p><ul><li>One</li><li>Two</li></ul>",
+			expectedText: "About We build open source . One Two",
+		},
+		{
+			name:         "scripts and styles are removed with their content",
+			raw:          `<p>Hi<script>alert("x")</script></p><style>p{color:red}</style>`,
+			expectedHTML: "<p>Hi</p>",
+			expectedText: "Hi",
+		},
+		{
+			name:         "unknown elements are removed but their text is kept",
+			raw:          `<div class="x"><span>Hello</span> <img src="https://example.org/a.png" onerror="alert(1)">world</div>`,
+			expectedHTML: "Hello world",
+			expectedText: "Hello world",
+		},
+		{
+			name:         "attributes other than the allowed ones are removed",
+			raw:          `<p onclick="alert(1)" style="color:red">Text</p>`,
+			expectedHTML: "<p>Text</p>",
+			expectedText: "Text",
+		},
+		{
+			name:         "http and mailto links are kept and marked nofollow",
+			raw:          `<a href="https://example.org/docs" title="Docs" target="_blank">Docs</a> <a href="mailto:info@example.org">Mail</a>`,
+			expectedHTML: `<a href="https://example.org/docs" title="Docs" rel="nofollow noopener">Docs</a> <a href="mailto:info@example.org" rel="nofollow noopener">Mail</a>`,
+			expectedText: "Docs Mail",
+		},
+		{
+			name:         "javascript and relative links are removed",
+			raw:          `<a href="JavaScript:alert(1)">Bad</a><a href="/docs">Relative</a>`,
+			expectedHTML: `<a rel="nofollow noopener">Bad</a><a rel="nofollow noopener">Relative</a>`,
+			expectedText: "Bad Relative",
+		},
+		{
+			name:         "text and attributes are escaped",
+			raw:          `<p title="x">1 &lt; 2 &amp; "quoted"</p><a href="https://example.org/?a=1&amp;b=&quot;2&quot;">Q</a>`,
+			expectedHTML: `<p>1 &lt; 2 &amp; &#34;quoted&#34;</p><a href="https://example.org/?a=1&amp;b=&#34;2&#34;" rel="nofollow noopener">Q</a>`,
+			expectedText: `1 < 2 & "quoted" Q`,
+		},
+		{
+			name:         "unclosed elements are closed and stray end tags removed",
+			raw:          "<p><strong>Bold</p></em><ul><li>Item",
+			expectedHTML: "<p><strong>Bold</strong></p><ul><li>Item</li></ul>",
+			expectedText: "Bold Item",
+		},
+		{
+			name:         "comments are removed",
+			raw:          "<p>Kept<!-- <script>alert(1)</script> --></p>",
+			expectedHTML: "<p>Kept</p>",
+			expectedText: "Kept",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sanitized, text := sanitizeOverviewHTML(tt.raw)
+			assert.Equal(t, tt.expectedHTML, sanitized)
+			assert.Equal(t, tt.expectedText, text)
+		})
+	}
+}
+
+func TestConvertToDBProjectSettings_sanitizesOverview(t *testing.T) {
+	settings, err := ConvertToDBProjectSettings(&projsvc.ProjectSettings{
+		UID:          misc.StringPtr("project-1"),
+		OverviewHTML: misc.StringPtr(`<p onclick="x()">Curated <b>content</b></p><script>steal()</script>`),
+	}, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "<p>Curated <b>content</b></p>", settings.OverviewHTML)
+	assert.Equal(t, "Curated content", settings.OverviewText)
+	assert.Contains(t, settings.TagsTemplatized(), "{{ overview_text }}")
+	assert.Equal(t, "<p>Curated <b>content</b></p>", *ConvertToServiceProjectSettings(settings).OverviewHTML)
+}
@@ -0,0 +1,129 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+)
+
+// ListProjectEvents returns the lifecycle events of a project, oldest first,
+// reconstructed from the revisions of its base and settings retained in the
+// project store, so that a new downstream consumer can backfill its state.
+// Only the events that occurred at or after since are returned, unless since
+// is zero.
+func (s *ProjectsService) ListProjectEvents(ctx context.Context, projectUID string, since time.Time) ([]*models.ProjectHistoryEvent, error) {
+	if !s.ServiceReady() || s.ProjectHistoryRepository == nil {
+		slog.ErrorContext(ctx, "service not ready or project history not available")
+		return nil, domain.ErrServiceUnavailable
+	}
+
+	ctx, span := startSpan(ctx, "ProjectsService.ListProjectEvents", attribute.String("project.uid", projectUID))
+	defer span.End()
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", projectUID))
+
+	revisions, err := s.ProjectHistoryRepository.GetProjectHistory(ctx, projectUID)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	history := projectHistoryEvents(projectUID, revisions)
+	result := make([]*models.ProjectHistoryEvent, 0, len(history))
+	for _, event := range history {
+		if !event.OccurredAt.Before(since) {
+			result = append(result, event)
+		}
+	}
+	return result, nil
+}
+
+// projectHistoryEvents reconstructs the lifecycle events of a project from the
+// revisions of its base and settings, oldest first:
+//   - the first revision of the base is project.created when it was written
+//     as the project was created, and carries the first settings revision
+//     written after it;
+//   - the other revisions of the base are project.updated, with the previous
+//     revision, when it is still retained;
+//   - the deletion of the base is project.deleted, with the last revision;
+//   - the other revisions of the settings are project.settings.updated, with
+//     the previous revision, when it is still retained.
+//
+// The deletion of the settings is part of the deletion of the project and is
+// not reported on its own.
+func projectHistoryEvents(projectUID string, revisions []models.ProjectRevision) []*models.ProjectHistoryEvent {
+	var (
+		result   []*models.ProjectHistoryEvent
+		project  *models.ProjectBase
+		settings *models.ProjectSettings
+		// created is the project.created event that still waits for the
+		// project's first settings.
+		created *models.ProjectHistoryEvent
+	)
+	for _, revision := range revisions {
+		event := &models.ProjectHistoryEvent{
+			ID:         projectHistoryEventID(projectUID, revision),
+			OccurredAt: revision.Created,
+			ProjectUID: projectUID,
+			Revision:   revision.Revision,
+		}
+		switch {
+		case revision.Kind == models.ProjectRevisionBase && revision.Deleted:
+			if project == nil {
+				continue
+			}
+			event.Type = string(events.ProjectDeleted)
+			event.Project = project
+			project, created = nil, nil
+		case revision.Kind == models.ProjectRevisionBase:
+			if project == nil && isNewProjectRevision(revision.Project) {
+				event.Type = string(events.ProjectCreated)
+				created, settings = event, nil
+			} else {
+				event.Type = string(events.ProjectUpdated)
+				event.PreviousProject = project
+			}
+			event.Project = revision.Project
+			project = revision.Project
+		case revision.Deleted:
+			settings = nil
+			continue
+		case created != nil && settings == nil:
+			created.Settings = revision.Settings
+			settings, created = revision.Settings, nil
+			continue
+		default:
+			event.Type = string(events.ProjectSettingsUpdated)
+			event.Settings = revision.Settings
+			event.PreviousSettings = settings
+			settings = revision.Settings
+		}
+		result = append(result, event)
+	}
+	return result
+}
+
+// isNewProjectRevision reports whether project was written as the project was
+// created, rather than by a later update.
+func isNewProjectRevision(project *models.ProjectBase) bool {
+	return project.CreatedAt != nil && (project.UpdatedAt == nil || project.UpdatedAt.Equal(*project.CreatedAt))
+}
+
+// projectHistoryEventID returns the ID of the event reconstructed from
+// revision, a UUID derived from the revision so that consumers replaying the
+// history more than once can tell the events they have already seen.
+func projectHistoryEventID(projectUID string, revision models.ProjectRevision) string {
+	name := fmt.Sprintf("lfx-projects-api/%s/%s/%d", revision.Kind, projectUID, revision.Revision)
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(name)).String()
+}
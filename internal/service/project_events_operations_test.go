@@ -0,0 +1,100 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+)
+
+func TestProjectHistoryEvents(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	updated := created.Add(time.Hour)
+	first := &models.ProjectBase{UID: "p1", Name: "First", CreatedAt: &created, UpdatedAt: &created}
+	second := &models.ProjectBase{UID: "p1", Name: "Second", CreatedAt: &created, UpdatedAt: &updated}
+	settings := &models.ProjectSettings{UID: "p1", MissionStatement: "first"}
+	newSettings := &models.ProjectSettings{UID: "p1", MissionStatement: "second"}
+
+	t.Run("full lifecycle", func(t *testing.T) {
+		history := projectHistoryEvents("p1", []models.ProjectRevision{
+			{Kind: models.ProjectRevisionBase, Revision: 1, Created: created, Project: first},
+			{Kind: models.ProjectRevisionSettings, Revision: 1, Created: created, Settings: settings},
+			{Kind: models.ProjectRevisionBase, Revision: 2, Created: updated, Project: second},
+			{Kind: models.ProjectRevisionSettings, Revision: 2, Created: updated.Add(time.Minute), Settings: newSettings},
+			{Kind: models.ProjectRevisionBase, Revision: 3, Created: updated.Add(time.Hour), Deleted: true},
+			{Kind: models.ProjectRevisionSettings, Revision: 3, Created: updated.Add(time.Hour), Deleted: true},
+		})
+		require.Len(t, history, 4)
+
+		assert.Equal(t, string(events.ProjectCreated), history[0].Type)
+		assert.Same(t, first, history[0].Project)
+		assert.Same(t, settings, history[0].Settings)
+
+		assert.Equal(t, string(events.ProjectUpdated), history[1].Type)
+		assert.Same(t, second, history[1].Project)
+		assert.Same(t, first, history[1].PreviousProject)
+
+		assert.Equal(t, string(events.ProjectSettingsUpdated), history[2].Type)
+		assert.Same(t, newSettings, history[2].Settings)
+		assert.Same(t, settings, history[2].PreviousSettings)
+
+		assert.Equal(t, string(events.ProjectDeleted), history[3].Type)
+		assert.Same(t, second, history[3].Project)
+		assert.Equal(t, uint64(3), history[3].Revision)
+	})
+
+	t.Run("history starting after creation has no created event", func(t *testing.T) {
+		history := projectHistoryEvents("p1", []models.ProjectRevision{
+			{Kind: models.ProjectRevisionBase, Revision: 2, Created: updated, Project: second},
+			{Kind: models.ProjectRevisionSettings, Revision: 2, Created: updated, Settings: newSettings},
+		})
+		require.Len(t, history, 2)
+		assert.Equal(t, string(events.ProjectUpdated), history[0].Type)
+		assert.Nil(t, history[0].PreviousProject)
+		assert.Equal(t, string(events.ProjectSettingsUpdated), history[1].Type)
+		assert.Nil(t, history[1].PreviousSettings)
+	})
+
+	t.Run("event IDs are stable", func(t *testing.T) {
+		revisions := []models.ProjectRevision{{Kind: models.ProjectRevisionBase, Revision: 1, Created: created, Project: first}}
+		assert.Equal(t, projectHistoryEvents("p1", revisions)[0].ID, projectHistoryEvents("p1", revisions)[0].ID)
+		assert.NotEqual(t, projectHistoryEvents("p1", revisions)[0].ID, projectHistoryEvents("p2", revisions)[0].ID)
+	})
+}
+
+func TestProjectsService_ListProjectEvents(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	updated := created.Add(time.Hour)
+
+	t.Run("events before since are left out", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+		history := &domain.MockProjectHistoryRepository{}
+		service.ProjectHistoryRepository = history
+		history.On("GetProjectHistory", mock.Anything, "p1").Return([]models.ProjectRevision{
+			{Kind: models.ProjectRevisionBase, Revision: 1, Created: created, Project: &models.ProjectBase{UID: "p1", CreatedAt: &created}},
+			{Kind: models.ProjectRevisionBase, Revision: 2, Created: updated, Project: &models.ProjectBase{UID: "p1", CreatedAt: &created, UpdatedAt: &updated}},
+		}, nil)
+
+		result, err := service.ListProjectEvents(context.Background(), "p1", updated)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, string(events.ProjectUpdated), result[0].Type)
+	})
+
+	t.Run("history not available", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+
+		_, err := service.ListProjectEvents(context.Background(), "p1", time.Time{})
+		assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+	})
+}
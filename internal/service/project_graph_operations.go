@@ -0,0 +1,240 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// projectGraphBatchWait is how long a project graph waits for more keys
+	// before fetching a batch.
+	projectGraphBatchWait = 2 * time.Millisecond
+	// projectGraphMaxBatch caps the keys fetched by one batch.
+	projectGraphMaxBatch = 100
+	// maxConcurrentProjectGraphReads bounds the repository reads of one batch
+	// made at once.
+	maxConcurrentProjectGraphReads = 8
+)
+
+// projectAccess is a relation checked on a project.
+type projectAccess struct {
+	projectUID string
+	relation   string
+}
+
+// ProjectGraph reads projects, their children and their settings for one
+// request that walks the project hierarchy, such as a GraphQL query. Reads of
+// the same kind made at about the same time are batched, and every result is
+// cached for the life of the graph, so a graph must not outlive its request.
+//
+// Projects are only returned to principals that are viewers of them, and
+// settings to principals that are auditors, as for the REST endpoints.
+type ProjectGraph struct {
+	service  *ProjectsService
+	projects *batchLoader[string, *models.ProjectBase]
+	settings *batchLoader[string, *models.ProjectSettings]
+	access   *batchLoader[projectAccess, bool]
+
+	childrenOnce sync.Once
+	children     map[string][]*models.ProjectBase
+	childrenErr  error
+}
+
+// NewProjectGraph returns an empty ProjectGraph for one request.
+func (s *ProjectsService) NewProjectGraph() *ProjectGraph {
+	g := &ProjectGraph{service: s}
+	g.projects = newBatchLoader(projectGraphBatchWait, projectGraphMaxBatch, g.fetchProjects)
+	g.settings = newBatchLoader(projectGraphBatchWait, projectGraphMaxBatch, g.fetchSettings)
+	g.access = newBatchLoader(projectGraphBatchWait, projectGraphMaxBatch, g.fetchAccess)
+	return g
+}
+
+// Project returns the project projectUID, or nil if it does not exist.
+func (g *ProjectGraph) Project(ctx context.Context, projectUID string) (*models.ProjectBase, error) {
+	if !g.service.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+
+	// Access is checked first so that the principal cannot tell whether a
+	// project it may not view exists.
+	if err := g.authorize(ctx, projectUID, relationViewer); err != nil {
+		return nil, err
+	}
+	return g.projects.Load(ctx, projectUID)
+}
+
+// ProjectBySlug returns the project with slug, or nil if there is none or the
+// principal may not view it. Slugs are guessable, so a project the principal
+// may not view is answered as if it did not exist, rather than as forbidden.
+func (g *ProjectGraph) ProjectBySlug(ctx context.Context, slug string) (*models.ProjectBase, error) {
+	if !g.service.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+
+	projectUID, err := g.service.ProjectRepository.GetProjectUIDFromSlug(ctx, slug)
+	if err != nil {
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			return nil, nil
+		}
+		slog.ErrorContext(ctx, "error getting project UID from slug", constants.ErrKey, err, "project_slug", slug)
+		return nil, domain.ErrInternal
+	}
+
+	project, err := g.Project(ctx, projectUID)
+	if errors.Is(err, domain.ErrForbidden) {
+		return nil, nil
+	}
+	return project, err
+}
+
+// Children returns the direct children of the project projectUID that the
+// principal can view, ordered by slug. The first call lists every project
+// once, so that the children of any number of projects cost a single read.
+func (g *ProjectGraph) Children(ctx context.Context, projectUID string) ([]*models.ProjectBase, error) {
+	g.childrenOnce.Do(func() {
+		projects, err := g.service.ProjectRepository.ListAllProjectsBase(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "error listing projects for project graph", constants.ErrKey, err)
+			g.childrenErr = domain.ErrInternal
+			return
+		}
+		g.children = map[string][]*models.ProjectBase{}
+		for _, project := range projects {
+			g.projects.Prime(project.UID, project)
+			if project.ParentUID != "" {
+				g.children[project.ParentUID] = append(g.children[project.ParentUID], project)
+			}
+		}
+		for _, children := range g.children {
+			sort.Slice(children, func(i, j int) bool {
+				return children[i].Slug < children[j].Slug
+			})
+		}
+	})
+	if g.childrenErr != nil {
+		return nil, g.childrenErr
+	}
+
+	children := g.children[projectUID]
+	allowed := make([]bool, len(children))
+	eg, egCtx := errgroup.WithContext(ctx)
+	for i, child := range children {
+		eg.Go(func() error {
+			var err error
+			allowed[i], err = g.access.Load(egCtx, projectAccess{projectUID: child.UID, relation: relationViewer})
+			return err
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	visible := make([]*models.ProjectBase, 0, len(children))
+	for i, child := range children {
+		if allowed[i] {
+			visible = append(visible, child)
+		}
+	}
+	return visible, nil
+}
+
+// Settings returns the settings of the project projectUID, or nil if there are
+// none. The principal must be an auditor of the project.
+func (g *ProjectGraph) Settings(ctx context.Context, projectUID string) (*models.ProjectSettings, error) {
+	if err := g.authorize(ctx, projectUID, relationAuditor); err != nil {
+		return nil, err
+	}
+	return g.settings.Load(ctx, projectUID)
+}
+
+// authorize checks that the principal in ctx has relation on the project.
+func (g *ProjectGraph) authorize(ctx context.Context, projectUID, relation string) error {
+	allowed, err := g.access.Load(ctx, projectAccess{projectUID: projectUID, relation: relation})
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return domain.ErrForbidden
+	}
+	return nil
+}
+
+func (g *ProjectGraph) fetchProjects(ctx context.Context, projectUIDs []string) (map[string]*models.ProjectBase, error) {
+	return fetchConcurrently(ctx, projectUIDs, func(ctx context.Context, projectUID string) (*models.ProjectBase, error) {
+		project, err := g.service.ProjectRepository.GetProjectBase(ctx, projectUID)
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "error getting project from store", constants.ErrKey, err, "project_uid", projectUID)
+			return nil, domain.ErrInternal
+		}
+		return project, nil
+	})
+}
+
+func (g *ProjectGraph) fetchSettings(ctx context.Context, projectUIDs []string) (map[string]*models.ProjectSettings, error) {
+	return fetchConcurrently(ctx, projectUIDs, func(ctx context.Context, projectUID string) (*models.ProjectSettings, error) {
+		settings, err := g.service.ProjectRepository.GetProjectSettings(ctx, projectUID)
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "error getting project settings from store", constants.ErrKey, err, "project_uid", projectUID)
+			return nil, domain.ErrInternal
+		}
+		if g.service.Config.RefreshUserInfo {
+			g.service.refreshUserInfo(ctx, settings)
+		}
+		return settings, nil
+	})
+}
+
+func (g *ProjectGraph) fetchAccess(ctx context.Context, checks []projectAccess) (map[projectAccess]bool, error) {
+	return fetchConcurrently(ctx, checks, func(ctx context.Context, check projectAccess) (bool, error) {
+		err := g.service.authorizeProject(ctx, check.projectUID, check.relation)
+		if errors.Is(err, domain.ErrForbidden) {
+			return false, nil
+		}
+		return err == nil, err
+	})
+}
+
+// fetchConcurrently calls fetch for each key, a few at a time, and returns the
+// values by key. The first error fails the whole batch.
+func fetchConcurrently[K comparable, V any](ctx context.Context, keys []K, fetch func(context.Context, K) (V, error)) (map[K]V, error) {
+	var mu sync.Mutex
+	values := make(map[K]V, len(keys))
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(maxConcurrentProjectGraphReads)
+	for _, key := range keys {
+		eg.Go(func() error {
+			value, err := fetch(egCtx, key)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			values[key] = value
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
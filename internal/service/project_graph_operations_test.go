@@ -0,0 +1,184 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+func TestBatchLoader(t *testing.T) {
+	var calls atomic.Int32
+	loader := newBatchLoader(10*time.Millisecond, 3, func(_ context.Context, keys []string) (map[string]int, error) {
+		calls.Add(1)
+		values := map[string]int{}
+		for _, key := range keys {
+			if key != "missing" {
+				values[key] = len(key)
+			}
+		}
+		return values, nil
+	})
+
+	keys := []string{"a", "bb", "ccc", "a", "missing"}
+	results := make([]int, len(keys))
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := loader.Load(context.Background(), key)
+			assert.NoError(t, err)
+			results[i] = value
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, []int{1, 2, 3, 1, 0}, results)
+	// Four distinct keys with a batch size of three take two fetches.
+	assert.Equal(t, int32(2), calls.Load())
+
+	// Cached keys, including misses, are not fetched again.
+	value, err := loader.Load(context.Background(), "missing")
+	assert.NoError(t, err)
+	assert.Zero(t, value)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestBatchLoader_error(t *testing.T) {
+	loader := newBatchLoader(time.Millisecond, 10, func(context.Context, []string) (map[string]int, error) {
+		return nil, domain.ErrInternal
+	})
+
+	_, err := loader.Load(context.Background(), "a")
+
+	assert.ErrorIs(t, err, domain.ErrInternal)
+}
+
+func TestBatchLoader_prime(t *testing.T) {
+	loader := newBatchLoader(time.Millisecond, 10, func(context.Context, []string) (map[string]int, error) {
+		t.Error("primed key was fetched")
+		return nil, nil
+	})
+	loader.Prime("a", 7)
+
+	value, err := loader.Load(context.Background(), "a")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 7, value)
+}
+
+func TestProjectGraph(t *testing.T) {
+	root := &models.ProjectBase{UID: "root", Slug: "root"}
+	childB := &models.ProjectBase{UID: "child-b", Slug: "b", ParentUID: "root"}
+	childA := &models.ProjectBase{UID: "child-a", Slug: "a", ParentUID: "root"}
+	private := &models.ProjectBase{UID: "private", Slug: "c", ParentUID: "root"}
+
+	service, mockRepo, _, _ := setupServiceForTesting()
+	checker := &domain.MockAccessChecker{}
+	service.AccessChecker = checker
+	checker.On("CheckAccess", mock.Anything, "alice", "project:private", "viewer").Return(false, nil)
+	checker.On("CheckAccess", mock.Anything, "alice", "project:child-b", "auditor").Return(false, nil)
+	checker.On("CheckAccess", mock.Anything, "alice", mock.Anything, mock.Anything).Return(true, nil)
+	mockRepo.On("GetProjectBase", mock.Anything, "root").Return(root, nil).Once()
+	mockRepo.On("ListAllProjectsBase", mock.Anything).Return([]*models.ProjectBase{root, childB, childA, private}, nil).Once()
+	mockRepo.On("GetProjectSettings", mock.Anything, "child-a").Return(&models.ProjectSettings{UID: "child-a", MissionStatement: "a"}, nil).Once()
+	mockRepo.On("GetProjectUIDFromSlug", mock.Anything, "private").Return("private", nil)
+	mockRepo.On("GetProjectUIDFromSlug", mock.Anything, "missing").Return("", domain.ErrProjectNotFound)
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "alice")
+
+	graph := service.NewProjectGraph()
+
+	project, err := graph.Project(ctx, "root")
+	require.NoError(t, err)
+	assert.Equal(t, root, project)
+
+	children, err := graph.Children(ctx, "root")
+	require.NoError(t, err)
+	assert.Equal(t, []*models.ProjectBase{childA, childB}, children)
+
+	// Children are cached by the listing, so reading them again costs nothing.
+	project, err = graph.Project(ctx, "child-a")
+	require.NoError(t, err)
+	assert.Equal(t, childA, project)
+	grandchildren, err := graph.Children(ctx, "child-a")
+	require.NoError(t, err)
+	assert.Empty(t, grandchildren)
+
+	settings, err := graph.Settings(ctx, "child-a")
+	require.NoError(t, err)
+	assert.Equal(t, "a", settings.MissionStatement)
+
+	_, err = graph.Settings(ctx, "child-b")
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	_, err = graph.Project(ctx, "private")
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	// A slug is answered the same whether its project is hidden or missing.
+	project, err = graph.ProjectBySlug(ctx, "private")
+	require.NoError(t, err)
+	assert.Nil(t, project)
+	project, err = graph.ProjectBySlug(ctx, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, project)
+
+	mockRepo.AssertExpectations(t)
+	checker.AssertNumberOfCalls(t, "CheckAccess", 6)
+}
+
+func TestProjectGraph_Project_notFound(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	mockRepo.On("GetProjectBase", mock.Anything, "missing").Return(nil, domain.ErrProjectNotFound)
+	mockRepo.On("GetProjectUIDFromSlug", mock.Anything, "missing").Return("", domain.ErrProjectNotFound)
+
+	graph := service.NewProjectGraph()
+
+	project, err := graph.Project(context.Background(), "missing")
+	assert.NoError(t, err)
+	assert.Nil(t, project)
+
+	project, err = graph.ProjectBySlug(context.Background(), "missing")
+	assert.NoError(t, err)
+	assert.Nil(t, project)
+}
+
+func TestProjectGraph_Settings_batched(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	uids := []string{"p-1", "p-2", "p-3", "p-4"}
+	for _, uid := range uids {
+		mockRepo.On("GetProjectSettings", mock.Anything, uid).Return(&models.ProjectSettings{UID: uid}, nil).Once()
+	}
+	mockRepo.On("GetProjectSettings", mock.Anything, "p-5").Return(nil, errors.New("nats timeout"))
+
+	graph := service.NewProjectGraph()
+
+	var wg sync.WaitGroup
+	for _, uid := range append(uids, uids...) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			settings, err := graph.Settings(context.Background(), uid)
+			assert.NoError(t, err)
+			assert.Equal(t, uid, settings.UID)
+		}()
+	}
+	wg.Wait()
+
+	_, err := graph.Settings(context.Background(), "p-5")
+	assert.ErrorIs(t, err, domain.ErrInternal)
+	mockRepo.AssertExpectations(t)
+}
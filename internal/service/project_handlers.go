@@ -9,18 +9,25 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 
 	"github.com/google/uuid"
+	inviteapi "github.com/linuxfoundation/lfx-v2-invite-service/pkg/api"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
 	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
 	structs "github.com/linuxfoundation/lfx-v2-project-service/pkg/struct"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // HandleMessage implements domain.MessageHandler interface
 func (s *ProjectsService) HandleMessage(ctx context.Context, msg domain.Message) {
-	subject := msg.Subject()
+	subject := s.Config.localSubject(msg.Subject())
+	ctx, span := startSpan(ctx, "ProjectsService.HandleMessage", attribute.String("subject", subject))
+	defer span.End()
 	ctx = log.AppendCtx(ctx, slog.String("subject", subject))
 	slog.DebugContext(ctx, "handling NATS message")
 
@@ -32,8 +39,23 @@ func (s *ProjectsService) HandleMessage(ctx context.Context, msg domain.Message)
 		constants.ProjectGetSlugSubject:      s.HandleProjectGetSlug,
 		constants.ProjectGetLogoSubject:      s.HandleProjectGetLogo,
 		constants.ProjectSlugToUIDSubject:    s.HandleProjectSlugToUID,
+		constants.ProjectLegacyToUIDSubject:  s.HandleProjectLegacyToUID,
 		constants.ProjectGetParentUIDSubject: s.HandleProjectGetParentUID,
 		constants.ProjectGetWritersSubject:   s.HandleProjectGetWriters,
+		constants.UserGetProjectsSubject:     s.HandleUserGetProjects,
+	}
+
+	if request, ok := events.ParseLookupRequest(msg.Data()); ok {
+		span.SetAttributes(attribute.String("lookup.version", request.Version))
+		s.respondLookup(ctx, msg, s.handleLookupRequest(ctx, subject, request))
+		return
+	}
+	if s.Config.VersionedLookupsOnly {
+		slog.WarnContext(ctx, "rejecting unversioned lookup request")
+		if err := msg.Respond(nil); err != nil {
+			slog.ErrorContext(ctx, "error responding to NATS message", constants.ErrKey, err)
+		}
+		return
 	}
 
 	handler, ok := handlers[subject]
@@ -49,7 +71,12 @@ func (s *ProjectsService) HandleMessage(ctx context.Context, msg domain.Message)
 
 	response, err = handler(ctx, msg)
 	if err != nil {
-		if errors.Is(err, domain.ErrProjectNotFound) {
+		recordSpanError(span, err)
+		if ctx.Err() != nil {
+			slog.WarnContext(ctx, "NATS handler did not finish in time",
+				constants.ErrKey, errors.Join(ctx.Err(), err),
+			)
+		} else if errors.Is(err, domain.ErrProjectNotFound) {
 			slog.WarnContext(ctx, "project not found while handling message",
 				constants.ErrKey, err,
 			)
@@ -73,28 +100,166 @@ func (s *ProjectsService) HandleMessage(ctx context.Context, msg domain.Message)
 	slog.DebugContext(ctx, "responded to NATS message", "response", response)
 }
 
-func (s *ProjectsService) handleProjectGetAttribute(ctx context.Context, msg domain.Message, subject, getAttribute string) ([]byte, error) {
+// respondLookup replies to msg with the envelope of a versioned lookup.
+func (s *ProjectsService) respondLookup(ctx context.Context, msg domain.Message, response events.LookupResponse) {
+	data, err := json.Marshal(response)
+	if err != nil {
+		slog.ErrorContext(ctx, "error marshalling lookup response", constants.ErrKey, err)
+		return
+	}
+	if err := msg.Respond(data); err != nil {
+		slog.ErrorContext(ctx, "error responding to NATS message", constants.ErrKey, err)
+		return
+	}
+	slog.DebugContext(ctx, "responded to NATS message", "response", data)
+}
+
+// EventHandlers returns the handlers of the events the service subscribes to,
+// keyed by subject. Unlike the lookups served by HandleMessage, events get no
+// reply, so a handler error is recorded as a dead letter for later replay.
+func (s *ProjectsService) EventHandlers() map[string]func(ctx context.Context, msg domain.Message) error {
+	return map[string]func(ctx context.Context, msg domain.Message) error{
+		constants.ProjectSettingsUpdatedSubject:            s.HandleProjectSettingsUpdated,
+		inviteapi.InviteServiceAcceptedSubject:             s.HandleInviteAccepted,
+		constants.ProjectDocumentCreatedSubject:            s.HandleProjectDocumentCreated,
+		constants.ProjectLinkCreatedSubject:                s.HandleProjectLinkCreated,
+		projectEventSubject(events.ProjectCreated):         handleAll(s.HandleProjectLogoChanged, s.HandleProjectWebhookEvent),
+		projectEventSubject(events.ProjectUpdated):         handleAll(s.HandleProjectLogoChanged, s.HandleProjectWebhookEvent),
+		projectEventSubject(events.ProjectDeleted):         s.HandleProjectWebhookEvent,
+		projectEventSubject(events.ProjectSettingsUpdated): s.HandleProjectWebhookEvent,
+		projectEventSubject(events.ProjectAnnounced):       s.HandleProjectWebhookEvent,
+		projectEventSubject(events.ProjectLegalChanged):    s.HandleProjectWebhookEvent,
+		projectEventSubject(events.ProjectMerged):          s.HandleProjectWebhookEvent,
+		projectEventSubject(events.ProjectTransferred):     s.HandleProjectWebhookEvent,
+	}
+}
+
+// StreamHandlers returns the handlers of the messages the service consumes
+// from JetStream streams, keyed by subject. A message is redelivered while its
+// handler fails with an error that domain.IsRetryable reports as transient,
+// and recorded as a dead letter once it gives up.
+func (s *ProjectsService) StreamHandlers() map[string]func(ctx context.Context, msg domain.Message) error {
+	return map[string]func(ctx context.Context, msg domain.Message) error{
+		constants.ProjectUpsertSubject: s.HandleProjectUpsert,
+	}
+}
+
+// handleAll returns an event handler that calls every handler, so that more
+// than one handler can subscribe to a subject. It fails if any handler fails;
+// as the event is then replayed to all of them, each must tolerate seeing it twice.
+func handleAll(handlers ...func(ctx context.Context, msg domain.Message) error) func(ctx context.Context, msg domain.Message) error {
+	return func(ctx context.Context, msg domain.Message) error {
+		var errs []error
+		for _, handle := range handlers {
+			errs = append(errs, handle(ctx, msg))
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// errLookupRedacted is returned by lookupProject when the attribute of a
+// private project must be withheld from the requester.
+var errLookupRedacted = errors.New("project is not public")
+
+// projectNameReply is the reply of get_name to an unversioned request that
+// includes the previous names.
+type projectNameReply struct {
+	Name          string                `json:"name"`
+	PreviousNames []models.PreviousName `json:"previous_names"`
+}
+
+// parseProjectLookupRequest parses the unversioned form of a project lookup,
+// a plain-text project UID or a JSON events.ProjectLookupRequest.
+func parseProjectLookupRequest(data []byte) (events.ProjectLookupRequest, error) {
+	if len(data) > 0 && data[0] == '{' {
+		var request events.ProjectLookupRequest
+		if err := json.Unmarshal(data, &request); err != nil {
+			return events.ProjectLookupRequest{}, err
+		}
+		return request, nil
+	}
+	return events.ProjectLookupRequest{UID: string(data)}, nil
+}
 
+// redactPrivateLookup is the policy hook for lookups of attributes of projects
+// that are not public. It reports whether the attribute must be withheld,
+// which under PrivateLookupRedact is unless the request asserts a trusted
+// service principal or a principal that is a viewer of the project.
+func (s *ProjectsService) redactPrivateLookup(ctx context.Context, project *models.ProjectBase, request events.ProjectLookupRequest) bool {
+	if project.Public || s.Config.PrivateLookupPolicy != PrivateLookupRedact {
+		return false
+	}
+	if request.Principal == "" {
+		return true
+	}
+	if slices.Contains(s.Config.TrustedPrincipals, request.Principal) {
+		return false
+	}
+	if s.AccessChecker == nil {
+		return true
+	}
+	allowed, err := s.AccessChecker.CheckAccess(ctx, request.Principal, "project:"+project.UID, relationViewer)
+	if err != nil {
+		slog.ErrorContext(ctx, "error checking project access for lookup", constants.ErrKey, err,
+			"principal", request.Principal)
+		return true
+	}
+	return !allowed
+}
+
+// lookupProject returns the project of a lookup of getAttribute, or
+// errLookupRedacted when the attribute must be withheld from the requester.
+func (s *ProjectsService) lookupProject(ctx context.Context, subject, getAttribute string, request events.ProjectLookupRequest, redactPrivate bool) (*models.ProjectBase, error) {
 	if !s.ServiceReady() {
 		slog.ErrorContext(ctx, "NATS KV store not initialized")
 		return nil, fmt.Errorf("NATS KV store not initialized")
 	}
 
-	projectUID := string(msg.Data())
+	projectUID := request.UID
+	trace.SpanFromContext(ctx).SetAttributes(projectUIDAttr(projectUID))
 
 	ctx = log.AppendCtx(ctx, slog.String("project_id", projectUID))
 	ctx = log.AppendCtx(ctx, slog.String("subject", subject))
 
 	// Validate that the project ID is a valid UUID.
-	_, err := uuid.Parse(projectUID)
+	if _, err := uuid.Parse(projectUID); err != nil {
+		return nil, fmt.Errorf("%w: invalid project UID: %w", domain.ErrValidationFailed, err)
+	}
+
+	project, err := s.ProjectRepository.GetProjectBase(ctx, projectUID)
 	if err != nil {
 		return nil, err
 	}
 
-	project, err := s.ProjectRepository.GetProjectBase(ctx, projectUID)
+	if redactPrivate && s.redactPrivateLookup(ctx, project, request) {
+		slog.DebugContext(ctx, "redacting attribute of private project", "attribute", getAttribute)
+		return nil, errLookupRedacted
+	}
+	return project, nil
+}
+
+// handleProjectLookup parses the unversioned lookup request of msg and returns
+// its project. A withheld attribute is returned as a nil project.
+func (s *ProjectsService) handleProjectLookup(ctx context.Context, msg domain.Message, subject, getAttribute string, redactPrivate bool) (*models.ProjectBase, events.ProjectLookupRequest, error) {
+	request, err := parseProjectLookupRequest(msg.Data())
+	if err != nil {
+		return nil, request, err
+	}
+	project, err := s.lookupProject(ctx, subject, getAttribute, request, redactPrivate)
+	if errors.Is(err, errLookupRedacted) {
+		return nil, request, nil
+	}
+	return project, request, err
+}
+
+func (s *ProjectsService) handleProjectGetAttribute(ctx context.Context, msg domain.Message, subject, getAttribute string, redactPrivate bool) ([]byte, error) {
+	project, _, err := s.handleProjectLookup(ctx, msg, subject, getAttribute, redactPrivate)
 	if err != nil {
 		return nil, err
 	}
+	if project == nil {
+		return []byte{}, nil
+	}
 
 	value, ok := structs.FieldByTag(project, "json", getAttribute)
 	if !ok {
@@ -110,18 +275,39 @@ func (s *ProjectsService) handleProjectGetAttribute(ctx context.Context, msg dom
 }
 
 // HandleProjectGetName is the message handler for the project-get-name subject.
+// Reply: the plain-text project name, or a JSON projectNameReply when the
+// request sets include_previous_names.
 func (s *ProjectsService) HandleProjectGetName(ctx context.Context, msg domain.Message) ([]byte, error) {
-	return s.handleProjectGetAttribute(ctx, msg, constants.ProjectGetNameSubject, "name")
+	project, request, err := s.handleProjectLookup(ctx, msg, constants.ProjectGetNameSubject, "name", true)
+	if err != nil {
+		return nil, err
+	}
+	if project == nil {
+		return []byte{}, nil
+	}
+	if !request.IncludePreviousNames {
+		return []byte(project.Name), nil
+	}
+
+	reply := projectNameReply{Name: project.Name, PreviousNames: project.PreviousNames}
+	if reply.PreviousNames == nil {
+		reply.PreviousNames = []models.PreviousName{}
+	}
+	out, err := json.Marshal(reply)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal project name: %w", err)
+	}
+	return out, nil
 }
 
 // HandleProjectGetSlug is the message handler for the project-get-slug subject.
 func (s *ProjectsService) HandleProjectGetSlug(ctx context.Context, msg domain.Message) ([]byte, error) {
-	return s.handleProjectGetAttribute(ctx, msg, constants.ProjectGetSlugSubject, "slug")
+	return s.handleProjectGetAttribute(ctx, msg, constants.ProjectGetSlugSubject, "slug", false)
 }
 
 // HandleProjectGetLogo is the message handler for the project-get-logo subject.
 func (s *ProjectsService) HandleProjectGetLogo(ctx context.Context, msg domain.Message) ([]byte, error) {
-	return s.handleProjectGetAttribute(ctx, msg, constants.ProjectGetLogoSubject, "logo_url")
+	return s.handleProjectGetAttribute(ctx, msg, constants.ProjectGetLogoSubject, "logo_url", true)
 }
 
 // HandleProjectSlugToUID is the message handler for the project-slug-to-uid subject.
@@ -144,9 +330,34 @@ func (s *ProjectsService) HandleProjectSlugToUID(ctx context.Context, msg domain
 	return []byte(project), nil
 }
 
+// HandleProjectLegacyToUID is the message handler for the project-legacy-to-uid subject.
+// Request: plain-text LFX v1 project ID. Reply: plain-text project UID.
+func (s *ProjectsService) HandleProjectLegacyToUID(ctx context.Context, msg domain.Message) ([]byte, error) {
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS KV store not initialized")
+		return nil, fmt.Errorf("NATS KV store not initialized")
+	}
+
+	legacyID := string(msg.Data())
+
+	ctx = log.AppendCtx(ctx, slog.String("legacy_id", legacyID))
+	ctx = log.AppendCtx(ctx, slog.String("subject", constants.ProjectLegacyToUIDSubject))
+
+	if legacyID == "" {
+		return nil, domain.ErrValidationFailed
+	}
+
+	projectUID, err := s.ProjectRepository.GetProjectUIDFromLegacyID(ctx, legacyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(projectUID), nil
+}
+
 // HandleProjectGetParentUID is the message handler for the project-get-parent-uid subject.
 func (s *ProjectsService) HandleProjectGetParentUID(ctx context.Context, msg domain.Message) ([]byte, error) {
-	return s.handleProjectGetAttribute(ctx, msg, constants.ProjectGetParentUIDSubject, "parent_uid")
+	return s.handleProjectGetAttribute(ctx, msg, constants.ProjectGetParentUIDSubject, "parent_uid", false)
 }
 
 // HandleProjectGetWriters is the message handler for the project-get-writers subject.
@@ -158,14 +369,29 @@ func (s *ProjectsService) HandleProjectGetWriters(ctx context.Context, msg domai
 		return nil, fmt.Errorf("NATS KV store not initialized")
 	}
 
-	projectUID := string(msg.Data())
+	writers, err := s.projectWriters(ctx, string(msg.Data()))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := json.Marshal(writers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal writers: %w", err)
+	}
+
+	return out, nil
+}
+
+// projectWriters returns the writers of the project with projectUID, or an
+// empty list when it has none.
+func (s *ProjectsService) projectWriters(ctx context.Context, projectUID string) ([]models.UserInfo, error) {
+	trace.SpanFromContext(ctx).SetAttributes(projectUIDAttr(projectUID))
 
 	ctx = log.AppendCtx(ctx, slog.String("project_id", projectUID))
 	ctx = log.AppendCtx(ctx, slog.String("subject", constants.ProjectGetWritersSubject))
 
-	_, err := uuid.Parse(projectUID)
-	if err != nil {
-		return nil, err
+	if _, err := uuid.Parse(projectUID); err != nil {
+		return nil, fmt.Errorf("%w: invalid project UID: %w", domain.ErrValidationFailed, err)
 	}
 
 	settings, err := s.ProjectRepository.GetProjectSettings(ctx, projectUID)
@@ -177,11 +403,5 @@ func (s *ProjectsService) HandleProjectGetWriters(ctx context.Context, msg domai
 	if writers == nil {
 		writers = []models.UserInfo{}
 	}
-
-	out, err := json.Marshal(writers)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal writers: %w", err)
-	}
-
-	return out, nil
+	return writers, nil
 }
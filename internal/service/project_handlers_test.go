@@ -6,6 +6,7 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestProjectsService_HandleMessage(t *testing.T) {
@@ -93,6 +95,15 @@ func TestProjectsService_HandleMessage(t *testing.T) {
 			},
 			expectCalls: true,
 		},
+		{
+			name:        "handle project legacy ID to UID message",
+			subject:     constants.ProjectLegacyToUIDSubject,
+			messageData: []byte("a0941000002wBz9AAE"),
+			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
+				mockRepo.On("GetProjectUIDFromLegacyID", mock.Anything, "a0941000002wBz9AAE").Return("test-project-uid", nil)
+			},
+			expectCalls: true,
+		},
 		{
 			name:        "handle project get parent UID message",
 			subject:     constants.ProjectGetParentUIDSubject,
@@ -198,6 +209,39 @@ func TestProjectsService_HandleProjectGetName(t *testing.T) {
 				assert.Equal(t, "Test Project Name", string(response))
 			},
 		},
+		{
+			name:        "previous names included on request",
+			messageData: []byte(`{"uid": "01234567-89ab-cdef-0123-456789abcdef", "include_previous_names": true}`),
+			setupMocks: func(mockRepo *domain.MockProjectRepository) {
+				renamedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+				mockRepo.On("GetProjectBase", mock.Anything, "01234567-89ab-cdef-0123-456789abcdef").Return(
+					&models.ProjectBase{
+						UID:           "01234567-89ab-cdef-0123-456789abcdef",
+						Name:          "Test Project Name",
+						PreviousNames: []models.PreviousName{{Name: "Old Project Name", RenamedAt: renamedAt}},
+					},
+					nil,
+				)
+			},
+			expectedErr: false,
+			validate: func(t *testing.T, response []byte) {
+				assert.JSONEq(t, `{"name":"Test Project Name","previous_names":[{"name":"Old Project Name","renamed_at":"2024-05-01T12:00:00Z"}]}`, string(response))
+			},
+		},
+		{
+			name:        "previous names requested for a never renamed project",
+			messageData: []byte(`{"uid": "01234567-89ab-cdef-0123-456789abcdef", "include_previous_names": true}`),
+			setupMocks: func(mockRepo *domain.MockProjectRepository) {
+				mockRepo.On("GetProjectBase", mock.Anything, "01234567-89ab-cdef-0123-456789abcdef").Return(
+					&models.ProjectBase{UID: "01234567-89ab-cdef-0123-456789abcdef", Name: "Test Project Name"},
+					nil,
+				)
+			},
+			expectedErr: false,
+			validate: func(t *testing.T, response []byte) {
+				assert.JSONEq(t, `{"name":"Test Project Name","previous_names":[]}`, string(response))
+			},
+		},
 		{
 			name:        "project not found",
 			messageData: []byte("01234567-89ab-cdef-0123-456789abcd00"),
@@ -259,6 +303,112 @@ func TestProjectsService_HandleProjectGetName(t *testing.T) {
 	}
 }
 
+func TestProjectsService_HandleProjectGetName_privateLookupPolicy(t *testing.T) {
+
+	ctx := context.Background()
+	projectUID := "01234567-89ab-cdef-0123-456789abcdef"
+
+	tests := []struct {
+		name             string
+		policy           PrivateLookupPolicy
+		public           bool
+		messageData      []byte
+		setupMocks       func(*domain.MockAccessChecker)
+		expectedResponse string
+	}{
+		{
+			name:             "allow policy returns name of private project",
+			policy:           PrivateLookupAllow,
+			messageData:      []byte(projectUID),
+			setupMocks:       func(*domain.MockAccessChecker) {},
+			expectedResponse: "Private Project",
+		},
+		{
+			name:             "redact policy returns name of public project",
+			policy:           PrivateLookupRedact,
+			public:           true,
+			messageData:      []byte(projectUID),
+			setupMocks:       func(*domain.MockAccessChecker) {},
+			expectedResponse: "Private Project",
+		},
+		{
+			name:             "redact policy without access assertion",
+			policy:           PrivateLookupRedact,
+			messageData:      []byte(projectUID),
+			setupMocks:       func(*domain.MockAccessChecker) {},
+			expectedResponse: "",
+		},
+		{
+			name:        "redact policy with viewer assertion",
+			policy:      PrivateLookupRedact,
+			messageData: []byte(`{"uid":"` + projectUID + `","principal":"alice"}`),
+			setupMocks: func(checker *domain.MockAccessChecker) {
+				checker.On("CheckAccess", mock.Anything, "alice", "project:"+projectUID, "viewer").Return(true, nil)
+			},
+			expectedResponse: "Private Project",
+		},
+		{
+			name:             "redact policy with trusted principal assertion",
+			policy:           PrivateLookupRedact,
+			messageData:      []byte(`{"uid":"` + projectUID + `","principal":"svc-committee"}`),
+			setupMocks:       func(*domain.MockAccessChecker) {},
+			expectedResponse: "Private Project",
+		},
+		{
+			name:        "redact policy with non-viewer assertion",
+			policy:      PrivateLookupRedact,
+			messageData: []byte(`{"uid":"` + projectUID + `","principal":"mallory"}`),
+			setupMocks: func(checker *domain.MockAccessChecker) {
+				checker.On("CheckAccess", mock.Anything, "mallory", "project:"+projectUID, "viewer").Return(false, nil)
+			},
+			expectedResponse: "",
+		},
+		{
+			name:        "redact policy when access check fails",
+			policy:      PrivateLookupRedact,
+			messageData: []byte(`{"uid":"` + projectUID + `","principal":"alice"}`),
+			setupMocks: func(checker *domain.MockAccessChecker) {
+				checker.On("CheckAccess", mock.Anything, "alice", "project:"+projectUID, "viewer").Return(false, errors.New("timeout"))
+			},
+			expectedResponse: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := setupServiceForTesting()
+			checker := &domain.MockAccessChecker{}
+			tt.setupMocks(checker)
+			service.AccessChecker = checker
+			service.Config.PrivateLookupPolicy = tt.policy
+			service.Config.TrustedPrincipals = []string{"svc-committee"}
+			mockRepo.On("GetProjectBase", mock.Anything, projectUID).Return(
+				&models.ProjectBase{UID: projectUID, Name: "Private Project", Public: tt.public}, nil,
+			)
+
+			response, err := service.HandleProjectGetName(ctx, newMockMessage(constants.ProjectGetNameSubject, tt.messageData))
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResponse, string(response))
+			checker.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectsService_HandleProjectGetSlug_notRedacted(t *testing.T) {
+	projectUID := "01234567-89ab-cdef-0123-456789abcdef"
+	service, mockRepo, _, _ := setupServiceForTesting()
+	service.Config.PrivateLookupPolicy = PrivateLookupRedact
+	mockRepo.On("GetProjectBase", mock.Anything, projectUID).Return(
+		&models.ProjectBase{UID: projectUID, Slug: "private-project"}, nil,
+	)
+
+	response, err := service.HandleProjectGetSlug(context.Background(), newMockMessage(constants.ProjectGetSlugSubject, []byte(projectUID)))
+
+	require.NoError(t, err)
+	assert.Equal(t, "private-project", string(response))
+}
+
 func TestProjectsService_HandleProjectGetSlug(t *testing.T) {
 
 	ctx := context.Background()
@@ -510,6 +660,62 @@ func TestProjectsService_HandleProjectSlugToUID(t *testing.T) {
 	}
 }
 
+func TestProjectsService_HandleProjectLegacyToUID(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		messageData []byte
+		setupMocks  func(*domain.MockProjectRepository)
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:        "legacy ID is resolved",
+			messageData: []byte("a0941000002wBz9AAE"),
+			setupMocks: func(mockRepo *domain.MockProjectRepository) {
+				mockRepo.On("GetProjectUIDFromLegacyID", mock.Anything, "a0941000002wBz9AAE").Return("test-project-uid", nil)
+			},
+			expected: "test-project-uid",
+		},
+		{
+			name:        "legacy ID not found",
+			messageData: []byte("a0941000002wBz9AAE"),
+			setupMocks: func(mockRepo *domain.MockProjectRepository) {
+				mockRepo.On("GetProjectUIDFromLegacyID", mock.Anything, "a0941000002wBz9AAE").Return("", domain.ErrProjectNotFound)
+			},
+			expectedErr: domain.ErrProjectNotFound,
+		},
+		{
+			name:        "empty legacy ID",
+			messageData: []byte(""),
+			setupMocks:  func(_ *domain.MockProjectRepository) {},
+			expectedErr: domain.ErrValidationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := setupServiceForTesting()
+			tt.setupMocks(mockRepo)
+
+			mockMsg := newMockMessage(constants.ProjectLegacyToUIDSubject, tt.messageData)
+
+			response, err := service.HandleProjectLegacyToUID(ctx, mockMsg)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, response)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, string(response))
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestProjectsService_HandleProjectGetParentUID(t *testing.T) {
 
 	ctx := context.Background()
@@ -0,0 +1,78 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// projectHierarchyTTL is how long a cached project hierarchy is used before
+// the projects are listed again.
+const projectHierarchyTTL = 30 * time.Second
+
+// projectHierarchyCache holds the last project hierarchy built, so that the
+// hierarchy fields of single projects are computed without listing every
+// project each time.
+type projectHierarchyCache struct {
+	mu        sync.Mutex
+	hierarchy *models.ProjectHierarchy
+	builtAt   time.Time
+}
+
+// projectHierarchy returns the project hierarchy, from the cache when it is
+// fresh and has every project in projectUIDs. Otherwise every project is
+// listed and the cache is replaced.
+func (s *ProjectsService) projectHierarchy(ctx context.Context, projectUIDs ...string) (*models.ProjectHierarchy, error) {
+	s.hierarchyCache.mu.Lock()
+	hierarchy := s.hierarchyCache.hierarchy
+	fresh := hierarchy != nil && time.Since(s.hierarchyCache.builtAt) < projectHierarchyTTL
+	s.hierarchyCache.mu.Unlock()
+	if fresh {
+		complete := true
+		for _, projectUID := range projectUIDs {
+			if projectUID != "" && !hierarchy.Has(projectUID) {
+				complete = false
+				break
+			}
+		}
+		if complete {
+			return hierarchy, nil
+		}
+	}
+
+	projects, err := s.ProjectRepository.ListAllProjectsBase(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "error listing projects for project hierarchy", constants.ErrKey, err)
+		return nil, err
+	}
+	hierarchy = models.NewProjectHierarchy()
+	for _, project := range projects {
+		hierarchy.Add(project)
+	}
+	s.cacheProjectHierarchy(hierarchy)
+	return hierarchy, nil
+}
+
+// cacheProjectHierarchy replaces the cached project hierarchy with hierarchy,
+// which must index every project.
+func (s *ProjectsService) cacheProjectHierarchy(hierarchy *models.ProjectHierarchy) {
+	s.hierarchyCache.mu.Lock()
+	defer s.hierarchyCache.mu.Unlock()
+	s.hierarchyCache.hierarchy = hierarchy
+	s.hierarchyCache.builtAt = time.Now()
+}
+
+// invalidateProjectHierarchy drops the cached project hierarchy after a
+// project is created, moved or deleted.
+func (s *ProjectsService) invalidateProjectHierarchy() {
+	s.hierarchyCache.mu.Lock()
+	defer s.hierarchyCache.mu.Unlock()
+	s.hierarchyCache.hierarchy = nil
+}
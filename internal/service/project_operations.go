@@ -6,11 +6,17 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"maps"
+	"net/mail"
+	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	fgaconstants "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/constants"
@@ -21,82 +27,164 @@ import (
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/metrics"
 	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
 	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
 	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/errgroup"
+	"golang.org/x/text/language"
 )
 
-// GetProjects fetches all projects
-func (s *ProjectsService) GetProjects(ctx context.Context) ([]*projsvc.ProjectFull, error) {
+// GetProjects fetches all projects, keeping only those that have every tag in
+// the payload's tag filter. The response may be cached by anyone when it only
+// holds the base fields of public projects.
+func (s *ProjectsService) GetProjects(ctx context.Context, payload *projsvc.GetProjectsPayload) (*projsvc.GetProjectsResult, error) {
 	if !s.ServiceReady() {
 		slog.ErrorContext(ctx, "NATS connection or store not initialized")
 		return nil, domain.ErrServiceUnavailable
 	}
 
-	// Get all projects from the store
-	projectsBase, projectsSettings, err := s.ProjectRepository.ListAllProjects(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	// Create maps for easy lookup
-	projectsBaseMap := make(map[string]*models.ProjectBase)
-	for _, proj := range projectsBase {
-		projectsBaseMap[proj.UID] = proj
-	}
-
-	projectsSettingsMap := make(map[string]*models.ProjectSettings)
-	for _, settings := range projectsSettings {
-		projectsSettingsMap[settings.UID] = settings
-	}
-
 	// Combine base and settings to get the full project data
 	projectsFull := []*projsvc.ProjectFull{}
-	for uid, projectBase := range projectsBaseMap {
-		projectSettings := projectsSettingsMap[uid] // May be nil if settings don't exist
-		projectFull := ConvertToProjectFull(projectBase, projectSettings)
+	var tagFilter []string
+	var selection fieldSelection
+	var baseOnly bool
+	convert := ConvertToProjectFull
+	if payload != nil {
+		var err error
+		if selection, err = parseFieldSelection[projsvc.ProjectFull](payload.Fields); err != nil {
+			return nil, err
+		}
+		tagFilter = payload.Tag
+		baseOnly = payload.BaseOnly
+		if payload.Version != nil && *payload.Version == constants.APIVersion2 {
+			convert = ConvertToProjectFullV2
+		}
+		if baseOnly {
+			convert = func(base *models.ProjectBase, _ *models.ProjectSettings, _ *models.ProjectHierarchy) *projsvc.ProjectFull {
+				return convertToProjectFullBase(base)
+			}
+		}
+	}
+	// The hierarchy is built from every project, including those left out by
+	// the tag filter, and the hierarchy fields are set once all are read.
+	hierarchy := models.NewProjectHierarchy()
+	add := func(projectBase *models.ProjectBase, projectSettings *models.ProjectSettings) error {
+		hierarchy.Add(projectBase)
+		if !hasAllProjectTags(projectBase, tagFilter) {
+			return nil
+		}
+		// projectSettings may be nil if settings don't exist
+		projectFull := convert(projectBase, projectSettings, nil)
 		if projectFull != nil {
 			projectsFull = append(projectsFull, projectFull)
 		}
+		return nil
+	}
+	var err error
+	if baseOnly {
+		err = s.forEachProjectBase(ctx, func(projectBase *models.ProjectBase) error {
+			return add(projectBase, nil)
+		})
+	} else {
+		err = s.forEachProject(ctx, add)
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.cacheProjectHierarchy(hierarchy)
+	public := true
+	for _, projectFull := range projectsFull {
+		setProjectHierarchyFields(projectFull, hierarchy)
+		public = public && *projectFull.Public
+		applyFieldSelection(projectFull, selection)
+		public = public && !hasProjectSettingsFields(projectFull)
 	}
 
 	slog.DebugContext(ctx, "returning projects", "projects", projectsFull)
 
-	return projectsFull, nil
+	return &projsvc.GetProjectsResult{
+		Projects:     projectsFull,
+		CacheControl: misc.StringPtr(s.Config.ProjectListCachePolicy.cacheControl(public)),
+	}, nil
 }
 
 // CreateProject creates a new project
 func (s *ProjectsService) CreateProject(ctx context.Context, payload *projsvc.CreateProjectPayload) (*projsvc.ProjectFull, error) {
+	ctx, span := startSpan(ctx, "ProjectsService.CreateProject")
+	defer span.End()
+
+	project, err := s.createProject(ctx, payload)
+	recordSpanError(span, err)
+	if project != nil && project.UID != nil {
+		span.SetAttributes(projectUIDAttr(*project.UID))
+	}
+	return project, err
+}
+
+func (s *ProjectsService) createProject(ctx context.Context, payload *projsvc.CreateProjectPayload) (*projsvc.ProjectFull, error) {
 	if !s.ServiceReady() {
 		slog.ErrorContext(ctx, "NATS connection or store not initialized")
 		return nil, domain.ErrServiceUnavailable
 	}
 
-	// An Archived project must carry an entity dissolution date.
-	// Checked before any DB calls so invalid payloads are rejected cheaply.
-	if err := validateArchivedRequiresDissolutionDate(payload.Stage, payload.EntityDissolutionDate); err != nil {
-		slog.WarnContext(ctx, "archived project missing dissolution date", constants.ErrKey, err)
+	// Template defaults are filled in first so that they are validated like
+	// the rest of the payload.
+	if err := s.applyProjectTemplate(ctx, payload); err != nil {
 		return nil, err
 	}
 
-	// Check if slug exists
-	exists, err := s.ProjectRepository.ProjectSlugExists(ctx, payload.Slug)
-	if err != nil {
-		slog.ErrorContext(ctx, "error checking if slug exists", constants.ErrKey, err)
-		return nil, domain.ErrInternal
+	// Checked before any DB calls so invalid payloads are rejected cheaply.
+	if err := validateProjectBasePayload(payload.Stage, payload.EntityDissolutionDate, payload.Annotations, payload.ParentUID, payload.Links, payload.NameI18n, payload.DescriptionI18n); err != nil {
+		slog.WarnContext(ctx, "invalid project payload", constants.ErrKey, err)
+		return nil, err
 	}
-	if exists {
-		// Slug already exists
-		return nil, domain.ErrProjectSlugExists
+	if err := s.checkTextLengths(ctx, append([]textField{
+		{"description", payload.Description, s.Config.MaxDescriptionLength},
+		{"mission_statement", stringValue(payload.MissionStatement), s.Config.MaxMissionStatementLength},
+	}, localizedTextFields("description_i18n", payload.DescriptionI18n, s.Config.MaxDescriptionLength)...)...); err != nil {
+		return nil, err
+	}
+	if err := s.checkProjectEnums(ctx, payload.Stage, payload.Category, nil); err != nil {
+		return nil, err
+	}
+	if err := s.checkRestrictedFieldChanges(ctx, setRestrictedFields(payload)); err != nil {
+		return nil, err
 	}
 
-	// Validate that the parent UID is a valid UUID and is an existing project UID.
+	// Only writers of the parent project may create a project under it.
 	if payload.ParentUID != "" {
-		if _, err := uuid.Parse(payload.ParentUID); err != nil {
-			slog.ErrorContext(ctx, "invalid parent UID", constants.ErrKey, err)
-			return nil, domain.ErrValidationFailed
+		if err := s.authorizeProject(ctx, payload.ParentUID, relationWriter); err != nil {
+			return nil, err
 		}
+	}
+
+	// A project created without a slug gets one generated from its name.
+	slug := stringValue(payload.Slug)
+	generatedSlug := slug == ""
+	if generatedSlug {
+		generated, err := s.generateProjectSlug(ctx, payload.Name)
+		if err != nil {
+			return nil, err
+		}
+		slug = generated
+	} else {
+		// Check if slug exists
+		exists, err := s.ProjectRepository.ProjectSlugExists(ctx, slug)
+		if err != nil {
+			slog.ErrorContext(ctx, "error checking if slug exists", constants.ErrKey, err)
+			return nil, domain.ErrInternal
+		}
+		if exists {
+			// Slug already exists
+			metrics.IncSlugCollision()
+			return nil, domain.ErrProjectSlugExists
+		}
+	}
+
+	// Validate that the parent UID is an existing project UID.
+	if payload.ParentUID != "" {
 		exists, err := s.ProjectRepository.ProjectExists(ctx, payload.ParentUID)
 		if err != nil {
 			slog.ErrorContext(ctx, "error checking if parent project exists", constants.ErrKey, err)
@@ -105,9 +193,25 @@ func (s *ProjectsService) CreateProject(ctx context.Context, payload *projsvc.Cr
 		if !exists {
 			slog.WarnContext(ctx, "parent project not found",
 				slog.String("parent_uid", payload.ParentUID),
-				slog.String("project_slug", payload.Slug),
+				slog.String("project_slug", slug),
 			)
-			return nil, domain.ErrInvalidParentProject
+			return nil, domain.NewFieldValidationError("parent_uid", domain.FieldErrorNotFound, domain.ErrInvalidParentProject)
+		}
+		if err := s.enforceHierarchyPolicies(ctx, "", payload.ParentUID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Validate that the legal parent UID is a project that can be a legal parent.
+	if legalParentUID := stringValue(payload.LegalParentUID); legalParentUID != "" {
+		if err := s.checkLegalParent(ctx, "", legalParentUID); err != nil {
+			return nil, err
+		}
+	}
+
+	if !payload.AllowDuplicates {
+		if err := s.checkDuplicateProject(ctx, payload.Name, payload.WebsiteURL, payload.RepositoryURL); err != nil {
+			return nil, err
 		}
 	}
 
@@ -129,7 +233,7 @@ func (s *ProjectsService) CreateProject(ctx context.Context, payload *projsvc.Cr
 	id := uuid.NewString()
 	project := &projsvc.ProjectBase{
 		UID:                        &id,
-		Slug:                       &payload.Slug,
+		Slug:                       &slug,
 		Description:                &payload.Description,
 		Name:                       &payload.Name,
 		Public:                     payload.Public,
@@ -150,10 +254,17 @@ func (s *ProjectsService) CreateProject(ctx context.Context, payload *projsvc.Cr
 		LogoURL:                    payload.LogoURL,
 		WebsiteURL:                 payload.WebsiteURL,
 		RepositoryURL:              payload.RepositoryURL,
+		Links:                      payload.Links,
+		Annotations:                payload.Annotations,
+		NameI18n:                   payload.NameI18n,
+		DescriptionI18n:            payload.DescriptionI18n,
+		LegacyID:                   payload.LegacyID,
+		Tags:                       payload.Tags,
 	}
 	projectSettings := &projsvc.ProjectSettings{
 		UID:                 &id,
 		MissionStatement:    payload.MissionStatement,
+		OverviewHTML:        payload.OverviewHTML,
 		AnnouncementDate:    payload.AnnouncementDate,
 		Writers:             payload.Writers,
 		Auditors:            payload.Auditors,
@@ -178,42 +289,76 @@ func (s *ProjectsService) CreateProject(ctx context.Context, payload *projsvc.Cr
 	// Create the project in the repository
 	slog.With("project_uid", projectDB.UID, "project_slug", projectDB.Slug)
 	err = s.ProjectRepository.CreateProject(ctx, projectDB, projectSettingsDB)
+	for attempt := 1; generatedSlug && errors.Is(err, domain.ErrProjectSlugExists) && attempt < maxGeneratedSlugAttempts; attempt++ {
+		// Another project took the generated slug since it was checked, so
+		// the next free one is used.
+		if projectDB.Slug, err = s.generateProjectSlug(ctx, payload.Name); err != nil {
+			return nil, err
+		}
+		err = s.ProjectRepository.CreateProject(ctx, projectDB, projectSettingsDB)
+	}
 	if err != nil {
 		if errors.Is(err, domain.ErrProjectSlugExists) {
+			metrics.IncSlugCollision()
 			return nil, domain.ErrProjectSlugExists
 		}
+		if errors.Is(err, domain.ErrProjectLegacyIDExists) {
+			return nil, domain.ErrProjectLegacyIDExists
+		}
 		return nil, domain.ErrInternal
 	}
 
-	g := new(errgroup.Group)
-	g.Go(func() error {
-		msg := indexerTypes.IndexerMessageEnvelope{
-			Action:         indexerConstants.ActionCreated,
-			Data:           *projectDB,
-			IndexingConfig: projectDB.IndexingConfig(),
-		}
-		return s.MessageBuilder.SendIndexerMessage(ctx, constants.IndexProjectSubject, msg, runSync)
-	})
+	event := newProjectLifecycleEvent(ctx, events.ProjectCreated, projectDB.UID)
+	event.Project = DomainProjectToEvent(projectDB)
+	event.Settings = domainSettingsPtrToEvent(projectSettingsDB)
+	messages := []outboundMessage{
+		{
+			kind:    models.OutboxKindIndexer,
+			subject: constants.IndexProjectSubject,
+			message: indexerTypes.IndexerMessageEnvelope{
+				Action:         indexerConstants.ActionCreated,
+				Data:           *projectDB,
+				IndexingConfig: projectDB.IndexingConfig(),
+			},
+		},
+		{
+			kind:    models.OutboxKindIndexer,
+			subject: constants.IndexProjectSettingsSubject,
+			message: indexerTypes.IndexerMessageEnvelope{
+				Action:         indexerConstants.ActionCreated,
+				Data:           *projectSettingsDB,
+				IndexingConfig: projectSettingsDB.IndexingConfig(projectDB.UID),
+			},
+		},
+		{
+			kind:    models.OutboxKindAccess,
+			subject: fgaconstants.GenericUpdateAccessSubject,
+			message: buildFGAUpdateAccessMessage(projectDB, projectSettingsDB),
+		},
+		{
+			kind:    models.OutboxKindEvent,
+			subject: projectEventSubject(event.Type),
+			message: event,
+		},
+	}
+
+	if err := s.publishProjectMessages(ctx, projectDB.UID, runSync, messages); err != nil {
+		return nil, err
+	}
 
-	g.Go(func() error {
-		msg := indexerTypes.IndexerMessageEnvelope{
-			Action:         indexerConstants.ActionCreated,
-			Data:           *projectSettingsDB,
-			IndexingConfig: projectSettingsDB.IndexingConfig(projectDB.UID),
+	// A new project has no children, so the hierarchy is only read for the
+	// ancestors of a project with a parent. The hierarchy fields are left out
+	// rather than failing the request when it cannot be read, as the project
+	// is created.
+	hierarchy := models.NewProjectHierarchy()
+	if projectDB.ParentUID != "" {
+		hierarchy, err = s.projectHierarchy(ctx, projectDB.ParentUID)
+		if err != nil {
+			slog.WarnContext(ctx, "project hierarchy fields are left out of the created project", constants.ErrKey, err)
 		}
-		return s.MessageBuilder.SendIndexerMessage(ctx, constants.IndexProjectSettingsSubject, msg, runSync)
-	})
-
-	g.Go(func() error {
-		msg := buildFGAUpdateAccessMessage(projectDB, projectSettingsDB)
-		return s.MessageBuilder.SendAccessMessage(ctx, fgaconstants.GenericUpdateAccessSubject, msg, runSync)
-	})
-
-	if err := g.Wait(); err != nil {
-		return nil, domain.ErrInternal
 	}
-
-	projectFull := ConvertToProjectFull(projectDB, projectSettingsDB)
+	s.invalidateProjectHierarchy()
+	projectFull := ConvertToProjectFull(projectDB, projectSettingsDB, hierarchy)
 
 	slog.DebugContext(ctx, "returning created project", "project", projectFull)
 
@@ -231,8 +376,27 @@ func (s *ProjectsService) GetOneProjectBase(ctx context.Context, payload *projsv
 		return nil, domain.ErrValidationFailed
 	}
 
+	// Without expand=settings only the base fields can be selected.
+	expandSettings := payload.Expand != nil && *payload.Expand == projectExpandSettings
+	parseSelection := parseFieldSelection[projsvc.ProjectBase]
+	if expandSettings {
+		parseSelection = parseFieldSelection[projsvc.ProjectFull]
+	}
+	selection, err := parseSelection(payload.Fields)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx = log.AppendCtx(ctx, slog.String("project_uid", *payload.UID))
 
+	// The settings are only readable by the project's auditors, which the
+	// gateway checks on the settings endpoint but not on this one.
+	if expandSettings {
+		if err := s.authorizeProject(ctx, *payload.UID, relationAuditor); err != nil {
+			return nil, err
+		}
+	}
+
 	// Get project with revision from store
 	projectDB, revision, err := s.ProjectRepository.GetProjectBaseWithRevision(ctx, *payload.UID)
 	if err != nil {
@@ -244,17 +408,65 @@ func (s *ProjectsService) GetOneProjectBase(ctx context.Context, payload *projsv
 		return nil, domain.ErrInternal
 	}
 
-	project := ConvertToServiceProjectBase(projectDB)
+	result := &projsvc.GetOneProjectBaseResult{}
+	if expandSettings {
+		projectSettingsDB, settingsRevision, err := s.ProjectRepository.GetProjectSettingsWithRevision(ctx, *payload.UID)
+		switch {
+		case errors.Is(err, domain.ErrProjectNotFound):
+			// A project without settings is returned with has_settings false.
+		case err != nil:
+			slog.ErrorContext(ctx, "error getting project settings from store", constants.ErrKey, err)
+			return nil, domain.ErrInternal
+		default:
+			if s.Config.RefreshUserInfo {
+				s.refreshUserInfo(ctx, projectSettingsDB)
+			}
+			result.SettingsEtag = misc.StringPtr(strconv.FormatUint(settingsRevision, 10))
+		}
+		result.Project = ConvertToProjectFull(projectDB, projectSettingsDB, nil)
+	} else {
+		result.Project = convertToProjectFullBase(projectDB)
+	}
+	applyFieldSelection(result.Project, selection)
+	result.CacheControl = misc.StringPtr(s.Config.ProjectCachePolicy.cacheControl(projectDB.Public && !expandSettings))
 
 	// Store the revision in context for the custom encoder to use
 	revisionStr := strconv.FormatUint(revision, 10)
 	ctx = context.WithValue(ctx, constants.ETagContextID, revisionStr)
+	result.Etag = &revisionStr
+
+	slog.DebugContext(ctx, "returning project", "project", result.Project, "revision", revision)
+
+	return result, nil
+}
+
+// GetProjectUIDFromLegacyID resolves a project's LFX v1 ID to its UID.
+func (s *ProjectsService) GetProjectUIDFromLegacyID(ctx context.Context, payload *projsvc.GetProjectUIDFromLegacyIDPayload) (*projsvc.GetProjectUIDFromLegacyIDResult, error) {
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
 
-	slog.DebugContext(ctx, "returning project", "project", project, "revision", revision)
+	if payload == nil || payload.LegacyID == "" {
+		slog.WarnContext(ctx, "project legacy ID is required")
+		return nil, domain.ErrValidationFailed
+	}
 
-	return &projsvc.GetOneProjectBaseResult{
-		Project: project,
-		Etag:    &revisionStr,
+	ctx = log.AppendCtx(ctx, slog.String("legacy_id", payload.LegacyID))
+
+	projectUID, err := s.ProjectRepository.GetProjectUIDFromLegacyID(ctx, payload.LegacyID)
+	if err != nil {
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			slog.WarnContext(ctx, "project not found", constants.ErrKey, err)
+			return nil, domain.ErrProjectNotFound
+		}
+		slog.ErrorContext(ctx, "error getting project UID from legacy ID", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+
+	return &projsvc.GetProjectUIDFromLegacyIDResult{
+		UID:      projectUID,
+		LegacyID: payload.LegacyID,
 	}, nil
 }
 
@@ -294,6 +506,9 @@ func (s *ProjectsService) GetOneProjectSettings(ctx context.Context, payload *pr
 		return nil, domain.ErrInternal
 	}
 
+	if s.Config.RefreshUserInfo {
+		s.refreshUserInfo(ctx, projectSettingsDB)
+	}
 	projectSettings := ConvertToServiceProjectSettings(projectSettingsDB)
 
 	// Store the revision in context for the custom encoder to use
@@ -305,11 +520,25 @@ func (s *ProjectsService) GetOneProjectSettings(ctx context.Context, payload *pr
 	return &projsvc.GetOneProjectSettingsResult{
 		ProjectSettings: projectSettings,
 		Etag:            &revisionStr,
+		CacheControl:    misc.StringPtr(cacheControlPrivate),
 	}, nil
 }
 
 // Update a project's base information.
 func (s *ProjectsService) UpdateProjectBase(ctx context.Context, payload *projsvc.UpdateProjectBasePayload) (*projsvc.ProjectBase, error) {
+	var attrs []attribute.KeyValue
+	if payload != nil {
+		attrs = payloadSpanAttrs(payload.UID, payload.IfMatch)
+	}
+	ctx, span := startSpan(ctx, "ProjectsService.UpdateProjectBase", attrs...)
+	defer span.End()
+
+	project, err := s.updateProjectBase(ctx, payload)
+	recordSpanError(span, err)
+	return project, err
+}
+
+func (s *ProjectsService) updateProjectBase(ctx context.Context, payload *projsvc.UpdateProjectBasePayload) (*projsvc.ProjectBase, error) {
 	if !s.ServiceReady() {
 		slog.ErrorContext(ctx, "NATS connection or store not initialized")
 		return nil, domain.ErrServiceUnavailable
@@ -320,37 +549,37 @@ func (s *ProjectsService) UpdateProjectBase(ctx context.Context, payload *projsv
 		return nil, domain.ErrValidationFailed
 	}
 
-	// An Archived project must carry an entity dissolution date.
 	// Validated before any repository access so invalid payloads are rejected cheaply,
 	// including when SkipEtagValidation is true (which would otherwise trigger a DB read first).
-	if err := validateArchivedRequiresDissolutionDate(payload.Stage, payload.EntityDissolutionDate); err != nil {
-		slog.WarnContext(ctx, "archived project missing dissolution date", constants.ErrKey, err)
+	if err := validateProjectBasePayload(payload.Stage, payload.EntityDissolutionDate, payload.Annotations, payload.ParentUID, payload.Links, payload.NameI18n, payload.DescriptionI18n); err != nil {
+		slog.WarnContext(ctx, "invalid project payload", constants.ErrKey, err)
+		return nil, err
+	}
+	if err := s.checkTextLengths(ctx, append([]textField{
+		{"description", payload.Description, s.Config.MaxDescriptionLength},
+	}, localizedTextFields("description_i18n", payload.DescriptionI18n, s.Config.MaxDescriptionLength)...)...); err != nil {
 		return nil, err
 	}
 
-	var revision uint64
-	var err error
-	if !s.Config.SkipEtagValidation {
-		if payload.IfMatch == nil {
-			slog.WarnContext(ctx, "If-Match header is missing")
-			return nil, domain.ErrValidationFailed
-		}
-		revision, err = strconv.ParseUint(*payload.IfMatch, 10, 64)
-		if err != nil {
-			slog.ErrorContext(ctx, "error parsing If-Match header", constants.ErrKey, err)
-			return nil, domain.ErrValidationFailed
-		}
-	} else {
-		// If skipping the Etag validation, we need to get the key revision from the store with a Get request.
-		_, revision, err = s.ProjectRepository.GetProjectBaseWithRevision(ctx, *payload.UID)
+	if err := s.authorizeProject(ctx, *payload.UID, relationWriter); err != nil {
+		return nil, err
+	}
+
+	// If skipping the Etag validation, we need to get the key revision from the store with a Get request.
+	revision, err := s.ifMatchRevision(ctx, "project:"+*payload.UID, payload.IfMatch, payload.ForceUpdate != nil && *payload.ForceUpdate, func() (uint64, error) {
+		_, revision, err := s.ProjectRepository.GetProjectBaseWithRevision(ctx, *payload.UID)
 		if err != nil {
 			if errors.Is(err, domain.ErrProjectNotFound) {
 				slog.WarnContext(ctx, "project not found", constants.ErrKey, err)
-				return nil, domain.ErrProjectNotFound
+				return 0, domain.ErrProjectNotFound
 			}
 			slog.ErrorContext(ctx, "error getting project from store", constants.ErrKey, err)
-			return nil, domain.ErrInternal
+			return 0, domain.ErrInternal
 		}
+		return revision, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	ctx = log.AppendCtx(ctx, slog.String("project_uid", *payload.UID))
@@ -367,6 +596,16 @@ func (s *ProjectsService) UpdateProjectBase(ctx context.Context, payload *projsv
 		return nil, domain.ErrInternal
 	}
 
+	if err := s.checkRestrictedFieldChanges(ctx, changedRestrictedFields(payload, existingProjectDB)); err != nil {
+		return nil, err
+	}
+	if err := s.checkProjectEnums(ctx, payload.Stage, payload.Category, existingProjectDB); err != nil {
+		return nil, err
+	}
+	if err := s.checkDissolvedEntityChanges(ctx, payload, existingProjectDB); err != nil {
+		return nil, err
+	}
+
 	// Check if the new slug is already taken
 	if payload.Slug != existingProjectDB.Slug {
 		newSlugExists, err := s.ProjectRepository.ProjectSlugExists(ctx, payload.Slug)
@@ -376,16 +615,13 @@ func (s *ProjectsService) UpdateProjectBase(ctx context.Context, payload *projsv
 		}
 		if newSlugExists {
 			// The slug is already taken
+			metrics.IncSlugCollision()
 			return nil, domain.ErrProjectSlugExists
 		}
 	}
 
-	// Validate that the parent UID is a valid UUID and is an existing project UID.
+	// Validate that the parent UID is an existing project UID.
 	if payload.ParentUID != "" {
-		if _, err := uuid.Parse(payload.ParentUID); err != nil {
-			slog.ErrorContext(ctx, "invalid parent UID", constants.ErrKey, err)
-			return nil, domain.ErrValidationFailed
-		}
 		exists, err := s.ProjectRepository.ProjectExists(ctx, payload.ParentUID)
 		if err != nil {
 			slog.ErrorContext(ctx, "error checking if parent project exists", constants.ErrKey, err)
@@ -395,7 +631,25 @@ func (s *ProjectsService) UpdateProjectBase(ctx context.Context, payload *projsv
 			slog.WarnContext(ctx, "parent project not found",
 				slog.String("parent_uid", payload.ParentUID),
 			)
-			return nil, domain.ErrInvalidParentProject
+			return nil, domain.NewFieldValidationError("parent_uid", domain.FieldErrorNotFound, domain.ErrInvalidParentProject)
+		}
+		if payload.ParentUID != existingProjectDB.ParentUID {
+			if err := s.authorizeProject(ctx, payload.ParentUID, relationWriter); err != nil {
+				return nil, err
+			}
+			if err := s.checkHierarchyCycle(ctx, *payload.UID, payload.ParentUID); err != nil {
+				return nil, err
+			}
+			if err := s.enforceHierarchyPolicies(ctx, *payload.UID, payload.ParentUID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Validate a changed legal parent UID; an unchanged one is kept as stored.
+	if legalParentUID := stringValue(payload.LegalParentUID); legalParentUID != "" && legalParentUID != existingProjectDB.LegalParentUID {
+		if err := s.checkLegalParent(ctx, *payload.UID, legalParentUID); err != nil {
+			return nil, err
 		}
 	}
 
@@ -429,6 +683,12 @@ func (s *ProjectsService) UpdateProjectBase(ctx context.Context, payload *projsv
 		LogoURL:                    payload.LogoURL,
 		WebsiteURL:                 payload.WebsiteURL,
 		RepositoryURL:              payload.RepositoryURL,
+		Links:                      payload.Links,
+		Annotations:                payload.Annotations,
+		NameI18n:                   payload.NameI18n,
+		DescriptionI18n:            payload.DescriptionI18n,
+		LegacyID:                   payload.LegacyID,
+		Tags:                       payload.Tags,
 		UpdatedAt:                  misc.StringPtr(currentTime.Format(time.RFC3339)),
 	}
 	if existingProjectDB.CreatedAt != nil {
@@ -440,6 +700,13 @@ func (s *ProjectsService) UpdateProjectBase(ctx context.Context, payload *projsv
 		slog.ErrorContext(ctx, "error converting project to DB project", constants.ErrKey, err)
 		return nil, domain.ErrInternal
 	}
+	// The PNG rendering of the logo is kept until the logo changes, after which
+	// HandleProjectLogoChanged converts the new one.
+	if projectDB.LogoURL == existingProjectDB.LogoURL {
+		projectDB.PNGLogoURL = existingProjectDB.PNGLogoURL
+	}
+	projectDB.MergedFrom = existingProjectDB.MergedFrom
+	projectDB.RecordRename(existingProjectDB, currentTime)
 
 	// Update the project in the repository
 	err = s.ProjectRepository.UpdateProjectBase(ctx, projectDB, revision)
@@ -448,37 +715,22 @@ func (s *ProjectsService) UpdateProjectBase(ctx context.Context, payload *projsv
 			slog.WarnContext(ctx, "etag header is invalid", constants.ErrKey, err)
 			return nil, domain.ErrRevisionMismatch
 		}
+		if errors.Is(err, domain.ErrProjectLegacyIDExists) {
+			return nil, domain.ErrProjectLegacyIDExists
+		}
 		if errors.Is(err, domain.ErrInternal) {
 			slog.ErrorContext(ctx, "error updating project in store", constants.ErrKey, err)
 			return nil, domain.ErrInternal
 		}
 		return nil, domain.ErrInternal
 	}
-
-	projectSettingsDB, err := s.ProjectRepository.GetProjectSettings(ctx, *payload.UID)
-	if err != nil {
-		slog.ErrorContext(ctx, "error getting project settings from store", constants.ErrKey, err)
-		return nil, domain.ErrInternal
+	if projectDB.ParentUID != existingProjectDB.ParentUID || projectDB.Slug != existingProjectDB.Slug {
+		s.invalidateProjectHierarchy()
 	}
 
-	g := new(errgroup.Group)
-	g.Go(func() error {
-		msg := indexerTypes.IndexerMessageEnvelope{
-			Action:         indexerConstants.ActionUpdated,
-			Data:           *projectDB,
-			IndexingConfig: projectDB.IndexingConfig(),
-		}
-		return s.MessageBuilder.SendIndexerMessage(ctx, constants.IndexProjectSubject, msg, runSync)
-	})
-
-	g.Go(func() error {
-		msg := buildFGAUpdateAccessMessage(projectDB, projectSettingsDB)
-		return s.MessageBuilder.SendAccessMessage(ctx, fgaconstants.GenericUpdateAccessSubject, msg, runSync)
-	})
-
-	if err := g.Wait(); err != nil {
-		// Return the first error from the goroutines.
-		return nil, domain.ErrInternal
+	messages := projectBaseUpdatedMessages(ctx, projectDB, existingProjectDB)
+	if err := s.publishProjectMessages(ctx, projectDB.UID, runSync, messages); err != nil {
+		return nil, err
 	}
 
 	slog.DebugContext(ctx, "returning updated project", "project", project)
@@ -488,8 +740,95 @@ func (s *ProjectsService) UpdateProjectBase(ctx context.Context, payload *projsv
 	return projectResp, nil
 }
 
+// projectBaseUpdatedMessages returns the indexer, FGA sync, and event messages
+// that follow an update of a project's base information. FGA sync is only sent
+// the public flag and parent, and only when one of them changed; the relations
+// set from the settings are left to settings updates.
+func projectBaseUpdatedMessages(ctx context.Context, projectDB, previous *models.ProjectBase) []outboundMessage {
+	event := newProjectLifecycleEvent(ctx, events.ProjectUpdated, projectDB.UID)
+	event.Project = DomainProjectToEvent(projectDB)
+	event.PreviousProject = DomainProjectToEvent(previous)
+	messages := []outboundMessage{
+		{
+			kind:    models.OutboxKindIndexer,
+			subject: constants.IndexProjectSubject,
+			message: indexerTypes.IndexerMessageEnvelope{
+				Action:         indexerConstants.ActionUpdated,
+				Data:           *projectDB,
+				IndexingConfig: projectDB.IndexingConfig(),
+			},
+		},
+	}
+	if previous == nil || previous.Public != projectDB.Public || previous.ParentUID != projectDB.ParentUID {
+		messages = append(messages, outboundMessage{
+			kind:    models.OutboxKindAccess,
+			subject: fgaconstants.GenericUpdateAccessSubject,
+			message: buildFGABaseAccessMessage(projectDB),
+		})
+	}
+	messages = append(messages, outboundMessage{
+		kind:    models.OutboxKindEvent,
+		subject: projectEventSubject(event.Type),
+		message: event,
+	})
+
+	if change := projectLegalChange(previous, projectDB); change != nil {
+		legalEvent := newProjectLifecycleEvent(ctx, events.ProjectLegalChanged, projectDB.UID)
+		legalEvent.Project = event.Project
+		legalEvent.LegalChange = change
+		messages = append(messages, outboundMessage{
+			kind:    models.OutboxKindEvent,
+			subject: projectEventSubject(legalEvent.Type),
+			message: legalEvent,
+		})
+		// The messages are built once the update is stored, so the change is
+		// audited here for every path that updates a project.
+		log.Audit(ctx, "project legal fields changed",
+			"project_uid", projectDB.UID,
+			"actor", legalEvent.Actor.Username,
+			"event_id", legalEvent.ID,
+			"funding_model_before", change.Before.FundingModel,
+			"funding_model_after", change.After.FundingModel,
+			"legal_entity_type_before", change.Before.LegalEntityType,
+			"legal_entity_type_after", change.After.LegalEntityType,
+			"effective_date", change.EffectiveDate,
+		)
+	}
+	return messages
+}
+
+// projectLegalChange returns the change of the funding model and legal entity
+// type from previous to project, nil when neither changed.
+func projectLegalChange(previous, project *models.ProjectBase) *events.LegalChange {
+	if previous == nil || (previous.LegalEntityType == project.LegalEntityType && slices.Equal(previous.FundingModel, project.FundingModel)) {
+		return nil
+	}
+	effective := time.Now().UTC()
+	if project.UpdatedAt != nil {
+		effective = project.UpdatedAt.UTC()
+	}
+	return &events.LegalChange{
+		Before:        events.LegalFields{FundingModel: previous.FundingModel, LegalEntityType: previous.LegalEntityType},
+		After:         events.LegalFields{FundingModel: project.FundingModel, LegalEntityType: project.LegalEntityType},
+		EffectiveDate: effective,
+	}
+}
+
 // Update a project's settings.
 func (s *ProjectsService) UpdateProjectSettings(ctx context.Context, payload *projsvc.UpdateProjectSettingsPayload) (*projsvc.ProjectSettings, error) {
+	var attrs []attribute.KeyValue
+	if payload != nil {
+		attrs = payloadSpanAttrs(payload.UID, payload.IfMatch)
+	}
+	ctx, span := startSpan(ctx, "ProjectsService.UpdateProjectSettings", attrs...)
+	defer span.End()
+
+	settings, err := s.updateProjectSettings(ctx, payload)
+	recordSpanError(span, err)
+	return settings, err
+}
+
+func (s *ProjectsService) updateProjectSettings(ctx context.Context, payload *projsvc.UpdateProjectSettingsPayload) (*projsvc.ProjectSettings, error) {
 	if !s.ServiceReady() {
 		slog.ErrorContext(ctx, "NATS connection or store not initialized")
 		return nil, domain.ErrServiceUnavailable
@@ -500,25 +839,27 @@ func (s *ProjectsService) UpdateProjectSettings(ctx context.Context, payload *pr
 		return nil, domain.ErrValidationFailed
 	}
 
-	var revision uint64
-	var err error
-	if !s.Config.SkipEtagValidation {
-		if payload.IfMatch == nil {
-			slog.WarnContext(ctx, "If-Match header is missing")
-			return nil, domain.ErrValidationFailed
-		}
-		revision, err = strconv.ParseUint(*payload.IfMatch, 10, 64)
-		if err != nil {
-			slog.ErrorContext(ctx, "error parsing If-Match header", constants.ErrKey, err)
-			return nil, domain.ErrValidationFailed
-		}
-	} else {
-		// If skipping the Etag validation, we need to get the key revision from the store with a Get request.
-		_, revision, err = s.ProjectRepository.GetProjectSettingsWithRevision(ctx, *payload.UID)
+	if err := s.checkTextLengths(ctx,
+		textField{"mission_statement", stringValue(payload.MissionStatement), s.Config.MaxMissionStatementLength},
+	); err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeProject(ctx, *payload.UID, relationWriter); err != nil {
+		return nil, err
+	}
+
+	// If skipping the Etag validation, we need to get the key revision from the store with a Get request.
+	revision, err := s.ifMatchRevision(ctx, "project_settings:"+*payload.UID, payload.IfMatch, payload.ForceUpdate != nil && *payload.ForceUpdate, func() (uint64, error) {
+		_, revision, err := s.ProjectRepository.GetProjectSettingsWithRevision(ctx, *payload.UID)
 		if err != nil {
 			slog.ErrorContext(ctx, "error getting project settings from store", constants.ErrKey, err)
-			return nil, domain.ErrInternal
+			return 0, domain.ErrInternal
 		}
+		return revision, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	ctx = log.AppendCtx(ctx, slog.String("project_uid", *payload.UID))
@@ -565,6 +906,7 @@ func (s *ProjectsService) UpdateProjectSettings(ctx context.Context, payload *pr
 	projectSettings := &projsvc.ProjectSettings{
 		UID:                 payload.UID,
 		MissionStatement:    payload.MissionStatement,
+		OverviewHTML:        payload.OverviewHTML,
 		AnnouncementDate:    payload.AnnouncementDate,
 		Writers:             payload.Writers,
 		Auditors:            payload.Auditors,
@@ -583,10 +925,25 @@ func (s *ProjectsService) UpdateProjectSettings(ctx context.Context, payload *pr
 		slog.ErrorContext(ctx, "error converting project settings to DB project settings", constants.ErrKey, err)
 		return nil, domain.ErrInternal
 	}
+	// The overview is answered as stored, after its sanitization.
+	if projectSettings.OverviewHTML != nil {
+		projectSettings.OverviewHTML = &projectSettingsDB.OverviewHTML
+	}
 
 	// Update the project settings using the store
 	err = s.ProjectRepository.UpdateProjectSettings(ctx, projectSettingsDB, revision)
+	if errors.Is(err, domain.ErrRevisionMismatch) && payload.Merge != nil && *payload.Merge {
+		var current *models.ProjectSettings
+		current, projectSettingsDB, err = s.mergeProjectSettingsUpdate(ctx, *payload.UID, revision, projectSettingsDB)
+		if err == nil {
+			existingProjectSettingsDB = current
+			projectSettings = ConvertToServiceProjectSettings(projectSettingsDB)
+		}
+	}
 	if err != nil {
+		if errors.Is(err, domain.ErrSettingsMergeConflict) {
+			return nil, err
+		}
 		if errors.Is(err, domain.ErrRevisionMismatch) {
 			slog.WarnContext(ctx, "etag header is invalid", constants.ErrKey, err)
 			return nil, domain.ErrRevisionMismatch
@@ -604,35 +961,11 @@ func (s *ProjectsService) UpdateProjectSettings(ctx context.Context, payload *pr
 		return nil, domain.ErrInternal
 	}
 
-	g := new(errgroup.Group)
-	g.Go(func() error {
-		msg := indexerTypes.IndexerMessageEnvelope{
-			Action:         indexerConstants.ActionUpdated,
-			Data:           *projectSettingsDB,
-			IndexingConfig: projectSettingsDB.IndexingConfig(projectDB.UID),
-		}
-		return s.MessageBuilder.SendIndexerMessage(ctx, constants.IndexProjectSettingsSubject, msg, runSync)
-	})
-
-	g.Go(func() error {
-		msg := buildFGAUpdateAccessMessage(projectDB, projectSettingsDB)
-		return s.MessageBuilder.SendAccessMessage(ctx, fgaconstants.GenericUpdateAccessSubject, msg, runSync)
-	})
+	messages := projectSettingsUpdatedMessages(ctx, projectDB, existingProjectSettingsDB, projectSettingsDB,
+		projectSettingsAccessMessages(projectDB, existingProjectSettingsDB, projectSettingsDB)...)
 
-	g.Go(func() error {
-		principal, _ := ctx.Value(constants.PrincipalContextID).(string)
-		msg := events.ProjectSettingsUpdatedMessage{
-			ProjectUID:  *payload.UID,
-			OldSettings: DomainSettingsToEvent(existingProjectSettingsDB),
-			NewSettings: DomainSettingsToEvent(projectSettingsDB),
-			Actor:       events.Actor{Username: principal},
-		}
-		return s.MessageBuilder.SendProjectEventMessage(ctx, constants.ProjectSettingsUpdatedSubject, msg)
-	})
-
-	if err := g.Wait(); err != nil {
-		// Return the first error from the goroutines.
-		return nil, domain.ErrInternal
+	if err := s.publishProjectMessages(ctx, *payload.UID, runSync, messages); err != nil {
+		return nil, err
 	}
 
 	slog.DebugContext(ctx, "returning updated project settings", "project_settings", projectSettings)
@@ -640,8 +973,74 @@ func (s *ProjectsService) UpdateProjectSettings(ctx context.Context, payload *pr
 	return projectSettings, nil
 }
 
+// projectSettingsUpdatedMessages returns the indexer and event messages that
+// follow an update of a project's settings, together with access, the FGA sync
+// messages for the change, if any.
+func projectSettingsUpdatedMessages(ctx context.Context, projectDB *models.ProjectBase, previous, settings *models.ProjectSettings, access ...outboundMessage) []outboundMessage {
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	event := newProjectLifecycleEvent(ctx, events.ProjectSettingsUpdated, projectDB.UID)
+	event.Settings = domainSettingsPtrToEvent(settings)
+	event.PreviousSettings = domainSettingsPtrToEvent(previous)
+	messages := []outboundMessage{
+		{
+			kind:    models.OutboxKindIndexer,
+			subject: constants.IndexProjectSettingsSubject,
+			message: indexerTypes.IndexerMessageEnvelope{
+				Action:         indexerConstants.ActionUpdated,
+				Data:           *settings,
+				IndexingConfig: settings.IndexingConfig(projectDB.UID),
+			},
+		},
+	}
+	messages = append(messages, access...)
+	return append(messages,
+		outboundMessage{
+			kind:    models.OutboxKindEvent,
+			subject: constants.ProjectSettingsUpdatedSubject,
+			message: events.ProjectSettingsUpdatedMessage{
+				ProjectUID:  projectDB.UID,
+				OldSettings: DomainSettingsToEvent(previous),
+				NewSettings: DomainSettingsToEvent(settings),
+				Actor:       events.Actor{Username: principal},
+			},
+		},
+		outboundMessage{
+			kind:    models.OutboxKindEvent,
+			subject: projectEventSubject(event.Type),
+			message: event,
+		},
+	)
+}
+
+// projectSettingsAccessMessages returns the FGA sync message for the relations
+// that changed from the previous settings, or none when none of them changed.
+func projectSettingsAccessMessages(projectDB *models.ProjectBase, previous, settings *models.ProjectSettings) []outboundMessage {
+	message, changed := buildFGASettingsAccessMessage(projectDB, previous, settings)
+	if !changed {
+		return nil
+	}
+	return []outboundMessage{{
+		kind:    models.OutboxKindAccess,
+		subject: fgaconstants.GenericUpdateAccessSubject,
+		message: message,
+	}}
+}
+
 // Delete a project.
 func (s *ProjectsService) DeleteProject(ctx context.Context, payload *projsvc.DeleteProjectPayload) error {
+	var attrs []attribute.KeyValue
+	if payload != nil {
+		attrs = payloadSpanAttrs(payload.UID, payload.IfMatch)
+	}
+	ctx, span := startSpan(ctx, "ProjectsService.DeleteProject", attrs...)
+	defer span.End()
+
+	err := s.deleteProject(ctx, payload)
+	recordSpanError(span, err)
+	return err
+}
+
+func (s *ProjectsService) deleteProject(ctx context.Context, payload *projsvc.DeleteProjectPayload) error {
 	if !s.ServiceReady() {
 		slog.ErrorContext(ctx, "NATS connection or store not initialized")
 		return domain.ErrServiceUnavailable
@@ -652,42 +1051,33 @@ func (s *ProjectsService) DeleteProject(ctx context.Context, payload *projsvc.De
 		return domain.ErrValidationFailed
 	}
 
-	var revision uint64
-	var err error
-	var projectDB *models.ProjectBase
+	if err := s.authorizeProject(ctx, *payload.UID, relationOwner); err != nil {
+		return err
+	}
 
-	if !s.Config.SkipEtagValidation {
-		if payload.IfMatch == nil {
-			slog.WarnContext(ctx, "If-Match header is missing")
-			return domain.ErrValidationFailed
-		}
-		revision, err = strconv.ParseUint(*payload.IfMatch, 10, 64)
-		if err != nil {
-			slog.ErrorContext(ctx, "error parsing If-Match header", constants.ErrKey, err)
-			return domain.ErrValidationFailed
-		}
+	var projectDB *models.ProjectBase
+	// If skipping the Etag validation, we need to get the key revision from the store with a Get request.
+	// Also get the project data for funding model validation (single fetch).
+	revision, err := s.ifMatchRevision(ctx, "project:"+*payload.UID, payload.IfMatch, payload.ForceUpdate != nil && *payload.ForceUpdate, func() (uint64, error) {
+		var revision uint64
+		var err error
+		projectDB, revision, err = s.ProjectRepository.GetProjectBaseWithRevision(ctx, *payload.UID)
+		return revision, err
+	})
+	if err == nil && projectDB == nil {
 		// Fetch the project to validate funding model before deletion
 		projectDB, err = s.ProjectRepository.GetProjectBase(ctx, *payload.UID)
-		if err != nil {
-			if errors.Is(err, domain.ErrProjectNotFound) {
-				slog.WarnContext(ctx, "project not found", constants.ErrKey, err)
-				return domain.ErrProjectNotFound
-			}
-			slog.ErrorContext(ctx, "error getting project from store", constants.ErrKey, err)
-			return domain.ErrInternal
+	}
+	if err != nil {
+		if errors.Is(err, domain.ErrValidationFailed) {
+			return domain.ErrValidationFailed
 		}
-	} else {
-		// If skipping the Etag validation, we need to get the key revision from the store with a Get request.
-		// Also get the project data for funding model validation (single fetch).
-		projectDB, revision, err = s.ProjectRepository.GetProjectBaseWithRevision(ctx, *payload.UID)
-		if err != nil {
-			if errors.Is(err, domain.ErrProjectNotFound) {
-				slog.WarnContext(ctx, "project not found", constants.ErrKey, err)
-				return domain.ErrProjectNotFound
-			}
-			slog.ErrorContext(ctx, "error getting project from store", constants.ErrKey, err)
-			return domain.ErrInternal
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			slog.WarnContext(ctx, "project not found", constants.ErrKey, err)
+			return domain.ErrProjectNotFound
 		}
+		slog.ErrorContext(ctx, "error getting project from store", constants.ErrKey, err)
+		return domain.ErrInternal
 	}
 
 	ctx = log.AppendCtx(ctx, slog.String("project_uid", *payload.UID))
@@ -724,33 +1114,70 @@ func (s *ProjectsService) DeleteProject(ctx context.Context, payload *projsvc.De
 		return domain.ErrInternal
 	}
 
-	g := new(errgroup.Group)
-	g.Go(func() error {
-		return s.MessageBuilder.SendIndexerMessage(ctx, constants.IndexProjectSubject, *payload.UID, runSync)
-	})
+	s.recordProjectDeletion(ctx, projectDB, "")
+	s.invalidateProjectHierarchy()
 
-	g.Go(func() error {
-		return s.MessageBuilder.SendIndexerMessage(ctx, constants.IndexProjectSettingsSubject, *payload.UID, runSync)
-	})
+	messages := projectDeletedMessages(ctx, projectDB)
+	if err := s.publishProjectMessages(ctx, *payload.UID, runSync, messages); err != nil {
+		return err
+	}
+
+	slog.DebugContext(ctx, "deleted project", "project_uid", *payload.UID)
+	return nil
+}
 
-	g.Go(func() error {
-		msg := fgatypes.GenericFGAMessage{
-			ObjectType: "project",
-			Operation:  "delete_access",
-			Data: fgatypes.GenericDeleteData{
-				UID: *payload.UID,
+// projectDeletedMessages returns the indexer, FGA sync, and event messages that
+// follow the deletion of projectDB.
+func projectDeletedMessages(ctx context.Context, projectDB *models.ProjectBase) []outboundMessage {
+	event := newProjectLifecycleEvent(ctx, events.ProjectDeleted, projectDB.UID)
+	event.Project = DomainProjectToEvent(projectDB)
+	return []outboundMessage{
+		{
+			kind:    models.OutboxKindIndexer,
+			subject: constants.IndexProjectSubject,
+			message: projectDB.UID,
+		},
+		{
+			kind:    models.OutboxKindIndexer,
+			subject: constants.IndexProjectSettingsSubject,
+			message: projectDB.UID,
+		},
+		{
+			kind:    models.OutboxKindAccess,
+			subject: fgaconstants.GenericDeleteAccessSubject,
+			message: fgatypes.GenericFGAMessage{
+				ObjectType: "project",
+				Operation:  "delete_access",
+				Data: fgatypes.GenericDeleteData{
+					UID: projectDB.UID,
+				},
 			},
-		}
-		return s.MessageBuilder.SendAccessMessage(ctx, fgaconstants.GenericDeleteAccessSubject, msg, runSync)
-	})
+		},
+		{
+			kind:    models.OutboxKindEvent,
+			subject: projectEventSubject(event.Type),
+			message: event,
+		},
+	}
+}
 
-	if err := g.Wait(); err != nil {
-		// Return the first error from the goroutines.
-		return domain.ErrInternal
+// newProjectLifecycleEvent returns a lifecycle event envelope of the given type
+// for projectUID, attributed to the principal on ctx.
+func newProjectLifecycleEvent(ctx context.Context, eventType events.ProjectEventType, projectUID string) events.ProjectLifecycleEvent {
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	return events.ProjectLifecycleEvent{
+		Version:    events.ProjectEventSchemaVersion,
+		ID:         uuid.NewString(),
+		Type:       eventType,
+		OccurredAt: time.Now().UTC(),
+		ProjectUID: projectUID,
+		Actor:      events.Actor{Username: principal},
 	}
+}
 
-	slog.DebugContext(ctx, "deleted project", "project_uid", *payload.UID)
-	return nil
+// projectEventSubject returns the NATS subject a lifecycle event of eventType is published on.
+func projectEventSubject(eventType events.ProjectEventType) string {
+	return constants.ProjectEventsSubjectPrefix + string(eventType)
 }
 
 // isCrowdfundingOnly checks if the funding model is exactly ["Crowdfunding"] and nothing else.
@@ -763,6 +1190,120 @@ func isCrowdfundingOnly(fundingModels []string) bool {
 // dissolution date.
 const projectStageArchived = "Archived"
 
+// validateProjectBasePayload checks the fields of a project base payload that
+// can be validated without repository access, and reports every failing field
+// at once.
+func validateProjectBasePayload(stage, dissolutionDate *string, annotations map[string]string, parentUID string, links *projsvc.ProjectLinks, nameI18n, descriptionI18n map[string]string) error {
+	validation := &domain.ValidationError{}
+	if err := validateArchivedRequiresDissolutionDate(stage, dissolutionDate); err != nil {
+		validation.Add("entity_dissolution_date", domain.FieldErrorRequired, err)
+	}
+	if err := validateAnnotations(annotations); err != nil {
+		validation.Add("annotations", domain.FieldErrorTooLarge, err)
+	}
+	if parentUID != "" {
+		if _, err := uuid.Parse(parentUID); err != nil {
+			validation.Add("parent_uid", domain.FieldErrorInvalid, domain.ErrInvalidParentUID)
+		}
+	}
+	validateProjectLinks(validation, links)
+	validateLocalizedField(validation, "name_i18n", nameI18n)
+	validateLocalizedField(validation, "description_i18n", descriptionI18n)
+	return validation.Err()
+}
+
+// validateLocalizedField adds to validation an error for each entry of the
+// localized field path whose key is not a well-formed BCP 47 language tag or
+// whose value is blank. The API design only checks the shape of the keys.
+func validateLocalizedField(validation *domain.ValidationError, path string, values map[string]string) {
+	for _, tag := range slices.Sorted(maps.Keys(values)) {
+		if _, err := language.Parse(tag); err != nil {
+			validation.Add(path+"."+tag, domain.FieldErrorInvalid, domain.ErrInvalidLocalization)
+			continue
+		}
+		if strings.TrimSpace(values[tag]) == "" {
+			validation.Add(path+"."+tag, domain.FieldErrorRequired, domain.ErrInvalidLocalization)
+		}
+	}
+}
+
+// localizedTextFields returns the entries of the localized field path as
+// text fields with the length limit limit, in language tag order.
+func localizedTextFields(path string, values map[string]string, limit int) []textField {
+	fields := make([]textField, 0, len(values))
+	for _, tag := range slices.Sorted(maps.Keys(values)) {
+		fields = append(fields, textField{path + "." + tag, values[tag], limit})
+	}
+	return fields
+}
+
+// validateProjectLinks adds to validation an error for each link of links
+// that is not an absolute http or https URL, and for a contact email that is
+// not a plain email address. Goa's format checks accept other schemes, such as
+// javascript:, and addresses with a display name.
+func validateProjectLinks(validation *domain.ValidationError, links *projsvc.ProjectLinks) {
+	if links == nil {
+		return
+	}
+	for _, link := range []struct {
+		path  string
+		value *string
+	}{
+		{"links.twitter", links.Twitter},
+		{"links.linkedin", links.Linkedin},
+		{"links.youtube", links.Youtube},
+		{"links.slack", links.Slack},
+		{"links.discord", links.Discord},
+		{"links.mailing_list", links.MailingList},
+	} {
+		if link.value == nil || *link.value == "" {
+			continue
+		}
+		u, err := url.Parse(*link.value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			validation.Add(link.path, domain.FieldErrorInvalid, domain.ErrInvalidProjectLink)
+		}
+	}
+	if email := stringValue(links.ContactEmail); email != "" {
+		if address, err := mail.ParseAddress(email); err != nil || address.Name != "" || address.Address != email {
+			validation.Add("links.contact_email", domain.FieldErrorInvalid, domain.ErrInvalidContactEmail)
+		}
+	}
+}
+
+// textField is a free-text payload field checked by checkTextLengths.
+type textField struct {
+	path  string
+	value string
+	// max is the maximum length in characters; zero means no limit.
+	max int
+}
+
+// checkTextLengths rejects the free-text fields that are longer than their
+// configured maximum, reporting each of them. Oversized values would otherwise
+// be stored and passed on to the indexer.
+func (s *ProjectsService) checkTextLengths(ctx context.Context, fields ...textField) error {
+	validation := &domain.ValidationError{}
+	for _, field := range fields {
+		if field.max <= 0 {
+			continue
+		}
+		if length := utf8.RuneCountInString(field.value); length > field.max {
+			validation.Fields = append(validation.Fields, domain.FieldError{
+				Path:    field.path,
+				Code:    domain.FieldErrorTooLarge,
+				Message: fmt.Sprintf("%s must be at most %d characters, got %d", field.path, field.max, length),
+				Err:     domain.ErrFieldTooLong,
+			})
+		}
+	}
+	if err := validation.Err(); err != nil {
+		slog.WarnContext(ctx, "project payload field too long", constants.ErrKey, err)
+		return err
+	}
+	return nil
+}
+
 // validateArchivedRequiresDissolutionDate checks that a project whose
 // stage is "Archived" must carry an entity dissolution date. The arguments are
 // the Goa payload pointers (stage and entity_dissolution_date); a nil, empty, or
@@ -780,6 +1321,152 @@ func validateArchivedRequiresDissolutionDate(stage, dissolutionDate *string) err
 	return nil
 }
 
+// checkHierarchyCycle walks up the ancestors of parentUID and returns
+// ErrProjectHierarchyCycle if projectUID is one of them, that is, if making
+// parentUID the parent of projectUID would make the project its own ancestor.
+func (s *ProjectsService) checkHierarchyCycle(ctx context.Context, projectUID, parentUID string) error {
+	visited := map[string]bool{}
+	for ancestorUID := parentUID; ancestorUID != ""; {
+		if ancestorUID == projectUID {
+			slog.WarnContext(ctx, "parent project would create a hierarchy cycle",
+				slog.String("parent_uid", parentUID),
+			)
+			return domain.ErrProjectHierarchyCycle
+		}
+		if visited[ancestorUID] {
+			// The stored hierarchy already loops without passing through this
+			// project, so the change does not add a cycle of its own.
+			slog.WarnContext(ctx, "existing project hierarchy contains a cycle",
+				slog.String("ancestor_uid", ancestorUID),
+			)
+			return nil
+		}
+		visited[ancestorUID] = true
+
+		ancestor, err := s.ProjectRepository.GetProjectBase(ctx, ancestorUID)
+		if err != nil {
+			if errors.Is(err, domain.ErrProjectNotFound) {
+				// A dangling parent reference ends the chain.
+				return nil
+			}
+			slog.ErrorContext(ctx, "error getting ancestor project", constants.ErrKey, err, "ancestor_uid", ancestorUID)
+			return domain.ErrInternal
+		}
+		ancestorUID = ancestor.ParentUID
+	}
+	return nil
+}
+
+// enforceHierarchyPolicies checks that placing a project under parentUID keeps
+// the hierarchy within the configured MaxChildProjects and MaxHierarchyDepth
+// limits. projectUID is empty for a new project; an existing project is moved
+// together with its descendants.
+func (s *ProjectsService) enforceHierarchyPolicies(ctx context.Context, projectUID, parentUID string) error {
+	if s.Config.MaxChildProjects <= 0 && s.Config.MaxHierarchyDepth <= 0 {
+		return nil
+	}
+
+	projects, err := s.ProjectRepository.ListAllProjectsBase(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "error listing projects for hierarchy policies", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+	parents := make(map[string]string, len(projects))
+	children := map[string][]string{}
+	for _, project := range projects {
+		parents[project.UID] = project.ParentUID
+		if project.ParentUID != "" {
+			children[project.ParentUID] = append(children[project.ParentUID], project.UID)
+		}
+	}
+
+	if limit := s.Config.MaxChildProjects; limit > 0 {
+		siblings := 0
+		for _, childUID := range children[parentUID] {
+			if childUID != projectUID {
+				siblings++
+			}
+		}
+		if siblings >= limit {
+			slog.WarnContext(ctx, "parent project has reached the maximum number of children",
+				slog.String("parent_uid", parentUID),
+				slog.Int("limit", limit),
+			)
+			return &domain.PolicyViolationError{Policy: domain.PolicyMaxChildProjects, Limit: limit}
+		}
+	}
+
+	if limit := s.Config.MaxHierarchyDepth; limit > 0 {
+		// The parent's level, counting the parent itself and each of its ancestors.
+		parentLevel := 0
+		visited := map[string]bool{}
+		for ancestorUID := parentUID; ancestorUID != "" && !visited[ancestorUID]; ancestorUID = parents[ancestorUID] {
+			visited[ancestorUID] = true
+			parentLevel++
+		}
+		if parentLevel+subtreeHeight(children, projectUID) > limit {
+			slog.WarnContext(ctx, "project hierarchy would exceed the maximum depth",
+				slog.String("parent_uid", parentUID),
+				slog.Int("limit", limit),
+			)
+			return &domain.PolicyViolationError{Policy: domain.PolicyMaxHierarchyDepth, Limit: limit}
+		}
+	}
+
+	return nil
+}
+
+// subtreeHeight returns the number of levels in the subtree rooted at
+// projectUID, which is 1 for a project without children or a new project.
+func subtreeHeight(children map[string][]string, projectUID string) int {
+	if projectUID == "" {
+		return 1
+	}
+	height := 0
+	visited := map[string]bool{projectUID: true}
+	for level := []string{projectUID}; len(level) > 0; height++ {
+		var next []string
+		for _, uid := range level {
+			for _, childUID := range children[uid] {
+				if !visited[childUID] {
+					visited[childUID] = true
+					next = append(next, childUID)
+				}
+			}
+		}
+		level = next
+	}
+	return height
+}
+
+// hasAllProjectTags reports whether the project has every tag in tags.
+func hasAllProjectTags(project *models.ProjectBase, tags []string) bool {
+	for _, tag := range tags {
+		if !slices.Contains(project.ProjectTags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// maxAnnotationsSize is the maximum combined size, in bytes, of a project's
+// annotation keys and values.
+const maxAnnotationsSize = 8 * 1024
+
+// validateAnnotations checks that the combined size of the annotation keys and
+// values stays within maxAnnotationsSize. The per-key and per-value limits are
+// enforced by the API design.
+func validateAnnotations(annotations map[string]string) error {
+	size := 0
+	for key, value := range annotations {
+		size += len(key) + len(value)
+	}
+	if size > maxAnnotationsSize {
+		return domain.ErrAnnotationsTooLarge
+	}
+	return nil
+}
+
 // enrichAllRoleFields overwrites Username, Name, and Avatar on every UserInfo across all supplied
 // slices and singles with authoritative values from the auth service.
 // Each unique email is looked up exactly once; lookups run concurrently with a bounded semaphore.
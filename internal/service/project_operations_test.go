@@ -5,6 +5,8 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,6 +15,8 @@ import (
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/auth"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
 	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -20,8 +24,21 @@ import (
 )
 
 func TestProjectsService_GetProjects(t *testing.T) {
+	taggedProjects := func(mockRepo *domain.MockProjectRepository, _ *domain.MockMessageBuilder) {
+		mockRepo.On("ListAllProjects", mock.Anything).Return(
+			[]*models.ProjectBase{
+				{UID: "project-1", Slug: "project-1", ProjectTags: []string{"cncf", "security-critical"}},
+				{UID: "project-2", Slug: "project-2", ProjectTags: []string{"cncf"}},
+				{UID: "project-3", Slug: "project-3"},
+			},
+			[]*models.ProjectSettings{},
+			nil,
+		)
+	}
+
 	tests := []struct {
 		name        string
+		tags        []string
 		setupMocks  func(*domain.MockProjectRepository, *domain.MockMessageBuilder)
 		expectedLen int
 		wantErr     bool
@@ -101,6 +118,24 @@ func TestProjectsService_GetProjects(t *testing.T) {
 			expectedLen: 0,
 			wantErr:     false,
 		},
+		{
+			name:        "filter by tag",
+			tags:        []string{"cncf"},
+			setupMocks:  taggedProjects,
+			expectedLen: 2,
+		},
+		{
+			name:        "filter by several tags requires all of them",
+			tags:        []string{"cncf", "security-critical"},
+			setupMocks:  taggedProjects,
+			expectedLen: 1,
+		},
+		{
+			name:        "filter by unknown tag",
+			tags:        []string{"lfai"},
+			setupMocks:  taggedProjects,
+			expectedLen: 0,
+		},
 	}
 
 	for _, tt := range tests {
@@ -113,7 +148,7 @@ func TestProjectsService_GetProjects(t *testing.T) {
 
 			tt.setupMocks(mockRepo, mockBuilder)
 
-			result, err := service.GetProjects(context.Background())
+			result, err := service.GetProjects(context.Background(), &projsvc.GetProjectsPayload{Tag: tt.tags})
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -123,7 +158,7 @@ func TestProjectsService_GetProjects(t *testing.T) {
 				assert.Nil(t, result)
 			} else {
 				assert.NoError(t, err)
-				assert.Len(t, result, tt.expectedLen)
+				assert.Len(t, result.Projects, tt.expectedLen)
 			}
 
 			mockRepo.AssertExpectations(t)
@@ -133,6 +168,26 @@ func TestProjectsService_GetProjects(t *testing.T) {
 	}
 }
 
+func TestProjectsService_GetProjectsBaseOnly(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	mockRepo.On("ListAllProjectsBase", mock.Anything).Return([]*models.ProjectBase{
+		{UID: "project-1", Slug: "project-1", Name: "Project 1"},
+		{UID: "project-2", Slug: "project-2", ParentUID: "project-1"},
+	}, nil)
+
+	result, err := service.GetProjects(context.Background(), &projsvc.GetProjectsPayload{BaseOnly: true})
+
+	require.NoError(t, err)
+	projects := result.Projects
+	require.Len(t, projects, 2)
+	assert.Equal(t, "Project 1", *projects[0].Name)
+	assert.Nil(t, projects[0].HasSettings)
+	assert.Equal(t, 1, *projects[0].ChildCount)
+	assert.Equal(t, "project-1/project-2", *projects[1].SlugPath)
+	mockRepo.AssertNotCalled(t, "ListAllProjects", mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
+
 func TestProjectsService_CreateProject(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -146,18 +201,19 @@ func TestProjectsService_CreateProject(t *testing.T) {
 		{
 			name: "successful project creation",
 			payload: &projsvc.CreateProjectPayload{
-				Slug:        "test-project",
+				Slug:        misc.StringPtr("test-project"),
 				Name:        "Test Project",
 				Description: "Test Description",
 				Public:      misc.BoolPtr(true),
-				Stage:       misc.StringPtr("incubating"),
-				Category:    misc.StringPtr("foundation"),
+				Stage:       misc.StringPtr("Active"),
+				Category:    misc.StringPtr("Incubating"),
 			},
 			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
 				mockRepo.On("ProjectSlugExists", mock.Anything, "test-project").Return(false, nil)
 				mockRepo.On("CreateProject", mock.Anything, mock.AnythingOfType("*models.ProjectBase"), mock.AnythingOfType("*models.ProjectSettings")).Return(nil)
 				mockBuilder.On("SendIndexerMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("types.IndexerMessageEnvelope"), mock.AnythingOfType("bool")).Return(nil).Times(2)
 				mockBuilder.On("SendAccessMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("types.GenericFGAMessage"), mock.AnythingOfType("bool")).Return(nil)
+				mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("events.ProjectLifecycleEvent")).Return(nil)
 			},
 			wantErr: false,
 			validate: func(t *testing.T, result *projsvc.ProjectFull) {
@@ -172,7 +228,7 @@ func TestProjectsService_CreateProject(t *testing.T) {
 		{
 			name: "service not ready",
 			payload: &projsvc.CreateProjectPayload{
-				Slug: "test-project",
+				Slug: misc.StringPtr("test-project"),
 				Name: "Test Project",
 			},
 			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
@@ -184,21 +240,34 @@ func TestProjectsService_CreateProject(t *testing.T) {
 		{
 			name: "invalid parent UID",
 			payload: &projsvc.CreateProjectPayload{
-				Slug:      "test-project",
+				Slug:      misc.StringPtr("test-project"),
 				Name:      "Test Project",
 				ParentUID: "invalid-uuid",
 			},
 			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
-				// Slug check happens first, then validation
+				// Validation fails before any DB call; no mocks required.
+			},
+			wantErr:     true,
+			expectedErr: domain.ErrInvalidParentUID,
+		},
+		{
+			name: "parent project not found",
+			payload: &projsvc.CreateProjectPayload{
+				Slug:      misc.StringPtr("test-project"),
+				Name:      "Test Project",
+				ParentUID: "787620d0-d7de-449a-b0bf-9d28b13da818",
+			},
+			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
 				mockRepo.On("ProjectSlugExists", mock.Anything, "test-project").Return(false, nil)
+				mockRepo.On("ProjectExists", mock.Anything, "787620d0-d7de-449a-b0bf-9d28b13da818").Return(false, nil)
 			},
 			wantErr:     true,
-			expectedErr: domain.ErrValidationFailed,
+			expectedErr: domain.ErrInvalidParentProject,
 		},
 		{
 			name: "slug already exists",
 			payload: &projsvc.CreateProjectPayload{
-				Slug: "existing-project",
+				Slug: misc.StringPtr("existing-project"),
 				Name: "Test Project",
 			},
 			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
@@ -207,10 +276,23 @@ func TestProjectsService_CreateProject(t *testing.T) {
 			wantErr:     true,
 			expectedErr: domain.ErrProjectSlugExists,
 		},
+		{
+			name: "category that is not allowed is rejected",
+			payload: &projsvc.CreateProjectPayload{
+				Slug:     misc.StringPtr("test-project"),
+				Name:     "Test Project",
+				Category: misc.StringPtr("foundation"),
+			},
+			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
+				// Validation fails before any DB call; no mocks required.
+			},
+			wantErr:     true,
+			expectedErr: domain.ErrInvalidEnumValue,
+		},
 		{
 			name: "archived stage without dissolution date is rejected",
 			payload: &projsvc.CreateProjectPayload{
-				Slug:  "archived-project",
+				Slug:  misc.StringPtr("archived-project"),
 				Name:  "Archived Project",
 				Stage: misc.StringPtr("Archived"),
 			},
@@ -223,7 +305,7 @@ func TestProjectsService_CreateProject(t *testing.T) {
 		{
 			name: "archived stage with empty dissolution date is rejected",
 			payload: &projsvc.CreateProjectPayload{
-				Slug:                  "archived-empty-date",
+				Slug:                  misc.StringPtr("archived-empty-date"),
 				Name:                  "Archived Project",
 				Stage:                 misc.StringPtr("Archived"),
 				EntityDissolutionDate: misc.StringPtr("   "),
@@ -234,10 +316,47 @@ func TestProjectsService_CreateProject(t *testing.T) {
 			wantErr:     true,
 			expectedErr: domain.ErrArchivedRequiresDissolutionDate,
 		},
+		{
+			name: "annotations exceeding the total size limit are rejected",
+			payload: &projsvc.CreateProjectPayload{
+				Slug: misc.StringPtr("annotated-project"),
+				Name: "Annotated Project",
+				Annotations: map[string]string{
+					"a": strings.Repeat("x", 1024*5),
+					"b": strings.Repeat("x", 1024*5),
+				},
+			},
+			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
+				// Validation fails before any DB call; no mocks required.
+			},
+			wantErr:     true,
+			expectedErr: domain.ErrAnnotationsTooLarge,
+		},
+		{
+			name: "annotations are stored and returned",
+			payload: &projsvc.CreateProjectPayload{
+				Slug:        misc.StringPtr("annotated-project"),
+				Name:        "Annotated Project",
+				Description: "Desc",
+				Annotations: map[string]string{"salesforce.v1/id": "a0941000002wBz9AAE"},
+			},
+			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
+				mockRepo.On("ProjectSlugExists", mock.Anything, "annotated-project").Return(false, nil)
+				mockRepo.On("CreateProject", mock.Anything, mock.MatchedBy(func(p *models.ProjectBase) bool {
+					return p.Annotations["salesforce.v1/id"] == "a0941000002wBz9AAE"
+				}), mock.AnythingOfType("*models.ProjectSettings")).Return(nil)
+				mockBuilder.On("SendIndexerMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("types.IndexerMessageEnvelope"), mock.AnythingOfType("bool")).Return(nil).Times(2)
+				mockBuilder.On("SendAccessMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("types.GenericFGAMessage"), mock.AnythingOfType("bool")).Return(nil)
+				mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("events.ProjectLifecycleEvent")).Return(nil)
+			},
+			validate: func(t *testing.T, result *projsvc.ProjectFull) {
+				assert.Equal(t, map[string]string{"salesforce.v1/id": "a0941000002wBz9AAE"}, result.Annotations)
+			},
+		},
 		{
 			name: "archived stage with dissolution date succeeds",
 			payload: &projsvc.CreateProjectPayload{
-				Slug:                  "archived-with-date",
+				Slug:                  misc.StringPtr("archived-with-date"),
 				Name:                  "Archived Project",
 				Description:           "Desc",
 				Stage:                 misc.StringPtr("Archived"),
@@ -248,6 +367,7 @@ func TestProjectsService_CreateProject(t *testing.T) {
 				mockRepo.On("CreateProject", mock.Anything, mock.AnythingOfType("*models.ProjectBase"), mock.AnythingOfType("*models.ProjectSettings")).Return(nil)
 				mockBuilder.On("SendIndexerMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("types.IndexerMessageEnvelope"), mock.AnythingOfType("bool")).Return(nil).Times(2)
 				mockBuilder.On("SendAccessMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("types.GenericFGAMessage"), mock.AnythingOfType("bool")).Return(nil)
+				mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("events.ProjectLifecycleEvent")).Return(nil)
 			},
 			wantErr: false,
 			validate: func(t *testing.T, result *projsvc.ProjectFull) {
@@ -258,7 +378,7 @@ func TestProjectsService_CreateProject(t *testing.T) {
 		{
 			name: "repository creation error",
 			payload: &projsvc.CreateProjectPayload{
-				Slug: "test-project",
+				Slug: misc.StringPtr("test-project"),
 				Name: "Test Project",
 			},
 			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
@@ -271,7 +391,7 @@ func TestProjectsService_CreateProject(t *testing.T) {
 		{
 			name: "create project — writer username enriched from email",
 			payload: &projsvc.CreateProjectPayload{
-				Slug:        "new-project",
+				Slug:        misc.StringPtr("new-project"),
 				Name:        "New Project",
 				Description: "Desc",
 				Writers: []*projsvc.UserInfo{
@@ -289,6 +409,7 @@ func TestProjectsService_CreateProject(t *testing.T) {
 				})).Return(nil)
 				mockBuilder.On("SendIndexerMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(2)
 				mockBuilder.On("SendAccessMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("events.ProjectLifecycleEvent")).Return(nil)
 			},
 			wantErr: false,
 			validate: func(t *testing.T, result *projsvc.ProjectFull) {
@@ -316,7 +437,7 @@ func TestProjectsService_CreateProject(t *testing.T) {
 			if tt.wantErr {
 				assert.Error(t, err)
 				if tt.expectedErr != nil {
-					assert.Equal(t, tt.expectedErr, err)
+					assert.ErrorIs(t, err, tt.expectedErr)
 				}
 				assert.Nil(t, result)
 			} else {
@@ -335,6 +456,50 @@ func TestProjectsService_CreateProject(t *testing.T) {
 	}
 }
 
+func TestProjectsService_CreateProject_restrictedFields(t *testing.T) {
+	payload := func() *projsvc.CreateProjectPayload {
+		return &projsvc.CreateProjectPayload{
+			Slug:            misc.StringPtr("test-project"),
+			Name:            "Test Project",
+			Description:     "Test Description",
+			LegalEntityType: misc.StringPtr("Subsidiary"),
+			FundingModel:    []string{"Membership"},
+		}
+	}
+
+	t.Run("writer cannot set restricted fields", func(t *testing.T) {
+		service, mockRepo, _, _ := setupServiceForTesting()
+		service.Config.EnforceFieldPermissions = true
+		ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "alice")
+
+		result, err := service.CreateProject(ctx, payload())
+
+		assert.ErrorIs(t, err, domain.ErrRestrictedField)
+		var validationErr *domain.ValidationError
+		if assert.ErrorAs(t, err, &validationErr) {
+			assert.Len(t, validationErr.Fields, 2)
+		}
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "CreateProject", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("staff sets restricted fields", func(t *testing.T) {
+		service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+		service.Config.EnforceFieldPermissions = true
+		mockRepo.On("ProjectSlugExists", mock.Anything, "test-project").Return(false, nil)
+		mockRepo.On("CreateProject", mock.Anything, mock.AnythingOfType("*models.ProjectBase"), mock.AnythingOfType("*models.ProjectSettings")).Return(nil)
+		mockBuilder.On("SendIndexerMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("types.IndexerMessageEnvelope"), mock.AnythingOfType("bool")).Return(nil).Times(2)
+		mockBuilder.On("SendAccessMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("types.GenericFGAMessage"), mock.AnythingOfType("bool")).Return(nil)
+		mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("events.ProjectLifecycleEvent")).Return(nil)
+
+		result, err := service.CreateProject(staffContext(), payload())
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Membership"}, result.FundingModel)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
 func TestProjectsService_GetOneProjectBase(t *testing.T) {
 	now := time.Now()
 
@@ -376,6 +541,62 @@ func TestProjectsService_GetOneProjectBase(t *testing.T) {
 				assert.Equal(t, "123", *result.Etag)
 			},
 		},
+		{
+			name: "expand settings",
+			payload: &projsvc.GetOneProjectBasePayload{
+				UID:    misc.StringPtr("test-project-uid"),
+				Expand: misc.StringPtr("settings"),
+			},
+			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
+				mockRepo.On("GetProjectBaseWithRevision", mock.Anything, "test-project-uid").Return(
+					&models.ProjectBase{UID: "test-project-uid", Slug: "test-project"}, uint64(123), nil,
+				)
+				mockRepo.On("GetProjectSettingsWithRevision", mock.Anything, "test-project-uid").Return(
+					&models.ProjectSettings{
+						UID:              "test-project-uid",
+						MissionStatement: "Mission",
+						Writers:          []models.UserInfo{{Username: "writer1"}},
+					},
+					uint64(45),
+					nil,
+				)
+			},
+			validate: func(t *testing.T, result *projsvc.GetOneProjectBaseResult) {
+				assert.Equal(t, "test-project", *result.Project.Slug)
+				assert.Equal(t, "Mission", *result.Project.MissionStatement)
+				require.Len(t, result.Project.Writers, 1)
+				assert.True(t, *result.Project.HasSettings)
+				assert.Equal(t, "123", *result.Etag)
+				assert.Equal(t, "45", *result.SettingsEtag)
+			},
+		},
+		{
+			name: "base only without expand",
+			payload: &projsvc.GetOneProjectBasePayload{
+				UID: misc.StringPtr("test-project-uid"),
+			},
+			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
+				mockRepo.On("GetProjectBaseWithRevision", mock.Anything, "test-project-uid").Return(
+					&models.ProjectBase{UID: "test-project-uid", Slug: "test-project"}, uint64(123), nil,
+				)
+			},
+			validate: func(t *testing.T, result *projsvc.GetOneProjectBaseResult) {
+				assert.Nil(t, result.Project.HasSettings)
+				assert.Nil(t, result.Project.DisplayStatus)
+				assert.Nil(t, result.SettingsEtag)
+			},
+		},
+		{
+			name: "settings fields cannot be selected without expand",
+			payload: &projsvc.GetOneProjectBasePayload{
+				UID:    misc.StringPtr("test-project-uid"),
+				Fields: misc.StringPtr("uid,writers"),
+			},
+			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
+				// No repo calls expected
+			},
+			wantErr: true,
+		},
 		{
 			name: "project not found",
 			payload: &projsvc.GetOneProjectBasePayload{
@@ -442,6 +663,65 @@ func TestProjectsService_GetOneProjectBase(t *testing.T) {
 	}
 }
 
+func TestProjectsService_GetProjectUIDFromLegacyID(t *testing.T) {
+	tests := []struct {
+		name        string
+		payload     *projsvc.GetProjectUIDFromLegacyIDPayload
+		setupMocks  func(*domain.MockProjectRepository)
+		expected    *projsvc.GetProjectUIDFromLegacyIDResult
+		expectedErr error
+	}{
+		{
+			name:    "legacy ID is resolved",
+			payload: &projsvc.GetProjectUIDFromLegacyIDPayload{LegacyID: "a0941000002wBz9AAE"},
+			setupMocks: func(mockRepo *domain.MockProjectRepository) {
+				mockRepo.On("GetProjectUIDFromLegacyID", mock.Anything, "a0941000002wBz9AAE").Return("project-uid-1", nil)
+			},
+			expected: &projsvc.GetProjectUIDFromLegacyIDResult{UID: "project-uid-1", LegacyID: "a0941000002wBz9AAE"},
+		},
+		{
+			name:    "legacy ID not found",
+			payload: &projsvc.GetProjectUIDFromLegacyIDPayload{LegacyID: "a0941000002wBz9AAE"},
+			setupMocks: func(mockRepo *domain.MockProjectRepository) {
+				mockRepo.On("GetProjectUIDFromLegacyID", mock.Anything, "a0941000002wBz9AAE").Return("", domain.ErrProjectNotFound)
+			},
+			expectedErr: domain.ErrProjectNotFound,
+		},
+		{
+			name:    "repository error",
+			payload: &projsvc.GetProjectUIDFromLegacyIDPayload{LegacyID: "a0941000002wBz9AAE"},
+			setupMocks: func(mockRepo *domain.MockProjectRepository) {
+				mockRepo.On("GetProjectUIDFromLegacyID", mock.Anything, "a0941000002wBz9AAE").Return("", domain.ErrUnmarshal)
+			},
+			expectedErr: domain.ErrInternal,
+		},
+		{
+			name:        "empty legacy ID",
+			payload:     &projsvc.GetProjectUIDFromLegacyIDPayload{},
+			setupMocks:  func(_ *domain.MockProjectRepository) {},
+			expectedErr: domain.ErrValidationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := setupServiceForTesting()
+			tt.setupMocks(mockRepo)
+
+			result, err := service.GetProjectUIDFromLegacyID(context.Background(), tt.payload)
+
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestProjectsService_DeleteProject(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -475,6 +755,7 @@ func TestProjectsService_DeleteProject(t *testing.T) {
 					Operation:  "delete_access",
 					Data:       fgatypes.GenericDeleteData{UID: "test-project-uid"},
 				}, mock.AnythingOfType("bool")).Return(nil)
+				mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("events.ProjectLifecycleEvent")).Return(nil)
 			},
 			wantErr: false,
 		},
@@ -645,6 +926,7 @@ func TestProjectsService_DeleteProject(t *testing.T) {
 					Operation:  "delete_access",
 					Data:       fgatypes.GenericDeleteData{UID: "test-project-uid"},
 				}, mock.AnythingOfType("bool")).Return(nil)
+				mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("events.ProjectLifecycleEvent")).Return(nil)
 			},
 			wantErr: false,
 		},
@@ -774,6 +1056,85 @@ func TestProjectsService_ProjectValidation(t *testing.T) {
 	}
 }
 
+func TestValidateProjectBasePayload(t *testing.T) {
+	tests := []struct {
+		name           string
+		stage          *string
+		dissolution    *string
+		annotations    map[string]string
+		parentUID      string
+		links          *projsvc.ProjectLinks
+		nameI18n       map[string]string
+		descI18n       map[string]string
+		expectedFields []domain.FieldError
+	}{
+		{
+			name:        "valid payload",
+			stage:       misc.StringPtr("Archived"),
+			dissolution: misc.StringPtr("2021-12-31"),
+			annotations: map[string]string{"a": "b"},
+			parentUID:   "787620d0-d7de-449a-b0bf-9d28b13da818",
+			links: &projsvc.ProjectLinks{
+				Twitter:      misc.StringPtr("https://x.com/example"),
+				MailingList:  misc.StringPtr("https://lists.example.org/g/main"),
+				ContactEmail: misc.StringPtr("info@example.org"),
+			},
+			nameI18n: map[string]string{"zh": "示例基金会", "pt-BR": "Fundação Exemplo"},
+			descI18n: map[string]string{"ja": "例のプロジェクト"},
+		},
+		{
+			name:     "malformed language tags and blank localized values are reported",
+			nameI18n: map[string]string{"zh": "示例基金会", "abcdefghi": "Example", "ja": " "},
+			descI18n: map[string]string{"en-x": "Example"},
+			expectedFields: []domain.FieldError{
+				{Path: "name_i18n.abcdefghi", Code: domain.FieldErrorInvalid, Message: domain.ErrInvalidLocalization.Error(), Err: domain.ErrInvalidLocalization},
+				{Path: "name_i18n.ja", Code: domain.FieldErrorRequired, Message: domain.ErrInvalidLocalization.Error(), Err: domain.ErrInvalidLocalization},
+				{Path: "description_i18n.en-x", Code: domain.FieldErrorInvalid, Message: domain.ErrInvalidLocalization.Error(), Err: domain.ErrInvalidLocalization},
+			},
+		},
+		{
+			name: "invalid links are reported per field",
+			links: &projsvc.ProjectLinks{
+				Twitter:      misc.StringPtr("javascript:alert(1)"),
+				Linkedin:     misc.StringPtr("https://www.linkedin.com/company/example"),
+				Discord:      misc.StringPtr("/invite/example"),
+				ContactEmail: misc.StringPtr("Example <info@example.org>"),
+			},
+			expectedFields: []domain.FieldError{
+				{Path: "links.twitter", Code: domain.FieldErrorInvalid, Message: domain.ErrInvalidProjectLink.Error(), Err: domain.ErrInvalidProjectLink},
+				{Path: "links.discord", Code: domain.FieldErrorInvalid, Message: domain.ErrInvalidProjectLink.Error(), Err: domain.ErrInvalidProjectLink},
+				{Path: "links.contact_email", Code: domain.FieldErrorInvalid, Message: domain.ErrInvalidContactEmail.Error(), Err: domain.ErrInvalidContactEmail},
+			},
+		},
+		{
+			name:        "every invalid field is reported",
+			stage:       misc.StringPtr("Archived"),
+			annotations: map[string]string{"a": strings.Repeat("x", maxAnnotationsSize)},
+			parentUID:   "not-a-uuid",
+			expectedFields: []domain.FieldError{
+				{Path: "entity_dissolution_date", Code: domain.FieldErrorRequired, Message: domain.ErrArchivedRequiresDissolutionDate.Error(), Err: domain.ErrArchivedRequiresDissolutionDate},
+				{Path: "annotations", Code: domain.FieldErrorTooLarge, Message: domain.ErrAnnotationsTooLarge.Error(), Err: domain.ErrAnnotationsTooLarge},
+				{Path: "parent_uid", Code: domain.FieldErrorInvalid, Message: domain.ErrInvalidParentUID.Error(), Err: domain.ErrInvalidParentUID},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateProjectBasePayload(tt.stage, tt.dissolution, tt.annotations, tt.parentUID, tt.links, tt.nameI18n, tt.descI18n)
+
+			if tt.expectedFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr *domain.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.ErrorIs(t, err, domain.ErrValidationFailed)
+			assert.Equal(t, tt.expectedFields, validationErr.Fields)
+		})
+	}
+}
+
 func TestProjectsService_UpdateProjectBase(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -784,7 +1145,7 @@ func TestProjectsService_UpdateProjectBase(t *testing.T) {
 		validate    func(*testing.T, *projsvc.ProjectBase)
 	}{
 		{
-			name: "successful update — publishes FGA update_access message",
+			name: "making the project public — publishes FGA update_access message",
 			payload: &projsvc.UpdateProjectBasePayload{
 				UID:     misc.StringPtr("project-uid-1"),
 				IfMatch: misc.StringPtr("1"),
@@ -794,17 +1155,12 @@ func TestProjectsService_UpdateProjectBase(t *testing.T) {
 			},
 			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
 				projectDB := &models.ProjectBase{
-					UID:    "project-uid-1",
-					Slug:   "test-project",
-					Name:   "Test Project",
-					Public: true,
-				}
-				settingsDB := &models.ProjectSettings{
-					UID: "project-uid-1",
+					UID:  "project-uid-1",
+					Slug: "test-project",
+					Name: "Test Project",
 				}
 				mockRepo.On("GetProjectBase", mock.Anything, "project-uid-1").Return(projectDB, nil)
 				mockRepo.On("UpdateProjectBase", mock.Anything, mock.AnythingOfType("*models.ProjectBase"), uint64(1)).Return(nil)
-				mockRepo.On("GetProjectSettings", mock.Anything, "project-uid-1").Return(settingsDB, nil)
 				mockBuilder.On("SendIndexerMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("types.IndexerMessageEnvelope"), mock.AnythingOfType("bool")).Return(nil)
 				mockBuilder.On("SendAccessMessage",
 					mock.Anything,
@@ -813,14 +1169,16 @@ func TestProjectsService_UpdateProjectBase(t *testing.T) {
 						ObjectType: "project",
 						Operation:  "update_access",
 						Data: fgatypes.GenericAccessData{
-							UID:        "project-uid-1",
-							Public:     true,
-							Relations:  make(map[string][]string),
-							References: make(map[string][]string),
+							UID:              "project-uid-1",
+							Public:           true,
+							Relations:        make(map[string][]string),
+							References:       make(map[string][]string),
+							ExcludeRelations: []string{"writer", "auditor", "meeting_coordinator", "executive_director"},
 						},
 					},
 					mock.AnythingOfType("bool"),
 				).Return(nil)
+				mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("events.ProjectLifecycleEvent")).Return(nil)
 			},
 			wantErr: false,
 			validate: func(t *testing.T, result *projsvc.ProjectBase) {
@@ -829,7 +1187,83 @@ func TestProjectsService_UpdateProjectBase(t *testing.T) {
 			},
 		},
 		{
-			name: "successful update — FGA message includes parent reference",
+			name: "unchanged logo keeps the PNG logo URL",
+			payload: &projsvc.UpdateProjectBasePayload{
+				UID:     misc.StringPtr("project-uid-1"),
+				IfMatch: misc.StringPtr("1"),
+				Slug:    "test-project",
+				Name:    "Test Project",
+				LogoURL: misc.StringPtr("https://example.org/logo.svg"),
+			},
+			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
+				projectDB := &models.ProjectBase{
+					UID:        "project-uid-1",
+					Slug:       "test-project",
+					Name:       "Test Project",
+					LogoURL:    "https://example.org/logo.svg",
+					PNGLogoURL: "https://logos.example.org/project-uid-1.png",
+				}
+				mockRepo.On("GetProjectBase", mock.Anything, "project-uid-1").Return(projectDB, nil)
+				mockRepo.On("UpdateProjectBase", mock.Anything, mock.MatchedBy(func(p *models.ProjectBase) bool {
+					return p.PNGLogoURL == "https://logos.example.org/project-uid-1.png"
+				}), uint64(1)).Return(nil)
+				mockBuilder.On("SendIndexerMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name: "changed logo clears the PNG logo URL",
+			payload: &projsvc.UpdateProjectBasePayload{
+				UID:     misc.StringPtr("project-uid-1"),
+				IfMatch: misc.StringPtr("1"),
+				Slug:    "test-project",
+				Name:    "Test Project",
+				LogoURL: misc.StringPtr("https://example.org/new-logo.svg"),
+			},
+			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
+				projectDB := &models.ProjectBase{
+					UID:        "project-uid-1",
+					Slug:       "test-project",
+					Name:       "Test Project",
+					LogoURL:    "https://example.org/logo.svg",
+					PNGLogoURL: "https://logos.example.org/project-uid-1.png",
+				}
+				mockRepo.On("GetProjectBase", mock.Anything, "project-uid-1").Return(projectDB, nil)
+				mockRepo.On("UpdateProjectBase", mock.Anything, mock.MatchedBy(func(p *models.ProjectBase) bool {
+					return p.PNGLogoURL == ""
+				}), uint64(1)).Return(nil)
+				mockBuilder.On("SendIndexerMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name: "rename appends the previous name",
+			payload: &projsvc.UpdateProjectBasePayload{
+				UID:     misc.StringPtr("project-uid-1"),
+				IfMatch: misc.StringPtr("1"),
+				Slug:    "test-project",
+				Name:    "Renamed Project",
+			},
+			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
+				projectDB := &models.ProjectBase{
+					UID:           "project-uid-1",
+					Slug:          "test-project",
+					Name:          "Test Project",
+					PreviousNames: []models.PreviousName{{Name: "First Project"}},
+				}
+				mockRepo.On("GetProjectBase", mock.Anything, "project-uid-1").Return(projectDB, nil)
+				mockRepo.On("UpdateProjectBase", mock.Anything, mock.MatchedBy(func(p *models.ProjectBase) bool {
+					return len(p.PreviousNames) == 2 &&
+						p.PreviousNames[0].Name == "First Project" &&
+						p.PreviousNames[1].Name == "Test Project" &&
+						!p.PreviousNames[1].RenamedAt.IsZero()
+				}), uint64(1)).Return(nil)
+				mockBuilder.On("SendIndexerMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name: "moving under a parent — FGA message includes parent reference",
 			payload: &projsvc.UpdateProjectBasePayload{
 				UID:       misc.StringPtr("aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee"),
 				IfMatch:   misc.StringPtr("5"),
@@ -839,16 +1273,14 @@ func TestProjectsService_UpdateProjectBase(t *testing.T) {
 			},
 			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
 				projectDB := &models.ProjectBase{
-					UID:       "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
-					Slug:      "child-project",
-					Name:      "Child Project",
-					ParentUID: "11111111-2222-3333-4444-555555555555",
+					UID:  "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+					Slug: "child-project",
+					Name: "Child Project",
 				}
-				settingsDB := &models.ProjectSettings{UID: "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee"}
 				mockRepo.On("GetProjectBase", mock.Anything, "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee").Return(projectDB, nil)
 				mockRepo.On("ProjectExists", mock.Anything, "11111111-2222-3333-4444-555555555555").Return(true, nil)
+				mockRepo.On("GetProjectBase", mock.Anything, "11111111-2222-3333-4444-555555555555").Return(&models.ProjectBase{UID: "11111111-2222-3333-4444-555555555555"}, nil)
 				mockRepo.On("UpdateProjectBase", mock.Anything, mock.AnythingOfType("*models.ProjectBase"), uint64(5)).Return(nil)
-				mockRepo.On("GetProjectSettings", mock.Anything, "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee").Return(settingsDB, nil)
 				mockBuilder.On("SendIndexerMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("types.IndexerMessageEnvelope"), mock.AnythingOfType("bool")).Return(nil)
 				mockBuilder.On("SendAccessMessage",
 					mock.Anything,
@@ -857,17 +1289,38 @@ func TestProjectsService_UpdateProjectBase(t *testing.T) {
 						ObjectType: "project",
 						Operation:  "update_access",
 						Data: fgatypes.GenericAccessData{
-							UID:        "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
-							Public:     false,
-							Relations:  make(map[string][]string),
-							References: map[string][]string{"parent": {"project:11111111-2222-3333-4444-555555555555"}},
+							UID:              "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+							Public:           false,
+							Relations:        make(map[string][]string),
+							References:       map[string][]string{"parent": {"project:11111111-2222-3333-4444-555555555555"}},
+							ExcludeRelations: []string{"writer", "auditor", "meeting_coordinator", "executive_director"},
 						},
 					},
 					mock.AnythingOfType("bool"),
 				).Return(nil)
+				mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("events.ProjectLifecycleEvent")).Return(nil)
 			},
 			wantErr: false,
 		},
+		{
+			name: "parent change creating a cycle is rejected",
+			payload: &projsvc.UpdateProjectBasePayload{
+				UID:       misc.StringPtr("aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee"),
+				IfMatch:   misc.StringPtr("5"),
+				Slug:      "parent-project",
+				Name:      "Parent Project",
+				ParentUID: "11111111-2222-3333-4444-555555555555",
+			},
+			setupMocks: func(mockRepo *domain.MockProjectRepository, _ *domain.MockMessageBuilder) {
+				projectDB := &models.ProjectBase{UID: "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", Slug: "parent-project", Name: "Parent Project"}
+				childDB := &models.ProjectBase{UID: "11111111-2222-3333-4444-555555555555", ParentUID: "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee"}
+				mockRepo.On("GetProjectBase", mock.Anything, "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee").Return(projectDB, nil)
+				mockRepo.On("ProjectExists", mock.Anything, "11111111-2222-3333-4444-555555555555").Return(true, nil)
+				mockRepo.On("GetProjectBase", mock.Anything, "11111111-2222-3333-4444-555555555555").Return(childDB, nil)
+			},
+			wantErr:     true,
+			expectedErr: domain.ErrProjectHierarchyCycle,
+		},
 		{
 			name:        "service not ready",
 			payload:     &projsvc.UpdateProjectBasePayload{UID: misc.StringPtr("project-uid-1")},
@@ -904,6 +1357,21 @@ func TestProjectsService_UpdateProjectBase(t *testing.T) {
 			wantErr:     true,
 			expectedErr: domain.ErrArchivedRequiresDissolutionDate,
 		},
+		{
+			name: "annotations exceeding the total size limit are rejected",
+			payload: &projsvc.UpdateProjectBasePayload{
+				UID:         misc.StringPtr("project-uid-1"),
+				IfMatch:     misc.StringPtr("1"),
+				Slug:        "test-project",
+				Name:        "Test Project",
+				Annotations: map[string]string{"a": strings.Repeat("x", maxAnnotationsSize)},
+			},
+			setupMocks: func(mockRepo *domain.MockProjectRepository, _ *domain.MockMessageBuilder) {
+				// Validation fails before any DB call; no mocks required.
+			},
+			wantErr:     true,
+			expectedErr: domain.ErrAnnotationsTooLarge,
+		},
 		{
 			name: "archived stage with dissolution date succeeds",
 			payload: &projsvc.UpdateProjectBasePayload{
@@ -916,12 +1384,10 @@ func TestProjectsService_UpdateProjectBase(t *testing.T) {
 			},
 			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
 				projectDB := &models.ProjectBase{UID: "project-uid-1", Slug: "test-project", Name: "Test Project"}
-				settingsDB := &models.ProjectSettings{UID: "project-uid-1"}
 				mockRepo.On("GetProjectBase", mock.Anything, "project-uid-1").Return(projectDB, nil)
 				mockRepo.On("UpdateProjectBase", mock.Anything, mock.AnythingOfType("*models.ProjectBase"), uint64(1)).Return(nil)
-				mockRepo.On("GetProjectSettings", mock.Anything, "project-uid-1").Return(settingsDB, nil)
 				mockBuilder.On("SendIndexerMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("types.IndexerMessageEnvelope"), mock.AnythingOfType("bool")).Return(nil)
-				mockBuilder.On("SendAccessMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("types.GenericFGAMessage"), mock.AnythingOfType("bool")).Return(nil)
+				mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("events.ProjectLifecycleEvent")).Return(nil)
 			},
 			wantErr: false,
 			validate: func(t *testing.T, result *projsvc.ProjectBase) {
@@ -940,12 +1406,10 @@ func TestProjectsService_UpdateProjectBase(t *testing.T) {
 			},
 			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
 				projectDB := &models.ProjectBase{UID: "project-uid-1", Slug: "test-project", Name: "Test Project"}
-				settingsDB := &models.ProjectSettings{UID: "project-uid-1"}
 				mockRepo.On("GetProjectBase", mock.Anything, "project-uid-1").Return(projectDB, nil)
 				mockRepo.On("UpdateProjectBase", mock.Anything, mock.AnythingOfType("*models.ProjectBase"), uint64(1)).Return(nil)
-				mockRepo.On("GetProjectSettings", mock.Anything, "project-uid-1").Return(settingsDB, nil)
 				mockBuilder.On("SendIndexerMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("types.IndexerMessageEnvelope"), mock.AnythingOfType("bool")).Return(nil)
-				mockBuilder.On("SendAccessMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("types.GenericFGAMessage"), mock.AnythingOfType("bool")).Return(nil)
+				mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("events.ProjectLifecycleEvent")).Return(nil)
 			},
 			wantErr: false,
 		},
@@ -966,7 +1430,7 @@ func TestProjectsService_UpdateProjectBase(t *testing.T) {
 			if tt.wantErr {
 				assert.Error(t, err)
 				if tt.expectedErr != nil {
-					assert.Equal(t, tt.expectedErr, err)
+					assert.ErrorIs(t, err, tt.expectedErr)
 				}
 				assert.Nil(t, result)
 			} else {
@@ -983,6 +1447,289 @@ func TestProjectsService_UpdateProjectBase(t *testing.T) {
 	}
 }
 
+func TestProjectBaseUpdatedMessages_legalChange(t *testing.T) {
+	updatedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	previous := &models.ProjectBase{UID: "project-1", FundingModel: []string{"Crowdfunding"}, LegalEntityType: "Subproject"}
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "jdoe")
+
+	tests := []struct {
+		name          string
+		project       *models.ProjectBase
+		expectedEvent bool
+	}{
+		{
+			name:    "unchanged legal fields",
+			project: &models.ProjectBase{UID: "project-1", Name: "Renamed", FundingModel: []string{"Crowdfunding"}, LegalEntityType: "Subproject", UpdatedAt: &updatedAt},
+		},
+		{
+			name:          "funding model changed",
+			project:       &models.ProjectBase{UID: "project-1", FundingModel: []string{"Membership"}, LegalEntityType: "Subproject", UpdatedAt: &updatedAt},
+			expectedEvent: true,
+		},
+		{
+			name:          "legal entity type changed",
+			project:       &models.ProjectBase{UID: "project-1", FundingModel: []string{"Crowdfunding"}, LegalEntityType: "Series LLC", UpdatedAt: &updatedAt},
+			expectedEvent: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messages := projectBaseUpdatedMessages(ctx, tt.project, previous)
+
+			var legal *events.ProjectLifecycleEvent
+			for _, m := range messages {
+				if m.subject == projectEventSubject(events.ProjectLegalChanged) {
+					event := m.message.(events.ProjectLifecycleEvent)
+					legal = &event
+				}
+			}
+			if !tt.expectedEvent {
+				assert.Nil(t, legal)
+				return
+			}
+			require.NotNil(t, legal)
+			assert.Equal(t, events.ProjectLegalChanged, legal.Type)
+			assert.Equal(t, "jdoe", legal.Actor.Username)
+			require.NotNil(t, legal.LegalChange)
+			assert.Equal(t, events.LegalFields{FundingModel: []string{"Crowdfunding"}, LegalEntityType: "Subproject"}, legal.LegalChange.Before)
+			assert.Equal(t, events.LegalFields{FundingModel: tt.project.FundingModel, LegalEntityType: tt.project.LegalEntityType}, legal.LegalChange.After)
+			assert.Equal(t, updatedAt, legal.LegalChange.EffectiveDate)
+		})
+	}
+}
+
+func TestProjectsService_checkHierarchyCycle(t *testing.T) {
+	// chain stores a hierarchy of depth projects, project-0 being the root and
+	// project-N the child of project-(N-1).
+	chain := func(mockRepo *domain.MockProjectRepository, depth int) {
+		for i := 0; i < depth; i++ {
+			projectDB := &models.ProjectBase{UID: fmt.Sprintf("project-%d", i)}
+			if i > 0 {
+				projectDB.ParentUID = fmt.Sprintf("project-%d", i-1)
+			}
+			mockRepo.On("GetProjectBase", mock.Anything, projectDB.UID).Return(projectDB, nil).Maybe()
+		}
+	}
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*domain.MockProjectRepository)
+		projectUID  string
+		parentUID   string
+		expectedErr error
+	}{
+		{
+			name:        "project as its own parent",
+			setupMocks:  func(mockRepo *domain.MockProjectRepository) {},
+			projectUID:  "project-0",
+			parentUID:   "project-0",
+			expectedErr: domain.ErrProjectHierarchyCycle,
+		},
+		{
+			name:        "root moved under a deep descendant",
+			setupMocks:  func(mockRepo *domain.MockProjectRepository) { chain(mockRepo, 100) },
+			projectUID:  "project-0",
+			parentUID:   "project-99",
+			expectedErr: domain.ErrProjectHierarchyCycle,
+		},
+		{
+			name:        "middle project moved under its own descendant",
+			setupMocks:  func(mockRepo *domain.MockProjectRepository) { chain(mockRepo, 100) },
+			projectUID:  "project-50",
+			parentUID:   "project-75",
+			expectedErr: domain.ErrProjectHierarchyCycle,
+		},
+		{
+			name:       "deep project moved under an unrelated ancestor",
+			setupMocks: func(mockRepo *domain.MockProjectRepository) { chain(mockRepo, 100) },
+			projectUID: "project-99",
+			parentUID:  "project-10",
+		},
+		{
+			name: "project moved under another tree",
+			setupMocks: func(mockRepo *domain.MockProjectRepository) {
+				chain(mockRepo, 10)
+				mockRepo.On("GetProjectBase", mock.Anything, "other-root").Return(&models.ProjectBase{UID: "other-root"}, nil)
+			},
+			projectUID: "project-0",
+			parentUID:  "other-root",
+		},
+		{
+			name: "existing cycle above the new parent",
+			setupMocks: func(mockRepo *domain.MockProjectRepository) {
+				mockRepo.On("GetProjectBase", mock.Anything, "loop-a").Return(&models.ProjectBase{UID: "loop-a", ParentUID: "loop-b"}, nil)
+				mockRepo.On("GetProjectBase", mock.Anything, "loop-b").Return(&models.ProjectBase{UID: "loop-b", ParentUID: "loop-a"}, nil)
+			},
+			projectUID: "project-0",
+			parentUID:  "loop-a",
+		},
+		{
+			name: "dangling ancestor reference",
+			setupMocks: func(mockRepo *domain.MockProjectRepository) {
+				mockRepo.On("GetProjectBase", mock.Anything, "orphan").Return(&models.ProjectBase{UID: "orphan", ParentUID: "gone"}, nil)
+				mockRepo.On("GetProjectBase", mock.Anything, "gone").Return(nil, domain.ErrProjectNotFound)
+			},
+			projectUID: "project-0",
+			parentUID:  "orphan",
+		},
+		{
+			name: "repository error",
+			setupMocks: func(mockRepo *domain.MockProjectRepository) {
+				mockRepo.On("GetProjectBase", mock.Anything, "project-1").Return(nil, domain.ErrUnmarshal)
+			},
+			projectUID:  "project-0",
+			parentUID:   "project-1",
+			expectedErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := setupServiceForTesting()
+			tt.setupMocks(mockRepo)
+
+			err := service.checkHierarchyCycle(context.Background(), tt.projectUID, tt.parentUID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectsService_enforceHierarchyPolicies(t *testing.T) {
+	// The stored hierarchy:
+	//
+	//	root
+	//	├── a
+	//	│   └── a1
+	//	│       └── a2
+	//	└── b
+	projects := []*models.ProjectBase{
+		{UID: "root"},
+		{UID: "a", ParentUID: "root"},
+		{UID: "a1", ParentUID: "a"},
+		{UID: "a2", ParentUID: "a1"},
+		{UID: "b", ParentUID: "root"},
+	}
+
+	tests := []struct {
+		name        string
+		config      ServiceConfig
+		projectUID  string
+		parentUID   string
+		expectedErr error
+	}{
+		{
+			name:      "no limits configured",
+			parentUID: "a2",
+		},
+		{
+			name:      "new project within limits",
+			config:    ServiceConfig{MaxHierarchyDepth: 5, MaxChildProjects: 2},
+			parentUID: "a2",
+		},
+		{
+			name:        "new project under a full parent",
+			config:      ServiceConfig{MaxChildProjects: 2},
+			parentUID:   "root",
+			expectedErr: &domain.PolicyViolationError{Policy: domain.PolicyMaxChildProjects, Limit: 2},
+		},
+		{
+			name:       "existing child does not count against its own parent",
+			config:     ServiceConfig{MaxChildProjects: 2},
+			projectUID: "b",
+			parentUID:  "root",
+		},
+		{
+			name:        "new project too deep",
+			config:      ServiceConfig{MaxHierarchyDepth: 4},
+			parentUID:   "a2",
+			expectedErr: &domain.PolicyViolationError{Policy: domain.PolicyMaxHierarchyDepth, Limit: 4},
+		},
+		{
+			name:        "moved subtree too deep",
+			config:      ServiceConfig{MaxHierarchyDepth: 4},
+			projectUID:  "a",
+			parentUID:   "b",
+			expectedErr: &domain.PolicyViolationError{Policy: domain.PolicyMaxHierarchyDepth, Limit: 4},
+		},
+		{
+			name:       "moved subtree within depth",
+			config:     ServiceConfig{MaxHierarchyDepth: 4},
+			projectUID: "a1",
+			parentUID:  "b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := setupServiceForTesting()
+			service.Config = tt.config
+			mockRepo.On("ListAllProjectsBase", mock.Anything).Return(projects, nil).Maybe()
+
+			err := service.enforceHierarchyPolicies(context.Background(), tt.projectUID, tt.parentUID)
+
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				assert.ErrorIs(t, err, domain.ErrPolicyViolation)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProjectsService_checkTextLengths(t *testing.T) {
+	tests := []struct {
+		name           string
+		fields         []textField
+		expectedFields []domain.FieldError
+	}{
+		{
+			name:   "within limits",
+			fields: []textField{{"description", "short", 10}, {"mission_statement", "héllo wörld", 11}},
+		},
+		{
+			name:   "no limit configured",
+			fields: []textField{{"description", strings.Repeat("x", 100), 0}},
+		},
+		{
+			name:   "too long fields are all reported",
+			fields: []textField{{"description", strings.Repeat("é", 11), 10}, {"mission_statement", "ok", 10}, {"other", "abcdef", 5}},
+			expectedFields: []domain.FieldError{
+				{Path: "description", Code: domain.FieldErrorTooLarge, Message: "description must be at most 10 characters, got 11", Err: domain.ErrFieldTooLong},
+				{Path: "other", Code: domain.FieldErrorTooLarge, Message: "other must be at most 5 characters, got 6", Err: domain.ErrFieldTooLong},
+			},
+		}, {
+			name:   "localized descriptions are checked per language",
+			fields: localizedTextFields("description_i18n", map[string]string{"zh": "短", "ja": strings.Repeat("長", 6)}, 5),
+			expectedFields: []domain.FieldError{
+				{Path: "description_i18n.ja", Code: domain.FieldErrorTooLarge, Message: "description_i18n.ja must be at most 5 characters, got 6", Err: domain.ErrFieldTooLong},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _, _, _ := setupServiceForTesting()
+
+			err := service.checkTextLengths(context.Background(), tt.fields...)
+
+			if tt.expectedFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, &domain.ValidationError{Fields: tt.expectedFields}, err)
+			assert.ErrorIs(t, err, domain.ErrFieldTooLong)
+		})
+	}
+}
+
 func TestProjectsService_UpdateProjectSettings(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -1088,7 +1835,6 @@ func TestProjectsService_UpdateProjectSettings(t *testing.T) {
 				}), uint64(1)).Return(nil)
 				mockRepo.On("GetProjectBase", mock.Anything, "project-uid-1").Return(projectDB, nil)
 				mockBuilder.On("SendIndexerMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				mockBuilder.On("SendAccessMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
 			wantErr: false,
@@ -1112,7 +1858,6 @@ func TestProjectsService_UpdateProjectSettings(t *testing.T) {
 				}), uint64(1)).Return(nil)
 				mockRepo.On("GetProjectBase", mock.Anything, "project-uid-1").Return(projectDB, nil)
 				mockBuilder.On("SendIndexerMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				mockBuilder.On("SendAccessMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
 			wantErr: false,
@@ -4,7 +4,12 @@
 package service
 
 import (
+	"strings"
+	"sync"
+
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
 )
 
 // ProjectsService implements the projsvc.Service interface and domain.MessageHandler
@@ -13,10 +18,91 @@ type ProjectsService struct {
 	DocumentRepository domain.DocumentRepository
 	LinkRepository     domain.LinkRepository
 	FolderRepository   domain.FolderRepository
-	MessageBuilder     domain.MessageBuilder
-	UserReader         domain.UserReader
-	Auth               domain.Authenticator
-	Config             ServiceConfig
+	// OutboxRepository is optional; when set, messages that follow a project write
+	// are recorded there until published so that failed publishes are retried.
+	OutboxRepository domain.OutboxRepository
+	// DeadLetterRepository is optional; when set, events whose handler fails are
+	// recorded there until replayed instead of being dropped.
+	DeadLetterRepository domain.DeadLetterRepository
+	MessageBuilder       domain.MessageBuilder
+	UserReader           domain.UserReader
+	// AccessChecker is optional; when set, project writes are checked against the
+	// principal's OpenFGA relations in addition to the gateway's checks.
+	AccessChecker domain.AccessChecker
+	// LogoStorage is optional; when set, project logos can be uploaded.
+	LogoStorage domain.LogoStorage
+	// LogoConverter is optional; when set, uploaded SVG logos are also stored as PNG.
+	LogoConverter domain.LogoConverter
+	// LogoFetcher is optional; when set with LogoStorage and LogoConverter, SVG
+	// logos set through logo_url are converted to PNG in the background.
+	LogoFetcher domain.LogoFetcher
+	// CharterStorage and CharterRepository are optional; when both are set,
+	// project charters can be uploaded and their versions listed.
+	CharterStorage    domain.CharterStorage
+	CharterRepository domain.CharterRepository
+	// AssociationRepository and AssociationChecker are optional; when both are
+	// set, projects can be associated with committees, mailing lists and
+	// meeting series.
+	AssociationRepository domain.AssociationRepository
+	AssociationChecker    domain.AssociationChecker
+	// SettingsHistoryRepository is optional; when set, the difference between
+	// two revisions of a project's settings can be read.
+	SettingsHistoryRepository domain.SettingsHistoryRepository
+	// ProjectHistoryRepository is optional; when set, the lifecycle events of a
+	// project can be replayed from the retained revisions of the project.
+	ProjectHistoryRepository domain.ProjectHistoryRepository
+	// KVInspector is optional; when set, admins can read the entries stored for
+	// a project as they are.
+	KVInspector domain.KVInspector
+	// SlugMappingRepository is optional; when set, admins can rebuild the slug
+	// to UID mappings of projects.
+	SlugMappingRepository domain.SlugMappingRepository
+	// BackupStorage and BackupRepository are optional; when both are set, admins
+	// can back up the project stores and restore them.
+	BackupStorage    domain.BackupStorage
+	BackupRepository domain.BackupRepository
+	// WebhookRepository and WebhookSender are optional; when both are set,
+	// admins can register webhooks that receive project lifecycle events.
+	WebhookRepository domain.WebhookRepository
+	WebhookSender     domain.WebhookSender
+	// AnnouncementRepository is optional; when set, the announcements of projects
+	// whose announcement date is reached can be published.
+	AnnouncementRepository domain.AnnouncementRepository
+	// ProjectTemplateRepository is optional; when set, admins can define
+	// templates that projects are created from.
+	ProjectTemplateRepository domain.ProjectTemplateRepository
+	// ProjectEnumsRepository is optional; when set, admins manage the stages
+	// and categories projects may have instead of using the defaults.
+	ProjectEnumsRepository domain.ProjectEnumsRepository
+	// ProjectDeletionRepository is optional; when set, a tombstone is recorded
+	// for every deleted project so that caches can drop it.
+	ProjectDeletionRepository domain.ProjectDeletionRepository
+	// ProjectStreamer is optional; when set, bulk reads of every project
+	// convert the projects as they are read instead of loading them all first.
+	ProjectStreamer domain.ProjectStreamer
+	// ProjectSearcher is optional; when set, project searches use the search
+	// index instead of scanning every project.
+	ProjectSearcher domain.ProjectSearcher
+	// ProjectSuggester is optional; when set, autocomplete finds projects in it
+	// instead of scanning every project.
+	ProjectSuggester domain.ProjectSuggester
+	// ConsistencyChecker is optional; when set, the project store can be scanned
+	// for inconsistencies by the integrity scanner.
+	ConsistencyChecker domain.ConsistencyChecker
+	// ReadinessChecker is optional; when set, the service is only ready while
+	// it reports the dependencies it probes as available.
+	ReadinessChecker domain.ReadinessChecker
+	Auth             domain.Authenticator
+	Config           ServiceConfig
+
+	healthChecksMu sync.RWMutex
+	healthChecks   []healthCheck
+
+	hierarchyCache projectHierarchyCache
+	enumsCache     projectEnumsCache
+
+	integrityMu     sync.Mutex
+	integrityReport *models.IntegrityReport
 }
 
 // NewProjectsService creates a new ProjectsService.
@@ -46,4 +132,86 @@ type ServiceConfig struct {
 	// InvitesEnabled gates outbound invite requests for non-LFID users via the invite service.
 	// Disabled by default; set INVITES_ENABLED=true to enable.
 	InvitesEnabled bool
+	// MaxHierarchyDepth is the maximum number of levels in a project hierarchy, a root
+	// project being level 1. Zero means unlimited; set with MAX_HIERARCHY_DEPTH.
+	MaxHierarchyDepth int
+	// MaxChildProjects is the maximum number of direct children of a project.
+	// Zero means unlimited; set with MAX_CHILD_PROJECTS.
+	MaxChildProjects int
+	// TrustedPrincipals are service principals that bypass the AccessChecker, such as
+	// the M2M clients of other platform services; set with TRUSTED_SERVICE_PRINCIPALS.
+	TrustedPrincipals []string
+	// EnforceFieldPermissions restricts changes to fields such as the legal entity type
+	// and funding model to LF staff and trusted principals; set FIELD_PERMISSIONS_ENABLED=true.
+	EnforceFieldPermissions bool
+	// PrivateLookupPolicy decides how NATS name and logo lookups of non-public
+	// projects are answered; set with PRIVATE_LOOKUP_POLICY. Defaults to PrivateLookupAllow.
+	PrivateLookupPolicy PrivateLookupPolicy
+	// MaxDescriptionLength and MaxMissionStatementLength cap, in characters,
+	// the project description and mission statement. Zero means no limit.
+	MaxDescriptionLength      int
+	MaxMissionStatementLength int
+	// RefreshUserInfo replaces the stored names and avatars of a project's users with
+	// their current profiles when the settings are read; set USER_INFO_REFRESH_ENABLED=true.
+	RefreshUserInfo bool
+	// DuplicateCheck rejects a new project with a similar name, or the same website or
+	// repository URL, as an existing project unless the request allows duplicates;
+	// set DUPLICATE_CHECK_ENABLED=true.
+	DuplicateCheck bool
+	// DuplicateNameSimilarity is the name similarity, between 0 and 1, from which two
+	// projects are considered duplicates; set with DUPLICATE_NAME_SIMILARITY.
+	DuplicateNameSimilarity float64
+	// AutojoinRole is the member role a user is given by joining a project with autojoin
+	// enabled; set with AUTOJOIN_ROLE. Defaults to models.ProjectMemberRoleAuditor.
+	AutojoinRole models.ProjectMemberRole
+	// AnnouncementPublic makes a project public when its announcement is published;
+	// set ANNOUNCEMENT_SET_PUBLIC=true.
+	AnnouncementPublic bool
+	// AnnouncementStage, when set, is the stage a project is moved to when its
+	// announcement is published; set with ANNOUNCEMENT_STAGE.
+	AnnouncementStage string
+	// VersionedLookupsOnly rejects NATS lookups that are not in the versioned
+	// events.LookupRequest envelope, ending the transition from plain-text
+	// requests; set NATS_VERSIONED_LOOKUPS_ONLY=true.
+	VersionedLookupsOnly bool
+	// Tenant, when set, namespaces the service's KV keys and NATS subjects so that
+	// several logical environments can share a NATS cluster; set with TENANT.
+	// Inbound subjects carry the tenant prefix, which handlers do not see.
+	Tenant string
+	// SubjectPrefix, when set, replaces constants.ProjectsAPISubjectPrefix in the
+	// subjects the service handles and publishes its own events on, so that
+	// instances with different prefixes share a NATS cluster; set with
+	// NATS_SUBJECT_PREFIX. Handlers see the subjects under the default prefix.
+	SubjectPrefix string
+	// ProjectCachePolicy and ProjectListCachePolicy are how long caches may keep
+	// the GET /projects/{uid} and GET /projects responses about public projects;
+	// set with CACHE_CONTROL_PROJECT_MAX_AGE, CACHE_CONTROL_PROJECTS_MAX_AGE and
+	// their _STALE_WHILE_REVALIDATE counterparts.
+	ProjectCachePolicy     CachePolicy
+	ProjectListCachePolicy CachePolicy
 }
+
+// localSubject returns subject without the tenant prefix and under the default
+// subject prefix, as the handlers know it.
+func (c ServiceConfig) localSubject(subject string) string {
+	if c.Tenant != "" {
+		subject = strings.TrimPrefix(subject, c.Tenant+constants.TenantSeparator)
+	}
+	if c.SubjectPrefix != "" {
+		if rest, ok := strings.CutPrefix(subject, c.SubjectPrefix+"."); ok {
+			subject = constants.ProjectsAPISubjectPrefix + "." + rest
+		}
+	}
+	return subject
+}
+
+// PrivateLookupPolicy decides how NATS lookups of non-public projects are answered.
+type PrivateLookupPolicy string
+
+const (
+	// PrivateLookupAllow answers lookups of non-public projects like any other.
+	PrivateLookupAllow PrivateLookupPolicy = "allow"
+	// PrivateLookupRedact answers lookups of non-public projects with an empty
+	// reply, unless the request asserts a principal that is a viewer of the project.
+	PrivateLookupRedact PrivateLookupPolicy = "redact"
+)
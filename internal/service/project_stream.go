@@ -0,0 +1,64 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+// forEachProject calls fn with each project base and its settings, which are
+// nil for a project without settings, in no particular order. With a
+// ProjectStreamer only the settings are held in memory while the project bases
+// are streamed; otherwise every project is listed first.
+func (s *ProjectsService) forEachProject(ctx context.Context, fn func(*models.ProjectBase, *models.ProjectSettings) error) error {
+	if s.ProjectStreamer == nil {
+		projectsBase, projectsSettings, err := s.ProjectRepository.ListAllProjects(ctx)
+		if err != nil {
+			return err
+		}
+		settingsByUID := make(map[string]*models.ProjectSettings, len(projectsSettings))
+		for _, settings := range projectsSettings {
+			settingsByUID[settings.UID] = settings
+		}
+		for _, projectBase := range projectsBase {
+			if err := fn(projectBase, settingsByUID[projectBase.UID]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	settingsByUID := make(map[string]*models.ProjectSettings)
+	err := s.ProjectStreamer.StreamProjectsSettings(ctx, func(settings *models.ProjectSettings) error {
+		settingsByUID[settings.UID] = settings
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return s.ProjectStreamer.StreamProjectsBase(ctx, func(projectBase *models.ProjectBase) error {
+		return fn(projectBase, settingsByUID[projectBase.UID])
+	})
+}
+
+// forEachProjectBase calls fn with each project base, in no particular order,
+// without reading the settings. With a ProjectStreamer the project bases are
+// streamed; otherwise they are listed first.
+func (s *ProjectsService) forEachProjectBase(ctx context.Context, fn func(*models.ProjectBase) error) error {
+	if s.ProjectStreamer != nil {
+		return s.ProjectStreamer.StreamProjectsBase(ctx, fn)
+	}
+	projectsBase, err := s.ProjectRepository.ListAllProjectsBase(ctx)
+	if err != nil {
+		return err
+	}
+	for _, projectBase := range projectsBase {
+		if err := fn(projectBase); err != nil {
+			return err
+		}
+	}
+	return nil
+}
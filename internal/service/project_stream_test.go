@@ -0,0 +1,126 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+// fakeProjectStreamer streams the projects it holds.
+type fakeProjectStreamer struct {
+	projects []*models.ProjectBase
+	settings []*models.ProjectSettings
+}
+
+func (f *fakeProjectStreamer) StreamProjectsBase(_ context.Context, fn func(*models.ProjectBase) error) error {
+	for _, project := range f.projects {
+		if err := fn(project); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeProjectStreamer) StreamProjectsSettings(_ context.Context, fn func(*models.ProjectSettings) error) error {
+	for _, settings := range f.settings {
+		if err := fn(settings); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestProjectsService_GetProjects_streamed(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	service.ProjectStreamer = &fakeProjectStreamer{
+		projects: []*models.ProjectBase{
+			{UID: "project-1", Slug: "project-1", ProjectTags: []string{"cncf"}},
+			{UID: "project-2", Slug: "project-2"},
+		},
+		settings: []*models.ProjectSettings{{UID: "project-1", MissionStatement: "Mission 1"}},
+	}
+
+	result, err := service.GetProjects(context.Background(), &projsvc.GetProjectsPayload{Tag: []string{"cncf"}})
+
+	require.NoError(t, err)
+	projects := result.Projects
+	require.Len(t, projects, 1)
+	assert.Equal(t, "project-1", *projects[0].UID)
+	assert.Equal(t, "Mission 1", *projects[0].MissionStatement)
+	mockRepo.AssertNotCalled(t, "ListAllProjects", mock.Anything)
+}
+
+func TestProjectsService_GetProjects_version2(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+	service.ProjectStreamer = &fakeProjectStreamer{
+		projects: []*models.ProjectBase{{UID: "project-1", Slug: "project-1"}},
+		settings: []*models.ProjectSettings{{UID: "project-1", MissionStatement: "Mission 1"}},
+	}
+
+	result, err := service.GetProjects(context.Background(), &projsvc.GetProjectsPayload{Version: misc.StringPtr(constants.APIVersion2)})
+
+	require.NoError(t, err)
+	projects := result.Projects
+	require.Len(t, projects, 1)
+	assert.Nil(t, projects[0].MissionStatement)
+	require.NotNil(t, projects[0].Settings)
+	assert.Equal(t, "Mission 1", *projects[0].Settings.MissionStatement)
+
+	result, err = service.GetProjects(context.Background(), &projsvc.GetProjectsPayload{Version: misc.StringPtr(constants.APIVersion1)})
+
+	require.NoError(t, err)
+	projects = result.Projects
+	require.Len(t, projects, 1)
+	assert.Equal(t, "Mission 1", *projects[0].MissionStatement)
+	assert.Nil(t, projects[0].Settings)
+}
+
+func TestProjectsService_GetProjects_computedFields(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	service.ProjectStreamer = &fakeProjectStreamer{
+		projects: []*models.ProjectBase{
+			{UID: "project-2", Slug: "kubernetes", ParentUID: "project-1", Stage: "Active", ProjectTags: []string{"cncf"}},
+			{UID: "project-1", Slug: "cncf", Stage: "Active"},
+			{UID: "project-3", Slug: "prometheus", ParentUID: "project-1", Stage: "Formation - Engaged", ProjectTags: []string{"cncf"}},
+		},
+		settings: []*models.ProjectSettings{{UID: "project-2", MissionStatement: "Mission"}},
+	}
+
+	result, err := service.GetProjects(context.Background(), &projsvc.GetProjectsPayload{Tag: []string{"cncf"}})
+
+	require.NoError(t, err)
+	projects := result.Projects
+	require.Len(t, projects, 2)
+	assert.Equal(t, "cncf/kubernetes", *projects[0].SlugPath, "the parent is indexed although the tag filter leaves it out")
+	assert.Equal(t, 2, *projects[0].Depth)
+	assert.Equal(t, 0, *projects[0].ChildCount)
+	assert.True(t, *projects[0].HasSettings)
+	assert.Equal(t, models.DisplayStatusActive, *projects[0].DisplayStatus)
+	assert.False(t, *projects[1].HasSettings)
+	assert.Equal(t, models.DisplayStatusForming, *projects[1].DisplayStatus)
+
+	// The hierarchy built by the listing is cached.
+	hierarchy, err := service.projectHierarchy(context.Background(), "project-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, hierarchy.ChildCount("project-1"))
+	mockRepo.AssertNotCalled(t, "ListAllProjectsBase", mock.Anything)
+
+	// Once dropped, the hierarchy is listed again.
+	service.invalidateProjectHierarchy()
+	mockRepo.On("ListAllProjectsBase", mock.Anything).Return([]*models.ProjectBase{{UID: "project-1", Slug: "cncf"}}, nil).Once()
+	hierarchy, err = service.projectHierarchy(context.Background(), "project-1")
+	require.NoError(t, err)
+	assert.Equal(t, 0, hierarchy.ChildCount("project-1"))
+	mockRepo.AssertExpectations(t)
+}
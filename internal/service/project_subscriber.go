@@ -21,6 +21,7 @@ import (
 	"github.com/linuxfoundation/lfx-v2-project-service/internal/service/email"
 	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
 	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -66,6 +67,8 @@ func (s *ProjectsService) HandleProjectSettingsUpdated(ctx context.Context, msg
 		return nil
 	}
 
+	trace.SpanFromContext(ctx).SetAttributes(projectUIDAttr(event.ProjectUID))
+
 	changes := diffUserChanges(event.OldSettings, event.NewSettings)
 	slog.DebugContext(ctx, "project_subscriber: received project_settings.updated event",
 		"project_uid", event.ProjectUID, "change_count", len(changes))
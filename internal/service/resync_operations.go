@@ -0,0 +1,168 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	fgaconstants "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/constants"
+	indexerConstants "github.com/linuxfoundation/lfx-v2-indexer-service/pkg/constants"
+	indexerTypes "github.com/linuxfoundation/lfx-v2-indexer-service/pkg/types"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ResyncFilter selects the projects re-published by ResyncProjects. Empty fields match every project.
+type ResyncFilter struct {
+	// UIDPrefix matches projects whose UID starts with the prefix.
+	UIDPrefix string
+	// UpdatedSince matches projects whose base or settings were updated at or after the time.
+	UpdatedSince *time.Time
+	// Stage matches projects in the given stage.
+	Stage string
+	// DryRun reports the matching projects without publishing anything.
+	DryRun bool
+}
+
+// ResyncResult summarizes a ResyncProjects run.
+type ResyncResult struct {
+	// ProjectUIDs are the UIDs of the projects that matched the filter, sorted.
+	ProjectUIDs []string
+	// Published is the number of projects whose messages were all published.
+	Published int
+	// Failed is the number of projects with at least one message that failed to publish.
+	Failed int
+	// MissingSettings are the UIDs of the matching projects that were skipped
+	// because their settings are missing, sorted. Their update_access message
+	// would remove every writer, auditor and meeting coordinator from FGA.
+	MissingSettings []string
+}
+
+// ResyncProjects re-publishes the indexer and update_access messages of every project
+// matching filter, so that the index and FGA can be rebuilt after a downstream outage
+// or a schema change. Only LF staff and trusted service principals may resync.
+func (s *ProjectsService) ResyncProjects(ctx context.Context, filter ResyncFilter) (*ResyncResult, error) {
+	if s.ProjectRepository == nil || s.MessageBuilder == nil {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "resync projects"); err != nil {
+		return nil, err
+	}
+
+	ctx, span := startSpan(ctx, "ProjectsService.ResyncProjects",
+		attribute.Bool("dry_run", filter.DryRun),
+	)
+	defer span.End()
+
+	projectsBase, projectsSettings, err := s.ProjectRepository.ListAllProjects(ctx)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	settingsByUID := make(map[string]*models.ProjectSettings, len(projectsSettings))
+	for _, settings := range projectsSettings {
+		settingsByUID[settings.UID] = settings
+	}
+
+	sort.Slice(projectsBase, func(i, j int) bool {
+		return projectsBase[i].UID < projectsBase[j].UID
+	})
+
+	result := &ResyncResult{ProjectUIDs: []string{}, MissingSettings: []string{}}
+	for _, projectDB := range projectsBase {
+		projectSettingsDB := settingsByUID[projectDB.UID]
+		if !filter.matches(projectDB, projectSettingsDB) {
+			continue
+		}
+		result.ProjectUIDs = append(result.ProjectUIDs, projectDB.UID)
+		if projectSettingsDB == nil {
+			slog.WarnContext(ctx, "project settings missing, skipping project", "project_uid", projectDB.UID)
+			result.MissingSettings = append(result.MissingSettings, projectDB.UID)
+			continue
+		}
+		if filter.DryRun {
+			continue
+		}
+
+		if err := s.publishProjectMessages(ctx, projectDB.UID, false, resyncMessages(projectDB, projectSettingsDB)); err != nil {
+			slog.WarnContext(ctx, "error re-publishing project messages", constants.ErrKey, err, "project_uid", projectDB.UID)
+			result.Failed++
+			continue
+		}
+		result.Published++
+	}
+
+	span.SetAttributes(
+		attribute.Int("resync.matched", len(result.ProjectUIDs)),
+		attribute.Int("resync.failed", result.Failed),
+		attribute.Int("resync.missing_settings", len(result.MissingSettings)),
+	)
+	slog.InfoContext(ctx, "resynced projects",
+		"matched", len(result.ProjectUIDs),
+		"published", result.Published,
+		"failed", result.Failed,
+		"missing_settings", len(result.MissingSettings),
+		"dry_run", filter.DryRun,
+	)
+
+	return result, nil
+}
+
+// matches reports whether the project passes every filter that is set.
+func (f ResyncFilter) matches(projectDB *models.ProjectBase, projectSettingsDB *models.ProjectSettings) bool {
+	if f.UIDPrefix != "" && !strings.HasPrefix(projectDB.UID, f.UIDPrefix) {
+		return false
+	}
+	if f.Stage != "" && projectDB.Stage != f.Stage {
+		return false
+	}
+	if f.UpdatedSince != nil {
+		updated := projectDB.UpdatedAt != nil && !projectDB.UpdatedAt.Before(*f.UpdatedSince)
+		if projectSettingsDB != nil && projectSettingsDB.UpdatedAt != nil && !projectSettingsDB.UpdatedAt.Before(*f.UpdatedSince) {
+			updated = true
+		}
+		if !updated {
+			return false
+		}
+	}
+	return true
+}
+
+// resyncMessages returns the indexer and update_access messages describing the
+// current state of a project.
+func resyncMessages(projectDB *models.ProjectBase, projectSettingsDB *models.ProjectSettings) []outboundMessage {
+	return []outboundMessage{
+		{
+			kind:    models.OutboxKindIndexer,
+			subject: constants.IndexProjectSubject,
+			message: indexerTypes.IndexerMessageEnvelope{
+				Action:         indexerConstants.ActionUpdated,
+				Data:           *projectDB,
+				IndexingConfig: projectDB.IndexingConfig(),
+			},
+		},
+		{
+			kind:    models.OutboxKindIndexer,
+			subject: constants.IndexProjectSettingsSubject,
+			message: indexerTypes.IndexerMessageEnvelope{
+				Action:         indexerConstants.ActionUpdated,
+				Data:           *projectSettingsDB,
+				IndexingConfig: projectSettingsDB.IndexingConfig(projectDB.UID),
+			},
+		},
+		{
+			kind:    models.OutboxKindAccess,
+			subject: fgaconstants.GenericUpdateAccessSubject,
+			message: buildFGAUpdateAccessMessage(projectDB, projectSettingsDB),
+		},
+	}
+}
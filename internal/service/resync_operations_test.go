@@ -0,0 +1,112 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProjectsService_ResyncProjects(t *testing.T) {
+	old := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	recent := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	projects := []*models.ProjectBase{
+		{UID: "b-active", Stage: "Active", UpdatedAt: &old},
+		{UID: "a-archived", Stage: "Archived", UpdatedAt: &recent},
+		{UID: "a-active", Stage: "Active", UpdatedAt: &old},
+	}
+	settings := []*models.ProjectSettings{
+		{UID: "b-active", UpdatedAt: &old},
+		{UID: "a-active", UpdatedAt: &recent},
+	}
+
+	tests := []struct {
+		name           string
+		filter         ResyncFilter
+		ctx            context.Context
+		setupMocks     func(*domain.MockProjectRepository, *domain.MockMessageBuilder)
+		expectedResult *ResyncResult
+		expectedErr    error
+	}{
+		{
+			name:   "dry run matches by uid prefix without publishing",
+			filter: ResyncFilter{UIDPrefix: "a-", DryRun: true},
+			setupMocks: func(repo *domain.MockProjectRepository, _ *domain.MockMessageBuilder) {
+				repo.On("ListAllProjects", mock.Anything).Return(projects, settings, nil)
+			},
+			expectedResult: &ResyncResult{ProjectUIDs: []string{"a-active", "a-archived"}, MissingSettings: []string{"a-archived"}},
+		},
+		{
+			name:   "updated since matches base or settings updates",
+			filter: ResyncFilter{UpdatedSince: &since, DryRun: true},
+			setupMocks: func(repo *domain.MockProjectRepository, _ *domain.MockMessageBuilder) {
+				repo.On("ListAllProjects", mock.Anything).Return(projects, settings, nil)
+			},
+			expectedResult: &ResyncResult{ProjectUIDs: []string{"a-active", "a-archived"}, MissingSettings: []string{"a-archived"}},
+		},
+		{
+			name:   "publishes indexer and access messages for matching stage",
+			filter: ResyncFilter{Stage: "Active"},
+			setupMocks: func(repo *domain.MockProjectRepository, mb *domain.MockMessageBuilder) {
+				repo.On("ListAllProjects", mock.Anything).Return(projects, settings, nil)
+				mb.On("SendIndexerMessage", mock.Anything, constants.IndexProjectSubject, mock.AnythingOfType("types.IndexerMessageEnvelope"), false).Return(nil).Times(2)
+				mb.On("SendIndexerMessage", mock.Anything, constants.IndexProjectSettingsSubject, mock.AnythingOfType("types.IndexerMessageEnvelope"), false).Return(nil).Once()
+				mb.On("SendIndexerMessage", mock.Anything, constants.IndexProjectSettingsSubject, mock.AnythingOfType("types.IndexerMessageEnvelope"), false).Return(errors.New("nats: connection closed")).Once()
+				mb.On("SendAccessMessage", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("types.GenericFGAMessage"), false).Return(nil).Times(2)
+			},
+			expectedResult: &ResyncResult{ProjectUIDs: []string{"a-active", "b-active"}, Published: 1, Failed: 1, MissingSettings: []string{}},
+		},
+		{
+			name:   "project without settings is skipped and reported",
+			filter: ResyncFilter{UIDPrefix: "a-archived"},
+			setupMocks: func(repo *domain.MockProjectRepository, _ *domain.MockMessageBuilder) {
+				repo.On("ListAllProjects", mock.Anything).Return(projects, settings, nil)
+			},
+			expectedResult: &ResyncResult{ProjectUIDs: []string{"a-archived"}, MissingSettings: []string{"a-archived"}},
+		},
+		{
+			name:        "forbidden for a principal that is not staff",
+			ctx:         context.WithValue(context.Background(), constants.PrincipalContextID, "user-1"),
+			setupMocks:  func(*domain.MockProjectRepository, *domain.MockMessageBuilder) {},
+			expectedErr: domain.ErrForbidden,
+		},
+		{
+			name: "list error",
+			setupMocks: func(repo *domain.MockProjectRepository, _ *domain.MockMessageBuilder) {
+				repo.On("ListAllProjects", mock.Anything).Return(nil, nil, domain.ErrInternal)
+			},
+			expectedErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &domain.MockProjectRepository{}
+			mockBuilder := &domain.MockMessageBuilder{}
+			tt.setupMocks(mockRepo, mockBuilder)
+			s := &ProjectsService{ProjectRepository: mockRepo, MessageBuilder: mockBuilder}
+
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = staffContext()
+			}
+			result, err := s.ResyncProjects(ctx, tt.filter)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedResult, result)
+			mockRepo.AssertExpectations(t)
+			mockBuilder.AssertExpectations(t)
+		})
+	}
+}
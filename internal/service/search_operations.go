@@ -0,0 +1,249 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Where the results of a project search come from.
+const (
+	// SearchSourceIndex is the OpenSearch index fed by the indexer messages.
+	SearchSourceIndex = "index"
+	// SearchSourceStore is a scan of every project in the project store, used
+	// when no index is configured or the index cannot be searched.
+	SearchSourceStore = "store"
+)
+
+// SearchProjects returns at most limit projects whose name, slug or description
+// match query, best match first, along with the SearchSource* they come from.
+// Projects the principal cannot view are left out, so fewer than limit
+// projects may be returned.
+func (s *ProjectsService) SearchProjects(ctx context.Context, query string, limit int) ([]*models.ProjectBase, string, error) {
+	ctx, span := startSpan(ctx, "ProjectsService.SearchProjects", attribute.Int("limit", limit))
+	defer span.End()
+
+	projects, source, err := s.searchProjects(ctx, strings.TrimSpace(query), limit)
+	recordSpanError(span, err)
+	span.SetAttributes(attribute.String("source", source))
+	return projects, source, err
+}
+
+func (s *ProjectsService) searchProjects(ctx context.Context, query string, limit int) ([]*models.ProjectBase, string, error) {
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return nil, "", domain.ErrServiceUnavailable
+	}
+	if query == "" {
+		return nil, "", domain.NewFieldValidationError("q", domain.FieldErrorRequired, domain.ErrValidationFailed)
+	}
+
+	if s.ProjectSearcher != nil {
+		candidates, err := s.searchProjectIndex(ctx, query, limit)
+		if err == nil {
+			projects, err := s.viewableProjects(ctx, candidates, limit)
+			return projects, SearchSourceIndex, err
+		}
+		slog.WarnContext(ctx, "error searching project index, scanning the project store", constants.ErrKey, err)
+	}
+
+	candidates, err := s.searchProjectStore(ctx, query)
+	if err != nil {
+		slog.ErrorContext(ctx, "error listing projects", constants.ErrKey, err)
+		return nil, "", domain.ErrInternal
+	}
+	projects, err := s.viewableProjects(ctx, candidates, limit)
+	return projects, SearchSourceStore, err
+}
+
+// searchProjectIndex searches the index and reads the matching projects from
+// the project store, so that the results are current. Projects the index still
+// holds after they were deleted are skipped.
+func (s *ProjectsService) searchProjectIndex(ctx context.Context, query string, limit int) ([]*models.ProjectBase, error) {
+	uids, err := s.ProjectSearcher.SearchProjects(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	projects := make([]*models.ProjectBase, 0, len(uids))
+	for _, uid := range uids {
+		project, err := s.ProjectRepository.GetProjectBase(ctx, uid)
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+	return projects, nil
+}
+
+// searchProjectStore scans every project for the words of query, ranking
+// projects whose name or slug match above those only matching by description.
+func (s *ProjectsService) searchProjectStore(ctx context.Context, query string) ([]*models.ProjectBase, error) {
+	projects, err := s.ProjectRepository.ListAllProjectsBase(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := strings.Fields(strings.ToLower(query))
+	ranks := make(map[string]int, len(projects))
+	var matches []*models.ProjectBase
+	for _, project := range projects {
+		if rank, ok := projectSearchRank(project, strings.ToLower(query), terms); ok {
+			ranks[project.UID] = rank
+			matches = append(matches, project)
+		}
+	}
+	slices.SortFunc(matches, func(a, b *models.ProjectBase) int {
+		return cmp.Or(
+			cmp.Compare(ranks[a.UID], ranks[b.UID]),
+			strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name)),
+			strings.Compare(a.Slug, b.Slug),
+		)
+	})
+	return matches, nil
+}
+
+// projectSearchRank reports whether every term is in the project's name, slug
+// or description, and how well it matches: 0 for the exact name or slug, 1 for
+// a name or slug starting with the query, 2 for a name or slug holding every
+// term, and 3 otherwise.
+func projectSearchRank(project *models.ProjectBase, query string, terms []string) (int, bool) {
+	name, slug := strings.ToLower(project.Name), strings.ToLower(project.Slug)
+	names := name + " " + slug
+	text := names + " " + strings.ToLower(project.Description)
+	for _, term := range terms {
+		if !strings.Contains(text, term) {
+			return 0, false
+		}
+	}
+	switch {
+	case name == query || slug == query:
+		return 0, true
+	case strings.HasPrefix(name, query) || strings.HasPrefix(slug, query):
+		return 1, true
+	case !slices.ContainsFunc(terms, func(term string) bool { return !strings.Contains(names, term) }):
+		return 2, true
+	}
+	return 3, true
+}
+
+// viewableProjects returns the first limit projects the principal can view.
+func (s *ProjectsService) viewableProjects(ctx context.Context, projects []*models.ProjectBase, limit int) ([]*models.ProjectBase, error) {
+	viewable := []*models.ProjectBase{}
+	for _, project := range projects {
+		if len(viewable) == limit {
+			break
+		}
+		ok, err := s.canViewProject(ctx, project.UID, project.Public)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			viewable = append(viewable, project)
+		}
+	}
+	return viewable, nil
+}
+
+// canViewProject reports whether the principal may see a project in search
+// results. Without an AccessChecker, private projects are only shown to LF
+// staff and trusted service principals, as the gateway does not check the
+// results.
+func (s *ProjectsService) canViewProject(ctx context.Context, projectUID string, public bool) (bool, error) {
+	if s.AccessChecker == nil {
+		return public || s.isStaffOrTrusted(ctx), nil
+	}
+	err := s.authorizeProject(ctx, projectUID, relationViewer)
+	if errors.Is(err, domain.ErrForbidden) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// AutocompleteProjects returns at most limit projects whose name, slug, or a
+// word of them, starts with prefix, for typeahead. Exact matches come first,
+// then names and slugs starting with prefix, then words starting with it.
+// The projects are found in the ProjectSuggester when it is set and
+// available, and by scanning every project otherwise.
+func (s *ProjectsService) AutocompleteProjects(ctx context.Context, prefix string, limit int) ([]models.ProjectSuggestion, error) {
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	if prefix == "" {
+		return nil, domain.NewFieldValidationError("q", domain.FieldErrorRequired, domain.ErrValidationFailed)
+	}
+
+	candidates, err := s.suggestProjects(ctx, prefix)
+	if err != nil {
+		slog.ErrorContext(ctx, "error listing projects", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+
+	ranks := make(map[string]int, len(candidates))
+	for _, candidate := range candidates {
+		ranks[candidate.UID], _ = candidate.MatchRank(prefix)
+	}
+	slices.SortFunc(candidates, func(a, b models.ProjectSuggestion) int {
+		return cmp.Or(
+			cmp.Compare(ranks[a.UID], ranks[b.UID]),
+			cmp.Compare(len(a.Name), len(b.Name)),
+			strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name)),
+			strings.Compare(a.Slug, b.Slug),
+		)
+	})
+
+	suggestions := []models.ProjectSuggestion{}
+	for _, candidate := range candidates {
+		if len(suggestions) == limit {
+			break
+		}
+		ok, err := s.canViewProject(ctx, candidate.UID, candidate.Public)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			suggestions = append(suggestions, candidate)
+		}
+	}
+	return suggestions, nil
+}
+
+// suggestProjects returns the projects matching the lowercase prefix, in no
+// particular order.
+func (s *ProjectsService) suggestProjects(ctx context.Context, prefix string) ([]models.ProjectSuggestion, error) {
+	if s.ProjectSuggester != nil {
+		suggestions, err := s.ProjectSuggester.SuggestProjects(ctx, prefix)
+		if err == nil {
+			return suggestions, nil
+		}
+		slog.WarnContext(ctx, "project prefix index unavailable, scanning the project store", constants.ErrKey, err)
+	}
+
+	projects, err := s.ProjectRepository.ListAllProjectsBase(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var suggestions []models.ProjectSuggestion
+	for _, project := range projects {
+		suggestion := models.NewProjectSuggestion(project)
+		if _, ok := suggestion.MatchRank(prefix); ok {
+			suggestions = append(suggestions, suggestion)
+		}
+	}
+	return suggestions, nil
+}
@@ -0,0 +1,184 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+func TestProjectsService_SearchProjects_index(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	searcher := &domain.MockProjectSearcher{}
+	service.ProjectSearcher = searcher
+	access := &domain.MockAccessChecker{}
+	service.AccessChecker = access
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "alice")
+
+	searcher.On("SearchProjects", mock.Anything, "kube", 2).Return([]string{"project-1", "deleted", "project-2", "project-3"}, nil)
+	mockRepo.On("GetProjectBase", mock.Anything, "project-1").Return(&models.ProjectBase{UID: "project-1", Slug: "kubernetes"}, nil)
+	mockRepo.On("GetProjectBase", mock.Anything, "deleted").Return(nil, domain.ErrProjectNotFound)
+	mockRepo.On("GetProjectBase", mock.Anything, "project-2").Return(&models.ProjectBase{UID: "project-2", Slug: "kubeflow"}, nil)
+	mockRepo.On("GetProjectBase", mock.Anything, "project-3").Return(&models.ProjectBase{UID: "project-3", Slug: "kubevirt"}, nil)
+	access.On("CheckAccess", mock.Anything, "alice", "project:project-1", relationViewer).Return(true, nil)
+	access.On("CheckAccess", mock.Anything, "alice", "project:project-2", relationViewer).Return(false, nil)
+	access.On("CheckAccess", mock.Anything, "alice", "project:project-3", relationViewer).Return(true, nil)
+
+	projects, source, err := service.SearchProjects(ctx, " kube ", 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, SearchSourceIndex, source)
+	require.Len(t, projects, 2)
+	assert.Equal(t, "kubernetes", projects[0].Slug)
+	assert.Equal(t, "kubevirt", projects[1].Slug)
+}
+
+func TestProjectsService_SearchProjects_store(t *testing.T) {
+	stored := []*models.ProjectBase{
+		{UID: "project-1", Slug: "cloud-tools", Name: "Cloud Tools", Description: "Tools for kubernetes", Public: true},
+		{UID: "project-2", Slug: "kubernetes", Name: "Kubernetes", Public: true},
+		{UID: "project-3", Slug: "kubeflow", Name: "Kubeflow", Public: true},
+		{UID: "project-4", Slug: "k8s-kubernetes-operators", Name: "Operators", Public: true},
+		{UID: "project-5", Slug: "kubernetes-private", Name: "Kubernetes Private"},
+		{UID: "project-6", Slug: "other", Name: "Other", Public: true},
+	}
+
+	tests := []struct {
+		name      string
+		roles     []string
+		searchErr error
+		expected  []string
+	}{
+		{
+			name:     "without a search index",
+			expected: []string{"kubernetes", "k8s-kubernetes-operators", "cloud-tools"},
+		},
+		{
+			name:      "index unavailable",
+			searchErr: errors.New("connection refused"),
+			expected:  []string{"kubernetes", "k8s-kubernetes-operators", "cloud-tools"},
+		},
+		{
+			name:     "staff see private projects",
+			roles:    []string{domain.RoleStaff},
+			expected: []string{"kubernetes", "kubernetes-private", "k8s-kubernetes-operators", "cloud-tools"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := setupServiceForTesting()
+			if tt.searchErr != nil {
+				searcher := &domain.MockProjectSearcher{}
+				searcher.On("SearchProjects", mock.Anything, "Kubernetes", 10).Return(nil, tt.searchErr)
+				service.ProjectSearcher = searcher
+			}
+			mockRepo.On("ListAllProjectsBase", mock.Anything).Return(stored, nil)
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "alice")
+			ctx = context.WithValue(ctx, constants.RolesContextID, tt.roles)
+
+			projects, source, err := service.SearchProjects(ctx, "Kubernetes", 10)
+
+			require.NoError(t, err)
+			assert.Equal(t, SearchSourceStore, source)
+			slugs := make([]string, len(projects))
+			for i, project := range projects {
+				slugs[i] = project.Slug
+			}
+			assert.Equal(t, tt.expected, slugs)
+		})
+	}
+}
+
+func TestProjectsService_SearchProjects_emptyQuery(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+
+	_, _, err := service.SearchProjects(context.Background(), " ", 20)
+
+	assert.ErrorIs(t, err, domain.ErrValidationFailed)
+}
+
+func TestProjectsService_AutocompleteProjects(t *testing.T) {
+	suggestions := []models.ProjectSuggestion{
+		{UID: "project-1", Slug: "cloud-native", Name: "Cloud Native Kube Tools", Public: true},
+		{UID: "project-2", Slug: "kubeflow", Name: "Kubeflow", Public: true},
+		{UID: "project-3", Slug: "kube", Name: "Kube", Public: true},
+		{UID: "project-4", Slug: "kubernetes", Name: "Kubernetes", Public: true},
+		{UID: "project-5", Slug: "kube-private", Name: "Kube Private"},
+	}
+	stored := make([]*models.ProjectBase, 0, len(suggestions)+1)
+	for _, s := range suggestions {
+		stored = append(stored, &models.ProjectBase{UID: s.UID, Slug: s.Slug, Name: s.Name, Public: s.Public})
+	}
+	stored = append(stored, &models.ProjectBase{UID: "project-6", Slug: "linux", Name: "Linux", Public: true})
+
+	tests := []struct {
+		name       string
+		suggestErr error
+		useStore   bool
+		limit      int
+		expected   []string
+	}{
+		{
+			name:     "from the prefix index",
+			limit:    10,
+			expected: []string{"kube", "kubeflow", "kubernetes", "cloud-native"},
+		},
+		{
+			name:     "limited",
+			limit:    2,
+			expected: []string{"kube", "kubeflow"},
+		},
+		{
+			name:       "index unavailable",
+			suggestErr: domain.ErrServiceUnavailable,
+			limit:      10,
+			expected:   []string{"kube", "kubeflow", "kubernetes", "cloud-native"},
+		},
+		{
+			name:     "without an index",
+			useStore: true,
+			limit:    10,
+			expected: []string{"kube", "kubeflow", "kubernetes", "cloud-native"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := setupServiceForTesting()
+			if !tt.useStore {
+				suggester := &domain.MockProjectSuggester{}
+				if tt.suggestErr != nil {
+					suggester.On("SuggestProjects", mock.Anything, "kube").Return(nil, tt.suggestErr)
+				} else {
+					suggester.On("SuggestProjects", mock.Anything, "kube").Return(slices.Clone(suggestions), nil)
+				}
+				service.ProjectSuggester = suggester
+			}
+			if tt.useStore || tt.suggestErr != nil {
+				mockRepo.On("ListAllProjectsBase", mock.Anything).Return(stored, nil)
+			}
+
+			projects, err := service.AutocompleteProjects(context.Background(), " Kube", tt.limit)
+
+			require.NoError(t, err)
+			slugs := make([]string, len(projects))
+			for i, project := range projects {
+				slugs[i] = project.Slug
+			}
+			assert.Equal(t, tt.expected, slugs)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
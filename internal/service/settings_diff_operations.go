@@ -0,0 +1,136 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// GetProjectSettingsDiff returns the field-by-field difference between two
+// revisions of a project's settings, such as the writers added and removed
+// and a changed mission statement. Revisions are the settings ETags.
+func (s *ProjectsService) GetProjectSettingsDiff(ctx context.Context, projectUID string, fromRevision, toRevision uint64) (*models.ProjectSettingsDiff, error) {
+	if !s.ServiceReady() || s.SettingsHistoryRepository == nil {
+		slog.ErrorContext(ctx, "service not ready or settings history not available")
+		return nil, domain.ErrServiceUnavailable
+	}
+
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", projectUID))
+
+	exists, err := s.ProjectRepository.ProjectExists(ctx, projectUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error checking if project exists", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+	if !exists {
+		return nil, domain.ErrProjectNotFound
+	}
+
+	from, err := s.SettingsHistoryRepository.GetProjectSettingsAtRevision(ctx, projectUID, fromRevision)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.SettingsHistoryRepository.GetProjectSettingsAtRevision(ctx, projectUID, toRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ProjectSettingsDiff{
+		ProjectUID:   projectUID,
+		FromRevision: fromRevision,
+		ToRevision:   toRevision,
+		Changes:      diffProjectSettings(from, to),
+	}, nil
+}
+
+// diffProjectSettings returns the changes from one revision of a project's
+// settings to another, in the order the fields appear in the settings.
+func diffProjectSettings(from, to *models.ProjectSettings) []models.ProjectSettingsChange {
+	changes := []models.ProjectSettingsChange{}
+	addText := func(field, fromValue, toValue string) {
+		if fromValue != toValue {
+			changes = append(changes, models.ProjectSettingsChange{Field: field, From: fromValue, To: toValue})
+		}
+	}
+	addUsers := func(field string, fromUsers, toUsers []models.UserInfo) {
+		added, removed := diffUsers(fromUsers, toUsers), diffUsers(toUsers, fromUsers)
+		if len(added) > 0 || len(removed) > 0 {
+			changes = append(changes, models.ProjectSettingsChange{Field: field, Added: added, Removed: removed})
+		}
+	}
+
+	addText("mission_statement", from.MissionStatement, to.MissionStatement)
+	addText("overview_html", from.OverviewHTML, to.OverviewHTML)
+	addText("announcement_date", formatDate(from.AnnouncementDate), formatDate(to.AnnouncementDate))
+	addUsers("auditors", from.Auditors, to.Auditors)
+	addUsers("writers", from.Writers, to.Writers)
+	addUsers("meeting_coordinators", from.MeetingCoordinators, to.MeetingCoordinators)
+	addUsers("executive_director", userList(from.ExecutiveDirector), userList(to.ExecutiveDirector))
+	addUsers("program_manager", userList(from.ProgramManager), userList(to.ProgramManager))
+	addUsers("opportunity_owner", userList(from.OpportunityOwner), userList(to.OpportunityOwner))
+	return changes
+}
+
+// diffUsers returns the users in b that are not in a. Users are matched by
+// username and, failing that, by email, so that a profile update such as a new
+// avatar is not reported as a change.
+func diffUsers(a, b []models.UserInfo) []models.UserInfo {
+	known := make(map[string]bool)
+	for _, u := range a {
+		for _, key := range userKeys(u) {
+			known[key] = true
+		}
+	}
+
+	var diff []models.UserInfo
+	for _, u := range b {
+		found := false
+		for _, key := range userKeys(u) {
+			if known[key] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			diff = append(diff, u)
+		}
+	}
+	return diff
+}
+
+// userKeys returns the keys that identify a user in a settings diff.
+func userKeys(u models.UserInfo) []string {
+	var keys []string
+	if u.Username != "" {
+		keys = append(keys, "username:"+u.Username)
+	}
+	if u.Email != "" {
+		keys = append(keys, "email:"+strings.ToLower(strings.TrimSpace(u.Email)))
+	}
+	return keys
+}
+
+// userList returns a single-user settings field as a list.
+func userList(u *models.UserInfo) []models.UserInfo {
+	if u == nil {
+		return nil
+	}
+	return []models.UserInfo{*u}
+}
+
+// formatDate formats an optional date the way the settings API does.
+func formatDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
@@ -0,0 +1,105 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+func TestProjectsService_GetProjectSettingsDiff(t *testing.T) {
+	alice := models.UserInfo{Name: "Alice", Username: "alice", Email: "alice@example.org"}
+	bob := models.UserInfo{Name: "Bob", Username: "bob", Email: "bob@example.org"}
+	carol := models.UserInfo{Name: "Carol", Email: "Carol@example.org"}
+
+	from := &models.ProjectSettings{
+		UID:              "project-1",
+		MissionStatement: "Old mission",
+		Writers:          []models.UserInfo{alice, bob},
+		Auditors:         []models.UserInfo{carol},
+		ProgramManager:   &alice,
+	}
+	to := &models.ProjectSettings{
+		UID:              "project-1",
+		MissionStatement: "New mission",
+		// A new avatar and a differently cased email are not changes.
+		Writers:        []models.UserInfo{{Name: "Alice", Username: "alice", Email: "alice@example.org", Avatar: "https://example.org/alice.png"}, carol},
+		Auditors:       []models.UserInfo{{Name: "Carol", Email: "carol@example.org"}},
+		ProgramManager: &bob,
+	}
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*domain.MockProjectRepository, *domain.MockSettingsHistoryRepository)
+		expected    []models.ProjectSettingsChange
+		expectedErr error
+	}{
+		{
+			name: "changed fields are reported",
+			setupMocks: func(repo *domain.MockProjectRepository, history *domain.MockSettingsHistoryRepository) {
+				repo.On("ProjectExists", mock.Anything, "project-1").Return(true, nil)
+				history.On("GetProjectSettingsAtRevision", mock.Anything, "project-1", uint64(3)).Return(from, nil)
+				history.On("GetProjectSettingsAtRevision", mock.Anything, "project-1", uint64(7)).Return(to, nil)
+			},
+			expected: []models.ProjectSettingsChange{
+				{Field: "mission_statement", From: "Old mission", To: "New mission"},
+				{Field: "writers", Added: []models.UserInfo{carol}, Removed: []models.UserInfo{bob}},
+				{Field: "program_manager", Added: []models.UserInfo{bob}, Removed: []models.UserInfo{alice}},
+			},
+		},
+		{
+			name: "project not found",
+			setupMocks: func(repo *domain.MockProjectRepository, _ *domain.MockSettingsHistoryRepository) {
+				repo.On("ProjectExists", mock.Anything, "project-1").Return(false, nil)
+			},
+			expectedErr: domain.ErrProjectNotFound,
+		},
+		{
+			name: "revision not found",
+			setupMocks: func(repo *domain.MockProjectRepository, history *domain.MockSettingsHistoryRepository) {
+				repo.On("ProjectExists", mock.Anything, "project-1").Return(true, nil)
+				history.On("GetProjectSettingsAtRevision", mock.Anything, "project-1", uint64(3)).
+					Return(nil, domain.ErrSettingsRevisionNotFound)
+			},
+			expectedErr: domain.ErrSettingsRevisionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := setupServiceForTesting()
+			mockHistory := &domain.MockSettingsHistoryRepository{}
+			service.SettingsHistoryRepository = mockHistory
+			tt.setupMocks(mockRepo, mockHistory)
+
+			diff, err := service.GetProjectSettingsDiff(context.Background(), "project-1", 3, 7)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, uint64(3), diff.FromRevision)
+				assert.Equal(t, uint64(7), diff.ToRevision)
+				assert.Equal(t, tt.expected, diff.Changes)
+			}
+			mockRepo.AssertExpectations(t)
+			mockHistory.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectsService_GetProjectSettingsDiff_NotConfigured(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+
+	_, err := service.GetProjectSettingsDiff(context.Background(), "project-1", 3, 7)
+
+	assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+}
@@ -0,0 +1,218 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// mergeProjectSettingsUpdate writes ours, the settings of an update made
+// against baseRevision that is no longer current, merged with the changes
+// written since. It returns the settings the merge was made with and the
+// merged settings. The update fails with a SettingsMergeConflictError when
+// both changed the same fields, and with ErrRevisionMismatch when the base
+// revision is no longer in the settings history or the settings change again.
+func (s *ProjectsService) mergeProjectSettingsUpdate(ctx context.Context, projectUID string, baseRevision uint64, ours *models.ProjectSettings) (*models.ProjectSettings, *models.ProjectSettings, error) {
+	if s.SettingsHistoryRepository == nil {
+		slog.WarnContext(ctx, "settings history not available, cannot merge the settings update")
+		return nil, nil, domain.ErrRevisionMismatch
+	}
+
+	base, err := s.SettingsHistoryRepository.GetProjectSettingsAtRevision(ctx, projectUID, baseRevision)
+	if err != nil {
+		if errors.Is(err, domain.ErrSettingsRevisionNotFound) {
+			slog.WarnContext(ctx, "base revision of the settings update not found, cannot merge it", "base_revision", baseRevision)
+			return nil, nil, domain.ErrRevisionMismatch
+		}
+		return nil, nil, domain.ErrInternal
+	}
+
+	theirs, revision, err := s.ProjectRepository.GetProjectSettingsWithRevision(ctx, projectUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error getting project settings from store", constants.ErrKey, err)
+		return nil, nil, domain.ErrInternal
+	}
+
+	merged, conflicts := mergeProjectSettings(base, theirs, ours)
+	if len(conflicts) > 0 {
+		slog.WarnContext(ctx, "settings update conflicts with a concurrent update",
+			"base_revision", baseRevision, "revision", revision, "conflicts", len(conflicts))
+		return nil, nil, &domain.SettingsMergeConflictError{Conflicts: conflicts}
+	}
+
+	if err := s.ProjectRepository.UpdateProjectSettings(ctx, merged, revision); err != nil {
+		return nil, nil, err
+	}
+	slog.InfoContext(ctx, "merged settings update with a concurrent update",
+		"base_revision", baseRevision, "revision", revision)
+	return theirs, merged, nil
+}
+
+// mergeProjectSettings merges ours and theirs, two updates of the base
+// settings. A field keeps the value of the update that changed it, and a role
+// list the users each update added, removed or edited. Fields that both
+// updates changed to different values are returned as conflicts.
+func mergeProjectSettings(base, theirs, ours *models.ProjectSettings) (*models.ProjectSettings, []domain.SettingsMergeConflict) {
+	merged := *ours
+	var conflicts []domain.SettingsMergeConflict
+	conflict := func(field string, users ...string) {
+		conflicts = append(conflicts, domain.SettingsMergeConflict{Field: field, Users: users})
+	}
+
+	var ok bool
+	if merged.MissionStatement, ok = mergeValue(base.MissionStatement, theirs.MissionStatement, ours.MissionStatement); !ok {
+		conflict("mission_statement")
+	}
+	switch {
+	case ours.OverviewHTML == base.OverviewHTML:
+		merged.OverviewHTML, merged.OverviewText = theirs.OverviewHTML, theirs.OverviewText
+	case theirs.OverviewHTML != base.OverviewHTML && theirs.OverviewHTML != ours.OverviewHTML:
+		conflict("overview_html")
+	}
+	if formatDate(ours.AnnouncementDate) == formatDate(base.AnnouncementDate) {
+		merged.AnnouncementDate = theirs.AnnouncementDate
+	} else if formatDate(theirs.AnnouncementDate) != formatDate(base.AnnouncementDate) &&
+		formatDate(theirs.AnnouncementDate) != formatDate(ours.AnnouncementDate) {
+		conflict("announcement_date")
+	}
+
+	for _, field := range []struct {
+		name               string
+		base, theirs, ours []models.UserInfo
+		target             *[]models.UserInfo
+	}{
+		{"auditors", base.Auditors, theirs.Auditors, ours.Auditors, &merged.Auditors},
+		{"writers", base.Writers, theirs.Writers, ours.Writers, &merged.Writers},
+		{"meeting_coordinators", base.MeetingCoordinators, theirs.MeetingCoordinators, ours.MeetingCoordinators, &merged.MeetingCoordinators},
+	} {
+		users, conflicting := mergeUsers(field.base, field.theirs, field.ours)
+		if len(conflicting) > 0 {
+			conflict(field.name, conflicting...)
+		}
+		*field.target = users
+	}
+
+	for _, field := range []struct {
+		name               string
+		base, theirs, ours *models.UserInfo
+		target             **models.UserInfo
+	}{
+		{"executive_director", base.ExecutiveDirector, theirs.ExecutiveDirector, ours.ExecutiveDirector, &merged.ExecutiveDirector},
+		{"program_manager", base.ProgramManager, theirs.ProgramManager, ours.ProgramManager, &merged.ProgramManager},
+		{"opportunity_owner", base.OpportunityOwner, theirs.OpportunityOwner, ours.OpportunityOwner, &merged.OpportunityOwner},
+	} {
+		switch {
+		case sameUsers(field.ours, field.base):
+			*field.target = field.theirs
+		case !sameUsers(field.theirs, field.base) && !sameUsers(field.theirs, field.ours):
+			conflict(field.name)
+		}
+	}
+
+	return &merged, conflicts
+}
+
+// mergeValue returns the value of the update that changed base, and false
+// when both changed it to different values.
+func mergeValue[T comparable](base, theirs, ours T) (T, bool) {
+	switch {
+	case ours == base:
+		return theirs, true
+	case theirs == base, theirs == ours:
+		return ours, true
+	}
+	return ours, false
+}
+
+// mergeUsers merges ours and theirs, two updates of the base role list, in
+// the order of theirs followed by the users only ours added. Users are matched
+// like in a settings diff. It returns the users both updates changed
+// differently: edited to different profiles, or edited by one and removed by
+// the other.
+func mergeUsers(base, theirs, ours []models.UserInfo) ([]models.UserInfo, []string) {
+	var merged []models.UserInfo
+	var conflicts []string
+	for _, t := range theirs {
+		b, inBase := findUser(base, t)
+		o, inOurs := findUser(ours, t)
+		switch {
+		case !inOurs && inBase:
+			// Removed by ours, which conflicts with an edit by theirs.
+			if userEdited(b, t) {
+				conflicts = append(conflicts, userKey(t))
+			}
+		case !inOurs:
+			merged = append(merged, t)
+		case inBase && !userEdited(b, o):
+			merged = append(merged, t)
+		case userEdited(o, t) && (!inBase || userEdited(b, t)):
+			// Added by both, or edited by both, differently.
+			conflicts = append(conflicts, userKey(t))
+		default:
+			merged = append(merged, o)
+		}
+	}
+	for _, o := range ours {
+		if _, inTheirs := findUser(theirs, o); inTheirs {
+			continue
+		}
+		b, inBase := findUser(base, o)
+		switch {
+		case !inBase:
+			merged = append(merged, o)
+		case userEdited(b, o):
+			// Edited by ours, removed by theirs.
+			conflicts = append(conflicts, userKey(o))
+		}
+	}
+	return merged, conflicts
+}
+
+// findUser returns the user of users that u is, matched like in a settings diff.
+func findUser(users []models.UserInfo, u models.UserInfo) (models.UserInfo, bool) {
+	keys := userKeys(u)
+	for _, candidate := range users {
+		for _, candidateKey := range userKeys(candidate) {
+			for _, key := range keys {
+				if key == candidateKey {
+					return candidate, true
+				}
+			}
+		}
+	}
+	return models.UserInfo{}, false
+}
+
+// userEdited reports whether the fields an update sets differ between two
+// versions of a user. Avatars and invites, refreshed by the service, are not
+// compared.
+func userEdited(a, b models.UserInfo) bool {
+	return a.Name != b.Name || a.Username != b.Username ||
+		!strings.EqualFold(strings.TrimSpace(a.Email), strings.TrimSpace(b.Email))
+}
+
+// userKey identifies u in a merge conflict by username, or else email.
+func userKey(u models.UserInfo) string {
+	if u.Username != "" {
+		return u.Username
+	}
+	return u.Email
+}
+
+// sameUsers reports whether a and b, single-user settings fields, hold the
+// same version of the same user, or are both empty.
+func sameUsers(a, b *models.UserInfo) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	_, same := findUser([]models.UserInfo{*a}, *b)
+	return same && !userEdited(*a, *b)
+}
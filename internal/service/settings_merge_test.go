@@ -0,0 +1,194 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+func TestMergeProjectSettings(t *testing.T) {
+	alice := models.UserInfo{Name: "Alice", Username: "alice", Email: "alice@example.org"}
+	bob := models.UserInfo{Name: "Bob", Username: "bob", Email: "bob@example.org"}
+	carol := models.UserInfo{Name: "Carol", Email: "carol@example.org"}
+	renamed := func(u models.UserInfo, name string) models.UserInfo {
+		u.Name = name
+		return u
+	}
+
+	tests := []struct {
+		name               string
+		base, theirs, ours *models.ProjectSettings
+		expected           *models.ProjectSettings
+		conflicts          []domain.SettingsMergeConflict
+	}{
+		{
+			name:     "changes to different fields are merged",
+			base:     &models.ProjectSettings{MissionStatement: "Mission", Writers: []models.UserInfo{alice}},
+			theirs:   &models.ProjectSettings{MissionStatement: "Mission", Writers: []models.UserInfo{alice, bob}},
+			ours:     &models.ProjectSettings{MissionStatement: "New mission", Writers: []models.UserInfo{alice}, ProgramManager: &carol},
+			expected: &models.ProjectSettings{MissionStatement: "New mission", Writers: []models.UserInfo{alice, bob}, ProgramManager: &carol},
+		},
+		{
+			name:     "users added and removed by each update are merged",
+			base:     &models.ProjectSettings{Writers: []models.UserInfo{alice, bob}},
+			theirs:   &models.ProjectSettings{Writers: []models.UserInfo{bob, carol}},
+			ours:     &models.ProjectSettings{Writers: []models.UserInfo{alice, {Name: "Dan", Username: "dan"}}},
+			expected: &models.ProjectSettings{Writers: []models.UserInfo{carol, {Name: "Dan", Username: "dan"}}},
+		},
+		{
+			name:     "the same change is not a conflict",
+			base:     &models.ProjectSettings{MissionStatement: "Mission", Auditors: []models.UserInfo{alice}},
+			theirs:   &models.ProjectSettings{MissionStatement: "New mission", Auditors: []models.UserInfo{alice, bob}},
+			ours:     &models.ProjectSettings{MissionStatement: "New mission", Auditors: []models.UserInfo{alice, bob}},
+			expected: &models.ProjectSettings{MissionStatement: "New mission", Auditors: []models.UserInfo{alice, bob}},
+		},
+		{
+			name:     "an edited user is kept from the update that edited it",
+			base:     &models.ProjectSettings{Writers: []models.UserInfo{alice, bob}},
+			theirs:   &models.ProjectSettings{Writers: []models.UserInfo{alice, renamed(bob, "Robert")}},
+			ours:     &models.ProjectSettings{Writers: []models.UserInfo{renamed(alice, "Alicia"), bob}},
+			expected: &models.ProjectSettings{Writers: []models.UserInfo{renamed(alice, "Alicia"), renamed(bob, "Robert")}},
+		},
+		{
+			name:   "fields changed differently conflict",
+			base:   &models.ProjectSettings{MissionStatement: "Mission", ExecutiveDirector: &alice},
+			theirs: &models.ProjectSettings{MissionStatement: "Their mission", ExecutiveDirector: &bob},
+			ours:   &models.ProjectSettings{MissionStatement: "Our mission", ExecutiveDirector: &carol},
+			conflicts: []domain.SettingsMergeConflict{
+				{Field: "mission_statement"},
+				{Field: "executive_director"},
+			},
+		},
+		{
+			name:   "users changed differently conflict",
+			base:   &models.ProjectSettings{Writers: []models.UserInfo{alice, bob}},
+			theirs: &models.ProjectSettings{Writers: []models.UserInfo{renamed(alice, "Alicia")}},
+			ours:   &models.ProjectSettings{Writers: []models.UserInfo{renamed(alice, "Ali"), renamed(bob, "Robert")}},
+			conflicts: []domain.SettingsMergeConflict{
+				{Field: "writers", Users: []string{"alice", "bob"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, conflicts := mergeProjectSettings(tt.base, tt.theirs, tt.ours)
+
+			assert.Equal(t, tt.conflicts, conflicts)
+			if tt.expected != nil {
+				assert.Equal(t, tt.expected, merged)
+			}
+		})
+	}
+}
+
+func TestProjectsService_UpdateProjectSettings_merge(t *testing.T) {
+	alice := models.UserInfo{Name: "Alice", Username: "alice", Email: "alice@example.com"}
+	bob := models.UserInfo{Name: "Bob", Username: "bob", Email: "bob@example.com"}
+	base := &models.ProjectSettings{UID: "project-uid-1", MissionStatement: "Mission", Writers: []models.UserInfo{alice}}
+
+	tests := []struct {
+		name        string
+		merge       bool
+		setupMocks  func(*domain.MockProjectRepository, *domain.MockSettingsHistoryRepository, *domain.MockMessageBuilder)
+		expectedErr error
+		conflicts   []domain.SettingsMergeConflict
+	}{
+		{
+			name:  "a concurrent change to other fields is merged",
+			merge: true,
+			setupMocks: func(repo *domain.MockProjectRepository, history *domain.MockSettingsHistoryRepository, builder *domain.MockMessageBuilder) {
+				theirs := &models.ProjectSettings{UID: "project-uid-1", MissionStatement: "Mission", Writers: []models.UserInfo{alice, bob}}
+				repo.On("GetProjectSettings", mock.Anything, "project-uid-1").Return(theirs, nil)
+				repo.On("UpdateProjectSettings", mock.Anything, mock.Anything, uint64(3)).Return(domain.ErrRevisionMismatch)
+				history.On("GetProjectSettingsAtRevision", mock.Anything, "project-uid-1", uint64(3)).Return(base, nil)
+				repo.On("GetProjectSettingsWithRevision", mock.Anything, "project-uid-1").Return(theirs, uint64(5), nil)
+				repo.On("UpdateProjectSettings", mock.Anything, mock.MatchedBy(func(s *models.ProjectSettings) bool {
+					return s.MissionStatement == "New mission" && len(s.Writers) == 2 && s.Writers[1].Username == "bob"
+				}), uint64(5)).Return(nil)
+				repo.On("GetProjectBase", mock.Anything, "project-uid-1").Return(&models.ProjectBase{UID: "project-uid-1"}, nil)
+				builder.On("SendIndexerMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				builder.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name:  "a concurrent change to the same field conflicts",
+			merge: true,
+			setupMocks: func(repo *domain.MockProjectRepository, history *domain.MockSettingsHistoryRepository, _ *domain.MockMessageBuilder) {
+				theirs := &models.ProjectSettings{UID: "project-uid-1", MissionStatement: "Their mission", Writers: []models.UserInfo{alice}}
+				repo.On("GetProjectSettings", mock.Anything, "project-uid-1").Return(theirs, nil)
+				repo.On("UpdateProjectSettings", mock.Anything, mock.Anything, uint64(3)).Return(domain.ErrRevisionMismatch)
+				history.On("GetProjectSettingsAtRevision", mock.Anything, "project-uid-1", uint64(3)).Return(base, nil)
+				repo.On("GetProjectSettingsWithRevision", mock.Anything, "project-uid-1").Return(theirs, uint64(5), nil)
+			},
+			expectedErr: domain.ErrSettingsMergeConflict,
+			conflicts:   []domain.SettingsMergeConflict{{Field: "mission_statement"}},
+		},
+		{
+			name:  "a base revision no longer in the history is a revision mismatch",
+			merge: true,
+			setupMocks: func(repo *domain.MockProjectRepository, history *domain.MockSettingsHistoryRepository, _ *domain.MockMessageBuilder) {
+				repo.On("GetProjectSettings", mock.Anything, "project-uid-1").Return(base, nil)
+				repo.On("UpdateProjectSettings", mock.Anything, mock.Anything, uint64(3)).Return(domain.ErrRevisionMismatch)
+				history.On("GetProjectSettingsAtRevision", mock.Anything, "project-uid-1", uint64(3)).Return(nil, domain.ErrSettingsRevisionNotFound)
+			},
+			expectedErr: domain.ErrRevisionMismatch,
+		},
+		{
+			name: "without X-Merge the update is a revision mismatch",
+			setupMocks: func(repo *domain.MockProjectRepository, _ *domain.MockSettingsHistoryRepository, _ *domain.MockMessageBuilder) {
+				repo.On("GetProjectSettings", mock.Anything, "project-uid-1").Return(base, nil)
+				repo.On("UpdateProjectSettings", mock.Anything, mock.Anything, uint64(3)).Return(domain.ErrRevisionMismatch)
+			},
+			expectedErr: domain.ErrRevisionMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+			history := &domain.MockSettingsHistoryRepository{}
+			service.SettingsHistoryRepository = history
+			mockUserReader := service.UserReader.(*domain.MockUserReader)
+			mockUserReader.On("UsernameByEmail", mock.Anything, "alice@example.com").Return("alice", nil)
+			mockUserReader.On("UserMetadataByPrincipal", mock.Anything, "alice").Return((*domain.UserMetadata)(nil), nil)
+			mockRepo.On("ProjectExists", mock.Anything, "project-uid-1").Return(true, nil)
+			tt.setupMocks(mockRepo, history, mockBuilder)
+
+			result, err := service.UpdateProjectSettings(context.Background(), &projsvc.UpdateProjectSettingsPayload{
+				UID:              misc.StringPtr("project-uid-1"),
+				IfMatch:          misc.StringPtr("3"),
+				Merge:            &tt.merge,
+				MissionStatement: misc.StringPtr("New mission"),
+				Writers: []*projsvc.UserInfo{
+					{Name: misc.StringPtr("Alice"), Username: misc.StringPtr("alice"), Email: misc.StringPtr("alice@example.com")},
+				},
+			})
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				var conflictErr *domain.SettingsMergeConflictError
+				if tt.conflicts != nil && assert.ErrorAs(t, err, &conflictErr) {
+					assert.Equal(t, tt.conflicts, conflictErr.Conflicts)
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "New mission", *result.MissionStatement)
+				assert.Len(t, result.Writers, 2)
+			}
+			mockRepo.AssertExpectations(t)
+			history.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// maxGeneratedSlugLength is the maximum length of a slug generated from a
+	// project name, including its collision suffix.
+	maxGeneratedSlugLength = 63
+	// maxSlugSuffix is the highest collision suffix tried for a generated
+	// slug, such as "-2" and "-3", before the slug is reported as taken.
+	maxSlugSuffix = 100
+	// maxGeneratedSlugAttempts is how many times a project with a generated
+	// slug is created before a slug taken concurrently is reported.
+	maxGeneratedSlugAttempts = 3
+	// defaultSlug is the slug generated for a name without any letter or
+	// digit that can be written in ASCII.
+	defaultSlug = "project"
+)
+
+// slugTransliterations are the characters that do not decompose into an ASCII
+// letter and a combining mark, written as the ASCII letters they are usually
+// transliterated to.
+var slugTransliterations = map[rune]string{
+	'ß': "ss",
+	'æ': "ae",
+	'œ': "oe",
+	'ø': "o",
+	'đ': "d",
+	'ð': "d",
+	'ł': "l",
+	'þ': "th",
+	'ı': "i",
+	'&': "and",
+}
+
+// slugify returns the slug of name: its letters and digits transliterated to
+// lowercase ASCII, with every other run of characters replaced by a hyphen.
+// The slug starts with a letter and is at most maxGeneratedSlugLength long, so
+// that it matches the slug pattern of the API; a name that leaves no usable
+// characters gives defaultSlug.
+func slugify(name string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range norm.NFKD.String(strings.ToLower(name)) {
+		if unicode.Is(unicode.Mn, r) {
+			// Accents split off the letters they were on.
+			continue
+		}
+		switch {
+		case slugTransliterations[r] != "":
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			hyphen = false
+			b.WriteString(slugTransliterations[r])
+		case r >= 'a' && r <= 'z' || r >= '0' && r <= '9':
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			hyphen = false
+			b.WriteRune(r)
+		default:
+			hyphen = true
+		}
+	}
+
+	slug := b.String()
+	if slug == "" {
+		return defaultSlug
+	}
+	if slug[0] < 'a' || slug[0] > 'z' || len(slug) < 2 {
+		slug = defaultSlug + "-" + slug
+	}
+	return truncateSlug(slug, maxGeneratedSlugLength)
+}
+
+// truncateSlug shortens slug to at most limit bytes, dropping any hyphen the
+// cut leaves at its end.
+func truncateSlug(slug string, limit int) string {
+	if len(slug) <= limit {
+		return slug
+	}
+	return strings.TrimRight(slug[:limit], "-")
+}
+
+// generateProjectSlug returns a slug for a new project named name that no
+// project holds: the slug of the name, followed by "-2", "-3" and so on when
+// it is taken.
+func (s *ProjectsService) generateProjectSlug(ctx context.Context, name string) (string, error) {
+	base := slugify(name)
+	for suffix := 1; suffix <= maxSlugSuffix; suffix++ {
+		candidate := base
+		if suffix > 1 {
+			tail := "-" + strconv.Itoa(suffix)
+			candidate = truncateSlug(base, maxGeneratedSlugLength-len(tail)) + tail
+		}
+		exists, err := s.ProjectRepository.ProjectSlugExists(ctx, candidate)
+		if err != nil {
+			slog.ErrorContext(ctx, "error checking if slug exists", constants.ErrKey, err)
+			return "", domain.ErrInternal
+		}
+		if !exists {
+			return candidate, nil
+		}
+	}
+	slog.WarnContext(ctx, "no free slug for the project name", slog.String("slug", base))
+	return "", domain.ErrProjectSlugExists
+}
@@ -0,0 +1,51 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+// RebuildSlugMappings rebuilds the slug to UID mappings from the projects, so
+// that slug lookups find projects whose mapping was lost or left stale by an
+// interrupted write. Mappings that no project holds are only removed when
+// removeDangling is set. Only LF staff and trusted service principals may
+// rebuild the mappings.
+func (s *ProjectsService) RebuildSlugMappings(ctx context.Context, removeDangling bool) (*models.SlugRebuildReport, error) {
+	if s.SlugMappingRepository == nil {
+		slog.ErrorContext(ctx, "slug mappings are not stored apart from projects")
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "rebuild slug mappings"); err != nil {
+		return nil, err
+	}
+
+	ctx, span := startSpan(ctx, "ProjectsService.RebuildSlugMappings",
+		attribute.Bool("remove_dangling", removeDangling),
+	)
+	defer span.End()
+
+	report, err := s.SlugMappingRepository.RebuildSlugMappings(ctx, removeDangling)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "rebuilt project slug mappings",
+		"projects", report.Projects,
+		"created", len(report.CreatedMappings),
+		"updated", len(report.UpdatedMappings),
+		"conflicting", len(report.ConflictingSlugs),
+		"dangling", len(report.DanglingMappings),
+		"removed", report.RemovedMappings,
+		"failed", report.Failed,
+	)
+	return report, nil
+}
@@ -0,0 +1,51 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+func TestProjectsService_RebuildSlugMappings(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+	slugMappings := &domain.MockSlugMappingRepository{}
+	service.SlugMappingRepository = slugMappings
+	expected := &models.SlugRebuildReport{Projects: 2, CreatedMappings: []string{"cncf"}, DanglingMappings: []string{"old"}, RemovedMappings: 1}
+	slugMappings.On("RebuildSlugMappings", mock.Anything, true).Return(expected, nil).Once()
+
+	report, err := service.RebuildSlugMappings(staffContext(), true)
+
+	require.NoError(t, err)
+	assert.Equal(t, expected, report)
+	slugMappings.AssertExpectations(t)
+}
+
+func TestProjectsService_RebuildSlugMappings_forbidden(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+	slugMappings := &domain.MockSlugMappingRepository{}
+	service.SlugMappingRepository = slugMappings
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user-1")
+
+	_, err := service.RebuildSlugMappings(ctx, true)
+
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	slugMappings.AssertNotCalled(t, "RebuildSlugMappings", mock.Anything, mock.Anything)
+}
+
+func TestProjectsService_RebuildSlugMappings_unavailable(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+
+	_, err := service.RebuildSlugMappings(context.Background(), false)
+
+	assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+}
@@ -0,0 +1,135 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+)
+
+// slugPattern is the slug pattern of the API design.
+var slugPattern = regexp.MustCompile(`^[a-z][a-z0-9_\-]*[a-z0-9]$`)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"spaces and punctuation", "Cloud Native Computing Foundation (CNCF)", "cloud-native-computing-foundation-cncf"},
+		{"accents", "Fundação Café Élan", "fundacao-cafe-elan"},
+		{"letters without decomposition", "Straße Øresund Łódź", "strasse-oresund-lodz"},
+		{"ampersand", "Tools & Libraries", "tools-and-libraries"},
+		{"compatibility characters", "ｆｕｌｌ ｗｉｄｔｈ ①", "full-width-1"},
+		{"leading digit", "3D Printing", "project-3d-printing"},
+		{"single letter", "X", "project-x"},
+		{"no ASCII letters", "开源项目", "project"},
+		{"long name", strings.Repeat("word ", 20), strings.TrimRight(strings.Repeat("word-", 13)[:63], "-")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slug := slugify(tt.input)
+
+			assert.Equal(t, tt.expected, slug)
+			assert.Regexp(t, slugPattern, slug)
+			assert.LessOrEqual(t, len(slug), maxGeneratedSlugLength)
+		})
+	}
+}
+
+func TestProjectsService_generateProjectSlug(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		taken    []string
+		expected string
+	}{
+		{
+			name:     "free slug",
+			input:    "Foo Project",
+			expected: "foo-project",
+		},
+		{
+			name:     "taken slugs are suffixed",
+			input:    "Foo Project",
+			taken:    []string{"foo-project", "foo-project-2"},
+			expected: "foo-project-3",
+		},
+		{
+			name:     "suffix fits within the length limit",
+			input:    strings.Repeat("a", 70),
+			taken:    []string{strings.Repeat("a", 63)},
+			expected: strings.Repeat("a", 61) + "-2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := setupServiceForTesting()
+			for _, slug := range tt.taken {
+				mockRepo.On("ProjectSlugExists", mock.Anything, slug).Return(true, nil).Once()
+			}
+			mockRepo.On("ProjectSlugExists", mock.Anything, tt.expected).Return(false, nil).Once()
+
+			slug, err := service.generateProjectSlug(context.Background(), tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, slug)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("store error", func(t *testing.T) {
+		service, mockRepo, _, _ := setupServiceForTesting()
+		mockRepo.On("ProjectSlugExists", mock.Anything, "foo").Return(false, errors.New("boom"))
+
+		_, err := service.generateProjectSlug(context.Background(), "Foo")
+
+		assert.ErrorIs(t, err, domain.ErrInternal)
+	})
+
+	t.Run("every suffix taken", func(t *testing.T) {
+		service, mockRepo, _, _ := setupServiceForTesting()
+		mockRepo.On("ProjectSlugExists", mock.Anything, mock.Anything).Return(true, nil)
+
+		_, err := service.generateProjectSlug(context.Background(), "Foo")
+
+		assert.ErrorIs(t, err, domain.ErrProjectSlugExists)
+		mockRepo.AssertNumberOfCalls(t, "ProjectSlugExists", maxSlugSuffix)
+	})
+}
+
+func TestProjectsService_CreateProject_generatedSlug(t *testing.T) {
+	service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+	mockRepo.On("ProjectSlugExists", mock.Anything, "foo-project").Return(false, nil).Once()
+	// Another project takes the slug between the check and the create.
+	mockRepo.On("CreateProject", mock.Anything, mock.Anything, mock.Anything).Return(domain.ErrProjectSlugExists).Once()
+	mockRepo.On("ProjectSlugExists", mock.Anything, "foo-project").Return(true, nil).Once()
+	mockRepo.On("ProjectSlugExists", mock.Anything, "foo-project-2").Return(false, nil).Once()
+	mockRepo.On("CreateProject", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	mockBuilder.On("SendIndexerMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockBuilder.On("SendAccessMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	result, err := service.CreateProject(context.Background(), &projsvc.CreateProjectPayload{
+		Name:            "Foo Project",
+		Description:     "Foo",
+		AllowDuplicates: true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "foo-project-2", *result.Slug)
+	mockRepo.AssertExpectations(t)
+}
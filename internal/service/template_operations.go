@@ -0,0 +1,179 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// CreateProjectTemplate stores a new project template. The creation fields
+// are filled in. Only LF staff and trusted service principals may manage
+// templates.
+func (s *ProjectsService) CreateProjectTemplate(ctx context.Context, template *models.ProjectTemplate) (*models.ProjectTemplate, error) {
+	if s.ProjectTemplateRepository == nil {
+		slog.ErrorContext(ctx, "project template repository not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "create project template"); err != nil {
+		return nil, err
+	}
+
+	ctx, span := startSpan(ctx, "ProjectsService.CreateProjectTemplate", attribute.String("template.name", template.Name))
+	defer span.End()
+
+	if err := s.checkTextLengths(ctx,
+		textField{"mission_statement", template.MissionStatement, s.Config.MaxMissionStatementLength},
+	); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	if err := s.checkProjectEnums(ctx, &template.Stage, &template.Category, nil); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	now := time.Now().UTC()
+	template.CreatedBy = principal
+	template.CreatedAt = now
+	template.UpdatedAt = now
+	if err := s.ProjectTemplateRepository.CreateProjectTemplate(ctx, template); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "created project template", "template", template.Name)
+	return template, nil
+}
+
+// GetProjectTemplate returns a project template.
+func (s *ProjectsService) GetProjectTemplate(ctx context.Context, name string) (*models.ProjectTemplate, error) {
+	if s.ProjectTemplateRepository == nil {
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "get project template"); err != nil {
+		return nil, err
+	}
+	return s.ProjectTemplateRepository.GetProjectTemplate(ctx, name)
+}
+
+// ListProjectTemplates returns every project template, sorted by name.
+func (s *ProjectsService) ListProjectTemplates(ctx context.Context) ([]*models.ProjectTemplate, error) {
+	if s.ProjectTemplateRepository == nil {
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "list project templates"); err != nil {
+		return nil, err
+	}
+	return s.ProjectTemplateRepository.ListProjectTemplates(ctx)
+}
+
+// UpdateProjectTemplate replaces the defaults of a project template, keeping
+// its creation fields. Projects already created from it are not changed.
+func (s *ProjectsService) UpdateProjectTemplate(ctx context.Context, template *models.ProjectTemplate) (*models.ProjectTemplate, error) {
+	if s.ProjectTemplateRepository == nil {
+		slog.ErrorContext(ctx, "project template repository not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "update project template"); err != nil {
+		return nil, err
+	}
+
+	ctx, span := startSpan(ctx, "ProjectsService.UpdateProjectTemplate", attribute.String("template.name", template.Name))
+	defer span.End()
+
+	if err := s.checkTextLengths(ctx,
+		textField{"mission_statement", template.MissionStatement, s.Config.MaxMissionStatementLength},
+	); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	if err := s.checkProjectEnums(ctx, &template.Stage, &template.Category, nil); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	existing, err := s.ProjectTemplateRepository.GetProjectTemplate(ctx, template.Name)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	template.CreatedBy = existing.CreatedBy
+	template.CreatedAt = existing.CreatedAt
+	template.UpdatedAt = time.Now().UTC()
+	if err := s.ProjectTemplateRepository.UpdateProjectTemplate(ctx, template); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "updated project template", "template", template.Name)
+	return template, nil
+}
+
+// DeleteProjectTemplate removes a project template. Projects already created
+// from it are not changed.
+func (s *ProjectsService) DeleteProjectTemplate(ctx context.Context, name string) error {
+	if s.ProjectTemplateRepository == nil {
+		return domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "delete project template"); err != nil {
+		return err
+	}
+	if err := s.ProjectTemplateRepository.DeleteProjectTemplate(ctx, name); err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "deleted project template", "template", name)
+	return nil
+}
+
+// applyProjectTemplate fills in the fields of a new project that the payload
+// leaves empty from the template it names, if any.
+func (s *ProjectsService) applyProjectTemplate(ctx context.Context, payload *projsvc.CreateProjectPayload) error {
+	if payload.Template == nil || *payload.Template == "" {
+		return nil
+	}
+	if s.ProjectTemplateRepository == nil {
+		slog.ErrorContext(ctx, "project template repository not initialized", "template", *payload.Template)
+		return domain.ErrServiceUnavailable
+	}
+
+	template, err := s.ProjectTemplateRepository.GetProjectTemplate(ctx, *payload.Template)
+	if err != nil {
+		if errors.Is(err, domain.ErrProjectTemplateNotFound) {
+			validation := &domain.ValidationError{}
+			validation.Add("template", domain.FieldErrorNotFound, domain.ErrProjectTemplateNotFound)
+			return validation.Err()
+		}
+		return err
+	}
+
+	if payload.Stage == nil && template.Stage != "" {
+		payload.Stage = misc.StringPtr(template.Stage)
+	}
+	if payload.Category == nil && template.Category != "" {
+		payload.Category = misc.StringPtr(template.Category)
+	}
+	if len(payload.FundingModel) == 0 && len(template.FundingModel) > 0 {
+		payload.FundingModel = append([]string(nil), template.FundingModel...)
+	}
+	if payload.MissionStatement == nil && template.MissionStatement != "" {
+		payload.MissionStatement = misc.StringPtr(template.MissionStatement)
+	}
+	if len(payload.Auditors) == 0 && len(template.Auditors) > 0 {
+		payload.Auditors = convertUsersToAPI(template.Auditors)
+	}
+
+	slog.DebugContext(ctx, "applied project template", "template", template.Name, "project_slug", payload.Slug)
+	return nil
+}
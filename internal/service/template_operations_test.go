@@ -0,0 +1,142 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+func sandboxTemplate() *models.ProjectTemplate {
+	return &models.ProjectTemplate{
+		Name:             "cncf-sandbox",
+		Stage:            "Formation - Exploratory",
+		Category:         "Sandbox",
+		FundingModel:     []string{"Membership"},
+		MissionStatement: "Grow the project in the CNCF sandbox.",
+		Auditors:         []models.UserInfo{{Username: "auditor", Email: "auditor@example.com"}},
+	}
+}
+
+func TestProjectsService_applyProjectTemplate(t *testing.T) {
+	t.Run("fills in the empty fields", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+		templates := &domain.MockProjectTemplateRepository{}
+		service.ProjectTemplateRepository = templates
+		templates.On("GetProjectTemplate", mock.Anything, "cncf-sandbox").Return(sandboxTemplate(), nil)
+		payload := &projsvc.CreateProjectPayload{
+			Slug:     misc.StringPtr("sandbox-project"),
+			Template: misc.StringPtr("cncf-sandbox"),
+			Stage:    misc.StringPtr("Active"),
+		}
+
+		err := service.applyProjectTemplate(context.Background(), payload)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Active", *payload.Stage)
+		assert.Equal(t, "Sandbox", *payload.Category)
+		assert.Equal(t, []string{"Membership"}, payload.FundingModel)
+		assert.Equal(t, "Grow the project in the CNCF sandbox.", *payload.MissionStatement)
+		require.Len(t, payload.Auditors, 1)
+		assert.Equal(t, "auditor", *payload.Auditors[0].Username)
+	})
+
+	t.Run("no template", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+		payload := &projsvc.CreateProjectPayload{Slug: misc.StringPtr("plain-project")}
+
+		err := service.applyProjectTemplate(context.Background(), payload)
+
+		require.NoError(t, err)
+		assert.Nil(t, payload.Stage)
+	})
+
+	t.Run("unknown template", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+		templates := &domain.MockProjectTemplateRepository{}
+		service.ProjectTemplateRepository = templates
+		templates.On("GetProjectTemplate", mock.Anything, "missing").Return(nil, domain.ErrProjectTemplateNotFound)
+
+		err := service.applyProjectTemplate(context.Background(), &projsvc.CreateProjectPayload{Template: misc.StringPtr("missing")})
+
+		var validationErr *domain.ValidationError
+		require.True(t, errors.As(err, &validationErr))
+		assert.Equal(t, "template", validationErr.Fields[0].Path)
+		assert.Equal(t, domain.FieldErrorNotFound, validationErr.Fields[0].Code)
+	})
+
+	t.Run("templates not configured", func(t *testing.T) {
+		service, _, _, _ := setupServiceForTesting()
+
+		err := service.applyProjectTemplate(context.Background(), &projsvc.CreateProjectPayload{Template: misc.StringPtr("cncf-sandbox")})
+
+		assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+	})
+}
+
+func TestProjectsService_UpdateProjectTemplate_keepsCreation(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+	templates := &domain.MockProjectTemplateRepository{}
+	service.ProjectTemplateRepository = templates
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	existing := sandboxTemplate()
+	existing.CreatedBy = "admin"
+	existing.CreatedAt = createdAt
+	templates.On("GetProjectTemplate", mock.Anything, "cncf-sandbox").Return(existing, nil)
+	templates.On("UpdateProjectTemplate", mock.Anything, mock.Anything).Return(nil).Once()
+
+	updated, err := service.UpdateProjectTemplate(staffContext(), &models.ProjectTemplate{Name: "cncf-sandbox", Stage: "Active"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "admin", updated.CreatedBy)
+	assert.Equal(t, createdAt, updated.CreatedAt)
+	assert.True(t, updated.UpdatedAt.After(createdAt))
+	assert.Empty(t, updated.Category)
+	templates.AssertExpectations(t)
+}
+
+func TestProjectsService_ProjectTemplates_forbidden(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+	templates := &domain.MockProjectTemplateRepository{}
+	service.ProjectTemplateRepository = templates
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user-1")
+
+	_, err := service.CreateProjectTemplate(ctx, sandboxTemplate())
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = service.UpdateProjectTemplate(ctx, sandboxTemplate())
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = service.ListProjectTemplates(ctx)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = service.GetProjectTemplate(ctx, "cncf-sandbox")
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	err = service.DeleteProjectTemplate(ctx, "cncf-sandbox")
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	templates.AssertNotCalled(t, "CreateProjectTemplate", mock.Anything, mock.Anything)
+	templates.AssertNotCalled(t, "DeleteProjectTemplate", mock.Anything, mock.Anything)
+}
+
+func TestProjectsService_ProjectTemplates_unavailable(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+
+	_, err := service.CreateProjectTemplate(context.Background(), sandboxTemplate())
+	assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+	_, err = service.ListProjectTemplates(context.Background())
+	assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+	_, err = service.GetProjectTemplate(context.Background(), "cncf-sandbox")
+	assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+	err = service.DeleteProjectTemplate(context.Background(), "cncf-sandbox")
+	assert.ErrorIs(t, err, domain.ErrServiceUnavailable)
+}
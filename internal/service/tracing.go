@@ -0,0 +1,50 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer is safe to initialize at package level — otel.Tracer() returns a
+// delegating tracer that forwards to whatever TracerProvider is registered at
+// call time, so otel.SetTracerProvider() updates it regardless of init order.
+var tracer = otel.Tracer("github.com/linuxfoundation/lfx-v2-project-service/internal/service")
+
+// projectUIDAttr is the span attribute carrying the UID of the project being operated on.
+func projectUIDAttr(projectUID string) attribute.KeyValue {
+	return attribute.String("project_uid", projectUID)
+}
+
+// payloadSpanAttrs returns the project UID and expected revision attributes for a
+// write payload, skipping any that were not provided.
+func payloadSpanAttrs(projectUID, ifMatch *string) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	if projectUID != nil {
+		attrs = append(attrs, projectUIDAttr(*projectUID))
+	}
+	if ifMatch != nil {
+		attrs = append(attrs, attribute.String("revision", *ifMatch))
+	}
+	return attrs
+}
+
+// startSpan starts an internal span for a service operation.
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// recordSpanError marks the span as failed when err is non-nil.
+func recordSpanError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,209 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+	"time"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// TransferProject transfers the project payload.UID to new owners, such as
+// when it moves to another foundation. Its writers are replaced by
+// payload.Writers in a single update of the If-Match revision of its settings
+// and, when payload.ParentUID is set, it is then moved under that parent. The
+// transfer is audited and published as a project.transferred event naming the
+// previous writers. Returns the transferred project.
+func (s *ProjectsService) TransferProject(ctx context.Context, payload *projsvc.TransferProjectPayload) (*projsvc.ProjectFull, error) {
+	var attrs []attribute.KeyValue
+	if payload != nil {
+		attrs = payloadSpanAttrs(&payload.UID, payload.IfMatch)
+	}
+	ctx, span := startSpan(ctx, "ProjectsService.TransferProject", attrs...)
+	defer span.End()
+
+	project, err := s.transferProject(ctx, payload)
+	recordSpanError(span, err)
+	return project, err
+}
+
+func (s *ProjectsService) transferProject(ctx context.Context, payload *projsvc.TransferProjectPayload) (*projsvc.ProjectFull, error) {
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+
+	if payload == nil || payload.UID == "" {
+		slog.WarnContext(ctx, "project UID is required")
+		return nil, domain.ErrValidationFailed
+	}
+	if len(payload.Writers) == 0 {
+		slog.WarnContext(ctx, "a transferred project needs writers")
+		return nil, domain.NewFieldValidationError("writers", domain.FieldErrorRequired, domain.ErrValidationFailed)
+	}
+	parentUID := stringValue(payload.ParentUID)
+	if parentUID == payload.UID {
+		slog.WarnContext(ctx, "project cannot be moved under itself")
+		return nil, domain.NewFieldValidationError("parent_uid", domain.FieldErrorInvalid, domain.ErrInvalidParentProject)
+	}
+
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", payload.UID))
+
+	// The transfer hands the project over, so only its owners may make it.
+	if err := s.authorizeProject(ctx, payload.UID, relationOwner); err != nil {
+		return nil, err
+	}
+
+	projectDB, err := s.ProjectRepository.GetProjectBase(ctx, payload.UID)
+	if err != nil {
+		return nil, transferReadError(ctx, err)
+	}
+	moved := parentUID != "" && parentUID != projectDB.ParentUID
+	if moved {
+		if err := s.checkTransferParent(ctx, payload.UID, parentUID); err != nil {
+			return nil, err
+		}
+	}
+
+	settings, current, err := s.ProjectRepository.GetProjectSettingsWithRevision(ctx, payload.UID)
+	if err != nil {
+		return nil, transferReadError(ctx, err)
+	}
+	revision, err := s.ifMatchRevision(ctx, "project_settings:"+payload.UID, payload.IfMatch, payload.ForceUpdate != nil && *payload.ForceUpdate, func() (uint64, error) {
+		return current, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if revision != current {
+		slog.WarnContext(ctx, "etag header is invalid", "etag", revision, "revision", current)
+		return nil, domain.ErrRevisionMismatch
+	}
+	ctx = log.AppendCtx(ctx, slog.String("etag", strconv.FormatUint(revision, 10)))
+
+	// Enrich usernames from the auth service before persisting; caller-supplied LFIDs are untrusted.
+	if err := s.enrichAllRoleFields(ctx, [][]*projsvc.UserInfo{payload.Writers}, nil); err != nil {
+		slog.ErrorContext(ctx, "error enriching user role fields", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+
+	runSync := payload.XSync != nil && *payload.XSync
+	now := time.Now().UTC()
+
+	previousSettings := *settings
+	settings.Writers = convertUsersFromAPI(payload.Writers, previousSettings.Writers)
+	settings.UpdatedAt = &now
+	if err := s.ProjectRepository.UpdateProjectSettings(ctx, settings, revision); err != nil {
+		if errors.Is(err, domain.ErrRevisionMismatch) {
+			slog.WarnContext(ctx, "etag header is invalid", constants.ErrKey, err)
+			return nil, domain.ErrRevisionMismatch
+		}
+		slog.ErrorContext(ctx, "error updating project settings in store", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+	messages := projectSettingsUpdatedMessages(ctx, projectDB, &previousSettings, settings,
+		projectSettingsAccessMessages(projectDB, &previousSettings, settings)...)
+	if err := s.publishProjectMessages(ctx, payload.UID, runSync, messages); err != nil {
+		return nil, err
+	}
+
+	previousProject := *projectDB
+	if moved {
+		projectDB, err = s.ProjectRepository.UpdateProjectBaseWithRetry(ctx, payload.UID, func(p *models.ProjectBase) error {
+			previousProject = *p
+			p.ParentUID = parentUID
+			p.UpdatedAt = &now
+			return nil
+		})
+		if err != nil {
+			return nil, transferReadError(ctx, err)
+		}
+		s.invalidateProjectHierarchy()
+		if err := s.publishProjectMessages(ctx, payload.UID, runSync, projectBaseUpdatedMessages(ctx, projectDB, &previousProject)); err != nil {
+			return nil, err
+		}
+	}
+
+	transferEvent := newProjectLifecycleEvent(ctx, events.ProjectTransferred, payload.UID)
+	transferEvent.Project = DomainProjectToEvent(projectDB)
+	if moved {
+		transferEvent.PreviousProject = DomainProjectToEvent(&previousProject)
+	}
+	transferEvent.Transfer = &events.ProjectTransfer{
+		PreviousWriters:   domainUsersToEvent(previousSettings.Writers),
+		Writers:           domainUsersToEvent(settings.Writers),
+		PreviousParentUID: previousProject.ParentUID,
+		ParentUID:         projectDB.ParentUID,
+		Reason:            stringValue(payload.Reason),
+	}
+	if err := s.publishProjectMessages(ctx, payload.UID, runSync, []outboundMessage{{
+		kind:    models.OutboxKindEvent,
+		subject: projectEventSubject(transferEvent.Type),
+		message: transferEvent,
+	}}); err != nil {
+		return nil, err
+	}
+
+	log.Audit(ctx, "project transferred",
+		"project_uid", projectDB.UID,
+		"project_slug", projectDB.Slug,
+		"actor", transferEvent.Actor.Username,
+		"event_id", transferEvent.ID,
+		"previous_writers", extractUsernames(previousSettings.Writers),
+		"writers", extractUsernames(settings.Writers),
+		"previous_parent_uid", previousProject.ParentUID,
+		"parent_uid", projectDB.ParentUID,
+		"reason", stringValue(payload.Reason),
+	)
+
+	hierarchy, err := s.projectHierarchy(ctx, projectDB.UID)
+	if err != nil {
+		slog.WarnContext(ctx, "project hierarchy fields are left out of the transferred project", constants.ErrKey, err)
+	}
+	return ConvertToProjectFull(projectDB, settings, hierarchy), nil
+}
+
+// checkTransferParent checks that the project projectUID may be moved under
+// the project parentUID: the parent exists, the caller may write it, and the
+// move neither makes the project its own ancestor nor breaks the hierarchy
+// limits.
+func (s *ProjectsService) checkTransferParent(ctx context.Context, projectUID, parentUID string) error {
+	exists, err := s.ProjectRepository.ProjectExists(ctx, parentUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error checking if parent project exists", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+	if !exists {
+		slog.WarnContext(ctx, "parent project not found", slog.String("parent_uid", parentUID))
+		return domain.NewFieldValidationError("parent_uid", domain.FieldErrorNotFound, domain.ErrInvalidParentProject)
+	}
+	if err := s.authorizeProject(ctx, parentUID, relationWriter); err != nil {
+		return err
+	}
+	if err := s.checkHierarchyCycle(ctx, projectUID, parentUID); err != nil {
+		return err
+	}
+	return s.enforceHierarchyPolicies(ctx, projectUID, parentUID)
+}
+
+// transferReadError maps an error from reading or updating the project of a
+// transfer to the error returned to the caller.
+func transferReadError(ctx context.Context, err error) error {
+	if errors.Is(err, domain.ErrProjectNotFound) {
+		slog.WarnContext(ctx, "project not found", constants.ErrKey, err)
+		return domain.ErrProjectNotFound
+	}
+	slog.ErrorContext(ctx, "error getting project from store", constants.ErrKey, err)
+	return domain.ErrInternal
+}
@@ -0,0 +1,141 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/misc"
+)
+
+func TestProjectsService_TransferProject(t *testing.T) {
+	newWriters := []*projsvc.UserInfo{
+		{Name: misc.StringPtr("Dan"), Username: misc.StringPtr("dan"), Email: misc.StringPtr("dan@example.com")},
+	}
+
+	t.Run("writers are replaced and the project moves under the new parent", func(t *testing.T) {
+		service, repo, mb, _ := setupServiceForTesting()
+		mockUserReader := service.UserReader.(*domain.MockUserReader)
+		mockUserReader.On("UsernameByEmail", mock.Anything, "dan@example.com").Return("dan", nil)
+		mockUserReader.On("UserMetadataByPrincipal", mock.Anything, "dan").Return((*domain.UserMetadata)(nil), nil)
+
+		project := &models.ProjectBase{UID: "project", Slug: "project", ParentUID: "old-parent"}
+		newParent := &models.ProjectBase{UID: "new-parent", Slug: "new-parent"}
+		settings := &models.ProjectSettings{UID: "project", Writers: []models.UserInfo{{Username: "alice"}}}
+
+		repo.On("GetProjectBase", mock.Anything, "project").Return(project, nil)
+		repo.On("ProjectExists", mock.Anything, "new-parent").Return(true, nil)
+		repo.On("GetProjectBase", mock.Anything, "new-parent").Return(newParent, nil)
+		repo.On("GetProjectSettingsWithRevision", mock.Anything, "project").Return(settings, uint64(3), nil)
+		repo.On("UpdateProjectSettings", mock.Anything, mock.MatchedBy(func(s *models.ProjectSettings) bool {
+			return len(s.Writers) == 1 && s.Writers[0].Username == "dan"
+		}), uint64(3)).Return(nil)
+		repo.On("UpdateProjectBaseWithRetry", mock.Anything, "project", mock.Anything).
+			Run(func(args mock.Arguments) {
+				require.NoError(t, args.Get(2).(func(*models.ProjectBase) error)(project))
+			}).
+			Return(project, nil)
+		repo.On("ListAllProjectsBase", mock.Anything).Return([]*models.ProjectBase{project, newParent}, nil)
+
+		var transfer *events.ProjectTransfer
+		mb.On("SendIndexerMessage", mock.Anything, mock.Anything, mock.Anything, false).Return(nil)
+		mb.On("SendAccessMessage", mock.Anything, mock.Anything, mock.Anything, false).Return(nil)
+		mb.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				if event, ok := args.Get(2).(events.ProjectLifecycleEvent); ok && event.Type == events.ProjectTransferred {
+					transfer = event.Transfer
+				}
+			}).
+			Return(nil)
+
+		result, err := service.TransferProject(context.Background(), &projsvc.TransferProjectPayload{
+			UID:       "project",
+			IfMatch:   misc.StringPtr("3"),
+			Writers:   newWriters,
+			ParentUID: misc.StringPtr("new-parent"),
+			Reason:    misc.StringPtr("Project moved to the CNCF"),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "new-parent", *result.ParentUID)
+		require.Len(t, result.Writers, 1)
+		assert.Equal(t, "dan", *result.Writers[0].Username)
+
+		require.NotNil(t, transfer)
+		assert.Equal(t, "alice", transfer.PreviousWriters[0].Username)
+		assert.Equal(t, "dan", transfer.Writers[0].Username)
+		assert.Equal(t, "old-parent", transfer.PreviousParentUID)
+		assert.Equal(t, "new-parent", transfer.ParentUID)
+		assert.Equal(t, "Project moved to the CNCF", transfer.Reason)
+
+		repo.AssertExpectations(t)
+	})
+
+	tests := []struct {
+		name        string
+		payload     *projsvc.TransferProjectPayload
+		setupMocks  func(*domain.MockProjectRepository)
+		expectedErr error
+	}{
+		{
+			name:        "writers are required",
+			payload:     &projsvc.TransferProjectPayload{UID: "project", IfMatch: misc.StringPtr("3")},
+			setupMocks:  func(*domain.MockProjectRepository) {},
+			expectedErr: domain.ErrValidationFailed,
+		},
+		{
+			name:        "project cannot be moved under itself",
+			payload:     &projsvc.TransferProjectPayload{UID: "project", IfMatch: misc.StringPtr("3"), Writers: newWriters, ParentUID: misc.StringPtr("project")},
+			setupMocks:  func(*domain.MockProjectRepository) {},
+			expectedErr: domain.ErrInvalidParentProject,
+		},
+		{
+			name:    "new parent must exist",
+			payload: &projsvc.TransferProjectPayload{UID: "project", IfMatch: misc.StringPtr("3"), Writers: newWriters, ParentUID: misc.StringPtr("missing")},
+			setupMocks: func(repo *domain.MockProjectRepository) {
+				repo.On("GetProjectBase", mock.Anything, "project").Return(&models.ProjectBase{UID: "project"}, nil)
+				repo.On("ProjectExists", mock.Anything, "missing").Return(false, nil)
+			},
+			expectedErr: domain.ErrInvalidParentProject,
+		},
+		{
+			name:    "stale settings revision is a mismatch",
+			payload: &projsvc.TransferProjectPayload{UID: "project", IfMatch: misc.StringPtr("2"), Writers: newWriters},
+			setupMocks: func(repo *domain.MockProjectRepository) {
+				repo.On("GetProjectBase", mock.Anything, "project").Return(&models.ProjectBase{UID: "project"}, nil)
+				repo.On("GetProjectSettingsWithRevision", mock.Anything, "project").
+					Return(&models.ProjectSettings{UID: "project"}, uint64(3), nil)
+			},
+			expectedErr: domain.ErrRevisionMismatch,
+		},
+		{
+			name:    "project not found",
+			payload: &projsvc.TransferProjectPayload{UID: "project", IfMatch: misc.StringPtr("3"), Writers: newWriters},
+			setupMocks: func(repo *domain.MockProjectRepository) {
+				repo.On("GetProjectBase", mock.Anything, "project").Return(nil, domain.ErrProjectNotFound)
+			},
+			expectedErr: domain.ErrProjectNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo, _, _ := setupServiceForTesting()
+			tt.setupMocks(repo)
+
+			_, err := service.TransferProject(context.Background(), tt.payload)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			repo.AssertExpectations(t)
+		})
+	}
+}
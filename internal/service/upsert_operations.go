@@ -0,0 +1,224 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strconv"
+
+	projsvc "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+)
+
+// HandleProjectUpsert creates or updates the project of an events.ProjectUpsert
+// pushed by the LFX v1 sync pipeline. The write is made as the pipeline, which
+// NATS authenticated, and may reach any project, but goes through the same
+// validation, restricted field and dissolved entity guards, messages and events
+// as one made through the API. An upsert that changes nothing is not written.
+func (s *ProjectsService) HandleProjectUpsert(ctx context.Context, msg domain.Message) error {
+	ctx, span := startSpan(ctx, "ProjectsService.HandleProjectUpsert")
+	defer span.End()
+
+	err := s.handleProjectUpsert(ctx, msg)
+	recordSpanError(span, err)
+	return err
+}
+
+func (s *ProjectsService) handleProjectUpsert(ctx context.Context, msg domain.Message) error {
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return domain.ErrServiceUnavailable
+	}
+
+	var upsert events.ProjectUpsert
+	if err := json.Unmarshal(msg.Data(), &upsert); err != nil {
+		slog.WarnContext(ctx, "invalid project upsert", constants.ErrKey, err)
+		return fmt.Errorf("%w: %w", domain.ErrUnmarshal, err)
+	}
+	if upsert.LegacyID == "" {
+		return domain.NewFieldValidationError("legacy_id", domain.FieldErrorRequired, domain.ErrValidationFailed)
+	}
+
+	ctx = log.AppendCtx(ctx, slog.String("legacy_id", upsert.LegacyID))
+	ctx = withUpsertPublisher(ctx)
+
+	projectUID, err := s.ProjectRepository.GetProjectUIDFromLegacyID(ctx, upsert.LegacyID)
+	if errors.Is(err, domain.ErrProjectNotFound) {
+		return s.createUpsertedProject(ctx, upsert)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "error getting project UID from legacy ID", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", projectUID))
+	projectDB, revision, err := s.ProjectRepository.GetProjectBaseWithRevision(ctx, projectUID)
+	if err != nil {
+		// The project may have been deleted since its legacy ID was read; the
+		// upsert is then retried and creates it again.
+		slog.ErrorContext(ctx, "error getting project base", constants.ErrKey, err)
+		return domain.ErrInternal
+	}
+
+	current := projectUpdatePayload(ConvertToServiceProjectBase(projectDB))
+	payload := projectUpdatePayload(ConvertToServiceProjectBase(projectDB))
+	applyProjectUpsert(payload, upsert)
+	if reflect.DeepEqual(payload, current) {
+		slog.DebugContext(ctx, "project upsert changes nothing")
+		return nil
+	}
+
+	// The update applies to the revision the upsert was merged with, so that a
+	// concurrent write fails it with a revision mismatch and it is retried.
+	ifMatch := strconv.FormatUint(revision, 10)
+	payload.IfMatch = &ifMatch
+	if _, err := s.updateProjectBase(ctx, payload); err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "updated project from upsert")
+	return nil
+}
+
+// createUpsertedProject creates the project of upsert, which no project has
+// the legacy ID of.
+func (s *ProjectsService) createUpsertedProject(ctx context.Context, upsert events.ProjectUpsert) error {
+	validation := &domain.ValidationError{}
+	if stringValue(upsert.Slug) == "" {
+		validation.Add("slug", domain.FieldErrorRequired, domain.ErrValidationFailed)
+	}
+	if stringValue(upsert.Name) == "" {
+		validation.Add("name", domain.FieldErrorRequired, domain.ErrValidationFailed)
+	}
+	if err := validation.Err(); err != nil {
+		slog.WarnContext(ctx, "invalid project upsert", constants.ErrKey, err)
+		return err
+	}
+
+	// The v1 project already exists, so it is not a duplicate of another one.
+	payload := &projsvc.CreateProjectPayload{AllowDuplicates: true, LegacyID: &upsert.LegacyID}
+	update := &projsvc.UpdateProjectBasePayload{}
+	applyProjectUpsert(update, upsert)
+	payload.Slug = &update.Slug
+	payload.Name = update.Name
+	payload.Description = update.Description
+	payload.Public = update.Public
+	payload.ParentUID = update.ParentUID
+	payload.Stage = update.Stage
+	payload.Category = update.Category
+	payload.LegalEntityType = update.LegalEntityType
+	payload.LegalEntityName = update.LegalEntityName
+	payload.Funding = update.Funding
+	payload.FundingModel = update.FundingModel
+	payload.FormationDate = update.FormationDate
+	payload.CharterURL = update.CharterURL
+	payload.LogoURL = update.LogoURL
+	payload.WebsiteURL = update.WebsiteURL
+	payload.RepositoryURL = update.RepositoryURL
+	payload.Tags = update.Tags
+
+	project, err := s.createProject(ctx, payload)
+	if err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "created project from upsert", "project_uid", stringValue(project.UID))
+	return nil
+}
+
+// projectUpdatePayload returns the update payload that writes project back
+// unchanged.
+func projectUpdatePayload(project *projsvc.ProjectBase) *projsvc.UpdateProjectBasePayload {
+	return &projsvc.UpdateProjectBasePayload{
+		UID:                        project.UID,
+		Slug:                       stringValue(project.Slug),
+		Description:                stringValue(project.Description),
+		Name:                       stringValue(project.Name),
+		Public:                     project.Public,
+		IsFoundation:               project.IsFoundation,
+		ParentUID:                  stringValue(project.ParentUID),
+		Stage:                      project.Stage,
+		Category:                   project.Category,
+		Funding:                    project.Funding,
+		FundingModel:               project.FundingModel,
+		CharterURL:                 project.CharterURL,
+		LegalEntityType:            project.LegalEntityType,
+		LegalEntityName:            project.LegalEntityName,
+		LegalParentUID:             project.LegalParentUID,
+		EntityDissolutionDate:      project.EntityDissolutionDate,
+		EntityFormationDocumentURL: project.EntityFormationDocumentURL,
+		AutojoinEnabled:            project.AutojoinEnabled,
+		FormationDate:              project.FormationDate,
+		LogoURL:                    project.LogoURL,
+		RepositoryURL:              project.RepositoryURL,
+		WebsiteURL:                 project.WebsiteURL,
+		Links:                      project.Links,
+		Annotations:                project.Annotations,
+		NameI18n:                   project.NameI18n,
+		DescriptionI18n:            project.DescriptionI18n,
+		LegacyID:                   project.LegacyID,
+		Tags:                       project.Tags,
+	}
+}
+
+// applyProjectUpsert sets the fields of payload that upsert sets. Empty values
+// are set like the project converters read them back, as nil, so that an
+// upsert repeating the stored project changes nothing.
+func applyProjectUpsert(payload *projsvc.UpdateProjectBasePayload, upsert events.ProjectUpsert) {
+	if upsert.Slug != nil {
+		payload.Slug = *upsert.Slug
+	}
+	if upsert.Name != nil {
+		payload.Name = *upsert.Name
+	}
+	if upsert.Description != nil {
+		payload.Description = *upsert.Description
+	}
+	if upsert.ParentUID != nil {
+		payload.ParentUID = *upsert.ParentUID
+	}
+	if upsert.Public != nil {
+		payload.Public = upsert.Public
+	}
+	for _, field := range []struct {
+		value  *string
+		target **string
+	}{
+		{upsert.Stage, &payload.Stage},
+		{upsert.Category, &payload.Category},
+		{upsert.LegalEntityType, &payload.LegalEntityType},
+		{upsert.LegalEntityName, &payload.LegalEntityName},
+		{upsert.Funding, &payload.Funding},
+		{upsert.FormationDate, &payload.FormationDate},
+		{upsert.CharterURL, &payload.CharterURL},
+		{upsert.LogoURL, &payload.LogoURL},
+		{upsert.WebsiteURL, &payload.WebsiteURL},
+		{upsert.RepositoryURL, &payload.RepositoryURL},
+	} {
+		if field.value != nil {
+			*field.target = nil
+			if *field.value != "" {
+				*field.target = field.value
+			}
+		}
+	}
+	if upsert.FundingModel != nil {
+		payload.FundingModel = nil
+		if len(upsert.FundingModel) > 0 {
+			payload.FundingModel = upsert.FundingModel
+		}
+	}
+	if upsert.Tags != nil {
+		payload.Tags = nil
+		if len(upsert.Tags) > 0 {
+			payload.Tags = upsert.Tags
+		}
+	}
+}
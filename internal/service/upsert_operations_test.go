@@ -0,0 +1,205 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+func TestProjectsService_HandleProjectUpsert(t *testing.T) {
+	existing := func() *models.ProjectBase {
+		return &models.ProjectBase{
+			UID:         "project-uid-1",
+			Slug:        "test-project",
+			Name:        "Test Project",
+			Description: "A test project",
+			Stage:       "Active",
+			LegacyID:    "a0941000002wBz4AAE",
+		}
+	}
+
+	tests := []struct {
+		name        string
+		data        string
+		setupMocks  func(*domain.MockProjectRepository, *domain.MockMessageBuilder)
+		wantErr     error
+		wantInvalid string
+	}{
+		{
+			name: "creates the project of a new legacy ID",
+			data: `{"legacy_id": "a0941000002wBz4AAE", "slug": "test-project", "name": "Test Project", "public": true}`,
+			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
+				mockRepo.On("GetProjectUIDFromLegacyID", mock.Anything, "a0941000002wBz4AAE").Return("", domain.ErrProjectNotFound)
+				mockRepo.On("ProjectSlugExists", mock.Anything, "test-project").Return(false, nil)
+				mockRepo.On("CreateProject", mock.Anything, mock.MatchedBy(func(p *models.ProjectBase) bool {
+					return p.LegacyID == "a0941000002wBz4AAE" && p.Name == "Test Project" && p.Public
+				}), mock.AnythingOfType("*models.ProjectSettings")).Return(nil)
+				mockBuilder.On("SendIndexerMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				mockBuilder.On("SendAccessMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name: "updates the fields set by the upsert",
+			data: `{"legacy_id": "a0941000002wBz4AAE", "name": "Renamed Project", "stage": ""}`,
+			setupMocks: func(mockRepo *domain.MockProjectRepository, mockBuilder *domain.MockMessageBuilder) {
+				mockRepo.On("GetProjectUIDFromLegacyID", mock.Anything, "a0941000002wBz4AAE").Return("project-uid-1", nil)
+				mockRepo.On("GetProjectBaseWithRevision", mock.Anything, "project-uid-1").Return(existing(), uint64(7), nil)
+				mockRepo.On("GetProjectBase", mock.Anything, "project-uid-1").Return(existing(), nil)
+				mockRepo.On("UpdateProjectBase", mock.Anything, mock.MatchedBy(func(p *models.ProjectBase) bool {
+					return p.Name == "Renamed Project" && p.Description == "A test project" && p.Stage == "" &&
+						p.LegacyID == "a0941000002wBz4AAE"
+				}), uint64(7)).Return(nil)
+				mockBuilder.On("SendIndexerMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name: "an upsert that changes nothing is not written",
+			data: `{"legacy_id": "a0941000002wBz4AAE", "name": "Test Project", "stage": "Active", "tags": []}`,
+			setupMocks: func(mockRepo *domain.MockProjectRepository, _ *domain.MockMessageBuilder) {
+				mockRepo.On("GetProjectUIDFromLegacyID", mock.Anything, "a0941000002wBz4AAE").Return("project-uid-1", nil)
+				mockRepo.On("GetProjectBaseWithRevision", mock.Anything, "project-uid-1").Return(existing(), uint64(7), nil)
+			},
+		},
+		{
+			name: "a concurrent write is retried",
+			data: `{"legacy_id": "a0941000002wBz4AAE", "name": "Renamed Project"}`,
+			setupMocks: func(mockRepo *domain.MockProjectRepository, _ *domain.MockMessageBuilder) {
+				mockRepo.On("GetProjectUIDFromLegacyID", mock.Anything, "a0941000002wBz4AAE").Return("project-uid-1", nil)
+				mockRepo.On("GetProjectBaseWithRevision", mock.Anything, "project-uid-1").Return(existing(), uint64(7), nil)
+				mockRepo.On("GetProjectBase", mock.Anything, "project-uid-1").Return(existing(), nil)
+				mockRepo.On("UpdateProjectBase", mock.Anything, mock.Anything, uint64(7)).Return(domain.ErrRevisionMismatch)
+			},
+			wantErr: domain.ErrRevisionMismatch,
+		},
+		{
+			name:        "a new project needs a slug and a name",
+			data:        `{"legacy_id": "a0941000002wBz4AAE", "name": "Test Project"}`,
+			wantInvalid: "slug",
+			setupMocks: func(mockRepo *domain.MockProjectRepository, _ *domain.MockMessageBuilder) {
+				mockRepo.On("GetProjectUIDFromLegacyID", mock.Anything, "a0941000002wBz4AAE").Return("", domain.ErrProjectNotFound)
+			},
+		},
+		{
+			name:        "the legacy ID is required",
+			data:        `{"slug": "test-project", "name": "Test Project"}`,
+			wantInvalid: "legacy_id",
+		},
+		{
+			name:    "malformed upsert",
+			data:    `{"legacy_id": 42}`,
+			wantErr: domain.ErrUnmarshal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockRepo, mockBuilder)
+			}
+
+			err := service.HandleProjectUpsert(context.Background(), &mockMessage{
+				subject: constants.ProjectUpsertSubject,
+				data:    []byte(tt.data),
+			})
+
+			switch {
+			case tt.wantErr != nil:
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, domain.IsRetryable(tt.wantErr), domain.IsRetryable(err))
+			case tt.wantInvalid != "":
+				var validationErr *domain.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tt.wantInvalid, validationErr.Fields[0].Path)
+				assert.False(t, domain.IsRetryable(err))
+			default:
+				require.NoError(t, err)
+			}
+			mockRepo.AssertExpectations(t)
+			mockBuilder.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectsService_HandleProjectUpsert_accessCheck(t *testing.T) {
+	service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+	accessChecker := &domain.MockAccessChecker{}
+	service.AccessChecker = accessChecker
+	mockRepo.On("GetProjectUIDFromLegacyID", mock.Anything, "a0941000002wBz4AAE").Return("project-uid-1", nil)
+	mockRepo.On("GetProjectBaseWithRevision", mock.Anything, "project-uid-1").Return(&models.ProjectBase{UID: "project-uid-1", Slug: "test-project", Name: "Test Project"}, uint64(3), nil)
+	mockRepo.On("GetProjectBase", mock.Anything, "project-uid-1").Return(&models.ProjectBase{UID: "project-uid-1", Slug: "test-project", Name: "Test Project"}, nil)
+	mockRepo.On("UpdateProjectBase", mock.Anything, mock.Anything, uint64(3)).Return(nil)
+	mockBuilder.On("SendIndexerMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	err := service.HandleProjectUpsert(context.Background(), &mockMessage{
+		subject: constants.ProjectUpsertSubject,
+		data:    []byte(`{"legacy_id": "a0941000002wBz4AAE", "name": "Renamed Project"}`),
+	})
+
+	require.NoError(t, err)
+	accessChecker.AssertNotCalled(t, "CheckAccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestProjectsService_HandleProjectUpsert_guards(t *testing.T) {
+	dissolved := time.Now().AddDate(-1, 0, 0)
+	tests := []struct {
+		name            string
+		data            string
+		existing        *models.ProjectBase
+		fieldPermission bool
+		wantField       string
+		wantErr         error
+	}{
+		{
+			name:            "restricted fields are not changed",
+			data:            `{"legacy_id": "a0941000002wBz4AAE", "legal_entity_type": "Subsidiary"}`,
+			existing:        &models.ProjectBase{UID: "project-uid-1", Slug: "test-project", Name: "Test Project", LegalEntityType: "Internal Allocation"},
+			fieldPermission: true,
+			wantField:       "legal_entity_type",
+			wantErr:         domain.ErrRestrictedField,
+		},
+		{
+			name:      "legal fields of a dissolved project are not changed",
+			data:      `{"legacy_id": "a0941000002wBz4AAE", "funding": "Funded"}`,
+			existing:  &models.ProjectBase{UID: "project-uid-1", Slug: "test-project", Name: "Test Project", Funding: "Unfunded", EntityDissolutionDate: &dissolved},
+			wantField: "funding",
+			wantErr:   domain.ErrProjectDissolved,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := setupServiceForTesting()
+			service.Config.EnforceFieldPermissions = tt.fieldPermission
+			mockRepo.On("GetProjectUIDFromLegacyID", mock.Anything, "a0941000002wBz4AAE").Return("project-uid-1", nil)
+			mockRepo.On("GetProjectBaseWithRevision", mock.Anything, "project-uid-1").Return(tt.existing, uint64(3), nil)
+			mockRepo.On("GetProjectBase", mock.Anything, "project-uid-1").Return(tt.existing, nil).Maybe()
+
+			err := service.HandleProjectUpsert(context.Background(), &mockMessage{
+				subject: constants.ProjectUpsertSubject,
+				data:    []byte(tt.data),
+			})
+
+			var validationErr *domain.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.wantField, validationErr.Fields[0].Path)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.False(t, domain.IsRetryable(err))
+			mockRepo.AssertNotCalled(t, "UpdateProjectBase", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	indexerConstants "github.com/linuxfoundation/lfx-v2-indexer-service/pkg/constants"
+	indexerTypes "github.com/linuxfoundation/lfx-v2-indexer-service/pkg/types"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"golang.org/x/sync/errgroup"
+)
+
+// maxUserInfoLookups bounds the concurrent user metadata lookups of one project.
+const maxUserInfoLookups = 8
+
+// errUserInfoUnchanged aborts a user info refresh that would not change the settings.
+var errUserInfoUnchanged = errors.New("project user info unchanged")
+
+// refreshUserInfo replaces the name and avatar of every user in settings that
+// has a username with the user's current profile from the auth service, and
+// reports whether anything changed. Emails are left alone, as they are what
+// usernames are resolved from when settings are written.
+//
+// Users whose profile cannot be read keep their stored values; lookup errors
+// are only logged, so that a slow or unavailable auth service does not fail
+// the read.
+func (s *ProjectsService) refreshUserInfo(ctx context.Context, settings *models.ProjectSettings) bool {
+	if settings == nil || s.UserReader == nil {
+		return false
+	}
+
+	byUsername := make(map[string][]*models.UserInfo)
+	gather := func(u *models.UserInfo) {
+		if u == nil || u.Username == "" {
+			return
+		}
+		byUsername[u.Username] = append(byUsername[u.Username], u)
+	}
+	for _, users := range [][]models.UserInfo{settings.Writers, settings.Auditors, settings.MeetingCoordinators} {
+		for i := range users {
+			gather(&users[i])
+		}
+	}
+	gather(settings.ExecutiveDirector)
+	gather(settings.ProgramManager)
+	gather(settings.OpportunityOwner)
+	if len(byUsername) == 0 {
+		return false
+	}
+
+	profiles := make(map[string]*domain.UserMetadata, len(byUsername))
+	var mu sync.Mutex
+	g := new(errgroup.Group)
+	g.SetLimit(maxUserInfoLookups)
+	for username := range byUsername {
+		g.Go(func() error {
+			meta, err := s.UserReader.UserMetadataByPrincipal(ctx, username)
+			if err != nil {
+				slog.WarnContext(ctx, "user metadata lookup failed, keeping stored name and avatar", constants.ErrKey, err)
+				return nil
+			}
+			mu.Lock()
+			profiles[username] = meta
+			mu.Unlock()
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	changed := false
+	for username, users := range byUsername {
+		meta := profiles[username]
+		if meta == nil {
+			continue
+		}
+		for _, u := range users {
+			if meta.Name != "" && u.Name != meta.Name {
+				u.Name = meta.Name
+				changed = true
+			}
+			if meta.Picture != "" && u.Avatar != meta.Picture {
+				u.Avatar = meta.Picture
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+// RefreshProjectsUserInfo stores the current names and avatars of the users of
+// every project whose stored values are stale, and returns the number of
+// projects updated. Each project is re-read and compared again before it is
+// written, so concurrent changes are not overwritten.
+//
+// Only the indexer is told about the new values: a changed display name is
+// not a change to the project's roles, so no access or settings events are sent.
+func (s *ProjectsService) RefreshProjectsUserInfo(ctx context.Context) (int, error) {
+	if !s.ServiceReady() {
+		return 0, domain.ErrServiceUnavailable
+	}
+
+	allSettings, err := s.ProjectRepository.ListAllProjectsSettings(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	updated := 0
+	for _, stored := range allSettings {
+		if ctx.Err() != nil {
+			return updated, ctx.Err()
+		}
+		if !s.refreshUserInfo(ctx, stored) {
+			continue
+		}
+		written, err := s.refreshProjectUserInfo(ctx, stored.UID)
+		if err != nil {
+			slog.WarnContext(ctx, "error refreshing project user info", constants.ErrKey, err, "project_uid", stored.UID)
+			continue
+		}
+		if written {
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+// refreshProjectUserInfo writes the current names and avatars of one project's
+// users and reports whether the settings were written.
+func (s *ProjectsService) refreshProjectUserInfo(ctx context.Context, projectUID string) (bool, error) {
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", projectUID))
+
+	settings, err := s.ProjectRepository.UpdateProjectSettingsWithRetry(ctx, projectUID, func(p *models.ProjectSettings) error {
+		if !s.refreshUserInfo(ctx, p) {
+			return errUserInfoUnchanged
+		}
+		return nil
+	})
+	if errors.Is(err, errUserInfoUnchanged) || errors.Is(err, domain.ErrProjectNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	messages := []outboundMessage{{
+		kind:    models.OutboxKindIndexer,
+		subject: constants.IndexProjectSettingsSubject,
+		message: indexerTypes.IndexerMessageEnvelope{
+			Action:         indexerConstants.ActionUpdated,
+			Data:           *settings,
+			IndexingConfig: settings.IndexingConfig(projectUID),
+		},
+	}}
+	if err := s.publishProjectMessages(ctx, projectUID, false, messages); err != nil {
+		return true, err
+	}
+
+	slog.InfoContext(ctx, "refreshed project user info")
+	return true, nil
+}
+
+// RunUserInfoRefresher calls RefreshProjectsUserInfo every interval until ctx is cancelled.
+func (s *ProjectsService) RunUserInfoRefresher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			updated, err := s.RefreshProjectsUserInfo(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "error refreshing project user info", constants.ErrKey, err)
+				continue
+			}
+			slog.DebugContext(ctx, "refreshed project user info", "projects_updated", updated)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+func TestProjectsService_refreshUserInfo(t *testing.T) {
+	service, _, _, _ := setupServiceForTesting()
+	userReader := service.UserReader.(*domain.MockUserReader)
+	userReader.On("UserMetadataByPrincipal", mock.Anything, "alice").
+		Return(&domain.UserMetadata{Name: "Alice Smith", Picture: "https://example.org/alice.png"}, nil)
+	userReader.On("UserMetadataByPrincipal", mock.Anything, "bob").
+		Return(&domain.UserMetadata{Name: "Bob", Picture: ""}, nil)
+	userReader.On("UserMetadataByPrincipal", mock.Anything, "carol").
+		Return(nil, errors.New("timeout"))
+
+	settings := &models.ProjectSettings{
+		UID:               "project-1",
+		Writers:           []models.UserInfo{{Name: "Alice", Username: "alice", Email: "alice@example.org"}},
+		Auditors:          []models.UserInfo{{Name: "Bob", Username: "bob", Avatar: "https://example.org/bob.png"}, {Name: "Dave", Email: "dave@example.org"}},
+		ExecutiveDirector: &models.UserInfo{Name: "Alice", Username: "alice"},
+		ProgramManager:    &models.UserInfo{Name: "Carol", Username: "carol"},
+	}
+
+	assert.True(t, service.refreshUserInfo(context.Background(), settings))
+
+	assert.Equal(t, models.UserInfo{Name: "Alice Smith", Username: "alice", Email: "alice@example.org", Avatar: "https://example.org/alice.png"}, settings.Writers[0])
+	assert.Equal(t, "Alice Smith", settings.ExecutiveDirector.Name)
+	// An empty profile field does not clear the stored value.
+	assert.Equal(t, "https://example.org/bob.png", settings.Auditors[0].Avatar)
+	// Users without a username and failed lookups keep their stored values.
+	assert.Equal(t, "Dave", settings.Auditors[1].Name)
+	assert.Equal(t, "Carol", settings.ProgramManager.Name)
+	userReader.AssertNumberOfCalls(t, "UserMetadataByPrincipal", 3)
+
+	assert.False(t, service.refreshUserInfo(context.Background(), settings))
+}
+
+func TestProjectsService_RefreshProjectsUserInfo(t *testing.T) {
+	service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+	userReader := service.UserReader.(*domain.MockUserReader)
+	userReader.On("UserMetadataByPrincipal", mock.Anything, "alice").Return(&domain.UserMetadata{Name: "Alice Smith"}, nil)
+	userReader.On("UserMetadataByPrincipal", mock.Anything, "bob").Return(&domain.UserMetadata{Name: "Bob"}, nil)
+
+	stale := &models.ProjectSettings{UID: "project-1", Writers: []models.UserInfo{{Name: "Alice", Username: "alice"}}}
+	current := &models.ProjectSettings{UID: "project-2", Writers: []models.UserInfo{{Name: "Bob", Username: "bob"}}}
+	mockRepo.On("ListAllProjectsSettings", mock.Anything).Return([]*models.ProjectSettings{
+		{UID: "project-1", Writers: []models.UserInfo{{Name: "Alice", Username: "alice"}}},
+		current,
+	}, nil)
+	mockRepo.On("UpdateProjectSettingsWithRetry", mock.Anything, "project-1", mock.Anything).
+		Run(func(args mock.Arguments) {
+			require.NoError(t, args.Get(2).(func(*models.ProjectSettings) error)(stale))
+		}).
+		Return(stale, nil)
+	mockBuilder.On("SendIndexerMessage", mock.Anything, constants.IndexProjectSettingsSubject, mock.Anything, false).Return(nil)
+
+	updated, err := service.RefreshProjectsUserInfo(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, updated)
+	assert.Equal(t, "Alice Smith", stale.Writers[0].Name)
+	mockRepo.AssertNotCalled(t, "UpdateProjectSettingsWithRetry", mock.Anything, "project-2", mock.Anything)
+	mockBuilder.AssertNotCalled(t, "SendAccessMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockBuilder.AssertNotCalled(t, "SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+	mockBuilder.AssertExpectations(t)
+}
+
+func TestProjectsService_RefreshProjectsUserInfo_changedMeanwhile(t *testing.T) {
+	service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+	userReader := service.UserReader.(*domain.MockUserReader)
+	userReader.On("UserMetadataByPrincipal", mock.Anything, "alice").Return(&domain.UserMetadata{Name: "Alice Smith"}, nil)
+
+	mockRepo.On("ListAllProjectsSettings", mock.Anything).Return([]*models.ProjectSettings{
+		{UID: "project-1", Writers: []models.UserInfo{{Name: "Alice", Username: "alice"}}},
+	}, nil)
+	// Another write stored the current name before the refresher re-read the settings.
+	mockRepo.On("UpdateProjectSettingsWithRetry", mock.Anything, "project-1", mock.Anything).
+		Run(func(args mock.Arguments) {
+			err := args.Get(2).(func(*models.ProjectSettings) error)(&models.ProjectSettings{
+				UID: "project-1", Writers: []models.UserInfo{{Name: "Alice Smith", Username: "alice"}},
+			})
+			assert.ErrorIs(t, err, errUserInfoUnchanged)
+		}).
+		Return(nil, errUserInfoUnchanged)
+
+	updated, err := service.RefreshProjectsUserInfo(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, updated)
+	mockBuilder.AssertNotCalled(t, "SendIndexerMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
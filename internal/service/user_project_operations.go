@@ -0,0 +1,237 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	fgaconstants "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/constants"
+	fgatypes "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/types"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// GetUserProjects returns the projects in which the user with username is a
+// writer, auditor or meeting coordinator, ordered by slug. Users may only list
+// their own projects, unless they are LF staff or a trusted service.
+func (s *ProjectsService) GetUserProjects(ctx context.Context, username string) ([]models.UserProject, error) {
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return nil, domain.NewFieldValidationError("username", domain.FieldErrorRequired, domain.ErrValidationFailed)
+	}
+	if err := s.authorizeUser(ctx, username); err != nil {
+		return nil, err
+	}
+
+	return s.listUserProjects(ctx, username)
+}
+
+// HandleUserGetProjects is the message handler for the user-get-projects subject.
+// Request: plain-text username. Reply: JSON-encoded []models.UserProject.
+// Returns an empty JSON array when the user holds no role in any project.
+func (s *ProjectsService) HandleUserGetProjects(ctx context.Context, msg domain.Message) ([]byte, error) {
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS KV store not initialized")
+		return nil, fmt.Errorf("NATS KV store not initialized")
+	}
+
+	username := strings.TrimSpace(string(msg.Data()))
+	ctx = log.AppendCtx(ctx, slog.String("subject", constants.UserGetProjectsSubject))
+
+	if username == "" {
+		return nil, fmt.Errorf("username is required")
+	}
+
+	projects, err := s.listUserProjects(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := json.Marshal(projects)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal user projects: %w", err)
+	}
+
+	return out, nil
+}
+
+// listUserProjects scans the settings of every project for the roles held by
+// the user with username.
+func (s *ProjectsService) listUserProjects(ctx context.Context, username string) ([]models.UserProject, error) {
+	projectsBase, projectsSettings, err := s.ProjectRepository.ListAllProjects(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "error listing projects", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+
+	rolesByProject := make(map[string][]models.ProjectMemberRole)
+	for _, settings := range projectsSettings {
+		for _, role := range models.ProjectMemberRoles {
+			if slices.ContainsFunc(*settings.Members(role), func(u models.UserInfo) bool { return u.Username == username }) {
+				rolesByProject[settings.UID] = append(rolesByProject[settings.UID], role)
+			}
+		}
+	}
+
+	projects := []models.UserProject{}
+	for _, project := range projectsBase {
+		roles, ok := rolesByProject[project.UID]
+		if !ok {
+			continue
+		}
+		projects = append(projects, models.UserProject{
+			ProjectUID: project.UID,
+			Slug:       project.Slug,
+			Name:       project.Name,
+			Roles:      roles,
+		})
+	}
+	slices.SortFunc(projects, func(a, b models.UserProject) int { return strings.Compare(a.Slug, b.Slug) })
+
+	return projects, nil
+}
+
+// RemoveUserAccess removes the user with username from the writers, auditors
+// and meeting coordinators of every project, for employee departures and
+// erasure requests. Only LF staff and trusted services may do so. Each project
+// is updated on its own, re-reading the settings and retrying on concurrent
+// writes; a project that fails does not stop the others. The outcome for each
+// project the user held a role in is returned, ordered by slug.
+func (s *ProjectsService) RemoveUserAccess(ctx context.Context, username string, xSync bool) ([]models.UserAccessRemoval, error) {
+	ctx, span := startSpan(ctx, "ProjectsService.RemoveUserAccess")
+	defer span.End()
+
+	removals, err := s.removeUserAccess(ctx, strings.TrimSpace(username), xSync)
+	recordSpanError(span, err)
+	return removals, err
+}
+
+func (s *ProjectsService) removeUserAccess(ctx context.Context, username string, xSync bool) ([]models.UserAccessRemoval, error) {
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+	if username == "" {
+		return nil, domain.NewFieldValidationError("username", domain.FieldErrorRequired, domain.ErrValidationFailed)
+	}
+	if !s.isStaffOrTrusted(ctx) {
+		principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+		slog.WarnContext(ctx, "principal cannot remove user access", "principal", principal)
+		return nil, domain.ErrForbidden
+	}
+
+	projects, err := s.listUserProjects(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	removals := make([]models.UserAccessRemoval, 0, len(projects))
+	counts := map[string]int{}
+	for _, project := range projects {
+		removal := s.removeUserFromProject(ctx, project, username, xSync)
+		counts[removal.Status]++
+		removals = append(removals, removal)
+	}
+
+	slog.InfoContext(ctx, "removed user access",
+		"updated", counts[models.ProjectMembersChangeUpdated],
+		"unchanged", counts[models.ProjectMembersChangeUnchanged],
+		"not_found", counts[models.ProjectMembersChangeNotFound],
+		"failed", counts[models.ProjectMembersChangeFailed],
+	)
+	return removals, nil
+}
+
+// removeUserFromProject removes the user with username from every member role
+// of one project and publishes the messages that follow, reporting the outcome
+// rather than returning an error. The roles of the outcome are the ones the
+// user held when the settings were updated.
+func (s *ProjectsService) removeUserFromProject(ctx context.Context, project models.UserProject, username string, xSync bool) models.UserAccessRemoval {
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", project.ProjectUID))
+	removal := models.UserAccessRemoval{UserProject: project}
+
+	var previous models.ProjectSettings
+	var removed []models.ProjectMemberRole
+	settings, err := s.ProjectRepository.UpdateProjectSettingsWithRetry(ctx, project.ProjectUID, func(p *models.ProjectSettings) error {
+		updated := *p
+		removed = nil
+		for _, role := range models.ProjectMemberRoles {
+			members := updated.Members(role)
+			remaining := slices.DeleteFunc(slices.Clone(*members), func(u models.UserInfo) bool { return u.Username == username })
+			if len(remaining) != len(*members) {
+				removed = append(removed, role)
+				*members = remaining
+			}
+		}
+		if len(removed) == 0 {
+			return errMemberUnchanged
+		}
+		previous = *p
+		now := time.Now().UTC()
+		updated.UpdatedAt = &now
+		*p = updated
+		return nil
+	})
+	switch {
+	case errors.Is(err, errMemberUnchanged):
+		removal.Status = models.ProjectMembersChangeUnchanged
+		return removal
+	case errors.Is(err, domain.ErrProjectNotFound):
+		removal.Status = models.ProjectMembersChangeNotFound
+		return removal
+	case err != nil:
+		removal.Status = models.ProjectMembersChangeFailed
+		removal.Error = s.projectMemberUpdateError(ctx, err).Error()
+		return removal
+	}
+	removal.Roles = removed
+
+	relations := make([]string, len(removed))
+	for i, role := range removed {
+		relations[i] = string(role)
+	}
+	projectDB, err := s.ProjectRepository.GetProjectBase(ctx, project.ProjectUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error getting project from store", constants.ErrKey, err)
+		removal.Status = models.ProjectMembersChangeFailed
+		removal.Error = domain.ErrInternal.Error()
+		return removal
+	}
+	messages := projectSettingsUpdatedMessages(ctx, projectDB, &previous, settings, outboundMessage{
+		kind:    models.OutboxKindAccess,
+		subject: fgaconstants.GenericMemberRemoveSubject,
+		message: fgatypes.GenericFGAMessage{
+			ObjectType: "project",
+			Operation:  "member_remove",
+			Data: fgatypes.GenericMemberData{
+				UID:       project.ProjectUID,
+				Username:  username,
+				Relations: relations,
+			},
+		},
+	})
+	if err := s.publishProjectMessages(ctx, project.ProjectUID, xSync, messages); err != nil {
+		removal.Status = models.ProjectMembersChangeFailed
+		removal.Error = err.Error()
+		return removal
+	}
+
+	slog.InfoContext(ctx, "removed user from project", "roles", relations)
+	removal.Status = models.ProjectMembersChangeUpdated
+	return removal
+}
@@ -0,0 +1,173 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	fgaconstants "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/constants"
+	fgatypes "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// expectUserProjects stores three projects, two of which alice holds roles in.
+func expectUserProjects(repo *domain.MockProjectRepository) {
+	alice := models.UserInfo{Username: "alice", Email: "alice@example.org"}
+	bob := models.UserInfo{Username: "bob", Email: "bob@example.org"}
+	repo.On("ListAllProjects", mock.Anything).Return(
+		[]*models.ProjectBase{
+			{UID: "project-1", Slug: "zeta", Name: "Zeta"},
+			{UID: "project-2", Slug: "alpha", Name: "Alpha"},
+			{UID: "project-3", Slug: "beta", Name: "Beta"},
+		},
+		[]*models.ProjectSettings{
+			{UID: "project-1", Writers: []models.UserInfo{alice}, MeetingCoordinators: []models.UserInfo{alice, bob}},
+			{UID: "project-2", Auditors: []models.UserInfo{bob, alice}},
+			{UID: "project-3", Writers: []models.UserInfo{bob}},
+		},
+		nil,
+	)
+}
+
+func TestProjectsService_GetUserProjects(t *testing.T) {
+	aliceProjects := []models.UserProject{
+		{ProjectUID: "project-2", Slug: "alpha", Name: "Alpha", Roles: []models.ProjectMemberRole{models.ProjectMemberRoleAuditor}},
+		{ProjectUID: "project-1", Slug: "zeta", Name: "Zeta", Roles: []models.ProjectMemberRole{models.ProjectMemberRoleWriter, models.ProjectMemberRoleMeetingCoordinator}},
+	}
+
+	tests := []struct {
+		name        string
+		principal   string
+		roles       []string
+		username    string
+		expected    []models.UserProject
+		expectedErr error
+	}{
+		{
+			name:      "own projects",
+			principal: "alice",
+			username:  "alice",
+			expected:  aliceProjects,
+		},
+		{
+			name:      "staff may list another user's projects",
+			principal: "staff-user",
+			roles:     []string{domain.RoleStaff},
+			username:  "alice",
+			expected:  aliceProjects,
+		},
+		{
+			name:      "user without roles",
+			principal: "carol",
+			username:  "carol",
+			expected:  []models.UserProject{},
+		},
+		{
+			name:        "another user's projects",
+			principal:   "bob",
+			username:    "alice",
+			expectedErr: domain.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, _, _ := setupServiceForTesting()
+			if tt.expectedErr == nil {
+				expectUserProjects(mockRepo)
+			}
+			ctx := context.WithValue(context.Background(), constants.PrincipalContextID, tt.principal)
+			ctx = context.WithValue(ctx, constants.RolesContextID, tt.roles)
+
+			projects, err := service.GetUserProjects(ctx, tt.username)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, projects)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectsService_HandleUserGetProjects(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	expectUserProjects(mockRepo)
+
+	response, err := service.HandleUserGetProjects(context.Background(), domain.NewMockMessage([]byte("bob"), constants.UserGetProjectsSubject))
+
+	require.NoError(t, err)
+	var projects []models.UserProject
+	require.NoError(t, json.Unmarshal(response, &projects))
+	require.Len(t, projects, 3)
+	assert.Equal(t, "alpha", projects[0].Slug)
+	assert.Equal(t, []models.ProjectMemberRole{models.ProjectMemberRoleAuditor}, projects[0].Roles)
+	assert.Equal(t, []models.ProjectMemberRole{models.ProjectMemberRoleMeetingCoordinator}, projects[2].Roles)
+}
+
+func TestProjectsService_RemoveUserAccess(t *testing.T) {
+	alice := models.UserInfo{Username: "alice"}
+	bob := models.UserInfo{Username: "bob"}
+	stored := &models.ProjectSettings{UID: "project-1", Writers: []models.UserInfo{alice}, MeetingCoordinators: []models.UserInfo{alice, bob}}
+
+	service, mockRepo, mockBuilder, _ := setupServiceForTesting()
+	expectUserProjects(mockRepo)
+	mockRepo.On("UpdateProjectSettingsWithRetry", mock.Anything, "project-1", mock.Anything).
+		Run(func(args mock.Arguments) {
+			_ = args.Get(2).(func(*models.ProjectSettings) error)(stored)
+		}).
+		Return(stored, nil)
+	mockRepo.On("GetProjectBase", mock.Anything, "project-1").Return(&models.ProjectBase{UID: "project-1", Slug: "zeta"}, nil)
+	mockRepo.On("UpdateProjectSettingsWithRetry", mock.Anything, "project-2", mock.Anything).Return(nil, domain.ErrRevisionMismatch)
+	mockBuilder.On("SendIndexerMessage", mock.Anything, constants.IndexProjectSettingsSubject, mock.Anything, false).Return(nil)
+	mockBuilder.On("SendAccessMessage", mock.Anything, fgaconstants.GenericMemberRemoveSubject, mock.Anything, false).Return(nil)
+	mockBuilder.On("SendProjectEventMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	removals, err := service.RemoveUserAccess(staffContext(), " alice ", false)
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.UserAccessRemoval{
+		{
+			UserProject: models.UserProject{ProjectUID: "project-2", Slug: "alpha", Name: "Alpha", Roles: []models.ProjectMemberRole{models.ProjectMemberRoleAuditor}},
+			Status:      models.ProjectMembersChangeFailed,
+			Error:       domain.ErrRevisionMismatch.Error(),
+		},
+		{
+			UserProject: models.UserProject{ProjectUID: "project-1", Slug: "zeta", Name: "Zeta", Roles: []models.ProjectMemberRole{models.ProjectMemberRoleWriter, models.ProjectMemberRoleMeetingCoordinator}},
+			Status:      models.ProjectMembersChangeUpdated,
+		},
+	}, removals)
+	assert.Empty(t, stored.Writers)
+	assert.Equal(t, []models.UserInfo{bob}, stored.MeetingCoordinators)
+	mockBuilder.AssertCalled(t, "SendAccessMessage", mock.Anything, fgaconstants.GenericMemberRemoveSubject, fgatypes.GenericFGAMessage{
+		ObjectType: "project",
+		Operation:  "member_remove",
+		Data: fgatypes.GenericMemberData{
+			UID:       "project-1",
+			Username:  "alice",
+			Relations: []string{"writer", "meeting_coordinator"},
+		},
+	}, false)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestProjectsService_RemoveUserAccess_forbidden(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "alice")
+
+	_, err := service.RemoveUserAccess(ctx, "alice", false)
+
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	mockRepo.AssertNotCalled(t, "ListAllProjects", mock.Anything)
+}
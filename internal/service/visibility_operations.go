@@ -0,0 +1,184 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+)
+
+// GetProjectVisibilityImpact reports what flipping the project's public flag
+// would affect: its descendants, its URL fields pointing outside the service,
+// and the associated resources and webhooks that follow it. Nothing is changed.
+func (s *ProjectsService) GetProjectVisibilityImpact(ctx context.Context, projectUID string) (*models.ProjectVisibilityImpact, error) {
+	ctx, span := startSpan(ctx, "ProjectsService.GetProjectVisibilityImpact", projectUIDAttr(projectUID))
+	defer span.End()
+
+	impact, err := s.getProjectVisibilityImpact(ctx, projectUID)
+	recordSpanError(span, err)
+	return impact, err
+}
+
+func (s *ProjectsService) getProjectVisibilityImpact(ctx context.Context, projectUID string) (*models.ProjectVisibilityImpact, error) {
+	if !s.ServiceReady() {
+		slog.ErrorContext(ctx, "NATS connection or store not initialized")
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeProject(ctx, projectUID, relationWriter); err != nil {
+		return nil, err
+	}
+
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", projectUID))
+
+	project, err := s.ProjectRepository.GetProjectBase(ctx, projectUID)
+	if err != nil {
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			return nil, domain.ErrProjectNotFound
+		}
+		slog.ErrorContext(ctx, "error getting project from store", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+	projects, err := s.ProjectRepository.ListAllProjectsBase(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "error listing projects for visibility impact", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+
+	impact := &models.ProjectVisibilityImpact{
+		ProjectUID:         projectUID,
+		Public:             project.Public,
+		TargetPublic:       !project.Public,
+		Descendants:        descendantProjects(projects, projectUID),
+		ExternalReferences: externalReferences(project),
+	}
+	for _, descendant := range impact.Descendants {
+		if descendant.Public != impact.TargetPublic {
+			impact.MismatchedDescendants++
+		}
+	}
+
+	subscribers, err := s.visibilitySubscribers(ctx, projectUID)
+	if err != nil {
+		return nil, err
+	}
+	impact.Subscribers = subscribers
+	return impact, nil
+}
+
+// descendantProjects returns the projects under projectUID, ordered by slug.
+func descendantProjects(projects []*models.ProjectBase, projectUID string) []models.VisibilityImpactProject {
+	descendants := []models.VisibilityImpactProject{}
+	for _, descendant := range descendantBases(projects, projectUID) {
+		descendants = append(descendants, models.VisibilityImpactProject{
+			UID:       descendant.UID,
+			Slug:      descendant.Slug,
+			Name:      descendant.Name,
+			ParentUID: descendant.ParentUID,
+			Public:    descendant.Public,
+		})
+	}
+	sort.Slice(descendants, func(i, j int) bool { return descendants[i].Slug < descendants[j].Slug })
+	return descendants
+}
+
+// descendantBases returns the projects under projectUID, at any depth, breadth first.
+func descendantBases(projects []*models.ProjectBase, projectUID string) []*models.ProjectBase {
+	children := map[string][]*models.ProjectBase{}
+	for _, project := range projects {
+		if project.ParentUID != "" {
+			children[project.ParentUID] = append(children[project.ParentUID], project)
+		}
+	}
+
+	descendants := []*models.ProjectBase{}
+	// seen guards against a cycle left behind in the stored hierarchy.
+	seen := map[string]bool{projectUID: true}
+	queue := []string{projectUID}
+	for len(queue) > 0 {
+		uid := queue[0]
+		queue = queue[1:]
+		for _, child := range children[uid] {
+			if seen[child.UID] {
+				continue
+			}
+			seen[child.UID] = true
+			queue = append(queue, child.UID)
+			descendants = append(descendants, child)
+		}
+	}
+	return descendants
+}
+
+// externalReferences returns the URL fields of the project that are set,
+// including its links.
+func externalReferences(project *models.ProjectBase) []models.ExternalReference {
+	links := project.Links
+	if links == nil {
+		links = &models.ProjectLinks{}
+	}
+	references := []models.ExternalReference{}
+	for _, field := range []models.ExternalReference{
+		{Field: "website_url", URL: project.WebsiteURL},
+		{Field: "repository_url", URL: project.RepositoryURL},
+		{Field: "charter_url", URL: project.CharterURL},
+		{Field: "logo_url", URL: project.LogoURL},
+		{Field: "entity_formation_document_url", URL: project.EntityFormationDocumentURL},
+		{Field: "links.twitter", URL: links.Twitter},
+		{Field: "links.linkedin", URL: links.LinkedIn},
+		{Field: "links.youtube", URL: links.YouTube},
+		{Field: "links.slack", URL: links.Slack},
+		{Field: "links.discord", URL: links.Discord},
+		{Field: "links.mailing_list", URL: links.MailingList},
+	} {
+		if field.URL != "" {
+			references = append(references, field)
+		}
+	}
+	return references
+}
+
+// visibilitySubscribers counts the committees, mailing lists and meeting
+// series associated with the project, and the webhooks that receive its
+// events. It returns nil when neither associations nor webhooks are enabled.
+func (s *ProjectsService) visibilitySubscribers(ctx context.Context, projectUID string) (*models.VisibilitySubscribers, error) {
+	if s.AssociationRepository == nil && s.WebhookRepository == nil {
+		return nil, nil
+	}
+
+	subscribers := &models.VisibilitySubscribers{}
+	if s.AssociationRepository != nil {
+		associations, err := s.AssociationRepository.GetProjectAssociations(ctx, projectUID)
+		if err != nil {
+			slog.ErrorContext(ctx, "error getting project associations", constants.ErrKey, err)
+			return nil, domain.ErrInternal
+		}
+		committees, mailingLists, meetingSeries := len(associations.CommitteeUIDs), len(associations.MailingListIDs), len(associations.MeetingSeriesIDs)
+		subscribers.Committees = &committees
+		subscribers.MailingLists = &mailingLists
+		subscribers.MeetingSeries = &meetingSeries
+	}
+	if s.WebhookRepository != nil {
+		webhooks, err := s.WebhookRepository.ListWebhooks(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "error listing webhooks", constants.ErrKey, err)
+			return nil, domain.ErrInternal
+		}
+		count := 0
+		for _, webhook := range webhooks {
+			if webhook.Matches(string(events.ProjectUpdated), projectUID) {
+				count++
+			}
+		}
+		subscribers.Webhooks = &count
+	}
+	return subscribers, nil
+}
@@ -0,0 +1,84 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+)
+
+func TestProjectsService_GetProjectVisibilityImpact(t *testing.T) {
+	root := &models.ProjectBase{UID: "root", Slug: "root", Name: "Root", Public: true, WebsiteURL: "https://root.org", CharterURL: "https://root.org/charter.pdf"}
+	projects := []*models.ProjectBase{
+		root,
+		{UID: "b", Slug: "b", Name: "B", ParentUID: "root", Public: true},
+		{UID: "a", Slug: "a", Name: "A", ParentUID: "root"},
+		{UID: "c", Slug: "c", Name: "C", ParentUID: "b", Public: true},
+		{UID: "other", Slug: "other", Name: "Other", Public: true},
+	}
+
+	service, mockRepo, _, _ := setupServiceForTesting()
+	associations := &domain.MockAssociationRepository{}
+	webhooks := &domain.MockWebhookRepository{}
+	service.AssociationRepository = associations
+	service.WebhookRepository = webhooks
+	mockRepo.On("GetProjectBase", mock.Anything, "root").Return(root, nil).Once()
+	mockRepo.On("ListAllProjectsBase", mock.Anything).Return(projects, nil).Once()
+	associations.On("GetProjectAssociations", mock.Anything, "root").Return(&models.ProjectAssociations{
+		ProjectUID:     "root",
+		CommitteeUIDs:  []string{"c1", "c2"},
+		MailingListIDs: []string{"m1"},
+	}, nil).Once()
+	webhooks.On("ListWebhooks", mock.Anything).Return([]*models.Webhook{
+		{ID: "all"},
+		{ID: "root", ProjectUID: "root", Events: []string{"project.updated"}},
+		{ID: "other", ProjectUID: "other"},
+		{ID: "created", ProjectUID: "root", Events: []string{"project.created"}},
+	}, nil).Once()
+
+	impact, err := service.GetProjectVisibilityImpact(context.Background(), "root")
+
+	require.NoError(t, err)
+	two, one, zero := 2, 1, 0
+	assert.Equal(t, &models.ProjectVisibilityImpact{
+		ProjectUID:   "root",
+		Public:       true,
+		TargetPublic: false,
+		Descendants: []models.VisibilityImpactProject{
+			{UID: "a", Slug: "a", Name: "A", ParentUID: "root"},
+			{UID: "b", Slug: "b", Name: "B", ParentUID: "root", Public: true},
+			{UID: "c", Slug: "c", Name: "C", ParentUID: "b", Public: true},
+		},
+		MismatchedDescendants: 2,
+		ExternalReferences: []models.ExternalReference{
+			{Field: "website_url", URL: "https://root.org"},
+			{Field: "charter_url", URL: "https://root.org/charter.pdf"},
+		},
+		Subscribers: &models.VisibilitySubscribers{
+			Committees:    &two,
+			MailingLists:  &one,
+			MeetingSeries: &zero,
+			Webhooks:      &two,
+		},
+	}, impact)
+	mockRepo.AssertExpectations(t)
+	associations.AssertExpectations(t)
+	webhooks.AssertExpectations(t)
+}
+
+func TestProjectsService_GetProjectVisibilityImpact_notFound(t *testing.T) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	mockRepo.On("GetProjectBase", mock.Anything, "missing").Return(nil, domain.ErrProjectNotFound).Once()
+
+	_, err := service.GetProjectVisibilityImpact(context.Background(), "missing")
+
+	assert.ErrorIs(t, err, domain.ErrProjectNotFound)
+}
@@ -0,0 +1,397 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/log"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// webhookBaseRetryDelay is the delay before the first retry of a failed webhook delivery.
+	webhookBaseRetryDelay = 30 * time.Second
+	// webhookMaxRetryDelay caps the exponential backoff between webhook delivery retries.
+	webhookMaxRetryDelay = time.Hour
+	// webhookClaimTimeout is how long a delivery being sent is left alone by the
+	// webhook dispatchers of every replica. It must exceed the send timeout, or
+	// a slow endpoint would receive the delivery again while it is being sent.
+	webhookClaimTimeout = time.Minute
+	// maxConcurrentWebhookDeliveries bounds the deliveries of one event sent at once.
+	maxConcurrentWebhookDeliveries = 4
+)
+
+// webhookEventTypes are the lifecycle event types webhooks can receive.
+var webhookEventTypes = []string{
+	string(events.ProjectCreated),
+	string(events.ProjectUpdated),
+	string(events.ProjectDeleted),
+	string(events.ProjectSettingsUpdated),
+	string(events.ProjectAnnounced),
+	string(events.ProjectLegalChanged),
+	string(events.ProjectMerged),
+	string(events.ProjectTransferred),
+}
+
+// webhooksEnabled reports whether webhooks are configured.
+func (s *ProjectsService) webhooksEnabled() bool {
+	return s.WebhookRepository != nil && s.WebhookSender != nil
+}
+
+// CreateWebhook registers a webhook for the lifecycle events of one project,
+// or of every project when its ProjectUID is empty. The ID, signing secret and
+// creation fields are filled in; the secret is only ever returned here. Only
+// LF staff and trusted service principals may manage webhooks.
+func (s *ProjectsService) CreateWebhook(ctx context.Context, webhook *models.Webhook) (*models.Webhook, error) {
+	if !s.ServiceReady() || !s.webhooksEnabled() {
+		slog.ErrorContext(ctx, "service not ready or webhooks not configured")
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "create webhook"); err != nil {
+		return nil, err
+	}
+
+	ctx, span := startSpan(ctx, "ProjectsService.CreateWebhook")
+	defer span.End()
+
+	if err := s.validateWebhook(ctx, webhook); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		slog.ErrorContext(ctx, "error generating webhook secret", constants.ErrKey, err)
+		return nil, domain.ErrInternal
+	}
+
+	principal, _ := ctx.Value(constants.PrincipalContextID).(string)
+	webhook.ID = uuid.NewString()
+	webhook.Secret = hex.EncodeToString(secret)
+	webhook.CreatedBy = principal
+	webhook.CreatedAt = time.Now().UTC()
+	if err := s.WebhookRepository.CreateWebhook(ctx, webhook); err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "created webhook", "webhook_id", webhook.ID, "project_uid", webhook.ProjectUID, "events", webhook.Events)
+	return webhook, nil
+}
+
+// validateWebhook checks the fields of a webhook to be registered and removes
+// duplicate event types.
+func (s *ProjectsService) validateWebhook(ctx context.Context, webhook *models.Webhook) error {
+	validation := &domain.ValidationError{}
+
+	u, err := url.Parse(webhook.URL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		validation.Add("url", domain.FieldErrorInvalid, domain.ErrInvalidWebhookURL)
+	}
+
+	slices.Sort(webhook.Events)
+	webhook.Events = slices.Compact(webhook.Events)
+	for _, eventType := range webhook.Events {
+		if !slices.Contains(webhookEventTypes, eventType) {
+			validation.Add("events", domain.FieldErrorInvalid, domain.ErrInvalidWebhookEvent)
+			break
+		}
+	}
+
+	webhook.ProjectUID = strings.TrimSpace(webhook.ProjectUID)
+	if webhook.ProjectUID != "" {
+		exists, err := s.ProjectRepository.ProjectExists(ctx, webhook.ProjectUID)
+		if err != nil {
+			slog.ErrorContext(ctx, "error checking if project exists", constants.ErrKey, err)
+			return domain.ErrInternal
+		}
+		if !exists {
+			validation.Add("project_uid", domain.FieldErrorNotFound, domain.ErrProjectNotFound)
+		}
+	}
+
+	return validation.Err()
+}
+
+// GetWebhook returns a registered webhook.
+func (s *ProjectsService) GetWebhook(ctx context.Context, webhookID string) (*models.Webhook, error) {
+	if !s.webhooksEnabled() {
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "get webhook"); err != nil {
+		return nil, err
+	}
+	return s.WebhookRepository.GetWebhook(ctx, webhookID)
+}
+
+// ListWebhooks returns every registered webhook, oldest first.
+func (s *ProjectsService) ListWebhooks(ctx context.Context) ([]*models.Webhook, error) {
+	if !s.webhooksEnabled() {
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "list webhooks"); err != nil {
+		return nil, err
+	}
+	return s.WebhookRepository.ListWebhooks(ctx)
+}
+
+// DeleteWebhook removes a webhook and its deliveries. Deliveries still being
+// retried are not sent again.
+func (s *ProjectsService) DeleteWebhook(ctx context.Context, webhookID string) error {
+	if !s.webhooksEnabled() {
+		return domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "delete webhook"); err != nil {
+		return err
+	}
+	if err := s.WebhookRepository.DeleteWebhook(ctx, webhookID); err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "deleted webhook", "webhook_id", webhookID)
+	return nil
+}
+
+// ListWebhookDeliveries returns the deliveries of a webhook, newest first.
+func (s *ProjectsService) ListWebhookDeliveries(ctx context.Context, webhookID string) ([]*models.WebhookDelivery, error) {
+	if !s.webhooksEnabled() {
+		return nil, domain.ErrServiceUnavailable
+	}
+	if err := s.authorizeAdmin(ctx, "list webhook deliveries"); err != nil {
+		return nil, err
+	}
+	if _, err := s.WebhookRepository.GetWebhook(ctx, webhookID); err != nil {
+		return nil, err
+	}
+	return s.WebhookRepository.ListWebhookDeliveries(ctx, webhookID)
+}
+
+// HandleProjectWebhookEvent records a delivery of a project lifecycle event to
+// every webhook registered for it, and sends each one. A delivery that fails is
+// left for the webhook dispatcher to retry; only failing to record the
+// deliveries fails the event, so that it is dead-lettered and can be replayed.
+// A replayed event is not delivered twice, as deliveries are keyed by the event
+// ID, and a delivery is recorded as claimed so that no dispatcher sends it while
+// it is being sent here.
+func (s *ProjectsService) HandleProjectWebhookEvent(ctx context.Context, msg domain.Message) error {
+	if !s.webhooksEnabled() {
+		return nil
+	}
+
+	var event events.ProjectLifecycleEvent
+	if err := json.Unmarshal(msg.Data(), &event); err != nil {
+		slog.WarnContext(ctx, "webhooks: failed to unmarshal project event", constants.ErrKey, err)
+		return nil
+	}
+	if event.ID == "" {
+		slog.WarnContext(ctx, "webhooks: project event without an ID", "subject", msg.Subject())
+		return nil
+	}
+
+	ctx, span := startSpan(ctx, "ProjectsService.HandleProjectWebhookEvent", projectUIDAttr(event.ProjectUID),
+		attribute.String("event_type", string(event.Type)))
+	defer span.End()
+	ctx = log.AppendCtx(ctx, slog.String("project_uid", event.ProjectUID))
+
+	webhooks, err := s.WebhookRepository.ListWebhooks(ctx)
+	if err != nil {
+		recordSpanError(span, err)
+		return err
+	}
+
+	g := new(errgroup.Group)
+	g.SetLimit(maxConcurrentWebhookDeliveries)
+	var recordErr error
+	for _, webhook := range webhooks {
+		if !webhook.Matches(string(event.Type), event.ProjectUID) {
+			continue
+		}
+		now := time.Now().UTC()
+		delivery := &models.WebhookDelivery{
+			ID:            event.ID,
+			WebhookID:     webhook.ID,
+			EventType:     string(event.Type),
+			ProjectUID:    event.ProjectUID,
+			Payload:       msg.Data(),
+			Status:        models.WebhookDeliveryPending,
+			CreatedAt:     now,
+			NextAttemptAt: now.Add(webhookClaimTimeout),
+		}
+		if err := s.WebhookRepository.CreateWebhookDelivery(ctx, delivery); err != nil {
+			if errors.Is(err, domain.ErrWebhookDeliveryExists) {
+				continue
+			}
+			recordErr = errors.Join(recordErr, err)
+			continue
+		}
+		g.Go(func() error {
+			s.deliverWebhook(ctx, webhook, delivery)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	recordSpanError(span, recordErr)
+	return recordErr
+}
+
+// deliverWebhook sends a claimed delivery and records the outcome: delivered,
+// scheduled for a retry, or failed once MaxWebhookDeliveryAttempts is reached.
+// The outcome is only recorded at the revision the delivery was claimed at.
+func (s *ProjectsService) deliverWebhook(ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery) {
+	status, sendErr := s.WebhookSender.SendWebhook(ctx, webhook, delivery)
+
+	now := time.Now().UTC()
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.ResponseStatus = status
+	switch {
+	case sendErr == nil:
+		delivery.Status = models.WebhookDeliveryDelivered
+		delivery.DeliveredAt = &now
+		delivery.LastError = ""
+	case delivery.Attempts >= models.MaxWebhookDeliveryAttempts:
+		delivery.Status = models.WebhookDeliveryFailed
+		delivery.LastError = sendErr.Error()
+		slog.WarnContext(ctx, "webhook delivery failed, giving up", constants.ErrKey, sendErr,
+			"webhook_id", webhook.ID,
+			"delivery_id", delivery.ID,
+			"attempts", delivery.Attempts,
+		)
+	default:
+		delivery.LastError = sendErr.Error()
+		delivery.NextAttemptAt = now.Add(webhookRetryDelay(delivery.Attempts))
+		slog.WarnContext(ctx, "webhook delivery failed, will retry", constants.ErrKey, sendErr,
+			"webhook_id", webhook.ID,
+			"delivery_id", delivery.ID,
+			"attempts", delivery.Attempts,
+			"next_attempt_at", delivery.NextAttemptAt,
+		)
+	}
+
+	err := s.WebhookRepository.UpdateWebhookDelivery(ctx, delivery)
+	switch {
+	case errors.Is(err, domain.ErrRevisionMismatch):
+		slog.WarnContext(ctx, "webhook delivery claimed elsewhere while being sent, attempt not recorded",
+			"webhook_id", webhook.ID,
+			"delivery_id", delivery.ID,
+		)
+	case err != nil:
+		slog.ErrorContext(ctx, "error recording webhook delivery attempt", constants.ErrKey, err,
+			"webhook_id", webhook.ID,
+			"delivery_id", delivery.ID,
+		)
+	}
+}
+
+// claimWebhookDelivery moves the next attempt of a due delivery past
+// webhookClaimTimeout at the revision it was listed at, so that the dispatchers
+// of other replicas leave it alone while it is sent. It reports whether the
+// delivery was claimed; one claimed or settled elsewhere since it was listed
+// is not.
+func (s *ProjectsService) claimWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) bool {
+	delivery.NextAttemptAt = time.Now().UTC().Add(webhookClaimTimeout)
+	err := s.WebhookRepository.UpdateWebhookDelivery(ctx, delivery)
+	switch {
+	case errors.Is(err, domain.ErrRevisionMismatch):
+		slog.DebugContext(ctx, "webhook delivery claimed elsewhere", "webhook_id", delivery.WebhookID, "delivery_id", delivery.ID)
+		return false
+	case err != nil:
+		slog.ErrorContext(ctx, "error claiming webhook delivery", constants.ErrKey, err, "webhook_id", delivery.WebhookID, "delivery_id", delivery.ID)
+		return false
+	}
+	return true
+}
+
+// DispatchWebhooks sends every pending webhook delivery that is due for a retry.
+// Each delivery is claimed before it is sent, so that the dispatchers of several
+// replicas do not send it more than once.
+func (s *ProjectsService) DispatchWebhooks(ctx context.Context) error {
+	if !s.webhooksEnabled() {
+		return domain.ErrServiceUnavailable
+	}
+
+	ctx, span := startSpan(ctx, "ProjectsService.DispatchWebhooks")
+	defer span.End()
+
+	deliveries, err := s.WebhookRepository.ListWebhookDeliveries(ctx, "")
+	if err != nil {
+		recordSpanError(span, err)
+		return err
+	}
+
+	webhooks := make(map[string]*models.Webhook)
+	now := time.Now()
+	attempted := 0
+	for _, delivery := range deliveries {
+		if !delivery.Due(now) {
+			continue
+		}
+		webhook, ok := webhooks[delivery.WebhookID]
+		if !ok {
+			webhook, err = s.WebhookRepository.GetWebhook(ctx, delivery.WebhookID)
+			if err != nil && !errors.Is(err, domain.ErrWebhookNotFound) {
+				recordSpanError(span, err)
+				return err
+			}
+			webhooks[delivery.WebhookID] = webhook
+		}
+		if webhook == nil {
+			// Deleted along with its deliveries since they were listed.
+			continue
+		}
+		if !s.claimWebhookDelivery(ctx, delivery) {
+			continue
+		}
+		s.deliverWebhook(ctx, webhook, delivery)
+		attempted++
+	}
+
+	if attempted > 0 {
+		slog.InfoContext(ctx, "dispatched webhook deliveries", "attempted", attempted)
+	}
+	return nil
+}
+
+// RunWebhookDispatcher calls DispatchWebhooks every interval until ctx is cancelled.
+func (s *ProjectsService) RunWebhookDispatcher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.DispatchWebhooks(ctx); err != nil {
+				slog.ErrorContext(ctx, "error dispatching webhook deliveries", constants.ErrKey, err)
+			}
+		}
+	}
+}
+
+// webhookRetryDelay returns the backoff before the next attempt after the given
+// number of failed attempts.
+func webhookRetryDelay(attempts int) time.Duration {
+	delay := webhookBaseRetryDelay
+	for i := 1; i < attempts && delay < webhookMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, webhookMaxRetryDelay)
+}
@@ -0,0 +1,242 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/domain/models"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/events"
+)
+
+func setupWebhookServiceForTesting() (*ProjectsService, *domain.MockProjectRepository, *domain.MockWebhookRepository, *domain.MockWebhookSender) {
+	service, mockRepo, _, _ := setupServiceForTesting()
+	webhookRepo := &domain.MockWebhookRepository{}
+	sender := &domain.MockWebhookSender{}
+	service.WebhookRepository = webhookRepo
+	service.WebhookSender = sender
+	return service, mockRepo, webhookRepo, sender
+}
+
+func TestProjectsService_CreateWebhook(t *testing.T) {
+	tests := []struct {
+		name           string
+		webhook        *models.Webhook
+		setupMocks     func(*domain.MockProjectRepository, *domain.MockWebhookRepository)
+		expectedEvents []string
+		expectedFields []string
+	}{
+		{
+			name:    "registers a project webhook",
+			webhook: &models.Webhook{URL: "https://example.org/hook", ProjectUID: "project-1", Events: []string{"project.updated", "project.created", "project.updated"}},
+			setupMocks: func(repo *domain.MockProjectRepository, webhooks *domain.MockWebhookRepository) {
+				repo.On("ProjectExists", mock.Anything, "project-1").Return(true, nil)
+				webhooks.On("CreateWebhook", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedEvents: []string{"project.created", "project.updated"},
+		},
+		{
+			name:    "registers a global webhook for every event",
+			webhook: &models.Webhook{URL: "https://example.org/hook"},
+			setupMocks: func(_ *domain.MockProjectRepository, webhooks *domain.MockWebhookRepository) {
+				webhooks.On("CreateWebhook", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedEvents: []string{},
+		},
+		{
+			name:           "rejects an http URL and unknown events",
+			webhook:        &models.Webhook{URL: "http://example.org/hook", Events: []string{"project.renamed"}},
+			expectedFields: []string{"url", "events"},
+		},
+		{
+			name:    "rejects an unknown project",
+			webhook: &models.Webhook{URL: "https://example.org/hook", ProjectUID: "missing"},
+			setupMocks: func(repo *domain.MockProjectRepository, _ *domain.MockWebhookRepository) {
+				repo.On("ProjectExists", mock.Anything, "missing").Return(false, nil)
+			},
+			expectedFields: []string{"project_uid"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo, webhookRepo, _ := setupWebhookServiceForTesting()
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockRepo, webhookRepo)
+			}
+
+			webhook, err := service.CreateWebhook(staffContext(), tt.webhook)
+
+			if tt.expectedFields != nil {
+				var validationErr *domain.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				var fields []string
+				for _, field := range validationErr.Fields {
+					fields = append(fields, field.Path)
+				}
+				assert.Equal(t, tt.expectedFields, fields)
+				webhookRepo.AssertNotCalled(t, "CreateWebhook", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, webhook.ID)
+			assert.Equal(t, "staff-user", webhook.CreatedBy)
+			assert.Len(t, webhook.Secret, 64)
+			assert.False(t, webhook.CreatedAt.IsZero())
+			assert.ElementsMatch(t, tt.expectedEvents, webhook.Events)
+			mockRepo.AssertExpectations(t)
+			webhookRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectsService_Webhooks_forbidden(t *testing.T) {
+	service, _, webhookRepo, _ := setupWebhookServiceForTesting()
+	ctx := context.WithValue(context.Background(), constants.PrincipalContextID, "user-1")
+
+	_, err := service.CreateWebhook(ctx, &models.Webhook{URL: "https://example.org/hook"})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = service.ListWebhooks(ctx)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = service.GetWebhook(ctx, "hook-1")
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	assert.ErrorIs(t, service.DeleteWebhook(ctx, "hook-1"), domain.ErrForbidden)
+	_, err = service.ListWebhookDeliveries(ctx, "hook-1")
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	webhookRepo.AssertNotCalled(t, "CreateWebhook", mock.Anything, mock.Anything)
+	webhookRepo.AssertNotCalled(t, "DeleteWebhook", mock.Anything, mock.Anything)
+}
+
+func TestProjectsService_HandleProjectWebhookEvent(t *testing.T) {
+	event := events.ProjectLifecycleEvent{ID: "event-1", Type: events.ProjectUpdated, ProjectUID: "project-1"}
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	accepting := &models.Webhook{ID: "hook-1", URL: "https://example.org/a"}
+	failing := &models.Webhook{ID: "hook-2", URL: "https://example.org/b", Events: []string{"project.updated"}}
+	replayed := &models.Webhook{ID: "hook-3", URL: "https://example.org/c", ProjectUID: "project-1"}
+	otherProject := &models.Webhook{ID: "hook-4", URL: "https://example.org/d", ProjectUID: "project-2"}
+	otherEvent := &models.Webhook{ID: "hook-5", URL: "https://example.org/e", Events: []string{"project.deleted"}}
+
+	service, _, webhookRepo, sender := setupWebhookServiceForTesting()
+	webhookRepo.On("ListWebhooks", mock.Anything).Return([]*models.Webhook{accepting, failing, replayed, otherProject, otherEvent}, nil)
+	webhookRepo.On("CreateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *models.WebhookDelivery) bool {
+		return d.WebhookID == "hook-3"
+	})).Return(domain.ErrWebhookDeliveryExists)
+	webhookRepo.On("CreateWebhookDelivery", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			// Claimed by the handler: dispatchers must not pick it up while it is sent.
+			delivery := args.Get(1).(*models.WebhookDelivery)
+			assert.True(t, delivery.NextAttemptAt.After(time.Now()))
+		}).
+		Return(nil)
+	sender.On("SendWebhook", mock.Anything, accepting, mock.Anything).Return(200, nil)
+	sender.On("SendWebhook", mock.Anything, failing, mock.Anything).Return(503, errors.New("unavailable"))
+
+	var updated []*models.WebhookDelivery
+	webhookRepo.On("UpdateWebhookDelivery", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			updated = append(updated, args.Get(1).(*models.WebhookDelivery))
+		}).
+		Return(nil)
+
+	err = service.HandleProjectWebhookEvent(context.Background(), domain.NewMockMessage(data, projectEventSubject(events.ProjectUpdated)))
+
+	require.NoError(t, err)
+	require.Len(t, updated, 2)
+	for _, delivery := range updated {
+		assert.Equal(t, "event-1", delivery.ID)
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.JSONEq(t, string(data), string(delivery.Payload))
+		switch delivery.WebhookID {
+		case "hook-1":
+			assert.Equal(t, models.WebhookDeliveryDelivered, delivery.Status)
+			assert.NotNil(t, delivery.DeliveredAt)
+		case "hook-2":
+			assert.Equal(t, models.WebhookDeliveryPending, delivery.Status)
+			assert.Equal(t, 503, delivery.ResponseStatus)
+			assert.Equal(t, "unavailable", delivery.LastError)
+			assert.True(t, delivery.NextAttemptAt.After(time.Now()))
+		default:
+			t.Errorf("unexpected delivery to %s", delivery.WebhookID)
+		}
+	}
+	sender.AssertNotCalled(t, "SendWebhook", mock.Anything, replayed, mock.Anything)
+}
+
+func TestProjectsService_HandleProjectWebhookEvent_recordError(t *testing.T) {
+	data, err := json.Marshal(events.ProjectLifecycleEvent{ID: "event-1", Type: events.ProjectDeleted, ProjectUID: "project-1"})
+	require.NoError(t, err)
+
+	service, _, webhookRepo, sender := setupWebhookServiceForTesting()
+	webhookRepo.On("ListWebhooks", mock.Anything).Return([]*models.Webhook{{ID: "hook-1"}}, nil)
+	webhookRepo.On("CreateWebhookDelivery", mock.Anything, mock.Anything).Return(domain.ErrInternal)
+
+	err = service.HandleProjectWebhookEvent(context.Background(), domain.NewMockMessage(data, projectEventSubject(events.ProjectDeleted)))
+
+	assert.ErrorIs(t, err, domain.ErrInternal)
+	sender.AssertNotCalled(t, "SendWebhook", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestProjectsService_DispatchWebhooks(t *testing.T) {
+	now := time.Now()
+	webhook := &models.Webhook{ID: "hook-1", URL: "https://example.org/a"}
+	due := &models.WebhookDelivery{ID: "event-1", WebhookID: "hook-1", Status: models.WebhookDeliveryPending, Attempts: 1, NextAttemptAt: now.Add(-time.Second)}
+	lastAttempt := &models.WebhookDelivery{ID: "event-2", WebhookID: "hook-1", Status: models.WebhookDeliveryPending, Attempts: models.MaxWebhookDeliveryAttempts - 1, NextAttemptAt: now.Add(-time.Second)}
+	notDue := &models.WebhookDelivery{ID: "event-3", WebhookID: "hook-1", Status: models.WebhookDeliveryPending, Attempts: 1, NextAttemptAt: now.Add(time.Hour)}
+	delivered := &models.WebhookDelivery{ID: "event-4", WebhookID: "hook-1", Status: models.WebhookDeliveryDelivered}
+	orphan := &models.WebhookDelivery{ID: "event-5", WebhookID: "hook-2", Status: models.WebhookDeliveryPending, NextAttemptAt: now.Add(-time.Second)}
+
+	service, _, webhookRepo, sender := setupWebhookServiceForTesting()
+	webhookRepo.On("ListWebhookDeliveries", mock.Anything, "").Return([]*models.WebhookDelivery{due, lastAttempt, notDue, delivered, orphan}, nil)
+	webhookRepo.On("GetWebhook", mock.Anything, "hook-1").Return(webhook, nil).Once()
+	webhookRepo.On("GetWebhook", mock.Anything, "hook-2").Return(nil, domain.ErrWebhookNotFound)
+	sender.On("SendWebhook", mock.Anything, webhook, due).Return(200, nil)
+	sender.On("SendWebhook", mock.Anything, webhook, lastAttempt).Return(0, errors.New("connection refused"))
+	webhookRepo.On("UpdateWebhookDelivery", mock.Anything, mock.Anything).Return(nil)
+
+	require.NoError(t, service.DispatchWebhooks(context.Background()))
+
+	assert.Equal(t, models.WebhookDeliveryDelivered, due.Status)
+	assert.Equal(t, models.WebhookDeliveryFailed, lastAttempt.Status)
+	assert.Equal(t, models.MaxWebhookDeliveryAttempts, lastAttempt.Attempts)
+	// Each attempted delivery is claimed, then its outcome recorded.
+	webhookRepo.AssertNumberOfCalls(t, "UpdateWebhookDelivery", 4)
+	webhookRepo.AssertExpectations(t)
+	sender.AssertExpectations(t)
+}
+
+func TestProjectsService_DispatchWebhooks_claimedElsewhere(t *testing.T) {
+	webhook := &models.Webhook{ID: "hook-1", URL: "https://example.org/a"}
+	due := &models.WebhookDelivery{ID: "event-1", WebhookID: "hook-1", Status: models.WebhookDeliveryPending, NextAttemptAt: time.Now().Add(-time.Second), Revision: 3}
+
+	service, _, webhookRepo, sender := setupWebhookServiceForTesting()
+	webhookRepo.On("ListWebhookDeliveries", mock.Anything, "").Return([]*models.WebhookDelivery{due}, nil)
+	webhookRepo.On("GetWebhook", mock.Anything, "hook-1").Return(webhook, nil)
+	webhookRepo.On("UpdateWebhookDelivery", mock.Anything, mock.MatchedBy(func(d *models.WebhookDelivery) bool {
+		return d.Revision == 3 && d.NextAttemptAt.After(time.Now())
+	})).Return(domain.ErrRevisionMismatch)
+
+	require.NoError(t, service.DispatchWebhooks(context.Background()))
+
+	sender.AssertNotCalled(t, "SendWebhook", mock.Anything, mock.Anything, mock.Anything)
+	webhookRepo.AssertNumberOfCalls(t, "UpdateWebhookDelivery", 1)
+	webhookRepo.AssertExpectations(t)
+}
+
+func TestWebhookRetryDelay(t *testing.T) {
+	assert.Equal(t, 30*time.Second, webhookRetryDelay(1))
+	assert.Equal(t, time.Minute, webhookRetryDelay(2))
+	assert.Equal(t, time.Hour, webhookRetryDelay(20))
+}
@@ -0,0 +1,360 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+// Package client is a Go client for the project service's HTTP API. It wraps
+// the Goa generated HTTP client, adding the bearer token to each request,
+// retrying idempotent requests the service could not answer, passing ETags
+// through to conditional updates, and returning error responses as *Error.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	goahttp "goa.design/goa/v3/http"
+
+	projecthttp "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/http/project_service/client"
+	projectservice "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+)
+
+const (
+	// apiVersion is the version of the API the client calls.
+	apiVersion = "1"
+
+	defaultTimeout      = 30 * time.Second
+	defaultMaxAttempts  = 4
+	defaultRetryBackoff = 250 * time.Millisecond
+	defaultUserAgent    = "lfx-v2-project-service-client"
+
+	// maxModifyAttempts bounds the read-modify-write cycles of ModifyProject
+	// and ModifyProjectSettings that lose to a concurrent write.
+	maxModifyAttempts = 3
+)
+
+// TokenSource returns the bearer token to send with a request, such as a JWT
+// issued by Heimdall or a machine-to-machine access token. It is called for
+// every request, so it should cache tokens that are costly to get.
+type TokenSource func(ctx context.Context) (string, error)
+
+// StaticToken returns a TokenSource that always returns token.
+func StaticToken(token string) TokenSource {
+	return func(context.Context) (string, error) {
+		return token, nil
+	}
+}
+
+// Config configures a Client.
+type Config struct {
+	// BaseURL is the scheme and host of the project service, such as
+	// http://localhost:8080. It must not have a path.
+	BaseURL string
+	// Token returns the bearer token to send. Requests are sent without one
+	// when it is nil.
+	Token TokenSource
+	// HTTPClient sends the requests. Defaults to a client with a 30 second
+	// timeout.
+	HTTPClient *http.Client
+	// MaxAttempts is the number of times an idempotent request is sent before
+	// giving up. Defaults to 4; 1 disables retries.
+	MaxAttempts int
+	// RetryBackoff is the wait before the first retry, doubling for each
+	// later one. Defaults to 250ms.
+	RetryBackoff time.Duration
+	// UserAgent is the User-Agent header of the requests. Defaults to
+	// lfx-v2-project-service-client.
+	UserAgent string
+}
+
+// Client calls the project service's HTTP API.
+type Client struct {
+	svc *projectservice.Client
+}
+
+// New returns a Client for the project service at cfg.BaseURL.
+func New(cfg Config) (*Client, error) {
+	base, err := url.Parse(cfg.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base URL: %w", err)
+	}
+	if base.Scheme == "" || base.Host == "" {
+		return nil, fmt.Errorf("invalid base URL %q: scheme and host are required", cfg.BaseURL)
+	}
+	if base.Path != "" && base.Path != "/" {
+		return nil, fmt.Errorf("invalid base URL %q: must not have a path", cfg.BaseURL)
+	}
+
+	t := &transport{
+		httpClient:  cfg.HTTPClient,
+		token:       cfg.Token,
+		userAgent:   cfg.UserAgent,
+		maxAttempts: cfg.MaxAttempts,
+		backoff:     cfg.RetryBackoff,
+	}
+	if t.httpClient == nil {
+		t.httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+	if t.userAgent == "" {
+		t.userAgent = defaultUserAgent
+	}
+	if t.maxAttempts <= 0 {
+		t.maxAttempts = defaultMaxAttempts
+	}
+	if t.backoff <= 0 {
+		t.backoff = defaultRetryBackoff
+	}
+
+	h := projecthttp.NewClient(base.Scheme, base.Host, t, goahttp.RequestEncoder, goahttp.ResponseDecoder, false)
+	return &Client{
+		svc: &projectservice.Client{
+			GetProjectsEndpoint:               h.GetProjects(),
+			CreateProjectEndpoint:             h.CreateProject(),
+			GetOneProjectBaseEndpoint:         h.GetOneProjectBase(),
+			GetProjectUIDFromLegacyIDEndpoint: h.GetProjectUIDFromLegacyID(),
+			GetOneProjectSettingsEndpoint:     h.GetOneProjectSettings(),
+			UpdateProjectBaseEndpoint:         h.UpdateProjectBase(),
+			UpdateProjectSettingsEndpoint:     h.UpdateProjectSettings(),
+			DeleteProjectEndpoint:             h.DeleteProject(),
+			BulkDeleteProjectsEndpoint:        h.BulkDeleteProjects(),
+			GetUserProjectsEndpoint:           h.GetUserProjects(),
+		},
+	}, nil
+}
+
+// ListProjects returns every project, or only those that have all of tags.
+func (c *Client) ListProjects(ctx context.Context, tags ...string) ([]*projectservice.ProjectFull, error) {
+	res, err := call(ctx, c.svc.GetProjects, &projectservice.GetProjectsPayload{
+		Version: strPtr(apiVersion),
+		Tag:     tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Projects, nil
+}
+
+// GetProject returns the project uid and its ETag.
+func (c *Client) GetProject(ctx context.Context, uid string) (*projectservice.ProjectFull, string, error) {
+	res, err := call(ctx, c.svc.GetOneProjectBase, &projectservice.GetOneProjectBasePayload{
+		Version: strPtr(apiVersion),
+		UID:     &uid,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return res.Project, deref(res.Etag), nil
+}
+
+// GetProjectSettings returns the settings of the project uid and their ETag.
+func (c *Client) GetProjectSettings(ctx context.Context, uid string) (*projectservice.ProjectSettings, string, error) {
+	res, err := call(ctx, c.svc.GetOneProjectSettings, &projectservice.GetOneProjectSettingsPayload{
+		Version: strPtr(apiVersion),
+		UID:     &uid,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return res.ProjectSettings, deref(res.Etag), nil
+}
+
+// ProjectUIDFromLegacyID returns the UID of the project with the LFX v1 ID
+// legacyID.
+func (c *Client) ProjectUIDFromLegacyID(ctx context.Context, legacyID string) (string, error) {
+	res, err := call(ctx, c.svc.GetProjectUIDFromLegacyID, &projectservice.GetProjectUIDFromLegacyIDPayload{
+		Version:  strPtr(apiVersion),
+		LegacyID: legacyID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.UID, nil
+}
+
+// UserProjects returns the projects in which the user username is a writer,
+// auditor or meeting coordinator, with the user's roles in each.
+func (c *Client) UserProjects(ctx context.Context, username string) ([]*projectservice.UserProject, error) {
+	res, err := call(ctx, c.svc.GetUserProjects, &projectservice.GetUserProjectsPayload{
+		Version:  strPtr(apiVersion),
+		Username: username,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Projects, nil
+}
+
+// SearchProjects returns at most limit projects whose name, slug or
+// description match query, best match first.
+func (c *Client) SearchProjects(ctx context.Context, query string, limit int) ([]*projectservice.ProjectBase, error) {
+	res, err := call(ctx, c.svc.SearchProjects, &projectservice.SearchProjectsPayload{
+		Version: strPtr(apiVersion),
+		Q:       query,
+		Limit:   limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Projects, nil
+}
+
+// CreateProject creates a project and returns it with its settings. It is
+// not retried, so a failed create may still have created the project.
+func (c *Client) CreateProject(ctx context.Context, p *projectservice.CreateProjectPayload) (*projectservice.ProjectFull, error) {
+	p.Version = strPtr(apiVersion)
+	return call(ctx, c.svc.CreateProject, p)
+}
+
+// UpdateProject replaces the project p.UID if its ETag is still etag, as
+// returned by GetProject. It returns ErrConflict if the project changed since.
+func (c *Client) UpdateProject(ctx context.Context, p *projectservice.UpdateProjectBasePayload, etag string) (*projectservice.ProjectBase, error) {
+	p.Version = strPtr(apiVersion)
+	p.IfMatch = &etag
+	return call(ctx, c.svc.UpdateProjectBase, p)
+}
+
+// UpdateProjectSettings replaces the settings of the project p.UID if their
+// ETag is still etag, as returned by GetProjectSettings. It returns
+// ErrConflict if the settings changed since.
+func (c *Client) UpdateProjectSettings(ctx context.Context, p *projectservice.UpdateProjectSettingsPayload, etag string) (*projectservice.ProjectSettings, error) {
+	p.Version = strPtr(apiVersion)
+	p.IfMatch = &etag
+	return call(ctx, c.svc.UpdateProjectSettings, p)
+}
+
+// DeleteProject deletes the project uid if its ETag is still etag, as
+// returned by GetProject. It returns ErrConflict if the project changed since.
+func (c *Client) DeleteProject(ctx context.Context, uid, etag string) error {
+	_, err := call(ctx, func(ctx context.Context, p *projectservice.DeleteProjectPayload) (struct{}, error) {
+		return struct{}{}, c.svc.DeleteProject(ctx, p)
+	}, &projectservice.DeleteProjectPayload{
+		Version: strPtr(apiVersion),
+		IfMatch: &etag,
+		UID:     &uid,
+	})
+	return err
+}
+
+// BulkDeleteProjects deletes the projects p.Uids at once, children first, and
+// returns the outcome of each. Only LF staff and trusted service principals
+// may call it.
+func (c *Client) BulkDeleteProjects(ctx context.Context, p *projectservice.BulkDeleteProjectsPayload) (*projectservice.BulkProjectDeletionResult, error) {
+	p.Version = strPtr(apiVersion)
+	return call(ctx, c.svc.BulkDeleteProjects, p)
+}
+
+// ModifyProject reads the project uid, lets modify change it, and writes it
+// back, starting over if the project is changed by someone else in between.
+// It returns the updated project.
+func (c *Client) ModifyProject(ctx context.Context, uid string, modify func(*projectservice.UpdateProjectBasePayload) error) (*projectservice.ProjectBase, error) {
+	for attempt := 1; ; attempt++ {
+		project, etag, err := c.GetProject(ctx, uid)
+		if err != nil {
+			return nil, err
+		}
+		p := updateProjectBasePayload(project)
+		if err := modify(p); err != nil {
+			return nil, err
+		}
+		updated, err := c.UpdateProject(ctx, p, etag)
+		if errors.Is(err, ErrConflict) && attempt < maxModifyAttempts {
+			continue
+		}
+		return updated, err
+	}
+}
+
+// ModifyProjectSettings reads the settings of the project uid, lets modify
+// change them, and writes them back, starting over if the settings are changed
+// by someone else in between. It returns the updated settings.
+func (c *Client) ModifyProjectSettings(ctx context.Context, uid string, modify func(*projectservice.UpdateProjectSettingsPayload) error) (*projectservice.ProjectSettings, error) {
+	for attempt := 1; ; attempt++ {
+		settings, etag, err := c.GetProjectSettings(ctx, uid)
+		if err != nil {
+			return nil, err
+		}
+		p := &projectservice.UpdateProjectSettingsPayload{
+			UID:                 &uid,
+			MissionStatement:    settings.MissionStatement,
+			OverviewHTML:        settings.OverviewHTML,
+			AnnouncementDate:    settings.AnnouncementDate,
+			Writers:             settings.Writers,
+			MeetingCoordinators: settings.MeetingCoordinators,
+			Auditors:            settings.Auditors,
+			ExecutiveDirector:   settings.ExecutiveDirector,
+			ProgramManager:      settings.ProgramManager,
+			OpportunityOwner:    settings.OpportunityOwner,
+		}
+		if err := modify(p); err != nil {
+			return nil, err
+		}
+		updated, err := c.UpdateProjectSettings(ctx, p, etag)
+		if errors.Is(err, ErrConflict) && attempt < maxModifyAttempts {
+			continue
+		}
+		return updated, err
+	}
+}
+
+// updateProjectBasePayload returns the update payload that writes project
+// back unchanged.
+func updateProjectBasePayload(project *projectservice.ProjectFull) *projectservice.UpdateProjectBasePayload {
+	return &projectservice.UpdateProjectBasePayload{
+		UID:                        project.UID,
+		Slug:                       deref(project.Slug),
+		Description:                deref(project.Description),
+		Name:                       deref(project.Name),
+		Public:                     project.Public,
+		IsFoundation:               project.IsFoundation,
+		ParentUID:                  deref(project.ParentUID),
+		Stage:                      project.Stage,
+		Category:                   project.Category,
+		Funding:                    project.Funding,
+		FundingModel:               project.FundingModel,
+		CharterURL:                 project.CharterURL,
+		LegalEntityType:            project.LegalEntityType,
+		LegalEntityName:            project.LegalEntityName,
+		LegalParentUID:             project.LegalParentUID,
+		EntityDissolutionDate:      project.EntityDissolutionDate,
+		EntityFormationDocumentURL: project.EntityFormationDocumentURL,
+		AutojoinEnabled:            project.AutojoinEnabled,
+		FormationDate:              project.FormationDate,
+		LogoURL:                    project.LogoURL,
+		RepositoryURL:              project.RepositoryURL,
+		WebsiteURL:                 project.WebsiteURL,
+		Links:                      project.Links,
+		Annotations:                project.Annotations,
+		NameI18n:                   project.NameI18n,
+		DescriptionI18n:            project.DescriptionI18n,
+		LegacyID:                   project.LegacyID,
+		Tags:                       project.Tags,
+	}
+}
+
+// call calls a method of the generated client and converts its error
+// responses into *Error.
+func call[P, R any](ctx context.Context, method func(context.Context, P) (R, error), payload P) (R, error) {
+	resp := &response{}
+	res, err := method(context.WithValue(ctx, responseKey{}, resp), payload)
+	if err != nil {
+		var zero R
+		return zero, toError(err, resp.statusCode)
+	}
+	return res, nil
+}
+
+var _ goahttp.Doer = (*transport)(nil)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
@@ -0,0 +1,219 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	projectservice "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+)
+
+const testUID = "7cad5a8d-19d0-41a4-81a6-043453daf9ee"
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	c, err := New(Config{
+		BaseURL:      server.URL,
+		Token:        StaticToken("token"),
+		RetryBackoff: time.Millisecond,
+	})
+	require.NoError(t, err)
+	return c
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func TestNew_invalidBaseURL(t *testing.T) {
+	for _, baseURL := range []string{"", "localhost:8080", "http://localhost:8080/projects"} {
+		_, err := New(Config{BaseURL: baseURL})
+		assert.Error(t, err, baseURL)
+	}
+}
+
+func TestGetProject(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/"+testUID, r.URL.Path)
+		assert.Equal(t, "1", r.URL.Query().Get("v"))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Equal(t, defaultUserAgent, r.Header.Get("User-Agent"))
+		w.Header().Set("ETag", "7")
+		writeJSON(w, http.StatusOK, map[string]any{"uid": testUID, "slug": "cncf", "name": "CNCF"})
+	})
+
+	project, etag, err := c.GetProject(context.Background(), testUID)
+
+	require.NoError(t, err)
+	assert.Equal(t, "cncf", *project.Slug)
+	assert.Equal(t, "7", etag)
+}
+
+func TestBulkDeleteProjects(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/projects/bulk", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("force"))
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"uids":["`+testUID+`"]}`, string(body))
+		writeJSON(w, http.StatusOK, map[string]any{
+			"results": []map[string]any{{"uid": testUID, "slug": "cncf", "requested": true, "status": "deleted"}},
+			"deleted": 1,
+			"failed":  0,
+			"dry_run": false,
+		})
+	})
+
+	res, err := c.BulkDeleteProjects(context.Background(), &projectservice.BulkDeleteProjectsPayload{
+		Uids:  []string{testUID},
+		Force: true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, res.Deleted)
+	assert.Equal(t, "deleted", res.Results[0].Status)
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		name            string
+		status          int
+		body            any
+		expectedErr     error
+		expectedMessage string
+		expectedType    string
+	}{
+		{
+			name:            "declared error response",
+			status:          http.StatusNotFound,
+			body:            map[string]string{"code": "404", "message": "project not found"},
+			expectedErr:     ErrNotFound,
+			expectedMessage: "project not found",
+		},
+		{
+			name:   "problem details response",
+			status: http.StatusNotFound,
+			body: map[string]any{
+				"type":       "urn:lfx:project-service:problem:project-not-found",
+				"title":      "Project not found",
+				"status":     404,
+				"detail":     "project not found",
+				"request_id": "req-1",
+				"code":       "404",
+				"message":    "project not found",
+			},
+			expectedErr:     ErrNotFound,
+			expectedMessage: "project not found",
+			expectedType:    "urn:lfx:project-service:problem:project-not-found",
+		},
+		{
+			name:        "gateway rejects the token",
+			status:      http.StatusUnauthorized,
+			body:        "unauthorized",
+			expectedErr: ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+				writeJSON(w, tt.status, tt.body)
+			})
+
+			_, _, err := c.GetProject(context.Background(), testUID)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			var apiErr *Error
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, tt.status, apiErr.StatusCode)
+			assert.Equal(t, tt.expectedMessage, apiErr.Message)
+			assert.Equal(t, tt.expectedType, apiErr.Type)
+		})
+	}
+}
+
+func TestRetries(t *testing.T) {
+	var gets, posts atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			posts.Add(1)
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{"code": "503", "message": "service unavailable"})
+			return
+		}
+		if gets.Add(1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"uid": testUID})
+	})
+
+	_, _, err := c.GetProject(context.Background(), testUID)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), gets.Load())
+
+	_, err = c.CreateProject(context.Background(), &projectservice.CreateProjectPayload{
+		Slug: strPtr("cncf"), Name: "CNCF", Description: "CNCF", ParentUID: testUID,
+	})
+	assert.ErrorIs(t, err, ErrServiceUnavailable)
+	assert.Equal(t, int32(1), posts.Load())
+}
+
+func TestModifyProjectSettings(t *testing.T) {
+	var puts atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			// The settings change between the first read and write.
+			w.Header().Set("ETag", "1")
+			if puts.Load() > 0 {
+				w.Header().Set("ETag", "2")
+			}
+			writeJSON(w, http.StatusOK, map[string]any{"uid": testUID, "mission_statement": "old"})
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			if puts.Add(1) == 1 {
+				assert.Equal(t, "1", r.Header.Get("If-Match"))
+				writeJSON(w, http.StatusConflict, map[string]string{"code": "409", "message": "revision mismatch"})
+				return
+			}
+			assert.Equal(t, "2", r.Header.Get("If-Match"))
+			assert.JSONEq(t, `{"mission_statement":"new"}`, string(body))
+			writeJSON(w, http.StatusOK, map[string]any{"uid": testUID, "mission_statement": "new"})
+		}
+	})
+
+	settings, err := c.ModifyProjectSettings(context.Background(), testUID, func(p *projectservice.UpdateProjectSettingsPayload) error {
+		mission := "new"
+		p.MissionStatement = &mission
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "new", *settings.MissionStatement)
+	assert.Equal(t, int32(2), puts.Load())
+
+	// An error from modify is returned without writing.
+	modifyErr := errors.New("no change")
+	_, err = c.ModifyProjectSettings(context.Background(), testUID, func(*projectservice.UpdateProjectSettingsPayload) error {
+		return modifyErr
+	})
+	assert.ErrorIs(t, err, modifyErr)
+	assert.Equal(t, int32(2), puts.Load())
+}
@@ -0,0 +1,224 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	projectservice "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+)
+
+// Errors matched by errors.Is against the errors returned for the project
+// service's error responses.
+var (
+	ErrBadRequest         = errors.New("bad request")
+	ErrUnauthorized       = errors.New("unauthorized")
+	ErrForbidden          = errors.New("forbidden")
+	ErrNotFound           = errors.New("not found")
+	ErrConflict           = errors.New("conflict")
+	ErrUnprocessable      = errors.New("unprocessable entity")
+	ErrRateLimited        = errors.New("rate limited")
+	ErrInternal           = errors.New("internal server error")
+	ErrServiceUnavailable = errors.New("service unavailable")
+)
+
+// Error is an error response of the project service.
+type Error struct {
+	// StatusCode is the HTTP status of the response.
+	StatusCode int
+	// Type is the URI identifying the problem type of the response, such as
+	// urn:lfx:project-service:problem:project-not-found, if it had one.
+	Type string
+	// Message is the detail of the response's problem, if it had one.
+	Message string
+	// RequestID is the ID the service gave the failed request, if known.
+	RequestID string
+	// Fields lists the request fields that were rejected, for a 400, 403 or
+	// 422 response that names them.
+	Fields []FieldError
+	// Duplicates lists the existing projects a new project likely duplicates,
+	// for a 409 response to a project create that was rejected as a duplicate.
+	Duplicates []DuplicateProject
+	// Conflicts lists the settings fields that both a merged settings update
+	// and a concurrent update changed, for a 409 response to the update.
+	Conflicts []SettingsConflict
+	// Contacts lists the people to contact about joining a project, for a 403
+	// response to joining a project that does not have autojoin enabled.
+	Contacts []Contact
+}
+
+// FieldError is a request field rejected by the project service.
+type FieldError struct {
+	Path    string
+	Code    string
+	Message string
+}
+
+// DuplicateProject is an existing project a new project likely duplicates.
+type DuplicateProject struct {
+	UID    string
+	Slug   string
+	Name   string
+	Reason string
+}
+
+// SettingsConflict is a settings field that a merged settings update and a
+// concurrent update both changed. Users lists the users of a role list that
+// both changed differently.
+type SettingsConflict struct {
+	Field string
+	Users []string
+}
+
+// Contact is a person to contact about a project.
+type Contact struct {
+	Role  string
+	Name  string
+	Email string
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("project service: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("project service: %d %s", e.StatusCode, e.Message)
+}
+
+// Is reports whether target is the sentinel error of the response's status,
+// such as ErrNotFound for a 404.
+func (e *Error) Is(target error) bool {
+	switch e.StatusCode {
+	case http.StatusBadRequest:
+		return target == ErrBadRequest
+	case http.StatusUnauthorized:
+		return target == ErrUnauthorized
+	case http.StatusForbidden:
+		return target == ErrForbidden
+	case http.StatusNotFound:
+		return target == ErrNotFound
+	case http.StatusConflict, http.StatusPreconditionFailed:
+		return target == ErrConflict
+	case http.StatusUnprocessableEntity:
+		return target == ErrUnprocessable
+	case http.StatusTooManyRequests:
+		return target == ErrRateLimited
+	case http.StatusServiceUnavailable:
+		return target == ErrServiceUnavailable
+	}
+	return e.StatusCode >= http.StatusInternalServerError && target == ErrInternal
+}
+
+// toError converts an error returned by a generated endpoint into an *Error
+// when it is an error response. statusCode is the status of the last response
+// received, which is used for responses the design does not declare, such as
+// a 401 or 429 from the gateway. Other errors, such as transport errors, are
+// returned as they are.
+func toError(err error, statusCode int) error {
+	var (
+		badRequest    *projectservice.BadRequestError
+		forbidden     *projectservice.ForbiddenError
+		notFound      *projectservice.NotFoundError
+		conflict      *projectservice.ConflictError
+		unprocessable *projectservice.UnprocessableEntityError
+		internal      *projectservice.InternalServerError
+		unavailable   *projectservice.ServiceUnavailableError
+	)
+	switch {
+	case errors.As(err, &badRequest):
+		e := problemError(http.StatusBadRequest, badRequest.Type, badRequest.Detail, badRequest.Message, badRequest.RequestID)
+		e.Fields = toFieldErrors(badRequest.FieldErrors, badRequest.Fields)
+		return e
+	case errors.As(err, &forbidden):
+		e := problemError(http.StatusForbidden, forbidden.Type, forbidden.Detail, forbidden.Message, forbidden.RequestID)
+		e.Fields = toFieldErrors(forbidden.FieldErrors, forbidden.Fields)
+		e.Contacts = toContacts(forbidden.Contacts)
+		return e
+	case errors.As(err, &notFound):
+		return problemError(http.StatusNotFound, notFound.Type, notFound.Detail, notFound.Message, notFound.RequestID)
+	case errors.As(err, &conflict):
+		e := problemError(http.StatusConflict, conflict.Type, conflict.Detail, conflict.Message, conflict.RequestID)
+		e.Duplicates = toDuplicates(conflict.Duplicates)
+		e.Conflicts = toSettingsConflicts(conflict.Conflicts)
+		return e
+	case errors.As(err, &unprocessable):
+		e := problemError(http.StatusUnprocessableEntity, unprocessable.Type, unprocessable.Detail, unprocessable.Message, unprocessable.RequestID)
+		e.Fields = toFieldErrors(unprocessable.FieldErrors, unprocessable.Fields)
+		return e
+	case errors.As(err, &internal):
+		return problemError(http.StatusInternalServerError, internal.Type, internal.Detail, internal.Message, internal.RequestID)
+	case errors.As(err, &unavailable):
+		return problemError(http.StatusServiceUnavailable, unavailable.Type, unavailable.Detail, unavailable.Message, unavailable.RequestID)
+	case statusCode >= http.StatusBadRequest:
+		return &Error{StatusCode: statusCode}
+	}
+	return err
+}
+
+// problemError returns the error of a problem details response, taking the
+// message from its deprecated message when it has no detail.
+func problemError(statusCode int, problemType, detail *string, message string, requestID *string) *Error {
+	e := &Error{StatusCode: statusCode, Message: message}
+	if problemType != nil {
+		e.Type = *problemType
+	}
+	if detail != nil {
+		e.Message = *detail
+	}
+	if requestID != nil {
+		e.RequestID = *requestID
+	}
+	return e
+}
+
+// toFieldErrors converts the field errors of a response, falling back to its
+// deprecated fields list.
+func toFieldErrors(fields, deprecated []*projectservice.FieldError) []FieldError {
+	if len(fields) == 0 {
+		fields = deprecated
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	converted := make([]FieldError, 0, len(fields))
+	for _, f := range fields {
+		converted = append(converted, FieldError{Path: f.Path, Code: f.Code, Message: f.Message})
+	}
+	return converted
+}
+
+func toDuplicates(duplicates []*projectservice.DuplicateProject) []DuplicateProject {
+	if len(duplicates) == 0 {
+		return nil
+	}
+	converted := make([]DuplicateProject, 0, len(duplicates))
+	for _, d := range duplicates {
+		converted = append(converted, DuplicateProject{UID: d.UID, Slug: d.Slug, Name: d.Name, Reason: d.Reason})
+	}
+	return converted
+}
+
+func toSettingsConflicts(conflicts []*projectservice.SettingsMergeConflict) []SettingsConflict {
+	if len(conflicts) == 0 {
+		return nil
+	}
+	converted := make([]SettingsConflict, 0, len(conflicts))
+	for _, c := range conflicts {
+		converted = append(converted, SettingsConflict{Field: c.Field, Users: c.Users})
+	}
+	return converted
+}
+
+func toContacts(contacts []*projectservice.ProjectContact) []Contact {
+	if len(contacts) == 0 {
+		return nil
+	}
+	converted := make([]Contact, 0, len(contacts))
+	for _, c := range contacts {
+		converted = append(converted, Contact{Role: c.Role, Name: c.Name, Email: c.Email})
+	}
+	return converted
+}
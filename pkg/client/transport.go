@@ -0,0 +1,123 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/constants"
+)
+
+// maxRetryDelay caps the wait before a retry, including one asked for by a
+// Retry-After header.
+const maxRetryDelay = 10 * time.Second
+
+// responseKey is the context key of the *response a call records the status
+// of its last response in.
+type responseKey struct{}
+
+// response is the last response received for a call.
+type response struct {
+	statusCode int
+}
+
+// transport sends the requests of the generated HTTP client, adding the
+// Authorization and User-Agent headers and retrying failed idempotent requests.
+type transport struct {
+	httpClient  *http.Client
+	token       TokenSource
+	userAgent   string
+	maxAttempts int
+	backoff     time.Duration
+}
+
+// Do sends req. GET, PUT and DELETE requests are retried when the service
+// cannot be reached or answers 429, 502, 503 or 504, up to maxAttempts in all.
+// POST requests are sent once, as they are not idempotent.
+func (t *transport) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if t.token != nil && req.Header.Get(constants.AuthorizationHeader) == "" {
+		token, err := t.token(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting bearer token: %w", err)
+		}
+		req.Header.Set(constants.AuthorizationHeader, "Bearer "+token)
+	}
+	if t.userAgent != "" {
+		req.Header.Set("User-Agent", t.userAgent)
+	}
+
+	retryable := isIdempotent(req.Method) && (req.Body == nil || req.Body == http.NoBody || req.GetBody != nil)
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		resp, err := t.httpClient.Do(req)
+		if !retryable || attempt >= t.maxAttempts || !shouldRetry(resp, err) || ctx.Err() != nil {
+			if resp != nil {
+				if r, ok := ctx.Value(responseKey{}).(*response); ok {
+					r.statusCode = resp.StatusCode
+				}
+			}
+			return resp, err
+		}
+
+		delay := t.retryDelay(attempt, resp)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryDelay returns how long to wait after the attempt-th failed attempt: the
+// response's Retry-After, if it has one, or else a delay doubling from backoff.
+// Either is capped at maxRetryDelay.
+func (t *transport) retryDelay(attempt int, resp *http.Response) time.Duration {
+	delay := t.backoff << (attempt - 1)
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			delay = time.Duration(seconds) * time.Second
+		}
+	}
+	if delay < 0 || delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	return delay
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
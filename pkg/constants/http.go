@@ -18,6 +18,15 @@ const (
 	XOnBehalfOfHeader string = "x-on-behalf-of"
 )
 
+// Versions of the API, selected with the v query parameter
+const (
+	// APIVersion1 is the version used when a request does not select one.
+	APIVersion1 string = "1"
+
+	// APIVersion2 nests the settings of the projects listed by GET /projects.
+	APIVersion2 string = "2"
+)
+
 // contextRequestID is the type for the request ID context key
 type contextRequestID string
 
@@ -36,6 +45,12 @@ type contextPrincipal string
 // PrincipalContextID is the context ID for the principal
 const PrincipalContextID contextPrincipal = "x-on-behalf-of"
 
+// contextRoles is the type for the principal roles context key
+type contextRoles string
+
+// RolesContextID is the context ID for the principal's roles
+const RolesContextID contextRoles = "roles"
+
 type contextEtag string
 
 // ETagContextID is the context ID for the ETag
@@ -20,6 +20,47 @@ const (
 	// KVStoreNameProjectDocuments is the name of the KV store for project document metadata.
 	KVStoreNameProjectDocuments = "project-documents-metadata"
 
+	// KVStoreNameProjectOutbox is the name of the KV store holding outbound messages
+	// that have not yet been published.
+	KVStoreNameProjectOutbox = "project-outbox"
+
+	// KVStoreNameProjectDeadLetters is the name of the KV store holding inbound
+	// events whose handler failed, until they are replayed.
+	KVStoreNameProjectDeadLetters = "project-dead-letters"
+
+	// KVStoreNameProjectCharters is the name of the KV store holding the version
+	// history of project charters.
+	KVStoreNameProjectCharters = "project-charters"
+
+	// KVStoreNameProjectAssociations is the name of the KV store holding project
+	// references to committees, mailing lists and meeting series.
+	KVStoreNameProjectAssociations = "project-associations"
+
+	// KVStoreNameProjectWebhooks is the name of the KV store holding the
+	// webhooks registered for project lifecycle events.
+	KVStoreNameProjectWebhooks = "project-webhooks"
+
+	// KVStoreNameProjectWebhookDeliveries is the name of the KV store holding
+	// the deliveries of project lifecycle events to webhooks.
+	KVStoreNameProjectWebhookDeliveries = "project-webhook-deliveries"
+
+	// KVStoreNameProjectAnnouncements is the name of the KV store recording the
+	// project announcements that were published.
+	KVStoreNameProjectAnnouncements = "project-announcements"
+
+	// KVStoreNameProjectTemplates is the name of the KV store holding the
+	// templates that new projects can be created from.
+	KVStoreNameProjectTemplates = "project-templates"
+
+	// KVStoreNameProjectMetadata is the name of the KV store holding the
+	// admin-managed configuration of project fields, such as the allowed
+	// stages and categories.
+	KVStoreNameProjectMetadata = "project-metadata"
+
+	// KVStoreNameProjectDeletions is the name of the KV store holding the
+	// tombstones of deleted projects, which expire after the bucket's TTL.
+	KVStoreNameProjectDeletions = "project-deletions"
+
 	// ObjectStoreNameProjectDocuments is the name of the object store for project document files.
 	ObjectStoreNameProjectDocuments = "project-documents"
 
@@ -32,6 +73,10 @@ const (
 	// KVLookupLinkKey is the per-project link index key.
 	// Format: lookup/project-links/<projectUID>/<linkUID>
 	KVLookupLinkKey = "lookup/project-links/%s/%s"
+
+	// KVHealthSentinelKey is the key read by health checks to confirm a KV bucket round-trip.
+	// The key is never written; a "key not found" reply is the expected healthy response.
+	KVHealthSentinelKey = "health/sentinel"
 )
 
 // NATS subjects that the project service sends messages about.
@@ -67,13 +112,39 @@ const (
 	// The payload is the marshalled events.ProjectLinkCreatedMessage.
 	// The subject is of the form: lfx.projects-api.project_link.created
 	ProjectLinkCreatedSubject = "lfx.projects-api.project_link.created"
+
+	// ProjectEventsSubjectPrefix is the subject prefix for canonical project lifecycle events.
+	// The event type is appended to form the full subject, e.g. lfx.projects-api.events.project.created.
+	// The payload is the marshalled events.ProjectLifecycleEvent.
+	ProjectEventsSubjectPrefix = "lfx.projects-api.events."
+)
+
+// NATS JetStream stream names.
+const (
+	// StreamNameProjectEvents is the name of the JetStream stream capturing
+	// every subject under ProjectEventsSubjectPrefix.
+	StreamNameProjectEvents = "project-events"
+
+	// StreamNameProjectUpserts is the name of the JetStream stream capturing
+	// ProjectUpsertSubject, from which upserts are consumed durably.
+	StreamNameProjectUpserts = "project-upserts"
 )
 
 // NATS wildcard subjects that the project service handles messages about.
 const (
+	// ProjectsAPISubjectPrefix is the prefix of the subjects the project service
+	// handles and publishes its own events on. It can be replaced with
+	// NATS_SUBJECT_PREFIX.
+	ProjectsAPISubjectPrefix = "lfx.projects-api"
 	// ProjectsAPIQueue is the subject name for the projects API.
 	// The subject is of the form: lfx.projects-api.queue
 	ProjectsAPIQueue = "lfx.projects-api.queue"
+	// ProjectsAPIServiceName is the name the project service's NATS handlers are
+	// registered under as a NATS micro service, as listed by `nats micro ls`.
+	ProjectsAPIServiceName = "lfx-projects-api"
+	// ProjectUpsertPublisher is the principal project upserts are written as:
+	// the LFX v1 sync pipeline, the only publisher of ProjectUpsertSubject.
+	ProjectUpsertPublisher = "lfx-v1-sync"
 )
 
 // NATS specific subjects that the project service handles messages about.
@@ -90,6 +161,10 @@ const (
 	// ProjectSlugToUIDSubject is the subject for the project slug to UID.
 	// The subject is of the form: lfx.projects-api.slug_to_uid
 	ProjectSlugToUIDSubject = "lfx.projects-api.slug_to_uid"
+	// ProjectLegacyToUIDSubject is the subject for resolving a project's LFX v1 ID to its UID.
+	// Request: plain-text v1 project ID. Reply: plain-text project UID (empty when not found).
+	// The subject is of the form: lfx.projects-api.legacy_to_uid
+	ProjectLegacyToUIDSubject = "lfx.projects-api.legacy_to_uid"
 	// ProjectGetParentUIDSubject is the subject for getting the parent project UID.
 	// The subject is of the form: lfx.projects-api.get_parent_uid
 	ProjectGetParentUIDSubject = "lfx.projects-api.get_parent_uid"
@@ -97,6 +172,17 @@ const (
 	// Request: plain-text project UID. Reply: JSON-encoded []models.UserInfo (empty array when no writers).
 	// The subject is of the form: lfx.projects-api.get_writers
 	ProjectGetWritersSubject = "lfx.projects-api.get_writers"
+	// UserGetProjectsSubject is the subject for listing the projects in which a user is a
+	// writer, auditor or meeting coordinator.
+	// Request: plain-text username. Reply: JSON-encoded []models.UserProject (empty array when none).
+	// The subject is of the form: lfx.projects-api.get_user_projects
+	UserGetProjectsSubject = "lfx.projects-api.get_user_projects"
+	// ProjectUpsertSubject is the subject on which the LFX v1 sync pipeline pushes
+	// project updates, consumed from the StreamNameProjectUpserts stream.
+	// Message: JSON-encoded events.ProjectUpsert. No reply; the publisher gets the
+	// stream's acknowledgement.
+	// The subject is of the form: lfx.projects-api.upsert
+	ProjectUpsertSubject = "lfx.projects-api.upsert"
 )
 
 // NATS subjects for external service lookups.
@@ -108,4 +194,25 @@ const (
 	// AuthEmailToUsernameSubject resolves a registered LFID username by primary email.
 	// Request: plain-text email. Reply: plain-text username on success, JSON error envelope on miss.
 	AuthEmailToUsernameSubject = "lfx.auth-service.email_to_username"
+
+	// CommitteeGetNameSubject looks up a committee's name by its UID.
+	// Request: plain-text committee UID. Reply: plain-text name (empty when not found).
+	// The subject is of the form: lfx.committee-api.get_name
+	CommitteeGetNameSubject = "lfx.committee-api.get_name"
+
+	// MailingListGetNameSubject looks up a mailing list's name by its ID.
+	// Request: plain-text mailing list ID. Reply: plain-text name (empty when not found).
+	// The subject is of the form: lfx.mailing-list-api.get_name
+	MailingListGetNameSubject = "lfx.mailing-list-api.get_name"
+
+	// MeetingSeriesGetNameSubject looks up a meeting series' title by its ID.
+	// Request: plain-text meeting series ID. Reply: plain-text title (empty when not found).
+	// The subject is of the form: lfx.meeting-api.get_name
+	MeetingSeriesGetNameSubject = "lfx.meeting-api.get_name"
 )
+
+// TenantSeparator separates the optional tenant name from the KV keys and NATS
+// subjects it namespaces, as in sandbox.lfx.projects-api.get_name. A dot makes
+// the tenant its own subject token, so that a tenant's keys and subjects can be
+// matched with a wildcard.
+const TenantSeparator = "."
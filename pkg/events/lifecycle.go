@@ -0,0 +1,145 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package events
+
+import "time"
+
+// ProjectEventSchemaVersion is the version of the [ProjectLifecycleEvent] schema.
+// It is bumped only for breaking changes; adding optional fields does not change it.
+const ProjectEventSchemaVersion = 1
+
+// ProjectEventType identifies the kind of project lifecycle event.
+type ProjectEventType string
+
+// Project lifecycle event types. Each is published on
+// lfx.projects-api.events.<type>, e.g. lfx.projects-api.events.project.created.
+const (
+	ProjectCreated         ProjectEventType = "project.created"
+	ProjectUpdated         ProjectEventType = "project.updated"
+	ProjectDeleted         ProjectEventType = "project.deleted"
+	ProjectSettingsUpdated ProjectEventType = "project.settings.updated"
+	ProjectAnnounced       ProjectEventType = "project.announced"
+	ProjectLegalChanged    ProjectEventType = "project.legal_changed"
+	ProjectMerged          ProjectEventType = "project.merged"
+	ProjectTransferred     ProjectEventType = "project.transferred"
+)
+
+// Project is the project base representation used in event payloads.
+type Project struct {
+	UID                        string            `json:"uid"`
+	Slug                       string            `json:"slug"`
+	Name                       string            `json:"name"`
+	Description                string            `json:"description"`
+	Public                     bool              `json:"public"`
+	IsFoundation               bool              `json:"is_foundation"`
+	ParentUID                  string            `json:"parent_uid"`
+	Stage                      string            `json:"stage"`
+	Category                   string            `json:"category"`
+	LegalEntityType            string            `json:"legal_entity_type"`
+	LegalEntityName            string            `json:"legal_entity_name"`
+	LegalParentUID             string            `json:"legal_parent_uid"`
+	Funding                    string            `json:"funding"`
+	FundingModel               []string          `json:"funding_model"`
+	EntityDissolutionDate      *time.Time        `json:"entity_dissolution_date"`
+	EntityFormationDocumentURL string            `json:"entity_formation_document_url"`
+	FormationDate              *time.Time        `json:"formation_date"`
+	AutojoinEnabled            bool              `json:"autojoin_enabled"`
+	CharterURL                 string            `json:"charter_url"`
+	LogoURL                    string            `json:"logo_url"`
+	PNGLogoURL                 string            `json:"png_logo_url,omitempty"`
+	WebsiteURL                 string            `json:"website_url"`
+	RepositoryURL              string            `json:"repository_url"`
+	Links                      *ProjectLinks     `json:"links,omitempty"`
+	Annotations                map[string]string `json:"annotations,omitempty"`
+	NameI18n                   map[string]string `json:"name_i18n,omitempty"`
+	DescriptionI18n            map[string]string `json:"description_i18n,omitempty"`
+	LegacyID                   string            `json:"legacy_id,omitempty"`
+	Tags                       []string          `json:"tags,omitempty"`
+	CreatedAt                  *time.Time        `json:"created_at"`
+	UpdatedAt                  *time.Time        `json:"updated_at"`
+}
+
+// ProjectLinks are the social links and contact details of a project in event payloads.
+type ProjectLinks struct {
+	Twitter      string `json:"twitter,omitempty"`
+	LinkedIn     string `json:"linkedin,omitempty"`
+	YouTube      string `json:"youtube,omitempty"`
+	Slack        string `json:"slack,omitempty"`
+	Discord      string `json:"discord,omitempty"`
+	MailingList  string `json:"mailing_list,omitempty"`
+	ContactEmail string `json:"contact_email,omitempty"`
+}
+
+// ProjectLifecycleEvent is the canonical envelope for project lifecycle events
+// published to the project events JetStream stream. Downstream services should
+// depend on this type rather than on indexer or FGA message formats.
+//
+// Which payload fields are set depends on Type:
+//   - project.created: Project and Settings
+//   - project.updated: Project and PreviousProject
+//   - project.deleted: Project (the state before deletion)
+//   - project.settings.updated: Settings and PreviousSettings
+//   - project.announced: Project and Settings, and PreviousProject when the
+//     announcement changed the project's stage or visibility
+//   - project.legal_changed: Project and LegalChange
+//   - project.merged: Project (the merged project, before it was deleted)
+//     and Merge
+//   - project.transferred: Project, PreviousProject when the transfer moved
+//     the project under another parent, and Transfer
+type ProjectLifecycleEvent struct {
+	Version          int              `json:"version"`
+	ID               string           `json:"id"`
+	Type             ProjectEventType `json:"type"`
+	OccurredAt       time.Time        `json:"occurred_at"`
+	ProjectUID       string           `json:"project_uid"`
+	Actor            Actor            `json:"actor"`
+	Project          *Project         `json:"project,omitempty"`
+	PreviousProject  *Project         `json:"previous_project,omitempty"`
+	Settings         *ProjectSettings `json:"settings,omitempty"`
+	PreviousSettings *ProjectSettings `json:"previous_settings,omitempty"`
+	LegalChange      *LegalChange     `json:"legal_change,omitempty"`
+	Merge            *ProjectMerge    `json:"merge,omitempty"`
+	Transfer         *ProjectTransfer `json:"transfer,omitempty"`
+}
+
+// ProjectMerge is the merge of a project into another one, the target.
+type ProjectMerge struct {
+	TargetUID  string `json:"target_uid"`
+	TargetSlug string `json:"target_slug"`
+	// ReparentedChildren are the UIDs of the children of the merged project
+	// that were moved under the target.
+	ReparentedChildren []string `json:"reparented_children"`
+	// AddedWriters and AddedAuditors are the usernames of the writers and
+	// auditors of the merged project that were added to the target's.
+	AddedWriters  []string `json:"added_writers"`
+	AddedAuditors []string `json:"added_auditors"`
+}
+
+// ProjectTransfer is the transfer of a project to new owners. Consumers such
+// as notification services tell the previous writers that they no longer
+// administer the project.
+type ProjectTransfer struct {
+	PreviousWriters []UserInfo `json:"previous_writers"`
+	Writers         []UserInfo `json:"writers"`
+	// PreviousParentUID and ParentUID are the parent of the project before and
+	// after the transfer, the same when it was not moved.
+	PreviousParentUID string `json:"previous_parent_uid"`
+	ParentUID         string `json:"parent_uid"`
+	Reason            string `json:"reason,omitempty"`
+}
+
+// LegalChange is a change of the legal fields of a project, for the billing
+// and legal systems.
+type LegalChange struct {
+	Before LegalFields `json:"before"`
+	After  LegalFields `json:"after"`
+	// EffectiveDate is when the change was made.
+	EffectiveDate time.Time `json:"effective_date"`
+}
+
+// LegalFields are the fields of a project that a LegalChange reports.
+type LegalFields struct {
+	FundingModel    []string `json:"funding_model"`
+	LegalEntityType string   `json:"legal_entity_type"`
+}
@@ -0,0 +1,153 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// LookupVersion is the version of the lookup request and response envelopes.
+const LookupVersion = "v1"
+
+// Error codes of a LookupResponse.
+const (
+	// LookupErrorInvalidRequest is returned for a malformed request or payload.
+	LookupErrorInvalidRequest = "invalid_request"
+	// LookupErrorUnsupportedVersion is returned for an envelope version the
+	// service does not know.
+	LookupErrorUnsupportedVersion = "unsupported_version"
+	// LookupErrorNotFound is returned when the project or user does not exist.
+	LookupErrorNotFound = "not_found"
+	// LookupErrorForbidden is returned when the attribute of a private project
+	// is withheld from the requester.
+	LookupErrorForbidden = "forbidden"
+	// LookupErrorUnavailable is returned while the service cannot serve
+	// lookups, such as when it shuts down.
+	LookupErrorUnavailable = "unavailable"
+	// LookupErrorTimeout is returned when the lookup did not finish within the
+	// service's handler timeout. The request may be retried.
+	LookupErrorTimeout = "timeout"
+	// LookupErrorInternal is returned for any other failure.
+	LookupErrorInternal = "internal"
+)
+
+// LookupRequest is the envelope of a request to a projects-api lookup
+// subject, such as lfx.projects-api.get_name. Its payload is the request type
+// of the subject.
+type LookupRequest struct {
+	Version string `json:"version"`
+	// RequestID is returned as is in the response, so that the requester can
+	// match them up.
+	RequestID string          `json:"request_id,omitempty"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// LookupResponse is the envelope of the reply to a LookupRequest. It holds
+// either the response type of the subject as payload, or an error.
+type LookupResponse struct {
+	Version   string          `json:"version"`
+	RequestID string          `json:"request_id,omitempty"`
+	Payload   json.RawMessage `json:"payload,omitempty"`
+	Error     *LookupError    `json:"error,omitempty"`
+}
+
+// LookupError is the error of a failed lookup.
+type LookupError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ParseLookupRequest returns the envelope of data, and whether data is an
+// envelope at all. Requests that are plain text, or JSON without a version,
+// are not.
+func ParseLookupRequest(data []byte) (LookupRequest, bool) {
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return LookupRequest{}, false
+	}
+	var request LookupRequest
+	if err := json.Unmarshal(data, &request); err != nil || request.Version == "" {
+		return LookupRequest{}, false
+	}
+	return request, true
+}
+
+// ProjectLookupRequest is the payload of a request on get_name, get_slug,
+// get_logo, get_parent_uid and get_writers.
+type ProjectLookupRequest struct {
+	UID string `json:"uid"`
+	// Principal asserts on whose behalf the lookup is made. It is checked for
+	// the viewer relation before a private project's name or logo is returned.
+	Principal string `json:"principal,omitempty"`
+	// IncludePreviousNames asks get_name for the project's previous names too.
+	IncludePreviousNames bool `json:"include_previous_names,omitempty"`
+}
+
+// ProjectSlugRequest is the payload of a request on slug_to_uid.
+type ProjectSlugRequest struct {
+	Slug string `json:"slug"`
+}
+
+// ProjectLegacyIDRequest is the payload of a request on legacy_to_uid.
+type ProjectLegacyIDRequest struct {
+	LegacyID string `json:"legacy_id"`
+}
+
+// UserProjectsRequest is the payload of a request on get_user_projects.
+type UserProjectsRequest struct {
+	Username string `json:"username"`
+}
+
+// PreviousName is a name a project had before it was renamed.
+type PreviousName struct {
+	Name      string    `json:"name"`
+	RenamedAt time.Time `json:"renamed_at"`
+}
+
+// ProjectNameResponse is the payload of a reply on get_name.
+type ProjectNameResponse struct {
+	Name string `json:"name"`
+	// PreviousNames, oldest first, are only returned when requested.
+	PreviousNames []PreviousName `json:"previous_names,omitempty"`
+}
+
+// ProjectSlugResponse is the payload of a reply on get_slug.
+type ProjectSlugResponse struct {
+	Slug string `json:"slug"`
+}
+
+// ProjectLogoResponse is the payload of a reply on get_logo.
+type ProjectLogoResponse struct {
+	LogoURL string `json:"logo_url"`
+}
+
+// ProjectParentUIDResponse is the payload of a reply on get_parent_uid. The
+// parent UID is empty for a root project.
+type ProjectParentUIDResponse struct {
+	ParentUID string `json:"parent_uid"`
+}
+
+// ProjectUIDResponse is the payload of a reply on slug_to_uid and legacy_to_uid.
+type ProjectUIDResponse struct {
+	UID string `json:"uid"`
+}
+
+// ProjectWritersResponse is the payload of a reply on get_writers.
+type ProjectWritersResponse struct {
+	Writers []UserInfo `json:"writers"`
+}
+
+// UserProject is a project in which a user holds a role.
+type UserProject struct {
+	ProjectUID string   `json:"project_uid"`
+	Slug       string   `json:"slug"`
+	Name       string   `json:"name"`
+	Roles      []string `json:"roles"`
+}
+
+// UserProjectsResponse is the payload of a reply on get_user_projects.
+type UserProjectsResponse struct {
+	Projects []UserProject `json:"projects"`
+}
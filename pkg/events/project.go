@@ -32,6 +32,7 @@ type UserInfo struct {
 type ProjectSettings struct {
 	UID                 string     `json:"uid"`
 	MissionStatement    string     `json:"mission_statement"`
+	OverviewHTML        string     `json:"overview_html,omitempty"`
 	AnnouncementDate    *time.Time `json:"announcement_date"`
 	Auditors            []UserInfo `json:"auditors"`
 	Writers             []UserInfo `json:"writers"`
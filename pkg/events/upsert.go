@@ -0,0 +1,34 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package events
+
+// ProjectUpsert is the payload of a message on lfx.projects-api.upsert,
+// through which the LFX v1 sync pipeline pushes project updates. The project
+// is matched by its LegacyID: it is created when no project has the legacy ID,
+// and its base information is updated otherwise.
+//
+// Fields left out, or null, keep their current value on an update and are
+// empty on a new project, which also needs Slug and Name.
+type ProjectUpsert struct {
+	// LegacyID is the LFX v1 ID of the project. It is required.
+	LegacyID        string   `json:"legacy_id"`
+	Slug            *string  `json:"slug,omitempty"`
+	Name            *string  `json:"name,omitempty"`
+	Description     *string  `json:"description,omitempty"`
+	Public          *bool    `json:"public,omitempty"`
+	ParentUID       *string  `json:"parent_uid,omitempty"`
+	Stage           *string  `json:"stage,omitempty"`
+	Category        *string  `json:"category,omitempty"`
+	LegalEntityType *string  `json:"legal_entity_type,omitempty"`
+	LegalEntityName *string  `json:"legal_entity_name,omitempty"`
+	Funding         *string  `json:"funding,omitempty"`
+	FundingModel    []string `json:"funding_model,omitempty"`
+	// FormationDate is a date of the form 2006-01-02.
+	FormationDate *string  `json:"formation_date,omitempty"`
+	CharterURL    *string  `json:"charter_url,omitempty"`
+	LogoURL       *string  `json:"logo_url,omitempty"`
+	WebsiteURL    *string  `json:"website_url,omitempty"`
+	RepositoryURL *string  `json:"repository_url,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+}
@@ -12,3 +12,8 @@ func StringPtr(s string) *string {
 func BoolPtr(b bool) *bool {
 	return &b
 }
+
+// IntPtr converts an int to a pointer to an int.
+func IntPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,152 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+// Package redact removes personal data, the emails and names of users, from
+// logs and published messages.
+package redact
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Mode is how personal data is redacted.
+type Mode string
+
+const (
+	// Off leaves personal data as it is.
+	Off Mode = "off"
+	// Mask keeps the first character of names and of the local part of
+	// emails, and the domain of emails, e.g. "j***@example.com".
+	Mask Mode = "mask"
+	// Hash replaces personal data with a short HMAC-SHA256 digest keyed with
+	// the key set by SetHashKey, so that the records of one user can still be
+	// correlated but not looked up by hashing known emails, e.g.
+	// "hmac:1f2e3d4c5b6a7980".
+	Hash Mode = "hash"
+)
+
+// hashKey is the HMAC key of Hash. Without it, Hash replaces personal data
+// with a placeholder rather than an unkeyed digest.
+var hashKey []byte
+
+// SetHashKey sets the HMAC key of Hash. It is meant to be called once, at
+// startup, before anything is redacted.
+func SetHashKey(key string) {
+	hashKey = []byte(key)
+}
+
+// HashKeySet reports whether the HMAC key of Hash is set.
+func HashKeySet() bool {
+	return len(hashKey) > 0
+}
+
+// emailPattern matches the email addresses redacted from free text.
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+// ParseMode parses a redaction mode; an empty value is Off.
+func ParseMode(value string) (Mode, error) {
+	switch mode := Mode(strings.ToLower(strings.TrimSpace(value))); mode {
+	case "", Off:
+		return Off, nil
+	case Mask, Hash:
+		return mode, nil
+	default:
+		return Off, fmt.Errorf("unknown redaction mode %q, expected %q, %q or %q", value, Off, Mask, Hash)
+	}
+}
+
+// Enabled reports whether m redacts anything.
+func (m Mode) Enabled() bool {
+	return m == Mask || m == Hash
+}
+
+// Email redacts an email address.
+func (m Mode) Email(email string) string {
+	if email == "" || !m.Enabled() {
+		return email
+	}
+	if m == Hash {
+		return hash(strings.ToLower(strings.TrimSpace(email)))
+	}
+	local, domain, ok := strings.Cut(email, "@")
+	if !ok {
+		return mask(email)
+	}
+	return mask(local) + "@" + domain
+}
+
+// Name redacts the name of a user.
+func (m Mode) Name(name string) string {
+	if name == "" || !m.Enabled() {
+		return name
+	}
+	if m == Hash {
+		return hash(name)
+	}
+	return mask(name)
+}
+
+// Text redacts the email addresses found in free text, such as a log message
+// or an error.
+func (m Mode) Text(text string) string {
+	if !m.Enabled() || !strings.Contains(text, "@") {
+		return text
+	}
+	return emailPattern.ReplaceAllStringFunc(text, m.Email)
+}
+
+// Value redacts a value decoded from JSON in place and returns it. The "email"
+// of every object is redacted, and so is the "name" of the objects that have
+// an email, such as the users of project settings. Emails are also redacted
+// from all other strings.
+func (m Mode) Value(value any) any {
+	if !m.Enabled() {
+		return value
+	}
+	switch v := value.(type) {
+	case map[string]any:
+		_, isUser := v["email"]
+		for key, field := range v {
+			switch s, ok := field.(string); {
+			case ok && key == "email":
+				v[key] = m.Email(s)
+			case ok && key == "name" && isUser:
+				v[key] = m.Name(s)
+			default:
+				v[key] = m.Value(field)
+			}
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = m.Value(item)
+		}
+		return v
+	case string:
+		return m.Text(v)
+	default:
+		return value
+	}
+}
+
+// mask keeps the first character of s.
+func mask(s string) string {
+	r, _ := utf8.DecodeRuneInString(s)
+	return string(r) + "***"
+}
+
+// hash returns a short HMAC digest of s, or a placeholder when no key is set.
+func hash(s string) string {
+	if !HashKeySet() {
+		return "[redacted]"
+	}
+	mac := hmac.New(sha256.New, hashKey)
+	mac.Write([]byte(s))
+	return "hmac:" + hex.EncodeToString(mac.Sum(nil)[:8])
+}
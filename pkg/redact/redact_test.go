@@ -0,0 +1,104 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package redact
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMode(t *testing.T) {
+	tests := []struct {
+		value     string
+		expected  Mode
+		expectErr bool
+	}{
+		{"", Off, false},
+		{"off", Off, false},
+		{"mask", Mask, false},
+		{" Hash ", Hash, false},
+		{"encrypt", Off, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			mode, err := ParseMode(tt.value)
+			assert.Equal(t, tt.expected, mode)
+			assert.Equal(t, tt.expectErr, err != nil)
+		})
+	}
+}
+
+func TestMode_Email(t *testing.T) {
+	assert.Equal(t, "jane@example.com", Off.Email("jane@example.com"))
+	assert.Equal(t, "j***@example.com", Mask.Email("jane@example.com"))
+	assert.Equal(t, "j***", Mask.Email("jane"))
+	assert.Equal(t, "", Mask.Email(""))
+
+	setHashKey(t, "secret")
+	hashed := Hash.Email("jane@example.com")
+	assert.Regexp(t, `^hmac:[0-9a-f]{16}$`, hashed)
+	assert.Equal(t, hashed, Hash.Email(" Jane@Example.com"), "hashes are case insensitive")
+	assert.NotEqual(t, hashed, Hash.Email("john@example.com"))
+
+	SetHashKey("another secret")
+	assert.NotEqual(t, hashed, Hash.Email("jane@example.com"), "hashes depend on the key")
+}
+
+func TestMode_HashWithoutKey(t *testing.T) {
+	setHashKey(t, "")
+
+	assert.Equal(t, "[redacted]", Hash.Email("jane@example.com"))
+	assert.Equal(t, "[redacted]", Hash.Name("Jane Doe"))
+}
+
+// setHashKey sets the HMAC key of Hash for the test.
+func setHashKey(t *testing.T, key string) {
+	t.Helper()
+	previous := hashKey
+	t.Cleanup(func() { hashKey = previous })
+	SetHashKey(key)
+}
+
+func TestMode_Name(t *testing.T) {
+	assert.Equal(t, "Jane Doe", Off.Name("Jane Doe"))
+	assert.Equal(t, "J***", Mask.Name("Jane Doe"))
+	assert.Equal(t, "É***", Mask.Name("Émile"))
+	setHashKey(t, "secret")
+	assert.Regexp(t, `^hmac:[0-9a-f]{16}$`, Hash.Name("Jane Doe"))
+}
+
+func TestMode_Text(t *testing.T) {
+	text := "invite sent to jane@example.com and john.doe+lfx@example.org"
+
+	assert.Equal(t, text, Off.Text(text))
+	assert.Equal(t, "invite sent to j***@example.com and j***@example.org", Mask.Text(text))
+	assert.Equal(t, "no address here", Mask.Text("no address here"))
+}
+
+func TestMode_Value(t *testing.T) {
+	var value any
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"uid": "project",
+		"name": "My Project",
+		"writers": [{"name": "Jane Doe", "email": "jane@example.com", "username": "jane"}],
+		"executive_director": {"name": "John Doe", "email": "john@example.com", "username": "john"},
+		"description": "contact info@example.com"
+	}`), &value))
+
+	redacted := Mask.Value(value)
+
+	data, err := json.Marshal(redacted)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"uid": "project",
+		"name": "My Project",
+		"writers": [{"name": "J***", "email": "j***@example.com", "username": "jane"}],
+		"executive_director": {"name": "J***", "email": "j***@example.com", "username": "john"},
+		"description": "contact i***@example.com"
+	}`, string(data))
+}
@@ -0,0 +1,291 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"time"
+
+	projectservice "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+)
+
+// stageArchived is the stage that requires an entity dissolution date.
+const stageArchived = "Archived"
+
+// ProjectData represents the structure for creating a project
+type ProjectData struct {
+	Name                  string                     `json:"name"`
+	Description           string                     `json:"description"`
+	Public                bool                       `json:"public"`
+	IsFoundation          bool                       `json:"is_foundation"`
+	Stage                 string                     `json:"stage"`
+	Category              string                     `json:"category,omitempty"`
+	LogoURL               string                     `json:"logo_url"`
+	EntityDissolutionDate string                     `json:"entity_dissolution_date,omitempty"`
+	Auditors              []*projectservice.UserInfo `json:"auditors"`
+	Writers               []*projectservice.UserInfo `json:"writers"`
+}
+
+// ProjectNode is a generated project with the projects generated under it.
+type ProjectNode struct {
+	Project  ProjectData
+	Depth    int
+	Children []*ProjectNode
+}
+
+// Count returns the number of projects in the subtree rooted at n.
+func (n *ProjectNode) Count() int {
+	count := 1
+	for _, child := range n.Children {
+		count += child.Count()
+	}
+	return count
+}
+
+// WeightedValue is a value picked with a probability proportional to Weight.
+type WeightedValue struct {
+	Value  string
+	Weight int
+}
+
+// ParseWeightedValues parses a comma-separated list of value=weight pairs,
+// such as "Active=6,Prospect=1". A value without a weight has weight 1.
+func ParseWeightedValues(s string) ([]WeightedValue, error) {
+	var values []WeightedValue
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		value, weight := part, 1
+		if i := strings.LastIndex(part, "="); i >= 0 {
+			w, err := strconv.Atoi(strings.TrimSpace(part[i+1:]))
+			if err != nil || w < 0 {
+				return nil, fmt.Errorf("invalid weight in %q", part)
+			}
+			value, weight = strings.TrimSpace(part[:i]), w
+		}
+		if value == "" {
+			return nil, fmt.Errorf("missing value in %q", part)
+		}
+		if weight > 0 {
+			values = append(values, WeightedValue{Value: value, Weight: weight})
+		}
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no value with a positive weight in %q", s)
+	}
+	return values, nil
+}
+
+// GeneratorConfig configures the projects generated by a ProjectGenerator.
+type GeneratorConfig struct {
+	// Seed makes the generated projects the same from run to run.
+	Seed uint64
+	// MaxDepth is the number of levels under each foundation.
+	MaxDepth int
+	// MaxChildren is the maximum number of projects directly under a project.
+	MaxChildren int
+	Stages      []WeightedValue
+	Categories  []WeightedValue
+	// LogoBaseURL is the URL under which the logo of each project is.
+	LogoBaseURL string
+}
+
+// ProjectGenerator generates realistic project data from a seeded random
+// source, so that the same seed gives the same projects.
+type ProjectGenerator struct {
+	config GeneratorConfig
+	rng    *rand.Rand
+	names  map[string]bool
+}
+
+// NewProjectGenerator creates a new project generator
+func NewProjectGenerator(config GeneratorConfig) *ProjectGenerator {
+	return &ProjectGenerator{
+		config: config,
+		// The data only needs to be reproducible, not unpredictable.
+		rng:   rand.New(rand.NewPCG(config.Seed, config.Seed)),
+		names: map[string]bool{},
+	}
+}
+
+// GenerateHierarchy returns numProjects projects as foundations with nested
+// subprojects, each foundation filled up to MaxDepth levels before the next
+// one is started.
+func (pg *ProjectGenerator) GenerateHierarchy(numProjects int) []*ProjectNode {
+	var foundations []*ProjectNode
+	remaining := numProjects
+	for remaining > 0 {
+		foundation := pg.generateNode(1, &remaining)
+		foundations = append(foundations, foundation)
+	}
+	return foundations
+}
+
+// generateNode generates a project at depth and, budget permitting, the
+// projects under it, decrementing remaining for each.
+func (pg *ProjectGenerator) generateNode(depth int, remaining *int) *ProjectNode {
+	*remaining--
+	node := &ProjectNode{Project: pg.GenerateProject(depth), Depth: depth}
+	if depth > pg.config.MaxDepth || pg.config.MaxChildren <= 0 {
+		return node
+	}
+	// Foundations always get subprojects; deeper projects may have none.
+	minChildren := 0
+	if depth == 1 {
+		minChildren = 1
+	}
+	numChildren := minChildren + pg.rng.IntN(pg.config.MaxChildren-minChildren+1)
+	for i := 0; i < numChildren && *remaining > 0; i++ {
+		node.Children = append(node.Children, pg.generateNode(depth+1, remaining))
+	}
+	return node
+}
+
+// GenerateProject generates a project at depth, depth 1 being a foundation.
+func (pg *ProjectGenerator) GenerateProject(depth int) ProjectData {
+	foundation := depth == 1
+	name := pg.uniqueName(foundation)
+	stage := pg.pick(pg.config.Stages)
+
+	project := ProjectData{
+		Name:         name,
+		Description:  pg.description(name, foundation),
+		Public:       pg.rng.IntN(4) > 0,
+		IsFoundation: foundation,
+		Stage:        stage,
+		LogoURL:      strings.TrimSuffix(pg.config.LogoBaseURL, "/") + "/" + logoFileName(name),
+		Auditors:     pg.users(1, 2),
+		Writers:      pg.users(1, 3),
+	}
+	if !foundation {
+		project.Category = pg.pick(pg.config.Categories)
+	}
+	if stage == stageArchived {
+		// Archived projects must have been dissolved.
+		dissolved := time.Date(2015+pg.rng.IntN(10), time.Month(1+pg.rng.IntN(12)), 1+pg.rng.IntN(28), 0, 0, 0, 0, time.UTC)
+		project.EntityDissolutionDate = dissolved.Format(time.DateOnly)
+	}
+	return project
+}
+
+// uniqueName returns a project name that was not generated before.
+func (pg *ProjectGenerator) uniqueName(foundation bool) string {
+	for attempt := 1; ; attempt++ {
+		var name string
+		if foundation {
+			name = fmt.Sprintf("%s %s Foundation", pg.pickString(nameQualifiers), pg.pickString(foundationDomains))
+		} else {
+			name = fmt.Sprintf("%s %s", pg.pickString(projectPrefixes), pg.pickString(projectNouns))
+		}
+		// Once the combinations run short, number the names.
+		if attempt > 10 {
+			name = fmt.Sprintf("%s %d", name, attempt)
+		}
+		if !pg.names[name] {
+			pg.names[name] = true
+			return name
+		}
+	}
+}
+
+// description returns a description of the project name.
+func (pg *ProjectGenerator) description(name string, foundation bool) string {
+	if foundation {
+		return fmt.Sprintf("The %s hosts open source projects and communities in %s.", name, pg.pickString(foundationFocuses))
+	}
+	return fmt.Sprintf("%s is an open source project %s.", name, pg.pickString(projectPurposes))
+}
+
+// users returns between minUsers and maxUsers distinct people.
+func (pg *ProjectGenerator) users(minUsers, maxUsers int) []*projectservice.UserInfo {
+	count := minUsers + pg.rng.IntN(maxUsers-minUsers+1)
+	users := make([]*projectservice.UserInfo, 0, count)
+	for _, i := range pg.rng.Perm(len(people))[:count] {
+		person := people[i]
+		users = append(users, &projectservice.UserInfo{
+			Name:     stringPtr(person.name),
+			Email:    stringPtr(person.username + "@example.org"),
+			Username: stringPtr(person.username),
+			Avatar:   stringPtr("https://avatars.example.org/" + person.username + ".png"),
+		})
+	}
+	return users
+}
+
+// pick returns one of values, chosen by weight.
+func (pg *ProjectGenerator) pick(values []WeightedValue) string {
+	total := 0
+	for _, v := range values {
+		total += v.Weight
+	}
+	n := pg.rng.IntN(total)
+	for _, v := range values {
+		if n < v.Weight {
+			return v.Value
+		}
+		n -= v.Weight
+	}
+	return values[len(values)-1].Value
+}
+
+// pickString returns one of values, chosen uniformly.
+func (pg *ProjectGenerator) pickString(values []string) string {
+	return values[pg.rng.IntN(len(values))]
+}
+
+// logoFileName returns the file name of the logo of the project name.
+func logoFileName(name string) string {
+	return strings.ReplaceAll(strings.ToLower(name), " ", "-") + ".png"
+}
+
+// person is a mock writer or auditor.
+type person struct {
+	name     string
+	username string
+}
+
+var (
+	people = []person{
+		{"Priya Raman", "praman"},
+		{"Mateo García", "mgarcia"},
+		{"Wei Chen", "wchen"},
+		{"Amara Okafor", "aokafor"},
+		{"Lukas Becker", "lbecker"},
+		{"Sofia Rossi", "srossi"},
+		{"Kenji Watanabe", "kwatanabe"},
+		{"Fatima Haddad", "fhaddad"},
+		{"Olivia Martin", "omartin"},
+		{"Noah Kowalski", "nkowalski"},
+		{"Ana Souza", "asouza"},
+		{"Ravi Iyer", "riyer"},
+		{"Emma Johansson", "ejohansson"},
+		{"Daniel Cohen", "dcohen"},
+	}
+
+	nameQualifiers    = []string{"Open", "Cloud Native", "Linux", "Edge", "Secure", "Continuous", "Sovereign", "Decentralized"}
+	foundationDomains = []string{"Networking", "Storage", "AI", "Mobility", "Energy", "Finance", "Automotive", "Telecom", "Data", "Hardware"}
+	foundationFocuses = []string{
+		"networking and telecommunications",
+		"cloud infrastructure",
+		"machine learning and data",
+		"embedded and automotive systems",
+		"financial services",
+		"software supply chain security",
+	}
+	projectPrefixes = []string{"Kube", "Flux", "Quantum", "Nimbus", "Aurora", "Falcon", "Harbor", "Lattice", "Beacon", "Orbit", "Cedar", "Vortex"}
+	projectNouns    = []string{"Mesh", "Gateway", "Operator", "Runtime", "Registry", "Scheduler", "Proxy", "SDK", "Pipeline", "Toolkit", "Observer", "Vault"}
+	projectPurposes = []string{
+		"for running workloads at the edge",
+		"that schedules batch jobs across clusters",
+		"for signing and verifying software artifacts",
+		"that collects metrics, logs and traces",
+		"for managing service-to-service traffic",
+		"that provisions infrastructure declaratively",
+	}
+)
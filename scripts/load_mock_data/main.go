@@ -6,340 +6,323 @@
 package main
 
 import (
-	"bytes"
+	"cmp"
 	"context"
-	"crypto/rand"
-	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
-	"math/big"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
 	projectservice "github.com/linuxfoundation/lfx-v2-project-service/api/project/v1/gen/project_service"
+	"github.com/linuxfoundation/lfx-v2-project-service/pkg/client"
 )
 
-// ProjectData represents the structure for creating a project
-type ProjectData struct {
-	Slug         string                     `json:"slug"`
-	Name         string                     `json:"name"`
-	Description  string                     `json:"description"`
-	Public       bool                       `json:"public"`
-	IsFoundation bool                       `json:"is_foundation"`
-	ParentUID    string                     `json:"parent_uid"`
-	Auditors     []*projectservice.UserInfo `json:"auditors"`
-	Writers      []*projectservice.UserInfo `json:"writers"`
-}
-
-// ProjectResponse represents the response from the API
-type ProjectResponse struct {
-	ID          *string  `json:"id,omitempty"`
-	Slug        *string  `json:"slug,omitempty"`
-	Name        *string  `json:"name,omitempty"`
-	Description *string  `json:"description,omitempty"`
-	Public      *bool    `json:"public,omitempty"`
-	ParentUID   *string  `json:"parent_uid,omitempty"`
-	Auditors    []string `json:"auditors,omitempty"`
-	Writers     []string `json:"writers,omitempty"`
-}
+const (
+	// defaultStages are the stage weights used without -stages.
+	defaultStages = "Active=6,Formation - Engaged=2,Formation - Exploratory=1,Prospect=1"
+	// defaultCategories are the category weights used without -categories.
+	defaultCategories = "Incubating=3,Sandbox=3,Graduated=2,Growth=1,Mature=1,SIG=1"
+	// cleanupBatchSize is the number of projects deleted per bulk deletion,
+	// the most the API accepts.
+	cleanupBatchSize = 100
+)
 
 // stringPtr returns a pointer to the given string
 func stringPtr(s string) *string {
 	return &s
 }
 
+// deref returns the string s points to, or "" when s is nil
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 // Config holds the configuration for the script
 type Config struct {
 	APIURL      string
 	BearerToken string
 	NumProjects int
-	Version     string
 	Timeout     time.Duration
 	ParentUID   string
-}
-
-// ProjectGenerator generates random project data
-type ProjectGenerator struct {
-	descriptions []string
-	managerIDs   []string
-}
-
-// NewProjectGenerator creates a new project generator with predefined data
-func NewProjectGenerator() *ProjectGenerator {
-	return &ProjectGenerator{
-		descriptions: []string{
-			"A test description 1",
-			"A test description 2",
-			"A test description 3",
-			"A test description 4",
-			"A test description 5",
-			"A test description 6",
-			"A test description 7",
-			"A test description 8",
-			"A test description 9",
-			"A test description 10",
-		},
-		managerIDs: []string{
-			"user123", "user456", "user789", "admin001", "admin002", "manager001",
-			"manager002", "lead001", "lead002", "pm001", "pm002", "tech_lead_001",
-		},
-	}
-}
-
-// GenerateProject creates a random project with the given index
-func (pg *ProjectGenerator) GenerateProject(index int, parentUID string) ProjectData {
-	// Generate a random project number (1 to 100000)
-	projectNumber, _ := rand.Int(rand.Reader, big.NewInt(100000))
-	projectNumber.Add(projectNumber, big.NewInt(1)) // Add 1 to make range 1-100000
-
-	// Generate a random description
-	descIndex, _ := rand.Int(rand.Reader, big.NewInt(int64(len(pg.descriptions))))
-
-	name := fmt.Sprintf("Project %d", projectNumber.Int64())
-	description := pg.descriptions[descIndex.Int64()]
-
-	// Generate slug from name
-	slug := generateSlug(name)
-
-	// Generate random public flag
-	publicInt, _ := rand.Int(rand.Reader, big.NewInt(2))
-	publicInt.Add(publicInt, big.NewInt(1))
-	public := publicInt.Int64() == 1
-
-	// Generate random auditors (1-3 auditors)
-	numAuditors, _ := rand.Int(rand.Reader, big.NewInt(3))
-	numAuditors.Add(numAuditors, big.NewInt(1))
-
-	auditors := make([]*projectservice.UserInfo, numAuditors.Int64())
-	for i := range auditors {
-		auditorIndex, _ := rand.Int(rand.Reader, big.NewInt(int64(len(pg.managerIDs))))
-		username := pg.managerIDs[auditorIndex.Int64()]
-		auditors[i] = &projectservice.UserInfo{
-			Username: &username,
-			Name:     &username, // Use username as name for mock data
-			Email:    stringPtr(username + "@example.com"),
-			Avatar:   stringPtr(""),
-		}
-	}
-
-	// Generate random writers (1-3 writers)
-	numWriters, _ := rand.Int(rand.Reader, big.NewInt(3))
-	numWriters.Add(numWriters, big.NewInt(1))
-
-	writers := make([]*projectservice.UserInfo, numWriters.Int64())
-	for i := range writers {
-		writerIndex, _ := rand.Int(rand.Reader, big.NewInt(int64(len(pg.managerIDs))))
-		username := pg.managerIDs[writerIndex.Int64()]
-		writers[i] = &projectservice.UserInfo{
-			Username: &username,
-			Name:     &username, // Use username as name for mock data
-			Email:    stringPtr(username + "@example.com"),
-			Avatar:   stringPtr(""),
-		}
-	}
-
-	return ProjectData{
-		Slug:         slug,
-		Name:         name,
-		Description:  description,
-		Public:       public,
-		IsFoundation: false,
-		ParentUID:    parentUID,
-		Auditors:     auditors,
-		Writers:      writers,
-	}
-}
-
-// generateSlug creates a URL-friendly slug from a name
-func generateSlug(name string) string {
-	// Convert to lowercase and replace spaces/special chars with hyphens
-	slug := strings.ToLower(name)
-	slug = strings.ReplaceAll(slug, " ", "-")
-	slug = strings.ReplaceAll(slug, "_", "-")
-	slug = strings.ReplaceAll(slug, ".", "")
-	slug = strings.ReplaceAll(slug, ",", "")
-	slug = strings.ReplaceAll(slug, "(", "")
-	slug = strings.ReplaceAll(slug, ")", "")
-
-	// Remove multiple consecutive hyphens
-	for strings.Contains(slug, "--") {
-		slug = strings.ReplaceAll(slug, "--", "-")
-	}
-
-	// Remove leading/trailing hyphens
-	slug = strings.Trim(slug, "-")
-
-	// Ensure it starts with a letter and add index if needed
-	if len(slug) == 0 || !isLetter(slug[0]) {
-		slug = "project-" + slug
-	}
-
-	return slug
-}
-
-// isLetter checks if a byte is a letter
-func isLetter(b byte) bool {
-	return (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+	StateFile   string
+	Generator   GeneratorConfig
 }
 
 // ProjectClient handles API communication
 type ProjectClient struct {
-	config *Config
-	client *http.Client
+	client *client.Client
 }
 
 // NewProjectClient creates a new project client
-func NewProjectClient(config *Config) *ProjectClient {
-	return &ProjectClient{
-		config: config,
-		client: &http.Client{
-			Timeout: config.Timeout,
-		},
+func NewProjectClient(config *Config) (*ProjectClient, error) {
+	// Accept the projects endpoint URL as well as the service's base URL.
+	cfg := client.Config{
+		BaseURL:    strings.TrimSuffix(strings.TrimSuffix(config.APIURL, "/"), "/projects"),
+		HTTPClient: &http.Client{Timeout: config.Timeout},
+	}
+	if config.BearerToken != "" {
+		cfg.Token = client.StaticToken(config.BearerToken)
 	}
+	c, err := client.New(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &ProjectClient{client: c}, nil
 }
 
 // CreateProject sends a project creation request to the API
-func (pc *ProjectClient) CreateProject(ctx context.Context, project ProjectData) (*ProjectResponse, error) {
-	// Create the payload
+func (pc *ProjectClient) CreateProject(ctx context.Context, project ProjectData, parentUID string) (*projectservice.ProjectFull, error) {
 	payload := &projectservice.CreateProjectPayload{
-		Slug:         project.Slug,
 		Name:         project.Name,
 		Description:  project.Description,
 		Public:       &project.Public,
 		IsFoundation: &project.IsFoundation,
-		ParentUID:    project.ParentUID,
+		ParentUID:    parentUID,
+		Stage:        &project.Stage,
+		LogoURL:      &project.LogoURL,
 		Auditors:     project.Auditors,
 		Writers:      project.Writers,
+		// Generated names can repeat those of earlier runs.
+		AllowDuplicates: true,
 	}
+	if project.Category != "" {
+		payload.Category = &project.Category
+	}
+	if project.EntityDissolutionDate != "" {
+		payload.EntityDissolutionDate = &project.EntityDissolutionDate
+	}
+	return pc.client.CreateProject(ctx, payload)
+}
 
-	// Marshal the payload
-	payloadBytes, err := json.Marshal(map[string]interface{}{
-		"slug":          payload.Slug,
-		"name":          payload.Name,
-		"description":   payload.Description,
-		"public":        payload.Public,
-		"is_foundation": payload.IsFoundation,
-		"parent_uid":    payload.ParentUID,
-		"auditors":      payload.Auditors,
-		"writers":       payload.Writers,
-	})
+// loader creates a generated hierarchy, recording each created project in
+// the state file.
+type loader struct {
+	pc        *ProjectClient
+	state     *State
+	stateFile string
+	seed      uint64
+	created   int
+	failed    int
+	skipped   int
+	total     int
+}
+
+// LoadMockData generates numProjects projects from the generator config and
+// creates them under parentUID, parents before their children. The UID of
+// each created project is added to the state file.
+func (pc *ProjectClient) LoadMockData(ctx context.Context, config *Config) error {
+	state, err := LoadState(config.StateFile)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+		return err
 	}
 
-	// Create the request
-	req, err := http.NewRequestWithContext(ctx, "POST", pc.config.APIURL, bytes.NewBuffer(payloadBytes))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+	foundations := NewProjectGenerator(config.Generator).GenerateHierarchy(config.NumProjects)
+	l := &loader{pc: pc, state: state, stateFile: config.StateFile, seed: config.Generator.Seed, total: config.NumProjects}
+
+	log.Printf("Starting to create %d projects in %d foundations (seed %d)...", config.NumProjects, len(foundations), config.Generator.Seed)
+	for _, foundation := range foundations {
+		if err := l.create(ctx, foundation, config.ParentUID); err != nil {
+			return err
+		}
 	}
 
-	// Set headers
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+pc.config.BearerToken)
+	log.Printf("Completed! Successfully created %d projects, %d errors, %d skipped", l.created, l.failed, l.skipped)
+	log.Printf("Created projects are recorded in %s; run with -cleanup to delete them", config.StateFile)
+	return nil
+}
 
-	// Add version query parameter
-	q := req.URL.Query()
-	q.Add("v", pc.config.Version)
-	req.URL.RawQuery = q.Encode()
+// create creates the project of node under parentUID, then the projects
+// under it. The projects under a project that could not be created are
+// skipped. Only a failure to write the state file is returned.
+func (l *loader) create(ctx context.Context, node *ProjectNode, parentUID string) error {
+	log.Printf("Creating project %d/%d: %s", l.created+l.failed+l.skipped+1, l.total, node.Project.Name)
 
-	// Send the request
-	resp, err := pc.client.Do(req)
+	// The API generates the slug from the name, adding a suffix when the
+	// slug is taken.
+	created, err := l.pc.CreateProject(ctx, node.Project, parentUID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send request: %w", err)
-	}
-	defer resp.Body.Close() //nolint:errcheck
-
-	// Check response status
-	if resp.StatusCode != http.StatusCreated {
-		var errorResp map[string]interface{}
-		if err := json.NewDecoder(resp.Body).Decode(&errorResp); err != nil {
-			return nil, fmt.Errorf("request failed with status %d", resp.StatusCode)
+		log.Printf("Error creating project %s: %v", node.Project.Name, err)
+		l.failed++
+		if skipped := node.Count() - 1; skipped > 0 {
+			log.Printf("Skipping the %d projects under %s", skipped, node.Project.Name)
+			l.skipped += skipped
 		}
-		return nil, fmt.Errorf("request failed with status %d: %v", resp.StatusCode, errorResp)
+		return nil
 	}
 
-	// Parse response
-	var projectResp ProjectResponse
-	if err := json.NewDecoder(resp.Body).Decode(&projectResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	log.Printf("Successfully created project: %s", deref(created.Slug))
+	l.created++
+	l.state.Projects = append(l.state.Projects, CreatedProject{
+		UID:   deref(created.UID),
+		Slug:  deref(created.Slug),
+		Name:  node.Project.Name,
+		Depth: node.Depth,
+		Seed:  l.seed,
+	})
+	if err := l.state.Save(l.stateFile); err != nil {
+		return fmt.Errorf("error recording project %s: %w", deref(created.UID), err)
 	}
 
-	return &projectResp, nil
-}
-
-// LoadMockData loads the specified number of projects
-func (pc *ProjectClient) LoadMockData(ctx context.Context, numProjects int, parentUID string) error {
-	generator := NewProjectGenerator()
-
-	log.Printf("Starting to create %d projects...", numProjects)
+	// Add a small delay to avoid overwhelming the API
+	time.Sleep(100 * time.Millisecond)
 
-	successCount := 0
-	errorCount := 0
+	for _, child := range node.Children {
+		if err := l.create(ctx, child, deref(created.UID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	for i := 0; i < numProjects; i++ {
-		project := generator.GenerateProject(i, parentUID)
+// Cleanup deletes every project recorded in the state file, deepest first,
+// and removes from it those that were deleted or no longer exist.
+func (pc *ProjectClient) Cleanup(ctx context.Context, stateFile string) error {
+	state, err := LoadState(stateFile)
+	if err != nil {
+		return err
+	}
+	if len(state.Projects) == 0 {
+		log.Printf("No projects recorded in %s", stateFile)
+		return nil
+	}
 
-		log.Printf("Creating project %d/%d: %s (%s)", i+1, numProjects, project.Name, project.Slug)
+	// Deleting children before their parents keeps every batch free of
+	// projects whose children are in a later batch.
+	projects := slices.Clone(state.Projects)
+	slices.SortStableFunc(projects, func(a, b CreatedProject) int {
+		return cmp.Compare(b.Depth, a.Depth)
+	})
 
-		_, err := pc.CreateProject(ctx, project)
+	log.Printf("Deleting %d projects recorded in %s...", len(projects), stateFile)
+	gone := map[string]bool{}
+	deleted, failed := 0, 0
+	for batch := range slices.Chunk(projects, cleanupBatchSize) {
+		uids := make([]string, len(batch))
+		for i, project := range batch {
+			uids[i] = project.UID
+		}
+		// The generated foundations are not Crowdfunding projects, which
+		// only force allows deleting.
+		res, err := pc.client.BulkDeleteProjects(ctx, &projectservice.BulkDeleteProjectsPayload{
+			Uids:  uids,
+			Force: true,
+		})
 		if err != nil {
-			log.Printf("Error creating project %s: %v", project.Slug, err)
-			errorCount++
+			log.Printf("Error deleting %d projects: %v", len(uids), err)
+			failed += len(uids)
 			continue
 		}
+		for _, result := range res.Results {
+			switch result.Status {
+			case "deleted":
+				deleted++
+				gone[result.UID] = true
+			case "not_found":
+				log.Printf("Project %s was already deleted", result.UID)
+				gone[result.UID] = true
+			default:
+				log.Printf("Project %s %s: %s", result.UID, result.Status, deref(result.Error))
+				failed++
+			}
+		}
+	}
 
-		log.Printf("Successfully created project: %s", project.Slug)
-		successCount++
-
-		// Add a small delay to avoid overwhelming the API
-		time.Sleep(100 * time.Millisecond)
+	state.Projects = slices.DeleteFunc(state.Projects, func(p CreatedProject) bool {
+		return gone[p.UID]
+	})
+	if err := state.Save(stateFile); err != nil {
+		return fmt.Errorf("error updating state file: %w", err)
 	}
 
-	log.Printf("Completed! Successfully created %d projects, %d errors", successCount, errorCount)
+	log.Printf("Completed! Deleted %d projects, %d errors", deleted, failed)
+	if failed > 0 {
+		log.Printf("The projects that were not deleted are still recorded in %s", stateFile)
+	}
 	return nil
 }
 
 func main() {
 	// Parse command line flags
 	var (
-		apiURL      = flag.String("api-url", "http://localhost:8080/projects", "Project service API URL")
+		apiURL      = flag.String("api-url", "http://localhost:8080", "Project service base URL")
 		bearerToken = flag.String("bearer-token", "", "Bearer token for authentication")
 		numProjects = flag.Int("num-projects", 10, "Number of projects to create")
-		version     = flag.String("version", "1", "API version")
 		timeout     = flag.Duration("timeout", 30*time.Second, "Request timeout")
-		parentUID   = flag.String("parent-uid", "", "Parent UID for all generated projects")
+		parentUID   = flag.String("parent-uid", "", "Parent UID of the generated foundations")
+		seed        = flag.Uint64("seed", uint64(time.Now().UnixNano()), "Seed of the generated data; the same seed generates the same projects")
+		maxDepth    = flag.Int("max-depth", 2, "Number of subproject levels under each foundation")
+		maxChildren = flag.Int("max-children", 3, "Maximum number of subprojects directly under a project")
+		stages      = flag.String("stages", defaultStages, "Comma-separated stage=weight pairs the stages are picked from")
+		categories  = flag.String("categories", defaultCategories, "Comma-separated category=weight pairs the subproject categories are picked from")
+		logoBaseURL = flag.String("logo-base-url", "https://logos.example.org", "Base URL of the generated logo URLs")
+		stateFile   = flag.String("state-file", "load_mock_data_state.json", "File recording the created projects for -cleanup")
+		cleanup     = flag.Bool("cleanup", false, "Delete the projects recorded in -state-file instead of creating projects")
 	)
 	flag.Parse()
 
-	// Validate required parameters
-	if *numProjects <= 0 {
-		log.Fatal("Number of projects must be greater than 0.")
-	}
-	if *parentUID == "" {
-		log.Fatal("Parent UID is required. Use -parent-uid flag to specify the parent UID for all generated projects.")
-	}
-
 	// Create configuration
 	config := &Config{
 		APIURL:      *apiURL,
 		BearerToken: *bearerToken,
 		NumProjects: *numProjects,
-		Version:     *version,
 		Timeout:     *timeout,
 		ParentUID:   *parentUID,
+		StateFile:   *stateFile,
 	}
 
 	// Create client
-	client := NewProjectClient(config)
+	projectClient, err := NewProjectClient(config)
+	if err != nil {
+		log.Fatalf("Invalid API URL: %v", err)
+	}
+
+	if *cleanup {
+		// Each request is bounded by the client's timeout.
+		if err := projectClient.Cleanup(context.Background(), config.StateFile); err != nil {
+			log.Printf("Failed to clean up mock data: %v", err)
+		}
+		return
+	}
+
+	// Validate required parameters
+	if *numProjects <= 0 {
+		log.Fatal("Number of projects must be greater than 0.")
+	}
+	if *parentUID == "" {
+		log.Fatal("Parent UID is required. Use -parent-uid flag to specify the parent UID of the generated foundations.")
+	}
+	if *maxDepth < 0 || *maxChildren < 0 {
+		log.Fatal("Maximum depth and children must not be negative.")
+	}
+	stageWeights, err := ParseWeightedValues(*stages)
+	if err != nil {
+		log.Fatalf("Invalid -stages: %v", err)
+	}
+	categoryWeights, err := ParseWeightedValues(*categories)
+	if err != nil {
+		log.Fatalf("Invalid -categories: %v", err)
+	}
+	config.Generator = GeneratorConfig{
+		Seed:        *seed,
+		MaxDepth:    *maxDepth,
+		MaxChildren: *maxChildren,
+		Stages:      stageWeights,
+		Categories:  categoryWeights,
+		LogoBaseURL: *logoBaseURL,
+	}
 
 	// Create context with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), config.Timeout*time.Duration(config.NumProjects))
 	defer cancel()
 
 	// Load mock data
-	if err := client.LoadMockData(ctx, config.NumProjects, config.ParentUID); err != nil {
+	if err := projectClient.LoadMockData(ctx, config); err != nil {
 		log.Printf("Failed to load mock data: %v", err)
 		return
 	}
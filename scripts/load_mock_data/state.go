@@ -0,0 +1,64 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// CreatedProject is a project created by the loader, recorded so that
+// cleanup can delete it.
+type CreatedProject struct {
+	UID   string `json:"uid"`
+	Slug  string `json:"slug"`
+	Name  string `json:"name"`
+	Depth int    `json:"depth"`
+	Seed  uint64 `json:"seed"`
+}
+
+// State is the content of the state file: every project created by the runs
+// that wrote to it and that was not cleaned up yet.
+type State struct {
+	Projects []CreatedProject `json:"projects"`
+}
+
+// LoadState reads the state file at path; a missing file is an empty state.
+func LoadState(path string) (*State, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &State{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var state State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("invalid state file %s: %w", path, err)
+	}
+	return &state, nil
+}
+
+// Save writes the state to path, through a temporary file so that an
+// interrupted run does not leave a truncated file. An empty state removes
+// the file.
+func (s *State) Save(path string) error {
+	if len(s.Projects) == 0 {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
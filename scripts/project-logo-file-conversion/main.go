@@ -4,7 +4,6 @@
 package main
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -15,9 +14,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
-	"os/exec"
 	"slices"
-	"strconv"
 	"strings"
 	"time"
 
@@ -26,7 +23,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
-	"github.com/rustyoz/svg"
+
+	"github.com/linuxfoundation/lfx-v2-project-service/internal/infrastructure/logo"
 )
 
 var (
@@ -82,7 +80,7 @@ type ImageDimensions struct {
 
 // downloadFile tries to download an image file from a url into a local file, and then returns
 // the image dimensions by parsing the original .svg file
-func downloadFile(url string, out *os.File) (imgDimensions *ImageDimensions, err error) {
+func downloadFile(url string, out *os.File) (*ImageDimensions, error) {
 	downloadImageTime := time.Now()
 	client := http.Client{Timeout: 30 * time.Second}
 	resp, err := client.Get(url)
@@ -99,67 +97,23 @@ func downloadFile(url string, out *os.File) (imgDimensions *ImageDimensions, err
 		return nil, err
 	}
 
-	// Duplicate io.ReadCloser so it can be used for writing to the file and for parsing the svg file content
-	var buf bytes.Buffer
-	respBody := io.TeeReader(resp.Body, &buf)
-
-	// Recover from a panic that can be caused by svg.ParseSvgFromReader, which we don't have control over
-	defer func() {
-		if r := recover(); r != nil {
-			// Write the body to file
-			_, err = io.Copy(out, &buf)
-			if err != nil {
-				slog.Error("error copying response body", "url", url, "error", err)
-				return
-			}
-
-			// Use default width and height when image dimensions can't be retrieved
-			imgWidth := defaultWidth
-			imgHeight := defaultHeight
-			slog.Info("image download time",
-				"url", url,
-				"image_width", imgWidth,
-				"image_height", imgHeight,
-				"duration", time.Since(downloadImageTime).String())
-			imgDimensions = &ImageDimensions{Width: imgWidth, Height: imgHeight}
-			err = nil
-		}
-
-	}()
-
-	// Get the file (image) dimensions
-	svgImg, err := svg.ParseSvgFromReader(respBody, "project logo", 1)
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		// Don't return on error but instead just continue and use the default image width and height
-		slog.Warn("unable to parse svg image", "url", url, "error", err)
+		slog.Error("error reading response body", "url", url, "error", err)
+		return nil, err
 	}
 
-	// Set defaults, then try to get the image's actual width and height
-	imgWidth := defaultWidth
-	imgHeight := defaultHeight
-	if svgImg != nil {
-		viewBox, err := svgImg.ViewBoxValues()
-		if err != nil {
-			imgWidth, _ = strconv.Atoi(svgImg.Width)
-			imgHeight, _ = strconv.Atoi(svgImg.Height)
-			slog.Warn("unable to get image dimensions from viewbox, so using height and width on the svg element", "url", url, "error", err)
-		} else {
-			imgWidth = int(viewBox[2])  // width
-			imgHeight = int(viewBox[3]) // height
-		}
-
-		// Resort back to default dimensions if the width or height is 0, since in that case we wouldn't
-		// know what the ratio of the width:height should be.
-		if imgWidth == 0 || imgHeight == 0 {
-			slog.Warn("one of the image dimensions is set to zero, so using default height and width", "url", url, "img_width", imgWidth, "img_height", imgHeight)
-			imgWidth = defaultWidth
-			imgHeight = defaultHeight
-		}
+	// Get the image's actual width and height, using the defaults when they can't be read,
+	// since in that case we wouldn't know what the ratio of the width:height should be.
+	imgWidth, imgHeight, err := logo.SVGSize(body)
+	if err != nil {
+		slog.Warn("unable to get svg image dimensions, so using default height and width", "url", url, "error", err)
+		imgWidth = defaultWidth
+		imgHeight = defaultHeight
 	}
 
 	// Write the body to file
-	_, err = io.Copy(out, &buf)
-	if err != nil {
+	if _, err := out.Write(body); err != nil {
 		slog.Error("error copying response body into file", "url", url, "error", err)
 		return nil, err
 	}
@@ -174,24 +128,27 @@ func downloadFile(url string, out *os.File) (imgDimensions *ImageDimensions, err
 
 // convertFile converts an .svg file to .png format with a given width and height, from a specific input path to an output path
 func convertFile(inputFilePath string, outputFilePath string, imageWidth int, imageHeight int) error {
-	// Run command to export SVG as PNG with inkscape
 	conversionTime := time.Now()
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-	cmd := exec.CommandContext(
-		ctx,
-		"inkscape",
-		"--export-type=png",
-		fmt.Sprintf("--export-width=%d", imageWidth),
-		fmt.Sprintf("--export-height=%d", imageHeight),
-		fmt.Sprintf("--export-filename=%s", outputFilePath),
-		inputFilePath)
-	slog.Debug("inkscape file conversion command", "cmd", cmd.String())
-
-	if _, err := cmd.Output(); err != nil {
+	converter, err := logo.NewInkscapeConverter("inkscape")
+	if err != nil {
+		slog.Error("inkscape: executable not found", "error", err)
+		return err
+	}
+
+	svgData, err := os.ReadFile(inputFilePath)
+	if err != nil {
+		slog.Error("error reading svg file", "file", inputFilePath, "error", err)
+		return err
+	}
+	pngData, err := converter.ConvertSVGToPNG(context.Background(), svgData, imageWidth, imageHeight)
+	if err != nil {
 		slog.Error("inkscape: error running command", "error", err)
 		return err
 	}
+	if err := os.WriteFile(outputFilePath, pngData, 0644); err != nil {
+		slog.Error("error writing png file", "file", outputFilePath, "error", err)
+		return err
+	}
 	slog.Info("image conversion time",
 		"input_file", inputFilePath,
 		"output_file", outputFilePath,